@@ -11,6 +11,7 @@ import (
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -256,6 +257,126 @@ func (RestartPolicy) EnumDescriptor() ([]byte, []int) {
 	return file_api_container_service_proto_rawDescGZIP(), []int{4}
 }
 
+type StarlarkRunStatus int32
+
+const (
+	StarlarkRunStatus_STARLARK_RUN_IN_PROGRESS StarlarkRunStatus = 0
+	StarlarkRunStatus_STARLARK_RUN_SUCCEEDED   StarlarkRunStatus = 1
+	StarlarkRunStatus_STARLARK_RUN_FAILED      StarlarkRunStatus = 2
+	// The client stopped following the run before it finished, so its outcome wasn't recorded
+	StarlarkRunStatus_STARLARK_RUN_OUTCOME_UNKNOWN StarlarkRunStatus = 3
+)
+
+// Enum value maps for StarlarkRunStatus.
+var (
+	StarlarkRunStatus_name = map[int32]string{
+		0: "STARLARK_RUN_IN_PROGRESS",
+		1: "STARLARK_RUN_SUCCEEDED",
+		2: "STARLARK_RUN_FAILED",
+		3: "STARLARK_RUN_OUTCOME_UNKNOWN",
+	}
+	StarlarkRunStatus_value = map[string]int32{
+		"STARLARK_RUN_IN_PROGRESS":     0,
+		"STARLARK_RUN_SUCCEEDED":       1,
+		"STARLARK_RUN_FAILED":          2,
+		"STARLARK_RUN_OUTCOME_UNKNOWN": 3,
+	}
+)
+
+func (x StarlarkRunStatus) Enum() *StarlarkRunStatus {
+	p := new(StarlarkRunStatus)
+	*p = x
+	return p
+}
+
+func (x StarlarkRunStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StarlarkRunStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_container_service_proto_enumTypes[5].Descriptor()
+}
+
+func (StarlarkRunStatus) Type() protoreflect.EnumType {
+	return &file_api_container_service_proto_enumTypes[5]
+}
+
+func (x StarlarkRunStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StarlarkRunStatus.Descriptor instead.
+func (StarlarkRunStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{5}
+}
+
+// ==============================================================================================
+//
+//	Watch Events
+//
+// ==============================================================================================
+type ApiContainerEventType int32
+
+const (
+	ApiContainerEventType_STARLARK_RUN_STARTED   ApiContainerEventType = 0
+	ApiContainerEventType_STARLARK_RUN_FINISHED  ApiContainerEventType = 1
+	ApiContainerEventType_FILES_ARTIFACT_CREATED ApiContainerEventType = 2
+	ApiContainerEventType_SERVICE_ADDED          ApiContainerEventType = 3
+	ApiContainerEventType_SERVICE_STARTED        ApiContainerEventType = 4
+	ApiContainerEventType_SERVICE_STOPPED        ApiContainerEventType = 5
+	// A service that was started but whose container isn't running anymore, without anyone having stopped it
+	ApiContainerEventType_SERVICE_CRASHED ApiContainerEventType = 6
+)
+
+// Enum value maps for ApiContainerEventType.
+var (
+	ApiContainerEventType_name = map[int32]string{
+		0: "STARLARK_RUN_STARTED",
+		1: "STARLARK_RUN_FINISHED",
+		2: "FILES_ARTIFACT_CREATED",
+		3: "SERVICE_ADDED",
+		4: "SERVICE_STARTED",
+		5: "SERVICE_STOPPED",
+		6: "SERVICE_CRASHED",
+	}
+	ApiContainerEventType_value = map[string]int32{
+		"STARLARK_RUN_STARTED":   0,
+		"STARLARK_RUN_FINISHED":  1,
+		"FILES_ARTIFACT_CREATED": 2,
+		"SERVICE_ADDED":          3,
+		"SERVICE_STARTED":        4,
+		"SERVICE_STOPPED":        5,
+		"SERVICE_CRASHED":        6,
+	}
+)
+
+func (x ApiContainerEventType) Enum() *ApiContainerEventType {
+	p := new(ApiContainerEventType)
+	*p = x
+	return p
+}
+
+func (x ApiContainerEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ApiContainerEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_container_service_proto_enumTypes[6].Descriptor()
+}
+
+func (ApiContainerEventType) Type() protoreflect.EnumType {
+	return &file_api_container_service_proto_enumTypes[6]
+}
+
+func (x ApiContainerEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ApiContainerEventType.Descriptor instead.
+func (ApiContainerEventType) EnumDescriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{6}
+}
+
 type Port_TransportProtocol int32
 
 const (
@@ -289,11 +410,11 @@ func (x Port_TransportProtocol) String() string {
 }
 
 func (Port_TransportProtocol) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_container_service_proto_enumTypes[5].Descriptor()
+	return file_api_container_service_proto_enumTypes[7].Descriptor()
 }
 
 func (Port_TransportProtocol) Type() protoreflect.EnumType {
-	return &file_api_container_service_proto_enumTypes[5]
+	return &file_api_container_service_proto_enumTypes[7]
 }
 
 func (x Port_TransportProtocol) Number() protoreflect.EnumNumber {
@@ -338,11 +459,11 @@ func (x Container_Status) String() string {
 }
 
 func (Container_Status) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_container_service_proto_enumTypes[6].Descriptor()
+	return file_api_container_service_proto_enumTypes[8].Descriptor()
 }
 
 func (Container_Status) Type() protoreflect.EnumType {
-	return &file_api_container_service_proto_enumTypes[6]
+	return &file_api_container_service_proto_enumTypes[8]
 }
 
 func (x Container_Status) Number() protoreflect.EnumNumber {
@@ -1454,6 +1575,7 @@ type StarlarkRunResponseLine struct {
 	//	*StarlarkRunResponseLine_RunFinishedEvent
 	//	*StarlarkRunResponseLine_Warning
 	//	*StarlarkRunResponseLine_Info
+	//	*StarlarkRunResponseLine_InstructionOutput
 	RunResponseLine isStarlarkRunResponseLine_RunResponseLine `protobuf_oneof:"run_response_line"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
@@ -1559,6 +1681,15 @@ func (x *StarlarkRunResponseLine) GetInfo() *StarlarkInfo {
 	return nil
 }
 
+func (x *StarlarkRunResponseLine) GetInstructionOutput() *StarlarkInstructionOutput {
+	if x != nil {
+		if x, ok := x.RunResponseLine.(*StarlarkRunResponseLine_InstructionOutput); ok {
+			return x.InstructionOutput
+		}
+	}
+	return nil
+}
+
 type isStarlarkRunResponseLine_RunResponseLine interface {
 	isStarlarkRunResponseLine_RunResponseLine()
 }
@@ -1591,6 +1722,10 @@ type StarlarkRunResponseLine_Info struct {
 	Info *StarlarkInfo `protobuf:"bytes,7,opt,name=info,proto3,oneof"`
 }
 
+type StarlarkRunResponseLine_InstructionOutput struct {
+	InstructionOutput *StarlarkInstructionOutput `protobuf:"bytes,8,opt,name=instruction_output,json=instructionOutput,proto3,oneof"`
+}
+
 func (*StarlarkRunResponseLine_Instruction) isStarlarkRunResponseLine_RunResponseLine() {}
 
 func (*StarlarkRunResponseLine_Error) isStarlarkRunResponseLine_RunResponseLine() {}
@@ -1605,6 +1740,61 @@ func (*StarlarkRunResponseLine_Warning) isStarlarkRunResponseLine_RunResponseLin
 
 func (*StarlarkRunResponseLine_Info) isStarlarkRunResponseLine_RunResponseLine() {}
 
+func (*StarlarkRunResponseLine_InstructionOutput) isStarlarkRunResponseLine_RunResponseLine() {}
+
+// A line of output produced by an instruction while it is being executed, for example the output of an `exec`
+type StarlarkInstructionOutput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OutputLine    string                 `protobuf:"bytes,1,opt,name=output_line,json=outputLine,proto3" json:"output_line,omitempty"`
+	InstructionId *string                `protobuf:"bytes,2,opt,name=instruction_id,json=instructionId,proto3,oneof" json:"instruction_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StarlarkInstructionOutput) Reset() {
+	*x = StarlarkInstructionOutput{}
+	mi := &file_api_container_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StarlarkInstructionOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StarlarkInstructionOutput) ProtoMessage() {}
+
+func (x *StarlarkInstructionOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StarlarkInstructionOutput.ProtoReflect.Descriptor instead.
+func (*StarlarkInstructionOutput) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *StarlarkInstructionOutput) GetOutputLine() string {
+	if x != nil {
+		return x.OutputLine
+	}
+	return ""
+}
+
+func (x *StarlarkInstructionOutput) GetInstructionId() string {
+	if x != nil && x.InstructionId != nil {
+		return *x.InstructionId
+	}
+	return ""
+}
+
 type StarlarkInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	InfoMessage   string                 `protobuf:"bytes,1,opt,name=info_message,json=infoMessage,proto3" json:"info_message,omitempty"`
@@ -1614,7 +1804,7 @@ type StarlarkInfo struct {
 
 func (x *StarlarkInfo) Reset() {
 	*x = StarlarkInfo{}
-	mi := &file_api_container_service_proto_msgTypes[10]
+	mi := &file_api_container_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1626,7 +1816,7 @@ func (x *StarlarkInfo) String() string {
 func (*StarlarkInfo) ProtoMessage() {}
 
 func (x *StarlarkInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_api_container_service_proto_msgTypes[10]
+	mi := &file_api_container_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1639,7 +1829,7 @@ func (x *StarlarkInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StarlarkInfo.ProtoReflect.Descriptor instead.
 func (*StarlarkInfo) Descriptor() ([]byte, []int) {
-	return file_api_container_service_proto_rawDescGZIP(), []int{10}
+	return file_api_container_service_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *StarlarkInfo) GetInfoMessage() string {
@@ -1658,7 +1848,7 @@ type StarlarkWarning struct {
 
 func (x *StarlarkWarning) Reset() {
 	*x = StarlarkWarning{}
-	mi := &file_api_container_service_proto_msgTypes[11]
+	mi := &file_api_container_service_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1670,7 +1860,7 @@ func (x *StarlarkWarning) String() string {
 func (*StarlarkWarning) ProtoMessage() {}
 
 func (x *StarlarkWarning) ProtoReflect() protoreflect.Message {
-	mi := &file_api_container_service_proto_msgTypes[11]
+	mi := &file_api_container_service_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1683,7 +1873,7 @@ func (x *StarlarkWarning) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StarlarkWarning.ProtoReflect.Descriptor instead.
 func (*StarlarkWarning) Descriptor() ([]byte, []int) {
-	return file_api_container_service_proto_rawDescGZIP(), []int{11}
+	return file_api_container_service_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *StarlarkWarning) GetWarningMessage() string {
@@ -1708,7 +1898,7 @@ type StarlarkInstruction struct {
 
 func (x *StarlarkInstruction) Reset() {
 	*x = StarlarkInstruction{}
-	mi := &file_api_container_service_proto_msgTypes[12]
+	mi := &file_api_container_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1720,7 +1910,7 @@ func (x *StarlarkInstruction) String() string {
 func (*StarlarkInstruction) ProtoMessage() {}
 
 func (x *StarlarkInstruction) ProtoReflect() protoreflect.Message {
-	mi := &file_api_container_service_proto_msgTypes[12]
+	mi := &file_api_container_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1733,7 +1923,7 @@ func (x *StarlarkInstruction) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StarlarkInstruction.ProtoReflect.Descriptor instead.
 func (*StarlarkInstruction) Descriptor() ([]byte, []int) {
-	return file_api_container_service_proto_rawDescGZIP(), []int{12}
+	return file_api_container_service_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *StarlarkInstruction) GetPosition() *StarlarkInstructionPosition {
@@ -1796,7 +1986,7 @@ type StarlarkInstructionResult struct {
 
 func (x *StarlarkInstructionResult) Reset() {
 	*x = StarlarkInstructionResult{}
-	mi := &file_api_container_service_proto_msgTypes[13]
+	mi := &file_api_container_service_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1808,7 +1998,7 @@ func (x *StarlarkInstructionResult) String() string {
 func (*StarlarkInstructionResult) ProtoMessage() {}
 
 func (x *StarlarkInstructionResult) ProtoReflect() protoreflect.Message {
-	mi := &file_api_container_service_proto_msgTypes[13]
+	mi := &file_api_container_service_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1821,7 +2011,7 @@ func (x *StarlarkInstructionResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StarlarkInstructionResult.ProtoReflect.Descriptor instead.
 func (*StarlarkInstructionResult) Descriptor() ([]byte, []int) {
-	return file_api_container_service_proto_rawDescGZIP(), []int{13}
+	return file_api_container_service_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *StarlarkInstructionResult) GetSerializedInstructionResult() string {
@@ -1856,7 +2046,7 @@ type StarlarkInstructionArg struct {
 
 func (x *StarlarkInstructionArg) Reset() {
 	*x = StarlarkInstructionArg{}
-	mi := &file_api_container_service_proto_msgTypes[14]
+	mi := &file_api_container_service_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1868,7 +2058,7 @@ func (x *StarlarkInstructionArg) String() string {
 func (*StarlarkInstructionArg) ProtoMessage() {}
 
 func (x *StarlarkInstructionArg) ProtoReflect() protoreflect.Message {
-	mi := &file_api_container_service_proto_msgTypes[14]
+	mi := &file_api_container_service_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1881,7 +2071,7 @@ func (x *StarlarkInstructionArg) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StarlarkInstructionArg.ProtoReflect.Descriptor instead.
 func (*StarlarkInstructionArg) Descriptor() ([]byte, []int) {
-	return file_api_container_service_proto_rawDescGZIP(), []int{14}
+	return file_api_container_service_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *StarlarkInstructionArg) GetSerializedArgValue() string {
@@ -1916,7 +2106,7 @@ type StarlarkInstructionPosition struct {
 
 func (x *StarlarkInstructionPosition) Reset() {
 	*x = StarlarkInstructionPosition{}
-	mi := &file_api_container_service_proto_msgTypes[15]
+	mi := &file_api_container_service_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1928,7 +2118,7 @@ func (x *StarlarkInstructionPosition) String() string {
 func (*StarlarkInstructionPosition) ProtoMessage() {}
 
 func (x *StarlarkInstructionPosition) ProtoReflect() protoreflect.Message {
-	mi := &file_api_container_service_proto_msgTypes[15]
+	mi := &file_api_container_service_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1941,7 +2131,7 @@ func (x *StarlarkInstructionPosition) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StarlarkInstructionPosition.ProtoReflect.Descriptor instead.
 func (*StarlarkInstructionPosition) Descriptor() ([]byte, []int) {
-	return file_api_container_service_proto_rawDescGZIP(), []int{15}
+	return file_api_container_service_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *StarlarkInstructionPosition) GetFilename() string {
@@ -1979,7 +2169,7 @@ type StarlarkError struct {
 
 func (x *StarlarkError) Reset() {
 	*x = StarlarkError{}
-	mi := &file_api_container_service_proto_msgTypes[16]
+	mi := &file_api_container_service_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1991,7 +2181,7 @@ func (x *StarlarkError) String() string {
 func (*StarlarkError) ProtoMessage() {}
 
 func (x *StarlarkError) ProtoReflect() protoreflect.Message {
-	mi := &file_api_container_service_proto_msgTypes[16]
+	mi := &file_api_container_service_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2004,7 +2194,7 @@ func (x *StarlarkError) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StarlarkError.ProtoReflect.Descriptor instead.
 func (*StarlarkError) Descriptor() ([]byte, []int) {
-	return file_api_container_service_proto_rawDescGZIP(), []int{16}
+	return file_api_container_service_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *StarlarkError) GetError() isStarlarkError_Error {
@@ -2072,7 +2262,7 @@ type StarlarkInterpretationError struct {
 
 func (x *StarlarkInterpretationError) Reset() {
 	*x = StarlarkInterpretationError{}
-	mi := &file_api_container_service_proto_msgTypes[17]
+	mi := &file_api_container_service_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2084,7 +2274,7 @@ func (x *StarlarkInterpretationError) String() string {
 func (*StarlarkInterpretationError) ProtoMessage() {}
 
 func (x *StarlarkInterpretationError) ProtoReflect() protoreflect.Message {
-	mi := &file_api_container_service_proto_msgTypes[17]
+	mi := &file_api_container_service_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2097,7 +2287,7 @@ func (x *StarlarkInterpretationError) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StarlarkInterpretationError.ProtoReflect.Descriptor instead.
 func (*StarlarkInterpretationError) Descriptor() ([]byte, []int) {
-	return file_api_container_service_proto_rawDescGZIP(), []int{17}
+	return file_api_container_service_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *StarlarkInterpretationError) GetErrorMessage() string {
@@ -2116,7 +2306,7 @@ type StarlarkValidationError struct {
 
 func (x *StarlarkValidationError) Reset() {
 	*x = StarlarkValidationError{}
-	mi := &file_api_container_service_proto_msgTypes[18]
+	mi := &file_api_container_service_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2128,7 +2318,7 @@ func (x *StarlarkValidationError) String() string {
 func (*StarlarkValidationError) ProtoMessage() {}
 
 func (x *StarlarkValidationError) ProtoReflect() protoreflect.Message {
-	mi := &file_api_container_service_proto_msgTypes[18]
+	mi := &file_api_container_service_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2141,7 +2331,7 @@ func (x *StarlarkValidationError) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StarlarkValidationError.ProtoReflect.Descriptor instead.
 func (*StarlarkValidationError) Descriptor() ([]byte, []int) {
-	return file_api_container_service_proto_rawDescGZIP(), []int{18}
+	return file_api_container_service_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *StarlarkValidationError) GetErrorMessage() string {
@@ -2160,7 +2350,7 @@ type StarlarkExecutionError struct {
 
 func (x *StarlarkExecutionError) Reset() {
 	*x = StarlarkExecutionError{}
-	mi := &file_api_container_service_proto_msgTypes[19]
+	mi := &file_api_container_service_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2172,7 +2362,7 @@ func (x *StarlarkExecutionError) String() string {
 func (*StarlarkExecutionError) ProtoMessage() {}
 
 func (x *StarlarkExecutionError) ProtoReflect() protoreflect.Message {
-	mi := &file_api_container_service_proto_msgTypes[19]
+	mi := &file_api_container_service_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2185,7 +2375,7 @@ func (x *StarlarkExecutionError) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StarlarkExecutionError.ProtoReflect.Descriptor instead.
 func (*StarlarkExecutionError) Descriptor() ([]byte, []int) {
-	return file_api_container_service_proto_rawDescGZIP(), []int{19}
+	return file_api_container_service_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *StarlarkExecutionError) GetErrorMessage() string {
@@ -2207,7 +2397,7 @@ type StarlarkRunProgress struct {
 
 func (x *StarlarkRunProgress) Reset() {
 	*x = StarlarkRunProgress{}
-	mi := &file_api_container_service_proto_msgTypes[20]
+	mi := &file_api_container_service_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2219,7 +2409,7 @@ func (x *StarlarkRunProgress) String() string {
 func (*StarlarkRunProgress) ProtoMessage() {}
 
 func (x *StarlarkRunProgress) ProtoReflect() protoreflect.Message {
-	mi := &file_api_container_service_proto_msgTypes[20]
+	mi := &file_api_container_service_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2232,7 +2422,7 @@ func (x *StarlarkRunProgress) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StarlarkRunProgress.ProtoReflect.Descriptor instead.
 func (*StarlarkRunProgress) Descriptor() ([]byte, []int) {
-	return file_api_container_service_proto_rawDescGZIP(), []int{20}
+	return file_api_container_service_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *StarlarkRunProgress) GetCurrentStepInfo() []string {
@@ -2274,7 +2464,7 @@ type StarlarkRunFinishedEvent struct {
 
 func (x *StarlarkRunFinishedEvent) Reset() {
 	*x = StarlarkRunFinishedEvent{}
-	mi := &file_api_container_service_proto_msgTypes[21]
+	mi := &file_api_container_service_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2286,7 +2476,7 @@ func (x *StarlarkRunFinishedEvent) String() string {
 func (*StarlarkRunFinishedEvent) ProtoMessage() {}
 
 func (x *StarlarkRunFinishedEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_api_container_service_proto_msgTypes[21]
+	mi := &file_api_container_service_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2299,7 +2489,7 @@ func (x *StarlarkRunFinishedEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StarlarkRunFinishedEvent.ProtoReflect.Descriptor instead.
 func (*StarlarkRunFinishedEvent) Descriptor() ([]byte, []int) {
-	return file_api_container_service_proto_rawDescGZIP(), []int{21}
+	return file_api_container_service_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *StarlarkRunFinishedEvent) GetIsRunSuccessful() bool {
@@ -2339,7 +2529,7 @@ type GetServicesArgs struct {
 
 func (x *GetServicesArgs) Reset() {
 	*x = GetServicesArgs{}
-	mi := &file_api_container_service_proto_msgTypes[22]
+	mi := &file_api_container_service_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2351,7 +2541,7 @@ func (x *GetServicesArgs) String() string {
 func (*GetServicesArgs) ProtoMessage() {}
 
 func (x *GetServicesArgs) ProtoReflect() protoreflect.Message {
-	mi := &file_api_container_service_proto_msgTypes[22]
+	mi := &file_api_container_service_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2364,7 +2554,7 @@ func (x *GetServicesArgs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetServicesArgs.ProtoReflect.Descriptor instead.
 func (*GetServicesArgs) Descriptor() ([]byte, []int) {
-	return file_api_container_service_proto_rawDescGZIP(), []int{22}
+	return file_api_container_service_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *GetServicesArgs) GetServiceIdentifiers() map[string]bool {
@@ -2384,7 +2574,7 @@ type GetServicesResponse struct {
 
 func (x *GetServicesResponse) Reset() {
 	*x = GetServicesResponse{}
-	mi := &file_api_container_service_proto_msgTypes[23]
+	mi := &file_api_container_service_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2396,7 +2586,7 @@ func (x *GetServicesResponse) String() string {
 func (*GetServicesResponse) ProtoMessage() {}
 
 func (x *GetServicesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_container_service_proto_msgTypes[23]
+	mi := &file_api_container_service_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2409,7 +2599,7 @@ func (x *GetServicesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetServicesResponse.ProtoReflect.Descriptor instead.
 func (*GetServicesResponse) Descriptor() ([]byte, []int) {
-	return file_api_container_service_proto_rawDescGZIP(), []int{23}
+	return file_api_container_service_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *GetServicesResponse) GetServiceInfo() map[string]*ServiceInfo {
@@ -2434,7 +2624,7 @@ type ServiceIdentifiers struct {
 
 func (x *ServiceIdentifiers) Reset() {
 	*x = ServiceIdentifiers{}
-	mi := &file_api_container_service_proto_msgTypes[24]
+	mi := &file_api_container_service_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2446,7 +2636,7 @@ func (x *ServiceIdentifiers) String() string {
 func (*ServiceIdentifiers) ProtoMessage() {}
 
 func (x *ServiceIdentifiers) ProtoReflect() protoreflect.Message {
-	mi := &file_api_container_service_proto_msgTypes[24]
+	mi := &file_api_container_service_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2459,7 +2649,7 @@ func (x *ServiceIdentifiers) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServiceIdentifiers.ProtoReflect.Descriptor instead.
 func (*ServiceIdentifiers) Descriptor() ([]byte, []int) {
-	return file_api_container_service_proto_rawDescGZIP(), []int{24}
+	return file_api_container_service_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *ServiceIdentifiers) GetServiceUuid() string {
@@ -2492,7 +2682,7 @@ type GetExistingAndHistoricalServiceIdentifiersResponse struct {
 
 func (x *GetExistingAndHistoricalServiceIdentifiersResponse) Reset() {
 	*x = GetExistingAndHistoricalServiceIdentifiersResponse{}
-	mi := &file_api_container_service_proto_msgTypes[25]
+	mi := &file_api_container_service_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2504,7 +2694,7 @@ func (x *GetExistingAndHistoricalServiceIdentifiersResponse) String() string {
 func (*GetExistingAndHistoricalServiceIdentifiersResponse) ProtoMessage() {}
 
 func (x *GetExistingAndHistoricalServiceIdentifiersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_container_service_proto_msgTypes[25]
+	mi := &file_api_container_service_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2517,7 +2707,7 @@ func (x *GetExistingAndHistoricalServiceIdentifiersResponse) ProtoReflect() prot
 
 // Deprecated: Use GetExistingAndHistoricalServiceIdentifiersResponse.ProtoReflect.Descriptor instead.
 func (*GetExistingAndHistoricalServiceIdentifiersResponse) Descriptor() ([]byte, []int) {
-	return file_api_container_service_proto_rawDescGZIP(), []int{25}
+	return file_api_container_service_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *GetExistingAndHistoricalServiceIdentifiersResponse) GetAllIdentifiers() []*ServiceIdentifiers {
@@ -2543,7 +2733,7 @@ type ExecCommandArgs struct {
 
 func (x *ExecCommandArgs) Reset() {
 	*x = ExecCommandArgs{}
-	mi := &file_api_container_service_proto_msgTypes[26]
+	mi := &file_api_container_service_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2555,7 +2745,7 @@ func (x *ExecCommandArgs) String() string {
 func (*ExecCommandArgs) ProtoMessage() {}
 
 func (x *ExecCommandArgs) ProtoReflect() protoreflect.Message {
-	mi := &file_api_container_service_proto_msgTypes[26]
+	mi := &file_api_container_service_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2568,7 +2758,7 @@ func (x *ExecCommandArgs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExecCommandArgs.ProtoReflect.Descriptor instead.
 func (*ExecCommandArgs) Descriptor() ([]byte, []int) {
-	return file_api_container_service_proto_rawDescGZIP(), []int{26}
+	return file_api_container_service_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *ExecCommandArgs) GetServiceIdentifier() string {
@@ -2596,7 +2786,7 @@ type ExecCommandResponse struct {
 
 func (x *ExecCommandResponse) Reset() {
 	*x = ExecCommandResponse{}
-	mi := &file_api_container_service_proto_msgTypes[27]
+	mi := &file_api_container_service_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2608,7 +2798,7 @@ func (x *ExecCommandResponse) String() string {
 func (*ExecCommandResponse) ProtoMessage() {}
 
 func (x *ExecCommandResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_container_service_proto_msgTypes[27]
+	mi := &file_api_container_service_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2621,7 +2811,7 @@ func (x *ExecCommandResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExecCommandResponse.ProtoReflect.Descriptor instead.
 func (*ExecCommandResponse) Descriptor() ([]byte, []int) {
-	return file_api_container_service_proto_rawDescGZIP(), []int{27}
+	return file_api_container_service_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *ExecCommandResponse) GetExitCode() int32 {
@@ -2640,44 +2830,37 @@ func (x *ExecCommandResponse) GetLogOutput() string {
 
 // ==============================================================================================
 //
-//	Wait For HTTP Get Endpoint Availability
+//	Stream Exec Command
 //
 // ==============================================================================================
-type WaitForHttpGetEndpointAvailabilityArgs struct {
+type StreamExecCommandArgs struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The identifier of the service to check.
-	ServiceIdentifier string `protobuf:"bytes,1,opt,name=service_identifier,json=serviceIdentifier,proto3" json:"service_identifier,omitempty"`
-	// The port of the service to check. For instance 8080
-	Port uint32 `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
-	// The path of the service to check. It mustn't start with the first slash. For instance `service/health`
-	Path *string `protobuf:"bytes,3,opt,name=path,proto3,oneof" json:"path,omitempty"`
-	// The number of milliseconds to wait until executing the first HTTP call
-	InitialDelayMilliseconds *uint32 `protobuf:"varint,4,opt,name=initial_delay_milliseconds,json=initialDelayMilliseconds,proto3,oneof" json:"initial_delay_milliseconds,omitempty"`
-	// Max number of HTTP call attempts that this will execute until giving up and returning an error
-	Retries *uint32 `protobuf:"varint,5,opt,name=retries,proto3,oneof" json:"retries,omitempty"`
-	// Number of milliseconds to wait between retries
-	RetriesDelayMilliseconds *uint32 `protobuf:"varint,6,opt,name=retries_delay_milliseconds,json=retriesDelayMilliseconds,proto3,oneof" json:"retries_delay_milliseconds,omitempty"`
-	// If the endpoint returns this value, the service will be marked as available (e.g. Hello World).
-	BodyText      *string `protobuf:"bytes,7,opt,name=body_text,json=bodyText,proto3,oneof" json:"body_text,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// Types that are valid to be assigned to StreamExecCommandInput:
+	//
+	//	*StreamExecCommandArgs_Start
+	//	*StreamExecCommandArgs_Stdin
+	//	*StreamExecCommandArgs_CloseStdin
+	//	*StreamExecCommandArgs_TerminalSize
+	StreamExecCommandInput isStreamExecCommandArgs_StreamExecCommandInput `protobuf_oneof:"stream_exec_command_input"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
-func (x *WaitForHttpGetEndpointAvailabilityArgs) Reset() {
-	*x = WaitForHttpGetEndpointAvailabilityArgs{}
-	mi := &file_api_container_service_proto_msgTypes[28]
+func (x *StreamExecCommandArgs) Reset() {
+	*x = StreamExecCommandArgs{}
+	mi := &file_api_container_service_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WaitForHttpGetEndpointAvailabilityArgs) String() string {
+func (x *StreamExecCommandArgs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WaitForHttpGetEndpointAvailabilityArgs) ProtoMessage() {}
+func (*StreamExecCommandArgs) ProtoMessage() {}
 
-func (x *WaitForHttpGetEndpointAvailabilityArgs) ProtoReflect() protoreflect.Message {
-	mi := &file_api_container_service_proto_msgTypes[28]
+func (x *StreamExecCommandArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2688,66 +2871,322 @@ func (x *WaitForHttpGetEndpointAvailabilityArgs) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WaitForHttpGetEndpointAvailabilityArgs.ProtoReflect.Descriptor instead.
-func (*WaitForHttpGetEndpointAvailabilityArgs) Descriptor() ([]byte, []int) {
-	return file_api_container_service_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use StreamExecCommandArgs.ProtoReflect.Descriptor instead.
+func (*StreamExecCommandArgs) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *WaitForHttpGetEndpointAvailabilityArgs) GetServiceIdentifier() string {
+func (x *StreamExecCommandArgs) GetStreamExecCommandInput() isStreamExecCommandArgs_StreamExecCommandInput {
 	if x != nil {
-		return x.ServiceIdentifier
+		return x.StreamExecCommandInput
 	}
-	return ""
+	return nil
 }
 
-func (x *WaitForHttpGetEndpointAvailabilityArgs) GetPort() uint32 {
+func (x *StreamExecCommandArgs) GetStart() *StreamExecCommandStart {
 	if x != nil {
-		return x.Port
+		if x, ok := x.StreamExecCommandInput.(*StreamExecCommandArgs_Start); ok {
+			return x.Start
+		}
 	}
-	return 0
+	return nil
 }
 
-func (x *WaitForHttpGetEndpointAvailabilityArgs) GetPath() string {
-	if x != nil && x.Path != nil {
-		return *x.Path
+func (x *StreamExecCommandArgs) GetStdin() []byte {
+	if x != nil {
+		if x, ok := x.StreamExecCommandInput.(*StreamExecCommandArgs_Stdin); ok {
+			return x.Stdin
+		}
+	}
+	return nil
+}
+
+func (x *StreamExecCommandArgs) GetCloseStdin() bool {
+	if x != nil {
+		if x, ok := x.StreamExecCommandInput.(*StreamExecCommandArgs_CloseStdin); ok {
+			return x.CloseStdin
+		}
+	}
+	return false
+}
+
+func (x *StreamExecCommandArgs) GetTerminalSize() *TerminalSize {
+	if x != nil {
+		if x, ok := x.StreamExecCommandInput.(*StreamExecCommandArgs_TerminalSize); ok {
+			return x.TerminalSize
+		}
+	}
+	return nil
+}
+
+type isStreamExecCommandArgs_StreamExecCommandInput interface {
+	isStreamExecCommandArgs_StreamExecCommandInput()
+}
+
+type StreamExecCommandArgs_Start struct {
+	// Must be the first message sent, and only sent once
+	Start *StreamExecCommandStart `protobuf:"bytes,1,opt,name=start,proto3,oneof"`
+}
+
+type StreamExecCommandArgs_Stdin struct {
+	// Bytes to write to the stdin of the command. Ignored if stdin wasn't attached
+	Stdin []byte `protobuf:"bytes,2,opt,name=stdin,proto3,oneof"`
+}
+
+type StreamExecCommandArgs_CloseStdin struct {
+	// Signals that there's no more stdin to send
+	CloseStdin bool `protobuf:"varint,3,opt,name=close_stdin,json=closeStdin,proto3,oneof"`
+}
+
+type StreamExecCommandArgs_TerminalSize struct {
+	// New size of the client terminal. Ignored if no TTY was allocated
+	TerminalSize *TerminalSize `protobuf:"bytes,4,opt,name=terminal_size,json=terminalSize,proto3,oneof"`
+}
+
+func (*StreamExecCommandArgs_Start) isStreamExecCommandArgs_StreamExecCommandInput() {}
+
+func (*StreamExecCommandArgs_Stdin) isStreamExecCommandArgs_StreamExecCommandInput() {}
+
+func (*StreamExecCommandArgs_CloseStdin) isStreamExecCommandArgs_StreamExecCommandInput() {}
+
+func (*StreamExecCommandArgs_TerminalSize) isStreamExecCommandArgs_StreamExecCommandInput() {}
+
+type StreamExecCommandStart struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The service identifier of the container that the command should be executed in
+	ServiceIdentifier string   `protobuf:"bytes,1,opt,name=service_identifier,json=serviceIdentifier,proto3" json:"service_identifier,omitempty"`
+	CommandArgs       []string `protobuf:"bytes,2,rep,name=command_args,json=commandArgs,proto3" json:"command_args,omitempty"`
+	// Whether the stdin sent by the client should be attached to the command
+	AttachStdin bool `protobuf:"varint,3,opt,name=attach_stdin,json=attachStdin,proto3" json:"attach_stdin,omitempty"`
+	// Whether a TTY should be allocated for the command. With a TTY, stderr is merged into stdout
+	Tty           bool          `protobuf:"varint,4,opt,name=tty,proto3" json:"tty,omitempty"`
+	TerminalSize  *TerminalSize `protobuf:"bytes,5,opt,name=terminal_size,json=terminalSize,proto3,oneof" json:"terminal_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamExecCommandStart) Reset() {
+	*x = StreamExecCommandStart{}
+	mi := &file_api_container_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamExecCommandStart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamExecCommandStart) ProtoMessage() {}
+
+func (x *StreamExecCommandStart) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamExecCommandStart.ProtoReflect.Descriptor instead.
+func (*StreamExecCommandStart) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *StreamExecCommandStart) GetServiceIdentifier() string {
+	if x != nil {
+		return x.ServiceIdentifier
 	}
 	return ""
 }
 
-func (x *WaitForHttpGetEndpointAvailabilityArgs) GetInitialDelayMilliseconds() uint32 {
-	if x != nil && x.InitialDelayMilliseconds != nil {
-		return *x.InitialDelayMilliseconds
+func (x *StreamExecCommandStart) GetCommandArgs() []string {
+	if x != nil {
+		return x.CommandArgs
 	}
-	return 0
+	return nil
 }
 
-func (x *WaitForHttpGetEndpointAvailabilityArgs) GetRetries() uint32 {
-	if x != nil && x.Retries != nil {
-		return *x.Retries
+func (x *StreamExecCommandStart) GetAttachStdin() bool {
+	if x != nil {
+		return x.AttachStdin
+	}
+	return false
+}
+
+func (x *StreamExecCommandStart) GetTty() bool {
+	if x != nil {
+		return x.Tty
+	}
+	return false
+}
+
+func (x *StreamExecCommandStart) GetTerminalSize() *TerminalSize {
+	if x != nil {
+		return x.TerminalSize
+	}
+	return nil
+}
+
+type TerminalSize struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Width         uint32                 `protobuf:"varint,1,opt,name=width,proto3" json:"width,omitempty"`
+	Height        uint32                 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TerminalSize) Reset() {
+	*x = TerminalSize{}
+	mi := &file_api_container_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TerminalSize) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminalSize) ProtoMessage() {}
+
+func (x *TerminalSize) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminalSize.ProtoReflect.Descriptor instead.
+func (*TerminalSize) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *TerminalSize) GetWidth() uint32 {
+	if x != nil {
+		return x.Width
 	}
 	return 0
 }
 
-func (x *WaitForHttpGetEndpointAvailabilityArgs) GetRetriesDelayMilliseconds() uint32 {
-	if x != nil && x.RetriesDelayMilliseconds != nil {
-		return *x.RetriesDelayMilliseconds
+func (x *TerminalSize) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
 	}
 	return 0
 }
 
-func (x *WaitForHttpGetEndpointAvailabilityArgs) GetBodyText() string {
-	if x != nil && x.BodyText != nil {
-		return *x.BodyText
+type StreamExecCommandResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to StreamExecCommandOutput:
+	//
+	//	*StreamExecCommandResponse_Stdout
+	//	*StreamExecCommandResponse_Stderr
+	//	*StreamExecCommandResponse_ExitCode
+	StreamExecCommandOutput isStreamExecCommandResponse_StreamExecCommandOutput `protobuf_oneof:"stream_exec_command_output"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *StreamExecCommandResponse) Reset() {
+	*x = StreamExecCommandResponse{}
+	mi := &file_api_container_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamExecCommandResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamExecCommandResponse) ProtoMessage() {}
+
+func (x *StreamExecCommandResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamExecCommandResponse.ProtoReflect.Descriptor instead.
+func (*StreamExecCommandResponse) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *StreamExecCommandResponse) GetStreamExecCommandOutput() isStreamExecCommandResponse_StreamExecCommandOutput {
+	if x != nil {
+		return x.StreamExecCommandOutput
+	}
+	return nil
+}
+
+func (x *StreamExecCommandResponse) GetStdout() []byte {
+	if x != nil {
+		if x, ok := x.StreamExecCommandOutput.(*StreamExecCommandResponse_Stdout); ok {
+			return x.Stdout
+		}
+	}
+	return nil
+}
+
+func (x *StreamExecCommandResponse) GetStderr() []byte {
+	if x != nil {
+		if x, ok := x.StreamExecCommandOutput.(*StreamExecCommandResponse_Stderr); ok {
+			return x.Stderr
+		}
+	}
+	return nil
+}
+
+func (x *StreamExecCommandResponse) GetExitCode() int32 {
+	if x != nil {
+		if x, ok := x.StreamExecCommandOutput.(*StreamExecCommandResponse_ExitCode); ok {
+			return x.ExitCode
+		}
+	}
+	return 0
+}
+
+type isStreamExecCommandResponse_StreamExecCommandOutput interface {
+	isStreamExecCommandResponse_StreamExecCommandOutput()
+}
+
+type StreamExecCommandResponse_Stdout struct {
+	Stdout []byte `protobuf:"bytes,1,opt,name=stdout,proto3,oneof"`
+}
+
+type StreamExecCommandResponse_Stderr struct {
+	Stderr []byte `protobuf:"bytes,2,opt,name=stderr,proto3,oneof"`
+}
+
+type StreamExecCommandResponse_ExitCode struct {
+	// Sent once, as the last message, when the command has exited
+	ExitCode int32 `protobuf:"varint,3,opt,name=exit_code,json=exitCode,proto3,oneof"`
 }
 
+func (*StreamExecCommandResponse_Stdout) isStreamExecCommandResponse_StreamExecCommandOutput() {}
+
+func (*StreamExecCommandResponse_Stderr) isStreamExecCommandResponse_StreamExecCommandOutput() {}
+
+func (*StreamExecCommandResponse_ExitCode) isStreamExecCommandResponse_StreamExecCommandOutput() {}
+
 // ==============================================================================================
 //
-//	Wait For HTTP Post Endpoint Availability
+//	Wait For HTTP Get Endpoint Availability
 //
 // ==============================================================================================
-type WaitForHttpPostEndpointAvailabilityArgs struct {
+type WaitForHttpGetEndpointAvailabilityArgs struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The identifier of the service to check.
 	ServiceIdentifier string `protobuf:"bytes,1,opt,name=service_identifier,json=serviceIdentifier,proto3" json:"service_identifier,omitempty"`
@@ -2755,35 +3194,33 @@ type WaitForHttpPostEndpointAvailabilityArgs struct {
 	Port uint32 `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
 	// The path of the service to check. It mustn't start with the first slash. For instance `service/health`
 	Path *string `protobuf:"bytes,3,opt,name=path,proto3,oneof" json:"path,omitempty"`
-	// The content of the request body.
-	RequestBody *string `protobuf:"bytes,4,opt,name=request_body,json=requestBody,proto3,oneof" json:"request_body,omitempty"`
 	// The number of milliseconds to wait until executing the first HTTP call
-	InitialDelayMilliseconds *uint32 `protobuf:"varint,5,opt,name=initial_delay_milliseconds,json=initialDelayMilliseconds,proto3,oneof" json:"initial_delay_milliseconds,omitempty"`
+	InitialDelayMilliseconds *uint32 `protobuf:"varint,4,opt,name=initial_delay_milliseconds,json=initialDelayMilliseconds,proto3,oneof" json:"initial_delay_milliseconds,omitempty"`
 	// Max number of HTTP call attempts that this will execute until giving up and returning an error
-	Retries *uint32 `protobuf:"varint,6,opt,name=retries,proto3,oneof" json:"retries,omitempty"`
+	Retries *uint32 `protobuf:"varint,5,opt,name=retries,proto3,oneof" json:"retries,omitempty"`
 	// Number of milliseconds to wait between retries
-	RetriesDelayMilliseconds *uint32 `protobuf:"varint,7,opt,name=retries_delay_milliseconds,json=retriesDelayMilliseconds,proto3,oneof" json:"retries_delay_milliseconds,omitempty"`
+	RetriesDelayMilliseconds *uint32 `protobuf:"varint,6,opt,name=retries_delay_milliseconds,json=retriesDelayMilliseconds,proto3,oneof" json:"retries_delay_milliseconds,omitempty"`
 	// If the endpoint returns this value, the service will be marked as available (e.g. Hello World).
-	BodyText      *string `protobuf:"bytes,8,opt,name=body_text,json=bodyText,proto3,oneof" json:"body_text,omitempty"`
+	BodyText      *string `protobuf:"bytes,7,opt,name=body_text,json=bodyText,proto3,oneof" json:"body_text,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WaitForHttpPostEndpointAvailabilityArgs) Reset() {
-	*x = WaitForHttpPostEndpointAvailabilityArgs{}
-	mi := &file_api_container_service_proto_msgTypes[29]
+func (x *WaitForHttpGetEndpointAvailabilityArgs) Reset() {
+	*x = WaitForHttpGetEndpointAvailabilityArgs{}
+	mi := &file_api_container_service_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WaitForHttpPostEndpointAvailabilityArgs) String() string {
+func (x *WaitForHttpGetEndpointAvailabilityArgs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WaitForHttpPostEndpointAvailabilityArgs) ProtoMessage() {}
+func (*WaitForHttpGetEndpointAvailabilityArgs) ProtoMessage() {}
 
-func (x *WaitForHttpPostEndpointAvailabilityArgs) ProtoReflect() protoreflect.Message {
-	mi := &file_api_container_service_proto_msgTypes[29]
+func (x *WaitForHttpGetEndpointAvailabilityArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2794,159 +3231,1468 @@ func (x *WaitForHttpPostEndpointAvailabilityArgs) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WaitForHttpPostEndpointAvailabilityArgs.ProtoReflect.Descriptor instead.
-func (*WaitForHttpPostEndpointAvailabilityArgs) Descriptor() ([]byte, []int) {
-	return file_api_container_service_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use WaitForHttpGetEndpointAvailabilityArgs.ProtoReflect.Descriptor instead.
+func (*WaitForHttpGetEndpointAvailabilityArgs) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *WaitForHttpPostEndpointAvailabilityArgs) GetServiceIdentifier() string {
+func (x *WaitForHttpGetEndpointAvailabilityArgs) GetServiceIdentifier() string {
 	if x != nil {
 		return x.ServiceIdentifier
 	}
 	return ""
 }
 
-func (x *WaitForHttpPostEndpointAvailabilityArgs) GetPort() uint32 {
+func (x *WaitForHttpGetEndpointAvailabilityArgs) GetPort() uint32 {
 	if x != nil {
 		return x.Port
 	}
 	return 0
 }
 
-func (x *WaitForHttpPostEndpointAvailabilityArgs) GetPath() string {
+func (x *WaitForHttpGetEndpointAvailabilityArgs) GetPath() string {
 	if x != nil && x.Path != nil {
 		return *x.Path
 	}
 	return ""
 }
 
-func (x *WaitForHttpPostEndpointAvailabilityArgs) GetRequestBody() string {
-	if x != nil && x.RequestBody != nil {
-		return *x.RequestBody
+func (x *WaitForHttpGetEndpointAvailabilityArgs) GetInitialDelayMilliseconds() uint32 {
+	if x != nil && x.InitialDelayMilliseconds != nil {
+		return *x.InitialDelayMilliseconds
 	}
-	return ""
+	return 0
 }
 
-func (x *WaitForHttpPostEndpointAvailabilityArgs) GetInitialDelayMilliseconds() uint32 {
-	if x != nil && x.InitialDelayMilliseconds != nil {
-		return *x.InitialDelayMilliseconds
+func (x *WaitForHttpGetEndpointAvailabilityArgs) GetRetries() uint32 {
+	if x != nil && x.Retries != nil {
+		return *x.Retries
+	}
+	return 0
+}
+
+func (x *WaitForHttpGetEndpointAvailabilityArgs) GetRetriesDelayMilliseconds() uint32 {
+	if x != nil && x.RetriesDelayMilliseconds != nil {
+		return *x.RetriesDelayMilliseconds
 	}
 	return 0
 }
 
-func (x *WaitForHttpPostEndpointAvailabilityArgs) GetRetries() uint32 {
-	if x != nil && x.Retries != nil {
-		return *x.Retries
+func (x *WaitForHttpGetEndpointAvailabilityArgs) GetBodyText() string {
+	if x != nil && x.BodyText != nil {
+		return *x.BodyText
+	}
+	return ""
+}
+
+// ==============================================================================================
+//
+//	Wait For HTTP Post Endpoint Availability
+//
+// ==============================================================================================
+type WaitForHttpPostEndpointAvailabilityArgs struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The identifier of the service to check.
+	ServiceIdentifier string `protobuf:"bytes,1,opt,name=service_identifier,json=serviceIdentifier,proto3" json:"service_identifier,omitempty"`
+	// The port of the service to check. For instance 8080
+	Port uint32 `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	// The path of the service to check. It mustn't start with the first slash. For instance `service/health`
+	Path *string `protobuf:"bytes,3,opt,name=path,proto3,oneof" json:"path,omitempty"`
+	// The content of the request body.
+	RequestBody *string `protobuf:"bytes,4,opt,name=request_body,json=requestBody,proto3,oneof" json:"request_body,omitempty"`
+	// The number of milliseconds to wait until executing the first HTTP call
+	InitialDelayMilliseconds *uint32 `protobuf:"varint,5,opt,name=initial_delay_milliseconds,json=initialDelayMilliseconds,proto3,oneof" json:"initial_delay_milliseconds,omitempty"`
+	// Max number of HTTP call attempts that this will execute until giving up and returning an error
+	Retries *uint32 `protobuf:"varint,6,opt,name=retries,proto3,oneof" json:"retries,omitempty"`
+	// Number of milliseconds to wait between retries
+	RetriesDelayMilliseconds *uint32 `protobuf:"varint,7,opt,name=retries_delay_milliseconds,json=retriesDelayMilliseconds,proto3,oneof" json:"retries_delay_milliseconds,omitempty"`
+	// If the endpoint returns this value, the service will be marked as available (e.g. Hello World).
+	BodyText      *string `protobuf:"bytes,8,opt,name=body_text,json=bodyText,proto3,oneof" json:"body_text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WaitForHttpPostEndpointAvailabilityArgs) Reset() {
+	*x = WaitForHttpPostEndpointAvailabilityArgs{}
+	mi := &file_api_container_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WaitForHttpPostEndpointAvailabilityArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitForHttpPostEndpointAvailabilityArgs) ProtoMessage() {}
+
+func (x *WaitForHttpPostEndpointAvailabilityArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitForHttpPostEndpointAvailabilityArgs.ProtoReflect.Descriptor instead.
+func (*WaitForHttpPostEndpointAvailabilityArgs) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *WaitForHttpPostEndpointAvailabilityArgs) GetServiceIdentifier() string {
+	if x != nil {
+		return x.ServiceIdentifier
+	}
+	return ""
+}
+
+func (x *WaitForHttpPostEndpointAvailabilityArgs) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *WaitForHttpPostEndpointAvailabilityArgs) GetPath() string {
+	if x != nil && x.Path != nil {
+		return *x.Path
+	}
+	return ""
+}
+
+func (x *WaitForHttpPostEndpointAvailabilityArgs) GetRequestBody() string {
+	if x != nil && x.RequestBody != nil {
+		return *x.RequestBody
+	}
+	return ""
+}
+
+func (x *WaitForHttpPostEndpointAvailabilityArgs) GetInitialDelayMilliseconds() uint32 {
+	if x != nil && x.InitialDelayMilliseconds != nil {
+		return *x.InitialDelayMilliseconds
+	}
+	return 0
+}
+
+func (x *WaitForHttpPostEndpointAvailabilityArgs) GetRetries() uint32 {
+	if x != nil && x.Retries != nil {
+		return *x.Retries
+	}
+	return 0
+}
+
+func (x *WaitForHttpPostEndpointAvailabilityArgs) GetRetriesDelayMilliseconds() uint32 {
+	if x != nil && x.RetriesDelayMilliseconds != nil {
+		return *x.RetriesDelayMilliseconds
+	}
+	return 0
+}
+
+func (x *WaitForHttpPostEndpointAvailabilityArgs) GetBodyText() string {
+	if x != nil && x.BodyText != nil {
+		return *x.BodyText
+	}
+	return ""
+}
+
+// ==============================================================================================
+//
+//	Streamed Data Chunk
+//
+// ==============================================================================================
+type StreamedDataChunk struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Chunk of the overall files artifact bytes
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// Hash of the PREVIOUS chunk, or empty string is this is the first chunk
+	// Referencing the previous chunk via its hash allows Kurtosis to validate
+	// the consistency of the data in case some chunk were not received
+	PreviousChunkHash string `protobuf:"bytes,2,opt,name=previous_chunk_hash,json=previousChunkHash,proto3" json:"previous_chunk_hash,omitempty"`
+	// Additional metadata about the item being streamed
+	Metadata      *DataChunkMetadata `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamedDataChunk) Reset() {
+	*x = StreamedDataChunk{}
+	mi := &file_api_container_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamedDataChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamedDataChunk) ProtoMessage() {}
+
+func (x *StreamedDataChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamedDataChunk.ProtoReflect.Descriptor instead.
+func (*StreamedDataChunk) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *StreamedDataChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *StreamedDataChunk) GetPreviousChunkHash() string {
+	if x != nil {
+		return x.PreviousChunkHash
+	}
+	return ""
+}
+
+func (x *StreamedDataChunk) GetMetadata() *DataChunkMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type DataChunkMetadata struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DataChunkMetadata) Reset() {
+	*x = DataChunkMetadata{}
+	mi := &file_api_container_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataChunkMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataChunkMetadata) ProtoMessage() {}
+
+func (x *DataChunkMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataChunkMetadata.ProtoReflect.Descriptor instead.
+func (*DataChunkMetadata) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DataChunkMetadata) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// ==============================================================================================
+//
+//	Upload Files Artifact
+//
+// ==============================================================================================
+type UploadFilesArtifactResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID of the files artifact, for use when referencing it in the future
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// UUID of the files artifact, for use when referencing it in the future
+	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadFilesArtifactResponse) Reset() {
+	*x = UploadFilesArtifactResponse{}
+	mi := &file_api_container_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadFilesArtifactResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadFilesArtifactResponse) ProtoMessage() {}
+
+func (x *UploadFilesArtifactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadFilesArtifactResponse.ProtoReflect.Descriptor instead.
+func (*UploadFilesArtifactResponse) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *UploadFilesArtifactResponse) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *UploadFilesArtifactResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetMissingFilesArtifactBlobsArgs struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Hex encoded SHA-256 of the file contents
+	BlobSha256S   []string `protobuf:"bytes,1,rep,name=blob_sha256s,json=blobSha256s,proto3" json:"blob_sha256s,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMissingFilesArtifactBlobsArgs) Reset() {
+	*x = GetMissingFilesArtifactBlobsArgs{}
+	mi := &file_api_container_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMissingFilesArtifactBlobsArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMissingFilesArtifactBlobsArgs) ProtoMessage() {}
+
+func (x *GetMissingFilesArtifactBlobsArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMissingFilesArtifactBlobsArgs.ProtoReflect.Descriptor instead.
+func (*GetMissingFilesArtifactBlobsArgs) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetMissingFilesArtifactBlobsArgs) GetBlobSha256S() []string {
+	if x != nil {
+		return x.BlobSha256S
+	}
+	return nil
+}
+
+type GetMissingFilesArtifactBlobsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The subset of the requested SHA-256 whose content isn't stored in the enclave
+	MissingBlobSha256S []string `protobuf:"bytes,1,rep,name=missing_blob_sha256s,json=missingBlobSha256s,proto3" json:"missing_blob_sha256s,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetMissingFilesArtifactBlobsResponse) Reset() {
+	*x = GetMissingFilesArtifactBlobsResponse{}
+	mi := &file_api_container_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMissingFilesArtifactBlobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMissingFilesArtifactBlobsResponse) ProtoMessage() {}
+
+func (x *GetMissingFilesArtifactBlobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMissingFilesArtifactBlobsResponse.ProtoReflect.Descriptor instead.
+func (*GetMissingFilesArtifactBlobsResponse) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetMissingFilesArtifactBlobsResponse) GetMissingBlobSha256S() []string {
+	if x != nil {
+		return x.MissingBlobSha256S
+	}
+	return nil
+}
+
+// ==============================================================================================
+//
+//	Download Files Artifact
+//
+// ==============================================================================================
+type DownloadFilesArtifactArgs struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Files identifier to get bytes for
+	Identifier    string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadFilesArtifactArgs) Reset() {
+	*x = DownloadFilesArtifactArgs{}
+	mi := &file_api_container_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadFilesArtifactArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadFilesArtifactArgs) ProtoMessage() {}
+
+func (x *DownloadFilesArtifactArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadFilesArtifactArgs.ProtoReflect.Descriptor instead.
+func (*DownloadFilesArtifactArgs) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DownloadFilesArtifactArgs) GetIdentifier() string {
+	if x != nil {
+		return x.Identifier
+	}
+	return ""
+}
+
+// ==============================================================================================
+//
+//	Store Web Files Artifact
+//
+// ==============================================================================================
+type StoreWebFilesArtifactArgs struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// URL to download the artifact from
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// The name of the files artifact
+	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StoreWebFilesArtifactArgs) Reset() {
+	*x = StoreWebFilesArtifactArgs{}
+	mi := &file_api_container_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StoreWebFilesArtifactArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreWebFilesArtifactArgs) ProtoMessage() {}
+
+func (x *StoreWebFilesArtifactArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoreWebFilesArtifactArgs.ProtoReflect.Descriptor instead.
+func (*StoreWebFilesArtifactArgs) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *StoreWebFilesArtifactArgs) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *StoreWebFilesArtifactArgs) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type StoreWebFilesArtifactResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID of the files artifact, for use when referencing it in the future
+	Uuid          string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StoreWebFilesArtifactResponse) Reset() {
+	*x = StoreWebFilesArtifactResponse{}
+	mi := &file_api_container_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StoreWebFilesArtifactResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreWebFilesArtifactResponse) ProtoMessage() {}
+
+func (x *StoreWebFilesArtifactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoreWebFilesArtifactResponse.ProtoReflect.Descriptor instead.
+func (*StoreWebFilesArtifactResponse) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *StoreWebFilesArtifactResponse) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+type StoreFilesArtifactFromServiceArgs struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Identifier that will be used to identify the service where the source files will be copied from
+	ServiceIdentifier string `protobuf:"bytes,1,opt,name=service_identifier,json=serviceIdentifier,proto3" json:"service_identifier,omitempty"`
+	// The absolute source path where the source files will be copied from
+	SourcePath string `protobuf:"bytes,2,opt,name=source_path,json=sourcePath,proto3" json:"source_path,omitempty"`
+	// The name of the files artifact
+	Name          string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StoreFilesArtifactFromServiceArgs) Reset() {
+	*x = StoreFilesArtifactFromServiceArgs{}
+	mi := &file_api_container_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StoreFilesArtifactFromServiceArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreFilesArtifactFromServiceArgs) ProtoMessage() {}
+
+func (x *StoreFilesArtifactFromServiceArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoreFilesArtifactFromServiceArgs.ProtoReflect.Descriptor instead.
+func (*StoreFilesArtifactFromServiceArgs) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *StoreFilesArtifactFromServiceArgs) GetServiceIdentifier() string {
+	if x != nil {
+		return x.ServiceIdentifier
+	}
+	return ""
+}
+
+func (x *StoreFilesArtifactFromServiceArgs) GetSourcePath() string {
+	if x != nil {
+		return x.SourcePath
+	}
+	return ""
+}
+
+func (x *StoreFilesArtifactFromServiceArgs) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type StoreFilesArtifactFromServiceResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID of the files artifact, for use when referencing it in the future
+	Uuid          string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StoreFilesArtifactFromServiceResponse) Reset() {
+	*x = StoreFilesArtifactFromServiceResponse{}
+	mi := &file_api_container_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StoreFilesArtifactFromServiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreFilesArtifactFromServiceResponse) ProtoMessage() {}
+
+func (x *StoreFilesArtifactFromServiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoreFilesArtifactFromServiceResponse.ProtoReflect.Descriptor instead.
+func (*StoreFilesArtifactFromServiceResponse) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *StoreFilesArtifactFromServiceResponse) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+type FilesArtifactNameAndUuid struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// A string representing the name of the file
+	FileName string `protobuf:"bytes,1,opt,name=fileName,proto3" json:"fileName,omitempty"`
+	// A string representing the uuid of the file
+	FileUuid      string `protobuf:"bytes,2,opt,name=fileUuid,proto3" json:"fileUuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FilesArtifactNameAndUuid) Reset() {
+	*x = FilesArtifactNameAndUuid{}
+	mi := &file_api_container_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FilesArtifactNameAndUuid) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilesArtifactNameAndUuid) ProtoMessage() {}
+
+func (x *FilesArtifactNameAndUuid) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilesArtifactNameAndUuid.ProtoReflect.Descriptor instead.
+func (*FilesArtifactNameAndUuid) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *FilesArtifactNameAndUuid) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *FilesArtifactNameAndUuid) GetFileUuid() string {
+	if x != nil {
+		return x.FileUuid
+	}
+	return ""
+}
+
+type ListFilesArtifactNamesAndUuidsResponse struct {
+	state             protoimpl.MessageState      `protogen:"open.v1"`
+	FileNamesAndUuids []*FilesArtifactNameAndUuid `protobuf:"bytes,1,rep,name=file_names_and_uuids,json=fileNamesAndUuids,proto3" json:"file_names_and_uuids,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListFilesArtifactNamesAndUuidsResponse) Reset() {
+	*x = ListFilesArtifactNamesAndUuidsResponse{}
+	mi := &file_api_container_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFilesArtifactNamesAndUuidsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFilesArtifactNamesAndUuidsResponse) ProtoMessage() {}
+
+func (x *ListFilesArtifactNamesAndUuidsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFilesArtifactNamesAndUuidsResponse.ProtoReflect.Descriptor instead.
+func (*ListFilesArtifactNamesAndUuidsResponse) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListFilesArtifactNamesAndUuidsResponse) GetFileNamesAndUuids() []*FilesArtifactNameAndUuid {
+	if x != nil {
+		return x.FileNamesAndUuids
+	}
+	return nil
+}
+
+type InspectFilesArtifactContentsRequest struct {
+	state            protoimpl.MessageState    `protogen:"open.v1"`
+	FileNamesAndUuid *FilesArtifactNameAndUuid `protobuf:"bytes,1,opt,name=file_names_and_uuid,json=fileNamesAndUuid,proto3" json:"file_names_and_uuid,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *InspectFilesArtifactContentsRequest) Reset() {
+	*x = InspectFilesArtifactContentsRequest{}
+	mi := &file_api_container_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InspectFilesArtifactContentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InspectFilesArtifactContentsRequest) ProtoMessage() {}
+
+func (x *InspectFilesArtifactContentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InspectFilesArtifactContentsRequest.ProtoReflect.Descriptor instead.
+func (*InspectFilesArtifactContentsRequest) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *InspectFilesArtifactContentsRequest) GetFileNamesAndUuid() *FilesArtifactNameAndUuid {
+	if x != nil {
+		return x.FileNamesAndUuid
+	}
+	return nil
+}
+
+type InspectFilesArtifactContentsResponse struct {
+	state            protoimpl.MessageState                 `protogen:"open.v1"`
+	FileDescriptions []*FileArtifactContentsFileDescription `protobuf:"bytes,1,rep,name=file_descriptions,json=fileDescriptions,proto3" json:"file_descriptions,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *InspectFilesArtifactContentsResponse) Reset() {
+	*x = InspectFilesArtifactContentsResponse{}
+	mi := &file_api_container_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InspectFilesArtifactContentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InspectFilesArtifactContentsResponse) ProtoMessage() {}
+
+func (x *InspectFilesArtifactContentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InspectFilesArtifactContentsResponse.ProtoReflect.Descriptor instead.
+func (*InspectFilesArtifactContentsResponse) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *InspectFilesArtifactContentsResponse) GetFileDescriptions() []*FileArtifactContentsFileDescription {
+	if x != nil {
+		return x.FileDescriptions
+	}
+	return nil
+}
+
+type FileArtifactContentsFileDescription struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Path relative to the file artifact
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// Size of the file, in bytes
+	Size uint64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	// A bit of text content, if the file allows (similar to UNIX's 'head')
+	TextPreview   *string `protobuf:"bytes,3,opt,name=text_preview,json=textPreview,proto3,oneof" json:"text_preview,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileArtifactContentsFileDescription) Reset() {
+	*x = FileArtifactContentsFileDescription{}
+	mi := &file_api_container_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileArtifactContentsFileDescription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileArtifactContentsFileDescription) ProtoMessage() {}
+
+func (x *FileArtifactContentsFileDescription) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileArtifactContentsFileDescription.ProtoReflect.Descriptor instead.
+func (*FileArtifactContentsFileDescription) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *FileArtifactContentsFileDescription) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *FileArtifactContentsFileDescription) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *FileArtifactContentsFileDescription) GetTextPreview() string {
+	if x != nil && x.TextPreview != nil {
+		return *x.TextPreview
+	}
+	return ""
+}
+
+// ==============================================================================================
+//
+//	Get Files Artifact History
+//
+// ==============================================================================================
+type GetFilesArtifactHistoryArgs struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID, shortened UUID or name of the files artifact
+	Identifier    string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFilesArtifactHistoryArgs) Reset() {
+	*x = GetFilesArtifactHistoryArgs{}
+	mi := &file_api_container_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFilesArtifactHistoryArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFilesArtifactHistoryArgs) ProtoMessage() {}
+
+func (x *GetFilesArtifactHistoryArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFilesArtifactHistoryArgs.ProtoReflect.Descriptor instead.
+func (*GetFilesArtifactHistoryArgs) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetFilesArtifactHistoryArgs) GetIdentifier() string {
+	if x != nil {
+		return x.Identifier
+	}
+	return ""
+}
+
+type GetFilesArtifactHistoryResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	FileUuid string                 `protobuf:"bytes,1,opt,name=file_uuid,json=fileUuid,proto3" json:"file_uuid,omitempty"`
+	// Oldest first
+	Versions      []*FilesArtifactVersion `protobuf:"bytes,2,rep,name=versions,proto3" json:"versions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFilesArtifactHistoryResponse) Reset() {
+	*x = GetFilesArtifactHistoryResponse{}
+	mi := &file_api_container_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFilesArtifactHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFilesArtifactHistoryResponse) ProtoMessage() {}
+
+func (x *GetFilesArtifactHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFilesArtifactHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetFilesArtifactHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetFilesArtifactHistoryResponse) GetFileUuid() string {
+	if x != nil {
+		return x.FileUuid
+	}
+	return ""
+}
+
+func (x *GetFilesArtifactHistoryResponse) GetVersions() []*FilesArtifactVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+type FilesArtifactVersion struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Version uint32                 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// Unset for files artifacts stored before versions were recorded
+	CreationTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=creation_time,json=creationTime,proto3,oneof" json:"creation_time,omitempty"`
+	// What stored this version, usually the Starlark instruction. Empty for files artifacts stored before versions were recorded
+	Producer      string `protobuf:"bytes,3,opt,name=producer,proto3" json:"producer,omitempty"`
+	IsCurrent     bool   `protobuf:"varint,4,opt,name=is_current,json=isCurrent,proto3" json:"is_current,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FilesArtifactVersion) Reset() {
+	*x = FilesArtifactVersion{}
+	mi := &file_api_container_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FilesArtifactVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilesArtifactVersion) ProtoMessage() {}
+
+func (x *FilesArtifactVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilesArtifactVersion.ProtoReflect.Descriptor instead.
+func (*FilesArtifactVersion) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *FilesArtifactVersion) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *FilesArtifactVersion) GetCreationTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreationTime
+	}
+	return nil
+}
+
+func (x *FilesArtifactVersion) GetProducer() string {
+	if x != nil {
+		return x.Producer
+	}
+	return ""
+}
+
+func (x *FilesArtifactVersion) GetIsCurrent() bool {
+	if x != nil {
+		return x.IsCurrent
+	}
+	return false
+}
+
+type ConnectServicesArgs struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Connect       Connect                `protobuf:"varint,1,opt,name=connect,proto3,enum=api_container_api.Connect" json:"connect,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConnectServicesArgs) Reset() {
+	*x = ConnectServicesArgs{}
+	mi := &file_api_container_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConnectServicesArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectServicesArgs) ProtoMessage() {}
+
+func (x *ConnectServicesArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectServicesArgs.ProtoReflect.Descriptor instead.
+func (*ConnectServicesArgs) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ConnectServicesArgs) GetConnect() Connect {
+	if x != nil {
+		return x.Connect
+	}
+	return Connect_CONNECT
+}
+
+type ConnectServicesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConnectServicesResponse) Reset() {
+	*x = ConnectServicesResponse{}
+	mi := &file_api_container_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConnectServicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectServicesResponse) ProtoMessage() {}
+
+func (x *ConnectServicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectServicesResponse.ProtoReflect.Descriptor instead.
+func (*ConnectServicesResponse) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{54}
+}
+
+type GetStarlarkRunResponse struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	PackageId              string                 `protobuf:"bytes,1,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
+	SerializedScript       string                 `protobuf:"bytes,2,opt,name=serialized_script,json=serializedScript,proto3" json:"serialized_script,omitempty"`
+	SerializedParams       string                 `protobuf:"bytes,3,opt,name=serialized_params,json=serializedParams,proto3" json:"serialized_params,omitempty"`
+	Parallelism            int32                  `protobuf:"varint,4,opt,name=parallelism,proto3" json:"parallelism,omitempty"`
+	RelativePathToMainFile string                 `protobuf:"bytes,5,opt,name=relative_path_to_main_file,json=relativePathToMainFile,proto3" json:"relative_path_to_main_file,omitempty"`
+	MainFunctionName       string                 `protobuf:"bytes,6,opt,name=main_function_name,json=mainFunctionName,proto3" json:"main_function_name,omitempty"`
+	ExperimentalFeatures   []KurtosisFeatureFlag  `protobuf:"varint,7,rep,packed,name=experimental_features,json=experimentalFeatures,proto3,enum=api_container_api.KurtosisFeatureFlag" json:"experimental_features,omitempty"`
+	RestartPolicy          RestartPolicy          `protobuf:"varint,8,opt,name=restart_policy,json=restartPolicy,proto3,enum=api_container_api.RestartPolicy" json:"restart_policy,omitempty"`
+	// The params that were used on for the very first Starlark run in an APIC
+	InitialSerializedParams *string `protobuf:"bytes,9,opt,name=initial_serialized_params,json=initialSerializedParams,proto3,oneof" json:"initial_serialized_params,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *GetStarlarkRunResponse) Reset() {
+	*x = GetStarlarkRunResponse{}
+	mi := &file_api_container_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStarlarkRunResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStarlarkRunResponse) ProtoMessage() {}
+
+func (x *GetStarlarkRunResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStarlarkRunResponse.ProtoReflect.Descriptor instead.
+func (*GetStarlarkRunResponse) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetStarlarkRunResponse) GetPackageId() string {
+	if x != nil {
+		return x.PackageId
+	}
+	return ""
+}
+
+func (x *GetStarlarkRunResponse) GetSerializedScript() string {
+	if x != nil {
+		return x.SerializedScript
+	}
+	return ""
+}
+
+func (x *GetStarlarkRunResponse) GetSerializedParams() string {
+	if x != nil {
+		return x.SerializedParams
+	}
+	return ""
+}
+
+func (x *GetStarlarkRunResponse) GetParallelism() int32 {
+	if x != nil {
+		return x.Parallelism
+	}
+	return 0
+}
+
+func (x *GetStarlarkRunResponse) GetRelativePathToMainFile() string {
+	if x != nil {
+		return x.RelativePathToMainFile
+	}
+	return ""
+}
+
+func (x *GetStarlarkRunResponse) GetMainFunctionName() string {
+	if x != nil {
+		return x.MainFunctionName
+	}
+	return ""
+}
+
+func (x *GetStarlarkRunResponse) GetExperimentalFeatures() []KurtosisFeatureFlag {
+	if x != nil {
+		return x.ExperimentalFeatures
+	}
+	return nil
+}
+
+func (x *GetStarlarkRunResponse) GetRestartPolicy() RestartPolicy {
+	if x != nil {
+		return x.RestartPolicy
+	}
+	return RestartPolicy_NEVER
+}
+
+func (x *GetStarlarkRunResponse) GetInitialSerializedParams() string {
+	if x != nil && x.InitialSerializedParams != nil {
+		return *x.InitialSerializedParams
+	}
+	return ""
+}
+
+type StarlarkRunRecord struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	RunUuid   string                 `protobuf:"bytes,1,opt,name=run_uuid,json=runUuid,proto3" json:"run_uuid,omitempty"`
+	PackageId string                 `protobuf:"bytes,2,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
+	// The commit the package was at when it ran, if the package is a Git repository
+	PackageGitCommitSha    *string                `protobuf:"bytes,3,opt,name=package_git_commit_sha,json=packageGitCommitSha,proto3,oneof" json:"package_git_commit_sha,omitempty"`
+	SerializedParams       string                 `protobuf:"bytes,4,opt,name=serialized_params,json=serializedParams,proto3" json:"serialized_params,omitempty"`
+	RelativePathToMainFile string                 `protobuf:"bytes,5,opt,name=relative_path_to_main_file,json=relativePathToMainFile,proto3" json:"relative_path_to_main_file,omitempty"`
+	MainFunctionName       string                 `protobuf:"bytes,6,opt,name=main_function_name,json=mainFunctionName,proto3" json:"main_function_name,omitempty"`
+	StartTime              *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// Not set while the run is in progress
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Status  StarlarkRunStatus      `protobuf:"varint,9,opt,name=status,proto3,enum=api_container_api.StarlarkRunStatus" json:"status,omitempty"`
+	// The interpretation, validation and execution errors the run ended with
+	Errors []string `protobuf:"bytes,10,rep,name=errors,proto3" json:"errors,omitempty"`
+	// The number of lines the run streamed back that were recorded, progress updates excepted
+	NumberOfOutputLines uint32 `protobuf:"varint,12,opt,name=number_of_output_lines,json=numberOfOutputLines,proto3" json:"number_of_output_lines,omitempty"`
+	// Whether the output got too big for the following lines to be recorded
+	IsOutputTruncated bool `protobuf:"varint,13,opt,name=is_output_truncated,json=isOutputTruncated,proto3" json:"is_output_truncated,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *StarlarkRunRecord) Reset() {
+	*x = StarlarkRunRecord{}
+	mi := &file_api_container_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StarlarkRunRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StarlarkRunRecord) ProtoMessage() {}
+
+func (x *StarlarkRunRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StarlarkRunRecord.ProtoReflect.Descriptor instead.
+func (*StarlarkRunRecord) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *StarlarkRunRecord) GetRunUuid() string {
+	if x != nil {
+		return x.RunUuid
 	}
-	return 0
+	return ""
 }
 
-func (x *WaitForHttpPostEndpointAvailabilityArgs) GetRetriesDelayMilliseconds() uint32 {
-	if x != nil && x.RetriesDelayMilliseconds != nil {
-		return *x.RetriesDelayMilliseconds
+func (x *StarlarkRunRecord) GetPackageId() string {
+	if x != nil {
+		return x.PackageId
 	}
-	return 0
+	return ""
 }
 
-func (x *WaitForHttpPostEndpointAvailabilityArgs) GetBodyText() string {
-	if x != nil && x.BodyText != nil {
-		return *x.BodyText
+func (x *StarlarkRunRecord) GetPackageGitCommitSha() string {
+	if x != nil && x.PackageGitCommitSha != nil {
+		return *x.PackageGitCommitSha
 	}
 	return ""
 }
 
-// ==============================================================================================
-//
-//	Streamed Data Chunk
-//
-// ==============================================================================================
-type StreamedDataChunk struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Chunk of the overall files artifact bytes
-	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	// Hash of the PREVIOUS chunk, or empty string is this is the first chunk
-	// Referencing the previous chunk via its hash allows Kurtosis to validate
-	// the consistency of the data in case some chunk were not received
-	PreviousChunkHash string `protobuf:"bytes,2,opt,name=previous_chunk_hash,json=previousChunkHash,proto3" json:"previous_chunk_hash,omitempty"`
-	// Additional metadata about the item being streamed
-	Metadata      *DataChunkMetadata `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *StarlarkRunRecord) GetSerializedParams() string {
+	if x != nil {
+		return x.SerializedParams
+	}
+	return ""
 }
 
-func (x *StreamedDataChunk) Reset() {
-	*x = StreamedDataChunk{}
-	mi := &file_api_container_service_proto_msgTypes[30]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *StarlarkRunRecord) GetRelativePathToMainFile() string {
+	if x != nil {
+		return x.RelativePathToMainFile
+	}
+	return ""
 }
 
-func (x *StreamedDataChunk) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *StarlarkRunRecord) GetMainFunctionName() string {
+	if x != nil {
+		return x.MainFunctionName
+	}
+	return ""
 }
 
-func (*StreamedDataChunk) ProtoMessage() {}
+func (x *StarlarkRunRecord) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
 
-func (x *StreamedDataChunk) ProtoReflect() protoreflect.Message {
-	mi := &file_api_container_service_proto_msgTypes[30]
+func (x *StarlarkRunRecord) GetEndTime() *timestamppb.Timestamp {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.EndTime
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use StreamedDataChunk.ProtoReflect.Descriptor instead.
-func (*StreamedDataChunk) Descriptor() ([]byte, []int) {
-	return file_api_container_service_proto_rawDescGZIP(), []int{30}
+func (x *StarlarkRunRecord) GetStatus() StarlarkRunStatus {
+	if x != nil {
+		return x.Status
+	}
+	return StarlarkRunStatus_STARLARK_RUN_IN_PROGRESS
 }
 
-func (x *StreamedDataChunk) GetData() []byte {
+func (x *StarlarkRunRecord) GetErrors() []string {
 	if x != nil {
-		return x.Data
+		return x.Errors
 	}
 	return nil
 }
 
-func (x *StreamedDataChunk) GetPreviousChunkHash() string {
+func (x *StarlarkRunRecord) GetNumberOfOutputLines() uint32 {
 	if x != nil {
-		return x.PreviousChunkHash
+		return x.NumberOfOutputLines
 	}
-	return ""
+	return 0
 }
 
-func (x *StreamedDataChunk) GetMetadata() *DataChunkMetadata {
+func (x *StarlarkRunRecord) GetIsOutputTruncated() bool {
 	if x != nil {
-		return x.Metadata
+		return x.IsOutputTruncated
 	}
-	return nil
+	return false
 }
 
-type DataChunkMetadata struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ListStarlarkRunRecordsResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	StarlarkRunRecords []*StarlarkRunRecord   `protobuf:"bytes,1,rep,name=starlark_run_records,json=starlarkRunRecords,proto3" json:"starlark_run_records,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *DataChunkMetadata) Reset() {
-	*x = DataChunkMetadata{}
-	mi := &file_api_container_service_proto_msgTypes[31]
+func (x *ListStarlarkRunRecordsResponse) Reset() {
+	*x = ListStarlarkRunRecordsResponse{}
+	mi := &file_api_container_service_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DataChunkMetadata) String() string {
+func (x *ListStarlarkRunRecordsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DataChunkMetadata) ProtoMessage() {}
+func (*ListStarlarkRunRecordsResponse) ProtoMessage() {}
 
-func (x *DataChunkMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_api_container_service_proto_msgTypes[31]
+func (x *ListStarlarkRunRecordsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2957,48 +4703,40 @@ func (x *DataChunkMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DataChunkMetadata.ProtoReflect.Descriptor instead.
-func (*DataChunkMetadata) Descriptor() ([]byte, []int) {
-	return file_api_container_service_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use ListStarlarkRunRecordsResponse.ProtoReflect.Descriptor instead.
+func (*ListStarlarkRunRecordsResponse) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *DataChunkMetadata) GetName() string {
+func (x *ListStarlarkRunRecordsResponse) GetStarlarkRunRecords() []*StarlarkRunRecord {
 	if x != nil {
-		return x.Name
+		return x.StarlarkRunRecords
 	}
-	return ""
+	return nil
 }
 
-// ==============================================================================================
-//
-//	Upload Files Artifact
-//
-// ==============================================================================================
-type UploadFilesArtifactResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// UUID of the files artifact, for use when referencing it in the future
-	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	// UUID of the files artifact, for use when referencing it in the future
-	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+type GetStarlarkRunRecordArgs struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RunUuid       string                 `protobuf:"bytes,1,opt,name=run_uuid,json=runUuid,proto3" json:"run_uuid,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UploadFilesArtifactResponse) Reset() {
-	*x = UploadFilesArtifactResponse{}
-	mi := &file_api_container_service_proto_msgTypes[32]
+func (x *GetStarlarkRunRecordArgs) Reset() {
+	*x = GetStarlarkRunRecordArgs{}
+	mi := &file_api_container_service_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UploadFilesArtifactResponse) String() string {
+func (x *GetStarlarkRunRecordArgs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadFilesArtifactResponse) ProtoMessage() {}
+func (*GetStarlarkRunRecordArgs) ProtoMessage() {}
 
-func (x *UploadFilesArtifactResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_container_service_proto_msgTypes[32]
+func (x *GetStarlarkRunRecordArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3009,53 +4747,40 @@ func (x *UploadFilesArtifactResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadFilesArtifactResponse.ProtoReflect.Descriptor instead.
-func (*UploadFilesArtifactResponse) Descriptor() ([]byte, []int) {
-	return file_api_container_service_proto_rawDescGZIP(), []int{32}
-}
-
-func (x *UploadFilesArtifactResponse) GetUuid() string {
-	if x != nil {
-		return x.Uuid
-	}
-	return ""
+// Deprecated: Use GetStarlarkRunRecordArgs.ProtoReflect.Descriptor instead.
+func (*GetStarlarkRunRecordArgs) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *UploadFilesArtifactResponse) GetName() string {
+func (x *GetStarlarkRunRecordArgs) GetRunUuid() string {
 	if x != nil {
-		return x.Name
+		return x.RunUuid
 	}
 	return ""
 }
 
-// ==============================================================================================
-//
-//	Download Files Artifact
-//
-// ==============================================================================================
-type DownloadFilesArtifactArgs struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Files identifier to get bytes for
-	Identifier    string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+type PlanYaml struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlanYaml      string                 `protobuf:"bytes,1,opt,name=plan_yaml,json=planYaml,proto3" json:"plan_yaml,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DownloadFilesArtifactArgs) Reset() {
-	*x = DownloadFilesArtifactArgs{}
-	mi := &file_api_container_service_proto_msgTypes[33]
+func (x *PlanYaml) Reset() {
+	*x = PlanYaml{}
+	mi := &file_api_container_service_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DownloadFilesArtifactArgs) String() string {
+func (x *PlanYaml) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DownloadFilesArtifactArgs) ProtoMessage() {}
+func (*PlanYaml) ProtoMessage() {}
 
-func (x *DownloadFilesArtifactArgs) ProtoReflect() protoreflect.Message {
-	mi := &file_api_container_service_proto_msgTypes[33]
+func (x *PlanYaml) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3066,48 +4791,45 @@ func (x *DownloadFilesArtifactArgs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DownloadFilesArtifactArgs.ProtoReflect.Descriptor instead.
-func (*DownloadFilesArtifactArgs) Descriptor() ([]byte, []int) {
-	return file_api_container_service_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use PlanYaml.ProtoReflect.Descriptor instead.
+func (*PlanYaml) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *DownloadFilesArtifactArgs) GetIdentifier() string {
+func (x *PlanYaml) GetPlanYaml() string {
 	if x != nil {
-		return x.Identifier
+		return x.PlanYaml
 	}
 	return ""
 }
 
-// ==============================================================================================
-//
-//	Store Web Files Artifact
-//
-// ==============================================================================================
-type StoreWebFilesArtifactArgs struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// URL to download the artifact from
-	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
-	// The name of the files artifact
-	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type StarlarkScriptPlanYamlArgs struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	SerializedScript string                 `protobuf:"bytes,1,opt,name=serialized_script,json=serializedScript,proto3" json:"serialized_script,omitempty"`
+	SerializedParams *string                `protobuf:"bytes,2,opt,name=serialized_params,json=serializedParams,proto3,oneof" json:"serialized_params,omitempty"`
+	// The name of the main function, the default value is "run"
+	MainFunctionName *string `protobuf:"bytes,5,opt,name=main_function_name,json=mainFunctionName,proto3,oneof" json:"main_function_name,omitempty"`
+	// If true, permits Docker-only privileged containers, host bind mounts, and host PID namespace while interpreting the plan.
+	AllowPrivilegedMode *bool `protobuf:"varint,6,opt,name=allow_privileged_mode,json=allowPrivilegedMode,proto3,oneof" json:"allow_privileged_mode,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *StoreWebFilesArtifactArgs) Reset() {
-	*x = StoreWebFilesArtifactArgs{}
-	mi := &file_api_container_service_proto_msgTypes[34]
+func (x *StarlarkScriptPlanYamlArgs) Reset() {
+	*x = StarlarkScriptPlanYamlArgs{}
+	mi := &file_api_container_service_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StoreWebFilesArtifactArgs) String() string {
+func (x *StarlarkScriptPlanYamlArgs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StoreWebFilesArtifactArgs) ProtoMessage() {}
+func (*StarlarkScriptPlanYamlArgs) ProtoMessage() {}
 
-func (x *StoreWebFilesArtifactArgs) ProtoReflect() protoreflect.Message {
-	mi := &file_api_container_service_proto_msgTypes[34]
+func (x *StarlarkScriptPlanYamlArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3118,48 +4840,72 @@ func (x *StoreWebFilesArtifactArgs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StoreWebFilesArtifactArgs.ProtoReflect.Descriptor instead.
-func (*StoreWebFilesArtifactArgs) Descriptor() ([]byte, []int) {
-	return file_api_container_service_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use StarlarkScriptPlanYamlArgs.ProtoReflect.Descriptor instead.
+func (*StarlarkScriptPlanYamlArgs) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *StoreWebFilesArtifactArgs) GetUrl() string {
+func (x *StarlarkScriptPlanYamlArgs) GetSerializedScript() string {
 	if x != nil {
-		return x.Url
+		return x.SerializedScript
 	}
 	return ""
 }
 
-func (x *StoreWebFilesArtifactArgs) GetName() string {
-	if x != nil {
-		return x.Name
+func (x *StarlarkScriptPlanYamlArgs) GetSerializedParams() string {
+	if x != nil && x.SerializedParams != nil {
+		return *x.SerializedParams
 	}
 	return ""
 }
 
-type StoreWebFilesArtifactResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// UUID of the files artifact, for use when referencing it in the future
-	Uuid          string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *StarlarkScriptPlanYamlArgs) GetMainFunctionName() string {
+	if x != nil && x.MainFunctionName != nil {
+		return *x.MainFunctionName
+	}
+	return ""
 }
 
-func (x *StoreWebFilesArtifactResponse) Reset() {
-	*x = StoreWebFilesArtifactResponse{}
-	mi := &file_api_container_service_proto_msgTypes[35]
+func (x *StarlarkScriptPlanYamlArgs) GetAllowPrivilegedMode() bool {
+	if x != nil && x.AllowPrivilegedMode != nil {
+		return *x.AllowPrivilegedMode
+	}
+	return false
+}
+
+type StarlarkPackagePlanYamlArgs struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	PackageId string                 `protobuf:"bytes,1,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
+	// Serialized parameters data for the Starlark package main function
+	// This should be a valid JSON string
+	SerializedParams *string `protobuf:"bytes,2,opt,name=serialized_params,json=serializedParams,proto3,oneof" json:"serialized_params,omitempty"`
+	// whether or not this is package yaml should be pulled from on disk package or cloned
+	IsRemote bool `protobuf:"varint,3,opt,name=is_remote,json=isRemote,proto3" json:"is_remote,omitempty"`
+	// The relative main file filepath, the default value is the "main.star" file in the root of a package
+	RelativePathToMainFile *string `protobuf:"bytes,4,opt,name=relative_path_to_main_file,json=relativePathToMainFile,proto3,oneof" json:"relative_path_to_main_file,omitempty"`
+	// The name of the main function, the default value is "run"
+	MainFunctionName *string `protobuf:"bytes,5,opt,name=main_function_name,json=mainFunctionName,proto3,oneof" json:"main_function_name,omitempty"`
+	// If true, permits Docker-only privileged containers, host bind mounts, and host PID namespace while interpreting the plan.
+	AllowPrivilegedMode *bool `protobuf:"varint,6,opt,name=allow_privileged_mode,json=allowPrivilegedMode,proto3,oneof" json:"allow_privileged_mode,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *StarlarkPackagePlanYamlArgs) Reset() {
+	*x = StarlarkPackagePlanYamlArgs{}
+	mi := &file_api_container_service_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StoreWebFilesArtifactResponse) String() string {
+func (x *StarlarkPackagePlanYamlArgs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StoreWebFilesArtifactResponse) ProtoMessage() {}
+func (*StarlarkPackagePlanYamlArgs) ProtoMessage() {}
 
-func (x *StoreWebFilesArtifactResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_container_service_proto_msgTypes[35]
+func (x *StarlarkPackagePlanYamlArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3170,45 +4916,78 @@ func (x *StoreWebFilesArtifactResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StoreWebFilesArtifactResponse.ProtoReflect.Descriptor instead.
-func (*StoreWebFilesArtifactResponse) Descriptor() ([]byte, []int) {
-	return file_api_container_service_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use StarlarkPackagePlanYamlArgs.ProtoReflect.Descriptor instead.
+func (*StarlarkPackagePlanYamlArgs) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *StoreWebFilesArtifactResponse) GetUuid() string {
+func (x *StarlarkPackagePlanYamlArgs) GetPackageId() string {
 	if x != nil {
-		return x.Uuid
+		return x.PackageId
 	}
 	return ""
 }
 
-type StoreFilesArtifactFromServiceArgs struct {
+func (x *StarlarkPackagePlanYamlArgs) GetSerializedParams() string {
+	if x != nil && x.SerializedParams != nil {
+		return *x.SerializedParams
+	}
+	return ""
+}
+
+func (x *StarlarkPackagePlanYamlArgs) GetIsRemote() bool {
+	if x != nil {
+		return x.IsRemote
+	}
+	return false
+}
+
+func (x *StarlarkPackagePlanYamlArgs) GetRelativePathToMainFile() string {
+	if x != nil && x.RelativePathToMainFile != nil {
+		return *x.RelativePathToMainFile
+	}
+	return ""
+}
+
+func (x *StarlarkPackagePlanYamlArgs) GetMainFunctionName() string {
+	if x != nil && x.MainFunctionName != nil {
+		return *x.MainFunctionName
+	}
+	return ""
+}
+
+func (x *StarlarkPackagePlanYamlArgs) GetAllowPrivilegedMode() bool {
+	if x != nil && x.AllowPrivilegedMode != nil {
+		return *x.AllowPrivilegedMode
+	}
+	return false
+}
+
+type SetSecretArgs struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Identifier that will be used to identify the service where the source files will be copied from
-	ServiceIdentifier string `protobuf:"bytes,1,opt,name=service_identifier,json=serviceIdentifier,proto3" json:"service_identifier,omitempty"`
-	// The absolute source path where the source files will be copied from
-	SourcePath string `protobuf:"bytes,2,opt,name=source_path,json=sourcePath,proto3" json:"source_path,omitempty"`
-	// The name of the files artifact
-	Name          string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// The name of the secret, as referenced by `plan.get_secret(name)` in Starlark
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The value of the secret. It is encrypted before being written to the enclave database
+	Value         string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StoreFilesArtifactFromServiceArgs) Reset() {
-	*x = StoreFilesArtifactFromServiceArgs{}
-	mi := &file_api_container_service_proto_msgTypes[36]
+func (x *SetSecretArgs) Reset() {
+	*x = SetSecretArgs{}
+	mi := &file_api_container_service_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StoreFilesArtifactFromServiceArgs) String() string {
+func (x *SetSecretArgs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StoreFilesArtifactFromServiceArgs) ProtoMessage() {}
+func (*SetSecretArgs) ProtoMessage() {}
 
-func (x *StoreFilesArtifactFromServiceArgs) ProtoReflect() protoreflect.Message {
-	mi := &file_api_container_service_proto_msgTypes[36]
+func (x *SetSecretArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3219,55 +4998,48 @@ func (x *StoreFilesArtifactFromServiceArgs) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StoreFilesArtifactFromServiceArgs.ProtoReflect.Descriptor instead.
-func (*StoreFilesArtifactFromServiceArgs) Descriptor() ([]byte, []int) {
-	return file_api_container_service_proto_rawDescGZIP(), []int{36}
-}
-
-func (x *StoreFilesArtifactFromServiceArgs) GetServiceIdentifier() string {
-	if x != nil {
-		return x.ServiceIdentifier
-	}
-	return ""
+// Deprecated: Use SetSecretArgs.ProtoReflect.Descriptor instead.
+func (*SetSecretArgs) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *StoreFilesArtifactFromServiceArgs) GetSourcePath() string {
+func (x *SetSecretArgs) GetName() string {
 	if x != nil {
-		return x.SourcePath
+		return x.Name
 	}
 	return ""
 }
 
-func (x *StoreFilesArtifactFromServiceArgs) GetName() string {
+func (x *SetSecretArgs) GetValue() string {
 	if x != nil {
-		return x.Name
+		return x.Value
 	}
 	return ""
 }
 
-type StoreFilesArtifactFromServiceResponse struct {
+type ListSecretsResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// UUID of the files artifact, for use when referencing it in the future
-	Uuid          string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// The names of the secrets stored in the enclave
+	SecretNames   []string `protobuf:"bytes,1,rep,name=secret_names,json=secretNames,proto3" json:"secret_names,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StoreFilesArtifactFromServiceResponse) Reset() {
-	*x = StoreFilesArtifactFromServiceResponse{}
-	mi := &file_api_container_service_proto_msgTypes[37]
+func (x *ListSecretsResponse) Reset() {
+	*x = ListSecretsResponse{}
+	mi := &file_api_container_service_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StoreFilesArtifactFromServiceResponse) String() string {
+func (x *ListSecretsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StoreFilesArtifactFromServiceResponse) ProtoMessage() {}
+func (*ListSecretsResponse) ProtoMessage() {}
 
-func (x *StoreFilesArtifactFromServiceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_container_service_proto_msgTypes[37]
+func (x *ListSecretsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3278,43 +5050,41 @@ func (x *StoreFilesArtifactFromServiceResponse) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StoreFilesArtifactFromServiceResponse.ProtoReflect.Descriptor instead.
-func (*StoreFilesArtifactFromServiceResponse) Descriptor() ([]byte, []int) {
-	return file_api_container_service_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use ListSecretsResponse.ProtoReflect.Descriptor instead.
+func (*ListSecretsResponse) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *StoreFilesArtifactFromServiceResponse) GetUuid() string {
+func (x *ListSecretsResponse) GetSecretNames() []string {
 	if x != nil {
-		return x.Uuid
+		return x.SecretNames
 	}
-	return ""
+	return nil
 }
 
-type FilesArtifactNameAndUuid struct {
+type RemoveSecretArgs struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// A string representing the name of the file
-	FileName string `protobuf:"bytes,1,opt,name=fileName,proto3" json:"fileName,omitempty"`
-	// A string representing the uuid of the file
-	FileUuid      string `protobuf:"bytes,2,opt,name=fileUuid,proto3" json:"fileUuid,omitempty"`
+	// The name of the secret to remove
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FilesArtifactNameAndUuid) Reset() {
-	*x = FilesArtifactNameAndUuid{}
-	mi := &file_api_container_service_proto_msgTypes[38]
+func (x *RemoveSecretArgs) Reset() {
+	*x = RemoveSecretArgs{}
+	mi := &file_api_container_service_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FilesArtifactNameAndUuid) String() string {
+func (x *RemoveSecretArgs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FilesArtifactNameAndUuid) ProtoMessage() {}
+func (*RemoveSecretArgs) ProtoMessage() {}
 
-func (x *FilesArtifactNameAndUuid) ProtoReflect() protoreflect.Message {
-	mi := &file_api_container_service_proto_msgTypes[38]
+func (x *RemoveSecretArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3325,47 +5095,41 @@ func (x *FilesArtifactNameAndUuid) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FilesArtifactNameAndUuid.ProtoReflect.Descriptor instead.
-func (*FilesArtifactNameAndUuid) Descriptor() ([]byte, []int) {
-	return file_api_container_service_proto_rawDescGZIP(), []int{38}
-}
-
-func (x *FilesArtifactNameAndUuid) GetFileName() string {
-	if x != nil {
-		return x.FileName
-	}
-	return ""
+// Deprecated: Use RemoveSecretArgs.ProtoReflect.Descriptor instead.
+func (*RemoveSecretArgs) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *FilesArtifactNameAndUuid) GetFileUuid() string {
+func (x *RemoveSecretArgs) GetName() string {
 	if x != nil {
-		return x.FileUuid
+		return x.Name
 	}
 	return ""
 }
 
-type ListFilesArtifactNamesAndUuidsResponse struct {
-	state             protoimpl.MessageState      `protogen:"open.v1"`
-	FileNamesAndUuids []*FilesArtifactNameAndUuid `protobuf:"bytes,1,rep,name=file_names_and_uuids,json=fileNamesAndUuids,proto3" json:"file_names_and_uuids,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type ResumeServicesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The names of the services that were started again, in the order they were started
+	ResumedServiceNames []string `protobuf:"bytes,1,rep,name=resumed_service_names,json=resumedServiceNames,proto3" json:"resumed_service_names,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *ListFilesArtifactNamesAndUuidsResponse) Reset() {
-	*x = ListFilesArtifactNamesAndUuidsResponse{}
-	mi := &file_api_container_service_proto_msgTypes[39]
+func (x *ResumeServicesResponse) Reset() {
+	*x = ResumeServicesResponse{}
+	mi := &file_api_container_service_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListFilesArtifactNamesAndUuidsResponse) String() string {
+func (x *ResumeServicesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListFilesArtifactNamesAndUuidsResponse) ProtoMessage() {}
+func (*ResumeServicesResponse) ProtoMessage() {}
 
-func (x *ListFilesArtifactNamesAndUuidsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_container_service_proto_msgTypes[39]
+func (x *ResumeServicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3376,40 +5140,40 @@ func (x *ListFilesArtifactNamesAndUuidsResponse) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListFilesArtifactNamesAndUuidsResponse.ProtoReflect.Descriptor instead.
-func (*ListFilesArtifactNamesAndUuidsResponse) Descriptor() ([]byte, []int) {
-	return file_api_container_service_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use ResumeServicesResponse.ProtoReflect.Descriptor instead.
+func (*ResumeServicesResponse) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *ListFilesArtifactNamesAndUuidsResponse) GetFileNamesAndUuids() []*FilesArtifactNameAndUuid {
+func (x *ResumeServicesResponse) GetResumedServiceNames() []string {
 	if x != nil {
-		return x.FileNamesAndUuids
+		return x.ResumedServiceNames
 	}
 	return nil
 }
 
-type InspectFilesArtifactContentsRequest struct {
-	state            protoimpl.MessageState    `protogen:"open.v1"`
-	FileNamesAndUuid *FilesArtifactNameAndUuid `protobuf:"bytes,1,opt,name=file_names_and_uuid,json=fileNamesAndUuid,proto3" json:"file_names_and_uuid,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type GetSecretsRecipientPublicKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PublicKey     []byte                 `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InspectFilesArtifactContentsRequest) Reset() {
-	*x = InspectFilesArtifactContentsRequest{}
-	mi := &file_api_container_service_proto_msgTypes[40]
+func (x *GetSecretsRecipientPublicKeyResponse) Reset() {
+	*x = GetSecretsRecipientPublicKeyResponse{}
+	mi := &file_api_container_service_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InspectFilesArtifactContentsRequest) String() string {
+func (x *GetSecretsRecipientPublicKeyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InspectFilesArtifactContentsRequest) ProtoMessage() {}
+func (*GetSecretsRecipientPublicKeyResponse) ProtoMessage() {}
 
-func (x *InspectFilesArtifactContentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_container_service_proto_msgTypes[40]
+func (x *GetSecretsRecipientPublicKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3420,40 +5184,42 @@ func (x *InspectFilesArtifactContentsRequest) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InspectFilesArtifactContentsRequest.ProtoReflect.Descriptor instead.
-func (*InspectFilesArtifactContentsRequest) Descriptor() ([]byte, []int) {
-	return file_api_container_service_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use GetSecretsRecipientPublicKeyResponse.ProtoReflect.Descriptor instead.
+func (*GetSecretsRecipientPublicKeyResponse) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *InspectFilesArtifactContentsRequest) GetFileNamesAndUuid() *FilesArtifactNameAndUuid {
+func (x *GetSecretsRecipientPublicKeyResponse) GetPublicKey() []byte {
 	if x != nil {
-		return x.FileNamesAndUuid
+		return x.PublicKey
 	}
 	return nil
 }
 
-type InspectFilesArtifactContentsResponse struct {
-	state            protoimpl.MessageState                 `protogen:"open.v1"`
-	FileDescriptions []*FileArtifactContentsFileDescription `protobuf:"bytes,1,rep,name=file_descriptions,json=fileDescriptions,proto3" json:"file_descriptions,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type ExportEnclavePlanArgs struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The public key returned by GetSecretsRecipientPublicKey in the destination enclave. If unset, the secrets of the
+	// enclave aren't exported
+	SecretsRecipientPublicKey []byte `protobuf:"bytes,1,opt,name=secrets_recipient_public_key,json=secretsRecipientPublicKey,proto3,oneof" json:"secrets_recipient_public_key,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
 }
 
-func (x *InspectFilesArtifactContentsResponse) Reset() {
-	*x = InspectFilesArtifactContentsResponse{}
-	mi := &file_api_container_service_proto_msgTypes[41]
+func (x *ExportEnclavePlanArgs) Reset() {
+	*x = ExportEnclavePlanArgs{}
+	mi := &file_api_container_service_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InspectFilesArtifactContentsResponse) String() string {
+func (x *ExportEnclavePlanArgs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InspectFilesArtifactContentsResponse) ProtoMessage() {}
+func (*ExportEnclavePlanArgs) ProtoMessage() {}
 
-func (x *InspectFilesArtifactContentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_container_service_proto_msgTypes[41]
+func (x *ExportEnclavePlanArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3464,45 +5230,47 @@ func (x *InspectFilesArtifactContentsResponse) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InspectFilesArtifactContentsResponse.ProtoReflect.Descriptor instead.
-func (*InspectFilesArtifactContentsResponse) Descriptor() ([]byte, []int) {
-	return file_api_container_service_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use ExportEnclavePlanArgs.ProtoReflect.Descriptor instead.
+func (*ExportEnclavePlanArgs) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *InspectFilesArtifactContentsResponse) GetFileDescriptions() []*FileArtifactContentsFileDescription {
+func (x *ExportEnclavePlanArgs) GetSecretsRecipientPublicKey() []byte {
 	if x != nil {
-		return x.FileDescriptions
+		return x.SecretsRecipientPublicKey
 	}
 	return nil
 }
 
-type FileArtifactContentsFileDescription struct {
+type ExportEnclavePlanResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Path relative to the file artifact
-	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	// Size of the file, in bytes
-	Size uint64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
-	// A bit of text content, if the file allows (similar to UNIX's 'head')
-	TextPreview   *string `protobuf:"bytes,3,opt,name=text_preview,json=textPreview,proto3,oneof" json:"text_preview,omitempty"`
+	// The exported enclave plan, opaque to the clients, to be passed as it is to ReplayEnclavePlan
+	SerializedExportedEnclavePlan []byte `protobuf:"bytes,1,opt,name=serialized_exported_enclave_plan,json=serializedExportedEnclavePlan,proto3" json:"serialized_exported_enclave_plan,omitempty"`
+	// The names of the files artifacts that replaying the plan produces again. The other files artifacts of the enclave
+	// must be copied to the destination enclave before replaying the plan
+	ReplayedFilesArtifactNames []string `protobuf:"bytes,2,rep,name=replayed_files_artifact_names,json=replayedFilesArtifactNames,proto3" json:"replayed_files_artifact_names,omitempty"`
+	// The secrets of the enclave, sealed with the secrets recipient public key, to be passed as they are to
+	// ReplayEnclavePlan. Unset if no secrets recipient public key was given
+	SealedSecrets []byte `protobuf:"bytes,3,opt,name=sealed_secrets,json=sealedSecrets,proto3,oneof" json:"sealed_secrets,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FileArtifactContentsFileDescription) Reset() {
-	*x = FileArtifactContentsFileDescription{}
-	mi := &file_api_container_service_proto_msgTypes[42]
+func (x *ExportEnclavePlanResponse) Reset() {
+	*x = ExportEnclavePlanResponse{}
+	mi := &file_api_container_service_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FileArtifactContentsFileDescription) String() string {
+func (x *ExportEnclavePlanResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FileArtifactContentsFileDescription) ProtoMessage() {}
+func (*ExportEnclavePlanResponse) ProtoMessage() {}
 
-func (x *FileArtifactContentsFileDescription) ProtoReflect() protoreflect.Message {
-	mi := &file_api_container_service_proto_msgTypes[42]
+func (x *ExportEnclavePlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3513,97 +5281,56 @@ func (x *FileArtifactContentsFileDescription) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FileArtifactContentsFileDescription.ProtoReflect.Descriptor instead.
-func (*FileArtifactContentsFileDescription) Descriptor() ([]byte, []int) {
-	return file_api_container_service_proto_rawDescGZIP(), []int{42}
-}
-
-func (x *FileArtifactContentsFileDescription) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
+// Deprecated: Use ExportEnclavePlanResponse.ProtoReflect.Descriptor instead.
+func (*ExportEnclavePlanResponse) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *FileArtifactContentsFileDescription) GetSize() uint64 {
+func (x *ExportEnclavePlanResponse) GetSerializedExportedEnclavePlan() []byte {
 	if x != nil {
-		return x.Size
-	}
-	return 0
-}
-
-func (x *FileArtifactContentsFileDescription) GetTextPreview() string {
-	if x != nil && x.TextPreview != nil {
-		return *x.TextPreview
+		return x.SerializedExportedEnclavePlan
 	}
-	return ""
-}
-
-type ConnectServicesArgs struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Connect       Connect                `protobuf:"varint,1,opt,name=connect,proto3,enum=api_container_api.Connect" json:"connect,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ConnectServicesArgs) Reset() {
-	*x = ConnectServicesArgs{}
-	mi := &file_api_container_service_proto_msgTypes[43]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ConnectServicesArgs) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return nil
 }
 
-func (*ConnectServicesArgs) ProtoMessage() {}
-
-func (x *ConnectServicesArgs) ProtoReflect() protoreflect.Message {
-	mi := &file_api_container_service_proto_msgTypes[43]
+func (x *ExportEnclavePlanResponse) GetReplayedFilesArtifactNames() []string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ReplayedFilesArtifactNames
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ConnectServicesArgs.ProtoReflect.Descriptor instead.
-func (*ConnectServicesArgs) Descriptor() ([]byte, []int) {
-	return file_api_container_service_proto_rawDescGZIP(), []int{43}
+	return nil
 }
 
-func (x *ConnectServicesArgs) GetConnect() Connect {
+func (x *ExportEnclavePlanResponse) GetSealedSecrets() []byte {
 	if x != nil {
-		return x.Connect
+		return x.SealedSecrets
 	}
-	return Connect_CONNECT
+	return nil
 }
 
-type ConnectServicesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type ReplayEnclavePlanArgs struct {
+	state                         protoimpl.MessageState `protogen:"open.v1"`
+	SerializedExportedEnclavePlan []byte                 `protobuf:"bytes,1,opt,name=serialized_exported_enclave_plan,json=serializedExportedEnclavePlan,proto3" json:"serialized_exported_enclave_plan,omitempty"`
+	// The sealed secrets exported along with the plan. They're stored in this enclave before the plan is replayed
+	SealedSecrets []byte `protobuf:"bytes,2,opt,name=sealed_secrets,json=sealedSecrets,proto3,oneof" json:"sealed_secrets,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ConnectServicesResponse) Reset() {
-	*x = ConnectServicesResponse{}
-	mi := &file_api_container_service_proto_msgTypes[44]
+func (x *ReplayEnclavePlanArgs) Reset() {
+	*x = ReplayEnclavePlanArgs{}
+	mi := &file_api_container_service_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConnectServicesResponse) String() string {
+func (x *ReplayEnclavePlanArgs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConnectServicesResponse) ProtoMessage() {}
+func (*ReplayEnclavePlanArgs) ProtoMessage() {}
 
-func (x *ConnectServicesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_container_service_proto_msgTypes[44]
+func (x *ReplayEnclavePlanArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3614,42 +5341,59 @@ func (x *ConnectServicesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConnectServicesResponse.ProtoReflect.Descriptor instead.
-func (*ConnectServicesResponse) Descriptor() ([]byte, []int) {
-	return file_api_container_service_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use ReplayEnclavePlanArgs.ProtoReflect.Descriptor instead.
+func (*ReplayEnclavePlanArgs) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{69}
 }
 
-type GetStarlarkRunResponse struct {
-	state                  protoimpl.MessageState `protogen:"open.v1"`
-	PackageId              string                 `protobuf:"bytes,1,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
-	SerializedScript       string                 `protobuf:"bytes,2,opt,name=serialized_script,json=serializedScript,proto3" json:"serialized_script,omitempty"`
-	SerializedParams       string                 `protobuf:"bytes,3,opt,name=serialized_params,json=serializedParams,proto3" json:"serialized_params,omitempty"`
-	Parallelism            int32                  `protobuf:"varint,4,opt,name=parallelism,proto3" json:"parallelism,omitempty"`
-	RelativePathToMainFile string                 `protobuf:"bytes,5,opt,name=relative_path_to_main_file,json=relativePathToMainFile,proto3" json:"relative_path_to_main_file,omitempty"`
-	MainFunctionName       string                 `protobuf:"bytes,6,opt,name=main_function_name,json=mainFunctionName,proto3" json:"main_function_name,omitempty"`
-	ExperimentalFeatures   []KurtosisFeatureFlag  `protobuf:"varint,7,rep,packed,name=experimental_features,json=experimentalFeatures,proto3,enum=api_container_api.KurtosisFeatureFlag" json:"experimental_features,omitempty"`
-	RestartPolicy          RestartPolicy          `protobuf:"varint,8,opt,name=restart_policy,json=restartPolicy,proto3,enum=api_container_api.RestartPolicy" json:"restart_policy,omitempty"`
-	// The params that were used on for the very first Starlark run in an APIC
-	InitialSerializedParams *string `protobuf:"bytes,9,opt,name=initial_serialized_params,json=initialSerializedParams,proto3,oneof" json:"initial_serialized_params,omitempty"`
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
+func (x *ReplayEnclavePlanArgs) GetSerializedExportedEnclavePlan() []byte {
+	if x != nil {
+		return x.SerializedExportedEnclavePlan
+	}
+	return nil
 }
 
-func (x *GetStarlarkRunResponse) Reset() {
-	*x = GetStarlarkRunResponse{}
-	mi := &file_api_container_service_proto_msgTypes[45]
+func (x *ReplayEnclavePlanArgs) GetSealedSecrets() []byte {
+	if x != nil {
+		return x.SealedSecrets
+	}
+	return nil
+}
+
+// Only the fields relevant to the event type are set
+type ApiContainerEvent struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Type              ApiContainerEventType  `protobuf:"varint,1,opt,name=type,proto3,enum=api_container_api.ApiContainerEventType" json:"type,omitempty"`
+	Timestamp         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	ServiceName       *string                `protobuf:"bytes,3,opt,name=service_name,json=serviceName,proto3,oneof" json:"service_name,omitempty"`
+	ServiceUuid       *string                `protobuf:"bytes,4,opt,name=service_uuid,json=serviceUuid,proto3,oneof" json:"service_uuid,omitempty"`
+	FilesArtifactName *string                `protobuf:"bytes,5,opt,name=files_artifact_name,json=filesArtifactName,proto3,oneof" json:"files_artifact_name,omitempty"`
+	FilesArtifactUuid *string                `protobuf:"bytes,6,opt,name=files_artifact_uuid,json=filesArtifactUuid,proto3,oneof" json:"files_artifact_uuid,omitempty"`
+	// Set for STARLARK_RUN_FINISHED
+	IsRunSuccessful *bool `protobuf:"varint,7,opt,name=is_run_successful,json=isRunSuccessful,proto3,oneof" json:"is_run_successful,omitempty"`
+	// Identifies the run of the API container that published the event; sequence numbers start over when it changes
+	StreamId string `protobuf:"bytes,8,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	// Incremented by one for every event of the stream, starting at 1
+	SequenceNumber uint64 `protobuf:"varint,9,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ApiContainerEvent) Reset() {
+	*x = ApiContainerEvent{}
+	mi := &file_api_container_service_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetStarlarkRunResponse) String() string {
+func (x *ApiContainerEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStarlarkRunResponse) ProtoMessage() {}
+func (*ApiContainerEvent) ProtoMessage() {}
 
-func (x *GetStarlarkRunResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_container_service_proto_msgTypes[45]
+func (x *ApiContainerEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3660,96 +5404,100 @@ func (x *GetStarlarkRunResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStarlarkRunResponse.ProtoReflect.Descriptor instead.
-func (*GetStarlarkRunResponse) Descriptor() ([]byte, []int) {
-	return file_api_container_service_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use ApiContainerEvent.ProtoReflect.Descriptor instead.
+func (*ApiContainerEvent) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *GetStarlarkRunResponse) GetPackageId() string {
+func (x *ApiContainerEvent) GetType() ApiContainerEventType {
 	if x != nil {
-		return x.PackageId
+		return x.Type
 	}
-	return ""
+	return ApiContainerEventType_STARLARK_RUN_STARTED
 }
 
-func (x *GetStarlarkRunResponse) GetSerializedScript() string {
+func (x *ApiContainerEvent) GetTimestamp() *timestamppb.Timestamp {
 	if x != nil {
-		return x.SerializedScript
+		return x.Timestamp
 	}
-	return ""
+	return nil
 }
 
-func (x *GetStarlarkRunResponse) GetSerializedParams() string {
-	if x != nil {
-		return x.SerializedParams
+func (x *ApiContainerEvent) GetServiceName() string {
+	if x != nil && x.ServiceName != nil {
+		return *x.ServiceName
 	}
 	return ""
 }
 
-func (x *GetStarlarkRunResponse) GetParallelism() int32 {
-	if x != nil {
-		return x.Parallelism
+func (x *ApiContainerEvent) GetServiceUuid() string {
+	if x != nil && x.ServiceUuid != nil {
+		return *x.ServiceUuid
 	}
-	return 0
+	return ""
 }
 
-func (x *GetStarlarkRunResponse) GetRelativePathToMainFile() string {
-	if x != nil {
-		return x.RelativePathToMainFile
+func (x *ApiContainerEvent) GetFilesArtifactName() string {
+	if x != nil && x.FilesArtifactName != nil {
+		return *x.FilesArtifactName
 	}
 	return ""
 }
 
-func (x *GetStarlarkRunResponse) GetMainFunctionName() string {
-	if x != nil {
-		return x.MainFunctionName
+func (x *ApiContainerEvent) GetFilesArtifactUuid() string {
+	if x != nil && x.FilesArtifactUuid != nil {
+		return *x.FilesArtifactUuid
 	}
 	return ""
 }
 
-func (x *GetStarlarkRunResponse) GetExperimentalFeatures() []KurtosisFeatureFlag {
-	if x != nil {
-		return x.ExperimentalFeatures
+func (x *ApiContainerEvent) GetIsRunSuccessful() bool {
+	if x != nil && x.IsRunSuccessful != nil {
+		return *x.IsRunSuccessful
 	}
-	return nil
+	return false
 }
 
-func (x *GetStarlarkRunResponse) GetRestartPolicy() RestartPolicy {
+func (x *ApiContainerEvent) GetStreamId() string {
 	if x != nil {
-		return x.RestartPolicy
+		return x.StreamId
 	}
-	return RestartPolicy_NEVER
+	return ""
 }
 
-func (x *GetStarlarkRunResponse) GetInitialSerializedParams() string {
-	if x != nil && x.InitialSerializedParams != nil {
-		return *x.InitialSerializedParams
+func (x *ApiContainerEvent) GetSequenceNumber() uint64 {
+	if x != nil {
+		return x.SequenceNumber
 	}
-	return ""
+	return 0
 }
 
-type PlanYaml struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PlanYaml      string                 `protobuf:"bytes,1,opt,name=plan_yaml,json=planYaml,proto3" json:"plan_yaml,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type WatchEventsArgs struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The stream ID and sequence number of the last event received. The events of the same stream published after it
+	// that the API container still retains are sent first. If unset, or if the stream ID doesn't match because the API
+	// container restarted, all the events it retains are
+	AfterStreamId       *string `protobuf:"bytes,1,opt,name=after_stream_id,json=afterStreamId,proto3,oneof" json:"after_stream_id,omitempty"`
+	AfterSequenceNumber *uint64 `protobuf:"varint,2,opt,name=after_sequence_number,json=afterSequenceNumber,proto3,oneof" json:"after_sequence_number,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *PlanYaml) Reset() {
-	*x = PlanYaml{}
-	mi := &file_api_container_service_proto_msgTypes[46]
+func (x *WatchEventsArgs) Reset() {
+	*x = WatchEventsArgs{}
+	mi := &file_api_container_service_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PlanYaml) String() string {
+func (x *WatchEventsArgs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlanYaml) ProtoMessage() {}
+func (*WatchEventsArgs) ProtoMessage() {}
 
-func (x *PlanYaml) ProtoReflect() protoreflect.Message {
-	mi := &file_api_container_service_proto_msgTypes[46]
+func (x *WatchEventsArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3760,45 +5508,47 @@ func (x *PlanYaml) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlanYaml.ProtoReflect.Descriptor instead.
-func (*PlanYaml) Descriptor() ([]byte, []int) {
-	return file_api_container_service_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use WatchEventsArgs.ProtoReflect.Descriptor instead.
+func (*WatchEventsArgs) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *PlanYaml) GetPlanYaml() string {
-	if x != nil {
-		return x.PlanYaml
+func (x *WatchEventsArgs) GetAfterStreamId() string {
+	if x != nil && x.AfterStreamId != nil {
+		return *x.AfterStreamId
 	}
 	return ""
 }
 
-type StarlarkScriptPlanYamlArgs struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	SerializedScript string                 `protobuf:"bytes,1,opt,name=serialized_script,json=serializedScript,proto3" json:"serialized_script,omitempty"`
-	SerializedParams *string                `protobuf:"bytes,2,opt,name=serialized_params,json=serializedParams,proto3,oneof" json:"serialized_params,omitempty"`
-	// The name of the main function, the default value is "run"
-	MainFunctionName *string `protobuf:"bytes,5,opt,name=main_function_name,json=mainFunctionName,proto3,oneof" json:"main_function_name,omitempty"`
-	// If true, permits Docker-only privileged containers, host bind mounts, and host PID namespace while interpreting the plan.
-	AllowPrivilegedMode *bool `protobuf:"varint,6,opt,name=allow_privileged_mode,json=allowPrivilegedMode,proto3,oneof" json:"allow_privileged_mode,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+func (x *WatchEventsArgs) GetAfterSequenceNumber() uint64 {
+	if x != nil && x.AfterSequenceNumber != nil {
+		return *x.AfterSequenceNumber
+	}
+	return 0
 }
 
-func (x *StarlarkScriptPlanYamlArgs) Reset() {
-	*x = StarlarkScriptPlanYamlArgs{}
-	mi := &file_api_container_service_proto_msgTypes[47]
+type ServiceDependencies struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServiceNames  []string               `protobuf:"bytes,1,rep,name=service_names,json=serviceNames,proto3" json:"service_names,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceDependencies) Reset() {
+	*x = ServiceDependencies{}
+	mi := &file_api_container_service_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StarlarkScriptPlanYamlArgs) String() string {
+func (x *ServiceDependencies) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StarlarkScriptPlanYamlArgs) ProtoMessage() {}
+func (*ServiceDependencies) ProtoMessage() {}
 
-func (x *StarlarkScriptPlanYamlArgs) ProtoReflect() protoreflect.Message {
-	mi := &file_api_container_service_proto_msgTypes[47]
+func (x *ServiceDependencies) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3809,72 +5559,85 @@ func (x *StarlarkScriptPlanYamlArgs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StarlarkScriptPlanYamlArgs.ProtoReflect.Descriptor instead.
-func (*StarlarkScriptPlanYamlArgs) Descriptor() ([]byte, []int) {
-	return file_api_container_service_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use ServiceDependencies.ProtoReflect.Descriptor instead.
+func (*ServiceDependencies) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *StarlarkScriptPlanYamlArgs) GetSerializedScript() string {
+func (x *ServiceDependencies) GetServiceNames() []string {
 	if x != nil {
-		return x.SerializedScript
+		return x.ServiceNames
 	}
-	return ""
+	return nil
 }
 
-func (x *StarlarkScriptPlanYamlArgs) GetSerializedParams() string {
-	if x != nil && x.SerializedParams != nil {
-		return *x.SerializedParams
-	}
-	return ""
+type GetServiceDependenciesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Services that don't depend on any other service are mapped to an empty list
+	DependenciesByServiceName map[string]*ServiceDependencies `protobuf:"bytes,1,rep,name=dependencies_by_service_name,json=dependenciesByServiceName,proto3" json:"dependencies_by_service_name,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
 }
 
-func (x *StarlarkScriptPlanYamlArgs) GetMainFunctionName() string {
-	if x != nil && x.MainFunctionName != nil {
-		return *x.MainFunctionName
+func (x *GetServiceDependenciesResponse) Reset() {
+	*x = GetServiceDependenciesResponse{}
+	mi := &file_api_container_service_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetServiceDependenciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServiceDependenciesResponse) ProtoMessage() {}
+
+func (x *GetServiceDependenciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *StarlarkScriptPlanYamlArgs) GetAllowPrivilegedMode() bool {
-	if x != nil && x.AllowPrivilegedMode != nil {
-		return *x.AllowPrivilegedMode
+// Deprecated: Use GetServiceDependenciesResponse.ProtoReflect.Descriptor instead.
+func (*GetServiceDependenciesResponse) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *GetServiceDependenciesResponse) GetDependenciesByServiceName() map[string]*ServiceDependencies {
+	if x != nil {
+		return x.DependenciesByServiceName
 	}
-	return false
+	return nil
 }
 
-type StarlarkPackagePlanYamlArgs struct {
-	state     protoimpl.MessageState `protogen:"open.v1"`
-	PackageId string                 `protobuf:"bytes,1,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
-	// Serialized parameters data for the Starlark package main function
-	// This should be a valid JSON string
-	SerializedParams *string `protobuf:"bytes,2,opt,name=serialized_params,json=serializedParams,proto3,oneof" json:"serialized_params,omitempty"`
-	// whether or not this is package yaml should be pulled from on disk package or cloned
-	IsRemote bool `protobuf:"varint,3,opt,name=is_remote,json=isRemote,proto3" json:"is_remote,omitempty"`
-	// The relative main file filepath, the default value is the "main.star" file in the root of a package
-	RelativePathToMainFile *string `protobuf:"bytes,4,opt,name=relative_path_to_main_file,json=relativePathToMainFile,proto3,oneof" json:"relative_path_to_main_file,omitempty"`
-	// The name of the main function, the default value is "run"
-	MainFunctionName *string `protobuf:"bytes,5,opt,name=main_function_name,json=mainFunctionName,proto3,oneof" json:"main_function_name,omitempty"`
-	// If true, permits Docker-only privileged containers, host bind mounts, and host PID namespace while interpreting the plan.
-	AllowPrivilegedMode *bool `protobuf:"varint,6,opt,name=allow_privileged_mode,json=allowPrivilegedMode,proto3,oneof" json:"allow_privileged_mode,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+type WaitForServiceReadinessArgs struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServiceName   string                 `protobuf:"bytes,1,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StarlarkPackagePlanYamlArgs) Reset() {
-	*x = StarlarkPackagePlanYamlArgs{}
-	mi := &file_api_container_service_proto_msgTypes[48]
+func (x *WaitForServiceReadinessArgs) Reset() {
+	*x = WaitForServiceReadinessArgs{}
+	mi := &file_api_container_service_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StarlarkPackagePlanYamlArgs) String() string {
+func (x *WaitForServiceReadinessArgs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StarlarkPackagePlanYamlArgs) ProtoMessage() {}
+func (*WaitForServiceReadinessArgs) ProtoMessage() {}
 
-func (x *StarlarkPackagePlanYamlArgs) ProtoReflect() protoreflect.Message {
-	mi := &file_api_container_service_proto_msgTypes[48]
+func (x *WaitForServiceReadinessArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3885,58 +5648,68 @@ func (x *StarlarkPackagePlanYamlArgs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StarlarkPackagePlanYamlArgs.ProtoReflect.Descriptor instead.
-func (*StarlarkPackagePlanYamlArgs) Descriptor() ([]byte, []int) {
-	return file_api_container_service_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use WaitForServiceReadinessArgs.ProtoReflect.Descriptor instead.
+func (*WaitForServiceReadinessArgs) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *StarlarkPackagePlanYamlArgs) GetPackageId() string {
+func (x *WaitForServiceReadinessArgs) GetServiceName() string {
 	if x != nil {
-		return x.PackageId
+		return x.ServiceName
 	}
 	return ""
 }
 
-func (x *StarlarkPackagePlanYamlArgs) GetSerializedParams() string {
-	if x != nil && x.SerializedParams != nil {
-		return *x.SerializedParams
-	}
-	return ""
+type GetLastActivityTimeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Not set if the API container wasn't used since it started
+	LastActivityTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=last_activity_time,json=lastActivityTime,proto3,oneof" json:"last_activity_time,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *StarlarkPackagePlanYamlArgs) GetIsRemote() bool {
-	if x != nil {
-		return x.IsRemote
-	}
-	return false
+func (x *GetLastActivityTimeResponse) Reset() {
+	*x = GetLastActivityTimeResponse{}
+	mi := &file_api_container_service_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *StarlarkPackagePlanYamlArgs) GetRelativePathToMainFile() string {
-	if x != nil && x.RelativePathToMainFile != nil {
-		return *x.RelativePathToMainFile
-	}
-	return ""
+func (x *GetLastActivityTimeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *StarlarkPackagePlanYamlArgs) GetMainFunctionName() string {
-	if x != nil && x.MainFunctionName != nil {
-		return *x.MainFunctionName
+func (*GetLastActivityTimeResponse) ProtoMessage() {}
+
+func (x *GetLastActivityTimeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *StarlarkPackagePlanYamlArgs) GetAllowPrivilegedMode() bool {
-	if x != nil && x.AllowPrivilegedMode != nil {
-		return *x.AllowPrivilegedMode
+// Deprecated: Use GetLastActivityTimeResponse.ProtoReflect.Descriptor instead.
+func (*GetLastActivityTimeResponse) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *GetLastActivityTimeResponse) GetLastActivityTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastActivityTime
 	}
-	return false
+	return nil
 }
 
 var File_api_container_service_proto protoreflect.FileDescriptor
 
 const file_api_container_service_proto_rawDesc = "" +
 	"\n" +
-	"\x1bapi_container_service.proto\x12\x11api_container_api\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1egoogle/protobuf/duration.proto\"\xe2\x02\n" +
+	"\x1bapi_container_service.proto\x12\x11api_container_api\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe2\x02\n" +
 	"\x04Port\x12\x16\n" +
 	"\x06number\x18\x01 \x01(\rR\x06number\x12X\n" +
 	"\x12transport_protocol\x18\x02 \x01(\x0e2).api_container_api.Port.TransportProtocolR\x11transportProtocol\x12<\n" +
@@ -4105,7 +5878,7 @@ const file_api_container_service_proto_rawDesc = "" +
 	"\x12_github_auth_tokenB\v\n" +
 	"\t_parallelB\x11\n" +
 	"\x0f_resource_checkB\x18\n" +
-	"\x16_allow_privileged_mode\"\xb6\x04\n" +
+	"\x16_allow_privileged_mode\"\x95\x05\n" +
 	"\x17StarlarkRunResponseLine\x12J\n" +
 	"\vinstruction\x18\x01 \x01(\v2&.api_container_api.StarlarkInstructionH\x00R\vinstruction\x128\n" +
 	"\x05error\x18\x02 \x01(\v2 .api_container_api.StarlarkErrorH\x00R\x05error\x12M\n" +
@@ -4113,8 +5886,14 @@ const file_api_container_service_proto_rawDesc = "" +
 	"\x12instruction_result\x18\x04 \x01(\v2,.api_container_api.StarlarkInstructionResultH\x00R\x11instructionResult\x12[\n" +
 	"\x12run_finished_event\x18\x05 \x01(\v2+.api_container_api.StarlarkRunFinishedEventH\x00R\x10runFinishedEvent\x12>\n" +
 	"\awarning\x18\x06 \x01(\v2\".api_container_api.StarlarkWarningH\x00R\awarning\x125\n" +
-	"\x04info\x18\a \x01(\v2\x1f.api_container_api.StarlarkInfoH\x00R\x04infoB\x13\n" +
-	"\x11run_response_line\"1\n" +
+	"\x04info\x18\a \x01(\v2\x1f.api_container_api.StarlarkInfoH\x00R\x04info\x12]\n" +
+	"\x12instruction_output\x18\b \x01(\v2,.api_container_api.StarlarkInstructionOutputH\x00R\x11instructionOutputB\x13\n" +
+	"\x11run_response_line\"{\n" +
+	"\x19StarlarkInstructionOutput\x12\x1f\n" +
+	"\voutput_line\x18\x01 \x01(\tR\n" +
+	"outputLine\x12*\n" +
+	"\x0einstruction_id\x18\x02 \x01(\tH\x00R\rinstructionId\x88\x01\x01B\x11\n" +
+	"\x0f_instruction_id\"1\n" +
 	"\fStarlarkInfo\x12!\n" +
 	"\finfo_message\x18\x01 \x01(\tR\vinfoMessage\":\n" +
 	"\x0fStarlarkWarning\x12'\n" +
@@ -4190,7 +5969,29 @@ const file_api_container_service_proto_rawDesc = "" +
 	"\x13ExecCommandResponse\x12\x1b\n" +
 	"\texit_code\x18\x01 \x01(\x05R\bexitCode\x12\x1d\n" +
 	"\n" +
-	"log_output\x18\x02 \x01(\tR\tlogOutput\"\xac\x03\n" +
+	"log_output\x18\x02 \x01(\tR\tlogOutput\"\xfa\x01\n" +
+	"\x15StreamExecCommandArgs\x12A\n" +
+	"\x05start\x18\x01 \x01(\v2).api_container_api.StreamExecCommandStartH\x00R\x05start\x12\x16\n" +
+	"\x05stdin\x18\x02 \x01(\fH\x00R\x05stdin\x12!\n" +
+	"\vclose_stdin\x18\x03 \x01(\bH\x00R\n" +
+	"closeStdin\x12F\n" +
+	"\rterminal_size\x18\x04 \x01(\v2\x1f.api_container_api.TerminalSizeH\x00R\fterminalSizeB\x1b\n" +
+	"\x19stream_exec_command_input\"\xfc\x01\n" +
+	"\x16StreamExecCommandStart\x12-\n" +
+	"\x12service_identifier\x18\x01 \x01(\tR\x11serviceIdentifier\x12!\n" +
+	"\fcommand_args\x18\x02 \x03(\tR\vcommandArgs\x12!\n" +
+	"\fattach_stdin\x18\x03 \x01(\bR\vattachStdin\x12\x10\n" +
+	"\x03tty\x18\x04 \x01(\bR\x03tty\x12I\n" +
+	"\rterminal_size\x18\x05 \x01(\v2\x1f.api_container_api.TerminalSizeH\x00R\fterminalSize\x88\x01\x01B\x10\n" +
+	"\x0e_terminal_size\"<\n" +
+	"\fTerminalSize\x12\x14\n" +
+	"\x05width\x18\x01 \x01(\rR\x05width\x12\x16\n" +
+	"\x06height\x18\x02 \x01(\rR\x06height\"\x8c\x01\n" +
+	"\x19StreamExecCommandResponse\x12\x18\n" +
+	"\x06stdout\x18\x01 \x01(\fH\x00R\x06stdout\x12\x18\n" +
+	"\x06stderr\x18\x02 \x01(\fH\x00R\x06stderr\x12\x1d\n" +
+	"\texit_code\x18\x03 \x01(\x05H\x00R\bexitCodeB\x1c\n" +
+	"\x1astream_exec_command_output\"\xac\x03\n" +
 	"&WaitForHttpGetEndpointAvailabilityArgs\x12-\n" +
 	"\x12service_identifier\x18\x01 \x01(\tR\x11serviceIdentifier\x12\x12\n" +
 	"\x04port\x18\x02 \x01(\rR\x04port\x12\x17\n" +
@@ -4231,7 +6032,11 @@ const file_api_container_service_proto_rawDesc = "" +
 	"\x04name\x18\x01 \x01(\tR\x04name\"E\n" +
 	"\x1bUploadFilesArtifactResponse\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\";\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"E\n" +
+	" GetMissingFilesArtifactBlobsArgs\x12!\n" +
+	"\fblob_sha256s\x18\x01 \x03(\tR\vblobSha256s\"X\n" +
+	"$GetMissingFilesArtifactBlobsResponse\x120\n" +
+	"\x14missing_blob_sha256s\x18\x01 \x03(\tR\x12missingBlobSha256s\";\n" +
 	"\x19DownloadFilesArtifactArgs\x12\x1e\n" +
 	"\n" +
 	"identifier\x18\x01 \x01(\tR\n" +
@@ -4261,7 +6066,21 @@ const file_api_container_service_proto_rawDesc = "" +
 	"\x04path\x18\x01 \x01(\tR\x04path\x12\x12\n" +
 	"\x04size\x18\x02 \x01(\x04R\x04size\x12&\n" +
 	"\ftext_preview\x18\x03 \x01(\tH\x00R\vtextPreview\x88\x01\x01B\x0f\n" +
-	"\r_text_preview\"K\n" +
+	"\r_text_preview\"=\n" +
+	"\x1bGetFilesArtifactHistoryArgs\x12\x1e\n" +
+	"\n" +
+	"identifier\x18\x01 \x01(\tR\n" +
+	"identifier\"\x83\x01\n" +
+	"\x1fGetFilesArtifactHistoryResponse\x12\x1b\n" +
+	"\tfile_uuid\x18\x01 \x01(\tR\bfileUuid\x12C\n" +
+	"\bversions\x18\x02 \x03(\v2'.api_container_api.FilesArtifactVersionR\bversions\"\xc3\x01\n" +
+	"\x14FilesArtifactVersion\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\rR\aversion\x12D\n" +
+	"\rcreation_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\fcreationTime\x88\x01\x01\x12\x1a\n" +
+	"\bproducer\x18\x03 \x01(\tR\bproducer\x12\x1d\n" +
+	"\n" +
+	"is_current\x18\x04 \x01(\bR\tisCurrentB\x10\n" +
+	"\x0e_creation_time\"K\n" +
 	"\x13ConnectServicesArgs\x124\n" +
 	"\aconnect\x18\x01 \x01(\x0e2\x1a.api_container_api.ConnectR\aconnect\"\x19\n" +
 	"\x17ConnectServicesResponse\"\xa2\x04\n" +
@@ -4276,7 +6095,28 @@ const file_api_container_service_proto_rawDesc = "" +
 	"\x15experimental_features\x18\a \x03(\x0e2&.api_container_api.KurtosisFeatureFlagR\x14experimentalFeatures\x12G\n" +
 	"\x0erestart_policy\x18\b \x01(\x0e2 .api_container_api.RestartPolicyR\rrestartPolicy\x12?\n" +
 	"\x19initial_serialized_params\x18\t \x01(\tH\x00R\x17initialSerializedParams\x88\x01\x01B\x1c\n" +
-	"\x1a_initial_serialized_params\"'\n" +
+	"\x1a_initial_serialized_params\"\xec\x04\n" +
+	"\x11StarlarkRunRecord\x12\x19\n" +
+	"\brun_uuid\x18\x01 \x01(\tR\arunUuid\x12\x1d\n" +
+	"\n" +
+	"package_id\x18\x02 \x01(\tR\tpackageId\x128\n" +
+	"\x16package_git_commit_sha\x18\x03 \x01(\tH\x00R\x13packageGitCommitSha\x88\x01\x01\x12+\n" +
+	"\x11serialized_params\x18\x04 \x01(\tR\x10serializedParams\x12:\n" +
+	"\x1arelative_path_to_main_file\x18\x05 \x01(\tR\x16relativePathToMainFile\x12,\n" +
+	"\x12main_function_name\x18\x06 \x01(\tR\x10mainFunctionName\x129\n" +
+	"\n" +
+	"start_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12<\n" +
+	"\x06status\x18\t \x01(\x0e2$.api_container_api.StarlarkRunStatusR\x06status\x12\x16\n" +
+	"\x06errors\x18\n" +
+	" \x03(\tR\x06errors\x123\n" +
+	"\x16number_of_output_lines\x18\f \x01(\rR\x13numberOfOutputLines\x12.\n" +
+	"\x13is_output_truncated\x18\r \x01(\bR\x11isOutputTruncatedB\x19\n" +
+	"\x17_package_git_commit_shaJ\x04\b\v\x10\f\"x\n" +
+	"\x1eListStarlarkRunRecordsResponse\x12V\n" +
+	"\x14starlark_run_records\x18\x01 \x03(\v2$.api_container_api.StarlarkRunRecordR\x12starlarkRunRecords\"5\n" +
+	"\x18GetStarlarkRunRecordArgs\x12\x19\n" +
+	"\brun_uuid\x18\x01 \x01(\tR\arunUuid\"'\n" +
 	"\bPlanYaml\x12\x1b\n" +
 	"\tplan_yaml\x18\x01 \x01(\tR\bplanYaml\"\xae\x02\n" +
 	"\x1aStarlarkScriptPlanYamlArgs\x12+\n" +
@@ -4298,7 +6138,63 @@ const file_api_container_service_proto_rawDesc = "" +
 	"\x12_serialized_paramsB\x1d\n" +
 	"\x1b_relative_path_to_main_fileB\x15\n" +
 	"\x13_main_function_nameB\x18\n" +
-	"\x16_allow_privileged_mode*6\n" +
+	"\x16_allow_privileged_mode\"9\n" +
+	"\rSetSecretArgs\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\"8\n" +
+	"\x13ListSecretsResponse\x12!\n" +
+	"\fsecret_names\x18\x01 \x03(\tR\vsecretNames\"&\n" +
+	"\x10RemoveSecretArgs\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"L\n" +
+	"\x16ResumeServicesResponse\x122\n" +
+	"\x15resumed_service_names\x18\x01 \x03(\tR\x13resumedServiceNames\"E\n" +
+	"$GetSecretsRecipientPublicKeyResponse\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x01 \x01(\fR\tpublicKey\"~\n" +
+	"\x15ExportEnclavePlanArgs\x12D\n" +
+	"\x1csecrets_recipient_public_key\x18\x01 \x01(\fH\x00R\x19secretsRecipientPublicKey\x88\x01\x01B\x1f\n" +
+	"\x1d_secrets_recipient_public_key\"\xe6\x01\n" +
+	"\x19ExportEnclavePlanResponse\x12G\n" +
+	" serialized_exported_enclave_plan\x18\x01 \x01(\fR\x1dserializedExportedEnclavePlan\x12A\n" +
+	"\x1dreplayed_files_artifact_names\x18\x02 \x03(\tR\x1areplayedFilesArtifactNames\x12*\n" +
+	"\x0esealed_secrets\x18\x03 \x01(\fH\x00R\rsealedSecrets\x88\x01\x01B\x11\n" +
+	"\x0f_sealed_secrets\"\x9f\x01\n" +
+	"\x15ReplayEnclavePlanArgs\x12G\n" +
+	" serialized_exported_enclave_plan\x18\x01 \x01(\fR\x1dserializedExportedEnclavePlan\x12*\n" +
+	"\x0esealed_secrets\x18\x02 \x01(\fH\x00R\rsealedSecrets\x88\x01\x01B\x11\n" +
+	"\x0f_sealed_secrets\"\xa4\x04\n" +
+	"\x11ApiContainerEvent\x12<\n" +
+	"\x04type\x18\x01 \x01(\x0e2(.api_container_api.ApiContainerEventTypeR\x04type\x128\n" +
+	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12&\n" +
+	"\fservice_name\x18\x03 \x01(\tH\x00R\vserviceName\x88\x01\x01\x12&\n" +
+	"\fservice_uuid\x18\x04 \x01(\tH\x01R\vserviceUuid\x88\x01\x01\x123\n" +
+	"\x13files_artifact_name\x18\x05 \x01(\tH\x02R\x11filesArtifactName\x88\x01\x01\x123\n" +
+	"\x13files_artifact_uuid\x18\x06 \x01(\tH\x03R\x11filesArtifactUuid\x88\x01\x01\x12/\n" +
+	"\x11is_run_successful\x18\a \x01(\bH\x04R\x0fisRunSuccessful\x88\x01\x01\x12\x1b\n" +
+	"\tstream_id\x18\b \x01(\tR\bstreamId\x12'\n" +
+	"\x0fsequence_number\x18\t \x01(\x04R\x0esequenceNumberB\x0f\n" +
+	"\r_service_nameB\x0f\n" +
+	"\r_service_uuidB\x16\n" +
+	"\x14_files_artifact_nameB\x16\n" +
+	"\x14_files_artifact_uuidB\x14\n" +
+	"\x12_is_run_successful\"\xa5\x01\n" +
+	"\x0fWatchEventsArgs\x12+\n" +
+	"\x0fafter_stream_id\x18\x01 \x01(\tH\x00R\rafterStreamId\x88\x01\x01\x127\n" +
+	"\x15after_sequence_number\x18\x02 \x01(\x04H\x01R\x13afterSequenceNumber\x88\x01\x01B\x12\n" +
+	"\x10_after_stream_idB\x18\n" +
+	"\x16_after_sequence_number\":\n" +
+	"\x13ServiceDependencies\x12#\n" +
+	"\rservice_names\x18\x01 \x03(\tR\fserviceNames\"\xaa\x02\n" +
+	"\x1eGetServiceDependenciesResponse\x12\x91\x01\n" +
+	"\x1cdependencies_by_service_name\x18\x01 \x03(\v2P.api_container_api.GetServiceDependenciesResponse.DependenciesByServiceNameEntryR\x19dependenciesByServiceName\x1at\n" +
+	"\x1eDependenciesByServiceNameEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12<\n" +
+	"\x05value\x18\x02 \x01(\v2&.api_container_api.ServiceDependenciesR\x05value:\x028\x01\"@\n" +
+	"\x1bWaitForServiceReadinessArgs\x12!\n" +
+	"\fservice_name\x18\x01 \x01(\tR\vserviceName\"\x83\x01\n" +
+	"\x1bGetLastActivityTimeResponse\x12M\n" +
+	"\x12last_activity_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x10lastActivityTime\x88\x01\x01B\x15\n" +
+	"\x13_last_activity_time*6\n" +
 	"\rServiceStatus\x12\v\n" +
 	"\aSTOPPED\x10\x00\x12\v\n" +
 	"\aRUNNING\x10\x01\x12\v\n" +
@@ -4316,26 +6212,57 @@ const file_api_container_service_proto_rawDesc = "" +
 	"\rRestartPolicy\x12\t\n" +
 	"\x05NEVER\x10\x00\x12\n" +
 	"\n" +
-	"\x06ALWAYS\x10\x012\xa6\x10\n" +
+	"\x06ALWAYS\x10\x01*\x88\x01\n" +
+	"\x11StarlarkRunStatus\x12\x1c\n" +
+	"\x18STARLARK_RUN_IN_PROGRESS\x10\x00\x12\x1a\n" +
+	"\x16STARLARK_RUN_SUCCEEDED\x10\x01\x12\x17\n" +
+	"\x13STARLARK_RUN_FAILED\x10\x02\x12 \n" +
+	"\x1cSTARLARK_RUN_OUTCOME_UNKNOWN\x10\x03*\xba\x01\n" +
+	"\x15ApiContainerEventType\x12\x18\n" +
+	"\x14STARLARK_RUN_STARTED\x10\x00\x12\x19\n" +
+	"\x15STARLARK_RUN_FINISHED\x10\x01\x12\x1a\n" +
+	"\x16FILES_ARTIFACT_CREATED\x10\x02\x12\x11\n" +
+	"\rSERVICE_ADDED\x10\x03\x12\x13\n" +
+	"\x0fSERVICE_STARTED\x10\x04\x12\x13\n" +
+	"\x0fSERVICE_STOPPED\x10\x05\x12\x13\n" +
+	"\x0fSERVICE_CRASHED\x10\x062\xe1\x1e\n" +
 	"\x13ApiContainerService\x12m\n" +
 	"\x11RunStarlarkScript\x12(.api_container_api.RunStarlarkScriptArgs\x1a*.api_container_api.StarlarkRunResponseLine\"\x000\x01\x12Y\n" +
 	"\x15UploadStarlarkPackage\x12$.api_container_api.StreamedDataChunk\x1a\x16.google.protobuf.Empty\"\x00(\x01\x12o\n" +
 	"\x12RunStarlarkPackage\x12).api_container_api.RunStarlarkPackageArgs\x1a*.api_container_api.StarlarkRunResponseLine\"\x000\x01\x12[\n" +
 	"\vGetServices\x12\".api_container_api.GetServicesArgs\x1a&.api_container_api.GetServicesResponse\"\x00\x12\x8d\x01\n" +
 	"*GetExistingAndHistoricalServiceIdentifiers\x12\x16.google.protobuf.Empty\x1aE.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse\"\x00\x12[\n" +
-	"\vExecCommand\x12\".api_container_api.ExecCommandArgs\x1a&.api_container_api.ExecCommandResponse\"\x00\x12y\n" +
+	"\vExecCommand\x12\".api_container_api.ExecCommandArgs\x1a&.api_container_api.ExecCommandResponse\"\x00\x12q\n" +
+	"\x11StreamExecCommand\x12(.api_container_api.StreamExecCommandArgs\x1a,.api_container_api.StreamExecCommandResponse\"\x00(\x010\x01\x12y\n" +
 	"\"WaitForHttpGetEndpointAvailability\x129.api_container_api.WaitForHttpGetEndpointAvailabilityArgs\x1a\x16.google.protobuf.Empty\"\x00\x12{\n" +
 	"#WaitForHttpPostEndpointAvailability\x12:.api_container_api.WaitForHttpPostEndpointAvailabilityArgs\x1a\x16.google.protobuf.Empty\"\x00\x12o\n" +
-	"\x13UploadFilesArtifact\x12$.api_container_api.StreamedDataChunk\x1a..api_container_api.UploadFilesArtifactResponse\"\x00(\x01\x12o\n" +
+	"\x13UploadFilesArtifact\x12$.api_container_api.StreamedDataChunk\x1a..api_container_api.UploadFilesArtifactResponse\"\x00(\x01\x12\x8e\x01\n" +
+	"\x1cGetMissingFilesArtifactBlobs\x123.api_container_api.GetMissingFilesArtifactBlobsArgs\x1a7.api_container_api.GetMissingFilesArtifactBlobsResponse\"\x00\x12o\n" +
 	"\x15DownloadFilesArtifact\x12,.api_container_api.DownloadFilesArtifactArgs\x1a$.api_container_api.StreamedDataChunk\"\x000\x01\x12y\n" +
 	"\x15StoreWebFilesArtifact\x12,.api_container_api.StoreWebFilesArtifactArgs\x1a0.api_container_api.StoreWebFilesArtifactResponse\"\x00\x12\x91\x01\n" +
 	"\x1dStoreFilesArtifactFromService\x124.api_container_api.StoreFilesArtifactFromServiceArgs\x1a8.api_container_api.StoreFilesArtifactFromServiceResponse\"\x00\x12u\n" +
 	"\x1eListFilesArtifactNamesAndUuids\x12\x16.google.protobuf.Empty\x1a9.api_container_api.ListFilesArtifactNamesAndUuidsResponse\"\x00\x12\x91\x01\n" +
-	"\x1cInspectFilesArtifactContents\x126.api_container_api.InspectFilesArtifactContentsRequest\x1a7.api_container_api.InspectFilesArtifactContentsResponse\"\x00\x12g\n" +
+	"\x1cInspectFilesArtifactContents\x126.api_container_api.InspectFilesArtifactContentsRequest\x1a7.api_container_api.InspectFilesArtifactContentsResponse\"\x00\x12\x7f\n" +
+	"\x17GetFilesArtifactHistory\x12..api_container_api.GetFilesArtifactHistoryArgs\x1a2.api_container_api.GetFilesArtifactHistoryResponse\"\x00\x12g\n" +
 	"\x0fConnectServices\x12&.api_container_api.ConnectServicesArgs\x1a*.api_container_api.ConnectServicesResponse\"\x00\x12U\n" +
-	"\x0eGetStarlarkRun\x12\x16.google.protobuf.Empty\x1a).api_container_api.GetStarlarkRunResponse\"\x00\x12i\n" +
+	"\x0eGetStarlarkRun\x12\x16.google.protobuf.Empty\x1a).api_container_api.GetStarlarkRunResponse\"\x00\x12e\n" +
+	"\x16ListStarlarkRunRecords\x12\x16.google.protobuf.Empty\x1a1.api_container_api.ListStarlarkRunRecordsResponse\"\x00\x12k\n" +
+	"\x14GetStarlarkRunRecord\x12+.api_container_api.GetStarlarkRunRecordArgs\x1a$.api_container_api.StarlarkRunRecord\"\x00\x12y\n" +
+	"\x1aGetStarlarkRunRecordOutput\x12+.api_container_api.GetStarlarkRunRecordArgs\x1a*.api_container_api.StarlarkRunResponseLine\"\x000\x01\x12i\n" +
 	"\x19GetStarlarkScriptPlanYaml\x12-.api_container_api.StarlarkScriptPlanYamlArgs\x1a\x1b.api_container_api.PlanYaml\"\x00\x12k\n" +
-	"\x1aGetStarlarkPackagePlanYaml\x12..api_container_api.StarlarkPackagePlanYamlArgs\x1a\x1b.api_container_api.PlanYaml\"\x00BRZPgithub.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindingsb\x06proto3"
+	"\x1aGetStarlarkPackagePlanYaml\x12..api_container_api.StarlarkPackagePlanYamlArgs\x1a\x1b.api_container_api.PlanYaml\"\x00\x12G\n" +
+	"\tSetSecret\x12 .api_container_api.SetSecretArgs\x1a\x16.google.protobuf.Empty\"\x00\x12O\n" +
+	"\vListSecrets\x12\x16.google.protobuf.Empty\x1a&.api_container_api.ListSecretsResponse\"\x00\x12M\n" +
+	"\fRemoveSecret\x12#.api_container_api.RemoveSecretArgs\x1a\x16.google.protobuf.Empty\"\x00\x12U\n" +
+	"\x0eResumeServices\x12\x16.google.protobuf.Empty\x1a).api_container_api.ResumeServicesResponse\"\x00\x12J\n" +
+	"\x16RecordServicesToResume\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\"\x00\x12q\n" +
+	"\x1cGetSecretsRecipientPublicKey\x12\x16.google.protobuf.Empty\x1a7.api_container_api.GetSecretsRecipientPublicKeyResponse\"\x00\x12m\n" +
+	"\x11ExportEnclavePlan\x12(.api_container_api.ExportEnclavePlanArgs\x1a,.api_container_api.ExportEnclavePlanResponse\"\x00\x12m\n" +
+	"\x11ReplayEnclavePlan\x12(.api_container_api.ReplayEnclavePlanArgs\x1a*.api_container_api.StarlarkRunResponseLine\"\x000\x01\x12[\n" +
+	"\vWatchEvents\x12\".api_container_api.WatchEventsArgs\x1a$.api_container_api.ApiContainerEvent\"\x000\x01\x12e\n" +
+	"\x16GetServiceDependencies\x12\x16.google.protobuf.Empty\x1a1.api_container_api.GetServiceDependenciesResponse\"\x00\x12c\n" +
+	"\x17WaitForServiceReadiness\x12..api_container_api.WaitForServiceReadinessArgs\x1a\x16.google.protobuf.Empty\"\x00\x12_\n" +
+	"\x13GetLastActivityTime\x12\x16.google.protobuf.Empty\x1a..api_container_api.GetLastActivityTimeResponse\"\x00BRZPgithub.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindingsb\x06proto3"
 
 var (
 	file_api_container_service_proto_rawDescOnce sync.Once
@@ -4349,167 +6276,249 @@ func file_api_container_service_proto_rawDescGZIP() []byte {
 	return file_api_container_service_proto_rawDescData
 }
 
-var file_api_container_service_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
-var file_api_container_service_proto_msgTypes = make([]protoimpl.MessageInfo, 59)
+var file_api_container_service_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
+var file_api_container_service_proto_msgTypes = make([]protoimpl.MessageInfo, 87)
 var file_api_container_service_proto_goTypes = []any{
-	(ServiceStatus)(0),                  // 0: api_container_api.ServiceStatus
-	(ImageDownloadMode)(0),              // 1: api_container_api.ImageDownloadMode
-	(Connect)(0),                        // 2: api_container_api.Connect
-	(KurtosisFeatureFlag)(0),            // 3: api_container_api.KurtosisFeatureFlag
-	(RestartPolicy)(0),                  // 4: api_container_api.RestartPolicy
-	(Port_TransportProtocol)(0),         // 5: api_container_api.Port.TransportProtocol
-	(Container_Status)(0),               // 6: api_container_api.Container.Status
-	(*Port)(nil),                        // 7: api_container_api.Port
-	(*Container)(nil),                   // 8: api_container_api.Container
-	(*FilesArtifactsList)(nil),          // 9: api_container_api.FilesArtifactsList
-	(*User)(nil),                        // 10: api_container_api.User
-	(*Toleration)(nil),                  // 11: api_container_api.Toleration
-	(*ServiceInfo)(nil),                 // 12: api_container_api.ServiceInfo
-	(*GpuConfig)(nil),                   // 13: api_container_api.GpuConfig
-	(*RunStarlarkScriptArgs)(nil),       // 14: api_container_api.RunStarlarkScriptArgs
-	(*RunStarlarkPackageArgs)(nil),      // 15: api_container_api.RunStarlarkPackageArgs
-	(*StarlarkRunResponseLine)(nil),     // 16: api_container_api.StarlarkRunResponseLine
-	(*StarlarkInfo)(nil),                // 17: api_container_api.StarlarkInfo
-	(*StarlarkWarning)(nil),             // 18: api_container_api.StarlarkWarning
-	(*StarlarkInstruction)(nil),         // 19: api_container_api.StarlarkInstruction
-	(*StarlarkInstructionResult)(nil),   // 20: api_container_api.StarlarkInstructionResult
-	(*StarlarkInstructionArg)(nil),      // 21: api_container_api.StarlarkInstructionArg
-	(*StarlarkInstructionPosition)(nil), // 22: api_container_api.StarlarkInstructionPosition
-	(*StarlarkError)(nil),               // 23: api_container_api.StarlarkError
-	(*StarlarkInterpretationError)(nil), // 24: api_container_api.StarlarkInterpretationError
-	(*StarlarkValidationError)(nil),     // 25: api_container_api.StarlarkValidationError
-	(*StarlarkExecutionError)(nil),      // 26: api_container_api.StarlarkExecutionError
-	(*StarlarkRunProgress)(nil),         // 27: api_container_api.StarlarkRunProgress
-	(*StarlarkRunFinishedEvent)(nil),    // 28: api_container_api.StarlarkRunFinishedEvent
-	(*GetServicesArgs)(nil),             // 29: api_container_api.GetServicesArgs
-	(*GetServicesResponse)(nil),         // 30: api_container_api.GetServicesResponse
-	(*ServiceIdentifiers)(nil),          // 31: api_container_api.ServiceIdentifiers
-	(*GetExistingAndHistoricalServiceIdentifiersResponse)(nil), // 32: api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse
-	(*ExecCommandArgs)(nil),                         // 33: api_container_api.ExecCommandArgs
-	(*ExecCommandResponse)(nil),                     // 34: api_container_api.ExecCommandResponse
-	(*WaitForHttpGetEndpointAvailabilityArgs)(nil),  // 35: api_container_api.WaitForHttpGetEndpointAvailabilityArgs
-	(*WaitForHttpPostEndpointAvailabilityArgs)(nil), // 36: api_container_api.WaitForHttpPostEndpointAvailabilityArgs
-	(*StreamedDataChunk)(nil),                       // 37: api_container_api.StreamedDataChunk
-	(*DataChunkMetadata)(nil),                       // 38: api_container_api.DataChunkMetadata
-	(*UploadFilesArtifactResponse)(nil),             // 39: api_container_api.UploadFilesArtifactResponse
-	(*DownloadFilesArtifactArgs)(nil),               // 40: api_container_api.DownloadFilesArtifactArgs
-	(*StoreWebFilesArtifactArgs)(nil),               // 41: api_container_api.StoreWebFilesArtifactArgs
-	(*StoreWebFilesArtifactResponse)(nil),           // 42: api_container_api.StoreWebFilesArtifactResponse
-	(*StoreFilesArtifactFromServiceArgs)(nil),       // 43: api_container_api.StoreFilesArtifactFromServiceArgs
-	(*StoreFilesArtifactFromServiceResponse)(nil),   // 44: api_container_api.StoreFilesArtifactFromServiceResponse
-	(*FilesArtifactNameAndUuid)(nil),                // 45: api_container_api.FilesArtifactNameAndUuid
-	(*ListFilesArtifactNamesAndUuidsResponse)(nil),  // 46: api_container_api.ListFilesArtifactNamesAndUuidsResponse
-	(*InspectFilesArtifactContentsRequest)(nil),     // 47: api_container_api.InspectFilesArtifactContentsRequest
-	(*InspectFilesArtifactContentsResponse)(nil),    // 48: api_container_api.InspectFilesArtifactContentsResponse
-	(*FileArtifactContentsFileDescription)(nil),     // 49: api_container_api.FileArtifactContentsFileDescription
-	(*ConnectServicesArgs)(nil),                     // 50: api_container_api.ConnectServicesArgs
-	(*ConnectServicesResponse)(nil),                 // 51: api_container_api.ConnectServicesResponse
-	(*GetStarlarkRunResponse)(nil),                  // 52: api_container_api.GetStarlarkRunResponse
-	(*PlanYaml)(nil),                                // 53: api_container_api.PlanYaml
-	(*StarlarkScriptPlanYamlArgs)(nil),              // 54: api_container_api.StarlarkScriptPlanYamlArgs
-	(*StarlarkPackagePlanYamlArgs)(nil),             // 55: api_container_api.StarlarkPackagePlanYamlArgs
-	nil,                                             // 56: api_container_api.Container.EnvVarsEntry
-	nil,                                             // 57: api_container_api.ServiceInfo.PrivatePortsEntry
-	nil,                                             // 58: api_container_api.ServiceInfo.MaybePublicPortsEntry
-	nil,                                             // 59: api_container_api.ServiceInfo.ServiceDirPathsToFilesArtifactsListEntry
-	nil,                                             // 60: api_container_api.ServiceInfo.NodeSelectorsEntry
-	nil,                                             // 61: api_container_api.ServiceInfo.LabelsEntry
-	nil,                                             // 62: api_container_api.ServiceInfo.BindMountsEntry
-	nil,                                             // 63: api_container_api.GpuConfig.UlimitsEntry
-	nil,                                             // 64: api_container_api.GetServicesArgs.ServiceIdentifiersEntry
-	nil,                                             // 65: api_container_api.GetServicesResponse.ServiceInfoEntry
-	(*durationpb.Duration)(nil),                     // 66: google.protobuf.Duration
-	(*emptypb.Empty)(nil),                           // 67: google.protobuf.Empty
+	(ServiceStatus)(0),                                         // 0: api_container_api.ServiceStatus
+	(ImageDownloadMode)(0),                                     // 1: api_container_api.ImageDownloadMode
+	(Connect)(0),                                               // 2: api_container_api.Connect
+	(KurtosisFeatureFlag)(0),                                   // 3: api_container_api.KurtosisFeatureFlag
+	(RestartPolicy)(0),                                         // 4: api_container_api.RestartPolicy
+	(StarlarkRunStatus)(0),                                     // 5: api_container_api.StarlarkRunStatus
+	(ApiContainerEventType)(0),                                 // 6: api_container_api.ApiContainerEventType
+	(Port_TransportProtocol)(0),                                // 7: api_container_api.Port.TransportProtocol
+	(Container_Status)(0),                                      // 8: api_container_api.Container.Status
+	(*Port)(nil),                                               // 9: api_container_api.Port
+	(*Container)(nil),                                          // 10: api_container_api.Container
+	(*FilesArtifactsList)(nil),                                 // 11: api_container_api.FilesArtifactsList
+	(*User)(nil),                                               // 12: api_container_api.User
+	(*Toleration)(nil),                                         // 13: api_container_api.Toleration
+	(*ServiceInfo)(nil),                                        // 14: api_container_api.ServiceInfo
+	(*GpuConfig)(nil),                                          // 15: api_container_api.GpuConfig
+	(*RunStarlarkScriptArgs)(nil),                              // 16: api_container_api.RunStarlarkScriptArgs
+	(*RunStarlarkPackageArgs)(nil),                             // 17: api_container_api.RunStarlarkPackageArgs
+	(*StarlarkRunResponseLine)(nil),                            // 18: api_container_api.StarlarkRunResponseLine
+	(*StarlarkInstructionOutput)(nil),                          // 19: api_container_api.StarlarkInstructionOutput
+	(*StarlarkInfo)(nil),                                       // 20: api_container_api.StarlarkInfo
+	(*StarlarkWarning)(nil),                                    // 21: api_container_api.StarlarkWarning
+	(*StarlarkInstruction)(nil),                                // 22: api_container_api.StarlarkInstruction
+	(*StarlarkInstructionResult)(nil),                          // 23: api_container_api.StarlarkInstructionResult
+	(*StarlarkInstructionArg)(nil),                             // 24: api_container_api.StarlarkInstructionArg
+	(*StarlarkInstructionPosition)(nil),                        // 25: api_container_api.StarlarkInstructionPosition
+	(*StarlarkError)(nil),                                      // 26: api_container_api.StarlarkError
+	(*StarlarkInterpretationError)(nil),                        // 27: api_container_api.StarlarkInterpretationError
+	(*StarlarkValidationError)(nil),                            // 28: api_container_api.StarlarkValidationError
+	(*StarlarkExecutionError)(nil),                             // 29: api_container_api.StarlarkExecutionError
+	(*StarlarkRunProgress)(nil),                                // 30: api_container_api.StarlarkRunProgress
+	(*StarlarkRunFinishedEvent)(nil),                           // 31: api_container_api.StarlarkRunFinishedEvent
+	(*GetServicesArgs)(nil),                                    // 32: api_container_api.GetServicesArgs
+	(*GetServicesResponse)(nil),                                // 33: api_container_api.GetServicesResponse
+	(*ServiceIdentifiers)(nil),                                 // 34: api_container_api.ServiceIdentifiers
+	(*GetExistingAndHistoricalServiceIdentifiersResponse)(nil), // 35: api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse
+	(*ExecCommandArgs)(nil),                                    // 36: api_container_api.ExecCommandArgs
+	(*ExecCommandResponse)(nil),                                // 37: api_container_api.ExecCommandResponse
+	(*StreamExecCommandArgs)(nil),                              // 38: api_container_api.StreamExecCommandArgs
+	(*StreamExecCommandStart)(nil),                             // 39: api_container_api.StreamExecCommandStart
+	(*TerminalSize)(nil),                                       // 40: api_container_api.TerminalSize
+	(*StreamExecCommandResponse)(nil),                          // 41: api_container_api.StreamExecCommandResponse
+	(*WaitForHttpGetEndpointAvailabilityArgs)(nil),             // 42: api_container_api.WaitForHttpGetEndpointAvailabilityArgs
+	(*WaitForHttpPostEndpointAvailabilityArgs)(nil),            // 43: api_container_api.WaitForHttpPostEndpointAvailabilityArgs
+	(*StreamedDataChunk)(nil),                                  // 44: api_container_api.StreamedDataChunk
+	(*DataChunkMetadata)(nil),                                  // 45: api_container_api.DataChunkMetadata
+	(*UploadFilesArtifactResponse)(nil),                        // 46: api_container_api.UploadFilesArtifactResponse
+	(*GetMissingFilesArtifactBlobsArgs)(nil),                   // 47: api_container_api.GetMissingFilesArtifactBlobsArgs
+	(*GetMissingFilesArtifactBlobsResponse)(nil),               // 48: api_container_api.GetMissingFilesArtifactBlobsResponse
+	(*DownloadFilesArtifactArgs)(nil),                          // 49: api_container_api.DownloadFilesArtifactArgs
+	(*StoreWebFilesArtifactArgs)(nil),                          // 50: api_container_api.StoreWebFilesArtifactArgs
+	(*StoreWebFilesArtifactResponse)(nil),                      // 51: api_container_api.StoreWebFilesArtifactResponse
+	(*StoreFilesArtifactFromServiceArgs)(nil),                  // 52: api_container_api.StoreFilesArtifactFromServiceArgs
+	(*StoreFilesArtifactFromServiceResponse)(nil),              // 53: api_container_api.StoreFilesArtifactFromServiceResponse
+	(*FilesArtifactNameAndUuid)(nil),                           // 54: api_container_api.FilesArtifactNameAndUuid
+	(*ListFilesArtifactNamesAndUuidsResponse)(nil),             // 55: api_container_api.ListFilesArtifactNamesAndUuidsResponse
+	(*InspectFilesArtifactContentsRequest)(nil),                // 56: api_container_api.InspectFilesArtifactContentsRequest
+	(*InspectFilesArtifactContentsResponse)(nil),               // 57: api_container_api.InspectFilesArtifactContentsResponse
+	(*FileArtifactContentsFileDescription)(nil),                // 58: api_container_api.FileArtifactContentsFileDescription
+	(*GetFilesArtifactHistoryArgs)(nil),                        // 59: api_container_api.GetFilesArtifactHistoryArgs
+	(*GetFilesArtifactHistoryResponse)(nil),                    // 60: api_container_api.GetFilesArtifactHistoryResponse
+	(*FilesArtifactVersion)(nil),                               // 61: api_container_api.FilesArtifactVersion
+	(*ConnectServicesArgs)(nil),                                // 62: api_container_api.ConnectServicesArgs
+	(*ConnectServicesResponse)(nil),                            // 63: api_container_api.ConnectServicesResponse
+	(*GetStarlarkRunResponse)(nil),                             // 64: api_container_api.GetStarlarkRunResponse
+	(*StarlarkRunRecord)(nil),                                  // 65: api_container_api.StarlarkRunRecord
+	(*ListStarlarkRunRecordsResponse)(nil),                     // 66: api_container_api.ListStarlarkRunRecordsResponse
+	(*GetStarlarkRunRecordArgs)(nil),                           // 67: api_container_api.GetStarlarkRunRecordArgs
+	(*PlanYaml)(nil),                                           // 68: api_container_api.PlanYaml
+	(*StarlarkScriptPlanYamlArgs)(nil),                         // 69: api_container_api.StarlarkScriptPlanYamlArgs
+	(*StarlarkPackagePlanYamlArgs)(nil),                        // 70: api_container_api.StarlarkPackagePlanYamlArgs
+	(*SetSecretArgs)(nil),                                      // 71: api_container_api.SetSecretArgs
+	(*ListSecretsResponse)(nil),                                // 72: api_container_api.ListSecretsResponse
+	(*RemoveSecretArgs)(nil),                                   // 73: api_container_api.RemoveSecretArgs
+	(*ResumeServicesResponse)(nil),                             // 74: api_container_api.ResumeServicesResponse
+	(*GetSecretsRecipientPublicKeyResponse)(nil),               // 75: api_container_api.GetSecretsRecipientPublicKeyResponse
+	(*ExportEnclavePlanArgs)(nil),                              // 76: api_container_api.ExportEnclavePlanArgs
+	(*ExportEnclavePlanResponse)(nil),                          // 77: api_container_api.ExportEnclavePlanResponse
+	(*ReplayEnclavePlanArgs)(nil),                              // 78: api_container_api.ReplayEnclavePlanArgs
+	(*ApiContainerEvent)(nil),                                  // 79: api_container_api.ApiContainerEvent
+	(*WatchEventsArgs)(nil),                                    // 80: api_container_api.WatchEventsArgs
+	(*ServiceDependencies)(nil),                                // 81: api_container_api.ServiceDependencies
+	(*GetServiceDependenciesResponse)(nil),                     // 82: api_container_api.GetServiceDependenciesResponse
+	(*WaitForServiceReadinessArgs)(nil),                        // 83: api_container_api.WaitForServiceReadinessArgs
+	(*GetLastActivityTimeResponse)(nil),                        // 84: api_container_api.GetLastActivityTimeResponse
+	nil,                                                        // 85: api_container_api.Container.EnvVarsEntry
+	nil,                                                        // 86: api_container_api.ServiceInfo.PrivatePortsEntry
+	nil,                                                        // 87: api_container_api.ServiceInfo.MaybePublicPortsEntry
+	nil,                                                        // 88: api_container_api.ServiceInfo.ServiceDirPathsToFilesArtifactsListEntry
+	nil,                                                        // 89: api_container_api.ServiceInfo.NodeSelectorsEntry
+	nil,                                                        // 90: api_container_api.ServiceInfo.LabelsEntry
+	nil,                                                        // 91: api_container_api.ServiceInfo.BindMountsEntry
+	nil,                                                        // 92: api_container_api.GpuConfig.UlimitsEntry
+	nil,                                                        // 93: api_container_api.GetServicesArgs.ServiceIdentifiersEntry
+	nil,                                                        // 94: api_container_api.GetServicesResponse.ServiceInfoEntry
+	nil,                                                        // 95: api_container_api.GetServiceDependenciesResponse.DependenciesByServiceNameEntry
+	(*durationpb.Duration)(nil),                                // 96: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),                              // 97: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                                      // 98: google.protobuf.Empty
 }
 var file_api_container_service_proto_depIdxs = []int32{
-	5,  // 0: api_container_api.Port.transport_protocol:type_name -> api_container_api.Port.TransportProtocol
-	6,  // 1: api_container_api.Container.status:type_name -> api_container_api.Container.Status
-	56, // 2: api_container_api.Container.env_vars:type_name -> api_container_api.Container.EnvVarsEntry
-	57, // 3: api_container_api.ServiceInfo.private_ports:type_name -> api_container_api.ServiceInfo.PrivatePortsEntry
-	58, // 4: api_container_api.ServiceInfo.maybe_public_ports:type_name -> api_container_api.ServiceInfo.MaybePublicPortsEntry
+	7,  // 0: api_container_api.Port.transport_protocol:type_name -> api_container_api.Port.TransportProtocol
+	8,  // 1: api_container_api.Container.status:type_name -> api_container_api.Container.Status
+	85, // 2: api_container_api.Container.env_vars:type_name -> api_container_api.Container.EnvVarsEntry
+	86, // 3: api_container_api.ServiceInfo.private_ports:type_name -> api_container_api.ServiceInfo.PrivatePortsEntry
+	87, // 4: api_container_api.ServiceInfo.maybe_public_ports:type_name -> api_container_api.ServiceInfo.MaybePublicPortsEntry
 	0,  // 5: api_container_api.ServiceInfo.service_status:type_name -> api_container_api.ServiceStatus
-	8,  // 6: api_container_api.ServiceInfo.container:type_name -> api_container_api.Container
-	59, // 7: api_container_api.ServiceInfo.service_dir_paths_to_files_artifacts_list:type_name -> api_container_api.ServiceInfo.ServiceDirPathsToFilesArtifactsListEntry
-	10, // 8: api_container_api.ServiceInfo.user:type_name -> api_container_api.User
-	11, // 9: api_container_api.ServiceInfo.tolerations:type_name -> api_container_api.Toleration
-	60, // 10: api_container_api.ServiceInfo.node_selectors:type_name -> api_container_api.ServiceInfo.NodeSelectorsEntry
-	61, // 11: api_container_api.ServiceInfo.labels:type_name -> api_container_api.ServiceInfo.LabelsEntry
-	13, // 12: api_container_api.ServiceInfo.gpu_config:type_name -> api_container_api.GpuConfig
-	62, // 13: api_container_api.ServiceInfo.bind_mounts:type_name -> api_container_api.ServiceInfo.BindMountsEntry
-	63, // 14: api_container_api.GpuConfig.ulimits:type_name -> api_container_api.GpuConfig.UlimitsEntry
+	10, // 6: api_container_api.ServiceInfo.container:type_name -> api_container_api.Container
+	88, // 7: api_container_api.ServiceInfo.service_dir_paths_to_files_artifacts_list:type_name -> api_container_api.ServiceInfo.ServiceDirPathsToFilesArtifactsListEntry
+	12, // 8: api_container_api.ServiceInfo.user:type_name -> api_container_api.User
+	13, // 9: api_container_api.ServiceInfo.tolerations:type_name -> api_container_api.Toleration
+	89, // 10: api_container_api.ServiceInfo.node_selectors:type_name -> api_container_api.ServiceInfo.NodeSelectorsEntry
+	90, // 11: api_container_api.ServiceInfo.labels:type_name -> api_container_api.ServiceInfo.LabelsEntry
+	15, // 12: api_container_api.ServiceInfo.gpu_config:type_name -> api_container_api.GpuConfig
+	91, // 13: api_container_api.ServiceInfo.bind_mounts:type_name -> api_container_api.ServiceInfo.BindMountsEntry
+	92, // 14: api_container_api.GpuConfig.ulimits:type_name -> api_container_api.GpuConfig.UlimitsEntry
 	3,  // 15: api_container_api.RunStarlarkScriptArgs.experimental_features:type_name -> api_container_api.KurtosisFeatureFlag
 	1,  // 16: api_container_api.RunStarlarkScriptArgs.image_download_mode:type_name -> api_container_api.ImageDownloadMode
 	3,  // 17: api_container_api.RunStarlarkPackageArgs.experimental_features:type_name -> api_container_api.KurtosisFeatureFlag
 	1,  // 18: api_container_api.RunStarlarkPackageArgs.image_download_mode:type_name -> api_container_api.ImageDownloadMode
-	19, // 19: api_container_api.StarlarkRunResponseLine.instruction:type_name -> api_container_api.StarlarkInstruction
-	23, // 20: api_container_api.StarlarkRunResponseLine.error:type_name -> api_container_api.StarlarkError
-	27, // 21: api_container_api.StarlarkRunResponseLine.progress_info:type_name -> api_container_api.StarlarkRunProgress
-	20, // 22: api_container_api.StarlarkRunResponseLine.instruction_result:type_name -> api_container_api.StarlarkInstructionResult
-	28, // 23: api_container_api.StarlarkRunResponseLine.run_finished_event:type_name -> api_container_api.StarlarkRunFinishedEvent
-	18, // 24: api_container_api.StarlarkRunResponseLine.warning:type_name -> api_container_api.StarlarkWarning
-	17, // 25: api_container_api.StarlarkRunResponseLine.info:type_name -> api_container_api.StarlarkInfo
-	22, // 26: api_container_api.StarlarkInstruction.position:type_name -> api_container_api.StarlarkInstructionPosition
-	21, // 27: api_container_api.StarlarkInstruction.arguments:type_name -> api_container_api.StarlarkInstructionArg
-	66, // 28: api_container_api.StarlarkInstructionResult.execution_duration:type_name -> google.protobuf.Duration
-	24, // 29: api_container_api.StarlarkError.interpretation_error:type_name -> api_container_api.StarlarkInterpretationError
-	25, // 30: api_container_api.StarlarkError.validation_error:type_name -> api_container_api.StarlarkValidationError
-	26, // 31: api_container_api.StarlarkError.execution_error:type_name -> api_container_api.StarlarkExecutionError
-	66, // 32: api_container_api.StarlarkRunFinishedEvent.total_execution_duration:type_name -> google.protobuf.Duration
-	64, // 33: api_container_api.GetServicesArgs.service_identifiers:type_name -> api_container_api.GetServicesArgs.ServiceIdentifiersEntry
-	65, // 34: api_container_api.GetServicesResponse.service_info:type_name -> api_container_api.GetServicesResponse.ServiceInfoEntry
-	31, // 35: api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.allIdentifiers:type_name -> api_container_api.ServiceIdentifiers
-	38, // 36: api_container_api.StreamedDataChunk.metadata:type_name -> api_container_api.DataChunkMetadata
-	45, // 37: api_container_api.ListFilesArtifactNamesAndUuidsResponse.file_names_and_uuids:type_name -> api_container_api.FilesArtifactNameAndUuid
-	45, // 38: api_container_api.InspectFilesArtifactContentsRequest.file_names_and_uuid:type_name -> api_container_api.FilesArtifactNameAndUuid
-	49, // 39: api_container_api.InspectFilesArtifactContentsResponse.file_descriptions:type_name -> api_container_api.FileArtifactContentsFileDescription
-	2,  // 40: api_container_api.ConnectServicesArgs.connect:type_name -> api_container_api.Connect
-	3,  // 41: api_container_api.GetStarlarkRunResponse.experimental_features:type_name -> api_container_api.KurtosisFeatureFlag
-	4,  // 42: api_container_api.GetStarlarkRunResponse.restart_policy:type_name -> api_container_api.RestartPolicy
-	7,  // 43: api_container_api.ServiceInfo.PrivatePortsEntry.value:type_name -> api_container_api.Port
-	7,  // 44: api_container_api.ServiceInfo.MaybePublicPortsEntry.value:type_name -> api_container_api.Port
-	9,  // 45: api_container_api.ServiceInfo.ServiceDirPathsToFilesArtifactsListEntry.value:type_name -> api_container_api.FilesArtifactsList
-	12, // 46: api_container_api.GetServicesResponse.ServiceInfoEntry.value:type_name -> api_container_api.ServiceInfo
-	14, // 47: api_container_api.ApiContainerService.RunStarlarkScript:input_type -> api_container_api.RunStarlarkScriptArgs
-	37, // 48: api_container_api.ApiContainerService.UploadStarlarkPackage:input_type -> api_container_api.StreamedDataChunk
-	15, // 49: api_container_api.ApiContainerService.RunStarlarkPackage:input_type -> api_container_api.RunStarlarkPackageArgs
-	29, // 50: api_container_api.ApiContainerService.GetServices:input_type -> api_container_api.GetServicesArgs
-	67, // 51: api_container_api.ApiContainerService.GetExistingAndHistoricalServiceIdentifiers:input_type -> google.protobuf.Empty
-	33, // 52: api_container_api.ApiContainerService.ExecCommand:input_type -> api_container_api.ExecCommandArgs
-	35, // 53: api_container_api.ApiContainerService.WaitForHttpGetEndpointAvailability:input_type -> api_container_api.WaitForHttpGetEndpointAvailabilityArgs
-	36, // 54: api_container_api.ApiContainerService.WaitForHttpPostEndpointAvailability:input_type -> api_container_api.WaitForHttpPostEndpointAvailabilityArgs
-	37, // 55: api_container_api.ApiContainerService.UploadFilesArtifact:input_type -> api_container_api.StreamedDataChunk
-	40, // 56: api_container_api.ApiContainerService.DownloadFilesArtifact:input_type -> api_container_api.DownloadFilesArtifactArgs
-	41, // 57: api_container_api.ApiContainerService.StoreWebFilesArtifact:input_type -> api_container_api.StoreWebFilesArtifactArgs
-	43, // 58: api_container_api.ApiContainerService.StoreFilesArtifactFromService:input_type -> api_container_api.StoreFilesArtifactFromServiceArgs
-	67, // 59: api_container_api.ApiContainerService.ListFilesArtifactNamesAndUuids:input_type -> google.protobuf.Empty
-	47, // 60: api_container_api.ApiContainerService.InspectFilesArtifactContents:input_type -> api_container_api.InspectFilesArtifactContentsRequest
-	50, // 61: api_container_api.ApiContainerService.ConnectServices:input_type -> api_container_api.ConnectServicesArgs
-	67, // 62: api_container_api.ApiContainerService.GetStarlarkRun:input_type -> google.protobuf.Empty
-	54, // 63: api_container_api.ApiContainerService.GetStarlarkScriptPlanYaml:input_type -> api_container_api.StarlarkScriptPlanYamlArgs
-	55, // 64: api_container_api.ApiContainerService.GetStarlarkPackagePlanYaml:input_type -> api_container_api.StarlarkPackagePlanYamlArgs
-	16, // 65: api_container_api.ApiContainerService.RunStarlarkScript:output_type -> api_container_api.StarlarkRunResponseLine
-	67, // 66: api_container_api.ApiContainerService.UploadStarlarkPackage:output_type -> google.protobuf.Empty
-	16, // 67: api_container_api.ApiContainerService.RunStarlarkPackage:output_type -> api_container_api.StarlarkRunResponseLine
-	30, // 68: api_container_api.ApiContainerService.GetServices:output_type -> api_container_api.GetServicesResponse
-	32, // 69: api_container_api.ApiContainerService.GetExistingAndHistoricalServiceIdentifiers:output_type -> api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse
-	34, // 70: api_container_api.ApiContainerService.ExecCommand:output_type -> api_container_api.ExecCommandResponse
-	67, // 71: api_container_api.ApiContainerService.WaitForHttpGetEndpointAvailability:output_type -> google.protobuf.Empty
-	67, // 72: api_container_api.ApiContainerService.WaitForHttpPostEndpointAvailability:output_type -> google.protobuf.Empty
-	39, // 73: api_container_api.ApiContainerService.UploadFilesArtifact:output_type -> api_container_api.UploadFilesArtifactResponse
-	37, // 74: api_container_api.ApiContainerService.DownloadFilesArtifact:output_type -> api_container_api.StreamedDataChunk
-	42, // 75: api_container_api.ApiContainerService.StoreWebFilesArtifact:output_type -> api_container_api.StoreWebFilesArtifactResponse
-	44, // 76: api_container_api.ApiContainerService.StoreFilesArtifactFromService:output_type -> api_container_api.StoreFilesArtifactFromServiceResponse
-	46, // 77: api_container_api.ApiContainerService.ListFilesArtifactNamesAndUuids:output_type -> api_container_api.ListFilesArtifactNamesAndUuidsResponse
-	48, // 78: api_container_api.ApiContainerService.InspectFilesArtifactContents:output_type -> api_container_api.InspectFilesArtifactContentsResponse
-	51, // 79: api_container_api.ApiContainerService.ConnectServices:output_type -> api_container_api.ConnectServicesResponse
-	52, // 80: api_container_api.ApiContainerService.GetStarlarkRun:output_type -> api_container_api.GetStarlarkRunResponse
-	53, // 81: api_container_api.ApiContainerService.GetStarlarkScriptPlanYaml:output_type -> api_container_api.PlanYaml
-	53, // 82: api_container_api.ApiContainerService.GetStarlarkPackagePlanYaml:output_type -> api_container_api.PlanYaml
-	65, // [65:83] is the sub-list for method output_type
-	47, // [47:65] is the sub-list for method input_type
-	47, // [47:47] is the sub-list for extension type_name
-	47, // [47:47] is the sub-list for extension extendee
-	0,  // [0:47] is the sub-list for field type_name
+	22, // 19: api_container_api.StarlarkRunResponseLine.instruction:type_name -> api_container_api.StarlarkInstruction
+	26, // 20: api_container_api.StarlarkRunResponseLine.error:type_name -> api_container_api.StarlarkError
+	30, // 21: api_container_api.StarlarkRunResponseLine.progress_info:type_name -> api_container_api.StarlarkRunProgress
+	23, // 22: api_container_api.StarlarkRunResponseLine.instruction_result:type_name -> api_container_api.StarlarkInstructionResult
+	31, // 23: api_container_api.StarlarkRunResponseLine.run_finished_event:type_name -> api_container_api.StarlarkRunFinishedEvent
+	21, // 24: api_container_api.StarlarkRunResponseLine.warning:type_name -> api_container_api.StarlarkWarning
+	20, // 25: api_container_api.StarlarkRunResponseLine.info:type_name -> api_container_api.StarlarkInfo
+	19, // 26: api_container_api.StarlarkRunResponseLine.instruction_output:type_name -> api_container_api.StarlarkInstructionOutput
+	25, // 27: api_container_api.StarlarkInstruction.position:type_name -> api_container_api.StarlarkInstructionPosition
+	24, // 28: api_container_api.StarlarkInstruction.arguments:type_name -> api_container_api.StarlarkInstructionArg
+	96, // 29: api_container_api.StarlarkInstructionResult.execution_duration:type_name -> google.protobuf.Duration
+	27, // 30: api_container_api.StarlarkError.interpretation_error:type_name -> api_container_api.StarlarkInterpretationError
+	28, // 31: api_container_api.StarlarkError.validation_error:type_name -> api_container_api.StarlarkValidationError
+	29, // 32: api_container_api.StarlarkError.execution_error:type_name -> api_container_api.StarlarkExecutionError
+	96, // 33: api_container_api.StarlarkRunFinishedEvent.total_execution_duration:type_name -> google.protobuf.Duration
+	93, // 34: api_container_api.GetServicesArgs.service_identifiers:type_name -> api_container_api.GetServicesArgs.ServiceIdentifiersEntry
+	94, // 35: api_container_api.GetServicesResponse.service_info:type_name -> api_container_api.GetServicesResponse.ServiceInfoEntry
+	34, // 36: api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.allIdentifiers:type_name -> api_container_api.ServiceIdentifiers
+	39, // 37: api_container_api.StreamExecCommandArgs.start:type_name -> api_container_api.StreamExecCommandStart
+	40, // 38: api_container_api.StreamExecCommandArgs.terminal_size:type_name -> api_container_api.TerminalSize
+	40, // 39: api_container_api.StreamExecCommandStart.terminal_size:type_name -> api_container_api.TerminalSize
+	45, // 40: api_container_api.StreamedDataChunk.metadata:type_name -> api_container_api.DataChunkMetadata
+	54, // 41: api_container_api.ListFilesArtifactNamesAndUuidsResponse.file_names_and_uuids:type_name -> api_container_api.FilesArtifactNameAndUuid
+	54, // 42: api_container_api.InspectFilesArtifactContentsRequest.file_names_and_uuid:type_name -> api_container_api.FilesArtifactNameAndUuid
+	58, // 43: api_container_api.InspectFilesArtifactContentsResponse.file_descriptions:type_name -> api_container_api.FileArtifactContentsFileDescription
+	61, // 44: api_container_api.GetFilesArtifactHistoryResponse.versions:type_name -> api_container_api.FilesArtifactVersion
+	97, // 45: api_container_api.FilesArtifactVersion.creation_time:type_name -> google.protobuf.Timestamp
+	2,  // 46: api_container_api.ConnectServicesArgs.connect:type_name -> api_container_api.Connect
+	3,  // 47: api_container_api.GetStarlarkRunResponse.experimental_features:type_name -> api_container_api.KurtosisFeatureFlag
+	4,  // 48: api_container_api.GetStarlarkRunResponse.restart_policy:type_name -> api_container_api.RestartPolicy
+	97, // 49: api_container_api.StarlarkRunRecord.start_time:type_name -> google.protobuf.Timestamp
+	97, // 50: api_container_api.StarlarkRunRecord.end_time:type_name -> google.protobuf.Timestamp
+	5,  // 51: api_container_api.StarlarkRunRecord.status:type_name -> api_container_api.StarlarkRunStatus
+	65, // 52: api_container_api.ListStarlarkRunRecordsResponse.starlark_run_records:type_name -> api_container_api.StarlarkRunRecord
+	6,  // 53: api_container_api.ApiContainerEvent.type:type_name -> api_container_api.ApiContainerEventType
+	97, // 54: api_container_api.ApiContainerEvent.timestamp:type_name -> google.protobuf.Timestamp
+	95, // 55: api_container_api.GetServiceDependenciesResponse.dependencies_by_service_name:type_name -> api_container_api.GetServiceDependenciesResponse.DependenciesByServiceNameEntry
+	97, // 56: api_container_api.GetLastActivityTimeResponse.last_activity_time:type_name -> google.protobuf.Timestamp
+	9,  // 57: api_container_api.ServiceInfo.PrivatePortsEntry.value:type_name -> api_container_api.Port
+	9,  // 58: api_container_api.ServiceInfo.MaybePublicPortsEntry.value:type_name -> api_container_api.Port
+	11, // 59: api_container_api.ServiceInfo.ServiceDirPathsToFilesArtifactsListEntry.value:type_name -> api_container_api.FilesArtifactsList
+	14, // 60: api_container_api.GetServicesResponse.ServiceInfoEntry.value:type_name -> api_container_api.ServiceInfo
+	81, // 61: api_container_api.GetServiceDependenciesResponse.DependenciesByServiceNameEntry.value:type_name -> api_container_api.ServiceDependencies
+	16, // 62: api_container_api.ApiContainerService.RunStarlarkScript:input_type -> api_container_api.RunStarlarkScriptArgs
+	44, // 63: api_container_api.ApiContainerService.UploadStarlarkPackage:input_type -> api_container_api.StreamedDataChunk
+	17, // 64: api_container_api.ApiContainerService.RunStarlarkPackage:input_type -> api_container_api.RunStarlarkPackageArgs
+	32, // 65: api_container_api.ApiContainerService.GetServices:input_type -> api_container_api.GetServicesArgs
+	98, // 66: api_container_api.ApiContainerService.GetExistingAndHistoricalServiceIdentifiers:input_type -> google.protobuf.Empty
+	36, // 67: api_container_api.ApiContainerService.ExecCommand:input_type -> api_container_api.ExecCommandArgs
+	38, // 68: api_container_api.ApiContainerService.StreamExecCommand:input_type -> api_container_api.StreamExecCommandArgs
+	42, // 69: api_container_api.ApiContainerService.WaitForHttpGetEndpointAvailability:input_type -> api_container_api.WaitForHttpGetEndpointAvailabilityArgs
+	43, // 70: api_container_api.ApiContainerService.WaitForHttpPostEndpointAvailability:input_type -> api_container_api.WaitForHttpPostEndpointAvailabilityArgs
+	44, // 71: api_container_api.ApiContainerService.UploadFilesArtifact:input_type -> api_container_api.StreamedDataChunk
+	47, // 72: api_container_api.ApiContainerService.GetMissingFilesArtifactBlobs:input_type -> api_container_api.GetMissingFilesArtifactBlobsArgs
+	49, // 73: api_container_api.ApiContainerService.DownloadFilesArtifact:input_type -> api_container_api.DownloadFilesArtifactArgs
+	50, // 74: api_container_api.ApiContainerService.StoreWebFilesArtifact:input_type -> api_container_api.StoreWebFilesArtifactArgs
+	52, // 75: api_container_api.ApiContainerService.StoreFilesArtifactFromService:input_type -> api_container_api.StoreFilesArtifactFromServiceArgs
+	98, // 76: api_container_api.ApiContainerService.ListFilesArtifactNamesAndUuids:input_type -> google.protobuf.Empty
+	56, // 77: api_container_api.ApiContainerService.InspectFilesArtifactContents:input_type -> api_container_api.InspectFilesArtifactContentsRequest
+	59, // 78: api_container_api.ApiContainerService.GetFilesArtifactHistory:input_type -> api_container_api.GetFilesArtifactHistoryArgs
+	62, // 79: api_container_api.ApiContainerService.ConnectServices:input_type -> api_container_api.ConnectServicesArgs
+	98, // 80: api_container_api.ApiContainerService.GetStarlarkRun:input_type -> google.protobuf.Empty
+	98, // 81: api_container_api.ApiContainerService.ListStarlarkRunRecords:input_type -> google.protobuf.Empty
+	67, // 82: api_container_api.ApiContainerService.GetStarlarkRunRecord:input_type -> api_container_api.GetStarlarkRunRecordArgs
+	67, // 83: api_container_api.ApiContainerService.GetStarlarkRunRecordOutput:input_type -> api_container_api.GetStarlarkRunRecordArgs
+	69, // 84: api_container_api.ApiContainerService.GetStarlarkScriptPlanYaml:input_type -> api_container_api.StarlarkScriptPlanYamlArgs
+	70, // 85: api_container_api.ApiContainerService.GetStarlarkPackagePlanYaml:input_type -> api_container_api.StarlarkPackagePlanYamlArgs
+	71, // 86: api_container_api.ApiContainerService.SetSecret:input_type -> api_container_api.SetSecretArgs
+	98, // 87: api_container_api.ApiContainerService.ListSecrets:input_type -> google.protobuf.Empty
+	73, // 88: api_container_api.ApiContainerService.RemoveSecret:input_type -> api_container_api.RemoveSecretArgs
+	98, // 89: api_container_api.ApiContainerService.ResumeServices:input_type -> google.protobuf.Empty
+	98, // 90: api_container_api.ApiContainerService.RecordServicesToResume:input_type -> google.protobuf.Empty
+	98, // 91: api_container_api.ApiContainerService.GetSecretsRecipientPublicKey:input_type -> google.protobuf.Empty
+	76, // 92: api_container_api.ApiContainerService.ExportEnclavePlan:input_type -> api_container_api.ExportEnclavePlanArgs
+	78, // 93: api_container_api.ApiContainerService.ReplayEnclavePlan:input_type -> api_container_api.ReplayEnclavePlanArgs
+	80, // 94: api_container_api.ApiContainerService.WatchEvents:input_type -> api_container_api.WatchEventsArgs
+	98, // 95: api_container_api.ApiContainerService.GetServiceDependencies:input_type -> google.protobuf.Empty
+	83, // 96: api_container_api.ApiContainerService.WaitForServiceReadiness:input_type -> api_container_api.WaitForServiceReadinessArgs
+	98, // 97: api_container_api.ApiContainerService.GetLastActivityTime:input_type -> google.protobuf.Empty
+	18, // 98: api_container_api.ApiContainerService.RunStarlarkScript:output_type -> api_container_api.StarlarkRunResponseLine
+	98, // 99: api_container_api.ApiContainerService.UploadStarlarkPackage:output_type -> google.protobuf.Empty
+	18, // 100: api_container_api.ApiContainerService.RunStarlarkPackage:output_type -> api_container_api.StarlarkRunResponseLine
+	33, // 101: api_container_api.ApiContainerService.GetServices:output_type -> api_container_api.GetServicesResponse
+	35, // 102: api_container_api.ApiContainerService.GetExistingAndHistoricalServiceIdentifiers:output_type -> api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse
+	37, // 103: api_container_api.ApiContainerService.ExecCommand:output_type -> api_container_api.ExecCommandResponse
+	41, // 104: api_container_api.ApiContainerService.StreamExecCommand:output_type -> api_container_api.StreamExecCommandResponse
+	98, // 105: api_container_api.ApiContainerService.WaitForHttpGetEndpointAvailability:output_type -> google.protobuf.Empty
+	98, // 106: api_container_api.ApiContainerService.WaitForHttpPostEndpointAvailability:output_type -> google.protobuf.Empty
+	46, // 107: api_container_api.ApiContainerService.UploadFilesArtifact:output_type -> api_container_api.UploadFilesArtifactResponse
+	48, // 108: api_container_api.ApiContainerService.GetMissingFilesArtifactBlobs:output_type -> api_container_api.GetMissingFilesArtifactBlobsResponse
+	44, // 109: api_container_api.ApiContainerService.DownloadFilesArtifact:output_type -> api_container_api.StreamedDataChunk
+	51, // 110: api_container_api.ApiContainerService.StoreWebFilesArtifact:output_type -> api_container_api.StoreWebFilesArtifactResponse
+	53, // 111: api_container_api.ApiContainerService.StoreFilesArtifactFromService:output_type -> api_container_api.StoreFilesArtifactFromServiceResponse
+	55, // 112: api_container_api.ApiContainerService.ListFilesArtifactNamesAndUuids:output_type -> api_container_api.ListFilesArtifactNamesAndUuidsResponse
+	57, // 113: api_container_api.ApiContainerService.InspectFilesArtifactContents:output_type -> api_container_api.InspectFilesArtifactContentsResponse
+	60, // 114: api_container_api.ApiContainerService.GetFilesArtifactHistory:output_type -> api_container_api.GetFilesArtifactHistoryResponse
+	63, // 115: api_container_api.ApiContainerService.ConnectServices:output_type -> api_container_api.ConnectServicesResponse
+	64, // 116: api_container_api.ApiContainerService.GetStarlarkRun:output_type -> api_container_api.GetStarlarkRunResponse
+	66, // 117: api_container_api.ApiContainerService.ListStarlarkRunRecords:output_type -> api_container_api.ListStarlarkRunRecordsResponse
+	65, // 118: api_container_api.ApiContainerService.GetStarlarkRunRecord:output_type -> api_container_api.StarlarkRunRecord
+	18, // 119: api_container_api.ApiContainerService.GetStarlarkRunRecordOutput:output_type -> api_container_api.StarlarkRunResponseLine
+	68, // 120: api_container_api.ApiContainerService.GetStarlarkScriptPlanYaml:output_type -> api_container_api.PlanYaml
+	68, // 121: api_container_api.ApiContainerService.GetStarlarkPackagePlanYaml:output_type -> api_container_api.PlanYaml
+	98, // 122: api_container_api.ApiContainerService.SetSecret:output_type -> google.protobuf.Empty
+	72, // 123: api_container_api.ApiContainerService.ListSecrets:output_type -> api_container_api.ListSecretsResponse
+	98, // 124: api_container_api.ApiContainerService.RemoveSecret:output_type -> google.protobuf.Empty
+	74, // 125: api_container_api.ApiContainerService.ResumeServices:output_type -> api_container_api.ResumeServicesResponse
+	98, // 126: api_container_api.ApiContainerService.RecordServicesToResume:output_type -> google.protobuf.Empty
+	75, // 127: api_container_api.ApiContainerService.GetSecretsRecipientPublicKey:output_type -> api_container_api.GetSecretsRecipientPublicKeyResponse
+	77, // 128: api_container_api.ApiContainerService.ExportEnclavePlan:output_type -> api_container_api.ExportEnclavePlanResponse
+	18, // 129: api_container_api.ApiContainerService.ReplayEnclavePlan:output_type -> api_container_api.StarlarkRunResponseLine
+	79, // 130: api_container_api.ApiContainerService.WatchEvents:output_type -> api_container_api.ApiContainerEvent
+	82, // 131: api_container_api.ApiContainerService.GetServiceDependencies:output_type -> api_container_api.GetServiceDependenciesResponse
+	98, // 132: api_container_api.ApiContainerService.WaitForServiceReadiness:output_type -> google.protobuf.Empty
+	84, // 133: api_container_api.ApiContainerService.GetLastActivityTime:output_type -> api_container_api.GetLastActivityTimeResponse
+	98, // [98:134] is the sub-list for method output_type
+	62, // [62:98] is the sub-list for method input_type
+	62, // [62:62] is the sub-list for extension type_name
+	62, // [62:62] is the sub-list for extension extendee
+	0,  // [0:62] is the sub-list for field type_name
 }
 
 func init() { file_api_container_service_proto_init() }
@@ -4532,30 +6541,52 @@ func file_api_container_service_proto_init() {
 		(*StarlarkRunResponseLine_RunFinishedEvent)(nil),
 		(*StarlarkRunResponseLine_Warning)(nil),
 		(*StarlarkRunResponseLine_Info)(nil),
+		(*StarlarkRunResponseLine_InstructionOutput)(nil),
 	}
-	file_api_container_service_proto_msgTypes[12].OneofWrappers = []any{}
+	file_api_container_service_proto_msgTypes[10].OneofWrappers = []any{}
 	file_api_container_service_proto_msgTypes[13].OneofWrappers = []any{}
 	file_api_container_service_proto_msgTypes[14].OneofWrappers = []any{}
-	file_api_container_service_proto_msgTypes[16].OneofWrappers = []any{
+	file_api_container_service_proto_msgTypes[15].OneofWrappers = []any{}
+	file_api_container_service_proto_msgTypes[17].OneofWrappers = []any{
 		(*StarlarkError_InterpretationError)(nil),
 		(*StarlarkError_ValidationError)(nil),
 		(*StarlarkError_ExecutionError)(nil),
 	}
-	file_api_container_service_proto_msgTypes[20].OneofWrappers = []any{}
 	file_api_container_service_proto_msgTypes[21].OneofWrappers = []any{}
-	file_api_container_service_proto_msgTypes[28].OneofWrappers = []any{}
-	file_api_container_service_proto_msgTypes[29].OneofWrappers = []any{}
-	file_api_container_service_proto_msgTypes[42].OneofWrappers = []any{}
-	file_api_container_service_proto_msgTypes[45].OneofWrappers = []any{}
-	file_api_container_service_proto_msgTypes[47].OneofWrappers = []any{}
-	file_api_container_service_proto_msgTypes[48].OneofWrappers = []any{}
+	file_api_container_service_proto_msgTypes[22].OneofWrappers = []any{}
+	file_api_container_service_proto_msgTypes[29].OneofWrappers = []any{
+		(*StreamExecCommandArgs_Start)(nil),
+		(*StreamExecCommandArgs_Stdin)(nil),
+		(*StreamExecCommandArgs_CloseStdin)(nil),
+		(*StreamExecCommandArgs_TerminalSize)(nil),
+	}
+	file_api_container_service_proto_msgTypes[30].OneofWrappers = []any{}
+	file_api_container_service_proto_msgTypes[32].OneofWrappers = []any{
+		(*StreamExecCommandResponse_Stdout)(nil),
+		(*StreamExecCommandResponse_Stderr)(nil),
+		(*StreamExecCommandResponse_ExitCode)(nil),
+	}
+	file_api_container_service_proto_msgTypes[33].OneofWrappers = []any{}
+	file_api_container_service_proto_msgTypes[34].OneofWrappers = []any{}
+	file_api_container_service_proto_msgTypes[49].OneofWrappers = []any{}
+	file_api_container_service_proto_msgTypes[52].OneofWrappers = []any{}
+	file_api_container_service_proto_msgTypes[55].OneofWrappers = []any{}
+	file_api_container_service_proto_msgTypes[56].OneofWrappers = []any{}
+	file_api_container_service_proto_msgTypes[60].OneofWrappers = []any{}
+	file_api_container_service_proto_msgTypes[61].OneofWrappers = []any{}
+	file_api_container_service_proto_msgTypes[67].OneofWrappers = []any{}
+	file_api_container_service_proto_msgTypes[68].OneofWrappers = []any{}
+	file_api_container_service_proto_msgTypes[69].OneofWrappers = []any{}
+	file_api_container_service_proto_msgTypes[70].OneofWrappers = []any{}
+	file_api_container_service_proto_msgTypes[71].OneofWrappers = []any{}
+	file_api_container_service_proto_msgTypes[75].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_container_service_proto_rawDesc), len(file_api_container_service_proto_rawDesc)),
-			NumEnums:      7,
-			NumMessages:   59,
+			NumEnums:      9,
+			NumMessages:   87,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -26,18 +26,36 @@ const (
 	ApiContainerService_GetServices_FullMethodName                                = "/api_container_api.ApiContainerService/GetServices"
 	ApiContainerService_GetExistingAndHistoricalServiceIdentifiers_FullMethodName = "/api_container_api.ApiContainerService/GetExistingAndHistoricalServiceIdentifiers"
 	ApiContainerService_ExecCommand_FullMethodName                                = "/api_container_api.ApiContainerService/ExecCommand"
+	ApiContainerService_StreamExecCommand_FullMethodName                          = "/api_container_api.ApiContainerService/StreamExecCommand"
 	ApiContainerService_WaitForHttpGetEndpointAvailability_FullMethodName         = "/api_container_api.ApiContainerService/WaitForHttpGetEndpointAvailability"
 	ApiContainerService_WaitForHttpPostEndpointAvailability_FullMethodName        = "/api_container_api.ApiContainerService/WaitForHttpPostEndpointAvailability"
 	ApiContainerService_UploadFilesArtifact_FullMethodName                        = "/api_container_api.ApiContainerService/UploadFilesArtifact"
+	ApiContainerService_GetMissingFilesArtifactBlobs_FullMethodName               = "/api_container_api.ApiContainerService/GetMissingFilesArtifactBlobs"
 	ApiContainerService_DownloadFilesArtifact_FullMethodName                      = "/api_container_api.ApiContainerService/DownloadFilesArtifact"
 	ApiContainerService_StoreWebFilesArtifact_FullMethodName                      = "/api_container_api.ApiContainerService/StoreWebFilesArtifact"
 	ApiContainerService_StoreFilesArtifactFromService_FullMethodName              = "/api_container_api.ApiContainerService/StoreFilesArtifactFromService"
 	ApiContainerService_ListFilesArtifactNamesAndUuids_FullMethodName             = "/api_container_api.ApiContainerService/ListFilesArtifactNamesAndUuids"
 	ApiContainerService_InspectFilesArtifactContents_FullMethodName               = "/api_container_api.ApiContainerService/InspectFilesArtifactContents"
+	ApiContainerService_GetFilesArtifactHistory_FullMethodName                    = "/api_container_api.ApiContainerService/GetFilesArtifactHistory"
 	ApiContainerService_ConnectServices_FullMethodName                            = "/api_container_api.ApiContainerService/ConnectServices"
 	ApiContainerService_GetStarlarkRun_FullMethodName                             = "/api_container_api.ApiContainerService/GetStarlarkRun"
+	ApiContainerService_ListStarlarkRunRecords_FullMethodName                     = "/api_container_api.ApiContainerService/ListStarlarkRunRecords"
+	ApiContainerService_GetStarlarkRunRecord_FullMethodName                       = "/api_container_api.ApiContainerService/GetStarlarkRunRecord"
+	ApiContainerService_GetStarlarkRunRecordOutput_FullMethodName                 = "/api_container_api.ApiContainerService/GetStarlarkRunRecordOutput"
 	ApiContainerService_GetStarlarkScriptPlanYaml_FullMethodName                  = "/api_container_api.ApiContainerService/GetStarlarkScriptPlanYaml"
 	ApiContainerService_GetStarlarkPackagePlanYaml_FullMethodName                 = "/api_container_api.ApiContainerService/GetStarlarkPackagePlanYaml"
+	ApiContainerService_SetSecret_FullMethodName                                  = "/api_container_api.ApiContainerService/SetSecret"
+	ApiContainerService_ListSecrets_FullMethodName                                = "/api_container_api.ApiContainerService/ListSecrets"
+	ApiContainerService_RemoveSecret_FullMethodName                               = "/api_container_api.ApiContainerService/RemoveSecret"
+	ApiContainerService_ResumeServices_FullMethodName                             = "/api_container_api.ApiContainerService/ResumeServices"
+	ApiContainerService_RecordServicesToResume_FullMethodName                     = "/api_container_api.ApiContainerService/RecordServicesToResume"
+	ApiContainerService_GetSecretsRecipientPublicKey_FullMethodName               = "/api_container_api.ApiContainerService/GetSecretsRecipientPublicKey"
+	ApiContainerService_ExportEnclavePlan_FullMethodName                          = "/api_container_api.ApiContainerService/ExportEnclavePlan"
+	ApiContainerService_ReplayEnclavePlan_FullMethodName                          = "/api_container_api.ApiContainerService/ReplayEnclavePlan"
+	ApiContainerService_WatchEvents_FullMethodName                                = "/api_container_api.ApiContainerService/WatchEvents"
+	ApiContainerService_GetServiceDependencies_FullMethodName                     = "/api_container_api.ApiContainerService/GetServiceDependencies"
+	ApiContainerService_WaitForServiceReadiness_FullMethodName                    = "/api_container_api.ApiContainerService/WaitForServiceReadiness"
+	ApiContainerService_GetLastActivityTime_FullMethodName                        = "/api_container_api.ApiContainerService/GetLastActivityTime"
 )
 
 // ApiContainerServiceClient is the client API for ApiContainerService service.
@@ -56,12 +74,17 @@ type ApiContainerServiceClient interface {
 	GetExistingAndHistoricalServiceIdentifiers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetExistingAndHistoricalServiceIdentifiersResponse, error)
 	// Executes the given command inside a running container
 	ExecCommand(ctx context.Context, in *ExecCommandArgs, opts ...grpc.CallOption) (*ExecCommandResponse, error)
+	// Executes the given command inside a running container, streaming its output back as it is produced. The first
+	// message sent by the client must contain the command to run; the following ones carry stdin and terminal resizes
+	StreamExecCommand(ctx context.Context, opts ...grpc.CallOption) (ApiContainerService_StreamExecCommandClient, error)
 	// Block until the given HTTP endpoint returns available, calling it through a HTTP Get request
 	WaitForHttpGetEndpointAvailability(ctx context.Context, in *WaitForHttpGetEndpointAvailabilityArgs, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Block until the given HTTP endpoint returns available, calling it through a HTTP Post request
 	WaitForHttpPostEndpointAvailability(ctx context.Context, in *WaitForHttpPostEndpointAvailabilityArgs, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Uploads a files artifact to the Kurtosis File System
 	UploadFilesArtifact(ctx context.Context, opts ...grpc.CallOption) (ApiContainerService_UploadFilesArtifactClient, error)
+	// Returns which of the given file contents the Kurtosis File System doesn't store yet, so that uploads can leave out the others
+	GetMissingFilesArtifactBlobs(ctx context.Context, in *GetMissingFilesArtifactBlobsArgs, opts ...grpc.CallOption) (*GetMissingFilesArtifactBlobsResponse, error)
 	// Downloads a files artifact from the Kurtosis File System
 	DownloadFilesArtifact(ctx context.Context, in *DownloadFilesArtifactArgs, opts ...grpc.CallOption) (ApiContainerService_DownloadFilesArtifactClient, error)
 	// Tells the API container to download a files artifact from the web to the Kurtosis File System
@@ -70,14 +93,54 @@ type ApiContainerServiceClient interface {
 	StoreFilesArtifactFromService(ctx context.Context, in *StoreFilesArtifactFromServiceArgs, opts ...grpc.CallOption) (*StoreFilesArtifactFromServiceResponse, error)
 	ListFilesArtifactNamesAndUuids(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListFilesArtifactNamesAndUuidsResponse, error)
 	InspectFilesArtifactContents(ctx context.Context, in *InspectFilesArtifactContentsRequest, opts ...grpc.CallOption) (*InspectFilesArtifactContentsResponse, error)
+	// Returns the versions kept of a files artifact, each update adding one. A version can be referred to by suffixing the
+	// files artifact identifier with '@v<version>'
+	GetFilesArtifactHistory(ctx context.Context, in *GetFilesArtifactHistoryArgs, opts ...grpc.CallOption) (*GetFilesArtifactHistoryResponse, error)
 	// User services port forwarding
 	ConnectServices(ctx context.Context, in *ConnectServicesArgs, opts ...grpc.CallOption) (*ConnectServicesResponse, error)
 	// Get last Starlark run
 	GetStarlarkRun(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetStarlarkRunResponse, error)
+	// Lists every Starlark run of the enclave, oldest first, without their output
+	ListStarlarkRunRecords(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListStarlarkRunRecordsResponse, error)
+	// Gets a Starlark run of the enclave, without its output
+	GetStarlarkRunRecord(ctx context.Context, in *GetStarlarkRunRecordArgs, opts ...grpc.CallOption) (*StarlarkRunRecord, error)
+	// Streams the recorded output of a Starlark run of the enclave, line by line
+	GetStarlarkRunRecordOutput(ctx context.Context, in *GetStarlarkRunRecordArgs, opts ...grpc.CallOption) (ApiContainerService_GetStarlarkRunRecordOutputClient, error)
 	// Gets yaml representing the plan the script will execute in an enclave
 	GetStarlarkScriptPlanYaml(ctx context.Context, in *StarlarkScriptPlanYamlArgs, opts ...grpc.CallOption) (*PlanYaml, error)
 	// Gets yaml representing the plan the package will execute in an enclave
 	GetStarlarkPackagePlanYaml(ctx context.Context, in *StarlarkPackagePlanYamlArgs, opts ...grpc.CallOption) (*PlanYaml, error)
+	// Stores a secret in the enclave secret store, overwriting any previous value stored under the same name
+	SetSecret(ctx context.Context, in *SetSecretArgs, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Returns the names of the secrets stored in the enclave. Secret values are never returned by the API
+	ListSecrets(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListSecretsResponse, error)
+	// Removes a secret from the enclave secret store
+	RemoveSecret(ctx context.Context, in *RemoveSecretArgs, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Starts again, in dependency order, the services that were running before the enclave was stopped, waiting for
+	// each one to satisfy its ready conditions before starting the next
+	ResumeServices(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ResumeServicesResponse, error)
+	// Records the services currently started, before they get stopped along with the enclave, so that ResumeServices
+	// starts exactly those again once the enclave is started
+	RecordServicesToResume(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Returns a public key that the secrets of another enclave can be sealed with, so that only this API container can
+	// open them once they're passed to ReplayEnclavePlan. A new key is returned on every call, and only the last one
+	// returned can be used
+	GetSecretsRecipientPublicKey(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetSecretsRecipientPublicKeyResponse, error)
+	// Exports the enclave plan, along with the runtime values it refers to, so that it can be replayed in another enclave
+	ExportEnclavePlan(ctx context.Context, in *ExportEnclavePlanArgs, opts ...grpc.CallOption) (*ExportEnclavePlanResponse, error)
+	// Replays in this enclave an enclave plan exported from another enclave
+	ReplayEnclavePlan(ctx context.Context, in *ReplayEnclavePlanArgs, opts ...grpc.CallOption) (ApiContainerService_ReplayEnclavePlanClient, error)
+	// Streams what happens in the enclave: Starlark runs, services and files artifacts. The most recent events, including
+	// the ones that happened before the call, are sent first so that watchers can resume where they left off
+	WatchEvents(ctx context.Context, in *WatchEventsArgs, opts ...grpc.CallOption) (ApiContainerService_WatchEventsClient, error)
+	// Returns, for each service added by the enclave plan, the services it depends on, i.e. the ones whose runtime values
+	// or files artifacts its add_service instruction consumes, directly or through other instructions
+	GetServiceDependencies(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetServiceDependenciesResponse, error)
+	// Waits for a service to satisfy the ready conditions it was added with; services added without any return right away
+	WaitForServiceReadiness(ctx context.Context, in *WaitForServiceReadinessArgs, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Returns the last time a client used the API container, e.g. to run Starlark, exec into a service or look up its
+	// ports, so that the engine knows whether the enclave is idle
+	GetLastActivityTime(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetLastActivityTimeResponse, error)
 }
 
 type apiContainerServiceClient struct {
@@ -213,6 +276,37 @@ func (c *apiContainerServiceClient) ExecCommand(ctx context.Context, in *ExecCom
 	return out, nil
 }
 
+func (c *apiContainerServiceClient) StreamExecCommand(ctx context.Context, opts ...grpc.CallOption) (ApiContainerService_StreamExecCommandClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ApiContainerService_ServiceDesc.Streams[3], ApiContainerService_StreamExecCommand_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiContainerServiceStreamExecCommandClient{stream}
+	return x, nil
+}
+
+type ApiContainerService_StreamExecCommandClient interface {
+	Send(*StreamExecCommandArgs) error
+	Recv() (*StreamExecCommandResponse, error)
+	grpc.ClientStream
+}
+
+type apiContainerServiceStreamExecCommandClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiContainerServiceStreamExecCommandClient) Send(m *StreamExecCommandArgs) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *apiContainerServiceStreamExecCommandClient) Recv() (*StreamExecCommandResponse, error) {
+	m := new(StreamExecCommandResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *apiContainerServiceClient) WaitForHttpGetEndpointAvailability(ctx context.Context, in *WaitForHttpGetEndpointAvailabilityArgs, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, ApiContainerService_WaitForHttpGetEndpointAvailability_FullMethodName, in, out, opts...)
@@ -232,7 +326,7 @@ func (c *apiContainerServiceClient) WaitForHttpPostEndpointAvailability(ctx cont
 }
 
 func (c *apiContainerServiceClient) UploadFilesArtifact(ctx context.Context, opts ...grpc.CallOption) (ApiContainerService_UploadFilesArtifactClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ApiContainerService_ServiceDesc.Streams[3], ApiContainerService_UploadFilesArtifact_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &ApiContainerService_ServiceDesc.Streams[4], ApiContainerService_UploadFilesArtifact_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -265,8 +359,17 @@ func (x *apiContainerServiceUploadFilesArtifactClient) CloseAndRecv() (*UploadFi
 	return m, nil
 }
 
+func (c *apiContainerServiceClient) GetMissingFilesArtifactBlobs(ctx context.Context, in *GetMissingFilesArtifactBlobsArgs, opts ...grpc.CallOption) (*GetMissingFilesArtifactBlobsResponse, error) {
+	out := new(GetMissingFilesArtifactBlobsResponse)
+	err := c.cc.Invoke(ctx, ApiContainerService_GetMissingFilesArtifactBlobs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *apiContainerServiceClient) DownloadFilesArtifact(ctx context.Context, in *DownloadFilesArtifactArgs, opts ...grpc.CallOption) (ApiContainerService_DownloadFilesArtifactClient, error) {
-	stream, err := c.cc.NewStream(ctx, &ApiContainerService_ServiceDesc.Streams[4], ApiContainerService_DownloadFilesArtifact_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &ApiContainerService_ServiceDesc.Streams[5], ApiContainerService_DownloadFilesArtifact_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -333,6 +436,15 @@ func (c *apiContainerServiceClient) InspectFilesArtifactContents(ctx context.Con
 	return out, nil
 }
 
+func (c *apiContainerServiceClient) GetFilesArtifactHistory(ctx context.Context, in *GetFilesArtifactHistoryArgs, opts ...grpc.CallOption) (*GetFilesArtifactHistoryResponse, error) {
+	out := new(GetFilesArtifactHistoryResponse)
+	err := c.cc.Invoke(ctx, ApiContainerService_GetFilesArtifactHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *apiContainerServiceClient) ConnectServices(ctx context.Context, in *ConnectServicesArgs, opts ...grpc.CallOption) (*ConnectServicesResponse, error) {
 	out := new(ConnectServicesResponse)
 	err := c.cc.Invoke(ctx, ApiContainerService_ConnectServices_FullMethodName, in, out, opts...)
@@ -351,6 +463,56 @@ func (c *apiContainerServiceClient) GetStarlarkRun(ctx context.Context, in *empt
 	return out, nil
 }
 
+func (c *apiContainerServiceClient) ListStarlarkRunRecords(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListStarlarkRunRecordsResponse, error) {
+	out := new(ListStarlarkRunRecordsResponse)
+	err := c.cc.Invoke(ctx, ApiContainerService_ListStarlarkRunRecords_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiContainerServiceClient) GetStarlarkRunRecord(ctx context.Context, in *GetStarlarkRunRecordArgs, opts ...grpc.CallOption) (*StarlarkRunRecord, error) {
+	out := new(StarlarkRunRecord)
+	err := c.cc.Invoke(ctx, ApiContainerService_GetStarlarkRunRecord_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiContainerServiceClient) GetStarlarkRunRecordOutput(ctx context.Context, in *GetStarlarkRunRecordArgs, opts ...grpc.CallOption) (ApiContainerService_GetStarlarkRunRecordOutputClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ApiContainerService_ServiceDesc.Streams[6], ApiContainerService_GetStarlarkRunRecordOutput_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiContainerServiceGetStarlarkRunRecordOutputClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ApiContainerService_GetStarlarkRunRecordOutputClient interface {
+	Recv() (*StarlarkRunResponseLine, error)
+	grpc.ClientStream
+}
+
+type apiContainerServiceGetStarlarkRunRecordOutputClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiContainerServiceGetStarlarkRunRecordOutputClient) Recv() (*StarlarkRunResponseLine, error) {
+	m := new(StarlarkRunResponseLine)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *apiContainerServiceClient) GetStarlarkScriptPlanYaml(ctx context.Context, in *StarlarkScriptPlanYamlArgs, opts ...grpc.CallOption) (*PlanYaml, error) {
 	out := new(PlanYaml)
 	err := c.cc.Invoke(ctx, ApiContainerService_GetStarlarkScriptPlanYaml_FullMethodName, in, out, opts...)
@@ -369,6 +531,160 @@ func (c *apiContainerServiceClient) GetStarlarkPackagePlanYaml(ctx context.Conte
 	return out, nil
 }
 
+func (c *apiContainerServiceClient) SetSecret(ctx context.Context, in *SetSecretArgs, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ApiContainerService_SetSecret_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiContainerServiceClient) ListSecrets(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListSecretsResponse, error) {
+	out := new(ListSecretsResponse)
+	err := c.cc.Invoke(ctx, ApiContainerService_ListSecrets_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiContainerServiceClient) RemoveSecret(ctx context.Context, in *RemoveSecretArgs, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ApiContainerService_RemoveSecret_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiContainerServiceClient) ResumeServices(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ResumeServicesResponse, error) {
+	out := new(ResumeServicesResponse)
+	err := c.cc.Invoke(ctx, ApiContainerService_ResumeServices_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiContainerServiceClient) RecordServicesToResume(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ApiContainerService_RecordServicesToResume_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiContainerServiceClient) GetSecretsRecipientPublicKey(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetSecretsRecipientPublicKeyResponse, error) {
+	out := new(GetSecretsRecipientPublicKeyResponse)
+	err := c.cc.Invoke(ctx, ApiContainerService_GetSecretsRecipientPublicKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiContainerServiceClient) ExportEnclavePlan(ctx context.Context, in *ExportEnclavePlanArgs, opts ...grpc.CallOption) (*ExportEnclavePlanResponse, error) {
+	out := new(ExportEnclavePlanResponse)
+	err := c.cc.Invoke(ctx, ApiContainerService_ExportEnclavePlan_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiContainerServiceClient) ReplayEnclavePlan(ctx context.Context, in *ReplayEnclavePlanArgs, opts ...grpc.CallOption) (ApiContainerService_ReplayEnclavePlanClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ApiContainerService_ServiceDesc.Streams[7], ApiContainerService_ReplayEnclavePlan_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiContainerServiceReplayEnclavePlanClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ApiContainerService_ReplayEnclavePlanClient interface {
+	Recv() (*StarlarkRunResponseLine, error)
+	grpc.ClientStream
+}
+
+type apiContainerServiceReplayEnclavePlanClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiContainerServiceReplayEnclavePlanClient) Recv() (*StarlarkRunResponseLine, error) {
+	m := new(StarlarkRunResponseLine)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *apiContainerServiceClient) WatchEvents(ctx context.Context, in *WatchEventsArgs, opts ...grpc.CallOption) (ApiContainerService_WatchEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ApiContainerService_ServiceDesc.Streams[8], ApiContainerService_WatchEvents_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiContainerServiceWatchEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ApiContainerService_WatchEventsClient interface {
+	Recv() (*ApiContainerEvent, error)
+	grpc.ClientStream
+}
+
+type apiContainerServiceWatchEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiContainerServiceWatchEventsClient) Recv() (*ApiContainerEvent, error) {
+	m := new(ApiContainerEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *apiContainerServiceClient) GetServiceDependencies(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetServiceDependenciesResponse, error) {
+	out := new(GetServiceDependenciesResponse)
+	err := c.cc.Invoke(ctx, ApiContainerService_GetServiceDependencies_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiContainerServiceClient) WaitForServiceReadiness(ctx context.Context, in *WaitForServiceReadinessArgs, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ApiContainerService_WaitForServiceReadiness_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiContainerServiceClient) GetLastActivityTime(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetLastActivityTimeResponse, error) {
+	out := new(GetLastActivityTimeResponse)
+	err := c.cc.Invoke(ctx, ApiContainerService_GetLastActivityTime_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ApiContainerServiceServer is the server API for ApiContainerService service.
 // All implementations should embed UnimplementedApiContainerServiceServer
 // for forward compatibility
@@ -385,12 +701,17 @@ type ApiContainerServiceServer interface {
 	GetExistingAndHistoricalServiceIdentifiers(context.Context, *emptypb.Empty) (*GetExistingAndHistoricalServiceIdentifiersResponse, error)
 	// Executes the given command inside a running container
 	ExecCommand(context.Context, *ExecCommandArgs) (*ExecCommandResponse, error)
+	// Executes the given command inside a running container, streaming its output back as it is produced. The first
+	// message sent by the client must contain the command to run; the following ones carry stdin and terminal resizes
+	StreamExecCommand(ApiContainerService_StreamExecCommandServer) error
 	// Block until the given HTTP endpoint returns available, calling it through a HTTP Get request
 	WaitForHttpGetEndpointAvailability(context.Context, *WaitForHttpGetEndpointAvailabilityArgs) (*emptypb.Empty, error)
 	// Block until the given HTTP endpoint returns available, calling it through a HTTP Post request
 	WaitForHttpPostEndpointAvailability(context.Context, *WaitForHttpPostEndpointAvailabilityArgs) (*emptypb.Empty, error)
 	// Uploads a files artifact to the Kurtosis File System
 	UploadFilesArtifact(ApiContainerService_UploadFilesArtifactServer) error
+	// Returns which of the given file contents the Kurtosis File System doesn't store yet, so that uploads can leave out the others
+	GetMissingFilesArtifactBlobs(context.Context, *GetMissingFilesArtifactBlobsArgs) (*GetMissingFilesArtifactBlobsResponse, error)
 	// Downloads a files artifact from the Kurtosis File System
 	DownloadFilesArtifact(*DownloadFilesArtifactArgs, ApiContainerService_DownloadFilesArtifactServer) error
 	// Tells the API container to download a files artifact from the web to the Kurtosis File System
@@ -399,14 +720,54 @@ type ApiContainerServiceServer interface {
 	StoreFilesArtifactFromService(context.Context, *StoreFilesArtifactFromServiceArgs) (*StoreFilesArtifactFromServiceResponse, error)
 	ListFilesArtifactNamesAndUuids(context.Context, *emptypb.Empty) (*ListFilesArtifactNamesAndUuidsResponse, error)
 	InspectFilesArtifactContents(context.Context, *InspectFilesArtifactContentsRequest) (*InspectFilesArtifactContentsResponse, error)
+	// Returns the versions kept of a files artifact, each update adding one. A version can be referred to by suffixing the
+	// files artifact identifier with '@v<version>'
+	GetFilesArtifactHistory(context.Context, *GetFilesArtifactHistoryArgs) (*GetFilesArtifactHistoryResponse, error)
 	// User services port forwarding
 	ConnectServices(context.Context, *ConnectServicesArgs) (*ConnectServicesResponse, error)
 	// Get last Starlark run
 	GetStarlarkRun(context.Context, *emptypb.Empty) (*GetStarlarkRunResponse, error)
+	// Lists every Starlark run of the enclave, oldest first, without their output
+	ListStarlarkRunRecords(context.Context, *emptypb.Empty) (*ListStarlarkRunRecordsResponse, error)
+	// Gets a Starlark run of the enclave, without its output
+	GetStarlarkRunRecord(context.Context, *GetStarlarkRunRecordArgs) (*StarlarkRunRecord, error)
+	// Streams the recorded output of a Starlark run of the enclave, line by line
+	GetStarlarkRunRecordOutput(*GetStarlarkRunRecordArgs, ApiContainerService_GetStarlarkRunRecordOutputServer) error
 	// Gets yaml representing the plan the script will execute in an enclave
 	GetStarlarkScriptPlanYaml(context.Context, *StarlarkScriptPlanYamlArgs) (*PlanYaml, error)
 	// Gets yaml representing the plan the package will execute in an enclave
 	GetStarlarkPackagePlanYaml(context.Context, *StarlarkPackagePlanYamlArgs) (*PlanYaml, error)
+	// Stores a secret in the enclave secret store, overwriting any previous value stored under the same name
+	SetSecret(context.Context, *SetSecretArgs) (*emptypb.Empty, error)
+	// Returns the names of the secrets stored in the enclave. Secret values are never returned by the API
+	ListSecrets(context.Context, *emptypb.Empty) (*ListSecretsResponse, error)
+	// Removes a secret from the enclave secret store
+	RemoveSecret(context.Context, *RemoveSecretArgs) (*emptypb.Empty, error)
+	// Starts again, in dependency order, the services that were running before the enclave was stopped, waiting for
+	// each one to satisfy its ready conditions before starting the next
+	ResumeServices(context.Context, *emptypb.Empty) (*ResumeServicesResponse, error)
+	// Records the services currently started, before they get stopped along with the enclave, so that ResumeServices
+	// starts exactly those again once the enclave is started
+	RecordServicesToResume(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	// Returns a public key that the secrets of another enclave can be sealed with, so that only this API container can
+	// open them once they're passed to ReplayEnclavePlan. A new key is returned on every call, and only the last one
+	// returned can be used
+	GetSecretsRecipientPublicKey(context.Context, *emptypb.Empty) (*GetSecretsRecipientPublicKeyResponse, error)
+	// Exports the enclave plan, along with the runtime values it refers to, so that it can be replayed in another enclave
+	ExportEnclavePlan(context.Context, *ExportEnclavePlanArgs) (*ExportEnclavePlanResponse, error)
+	// Replays in this enclave an enclave plan exported from another enclave
+	ReplayEnclavePlan(*ReplayEnclavePlanArgs, ApiContainerService_ReplayEnclavePlanServer) error
+	// Streams what happens in the enclave: Starlark runs, services and files artifacts. The most recent events, including
+	// the ones that happened before the call, are sent first so that watchers can resume where they left off
+	WatchEvents(*WatchEventsArgs, ApiContainerService_WatchEventsServer) error
+	// Returns, for each service added by the enclave plan, the services it depends on, i.e. the ones whose runtime values
+	// or files artifacts its add_service instruction consumes, directly or through other instructions
+	GetServiceDependencies(context.Context, *emptypb.Empty) (*GetServiceDependenciesResponse, error)
+	// Waits for a service to satisfy the ready conditions it was added with; services added without any return right away
+	WaitForServiceReadiness(context.Context, *WaitForServiceReadinessArgs) (*emptypb.Empty, error)
+	// Returns the last time a client used the API container, e.g. to run Starlark, exec into a service or look up its
+	// ports, so that the engine knows whether the enclave is idle
+	GetLastActivityTime(context.Context, *emptypb.Empty) (*GetLastActivityTimeResponse, error)
 }
 
 // UnimplementedApiContainerServiceServer should be embedded to have forward compatible implementations.
@@ -431,6 +792,9 @@ func (UnimplementedApiContainerServiceServer) GetExistingAndHistoricalServiceIde
 func (UnimplementedApiContainerServiceServer) ExecCommand(context.Context, *ExecCommandArgs) (*ExecCommandResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExecCommand not implemented")
 }
+func (UnimplementedApiContainerServiceServer) StreamExecCommand(ApiContainerService_StreamExecCommandServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamExecCommand not implemented")
+}
 func (UnimplementedApiContainerServiceServer) WaitForHttpGetEndpointAvailability(context.Context, *WaitForHttpGetEndpointAvailabilityArgs) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WaitForHttpGetEndpointAvailability not implemented")
 }
@@ -440,6 +804,9 @@ func (UnimplementedApiContainerServiceServer) WaitForHttpPostEndpointAvailabilit
 func (UnimplementedApiContainerServiceServer) UploadFilesArtifact(ApiContainerService_UploadFilesArtifactServer) error {
 	return status.Errorf(codes.Unimplemented, "method UploadFilesArtifact not implemented")
 }
+func (UnimplementedApiContainerServiceServer) GetMissingFilesArtifactBlobs(context.Context, *GetMissingFilesArtifactBlobsArgs) (*GetMissingFilesArtifactBlobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMissingFilesArtifactBlobs not implemented")
+}
 func (UnimplementedApiContainerServiceServer) DownloadFilesArtifact(*DownloadFilesArtifactArgs, ApiContainerService_DownloadFilesArtifactServer) error {
 	return status.Errorf(codes.Unimplemented, "method DownloadFilesArtifact not implemented")
 }
@@ -455,18 +822,66 @@ func (UnimplementedApiContainerServiceServer) ListFilesArtifactNamesAndUuids(con
 func (UnimplementedApiContainerServiceServer) InspectFilesArtifactContents(context.Context, *InspectFilesArtifactContentsRequest) (*InspectFilesArtifactContentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InspectFilesArtifactContents not implemented")
 }
+func (UnimplementedApiContainerServiceServer) GetFilesArtifactHistory(context.Context, *GetFilesArtifactHistoryArgs) (*GetFilesArtifactHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFilesArtifactHistory not implemented")
+}
 func (UnimplementedApiContainerServiceServer) ConnectServices(context.Context, *ConnectServicesArgs) (*ConnectServicesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConnectServices not implemented")
 }
 func (UnimplementedApiContainerServiceServer) GetStarlarkRun(context.Context, *emptypb.Empty) (*GetStarlarkRunResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStarlarkRun not implemented")
 }
+func (UnimplementedApiContainerServiceServer) ListStarlarkRunRecords(context.Context, *emptypb.Empty) (*ListStarlarkRunRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStarlarkRunRecords not implemented")
+}
+func (UnimplementedApiContainerServiceServer) GetStarlarkRunRecord(context.Context, *GetStarlarkRunRecordArgs) (*StarlarkRunRecord, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStarlarkRunRecord not implemented")
+}
+func (UnimplementedApiContainerServiceServer) GetStarlarkRunRecordOutput(*GetStarlarkRunRecordArgs, ApiContainerService_GetStarlarkRunRecordOutputServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetStarlarkRunRecordOutput not implemented")
+}
 func (UnimplementedApiContainerServiceServer) GetStarlarkScriptPlanYaml(context.Context, *StarlarkScriptPlanYamlArgs) (*PlanYaml, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStarlarkScriptPlanYaml not implemented")
 }
 func (UnimplementedApiContainerServiceServer) GetStarlarkPackagePlanYaml(context.Context, *StarlarkPackagePlanYamlArgs) (*PlanYaml, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStarlarkPackagePlanYaml not implemented")
 }
+func (UnimplementedApiContainerServiceServer) SetSecret(context.Context, *SetSecretArgs) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSecret not implemented")
+}
+func (UnimplementedApiContainerServiceServer) ListSecrets(context.Context, *emptypb.Empty) (*ListSecretsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSecrets not implemented")
+}
+func (UnimplementedApiContainerServiceServer) RemoveSecret(context.Context, *RemoveSecretArgs) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveSecret not implemented")
+}
+func (UnimplementedApiContainerServiceServer) ResumeServices(context.Context, *emptypb.Empty) (*ResumeServicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeServices not implemented")
+}
+func (UnimplementedApiContainerServiceServer) RecordServicesToResume(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordServicesToResume not implemented")
+}
+func (UnimplementedApiContainerServiceServer) GetSecretsRecipientPublicKey(context.Context, *emptypb.Empty) (*GetSecretsRecipientPublicKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSecretsRecipientPublicKey not implemented")
+}
+func (UnimplementedApiContainerServiceServer) ExportEnclavePlan(context.Context, *ExportEnclavePlanArgs) (*ExportEnclavePlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportEnclavePlan not implemented")
+}
+func (UnimplementedApiContainerServiceServer) ReplayEnclavePlan(*ReplayEnclavePlanArgs, ApiContainerService_ReplayEnclavePlanServer) error {
+	return status.Errorf(codes.Unimplemented, "method ReplayEnclavePlan not implemented")
+}
+func (UnimplementedApiContainerServiceServer) WatchEvents(*WatchEventsArgs, ApiContainerService_WatchEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchEvents not implemented")
+}
+func (UnimplementedApiContainerServiceServer) GetServiceDependencies(context.Context, *emptypb.Empty) (*GetServiceDependenciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServiceDependencies not implemented")
+}
+func (UnimplementedApiContainerServiceServer) WaitForServiceReadiness(context.Context, *WaitForServiceReadinessArgs) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WaitForServiceReadiness not implemented")
+}
+func (UnimplementedApiContainerServiceServer) GetLastActivityTime(context.Context, *emptypb.Empty) (*GetLastActivityTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLastActivityTime not implemented")
+}
 
 // UnsafeApiContainerServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to ApiContainerServiceServer will
@@ -601,6 +1016,32 @@ func _ApiContainerService_ExecCommand_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiContainerService_StreamExecCommand_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ApiContainerServiceServer).StreamExecCommand(&apiContainerServiceStreamExecCommandServer{stream})
+}
+
+type ApiContainerService_StreamExecCommandServer interface {
+	Send(*StreamExecCommandResponse) error
+	Recv() (*StreamExecCommandArgs, error)
+	grpc.ServerStream
+}
+
+type apiContainerServiceStreamExecCommandServer struct {
+	grpc.ServerStream
+}
+
+func (x *apiContainerServiceStreamExecCommandServer) Send(m *StreamExecCommandResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *apiContainerServiceStreamExecCommandServer) Recv() (*StreamExecCommandArgs, error) {
+	m := new(StreamExecCommandArgs)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _ApiContainerService_WaitForHttpGetEndpointAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(WaitForHttpGetEndpointAvailabilityArgs)
 	if err := dec(in); err != nil {
@@ -663,6 +1104,24 @@ func (x *apiContainerServiceUploadFilesArtifactServer) Recv() (*StreamedDataChun
 	return m, nil
 }
 
+func _ApiContainerService_GetMissingFilesArtifactBlobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMissingFilesArtifactBlobsArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).GetMissingFilesArtifactBlobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_GetMissingFilesArtifactBlobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).GetMissingFilesArtifactBlobs(ctx, req.(*GetMissingFilesArtifactBlobsArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApiContainerService_DownloadFilesArtifact_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(DownloadFilesArtifactArgs)
 	if err := stream.RecvMsg(m); err != nil {
@@ -756,6 +1215,24 @@ func _ApiContainerService_InspectFilesArtifactContents_Handler(srv interface{},
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiContainerService_GetFilesArtifactHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFilesArtifactHistoryArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).GetFilesArtifactHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_GetFilesArtifactHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).GetFilesArtifactHistory(ctx, req.(*GetFilesArtifactHistoryArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApiContainerService_ConnectServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ConnectServicesArgs)
 	if err := dec(in); err != nil {
@@ -792,6 +1269,63 @@ func _ApiContainerService_GetStarlarkRun_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiContainerService_ListStarlarkRunRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).ListStarlarkRunRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_ListStarlarkRunRecords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).ListStarlarkRunRecords(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiContainerService_GetStarlarkRunRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStarlarkRunRecordArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).GetStarlarkRunRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_GetStarlarkRunRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).GetStarlarkRunRecord(ctx, req.(*GetStarlarkRunRecordArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiContainerService_GetStarlarkRunRecordOutput_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetStarlarkRunRecordArgs)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApiContainerServiceServer).GetStarlarkRunRecordOutput(m, &apiContainerServiceGetStarlarkRunRecordOutputServer{stream})
+}
+
+type ApiContainerService_GetStarlarkRunRecordOutputServer interface {
+	Send(*StarlarkRunResponseLine) error
+	grpc.ServerStream
+}
+
+type apiContainerServiceGetStarlarkRunRecordOutputServer struct {
+	grpc.ServerStream
+}
+
+func (x *apiContainerServiceGetStarlarkRunRecordOutputServer) Send(m *StarlarkRunResponseLine) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _ApiContainerService_GetStarlarkScriptPlanYaml_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StarlarkScriptPlanYamlArgs)
 	if err := dec(in); err != nil {
@@ -828,6 +1362,228 @@ func _ApiContainerService_GetStarlarkPackagePlanYaml_Handler(srv interface{}, ct
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiContainerService_SetSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSecretArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).SetSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_SetSecret_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).SetSecret(ctx, req.(*SetSecretArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiContainerService_ListSecrets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).ListSecrets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_ListSecrets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).ListSecrets(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiContainerService_RemoveSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveSecretArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).RemoveSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_RemoveSecret_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).RemoveSecret(ctx, req.(*RemoveSecretArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiContainerService_ResumeServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).ResumeServices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_ResumeServices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).ResumeServices(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiContainerService_RecordServicesToResume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).RecordServicesToResume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_RecordServicesToResume_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).RecordServicesToResume(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiContainerService_GetSecretsRecipientPublicKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).GetSecretsRecipientPublicKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_GetSecretsRecipientPublicKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).GetSecretsRecipientPublicKey(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiContainerService_ExportEnclavePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportEnclavePlanArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).ExportEnclavePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_ExportEnclavePlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).ExportEnclavePlan(ctx, req.(*ExportEnclavePlanArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiContainerService_ReplayEnclavePlan_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReplayEnclavePlanArgs)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApiContainerServiceServer).ReplayEnclavePlan(m, &apiContainerServiceReplayEnclavePlanServer{stream})
+}
+
+type ApiContainerService_ReplayEnclavePlanServer interface {
+	Send(*StarlarkRunResponseLine) error
+	grpc.ServerStream
+}
+
+type apiContainerServiceReplayEnclavePlanServer struct {
+	grpc.ServerStream
+}
+
+func (x *apiContainerServiceReplayEnclavePlanServer) Send(m *StarlarkRunResponseLine) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ApiContainerService_WatchEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchEventsArgs)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApiContainerServiceServer).WatchEvents(m, &apiContainerServiceWatchEventsServer{stream})
+}
+
+type ApiContainerService_WatchEventsServer interface {
+	Send(*ApiContainerEvent) error
+	grpc.ServerStream
+}
+
+type apiContainerServiceWatchEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *apiContainerServiceWatchEventsServer) Send(m *ApiContainerEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ApiContainerService_GetServiceDependencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).GetServiceDependencies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_GetServiceDependencies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).GetServiceDependencies(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiContainerService_WaitForServiceReadiness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WaitForServiceReadinessArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).WaitForServiceReadiness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_WaitForServiceReadiness_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).WaitForServiceReadiness(ctx, req.(*WaitForServiceReadinessArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiContainerService_GetLastActivityTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).GetLastActivityTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_GetLastActivityTime_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).GetLastActivityTime(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ApiContainerService_ServiceDesc is the grpc.ServiceDesc for ApiContainerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -855,6 +1611,10 @@ var ApiContainerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "WaitForHttpPostEndpointAvailability",
 			Handler:    _ApiContainerService_WaitForHttpPostEndpointAvailability_Handler,
 		},
+		{
+			MethodName: "GetMissingFilesArtifactBlobs",
+			Handler:    _ApiContainerService_GetMissingFilesArtifactBlobs_Handler,
+		},
 		{
 			MethodName: "StoreWebFilesArtifact",
 			Handler:    _ApiContainerService_StoreWebFilesArtifact_Handler,
@@ -871,6 +1631,10 @@ var ApiContainerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "InspectFilesArtifactContents",
 			Handler:    _ApiContainerService_InspectFilesArtifactContents_Handler,
 		},
+		{
+			MethodName: "GetFilesArtifactHistory",
+			Handler:    _ApiContainerService_GetFilesArtifactHistory_Handler,
+		},
 		{
 			MethodName: "ConnectServices",
 			Handler:    _ApiContainerService_ConnectServices_Handler,
@@ -879,6 +1643,14 @@ var ApiContainerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetStarlarkRun",
 			Handler:    _ApiContainerService_GetStarlarkRun_Handler,
 		},
+		{
+			MethodName: "ListStarlarkRunRecords",
+			Handler:    _ApiContainerService_ListStarlarkRunRecords_Handler,
+		},
+		{
+			MethodName: "GetStarlarkRunRecord",
+			Handler:    _ApiContainerService_GetStarlarkRunRecord_Handler,
+		},
 		{
 			MethodName: "GetStarlarkScriptPlanYaml",
 			Handler:    _ApiContainerService_GetStarlarkScriptPlanYaml_Handler,
@@ -887,6 +1659,46 @@ var ApiContainerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetStarlarkPackagePlanYaml",
 			Handler:    _ApiContainerService_GetStarlarkPackagePlanYaml_Handler,
 		},
+		{
+			MethodName: "SetSecret",
+			Handler:    _ApiContainerService_SetSecret_Handler,
+		},
+		{
+			MethodName: "ListSecrets",
+			Handler:    _ApiContainerService_ListSecrets_Handler,
+		},
+		{
+			MethodName: "RemoveSecret",
+			Handler:    _ApiContainerService_RemoveSecret_Handler,
+		},
+		{
+			MethodName: "ResumeServices",
+			Handler:    _ApiContainerService_ResumeServices_Handler,
+		},
+		{
+			MethodName: "RecordServicesToResume",
+			Handler:    _ApiContainerService_RecordServicesToResume_Handler,
+		},
+		{
+			MethodName: "GetSecretsRecipientPublicKey",
+			Handler:    _ApiContainerService_GetSecretsRecipientPublicKey_Handler,
+		},
+		{
+			MethodName: "ExportEnclavePlan",
+			Handler:    _ApiContainerService_ExportEnclavePlan_Handler,
+		},
+		{
+			MethodName: "GetServiceDependencies",
+			Handler:    _ApiContainerService_GetServiceDependencies_Handler,
+		},
+		{
+			MethodName: "WaitForServiceReadiness",
+			Handler:    _ApiContainerService_WaitForServiceReadiness_Handler,
+		},
+		{
+			MethodName: "GetLastActivityTime",
+			Handler:    _ApiContainerService_GetLastActivityTime_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -904,6 +1716,12 @@ var ApiContainerService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _ApiContainerService_RunStarlarkPackage_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "StreamExecCommand",
+			Handler:       _ApiContainerService_StreamExecCommand_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "UploadFilesArtifact",
 			Handler:       _ApiContainerService_UploadFilesArtifact_Handler,
@@ -914,6 +1732,21 @@ var ApiContainerService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _ApiContainerService_DownloadFilesArtifact_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetStarlarkRunRecordOutput",
+			Handler:       _ApiContainerService_GetStarlarkRunRecordOutput_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ReplayEnclavePlan",
+			Handler:       _ApiContainerService_ReplayEnclavePlan_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchEvents",
+			Handler:       _ApiContainerService_WatchEvents_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "api_container_service.proto",
 }
@@ -52,6 +52,9 @@ const (
 	// ApiContainerServiceExecCommandProcedure is the fully-qualified name of the ApiContainerService's
 	// ExecCommand RPC.
 	ApiContainerServiceExecCommandProcedure = "/api_container_api.ApiContainerService/ExecCommand"
+	// ApiContainerServiceStreamExecCommandProcedure is the fully-qualified name of the
+	// ApiContainerService's StreamExecCommand RPC.
+	ApiContainerServiceStreamExecCommandProcedure = "/api_container_api.ApiContainerService/StreamExecCommand"
 	// ApiContainerServiceWaitForHttpGetEndpointAvailabilityProcedure is the fully-qualified name of the
 	// ApiContainerService's WaitForHttpGetEndpointAvailability RPC.
 	ApiContainerServiceWaitForHttpGetEndpointAvailabilityProcedure = "/api_container_api.ApiContainerService/WaitForHttpGetEndpointAvailability"
@@ -61,6 +64,9 @@ const (
 	// ApiContainerServiceUploadFilesArtifactProcedure is the fully-qualified name of the
 	// ApiContainerService's UploadFilesArtifact RPC.
 	ApiContainerServiceUploadFilesArtifactProcedure = "/api_container_api.ApiContainerService/UploadFilesArtifact"
+	// ApiContainerServiceGetMissingFilesArtifactBlobsProcedure is the fully-qualified name of the
+	// ApiContainerService's GetMissingFilesArtifactBlobs RPC.
+	ApiContainerServiceGetMissingFilesArtifactBlobsProcedure = "/api_container_api.ApiContainerService/GetMissingFilesArtifactBlobs"
 	// ApiContainerServiceDownloadFilesArtifactProcedure is the fully-qualified name of the
 	// ApiContainerService's DownloadFilesArtifact RPC.
 	ApiContainerServiceDownloadFilesArtifactProcedure = "/api_container_api.ApiContainerService/DownloadFilesArtifact"
@@ -76,18 +82,66 @@ const (
 	// ApiContainerServiceInspectFilesArtifactContentsProcedure is the fully-qualified name of the
 	// ApiContainerService's InspectFilesArtifactContents RPC.
 	ApiContainerServiceInspectFilesArtifactContentsProcedure = "/api_container_api.ApiContainerService/InspectFilesArtifactContents"
+	// ApiContainerServiceGetFilesArtifactHistoryProcedure is the fully-qualified name of the
+	// ApiContainerService's GetFilesArtifactHistory RPC.
+	ApiContainerServiceGetFilesArtifactHistoryProcedure = "/api_container_api.ApiContainerService/GetFilesArtifactHistory"
 	// ApiContainerServiceConnectServicesProcedure is the fully-qualified name of the
 	// ApiContainerService's ConnectServices RPC.
 	ApiContainerServiceConnectServicesProcedure = "/api_container_api.ApiContainerService/ConnectServices"
 	// ApiContainerServiceGetStarlarkRunProcedure is the fully-qualified name of the
 	// ApiContainerService's GetStarlarkRun RPC.
 	ApiContainerServiceGetStarlarkRunProcedure = "/api_container_api.ApiContainerService/GetStarlarkRun"
+	// ApiContainerServiceListStarlarkRunRecordsProcedure is the fully-qualified name of the
+	// ApiContainerService's ListStarlarkRunRecords RPC.
+	ApiContainerServiceListStarlarkRunRecordsProcedure = "/api_container_api.ApiContainerService/ListStarlarkRunRecords"
+	// ApiContainerServiceGetStarlarkRunRecordProcedure is the fully-qualified name of the
+	// ApiContainerService's GetStarlarkRunRecord RPC.
+	ApiContainerServiceGetStarlarkRunRecordProcedure = "/api_container_api.ApiContainerService/GetStarlarkRunRecord"
+	// ApiContainerServiceGetStarlarkRunRecordOutputProcedure is the fully-qualified name of the
+	// ApiContainerService's GetStarlarkRunRecordOutput RPC.
+	ApiContainerServiceGetStarlarkRunRecordOutputProcedure = "/api_container_api.ApiContainerService/GetStarlarkRunRecordOutput"
 	// ApiContainerServiceGetStarlarkScriptPlanYamlProcedure is the fully-qualified name of the
 	// ApiContainerService's GetStarlarkScriptPlanYaml RPC.
 	ApiContainerServiceGetStarlarkScriptPlanYamlProcedure = "/api_container_api.ApiContainerService/GetStarlarkScriptPlanYaml"
 	// ApiContainerServiceGetStarlarkPackagePlanYamlProcedure is the fully-qualified name of the
 	// ApiContainerService's GetStarlarkPackagePlanYaml RPC.
 	ApiContainerServiceGetStarlarkPackagePlanYamlProcedure = "/api_container_api.ApiContainerService/GetStarlarkPackagePlanYaml"
+	// ApiContainerServiceSetSecretProcedure is the fully-qualified name of the ApiContainerService's
+	// SetSecret RPC.
+	ApiContainerServiceSetSecretProcedure = "/api_container_api.ApiContainerService/SetSecret"
+	// ApiContainerServiceListSecretsProcedure is the fully-qualified name of the ApiContainerService's
+	// ListSecrets RPC.
+	ApiContainerServiceListSecretsProcedure = "/api_container_api.ApiContainerService/ListSecrets"
+	// ApiContainerServiceRemoveSecretProcedure is the fully-qualified name of the ApiContainerService's
+	// RemoveSecret RPC.
+	ApiContainerServiceRemoveSecretProcedure = "/api_container_api.ApiContainerService/RemoveSecret"
+	// ApiContainerServiceResumeServicesProcedure is the fully-qualified name of the
+	// ApiContainerService's ResumeServices RPC.
+	ApiContainerServiceResumeServicesProcedure = "/api_container_api.ApiContainerService/ResumeServices"
+	// ApiContainerServiceRecordServicesToResumeProcedure is the fully-qualified name of the
+	// ApiContainerService's RecordServicesToResume RPC.
+	ApiContainerServiceRecordServicesToResumeProcedure = "/api_container_api.ApiContainerService/RecordServicesToResume"
+	// ApiContainerServiceGetSecretsRecipientPublicKeyProcedure is the fully-qualified name of the
+	// ApiContainerService's GetSecretsRecipientPublicKey RPC.
+	ApiContainerServiceGetSecretsRecipientPublicKeyProcedure = "/api_container_api.ApiContainerService/GetSecretsRecipientPublicKey"
+	// ApiContainerServiceExportEnclavePlanProcedure is the fully-qualified name of the
+	// ApiContainerService's ExportEnclavePlan RPC.
+	ApiContainerServiceExportEnclavePlanProcedure = "/api_container_api.ApiContainerService/ExportEnclavePlan"
+	// ApiContainerServiceReplayEnclavePlanProcedure is the fully-qualified name of the
+	// ApiContainerService's ReplayEnclavePlan RPC.
+	ApiContainerServiceReplayEnclavePlanProcedure = "/api_container_api.ApiContainerService/ReplayEnclavePlan"
+	// ApiContainerServiceWatchEventsProcedure is the fully-qualified name of the ApiContainerService's
+	// WatchEvents RPC.
+	ApiContainerServiceWatchEventsProcedure = "/api_container_api.ApiContainerService/WatchEvents"
+	// ApiContainerServiceGetServiceDependenciesProcedure is the fully-qualified name of the
+	// ApiContainerService's GetServiceDependencies RPC.
+	ApiContainerServiceGetServiceDependenciesProcedure = "/api_container_api.ApiContainerService/GetServiceDependencies"
+	// ApiContainerServiceWaitForServiceReadinessProcedure is the fully-qualified name of the
+	// ApiContainerService's WaitForServiceReadiness RPC.
+	ApiContainerServiceWaitForServiceReadinessProcedure = "/api_container_api.ApiContainerService/WaitForServiceReadiness"
+	// ApiContainerServiceGetLastActivityTimeProcedure is the fully-qualified name of the
+	// ApiContainerService's GetLastActivityTime RPC.
+	ApiContainerServiceGetLastActivityTimeProcedure = "/api_container_api.ApiContainerService/GetLastActivityTime"
 )
 
 // ApiContainerServiceClient is a client for the api_container_api.ApiContainerService service.
@@ -104,12 +158,17 @@ type ApiContainerServiceClient interface {
 	GetExistingAndHistoricalServiceIdentifiers(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetExistingAndHistoricalServiceIdentifiersResponse], error)
 	// Executes the given command inside a running container
 	ExecCommand(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.ExecCommandArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.ExecCommandResponse], error)
+	// Executes the given command inside a running container, streaming its output back as it is produced. The first
+	// message sent by the client must contain the command to run; the following ones carry stdin and terminal resizes
+	StreamExecCommand(context.Context) *connect.BidiStreamForClient[kurtosis_core_rpc_api_bindings.StreamExecCommandArgs, kurtosis_core_rpc_api_bindings.StreamExecCommandResponse]
 	// Block until the given HTTP endpoint returns available, calling it through a HTTP Get request
 	WaitForHttpGetEndpointAvailability(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.WaitForHttpGetEndpointAvailabilityArgs]) (*connect.Response[emptypb.Empty], error)
 	// Block until the given HTTP endpoint returns available, calling it through a HTTP Post request
 	WaitForHttpPostEndpointAvailability(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.WaitForHttpPostEndpointAvailabilityArgs]) (*connect.Response[emptypb.Empty], error)
 	// Uploads a files artifact to the Kurtosis File System
 	UploadFilesArtifact(context.Context) *connect.ClientStreamForClient[kurtosis_core_rpc_api_bindings.StreamedDataChunk, kurtosis_core_rpc_api_bindings.UploadFilesArtifactResponse]
+	// Returns which of the given file contents the Kurtosis File System doesn't store yet, so that uploads can leave out the others
+	GetMissingFilesArtifactBlobs(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.GetMissingFilesArtifactBlobsArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetMissingFilesArtifactBlobsResponse], error)
 	// Downloads a files artifact from the Kurtosis File System
 	DownloadFilesArtifact(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.DownloadFilesArtifactArgs]) (*connect.ServerStreamForClient[kurtosis_core_rpc_api_bindings.StreamedDataChunk], error)
 	// Tells the API container to download a files artifact from the web to the Kurtosis File System
@@ -118,14 +177,54 @@ type ApiContainerServiceClient interface {
 	StoreFilesArtifactFromService(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.StoreFilesArtifactFromServiceArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.StoreFilesArtifactFromServiceResponse], error)
 	ListFilesArtifactNamesAndUuids(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.ListFilesArtifactNamesAndUuidsResponse], error)
 	InspectFilesArtifactContents(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.InspectFilesArtifactContentsRequest]) (*connect.Response[kurtosis_core_rpc_api_bindings.InspectFilesArtifactContentsResponse], error)
+	// Returns the versions kept of a files artifact, each update adding one. A version can be referred to by suffixing the
+	// files artifact identifier with '@v<version>'
+	GetFilesArtifactHistory(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.GetFilesArtifactHistoryArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetFilesArtifactHistoryResponse], error)
 	// User services port forwarding
 	ConnectServices(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.ConnectServicesArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.ConnectServicesResponse], error)
 	// Get last Starlark run
 	GetStarlarkRun(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetStarlarkRunResponse], error)
+	// Lists every Starlark run of the enclave, oldest first, without their output
+	ListStarlarkRunRecords(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.ListStarlarkRunRecordsResponse], error)
+	// Gets a Starlark run of the enclave, without its output
+	GetStarlarkRunRecord(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.GetStarlarkRunRecordArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.StarlarkRunRecord], error)
+	// Streams the recorded output of a Starlark run of the enclave, line by line
+	GetStarlarkRunRecordOutput(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.GetStarlarkRunRecordArgs]) (*connect.ServerStreamForClient[kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine], error)
 	// Gets yaml representing the plan the script will execute in an enclave
 	GetStarlarkScriptPlanYaml(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.StarlarkScriptPlanYamlArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.PlanYaml], error)
 	// Gets yaml representing the plan the package will execute in an enclave
 	GetStarlarkPackagePlanYaml(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.StarlarkPackagePlanYamlArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.PlanYaml], error)
+	// Stores a secret in the enclave secret store, overwriting any previous value stored under the same name
+	SetSecret(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.SetSecretArgs]) (*connect.Response[emptypb.Empty], error)
+	// Returns the names of the secrets stored in the enclave. Secret values are never returned by the API
+	ListSecrets(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.ListSecretsResponse], error)
+	// Removes a secret from the enclave secret store
+	RemoveSecret(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.RemoveSecretArgs]) (*connect.Response[emptypb.Empty], error)
+	// Starts again, in dependency order, the services that were running before the enclave was stopped, waiting for
+	// each one to satisfy its ready conditions before starting the next
+	ResumeServices(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.ResumeServicesResponse], error)
+	// Records the services currently started, before they get stopped along with the enclave, so that ResumeServices
+	// starts exactly those again once the enclave is started
+	RecordServicesToResume(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[emptypb.Empty], error)
+	// Returns a public key that the secrets of another enclave can be sealed with, so that only this API container can
+	// open them once they're passed to ReplayEnclavePlan. A new key is returned on every call, and only the last one
+	// returned can be used
+	GetSecretsRecipientPublicKey(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetSecretsRecipientPublicKeyResponse], error)
+	// Exports the enclave plan, along with the runtime values it refers to, so that it can be replayed in another enclave
+	ExportEnclavePlan(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.ExportEnclavePlanArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.ExportEnclavePlanResponse], error)
+	// Replays in this enclave an enclave plan exported from another enclave
+	ReplayEnclavePlan(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.ReplayEnclavePlanArgs]) (*connect.ServerStreamForClient[kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine], error)
+	// Streams what happens in the enclave: Starlark runs, services and files artifacts. The most recent events, including
+	// the ones that happened before the call, are sent first so that watchers can resume where they left off
+	WatchEvents(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.WatchEventsArgs]) (*connect.ServerStreamForClient[kurtosis_core_rpc_api_bindings.ApiContainerEvent], error)
+	// Returns, for each service added by the enclave plan, the services it depends on, i.e. the ones whose runtime values
+	// or files artifacts its add_service instruction consumes, directly or through other instructions
+	GetServiceDependencies(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetServiceDependenciesResponse], error)
+	// Waits for a service to satisfy the ready conditions it was added with; services added without any return right away
+	WaitForServiceReadiness(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.WaitForServiceReadinessArgs]) (*connect.Response[emptypb.Empty], error)
+	// Returns the last time a client used the API container, e.g. to run Starlark, exec into a service or look up its
+	// ports, so that the engine knows whether the enclave is idle
+	GetLastActivityTime(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetLastActivityTimeResponse], error)
 }
 
 // NewApiContainerServiceClient constructs a client for the api_container_api.ApiContainerService
@@ -175,6 +274,12 @@ func NewApiContainerServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(apiContainerServiceMethods.ByName("ExecCommand")),
 			connect.WithClientOptions(opts...),
 		),
+		streamExecCommand: connect.NewClient[kurtosis_core_rpc_api_bindings.StreamExecCommandArgs, kurtosis_core_rpc_api_bindings.StreamExecCommandResponse](
+			httpClient,
+			baseURL+ApiContainerServiceStreamExecCommandProcedure,
+			connect.WithSchema(apiContainerServiceMethods.ByName("StreamExecCommand")),
+			connect.WithClientOptions(opts...),
+		),
 		waitForHttpGetEndpointAvailability: connect.NewClient[kurtosis_core_rpc_api_bindings.WaitForHttpGetEndpointAvailabilityArgs, emptypb.Empty](
 			httpClient,
 			baseURL+ApiContainerServiceWaitForHttpGetEndpointAvailabilityProcedure,
@@ -193,6 +298,12 @@ func NewApiContainerServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(apiContainerServiceMethods.ByName("UploadFilesArtifact")),
 			connect.WithClientOptions(opts...),
 		),
+		getMissingFilesArtifactBlobs: connect.NewClient[kurtosis_core_rpc_api_bindings.GetMissingFilesArtifactBlobsArgs, kurtosis_core_rpc_api_bindings.GetMissingFilesArtifactBlobsResponse](
+			httpClient,
+			baseURL+ApiContainerServiceGetMissingFilesArtifactBlobsProcedure,
+			connect.WithSchema(apiContainerServiceMethods.ByName("GetMissingFilesArtifactBlobs")),
+			connect.WithClientOptions(opts...),
+		),
 		downloadFilesArtifact: connect.NewClient[kurtosis_core_rpc_api_bindings.DownloadFilesArtifactArgs, kurtosis_core_rpc_api_bindings.StreamedDataChunk](
 			httpClient,
 			baseURL+ApiContainerServiceDownloadFilesArtifactProcedure,
@@ -223,6 +334,12 @@ func NewApiContainerServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(apiContainerServiceMethods.ByName("InspectFilesArtifactContents")),
 			connect.WithClientOptions(opts...),
 		),
+		getFilesArtifactHistory: connect.NewClient[kurtosis_core_rpc_api_bindings.GetFilesArtifactHistoryArgs, kurtosis_core_rpc_api_bindings.GetFilesArtifactHistoryResponse](
+			httpClient,
+			baseURL+ApiContainerServiceGetFilesArtifactHistoryProcedure,
+			connect.WithSchema(apiContainerServiceMethods.ByName("GetFilesArtifactHistory")),
+			connect.WithClientOptions(opts...),
+		),
 		connectServices: connect.NewClient[kurtosis_core_rpc_api_bindings.ConnectServicesArgs, kurtosis_core_rpc_api_bindings.ConnectServicesResponse](
 			httpClient,
 			baseURL+ApiContainerServiceConnectServicesProcedure,
@@ -235,6 +352,24 @@ func NewApiContainerServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(apiContainerServiceMethods.ByName("GetStarlarkRun")),
 			connect.WithClientOptions(opts...),
 		),
+		listStarlarkRunRecords: connect.NewClient[emptypb.Empty, kurtosis_core_rpc_api_bindings.ListStarlarkRunRecordsResponse](
+			httpClient,
+			baseURL+ApiContainerServiceListStarlarkRunRecordsProcedure,
+			connect.WithSchema(apiContainerServiceMethods.ByName("ListStarlarkRunRecords")),
+			connect.WithClientOptions(opts...),
+		),
+		getStarlarkRunRecord: connect.NewClient[kurtosis_core_rpc_api_bindings.GetStarlarkRunRecordArgs, kurtosis_core_rpc_api_bindings.StarlarkRunRecord](
+			httpClient,
+			baseURL+ApiContainerServiceGetStarlarkRunRecordProcedure,
+			connect.WithSchema(apiContainerServiceMethods.ByName("GetStarlarkRunRecord")),
+			connect.WithClientOptions(opts...),
+		),
+		getStarlarkRunRecordOutput: connect.NewClient[kurtosis_core_rpc_api_bindings.GetStarlarkRunRecordArgs, kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine](
+			httpClient,
+			baseURL+ApiContainerServiceGetStarlarkRunRecordOutputProcedure,
+			connect.WithSchema(apiContainerServiceMethods.ByName("GetStarlarkRunRecordOutput")),
+			connect.WithClientOptions(opts...),
+		),
 		getStarlarkScriptPlanYaml: connect.NewClient[kurtosis_core_rpc_api_bindings.StarlarkScriptPlanYamlArgs, kurtosis_core_rpc_api_bindings.PlanYaml](
 			httpClient,
 			baseURL+ApiContainerServiceGetStarlarkScriptPlanYamlProcedure,
@@ -247,6 +382,78 @@ func NewApiContainerServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(apiContainerServiceMethods.ByName("GetStarlarkPackagePlanYaml")),
 			connect.WithClientOptions(opts...),
 		),
+		setSecret: connect.NewClient[kurtosis_core_rpc_api_bindings.SetSecretArgs, emptypb.Empty](
+			httpClient,
+			baseURL+ApiContainerServiceSetSecretProcedure,
+			connect.WithSchema(apiContainerServiceMethods.ByName("SetSecret")),
+			connect.WithClientOptions(opts...),
+		),
+		listSecrets: connect.NewClient[emptypb.Empty, kurtosis_core_rpc_api_bindings.ListSecretsResponse](
+			httpClient,
+			baseURL+ApiContainerServiceListSecretsProcedure,
+			connect.WithSchema(apiContainerServiceMethods.ByName("ListSecrets")),
+			connect.WithClientOptions(opts...),
+		),
+		removeSecret: connect.NewClient[kurtosis_core_rpc_api_bindings.RemoveSecretArgs, emptypb.Empty](
+			httpClient,
+			baseURL+ApiContainerServiceRemoveSecretProcedure,
+			connect.WithSchema(apiContainerServiceMethods.ByName("RemoveSecret")),
+			connect.WithClientOptions(opts...),
+		),
+		resumeServices: connect.NewClient[emptypb.Empty, kurtosis_core_rpc_api_bindings.ResumeServicesResponse](
+			httpClient,
+			baseURL+ApiContainerServiceResumeServicesProcedure,
+			connect.WithSchema(apiContainerServiceMethods.ByName("ResumeServices")),
+			connect.WithClientOptions(opts...),
+		),
+		recordServicesToResume: connect.NewClient[emptypb.Empty, emptypb.Empty](
+			httpClient,
+			baseURL+ApiContainerServiceRecordServicesToResumeProcedure,
+			connect.WithSchema(apiContainerServiceMethods.ByName("RecordServicesToResume")),
+			connect.WithClientOptions(opts...),
+		),
+		getSecretsRecipientPublicKey: connect.NewClient[emptypb.Empty, kurtosis_core_rpc_api_bindings.GetSecretsRecipientPublicKeyResponse](
+			httpClient,
+			baseURL+ApiContainerServiceGetSecretsRecipientPublicKeyProcedure,
+			connect.WithSchema(apiContainerServiceMethods.ByName("GetSecretsRecipientPublicKey")),
+			connect.WithClientOptions(opts...),
+		),
+		exportEnclavePlan: connect.NewClient[kurtosis_core_rpc_api_bindings.ExportEnclavePlanArgs, kurtosis_core_rpc_api_bindings.ExportEnclavePlanResponse](
+			httpClient,
+			baseURL+ApiContainerServiceExportEnclavePlanProcedure,
+			connect.WithSchema(apiContainerServiceMethods.ByName("ExportEnclavePlan")),
+			connect.WithClientOptions(opts...),
+		),
+		replayEnclavePlan: connect.NewClient[kurtosis_core_rpc_api_bindings.ReplayEnclavePlanArgs, kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine](
+			httpClient,
+			baseURL+ApiContainerServiceReplayEnclavePlanProcedure,
+			connect.WithSchema(apiContainerServiceMethods.ByName("ReplayEnclavePlan")),
+			connect.WithClientOptions(opts...),
+		),
+		watchEvents: connect.NewClient[kurtosis_core_rpc_api_bindings.WatchEventsArgs, kurtosis_core_rpc_api_bindings.ApiContainerEvent](
+			httpClient,
+			baseURL+ApiContainerServiceWatchEventsProcedure,
+			connect.WithSchema(apiContainerServiceMethods.ByName("WatchEvents")),
+			connect.WithClientOptions(opts...),
+		),
+		getServiceDependencies: connect.NewClient[emptypb.Empty, kurtosis_core_rpc_api_bindings.GetServiceDependenciesResponse](
+			httpClient,
+			baseURL+ApiContainerServiceGetServiceDependenciesProcedure,
+			connect.WithSchema(apiContainerServiceMethods.ByName("GetServiceDependencies")),
+			connect.WithClientOptions(opts...),
+		),
+		waitForServiceReadiness: connect.NewClient[kurtosis_core_rpc_api_bindings.WaitForServiceReadinessArgs, emptypb.Empty](
+			httpClient,
+			baseURL+ApiContainerServiceWaitForServiceReadinessProcedure,
+			connect.WithSchema(apiContainerServiceMethods.ByName("WaitForServiceReadiness")),
+			connect.WithClientOptions(opts...),
+		),
+		getLastActivityTime: connect.NewClient[emptypb.Empty, kurtosis_core_rpc_api_bindings.GetLastActivityTimeResponse](
+			httpClient,
+			baseURL+ApiContainerServiceGetLastActivityTimeProcedure,
+			connect.WithSchema(apiContainerServiceMethods.ByName("GetLastActivityTime")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -258,18 +465,36 @@ type apiContainerServiceClient struct {
 	getServices                                *connect.Client[kurtosis_core_rpc_api_bindings.GetServicesArgs, kurtosis_core_rpc_api_bindings.GetServicesResponse]
 	getExistingAndHistoricalServiceIdentifiers *connect.Client[emptypb.Empty, kurtosis_core_rpc_api_bindings.GetExistingAndHistoricalServiceIdentifiersResponse]
 	execCommand                                *connect.Client[kurtosis_core_rpc_api_bindings.ExecCommandArgs, kurtosis_core_rpc_api_bindings.ExecCommandResponse]
+	streamExecCommand                          *connect.Client[kurtosis_core_rpc_api_bindings.StreamExecCommandArgs, kurtosis_core_rpc_api_bindings.StreamExecCommandResponse]
 	waitForHttpGetEndpointAvailability         *connect.Client[kurtosis_core_rpc_api_bindings.WaitForHttpGetEndpointAvailabilityArgs, emptypb.Empty]
 	waitForHttpPostEndpointAvailability        *connect.Client[kurtosis_core_rpc_api_bindings.WaitForHttpPostEndpointAvailabilityArgs, emptypb.Empty]
 	uploadFilesArtifact                        *connect.Client[kurtosis_core_rpc_api_bindings.StreamedDataChunk, kurtosis_core_rpc_api_bindings.UploadFilesArtifactResponse]
+	getMissingFilesArtifactBlobs               *connect.Client[kurtosis_core_rpc_api_bindings.GetMissingFilesArtifactBlobsArgs, kurtosis_core_rpc_api_bindings.GetMissingFilesArtifactBlobsResponse]
 	downloadFilesArtifact                      *connect.Client[kurtosis_core_rpc_api_bindings.DownloadFilesArtifactArgs, kurtosis_core_rpc_api_bindings.StreamedDataChunk]
 	storeWebFilesArtifact                      *connect.Client[kurtosis_core_rpc_api_bindings.StoreWebFilesArtifactArgs, kurtosis_core_rpc_api_bindings.StoreWebFilesArtifactResponse]
 	storeFilesArtifactFromService              *connect.Client[kurtosis_core_rpc_api_bindings.StoreFilesArtifactFromServiceArgs, kurtosis_core_rpc_api_bindings.StoreFilesArtifactFromServiceResponse]
 	listFilesArtifactNamesAndUuids             *connect.Client[emptypb.Empty, kurtosis_core_rpc_api_bindings.ListFilesArtifactNamesAndUuidsResponse]
 	inspectFilesArtifactContents               *connect.Client[kurtosis_core_rpc_api_bindings.InspectFilesArtifactContentsRequest, kurtosis_core_rpc_api_bindings.InspectFilesArtifactContentsResponse]
+	getFilesArtifactHistory                    *connect.Client[kurtosis_core_rpc_api_bindings.GetFilesArtifactHistoryArgs, kurtosis_core_rpc_api_bindings.GetFilesArtifactHistoryResponse]
 	connectServices                            *connect.Client[kurtosis_core_rpc_api_bindings.ConnectServicesArgs, kurtosis_core_rpc_api_bindings.ConnectServicesResponse]
 	getStarlarkRun                             *connect.Client[emptypb.Empty, kurtosis_core_rpc_api_bindings.GetStarlarkRunResponse]
+	listStarlarkRunRecords                     *connect.Client[emptypb.Empty, kurtosis_core_rpc_api_bindings.ListStarlarkRunRecordsResponse]
+	getStarlarkRunRecord                       *connect.Client[kurtosis_core_rpc_api_bindings.GetStarlarkRunRecordArgs, kurtosis_core_rpc_api_bindings.StarlarkRunRecord]
+	getStarlarkRunRecordOutput                 *connect.Client[kurtosis_core_rpc_api_bindings.GetStarlarkRunRecordArgs, kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine]
 	getStarlarkScriptPlanYaml                  *connect.Client[kurtosis_core_rpc_api_bindings.StarlarkScriptPlanYamlArgs, kurtosis_core_rpc_api_bindings.PlanYaml]
 	getStarlarkPackagePlanYaml                 *connect.Client[kurtosis_core_rpc_api_bindings.StarlarkPackagePlanYamlArgs, kurtosis_core_rpc_api_bindings.PlanYaml]
+	setSecret                                  *connect.Client[kurtosis_core_rpc_api_bindings.SetSecretArgs, emptypb.Empty]
+	listSecrets                                *connect.Client[emptypb.Empty, kurtosis_core_rpc_api_bindings.ListSecretsResponse]
+	removeSecret                               *connect.Client[kurtosis_core_rpc_api_bindings.RemoveSecretArgs, emptypb.Empty]
+	resumeServices                             *connect.Client[emptypb.Empty, kurtosis_core_rpc_api_bindings.ResumeServicesResponse]
+	recordServicesToResume                     *connect.Client[emptypb.Empty, emptypb.Empty]
+	getSecretsRecipientPublicKey               *connect.Client[emptypb.Empty, kurtosis_core_rpc_api_bindings.GetSecretsRecipientPublicKeyResponse]
+	exportEnclavePlan                          *connect.Client[kurtosis_core_rpc_api_bindings.ExportEnclavePlanArgs, kurtosis_core_rpc_api_bindings.ExportEnclavePlanResponse]
+	replayEnclavePlan                          *connect.Client[kurtosis_core_rpc_api_bindings.ReplayEnclavePlanArgs, kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine]
+	watchEvents                                *connect.Client[kurtosis_core_rpc_api_bindings.WatchEventsArgs, kurtosis_core_rpc_api_bindings.ApiContainerEvent]
+	getServiceDependencies                     *connect.Client[emptypb.Empty, kurtosis_core_rpc_api_bindings.GetServiceDependenciesResponse]
+	waitForServiceReadiness                    *connect.Client[kurtosis_core_rpc_api_bindings.WaitForServiceReadinessArgs, emptypb.Empty]
+	getLastActivityTime                        *connect.Client[emptypb.Empty, kurtosis_core_rpc_api_bindings.GetLastActivityTimeResponse]
 }
 
 // RunStarlarkScript calls api_container_api.ApiContainerService.RunStarlarkScript.
@@ -303,6 +528,11 @@ func (c *apiContainerServiceClient) ExecCommand(ctx context.Context, req *connec
 	return c.execCommand.CallUnary(ctx, req)
 }
 
+// StreamExecCommand calls api_container_api.ApiContainerService.StreamExecCommand.
+func (c *apiContainerServiceClient) StreamExecCommand(ctx context.Context) *connect.BidiStreamForClient[kurtosis_core_rpc_api_bindings.StreamExecCommandArgs, kurtosis_core_rpc_api_bindings.StreamExecCommandResponse] {
+	return c.streamExecCommand.CallBidiStream(ctx)
+}
+
 // WaitForHttpGetEndpointAvailability calls
 // api_container_api.ApiContainerService.WaitForHttpGetEndpointAvailability.
 func (c *apiContainerServiceClient) WaitForHttpGetEndpointAvailability(ctx context.Context, req *connect.Request[kurtosis_core_rpc_api_bindings.WaitForHttpGetEndpointAvailabilityArgs]) (*connect.Response[emptypb.Empty], error) {
@@ -320,6 +550,12 @@ func (c *apiContainerServiceClient) UploadFilesArtifact(ctx context.Context) *co
 	return c.uploadFilesArtifact.CallClientStream(ctx)
 }
 
+// GetMissingFilesArtifactBlobs calls
+// api_container_api.ApiContainerService.GetMissingFilesArtifactBlobs.
+func (c *apiContainerServiceClient) GetMissingFilesArtifactBlobs(ctx context.Context, req *connect.Request[kurtosis_core_rpc_api_bindings.GetMissingFilesArtifactBlobsArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetMissingFilesArtifactBlobsResponse], error) {
+	return c.getMissingFilesArtifactBlobs.CallUnary(ctx, req)
+}
+
 // DownloadFilesArtifact calls api_container_api.ApiContainerService.DownloadFilesArtifact.
 func (c *apiContainerServiceClient) DownloadFilesArtifact(ctx context.Context, req *connect.Request[kurtosis_core_rpc_api_bindings.DownloadFilesArtifactArgs]) (*connect.ServerStreamForClient[kurtosis_core_rpc_api_bindings.StreamedDataChunk], error) {
 	return c.downloadFilesArtifact.CallServerStream(ctx, req)
@@ -348,6 +584,11 @@ func (c *apiContainerServiceClient) InspectFilesArtifactContents(ctx context.Con
 	return c.inspectFilesArtifactContents.CallUnary(ctx, req)
 }
 
+// GetFilesArtifactHistory calls api_container_api.ApiContainerService.GetFilesArtifactHistory.
+func (c *apiContainerServiceClient) GetFilesArtifactHistory(ctx context.Context, req *connect.Request[kurtosis_core_rpc_api_bindings.GetFilesArtifactHistoryArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetFilesArtifactHistoryResponse], error) {
+	return c.getFilesArtifactHistory.CallUnary(ctx, req)
+}
+
 // ConnectServices calls api_container_api.ApiContainerService.ConnectServices.
 func (c *apiContainerServiceClient) ConnectServices(ctx context.Context, req *connect.Request[kurtosis_core_rpc_api_bindings.ConnectServicesArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.ConnectServicesResponse], error) {
 	return c.connectServices.CallUnary(ctx, req)
@@ -358,6 +599,22 @@ func (c *apiContainerServiceClient) GetStarlarkRun(ctx context.Context, req *con
 	return c.getStarlarkRun.CallUnary(ctx, req)
 }
 
+// ListStarlarkRunRecords calls api_container_api.ApiContainerService.ListStarlarkRunRecords.
+func (c *apiContainerServiceClient) ListStarlarkRunRecords(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.ListStarlarkRunRecordsResponse], error) {
+	return c.listStarlarkRunRecords.CallUnary(ctx, req)
+}
+
+// GetStarlarkRunRecord calls api_container_api.ApiContainerService.GetStarlarkRunRecord.
+func (c *apiContainerServiceClient) GetStarlarkRunRecord(ctx context.Context, req *connect.Request[kurtosis_core_rpc_api_bindings.GetStarlarkRunRecordArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.StarlarkRunRecord], error) {
+	return c.getStarlarkRunRecord.CallUnary(ctx, req)
+}
+
+// GetStarlarkRunRecordOutput calls
+// api_container_api.ApiContainerService.GetStarlarkRunRecordOutput.
+func (c *apiContainerServiceClient) GetStarlarkRunRecordOutput(ctx context.Context, req *connect.Request[kurtosis_core_rpc_api_bindings.GetStarlarkRunRecordArgs]) (*connect.ServerStreamForClient[kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine], error) {
+	return c.getStarlarkRunRecordOutput.CallServerStream(ctx, req)
+}
+
 // GetStarlarkScriptPlanYaml calls api_container_api.ApiContainerService.GetStarlarkScriptPlanYaml.
 func (c *apiContainerServiceClient) GetStarlarkScriptPlanYaml(ctx context.Context, req *connect.Request[kurtosis_core_rpc_api_bindings.StarlarkScriptPlanYamlArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.PlanYaml], error) {
 	return c.getStarlarkScriptPlanYaml.CallUnary(ctx, req)
@@ -369,6 +626,67 @@ func (c *apiContainerServiceClient) GetStarlarkPackagePlanYaml(ctx context.Conte
 	return c.getStarlarkPackagePlanYaml.CallUnary(ctx, req)
 }
 
+// SetSecret calls api_container_api.ApiContainerService.SetSecret.
+func (c *apiContainerServiceClient) SetSecret(ctx context.Context, req *connect.Request[kurtosis_core_rpc_api_bindings.SetSecretArgs]) (*connect.Response[emptypb.Empty], error) {
+	return c.setSecret.CallUnary(ctx, req)
+}
+
+// ListSecrets calls api_container_api.ApiContainerService.ListSecrets.
+func (c *apiContainerServiceClient) ListSecrets(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.ListSecretsResponse], error) {
+	return c.listSecrets.CallUnary(ctx, req)
+}
+
+// RemoveSecret calls api_container_api.ApiContainerService.RemoveSecret.
+func (c *apiContainerServiceClient) RemoveSecret(ctx context.Context, req *connect.Request[kurtosis_core_rpc_api_bindings.RemoveSecretArgs]) (*connect.Response[emptypb.Empty], error) {
+	return c.removeSecret.CallUnary(ctx, req)
+}
+
+// ResumeServices calls api_container_api.ApiContainerService.ResumeServices.
+func (c *apiContainerServiceClient) ResumeServices(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.ResumeServicesResponse], error) {
+	return c.resumeServices.CallUnary(ctx, req)
+}
+
+// RecordServicesToResume calls api_container_api.ApiContainerService.RecordServicesToResume.
+func (c *apiContainerServiceClient) RecordServicesToResume(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[emptypb.Empty], error) {
+	return c.recordServicesToResume.CallUnary(ctx, req)
+}
+
+// GetSecretsRecipientPublicKey calls
+// api_container_api.ApiContainerService.GetSecretsRecipientPublicKey.
+func (c *apiContainerServiceClient) GetSecretsRecipientPublicKey(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetSecretsRecipientPublicKeyResponse], error) {
+	return c.getSecretsRecipientPublicKey.CallUnary(ctx, req)
+}
+
+// ExportEnclavePlan calls api_container_api.ApiContainerService.ExportEnclavePlan.
+func (c *apiContainerServiceClient) ExportEnclavePlan(ctx context.Context, req *connect.Request[kurtosis_core_rpc_api_bindings.ExportEnclavePlanArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.ExportEnclavePlanResponse], error) {
+	return c.exportEnclavePlan.CallUnary(ctx, req)
+}
+
+// ReplayEnclavePlan calls api_container_api.ApiContainerService.ReplayEnclavePlan.
+func (c *apiContainerServiceClient) ReplayEnclavePlan(ctx context.Context, req *connect.Request[kurtosis_core_rpc_api_bindings.ReplayEnclavePlanArgs]) (*connect.ServerStreamForClient[kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine], error) {
+	return c.replayEnclavePlan.CallServerStream(ctx, req)
+}
+
+// WatchEvents calls api_container_api.ApiContainerService.WatchEvents.
+func (c *apiContainerServiceClient) WatchEvents(ctx context.Context, req *connect.Request[kurtosis_core_rpc_api_bindings.WatchEventsArgs]) (*connect.ServerStreamForClient[kurtosis_core_rpc_api_bindings.ApiContainerEvent], error) {
+	return c.watchEvents.CallServerStream(ctx, req)
+}
+
+// GetServiceDependencies calls api_container_api.ApiContainerService.GetServiceDependencies.
+func (c *apiContainerServiceClient) GetServiceDependencies(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetServiceDependenciesResponse], error) {
+	return c.getServiceDependencies.CallUnary(ctx, req)
+}
+
+// WaitForServiceReadiness calls api_container_api.ApiContainerService.WaitForServiceReadiness.
+func (c *apiContainerServiceClient) WaitForServiceReadiness(ctx context.Context, req *connect.Request[kurtosis_core_rpc_api_bindings.WaitForServiceReadinessArgs]) (*connect.Response[emptypb.Empty], error) {
+	return c.waitForServiceReadiness.CallUnary(ctx, req)
+}
+
+// GetLastActivityTime calls api_container_api.ApiContainerService.GetLastActivityTime.
+func (c *apiContainerServiceClient) GetLastActivityTime(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetLastActivityTimeResponse], error) {
+	return c.getLastActivityTime.CallUnary(ctx, req)
+}
+
 // ApiContainerServiceHandler is an implementation of the api_container_api.ApiContainerService
 // service.
 type ApiContainerServiceHandler interface {
@@ -384,12 +702,17 @@ type ApiContainerServiceHandler interface {
 	GetExistingAndHistoricalServiceIdentifiers(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetExistingAndHistoricalServiceIdentifiersResponse], error)
 	// Executes the given command inside a running container
 	ExecCommand(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.ExecCommandArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.ExecCommandResponse], error)
+	// Executes the given command inside a running container, streaming its output back as it is produced. The first
+	// message sent by the client must contain the command to run; the following ones carry stdin and terminal resizes
+	StreamExecCommand(context.Context, *connect.BidiStream[kurtosis_core_rpc_api_bindings.StreamExecCommandArgs, kurtosis_core_rpc_api_bindings.StreamExecCommandResponse]) error
 	// Block until the given HTTP endpoint returns available, calling it through a HTTP Get request
 	WaitForHttpGetEndpointAvailability(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.WaitForHttpGetEndpointAvailabilityArgs]) (*connect.Response[emptypb.Empty], error)
 	// Block until the given HTTP endpoint returns available, calling it through a HTTP Post request
 	WaitForHttpPostEndpointAvailability(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.WaitForHttpPostEndpointAvailabilityArgs]) (*connect.Response[emptypb.Empty], error)
 	// Uploads a files artifact to the Kurtosis File System
 	UploadFilesArtifact(context.Context, *connect.ClientStream[kurtosis_core_rpc_api_bindings.StreamedDataChunk]) (*connect.Response[kurtosis_core_rpc_api_bindings.UploadFilesArtifactResponse], error)
+	// Returns which of the given file contents the Kurtosis File System doesn't store yet, so that uploads can leave out the others
+	GetMissingFilesArtifactBlobs(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.GetMissingFilesArtifactBlobsArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetMissingFilesArtifactBlobsResponse], error)
 	// Downloads a files artifact from the Kurtosis File System
 	DownloadFilesArtifact(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.DownloadFilesArtifactArgs], *connect.ServerStream[kurtosis_core_rpc_api_bindings.StreamedDataChunk]) error
 	// Tells the API container to download a files artifact from the web to the Kurtosis File System
@@ -398,14 +721,54 @@ type ApiContainerServiceHandler interface {
 	StoreFilesArtifactFromService(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.StoreFilesArtifactFromServiceArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.StoreFilesArtifactFromServiceResponse], error)
 	ListFilesArtifactNamesAndUuids(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.ListFilesArtifactNamesAndUuidsResponse], error)
 	InspectFilesArtifactContents(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.InspectFilesArtifactContentsRequest]) (*connect.Response[kurtosis_core_rpc_api_bindings.InspectFilesArtifactContentsResponse], error)
+	// Returns the versions kept of a files artifact, each update adding one. A version can be referred to by suffixing the
+	// files artifact identifier with '@v<version>'
+	GetFilesArtifactHistory(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.GetFilesArtifactHistoryArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetFilesArtifactHistoryResponse], error)
 	// User services port forwarding
 	ConnectServices(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.ConnectServicesArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.ConnectServicesResponse], error)
 	// Get last Starlark run
 	GetStarlarkRun(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetStarlarkRunResponse], error)
+	// Lists every Starlark run of the enclave, oldest first, without their output
+	ListStarlarkRunRecords(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.ListStarlarkRunRecordsResponse], error)
+	// Gets a Starlark run of the enclave, without its output
+	GetStarlarkRunRecord(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.GetStarlarkRunRecordArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.StarlarkRunRecord], error)
+	// Streams the recorded output of a Starlark run of the enclave, line by line
+	GetStarlarkRunRecordOutput(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.GetStarlarkRunRecordArgs], *connect.ServerStream[kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine]) error
 	// Gets yaml representing the plan the script will execute in an enclave
 	GetStarlarkScriptPlanYaml(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.StarlarkScriptPlanYamlArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.PlanYaml], error)
 	// Gets yaml representing the plan the package will execute in an enclave
 	GetStarlarkPackagePlanYaml(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.StarlarkPackagePlanYamlArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.PlanYaml], error)
+	// Stores a secret in the enclave secret store, overwriting any previous value stored under the same name
+	SetSecret(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.SetSecretArgs]) (*connect.Response[emptypb.Empty], error)
+	// Returns the names of the secrets stored in the enclave. Secret values are never returned by the API
+	ListSecrets(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.ListSecretsResponse], error)
+	// Removes a secret from the enclave secret store
+	RemoveSecret(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.RemoveSecretArgs]) (*connect.Response[emptypb.Empty], error)
+	// Starts again, in dependency order, the services that were running before the enclave was stopped, waiting for
+	// each one to satisfy its ready conditions before starting the next
+	ResumeServices(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.ResumeServicesResponse], error)
+	// Records the services currently started, before they get stopped along with the enclave, so that ResumeServices
+	// starts exactly those again once the enclave is started
+	RecordServicesToResume(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[emptypb.Empty], error)
+	// Returns a public key that the secrets of another enclave can be sealed with, so that only this API container can
+	// open them once they're passed to ReplayEnclavePlan. A new key is returned on every call, and only the last one
+	// returned can be used
+	GetSecretsRecipientPublicKey(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetSecretsRecipientPublicKeyResponse], error)
+	// Exports the enclave plan, along with the runtime values it refers to, so that it can be replayed in another enclave
+	ExportEnclavePlan(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.ExportEnclavePlanArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.ExportEnclavePlanResponse], error)
+	// Replays in this enclave an enclave plan exported from another enclave
+	ReplayEnclavePlan(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.ReplayEnclavePlanArgs], *connect.ServerStream[kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine]) error
+	// Streams what happens in the enclave: Starlark runs, services and files artifacts. The most recent events, including
+	// the ones that happened before the call, are sent first so that watchers can resume where they left off
+	WatchEvents(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.WatchEventsArgs], *connect.ServerStream[kurtosis_core_rpc_api_bindings.ApiContainerEvent]) error
+	// Returns, for each service added by the enclave plan, the services it depends on, i.e. the ones whose runtime values
+	// or files artifacts its add_service instruction consumes, directly or through other instructions
+	GetServiceDependencies(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetServiceDependenciesResponse], error)
+	// Waits for a service to satisfy the ready conditions it was added with; services added without any return right away
+	WaitForServiceReadiness(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.WaitForServiceReadinessArgs]) (*connect.Response[emptypb.Empty], error)
+	// Returns the last time a client used the API container, e.g. to run Starlark, exec into a service or look up its
+	// ports, so that the engine knows whether the enclave is idle
+	GetLastActivityTime(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetLastActivityTimeResponse], error)
 }
 
 // NewApiContainerServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -451,6 +814,12 @@ func NewApiContainerServiceHandler(svc ApiContainerServiceHandler, opts ...conne
 		connect.WithSchema(apiContainerServiceMethods.ByName("ExecCommand")),
 		connect.WithHandlerOptions(opts...),
 	)
+	apiContainerServiceStreamExecCommandHandler := connect.NewBidiStreamHandler(
+		ApiContainerServiceStreamExecCommandProcedure,
+		svc.StreamExecCommand,
+		connect.WithSchema(apiContainerServiceMethods.ByName("StreamExecCommand")),
+		connect.WithHandlerOptions(opts...),
+	)
 	apiContainerServiceWaitForHttpGetEndpointAvailabilityHandler := connect.NewUnaryHandler(
 		ApiContainerServiceWaitForHttpGetEndpointAvailabilityProcedure,
 		svc.WaitForHttpGetEndpointAvailability,
@@ -469,6 +838,12 @@ func NewApiContainerServiceHandler(svc ApiContainerServiceHandler, opts ...conne
 		connect.WithSchema(apiContainerServiceMethods.ByName("UploadFilesArtifact")),
 		connect.WithHandlerOptions(opts...),
 	)
+	apiContainerServiceGetMissingFilesArtifactBlobsHandler := connect.NewUnaryHandler(
+		ApiContainerServiceGetMissingFilesArtifactBlobsProcedure,
+		svc.GetMissingFilesArtifactBlobs,
+		connect.WithSchema(apiContainerServiceMethods.ByName("GetMissingFilesArtifactBlobs")),
+		connect.WithHandlerOptions(opts...),
+	)
 	apiContainerServiceDownloadFilesArtifactHandler := connect.NewServerStreamHandler(
 		ApiContainerServiceDownloadFilesArtifactProcedure,
 		svc.DownloadFilesArtifact,
@@ -499,6 +874,12 @@ func NewApiContainerServiceHandler(svc ApiContainerServiceHandler, opts ...conne
 		connect.WithSchema(apiContainerServiceMethods.ByName("InspectFilesArtifactContents")),
 		connect.WithHandlerOptions(opts...),
 	)
+	apiContainerServiceGetFilesArtifactHistoryHandler := connect.NewUnaryHandler(
+		ApiContainerServiceGetFilesArtifactHistoryProcedure,
+		svc.GetFilesArtifactHistory,
+		connect.WithSchema(apiContainerServiceMethods.ByName("GetFilesArtifactHistory")),
+		connect.WithHandlerOptions(opts...),
+	)
 	apiContainerServiceConnectServicesHandler := connect.NewUnaryHandler(
 		ApiContainerServiceConnectServicesProcedure,
 		svc.ConnectServices,
@@ -511,6 +892,24 @@ func NewApiContainerServiceHandler(svc ApiContainerServiceHandler, opts ...conne
 		connect.WithSchema(apiContainerServiceMethods.ByName("GetStarlarkRun")),
 		connect.WithHandlerOptions(opts...),
 	)
+	apiContainerServiceListStarlarkRunRecordsHandler := connect.NewUnaryHandler(
+		ApiContainerServiceListStarlarkRunRecordsProcedure,
+		svc.ListStarlarkRunRecords,
+		connect.WithSchema(apiContainerServiceMethods.ByName("ListStarlarkRunRecords")),
+		connect.WithHandlerOptions(opts...),
+	)
+	apiContainerServiceGetStarlarkRunRecordHandler := connect.NewUnaryHandler(
+		ApiContainerServiceGetStarlarkRunRecordProcedure,
+		svc.GetStarlarkRunRecord,
+		connect.WithSchema(apiContainerServiceMethods.ByName("GetStarlarkRunRecord")),
+		connect.WithHandlerOptions(opts...),
+	)
+	apiContainerServiceGetStarlarkRunRecordOutputHandler := connect.NewServerStreamHandler(
+		ApiContainerServiceGetStarlarkRunRecordOutputProcedure,
+		svc.GetStarlarkRunRecordOutput,
+		connect.WithSchema(apiContainerServiceMethods.ByName("GetStarlarkRunRecordOutput")),
+		connect.WithHandlerOptions(opts...),
+	)
 	apiContainerServiceGetStarlarkScriptPlanYamlHandler := connect.NewUnaryHandler(
 		ApiContainerServiceGetStarlarkScriptPlanYamlProcedure,
 		svc.GetStarlarkScriptPlanYaml,
@@ -523,6 +922,78 @@ func NewApiContainerServiceHandler(svc ApiContainerServiceHandler, opts ...conne
 		connect.WithSchema(apiContainerServiceMethods.ByName("GetStarlarkPackagePlanYaml")),
 		connect.WithHandlerOptions(opts...),
 	)
+	apiContainerServiceSetSecretHandler := connect.NewUnaryHandler(
+		ApiContainerServiceSetSecretProcedure,
+		svc.SetSecret,
+		connect.WithSchema(apiContainerServiceMethods.ByName("SetSecret")),
+		connect.WithHandlerOptions(opts...),
+	)
+	apiContainerServiceListSecretsHandler := connect.NewUnaryHandler(
+		ApiContainerServiceListSecretsProcedure,
+		svc.ListSecrets,
+		connect.WithSchema(apiContainerServiceMethods.ByName("ListSecrets")),
+		connect.WithHandlerOptions(opts...),
+	)
+	apiContainerServiceRemoveSecretHandler := connect.NewUnaryHandler(
+		ApiContainerServiceRemoveSecretProcedure,
+		svc.RemoveSecret,
+		connect.WithSchema(apiContainerServiceMethods.ByName("RemoveSecret")),
+		connect.WithHandlerOptions(opts...),
+	)
+	apiContainerServiceResumeServicesHandler := connect.NewUnaryHandler(
+		ApiContainerServiceResumeServicesProcedure,
+		svc.ResumeServices,
+		connect.WithSchema(apiContainerServiceMethods.ByName("ResumeServices")),
+		connect.WithHandlerOptions(opts...),
+	)
+	apiContainerServiceRecordServicesToResumeHandler := connect.NewUnaryHandler(
+		ApiContainerServiceRecordServicesToResumeProcedure,
+		svc.RecordServicesToResume,
+		connect.WithSchema(apiContainerServiceMethods.ByName("RecordServicesToResume")),
+		connect.WithHandlerOptions(opts...),
+	)
+	apiContainerServiceGetSecretsRecipientPublicKeyHandler := connect.NewUnaryHandler(
+		ApiContainerServiceGetSecretsRecipientPublicKeyProcedure,
+		svc.GetSecretsRecipientPublicKey,
+		connect.WithSchema(apiContainerServiceMethods.ByName("GetSecretsRecipientPublicKey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	apiContainerServiceExportEnclavePlanHandler := connect.NewUnaryHandler(
+		ApiContainerServiceExportEnclavePlanProcedure,
+		svc.ExportEnclavePlan,
+		connect.WithSchema(apiContainerServiceMethods.ByName("ExportEnclavePlan")),
+		connect.WithHandlerOptions(opts...),
+	)
+	apiContainerServiceReplayEnclavePlanHandler := connect.NewServerStreamHandler(
+		ApiContainerServiceReplayEnclavePlanProcedure,
+		svc.ReplayEnclavePlan,
+		connect.WithSchema(apiContainerServiceMethods.ByName("ReplayEnclavePlan")),
+		connect.WithHandlerOptions(opts...),
+	)
+	apiContainerServiceWatchEventsHandler := connect.NewServerStreamHandler(
+		ApiContainerServiceWatchEventsProcedure,
+		svc.WatchEvents,
+		connect.WithSchema(apiContainerServiceMethods.ByName("WatchEvents")),
+		connect.WithHandlerOptions(opts...),
+	)
+	apiContainerServiceGetServiceDependenciesHandler := connect.NewUnaryHandler(
+		ApiContainerServiceGetServiceDependenciesProcedure,
+		svc.GetServiceDependencies,
+		connect.WithSchema(apiContainerServiceMethods.ByName("GetServiceDependencies")),
+		connect.WithHandlerOptions(opts...),
+	)
+	apiContainerServiceWaitForServiceReadinessHandler := connect.NewUnaryHandler(
+		ApiContainerServiceWaitForServiceReadinessProcedure,
+		svc.WaitForServiceReadiness,
+		connect.WithSchema(apiContainerServiceMethods.ByName("WaitForServiceReadiness")),
+		connect.WithHandlerOptions(opts...),
+	)
+	apiContainerServiceGetLastActivityTimeHandler := connect.NewUnaryHandler(
+		ApiContainerServiceGetLastActivityTimeProcedure,
+		svc.GetLastActivityTime,
+		connect.WithSchema(apiContainerServiceMethods.ByName("GetLastActivityTime")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/api_container_api.ApiContainerService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ApiContainerServiceRunStarlarkScriptProcedure:
@@ -537,12 +1008,16 @@ func NewApiContainerServiceHandler(svc ApiContainerServiceHandler, opts ...conne
 			apiContainerServiceGetExistingAndHistoricalServiceIdentifiersHandler.ServeHTTP(w, r)
 		case ApiContainerServiceExecCommandProcedure:
 			apiContainerServiceExecCommandHandler.ServeHTTP(w, r)
+		case ApiContainerServiceStreamExecCommandProcedure:
+			apiContainerServiceStreamExecCommandHandler.ServeHTTP(w, r)
 		case ApiContainerServiceWaitForHttpGetEndpointAvailabilityProcedure:
 			apiContainerServiceWaitForHttpGetEndpointAvailabilityHandler.ServeHTTP(w, r)
 		case ApiContainerServiceWaitForHttpPostEndpointAvailabilityProcedure:
 			apiContainerServiceWaitForHttpPostEndpointAvailabilityHandler.ServeHTTP(w, r)
 		case ApiContainerServiceUploadFilesArtifactProcedure:
 			apiContainerServiceUploadFilesArtifactHandler.ServeHTTP(w, r)
+		case ApiContainerServiceGetMissingFilesArtifactBlobsProcedure:
+			apiContainerServiceGetMissingFilesArtifactBlobsHandler.ServeHTTP(w, r)
 		case ApiContainerServiceDownloadFilesArtifactProcedure:
 			apiContainerServiceDownloadFilesArtifactHandler.ServeHTTP(w, r)
 		case ApiContainerServiceStoreWebFilesArtifactProcedure:
@@ -553,14 +1028,46 @@ func NewApiContainerServiceHandler(svc ApiContainerServiceHandler, opts ...conne
 			apiContainerServiceListFilesArtifactNamesAndUuidsHandler.ServeHTTP(w, r)
 		case ApiContainerServiceInspectFilesArtifactContentsProcedure:
 			apiContainerServiceInspectFilesArtifactContentsHandler.ServeHTTP(w, r)
+		case ApiContainerServiceGetFilesArtifactHistoryProcedure:
+			apiContainerServiceGetFilesArtifactHistoryHandler.ServeHTTP(w, r)
 		case ApiContainerServiceConnectServicesProcedure:
 			apiContainerServiceConnectServicesHandler.ServeHTTP(w, r)
 		case ApiContainerServiceGetStarlarkRunProcedure:
 			apiContainerServiceGetStarlarkRunHandler.ServeHTTP(w, r)
+		case ApiContainerServiceListStarlarkRunRecordsProcedure:
+			apiContainerServiceListStarlarkRunRecordsHandler.ServeHTTP(w, r)
+		case ApiContainerServiceGetStarlarkRunRecordProcedure:
+			apiContainerServiceGetStarlarkRunRecordHandler.ServeHTTP(w, r)
+		case ApiContainerServiceGetStarlarkRunRecordOutputProcedure:
+			apiContainerServiceGetStarlarkRunRecordOutputHandler.ServeHTTP(w, r)
 		case ApiContainerServiceGetStarlarkScriptPlanYamlProcedure:
 			apiContainerServiceGetStarlarkScriptPlanYamlHandler.ServeHTTP(w, r)
 		case ApiContainerServiceGetStarlarkPackagePlanYamlProcedure:
 			apiContainerServiceGetStarlarkPackagePlanYamlHandler.ServeHTTP(w, r)
+		case ApiContainerServiceSetSecretProcedure:
+			apiContainerServiceSetSecretHandler.ServeHTTP(w, r)
+		case ApiContainerServiceListSecretsProcedure:
+			apiContainerServiceListSecretsHandler.ServeHTTP(w, r)
+		case ApiContainerServiceRemoveSecretProcedure:
+			apiContainerServiceRemoveSecretHandler.ServeHTTP(w, r)
+		case ApiContainerServiceResumeServicesProcedure:
+			apiContainerServiceResumeServicesHandler.ServeHTTP(w, r)
+		case ApiContainerServiceRecordServicesToResumeProcedure:
+			apiContainerServiceRecordServicesToResumeHandler.ServeHTTP(w, r)
+		case ApiContainerServiceGetSecretsRecipientPublicKeyProcedure:
+			apiContainerServiceGetSecretsRecipientPublicKeyHandler.ServeHTTP(w, r)
+		case ApiContainerServiceExportEnclavePlanProcedure:
+			apiContainerServiceExportEnclavePlanHandler.ServeHTTP(w, r)
+		case ApiContainerServiceReplayEnclavePlanProcedure:
+			apiContainerServiceReplayEnclavePlanHandler.ServeHTTP(w, r)
+		case ApiContainerServiceWatchEventsProcedure:
+			apiContainerServiceWatchEventsHandler.ServeHTTP(w, r)
+		case ApiContainerServiceGetServiceDependenciesProcedure:
+			apiContainerServiceGetServiceDependenciesHandler.ServeHTTP(w, r)
+		case ApiContainerServiceWaitForServiceReadinessProcedure:
+			apiContainerServiceWaitForServiceReadinessHandler.ServeHTTP(w, r)
+		case ApiContainerServiceGetLastActivityTimeProcedure:
+			apiContainerServiceGetLastActivityTimeHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -594,6 +1101,10 @@ func (UnimplementedApiContainerServiceHandler) ExecCommand(context.Context, *con
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.ExecCommand is not implemented"))
 }
 
+func (UnimplementedApiContainerServiceHandler) StreamExecCommand(context.Context, *connect.BidiStream[kurtosis_core_rpc_api_bindings.StreamExecCommandArgs, kurtosis_core_rpc_api_bindings.StreamExecCommandResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.StreamExecCommand is not implemented"))
+}
+
 func (UnimplementedApiContainerServiceHandler) WaitForHttpGetEndpointAvailability(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.WaitForHttpGetEndpointAvailabilityArgs]) (*connect.Response[emptypb.Empty], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.WaitForHttpGetEndpointAvailability is not implemented"))
 }
@@ -606,6 +1117,10 @@ func (UnimplementedApiContainerServiceHandler) UploadFilesArtifact(context.Conte
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.UploadFilesArtifact is not implemented"))
 }
 
+func (UnimplementedApiContainerServiceHandler) GetMissingFilesArtifactBlobs(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.GetMissingFilesArtifactBlobsArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetMissingFilesArtifactBlobsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.GetMissingFilesArtifactBlobs is not implemented"))
+}
+
 func (UnimplementedApiContainerServiceHandler) DownloadFilesArtifact(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.DownloadFilesArtifactArgs], *connect.ServerStream[kurtosis_core_rpc_api_bindings.StreamedDataChunk]) error {
 	return connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.DownloadFilesArtifact is not implemented"))
 }
@@ -626,6 +1141,10 @@ func (UnimplementedApiContainerServiceHandler) InspectFilesArtifactContents(cont
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.InspectFilesArtifactContents is not implemented"))
 }
 
+func (UnimplementedApiContainerServiceHandler) GetFilesArtifactHistory(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.GetFilesArtifactHistoryArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetFilesArtifactHistoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.GetFilesArtifactHistory is not implemented"))
+}
+
 func (UnimplementedApiContainerServiceHandler) ConnectServices(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.ConnectServicesArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.ConnectServicesResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.ConnectServices is not implemented"))
 }
@@ -634,6 +1153,18 @@ func (UnimplementedApiContainerServiceHandler) GetStarlarkRun(context.Context, *
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.GetStarlarkRun is not implemented"))
 }
 
+func (UnimplementedApiContainerServiceHandler) ListStarlarkRunRecords(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.ListStarlarkRunRecordsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.ListStarlarkRunRecords is not implemented"))
+}
+
+func (UnimplementedApiContainerServiceHandler) GetStarlarkRunRecord(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.GetStarlarkRunRecordArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.StarlarkRunRecord], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.GetStarlarkRunRecord is not implemented"))
+}
+
+func (UnimplementedApiContainerServiceHandler) GetStarlarkRunRecordOutput(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.GetStarlarkRunRecordArgs], *connect.ServerStream[kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.GetStarlarkRunRecordOutput is not implemented"))
+}
+
 func (UnimplementedApiContainerServiceHandler) GetStarlarkScriptPlanYaml(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.StarlarkScriptPlanYamlArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.PlanYaml], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.GetStarlarkScriptPlanYaml is not implemented"))
 }
@@ -641,3 +1172,51 @@ func (UnimplementedApiContainerServiceHandler) GetStarlarkScriptPlanYaml(context
 func (UnimplementedApiContainerServiceHandler) GetStarlarkPackagePlanYaml(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.StarlarkPackagePlanYamlArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.PlanYaml], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.GetStarlarkPackagePlanYaml is not implemented"))
 }
+
+func (UnimplementedApiContainerServiceHandler) SetSecret(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.SetSecretArgs]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.SetSecret is not implemented"))
+}
+
+func (UnimplementedApiContainerServiceHandler) ListSecrets(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.ListSecretsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.ListSecrets is not implemented"))
+}
+
+func (UnimplementedApiContainerServiceHandler) RemoveSecret(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.RemoveSecretArgs]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.RemoveSecret is not implemented"))
+}
+
+func (UnimplementedApiContainerServiceHandler) ResumeServices(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.ResumeServicesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.ResumeServices is not implemented"))
+}
+
+func (UnimplementedApiContainerServiceHandler) RecordServicesToResume(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.RecordServicesToResume is not implemented"))
+}
+
+func (UnimplementedApiContainerServiceHandler) GetSecretsRecipientPublicKey(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetSecretsRecipientPublicKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.GetSecretsRecipientPublicKey is not implemented"))
+}
+
+func (UnimplementedApiContainerServiceHandler) ExportEnclavePlan(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.ExportEnclavePlanArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.ExportEnclavePlanResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.ExportEnclavePlan is not implemented"))
+}
+
+func (UnimplementedApiContainerServiceHandler) ReplayEnclavePlan(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.ReplayEnclavePlanArgs], *connect.ServerStream[kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.ReplayEnclavePlan is not implemented"))
+}
+
+func (UnimplementedApiContainerServiceHandler) WatchEvents(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.WatchEventsArgs], *connect.ServerStream[kurtosis_core_rpc_api_bindings.ApiContainerEvent]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.WatchEvents is not implemented"))
+}
+
+func (UnimplementedApiContainerServiceHandler) GetServiceDependencies(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetServiceDependenciesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.GetServiceDependencies is not implemented"))
+}
+
+func (UnimplementedApiContainerServiceHandler) WaitForServiceReadiness(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.WaitForServiceReadinessArgs]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.WaitForServiceReadiness is not implemented"))
+}
+
+func (UnimplementedApiContainerServiceHandler) GetLastActivityTime(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetLastActivityTimeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.GetLastActivityTime is not implemented"))
+}
@@ -248,6 +248,30 @@ func NewStarlarkRunResponseLineFromInstructionResultWithInstructionId(serialized
 	}
 }
 
+func NewStarlarkRunResponseLineFromInstructionOutput(outputLine string) *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine {
+	return &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine{
+		RunResponseLine: &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine_InstructionOutput{
+			InstructionOutput: &kurtosis_core_rpc_api_bindings.StarlarkInstructionOutput{
+				OutputLine:    outputLine,
+				InstructionId: nil,
+			},
+		},
+	}
+}
+
+func NewStarlarkRunResponseLineFromInstructionOutputWithInstructionId(outputLine string, instructionId string) *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine {
+	instructionIdPtr := new(string)
+	*instructionIdPtr = instructionId
+	return &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine{
+		RunResponseLine: &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine_InstructionOutput{
+			InstructionOutput: &kurtosis_core_rpc_api_bindings.StarlarkInstructionOutput{
+				OutputLine:    outputLine,
+				InstructionId: instructionIdPtr,
+			},
+		},
+	}
+}
+
 func NewStarlarkRunResponseLineFromInterpretationError(interpretationError *kurtosis_core_rpc_api_bindings.StarlarkInterpretationError) *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine {
 	return &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine{
 		RunResponseLine: &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine_Error{
@@ -522,6 +546,77 @@ func NewExecCommandResponse(exitCode int32, logOutput string) *kurtosis_core_rpc
 	}
 }
 
+// ==============================================================================================
+//
+//	Stream Exec Command
+//
+// ==============================================================================================
+
+func NewStreamExecCommandStartArgs(serviceIdentifier string, commandArgs []string, attachStdin bool, tty bool) *kurtosis_core_rpc_api_bindings.StreamExecCommandArgs {
+	return &kurtosis_core_rpc_api_bindings.StreamExecCommandArgs{
+		StreamExecCommandInput: &kurtosis_core_rpc_api_bindings.StreamExecCommandArgs_Start{
+			Start: &kurtosis_core_rpc_api_bindings.StreamExecCommandStart{
+				ServiceIdentifier: serviceIdentifier,
+				CommandArgs:       commandArgs,
+				AttachStdin:       attachStdin,
+				Tty:               tty,
+				TerminalSize:      nil,
+			},
+		},
+	}
+}
+
+func NewStreamExecCommandStdinArgs(stdin []byte) *kurtosis_core_rpc_api_bindings.StreamExecCommandArgs {
+	return &kurtosis_core_rpc_api_bindings.StreamExecCommandArgs{
+		StreamExecCommandInput: &kurtosis_core_rpc_api_bindings.StreamExecCommandArgs_Stdin{
+			Stdin: stdin,
+		},
+	}
+}
+
+func NewStreamExecCommandCloseStdinArgs() *kurtosis_core_rpc_api_bindings.StreamExecCommandArgs {
+	return &kurtosis_core_rpc_api_bindings.StreamExecCommandArgs{
+		StreamExecCommandInput: &kurtosis_core_rpc_api_bindings.StreamExecCommandArgs_CloseStdin{
+			CloseStdin: true,
+		},
+	}
+}
+
+func NewStreamExecCommandTerminalSizeArgs(width uint32, height uint32) *kurtosis_core_rpc_api_bindings.StreamExecCommandArgs {
+	return &kurtosis_core_rpc_api_bindings.StreamExecCommandArgs{
+		StreamExecCommandInput: &kurtosis_core_rpc_api_bindings.StreamExecCommandArgs_TerminalSize{
+			TerminalSize: &kurtosis_core_rpc_api_bindings.TerminalSize{
+				Width:  width,
+				Height: height,
+			},
+		},
+	}
+}
+
+func NewStreamExecCommandStdoutResponse(stdout []byte) *kurtosis_core_rpc_api_bindings.StreamExecCommandResponse {
+	return &kurtosis_core_rpc_api_bindings.StreamExecCommandResponse{
+		StreamExecCommandOutput: &kurtosis_core_rpc_api_bindings.StreamExecCommandResponse_Stdout{
+			Stdout: stdout,
+		},
+	}
+}
+
+func NewStreamExecCommandStderrResponse(stderr []byte) *kurtosis_core_rpc_api_bindings.StreamExecCommandResponse {
+	return &kurtosis_core_rpc_api_bindings.StreamExecCommandResponse{
+		StreamExecCommandOutput: &kurtosis_core_rpc_api_bindings.StreamExecCommandResponse_Stderr{
+			Stderr: stderr,
+		},
+	}
+}
+
+func NewStreamExecCommandExitCodeResponse(exitCode int32) *kurtosis_core_rpc_api_bindings.StreamExecCommandResponse {
+	return &kurtosis_core_rpc_api_bindings.StreamExecCommandResponse{
+		StreamExecCommandOutput: &kurtosis_core_rpc_api_bindings.StreamExecCommandResponse_ExitCode{
+			ExitCode: exitCode,
+		},
+	}
+}
+
 // ==============================================================================================
 //
 //	Upload Files Artifact
@@ -535,6 +630,12 @@ func NewUploadFilesArtifactResponse(artifactUuid string, artifactName string) *k
 	}
 }
 
+func NewGetMissingFilesArtifactBlobsArgs(blobSha256s []string) *kurtosis_core_rpc_api_bindings.GetMissingFilesArtifactBlobsArgs {
+	return &kurtosis_core_rpc_api_bindings.GetMissingFilesArtifactBlobsArgs{
+		BlobSha256S: blobSha256s,
+	}
+}
+
 // ==============================================================================================
 //
 //	Store Web Files Artifact
@@ -557,6 +658,18 @@ func DownloadFilesArtifactArgs(fileIdentifier string) *kurtosis_core_rpc_api_bin
 	}
 }
 
+// ==============================================================================================
+//
+//	Get Files Artifact History
+//
+// ==============================================================================================
+
+func NewGetFilesArtifactHistoryArgs(fileIdentifier string) *kurtosis_core_rpc_api_bindings.GetFilesArtifactHistoryArgs {
+	return &kurtosis_core_rpc_api_bindings.GetFilesArtifactHistoryArgs{
+		Identifier: fileIdentifier,
+	}
+}
+
 // ==============================================================================================
 //
 //	Connect Services arguments and response to configure user services port forwarding
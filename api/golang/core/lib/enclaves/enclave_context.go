@@ -472,7 +472,7 @@ func (enclaveCtx *EnclaveContext) GetServices() (map[services.ServiceName]servic
 }
 
 func (enclaveCtx *EnclaveContext) UploadFiles(pathToUpload string, artifactName string) (services.FilesArtifactUUID, services.FileArtifactName, error) {
-	content, contentSize, _, err := path_compression.CompressPath(pathToUpload, enforceMaxFileSizeLimit)
+	content, contentSize, err := enclaveCtx.compressPathForUpload(pathToUpload)
 	if err != nil {
 		return "", "", stacktrace.Propagate(err,
 			"There was an error compressing the file '%v' before upload",
@@ -506,6 +506,39 @@ func (enclaveCtx *EnclaveContext) UploadFiles(pathToUpload string, artifactName
 	return services.FilesArtifactUUID(response.GetUuid()), services.FileArtifactName(response.GetName()), nil
 }
 
+// compressPathForUpload leaves out of the archive the content of the files that the enclave already stores
+func (enclaveCtx *EnclaveContext) compressPathForUpload(pathToUpload string) (io.ReadCloser, uint64, error) {
+	blobHashesToReference := map[string]bool{}
+	fileContentSha256s, err := path_compression.ComputeFileContentSha256s(pathToUpload)
+	if err != nil {
+		return nil, 0, stacktrace.Propagate(err, "An error occurred hashing the files in '%v'", pathToUpload)
+	}
+	response, err := enclaveCtx.client.GetMissingFilesArtifactBlobs(context.Background(), binding_constructors.NewGetMissingFilesArtifactBlobsArgs(fileContentSha256s))
+	if err != nil {
+		// enclaves started with an older version of Kurtosis don't know this endpoint, everything gets uploaded to them
+		logrus.Debugf("Couldn't get the file contents the enclave is missing, all of them will be uploaded: %v", err)
+	} else {
+		missingBlobSha256s := map[string]bool{}
+		for _, missingBlobSha256 := range response.GetMissingBlobSha256S() {
+			missingBlobSha256s[missingBlobSha256] = true
+		}
+		for _, fileContentSha256 := range fileContentSha256s {
+			if !missingBlobSha256s[fileContentSha256] {
+				blobHashesToReference[fileContentSha256] = true
+			}
+		}
+	}
+
+	content, contentSize, _, err := path_compression.CompressPathWithBlobReferences(pathToUpload, enforceMaxFileSizeLimit, blobHashesToReference)
+	if err != nil {
+		return nil, 0, stacktrace.Propagate(err, "An error occurred compressing '%v'", pathToUpload)
+	}
+	if len(blobHashesToReference) > 0 {
+		logrus.Debugf("%d of the %d file contents in '%v' are already stored in the enclave and were left out of the upload", len(blobHashesToReference), len(fileContentSha256s), pathToUpload)
+	}
+	return content, contentSize, nil
+}
+
 func (enclaveCtx *EnclaveContext) StoreWebFiles(ctx context.Context, urlToStoreWeb string, artifactName string) (services.FilesArtifactUUID, error) {
 	args := binding_constructors.NewStoreWebFilesArtifactArgs(urlToStoreWeb, artifactName)
 	response, err := enclaveCtx.client.StoreWebFilesArtifact(ctx, args)
@@ -549,6 +582,16 @@ func (enclaveCtx *EnclaveContext) InspectFilesArtifact(ctx context.Context, arti
 	return response, nil
 }
 
+// GetFilesArtifactHistory returns the versions kept of the files artifact, oldest first. Any of them can be inspected or
+// downloaded by suffixing the files artifact identifier with '@v<version>'
+func (enclaveCtx *EnclaveContext) GetFilesArtifactHistory(ctx context.Context, artifactIdentifier string) (*kurtosis_core_rpc_api_bindings.GetFilesArtifactHistoryResponse, error) {
+	response, err := enclaveCtx.client.GetFilesArtifactHistory(ctx, binding_constructors.NewGetFilesArtifactHistoryArgs(artifactIdentifier))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the history of files artifact '%v'", artifactIdentifier)
+	}
+	return response, nil
+}
+
 func (enclaveCtx *EnclaveContext) GetExistingAndHistoricalServiceIdentifiers(ctx context.Context) (*services.ServiceIdentifiers, error) {
 	response, err := enclaveCtx.client.GetExistingAndHistoricalServiceIdentifiers(ctx, &emptypb.Empty{})
 	if err != nil {
@@ -582,6 +625,39 @@ func (enclaveCtx *EnclaveContext) GetStarlarkRun(ctx context.Context) (*kurtosis
 	return response, nil
 }
 
+// GetStarlarkRunRecords returns every Starlark run of the enclave, oldest first, without their output
+func (enclaveCtx *EnclaveContext) GetStarlarkRunRecords(ctx context.Context) ([]*kurtosis_core_rpc_api_bindings.StarlarkRunRecord, error) {
+	response, err := enclaveCtx.client.ListStarlarkRunRecords(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while listing the starlark runs of the enclave")
+	}
+	return response.GetStarlarkRunRecords(), nil
+}
+
+// GetStarlarkRunRecord returns a Starlark run of the enclave, without its output; use GetStarlarkRunRecordOutput to get it
+func (enclaveCtx *EnclaveContext) GetStarlarkRunRecord(ctx context.Context, runUuid string) (*kurtosis_core_rpc_api_bindings.StarlarkRunRecord, error) {
+	response, err := enclaveCtx.client.GetStarlarkRunRecord(ctx, &kurtosis_core_rpc_api_bindings.GetStarlarkRunRecordArgs{RunUuid: runUuid})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while getting starlark run '%v'", runUuid)
+	}
+	return response, nil
+}
+
+// GetStarlarkRunRecordOutput streams back the lines of output a Starlark run of the enclave streamed back when it ran.
+// The channel is closed once every line was received
+func (enclaveCtx *EnclaveContext) GetStarlarkRunRecordOutput(ctx context.Context, runUuid string) (chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, context.CancelFunc, error) {
+	ctxWithCancel, cancelCtxFunc := context.WithCancel(ctx)
+	starlarkResponseLineChan := make(chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine)
+	stream, err := enclaveCtx.client.GetStarlarkRunRecordOutput(ctxWithCancel, &kurtosis_core_rpc_api_bindings.GetStarlarkRunRecordArgs{RunUuid: runUuid})
+	if err != nil {
+		cancelCtxFunc() // manually call the cancel function as something went wrong
+		return nil, nil, stacktrace.Propagate(err, "An error occurred while getting the output of starlark run '%v'", runUuid)
+	}
+
+	go runReceiveStarlarkResponseLineRoutine(cancelCtxFunc, stream, starlarkResponseLineChan)
+	return starlarkResponseLineChan, cancelCtxFunc, nil
+}
+
 func (enclaveCtx *EnclaveContext) GetStarlarkRemotePackagePlanYaml(ctx context.Context, packageId string, serializedParams string, allowPrivilegedModeOpt ...bool) (*kurtosis_core_rpc_api_bindings.PlanYaml, error) {
 	allowPrivilegedMode := false
 	if len(allowPrivilegedModeOpt) > 0 {
@@ -666,6 +742,78 @@ func (enclaveCtx *EnclaveContext) GetStarlarkScriptPlanYaml(ctx context.Context,
 	return response, nil
 }
 
+func (enclaveCtx *EnclaveContext) SetSecret(ctx context.Context, name string, value string) error {
+	if _, err := enclaveCtx.client.SetSecret(ctx, &kurtosis_core_rpc_api_bindings.SetSecretArgs{
+		Name:  name,
+		Value: value,
+	}); err != nil {
+		return stacktrace.Propagate(err, "An error occurred setting secret '%s'", name)
+	}
+	return nil
+}
+
+func (enclaveCtx *EnclaveContext) GetSecretNames(ctx context.Context) ([]string, error) {
+	response, err := enclaveCtx.client.ListSecrets(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred listing the secrets of the enclave")
+	}
+	return response.GetSecretNames(), nil
+}
+
+func (enclaveCtx *EnclaveContext) RemoveSecret(ctx context.Context, name string) error {
+	if _, err := enclaveCtx.client.RemoveSecret(ctx, &kurtosis_core_rpc_api_bindings.RemoveSecretArgs{
+		Name: name,
+	}); err != nil {
+		return stacktrace.Propagate(err, "An error occurred removing secret '%s'", name)
+	}
+	return nil
+}
+
+// RecordServicesToResume records the services currently started, so that ResumeServices starts them again after they
+// got stopped along with the enclave
+func (enclaveCtx *EnclaveContext) RecordServicesToResume(ctx context.Context) error {
+	if _, err := enclaveCtx.client.RecordServicesToResume(ctx, &emptypb.Empty{}); err != nil {
+		return stacktrace.Propagate(err, "An error occurred recording the services of the enclave to resume")
+	}
+	return nil
+}
+
+// ResumeServices starts again the services that were running before the enclave was stopped, in dependency order, and
+// returns their names in the order they were started
+func (enclaveCtx *EnclaveContext) ResumeServices(ctx context.Context) ([]string, error) {
+	response, err := enclaveCtx.client.ResumeServices(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred resuming the services of the enclave")
+	}
+	return response.GetResumedServiceNames(), nil
+}
+
+// GetServiceDependencies returns, for each service added by the enclave plan, the services it depends on
+func (enclaveCtx *EnclaveContext) GetServiceDependencies(ctx context.Context) (map[services.ServiceName][]services.ServiceName, error) {
+	response, err := enclaveCtx.client.GetServiceDependencies(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the dependencies between the services of the enclave")
+	}
+	serviceDependencies := map[services.ServiceName][]services.ServiceName{}
+	for serviceName, dependencies := range response.GetDependenciesByServiceName() {
+		dependencyNames := []services.ServiceName{}
+		for _, dependencyName := range dependencies.GetServiceNames() {
+			dependencyNames = append(dependencyNames, services.ServiceName(dependencyName))
+		}
+		serviceDependencies[services.ServiceName(serviceName)] = dependencyNames
+	}
+	return serviceDependencies, nil
+}
+
+// WaitForServiceReadiness blocks until the service satisfies the ready conditions it was added with, if any
+func (enclaveCtx *EnclaveContext) WaitForServiceReadiness(ctx context.Context, serviceName services.ServiceName) error {
+	args := &kurtosis_core_rpc_api_bindings.WaitForServiceReadinessArgs{ServiceName: string(serviceName)}
+	if _, err := enclaveCtx.client.WaitForServiceReadiness(ctx, args); err != nil {
+		return stacktrace.Propagate(err, "An error occurred waiting for service '%v' to be ready", serviceName)
+	}
+	return nil
+}
+
 // ====================================================================================================
 //
 //	Private helper methods
@@ -18,13 +18,19 @@
 package services
 
 import (
+	"bytes"
 	"context"
+	"io"
 
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
 	"github.com/kurtosis-tech/stacktrace"
 )
 
+const (
+	streamExecCommandStdinBufferSize = 32 * 1024
+)
+
 type ServiceContext struct {
 	client      kurtosis_core_rpc_api_bindings.ApiContainerServiceClient
 	serviceName ServiceName
@@ -128,3 +134,93 @@ func (service *ServiceContext) ExecCommand(command []string) (int32, string, err
 	}
 	return resp.ExitCode, resp.LogOutput, nil
 }
+
+// StreamExecCommand runs the command in the service, writing its output to stdout and stderr as it is produced, and
+// returns its exit code. If stdin is not nil, it is forwarded to the command. If tty is set, a pseudo-terminal is
+// allocated, stderr is merged into stdout, and the sizes received on terminalSizes are applied to it
+func (service *ServiceContext) StreamExecCommand(
+	ctx context.Context,
+	command []string,
+	stdin io.Reader,
+	stdout io.Writer,
+	stderr io.Writer,
+	tty bool,
+	terminalSizes <-chan *kurtosis_core_rpc_api_bindings.TerminalSize,
+) (int32, error) {
+	serviceName := service.serviceName
+	ctxWithCancel, cancelFunc := context.WithCancel(ctx)
+	defer cancelFunc()
+
+	stream, err := service.client.StreamExecCommand(ctxWithCancel)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred opening the stream to execute command '%v' on service '%v'", command, serviceName)
+	}
+	if err := stream.Send(binding_constructors.NewStreamExecCommandStartArgs(string(serviceName), command, stdin != nil, tty)); err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred sending command '%v' to execute on service '%v'", command, serviceName)
+	}
+
+	// gRPC streams don't support concurrent sends, so stdin and terminal sizes are forwarded by the same goroutine
+	inputs := make(chan *kurtosis_core_rpc_api_bindings.StreamExecCommandArgs)
+	if stdin != nil {
+		go func() {
+			buffer := make([]byte, streamExecCommandStdinBufferSize)
+			for {
+				numBytesRead, err := stdin.Read(buffer)
+				if numBytesRead > 0 {
+					select {
+					case inputs <- binding_constructors.NewStreamExecCommandStdinArgs(bytes.Clone(buffer[:numBytesRead])):
+					case <-ctxWithCancel.Done():
+						return
+					}
+				}
+				if err != nil {
+					select {
+					case inputs <- binding_constructors.NewStreamExecCommandCloseStdinArgs():
+					case <-ctxWithCancel.Done():
+					}
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		for {
+			select {
+			case <-ctxWithCancel.Done():
+				return
+			case terminalSize, ok := <-terminalSizes:
+				if !ok {
+					// a nil channel blocks forever, so the loop keeps forwarding stdin
+					terminalSizes = nil
+					continue
+				}
+				if err := stream.Send(binding_constructors.NewStreamExecCommandTerminalSizeArgs(terminalSize.GetWidth(), terminalSize.GetHeight())); err != nil {
+					return
+				}
+			case input := <-inputs:
+				if err := stream.Send(input); err != nil {
+					return
+				}
+			}
+		}
+	}()
+
+	for {
+		response, err := stream.Recv()
+		if err != nil {
+			return 0, stacktrace.Propagate(err, "An error occurred executing command '%v' on service '%v'", command, serviceName)
+		}
+		switch output := response.GetStreamExecCommandOutput().(type) {
+		case *kurtosis_core_rpc_api_bindings.StreamExecCommandResponse_Stdout:
+			if _, err := stdout.Write(output.Stdout); err != nil {
+				return 0, stacktrace.Propagate(err, "An error occurred writing the output of command '%v' to stdout", command)
+			}
+		case *kurtosis_core_rpc_api_bindings.StreamExecCommandResponse_Stderr:
+			if _, err := stderr.Write(output.Stderr); err != nil {
+				return 0, stacktrace.Propagate(err, "An error occurred writing the output of command '%v' to stderr", command)
+			}
+		case *kurtosis_core_rpc_api_bindings.StreamExecCommandResponse_ExitCode:
+			return output.ExitCode, nil
+		}
+	}
+}
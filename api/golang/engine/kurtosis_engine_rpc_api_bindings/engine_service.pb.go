@@ -9,6 +9,7 @@ package kurtosis_engine_rpc_api_bindings
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
@@ -238,6 +239,182 @@ func (LogLineOperator) EnumDescriptor() ([]byte, []int) {
 	return file_engine_service_proto_rawDescGZIP(), []int{3}
 }
 
+// NOTE: We have to prefix the enum values with the enum name due to the way Protobuf enum value uniqueness works
+type EngineEventType int32
+
+const (
+	EngineEventType_EngineEventType_ENCLAVE_CREATED   EngineEventType = 0
+	EngineEventType_EngineEventType_ENCLAVE_STOPPED   EngineEventType = 1
+	EngineEventType_EngineEventType_ENCLAVE_DESTROYED EngineEventType = 2
+	EngineEventType_EngineEventType_SERVICE_ADDED     EngineEventType = 3
+	EngineEventType_EngineEventType_SERVICE_STARTED   EngineEventType = 4
+	EngineEventType_EngineEventType_SERVICE_STOPPED   EngineEventType = 5
+	// A service that was started but whose container isn't running anymore, without anyone having stopped it
+	EngineEventType_EngineEventType_SERVICE_CRASHED        EngineEventType = 6
+	EngineEventType_EngineEventType_STARLARK_RUN_STARTED   EngineEventType = 7
+	EngineEventType_EngineEventType_STARLARK_RUN_FINISHED  EngineEventType = 8
+	EngineEventType_EngineEventType_FILES_ARTIFACT_CREATED EngineEventType = 9
+)
+
+// Enum value maps for EngineEventType.
+var (
+	EngineEventType_name = map[int32]string{
+		0: "EngineEventType_ENCLAVE_CREATED",
+		1: "EngineEventType_ENCLAVE_STOPPED",
+		2: "EngineEventType_ENCLAVE_DESTROYED",
+		3: "EngineEventType_SERVICE_ADDED",
+		4: "EngineEventType_SERVICE_STARTED",
+		5: "EngineEventType_SERVICE_STOPPED",
+		6: "EngineEventType_SERVICE_CRASHED",
+		7: "EngineEventType_STARLARK_RUN_STARTED",
+		8: "EngineEventType_STARLARK_RUN_FINISHED",
+		9: "EngineEventType_FILES_ARTIFACT_CREATED",
+	}
+	EngineEventType_value = map[string]int32{
+		"EngineEventType_ENCLAVE_CREATED":        0,
+		"EngineEventType_ENCLAVE_STOPPED":        1,
+		"EngineEventType_ENCLAVE_DESTROYED":      2,
+		"EngineEventType_SERVICE_ADDED":          3,
+		"EngineEventType_SERVICE_STARTED":        4,
+		"EngineEventType_SERVICE_STOPPED":        5,
+		"EngineEventType_SERVICE_CRASHED":        6,
+		"EngineEventType_STARLARK_RUN_STARTED":   7,
+		"EngineEventType_STARLARK_RUN_FINISHED":  8,
+		"EngineEventType_FILES_ARTIFACT_CREATED": 9,
+	}
+)
+
+func (x EngineEventType) Enum() *EngineEventType {
+	p := new(EngineEventType)
+	*p = x
+	return p
+}
+
+func (x EngineEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EngineEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_engine_service_proto_enumTypes[4].Descriptor()
+}
+
+func (EngineEventType) Type() protoreflect.EnumType {
+	return &file_engine_service_proto_enumTypes[4]
+}
+
+func (x EngineEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EngineEventType.Descriptor instead.
+func (EngineEventType) EnumDescriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{4}
+}
+
+// ==============================================================================================
+//
+//	Scheduled Runs
+//
+// ==============================================================================================
+// NOTE: We have to prefix the enum values with the enum name due to the way Protobuf enum value uniqueness works
+type ScheduleEnclaveMode int32
+
+const (
+	// Every run creates a new enclave
+	ScheduleEnclaveMode_ScheduleEnclaveMode_FRESH ScheduleEnclaveMode = 0
+	// Every run happens in the same enclave, which is created by the first run if it doesn't exist
+	ScheduleEnclaveMode_ScheduleEnclaveMode_REUSE ScheduleEnclaveMode = 1
+)
+
+// Enum value maps for ScheduleEnclaveMode.
+var (
+	ScheduleEnclaveMode_name = map[int32]string{
+		0: "ScheduleEnclaveMode_FRESH",
+		1: "ScheduleEnclaveMode_REUSE",
+	}
+	ScheduleEnclaveMode_value = map[string]int32{
+		"ScheduleEnclaveMode_FRESH": 0,
+		"ScheduleEnclaveMode_REUSE": 1,
+	}
+)
+
+func (x ScheduleEnclaveMode) Enum() *ScheduleEnclaveMode {
+	p := new(ScheduleEnclaveMode)
+	*p = x
+	return p
+}
+
+func (x ScheduleEnclaveMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScheduleEnclaveMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_engine_service_proto_enumTypes[5].Descriptor()
+}
+
+func (ScheduleEnclaveMode) Type() protoreflect.EnumType {
+	return &file_engine_service_proto_enumTypes[5]
+}
+
+func (x ScheduleEnclaveMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScheduleEnclaveMode.Descriptor instead.
+func (ScheduleEnclaveMode) EnumDescriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{5}
+}
+
+// NOTE: We have to prefix the enum values with the enum name due to the way Protobuf enum value uniqueness works
+type ScheduledRunStatus int32
+
+const (
+	ScheduledRunStatus_ScheduledRunStatus_RUNNING   ScheduledRunStatus = 0
+	ScheduledRunStatus_ScheduledRunStatus_SUCCEEDED ScheduledRunStatus = 1
+	ScheduledRunStatus_ScheduledRunStatus_FAILED    ScheduledRunStatus = 2
+)
+
+// Enum value maps for ScheduledRunStatus.
+var (
+	ScheduledRunStatus_name = map[int32]string{
+		0: "ScheduledRunStatus_RUNNING",
+		1: "ScheduledRunStatus_SUCCEEDED",
+		2: "ScheduledRunStatus_FAILED",
+	}
+	ScheduledRunStatus_value = map[string]int32{
+		"ScheduledRunStatus_RUNNING":   0,
+		"ScheduledRunStatus_SUCCEEDED": 1,
+		"ScheduledRunStatus_FAILED":    2,
+	}
+)
+
+func (x ScheduledRunStatus) Enum() *ScheduledRunStatus {
+	p := new(ScheduledRunStatus)
+	*p = x
+	return p
+}
+
+func (x ScheduledRunStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScheduledRunStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_engine_service_proto_enumTypes[6].Descriptor()
+}
+
+func (ScheduledRunStatus) Type() protoreflect.EnumType {
+	return &file_engine_service_proto_enumTypes[6]
+}
+
+func (x ScheduledRunStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScheduledRunStatus.Descriptor instead.
+func (ScheduledRunStatus) EnumDescriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{6}
+}
+
 // ==============================================================================================
 //
 //	Get Engine Info
@@ -312,6 +489,14 @@ type CreateEnclaveArgs struct {
 	// Whether the APIC's container should run with the debug server to receive a remote debug connection
 	// This is not an EnclaveMode because we will need to debug both current Modes (Test and Prod)
 	ShouldApicRunInDebugMode *bool `protobuf:"varint,5,opt,name=should_apic_run_in_debug_mode,json=shouldApicRunInDebugMode,proto3,oneof" json:"should_apic_run_in_debug_mode,omitempty"`
+	// How long the enclave may live before the engine destroys it; if unset the enclave never expires
+	Ttl *durationpb.Duration `protobuf:"bytes,6,opt,name=ttl,proto3,oneof" json:"ttl,omitempty"`
+	// How long the enclave may go without activity before the engine stops it; if unset the enclave is never stopped for inactivity
+	IdleTimeout *durationpb.Duration `protobuf:"bytes,7,opt,name=idle_timeout,json=idleTimeout,proto3,oneof" json:"idle_timeout,omitempty"`
+	// Caps on the resources the services of the enclave can claim; if unset the enclave can use as much as the host allows
+	ResourceQuota *EnclaveResourceQuota `protobuf:"bytes,8,opt,name=resource_quota,json=resourceQuota,proto3,oneof" json:"resource_quota,omitempty"`
+	// Name of an enclave template the engine was started with; the enclave is handed out with the template's package already running
+	TemplateName *string `protobuf:"bytes,9,opt,name=template_name,json=templateName,proto3,oneof" json:"template_name,omitempty"`
 }
 
 func (x *CreateEnclaveArgs) Reset() {
@@ -381,6 +566,110 @@ func (x *CreateEnclaveArgs) GetShouldApicRunInDebugMode() bool {
 	return false
 }
 
+func (x *CreateEnclaveArgs) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+func (x *CreateEnclaveArgs) GetIdleTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.IdleTimeout
+	}
+	return nil
+}
+
+func (x *CreateEnclaveArgs) GetResourceQuota() *EnclaveResourceQuota {
+	if x != nil {
+		return x.ResourceQuota
+	}
+	return nil
+}
+
+func (x *CreateEnclaveArgs) GetTemplateName() string {
+	if x != nil && x.TemplateName != nil {
+		return *x.TemplateName
+	}
+	return ""
+}
+
+// A zero value means the corresponding resource isn't capped
+type EnclaveResourceQuota struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Maximum sum of the min CPU, in millicores, of all the services in the enclave
+	CpuMillicores uint64 `protobuf:"varint,1,opt,name=cpu_millicores,json=cpuMillicores,proto3" json:"cpu_millicores,omitempty"`
+	// Maximum sum of the min memory, in megabytes, of all the services in the enclave
+	MemoryMegabytes uint64 `protobuf:"varint,2,opt,name=memory_megabytes,json=memoryMegabytes,proto3" json:"memory_megabytes,omitempty"`
+	// Maximum number of services in the enclave
+	MaxServices uint32 `protobuf:"varint,3,opt,name=max_services,json=maxServices,proto3" json:"max_services,omitempty"`
+	// Maximum sum of the size, in megabytes, of all the persistent directories in the enclave
+	DiskMegabytes uint64 `protobuf:"varint,4,opt,name=disk_megabytes,json=diskMegabytes,proto3" json:"disk_megabytes,omitempty"`
+}
+
+func (x *EnclaveResourceQuota) Reset() {
+	*x = EnclaveResourceQuota{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnclaveResourceQuota) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnclaveResourceQuota) ProtoMessage() {}
+
+func (x *EnclaveResourceQuota) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnclaveResourceQuota.ProtoReflect.Descriptor instead.
+func (*EnclaveResourceQuota) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EnclaveResourceQuota) GetCpuMillicores() uint64 {
+	if x != nil {
+		return x.CpuMillicores
+	}
+	return 0
+}
+
+func (x *EnclaveResourceQuota) GetMemoryMegabytes() uint64 {
+	if x != nil {
+		return x.MemoryMegabytes
+	}
+	return 0
+}
+
+func (x *EnclaveResourceQuota) GetMaxServices() uint32 {
+	if x != nil {
+		return x.MaxServices
+	}
+	return 0
+}
+
+func (x *EnclaveResourceQuota) GetDiskMegabytes() uint64 {
+	if x != nil {
+		return x.DiskMegabytes
+	}
+	return 0
+}
+
 type CreateEnclaveResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -393,7 +682,7 @@ type CreateEnclaveResponse struct {
 func (x *CreateEnclaveResponse) Reset() {
 	*x = CreateEnclaveResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_engine_service_proto_msgTypes[2]
+		mi := &file_engine_service_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -406,7 +695,7 @@ func (x *CreateEnclaveResponse) String() string {
 func (*CreateEnclaveResponse) ProtoMessage() {}
 
 func (x *CreateEnclaveResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_engine_service_proto_msgTypes[2]
+	mi := &file_engine_service_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -419,7 +708,7 @@ func (x *CreateEnclaveResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateEnclaveResponse.ProtoReflect.Descriptor instead.
 func (*CreateEnclaveResponse) Descriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{2}
+	return file_engine_service_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CreateEnclaveResponse) GetEnclaveInfo() *EnclaveInfo {
@@ -447,7 +736,7 @@ type EnclaveAPIContainerInfo struct {
 func (x *EnclaveAPIContainerInfo) Reset() {
 	*x = EnclaveAPIContainerInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_engine_service_proto_msgTypes[3]
+		mi := &file_engine_service_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -460,7 +749,7 @@ func (x *EnclaveAPIContainerInfo) String() string {
 func (*EnclaveAPIContainerInfo) ProtoMessage() {}
 
 func (x *EnclaveAPIContainerInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_engine_service_proto_msgTypes[3]
+	mi := &file_engine_service_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -473,7 +762,7 @@ func (x *EnclaveAPIContainerInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnclaveAPIContainerInfo.ProtoReflect.Descriptor instead.
 func (*EnclaveAPIContainerInfo) Descriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{3}
+	return file_engine_service_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *EnclaveAPIContainerInfo) GetContainerId() string {
@@ -519,7 +808,7 @@ type EnclaveAPIContainerHostMachineInfo struct {
 func (x *EnclaveAPIContainerHostMachineInfo) Reset() {
 	*x = EnclaveAPIContainerHostMachineInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_engine_service_proto_msgTypes[4]
+		mi := &file_engine_service_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -532,7 +821,7 @@ func (x *EnclaveAPIContainerHostMachineInfo) String() string {
 func (*EnclaveAPIContainerHostMachineInfo) ProtoMessage() {}
 
 func (x *EnclaveAPIContainerHostMachineInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_engine_service_proto_msgTypes[4]
+	mi := &file_engine_service_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -545,7 +834,7 @@ func (x *EnclaveAPIContainerHostMachineInfo) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use EnclaveAPIContainerHostMachineInfo.ProtoReflect.Descriptor instead.
 func (*EnclaveAPIContainerHostMachineInfo) Descriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{4}
+	return file_engine_service_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *EnclaveAPIContainerHostMachineInfo) GetIpOnHostMachine() string {
@@ -585,12 +874,18 @@ type EnclaveInfo struct {
 	// The enclave's creation time
 	CreationTime *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=creation_time,json=creationTime,proto3" json:"creation_time,omitempty"`
 	Mode         EnclaveMode            `protobuf:"varint,9,opt,name=mode,proto3,enum=engine_api.EnclaveMode" json:"mode,omitempty"`
+	// NOTE: Will not be present if the enclave was created without a TTL
+	ExpirationTime *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=expiration_time,json=expirationTime,proto3,oneof" json:"expiration_time,omitempty"`
+	// NOTE: Will not be present if the enclave was created without an idle timeout
+	IdleExpirationTime *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=idle_expiration_time,json=idleExpirationTime,proto3,oneof" json:"idle_expiration_time,omitempty"`
+	// The engine API user who created the enclave; empty if the engine didn't require authentication back then
+	Owner string `protobuf:"bytes,12,opt,name=owner,proto3" json:"owner,omitempty"`
 }
 
 func (x *EnclaveInfo) Reset() {
 	*x = EnclaveInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_engine_service_proto_msgTypes[5]
+		mi := &file_engine_service_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -603,7 +898,7 @@ func (x *EnclaveInfo) String() string {
 func (*EnclaveInfo) ProtoMessage() {}
 
 func (x *EnclaveInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_engine_service_proto_msgTypes[5]
+	mi := &file_engine_service_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -616,7 +911,7 @@ func (x *EnclaveInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnclaveInfo.ProtoReflect.Descriptor instead.
 func (*EnclaveInfo) Descriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{5}
+	return file_engine_service_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *EnclaveInfo) GetEnclaveUuid() string {
@@ -682,6 +977,27 @@ func (x *EnclaveInfo) GetMode() EnclaveMode {
 	return EnclaveMode_TEST
 }
 
+func (x *EnclaveInfo) GetExpirationTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpirationTime
+	}
+	return nil
+}
+
+func (x *EnclaveInfo) GetIdleExpirationTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.IdleExpirationTime
+	}
+	return nil
+}
+
+func (x *EnclaveInfo) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
 type GetEnclavesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -694,7 +1010,7 @@ type GetEnclavesResponse struct {
 func (x *GetEnclavesResponse) Reset() {
 	*x = GetEnclavesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_engine_service_proto_msgTypes[6]
+		mi := &file_engine_service_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -707,7 +1023,7 @@ func (x *GetEnclavesResponse) String() string {
 func (*GetEnclavesResponse) ProtoMessage() {}
 
 func (x *GetEnclavesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_engine_service_proto_msgTypes[6]
+	mi := &file_engine_service_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -720,7 +1036,7 @@ func (x *GetEnclavesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEnclavesResponse.ProtoReflect.Descriptor instead.
 func (*GetEnclavesResponse) Descriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{6}
+	return file_engine_service_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetEnclavesResponse) GetEnclaveInfo() map[string]*EnclaveInfo {
@@ -747,7 +1063,7 @@ type EnclaveIdentifiers struct {
 func (x *EnclaveIdentifiers) Reset() {
 	*x = EnclaveIdentifiers{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_engine_service_proto_msgTypes[7]
+		mi := &file_engine_service_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -760,7 +1076,7 @@ func (x *EnclaveIdentifiers) String() string {
 func (*EnclaveIdentifiers) ProtoMessage() {}
 
 func (x *EnclaveIdentifiers) ProtoReflect() protoreflect.Message {
-	mi := &file_engine_service_proto_msgTypes[7]
+	mi := &file_engine_service_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -773,7 +1089,7 @@ func (x *EnclaveIdentifiers) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnclaveIdentifiers.ProtoReflect.Descriptor instead.
 func (*EnclaveIdentifiers) Descriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{7}
+	return file_engine_service_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *EnclaveIdentifiers) GetEnclaveUuid() string {
@@ -808,7 +1124,7 @@ type GetExistingAndHistoricalEnclaveIdentifiersResponse struct {
 func (x *GetExistingAndHistoricalEnclaveIdentifiersResponse) Reset() {
 	*x = GetExistingAndHistoricalEnclaveIdentifiersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_engine_service_proto_msgTypes[8]
+		mi := &file_engine_service_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -821,7 +1137,7 @@ func (x *GetExistingAndHistoricalEnclaveIdentifiersResponse) String() string {
 func (*GetExistingAndHistoricalEnclaveIdentifiersResponse) ProtoMessage() {}
 
 func (x *GetExistingAndHistoricalEnclaveIdentifiersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_engine_service_proto_msgTypes[8]
+	mi := &file_engine_service_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -834,7 +1150,7 @@ func (x *GetExistingAndHistoricalEnclaveIdentifiersResponse) ProtoReflect() prot
 
 // Deprecated: Use GetExistingAndHistoricalEnclaveIdentifiersResponse.ProtoReflect.Descriptor instead.
 func (*GetExistingAndHistoricalEnclaveIdentifiersResponse) Descriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{8}
+	return file_engine_service_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetExistingAndHistoricalEnclaveIdentifiersResponse) GetAllIdentifiers() []*EnclaveIdentifiers {
@@ -861,7 +1177,7 @@ type StopEnclaveArgs struct {
 func (x *StopEnclaveArgs) Reset() {
 	*x = StopEnclaveArgs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_engine_service_proto_msgTypes[9]
+		mi := &file_engine_service_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -874,7 +1190,7 @@ func (x *StopEnclaveArgs) String() string {
 func (*StopEnclaveArgs) ProtoMessage() {}
 
 func (x *StopEnclaveArgs) ProtoReflect() protoreflect.Message {
-	mi := &file_engine_service_proto_msgTypes[9]
+	mi := &file_engine_service_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -887,7 +1203,7 @@ func (x *StopEnclaveArgs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StopEnclaveArgs.ProtoReflect.Descriptor instead.
 func (*StopEnclaveArgs) Descriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{9}
+	return file_engine_service_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *StopEnclaveArgs) GetEnclaveIdentifier() string {
@@ -899,35 +1215,35 @@ func (x *StopEnclaveArgs) GetEnclaveIdentifier() string {
 
 // ==============================================================================================
 //
-//	Get Enclaves
+//	Start Enclave
 //
 // ==============================================================================================
-type GetEnclavesByUuidsArgs struct {
+type StartEnclaveArgs struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The enclave uuid of the Kurtosis enclaves to get. An empty list of enclave uuids will retrieve all enclaves.
-	EnclaveUuids []string `protobuf:"bytes,1,rep,name=enclave_uuids,json=enclaveUuids,proto3" json:"enclave_uuids,omitempty"`
+	// The identifier(uuid, shortened uuid, name) of the Kurtosis enclave to start
+	EnclaveIdentifier string `protobuf:"bytes,1,opt,name=enclave_identifier,json=enclaveIdentifier,proto3" json:"enclave_identifier,omitempty"`
 }
 
-func (x *GetEnclavesByUuidsArgs) Reset() {
-	*x = GetEnclavesByUuidsArgs{}
+func (x *StartEnclaveArgs) Reset() {
+	*x = StartEnclaveArgs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_engine_service_proto_msgTypes[10]
+		mi := &file_engine_service_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetEnclavesByUuidsArgs) String() string {
+func (x *StartEnclaveArgs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEnclavesByUuidsArgs) ProtoMessage() {}
+func (*StartEnclaveArgs) ProtoMessage() {}
 
-func (x *GetEnclavesByUuidsArgs) ProtoReflect() protoreflect.Message {
-	mi := &file_engine_service_proto_msgTypes[10]
+func (x *StartEnclaveArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -938,49 +1254,43 @@ func (x *GetEnclavesByUuidsArgs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEnclavesByUuidsArgs.ProtoReflect.Descriptor instead.
-func (*GetEnclavesByUuidsArgs) Descriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use StartEnclaveArgs.ProtoReflect.Descriptor instead.
+func (*StartEnclaveArgs) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *GetEnclavesByUuidsArgs) GetEnclaveUuids() []string {
+func (x *StartEnclaveArgs) GetEnclaveIdentifier() string {
 	if x != nil {
-		return x.EnclaveUuids
+		return x.EnclaveIdentifier
 	}
-	return nil
+	return ""
 }
 
-// ==============================================================================================
-//
-//	Destroy Enclave
-//
-// ==============================================================================================
-type DestroyEnclaveArgs struct {
+type StartEnclaveResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The identifier(uuid, shortened uuid, name) of the Kurtosis enclave to destroy
-	EnclaveIdentifier string `protobuf:"bytes,1,opt,name=enclave_identifier,json=enclaveIdentifier,proto3" json:"enclave_identifier,omitempty"`
+	EnclaveInfo *EnclaveInfo `protobuf:"bytes,1,opt,name=enclave_info,json=enclaveInfo,proto3" json:"enclave_info,omitempty"`
 }
 
-func (x *DestroyEnclaveArgs) Reset() {
-	*x = DestroyEnclaveArgs{}
+func (x *StartEnclaveResponse) Reset() {
+	*x = StartEnclaveResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_engine_service_proto_msgTypes[11]
+		mi := &file_engine_service_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DestroyEnclaveArgs) String() string {
+func (x *StartEnclaveResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DestroyEnclaveArgs) ProtoMessage() {}
+func (*StartEnclaveResponse) ProtoMessage() {}
 
-func (x *DestroyEnclaveArgs) ProtoReflect() protoreflect.Message {
-	mi := &file_engine_service_proto_msgTypes[11]
+func (x *StartEnclaveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -991,49 +1301,51 @@ func (x *DestroyEnclaveArgs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DestroyEnclaveArgs.ProtoReflect.Descriptor instead.
-func (*DestroyEnclaveArgs) Descriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use StartEnclaveResponse.ProtoReflect.Descriptor instead.
+func (*StartEnclaveResponse) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *DestroyEnclaveArgs) GetEnclaveIdentifier() string {
+func (x *StartEnclaveResponse) GetEnclaveInfo() *EnclaveInfo {
 	if x != nil {
-		return x.EnclaveIdentifier
+		return x.EnclaveInfo
 	}
-	return ""
+	return nil
 }
 
 // ==============================================================================================
 //
-//	Create Enclave
+//	Extend Enclave
 //
 // ==============================================================================================
-type CleanArgs struct {
+type ExtendEnclaveArgs struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// If true, It will clean even the running enclaves
-	ShouldCleanAll *bool `protobuf:"varint,1,opt,name=should_clean_all,json=shouldCleanAll,proto3,oneof" json:"should_clean_all,omitempty"`
+	// The identifier(uuid, shortened uuid, name) of the Kurtosis enclave to extend
+	EnclaveIdentifier string `protobuf:"bytes,1,opt,name=enclave_identifier,json=enclaveIdentifier,proto3" json:"enclave_identifier,omitempty"`
+	// How much time to add to the enclave's expiration deadline
+	Duration *durationpb.Duration `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
 }
 
-func (x *CleanArgs) Reset() {
-	*x = CleanArgs{}
+func (x *ExtendEnclaveArgs) Reset() {
+	*x = ExtendEnclaveArgs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_engine_service_proto_msgTypes[12]
+		mi := &file_engine_service_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CleanArgs) String() string {
+func (x *ExtendEnclaveArgs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CleanArgs) ProtoMessage() {}
+func (*ExtendEnclaveArgs) ProtoMessage() {}
 
-func (x *CleanArgs) ProtoReflect() protoreflect.Message {
-	mi := &file_engine_service_proto_msgTypes[12]
+func (x *ExtendEnclaveArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1044,44 +1356,50 @@ func (x *CleanArgs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CleanArgs.ProtoReflect.Descriptor instead.
-func (*CleanArgs) Descriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ExtendEnclaveArgs.ProtoReflect.Descriptor instead.
+func (*ExtendEnclaveArgs) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *CleanArgs) GetShouldCleanAll() bool {
-	if x != nil && x.ShouldCleanAll != nil {
-		return *x.ShouldCleanAll
+func (x *ExtendEnclaveArgs) GetEnclaveIdentifier() string {
+	if x != nil {
+		return x.EnclaveIdentifier
 	}
-	return false
+	return ""
 }
 
-type EnclaveNameAndUuid struct {
+func (x *ExtendEnclaveArgs) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+type ExtendEnclaveResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Uuid string `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	EnclaveInfo *EnclaveInfo `protobuf:"bytes,1,opt,name=enclave_info,json=enclaveInfo,proto3" json:"enclave_info,omitempty"`
 }
 
-func (x *EnclaveNameAndUuid) Reset() {
-	*x = EnclaveNameAndUuid{}
+func (x *ExtendEnclaveResponse) Reset() {
+	*x = ExtendEnclaveResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_engine_service_proto_msgTypes[13]
+		mi := &file_engine_service_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EnclaveNameAndUuid) String() string {
+func (x *ExtendEnclaveResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EnclaveNameAndUuid) ProtoMessage() {}
+func (*ExtendEnclaveResponse) ProtoMessage() {}
 
-func (x *EnclaveNameAndUuid) ProtoReflect() protoreflect.Message {
-	mi := &file_engine_service_proto_msgTypes[13]
+func (x *ExtendEnclaveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1092,51 +1410,105 @@ func (x *EnclaveNameAndUuid) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EnclaveNameAndUuid.ProtoReflect.Descriptor instead.
-func (*EnclaveNameAndUuid) Descriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use ExtendEnclaveResponse.ProtoReflect.Descriptor instead.
+func (*ExtendEnclaveResponse) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *EnclaveNameAndUuid) GetName() string {
+func (x *ExtendEnclaveResponse) GetEnclaveInfo() *EnclaveInfo {
 	if x != nil {
-		return x.Name
+		return x.EnclaveInfo
+	}
+	return nil
+}
+
+// ==============================================================================================
+//
+//	Clone Enclave
+//
+// ==============================================================================================
+type CloneEnclaveArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The identifier(uuid, shortened uuid, name) of the Kurtosis enclave to clone
+	SourceEnclaveIdentifier string `protobuf:"bytes,1,opt,name=source_enclave_identifier,json=sourceEnclaveIdentifier,proto3" json:"source_enclave_identifier,omitempty"`
+	// The name of the new enclave
+	DestinationEnclaveName string `protobuf:"bytes,2,opt,name=destination_enclave_name,json=destinationEnclaveName,proto3" json:"destination_enclave_name,omitempty"`
+}
+
+func (x *CloneEnclaveArgs) Reset() {
+	*x = CloneEnclaveArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloneEnclaveArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneEnclaveArgs) ProtoMessage() {}
+
+func (x *CloneEnclaveArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneEnclaveArgs.ProtoReflect.Descriptor instead.
+func (*CloneEnclaveArgs) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CloneEnclaveArgs) GetSourceEnclaveIdentifier() string {
+	if x != nil {
+		return x.SourceEnclaveIdentifier
 	}
 	return ""
 }
 
-func (x *EnclaveNameAndUuid) GetUuid() string {
+func (x *CloneEnclaveArgs) GetDestinationEnclaveName() string {
 	if x != nil {
-		return x.Uuid
+		return x.DestinationEnclaveName
 	}
 	return ""
 }
 
-type CleanResponse struct {
+type CloneEnclaveResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// removed enclave name and uuids
-	RemovedEnclaveNameAndUuids []*EnclaveNameAndUuid `protobuf:"bytes,1,rep,name=removed_enclave_name_and_uuids,json=removedEnclaveNameAndUuids,proto3" json:"removed_enclave_name_and_uuids,omitempty"`
+	EnclaveInfo *EnclaveInfo `protobuf:"bytes,1,opt,name=enclave_info,json=enclaveInfo,proto3" json:"enclave_info,omitempty"`
 }
 
-func (x *CleanResponse) Reset() {
-	*x = CleanResponse{}
+func (x *CloneEnclaveResponse) Reset() {
+	*x = CloneEnclaveResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_engine_service_proto_msgTypes[14]
+		mi := &file_engine_service_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CleanResponse) String() string {
+func (x *CloneEnclaveResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CleanResponse) ProtoMessage() {}
+func (*CloneEnclaveResponse) ProtoMessage() {}
 
-func (x *CleanResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_engine_service_proto_msgTypes[14]
+func (x *CloneEnclaveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1147,59 +1519,49 @@ func (x *CleanResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CleanResponse.ProtoReflect.Descriptor instead.
-func (*CleanResponse) Descriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use CloneEnclaveResponse.ProtoReflect.Descriptor instead.
+func (*CloneEnclaveResponse) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *CleanResponse) GetRemovedEnclaveNameAndUuids() []*EnclaveNameAndUuid {
+func (x *CloneEnclaveResponse) GetEnclaveInfo() *EnclaveInfo {
 	if x != nil {
-		return x.RemovedEnclaveNameAndUuids
+		return x.EnclaveInfo
 	}
 	return nil
 }
 
 // ==============================================================================================
 //
-//	Get User Service Logs
+//	Get Enclaves
 //
 // ==============================================================================================
-type GetServiceLogsArgs struct {
+type GetEnclavesByUuidsArgs struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The identifier of the user service's Kurtosis Enclave
-	EnclaveIdentifier string `protobuf:"bytes,1,opt,name=enclave_identifier,json=enclaveIdentifier,proto3" json:"enclave_identifier,omitempty"`
-	// "Set" of service UUIDs in the enclave
-	ServiceUuidSet map[string]bool `protobuf:"bytes,2,rep,name=service_uuid_set,json=serviceUuidSet,proto3" json:"service_uuid_set,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	// If true, It will follow the container logs
-	FollowLogs *bool `protobuf:"varint,3,opt,name=follow_logs,json=followLogs,proto3,oneof" json:"follow_logs,omitempty"`
-	// The conjunctive log lines filters, the first filter is applied over the found log lines, the second filter is applied over the filter one result and so on (like grep)
-	ConjunctiveFilters []*LogLineFilter `protobuf:"bytes,4,rep,name=conjunctive_filters,json=conjunctiveFilters,proto3" json:"conjunctive_filters,omitempty"`
-	// If true, return all log lines
-	ReturnAllLogs *bool `protobuf:"varint,5,opt,name=return_all_logs,json=returnAllLogs,proto3,oneof" json:"return_all_logs,omitempty"`
-	// If [return_all_logs] is false, return [num_log_lines]
-	NumLogLines *uint32 `protobuf:"varint,6,opt,name=num_log_lines,json=numLogLines,proto3,oneof" json:"num_log_lines,omitempty"`
+	// The enclave uuid of the Kurtosis enclaves to get. An empty list of enclave uuids will retrieve all enclaves.
+	EnclaveUuids []string `protobuf:"bytes,1,rep,name=enclave_uuids,json=enclaveUuids,proto3" json:"enclave_uuids,omitempty"`
 }
 
-func (x *GetServiceLogsArgs) Reset() {
-	*x = GetServiceLogsArgs{}
+func (x *GetEnclavesByUuidsArgs) Reset() {
+	*x = GetEnclavesByUuidsArgs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_engine_service_proto_msgTypes[15]
+		mi := &file_engine_service_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetServiceLogsArgs) String() string {
+func (x *GetEnclavesByUuidsArgs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetServiceLogsArgs) ProtoMessage() {}
+func (*GetEnclavesByUuidsArgs) ProtoMessage() {}
 
-func (x *GetServiceLogsArgs) ProtoReflect() protoreflect.Message {
-	mi := &file_engine_service_proto_msgTypes[15]
+func (x *GetEnclavesByUuidsArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1210,82 +1572,102 @@ func (x *GetServiceLogsArgs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetServiceLogsArgs.ProtoReflect.Descriptor instead.
-func (*GetServiceLogsArgs) Descriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use GetEnclavesByUuidsArgs.ProtoReflect.Descriptor instead.
+func (*GetEnclavesByUuidsArgs) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *GetServiceLogsArgs) GetEnclaveIdentifier() string {
+func (x *GetEnclavesByUuidsArgs) GetEnclaveUuids() []string {
 	if x != nil {
-		return x.EnclaveIdentifier
+		return x.EnclaveUuids
 	}
-	return ""
+	return nil
 }
 
-func (x *GetServiceLogsArgs) GetServiceUuidSet() map[string]bool {
-	if x != nil {
-		return x.ServiceUuidSet
-	}
-	return nil
+// ==============================================================================================
+//
+//	Destroy Enclave
+//
+// ==============================================================================================
+type DestroyEnclaveArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The identifier(uuid, shortened uuid, name) of the Kurtosis enclave to destroy
+	EnclaveIdentifier string `protobuf:"bytes,1,opt,name=enclave_identifier,json=enclaveIdentifier,proto3" json:"enclave_identifier,omitempty"`
 }
 
-func (x *GetServiceLogsArgs) GetFollowLogs() bool {
-	if x != nil && x.FollowLogs != nil {
-		return *x.FollowLogs
+func (x *DestroyEnclaveArgs) Reset() {
+	*x = DestroyEnclaveArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return false
 }
 
-func (x *GetServiceLogsArgs) GetConjunctiveFilters() []*LogLineFilter {
-	if x != nil {
-		return x.ConjunctiveFilters
-	}
-	return nil
+func (x *DestroyEnclaveArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GetServiceLogsArgs) GetReturnAllLogs() bool {
-	if x != nil && x.ReturnAllLogs != nil {
-		return *x.ReturnAllLogs
+func (*DestroyEnclaveArgs) ProtoMessage() {}
+
+func (x *DestroyEnclaveArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *GetServiceLogsArgs) GetNumLogLines() uint32 {
-	if x != nil && x.NumLogLines != nil {
-		return *x.NumLogLines
+// Deprecated: Use DestroyEnclaveArgs.ProtoReflect.Descriptor instead.
+func (*DestroyEnclaveArgs) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DestroyEnclaveArgs) GetEnclaveIdentifier() string {
+	if x != nil {
+		return x.EnclaveIdentifier
 	}
-	return 0
+	return ""
 }
 
-type GetServiceLogsResponse struct {
+// ==============================================================================================
+//
+//	Create Enclave
+//
+// ==============================================================================================
+type CleanArgs struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The service log lines grouped by service UUIDs and ordered in forward direction (oldest log line is the first element)
-	ServiceLogsByServiceUuid map[string]*LogLine `protobuf:"bytes,1,rep,name=service_logs_by_service_uuid,json=serviceLogsByServiceUuid,proto3" json:"service_logs_by_service_uuid,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// A set of service GUIDs requested by the user that were not found in the logs database, could be related that users send
-	// a wrong GUID or a right GUID for a service that has not sent any logs so far
-	NotFoundServiceUuidSet map[string]bool `protobuf:"bytes,2,rep,name=not_found_service_uuid_set,json=notFoundServiceUuidSet,proto3" json:"not_found_service_uuid_set,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// If true, It will clean even the running enclaves
+	ShouldCleanAll *bool `protobuf:"varint,1,opt,name=should_clean_all,json=shouldCleanAll,proto3,oneof" json:"should_clean_all,omitempty"`
 }
 
-func (x *GetServiceLogsResponse) Reset() {
-	*x = GetServiceLogsResponse{}
+func (x *CleanArgs) Reset() {
+	*x = CleanArgs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_engine_service_proto_msgTypes[16]
+		mi := &file_engine_service_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetServiceLogsResponse) String() string {
+func (x *CleanArgs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetServiceLogsResponse) ProtoMessage() {}
+func (*CleanArgs) ProtoMessage() {}
 
-func (x *GetServiceLogsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_engine_service_proto_msgTypes[16]
+func (x *CleanArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1296,9 +1678,261 @@ func (x *GetServiceLogsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetServiceLogsResponse.ProtoReflect.Descriptor instead.
+// Deprecated: Use CleanArgs.ProtoReflect.Descriptor instead.
+func (*CleanArgs) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CleanArgs) GetShouldCleanAll() bool {
+	if x != nil && x.ShouldCleanAll != nil {
+		return *x.ShouldCleanAll
+	}
+	return false
+}
+
+type EnclaveNameAndUuid struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Uuid string `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+}
+
+func (x *EnclaveNameAndUuid) Reset() {
+	*x = EnclaveNameAndUuid{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnclaveNameAndUuid) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnclaveNameAndUuid) ProtoMessage() {}
+
+func (x *EnclaveNameAndUuid) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnclaveNameAndUuid.ProtoReflect.Descriptor instead.
+func (*EnclaveNameAndUuid) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *EnclaveNameAndUuid) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EnclaveNameAndUuid) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+type CleanResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// removed enclave name and uuids
+	RemovedEnclaveNameAndUuids []*EnclaveNameAndUuid `protobuf:"bytes,1,rep,name=removed_enclave_name_and_uuids,json=removedEnclaveNameAndUuids,proto3" json:"removed_enclave_name_and_uuids,omitempty"`
+}
+
+func (x *CleanResponse) Reset() {
+	*x = CleanResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CleanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CleanResponse) ProtoMessage() {}
+
+func (x *CleanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CleanResponse.ProtoReflect.Descriptor instead.
+func (*CleanResponse) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CleanResponse) GetRemovedEnclaveNameAndUuids() []*EnclaveNameAndUuid {
+	if x != nil {
+		return x.RemovedEnclaveNameAndUuids
+	}
+	return nil
+}
+
+// ==============================================================================================
+//
+//	Get User Service Logs
+//
+// ==============================================================================================
+type GetServiceLogsArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The identifier of the user service's Kurtosis Enclave
+	EnclaveIdentifier string `protobuf:"bytes,1,opt,name=enclave_identifier,json=enclaveIdentifier,proto3" json:"enclave_identifier,omitempty"`
+	// "Set" of service UUIDs in the enclave
+	ServiceUuidSet map[string]bool `protobuf:"bytes,2,rep,name=service_uuid_set,json=serviceUuidSet,proto3" json:"service_uuid_set,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// If true, It will follow the container logs
+	FollowLogs *bool `protobuf:"varint,3,opt,name=follow_logs,json=followLogs,proto3,oneof" json:"follow_logs,omitempty"`
+	// The conjunctive log lines filters, the first filter is applied over the found log lines, the second filter is applied over the filter one result and so on (like grep)
+	ConjunctiveFilters []*LogLineFilter `protobuf:"bytes,4,rep,name=conjunctive_filters,json=conjunctiveFilters,proto3" json:"conjunctive_filters,omitempty"`
+	// If true, return all log lines
+	ReturnAllLogs *bool `protobuf:"varint,5,opt,name=return_all_logs,json=returnAllLogs,proto3,oneof" json:"return_all_logs,omitempty"`
+	// If [return_all_logs] is false, return [num_log_lines]
+	NumLogLines *uint32 `protobuf:"varint,6,opt,name=num_log_lines,json=numLogLines,proto3,oneof" json:"num_log_lines,omitempty"`
+}
+
+func (x *GetServiceLogsArgs) Reset() {
+	*x = GetServiceLogsArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetServiceLogsArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServiceLogsArgs) ProtoMessage() {}
+
+func (x *GetServiceLogsArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServiceLogsArgs.ProtoReflect.Descriptor instead.
+func (*GetServiceLogsArgs) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetServiceLogsArgs) GetEnclaveIdentifier() string {
+	if x != nil {
+		return x.EnclaveIdentifier
+	}
+	return ""
+}
+
+func (x *GetServiceLogsArgs) GetServiceUuidSet() map[string]bool {
+	if x != nil {
+		return x.ServiceUuidSet
+	}
+	return nil
+}
+
+func (x *GetServiceLogsArgs) GetFollowLogs() bool {
+	if x != nil && x.FollowLogs != nil {
+		return *x.FollowLogs
+	}
+	return false
+}
+
+func (x *GetServiceLogsArgs) GetConjunctiveFilters() []*LogLineFilter {
+	if x != nil {
+		return x.ConjunctiveFilters
+	}
+	return nil
+}
+
+func (x *GetServiceLogsArgs) GetReturnAllLogs() bool {
+	if x != nil && x.ReturnAllLogs != nil {
+		return *x.ReturnAllLogs
+	}
+	return false
+}
+
+func (x *GetServiceLogsArgs) GetNumLogLines() uint32 {
+	if x != nil && x.NumLogLines != nil {
+		return *x.NumLogLines
+	}
+	return 0
+}
+
+type GetServiceLogsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The service log lines grouped by service UUIDs and ordered in forward direction (oldest log line is the first element)
+	ServiceLogsByServiceUuid map[string]*LogLine `protobuf:"bytes,1,rep,name=service_logs_by_service_uuid,json=serviceLogsByServiceUuid,proto3" json:"service_logs_by_service_uuid,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// A set of service GUIDs requested by the user that were not found in the logs database, could be related that users send
+	// a wrong GUID or a right GUID for a service that has not sent any logs so far
+	NotFoundServiceUuidSet map[string]bool `protobuf:"bytes,2,rep,name=not_found_service_uuid_set,json=notFoundServiceUuidSet,proto3" json:"not_found_service_uuid_set,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *GetServiceLogsResponse) Reset() {
+	*x = GetServiceLogsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetServiceLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServiceLogsResponse) ProtoMessage() {}
+
+func (x *GetServiceLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServiceLogsResponse.ProtoReflect.Descriptor instead.
 func (*GetServiceLogsResponse) Descriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{16}
+	return file_engine_service_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *GetServiceLogsResponse) GetServiceLogsByServiceUuid() map[string]*LogLine {
@@ -1308,39 +1942,701 @@ func (x *GetServiceLogsResponse) GetServiceLogsByServiceUuid() map[string]*LogLi
 	return nil
 }
 
-func (x *GetServiceLogsResponse) GetNotFoundServiceUuidSet() map[string]bool {
+func (x *GetServiceLogsResponse) GetNotFoundServiceUuidSet() map[string]bool {
+	if x != nil {
+		return x.NotFoundServiceUuidSet
+	}
+	return nil
+}
+
+type LogLine struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Line      []string               `protobuf:"bytes,1,rep,name=line,proto3" json:"line,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *LogLine) Reset() {
+	*x = LogLine{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogLine) ProtoMessage() {}
+
+func (x *LogLine) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogLine.ProtoReflect.Descriptor instead.
+func (*LogLine) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *LogLine) GetLine() []string {
+	if x != nil {
+		return x.Line
+	}
+	return nil
+}
+
+func (x *LogLine) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+type LogLineFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Operator    LogLineOperator `protobuf:"varint,1,opt,name=operator,proto3,enum=engine_api.LogLineOperator" json:"operator,omitempty"`
+	TextPattern string          `protobuf:"bytes,2,opt,name=text_pattern,json=textPattern,proto3" json:"text_pattern,omitempty"`
+}
+
+func (x *LogLineFilter) Reset() {
+	*x = LogLineFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogLineFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogLineFilter) ProtoMessage() {}
+
+func (x *LogLineFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogLineFilter.ProtoReflect.Descriptor instead.
+func (*LogLineFilter) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *LogLineFilter) GetOperator() LogLineOperator {
+	if x != nil {
+		return x.Operator
+	}
+	return LogLineOperator_LogLineOperator_DOES_CONTAIN_TEXT
+}
+
+func (x *LogLineFilter) GetTextPattern() string {
+	if x != nil {
+		return x.TextPattern
+	}
+	return ""
+}
+
+// ==============================================================================================
+//
+//	Watch Events
+//
+// ==============================================================================================
+type WatchEventsArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// If set, only the events of this enclave are streamed
+	EnclaveIdentifier *string `protobuf:"bytes,1,opt,name=enclave_identifier,json=enclaveIdentifier,proto3,oneof" json:"enclave_identifier,omitempty"`
+	// If not empty, only the events of these types are streamed
+	EventTypes []EngineEventType `protobuf:"varint,2,rep,packed,name=event_types,json=eventTypes,proto3,enum=engine_api.EngineEventType" json:"event_types,omitempty"`
+}
+
+func (x *WatchEventsArgs) Reset() {
+	*x = WatchEventsArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchEventsArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchEventsArgs) ProtoMessage() {}
+
+func (x *WatchEventsArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchEventsArgs.ProtoReflect.Descriptor instead.
+func (*WatchEventsArgs) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *WatchEventsArgs) GetEnclaveIdentifier() string {
+	if x != nil && x.EnclaveIdentifier != nil {
+		return *x.EnclaveIdentifier
+	}
+	return ""
+}
+
+func (x *WatchEventsArgs) GetEventTypes() []EngineEventType {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+// Only the fields relevant to the event type are set
+type EngineEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type              EngineEventType        `protobuf:"varint,1,opt,name=type,proto3,enum=engine_api.EngineEventType" json:"type,omitempty"`
+	Timestamp         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	EnclaveUuid       string                 `protobuf:"bytes,3,opt,name=enclave_uuid,json=enclaveUuid,proto3" json:"enclave_uuid,omitempty"`
+	EnclaveName       string                 `protobuf:"bytes,4,opt,name=enclave_name,json=enclaveName,proto3" json:"enclave_name,omitempty"`
+	ServiceName       *string                `protobuf:"bytes,5,opt,name=service_name,json=serviceName,proto3,oneof" json:"service_name,omitempty"`
+	ServiceUuid       *string                `protobuf:"bytes,6,opt,name=service_uuid,json=serviceUuid,proto3,oneof" json:"service_uuid,omitempty"`
+	FilesArtifactName *string                `protobuf:"bytes,7,opt,name=files_artifact_name,json=filesArtifactName,proto3,oneof" json:"files_artifact_name,omitempty"`
+	FilesArtifactUuid *string                `protobuf:"bytes,8,opt,name=files_artifact_uuid,json=filesArtifactUuid,proto3,oneof" json:"files_artifact_uuid,omitempty"`
+	// Set for EngineEventType_STARLARK_RUN_FINISHED
+	IsRunSuccessful *bool `protobuf:"varint,9,opt,name=is_run_successful,json=isRunSuccessful,proto3,oneof" json:"is_run_successful,omitempty"`
+}
+
+func (x *EngineEvent) Reset() {
+	*x = EngineEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EngineEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EngineEvent) ProtoMessage() {}
+
+func (x *EngineEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EngineEvent.ProtoReflect.Descriptor instead.
+func (*EngineEvent) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *EngineEvent) GetType() EngineEventType {
+	if x != nil {
+		return x.Type
+	}
+	return EngineEventType_EngineEventType_ENCLAVE_CREATED
+}
+
+func (x *EngineEvent) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *EngineEvent) GetEnclaveUuid() string {
+	if x != nil {
+		return x.EnclaveUuid
+	}
+	return ""
+}
+
+func (x *EngineEvent) GetEnclaveName() string {
+	if x != nil {
+		return x.EnclaveName
+	}
+	return ""
+}
+
+func (x *EngineEvent) GetServiceName() string {
+	if x != nil && x.ServiceName != nil {
+		return *x.ServiceName
+	}
+	return ""
+}
+
+func (x *EngineEvent) GetServiceUuid() string {
+	if x != nil && x.ServiceUuid != nil {
+		return *x.ServiceUuid
+	}
+	return ""
+}
+
+func (x *EngineEvent) GetFilesArtifactName() string {
+	if x != nil && x.FilesArtifactName != nil {
+		return *x.FilesArtifactName
+	}
+	return ""
+}
+
+func (x *EngineEvent) GetFilesArtifactUuid() string {
+	if x != nil && x.FilesArtifactUuid != nil {
+		return *x.FilesArtifactUuid
+	}
+	return ""
+}
+
+func (x *EngineEvent) GetIsRunSuccessful() bool {
+	if x != nil && x.IsRunSuccessful != nil {
+		return *x.IsRunSuccessful
+	}
+	return false
+}
+
+type AddScheduleArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Standard five-field cron expression, evaluated in UTC
+	CronExpression string `protobuf:"bytes,1,opt,name=cron_expression,json=cronExpression,proto3" json:"cron_expression,omitempty"`
+	PackageId      string `protobuf:"bytes,2,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
+	// If unset, the package runs with its default params
+	SerializedParams *string             `protobuf:"bytes,3,opt,name=serialized_params,json=serializedParams,proto3,oneof" json:"serialized_params,omitempty"`
+	EnclaveMode      ScheduleEnclaveMode `protobuf:"varint,4,opt,name=enclave_mode,json=enclaveMode,proto3,enum=engine_api.ScheduleEnclaveMode" json:"enclave_mode,omitempty"`
+	// The name of the enclave to reuse, or the prefix of the names of the fresh enclaves; required when reusing an enclave
+	EnclaveName *string `protobuf:"bytes,5,opt,name=enclave_name,json=enclaveName,proto3,oneof" json:"enclave_name,omitempty"`
+	// Whether each fresh enclave is destroyed once its run is over; not allowed when reusing an enclave
+	ShouldDestroyEnclave *bool `protobuf:"varint,6,opt,name=should_destroy_enclave,json=shouldDestroyEnclave,proto3,oneof" json:"should_destroy_enclave,omitempty"`
+}
+
+func (x *AddScheduleArgs) Reset() {
+	*x = AddScheduleArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddScheduleArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddScheduleArgs) ProtoMessage() {}
+
+func (x *AddScheduleArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddScheduleArgs.ProtoReflect.Descriptor instead.
+func (*AddScheduleArgs) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *AddScheduleArgs) GetCronExpression() string {
+	if x != nil {
+		return x.CronExpression
+	}
+	return ""
+}
+
+func (x *AddScheduleArgs) GetPackageId() string {
+	if x != nil {
+		return x.PackageId
+	}
+	return ""
+}
+
+func (x *AddScheduleArgs) GetSerializedParams() string {
+	if x != nil && x.SerializedParams != nil {
+		return *x.SerializedParams
+	}
+	return ""
+}
+
+func (x *AddScheduleArgs) GetEnclaveMode() ScheduleEnclaveMode {
+	if x != nil {
+		return x.EnclaveMode
+	}
+	return ScheduleEnclaveMode_ScheduleEnclaveMode_FRESH
+}
+
+func (x *AddScheduleArgs) GetEnclaveName() string {
+	if x != nil && x.EnclaveName != nil {
+		return *x.EnclaveName
+	}
+	return ""
+}
+
+func (x *AddScheduleArgs) GetShouldDestroyEnclave() bool {
+	if x != nil && x.ShouldDestroyEnclave != nil {
+		return *x.ShouldDestroyEnclave
+	}
+	return false
+}
+
+type AddScheduleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Schedule *Schedule `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+}
+
+func (x *AddScheduleResponse) Reset() {
+	*x = AddScheduleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddScheduleResponse) ProtoMessage() {}
+
+func (x *AddScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddScheduleResponse.ProtoReflect.Descriptor instead.
+func (*AddScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *AddScheduleResponse) GetSchedule() *Schedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+type GetSchedulesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Schedules []*Schedule `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules,omitempty"`
+}
+
+func (x *GetSchedulesResponse) Reset() {
+	*x = GetSchedulesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSchedulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSchedulesResponse) ProtoMessage() {}
+
+func (x *GetSchedulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSchedulesResponse.ProtoReflect.Descriptor instead.
+func (*GetSchedulesResponse) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetSchedulesResponse) GetSchedules() []*Schedule {
+	if x != nil {
+		return x.Schedules
+	}
+	return nil
+}
+
+type RemoveScheduleArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The UUID or shortened UUID of the schedule to remove
+	ScheduleIdentifier string `protobuf:"bytes,1,opt,name=schedule_identifier,json=scheduleIdentifier,proto3" json:"schedule_identifier,omitempty"`
+}
+
+func (x *RemoveScheduleArgs) Reset() {
+	*x = RemoveScheduleArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveScheduleArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveScheduleArgs) ProtoMessage() {}
+
+func (x *RemoveScheduleArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveScheduleArgs.ProtoReflect.Descriptor instead.
+func (*RemoveScheduleArgs) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RemoveScheduleArgs) GetScheduleIdentifier() string {
+	if x != nil {
+		return x.ScheduleIdentifier
+	}
+	return ""
+}
+
+type Schedule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ScheduleUuid     string              `protobuf:"bytes,1,opt,name=schedule_uuid,json=scheduleUuid,proto3" json:"schedule_uuid,omitempty"`
+	ShortenedUuid    string              `protobuf:"bytes,2,opt,name=shortened_uuid,json=shortenedUuid,proto3" json:"shortened_uuid,omitempty"`
+	CronExpression   string              `protobuf:"bytes,3,opt,name=cron_expression,json=cronExpression,proto3" json:"cron_expression,omitempty"`
+	PackageId        string              `protobuf:"bytes,4,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
+	SerializedParams string              `protobuf:"bytes,5,opt,name=serialized_params,json=serializedParams,proto3" json:"serialized_params,omitempty"`
+	EnclaveMode      ScheduleEnclaveMode `protobuf:"varint,6,opt,name=enclave_mode,json=enclaveMode,proto3,enum=engine_api.ScheduleEnclaveMode" json:"enclave_mode,omitempty"`
+	// The name of the reused enclave, or the prefix of the names of the fresh enclaves
+	EnclaveName          string `protobuf:"bytes,7,opt,name=enclave_name,json=enclaveName,proto3" json:"enclave_name,omitempty"`
+	ShouldDestroyEnclave bool   `protobuf:"varint,8,opt,name=should_destroy_enclave,json=shouldDestroyEnclave,proto3" json:"should_destroy_enclave,omitempty"`
+	// The engine API user who added the schedule; blank if authentication is turned off
+	Owner        string                 `protobuf:"bytes,9,opt,name=owner,proto3" json:"owner,omitempty"`
+	CreationTime *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=creation_time,json=creationTime,proto3" json:"creation_time,omitempty"`
+	// Unset if the cron expression never matches again
+	NextRunTime *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=next_run_time,json=nextRunTime,proto3,oneof" json:"next_run_time,omitempty"`
+	// Oldest first
+	RecentRuns []*ScheduledRun `protobuf:"bytes,12,rep,name=recent_runs,json=recentRuns,proto3" json:"recent_runs,omitempty"`
+}
+
+func (x *Schedule) Reset() {
+	*x = Schedule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Schedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Schedule) ProtoMessage() {}
+
+func (x *Schedule) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Schedule.ProtoReflect.Descriptor instead.
+func (*Schedule) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Schedule) GetScheduleUuid() string {
+	if x != nil {
+		return x.ScheduleUuid
+	}
+	return ""
+}
+
+func (x *Schedule) GetShortenedUuid() string {
+	if x != nil {
+		return x.ShortenedUuid
+	}
+	return ""
+}
+
+func (x *Schedule) GetCronExpression() string {
+	if x != nil {
+		return x.CronExpression
+	}
+	return ""
+}
+
+func (x *Schedule) GetPackageId() string {
+	if x != nil {
+		return x.PackageId
+	}
+	return ""
+}
+
+func (x *Schedule) GetSerializedParams() string {
+	if x != nil {
+		return x.SerializedParams
+	}
+	return ""
+}
+
+func (x *Schedule) GetEnclaveMode() ScheduleEnclaveMode {
+	if x != nil {
+		return x.EnclaveMode
+	}
+	return ScheduleEnclaveMode_ScheduleEnclaveMode_FRESH
+}
+
+func (x *Schedule) GetEnclaveName() string {
+	if x != nil {
+		return x.EnclaveName
+	}
+	return ""
+}
+
+func (x *Schedule) GetShouldDestroyEnclave() bool {
+	if x != nil {
+		return x.ShouldDestroyEnclave
+	}
+	return false
+}
+
+func (x *Schedule) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *Schedule) GetCreationTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreationTime
+	}
+	return nil
+}
+
+func (x *Schedule) GetNextRunTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRunTime
+	}
+	return nil
+}
+
+func (x *Schedule) GetRecentRuns() []*ScheduledRun {
 	if x != nil {
-		return x.NotFoundServiceUuidSet
+		return x.RecentRuns
 	}
 	return nil
 }
 
-type LogLine struct {
+type ScheduledRun struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Line      []string               `protobuf:"bytes,1,rep,name=line,proto3" json:"line,omitempty"`
-	Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// Unset while the run is in progress
+	EndTime     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3,oneof" json:"end_time,omitempty"`
+	EnclaveName string                 `protobuf:"bytes,3,opt,name=enclave_name,json=enclaveName,proto3" json:"enclave_name,omitempty"`
+	Status      ScheduledRunStatus     `protobuf:"varint,4,opt,name=status,proto3,enum=engine_api.ScheduledRunStatus" json:"status,omitempty"`
+	// Why the run failed; blank if it didn't
+	ErrorMessage string `protobuf:"bytes,5,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
 }
 
-func (x *LogLine) Reset() {
-	*x = LogLine{}
+func (x *ScheduledRun) Reset() {
+	*x = ScheduledRun{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_engine_service_proto_msgTypes[17]
+		mi := &file_engine_service_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LogLine) String() string {
+func (x *ScheduledRun) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogLine) ProtoMessage() {}
+func (*ScheduledRun) ProtoMessage() {}
 
-func (x *LogLine) ProtoReflect() protoreflect.Message {
-	mi := &file_engine_service_proto_msgTypes[17]
+func (x *ScheduledRun) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1351,76 +2647,42 @@ func (x *LogLine) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogLine.ProtoReflect.Descriptor instead.
-func (*LogLine) Descriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ScheduledRun.ProtoReflect.Descriptor instead.
+func (*ScheduledRun) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *LogLine) GetLine() []string {
+func (x *ScheduledRun) GetStartTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Line
+		return x.StartTime
 	}
 	return nil
 }
 
-func (x *LogLine) GetTimestamp() *timestamppb.Timestamp {
+func (x *ScheduledRun) GetEndTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Timestamp
+		return x.EndTime
 	}
 	return nil
 }
 
-type LogLineFilter struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Operator    LogLineOperator `protobuf:"varint,1,opt,name=operator,proto3,enum=engine_api.LogLineOperator" json:"operator,omitempty"`
-	TextPattern string          `protobuf:"bytes,2,opt,name=text_pattern,json=textPattern,proto3" json:"text_pattern,omitempty"`
-}
-
-func (x *LogLineFilter) Reset() {
-	*x = LogLineFilter{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_engine_service_proto_msgTypes[18]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *LogLineFilter) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*LogLineFilter) ProtoMessage() {}
-
-func (x *LogLineFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_engine_service_proto_msgTypes[18]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ScheduledRun) GetEnclaveName() string {
+	if x != nil {
+		return x.EnclaveName
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use LogLineFilter.ProtoReflect.Descriptor instead.
-func (*LogLineFilter) Descriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{18}
+	return ""
 }
 
-func (x *LogLineFilter) GetOperator() LogLineOperator {
+func (x *ScheduledRun) GetStatus() ScheduledRunStatus {
 	if x != nil {
-		return x.Operator
+		return x.Status
 	}
-	return LogLineOperator_LogLineOperator_DOES_CONTAIN_TEXT
+	return ScheduledRunStatus_ScheduledRunStatus_RUNNING
 }
 
-func (x *LogLineFilter) GetTextPattern() string {
+func (x *ScheduledRun) GetErrorMessage() string {
 	if x != nil {
-		return x.TextPattern
+		return x.ErrorMessage
 	}
 	return ""
 }
@@ -1430,7 +2692,9 @@ var File_engine_service_proto protoreflect.FileDescriptor
 var file_engine_service_proto_rawDesc = []byte{
 	0x0a, 0x14, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61,
-	0x70, 0x69, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x70, 0x69, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
 	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
 	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
@@ -1438,7 +2702,7 @@ var file_engine_service_proto_rawDesc = []byte{
 	0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x6e, 0x67,
 	0x69, 0x6e, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x0d, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x22, 0xa5, 0x03, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61,
+	0x22, 0xd0, 0x05, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61,
 	0x76, 0x65, 0x41, 0x72, 0x67, 0x73, 0x12, 0x26, 0x0a, 0x0c, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76,
 	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b,
 	0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x3e,
@@ -1457,14 +2721,44 @@ var file_engine_service_proto_rawDesc = []byte{
 	0x69, 0x6e, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20,
 	0x01, 0x28, 0x08, 0x48, 0x04, 0x52, 0x18, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x41, 0x70, 0x69,
 	0x63, 0x52, 0x75, 0x6e, 0x49, 0x6e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x88,
-	0x01, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x42, 0x1c, 0x0a, 0x1a, 0x5f, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
-	0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x61,
-	0x67, 0x42, 0x1a, 0x0a, 0x18, 0x5f, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69,
-	0x6e, 0x65, 0x72, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x42, 0x07, 0x0a,
-	0x05, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x42, 0x20, 0x0a, 0x1e, 0x5f, 0x73, 0x68, 0x6f, 0x75, 0x6c,
-	0x64, 0x5f, 0x61, 0x70, 0x69, 0x63, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x69, 0x6e, 0x5f, 0x64, 0x65,
-	0x62, 0x75, 0x67, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x53, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61,
+	0x01, 0x01, 0x12, 0x30, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x05, 0x52, 0x03, 0x74, 0x74,
+	0x6c, 0x88, 0x01, 0x01, 0x12, 0x41, 0x0a, 0x0c, 0x69, 0x64, 0x6c, 0x65, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x06, 0x52, 0x0b, 0x69, 0x64, 0x6c, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x88, 0x01, 0x01, 0x12, 0x4c, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e, 0x63,
+	0x6c, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x51, 0x75, 0x6f, 0x74,
+	0x61, 0x48, 0x07, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x51, 0x75, 0x6f,
+	0x74, 0x61, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x48, 0x08, 0x52, 0x0c,
+	0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x42,
+	0x0f, 0x0a, 0x0d, 0x5f, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x42, 0x1c, 0x0a, 0x1a, 0x5f, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x61, 0x67, 0x42, 0x1a,
+	0x0a, 0x18, 0x5f, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
+	0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6d,
+	0x6f, 0x64, 0x65, 0x42, 0x20, 0x0a, 0x1e, 0x5f, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x5f, 0x61,
+	0x70, 0x69, 0x63, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x69, 0x6e, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67,
+	0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x74, 0x74, 0x6c, 0x42, 0x0f, 0x0a,
+	0x0d, 0x5f, 0x69, 0x64, 0x6c, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x42, 0x11,
+	0x0a, 0x0f, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x71, 0x75, 0x6f, 0x74,
+	0x61, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x22, 0xb2, 0x01, 0x0a, 0x14, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x25, 0x0a, 0x0e,
+	0x63, 0x70, 0x75, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x63, 0x70, 0x75, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x63, 0x6f,
+	0x72, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x6d, 0x65,
+	0x67, 0x61, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x6d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x65, 0x67, 0x61, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x21,
+	0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6d, 0x65, 0x67, 0x61, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x64, 0x69, 0x73, 0x6b, 0x4d,
+	0x65, 0x67, 0x61, 0x62, 0x79, 0x74, 0x65, 0x73, 0x22, 0x53, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61,
 	0x74, 0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
 	0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x69, 0x6e, 0x66,
 	0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65,
@@ -1491,7 +2785,7 @@ var file_engine_service_proto_rawDesc = []byte{
 	0x65, 0x12, 0x38, 0x0a, 0x19, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x6f,
 	0x6e, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x18, 0x05,
 	0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x67, 0x72, 0x70, 0x63, 0x50, 0x6f, 0x72, 0x74, 0x4f, 0x6e,
-	0x48, 0x6f, 0x73, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x22, 0xcd, 0x04, 0x0a, 0x0b,
+	0x48, 0x6f, 0x73, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x22, 0xad, 0x06, 0x0a, 0x0b,
 	0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x65,
 	0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x0b, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x55, 0x75, 0x69, 0x64, 0x12, 0x12,
@@ -1528,7 +2822,21 @@ var file_engine_service_proto_rawDesc = []byte{
 	0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2b,
 	0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x65,
 	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76,
-	0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0xc3, 0x01, 0x0a, 0x13,
+	0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x0f, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x48, 0x00, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69,
+	0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x51, 0x0a, 0x14, 0x69, 0x64, 0x6c, 0x65, 0x5f, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48,
+	0x01, 0x52, 0x12, 0x69, 0x64, 0x6c, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x42, 0x12,
+	0x0a, 0x10, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x69, 0x64, 0x6c, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0xc3, 0x01, 0x0a, 0x13,
 	0x47, 0x65, 0x74, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
 	0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0c, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x69,
 	0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x65, 0x6e, 0x67, 0x69,
@@ -1560,170 +2868,395 @@ var file_engine_service_proto_rawDesc = []byte{
 	0x76, 0x65, 0x41, 0x72, 0x67, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76,
 	0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x11, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x3d, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x63, 0x6c,
-	0x61, 0x76, 0x65, 0x73, 0x42, 0x79, 0x55, 0x75, 0x69, 0x64, 0x73, 0x41, 0x72, 0x67, 0x73, 0x12,
-	0x23, 0x0a, 0x0d, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x55,
-	0x75, 0x69, 0x64, 0x73, 0x22, 0x43, 0x0a, 0x12, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x45,
-	0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x72, 0x67, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x65, 0x6e,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x41, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x6e,
+	0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x72, 0x67, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x65, 0x6e, 0x63,
+	0x6c, 0x61, 0x76, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x52, 0x0a, 0x14, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3a, 0x0a, 0x0c, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f,
+	0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x0b, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x79, 0x0a, 0x11,
+	0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x72, 0x67,
+	0x73, 0x12, 0x2d, 0x0a, 0x12, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x65,
+	0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x12, 0x35, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x53, 0x0a, 0x15, 0x45, 0x78, 0x74, 0x65, 0x6e,
+	0x64, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3a, 0x0a, 0x0c, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f,
+	0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x0b, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x88, 0x01, 0x0a,
+	0x10, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x72, 0x67,
+	0x73, 0x12, 0x3a, 0x0a, 0x19, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x65, 0x6e, 0x63, 0x6c,
+	0x61, 0x76, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x6e, 0x63, 0x6c,
+	0x61, 0x76, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x38, 0x0a,
+	0x18, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x63,
+	0x6c, 0x61, 0x76, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x16, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x63, 0x6c,
+	0x61, 0x76, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x52, 0x0a, 0x14, 0x43, 0x6c, 0x6f, 0x6e, 0x65,
+	0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3a, 0x0a, 0x0c, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b,
+	0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x3d, 0x0a, 0x16, 0x47,
+	0x65, 0x74, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x73, 0x42, 0x79, 0x55, 0x75, 0x69, 0x64,
+	0x73, 0x41, 0x72, 0x67, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65,
+	0x5f, 0x75, 0x75, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x6e,
+	0x63, 0x6c, 0x61, 0x76, 0x65, 0x55, 0x75, 0x69, 0x64, 0x73, 0x22, 0x43, 0x0a, 0x12, 0x44, 0x65,
+	0x73, 0x74, 0x72, 0x6f, 0x79, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x72, 0x67, 0x73,
+	0x12, 0x2d, 0x0a, 0x12, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x65, 0x6e,
+	0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22,
+	0x4f, 0x0a, 0x09, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x41, 0x72, 0x67, 0x73, 0x12, 0x2d, 0x0a, 0x10,
+	0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x5f, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x5f, 0x61, 0x6c, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x0e, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64,
+	0x43, 0x6c, 0x65, 0x61, 0x6e, 0x41, 0x6c, 0x6c, 0x88, 0x01, 0x01, 0x42, 0x13, 0x0a, 0x11, 0x5f,
+	0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x5f, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x5f, 0x61, 0x6c, 0x6c,
+	0x22, 0x3c, 0x0a, 0x12, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x41,
+	0x6e, 0x64, 0x55, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x73,
+	0x0a, 0x0d, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x62, 0x0a, 0x1e, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x63, 0x6c, 0x61,
+	0x76, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x75, 0x75, 0x69, 0x64,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x41, 0x6e, 0x64, 0x55, 0x75, 0x69, 0x64, 0x52, 0x1a, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64,
+	0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x41, 0x6e, 0x64, 0x55, 0x75,
+	0x69, 0x64, 0x73, 0x22, 0xe2, 0x03, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x41, 0x72, 0x67, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x65, 0x6e,
 	0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x4f, 0x0a, 0x09, 0x43, 0x6c, 0x65,
-	0x61, 0x6e, 0x41, 0x72, 0x67, 0x73, 0x12, 0x2d, 0x0a, 0x10, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64,
-	0x5f, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x5f, 0x61, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x48, 0x00, 0x52, 0x0e, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x41,
-	0x6c, 0x6c, 0x88, 0x01, 0x01, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64,
-	0x5f, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x5f, 0x61, 0x6c, 0x6c, 0x22, 0x3c, 0x0a, 0x12, 0x45, 0x6e,
-	0x63, 0x6c, 0x61, 0x76, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x41, 0x6e, 0x64, 0x55, 0x75, 0x69, 0x64,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x73, 0x0a, 0x0d, 0x43, 0x6c, 0x65, 0x61,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x1e, 0x72, 0x65, 0x6d,
-	0x6f, 0x76, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45,
-	0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x41, 0x6e, 0x64, 0x55, 0x75, 0x69,
-	0x64, 0x52, 0x1a, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76,
-	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x41, 0x6e, 0x64, 0x55, 0x75, 0x69, 0x64, 0x73, 0x22, 0xe2, 0x03,
-	0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73,
-	0x41, 0x72, 0x67, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f,
-	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x11, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
-	0x69, 0x65, 0x72, 0x12, 0x5c, 0x0a, 0x10, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75,
-	0x75, 0x69, 0x64, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e,
-	0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x41, 0x72, 0x67, 0x73, 0x2e, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x53, 0x65,
-	0x74, 0x12, 0x24, 0x0a, 0x0b, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x6c, 0x6f, 0x67, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x0a, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
-	0x4c, 0x6f, 0x67, 0x73, 0x88, 0x01, 0x01, 0x12, 0x4a, 0x0a, 0x13, 0x63, 0x6f, 0x6e, 0x6a, 0x75,
-	0x6e, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x04,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70,
-	0x69, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52,
-	0x12, 0x63, 0x6f, 0x6e, 0x6a, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x76, 0x65, 0x46, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x73, 0x12, 0x2b, 0x0a, 0x0f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x61, 0x6c,
-	0x6c, 0x5f, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x0d,
-	0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x41, 0x6c, 0x6c, 0x4c, 0x6f, 0x67, 0x73, 0x88, 0x01, 0x01,
-	0x12, 0x27, 0x0a, 0x0d, 0x6e, 0x75, 0x6d, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x69, 0x6e, 0x65,
-	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x02, 0x52, 0x0b, 0x6e, 0x75, 0x6d, 0x4c, 0x6f,
-	0x67, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x88, 0x01, 0x01, 0x1a, 0x41, 0x0a, 0x13, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0e, 0x0a, 0x0c,
-	0x5f, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x6c, 0x6f, 0x67, 0x73, 0x42, 0x12, 0x0a, 0x10,
-	0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x61, 0x6c, 0x6c, 0x5f, 0x6c, 0x6f, 0x67, 0x73,
-	0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x6e, 0x75, 0x6d, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x69, 0x6e,
-	0x65, 0x73, 0x22, 0xc4, 0x03, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x80, 0x01,
-	0x0a, 0x1c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6c, 0x6f, 0x67, 0x73, 0x5f, 0x62,
-	0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70,
-	0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x4c, 0x6f, 0x67, 0x73, 0x42, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69,
-	0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x18, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c,
-	0x6f, 0x67, 0x73, 0x42, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64,
-	0x12, 0x7a, 0x0a, 0x1a, 0x6e, 0x6f, 0x74, 0x5f, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70,
-	0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e,
-	0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x53, 0x65, 0x74, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x16, 0x6e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x53, 0x65, 0x74, 0x1a, 0x60, 0x0a, 0x1d,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x42, 0x79, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
-	0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x67, 0x4c,
-	0x69, 0x6e, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x49,
-	0x0a, 0x1b, 0x4e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x55, 0x75, 0x69, 0x64, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x57, 0x0a, 0x07, 0x4c, 0x6f, 0x67,
-	0x4c, 0x69, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x5c, 0x0a, 0x10, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69,
+	0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x41,
+	0x72, 0x67, 0x73, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x53,
+	0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x55, 0x75, 0x69, 0x64, 0x53, 0x65, 0x74, 0x12, 0x24, 0x0a, 0x0b, 0x66, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x77, 0x5f, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x0a,
+	0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x4c, 0x6f, 0x67, 0x73, 0x88, 0x01, 0x01, 0x12, 0x4a, 0x0a,
+	0x13, 0x63, 0x6f, 0x6e, 0x6a, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x65, 0x6e, 0x67,
+	0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x12, 0x63, 0x6f, 0x6e, 0x6a, 0x75, 0x6e, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x2b, 0x0a, 0x0f, 0x72, 0x65, 0x74,
+	0x75, 0x72, 0x6e, 0x5f, 0x61, 0x6c, 0x6c, 0x5f, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x48, 0x01, 0x52, 0x0d, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x41, 0x6c, 0x6c, 0x4c,
+	0x6f, 0x67, 0x73, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0d, 0x6e, 0x75, 0x6d, 0x5f, 0x6c, 0x6f,
+	0x67, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x02, 0x52,
+	0x0b, 0x6e, 0x75, 0x6d, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x88, 0x01, 0x01, 0x1a,
+	0x41, 0x0a, 0x13, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x53, 0x65,
+	0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x6c, 0x6f,
+	0x67, 0x73, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x61, 0x6c,
+	0x6c, 0x5f, 0x6c, 0x6f, 0x67, 0x73, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x6e, 0x75, 0x6d, 0x5f, 0x6c,
+	0x6f, 0x67, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x22, 0xc4, 0x03, 0x0a, 0x16, 0x47, 0x65, 0x74,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x80, 0x01, 0x0a, 0x1c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f,
+	0x6c, 0x6f, 0x67, 0x73, 0x5f, 0x62, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f,
+	0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x65, 0x6e, 0x67,
+	0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x42, 0x79, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x18, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x42, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x12, 0x7a, 0x0a, 0x1a, 0x6e, 0x6f, 0x74, 0x5f, 0x66, 0x6f,
+	0x75, 0x6e, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64,
+	0x5f, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x65, 0x6e, 0x67,
+	0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4e,
+	0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75,
+	0x69, 0x64, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x16, 0x6e, 0x6f, 0x74, 0x46,
+	0x6f, 0x75, 0x6e, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x53,
+	0x65, 0x74, 0x1a, 0x60, 0x0a, 0x1d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67,
+	0x73, 0x42, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70,
+	0x69, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x49, 0x0a, 0x1b, 0x4e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x53, 0x65, 0x74, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x57, 0x0a, 0x07, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69,
+	0x6e, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x38,
+	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x6b, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x4c,
+	0x69, 0x6e, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x08, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x65, 0x6e,
+	0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65,
+	0x72, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x65, 0x78, 0x74, 0x50, 0x61,
+	0x74, 0x74, 0x65, 0x72, 0x6e, 0x22, 0x9a, 0x01, 0x0a, 0x0f, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x41, 0x72, 0x67, 0x73, 0x12, 0x32, 0x0a, 0x12, 0x65, 0x6e, 0x63,
+	0x6c, 0x61, 0x76, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x11, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x3c, 0x0a,
+	0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e,
+	0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x42, 0x15, 0x0a, 0x13, 0x5f,
+	0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x22, 0x91, 0x04, 0x0a, 0x0b, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1b, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e,
+	0x67, 0x69, 0x6e, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x21, 0x0a,
+	0x0c, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x55, 0x75, 0x69, 0x64,
+	0x12, 0x21, 0x0a, 0x0c, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x01, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64,
+	0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x13, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x5f, 0x61, 0x72, 0x74,
+	0x69, 0x66, 0x61, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x02, 0x52, 0x11, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x13, 0x66, 0x69, 0x6c, 0x65,
+	0x73, 0x5f, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x11, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72,
+	0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x55, 0x75, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a,
+	0x11, 0x69, 0x73, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66,
+	0x75, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x48, 0x04, 0x52, 0x0f, 0x69, 0x73, 0x52, 0x75,
+	0x6e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x88, 0x01, 0x01, 0x42, 0x0f,
+	0x0a, 0x0d, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42,
+	0x0f, 0x0a, 0x0d, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64,
+	0x42, 0x16, 0x0a, 0x14, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x5f, 0x61, 0x72, 0x74, 0x69, 0x66,
+	0x61, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x66, 0x69, 0x6c,
+	0x65, 0x73, 0x5f, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64,
+	0x42, 0x14, 0x0a, 0x12, 0x5f, 0x69, 0x73, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x22, 0xf4, 0x02, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x41, 0x72, 0x67, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x72,
+	0x6f, 0x6e, 0x5f, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x72, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x49, 0x64, 0x12, 0x30, 0x0a, 0x11, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64,
+	0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x10, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x88, 0x01, 0x01, 0x12, 0x42, 0x0a, 0x0c, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f,
+	0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x65, 0x6e, 0x67,
+	0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0b, 0x65, 0x6e, 0x63,
+	0x6c, 0x61, 0x76, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x26, 0x0a, 0x0c, 0x65, 0x6e, 0x63, 0x6c,
+	0x61, 0x76, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01,
+	0x52, 0x0b, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x39, 0x0a, 0x16, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x72,
+	0x6f, 0x79, 0x5f, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x48, 0x02, 0x52, 0x14, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f,
+	0x79, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x88, 0x01, 0x01, 0x42, 0x14, 0x0a, 0x12, 0x5f,
+	0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x42, 0x19, 0x0a, 0x17, 0x5f, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x5f, 0x64, 0x65,
+	0x73, 0x74, 0x72, 0x6f, 0x79, 0x5f, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x22, 0x47, 0x0a,
+	0x13, 0x41, 0x64, 0x64, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f,
+	0x61, 0x70, 0x69, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x08, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x22, 0x4a, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32,
+	0x0a, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x73, 0x22, 0x45, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x41, 0x72, 0x67, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0xd1, 0x04, 0x0a, 0x08, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x55, 0x75, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x73,
+	0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x55, 0x75,
+	0x69, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x72, 0x6f, 0x6e, 0x5f, 0x65, 0x78, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x72, 0x6f,
+	0x6e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x65,
+	0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65,
+	0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x42, 0x0a, 0x0c, 0x65, 0x6e, 0x63, 0x6c, 0x61,
+	0x76, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e,
+	0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0b,
+	0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x65,
+	0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x34,
+	0x0a, 0x16, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79,
+	0x5f, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14,
+	0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x45, 0x6e, 0x63,
+	0x6c, 0x61, 0x76, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x3f, 0x0a, 0x0d, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x43, 0x0a, 0x0d, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00,
+	0x52, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x52, 0x75, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x39, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x73, 0x18,
+	0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x52, 0x75, 0x6e, 0x52,
+	0x0a, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x52, 0x75, 0x6e, 0x73, 0x42, 0x10, 0x0a, 0x0e, 0x5f,
+	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x92, 0x02,
+	0x0a, 0x0c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x52, 0x75, 0x6e, 0x12, 0x39,
+	0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x08, 0x65, 0x6e, 0x64,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x22, 0x6b, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x46, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61,
-	0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x6f, 0x72, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c,
-	0x74, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x74, 0x65, 0x78, 0x74, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x2a,
-	0x27, 0x0a, 0x0b, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x08,
-	0x0a, 0x04, 0x54, 0x45, 0x53, 0x54, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x52, 0x4f, 0x44,
-	0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x2a, 0x86, 0x01, 0x0a, 0x17, 0x45, 0x6e, 0x63,
-	0x6c, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x0a, 0x1d, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x43,
-	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f,
-	0x45, 0x4d, 0x50, 0x54, 0x59, 0x10, 0x00, 0x12, 0x23, 0x0a, 0x1f, 0x45, 0x6e, 0x63, 0x6c, 0x61,
-	0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x23, 0x0a, 0x1f,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x6e, 0x63,
+	0x6c, 0x61, 0x76, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x36, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e,
+	0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x52,
+	0x75, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x23, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x2a, 0x27, 0x0a, 0x0b, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x4d, 0x6f, 0x64,
+	0x65, 0x12, 0x08, 0x0a, 0x04, 0x54, 0x45, 0x53, 0x54, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x50,
+	0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x2a, 0x86, 0x01, 0x0a, 0x17,
 	0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
-	0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x53, 0x54, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10,
-	0x02, 0x2a, 0x94, 0x01, 0x0a, 0x19, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x50, 0x49,
-	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x29, 0x0a, 0x25, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x50, 0x49, 0x43, 0x6f, 0x6e,
-	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x4e, 0x4f, 0x4e,
-	0x45, 0x58, 0x49, 0x53, 0x54, 0x45, 0x4e, 0x54, 0x10, 0x00, 0x12, 0x25, 0x0a, 0x21, 0x45, 0x6e,
-	0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x50, 0x49, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
-	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10,
-	0x01, 0x12, 0x25, 0x0a, 0x21, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x50, 0x49, 0x43,
-	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x53,
-	0x54, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0x02, 0x2a, 0xc3, 0x01, 0x0a, 0x0f, 0x4c, 0x6f, 0x67,
-	0x4c, 0x69, 0x6e, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x25, 0x0a, 0x21,
-	0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f,
-	0x44, 0x4f, 0x45, 0x53, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x5f, 0x54, 0x45, 0x58,
-	0x54, 0x10, 0x00, 0x12, 0x29, 0x0a, 0x25, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x4f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x44, 0x4f, 0x45, 0x53, 0x5f, 0x4e, 0x4f, 0x54, 0x5f,
-	0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x5f, 0x54, 0x45, 0x58, 0x54, 0x10, 0x01, 0x12, 0x2c,
-	0x0a, 0x28, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
-	0x72, 0x5f, 0x44, 0x4f, 0x45, 0x53, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x5f, 0x4d,
-	0x41, 0x54, 0x43, 0x48, 0x5f, 0x52, 0x45, 0x47, 0x45, 0x58, 0x10, 0x02, 0x12, 0x30, 0x0a, 0x2c,
-	0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f,
-	0x44, 0x4f, 0x45, 0x53, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e,
-	0x5f, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x52, 0x45, 0x47, 0x45, 0x58, 0x10, 0x03, 0x32, 0x8b,
-	0x06, 0x0a, 0x0d, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x12, 0x4c, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x66,
-	0x6f, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x21, 0x2e, 0x65, 0x6e, 0x67, 0x69,
-	0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53,
-	0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x12,
-	0x1d, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x21,
-	0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76,
-	0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1f, 0x2e, 0x65, 0x6e, 0x67,
-	0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x63, 0x6c, 0x61,
-	0x76, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a,
-	0x12, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x73, 0x42, 0x79, 0x55, 0x75,
-	0x69, 0x64, 0x73, 0x12, 0x22, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69,
-	0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x73, 0x42, 0x79, 0x55, 0x75,
-	0x69, 0x64, 0x73, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x1f, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65,
-	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x86, 0x01, 0x0a, 0x2a, 0x47,
-	0x65, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x41, 0x6e, 0x64, 0x48, 0x69, 0x73,
-	0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x0a, 0x1d, 0x45, 0x6e, 0x63, 0x6c, 0x61,
+	0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x5f, 0x45, 0x4d, 0x50, 0x54, 0x59, 0x10, 0x00, 0x12, 0x23, 0x0a, 0x1f, 0x45, 0x6e,
+	0x63, 0x6c, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12,
+	0x23, 0x0a, 0x1f, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x53, 0x54, 0x4f, 0x50, 0x50,
+	0x45, 0x44, 0x10, 0x02, 0x2a, 0x94, 0x01, 0x0a, 0x19, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65,
+	0x41, 0x50, 0x49, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x29, 0x0a, 0x25, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x50, 0x49,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f,
+	0x4e, 0x4f, 0x4e, 0x45, 0x58, 0x49, 0x53, 0x54, 0x45, 0x4e, 0x54, 0x10, 0x00, 0x12, 0x25, 0x0a,
+	0x21, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x50, 0x49, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49,
+	0x4e, 0x47, 0x10, 0x01, 0x12, 0x25, 0x0a, 0x21, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41,
+	0x50, 0x49, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x5f, 0x53, 0x54, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0x02, 0x2a, 0xc3, 0x01, 0x0a, 0x0f,
+	0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12,
+	0x25, 0x0a, 0x21, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x5f, 0x44, 0x4f, 0x45, 0x53, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x5f,
+	0x54, 0x45, 0x58, 0x54, 0x10, 0x00, 0x12, 0x29, 0x0a, 0x25, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e,
+	0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x44, 0x4f, 0x45, 0x53, 0x5f, 0x4e,
+	0x4f, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x5f, 0x54, 0x45, 0x58, 0x54, 0x10,
+	0x01, 0x12, 0x2c, 0x0a, 0x28, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x5f, 0x44, 0x4f, 0x45, 0x53, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49,
+	0x4e, 0x5f, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x52, 0x45, 0x47, 0x45, 0x58, 0x10, 0x02, 0x12,
+	0x30, 0x0a, 0x2c, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x5f, 0x44, 0x4f, 0x45, 0x53, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x54,
+	0x41, 0x49, 0x4e, 0x5f, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x52, 0x45, 0x47, 0x45, 0x58, 0x10,
+	0x03, 0x2a, 0x95, 0x03, 0x0a, 0x0f, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x1f, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x5f, 0x45, 0x4e, 0x43, 0x4c, 0x41, 0x56, 0x45,
+	0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x23, 0x0a, 0x1f, 0x45, 0x6e,
+	0x67, 0x69, 0x6e, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x5f, 0x45, 0x4e,
+	0x43, 0x4c, 0x41, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0x01, 0x12,
+	0x25, 0x0a, 0x21, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x5f, 0x45, 0x4e, 0x43, 0x4c, 0x41, 0x56, 0x45, 0x5f, 0x44, 0x45, 0x53, 0x54, 0x52,
+	0x4f, 0x59, 0x45, 0x44, 0x10, 0x02, 0x12, 0x21, 0x0a, 0x1d, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43,
+	0x45, 0x5f, 0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x03, 0x12, 0x23, 0x0a, 0x1f, 0x45, 0x6e, 0x67,
+	0x69, 0x6e, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x5f, 0x53, 0x45, 0x52,
+	0x56, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x45, 0x44, 0x10, 0x04, 0x12, 0x23,
+	0x0a, 0x1f, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x4f, 0x50, 0x50, 0x45,
+	0x44, 0x10, 0x05, 0x12, 0x23, 0x0a, 0x1f, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x43,
+	0x52, 0x41, 0x53, 0x48, 0x45, 0x44, 0x10, 0x06, 0x12, 0x28, 0x0a, 0x24, 0x45, 0x6e, 0x67, 0x69,
+	0x6e, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x5f, 0x53, 0x54, 0x41, 0x52,
+	0x4c, 0x41, 0x52, 0x4b, 0x5f, 0x52, 0x55, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x45, 0x44,
+	0x10, 0x07, 0x12, 0x29, 0x0a, 0x25, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x4c, 0x41, 0x52, 0x4b, 0x5f, 0x52,
+	0x55, 0x4e, 0x5f, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x45, 0x44, 0x10, 0x08, 0x12, 0x2a, 0x0a,
+	0x26, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x5f, 0x46, 0x49, 0x4c, 0x45, 0x53, 0x5f, 0x41, 0x52, 0x54, 0x49, 0x46, 0x41, 0x43, 0x54, 0x5f,
+	0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x09, 0x2a, 0x53, 0x0a, 0x13, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x4d, 0x6f, 0x64, 0x65,
+	0x12, 0x1d, 0x0a, 0x19, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x45, 0x6e, 0x63, 0x6c,
+	0x61, 0x76, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x5f, 0x46, 0x52, 0x45, 0x53, 0x48, 0x10, 0x00, 0x12,
+	0x1d, 0x0a, 0x19, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61,
+	0x76, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x5f, 0x52, 0x45, 0x55, 0x53, 0x45, 0x10, 0x01, 0x2a, 0x75,
+	0x0a, 0x12, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x52, 0x75, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x1a, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x64, 0x52, 0x75, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49,
+	0x4e, 0x47, 0x10, 0x00, 0x12, 0x20, 0x0a, 0x1c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x64, 0x52, 0x75, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45,
+	0x45, 0x44, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x64, 0x52, 0x75, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x46, 0x41, 0x49,
+	0x4c, 0x45, 0x44, 0x10, 0x02, 0x32, 0xb4, 0x0a, 0x0a, 0x0d, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4c, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x45, 0x6e,
+	0x67, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x21, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65,
+	0x74, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45,
+	0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x12, 0x1d, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f,
+	0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76,
+	0x65, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x21, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0b, 0x47, 0x65,
+	0x74, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x1a, 0x3e, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47,
-	0x65, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x41, 0x6e, 0x64, 0x48, 0x69, 0x73,
-	0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x70, 0x45, 0x6e, 0x63, 0x6c, 0x61,
-	0x76, 0x65, 0x12, 0x1b, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e,
-	0x53, 0x74, 0x6f, 0x70, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x72, 0x67, 0x73, 0x1a,
-	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0e, 0x44, 0x65, 0x73,
+	0x79, 0x1a, 0x1f, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47,
+	0x65, 0x74, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x63, 0x6c, 0x61,
+	0x76, 0x65, 0x73, 0x42, 0x79, 0x55, 0x75, 0x69, 0x64, 0x73, 0x12, 0x22, 0x2e, 0x65, 0x6e, 0x67,
+	0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x63, 0x6c, 0x61,
+	0x76, 0x65, 0x73, 0x42, 0x79, 0x55, 0x75, 0x69, 0x64, 0x73, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x1f,
+	0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x45,
+	0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x86, 0x01, 0x0a, 0x2a, 0x47, 0x65, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e,
+	0x67, 0x41, 0x6e, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x45, 0x6e,
+	0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73,
+	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x3e, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e,
+	0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e,
+	0x67, 0x41, 0x6e, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x45, 0x6e,
+	0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0b, 0x53, 0x74,
+	0x6f, 0x70, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x12, 0x1b, 0x2e, 0x65, 0x6e, 0x67, 0x69,
+	0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x45, 0x6e, 0x63, 0x6c, 0x61,
+	0x76, 0x65, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
+	0x12, 0x50, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65,
+	0x12, 0x1c, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x20,
+	0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x53, 0x0a, 0x0d, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x45, 0x6e, 0x63, 0x6c,
+	0x61, 0x76, 0x65, 0x12, 0x1d, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69,
+	0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x72,
+	0x67, 0x73, 0x1a, 0x21, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e,
+	0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0c, 0x43, 0x6c, 0x6f, 0x6e, 0x65,
+	0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x12, 0x1c, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76,
+	0x65, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x20, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0e, 0x44, 0x65, 0x73,
 	0x74, 0x72, 0x6f, 0x79, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x12, 0x1e, 0x2e, 0x65, 0x6e,
 	0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79,
 	0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
@@ -1737,7 +3270,26 @@ var file_engine_service_proto_rawDesc = []byte{
 	0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73,
 	0x41, 0x72, 0x67, 0x73, 0x1a, 0x22, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70,
 	0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x42, 0x56, 0x5a, 0x54,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x47, 0x0a, 0x0b,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1b, 0x2e, 0x65, 0x6e,
+	0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x17, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e,
+	0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x22, 0x00, 0x30, 0x01, 0x12, 0x4d, 0x0a, 0x0b, 0x41, 0x64, 0x64, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x12, 0x1b, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70,
+	0x69, 0x2e, 0x41, 0x64, 0x64, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x41, 0x72, 0x67,
+	0x73, 0x1a, 0x1f, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41,
+	0x64, 0x64, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x20, 0x2e, 0x65,
+	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x4a, 0x0a, 0x0e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x12, 0x1e, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x41, 0x72,
+	0x67, 0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x42, 0x56, 0x5a, 0x54,
 	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x72, 0x74, 0x6f,
 	0x73, 0x69, 0x73, 0x2d, 0x74, 0x65, 0x63, 0x68, 0x2f, 0x6b, 0x75, 0x72, 0x74, 0x6f, 0x73, 0x69,
 	0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x2f, 0x65, 0x6e, 0x67,
@@ -1758,82 +3310,137 @@ func file_engine_service_proto_rawDescGZIP() []byte {
 	return file_engine_service_proto_rawDescData
 }
 
-var file_engine_service_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_engine_service_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
+var file_engine_service_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_engine_service_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
 var file_engine_service_proto_goTypes = []interface{}{
 	(EnclaveMode)(0),                                           // 0: engine_api.EnclaveMode
 	(EnclaveContainersStatus)(0),                               // 1: engine_api.EnclaveContainersStatus
 	(EnclaveAPIContainerStatus)(0),                             // 2: engine_api.EnclaveAPIContainerStatus
 	(LogLineOperator)(0),                                       // 3: engine_api.LogLineOperator
-	(*GetEngineInfoResponse)(nil),                              // 4: engine_api.GetEngineInfoResponse
-	(*CreateEnclaveArgs)(nil),                                  // 5: engine_api.CreateEnclaveArgs
-	(*CreateEnclaveResponse)(nil),                              // 6: engine_api.CreateEnclaveResponse
-	(*EnclaveAPIContainerInfo)(nil),                            // 7: engine_api.EnclaveAPIContainerInfo
-	(*EnclaveAPIContainerHostMachineInfo)(nil),                 // 8: engine_api.EnclaveAPIContainerHostMachineInfo
-	(*EnclaveInfo)(nil),                                        // 9: engine_api.EnclaveInfo
-	(*GetEnclavesResponse)(nil),                                // 10: engine_api.GetEnclavesResponse
-	(*EnclaveIdentifiers)(nil),                                 // 11: engine_api.EnclaveIdentifiers
-	(*GetExistingAndHistoricalEnclaveIdentifiersResponse)(nil), // 12: engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse
-	(*StopEnclaveArgs)(nil),                                    // 13: engine_api.StopEnclaveArgs
-	(*GetEnclavesByUuidsArgs)(nil),                             // 14: engine_api.GetEnclavesByUuidsArgs
-	(*DestroyEnclaveArgs)(nil),                                 // 15: engine_api.DestroyEnclaveArgs
-	(*CleanArgs)(nil),                                          // 16: engine_api.CleanArgs
-	(*EnclaveNameAndUuid)(nil),                                 // 17: engine_api.EnclaveNameAndUuid
-	(*CleanResponse)(nil),                                      // 18: engine_api.CleanResponse
-	(*GetServiceLogsArgs)(nil),                                 // 19: engine_api.GetServiceLogsArgs
-	(*GetServiceLogsResponse)(nil),                             // 20: engine_api.GetServiceLogsResponse
-	(*LogLine)(nil),                                            // 21: engine_api.LogLine
-	(*LogLineFilter)(nil),                                      // 22: engine_api.LogLineFilter
-	nil,                                                        // 23: engine_api.GetEnclavesResponse.EnclaveInfoEntry
-	nil,                                                        // 24: engine_api.GetServiceLogsArgs.ServiceUuidSetEntry
-	nil,                                                        // 25: engine_api.GetServiceLogsResponse.ServiceLogsByServiceUuidEntry
-	nil,                                                        // 26: engine_api.GetServiceLogsResponse.NotFoundServiceUuidSetEntry
-	(*timestamppb.Timestamp)(nil),                              // 27: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),                                      // 28: google.protobuf.Empty
+	(EngineEventType)(0),                                       // 4: engine_api.EngineEventType
+	(ScheduleEnclaveMode)(0),                                   // 5: engine_api.ScheduleEnclaveMode
+	(ScheduledRunStatus)(0),                                    // 6: engine_api.ScheduledRunStatus
+	(*GetEngineInfoResponse)(nil),                              // 7: engine_api.GetEngineInfoResponse
+	(*CreateEnclaveArgs)(nil),                                  // 8: engine_api.CreateEnclaveArgs
+	(*EnclaveResourceQuota)(nil),                               // 9: engine_api.EnclaveResourceQuota
+	(*CreateEnclaveResponse)(nil),                              // 10: engine_api.CreateEnclaveResponse
+	(*EnclaveAPIContainerInfo)(nil),                            // 11: engine_api.EnclaveAPIContainerInfo
+	(*EnclaveAPIContainerHostMachineInfo)(nil),                 // 12: engine_api.EnclaveAPIContainerHostMachineInfo
+	(*EnclaveInfo)(nil),                                        // 13: engine_api.EnclaveInfo
+	(*GetEnclavesResponse)(nil),                                // 14: engine_api.GetEnclavesResponse
+	(*EnclaveIdentifiers)(nil),                                 // 15: engine_api.EnclaveIdentifiers
+	(*GetExistingAndHistoricalEnclaveIdentifiersResponse)(nil), // 16: engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse
+	(*StopEnclaveArgs)(nil),                                    // 17: engine_api.StopEnclaveArgs
+	(*StartEnclaveArgs)(nil),                                   // 18: engine_api.StartEnclaveArgs
+	(*StartEnclaveResponse)(nil),                               // 19: engine_api.StartEnclaveResponse
+	(*ExtendEnclaveArgs)(nil),                                  // 20: engine_api.ExtendEnclaveArgs
+	(*ExtendEnclaveResponse)(nil),                              // 21: engine_api.ExtendEnclaveResponse
+	(*CloneEnclaveArgs)(nil),                                   // 22: engine_api.CloneEnclaveArgs
+	(*CloneEnclaveResponse)(nil),                               // 23: engine_api.CloneEnclaveResponse
+	(*GetEnclavesByUuidsArgs)(nil),                             // 24: engine_api.GetEnclavesByUuidsArgs
+	(*DestroyEnclaveArgs)(nil),                                 // 25: engine_api.DestroyEnclaveArgs
+	(*CleanArgs)(nil),                                          // 26: engine_api.CleanArgs
+	(*EnclaveNameAndUuid)(nil),                                 // 27: engine_api.EnclaveNameAndUuid
+	(*CleanResponse)(nil),                                      // 28: engine_api.CleanResponse
+	(*GetServiceLogsArgs)(nil),                                 // 29: engine_api.GetServiceLogsArgs
+	(*GetServiceLogsResponse)(nil),                             // 30: engine_api.GetServiceLogsResponse
+	(*LogLine)(nil),                                            // 31: engine_api.LogLine
+	(*LogLineFilter)(nil),                                      // 32: engine_api.LogLineFilter
+	(*WatchEventsArgs)(nil),                                    // 33: engine_api.WatchEventsArgs
+	(*EngineEvent)(nil),                                        // 34: engine_api.EngineEvent
+	(*AddScheduleArgs)(nil),                                    // 35: engine_api.AddScheduleArgs
+	(*AddScheduleResponse)(nil),                                // 36: engine_api.AddScheduleResponse
+	(*GetSchedulesResponse)(nil),                               // 37: engine_api.GetSchedulesResponse
+	(*RemoveScheduleArgs)(nil),                                 // 38: engine_api.RemoveScheduleArgs
+	(*Schedule)(nil),                                           // 39: engine_api.Schedule
+	(*ScheduledRun)(nil),                                       // 40: engine_api.ScheduledRun
+	nil,                                                        // 41: engine_api.GetEnclavesResponse.EnclaveInfoEntry
+	nil,                                                        // 42: engine_api.GetServiceLogsArgs.ServiceUuidSetEntry
+	nil,                                                        // 43: engine_api.GetServiceLogsResponse.ServiceLogsByServiceUuidEntry
+	nil,                                                        // 44: engine_api.GetServiceLogsResponse.NotFoundServiceUuidSetEntry
+	(*durationpb.Duration)(nil),                                // 45: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),                              // 46: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                                      // 47: google.protobuf.Empty
 }
 var file_engine_service_proto_depIdxs = []int32{
 	0,  // 0: engine_api.CreateEnclaveArgs.mode:type_name -> engine_api.EnclaveMode
-	9,  // 1: engine_api.CreateEnclaveResponse.enclave_info:type_name -> engine_api.EnclaveInfo
-	1,  // 2: engine_api.EnclaveInfo.containers_status:type_name -> engine_api.EnclaveContainersStatus
-	2,  // 3: engine_api.EnclaveInfo.api_container_status:type_name -> engine_api.EnclaveAPIContainerStatus
-	7,  // 4: engine_api.EnclaveInfo.api_container_info:type_name -> engine_api.EnclaveAPIContainerInfo
-	8,  // 5: engine_api.EnclaveInfo.api_container_host_machine_info:type_name -> engine_api.EnclaveAPIContainerHostMachineInfo
-	27, // 6: engine_api.EnclaveInfo.creation_time:type_name -> google.protobuf.Timestamp
-	0,  // 7: engine_api.EnclaveInfo.mode:type_name -> engine_api.EnclaveMode
-	23, // 8: engine_api.GetEnclavesResponse.enclave_info:type_name -> engine_api.GetEnclavesResponse.EnclaveInfoEntry
-	11, // 9: engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.allIdentifiers:type_name -> engine_api.EnclaveIdentifiers
-	17, // 10: engine_api.CleanResponse.removed_enclave_name_and_uuids:type_name -> engine_api.EnclaveNameAndUuid
-	24, // 11: engine_api.GetServiceLogsArgs.service_uuid_set:type_name -> engine_api.GetServiceLogsArgs.ServiceUuidSetEntry
-	22, // 12: engine_api.GetServiceLogsArgs.conjunctive_filters:type_name -> engine_api.LogLineFilter
-	25, // 13: engine_api.GetServiceLogsResponse.service_logs_by_service_uuid:type_name -> engine_api.GetServiceLogsResponse.ServiceLogsByServiceUuidEntry
-	26, // 14: engine_api.GetServiceLogsResponse.not_found_service_uuid_set:type_name -> engine_api.GetServiceLogsResponse.NotFoundServiceUuidSetEntry
-	27, // 15: engine_api.LogLine.timestamp:type_name -> google.protobuf.Timestamp
-	3,  // 16: engine_api.LogLineFilter.operator:type_name -> engine_api.LogLineOperator
-	9,  // 17: engine_api.GetEnclavesResponse.EnclaveInfoEntry.value:type_name -> engine_api.EnclaveInfo
-	21, // 18: engine_api.GetServiceLogsResponse.ServiceLogsByServiceUuidEntry.value:type_name -> engine_api.LogLine
-	28, // 19: engine_api.EngineService.GetEngineInfo:input_type -> google.protobuf.Empty
-	5,  // 20: engine_api.EngineService.CreateEnclave:input_type -> engine_api.CreateEnclaveArgs
-	28, // 21: engine_api.EngineService.GetEnclaves:input_type -> google.protobuf.Empty
-	14, // 22: engine_api.EngineService.GetEnclavesByUuids:input_type -> engine_api.GetEnclavesByUuidsArgs
-	28, // 23: engine_api.EngineService.GetExistingAndHistoricalEnclaveIdentifiers:input_type -> google.protobuf.Empty
-	13, // 24: engine_api.EngineService.StopEnclave:input_type -> engine_api.StopEnclaveArgs
-	15, // 25: engine_api.EngineService.DestroyEnclave:input_type -> engine_api.DestroyEnclaveArgs
-	16, // 26: engine_api.EngineService.Clean:input_type -> engine_api.CleanArgs
-	19, // 27: engine_api.EngineService.GetServiceLogs:input_type -> engine_api.GetServiceLogsArgs
-	4,  // 28: engine_api.EngineService.GetEngineInfo:output_type -> engine_api.GetEngineInfoResponse
-	6,  // 29: engine_api.EngineService.CreateEnclave:output_type -> engine_api.CreateEnclaveResponse
-	10, // 30: engine_api.EngineService.GetEnclaves:output_type -> engine_api.GetEnclavesResponse
-	10, // 31: engine_api.EngineService.GetEnclavesByUuids:output_type -> engine_api.GetEnclavesResponse
-	12, // 32: engine_api.EngineService.GetExistingAndHistoricalEnclaveIdentifiers:output_type -> engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse
-	28, // 33: engine_api.EngineService.StopEnclave:output_type -> google.protobuf.Empty
-	28, // 34: engine_api.EngineService.DestroyEnclave:output_type -> google.protobuf.Empty
-	18, // 35: engine_api.EngineService.Clean:output_type -> engine_api.CleanResponse
-	20, // 36: engine_api.EngineService.GetServiceLogs:output_type -> engine_api.GetServiceLogsResponse
-	28, // [28:37] is the sub-list for method output_type
-	19, // [19:28] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	45, // 1: engine_api.CreateEnclaveArgs.ttl:type_name -> google.protobuf.Duration
+	45, // 2: engine_api.CreateEnclaveArgs.idle_timeout:type_name -> google.protobuf.Duration
+	9,  // 3: engine_api.CreateEnclaveArgs.resource_quota:type_name -> engine_api.EnclaveResourceQuota
+	13, // 4: engine_api.CreateEnclaveResponse.enclave_info:type_name -> engine_api.EnclaveInfo
+	1,  // 5: engine_api.EnclaveInfo.containers_status:type_name -> engine_api.EnclaveContainersStatus
+	2,  // 6: engine_api.EnclaveInfo.api_container_status:type_name -> engine_api.EnclaveAPIContainerStatus
+	11, // 7: engine_api.EnclaveInfo.api_container_info:type_name -> engine_api.EnclaveAPIContainerInfo
+	12, // 8: engine_api.EnclaveInfo.api_container_host_machine_info:type_name -> engine_api.EnclaveAPIContainerHostMachineInfo
+	46, // 9: engine_api.EnclaveInfo.creation_time:type_name -> google.protobuf.Timestamp
+	0,  // 10: engine_api.EnclaveInfo.mode:type_name -> engine_api.EnclaveMode
+	46, // 11: engine_api.EnclaveInfo.expiration_time:type_name -> google.protobuf.Timestamp
+	46, // 12: engine_api.EnclaveInfo.idle_expiration_time:type_name -> google.protobuf.Timestamp
+	41, // 13: engine_api.GetEnclavesResponse.enclave_info:type_name -> engine_api.GetEnclavesResponse.EnclaveInfoEntry
+	15, // 14: engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.allIdentifiers:type_name -> engine_api.EnclaveIdentifiers
+	13, // 15: engine_api.StartEnclaveResponse.enclave_info:type_name -> engine_api.EnclaveInfo
+	45, // 16: engine_api.ExtendEnclaveArgs.duration:type_name -> google.protobuf.Duration
+	13, // 17: engine_api.ExtendEnclaveResponse.enclave_info:type_name -> engine_api.EnclaveInfo
+	13, // 18: engine_api.CloneEnclaveResponse.enclave_info:type_name -> engine_api.EnclaveInfo
+	27, // 19: engine_api.CleanResponse.removed_enclave_name_and_uuids:type_name -> engine_api.EnclaveNameAndUuid
+	42, // 20: engine_api.GetServiceLogsArgs.service_uuid_set:type_name -> engine_api.GetServiceLogsArgs.ServiceUuidSetEntry
+	32, // 21: engine_api.GetServiceLogsArgs.conjunctive_filters:type_name -> engine_api.LogLineFilter
+	43, // 22: engine_api.GetServiceLogsResponse.service_logs_by_service_uuid:type_name -> engine_api.GetServiceLogsResponse.ServiceLogsByServiceUuidEntry
+	44, // 23: engine_api.GetServiceLogsResponse.not_found_service_uuid_set:type_name -> engine_api.GetServiceLogsResponse.NotFoundServiceUuidSetEntry
+	46, // 24: engine_api.LogLine.timestamp:type_name -> google.protobuf.Timestamp
+	3,  // 25: engine_api.LogLineFilter.operator:type_name -> engine_api.LogLineOperator
+	4,  // 26: engine_api.WatchEventsArgs.event_types:type_name -> engine_api.EngineEventType
+	4,  // 27: engine_api.EngineEvent.type:type_name -> engine_api.EngineEventType
+	46, // 28: engine_api.EngineEvent.timestamp:type_name -> google.protobuf.Timestamp
+	5,  // 29: engine_api.AddScheduleArgs.enclave_mode:type_name -> engine_api.ScheduleEnclaveMode
+	39, // 30: engine_api.AddScheduleResponse.schedule:type_name -> engine_api.Schedule
+	39, // 31: engine_api.GetSchedulesResponse.schedules:type_name -> engine_api.Schedule
+	5,  // 32: engine_api.Schedule.enclave_mode:type_name -> engine_api.ScheduleEnclaveMode
+	46, // 33: engine_api.Schedule.creation_time:type_name -> google.protobuf.Timestamp
+	46, // 34: engine_api.Schedule.next_run_time:type_name -> google.protobuf.Timestamp
+	40, // 35: engine_api.Schedule.recent_runs:type_name -> engine_api.ScheduledRun
+	46, // 36: engine_api.ScheduledRun.start_time:type_name -> google.protobuf.Timestamp
+	46, // 37: engine_api.ScheduledRun.end_time:type_name -> google.protobuf.Timestamp
+	6,  // 38: engine_api.ScheduledRun.status:type_name -> engine_api.ScheduledRunStatus
+	13, // 39: engine_api.GetEnclavesResponse.EnclaveInfoEntry.value:type_name -> engine_api.EnclaveInfo
+	31, // 40: engine_api.GetServiceLogsResponse.ServiceLogsByServiceUuidEntry.value:type_name -> engine_api.LogLine
+	47, // 41: engine_api.EngineService.GetEngineInfo:input_type -> google.protobuf.Empty
+	8,  // 42: engine_api.EngineService.CreateEnclave:input_type -> engine_api.CreateEnclaveArgs
+	47, // 43: engine_api.EngineService.GetEnclaves:input_type -> google.protobuf.Empty
+	24, // 44: engine_api.EngineService.GetEnclavesByUuids:input_type -> engine_api.GetEnclavesByUuidsArgs
+	47, // 45: engine_api.EngineService.GetExistingAndHistoricalEnclaveIdentifiers:input_type -> google.protobuf.Empty
+	17, // 46: engine_api.EngineService.StopEnclave:input_type -> engine_api.StopEnclaveArgs
+	18, // 47: engine_api.EngineService.StartEnclave:input_type -> engine_api.StartEnclaveArgs
+	20, // 48: engine_api.EngineService.ExtendEnclave:input_type -> engine_api.ExtendEnclaveArgs
+	22, // 49: engine_api.EngineService.CloneEnclave:input_type -> engine_api.CloneEnclaveArgs
+	25, // 50: engine_api.EngineService.DestroyEnclave:input_type -> engine_api.DestroyEnclaveArgs
+	26, // 51: engine_api.EngineService.Clean:input_type -> engine_api.CleanArgs
+	29, // 52: engine_api.EngineService.GetServiceLogs:input_type -> engine_api.GetServiceLogsArgs
+	33, // 53: engine_api.EngineService.WatchEvents:input_type -> engine_api.WatchEventsArgs
+	35, // 54: engine_api.EngineService.AddSchedule:input_type -> engine_api.AddScheduleArgs
+	47, // 55: engine_api.EngineService.GetSchedules:input_type -> google.protobuf.Empty
+	38, // 56: engine_api.EngineService.RemoveSchedule:input_type -> engine_api.RemoveScheduleArgs
+	7,  // 57: engine_api.EngineService.GetEngineInfo:output_type -> engine_api.GetEngineInfoResponse
+	10, // 58: engine_api.EngineService.CreateEnclave:output_type -> engine_api.CreateEnclaveResponse
+	14, // 59: engine_api.EngineService.GetEnclaves:output_type -> engine_api.GetEnclavesResponse
+	14, // 60: engine_api.EngineService.GetEnclavesByUuids:output_type -> engine_api.GetEnclavesResponse
+	16, // 61: engine_api.EngineService.GetExistingAndHistoricalEnclaveIdentifiers:output_type -> engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse
+	47, // 62: engine_api.EngineService.StopEnclave:output_type -> google.protobuf.Empty
+	19, // 63: engine_api.EngineService.StartEnclave:output_type -> engine_api.StartEnclaveResponse
+	21, // 64: engine_api.EngineService.ExtendEnclave:output_type -> engine_api.ExtendEnclaveResponse
+	23, // 65: engine_api.EngineService.CloneEnclave:output_type -> engine_api.CloneEnclaveResponse
+	47, // 66: engine_api.EngineService.DestroyEnclave:output_type -> google.protobuf.Empty
+	28, // 67: engine_api.EngineService.Clean:output_type -> engine_api.CleanResponse
+	30, // 68: engine_api.EngineService.GetServiceLogs:output_type -> engine_api.GetServiceLogsResponse
+	34, // 69: engine_api.EngineService.WatchEvents:output_type -> engine_api.EngineEvent
+	36, // 70: engine_api.EngineService.AddSchedule:output_type -> engine_api.AddScheduleResponse
+	37, // 71: engine_api.EngineService.GetSchedules:output_type -> engine_api.GetSchedulesResponse
+	47, // 72: engine_api.EngineService.RemoveSchedule:output_type -> google.protobuf.Empty
+	57, // [57:73] is the sub-list for method output_type
+	41, // [41:57] is the sub-list for method input_type
+	41, // [41:41] is the sub-list for extension type_name
+	41, // [41:41] is the sub-list for extension extendee
+	0,  // [0:41] is the sub-list for field type_name
 }
 
 func init() { file_engine_service_proto_init() }
@@ -1867,7 +3474,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateEnclaveResponse); i {
+			switch v := v.(*EnclaveResourceQuota); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1879,7 +3486,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnclaveAPIContainerInfo); i {
+			switch v := v.(*CreateEnclaveResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1891,7 +3498,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnclaveAPIContainerHostMachineInfo); i {
+			switch v := v.(*EnclaveAPIContainerInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1903,7 +3510,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnclaveInfo); i {
+			switch v := v.(*EnclaveAPIContainerHostMachineInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1915,7 +3522,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetEnclavesResponse); i {
+			switch v := v.(*EnclaveInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1927,7 +3534,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnclaveIdentifiers); i {
+			switch v := v.(*GetEnclavesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1939,7 +3546,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetExistingAndHistoricalEnclaveIdentifiersResponse); i {
+			switch v := v.(*EnclaveIdentifiers); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1951,7 +3558,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StopEnclaveArgs); i {
+			switch v := v.(*GetExistingAndHistoricalEnclaveIdentifiersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1963,7 +3570,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetEnclavesByUuidsArgs); i {
+			switch v := v.(*StopEnclaveArgs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1975,7 +3582,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DestroyEnclaveArgs); i {
+			switch v := v.(*StartEnclaveArgs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1987,7 +3594,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CleanArgs); i {
+			switch v := v.(*StartEnclaveResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1999,7 +3606,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnclaveNameAndUuid); i {
+			switch v := v.(*ExtendEnclaveArgs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2011,7 +3618,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CleanResponse); i {
+			switch v := v.(*ExtendEnclaveResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2023,7 +3630,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetServiceLogsArgs); i {
+			switch v := v.(*CloneEnclaveArgs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2035,7 +3642,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetServiceLogsResponse); i {
+			switch v := v.(*CloneEnclaveResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2047,7 +3654,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LogLine); i {
+			switch v := v.(*GetEnclavesByUuidsArgs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2059,6 +3666,90 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DestroyEnclaveArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CleanArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnclaveNameAndUuid); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CleanResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetServiceLogsArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetServiceLogsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogLine); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LogLineFilter); i {
 			case 0:
 				return &v.state
@@ -2070,17 +3761,119 @@ func file_engine_service_proto_init() {
 				return nil
 			}
 		}
+		file_engine_service_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchEventsArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_service_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EngineEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_service_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddScheduleArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_service_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddScheduleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_service_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSchedulesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_service_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveScheduleArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_service_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Schedule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_service_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduledRun); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_engine_service_proto_msgTypes[1].OneofWrappers = []interface{}{}
-	file_engine_service_proto_msgTypes[12].OneofWrappers = []interface{}{}
-	file_engine_service_proto_msgTypes[15].OneofWrappers = []interface{}{}
+	file_engine_service_proto_msgTypes[6].OneofWrappers = []interface{}{}
+	file_engine_service_proto_msgTypes[19].OneofWrappers = []interface{}{}
+	file_engine_service_proto_msgTypes[22].OneofWrappers = []interface{}{}
+	file_engine_service_proto_msgTypes[26].OneofWrappers = []interface{}{}
+	file_engine_service_proto_msgTypes[27].OneofWrappers = []interface{}{}
+	file_engine_service_proto_msgTypes[28].OneofWrappers = []interface{}{}
+	file_engine_service_proto_msgTypes[32].OneofWrappers = []interface{}{}
+	file_engine_service_proto_msgTypes[33].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_engine_service_proto_rawDesc,
-			NumEnums:      4,
-			NumMessages:   23,
+			NumEnums:      7,
+			NumMessages:   38,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -26,9 +26,16 @@ const (
 	EngineService_GetEnclavesByUuids_FullMethodName                         = "/engine_api.EngineService/GetEnclavesByUuids"
 	EngineService_GetExistingAndHistoricalEnclaveIdentifiers_FullMethodName = "/engine_api.EngineService/GetExistingAndHistoricalEnclaveIdentifiers"
 	EngineService_StopEnclave_FullMethodName                                = "/engine_api.EngineService/StopEnclave"
+	EngineService_StartEnclave_FullMethodName                               = "/engine_api.EngineService/StartEnclave"
+	EngineService_ExtendEnclave_FullMethodName                              = "/engine_api.EngineService/ExtendEnclave"
+	EngineService_CloneEnclave_FullMethodName                               = "/engine_api.EngineService/CloneEnclave"
 	EngineService_DestroyEnclave_FullMethodName                             = "/engine_api.EngineService/DestroyEnclave"
 	EngineService_Clean_FullMethodName                                      = "/engine_api.EngineService/Clean"
 	EngineService_GetServiceLogs_FullMethodName                             = "/engine_api.EngineService/GetServiceLogs"
+	EngineService_WatchEvents_FullMethodName                                = "/engine_api.EngineService/WatchEvents"
+	EngineService_AddSchedule_FullMethodName                                = "/engine_api.EngineService/AddSchedule"
+	EngineService_GetSchedules_FullMethodName                               = "/engine_api.EngineService/GetSchedules"
+	EngineService_RemoveSchedule_FullMethodName                             = "/engine_api.EngineService/RemoveSchedule"
 )
 
 // EngineServiceClient is the client API for EngineService service.
@@ -52,12 +59,32 @@ type EngineServiceClient interface {
 	GetExistingAndHistoricalEnclaveIdentifiers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetExistingAndHistoricalEnclaveIdentifiersResponse, error)
 	// Stops all containers in an enclave
 	StopEnclave(ctx context.Context, in *StopEnclaveArgs, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Starts again the API container and logs collector of a stopped enclave
+	StartEnclave(ctx context.Context, in *StartEnclaveArgs, opts ...grpc.CallOption) (*StartEnclaveResponse, error)
+	// Pushes back the expiration deadline of an enclave and resets its idle timer
+	ExtendEnclave(ctx context.Context, in *ExtendEnclaveArgs, opts ...grpc.CallOption) (*ExtendEnclaveResponse, error)
+	// Creates a new enclave with the files artifacts, persistent directories and services of an existing one
+	CloneEnclave(ctx context.Context, in *CloneEnclaveArgs, opts ...grpc.CallOption) (*CloneEnclaveResponse, error)
 	// Destroys an enclave, removing all artifacts associated with it
 	DestroyEnclave(ctx context.Context, in *DestroyEnclaveArgs, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Gets rid of old enclaves
 	Clean(ctx context.Context, in *CleanArgs, opts ...grpc.CallOption) (*CleanResponse, error)
 	// Get service logs
 	GetServiceLogs(ctx context.Context, in *GetServiceLogsArgs, opts ...grpc.CallOption) (EngineService_GetServiceLogsClient, error)
+	// Streams what happens to the enclaves from now on: enclaves created, stopped and destroyed, and the services,
+	// Starlark runs and files artifacts inside them
+	WatchEvents(ctx context.Context, in *WatchEventsArgs, opts ...grpc.CallOption) (EngineService_WatchEventsClient, error)
+	// ==============================================================================================
+	//
+	//	Scheduled Runs
+	//
+	// ==============================================================================================
+	// Makes the engine run a package every time a cron expression matches
+	AddSchedule(ctx context.Context, in *AddScheduleArgs, opts ...grpc.CallOption) (*AddScheduleResponse, error)
+	// Returns all the schedules along with the outcome of their latest runs
+	GetSchedules(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetSchedulesResponse, error)
+	// Stops a schedule from running again
+	RemoveSchedule(ctx context.Context, in *RemoveScheduleArgs, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type engineServiceClient struct {
@@ -122,6 +149,33 @@ func (c *engineServiceClient) StopEnclave(ctx context.Context, in *StopEnclaveAr
 	return out, nil
 }
 
+func (c *engineServiceClient) StartEnclave(ctx context.Context, in *StartEnclaveArgs, opts ...grpc.CallOption) (*StartEnclaveResponse, error) {
+	out := new(StartEnclaveResponse)
+	err := c.cc.Invoke(ctx, EngineService_StartEnclave_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) ExtendEnclave(ctx context.Context, in *ExtendEnclaveArgs, opts ...grpc.CallOption) (*ExtendEnclaveResponse, error) {
+	out := new(ExtendEnclaveResponse)
+	err := c.cc.Invoke(ctx, EngineService_ExtendEnclave_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) CloneEnclave(ctx context.Context, in *CloneEnclaveArgs, opts ...grpc.CallOption) (*CloneEnclaveResponse, error) {
+	out := new(CloneEnclaveResponse)
+	err := c.cc.Invoke(ctx, EngineService_CloneEnclave_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *engineServiceClient) DestroyEnclave(ctx context.Context, in *DestroyEnclaveArgs, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, EngineService_DestroyEnclave_FullMethodName, in, out, opts...)
@@ -172,6 +226,65 @@ func (x *engineServiceGetServiceLogsClient) Recv() (*GetServiceLogsResponse, err
 	return m, nil
 }
 
+func (c *engineServiceClient) WatchEvents(ctx context.Context, in *WatchEventsArgs, opts ...grpc.CallOption) (EngineService_WatchEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &EngineService_ServiceDesc.Streams[1], EngineService_WatchEvents_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &engineServiceWatchEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type EngineService_WatchEventsClient interface {
+	Recv() (*EngineEvent, error)
+	grpc.ClientStream
+}
+
+type engineServiceWatchEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *engineServiceWatchEventsClient) Recv() (*EngineEvent, error) {
+	m := new(EngineEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *engineServiceClient) AddSchedule(ctx context.Context, in *AddScheduleArgs, opts ...grpc.CallOption) (*AddScheduleResponse, error) {
+	out := new(AddScheduleResponse)
+	err := c.cc.Invoke(ctx, EngineService_AddSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) GetSchedules(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetSchedulesResponse, error) {
+	out := new(GetSchedulesResponse)
+	err := c.cc.Invoke(ctx, EngineService_GetSchedules_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) RemoveSchedule(ctx context.Context, in *RemoveScheduleArgs, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, EngineService_RemoveSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EngineServiceServer is the server API for EngineService service.
 // All implementations should embed UnimplementedEngineServiceServer
 // for forward compatibility
@@ -193,12 +306,32 @@ type EngineServiceServer interface {
 	GetExistingAndHistoricalEnclaveIdentifiers(context.Context, *emptypb.Empty) (*GetExistingAndHistoricalEnclaveIdentifiersResponse, error)
 	// Stops all containers in an enclave
 	StopEnclave(context.Context, *StopEnclaveArgs) (*emptypb.Empty, error)
+	// Starts again the API container and logs collector of a stopped enclave
+	StartEnclave(context.Context, *StartEnclaveArgs) (*StartEnclaveResponse, error)
+	// Pushes back the expiration deadline of an enclave and resets its idle timer
+	ExtendEnclave(context.Context, *ExtendEnclaveArgs) (*ExtendEnclaveResponse, error)
+	// Creates a new enclave with the files artifacts, persistent directories and services of an existing one
+	CloneEnclave(context.Context, *CloneEnclaveArgs) (*CloneEnclaveResponse, error)
 	// Destroys an enclave, removing all artifacts associated with it
 	DestroyEnclave(context.Context, *DestroyEnclaveArgs) (*emptypb.Empty, error)
 	// Gets rid of old enclaves
 	Clean(context.Context, *CleanArgs) (*CleanResponse, error)
 	// Get service logs
 	GetServiceLogs(*GetServiceLogsArgs, EngineService_GetServiceLogsServer) error
+	// Streams what happens to the enclaves from now on: enclaves created, stopped and destroyed, and the services,
+	// Starlark runs and files artifacts inside them
+	WatchEvents(*WatchEventsArgs, EngineService_WatchEventsServer) error
+	// ==============================================================================================
+	//
+	//	Scheduled Runs
+	//
+	// ==============================================================================================
+	// Makes the engine run a package every time a cron expression matches
+	AddSchedule(context.Context, *AddScheduleArgs) (*AddScheduleResponse, error)
+	// Returns all the schedules along with the outcome of their latest runs
+	GetSchedules(context.Context, *emptypb.Empty) (*GetSchedulesResponse, error)
+	// Stops a schedule from running again
+	RemoveSchedule(context.Context, *RemoveScheduleArgs) (*emptypb.Empty, error)
 }
 
 // UnimplementedEngineServiceServer should be embedded to have forward compatible implementations.
@@ -223,6 +356,15 @@ func (UnimplementedEngineServiceServer) GetExistingAndHistoricalEnclaveIdentifie
 func (UnimplementedEngineServiceServer) StopEnclave(context.Context, *StopEnclaveArgs) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StopEnclave not implemented")
 }
+func (UnimplementedEngineServiceServer) StartEnclave(context.Context, *StartEnclaveArgs) (*StartEnclaveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartEnclave not implemented")
+}
+func (UnimplementedEngineServiceServer) ExtendEnclave(context.Context, *ExtendEnclaveArgs) (*ExtendEnclaveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendEnclave not implemented")
+}
+func (UnimplementedEngineServiceServer) CloneEnclave(context.Context, *CloneEnclaveArgs) (*CloneEnclaveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneEnclave not implemented")
+}
 func (UnimplementedEngineServiceServer) DestroyEnclave(context.Context, *DestroyEnclaveArgs) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DestroyEnclave not implemented")
 }
@@ -232,6 +374,18 @@ func (UnimplementedEngineServiceServer) Clean(context.Context, *CleanArgs) (*Cle
 func (UnimplementedEngineServiceServer) GetServiceLogs(*GetServiceLogsArgs, EngineService_GetServiceLogsServer) error {
 	return status.Errorf(codes.Unimplemented, "method GetServiceLogs not implemented")
 }
+func (UnimplementedEngineServiceServer) WatchEvents(*WatchEventsArgs, EngineService_WatchEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchEvents not implemented")
+}
+func (UnimplementedEngineServiceServer) AddSchedule(context.Context, *AddScheduleArgs) (*AddScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSchedule not implemented")
+}
+func (UnimplementedEngineServiceServer) GetSchedules(context.Context, *emptypb.Empty) (*GetSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchedules not implemented")
+}
+func (UnimplementedEngineServiceServer) RemoveSchedule(context.Context, *RemoveScheduleArgs) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveSchedule not implemented")
+}
 
 // UnsafeEngineServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to EngineServiceServer will
@@ -352,6 +506,60 @@ func _EngineService_StopEnclave_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EngineService_StartEnclave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartEnclaveArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).StartEnclave(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineService_StartEnclave_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).StartEnclave(ctx, req.(*StartEnclaveArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_ExtendEnclave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtendEnclaveArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).ExtendEnclave(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineService_ExtendEnclave_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).ExtendEnclave(ctx, req.(*ExtendEnclaveArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_CloneEnclave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneEnclaveArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).CloneEnclave(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineService_CloneEnclave_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).CloneEnclave(ctx, req.(*CloneEnclaveArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EngineService_DestroyEnclave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DestroyEnclaveArgs)
 	if err := dec(in); err != nil {
@@ -409,6 +617,81 @@ func (x *engineServiceGetServiceLogsServer) Send(m *GetServiceLogsResponse) erro
 	return x.ServerStream.SendMsg(m)
 }
 
+func _EngineService_WatchEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchEventsArgs)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EngineServiceServer).WatchEvents(m, &engineServiceWatchEventsServer{stream})
+}
+
+type EngineService_WatchEventsServer interface {
+	Send(*EngineEvent) error
+	grpc.ServerStream
+}
+
+type engineServiceWatchEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *engineServiceWatchEventsServer) Send(m *EngineEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _EngineService_AddSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddScheduleArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).AddSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineService_AddSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).AddSchedule(ctx, req.(*AddScheduleArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_GetSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).GetSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineService_GetSchedules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).GetSchedules(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_RemoveSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveScheduleArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).RemoveSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineService_RemoveSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).RemoveSchedule(ctx, req.(*RemoveScheduleArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // EngineService_ServiceDesc is the grpc.ServiceDesc for EngineService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -440,6 +723,18 @@ var EngineService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "StopEnclave",
 			Handler:    _EngineService_StopEnclave_Handler,
 		},
+		{
+			MethodName: "StartEnclave",
+			Handler:    _EngineService_StartEnclave_Handler,
+		},
+		{
+			MethodName: "ExtendEnclave",
+			Handler:    _EngineService_ExtendEnclave_Handler,
+		},
+		{
+			MethodName: "CloneEnclave",
+			Handler:    _EngineService_CloneEnclave_Handler,
+		},
 		{
 			MethodName: "DestroyEnclave",
 			Handler:    _EngineService_DestroyEnclave_Handler,
@@ -448,6 +743,18 @@ var EngineService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Clean",
 			Handler:    _EngineService_Clean_Handler,
 		},
+		{
+			MethodName: "AddSchedule",
+			Handler:    _EngineService_AddSchedule_Handler,
+		},
+		{
+			MethodName: "GetSchedules",
+			Handler:    _EngineService_GetSchedules_Handler,
+		},
+		{
+			MethodName: "RemoveSchedule",
+			Handler:    _EngineService_RemoveSchedule_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -455,6 +762,11 @@ var EngineService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _EngineService_GetServiceLogs_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "WatchEvents",
+			Handler:       _EngineService_WatchEvents_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "engine_service.proto",
 }
@@ -52,6 +52,15 @@ const (
 	// EngineServiceStopEnclaveProcedure is the fully-qualified name of the EngineService's StopEnclave
 	// RPC.
 	EngineServiceStopEnclaveProcedure = "/engine_api.EngineService/StopEnclave"
+	// EngineServiceStartEnclaveProcedure is the fully-qualified name of the EngineService's
+	// StartEnclave RPC.
+	EngineServiceStartEnclaveProcedure = "/engine_api.EngineService/StartEnclave"
+	// EngineServiceExtendEnclaveProcedure is the fully-qualified name of the EngineService's
+	// ExtendEnclave RPC.
+	EngineServiceExtendEnclaveProcedure = "/engine_api.EngineService/ExtendEnclave"
+	// EngineServiceCloneEnclaveProcedure is the fully-qualified name of the EngineService's
+	// CloneEnclave RPC.
+	EngineServiceCloneEnclaveProcedure = "/engine_api.EngineService/CloneEnclave"
 	// EngineServiceDestroyEnclaveProcedure is the fully-qualified name of the EngineService's
 	// DestroyEnclave RPC.
 	EngineServiceDestroyEnclaveProcedure = "/engine_api.EngineService/DestroyEnclave"
@@ -60,6 +69,18 @@ const (
 	// EngineServiceGetServiceLogsProcedure is the fully-qualified name of the EngineService's
 	// GetServiceLogs RPC.
 	EngineServiceGetServiceLogsProcedure = "/engine_api.EngineService/GetServiceLogs"
+	// EngineServiceWatchEventsProcedure is the fully-qualified name of the EngineService's WatchEvents
+	// RPC.
+	EngineServiceWatchEventsProcedure = "/engine_api.EngineService/WatchEvents"
+	// EngineServiceAddScheduleProcedure is the fully-qualified name of the EngineService's AddSchedule
+	// RPC.
+	EngineServiceAddScheduleProcedure = "/engine_api.EngineService/AddSchedule"
+	// EngineServiceGetSchedulesProcedure is the fully-qualified name of the EngineService's
+	// GetSchedules RPC.
+	EngineServiceGetSchedulesProcedure = "/engine_api.EngineService/GetSchedules"
+	// EngineServiceRemoveScheduleProcedure is the fully-qualified name of the EngineService's
+	// RemoveSchedule RPC.
+	EngineServiceRemoveScheduleProcedure = "/engine_api.EngineService/RemoveSchedule"
 )
 
 // EngineServiceClient is a client for the engine_api.EngineService service.
@@ -81,12 +102,32 @@ type EngineServiceClient interface {
 	GetExistingAndHistoricalEnclaveIdentifiers(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetExistingAndHistoricalEnclaveIdentifiersResponse], error)
 	// Stops all containers in an enclave
 	StopEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.StopEnclaveArgs]) (*connect.Response[emptypb.Empty], error)
+	// Starts again the API container and logs collector of a stopped enclave
+	StartEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.StartEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.StartEnclaveResponse], error)
+	// Pushes back the expiration deadline of an enclave and resets its idle timer
+	ExtendEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.ExtendEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.ExtendEnclaveResponse], error)
+	// Creates a new enclave with the files artifacts, persistent directories and services of an existing one
+	CloneEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.CloneEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.CloneEnclaveResponse], error)
 	// Destroys an enclave, removing all artifacts associated with it
 	DestroyEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.DestroyEnclaveArgs]) (*connect.Response[emptypb.Empty], error)
 	// Gets rid of old enclaves
 	Clean(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.CleanArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.CleanResponse], error)
 	// Get service logs
 	GetServiceLogs(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.GetServiceLogsArgs]) (*connect.ServerStreamForClient[kurtosis_engine_rpc_api_bindings.GetServiceLogsResponse], error)
+	// Streams what happens to the enclaves from now on: enclaves created, stopped and destroyed, and the services,
+	// Starlark runs and files artifacts inside them
+	WatchEvents(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.WatchEventsArgs]) (*connect.ServerStreamForClient[kurtosis_engine_rpc_api_bindings.EngineEvent], error)
+	// ==============================================================================================
+	//
+	//	Scheduled Runs
+	//
+	// ==============================================================================================
+	// Makes the engine run a package every time a cron expression matches
+	AddSchedule(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.AddScheduleArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.AddScheduleResponse], error)
+	// Returns all the schedules along with the outcome of their latest runs
+	GetSchedules(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetSchedulesResponse], error)
+	// Stops a schedule from running again
+	RemoveSchedule(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.RemoveScheduleArgs]) (*connect.Response[emptypb.Empty], error)
 }
 
 // NewEngineServiceClient constructs a client for the engine_api.EngineService service. By default,
@@ -129,6 +170,21 @@ func NewEngineServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			baseURL+EngineServiceStopEnclaveProcedure,
 			opts...,
 		),
+		startEnclave: connect.NewClient[kurtosis_engine_rpc_api_bindings.StartEnclaveArgs, kurtosis_engine_rpc_api_bindings.StartEnclaveResponse](
+			httpClient,
+			baseURL+EngineServiceStartEnclaveProcedure,
+			opts...,
+		),
+		extendEnclave: connect.NewClient[kurtosis_engine_rpc_api_bindings.ExtendEnclaveArgs, kurtosis_engine_rpc_api_bindings.ExtendEnclaveResponse](
+			httpClient,
+			baseURL+EngineServiceExtendEnclaveProcedure,
+			opts...,
+		),
+		cloneEnclave: connect.NewClient[kurtosis_engine_rpc_api_bindings.CloneEnclaveArgs, kurtosis_engine_rpc_api_bindings.CloneEnclaveResponse](
+			httpClient,
+			baseURL+EngineServiceCloneEnclaveProcedure,
+			opts...,
+		),
 		destroyEnclave: connect.NewClient[kurtosis_engine_rpc_api_bindings.DestroyEnclaveArgs, emptypb.Empty](
 			httpClient,
 			baseURL+EngineServiceDestroyEnclaveProcedure,
@@ -144,6 +200,26 @@ func NewEngineServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			baseURL+EngineServiceGetServiceLogsProcedure,
 			opts...,
 		),
+		watchEvents: connect.NewClient[kurtosis_engine_rpc_api_bindings.WatchEventsArgs, kurtosis_engine_rpc_api_bindings.EngineEvent](
+			httpClient,
+			baseURL+EngineServiceWatchEventsProcedure,
+			opts...,
+		),
+		addSchedule: connect.NewClient[kurtosis_engine_rpc_api_bindings.AddScheduleArgs, kurtosis_engine_rpc_api_bindings.AddScheduleResponse](
+			httpClient,
+			baseURL+EngineServiceAddScheduleProcedure,
+			opts...,
+		),
+		getSchedules: connect.NewClient[emptypb.Empty, kurtosis_engine_rpc_api_bindings.GetSchedulesResponse](
+			httpClient,
+			baseURL+EngineServiceGetSchedulesProcedure,
+			opts...,
+		),
+		removeSchedule: connect.NewClient[kurtosis_engine_rpc_api_bindings.RemoveScheduleArgs, emptypb.Empty](
+			httpClient,
+			baseURL+EngineServiceRemoveScheduleProcedure,
+			opts...,
+		),
 	}
 }
 
@@ -155,9 +231,16 @@ type engineServiceClient struct {
 	getEnclavesByUuids                         *connect.Client[kurtosis_engine_rpc_api_bindings.GetEnclavesByUuidsArgs, kurtosis_engine_rpc_api_bindings.GetEnclavesResponse]
 	getExistingAndHistoricalEnclaveIdentifiers *connect.Client[emptypb.Empty, kurtosis_engine_rpc_api_bindings.GetExistingAndHistoricalEnclaveIdentifiersResponse]
 	stopEnclave                                *connect.Client[kurtosis_engine_rpc_api_bindings.StopEnclaveArgs, emptypb.Empty]
+	startEnclave                               *connect.Client[kurtosis_engine_rpc_api_bindings.StartEnclaveArgs, kurtosis_engine_rpc_api_bindings.StartEnclaveResponse]
+	extendEnclave                              *connect.Client[kurtosis_engine_rpc_api_bindings.ExtendEnclaveArgs, kurtosis_engine_rpc_api_bindings.ExtendEnclaveResponse]
+	cloneEnclave                               *connect.Client[kurtosis_engine_rpc_api_bindings.CloneEnclaveArgs, kurtosis_engine_rpc_api_bindings.CloneEnclaveResponse]
 	destroyEnclave                             *connect.Client[kurtosis_engine_rpc_api_bindings.DestroyEnclaveArgs, emptypb.Empty]
 	clean                                      *connect.Client[kurtosis_engine_rpc_api_bindings.CleanArgs, kurtosis_engine_rpc_api_bindings.CleanResponse]
 	getServiceLogs                             *connect.Client[kurtosis_engine_rpc_api_bindings.GetServiceLogsArgs, kurtosis_engine_rpc_api_bindings.GetServiceLogsResponse]
+	watchEvents                                *connect.Client[kurtosis_engine_rpc_api_bindings.WatchEventsArgs, kurtosis_engine_rpc_api_bindings.EngineEvent]
+	addSchedule                                *connect.Client[kurtosis_engine_rpc_api_bindings.AddScheduleArgs, kurtosis_engine_rpc_api_bindings.AddScheduleResponse]
+	getSchedules                               *connect.Client[emptypb.Empty, kurtosis_engine_rpc_api_bindings.GetSchedulesResponse]
+	removeSchedule                             *connect.Client[kurtosis_engine_rpc_api_bindings.RemoveScheduleArgs, emptypb.Empty]
 }
 
 // GetEngineInfo calls engine_api.EngineService.GetEngineInfo.
@@ -191,6 +274,21 @@ func (c *engineServiceClient) StopEnclave(ctx context.Context, req *connect.Requ
 	return c.stopEnclave.CallUnary(ctx, req)
 }
 
+// StartEnclave calls engine_api.EngineService.StartEnclave.
+func (c *engineServiceClient) StartEnclave(ctx context.Context, req *connect.Request[kurtosis_engine_rpc_api_bindings.StartEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.StartEnclaveResponse], error) {
+	return c.startEnclave.CallUnary(ctx, req)
+}
+
+// ExtendEnclave calls engine_api.EngineService.ExtendEnclave.
+func (c *engineServiceClient) ExtendEnclave(ctx context.Context, req *connect.Request[kurtosis_engine_rpc_api_bindings.ExtendEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.ExtendEnclaveResponse], error) {
+	return c.extendEnclave.CallUnary(ctx, req)
+}
+
+// CloneEnclave calls engine_api.EngineService.CloneEnclave.
+func (c *engineServiceClient) CloneEnclave(ctx context.Context, req *connect.Request[kurtosis_engine_rpc_api_bindings.CloneEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.CloneEnclaveResponse], error) {
+	return c.cloneEnclave.CallUnary(ctx, req)
+}
+
 // DestroyEnclave calls engine_api.EngineService.DestroyEnclave.
 func (c *engineServiceClient) DestroyEnclave(ctx context.Context, req *connect.Request[kurtosis_engine_rpc_api_bindings.DestroyEnclaveArgs]) (*connect.Response[emptypb.Empty], error) {
 	return c.destroyEnclave.CallUnary(ctx, req)
@@ -206,6 +304,26 @@ func (c *engineServiceClient) GetServiceLogs(ctx context.Context, req *connect.R
 	return c.getServiceLogs.CallServerStream(ctx, req)
 }
 
+// WatchEvents calls engine_api.EngineService.WatchEvents.
+func (c *engineServiceClient) WatchEvents(ctx context.Context, req *connect.Request[kurtosis_engine_rpc_api_bindings.WatchEventsArgs]) (*connect.ServerStreamForClient[kurtosis_engine_rpc_api_bindings.EngineEvent], error) {
+	return c.watchEvents.CallServerStream(ctx, req)
+}
+
+// AddSchedule calls engine_api.EngineService.AddSchedule.
+func (c *engineServiceClient) AddSchedule(ctx context.Context, req *connect.Request[kurtosis_engine_rpc_api_bindings.AddScheduleArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.AddScheduleResponse], error) {
+	return c.addSchedule.CallUnary(ctx, req)
+}
+
+// GetSchedules calls engine_api.EngineService.GetSchedules.
+func (c *engineServiceClient) GetSchedules(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetSchedulesResponse], error) {
+	return c.getSchedules.CallUnary(ctx, req)
+}
+
+// RemoveSchedule calls engine_api.EngineService.RemoveSchedule.
+func (c *engineServiceClient) RemoveSchedule(ctx context.Context, req *connect.Request[kurtosis_engine_rpc_api_bindings.RemoveScheduleArgs]) (*connect.Response[emptypb.Empty], error) {
+	return c.removeSchedule.CallUnary(ctx, req)
+}
+
 // EngineServiceHandler is an implementation of the engine_api.EngineService service.
 type EngineServiceHandler interface {
 	// Endpoint for getting information about the engine, which is also what we use to verify that the engine has become available
@@ -225,12 +343,32 @@ type EngineServiceHandler interface {
 	GetExistingAndHistoricalEnclaveIdentifiers(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetExistingAndHistoricalEnclaveIdentifiersResponse], error)
 	// Stops all containers in an enclave
 	StopEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.StopEnclaveArgs]) (*connect.Response[emptypb.Empty], error)
+	// Starts again the API container and logs collector of a stopped enclave
+	StartEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.StartEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.StartEnclaveResponse], error)
+	// Pushes back the expiration deadline of an enclave and resets its idle timer
+	ExtendEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.ExtendEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.ExtendEnclaveResponse], error)
+	// Creates a new enclave with the files artifacts, persistent directories and services of an existing one
+	CloneEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.CloneEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.CloneEnclaveResponse], error)
 	// Destroys an enclave, removing all artifacts associated with it
 	DestroyEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.DestroyEnclaveArgs]) (*connect.Response[emptypb.Empty], error)
 	// Gets rid of old enclaves
 	Clean(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.CleanArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.CleanResponse], error)
 	// Get service logs
 	GetServiceLogs(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.GetServiceLogsArgs], *connect.ServerStream[kurtosis_engine_rpc_api_bindings.GetServiceLogsResponse]) error
+	// Streams what happens to the enclaves from now on: enclaves created, stopped and destroyed, and the services,
+	// Starlark runs and files artifacts inside them
+	WatchEvents(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.WatchEventsArgs], *connect.ServerStream[kurtosis_engine_rpc_api_bindings.EngineEvent]) error
+	// ==============================================================================================
+	//
+	//	Scheduled Runs
+	//
+	// ==============================================================================================
+	// Makes the engine run a package every time a cron expression matches
+	AddSchedule(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.AddScheduleArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.AddScheduleResponse], error)
+	// Returns all the schedules along with the outcome of their latest runs
+	GetSchedules(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetSchedulesResponse], error)
+	// Stops a schedule from running again
+	RemoveSchedule(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.RemoveScheduleArgs]) (*connect.Response[emptypb.Empty], error)
 }
 
 // NewEngineServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -269,6 +407,21 @@ func NewEngineServiceHandler(svc EngineServiceHandler, opts ...connect.HandlerOp
 		svc.StopEnclave,
 		opts...,
 	)
+	engineServiceStartEnclaveHandler := connect.NewUnaryHandler(
+		EngineServiceStartEnclaveProcedure,
+		svc.StartEnclave,
+		opts...,
+	)
+	engineServiceExtendEnclaveHandler := connect.NewUnaryHandler(
+		EngineServiceExtendEnclaveProcedure,
+		svc.ExtendEnclave,
+		opts...,
+	)
+	engineServiceCloneEnclaveHandler := connect.NewUnaryHandler(
+		EngineServiceCloneEnclaveProcedure,
+		svc.CloneEnclave,
+		opts...,
+	)
 	engineServiceDestroyEnclaveHandler := connect.NewUnaryHandler(
 		EngineServiceDestroyEnclaveProcedure,
 		svc.DestroyEnclave,
@@ -284,6 +437,26 @@ func NewEngineServiceHandler(svc EngineServiceHandler, opts ...connect.HandlerOp
 		svc.GetServiceLogs,
 		opts...,
 	)
+	engineServiceWatchEventsHandler := connect.NewServerStreamHandler(
+		EngineServiceWatchEventsProcedure,
+		svc.WatchEvents,
+		opts...,
+	)
+	engineServiceAddScheduleHandler := connect.NewUnaryHandler(
+		EngineServiceAddScheduleProcedure,
+		svc.AddSchedule,
+		opts...,
+	)
+	engineServiceGetSchedulesHandler := connect.NewUnaryHandler(
+		EngineServiceGetSchedulesProcedure,
+		svc.GetSchedules,
+		opts...,
+	)
+	engineServiceRemoveScheduleHandler := connect.NewUnaryHandler(
+		EngineServiceRemoveScheduleProcedure,
+		svc.RemoveSchedule,
+		opts...,
+	)
 	return "/engine_api.EngineService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case EngineServiceGetEngineInfoProcedure:
@@ -298,12 +471,26 @@ func NewEngineServiceHandler(svc EngineServiceHandler, opts ...connect.HandlerOp
 			engineServiceGetExistingAndHistoricalEnclaveIdentifiersHandler.ServeHTTP(w, r)
 		case EngineServiceStopEnclaveProcedure:
 			engineServiceStopEnclaveHandler.ServeHTTP(w, r)
+		case EngineServiceStartEnclaveProcedure:
+			engineServiceStartEnclaveHandler.ServeHTTP(w, r)
+		case EngineServiceExtendEnclaveProcedure:
+			engineServiceExtendEnclaveHandler.ServeHTTP(w, r)
+		case EngineServiceCloneEnclaveProcedure:
+			engineServiceCloneEnclaveHandler.ServeHTTP(w, r)
 		case EngineServiceDestroyEnclaveProcedure:
 			engineServiceDestroyEnclaveHandler.ServeHTTP(w, r)
 		case EngineServiceCleanProcedure:
 			engineServiceCleanHandler.ServeHTTP(w, r)
 		case EngineServiceGetServiceLogsProcedure:
 			engineServiceGetServiceLogsHandler.ServeHTTP(w, r)
+		case EngineServiceWatchEventsProcedure:
+			engineServiceWatchEventsHandler.ServeHTTP(w, r)
+		case EngineServiceAddScheduleProcedure:
+			engineServiceAddScheduleHandler.ServeHTTP(w, r)
+		case EngineServiceGetSchedulesProcedure:
+			engineServiceGetSchedulesHandler.ServeHTTP(w, r)
+		case EngineServiceRemoveScheduleProcedure:
+			engineServiceRemoveScheduleHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -337,6 +524,18 @@ func (UnimplementedEngineServiceHandler) StopEnclave(context.Context, *connect.R
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.StopEnclave is not implemented"))
 }
 
+func (UnimplementedEngineServiceHandler) StartEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.StartEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.StartEnclaveResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.StartEnclave is not implemented"))
+}
+
+func (UnimplementedEngineServiceHandler) ExtendEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.ExtendEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.ExtendEnclaveResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.ExtendEnclave is not implemented"))
+}
+
+func (UnimplementedEngineServiceHandler) CloneEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.CloneEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.CloneEnclaveResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.CloneEnclave is not implemented"))
+}
+
 func (UnimplementedEngineServiceHandler) DestroyEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.DestroyEnclaveArgs]) (*connect.Response[emptypb.Empty], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.DestroyEnclave is not implemented"))
 }
@@ -348,3 +547,19 @@ func (UnimplementedEngineServiceHandler) Clean(context.Context, *connect.Request
 func (UnimplementedEngineServiceHandler) GetServiceLogs(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.GetServiceLogsArgs], *connect.ServerStream[kurtosis_engine_rpc_api_bindings.GetServiceLogsResponse]) error {
 	return connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.GetServiceLogs is not implemented"))
 }
+
+func (UnimplementedEngineServiceHandler) WatchEvents(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.WatchEventsArgs], *connect.ServerStream[kurtosis_engine_rpc_api_bindings.EngineEvent]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.WatchEvents is not implemented"))
+}
+
+func (UnimplementedEngineServiceHandler) AddSchedule(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.AddScheduleArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.AddScheduleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.AddSchedule is not implemented"))
+}
+
+func (UnimplementedEngineServiceHandler) GetSchedules(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetSchedulesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.GetSchedules is not implemented"))
+}
+
+func (UnimplementedEngineServiceHandler) RemoveSchedule(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.RemoveScheduleArgs]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.RemoveSchedule is not implemented"))
+}
@@ -0,0 +1,12 @@
+package kurtosis_context
+
+import (
+	"github.com/kurtosis-tech/kurtosis/api/golang/util/api_token_credentials"
+	"google.golang.org/grpc/credentials"
+)
+
+// NewEngineApiTokenCredentials returns gRPC call credentials that authenticate calls to an engine that requires API
+// tokens. The API containers of the engine's enclaves accept the same tokens
+func NewEngineApiTokenCredentials(apiToken string) credentials.PerRPCCredentials {
+	return api_token_credentials.NewApiTokenCredentials(apiToken)
+}
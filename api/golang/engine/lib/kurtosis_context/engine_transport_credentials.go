@@ -0,0 +1,27 @@
+package kurtosis_context
+
+import (
+	"github.com/kurtosis-tech/kurtosis/api/golang/util/tls_credentials"
+	"github.com/kurtosis-tech/kurtosis/contexts-config-store/api/golang/generated"
+	"github.com/kurtosis-tech/stacktrace"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// NewEngineTransportCredentials returns the gRPC transport credentials to reach the engine and the API containers of
+// a Kurtosis context with. With the context's engine TLS config, the servers are verified against its CA and its
+// client certificate, if any, is presented to them; without one, they're reached in plaintext
+func NewEngineTransportCredentials(engineTlsConfig *generated.TlsConfig) (credentials.TransportCredentials, error) {
+	if engineTlsConfig == nil {
+		return insecure.NewCredentials(), nil
+	}
+	transportCredentials, err := tls_credentials.NewClientTransportCredentials(
+		engineTlsConfig.GetCertificateAuthority(),
+		engineTlsConfig.GetClientCertificate(),
+		engineTlsConfig.GetClientKey(),
+	)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating TLS credentials from the engine TLS config")
+	}
+	return transportCredentials, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/Masterminds/semver/v3"
 	portal_constructors "github.com/kurtosis-tech/kurtosis-portal/api/golang/constructors"
@@ -13,13 +14,15 @@ import (
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/kurtosis_version"
+	"github.com/kurtosis-tech/kurtosis/contexts-config-store/api/golang/generated"
 	"github.com/kurtosis-tech/kurtosis/contexts-config-store/store"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -34,6 +37,8 @@ const (
 
 	serviceLogsStreamContentChanBufferSize = 5
 
+	engineEventsChanBufferSize = 5
+
 	grpcStreamCancelContextErrorMessage = "rpc error: code = Canceled desc = context canceled"
 
 	validUuidMatchesAllowed = 1
@@ -64,16 +69,67 @@ var (
 type KurtosisContext struct {
 	engineClient kurtosis_engine_rpc_api_bindings.EngineServiceClient
 	portalClient portal_api.KurtosisPortalClientClient
+	// Used to reach both the engine and the API containers
+	transportCredentials credentials.TransportCredentials
+	// Sent to both the engine and the API containers; empty if calls aren't authenticated
+	engineApiToken string
 }
 
 // NewKurtosisContextFromLocalEngine
 // Attempts to create a KurtosisContext connected to a Kurtosis engine running locally
 func NewKurtosisContextFromLocalEngine() (*KurtosisContext, error) {
-	ctx := context.Background()
 	kurtosisEngineSocketStr := fmt.Sprintf("%v:%v", localHostIPAddressStr, DefaultGrpcEngineServerPortNum)
+	kurtosisContext, err := NewKurtosisContextFromEngineAddress(kurtosisEngineSocketStr)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating a Kurtosis context connected to the local engine")
+	}
+	return kurtosisContext, nil
+}
 
-	// TODO SECURITY: Use HTTPS to ensure we're connecting to the real Kurtosis API servers
-	conn, err := grpc.NewClient(kurtosisEngineSocketStr, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(hundredMegabytes)))
+// NewKurtosisContextFromEngineAddress
+// Attempts to create a KurtosisContext connected to the Kurtosis engine (or engine gateway) listening on the given
+// 'host:port' address. If the current Kurtosis context has an engine API token, it's sent with every call
+func NewKurtosisContextFromEngineAddress(kurtosisEngineSocketStr string) (*KurtosisContext, error) {
+	currentContext, err := getCurrentContextIfStored()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the current Kurtosis context to get the engine API token from")
+	}
+	return NewKurtosisContextFromEngineAddressWithApiToken(kurtosisEngineSocketStr, currentContext.GetEngineApiToken())
+}
+
+// NewKurtosisContextFromEngineAddressWithApiToken
+// Same as NewKurtosisContextFromEngineAddress, but authenticates to the engine with the given API token instead of the
+// one of the current Kurtosis context. An empty token means calls aren't authenticated
+func NewKurtosisContextFromEngineAddressWithApiToken(kurtosisEngineSocketStr string, engineApiToken string) (*KurtosisContext, error) {
+	currentContext, err := getCurrentContextIfStored()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the current Kurtosis context to get the engine TLS config from")
+	}
+	transportCredentials, err := NewEngineTransportCredentials(currentContext.GetEngineTlsConfig())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the credentials to reach the engine of the current context with")
+	}
+	return NewKurtosisContextFromEngineAddressWithCredentials(kurtosisEngineSocketStr, engineApiToken, transportCredentials)
+}
+
+// NewKurtosisContextFromEngineAddressWithCredentials
+// Same as NewKurtosisContextFromEngineAddressWithApiToken, but reaches the engine and its API containers with the given
+// transport credentials instead of those derived from the engine TLS config of the current Kurtosis context
+func NewKurtosisContextFromEngineAddressWithCredentials(
+	kurtosisEngineSocketStr string,
+	engineApiToken string,
+	transportCredentials credentials.TransportCredentials,
+) (*KurtosisContext, error) {
+	ctx := context.Background()
+
+	dialOpts := []grpc.DialOption{
+		grpc.WithTransportCredentials(transportCredentials),
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(hundredMegabytes)),
+	}
+	if engineApiToken != "" {
+		dialOpts = append(dialOpts, grpc.WithPerRPCCredentials(NewEngineApiTokenCredentials(engineApiToken)))
+	}
+	conn, err := grpc.NewClient(kurtosisEngineSocketStr, dialOpts...)
 	if err != nil {
 		return nil, stacktrace.Propagate(
 			err,
@@ -88,21 +144,22 @@ func NewKurtosisContextFromLocalEngine() (*KurtosisContext, error) {
 	}
 
 	var portalClient portal_api.KurtosisPortalClientClient
-	currentContext, err := store.GetContextsConfigStore().GetCurrentContext()
-	if err == nil {
-		if store.IsRemote(currentContext) {
-			portalClient, err = CreatePortalDaemonClient(portalIsRequired)
-			if err != nil {
-				return nil, stacktrace.Propagate(err, "Error building client for Kurtosis Portal daemon")
-			}
+	currentContext, err := getCurrentContextIfStored()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the current Kurtosis context")
+	}
+	if currentContext != nil && store.IsRemote(currentContext) {
+		portalClient, err = CreatePortalDaemonClient(portalIsRequired)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Error building client for Kurtosis Portal daemon")
 		}
-	} else {
-		logrus.Warnf("Unable to retrieve current Kurtosis context. This is not critical, it will assume using Kurtosis default context for now.")
 	}
 
 	kurtosisContext := &KurtosisContext{
-		engineClient: engineServiceClient,
-		portalClient: portalClient,
+		engineClient:         engineServiceClient,
+		portalClient:         portalClient,
+		transportCredentials: transportCredentials,
+		engineApiToken:       engineApiToken,
 	}
 
 	return kurtosisContext, nil
@@ -117,7 +174,7 @@ func (kurtosisCtx *KurtosisContext) CreateEnclave(ctx context.Context, enclaveNa
 		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave with name '%v'", enclaveName)
 	}
 
-	enclaveContext, err := newEnclaveContextFromEnclaveInfo(ctx, kurtosisCtx.portalClient, response.EnclaveInfo)
+	enclaveContext, err := newEnclaveContextFromEnclaveInfo(ctx, kurtosisCtx.portalClient, kurtosisCtx.transportCredentials, kurtosisCtx.engineApiToken, response.EnclaveInfo)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave context from a newly-created enclave; this should never happen")
 	}
@@ -137,7 +194,7 @@ func (kurtosisCtx *KurtosisContext) CreateEnclaveWithDebugEnabled(
 		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave with name '%v'", enclaveName)
 	}
 
-	enclaveContext, err := newEnclaveContextFromEnclaveInfo(ctx, kurtosisCtx.portalClient, response.EnclaveInfo)
+	enclaveContext, err := newEnclaveContextFromEnclaveInfo(ctx, kurtosisCtx.portalClient, kurtosisCtx.transportCredentials, kurtosisCtx.engineApiToken, response.EnclaveInfo)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave context from a newly-created enclave; this should never happen")
 	}
@@ -154,7 +211,7 @@ func (kurtosisCtx *KurtosisContext) CreateProductionEnclave(ctx context.Context,
 		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave with name '%v'", enclaveName)
 	}
 
-	enclaveContext, err := newEnclaveContextFromEnclaveInfo(ctx, kurtosisCtx.portalClient, response.EnclaveInfo)
+	enclaveContext, err := newEnclaveContextFromEnclaveInfo(ctx, kurtosisCtx.portalClient, kurtosisCtx.transportCredentials, kurtosisCtx.engineApiToken, response.EnclaveInfo)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave context from a newly-created enclave; this should never happen")
 	}
@@ -171,7 +228,66 @@ func (kurtosisCtx *KurtosisContext) CreateProductionEnclaveWithDebugEnabled(ctx
 		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave with name '%v'", enclaveName)
 	}
 
-	enclaveContext, err := newEnclaveContextFromEnclaveInfo(ctx, kurtosisCtx.portalClient, response.EnclaveInfo)
+	enclaveContext, err := newEnclaveContextFromEnclaveInfo(ctx, kurtosisCtx.portalClient, kurtosisCtx.transportCredentials, kurtosisCtx.engineApiToken, response.EnclaveInfo)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave context from a newly-created enclave; this should never happen")
+	}
+
+	return enclaveContext, nil
+}
+
+// CreateEnclaveWithLifetime creates an enclave that the engine destroys once the TTL elapses, and stops once it goes
+// unused for longer than the idle timeout. A zero TTL or idle timeout disables that limit
+func (kurtosisCtx *KurtosisContext) CreateEnclaveWithLifetime(
+	ctx context.Context,
+	enclaveName string,
+	isProduction bool,
+	ttl time.Duration,
+	idleTimeout time.Duration,
+) (*enclaves.EnclaveContext, error) {
+
+	createEnclaveArgs := newCreateEnclaveArgsWithDefaultValues(enclaveName)
+	if isProduction {
+		createEnclaveArgs = newCreateProductionEnclaveWithDefaultValues(enclaveName)
+	}
+	if ttl > 0 {
+		createEnclaveArgs.Ttl = durationpb.New(ttl)
+	}
+	if idleTimeout > 0 {
+		createEnclaveArgs.IdleTimeout = durationpb.New(idleTimeout)
+	}
+
+	response, err := kurtosisCtx.engineClient.CreateEnclave(ctx, createEnclaveArgs)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave with name '%v'", enclaveName)
+	}
+
+	enclaveContext, err := newEnclaveContextFromEnclaveInfo(ctx, kurtosisCtx.portalClient, kurtosisCtx.transportCredentials, kurtosisCtx.engineApiToken, response.EnclaveInfo)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave context from a newly-created enclave; this should never happen")
+	}
+
+	return enclaveContext, nil
+}
+
+// CreateEnclaveFromTemplate creates an enclave with the package of one of the enclave templates the engine was started
+// with already running in it. It returns right away if the engine has an idle enclave ready for the template, otherwise
+// it waits for the package to run
+func (kurtosisCtx *KurtosisContext) CreateEnclaveFromTemplate(
+	ctx context.Context,
+	enclaveName string,
+	templateName string,
+) (*enclaves.EnclaveContext, error) {
+
+	createEnclaveArgs := newCreateEnclaveArgsWithDefaultValues(enclaveName)
+	createEnclaveArgs.TemplateName = &templateName
+
+	response, err := kurtosisCtx.engineClient.CreateEnclave(ctx, createEnclaveArgs)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave with name '%v' from template '%v'", enclaveName, templateName)
+	}
+
+	enclaveContext, err := newEnclaveContextFromEnclaveInfo(ctx, kurtosisCtx.portalClient, kurtosisCtx.transportCredentials, kurtosisCtx.engineApiToken, response.EnclaveInfo)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave context from a newly-created enclave; this should never happen")
 	}
@@ -185,7 +301,7 @@ func (kurtosisCtx *KurtosisContext) GetEnclaveContext(ctx context.Context, encla
 		return nil, stacktrace.Propagate(err, "An error occurred while getting enclave with identifier '%v'", enclaveIdentifier)
 	}
 
-	enclaveCtx, err := newEnclaveContextFromEnclaveInfo(ctx, kurtosisCtx.portalClient, enclaveInfo)
+	enclaveCtx, err := newEnclaveContextFromEnclaveInfo(ctx, kurtosisCtx.portalClient, kurtosisCtx.transportCredentials, kurtosisCtx.engineApiToken, enclaveInfo)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave context from the returned enclave info")
 	}
@@ -194,7 +310,7 @@ func (kurtosisCtx *KurtosisContext) GetEnclaveContext(ctx context.Context, encla
 }
 
 func (kurtosisCtx *KurtosisContext) GetEnclaveContextFromEnclaveInfo(ctx context.Context, enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo) (*enclaves.EnclaveContext, error) {
-	enclaveCtx, err := newEnclaveContextFromEnclaveInfo(ctx, kurtosisCtx.portalClient, enclaveInfo)
+	enclaveCtx, err := newEnclaveContextFromEnclaveInfo(ctx, kurtosisCtx.portalClient, kurtosisCtx.transportCredentials, kurtosisCtx.engineApiToken, enclaveInfo)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave context from the provided enclave info")
 	}
@@ -286,6 +402,66 @@ func (kurtosisCtx *KurtosisContext) StopEnclave(ctx context.Context, enclaveIden
 	return nil
 }
 
+// StartEnclave brings a stopped enclave back and starts again the services that were running when it was stopped, in
+// dependency order, waiting for each one to satisfy its ready conditions
+func (kurtosisCtx *KurtosisContext) StartEnclave(ctx context.Context, enclaveIdentifier string) (*enclaves.EnclaveContext, error) {
+	startEnclaveArgs := &kurtosis_engine_rpc_api_bindings.StartEnclaveArgs{
+		EnclaveIdentifier: enclaveIdentifier,
+	}
+
+	response, err := kurtosisCtx.engineClient.StartEnclave(ctx, startEnclaveArgs)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred starting enclave with identifier '%v'", enclaveIdentifier)
+	}
+
+	enclaveContext, err := newEnclaveContextFromEnclaveInfo(ctx, kurtosisCtx.portalClient, kurtosisCtx.transportCredentials, kurtosisCtx.engineApiToken, response.EnclaveInfo)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave context from started enclave '%v'", enclaveIdentifier)
+	}
+
+	if _, err := enclaveContext.ResumeServices(ctx); err != nil {
+		return nil, stacktrace.Propagate(err, "Enclave '%v' was started but an error occurred starting its services again", enclaveIdentifier)
+	}
+
+	return enclaveContext, nil
+}
+
+// ExtendEnclave pushes the expiration deadline of an enclave back by the given duration and resets its idle timer
+func (kurtosisCtx *KurtosisContext) ExtendEnclave(ctx context.Context, enclaveIdentifier string, duration time.Duration) (*kurtosis_engine_rpc_api_bindings.EnclaveInfo, error) {
+	extendEnclaveArgs := &kurtosis_engine_rpc_api_bindings.ExtendEnclaveArgs{
+		EnclaveIdentifier: enclaveIdentifier,
+		Duration:          durationpb.New(duration),
+	}
+
+	response, err := kurtosisCtx.engineClient.ExtendEnclave(ctx, extendEnclaveArgs)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred extending enclave with identifier '%v'", enclaveIdentifier)
+	}
+
+	return response.GetEnclaveInfo(), nil
+}
+
+// CloneEnclave creates a new enclave with the files artifacts, persistent directories and services of an existing one,
+// and returns a context for it. The services keep their names but get new UUIDs and IP addresses
+func (kurtosisCtx *KurtosisContext) CloneEnclave(ctx context.Context, sourceEnclaveIdentifier string, destinationEnclaveName string) (*enclaves.EnclaveContext, error) {
+	cloneEnclaveArgs := &kurtosis_engine_rpc_api_bindings.CloneEnclaveArgs{
+		SourceEnclaveIdentifier: sourceEnclaveIdentifier,
+		DestinationEnclaveName:  destinationEnclaveName,
+	}
+
+	response, err := kurtosisCtx.engineClient.CloneEnclave(ctx, cloneEnclaveArgs)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred cloning enclave with identifier '%v' into enclave '%v'", sourceEnclaveIdentifier, destinationEnclaveName)
+	}
+
+	enclaveContext, err := newEnclaveContextFromEnclaveInfo(ctx, kurtosisCtx.portalClient, kurtosisCtx.transportCredentials, kurtosisCtx.engineApiToken, response.GetEnclaveInfo())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave context from the info of cloned enclave '%v'", destinationEnclaveName)
+	}
+
+	return enclaveContext, nil
+}
+
 func (kurtosisCtx *KurtosisContext) DestroyEnclave(ctx context.Context, enclaveIdentifier string) error {
 	destroyEnclaveArgs := &kurtosis_engine_rpc_api_bindings.DestroyEnclaveArgs{
 		EnclaveIdentifier: enclaveIdentifier,
@@ -310,6 +486,33 @@ func (kurtosisCtx *KurtosisContext) Clean(ctx context.Context, shouldCleanAll bo
 	return cleanResponse.RemovedEnclaveNameAndUuids, nil
 }
 
+// AddSchedule makes the engine run a package every time the cron expression of the args matches
+func (kurtosisCtx *KurtosisContext) AddSchedule(ctx context.Context, addScheduleArgs *kurtosis_engine_rpc_api_bindings.AddScheduleArgs) (*kurtosis_engine_rpc_api_bindings.Schedule, error) {
+	response, err := kurtosisCtx.engineClient.AddSchedule(ctx, addScheduleArgs)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred adding a schedule with cron expression '%v' for package '%v'", addScheduleArgs.GetCronExpression(), addScheduleArgs.GetPackageId())
+	}
+	return response.GetSchedule(), nil
+}
+
+func (kurtosisCtx *KurtosisContext) GetSchedules(ctx context.Context) ([]*kurtosis_engine_rpc_api_bindings.Schedule, error) {
+	response, err := kurtosisCtx.engineClient.GetSchedules(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the schedules")
+	}
+	return response.GetSchedules(), nil
+}
+
+func (kurtosisCtx *KurtosisContext) RemoveSchedule(ctx context.Context, scheduleIdentifier string) error {
+	removeScheduleArgs := &kurtosis_engine_rpc_api_bindings.RemoveScheduleArgs{
+		ScheduleIdentifier: scheduleIdentifier,
+	}
+	if _, err := kurtosisCtx.engineClient.RemoveSchedule(ctx, removeScheduleArgs); err != nil {
+		return stacktrace.Propagate(err, "An error occurred removing schedule '%v'", scheduleIdentifier)
+	}
+	return nil
+}
+
 func (kurtosisCtx *KurtosisContext) GetServiceLogs(
 	ctx context.Context,
 	enclaveIdentifier string,
@@ -366,6 +569,46 @@ func (kurtosisCtx *KurtosisContext) GetServiceLogs(
 	return serviceLogsStreamContentChan, cancelCtxFunc, nil
 }
 
+// WatchEvents streams the events of the engine and its enclaves as they happen, until the returned function is called.
+// If the enclave identifier is empty, the events of every enclave are streamed; if no event types are given, events of
+// every type are streamed
+func (kurtosisCtx *KurtosisContext) WatchEvents(
+	ctx context.Context,
+	enclaveIdentifier string,
+	eventTypes []kurtosis_engine_rpc_api_bindings.EngineEventType,
+) (
+	chan *kurtosis_engine_rpc_api_bindings.EngineEvent,
+	func(),
+	error,
+) {
+	ctxWithCancel, cancelCtxFunc := context.WithCancel(ctx)
+	shouldCancelCtx := true
+	defer func() {
+		if shouldCancelCtx {
+			cancelCtxFunc()
+		}
+	}()
+
+	watchEventsArgs := &kurtosis_engine_rpc_api_bindings.WatchEventsArgs{
+		EnclaveIdentifier: nil,
+		EventTypes:        eventTypes,
+	}
+	if enclaveIdentifier != "" {
+		watchEventsArgs.EnclaveIdentifier = &enclaveIdentifier
+	}
+	stream, err := kurtosisCtx.engineClient.WatchEvents(ctxWithCancel, watchEventsArgs)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred watching the engine events using args '%+v'", watchEventsArgs)
+	}
+
+	engineEventsChan := make(chan *kurtosis_engine_rpc_api_bindings.EngineEvent, engineEventsChanBufferSize)
+	go runReceiveEngineEventsFromTheServerRoutine(cancelCtxFunc, engineEventsChan, stream)
+
+	//This is an async operation, so we don't want to cancel the context if the connection is established and data is flowing
+	shouldCancelCtx = false
+	return engineEventsChan, cancelCtxFunc, nil
+}
+
 func (kurtosisCtx *KurtosisContext) GetExistingAndHistoricalEnclaveIdentifiers(ctx context.Context) (*EnclaveIdentifiers, error) {
 	historicalEnclaveIdentifiers, err := kurtosisCtx.engineClient.GetExistingAndHistoricalEnclaveIdentifiers(ctx, &emptypb.Empty{})
 	if err != nil {
@@ -420,9 +663,39 @@ func runReceiveStreamLogsFromTheServerRoutine(
 	}
 }
 
+func runReceiveEngineEventsFromTheServerRoutine(
+	cancelCtxFunc context.CancelFunc,
+	engineEventsChan chan *kurtosis_engine_rpc_api_bindings.EngineEvent,
+	stream kurtosis_engine_rpc_api_bindings.EngineService_WatchEventsClient,
+) {
+	defer func() {
+		cancelCtxFunc()
+		close(engineEventsChan)
+	}()
+
+	for {
+		engineEvent, errReceivingStream := stream.Recv()
+		if errReceivingStream == io.EOF {
+			logrus.Debug("Received an 'EOF' error from the engine events GRPC stream")
+			return
+		}
+		if errReceivingStream != nil {
+			if errReceivingStream.Error() == grpcStreamCancelContextErrorMessage {
+				logrus.Debug("Received a 'context canceled' error from the engine events GRPC stream")
+				return
+			}
+			logrus.Errorf("An error occurred receiving the engine events stream. Error:\n%v", errReceivingStream)
+			return
+		}
+		engineEventsChan <- engineEvent
+	}
+}
+
 func newEnclaveContextFromEnclaveInfo(
 	ctx context.Context,
 	portalClient portal_api.KurtosisPortalClientClient,
+	transportCredentials credentials.TransportCredentials,
+	engineApiToken string,
 	enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo,
 ) (*enclaves.EnclaveContext, error) {
 	// for remote contexts, we need to tunnel the APIC port to the local machine
@@ -470,8 +743,16 @@ func newEnclaveContextFromEnclaveInfo(
 		apiContainerHostMachineInfo.IpOnHostMachine,
 		apiContainerHostMachineInfo.GrpcPortOnHostMachine,
 	)
-	// TODO SECURITY: use HTTPS!
-	apiContainerConn, err := grpc.NewClient(apiContainerHostMachineUrl, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(hundredMegabytes)))
+	// The API containers serve the engine's certificate and accept the engine's API tokens, so the engine credentials
+	// are good for them too
+	dialOpts := []grpc.DialOption{
+		grpc.WithTransportCredentials(transportCredentials),
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(hundredMegabytes)),
+	}
+	if engineApiToken != "" {
+		dialOpts = append(dialOpts, grpc.WithPerRPCCredentials(NewEngineApiTokenCredentials(engineApiToken)))
+	}
+	apiContainerConn, err := grpc.NewClient(apiContainerHostMachineUrl, dialOpts...)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred connecting to the API container on host machine URL '%v'", apiContainerHostMachineUrl)
 	}
@@ -486,6 +767,24 @@ func newEnclaveContextFromEnclaveInfo(
 	return result, nil
 }
 
+// getCurrentContextIfStored returns the current Kurtosis context, or nil if no contexts were ever stored on this machine
+// in which case the engine is reached the default way. Any other error is returned, as falling back on the default
+// would silently drop the engine TLS config and API token of the current context
+func getCurrentContextIfStored() (*generated.KurtosisContext, error) {
+	contextsConfigStoreExists, err := store.ContextsConfigStoreExists()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred checking if Kurtosis contexts were ever stored")
+	}
+	if !contextsConfigStoreExists {
+		return nil, nil
+	}
+	currentContext, err := store.GetContextsConfigStore().GetCurrentContext()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the current Kurtosis context from the contexts store")
+	}
+	return currentContext, nil
+}
+
 func validateEngineApiVersion(ctx context.Context, engineServiceClient kurtosis_engine_rpc_api_bindings.EngineServiceClient) error {
 	getEngineInfoResponse, err := engineServiceClient.GetEngineInfo(ctx, &emptypb.Empty{})
 	if err != nil {
@@ -55,6 +55,20 @@ const (
 	STOP EnclaveTargetStatus = "STOP"
 )
 
+// Defines values for EngineEventType.
+const (
+	ENCLAVECREATED       EngineEventType = "ENCLAVE_CREATED"
+	ENCLAVEDESTROYED     EngineEventType = "ENCLAVE_DESTROYED"
+	ENCLAVESTOPPED       EngineEventType = "ENCLAVE_STOPPED"
+	FILESARTIFACTCREATED EngineEventType = "FILES_ARTIFACT_CREATED"
+	SERVICEADDED         EngineEventType = "SERVICE_ADDED"
+	SERVICECRASHED       EngineEventType = "SERVICE_CRASHED"
+	SERVICESTARTED       EngineEventType = "SERVICE_STARTED"
+	SERVICESTOPPED       EngineEventType = "SERVICE_STOPPED"
+	STARLARKRUNFINISHED  EngineEventType = "STARLARK_RUN_FINISHED"
+	STARLARKRUNSTARTED   EngineEventType = "STARLARK_RUN_STARTED"
+)
+
 // Defines values for HttpMethodAvailability.
 const (
 	GET  HttpMethodAvailability = "GET"
@@ -207,6 +221,22 @@ type EnclaveStatus string
 // EnclaveTargetStatus defines model for EnclaveTargetStatus.
 type EnclaveTargetStatus string
 
+// EngineEvent Something that happened to an enclave. Only the fields relevant to the event type are set
+type EngineEvent struct {
+	EnclaveName       string          `json:"enclave_name"`
+	EnclaveUuid       string          `json:"enclave_uuid"`
+	FilesArtifactName *string         `json:"files_artifact_name,omitempty"`
+	FilesArtifactUuid *string         `json:"files_artifact_uuid,omitempty"`
+	IsRunSuccessful   *bool           `json:"is_run_successful,omitempty"`
+	ServiceName       *string         `json:"service_name,omitempty"`
+	ServiceUuid       *string         `json:"service_uuid,omitempty"`
+	Timestamp         Timestamp       `json:"timestamp"`
+	Type              EngineEventType `json:"type"`
+}
+
+// EngineEventType defines model for EngineEventType.
+type EngineEventType string
+
 // EngineInfo defines model for EngineInfo.
 type EngineInfo struct {
 	EngineVersion string `json:"engine_version"`
@@ -738,6 +768,15 @@ type PostEnclavesEnclaveIdentifierStarlarkScriptsParams struct {
 	RetrieveLogsAsync *RetrieveLogsAsync `form:"retrieve_logs_async,omitempty" json:"retrieve_logs_async,omitempty"`
 }
 
+// GetEventsParams defines parameters for GetEvents.
+type GetEventsParams struct {
+	// EnclaveIdentifier If set, only the events of this enclave are streamed
+	EnclaveIdentifier *string `form:"enclave_identifier,omitempty" json:"enclave_identifier,omitempty"`
+
+	// EventTypes If set, only the events of these types are streamed
+	EventTypes *[]EngineEventType `form:"event_types,omitempty" json:"event_types,omitempty"`
+}
+
 // PostEnclavesJSONRequestBody defines body for PostEnclaves for application/json ContentType.
 type PostEnclavesJSONRequestBody = CreateEnclave
 
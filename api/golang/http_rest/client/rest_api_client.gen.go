@@ -187,6 +187,9 @@ type ClientInterface interface {
 	// GetEngineInfo request
 	GetEngineInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetEvents request
+	GetEvents(ctx context.Context, params *GetEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetStarlarkExecutionsStarlarkExecutionUuidLogs request
 	GetStarlarkExecutionsStarlarkExecutionUuidLogs(ctx context.Context, starlarkExecutionUuid StarlarkExecutionUuid, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
@@ -611,6 +614,18 @@ func (c *Client) GetEngineInfo(ctx context.Context, reqEditors ...RequestEditorF
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetEvents(ctx context.Context, params *GetEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetEventsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetStarlarkExecutionsStarlarkExecutionUuidLogs(ctx context.Context, starlarkExecutionUuid StarlarkExecutionUuid, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetStarlarkExecutionsStarlarkExecutionUuidLogsRequest(c.Server, starlarkExecutionUuid)
 	if err != nil {
@@ -2035,6 +2050,71 @@ func NewGetEngineInfoRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
+// NewGetEventsRequest generates requests for GetEvents
+func NewGetEventsRequest(server string, params *GetEventsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/events")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.EnclaveIdentifier != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "enclave_identifier", runtime.ParamLocationQuery, *params.EnclaveIdentifier); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.EventTypes != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "event_types", runtime.ParamLocationQuery, *params.EventTypes); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewGetStarlarkExecutionsStarlarkExecutionUuidLogsRequest generates requests for GetStarlarkExecutionsStarlarkExecutionUuidLogs
 func NewGetStarlarkExecutionsStarlarkExecutionUuidLogsRequest(server string, starlarkExecutionUuid StarlarkExecutionUuid) (*http.Request, error) {
 	var err error
@@ -2209,6 +2289,9 @@ type ClientWithResponsesInterface interface {
 	// GetEngineInfoWithResponse request
 	GetEngineInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetEngineInfoResponse, error)
 
+	// GetEventsWithResponse request
+	GetEventsWithResponse(ctx context.Context, params *GetEventsParams, reqEditors ...RequestEditorFn) (*GetEventsResponse, error)
+
 	// GetStarlarkExecutionsStarlarkExecutionUuidLogsWithResponse request
 	GetStarlarkExecutionsStarlarkExecutionUuidLogsWithResponse(ctx context.Context, starlarkExecutionUuid StarlarkExecutionUuid, reqEditors ...RequestEditorFn) (*GetStarlarkExecutionsStarlarkExecutionUuidLogsResponse, error)
 }
@@ -2828,6 +2911,29 @@ func (r GetEngineInfoResponse) StatusCode() int {
 	return 0
 }
 
+type GetEventsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EngineEvent
+	JSONDefault  *NotOk
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type GetStarlarkExecutionsStarlarkExecutionUuidLogsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -3158,6 +3264,15 @@ func (c *ClientWithResponses) GetEngineInfoWithResponse(ctx context.Context, req
 	return ParseGetEngineInfoResponse(rsp)
 }
 
+// GetEventsWithResponse request returning *GetEventsResponse
+func (c *ClientWithResponses) GetEventsWithResponse(ctx context.Context, params *GetEventsParams, reqEditors ...RequestEditorFn) (*GetEventsResponse, error) {
+	rsp, err := c.GetEvents(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEventsResponse(rsp)
+}
+
 // GetStarlarkExecutionsStarlarkExecutionUuidLogsWithResponse request returning *GetStarlarkExecutionsStarlarkExecutionUuidLogsResponse
 func (c *ClientWithResponses) GetStarlarkExecutionsStarlarkExecutionUuidLogsWithResponse(ctx context.Context, starlarkExecutionUuid StarlarkExecutionUuid, reqEditors ...RequestEditorFn) (*GetStarlarkExecutionsStarlarkExecutionUuidLogsResponse, error) {
 	rsp, err := c.GetStarlarkExecutionsStarlarkExecutionUuidLogs(ctx, starlarkExecutionUuid, reqEditors...)
@@ -4016,6 +4131,39 @@ func ParseGetEngineInfoResponse(rsp *http.Response) (*GetEngineInfoResponse, err
 	return response, nil
 }
 
+// ParseGetEventsResponse parses an HTTP response from a GetEventsWithResponse call
+func ParseGetEventsResponse(rsp *http.Response) (*GetEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EngineEvent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest NotOk
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseGetStarlarkExecutionsStarlarkExecutionUuidLogsResponse parses an HTTP response from a GetStarlarkExecutionsStarlarkExecutionUuidLogsWithResponse call
 func ParseGetStarlarkExecutionsStarlarkExecutionUuidLogsResponse(rsp *http.Response) (*GetStarlarkExecutionsStarlarkExecutionUuidLogsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -1545,87 +1545,86 @@ func (sh *strictHandler) PostEnclavesEnclaveIdentifierStarlarkScripts(ctx echo.C
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
-
-	"H4sIAAAAAAAC/+w9a3PbuLV/BcN7Z7LboaV022lv/c1xnETTrK3xo7531hkGIo8k1BDAAqAcbcb//Q5e",
-	"fIggRfmVdLv90GxEPA7O+xwcnHyNUr7KOQOmZHT4NcqxwCtQIMzfsFBkjlOVkAyYInMCQv+cgUwFyRXh",
-	"LDqMLpeA/EDE8AoQF6goSBbFEdEDcqyWURzpT9FhcM04EvCvggjIokMlCogjmS5hhfVmapPraVIJwhbR",
-	"/X0cAUspXkMvUFdXk7cxkksuFDDIkP07Fw7AOVJLQG6hMJyBXfYE80sOqYIsESBzziS0oZx4OLKcE6aQ",
-	"AFUIJpFaEonWmBYQmwESxJqkgO4IpWgGaIXFLWQIS4TXmFA8o4B+gNFihD4ApRxdc0GzH0f+YP8qQGxq",
-	"J2sB1n+QpVJ5sgK15FmY+h8uL6fIDkCFhAwpjtIlpLcePEKJ2ozQW5jjgipEJHp/ctkFXn27OmD/LWAe",
-	"HUb/Na44dmy/yvEHpfKfzZSj2o4GesKIIpgmGVC8SVaEUiIh5SyT4cOwYjUDoVmkPlYf6Q4ThQqmCEXw",
-	"BdJCEbYw5JkTIZXFQoop7ThXDyD1Y865WGFlxqs//RTFniCEKViAMGfKcXqLF5o3w2dw31HFu0gtsSr5",
-	"x4IPHRJaW30/jjfLdACkll7qPDN7JhmhiUKrQir2SiGpsNBwqmUNs5JiuRyhd1wgwqTCLAX02S0zXgKm",
-	"avm5A+nuZL1Qc6ESS/UO4LlQni2CnN2Bxtq6fXgcQnA9G6RKZjzbdKqRmuBoEZOgNLjTs4vLuKZRSiaQ",
-	"wIwK0VP1up4+9ZMht3GXrDbg6kezACUIBITuZ/ylJnSlFCGsFKxyJS3rmgMY0B3zOklckLUWwyJHmGVO",
-	"geofMEMgBBedgFto9ieEmTdIm5z2a5IZqDsAhipQegB9Cq1hl1pDQvlCJlhuWBrkpTmmEmI0ozy9NUyF",
-	"vKFwONfU0WsgLKCyQA39rpm890RNMAKsM+OcAmYGcifrO90Qr1pqes8xdcqZwoR5RWh/Wq00z8glL2hW",
-	"V4uIsLBIB+DYR0NWgvyGZ04W5oTCVU45zt442dagAlP6P1cFVSTHQo01fQ8yrMy6AbLPCMMGya097a6W",
-	"fmbHU67Obrc2wnlOSYo1Ksf/lBqfXwda3nO39ITNuT3iliPGvLvh5NHQ007Wax9p+l8oLCgWtyfWrHL2",
-	"kS8CAnUlARGj1AzXLAVnvJB0gzxLWW/KL2J5dE0wuoaZ5OktKKldQMPSUgnAK42jOMoFz0EoRxGzdiId",
-	"SEm5nOHXNlAlzMbH3A+41t6BXY0n3aFRPeP90jnxU8kRfPZPSFVrYv9p29Pj6Jgzpv+zhYnX6AAdn52e",
-	"nhxfovEYvQGpEMzn2noaEzrn4g6LjLDFDfsjOkCnZ0lt+LQ5BGVEaq2ifRBgxUrD6kZHcVRNrYHoUWNA",
-	"tNJuQpoGhtNVlmBhCUkUrGQAt+WKWAi8iUzIocTG+OgPmrxO1thFVFlGNLownTbA6lqkQjtZaZ/MKqLA",
-	"eKmwKuQuaS0Rc2GHB9hI/9zYrX36uMJi7XQdvNLYL8gzF5dn0+nJW2S54vzq9HRy+h7dsJ/QAbo6/fvp",
-	"2fVpjQnc6CiO3MgojvyoEC9o+Ty2qj4svMh/3RZGZyD2pvgWThvLhJBUg/AcZEFVm2vhC1FJyjMYZOvj",
-	"iPJFwguVFwExPZKyWIFEV5fvDv5Hx8A8s2qwX8NUIDSWDx3oHaFw5ML8t/W9t48VDhimOlgQQLEiaxMm",
-	"2EiAVmmGKEBnSX4NRNgX5Ncy3NdLxIgwNNso42/VEfmXPwcRqeCLSnIBawJ3AVSiGVFmefiikLOnMSLz",
-	"GsiU8juJfpBkRSg2AcTV6eR/X0n0agk4e/XjTsT7EEafbxe2z2EOAlgawIQeJpEfiBouTJMqXse0Myp1",
-	"TMqSGrFW2zrwR3dL489aGLQSJ0rj20wpVCEgRDhv4F5kuy3cujSVOfEu3FovrUtCNYxJmdsqzNhElIM5",
-	"g7N5dPhLv3oOk/I+3scF+3Qfsh4dCRKtWpxefX+iDauOFoNqdKINwlt+x/TBfnaaqK3Ljz5eH/3fhVPl",
-	"P08uLqyC9pvYz1Ec+U+hrf5eCMUlke8AayK+o3gR3uz0LJmcXlyeXx1fTs5OL5Ljo+MPzf26RoS21Q5I",
-	"QIEssYAMnRlMSvTDlYQMvdmgn41rTgGduOSd/LHtSVZedZILrnjKadB+VNmHAbpdCcykSS7U1+xjkEs/",
-	"Y+on3MeRjkETRVbAQ0ZCR1MmSnUjUFYIcxAtYA7wXcJV5j4CEIeErcHHbcdt2/oV3ShagZR4AT22ephE",
-	"Xeqx28cyC1R7xBayvgNdui09V56cn5+dR3E0OX13FsXR9dH5aRdTnoPJiE05JemmQwpO/nFy7iSulK9S",
-	"APTHKPaCF9yiYD7+mtrMXxv5xooluSBrQmEBWbIKaoAja+ze6lhLHHBGN6iaU8XgMkZLLhWaEZahFS+Y",
-	"krFJ35hfp5O3Jksvc5yC0fUm9yMKVmYYTBLFpCkq8pf5gjhKKWeQ5NVhmlBeL0EtTSagypRWSQAzOTNX",
-	"BVyNbliVEFHa3NQn+UxaXlAKGZoLvjLfj6aTY0R5imm1vuICRmgyR0S9kghvfTZLE2nSoDdsideAZgAM",
-	"WTsCGSqktm/WBG1RS6OY28wkplQPa1PUnsPkJ7qP4U5ujvGeqA/FDM1gzkU9ajXcKLuwXmSJT9AGc9N1",
-	"8sEqV5uQP2DXKSSIh6+RiU0iCtY/u5uB4EsOgqyAKUyTuTVDzRCgT3uE7FcgLFwQtSxmCS7UMlH8FtgD",
-	"z2pDtcyZ5lIy+yBsG3OtNDFhybxgqbFXYSfQXJDUbtD0HOTn2LuqzCUBbbKZSHQTiYLdRCHQGWeJSTMS",
-	"tuhQKcMIlmOBKQX62PlErvqX+PNW2NBhgMw1ak/AbzOF22GPv3pFGVbYaT5AXpRLeW0gPQrYHR87JTp0",
-	"SBRPLGkJ7aBoGWvZlXXYov9Pz+6gqf7xxnDMSGuEm8jOcs634NxERdiDHHQV2uayUlsXBsLfgB36XSPu",
-	"oRF/12S/a7KmSpAgCKbkV8gSWaqEHZnw1pSQa35hb3ImZRYkkJs8YqELJaK9t5RTCgZqzT9FQbLYcpO7",
-	"UHJVJy69MCS9clrjRbdrMMvlbqB2J036FvEAdixjrtIah9i5aJsIFZguv9Lat48uwRBQ6+nE6um9cunN",
-	"033gUh2YggTtMntbYH+pWQL9aU4WhY7+OauffhTi1LR+5TAoBa9naUOT5CRLSkPTH66UhUBYWlfcXFaa",
-	"T9bYBUzXKKgnHsWF2phiBQnJE5xlHZexkynSH0HK7coPwiTJYKsEq3OTnIt+evdh2yR1Qs5G5Q/sj3FT",
-	"nuLwXfMrtNoPIzsvZpSk3eiamu91jP2BF0oj6Q91LKG7JQhoolIiAThd4hmFG3Z6dnlyiK59lY/2BXwy",
-	"uprAXintxDDCFs3qsoxk+lsGc8K0JtuYaztpyuZMkQhOb4FlKONgFpFFbu71BOg/dOBpz1mj85wHKWvR",
-	"8TyE9apn2IWY0zf+OuyFFOzFUyrXbWHclpwu9dtCVV2J9ejmb3eV5/2H3julF/FUwx5mm9EbdXqBzw1f",
-	"bYA31h/ZtTYQNnuY5GX6cEfWs5ZrbHpflRsYckn29dEaFYbt+aGdm9jqxUSQPF0hTAtJQc739SmmgqXN",
-	"cv7nYbc8frUJUyByAcok1u3au+56/Nx/YEqyB8wrq1XctE+ta17zey8SmksEBNCXkEAPupLuHH0IonL4",
-	"zoKW7e37jhJ2M0nHr1KJIg0rHT1l+Ikao3ceqL7xgMFzvuPIPcfAYlGs/FOAQfoysOyRWyRYCmOIo52V",
-	"ZAuf7bRm9b1bwRKZyFuS55CFKgfjKOeS+B32PMbUT+2hh1ctFd46j9iAdSCBSkyGCNWLFAG5AKlVndaR",
-	"YdzUdKxezmQ59gqvq1mhPQeecVqj0PaFHy1WbKBh1Iq/EyGUMNin0tqftVzULRF7mAaeratWoM5BomNM",
-	"Dc/h4YPJFJi+j87pnlM/c9uSBU5dH/RtjEMQhr6TnRfsHWFELiE7WQdFURTa7bBDEgiP0dJRsEQWaQpS",
-	"zgu6UyKr4q0dpqS18k4cBADegYFwNW6ZzasqYD/amta9LMd5wfw9+UctZAGrURs6FXyhA+RALZn7koTN",
-	"d1oIAUwlUkFeDhlew9mYvl+5Btf+pp4nH6KD2nA3lwyDtpMJmtjaQf/z2gO2nfXKvkp6mC/cU/491KX1",
-	"DNpR+dTFZ0P4+bx6ILeva19Z/sGu+V6OfF0Whs5pm6Y9dmuqwaETr7F5jrMHiLaGraLbdqTT4sF1OeDb",
-	"2JTW/n3S5PHRgvFux4fh4G9P2HkAv3UYbi7AlI76usR3gq9cJqgN7LC7tmY9ZzCBxguR2ti+48HxTHJa",
-	"KEB2pH1gWEuP2l/tRmV9C8+Jq2/ZnWOrAdBTH2rQcw2zBoZCqoULQNcwQ80q3IF3Q/vjrxCBC8Sr849I",
-	"ceTvV+1jP18PPAgretkebLTrDINJwsvjqUsQXhxfTn128O20lhm8PNZ/05+jONKfPsWh11TejiuiqP7m",
-	"s3fo/OTicl5QdDSdRHG0BiHd9qM/jl5rUHkODOckOoz+NHo9eh3Zx6uGBmOXbdd/uY+rv46XRCouNts/",
-	"f22/FL8fMmbscW92XYCtE87B1llOsugweg/qxC3h/qwuLI/K2XHj1X6HhaqGjAMv2+8/bT0S++n1672e",
-	"iA1y+brKm7ffT7Su7C5K99Y/SLXXeuY2u2vX8jxj++LNPDwrViusSRh9JFKZh4xNYTKlbVh7ML/4DgCR",
-	"8SuG0nJsCvsOfEo25zJA1ymXAwj7Ua/0zmY0n4jC/vHhphtptfeJ463HifePZJKH3PN0VuC3fb0X4RsL",
-	"g3T1m41XKf6pSqmFNOzoYiMVrB7NVgJWXEGLr7YMBVAqfQlq/c1rTevjLY6vylbvYLbjCA/h4nMD+DOy",
-	"8ZM8Yw0b8hBLdZvy5kPgxwrLAxTpiwjAUZYhy4yW+xV/HoZ3l5Ny/LX96vr+QSKQ8nzTwf643pXiiQXA",
-	"OcuyVXL0JPIQ75wVeLT+rFLUGS3cN93J37qQOLy/ks8rJ18DDZXuH+dR+v94cVYN9YZ6ede0Xl7wbZzT",
-	"CZM5pKrsXAHM1VO3XsE+PeuMvafw1Dzkq3T/fXiJpwrUge0UsXffjRdgE49R2ePUPaHOcZnd3QO96X4Q",
-	"B3lz+VRsskUFoFqwPIRaKy9AmUo1TVF3UxvoWiMrsAKqZVdHgE/fIHKql9J+o2Dp3PWzy0BhQk2taoln",
-	"hGe8MCG4rMhxR9TS1bPWKkMfwKul95jabiX+hnn/cNzz43G10Hccx/jmLMN9reePmCWIisJbnWAeS94q",
-	"J/dwTfPBrfFvk0cLPF74Nn6Kl++gWCP4QqQpC74pXr/+6S/I0oqkmKKaNn0U+btiwwfzwncXpD1TcNQw",
-	"Dt+NLUDa7SXzqoT8OfhjnFbdgB5hD1qcUmsj9NuK6usdlAK8stVD6eUC/HbfpJfhZFsfADbLtCBrYGUv",
-	"QfeyBfsHFrUkQFVb/xw87VsIy/HXWt/R+zHe6vbiFGMTR29Mp0fb27E6k+nE2WpNXLZ7jMt2A0QHqoIX",
-	"iyXCdpbHdPwUGrhssDLlQtmemkfN5qvfSt52z6r3gB0wvN76eMjqWC2HjOvpPDxgtu9POnzoAzdq96Ue",
-	"tGWtA22XyQyqhEfrgWPTCHjOS+92uynw04v58BDc1Zs8zBnyk78Hj3hI1VAjY/ciNuA9KESxVNWbZlGw",
-	"h5LcLTF2z2Jk9+2Gv33ErbfUI3S5JBJJBbl9FGitsO/gUu/3kmLW6HZrnjMGugDtdd2xNVl+53fWz6MR",
-	"usnzVKwx/lq9neq7Bav/qwKm/kkJsli4N61VOx/ts2SQU75ZlTnmNme92fheCDEi6hWlN8y6Awgz2+3W",
-	"ttoV4OqubLeizx1NaT/bHsyODf0/GNBok6uXu2Frgqs+vSM0MZ2TqOQo51KSmb1UsW2qTabcdA1nmW1c",
-	"5psOVIc1V4CrnILyEKol3DCT6EMlJ6LPgQbVn0ePkwb35+SlooPaA7vBZrvZkfu5gomAnnnhe8FQYfML",
-	"Bw5tIfMtBgoJ4pVEM1hiOn+s0rDHkc+sJrTC/V1HPFxHXDgqvYxm+Eay7ho6/UeLut3yiSXdPsW3QxeE",
-	"wdjVxOpfSkVQ8rcOK8ICV8UWrgmC58ImqkxNIgK2JoIz8zbRFRubf7bncOygGJnyuCWX6tDkI+7HOCdR",
-	"HK2xIHhGvYvrG646REd/++tf/1arAjZ//aQJ1mqYIXhmHzKgY8qLrBMiWYJ08NX+aU87Mj21RrfudnSU",
-	"8lUIxNqUJqSva//TLPXp/v8DAAD//+a0HBZKawAA",
+	"H4sIAAAAAAAC/+w9aZPbuLF/BcX3qpxN0ZKzSSUv8208HtuqeGdUc8Tv1Y6LhsiWhAwIMAAoW+ua//4K",
+	"Fw8RpKi5vEk2H7I7Io6+u9Fo9H6LUp4XnAFTMjr6FhVY4BwUCPMXFooscaoSkgFTZElA6J8zkKkghSKc",
+	"RUfR1RqQH4gYzgFxgcqSZFEcET2gwGodxZH+FB0F14wjAf8siYAsOlKihDiS6RpyrDdT20JPk0oQtoru",
+	"7uIIWErxBgaBur6evYmRXHOhgEGG7N9cOACXSK0BuYXCcAZ2ORDMrwWkCrJEgCw4k9CFcubhyApOmEIC",
+	"VCmYRGpNJNpgWkJsBkgQG5IC+kIoRQtAORa3kCEsEd5gQvGCAvodTFYT9B4o5egjFzT7YeIR+2cJYtvA",
+	"rAPYMCJrpYokB7XmWZj776+u5sgOQKWEDCmO0jWktx48QonaTtAbWOKSKkQkend61Qdec7smYP8tYBkd",
+	"Rf81rSV2ar/K6Xulip/MlOPGjgZ6wogimCYZULxNckIpkZBylskwMqzMFyC0iDTHapS+YKJQyRShCL5C",
+	"WirCVoY9SyKkslRIMaU9eA0A0kRzyUWOlRmv/vhjFHuGEKZgBcLgVOD0Fq+0bIZxcN9RLbtIrbGq5MeC",
+	"Dz0a2lj9MIk3y/QApNZe67wweyGZoJlCeSkVe6GQVFhoONW6QVlJsVxP0FsuEGFSYZYC+uyWma4BU7X+",
+	"3EN0h9kg1FyoxHK9B3gulBeLoGT3kLGx7hAdxzBczwapkgXPtr1mpKE4WsUkKA3u/PzyKm5YlEoIJDBj",
+	"QvRUva7nTxMz5Dbu09UWXMNkFqAEgYDS/YS/NpSu0iKElYK8UNKKrkHAgO6E12niimy0GpYFwixzBlT/",
+	"gBkCIbjoBdxCczgjzLxR1uRs2JIsQH0BYKgGZQDQx7AadqkNJJSvZILllqVBWVpiKiFGC8rTWyNUyDsK",
+	"R3PNHb0GwgJqD9Sy71rIBzFqgxEQnQXnFDAzkDtd3xuGuHFNu+eEOuVMYcK8IbQ/5bmWGbnmJc2aZhER",
+	"FlbpAByHWMhakV/zzOnCklC4LijH2Wun2xpUYEr/a15SRQos1FTz92WGlVk3wPYFYdgQubOn3dXyz+x4",
+	"xtX57c5GuCgoSbEm5fQfkrP2LkOe98ItPWNLblHcCcSYDzecPhp+2sl67WPN/0uFBcXi9tS6Vc4+8FVA",
+	"oa4lIGKMmpGateCMl5JukRcpw1fwi1gZ3RCMPsJC8vQWlNQhoBFpqQTgXNMojgrBCxDKccSsnUgHUlIt",
+	"Z+S1C1QFs4kxDwOus3dgVxNJ91hUL3g/9078VEkEX/wDUtWZOIxtd3ocnXDG9L92KPEKvUQn52dnpydX",
+	"aDpFr0EqBMul9p7GhS65+IJFRtjqhv0BvURn50lj+Lw9BGVEaquiYxBgZa5hdaOjOKqnNkD0pDEgWm03",
+	"R5oWhdM8S7CwjCQKchmgbbUiFgJvI3PkUGJrYvR7Td4kG+xOVFlGNLkwnbfA6lukJjvJdUxmDVFgvFRY",
+	"lXKftlaEubTDA2Kkf27t1sU+rqnYwK5HVlr7BWXm8up8Pj99g6xUXFyfnc3O3qEb9iN6ia7P/nZ2/vGs",
+	"IQRudBRHbmQUR35USBa0fp5YUx9WXuS/7iqjcxAHc3yHpq1lQkRqQHgBsqSqK7Xwlagk5RmM8vVxRPkq",
+	"4aUqyoCaHktZ5iDR9dXbl/+DgKU8s2Zw2MLUILSWDyH0llA4dsf8N829d9EKHxjm+rAggGJFNuaYYE8C",
+	"tE4zRAE+S/JL4IR9SX6pjvt6iRgRhhZbZeKtJiH//KcgIRV8VUkhYEPgS4CUaEGUWR6+KuT8aYzIsgEy",
+	"pfyLRL+TJCcUmwPE9dnsf19I9GINOHvxw17C+yOMxm8ftS9gCQJYGqCEHiaRH4haIUybK97GdDMqTUrK",
+	"ihuxNtv64I++rE08a2HQRpwoTW8zpVSlgBDjvIN7lu12aOvSVAbjfbS1UVqfhmoYkyq3VZqxiagGcwbn",
+	"y+jo52HzHGblXXxICPbpLuQ9ehIkR98qu/ruVDtWfVoMmtGZdghv+BemEfvJWaKuLT/+8PH4/y6dKf9p",
+	"dnlpDbTfxH6O4sh/Cm31t1IoLol8C1gz8S3Fq/BmZ+fJ7Ozy6uL65Gp2fnaZnByfvG/v1zcitK0OQAIG",
+	"ZI0FZOjcUFKi311LyNDrLfrJhOYU0KlL3skfupFkHVUnheCKp5wG/UedfRhh25XATJrkQnPNIQG58jPm",
+	"fsJdHOkzaKJIDrxU4dOUHoHcCJSVwiCiFcwBvk+5qtxHAOKQsrXkuBu47Xq/sp9EOUiJVzDgq8dp1JUe",
+	"u4uWWaDeI7aQDSF05bb0Unl6cXF+EcXR7OzteRRHH48vzvqE8gJMRmzOKUm3PVpw+vfTC6dxlX5VCqA/",
+	"RrFXvOAWJfPnr7nN/HWJb7xYUgiyIRRWkCV50AIcW2f3Rp+1xEvO6BbVc+ozuIzRmkuFFoRlKOclUzI2",
+	"6Rvz63z2xmTpZYFTMLbe5H5EyaoMg0mimDRFFHfyBXGUUs4gKWpk2lB+XINam0xAnSmtkwBmcmauCria",
+	"3LA6IaK0u2lO8pm0oqQUMrQUPDffj+ezE0R5imm9vuICJmi2RES9kAjvfDZLE2nSoDdsjTeAFgAMWT8C",
+	"Oq2u/Zt1QTvc0iTmNjOJKdXDuhy1eJj8RD8aDnODxjui3pcLtIAlF81Tq5FG2Uf1Mkt8gjaYm26yD/JC",
+	"bUPxgF2nlCDuv0Ymtoko2fDsfgHSKQtBcmAK02Rp3VD7CDBkPUL+K3AsXBG1LhcJLtU6UfwW2D1xtUe1",
+	"zLnmSjOHIOw6c200MWHJsmSp8VfhINBckDRu0PQc5OfYu6rMwuySzUSim0iU7CYKgc44S0yakbBVj0kZ",
+	"xzB9bUkp0IfOJzIfXuJPO8eGHgek18sHDvxGEzvHHn/1inSiz1k+QF6VK31tET0K+B1/dkr00SFRPLGs",
+	"JbSHo368W1kfW/T/6dk9PNU/3hiJmWiLcBPZWS74FpybUxH2IAdDha67rM3WpYHw38AP/WYRD7CIv1my",
+	"3yxZ2yRIEART8gtkiaxMwp5MeGdKKDS/tDc5syoLEshNHrPQhRLR0VvKKQUDtZYfnUWIrTS5CyVXdeLS",
+	"C2PSK2cNWXS7BrNc9lOyP2kytIgHsGcZc5XWQmLvol0m1GC6/Epn3yG+BI+A2k4n1k4flEtvY/eeS/XS",
+	"FCQoXvsC+0vDE+hPS7Iq9emfsyb2k5Ckps0rh1EpeD1LO5qkIFlSOZrh40pVCISlDcXNZaX5ZJ1dwHVN",
+	"gnbiQVKonSlWkJAiwVnWcxk7myP9EaTcWRERJkkGOyVYvZsUXAzze4jaJqkTCjbqeOBwipvyFEfveh2k",
+	"zX6Y2EW5oCTtJ9fcfG9S7Pe8VJpIv29SSSc8BbRJKZEAnK71VdkNOzu/Oj1CH32Vj44FfDK6nqDLbETJ",
+	"GGGrdnVZRjL9LYMlYdqSbc21nTRlc3rcAqe3wDKUcTCLyLLQA5AA/Q998LR4Nvi85EHOWnI8DWO96Rl3",
+	"Iebsjb8OeyYDe/mYxnVXGXc1p8/8dkjVNGIDtvn7XeX5+GHwTulZItVwhNkV9FadXuBzK1YbEY0Nn+w6",
+	"GwibPUyKKn24J+vZyDW2o686DAyFJIfGaK0Kw+780M5tag1SIsieviNMh0hByff1KaaCpSty/udxtzx+",
+	"tRlTIAoByiTW7dp38bi5f8eUZPeYV1WruGmfdlljcRkkQnuJgAK67wkMkCvpz9GHIKqG7y1o2d1+CJVw",
+	"mEl6fpVKlGnY6Ogp4zFqjd6LUHPjEYOXfA/KA2hgsSpz/xRglL0MLHvsFgnZTcscHawkO/TspjXr7/0G",
+	"lshE3pKigCxUORhHBZfE73AgGnM/dYAf3rTUdOtFsQXrSAZVlAwxapAoAgoBEpgyNjJMm4aN1cuZLMdB",
+	"x+t6VmjPkTjOGxzavfCjZc5GOkZt+HsJQgmDQyqtPa7Vom6J2MM0Ere+WoGmBImeMQ06h4ePZlNg+iE2",
+	"p39OHA15sgDWzUHfxzkEYRjC7KJkbwkjcg3Z6SaoiqLUYYcdkkB4jNaOkiWyTFOQclnSvRpZF2/tcSWd",
+	"lffSIADwHgqEq3H9AFRXwH6wNa0HeY6Lkvl78g+EQchrNIbOBV8JkLJL48J9ScLuOy2FAKYSqaCohoyv",
+	"4WxNP6xcg+t4U8+T97FBXbjbS4ZB2ysEbWrt4f9F4wHb3nplXyU9LhYeKP8eG9J6Ae2pfOqTszHyfFE/",
+	"kDs0tK89/+jQ/KBAvqkLd/HBUDnXdMBubTM4duJHbJ7jHACirWGLo76TTkcGN9WA7+NTOvsPaZOnRwfG",
+	"L3s+jAd/d8JeBPzWYbi5AFM66usS3wqeu0xQF9hxd23tes5gAo2XIrVn+/ByeCE5LRUgO9I+MGykR+2v",
+	"dqOqvoUXxNW37M+xNQAYqA815PkIixaFQqaFC9CvUFC7Cnfk3dDh9CtF4ALx+uKDvvLw96tmKb/IOKro",
+	"ZQeo0a0zDCYJr07mLkF4eXI199nBN/NGZvDqRP+lP+uU4Jt5IB1o3/ZaP66Iovqbz96hi9PLq2VJdSVW",
+	"FEcbENJtP/nD5JUGlRfAcEGio+iPk1eTV5F9vGp4MHXZdv3HXVz/OV0TqbjY7v78rftS/G7MmKmnvdl1",
+	"BbZOuABbZznLoqPoHahTt4T7Z31heVzNjluv9ns8VD1k2oUluvu080jsx1evDnoiNirk6ytv3n0/0bmy",
+	"u6zCW/8g1V7rmdvsvl0rfKb2xZteVpZ5jjULow9EKvOQsa1MmpoK6wjmZ98BIDJxxVheTk1h30ufki24",
+	"DPB1zuUIxn7QK721Gc1H4rB/fLjtJ1rjfeJ053Hi3QOF5D73PL0V+N1Y71nkxsIgXf1m61WKf6pSWSEN",
+	"O7rcSgX5g8VKQM4VdORqx1EApdKXoDbfvDasPt6R+Lps9Qss9qBwHym+MIA/oRg/yjPWsCMPiVS/K28/",
+	"BH6ostzDkD6LAhxnGbLCaKVf8acReHc5Kaffuq+u7+6lAikvtj3ij5tdKR5ZAVywLDslR4+iD/HeWV3y",
+	"Pa0W9Z4W7u7u/pOUxNH9hXxaPfkWaKh097CI0v/Ls4tqAJXvEJo2ywu+T3A6Y7KAVFWdK4C5eurOK9jH",
+	"F52pjxQeW4Z8le6/jizxVIF6aTtFHNx34xnExFNUDgR1j2hzXGZ3/0Dvuu8lQd5dPpaY7HABqFYsD6G2",
+	"yitQplJNc9Td1Aa61sgarIBp2dcR4NN3ODk1S2m/02HpwvWzy0BhQiFr0hnhhX5XinW4VrFDV1a6etZG",
+	"Zeg9ZLWKHlPbrcTfMB9+HPfyeFIv9Cs+xzgoD4i1nv7ELEHUHN7pBPNQ9tY5uftbmvdujX+ZPFrg8cL3",
+	"iVO8fgfVGsFXIk1Z8E356tWPf0aWV0TnTRrW9EHs7zsb3lsWfnWHtCc6HLWcw6/GFyBZQEqWdQn5U8jH",
+	"NK27AT3AH3QkpdFG6N/rVN/soBSQlZ0eSs93wO/2TXoeSbb1AWCzTCuyAVb1EnQvW7B/YNFIAtS19U8h",
+	"076FsJx+a/QdvZvinW4vzjC2afTadHq0vR1rnEwnzk5r4qrdY1y1GyD6oCp4uVojbGd5SsePYYGrBiv6",
+	"yYftqXncbr76vfRt/6wGL8YMb7Y+HrO6vp0eMW6g8/CI2b4/6fih99yo25d61JaNDrR9LjNoEh5sB05M",
+	"I2D9uNUnj3eaAj++mo8/grt6k/sFQ37yryEiHlM11MrYPYsPeAcKUSxV/aZZlOy+LHdLTN2zGNl/u+Fv",
+	"H3HnLfUEXa2JRFJBYR8FWi/sO7g0+72kmLW63ZrnjIEuQAddd+xMlr/yO+unsQj97Hks0Zh+q99ODd2C",
+	"Nf+rAqb+SQmyWrk3rXU7Hx2zZFBQvs2rHHNXsl5vfS+EWLcvovSG2XAAYWa73dpWuwJc3ZXtVvS5pynt",
+	"Z9uD2Ymh/w8GtNrk6uVumG7dW/Xp1W3ideckKjkquJRkYS9VbJtqPcl2DWeZbVzmmw7UyJorwLygoDyE",
+	"ag03zCT6UCWJ6HOgQfXnycO0wf1z9lyng1pIxrvtFsJPdpgI2JlnvhcMFTY/88Ghq2S+xUApQbyQaAFr",
+	"TJcPNRoWHfnEZkIb3N9sxP1txKXj0vNYhu+k6xbJ/2xVt1s+sqbbp/h26IowmLqaWPPLxr3l1H9UVqES",
+	"dn3GCGtffdBwHRG8SLbpZgoUEbANEZxpyxC5ymPz3/A5mjqQJqZWbs2lOjLJibspLkgURxssiM4kOAPl",
+	"u686qkd//ctf/tooCTZ/ftLc63TPEDyzrxrQie6U1QuRrEB6+c3+02I7MQ22JrfuqnSS8jwEYmNKG9JX",
+	"jf9p+fp09/8DAMat1RBXawAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
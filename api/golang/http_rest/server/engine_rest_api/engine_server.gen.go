@@ -749,35 +749,34 @@ func (sh *strictHandler) GetEngineInfo(ctx echo.Context) error {
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
-
-	"H4sIAAAAAAAC/9RZW2/bNhT+KwK3R9XK2oeifssSrzXW2obtYBuKQGXEY5uNRKok5TYw/N8HUtTNohU5",
-	"TQw0T5Z0rt+58BxmhyKepJwBUxINdyjFAiegQJgnYFGMtxBSAkzRFQWh3xKQkaCpopyhIbq5GV/7ntxw",
-	"oYAB8fJnLjyGE/D4ylMb8Kwg5COqeVKsNshHmgINXVp8JOBbRgUQNFQiAx/JaAMJ1urVQ6q5pBKUrdF+",
-	"r2kTvoUQx3HbvPHKMwK8nMjDcVxYIwfeVG1AfKcSPM7ih4JGKp6mQGp017DCWaw8Kr0VjmXpyLcMxEPl",
-	"Sc0Qh8V3nMeAGdrnNsuUMwkG5wlX03v9I+JMAVP6J07TmEZYuxF8ldqXXU3k7wJWaIh+C6rwBflXGcyt",
-	"6DFb8VzZQcQY/EghUtpDIbgwGFpmLfsypVecKUwZiGu4y9afOIEcWYMCGhoMfAQsS9Dwc/6kUb71W776",
-	"DXELhVVmU8vwovnNZDKevEc+Wiyns9noGvloMp2Eo3/Hi+VoskSVzCLiProSgBWMbFLptBU8BaFoDidO",
-	"aRgVKsOYr8MYtuBIDivBi/naMyS+Z32UnuLeePLXFDnUN+VvQUjKWajw2pGefpneeY44CBILb1dMraUm",
-	"EjpaG57FJMQpjUKRsZCykOhIhX1kueObJ2VRdJ+bZnf5XAWI332FSGn7riEGjfEiSxIsHtohyiuFhHUt",
-	"IWYkzDJKDAVVkMiesExwApeM3GSUaO3WHCwEfjB+teyzfJezcYnEBy7VJxxtKMsLp2XyWqRRmHKhQs7C",
-	"DZcqTHLyWlApU7AGoVXQtIOu0bwqzB08fofe236eud25E5SsIaRpiAkRIKUzN6uQU+IkqKyjTFICRUSP",
-	"gnKU7AgmDQtcEjps8B1edoA2Lg8g2caryFSdoE4kjtZ3eTYe4z1SeYbaym1J6XLDGe9mAdfzKKSWoUel",
-	"dVVMqzM+Ua5bmCyPjr69zR429SyWPcVYq2oS9IljOh5NHm2wS5qAVDhJ6+3/aN48of2fN9Vc+B2JziFQ",
-	"1ruOZC1mi2IeWI4WS+Sj2Xx6fXO1HE8nzgHA0fdbCX8UpH7QWCweK7Z+E83o02z5X5cnSyzWoNrCtIgj",
-	"fOujJxWYb8Uh3ScLGvQubxszZUthZIO44iLBCg1RRpl687qanWpHQAJS4rU7MvmLftPtUtMeemIEVDr8",
-	"3LIuh5ZWZQH4aD6fzpGP7Oz3z+XcBNMVgqrM664TrOCVTf5D2PUJaAFUVMX629+ZUFxS6c1Hi+Uqi73L",
-	"2Rj5qIwduhj8MbjQ6ngKDKcUDdGbwcXgAvlmjTL4B8Wmko+4MShoD7tmJnMtN8upl/OY5ai2rkkvk2Be",
-	"mD3HK5dD70u16XxBxjRhqn5MSkWjwiS/sVR+dke3IglqS9T+9mBRen1x8Wxr0uGM6tiUFlkUgZQ6LIUZ",
-	"yBDZPcitoLQ4yPc6s14Vg3ARBqjgUXgtqzJEt3qqAiO9iet7UDVQfwoXTAjVn3A8axRyjzOoOJ0PKuo8",
-	"4H2kUj0CXcqlA7sZl03wvmUg1Z+cPDxbPjWX0n2zNenteP+CydwIznlikftbu9ppBWPvV50p2FCpeL4K",
-	"PpbdHyzpT+J1ygZZH/3bG+T5crt2PeXRmk2PobtrX6Ltm4dBV49ugXBy03bc4R1r3udosVIJ/tCVmo+3",
-	"2LNi8qv2gPdQtmOPgMI0BuKZEecpCRtgoegKR+Yq+gTqIOYRjl+tqJ6oTmLUs4aCp3BKEFsaaTr766Dy",
-	"TpG1K34+t4yA8O8s5pj0EhbzdT/gC99PIg4izhhEeTKewlcdHCcwPTkqnRJ0YSSYkWeQBIyknOoK25mb",
-	"K5YldyaiW0xjfEdjqp7B5RNiqrCIsbg/iThIcXSP1yCfxhXs7K+Qkv1pIvLG1luv3apP7vmL4lrjl+j8",
-	"xXXV+Xt/ef3zpIH8xWF//mHfdW3Tf+R/+eAsegUnrx39EBT3EsdLpLxvetFELrWcNYu11s65pWw88AOi",
-	"TNujG659GZYvzYVp1XW1JhDbIn2b3nzUQ4sHbEsFZ4mGzkeZiNEQbZRKh4GNzMAMNxsu1dCcFPsApxT5",
-	"aIsFxXdxHgP9ofEfWvTu7dt3qPwXbf54qzE9NGMmOMnMsexdxTwjRy2SpUmvdvbG0Hg7iDTb4N5eZA0i",
-	"nrhMrLE0Lb2o/emo3+7/DwAA//9gNc1rlSAAAA==",
+	"H4sIAAAAAAAC/9RZW2/bvhX/KgK3R9XK2oeifssSrzXW2obtYBuKQGXEY5sNRaok5TYw/N0HUtTNoh05",
+	"TfJH+1JLOtffufAcZocSkWaCA9cKDXcowxKnoEHaJ+AJw1uIKQGu6YqCNG8JqETSTFPB0RDd3Iyvw0Bt",
+	"hNTAgQTFs5ABxykEYhXoDQROEAoRNTwZ1hsUIkOBhj4tIZLwI6cSCBpqmUOIVLKBFBv1+iEzXEpLytdo",
+	"vze0qdhCjBnrmjdeBVZAUBAFmLHSGjUIpnoD8idVEAjOHkoapUWWAWnQXcMK50wHVAUrzFTlyI8c5EPt",
+	"ScMQj8V3QjDAHO0Lm1UmuAKL80To6b35kQiugWvzE2cZowk2bkTflfFl1xD5dwkrNER/i+rwRcVXFc2d",
+	"6DFfiULZQcQ4/Mog0cZDKYW0GDpmI/syo1eCa0w5yGu4y9dfBIECWYsCGloMQgQ8T9Hwa/FkUL4NO76G",
+	"LXELjXXuUsvyovnNZDKefEQhWiyns9noGoVoMp3Eo/+OF8vRZIluw8OIh+hKAtYwckll0laKDKSmBZw4",
+	"o3FSqoyZWMcMtuBJDichYGIdWJIwcD6qQItgPPnXFHnUt+VvQSoqeKzx2pOeYZXeRY54CFIH76mYOktt",
+	"JEy0NiJnJMYZTWKZ85jymJhIxX1k+eO73zeL7mvb7FM+1wESd98h0ca+a2BgMF7kaYrlQzdERaWQuKkl",
+	"xpzEeU6JpaAaUtUTlglO4ZKTm5wStK/MwVLiB7SvX9T2Ob7L2bhC4pNQ+gtONpQXhdMxeS2zJM6E1LHg",
+	"8UYoHacFeSOolGtYgzQqaHaCrtW8asw9POEJvbf9PPO7cycpWUNMsxgTIkEpb27WIafES1BbR7miBMqI",
+	"HgXlKNkRTFoW+CScsCH0eHkCtHF1AKkuXmWmmgT1InG0vquz8Rjvkcqz1E5uR8opN7zxbhdwM49i6hh6",
+	"VNqpiul0xifK9QtT1dHRt7e5w6aZxaqnGGdVQ4I5cWzHo+mjDXZJU1Aap1mz/R/Nmye0/9dNNR9+R6Jz",
+	"CJTz7kSylrNFOQ8sR4slCtFsPr2+uVqOpxPvAODp+52EPwpSP2gcFo8VW7+JZvRltvzfKU+WWK5Bd4UZ",
+	"EUf41kdPKrDfykO6Txa06H3etmbKjsLEBXElZIo1GqKccv3uLQo9R0AKSuG1PzLFi37T7dLQHnpiBdQ6",
+	"wsKyUw4tncoS8NF8Pp2jELnZ7z+XcxtMXwjqMm+6TrCGNy75D2E3J6ADUFPNzLd/51ILRVUwHy2Wq5wF",
+	"l7MxClEVO3Qx+MfgwqgTGXCcUTRE7wYXgwsU2jXK4h+Vm0ox4jLQ0B127UzmW26W06DgsctRY11TQa7A",
+	"vrB7TlAth8G3etP5hqxp0lb9mFSKRqVJYWup/OqPbk0S1aLR/vZgUXp7cfFsa9LhjOrZlBZ5koBSJiyl",
+	"GcgSuT3Ir6CyOCr2OiNXOSVVGKCGR+O1qssQ3ZqpCqz0Nq4fQTdA/S1cMCHUfMJs1irkHmdQeTofVNTr",
+	"gPeZKv0IdJlQHuxmQrXB+5GD0v8U5OHZ8qm9lO7brclsx/sXTOZWcF4nFoW/jaudTjD2Yd2Zog1VWhSr",
+	"4GPZ/cmR/iZe52yQzdG/u0G+Xm43rqcC2rDpMXR33Uu0ffswONWjOyCc3bS76o8279dosUpL8XAqNR9v",
+	"sa+KyZ/aAz5C1Y4DAhpTBiSwI85TEjbCUtMVTuxV9BnUERMJZm9WlMGZjGbW0PAUTgVySxND534dVN45",
+	"snblz+eWERHxkzOBSS9hTKz7AV/6fhZxlAjOISmS8Ry++uA4g+nJUTkpwRRGijl5BknASSaoqbCdvbni",
+	"eXpnI7rFlOE7yqh+BpfPiKnGkmF5fxZxlOHkHq9BPY0r2rlfMSX780QUja23XrdVn93zF+W1xh/R+Z21",
+	"f0Hvr65/njSQvzjszz/s+65t+o/8Lx+cRa/gFLVjHqLyXuJ4iVT3TS+ayJWWV81io/X03LJ1fyE3D1UX",
+	"gl+Q5MY4033dy7h6aW9P6xZs1ILclrncdu2zmWAC4FsqBU+BaxSiXDI0RButs2HkwjSwk465vB/aY2Mf",
+	"4YyiEG2xpPiOFQExH1p/rkUf3r//gKq/1xaPtwbgQzNmUpDcntHBFRM5OWqRqkx6syv+L7wdJIZtcO9u",
+	"tQaJSH0mNljall40/pkUuN3/fwDQTV4moiAAAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -27,6 +27,9 @@ type ServerInterface interface {
 	// Get service logs
 	// (GET /enclaves/{enclave_identifier}/services/{service_identifier}/logs)
 	GetEnclavesEnclaveIdentifierServicesServiceIdentifierLogs(ctx echo.Context, enclaveIdentifier EnclaveIdentifier, serviceIdentifier ServiceIdentifier, params GetEnclavesEnclaveIdentifierServicesServiceIdentifierLogsParams) error
+	// Watch events
+	// (GET /events)
+	GetEvents(ctx echo.Context, params GetEventsParams) error
 	// Get Starlark execution logs
 	// (GET /starlark/executions/{starlark_execution_uuid}/logs)
 	GetStarlarkExecutionsStarlarkExecutionUuidLogs(ctx echo.Context, starlarkExecutionUuid StarlarkExecutionUuid) error
@@ -144,6 +147,31 @@ func (w *ServerInterfaceWrapper) GetEnclavesEnclaveIdentifierServicesServiceIden
 	return err
 }
 
+// GetEvents converts echo context to params.
+func (w *ServerInterfaceWrapper) GetEvents(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEventsParams
+	// ------------- Optional query parameter "enclave_identifier" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "enclave_identifier", ctx.QueryParams(), &params.EnclaveIdentifier)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter enclave_identifier: %s", err))
+	}
+
+	// ------------- Optional query parameter "event_types" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "event_types", ctx.QueryParams(), &params.EventTypes)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter event_types: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetEvents(ctx, params)
+	return err
+}
+
 // GetStarlarkExecutionsStarlarkExecutionUuidLogs converts echo context to params.
 func (w *ServerInterfaceWrapper) GetStarlarkExecutionsStarlarkExecutionUuidLogs(ctx echo.Context) error {
 	var err error
@@ -190,47 +218,54 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 
 	router.GET(baseURL+"/enclaves/:enclave_identifier/logs", wrapper.GetEnclavesEnclaveIdentifierLogs)
 	router.GET(baseURL+"/enclaves/:enclave_identifier/services/:service_identifier/logs", wrapper.GetEnclavesEnclaveIdentifierServicesServiceIdentifierLogs)
+	router.GET(baseURL+"/events", wrapper.GetEvents)
 	router.GET(baseURL+"/starlark/executions/:starlark_execution_uuid/logs", wrapper.GetStarlarkExecutionsStarlarkExecutionUuidLogs)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
-
-	"H4sIAAAAAAAC/+xa3W/buhX/VwhuwDZAtbLeh4vrt6LX7Q2WJUHirhdoA11aOrLZUKTKD7de4P99IEXJ",
-	"+qBlOQtQDFheYlPnm+f8eHToJ5yKohQcuFZ4/oRLIkkBGqT7lgr+xfBU0y0kOWX1MuV4jr8akDscYU4K",
-	"wPMgaYRVuoGCOB4NhWP+s4Qcz/Gf4oPiuCJT8ZVYX1EO7xw/3kdY70ornEhJdni/jzDwlJEtJDQDrmlO",
-	"QVqZGahU0lJTYS378OHy1wipjZAaOGSo+i4ksqYikSO9AeQF4ajypiR6c3AmoCXCEr4aKiHDcy0NtH3z",
-	"ViotKV87M3PBmPiWMLE+GrA2SUDYSggGhDtp3BSWLmGUw1F5XaKARMo1rG1Y99YXbSRPCGOjNvbJTtip",
-	"QG5pOr45yw0gT4cOdPWupIJrQjlIpDdE+6WiIDyz+2lYhlaA4DukRkOGKA9vX8CO87avFmAMzRIF+lh8",
-	"BnRjapoK6OkLZLnSRDIiH5PKVSq4UxGOpuH0q+kEUwukJUkfq0SvRdgYE3TvRaNKjC2LkqSPZH2kFI6Z",
-	"ck5AXcKpUnBVpe+10DePHl80cBdeUpaMpsQqiL8o69xTS+IYaNx50Zc8F5WyHhxw+F5CahMGpBRVAXhm",
-	"K9uDjkM/KUqQmlZmMr86deMirGkBSpOiPGXzsiGsglNH8lOltC3poVEjVl8g1VZPFycHhtvPRAs5EW5v",
-	"anLrAXzXSUm0BsnDtdE2tlHUYxyx+aZlG3BTWDG/3izuk7c318s3l9fJcvH7EkfV2vXNMrher/3zzfLt",
-	"b8nd4v3i9xBL+/HBpMP2dTJnEMVUZG77cyELovEcG8r1T69xNADUCBeglC2h40kyLYeXlrYfZSfgoCOq",
-	"LAvFuCOmFeDF3d3NHY7w5fW7Gxzhj2/uri+v3wdjcl9B2pU/Fboh4UInuTDcgl0AISfXSc1tD5VktetI",
-	"c2CQZdRWL2G3Hf0Tkrmlro7LPhCpGgYXDhEGjkK9LDjc5Hj+aVx3Le2Sa5ClBO1wrJK9j6bx/oswmj2D",
-	"b1Hjsmd76KdP5cvDWBC6IobRaKAfRsKVHC+CkEUN+UNowzrkPfVjroRLmR5ZVVqatDomQizTPepQn3So",
-	"rXgCcS5OuDziBpFrU9TN/aQePCD2jRcSKuVqc8iKQdKL5wAFWs+TqrsIEalEPdKyhCzUZEa4FIrWGs50",
-	"47ZmHdmPyrCoFbejLnZsnbhBTSRDGzUaFAmlBAXcQssWwrFRIClh9N+QJVbcljAzIXeDXCGdE328be1Q",
-	"/0RlpuCdM/X4kZpTBkcDUrdmJ+X0fG2ERnWj5W2a6NsdKMP0KJQk8ghNK85h8snbFGA/B3OO87R9Hp5k",
-	"Aa/bRD/mcAjaMObZneHvKKdqA9liGyxFaXiSe5IEwjS2OgxPlElTUCo37GRFCqNLM2Gfh5JPxiBg8IkI",
-	"3EqxlqACLV7pnyThMzM1UgLXidJQNiTTG78OOzfFqnp9mQAHWmjCHJ96TuEP7e6KDJt2MvLdaJ0Iet2d",
-	"12+b3dfU5sW86cZQzYCjs/vQwzE1uY88q+ts59BUniGOnqGtW7NTGT8SyatUnGpiLmwLfdi3fls+qIht",
-	"Q/BjAHCgfywL63gMbPx24sF08/sMJx2oVYfsXrYHKk3NZ0TDK03dMR6YNdWwpKlm9tk/jNRCUYXuFvfL",
-	"3DD05vYSR3gLUlWldzH7++zCqhMlcFJSPMc/zS5mFzhywzAXh9jPhO2XfXT4Gm+o0kLu+stPwxnyfgpN",
-	"TKSmOUm1Oo86ZiIl7JVtcM5klFAIDc/h9K/tKn4aTlzPdDZ+qj++tIw4E984EySbJKyehq+rkUYXot+D",
-	"RoVhmpasuUCoh9kKWVaUCu4PEraboeWGKgQ8KwXlGqWEI6UlkMINZh39aoeA6g1IpLS1nq8/c4I+wkqJ",
-	"9BG0lcfBQSb6q4RUFAXwDLK/ISERgzVJd+i35fLWy6V8PcORH8tRwS+zyuqF99n/v2wcvqqm+u1bnyNH",
-	"zIEkDtyOHMPXFtdgYjSBp31HMoE8dAc1ga1/yzGBpXvZsn/oDbhfX1y82Hi7PZALTLfvmz4R1SZgR5QT",
-	"/1oREt5YG1ezeDcSN0VBLJC5PPeb/BfVTXCLuMSWyCfcpBx2I6cThVVLmVSFDbAc0v88vjNA+QVQbFRC",
-	"7C+wXkBSjSQqfiqF1L5L3cdkSygjK8qofgGXT2NgfX9nCf8HQM5XkPL/fyj6ncf1f/z7YfjXzvHng55v",
-	"u6cVpSeO/X2seh5X/OQ/JTTbnyeiiutkvdocSNeUQ+wbb7vSyGyG9xZswnfJJxDnvocmIkeEH+6xD/fb",
-	"7icD30ACopxqSjRkyCjK1+gPCVpS2PobJ6J2PP1j9pkvN4DcF9+8EY5W7kcIyhSQIcHZDgmeAiI8Q/C9",
-	"pBIQyTVIT1NW9+oSvUYbYaSqH0pwme40nA+OaCI2fubTwXFw1aMGKx8MzZ6FiMd+IvBfw8JZ9xX9Acvw",
-	"dxXj2PHVgNIvAh2B1BxDEX8fWge7a+KVfatDwLdUCu5uDiJsJMNzvNG6nMe+9Gbu7W8jlJ671mAf29fY",
-	"CG+JpGTF/GhPSF9e3kH8y88//4Kj5prYfXUW9c24lSKrJjfoLRMmO2qRakx69VT9r0p8llq22aN/FZ+l",
-	"ogiZ2GLpWnrR+rNb+bD/TwAAAP//53BKX7omAAA=",
+	"H4sIAAAAAAAC/+wabW/buPmvENyAbYBqZ70Ph/M3I3Fa47I4sN32hjbQMdJjm41EqiTl1Av83wdSpKwX",
+	"WpazbMWAy5dY1PP+xkfk84wjnmacAVMSj55xRgRJQYEwTxFnX3MWKbqFcEUTt0wZHuFvOYgdDjAjKeCR",
+	"FzTAMtpASgyOgtQg/1nACo/wn4YHxsMCTA5v+PqGMrg2+HgfYLXLNHEiBNnh/T7AwKKEbCGkMTBFVxSE",
+	"phmDjATNFOVasg8fplcBkhsuFDCIUfHMBdKiIr5CagPIEsJBoU1G1OagjIdLgAV8y6mAGI+UyKGqm5VS",
+	"KkHZ2oi54knCn8KEr48arAriIfbAeQKEGWosTzVcmFAGR+nVgTwUKVOw1mbda11ULlhIkqRTxibYCTkl",
+	"iC2Nup2z3ACycOgA57wScaYIZSCQ2hBll9KUsFj7M09i9AAIvkOUK4gRZX73eeQ4z32OQJ7TOJSgjtmn",
+	"BdfFpsyABj9PlEtFRELEY1ioSjkzLPzWzBn9lteMqThSgkSPRaA7EtrGBC0saVSQ0WmRkeiRrI+kwjFR",
+	"zjGoCTiZcSaL8L3lavZo64sCZsxLsiyhEdEMhl+lVu65QrGraMwt6Slb8YJZoxww+J5BpAMGhOBFAlhk",
+	"TXvC1pTBZGsFqSMveApqQ9m6CMgNyTJTUxRHhLkiMkAzluyMtVcUklgiAQlsCVPGFdoJmjrSdkFEACpC",
+	"JRM8A6FoYRRXcwq7e6LEAbhIaAGsaAIyJELRFYnUcUINuKP0qAxFzkKZRxFIucoTX9ofkuUov2qWeAEU",
+	"TUEqkmanXL0sAcus6Uao+Hapwff7atx+LmhUBWiYOai75d5xxfzhK0RKi9FkYVyZp5r65PbyZvxxEl7O",
+	"J+Pl5AoH5cpiObu7q61cTRbL+eyfZm0xmX+cXk7C8dVV7XmxHM+XjRVHx61czseL98XKcjy/Gc9/Decf",
+	"bquo1eXr6e3Ugl9PbyaLcDxfTq/Hl8tS5vug7S+7TZt+oRbDiV3tW+pe5vqGDw3TKiWfl+qdRUtw/Zso",
+	"Lno2KDMHrjWA7yrMiFIgmH83qQpbMmogdsg8q8jmIutqNlmEl7Pb5Xh6Gy4nvy1xUKzdzpbedbf2j/Hy",
+	"8n04n7yb/OZDqb72eb5Wa1tWjHhs3L/iIiUKj3BOmfrpLQ5aLUiAU5BSbzrHg6Rf1e9Ka8cjKCTz2bhG",
+	"ppq68/lsjgM8vb2e4QB/Gs9vp7fvvDZZFOXtxvZRdZMwrsIVz5luDzw9Re88cdi6DQsfdmGzppI4pnrL",
+	"IsldjX+PYMb7ll32Hku5xmFi9tCWouCWOYPZCo8+d/N21KZMgcgEKLPzF7T3QT/cjySh8QvwJq6TsWj3",
+	"zfApdLnvMkKdRNsa7n0IHeYKjyeBT6IS/N7nsBp4g32XKv5UpkdWpRJ5VPRGPpT+GtWgTypUZdwDeMVP",
+	"qNyhBhHrPHWfw72+Wj1kx5aIL5UL55CHBMKGPdsd2OH98faKylA+0iyD2N+fZVxSx+FMNe4caoc/CsGC",
+	"it2OqliTtaeDSkv6HNVpFAGZAAlMl5YtHO1dKUnovyAONbktSfIesevF8vHsqeNdxUPNHTXJU1bbU49v",
+	"qbqvP2oQ15qdpNPQtSQauEbLytRTtznIPFGdpSQUR2AqdvaD93aTB/2cmnMcJ8BdO5lH6yrQj9kcvDJ0",
+	"aTbP2TVlVG4gLr+S6wLrz8SVBQnBD9P/a9I5jecqy3v4uU35pA08Ap+wwJ3gawHS0+Jl9k3o3zOjXAhg",
+	"KpQKshKkf+NXQ2d5+gCiVxoHWHFFEoMnX5L4bbnrJP2inbR83VonjO66c/e12TibsYCo7MaQQ8DB2X3o",
+	"YZvq3Uee1XVWY2gfnC2VraNncKvnbF/ET0SwIhT7iqhP3e4rfmu25a2M2JYAP6YAtvh3RaGzR0vGpxMv",
+	"+ovfRDipgGPtk3tZPVApcz4mCt4oarZxz+msK0uKqkS/+zUXiksq0XyyWK7yBI3vpjjAWxCySL2Lwd8H",
+	"F5odz4CRjOIR/mlwMbjAgTk+NnYY2qMz/bAPDo/DDZWKi11z+bl967LvAzN0J5nyPOhhwiOSvNENzpmI",
+	"AlKu4CWY9rNdDp/bdxRnKjt8dj9fm8Yw5k8s4STuRczdH63Bc3z+DhRK80TRLCmv3Nz1j0QaVV/52I0k",
+	"2Q3QckMlAhZnnDKFIsKQVAJIak7RDfzDDgFVGxBIKi09W39hBH2CB8mjR1CaHgNTMtFfBejbI2AxxH/T",
+	"Nx0JrEm0Q++XyztLl7L1AAf2WI5yNo0LqSdWZ/t/Wip8U9yDVe9Jj2wxB5Bh225H62sFq3Vi1AOneqvY",
+	"A9x3a9sDrXkv2AOlfj25v29cCb29uHi1C6HqgZznPmhR9onIiYAN0IrYzwof8VLaYXF7penKPE2J2BUR",
+	"48L7L7Ie4DjAiugU+YzLkMPmyOlEYjkqvbKwLCyH8D8P74yi/ApVrJPC0F75vgIlV0nk8DnjQtkudT8k",
+	"W0IT8kATql5B5dM10CKZgPg/KHI2g6T9/0Or33lYf9S/H1b/qjH+8qJn2+5+SWmBh3aCQb4Ma/hsf4U0",
+	"3p9HorBrb74qP4CuKYOhbbzNytYdOnuryKKoEE+HKQRZDhdYtmgleIoYf0KcjQ6rkQCiIA6QVFyfvCI9",
+	"TRODVILv9LJ+VIepHBl8YeWXtciZNABmZgCVjSOiTNIYNF7arGeFw+Vh7sGUM1fHUKWMfWGHOuYvUFt7",
+	"oNwoOHXTTFdIggoQd0MYlquZKaLSWaKYvDDCQYwD70CPd/CrY1LoLFFAghkBkb0k0YihAT9/jq419tCa",
+	"Mfpv1p8K9/9V/flEVLSxxu6oPWXelhdkekP3Tzid2NUXjR1bD1exw3RVSauYG3oCAYgyqqhORZRLnQm/",
+	"C1CCwtbe6hK5Y9Hvgy9MD3WZh4Ky7g8ezGiczFOIi9jiLAKTmPA9owIQWSkQFsaIqDuBt2jDcyHdSwHG",
+	"m4bD+Q0I6tl/fGH9G5DWdapsrXzIafyiruOIW//zrfesO8HmIWY7E7vz41sOUr3K9uwJza6d2s4c+Cvu",
+	"jT45QcC2VHBmbucCnIsEj/BGqWw0tNvbwJywbLhUI9N+74cko/ociQiqLweL43MubHpZBfEvP//8Cw7K",
+	"UQzzaCRqinEneFycjqLLhOfxUYlkKdKb5+J/keKDSKMNHu1x1yDiqU/ECkpd0ovKn3bl/f7fAwAFlOH3",
+	"UC0AAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,172 @@
+package tls_credentials
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"time"
+
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	certificatePemBlockType = "CERTIFICATE"
+	ecKeyPemBlockType       = "EC PRIVATE KEY"
+	pkcs8KeyPemBlockType    = "PRIVATE KEY"
+
+	// Tolerates clocks of the hosts the certificates get used on running a bit behind the issuer's
+	certificateBackdating = time.Hour
+
+	serialNumberBits = 128
+)
+
+// CertificateIssuer signs server and client certificates with a CA
+type CertificateIssuer struct {
+	caCertificatePem []byte
+
+	caCertificate *x509.Certificate
+
+	caKey crypto.Signer
+}
+
+// NewCertificateIssuer returns an issuer signing with the given PEM-encoded CA certificate and key
+func NewCertificateIssuer(caCertificatePem []byte, caKeyPem []byte) (*CertificateIssuer, error) {
+	// Parsing them as a key pair checks that the key belongs to the certificate, whatever format the key is in
+	caKeyPair, err := tls.X509KeyPair(caCertificatePem, caKeyPem)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the CA certificate and key")
+	}
+	caCertificate, err := x509.ParseCertificate(caKeyPair.Certificate[0])
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing the CA certificate")
+	}
+	if !caCertificate.IsCA {
+		return nil, stacktrace.NewError("The CA certificate with subject '%v' isn't allowed to sign certificates", caCertificate.Subject.String())
+	}
+	caKey, ok := caKeyPair.PrivateKey.(crypto.Signer)
+	if !ok {
+		return nil, stacktrace.NewError("The CA key can't be used to sign certificates")
+	}
+	return &CertificateIssuer{
+		caCertificatePem: caCertificatePem,
+		caCertificate:    caCertificate,
+		caKey:            caKey,
+	}, nil
+}
+
+// NewSelfSignedCertificateIssuer generates a new CA and returns an issuer signing with it
+func NewSelfSignedCertificateIssuer(caCommonName string, caValidity time.Duration) (*CertificateIssuer, error) {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred generating the CA key")
+	}
+	caTemplate, err := newCertificateTemplate(caCommonName, caValidity)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the CA certificate template")
+	}
+	caTemplate.IsCA = true
+	caTemplate.BasicConstraintsValid = true
+	caTemplate.KeyUsage = x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature
+	caCertificateDer, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the CA certificate")
+	}
+	caCertificate, err := x509.ParseCertificate(caCertificateDer)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing the CA certificate that was just created")
+	}
+	return &CertificateIssuer{
+		caCertificatePem: encodePem(certificatePemBlockType, caCertificateDer),
+		caCertificate:    caCertificate,
+		caKey:            caKey,
+	}, nil
+}
+
+func (issuer *CertificateIssuer) GetCaCertificatePem() []byte {
+	return issuer.caCertificatePem
+}
+
+// GetCaKeyPem returns the PEM-encoded key of the CA, for issuers whose CA has to be kept around
+func (issuer *CertificateIssuer) GetCaKeyPem() ([]byte, error) {
+	caKeyDer, err := x509.MarshalPKCS8PrivateKey(issuer.caKey)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred serializing the CA key")
+	}
+	return encodePem(pkcs8KeyPemBlockType, caKeyDer), nil
+}
+
+// IssueServerCertificate returns a PEM-encoded certificate and key for a Kurtosis server, with ServerName as DNS name
+func (issuer *CertificateIssuer) IssueServerCertificate(commonName string, validity time.Duration) ([]byte, []byte, error) {
+	template, err := newCertificateTemplate(commonName, validity)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred creating the server certificate template")
+	}
+	template.DNSNames = []string{ServerName}
+	template.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
+	certificatePem, keyPem, err := issuer.issue(template)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred issuing server certificate '%v'", commonName)
+	}
+	return certificatePem, keyPem, nil
+}
+
+// IssueClientCertificate returns a PEM-encoded client certificate and key
+func (issuer *CertificateIssuer) IssueClientCertificate(commonName string, validity time.Duration) ([]byte, []byte, error) {
+	template, err := newCertificateTemplate(commonName, validity)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred creating the client certificate template")
+	}
+	template.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
+	certificatePem, keyPem, err := issuer.issue(template)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred issuing client certificate '%v'", commonName)
+	}
+	return certificatePem, keyPem, nil
+}
+
+func (issuer *CertificateIssuer) issue(template *x509.Certificate) ([]byte, []byte, error) {
+	// A certificate outliving its CA wouldn't be trusted past the CA's expiry anyway
+	if template.NotAfter.After(issuer.caCertificate.NotAfter) {
+		template.NotAfter = issuer.caCertificate.NotAfter
+	}
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred generating the key")
+	}
+	certificateDer, err := x509.CreateCertificate(rand.Reader, template, issuer.caCertificate, &key.PublicKey, issuer.caKey)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred signing the certificate")
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred serializing the key")
+	}
+	return encodePem(certificatePemBlockType, certificateDer), encodePem(ecKeyPemBlockType, keyDer), nil
+}
+
+func newCertificateTemplate(commonName string, validity time.Duration) (*x509.Certificate, error) {
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), serialNumberBits))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred generating a certificate serial number")
+	}
+	now := time.Now()
+	// nolint:exhaustruct
+	return &x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    now.Add(-certificateBackdating),
+		NotAfter:     now.Add(validity),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}, nil
+}
+
+func encodePem(blockType string, der []byte) []byte {
+	// nolint:exhaustruct
+	return pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+}
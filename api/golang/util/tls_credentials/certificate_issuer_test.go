@@ -0,0 +1,88 @@
+package tls_credentials
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testCaValidity          = 24 * time.Hour
+	testCertificateValidity = time.Hour
+)
+
+func TestCertificateIssuer_IssuedCertificatesChainToTheCa(t *testing.T) {
+	issuer, err := NewSelfSignedCertificateIssuer("test CA", testCaValidity)
+	require.NoError(t, err)
+	caCertPool, err := NewCertPool(issuer.GetCaCertificatePem())
+	require.NoError(t, err)
+
+	serverCertificatePem, serverKeyPem, err := issuer.IssueServerCertificate("server", testCertificateValidity)
+	require.NoError(t, err)
+	serverCertificate := parseCertificate(t, serverCertificatePem, serverKeyPem)
+	_, err = serverCertificate.Verify(x509.VerifyOptions{
+		DNSName:   ServerName,
+		Roots:     caCertPool,
+		KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	})
+	require.NoError(t, err)
+
+	clientCertificatePem, clientKeyPem, err := issuer.IssueClientCertificate("client", testCertificateValidity)
+	require.NoError(t, err)
+	clientCertificate := parseCertificate(t, clientCertificatePem, clientKeyPem)
+	_, err = clientCertificate.Verify(x509.VerifyOptions{
+		Roots:     caCertPool,
+		KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	require.NoError(t, err)
+	require.WithinDuration(t, time.Now().Add(testCertificateValidity), clientCertificate.NotAfter, time.Minute)
+}
+
+func TestCertificateIssuer_CertificatesDontOutliveTheCa(t *testing.T) {
+	issuer, err := NewSelfSignedCertificateIssuer("test CA", testCertificateValidity)
+	require.NoError(t, err)
+
+	certificatePem, keyPem, err := issuer.IssueClientCertificate("client", testCaValidity)
+	require.NoError(t, err)
+	require.Equal(t, issuer.caCertificate.NotAfter, parseCertificate(t, certificatePem, keyPem).NotAfter)
+}
+
+func TestNewCertificateIssuer_RoundTripsTheCa(t *testing.T) {
+	issuer, err := NewSelfSignedCertificateIssuer("test CA", testCaValidity)
+	require.NoError(t, err)
+	caKeyPem, err := issuer.GetCaKeyPem()
+	require.NoError(t, err)
+
+	reloadedIssuer, err := NewCertificateIssuer(issuer.GetCaCertificatePem(), caKeyPem)
+	require.NoError(t, err)
+	require.Equal(t, issuer.caCertificate.Raw, reloadedIssuer.caCertificate.Raw)
+
+	otherIssuer, err := NewSelfSignedCertificateIssuer("other CA", testCaValidity)
+	require.NoError(t, err)
+	_, err = NewCertificateIssuer(otherIssuer.GetCaCertificatePem(), caKeyPem)
+	require.Error(t, err)
+}
+
+func TestNewCertificateIssuer_RejectsNonCaCertificates(t *testing.T) {
+	issuer, err := NewSelfSignedCertificateIssuer("test CA", testCaValidity)
+	require.NoError(t, err)
+	certificatePem, keyPem, err := issuer.IssueClientCertificate("client", testCertificateValidity)
+	require.NoError(t, err)
+
+	_, err = NewCertificateIssuer(certificatePem, keyPem)
+	require.Error(t, err)
+}
+
+func parseCertificate(t *testing.T, certificatePem []byte, keyPem []byte) *x509.Certificate {
+	_, err := tls.X509KeyPair(certificatePem, keyPem)
+	require.NoError(t, err)
+	certificateBlock, _ := pem.Decode(certificatePem)
+	require.NotNil(t, certificateBlock)
+	certificate, err := x509.ParseCertificate(certificateBlock.Bytes)
+	require.NoError(t, err)
+	return certificate
+}
@@ -0,0 +1,82 @@
+package tls_credentials
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+
+	"github.com/kurtosis-tech/stacktrace"
+	"google.golang.org/grpc/credentials"
+)
+
+const (
+	// ServerName is the name clients check the engine and API container certificates against, whatever address they
+	// dialled; those servers are reached through container IPs, port forwards and gateways, so their address can't be
+	// known when their certificate is issued. Certificates used by Kurtosis servers must have it as a DNS name
+	ServerName = "kurtosis"
+)
+
+// NewClientTlsConfig returns the TLS config of a client that trusts the servers whose certificate was signed by the
+// given CA. If a client certificate and key are given, they're presented to servers that require one
+func NewClientTlsConfig(caCertificatePem []byte, clientCertificatePem []byte, clientKeyPem []byte) (*tls.Config, error) {
+	certPool, err := NewCertPool(caCertificatePem)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the CA certificate")
+	}
+
+	// nolint:exhaustruct
+	tlsConfig := &tls.Config{
+		RootCAs:    certPool,
+		ServerName: ServerName,
+		MinVersion: tls.VersionTLS12,
+	}
+	if len(clientCertificatePem) > 0 || len(clientKeyPem) > 0 {
+		clientCertificate, err := tls.X509KeyPair(clientCertificatePem, clientKeyPem)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred reading the client certificate and key")
+		}
+		tlsConfig.Certificates = []tls.Certificate{clientCertificate}
+	}
+	return tlsConfig, nil
+}
+
+// NewClientTransportCredentials is NewClientTlsConfig for gRPC clients
+func NewClientTransportCredentials(caCertificatePem []byte, clientCertificatePem []byte, clientKeyPem []byte) (credentials.TransportCredentials, error) {
+	tlsConfig, err := NewClientTlsConfig(caCertificatePem, clientCertificatePem, clientKeyPem)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the client TLS config")
+	}
+	return credentials.NewTLS(tlsConfig), nil
+}
+
+// NewServerTlsConfig returns the TLS config of a server presenting the given certificate. If client certificates are
+// required, only clients whose certificate was signed by the given CA are let in
+func NewServerTlsConfig(caCertificatePem []byte, serverCertificatePem []byte, serverKeyPem []byte, requireClientCertificate bool) (*tls.Config, error) {
+	serverCertificate, err := tls.X509KeyPair(serverCertificatePem, serverKeyPem)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the server certificate and key")
+	}
+
+	// nolint:exhaustruct
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{serverCertificate},
+		ClientAuth:   tls.NoClientCert,
+		MinVersion:   tls.VersionTLS12,
+	}
+	if requireClientCertificate {
+		certPool, err := NewCertPool(caCertificatePem)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred reading the CA certificate")
+		}
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+		tlsConfig.ClientCAs = certPool
+	}
+	return tlsConfig, nil
+}
+
+func NewCertPool(caCertificatePem []byte) (*x509.CertPool, error) {
+	certPool := x509.NewCertPool()
+	if !certPool.AppendCertsFromPEM(caCertificatePem) {
+		return nil, stacktrace.NewError("No PEM-encoded certificate could be found in the CA certificate")
+	}
+	return certPool, nil
+}
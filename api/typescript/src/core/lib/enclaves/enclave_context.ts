@@ -52,6 +52,11 @@ const DOT_RELATIVE_PATH_INDICATOR_STRING = "."
 // required to get around the "only Github URLs" validation
 const composePackageIdPlaceholder = 'github.com/NOTIONAL_USER/COMPOSE-PACKAGE'
 
+const NO_API_TOKEN = ""
+// gRPC metadata keys are lowercase; the API container reads the same Authorization header as the engine
+const API_TOKEN_METADATA_KEY = "authorization"
+const BEARER_TOKEN_PREFIX = "Bearer "
+
 
 // TODO Remove this once package ID is detected ONLY the APIC side (i.e. the CLI doesn't need to tell the APIC what package ID it's using)
 // Doing so requires that we upload completely anonymous packages to the APIC, and it figures things out from there
@@ -83,6 +88,8 @@ export class EnclaveContext {
         apiContainerGrpcPortNum: number,
         enclaveUuid: string,
         enclaveName: string,
+        // Sent with every call, for API containers that authenticate their callers. Empty means calls aren't authenticated
+        apiToken: string = NO_API_TOKEN,
     ): Promise<Result<EnclaveContext, Error>> {
 
         let genericApiContainerClient: GenericApiContainerClient
@@ -95,7 +102,17 @@ export class EnclaveContext {
             const apiContainerServiceNode = await import( /* webpackIgnore: true */ "../../kurtosis_core_rpc_api_bindings/api_container_service_grpc_pb")
 
             const apiContainerGrpcUrl: string = `${ipAddress}:${apiContainerGrpcPortNum}`
-            const apiContainerClient = new apiContainerServiceNode.ApiContainerServiceClient(apiContainerGrpcUrl, grpc_node.credentials.createInsecure());
+            const interceptors: any[] = []
+            if (apiToken !== NO_API_TOKEN) {
+                // Call credentials can't be combined with an insecure channel, so the token is added by an interceptor
+                interceptors.push((options: any, nextCall: any) => new grpc_node.InterceptingCall(nextCall(options), {
+                    start: (metadata, listener, next) => {
+                        metadata.set(API_TOKEN_METADATA_KEY, `${BEARER_TOKEN_PREFIX}${apiToken}`)
+                        next(metadata, listener)
+                    },
+                }))
+            }
+            const apiContainerClient = new apiContainerServiceNode.ApiContainerServiceClient(apiContainerGrpcUrl, grpc_node.credentials.createInsecure(), { interceptors });
             genericApiContainerClient = new GrpcNodeApiContainerClient(apiContainerClient, enclaveUuid, enclaveName)
 
             const nodeTgzArchiver = await import(/* webpackIgnore: true */ "./node_tgz_archiver")
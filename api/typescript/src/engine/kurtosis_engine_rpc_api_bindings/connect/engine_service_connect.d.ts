@@ -4,7 +4,7 @@
 // @ts-nocheck
 
 import { Empty, MethodKind } from "@bufbuild/protobuf";
-import { CleanArgs, CleanResponse, CreateEnclaveArgs, CreateEnclaveResponse, DestroyEnclaveArgs, GetEnclavesByUuidsArgs, GetEnclavesResponse, GetEngineInfoResponse, GetExistingAndHistoricalEnclaveIdentifiersResponse, GetServiceLogsArgs, GetServiceLogsResponse, StopEnclaveArgs } from "./engine_service_pb.js";
+import { AddScheduleArgs, AddScheduleResponse, CleanArgs, CleanResponse, CreateEnclaveArgs, CreateEnclaveResponse, DestroyEnclaveArgs, GetEnclavesByUuidsArgs, GetEnclavesResponse, GetEngineInfoResponse, GetExistingAndHistoricalEnclaveIdentifiersResponse, GetSchedulesResponse, GetServiceLogsArgs, GetServiceLogsResponse, RemoveScheduleArgs, StopEnclaveArgs } from "./engine_service_pb.js";
 
 /**
  * @generated from service engine_api.EngineService
@@ -114,6 +114,39 @@ export declare const EngineService: {
       readonly O: typeof GetServiceLogsResponse,
       readonly kind: MethodKind.ServerStreaming,
     },
+    /**
+     * Makes the engine run a package every time a cron expression matches
+     *
+     * @generated from rpc engine_api.EngineService.AddSchedule
+     */
+    readonly addSchedule: {
+      readonly name: "AddSchedule",
+      readonly I: typeof AddScheduleArgs,
+      readonly O: typeof AddScheduleResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * Returns all the schedules along with the outcome of their latest runs
+     *
+     * @generated from rpc engine_api.EngineService.GetSchedules
+     */
+    readonly getSchedules: {
+      readonly name: "GetSchedules",
+      readonly I: typeof Empty,
+      readonly O: typeof GetSchedulesResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * Stops a schedule from running again
+     *
+     * @generated from rpc engine_api.EngineService.RemoveSchedule
+     */
+    readonly removeSchedule: {
+      readonly name: "RemoveSchedule",
+      readonly I: typeof RemoveScheduleArgs,
+      readonly O: typeof Empty,
+      readonly kind: MethodKind.Unary,
+    },
   }
 };
 
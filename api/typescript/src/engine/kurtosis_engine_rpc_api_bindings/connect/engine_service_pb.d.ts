@@ -814,3 +814,315 @@ export declare class LogLineFilter extends Message<LogLineFilter> {
   static equals(a: LogLineFilter | PlainMessage<LogLineFilter> | undefined, b: LogLineFilter | PlainMessage<LogLineFilter> | undefined): boolean;
 }
 
+/**
+ * ==============================================================================================
+ *                                        Scheduled Runs
+ * ==============================================================================================
+ * NOTE: We have to prefix the enum values with the enum name due to the way Protobuf enum value uniqueness works
+ *
+ * @generated from enum engine_api.ScheduleEnclaveMode
+ */
+export declare enum ScheduleEnclaveMode {
+  /**
+   * Every run creates a new enclave
+   *
+   * @generated from enum value: ScheduleEnclaveMode_FRESH = 0;
+   */
+  ScheduleEnclaveMode_FRESH = 0,
+
+  /**
+   * Every run happens in the same enclave, which is created by the first run if it doesn't exist
+   *
+   * @generated from enum value: ScheduleEnclaveMode_REUSE = 1;
+   */
+  ScheduleEnclaveMode_REUSE = 1,
+}
+
+/**
+ * NOTE: We have to prefix the enum values with the enum name due to the way Protobuf enum value uniqueness works
+ *
+ * @generated from enum engine_api.ScheduledRunStatus
+ */
+export declare enum ScheduledRunStatus {
+  /**
+   * @generated from enum value: ScheduledRunStatus_RUNNING = 0;
+   */
+  ScheduledRunStatus_RUNNING = 0,
+
+  /**
+   * @generated from enum value: ScheduledRunStatus_SUCCEEDED = 1;
+   */
+  ScheduledRunStatus_SUCCEEDED = 1,
+
+  /**
+   * @generated from enum value: ScheduledRunStatus_FAILED = 2;
+   */
+  ScheduledRunStatus_FAILED = 2,
+}
+
+/**
+ * @generated from message engine_api.AddScheduleArgs
+ */
+export declare class AddScheduleArgs extends Message<AddScheduleArgs> {
+  /**
+   * Standard five-field cron expression, evaluated in UTC
+   *
+   * @generated from field: string cron_expression = 1;
+   */
+  cronExpression: string;
+
+  /**
+   * @generated from field: string package_id = 2;
+   */
+  packageId: string;
+
+  /**
+   * If unset, the package runs with its default params
+   *
+   * @generated from field: optional string serialized_params = 3;
+   */
+  serializedParams?: string;
+
+  /**
+   * @generated from field: engine_api.ScheduleEnclaveMode enclave_mode = 4;
+   */
+  enclaveMode: ScheduleEnclaveMode;
+
+  /**
+   * The name of the enclave to reuse, or the prefix of the names of the fresh enclaves; required when reusing an enclave
+   *
+   * @generated from field: optional string enclave_name = 5;
+   */
+  enclaveName?: string;
+
+  /**
+   * Whether each fresh enclave is destroyed once its run is over; not allowed when reusing an enclave
+   *
+   * @generated from field: optional bool should_destroy_enclave = 6;
+   */
+  shouldDestroyEnclave?: boolean;
+
+  constructor(data?: PartialMessage<AddScheduleArgs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.AddScheduleArgs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddScheduleArgs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AddScheduleArgs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AddScheduleArgs;
+
+  static equals(a: AddScheduleArgs | PlainMessage<AddScheduleArgs> | undefined, b: AddScheduleArgs | PlainMessage<AddScheduleArgs> | undefined): boolean;
+}
+
+/**
+ * @generated from message engine_api.AddScheduleResponse
+ */
+export declare class AddScheduleResponse extends Message<AddScheduleResponse> {
+  /**
+   * @generated from field: engine_api.Schedule schedule = 1;
+   */
+  schedule?: Schedule;
+
+  constructor(data?: PartialMessage<AddScheduleResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.AddScheduleResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddScheduleResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AddScheduleResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AddScheduleResponse;
+
+  static equals(a: AddScheduleResponse | PlainMessage<AddScheduleResponse> | undefined, b: AddScheduleResponse | PlainMessage<AddScheduleResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message engine_api.GetSchedulesResponse
+ */
+export declare class GetSchedulesResponse extends Message<GetSchedulesResponse> {
+  /**
+   * @generated from field: repeated engine_api.Schedule schedules = 1;
+   */
+  schedules: Schedule[];
+
+  constructor(data?: PartialMessage<GetSchedulesResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.GetSchedulesResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSchedulesResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSchedulesResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSchedulesResponse;
+
+  static equals(a: GetSchedulesResponse | PlainMessage<GetSchedulesResponse> | undefined, b: GetSchedulesResponse | PlainMessage<GetSchedulesResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message engine_api.RemoveScheduleArgs
+ */
+export declare class RemoveScheduleArgs extends Message<RemoveScheduleArgs> {
+  /**
+   * The UUID or shortened UUID of the schedule to remove
+   *
+   * @generated from field: string schedule_identifier = 1;
+   */
+  scheduleIdentifier: string;
+
+  constructor(data?: PartialMessage<RemoveScheduleArgs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.RemoveScheduleArgs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveScheduleArgs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveScheduleArgs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveScheduleArgs;
+
+  static equals(a: RemoveScheduleArgs | PlainMessage<RemoveScheduleArgs> | undefined, b: RemoveScheduleArgs | PlainMessage<RemoveScheduleArgs> | undefined): boolean;
+}
+
+/**
+ * @generated from message engine_api.Schedule
+ */
+export declare class Schedule extends Message<Schedule> {
+  /**
+   * @generated from field: string schedule_uuid = 1;
+   */
+  scheduleUuid: string;
+
+  /**
+   * @generated from field: string shortened_uuid = 2;
+   */
+  shortenedUuid: string;
+
+  /**
+   * @generated from field: string cron_expression = 3;
+   */
+  cronExpression: string;
+
+  /**
+   * @generated from field: string package_id = 4;
+   */
+  packageId: string;
+
+  /**
+   * @generated from field: string serialized_params = 5;
+   */
+  serializedParams: string;
+
+  /**
+   * @generated from field: engine_api.ScheduleEnclaveMode enclave_mode = 6;
+   */
+  enclaveMode: ScheduleEnclaveMode;
+
+  /**
+   * The name of the reused enclave, or the prefix of the names of the fresh enclaves
+   *
+   * @generated from field: string enclave_name = 7;
+   */
+  enclaveName: string;
+
+  /**
+   * @generated from field: bool should_destroy_enclave = 8;
+   */
+  shouldDestroyEnclave: boolean;
+
+  /**
+   * The engine API user who added the schedule; blank if authentication is turned off
+   *
+   * @generated from field: string owner = 9;
+   */
+  owner: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp creation_time = 10;
+   */
+  creationTime?: Timestamp;
+
+  /**
+   * Unset if the cron expression never matches again
+   *
+   * @generated from field: optional google.protobuf.Timestamp next_run_time = 11;
+   */
+  nextRunTime?: Timestamp;
+
+  /**
+   * Oldest first
+   *
+   * @generated from field: repeated engine_api.ScheduledRun recent_runs = 12;
+   */
+  recentRuns: ScheduledRun[];
+
+  constructor(data?: PartialMessage<Schedule>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.Schedule";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Schedule;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Schedule;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Schedule;
+
+  static equals(a: Schedule | PlainMessage<Schedule> | undefined, b: Schedule | PlainMessage<Schedule> | undefined): boolean;
+}
+
+/**
+ * @generated from message engine_api.ScheduledRun
+ */
+export declare class ScheduledRun extends Message<ScheduledRun> {
+  /**
+   * @generated from field: google.protobuf.Timestamp start_time = 1;
+   */
+  startTime?: Timestamp;
+
+  /**
+   * Unset while the run is in progress
+   *
+   * @generated from field: optional google.protobuf.Timestamp end_time = 2;
+   */
+  endTime?: Timestamp;
+
+  /**
+   * @generated from field: string enclave_name = 3;
+   */
+  enclaveName: string;
+
+  /**
+   * @generated from field: engine_api.ScheduledRunStatus status = 4;
+   */
+  status: ScheduledRunStatus;
+
+  /**
+   * Why the run failed; blank if it didn't
+   *
+   * @generated from field: string error_message = 5;
+   */
+  errorMessage: string;
+
+  constructor(data?: PartialMessage<ScheduledRun>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.ScheduledRun";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ScheduledRun;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ScheduledRun;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ScheduledRun;
+
+  static equals(a: ScheduledRun | PlainMessage<ScheduledRun> | undefined, b: ScheduledRun | PlainMessage<ScheduledRun> | undefined): boolean;
+}
+
@@ -315,3 +315,113 @@ export const LogLineFilter = proto3.makeMessageType(
   ],
 );
 
+/**
+ * ==============================================================================================
+ *                                        Scheduled Runs
+ * ==============================================================================================
+ * NOTE: We have to prefix the enum values with the enum name due to the way Protobuf enum value uniqueness works
+ *
+ * @generated from enum engine_api.ScheduleEnclaveMode
+ */
+export const ScheduleEnclaveMode = proto3.makeEnum(
+  "engine_api.ScheduleEnclaveMode",
+  [
+    {no: 0, name: "ScheduleEnclaveMode_FRESH"},
+    {no: 1, name: "ScheduleEnclaveMode_REUSE"},
+  ],
+);
+
+/**
+ * NOTE: We have to prefix the enum values with the enum name due to the way Protobuf enum value uniqueness works
+ *
+ * @generated from enum engine_api.ScheduledRunStatus
+ */
+export const ScheduledRunStatus = proto3.makeEnum(
+  "engine_api.ScheduledRunStatus",
+  [
+    {no: 0, name: "ScheduledRunStatus_RUNNING"},
+    {no: 1, name: "ScheduledRunStatus_SUCCEEDED"},
+    {no: 2, name: "ScheduledRunStatus_FAILED"},
+  ],
+);
+
+/**
+ * @generated from message engine_api.AddScheduleArgs
+ */
+export const AddScheduleArgs = proto3.makeMessageType(
+  "engine_api.AddScheduleArgs",
+  () => [
+    { no: 1, name: "cron_expression", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "package_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "serialized_params", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "enclave_mode", kind: "enum", T: proto3.getEnumType(ScheduleEnclaveMode) },
+    { no: 5, name: "enclave_name", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 6, name: "should_destroy_enclave", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
+  ],
+);
+
+/**
+ * @generated from message engine_api.AddScheduleResponse
+ */
+export const AddScheduleResponse = proto3.makeMessageType(
+  "engine_api.AddScheduleResponse",
+  () => [
+    { no: 1, name: "schedule", kind: "message", T: Schedule },
+  ],
+);
+
+/**
+ * @generated from message engine_api.GetSchedulesResponse
+ */
+export const GetSchedulesResponse = proto3.makeMessageType(
+  "engine_api.GetSchedulesResponse",
+  () => [
+    { no: 1, name: "schedules", kind: "message", T: Schedule, repeated: true },
+  ],
+);
+
+/**
+ * @generated from message engine_api.RemoveScheduleArgs
+ */
+export const RemoveScheduleArgs = proto3.makeMessageType(
+  "engine_api.RemoveScheduleArgs",
+  () => [
+    { no: 1, name: "schedule_identifier", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message engine_api.Schedule
+ */
+export const Schedule = proto3.makeMessageType(
+  "engine_api.Schedule",
+  () => [
+    { no: 1, name: "schedule_uuid", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "shortened_uuid", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "cron_expression", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "package_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "serialized_params", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "enclave_mode", kind: "enum", T: proto3.getEnumType(ScheduleEnclaveMode) },
+    { no: 7, name: "enclave_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "should_destroy_enclave", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 9, name: "owner", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 10, name: "creation_time", kind: "message", T: Timestamp },
+    { no: 11, name: "next_run_time", kind: "message", T: Timestamp, opt: true },
+    { no: 12, name: "recent_runs", kind: "message", T: ScheduledRun, repeated: true },
+  ],
+);
+
+/**
+ * @generated from message engine_api.ScheduledRun
+ */
+export const ScheduledRun = proto3.makeMessageType(
+  "engine_api.ScheduledRun",
+  () => [
+    { no: 1, name: "start_time", kind: "message", T: Timestamp },
+    { no: 2, name: "end_time", kind: "message", T: Timestamp, opt: true },
+    { no: 3, name: "enclave_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "status", kind: "enum", T: proto3.getEnumType(ScheduledRunStatus) },
+    { no: 5, name: "error_message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
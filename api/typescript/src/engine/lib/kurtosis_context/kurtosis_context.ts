@@ -47,16 +47,30 @@ const DEFAULT_API_CONTAINER_VERSION_TAG = "";
 const DEFAULT_SHOULD_APIC_RUN_IN_DEBUG_MODE = false
 const RUN_APIC_IN_DEBUG_MODE = true
 
+const NO_ENGINE_API_TOKEN = ""
+// gRPC metadata keys are lowercase; the engine reads this as the HTTP Authorization header
+const ENGINE_API_TOKEN_METADATA_KEY = "authorization"
+const BEARER_TOKEN_PREFIX = "Bearer "
+
 // Docs available at https://docs.kurtosis.com/sdk#kurtosiscontext
 export class KurtosisContext {
     private readonly client: GenericEngineClient
+    // Also sent to the API containers of the enclaves, which accept the same tokens as the engine
+    private readonly engineApiToken: string
 
-    constructor(client: GenericEngineClient){
+    constructor(client: GenericEngineClient, engineApiToken: string = NO_ENGINE_API_TOKEN){
         this.client = client;
+        this.engineApiToken = engineApiToken;
     }
 
     // Attempts to create a KurtosisContext connected to a Kurtosis engine running locally
     public static async newKurtosisContextFromLocalEngine():Promise<Result<KurtosisContext, Error>>  {
+        return KurtosisContext.newKurtosisContextFromLocalEngineWithApiToken(NO_ENGINE_API_TOKEN)
+    }
+
+    // Same as newKurtosisContextFromLocalEngine, but sends the given API token with every call, for engines that require
+    // authentication. An empty token means calls aren't authenticated
+    public static async newKurtosisContextFromLocalEngineWithApiToken(engineApiToken: string):Promise<Result<KurtosisContext, Error>>  {
         let genericEngineClient: GenericEngineClient
         try {
             //These imports are dynamically imported here, otherwise compiling in Web environment fails for 2 reasons:
@@ -69,7 +83,17 @@ export class KurtosisContext {
             const engineServiceNode = await import( /* webpackIgnore: true */ "../../kurtosis_engine_rpc_api_bindings/engine_service_grpc_pb")
 
             const kurtosisEngineSocketStr: string = `${LOCAL_HOSTNAME}:${DEFAULT_GRPC_ENGINE_SERVER_PORT_NUM}`
-            const engineServiceClientNode = new engineServiceNode.EngineServiceClient(kurtosisEngineSocketStr, grpc_node.credentials.createInsecure())
+            const interceptors: any[] = []
+            if (engineApiToken !== NO_ENGINE_API_TOKEN) {
+                // Call credentials can't be combined with an insecure channel, so the token is added by an interceptor
+                interceptors.push((options: any, nextCall: any) => new grpc_node.InterceptingCall(nextCall(options), {
+                    start: (metadata, listener, next) => {
+                        metadata.set(ENGINE_API_TOKEN_METADATA_KEY, `${BEARER_TOKEN_PREFIX}${engineApiToken}`)
+                        next(metadata, listener)
+                    },
+                }))
+            }
+            const engineServiceClientNode = new engineServiceNode.EngineServiceClient(kurtosisEngineSocketStr, grpc_node.credentials.createInsecure(), { interceptors })
             genericEngineClient = new GrpcNodeEngineClient(engineServiceClientNode)
         } catch(error) {
             if (error instanceof Error) {
@@ -85,7 +109,7 @@ export class KurtosisContext {
             return err(engineApiVersionValidationResult.error)
         }
 
-        const kurtosisContext = new KurtosisContext(genericEngineClient)
+        const kurtosisContext = new KurtosisContext(genericEngineClient, engineApiToken)
         return ok(kurtosisContext)
     }
 
@@ -299,6 +323,7 @@ export class KurtosisContext {
             apiContainerHostMachineInfo.getGrpcPortOnHostMachine(),
             enclaveInfo.getEnclaveUuid(),
             enclaveInfo.getName(),
+            this.engineApiToken,
         )
         if(newEnclaveContextResult.isErr()){
             return err(newEnclaveContextResult.error)
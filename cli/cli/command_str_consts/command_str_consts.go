@@ -24,6 +24,7 @@ const (
 	ContextLsCmdStr         = "ls"
 	ContextRmCmdStr         = "rm"
 	ContextSetCmdStr        = "set"
+	ContextSetTokenCmdStr   = "set-token"
 	DiscordCmdStr           = "discord"
 	DocsCmdStr              = "docs"
 	EnclaveCmdStr           = "enclave"
@@ -31,9 +32,14 @@ const (
 	EnclaveLsCmdStr         = "ls"
 	EnclaveAddCmdStr        = "add"
 	EnclaveStopCmdStr       = "stop"
+	EnclaveStartCmdStr      = "start"
+	EnclaveExtendCmdStr     = "extend"
+	EnclaveCloneCmdStr      = "clone"
 	EnclaveRmCmdStr         = "rm"
 	EnclaveDumpCmdStr       = "dump"
 	EnclaveConnectCmdStr    = "connect"
+	EnclaveRunsCmdStr       = "runs"
+	EnclaveRunsShowCmdStr   = "show"
 	EngineCmdStr            = "engine"
 	EngineLogsCmdStr        = "logs"
 	EngineStartCmdStr       = "start"
@@ -48,12 +54,24 @@ const (
 	FilesStoreWebCmdStr     = "storeweb"
 	FilesStoreServiceCmdStr = "storeservice"
 	FilesRenderTemplate     = "rendertemplate"
+	FilesHistoryCmdStr      = "history"
+	FilesDiffCmdStr         = "diff"
+	FilesExportVolumeCmdStr = "export-volume"
+	FilesImportVolumeCmdStr = "import-volume"
 	KurtosisDumpCmdStr      = "dump"
 	KurtosisLintCmdStr      = "lint"
 	PortalCmdStr            = "portal"
 	PortalStartCmdStr       = "start"
 	PortalStatusCmdStr      = "status"
 	PortalStopCmdStr        = "stop"
+	ScheduleCmdStr          = "schedule"
+	ScheduleAddCmdStr       = "add"
+	ScheduleLsCmdStr        = "ls"
+	ScheduleRmCmdStr        = "rm"
+	SecretCmdStr            = "secret"
+	SecretSetCmdStr         = "set"
+	SecretLsCmdStr          = "ls"
+	SecretRmCmdStr          = "rm"
 	ServiceCmdStr           = "service"
 	ServiceAddCmdStr        = "add"
 	ServiceExecCmdStr       = "exec"
@@ -62,8 +80,11 @@ const (
 	ServiceShellCmdStr      = "shell"
 	ServiceStartCmdStr      = "start"
 	ServiceStopCmdStr       = "stop"
+	ServiceRestartCmdStr    = "restart"
 	ServiceInspectCmdStr    = "inspect"
 	ServiceUpdateCmdStr     = "update"
+	ServiceSyncCmdStr       = "sync"
+	ServiceScaleCmdStr      = "scale"
 	StarlarkRunCmdStr       = "run"
 	TwitterCmdStr           = "twitter"
 	ConfigCmdStr            = "config"
@@ -75,6 +96,7 @@ const (
 	InitCmdStr              = "init"
 	PortCmdStr              = "port"
 	PortPrintCmdStr         = "print"
+	PortForwardCmdStr       = "forward"
 	WebCmdStr               = "web"
 	GitHubCmdStr            = "github"
 	GitHubLoginCmdStr       = "login"
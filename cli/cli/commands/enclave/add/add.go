@@ -18,7 +18,9 @@ import (
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"strings"
+	"time"
 )
 
 const (
@@ -26,6 +28,22 @@ const (
 	apiContainerLogLevelFlagKey  = "api-container-log-level"
 	enclaveNameFlagKey           = "name"
 	enclaveProductionModeFlagKey = "production"
+	enclaveTtlFlagKey            = "ttl"
+	enclaveIdleTimeoutFlagKey    = "idle-timeout"
+	enclaveCpuQuotaFlagKey       = "cpu-quota"
+	enclaveMemoryQuotaFlagKey    = "memory-quota"
+	enclaveMaxServicesFlagKey    = "max-services"
+	enclaveDiskQuotaFlagKey      = "disk-quota"
+	enclaveFromTemplateFlagKey   = "from-template"
+
+	// Signifies that the enclave has no TTL or idle timeout
+	noEnclaveLifetimeLimit = ""
+
+	// Signifies that the resource isn't capped by the enclave resource quota
+	noEnclaveResourceQuotaStr = "0"
+
+	// Signifies that the enclave starts empty instead of from a template
+	noEnclaveTemplate = ""
 
 	// Signifies that an enclave name should be auto-generated
 	autogenerateEnclaveNameKeyword = ""
@@ -77,6 +95,48 @@ var EnclaveAddCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCo
 			Type:      flags.FlagType_Bool,
 			Default:   "false",
 		},
+		{
+			Key:     enclaveTtlFlagKey,
+			Usage:   "How long the enclave may live (e.g. '30m', '2h') before the engine destroys it. The enclave never expires if not set",
+			Type:    flags.FlagType_String,
+			Default: noEnclaveLifetimeLimit,
+		},
+		{
+			Key:     enclaveIdleTimeoutFlagKey,
+			Usage:   "How long the enclave may go unused (e.g. '30m', '2h') before the engine stops it; it can be started again with '" + command_str_consts.EnclaveCmdStr + " " + command_str_consts.EnclaveStartCmdStr + "'. The enclave is never stopped for inactivity if not set",
+			Type:    flags.FlagType_String,
+			Default: noEnclaveLifetimeLimit,
+		},
+		{
+			Key:     enclaveCpuQuotaFlagKey,
+			Usage:   "The maximum sum of the min CPU, in millicores, of all the services in the enclave. The enclave CPU isn't capped if not set",
+			Type:    flags.FlagType_Uint32,
+			Default: noEnclaveResourceQuotaStr,
+		},
+		{
+			Key:     enclaveMemoryQuotaFlagKey,
+			Usage:   "The maximum sum of the min memory, in megabytes, of all the services in the enclave. The enclave memory isn't capped if not set",
+			Type:    flags.FlagType_Uint32,
+			Default: noEnclaveResourceQuotaStr,
+		},
+		{
+			Key:     enclaveMaxServicesFlagKey,
+			Usage:   "The maximum number of services in the enclave. The number of services isn't capped if not set",
+			Type:    flags.FlagType_Uint32,
+			Default: noEnclaveResourceQuotaStr,
+		},
+		{
+			Key:     enclaveDiskQuotaFlagKey,
+			Usage:   "The maximum sum of the size, in megabytes, of all the persistent directories in the enclave. The enclave disk isn't capped if not set",
+			Type:    flags.FlagType_Uint32,
+			Default: noEnclaveResourceQuotaStr,
+		},
+		{
+			Key:     enclaveFromTemplateFlagKey,
+			Usage:   "The name of an enclave template from the 'enclave-templates' of the cluster config. The enclave is created with the template's package already running, which is near instant while the engine has an idle enclave for the template ready. The enclave starts empty if not set",
+			Type:    flags.FlagType_String,
+			Default: noEnclaveTemplate,
+		},
 	},
 }
 
@@ -115,6 +175,25 @@ func run(
 		return stacktrace.Propagate(err, "An error occurred while getting the enclave name using flag with key '%v'; this is a bug in Kurtosis ", enclaveNameFlagKey)
 	}
 
+	ttl, err := getDurationFlag(flags, enclaveTtlFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave TTL using flag with key '%v'", enclaveTtlFlagKey)
+	}
+
+	idleTimeout, err := getDurationFlag(flags, enclaveIdleTimeoutFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave idle timeout using flag with key '%v'", enclaveIdleTimeoutFlagKey)
+	}
+
+	templateName, err := flags.GetString(enclaveFromTemplateFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", enclaveFromTemplateFlagKey)
+	}
+	var templateNameArg *string
+	if templateName != noEnclaveTemplate {
+		templateNameArg = &templateName
+	}
+
 	dontRestartAPIContainers := false
 	engineManager, err := engine_manager.NewEngineManager(ctx)
 	if err != nil {
@@ -143,12 +222,21 @@ func run(
 		mode = kurtosis_engine_rpc_api_bindings.EnclaveMode_PRODUCTION
 	}
 
+	resourceQuota, err := getEnclaveResourceQuota(flags)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave resource quota from the flags")
+	}
+
 	createEnclaveArgs := &kurtosis_engine_rpc_api_bindings.CreateEnclaveArgs{
 		EnclaveName:              &enclaveName,
 		ApiContainerVersionTag:   &apiContainerVersion,
 		ApiContainerLogLevel:     &kurtosisLogLevelStr,
 		Mode:                     &mode,
 		ShouldApicRunInDebugMode: &shouldApicRunInDebugMode,
+		Ttl:                      ttl,
+		IdleTimeout:              idleTimeout,
+		ResourceQuota:            resourceQuota,
+		TemplateName:             templateNameArg,
 	}
 	createdEnclaveResponse, err := engineClient.CreateEnclave(ctx, createEnclaveArgs)
 	if err != nil {
@@ -162,3 +250,45 @@ func run(
 
 	return nil
 }
+
+// Returns nil if the flag wasn't set
+func getDurationFlag(flags *flags.ParsedFlags, flagKey string) (*durationpb.Duration, error) {
+	durationStr, err := flags.GetString(flagKey)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", flagKey)
+	}
+	if durationStr == noEnclaveLifetimeLimit {
+		return nil, nil
+	}
+	duration, err := time.ParseDuration(durationStr)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing duration '%v' of flag '%v'; valid durations look like '30m' or '2h'", durationStr, flagKey)
+	}
+	if duration <= 0 {
+		return nil, stacktrace.NewError("The '%v' flag must be a positive duration but was '%v'", flagKey, durationStr)
+	}
+	return durationpb.New(duration), nil
+}
+
+// Returns nil if none of the quota flags were set
+func getEnclaveResourceQuota(flags *flags.ParsedFlags) (*kurtosis_engine_rpc_api_bindings.EnclaveResourceQuota, error) {
+	quotaValues := map[string]uint32{}
+	for _, flagKey := range []string{enclaveCpuQuotaFlagKey, enclaveMemoryQuotaFlagKey, enclaveMaxServicesFlagKey, enclaveDiskQuotaFlagKey} {
+		quotaValue, err := flags.GetUint32(flagKey)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", flagKey)
+		}
+		quotaValues[flagKey] = quotaValue
+	}
+
+	resourceQuota := &kurtosis_engine_rpc_api_bindings.EnclaveResourceQuota{
+		CpuMillicores:   uint64(quotaValues[enclaveCpuQuotaFlagKey]),
+		MemoryMegabytes: uint64(quotaValues[enclaveMemoryQuotaFlagKey]),
+		MaxServices:     quotaValues[enclaveMaxServicesFlagKey],
+		DiskMegabytes:   uint64(quotaValues[enclaveDiskQuotaFlagKey]),
+	}
+	if resourceQuota.GetCpuMillicores() == 0 && resourceQuota.GetMemoryMegabytes() == 0 && resourceQuota.GetMaxServices() == 0 && resourceQuota.GetDiskMegabytes() == 0 {
+		return nil, nil
+	}
+	return resourceQuota, nil
+}
@@ -0,0 +1,88 @@
+package clone
+
+import (
+	"context"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	sourceEnclaveIdentifierArgKey = "source-enclave"
+	isSourceEnclaveIdArgOptional  = false
+	isSourceEnclaveIdArgGreedy    = false
+
+	destinationEnclaveNameArgKey        = "destination-enclave-name"
+	isDestinationEnclaveNameArgOptional = false
+	isDestinationEnclaveNameArgGreedy   = false
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+)
+
+var EnclaveCloneCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.EnclaveCloneCmdStr,
+	ShortDescription: "Clones an enclave",
+	LongDescription: "Creates a new enclave with the files artifacts, persistent directories and services of an " +
+		"existing running enclave. The services keep their names but get new UUIDs and IP addresses",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags:                     nil,
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			sourceEnclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isSourceEnclaveIdArgOptional,
+			isSourceEnclaveIdArgGreedy,
+		),
+		{
+			Key:                   destinationEnclaveNameArgKey,
+			IsOptional:            isDestinationEnclaveNameArgOptional,
+			DefaultValue:          nil,
+			IsGreedy:              isDestinationEnclaveNameArgGreedy,
+			ArgCompletionProvider: nil,
+			ValidationFunc:        nil,
+		},
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	engineClient kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	_ *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	sourceEnclaveIdentifier, err := args.GetNonGreedyArg(sourceEnclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the source enclave identifier arg using key '%v'", sourceEnclaveIdentifierArgKey)
+	}
+	destinationEnclaveName, err := args.GetNonGreedyArg(destinationEnclaveNameArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the destination enclave name arg using key '%v'", destinationEnclaveNameArgKey)
+	}
+
+	logrus.Infof("Cloning enclave '%v' into enclave '%v'; this replays all the instructions run in the source enclave, so it may take a while...", sourceEnclaveIdentifier, destinationEnclaveName)
+	cloneArgs := &kurtosis_engine_rpc_api_bindings.CloneEnclaveArgs{
+		SourceEnclaveIdentifier: sourceEnclaveIdentifier,
+		DestinationEnclaveName:  destinationEnclaveName,
+	}
+	response, err := engineClient.CloneEnclave(ctx, cloneArgs)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred cloning enclave '%v' into enclave '%v'", sourceEnclaveIdentifier, destinationEnclaveName)
+	}
+
+	enclaveInfo := response.GetEnclaveInfo()
+	logrus.Infof("Enclave '%v' cloned into enclave '%v' with UUID '%v'", sourceEnclaveIdentifier, enclaveInfo.GetName(), enclaveInfo.GetEnclaveUuid())
+	return nil
+}
@@ -8,11 +8,16 @@ package enclave
 import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/add"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/clone"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/connect"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/dump"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/extend"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/inspect"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/ls"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/rm"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/runs"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/runs/show"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/start"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/stop"
 	"github.com/spf13/cobra"
 )
@@ -30,7 +35,14 @@ func init() {
 	EnclaveCmd.AddCommand(inspect.EnclaveInspectCmd.MustGetCobraCommand())
 	EnclaveCmd.AddCommand(add.EnclaveAddCmd.MustGetCobraCommand())
 	EnclaveCmd.AddCommand(stop.EnclaveStopCmd.MustGetCobraCommand())
+	EnclaveCmd.AddCommand(start.EnclaveStartCmd.MustGetCobraCommand())
+	EnclaveCmd.AddCommand(extend.EnclaveExtendCmd.MustGetCobraCommand())
+	EnclaveCmd.AddCommand(clone.EnclaveCloneCmd.MustGetCobraCommand())
 	EnclaveCmd.AddCommand(rm.EnclaveRmCmd.MustGetCobraCommand())
 	EnclaveCmd.AddCommand(dump.EnclaveDumpCmd.MustGetCobraCommand())
 	EnclaveCmd.AddCommand(connect.EnclaveConnectCmd.MustGetCobraCommand())
+
+	enclaveRunsCmd := runs.EnclaveRunsCmd.MustGetCobraCommand()
+	enclaveRunsCmd.AddCommand(show.EnclaveRunsShowCmd.MustGetCobraCommand())
+	EnclaveCmd.AddCommand(enclaveRunsCmd)
 }
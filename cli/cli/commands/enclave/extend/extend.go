@@ -0,0 +1,115 @@
+package extend
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"time"
+)
+
+const (
+	enclaveIdentifierArgKey = "enclave"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	durationArgKey        = "duration"
+	isDurationArgOptional = false
+	isDurationArgGreedy   = false
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+)
+
+var EnclaveExtendCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.EnclaveExtendCmdStr,
+	ShortDescription: "Extends the lifetime of an enclave",
+	LongDescription: "Pushes back the expiration time of an enclave created with a TTL by the given duration (e.g. " +
+		"'30m', '2h'), and restarts the countdown of its idle timeout",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags:                     nil,
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+		{
+			Key:                   durationArgKey,
+			IsOptional:            isDurationArgOptional,
+			DefaultValue:          nil,
+			IsGreedy:              isDurationArgGreedy,
+			ArgCompletionProvider: nil,
+			ValidationFunc:        validateDurationArg,
+		},
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	engineClient kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	_ *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave identifier arg using key '%v'", enclaveIdentifierArgKey)
+	}
+	duration, err := getDurationFromArgs(args)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the duration to extend enclave '%v' by", enclaveIdentifier)
+	}
+
+	extendArgs := &kurtosis_engine_rpc_api_bindings.ExtendEnclaveArgs{
+		EnclaveIdentifier: enclaveIdentifier,
+		Duration:          durationpb.New(duration),
+	}
+	response, err := engineClient.ExtendEnclave(ctx, extendArgs)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred extending enclave '%v'", enclaveIdentifier)
+	}
+
+	enclaveInfo := response.GetEnclaveInfo()
+	if enclaveInfo.GetExpirationTime() != nil {
+		logrus.Infof("Enclave '%v' now expires at %v", enclaveIdentifier, enclaveInfo.GetExpirationTime().AsTime().Local().Format(time.RFC1123))
+	}
+	if enclaveInfo.GetIdleExpirationTime() != nil {
+		logrus.Infof("Enclave '%v' will be stopped if it's still unused at %v", enclaveIdentifier, enclaveInfo.GetIdleExpirationTime().AsTime().Local().Format(time.RFC1123))
+	}
+	return nil
+}
+
+func validateDurationArg(_ context.Context, _ *flags.ParsedFlags, args *args.ParsedArgs) error {
+	if _, err := getDurationFromArgs(args); err != nil {
+		return stacktrace.Propagate(err, "An error occurred validating the duration arg")
+	}
+	return nil
+}
+
+func getDurationFromArgs(args *args.ParsedArgs) (time.Duration, error) {
+	durationStr, err := args.GetNonGreedyArg(durationArgKey)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred getting the duration arg using key '%v'", durationArgKey)
+	}
+	duration, err := time.ParseDuration(durationStr)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred parsing duration '%v'; valid durations look like '30m' or '2h'", durationStr)
+	}
+	if duration <= 0 {
+		return 0, stacktrace.NewError("The duration to extend the enclave by must be positive but was '%v'", durationStr)
+	}
+	return duration, nil
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/cluster_federation"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/enclave_status_stringifier"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
@@ -38,6 +39,7 @@ const (
 	enclaveNameTitleName         = "Name"
 	enclaveStatusTitleName       = "Status"
 	enclaveCreationTimeTitleName = "Creation Time"
+	enclaveOwnerTitleName        = "Owner"
 	flagsTitleName               = "Flags"
 
 	fullUuidsFlagKey       = "full-uuids"
@@ -103,10 +105,13 @@ func run(
 		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", fullUuidsFlagKey)
 	}
 
-	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	// The enclave identifier may be qualified with the cluster it lives in, e.g. 'minikube/my-enclave'
+	kurtosisCtx, bareEnclaveIdentifier, closeKurtosisCtx, err := cluster_federation.NewKurtosisContextForEnclave(ctx, enclaveIdentifier)
 	if err != nil {
-		return stacktrace.Propagate(err, "An error occurred creating Kurtosis Context from local engine")
+		return stacktrace.Propagate(err, "An error occurred connecting to the Kurtosis engine of enclave '%v'", enclaveIdentifier)
 	}
+	defer closeKurtosisCtx()
+	enclaveIdentifier = bareEnclaveIdentifier
 
 	if err = PrintEnclaveInspect(ctx, kurtosisCtx, enclaveIdentifier, showFullUuids); err != nil {
 		// this is already wrapped up
@@ -148,6 +153,11 @@ func PrintEnclaveInspect(ctx context.Context, kurtosisCtx *kurtosis_context.Kurt
 	enclaveCreationTimeStr := enclaveCreationTime.AsTime().Local().Format(time.RFC1123)
 	keyValuePrinter.AddPair(enclaveCreationTimeTitleName, enclaveCreationTimeStr)
 
+	// Add owner row; enclaves only have an owner when the engine requires authentication
+	if enclaveInfo.GetOwner() != "" {
+		keyValuePrinter.AddPair(enclaveOwnerTitleName, enclaveInfo.GetOwner())
+	}
+
 	// Add flags row
 	allEnclaveFlagsStr := getAllEnclaveFlagsStr(enclaveInfo)
 
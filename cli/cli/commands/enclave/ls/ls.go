@@ -13,11 +13,14 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/cluster_federation"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/enclave_status_stringifier"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/kurtosis_config_getter"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
 	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
 	"sort"
 	"strings"
 	"time"
@@ -28,6 +31,8 @@ const (
 	enclaveStatusColumnHeader       = "Status"
 	enclaveNameColumnHeader         = "Name"
 	enclaveCreationTimeColumnHeader = "Creation Time"
+	enclaveClusterColumnHeader      = "Cluster"
+	enclaveExpiresColumnHeader      = "Expires In"
 
 	kurtosisBackendCtxKey = "kurtosis-backend"
 	engineClientCtxKey    = "engine-client"
@@ -35,13 +40,20 @@ const (
 	fullUuidsFlagKey       = "full-uuids"
 	fullUuidFlagKeyDefault = "false"
 
+	allClustersFlagKey     = "all-clusters"
+	allClustersFlagDefault = "false"
+
 	emptyTimeForOldEnclaves = ""
+
+	noExpirationStr             = ""
+	expiresInUnderAMinuteStr    = "< 1m"
+	minRemainingLifetimeToPrint = time.Minute
 )
 
 var EnclaveLsCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
 	CommandStr:                command_str_consts.EnclaveLsCmdStr,
 	ShortDescription:          "Lists enclaves",
-	LongDescription:           "Lists the enclaves running in the Kurtosis engine",
+	LongDescription:           "Lists the enclaves running in the Kurtosis engine. With --" + allClustersFlagKey + ", lists the enclaves of the engines of all the configured clusters; those enclaves can then be referred to as 'cluster/enclave' by other commands. Other clusters are only reachable from the local Kurtosis context, not from a remote one",
 	KurtosisBackendContextKey: kurtosisBackendCtxKey,
 	EngineClientContextKey:    engineClientCtxKey,
 	Flags: []*flags.FlagConfig{
@@ -51,6 +63,12 @@ var EnclaveLsCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCom
 			Type:    flags.FlagType_Bool,
 			Default: fullUuidFlagKeyDefault,
 		},
+		{
+			Key:     allClustersFlagKey,
+			Usage:   "If true then Kurtosis lists the enclaves of all the configured clusters whose engine is running, rather than only those of the current cluster. Not available when the current Kurtosis context is remote. Default false.",
+			Type:    flags.FlagType_Bool,
+			Default: allClustersFlagDefault,
+		},
 	},
 	Args:    nil,
 	RunFunc: run,
@@ -64,6 +82,23 @@ func run(
 	flags *flags.ParsedFlags,
 	_ *args.ParsedArgs,
 ) error {
+	showFullUuids, err := flags.GetBool(fullUuidsFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", fullUuidsFlagKey)
+	}
+
+	showAllClusters, err := flags.GetBool(allClustersFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", allClustersFlagKey)
+	}
+
+	if showAllClusters {
+		if err := cluster_federation.EnsureCurrentContextIsLocal(); err != nil {
+			return stacktrace.Propagate(err, "The '--%v' flag can't be used from the current Kurtosis context", allClustersFlagKey)
+		}
+		return printEnclavesOfAllClusters(ctx, showFullUuids)
+	}
+
 	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred creating Kurtosis Context from local engine")
@@ -74,12 +109,7 @@ func run(
 		return stacktrace.Propagate(err, "An error occurred getting enclaves")
 	}
 
-	showFullUuids, err := flags.GetBool(fullUuidsFlagKey)
-	if err != nil {
-		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", fullUuidsFlagKey)
-	}
-
-	tablePrinter := output_printers.NewTablePrinter(enclaveUuidColumnHeader, enclaveNameColumnHeader, enclaveStatusColumnHeader, enclaveCreationTimeColumnHeader)
+	tablePrinter := output_printers.NewTablePrinter(enclaveUuidColumnHeader, enclaveNameColumnHeader, enclaveStatusColumnHeader, enclaveCreationTimeColumnHeader, enclaveExpiresColumnHeader)
 	orderedEnclaveInfoMaps, enclaveWithoutCreationTimeInfoMap := getOrderedEnclaveInfoMapAndEnclaveWithoutCreationTimeMap(enclaves.GetEnclavesByUuid())
 
 	//TODO remove this iteration after 2023-01-01 when we are sure that there is not any old enclave created without the creation time label
@@ -96,7 +126,7 @@ func run(
 			return stacktrace.Propagate(err, "An error occurred when stringify enclave containers status '%v'", enclaveInfo.GetContainersStatus())
 		}
 
-		if err := tablePrinter.AddRow(uuidToPrint, enclaveInfo.Name, enclaveStatus, emptyTimeForOldEnclaves); err != nil {
+		if err := tablePrinter.AddRow(uuidToPrint, enclaveInfo.Name, enclaveStatus, emptyTimeForOldEnclaves, getRemainingLifetimeStr(enclaveInfo, time.Now())); err != nil {
 			return stacktrace.NewError("An error occurred adding row for enclave '%v' to the table printer", enclaveUuid)
 		}
 	}
@@ -120,7 +150,7 @@ func run(
 
 		enclaveName := enclaveInfo.GetName()
 
-		if err := tablePrinter.AddRow(uuidToPrint, enclaveName, enclaveStatus, enclaveCreationTime); err != nil {
+		if err := tablePrinter.AddRow(uuidToPrint, enclaveName, enclaveStatus, enclaveCreationTime, getRemainingLifetimeStr(enclaveInfo, time.Now())); err != nil {
 			return stacktrace.NewError("An error occurred adding row for enclave '%v' to the table printer", enclaveUuid)
 		}
 	}
@@ -130,6 +160,84 @@ func run(
 	return nil
 }
 
+// printEnclavesOfAllClusters merges the enclaves of the engines of every configured cluster into a single table. Clusters
+// whose engine can't be reached are skipped with a warning rather than failing the whole listing
+func printEnclavesOfAllClusters(ctx context.Context, showFullUuids bool) error {
+	clusterNames, err := kurtosis_config_getter.GetKurtosisClusterNames()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the names of the configured clusters")
+	}
+	currentClusterName, err := kurtosis_config_getter.GetCurrentKurtosisClusterName()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the name of the current cluster")
+	}
+	// The current cluster goes first so that an enclave shared by several clusters is attributed to the current one
+	sort.SliceStable(clusterNames, func(firstIndex, secondIndex int) bool {
+		return clusterNames[firstIndex] == currentClusterName && clusterNames[secondIndex] != currentClusterName
+	})
+
+	tablePrinter := output_printers.NewTablePrinter(enclaveClusterColumnHeader, enclaveUuidColumnHeader, enclaveNameColumnHeader, enclaveStatusColumnHeader, enclaveCreationTimeColumnHeader, enclaveExpiresColumnHeader)
+	// Several clusters can share the same engine (e.g. two Docker clusters), so we only list each enclave once
+	listedEnclaveUuids := map[string]bool{}
+	for _, clusterName := range clusterNames {
+		enclaveInfos, err := getEnclavesOfCluster(ctx, clusterName)
+		if err != nil {
+			logrus.Warnf("Skipping cluster '%v' as its enclaves couldn't be listed:\n%v", clusterName, err)
+			continue
+		}
+
+		orderedEnclaveInfoMaps, enclaveWithoutCreationTimeInfoMap := getOrderedEnclaveInfoMapAndEnclaveWithoutCreationTimeMap(enclaveInfos)
+		for _, enclaveInfo := range enclaveWithoutCreationTimeInfoMap {
+			orderedEnclaveInfoMaps = append(orderedEnclaveInfoMaps, enclaveInfo)
+		}
+		for _, enclaveInfo := range orderedEnclaveInfoMaps {
+			enclaveUuid := enclaveInfo.GetEnclaveUuid()
+			if listedEnclaveUuids[enclaveUuid] {
+				continue
+			}
+			listedEnclaveUuids[enclaveUuid] = true
+
+			uuidToPrint := enclaveInfo.GetShortenedUuid()
+			if showFullUuids {
+				uuidToPrint = enclaveUuid
+			}
+
+			enclaveStatus, err := enclave_status_stringifier.EnclaveContainersStatusStringifier(enclaveInfo.GetContainersStatus())
+			if err != nil {
+				return stacktrace.Propagate(err, "An error occurred when stringify enclave containers status '%v'", enclaveInfo.GetContainersStatus())
+			}
+
+			enclaveCreationTime := emptyTimeForOldEnclaves
+			if enclaveInfo.GetCreationTime() != nil {
+				// The extra space is a hack till we figure out the table printer color + formatting story
+				enclaveCreationTime = " " + enclaveInfo.CreationTime.AsTime().Local().Format(time.RFC1123)
+			}
+
+			if err := tablePrinter.AddRow(clusterName, uuidToPrint, enclaveInfo.GetName(), enclaveStatus, enclaveCreationTime, getRemainingLifetimeStr(enclaveInfo, time.Now())); err != nil {
+				return stacktrace.NewError("An error occurred adding row for enclave '%v' of cluster '%v' to the table printer", enclaveUuid, clusterName)
+			}
+		}
+	}
+
+	tablePrinter.Print()
+
+	return nil
+}
+
+func getEnclavesOfCluster(ctx context.Context, clusterName string) (map[string]*kurtosis_engine_rpc_api_bindings.EnclaveInfo, error) {
+	kurtosisCtx, closeKurtosisCtx, err := cluster_federation.NewKurtosisContextForCluster(ctx, clusterName)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred connecting to the engine of cluster '%v'", clusterName)
+	}
+	defer closeKurtosisCtx()
+
+	enclaves, err := kurtosisCtx.GetEnclaves(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the enclaves of cluster '%v'", clusterName)
+	}
+	return enclaves.GetEnclavesByUuid(), nil
+}
+
 func getOrderedEnclaveInfoMapAndEnclaveWithoutCreationTimeMap(
 	enclaveInfoMap map[string]*kurtosis_engine_rpc_api_bindings.EnclaveInfo,
 ) (
@@ -172,3 +280,30 @@ func getOrderedEnclaveInfoMapAndEnclaveWithoutCreationTimeMap(
 
 	return orderedEnclaveInfoMaps, enclaveWithoutCreationTimeInfoMap
 }
+
+// Returns how long the enclave has left before the engine destroys it for outliving its TTL or, if it's running, stops
+// it for going unused; empty if neither applies
+func getRemainingLifetimeStr(enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo, now time.Time) string {
+	var deadline *time.Time
+	if enclaveInfo.GetExpirationTime() != nil {
+		expirationTime := enclaveInfo.GetExpirationTime().AsTime()
+		deadline = &expirationTime
+	}
+	isRunning := enclaveInfo.GetContainersStatus() == kurtosis_engine_rpc_api_bindings.EnclaveContainersStatus_EnclaveContainersStatus_RUNNING
+	if isRunning && enclaveInfo.GetIdleExpirationTime() != nil {
+		idleExpirationTime := enclaveInfo.GetIdleExpirationTime().AsTime()
+		if deadline == nil || idleExpirationTime.Before(*deadline) {
+			deadline = &idleExpirationTime
+		}
+	}
+	if deadline == nil {
+		return noExpirationStr
+	}
+
+	remainingLifetime := deadline.Sub(now)
+	if remainingLifetime < minRemainingLifetimeToPrint {
+		return expiresInUnderAMinuteStr
+	}
+	// Durations are printed like '1h30m0s', the seconds are just noise here
+	return strings.TrimSuffix(remainingLifetime.Truncate(time.Minute).String(), "0s")
+}
@@ -0,0 +1,158 @@
+package runs
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/cluster_federation"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	enclaveIdentifierArgKey = "enclave"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	runUuidColumnHeader        = "UUID"
+	packageIdColumnHeader      = "Package"
+	gitCommitColumnHeader      = "Commit"
+	statusColumnHeader         = "Status"
+	startTimeColumnHeader      = "Start Time"
+	durationColumnHeader       = "Duration"
+	numberOfErrorsColumnHeader = "Errors"
+
+	fullUuidsFlagKey       = "full-uuids"
+	fullUuidFlagKeyDefault = "false"
+
+	shortenedGitCommitShaLength = 7
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+)
+
+var starlarkRunStatusStrs = map[kurtosis_core_rpc_api_bindings.StarlarkRunStatus]string{
+	kurtosis_core_rpc_api_bindings.StarlarkRunStatus_STARLARK_RUN_IN_PROGRESS:     "IN PROGRESS",
+	kurtosis_core_rpc_api_bindings.StarlarkRunStatus_STARLARK_RUN_SUCCEEDED:       "SUCCEEDED",
+	kurtosis_core_rpc_api_bindings.StarlarkRunStatus_STARLARK_RUN_FAILED:          "FAILED",
+	kurtosis_core_rpc_api_bindings.StarlarkRunStatus_STARLARK_RUN_OUTCOME_UNKNOWN: "UNKNOWN",
+}
+
+var EnclaveRunsCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.EnclaveRunsCmdStr,
+	ShortDescription: "Lists the Starlark runs of an enclave",
+	LongDescription: "Lists every package and script that ran in the enclave, oldest first, so that you can see how the enclave " +
+		"reached its current state. Use '" + command_str_consts.EnclaveRunsShowCmdStr + "' to see the parameters and the output of a run",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags: []*flags.FlagConfig{
+		{
+			Key:     fullUuidsFlagKey,
+			Usage:   "If true then Kurtosis prints full UUIDs and Git commits instead of shortened ones. Default false.",
+			Type:    flags.FlagType_Bool,
+			Default: fullUuidFlagKeyDefault,
+		},
+	},
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave identifier value using key '%v'", enclaveIdentifierArgKey)
+	}
+
+	showFullUuids, err := flags.GetBool(fullUuidsFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", fullUuidsFlagKey)
+	}
+
+	// The enclave identifier may be qualified with the cluster it lives in, e.g. 'minikube/my-enclave'
+	kurtosisCtx, bareEnclaveIdentifier, closeKurtosisCtx, err := cluster_federation.NewKurtosisContextForEnclave(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred connecting to the Kurtosis engine of enclave '%v'", enclaveIdentifier)
+	}
+	defer closeKurtosisCtx()
+
+	enclaveCtx, err := kurtosisCtx.GetEnclaveContext(ctx, bareEnclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave context for enclave '%v'", enclaveIdentifier)
+	}
+
+	runRecords, err := enclaveCtx.GetStarlarkRunRecords(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred listing the Starlark runs of enclave '%v'", enclaveIdentifier)
+	}
+
+	tablePrinter := output_printers.NewTablePrinter(runUuidColumnHeader, packageIdColumnHeader, gitCommitColumnHeader, statusColumnHeader, startTimeColumnHeader, durationColumnHeader, numberOfErrorsColumnHeader)
+	for _, runRecord := range runRecords {
+		runUuid := runRecord.GetRunUuid()
+		gitCommitSha := runRecord.GetPackageGitCommitSha()
+		if !showFullUuids {
+			runUuid = uuid_generator.ShortenedUUIDString(runUuid)
+			if len(gitCommitSha) > shortenedGitCommitShaLength {
+				gitCommitSha = gitCommitSha[:shortenedGitCommitShaLength]
+			}
+		}
+		if err := tablePrinter.AddRow(
+			runUuid,
+			runRecord.GetPackageId(),
+			gitCommitSha,
+			GetStarlarkRunStatusStr(runRecord.GetStatus()),
+			FormatStarlarkRunTime(runRecord.GetStartTime().AsTime()),
+			getDurationStr(runRecord),
+			strconv.Itoa(len(runRecord.GetErrors())),
+		); err != nil {
+			return stacktrace.NewError("An error occurred adding row for Starlark run '%v' to the table printer", runRecord.GetRunUuid())
+		}
+	}
+	tablePrinter.Print()
+
+	return nil
+}
+
+func GetStarlarkRunStatusStr(status kurtosis_core_rpc_api_bindings.StarlarkRunStatus) string {
+	statusStr, found := starlarkRunStatusStrs[status]
+	if !found {
+		return status.String()
+	}
+	return statusStr
+}
+
+func FormatStarlarkRunTime(runTime time.Time) string {
+	return runTime.Local().Format(time.RFC1123)
+}
+
+// getDurationStr returns an empty string for the runs that are still in progress
+func getDurationStr(runRecord *kurtosis_core_rpc_api_bindings.StarlarkRunRecord) string {
+	if runRecord.GetEndTime() == nil {
+		return ""
+	}
+	return runRecord.GetEndTime().AsTime().Sub(runRecord.GetStartTime().AsTime()).Round(time.Second).String()
+}
@@ -0,0 +1,185 @@
+package show
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/enclaves"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	command_args_run "github.com/kurtosis-tech/kurtosis/cli/cli/command_args/run"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/runs"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	runIdentifierArgKey = "run-id"
+
+	runUuidTitleName          = "UUID"
+	enclaveNameTitleName      = "Enclave"
+	packageIdTitleName        = "Package"
+	gitCommitTitleName        = "Commit"
+	serializedParamsTitleName = "Params"
+	mainFileTitleName         = "Main File"
+	mainFunctionTitleName     = "Main Function"
+	statusTitleName           = "Status"
+	startTimeTitleName        = "Start Time"
+	endTimeTitleName          = "End Time"
+
+	replayedOutputVerbosity = command_args_run.Brief
+	isReplayedRunDryRun     = false
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+)
+
+var EnclaveRunsShowCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.EnclaveRunsShowCmdStr,
+	ShortDescription: "Shows a Starlark run",
+	LongDescription: "Shows what a Starlark run of an enclave ran and with which parameters, then replays the output it " +
+		"produced. The run is looked up by its full or shortened UUID in all the running enclaves",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags:                     []*flags.FlagConfig{},
+	Args: []*args.ArgConfig{
+		{
+			Key: runIdentifierArgKey,
+		},
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	_ *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	runIdentifier, err := args.GetNonGreedyArg(runIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the run identifier value using key '%v'", runIdentifierArgKey)
+	}
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating Kurtosis Context from local engine")
+	}
+
+	enclaveCtx, runUuid, err := findEnclaveOfRun(ctx, kurtosisCtx, runIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred finding the enclave of Starlark run '%v'", runIdentifier)
+	}
+
+	runRecord, err := enclaveCtx.GetStarlarkRunRecord(ctx, runUuid)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting Starlark run '%v' of enclave '%v'", runUuid, enclaveCtx.GetEnclaveName())
+	}
+
+	keyValuePrinter := output_printers.NewKeyValuePrinter()
+	keyValuePrinter.AddPair(runUuidTitleName, runRecord.GetRunUuid())
+	keyValuePrinter.AddPair(enclaveNameTitleName, enclaveCtx.GetEnclaveName())
+	keyValuePrinter.AddPair(packageIdTitleName, runRecord.GetPackageId())
+	keyValuePrinter.AddPair(gitCommitTitleName, runRecord.GetPackageGitCommitSha())
+	keyValuePrinter.AddPair(serializedParamsTitleName, runRecord.GetSerializedParams())
+	keyValuePrinter.AddPair(mainFileTitleName, runRecord.GetRelativePathToMainFile())
+	keyValuePrinter.AddPair(mainFunctionTitleName, runRecord.GetMainFunctionName())
+	keyValuePrinter.AddPair(statusTitleName, runs.GetStarlarkRunStatusStr(runRecord.GetStatus()))
+	keyValuePrinter.AddPair(startTimeTitleName, runs.FormatStarlarkRunTime(runRecord.GetStartTime().AsTime()))
+	if runRecord.GetEndTime() != nil {
+		keyValuePrinter.AddPair(endTimeTitleName, runs.FormatStarlarkRunTime(runRecord.GetEndTime().AsTime()))
+	}
+	keyValuePrinter.Print()
+	out.PrintOutLn("")
+
+	outputLines, cancelFunc, err := enclaveCtx.GetStarlarkRunRecordOutput(ctx, runUuid)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the output of Starlark run '%v'", runUuid)
+	}
+	defer cancelFunc()
+	if err := printOutputLines(outputLines); err != nil {
+		return stacktrace.Propagate(err, "An error occurred replaying the output of Starlark run '%v'", runUuid)
+	}
+	if runRecord.GetIsOutputTruncated() {
+		out.PrintOutLn("")
+		out.PrintOutLn(fmt.Sprintf("The output of this run was too large to be recorded entirely; only its first %v lines were kept", runRecord.GetNumberOfOutputLines()))
+	}
+	return nil
+}
+
+// findEnclaveOfRun returns the context of the enclave the run belongs to, along with the full UUID of the run. Only the
+// enclaves whose API container is running can be searched
+func findEnclaveOfRun(ctx context.Context, kurtosisCtx *kurtosis_context.KurtosisContext, runIdentifier string) (*enclaves.EnclaveContext, string, error) {
+	allEnclaves, err := kurtosisCtx.GetEnclaves(ctx)
+	if err != nil {
+		return nil, "", stacktrace.Propagate(err, "An error occurred getting the enclaves")
+	}
+
+	var (
+		matchingEnclaveCtx *enclaves.EnclaveContext
+		matchingRunUuid    string
+	)
+	for _, enclaveInfo := range allEnclaves.GetEnclavesByUuid() {
+		if enclaveInfo.GetApiContainerStatus() != kurtosis_engine_rpc_api_bindings.EnclaveAPIContainerStatus_EnclaveAPIContainerStatus_RUNNING {
+			continue
+		}
+		enclaveCtx, err := kurtosisCtx.GetEnclaveContextFromEnclaveInfo(ctx, enclaveInfo)
+		if err != nil {
+			return nil, "", stacktrace.Propagate(err, "An error occurred getting the enclave context for enclave '%v'", enclaveInfo.GetName())
+		}
+		runRecords, err := enclaveCtx.GetStarlarkRunRecords(ctx)
+		if err != nil {
+			logrus.Warnf("Skipping enclave '%v' as its Starlark runs couldn't be listed:\n%v", enclaveInfo.GetName(), err)
+			continue
+		}
+		for _, runRecord := range runRecords {
+			runUuid := runRecord.GetRunUuid()
+			if runIdentifier != runUuid && runIdentifier != uuid_generator.ShortenedUUIDString(runUuid) {
+				continue
+			}
+			if matchingEnclaveCtx != nil {
+				return nil, "", stacktrace.NewError("Found more than one Starlark run matching '%v'; use the full UUID of the run", runIdentifier)
+			}
+			matchingEnclaveCtx = enclaveCtx
+			matchingRunUuid = runUuid
+		}
+	}
+	if matchingEnclaveCtx == nil {
+		return nil, "", stacktrace.NewError(
+			"No Starlark run matching '%v' was found in the running enclaves; the runs of a stopped enclave can only be shown once it is started again with '%v %v %v'",
+			runIdentifier,
+			command_str_consts.KurtosisCmdStr,
+			command_str_consts.EnclaveCmdStr,
+			command_str_consts.EnclaveStartCmdStr,
+		)
+	}
+	return matchingEnclaveCtx, matchingRunUuid, nil
+}
+
+func printOutputLines(outputLines chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine) error {
+	printer := output_printers.NewDefaultExecutionPrinter()
+	if err := printer.Start(); err != nil {
+		return stacktrace.Propagate(err, "An error occurred starting the printer")
+	}
+	defer printer.Stop()
+
+	for outputLine := range outputLines {
+		if err := printer.PrintKurtosisExecutionResponseLineToStdOut(outputLine, replayedOutputVerbosity, isReplayedRunDryRun); err != nil {
+			return stacktrace.Propagate(err, "An error occurred printing output line '%v'", strings.TrimSpace(outputLine.String()))
+		}
+	}
+	return nil
+}
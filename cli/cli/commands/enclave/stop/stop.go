@@ -102,6 +102,11 @@ func stopAllEnclaveServices(ctx context.Context, enclaveIdentifier string) error
 		return stacktrace.Propagate(err, "An error occurred getting an enclave context from enclave info for enclave '%v'", enclaveIdentifier)
 	}
 
+	// The services are recorded before stopping them so that 'enclave start' only resumes the ones that were running
+	if err := enclaveCtx.RecordServicesToResume(ctx); err != nil {
+		return stacktrace.Propagate(err, "An error occurred recording the services of enclave '%v' to resume on start", enclaveIdentifier)
+	}
+
 	allEnclaveServices, err := enclaveCtx.GetServices()
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred getting all enclave services")
@@ -0,0 +1,303 @@
+package diff
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/artifact_identifier_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/cluster_federation"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+const (
+	enclaveIdentifierArgKey = "enclave"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	artifactIdentifierArgKey        = "artifact-identifier"
+	isArtifactIdentifierArgOptional = false
+	isArtifactIdentifierArgGreedy   = false
+
+	fromVersionFlagKey = "from"
+	toVersionFlagKey   = "to"
+	// An empty version means the previous version for '--from' and the current one for '--to'
+	emptyVersionFlagValue = ""
+
+	versionPrefix              = "v"
+	pinnedIdentifierFormatStr  = "%s@v%d"
+	diffFilePathFormatStr      = "%s/%s"
+	missingFileLabel           = "/dev/null"
+	binaryFilesDifferFormatStr = "Binary files %s and %s differ\n"
+	directoryPathSuffix        = "/"
+	lineSeparator              = "\n"
+	numDiffContextLines        = 3
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+)
+
+var FilesDiffCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.FilesDiffCmdStr,
+	ShortDescription: "Shows what changed between two versions of a files artifact",
+	LongDescription: "Prints a unified diff of every file that changed between two versions of the given files artifact. " +
+		"By default the current version is compared with the one before it; use '" + command_str_consts.FilesHistoryCmdStr + "' to list the versions",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags: []*flags.FlagConfig{
+		{
+			Key:     fromVersionFlagKey,
+			Usage:   "The version to compare from, e.g. 'v1'. Defaults to the version before the one compared to.",
+			Type:    flags.FlagType_String,
+			Default: emptyVersionFlagValue,
+		},
+		{
+			Key:     toVersionFlagKey,
+			Usage:   "The version to compare to, e.g. 'v2'. Defaults to the current version.",
+			Type:    flags.FlagType_String,
+			Default: emptyVersionFlagValue,
+		},
+	},
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+		artifact_identifier_arg.NewArtifactIdentifierArg(
+			artifactIdentifierArgKey,
+			enclaveIdentifierArgKey,
+			isArtifactIdentifierArgOptional,
+			isArtifactIdentifierArgGreedy,
+		),
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave identifier value using key '%v'", enclaveIdentifierArgKey)
+	}
+
+	artifactIdentifier, err := args.GetNonGreedyArg(artifactIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the files artifact identifier value using key '%v'", artifactIdentifierArgKey)
+	}
+
+	fromVersionStr, err := flags.GetString(fromVersionFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", fromVersionFlagKey)
+	}
+	toVersionStr, err := flags.GetString(toVersionFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", toVersionFlagKey)
+	}
+
+	// The enclave identifier may be qualified with the cluster it lives in, e.g. 'minikube/my-enclave'
+	kurtosisCtx, bareEnclaveIdentifier, closeKurtosisCtx, err := cluster_federation.NewKurtosisContextForEnclave(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred connecting to the Kurtosis engine of enclave '%v'", enclaveIdentifier)
+	}
+	defer closeKurtosisCtx()
+
+	enclaveCtx, err := kurtosisCtx.GetEnclaveContext(ctx, bareEnclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave context for enclave '%v'", enclaveIdentifier)
+	}
+
+	history, err := enclaveCtx.GetFilesArtifactHistory(ctx, artifactIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the history of files artifact '%v' in enclave '%v'", artifactIdentifier, enclaveIdentifier)
+	}
+	fromVersion, toVersion, err := getVersionsToCompare(history.GetVersions(), fromVersionStr, toVersionStr)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred picking the versions of files artifact '%v' to compare", artifactIdentifier)
+	}
+
+	fromLabel := fmt.Sprintf(pinnedIdentifierFormatStr, artifactIdentifier, fromVersion)
+	toLabel := fmt.Sprintf(pinnedIdentifierFormatStr, artifactIdentifier, toVersion)
+	// The versions are resolved through the UUID so that the identifier passed in may itself be a shortened UUID
+	fromContents, err := enclaveCtx.InspectFilesArtifact(ctx, services.FileArtifactName(fmt.Sprintf(pinnedIdentifierFormatStr, history.GetFileUuid(), fromVersion)))
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred inspecting files artifact '%v'", fromLabel)
+	}
+	toContents, err := enclaveCtx.InspectFilesArtifact(ctx, services.FileArtifactName(fmt.Sprintf(pinnedIdentifierFormatStr, history.GetFileUuid(), toVersion)))
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred inspecting files artifact '%v'", toLabel)
+	}
+
+	diff, err := getFilesArtifactDiff(fromLabel, fromContents.GetFileDescriptions(), toLabel, toContents.GetFileDescriptions())
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred computing the diff between '%v' and '%v'", fromLabel, toLabel)
+	}
+	if diff == "" {
+		out.PrintOutLn(fmt.Sprintf("No differences between '%v' and '%v'", fromLabel, toLabel))
+		return nil
+	}
+	out.PrintOutLn(strings.TrimSuffix(diff, lineSeparator))
+	return nil
+}
+
+func getVersionsToCompare(versions []*kurtosis_core_rpc_api_bindings.FilesArtifactVersion, fromVersionStr string, toVersionStr string) (uint32, uint32, error) {
+	keptVersions := map[uint32]bool{}
+	var currentVersion uint32
+	for _, version := range versions {
+		keptVersions[version.GetVersion()] = true
+		if version.GetIsCurrent() {
+			currentVersion = version.GetVersion()
+		}
+	}
+
+	toVersion := currentVersion
+	if toVersionStr != emptyVersionFlagValue {
+		parsedVersion, err := parseVersion(toVersionStr)
+		if err != nil {
+			return 0, 0, stacktrace.Propagate(err, "An error occurred parsing the value of the '%v' flag", toVersionFlagKey)
+		}
+		toVersion = parsedVersion
+	}
+
+	var fromVersion uint32
+	if fromVersionStr != emptyVersionFlagValue {
+		parsedVersion, err := parseVersion(fromVersionStr)
+		if err != nil {
+			return 0, 0, stacktrace.Propagate(err, "An error occurred parsing the value of the '%v' flag", fromVersionFlagKey)
+		}
+		fromVersion = parsedVersion
+	} else {
+		// The previous version is the most recent one kept that is older than the version compared to
+		for _, version := range versions {
+			if version.GetVersion() < toVersion && version.GetVersion() > fromVersion {
+				fromVersion = version.GetVersion()
+			}
+		}
+		if fromVersion == 0 {
+			return 0, 0, stacktrace.NewError("There is no version kept before 'v%d' to compare it with; use the '%v' flag to pick one", toVersion, fromVersionFlagKey)
+		}
+	}
+
+	for _, version := range []uint32{fromVersion, toVersion} {
+		if !keptVersions[version] {
+			return 0, 0, stacktrace.NewError("Version 'v%d' isn't kept for this files artifact; use '%v' to list the versions kept", version, command_str_consts.FilesHistoryCmdStr)
+		}
+	}
+	return fromVersion, toVersion, nil
+}
+
+// parseVersion accepts both 'v2' and '2'
+func parseVersion(versionStr string) (uint32, error) {
+	version, err := strconv.ParseUint(strings.TrimPrefix(versionStr, versionPrefix), 10, 32)
+	if err != nil || version == 0 {
+		return 0, stacktrace.NewError("'%v' isn't a valid files artifact version; expected something like 'v2'", versionStr)
+	}
+	return uint32(version), nil
+}
+
+// getFilesArtifactDiff returns the unified diffs of every file that differs between the two contents, ordered by path.
+// Files that have no text representation are compared by size only, as their contents aren't returned by the inspection.
+func getFilesArtifactDiff(
+	fromLabel string,
+	fromFileDescriptions []*kurtosis_core_rpc_api_bindings.FileArtifactContentsFileDescription,
+	toLabel string,
+	toFileDescriptions []*kurtosis_core_rpc_api_bindings.FileArtifactContentsFileDescription,
+) (string, error) {
+	fromFiles := getFileDescriptionsByPath(fromFileDescriptions)
+	toFiles := getFileDescriptionsByPath(toFileDescriptions)
+
+	allPaths := []string{}
+	for path := range fromFiles {
+		allPaths = append(allPaths, path)
+	}
+	for path := range toFiles {
+		if _, found := fromFiles[path]; !found {
+			allPaths = append(allPaths, path)
+		}
+	}
+	sort.Strings(allPaths)
+
+	diff := strings.Builder{}
+	for _, path := range allPaths {
+		fromFile, isInFrom := fromFiles[path]
+		toFile, isInTo := toFiles[path]
+		fromFileLabel := missingFileLabel
+		if isInFrom {
+			fromFileLabel = fmt.Sprintf(diffFilePathFormatStr, fromLabel, path)
+		}
+		toFileLabel := missingFileLabel
+		if isInTo {
+			toFileLabel = fmt.Sprintf(diffFilePathFormatStr, toLabel, path)
+		}
+
+		if (isInFrom && fromFile.TextPreview == nil) || (isInTo && toFile.TextPreview == nil) {
+			if !isInFrom || !isInTo || fromFile.GetSize() != toFile.GetSize() || fromFile.GetTextPreview() != toFile.GetTextPreview() {
+				diff.WriteString(fmt.Sprintf(binaryFilesDifferFormatStr, fromFileLabel, toFileLabel))
+			}
+			continue
+		}
+
+		fileDiff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        splitLines(fromFile.GetTextPreview()),
+			B:        splitLines(toFile.GetTextPreview()),
+			FromFile: fromFileLabel,
+			FromDate: "",
+			ToFile:   toFileLabel,
+			ToDate:   "",
+			Eol:      "",
+			Context:  numDiffContextLines,
+		})
+		if err != nil {
+			return "", stacktrace.Propagate(err, "An error occurred computing the diff of file '%v'", path)
+		}
+		diff.WriteString(fileDiff)
+	}
+	return diff.String(), nil
+}
+
+// splitLines keeps the line endings like difflib.SplitLines does, but without making up an empty last line when the
+// content ends with a newline; an empty file has no lines at all
+func splitLines(content string) []string {
+	if content == "" {
+		return []string{}
+	}
+	lines := strings.SplitAfter(content, lineSeparator)
+	if lines[len(lines)-1] == "" {
+		return lines[:len(lines)-1]
+	}
+	lines[len(lines)-1] += lineSeparator
+	return lines
+}
+
+// getFileDescriptionsByPath leaves out directories, as only the files they contain matter to the diff
+func getFileDescriptionsByPath(fileDescriptions []*kurtosis_core_rpc_api_bindings.FileArtifactContentsFileDescription) map[string]*kurtosis_core_rpc_api_bindings.FileArtifactContentsFileDescription {
+	fileDescriptionsByPath := map[string]*kurtosis_core_rpc_api_bindings.FileArtifactContentsFileDescription{}
+	for _, fileDescription := range fileDescriptions {
+		if strings.HasSuffix(fileDescription.GetPath(), directoryPathSuffix) {
+			continue
+		}
+		fileDescriptionsByPath[fileDescription.GetPath()] = fileDescription
+	}
+	return fileDescriptionsByPath
+}
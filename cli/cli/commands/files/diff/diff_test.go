@@ -0,0 +1,98 @@
+package diff
+
+import (
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetFilesArtifactDiff(t *testing.T) {
+	from := []*kurtosis_core_rpc_api_bindings.FileArtifactContentsFileDescription{
+		newTextFileDescription("config/", ""),
+		newTextFileDescription("config/app.yaml", "port: 8080\nlevel: info\n"),
+		newTextFileDescription("config/removed.txt", "gone\n"),
+		newTextFileDescription("config/same.txt", "same\n"),
+		newBinaryFileDescription("config/logo.png", 10),
+	}
+	to := []*kurtosis_core_rpc_api_bindings.FileArtifactContentsFileDescription{
+		newTextFileDescription("config/", ""),
+		newTextFileDescription("config/app.yaml", "port: 9090\nlevel: info\n"),
+		newTextFileDescription("config/added.txt", "new\n"),
+		newTextFileDescription("config/same.txt", "same\n"),
+		newBinaryFileDescription("config/logo.png", 12),
+	}
+
+	diff, err := getFilesArtifactDiff("my-config@v1", from, "my-config@v2", to)
+	require.NoError(t, err)
+	expectedDiff := `--- /dev/null
++++ my-config@v2/config/added.txt
+@@ -0,0 +1 @@
++new
+--- my-config@v1/config/app.yaml
++++ my-config@v2/config/app.yaml
+@@ -1,2 +1,2 @@
+-port: 8080
++port: 9090
+ level: info
+Binary files my-config@v1/config/logo.png and my-config@v2/config/logo.png differ
+--- my-config@v1/config/removed.txt
++++ /dev/null
+@@ -1 +0,0 @@
+-gone
+`
+	require.Equal(t, expectedDiff, diff)
+}
+
+func TestGetFilesArtifactDiff_NoDifferences(t *testing.T) {
+	contents := []*kurtosis_core_rpc_api_bindings.FileArtifactContentsFileDescription{
+		newTextFileDescription("app.yaml", "port: 8080\n"),
+		newBinaryFileDescription("logo.png", 10),
+	}
+	diff, err := getFilesArtifactDiff("my-config@v1", contents, "my-config@v2", contents)
+	require.NoError(t, err)
+	require.Empty(t, diff)
+}
+
+func TestGetVersionsToCompare(t *testing.T) {
+	versions := []*kurtosis_core_rpc_api_bindings.FilesArtifactVersion{
+		{Version: 3, CreationTime: nil, Producer: "", IsCurrent: false},
+		{Version: 4, CreationTime: nil, Producer: "", IsCurrent: false},
+		{Version: 5, CreationTime: nil, Producer: "", IsCurrent: true},
+	}
+
+	fromVersion, toVersion, err := getVersionsToCompare(versions, "", "")
+	require.NoError(t, err)
+	require.Equal(t, uint32(4), fromVersion)
+	require.Equal(t, uint32(5), toVersion)
+
+	fromVersion, toVersion, err = getVersionsToCompare(versions, "v3", "4")
+	require.NoError(t, err)
+	require.Equal(t, uint32(3), fromVersion)
+	require.Equal(t, uint32(4), toVersion)
+
+	_, _, err = getVersionsToCompare(versions, "", "v3")
+	require.Error(t, err)
+
+	_, _, err = getVersionsToCompare(versions, "v1", "")
+	require.Error(t, err)
+
+	_, _, err = getVersionsToCompare(versions, "latest", "")
+	require.Error(t, err)
+}
+
+func newTextFileDescription(path string, content string) *kurtosis_core_rpc_api_bindings.FileArtifactContentsFileDescription {
+	return &kurtosis_core_rpc_api_bindings.FileArtifactContentsFileDescription{
+		Path:        path,
+		Size:        uint64(len(content)),
+		TextPreview: &content,
+	}
+}
+
+func newBinaryFileDescription(path string, size uint64) *kurtosis_core_rpc_api_bindings.FileArtifactContentsFileDescription {
+	return &kurtosis_core_rpc_api_bindings.FileArtifactContentsFileDescription{
+		Path:        path,
+		Size:        size,
+		TextPreview: nil,
+	}
+}
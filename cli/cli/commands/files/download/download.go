@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
-	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/artifact_identifier_arg"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
@@ -12,6 +11,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/cluster_federation"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/files"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
@@ -124,10 +124,13 @@ func run(
 		return stacktrace.Propagate(err, "An error occurred while getting the default value for the '%v' flag", noExtractFlagKey)
 	}
 
-	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	// The enclave identifier may be qualified with the cluster it lives in, e.g. 'minikube/my-enclave'
+	kurtosisCtx, bareEnclaveIdentifier, closeKurtosisCtx, err := cluster_federation.NewKurtosisContextForEnclave(ctx, enclaveIdentifier)
 	if err != nil {
-		return stacktrace.Propagate(err, "An error occurred connecting to the local Kurtosis engine")
+		return stacktrace.Propagate(err, "An error occurred connecting to the Kurtosis engine of enclave '%v'", enclaveIdentifier)
 	}
+	defer closeKurtosisCtx()
+	enclaveIdentifier = bareEnclaveIdentifier
 	enclaveCtx, err := kurtosisCtx.GetEnclaveContext(ctx, enclaveIdentifier)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred getting the enclave context for enclave '%v'", enclaveIdentifier)
@@ -0,0 +1,232 @@
+package exportvolume
+
+import (
+	"compress/gzip"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/file_system_path_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
+	"github.com/kurtosis-tech/kurtosis/path-compression"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	enclaveIdentifierArgKey = "enclave"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	persistentKeyArgKey        = "persistent-key"
+	isPersistentKeyArgOptional = false
+	isPersistentKeyArgGreedy   = false
+
+	destinationPathArgKey        = "destination-path"
+	isDestinationPathArgOptional = false
+	emptyDestinationPathArg      = ""
+
+	destinationFilePermission = 0o644
+
+	tarExtension             = ".tar"
+	tempTarFilenamePattern   = "kurtosis-volume-*" + tarExtension
+	persistentKeysSeparator  = ", "
+	noPersistentKeysFoundStr = "none"
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+)
+
+// The destination is written as a gzipped tarball when it ends in one of these, and as a plain tarball if it ends in
+// '.tar'; any other destination is a directory that the contents get extracted into
+var gzipArchiveExtensions = []string{".tgz", ".tar.gz"}
+
+var FilesExportVolumeCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.FilesExportVolumeCmdStr,
+	ShortDescription: "Export the contents of a persistent directory",
+	LongDescription: "Copies the contents of the persistent directory with the given key out of the enclave. If the destination ends in " +
+		"'.tgz' or '.tar.gz' the contents are written as a gzipped tarball, if it ends in '.tar' as a plain tarball, and otherwise they're " +
+		"extracted into the destination directory",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags:                     []*flags.FlagConfig{},
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+		{
+			Key:        persistentKeyArgKey,
+			IsOptional: isPersistentKeyArgOptional,
+			IsGreedy:   isPersistentKeyArgGreedy,
+		},
+		file_system_path_arg.NewFilepathOrDirpathArg(
+			destinationPathArgKey,
+			isDestinationPathArgOptional,
+			emptyDestinationPathArg,
+			file_system_path_arg.BypassDefaultValidationFunc,
+		),
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	kurtosisBackend backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	_ *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave identifier using arg key '%v'", enclaveIdentifierArgKey)
+	}
+
+	persistentKeyStr, err := args.GetNonGreedyArg(persistentKeyArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the persistent key using arg key '%v'", persistentKeyArgKey)
+	}
+	persistentKey := service_directory.DirectoryPersistentKey(persistentKeyStr)
+
+	destinationPath, err := args.GetNonGreedyArg(destinationPathArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the destination path using arg key '%v'", destinationPathArgKey)
+	}
+	absoluteDestinationPath, err := filepath.Abs(destinationPath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while getting absolute path for the passed destination path '%v'", destinationPath)
+	}
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred connecting to the local Kurtosis engine")
+	}
+	enclaveCtx, err := kurtosisCtx.GetEnclaveContext(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave context for enclave '%v'", enclaveIdentifier)
+	}
+	enclaveUuid := enclave.EnclaveUUID(enclaveCtx.GetEnclaveUuid())
+
+	existingPersistentKeys, err := kurtosisBackend.GetPersistentDirectoryKeys(ctx, enclaveUuid)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the persistent directories of enclave '%v'", enclaveIdentifier)
+	}
+	if !existingPersistentKeys[persistentKey] {
+		return stacktrace.NewError(
+			"Persistent directory '%v' doesn't exist in enclave '%v'; the existing ones are: %v",
+			persistentKey,
+			enclaveIdentifier,
+			getSortedPersistentKeysStr(existingPersistentKeys),
+		)
+	}
+
+	if isGzipArchivePath(absoluteDestinationPath) || strings.HasSuffix(absoluteDestinationPath, tarExtension) {
+		if err := exportToArchive(ctx, kurtosisBackend, enclaveUuid, persistentKey, absoluteDestinationPath); err != nil {
+			return stacktrace.Propagate(err, "An error occurred exporting persistent directory '%v' to '%v'", persistentKey, absoluteDestinationPath)
+		}
+	} else {
+		if err := exportToDirectory(ctx, kurtosisBackend, enclaveUuid, persistentKey, absoluteDestinationPath); err != nil {
+			return stacktrace.Propagate(err, "An error occurred exporting persistent directory '%v' into '%v'", persistentKey, absoluteDestinationPath)
+		}
+	}
+	out.PrintOutLn(fmt.Sprintf("Exported persistent directory '%v' to '%v'", persistentKey, absoluteDestinationPath))
+	return nil
+}
+
+func exportToArchive(
+	ctx context.Context,
+	kurtosisBackend backend_interface.KurtosisBackend,
+	enclaveUuid enclave.EnclaveUUID,
+	persistentKey service_directory.DirectoryPersistentKey,
+	archiveFilepath string,
+) error {
+	archiveFile, err := os.OpenFile(archiveFilepath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, destinationFilePermission)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating file '%v'", archiveFilepath)
+	}
+	defer archiveFile.Close()
+
+	if !isGzipArchivePath(archiveFilepath) {
+		if err := kurtosisBackend.ExportPersistentDirectory(ctx, enclaveUuid, persistentKey, archiveFile); err != nil {
+			return stacktrace.Propagate(err, "An error occurred exporting the contents of persistent directory '%v'", persistentKey)
+		}
+		return nil
+	}
+	gzipWriter := gzip.NewWriter(archiveFile)
+	if err := kurtosisBackend.ExportPersistentDirectory(ctx, enclaveUuid, persistentKey, gzipWriter); err != nil {
+		return stacktrace.Propagate(err, "An error occurred exporting the contents of persistent directory '%v'", persistentKey)
+	}
+	// Closing flushes the end of the compressed stream
+	if err := gzipWriter.Close(); err != nil {
+		return stacktrace.Propagate(err, "An error occurred compressing the contents of persistent directory '%v'", persistentKey)
+	}
+	return nil
+}
+
+func exportToDirectory(
+	ctx context.Context,
+	kurtosisBackend backend_interface.KurtosisBackend,
+	enclaveUuid enclave.EnclaveUUID,
+	persistentKey service_directory.DirectoryPersistentKey,
+	destinationDirpath string,
+) error {
+	tempTarFile, err := os.CreateTemp("", tempTarFilenamePattern)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating a temporary file to export persistent directory '%v' to", persistentKey)
+	}
+	defer func() {
+		tempTarFile.Close()
+		if err := os.Remove(tempTarFile.Name()); err != nil {
+			logrus.Warnf("Couldn't remove temporary file '%v':\n%v", tempTarFile.Name(), err)
+		}
+	}()
+
+	if err := kurtosisBackend.ExportPersistentDirectory(ctx, enclaveUuid, persistentKey, tempTarFile); err != nil {
+		return stacktrace.Propagate(err, "An error occurred exporting the contents of persistent directory '%v'", persistentKey)
+	}
+	if err := tempTarFile.Close(); err != nil {
+		return stacktrace.Propagate(err, "An error occurred closing temporary file '%v'", tempTarFile.Name())
+	}
+	if err := path_compression.Unarchive(tempTarFile.Name(), destinationDirpath); err != nil {
+		return stacktrace.Propagate(err, "An error occurred extracting the contents of persistent directory '%v' into '%v'", persistentKey, destinationDirpath)
+	}
+	return nil
+}
+
+func isGzipArchivePath(path string) bool {
+	for _, extension := range gzipArchiveExtensions {
+		if strings.HasSuffix(path, extension) {
+			return true
+		}
+	}
+	return false
+}
+
+func getSortedPersistentKeysStr(persistentKeys map[service_directory.DirectoryPersistentKey]bool) string {
+	if len(persistentKeys) == 0 {
+		return noPersistentKeysFoundStr
+	}
+	persistentKeyStrs := []string{}
+	for persistentKey := range persistentKeys {
+		persistentKeyStrs = append(persistentKeyStrs, fmt.Sprintf("'%v'", persistentKey))
+	}
+	sort.Strings(persistentKeyStrs)
+	return strings.Join(persistentKeyStrs, persistentKeysSeparator)
+}
@@ -2,7 +2,11 @@ package files
 
 import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/files/diff"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/files/download"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/files/exportvolume"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/files/history"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/files/importvolume"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/files/inspect"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/files/rendertemplate"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/files/storeservice"
@@ -27,4 +31,8 @@ func init() {
 	FilesCmd.AddCommand(rendertemplate.RenderTemplateCommand.MustGetCobraCommand())
 	FilesCmd.AddCommand(inspect.FilesInspectCmd.MustGetCobraCommand())
 	FilesCmd.AddCommand(download.FilesUploadCmd.MustGetCobraCommand())
+	FilesCmd.AddCommand(history.FilesHistoryCmd.MustGetCobraCommand())
+	FilesCmd.AddCommand(diff.FilesDiffCmd.MustGetCobraCommand())
+	FilesCmd.AddCommand(exportvolume.FilesExportVolumeCmd.MustGetCobraCommand())
+	FilesCmd.AddCommand(importvolume.FilesImportVolumeCmd.MustGetCobraCommand())
 }
@@ -0,0 +1,125 @@
+package history
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/artifact_identifier_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/cluster_federation"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	enclaveIdentifierArgKey = "enclave"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	artifactIdentifierArgKey        = "artifact-identifier"
+	isArtifactIdentifierArgOptional = false
+	isArtifactIdentifierArgGreedy   = false
+
+	versionColumnHeader      = "Version"
+	creationTimeColumnHeader = "Created"
+	producerColumnHeader     = "Producer"
+
+	versionFormatStr        = "v%d"
+	currentVersionFormatStr = "v%d (current)"
+	unknownValueStr         = "<unknown>"
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+)
+
+var FilesHistoryCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.FilesHistoryCmdStr,
+	ShortDescription: "Lists the versions of a files artifact",
+	LongDescription: "Lists the versions kept of the given files artifact, oldest first, along with when and by what they were produced. " +
+		"A version can be inspected, downloaded or mounted by suffixing the files artifact name with '@v<version>', e.g. 'my-config@v2'",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags:                     []*flags.FlagConfig{},
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+		artifact_identifier_arg.NewArtifactIdentifierArg(
+			artifactIdentifierArgKey,
+			enclaveIdentifierArgKey,
+			isArtifactIdentifierArgOptional,
+			isArtifactIdentifierArgGreedy,
+		),
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	_ *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave identifier value using key '%v'", enclaveIdentifierArgKey)
+	}
+
+	artifactIdentifier, err := args.GetNonGreedyArg(artifactIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the files artifact identifier value using key '%v'", artifactIdentifierArgKey)
+	}
+
+	// The enclave identifier may be qualified with the cluster it lives in, e.g. 'minikube/my-enclave'
+	kurtosisCtx, bareEnclaveIdentifier, closeKurtosisCtx, err := cluster_federation.NewKurtosisContextForEnclave(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred connecting to the Kurtosis engine of enclave '%v'", enclaveIdentifier)
+	}
+	defer closeKurtosisCtx()
+
+	enclaveCtx, err := kurtosisCtx.GetEnclaveContext(ctx, bareEnclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave context for enclave '%v'", enclaveIdentifier)
+	}
+
+	history, err := enclaveCtx.GetFilesArtifactHistory(ctx, artifactIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the history of files artifact '%v' in enclave '%v'", artifactIdentifier, enclaveIdentifier)
+	}
+
+	tablePrinter := output_printers.NewTablePrinter(versionColumnHeader, creationTimeColumnHeader, producerColumnHeader)
+	for _, version := range history.GetVersions() {
+		versionStr := fmt.Sprintf(versionFormatStr, version.GetVersion())
+		if version.GetIsCurrent() {
+			versionStr = fmt.Sprintf(currentVersionFormatStr, version.GetVersion())
+		}
+		// Files artifacts stored before versions were recorded have neither a creation time nor a producer
+		creationTimeStr := unknownValueStr
+		if version.GetCreationTime() != nil {
+			creationTimeStr = version.GetCreationTime().AsTime().Local().Format(time.RFC1123)
+		}
+		producer := version.GetProducer()
+		if producer == "" {
+			producer = unknownValueStr
+		}
+		if err := tablePrinter.AddRow(versionStr, creationTimeStr, producer); err != nil {
+			return stacktrace.NewError("An error occurred adding row for version '%v' of files artifact '%v' to the table printer", version.GetVersion(), artifactIdentifier)
+		}
+	}
+	tablePrinter.Print()
+
+	return nil
+}
@@ -0,0 +1,195 @@
+package importvolume
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/file_system_path_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
+	"github.com/kurtosis-tech/kurtosis/path-compression"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	enclaveIdentifierArgKey = "enclave"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	persistentKeyArgKey        = "persistent-key"
+	isPersistentKeyArgOptional = false
+	isPersistentKeyArgGreedy   = false
+
+	sourcePathArgKey        = "source-path"
+	isSourcePathArgOptional = false
+	emptySourcePathArg      = ""
+
+	// Only used on Kubernetes, when the persistent directory doesn't exist yet; Docker volumes can't be sized
+	sizeFlagKey     = "size"
+	sizeFlagDefault = "1024"
+
+	megaByteToByteMultiplier = 1024 * 1024
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+)
+
+var gzipMagicBytes = []byte{0x1f, 0x8b}
+
+var FilesImportVolumeCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.FilesImportVolumeCmdStr,
+	ShortDescription: "Import files into a persistent directory",
+	LongDescription: "Replaces the contents of the persistent directory with the given key by the files of a local directory, " +
+		"or of a plain or gzipped tarball, creating the persistent directory if it doesn't exist yet. Services mounting it " +
+		"see the new contents right away, so it's safer to stop them while importing",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags: []*flags.FlagConfig{
+		{
+			Key:     sizeFlagKey,
+			Usage:   "The size in megabytes of the persistent directory when it has to be created; only Kubernetes takes it into account",
+			Type:    flags.FlagType_Uint32,
+			Default: sizeFlagDefault,
+		},
+	},
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+		{
+			Key:        persistentKeyArgKey,
+			IsOptional: isPersistentKeyArgOptional,
+			IsGreedy:   isPersistentKeyArgGreedy,
+		},
+		file_system_path_arg.NewFilepathOrDirpathArg(
+			sourcePathArgKey,
+			isSourcePathArgOptional,
+			emptySourcePathArg,
+			file_system_path_arg.DefaultValidationFunc,
+		),
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	kurtosisBackend backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave identifier using arg key '%v'", enclaveIdentifierArgKey)
+	}
+
+	persistentKeyStr, err := args.GetNonGreedyArg(persistentKeyArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the persistent key using arg key '%v'", persistentKeyArgKey)
+	}
+	persistentKey := service_directory.DirectoryPersistentKey(persistentKeyStr)
+	if !service_directory.IsPersistentKeyValid(persistentKey) {
+		return stacktrace.NewError("Persistent key '%v' is invalid; it must match the regex '%v'", persistentKey, service_directory.WordWrappedPersistentKeyRegex)
+	}
+
+	sourcePath, err := args.GetNonGreedyArg(sourcePathArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the source path using arg key '%v'", sourcePathArgKey)
+	}
+
+	sizeMegabytes, err := flags.GetUint32(sizeFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the size flag '%v'", sizeFlagKey)
+	}
+	size := service_directory.DirectoryPersistentSize(int64(sizeMegabytes) * megaByteToByteMultiplier)
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred connecting to the local Kurtosis engine")
+	}
+	enclaveCtx, err := kurtosisCtx.GetEnclaveContext(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave context for enclave '%v'", enclaveIdentifier)
+	}
+	enclaveUuid := enclave.EnclaveUUID(enclaveCtx.GetEnclaveUuid())
+
+	tarContent, closeTarContent, err := openSourceAsTar(ctx, sourcePath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred reading '%v'", sourcePath)
+	}
+	defer closeTarContent()
+
+	if err := kurtosisBackend.ImportPersistentDirectory(ctx, enclaveUuid, persistentKey, size, tarContent); err != nil {
+		return stacktrace.Propagate(err, "An error occurred importing '%v' into persistent directory '%v' of enclave '%v'", sourcePath, persistentKey, enclaveIdentifier)
+	}
+	out.PrintOutLn(fmt.Sprintf("Imported '%v' into persistent directory '%v'", sourcePath, persistentKey))
+	return nil
+}
+
+// openSourceAsTar returns the files to import as an uncompressed TAR, archiving them on the fly if the source is a
+// directory, alongside the function releasing what was opened to read them
+func openSourceAsTar(ctx context.Context, sourcePath string) (io.Reader, func(), error) {
+	sourceInfo, err := os.Stat(sourcePath)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred inspecting '%v'", sourcePath)
+	}
+
+	if sourceInfo.IsDir() {
+		relativeFilepaths, err := path_compression.ListFilesInPath(sourcePath)
+		if err != nil {
+			return nil, nil, stacktrace.Propagate(err, "An error occurred listing the files in '%v'", sourcePath)
+		}
+		archiveReader, archiveWriter := io.Pipe()
+		go func() {
+			// A nil error closes the pipe normally, which the reading side sees as the end of the archive
+			archiveWriter.CloseWithError(path_compression.ArchiveFilesToTar(ctx, sourcePath, relativeFilepaths, archiveWriter))
+		}()
+		return archiveReader, func() { archiveReader.Close() }, nil
+	}
+
+	sourceFile, err := os.Open(sourcePath)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred opening '%v'", sourcePath)
+	}
+	tarContent, err := decompressIfGzipped(sourceFile)
+	if err != nil {
+		sourceFile.Close()
+		return nil, nil, stacktrace.Propagate(err, "An error occurred decompressing '%v'", sourcePath)
+	}
+	return tarContent, func() { sourceFile.Close() }, nil
+}
+
+func decompressIfGzipped(content io.Reader) (io.Reader, error) {
+	bufferedContent := bufio.NewReader(content)
+	header, err := bufferedContent.Peek(len(gzipMagicBytes))
+	if err != nil && err != io.EOF {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the header of the content")
+	}
+	if !bytes.Equal(header, gzipMagicBytes) {
+		return bufferedContent, nil
+	}
+	gzipReader, err := gzip.NewReader(bufferedContent)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred opening the gzipped content")
+	}
+	return gzipReader, nil
+}
@@ -0,0 +1,75 @@
+package importvolume
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testFilename    = "genesis.json"
+	testFileContent = "{}"
+)
+
+func TestOpenSourceAsTar_ArchivesDirectory(t *testing.T) {
+	dirpath := t.TempDir()
+	require.NoError(t, os.WriteFile(path.Join(dirpath, testFilename), []byte(testFileContent), 0o644))
+
+	tarContent, closeTarContent, err := openSourceAsTar(context.Background(), dirpath)
+	require.NoError(t, err)
+	defer closeTarContent()
+	requireTarWithSingleFile(t, tarContent)
+}
+
+func TestOpenSourceAsTar_DecompressesGzippedTarball(t *testing.T) {
+	tarballFilepath := path.Join(t.TempDir(), "genesis.tgz")
+	gzippedContent := &bytes.Buffer{}
+	gzipWriter := gzip.NewWriter(gzippedContent)
+	writeTarWithSingleFile(t, gzipWriter)
+	require.NoError(t, gzipWriter.Close())
+	require.NoError(t, os.WriteFile(tarballFilepath, gzippedContent.Bytes(), 0o644))
+
+	tarContent, closeTarContent, err := openSourceAsTar(context.Background(), tarballFilepath)
+	require.NoError(t, err)
+	defer closeTarContent()
+	requireTarWithSingleFile(t, tarContent)
+}
+
+func TestOpenSourceAsTar_PassesPlainTarballThrough(t *testing.T) {
+	tarballFilepath := path.Join(t.TempDir(), "genesis.tar")
+	plainContent := &bytes.Buffer{}
+	writeTarWithSingleFile(t, plainContent)
+	require.NoError(t, os.WriteFile(tarballFilepath, plainContent.Bytes(), 0o644))
+
+	tarContent, closeTarContent, err := openSourceAsTar(context.Background(), tarballFilepath)
+	require.NoError(t, err)
+	defer closeTarContent()
+	requireTarWithSingleFile(t, tarContent)
+}
+
+func writeTarWithSingleFile(t *testing.T, output io.Writer) {
+	tarWriter := tar.NewWriter(output)
+	require.NoError(t, tarWriter.WriteHeader(&tar.Header{Name: testFilename, Mode: 0o644, Size: int64(len(testFileContent))}))
+	_, err := tarWriter.Write([]byte(testFileContent))
+	require.NoError(t, err)
+	require.NoError(t, tarWriter.Close())
+}
+
+func requireTarWithSingleFile(t *testing.T, tarContent io.Reader) {
+	tarReader := tar.NewReader(tarContent)
+	header, err := tarReader.Next()
+	require.NoError(t, err)
+	require.Equal(t, testFilename, header.Name)
+	content, err := io.ReadAll(tarReader)
+	require.NoError(t, err)
+	require.Equal(t, testFileContent, string(content))
+	_, err = tarReader.Next()
+	require.Equal(t, io.EOF, err)
+}
@@ -3,6 +3,7 @@ package gateway
 import (
 	"context"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/engine_tls"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/kurtosis_config_getter"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_gateway/connection"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_gateway/run/engine_gateway"
@@ -43,7 +44,11 @@ func run(cmd *cobra.Command, args []string) error {
 		return stacktrace.Propagate(err, "An error occurred creating Kubernetes configuration")
 	}
 
-	connectionProvider, err := connection.NewGatewayConnectionProvider(ctx, kubernetesConfig)
+	engineTlsConfig, err := engine_tls.GetEngineTlsConfig(clusterConfig.GetEngineTlsConfig())
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the engine TLS config")
+	}
+	connectionProvider, err := connection.NewGatewayConnectionProvider(ctx, kubernetesConfig, engineTlsConfig)
 	if err != nil {
 		return stacktrace.Propagate(err, "Expected to be able to instantiate a gateway connection provider, instead a non-nil error was returned")
 	}
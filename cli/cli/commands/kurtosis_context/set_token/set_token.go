@@ -0,0 +1,72 @@
+package set_token
+
+import (
+	"context"
+
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/context_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/contexts-config-store/store"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	contextIdentifierArgKey      = "context"
+	contextIdentifierArgIsGreedy = false
+
+	engineApiTokenArgKey = "token"
+	noEngineApiToken     = ""
+)
+
+var ContextSetTokenCmd = &lowlevel.LowlevelKurtosisCommand{
+	CommandStr:       command_str_consts.ContextSetTokenCmdStr,
+	ShortDescription: "Sets the engine API token of a Kurtosis context",
+	LongDescription: "Stores the API token the CLI sends to the engine of a Kurtosis context, for engines that " +
+		"require authentication. Omitting the token removes the stored one.",
+	Flags: []*flags.FlagConfig{},
+	Args: []*args.ArgConfig{
+		context_id_arg.NewContextIdentifierArg(store.GetContextsConfigStore(), contextIdentifierArgKey, contextIdentifierArgIsGreedy),
+		{
+			Key:          engineApiTokenArgKey,
+			IsOptional:   true,
+			DefaultValue: noEngineApiToken,
+		},
+	},
+	PreValidationAndRunFunc:  nil,
+	RunFunc:                  run,
+	PostValidationAndRunFunc: nil,
+}
+
+func run(_ context.Context, _ *flags.ParsedFlags, args *args.ParsedArgs) error {
+	contextIdentifier, err := args.GetNonGreedyArg(contextIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for context identifier arg '%v' but none was found; this is a bug in the Kurtosis CLI!", contextIdentifierArgKey)
+	}
+	engineApiToken, err := args.GetNonGreedyArg(engineApiTokenArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for engine API token arg '%v' but none was found; this is a bug in the Kurtosis CLI!", engineApiTokenArgKey)
+	}
+
+	contextsConfigStore := store.GetContextsConfigStore()
+	contextsMatchingIdentifiers, err := context_id_arg.GetContextUuidForContextIdentifier(contextsConfigStore, []string{contextIdentifier})
+	if err != nil {
+		return stacktrace.Propagate(err, "Error searching for context matching context identifier: '%s'", contextIdentifier)
+	}
+	contextUuid, found := contextsMatchingIdentifiers[contextIdentifier]
+	if !found {
+		return stacktrace.NewError("No context matching identifier '%s' could be found", contextIdentifier)
+	}
+
+	if err = contextsConfigStore.SetEngineApiToken(contextUuid, engineApiToken); err != nil {
+		return stacktrace.Propagate(err, "An error occurred storing the engine API token of context '%s'", contextIdentifier)
+	}
+	if engineApiToken == noEngineApiToken {
+		logrus.Infof("Removed the engine API token of context '%s'", contextIdentifier)
+	} else {
+		logrus.Infof("Stored the engine API token of context '%s'", contextIdentifier)
+	}
+	return nil
+}
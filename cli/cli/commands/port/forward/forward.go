@@ -0,0 +1,315 @@
+package forward
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"os/signal"
+	"sort"
+	"syscall"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/port/live_user_service_port_forwarder"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/engine_tls"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/kurtosis_config_getter"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/resolved_config"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_gateway/connection"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_gateway/run/engine_gateway"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/contexts-config-store/store"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+
+	enclaveIdentifierArgKey = "enclave"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	// The service identifier and the port ID, in this order
+	serviceAndPortIdentifiersArgKey        = "service-and-port-id"
+	isServiceAndPortIdentifiersArgOptional = true
+	isServiceAndPortIdentifiersArgGreedy   = true
+	serviceIdentifierIndex                 = 0
+	portIdentifierIndex                    = 1
+	numServiceAndPortIdentifiers           = 2
+
+	localPortFlagKey     = "local-port"
+	localPortFlagDefault = "0"
+
+	allFlagKey     = "all"
+	allFlagDefault = "false"
+
+	localHostIpStr = "127.0.0.1"
+
+	serviceColumnHeader = "Service"
+	portIdColumnHeader  = "Port ID"
+	urlColumnHeader     = "URL"
+
+	interruptChanBufferSize = 5
+)
+
+var PortForwardCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.PortForwardCmdStr,
+	ShortDescription: "Forward service ports to the host on Kubernetes",
+	LongDescription: fmt.Sprintf(
+		"Forwards a port of a service running in a Kubernetes enclave to a local port, until interrupted. "+
+			"The port forward reconnects automatically when the pod of the service restarts. "+
+			"Use the '--%v' flag instead of passing a service and a port ID to forward every port of every service in the enclave.",
+		allFlagKey,
+	),
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags: []*flags.FlagConfig{
+		{
+			Key:     localPortFlagKey,
+			Usage:   "The local port to forward the service port to. A random free port is used if not set",
+			Type:    flags.FlagType_Uint32,
+			Default: localPortFlagDefault,
+		},
+		{
+			Key:     allFlagKey,
+			Usage:   "Forward every port of every service in the enclave to random local ports, and print a table of their URLs",
+			Type:    flags.FlagType_Bool,
+			Default: allFlagDefault,
+		},
+	},
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+		{
+			Key:          serviceAndPortIdentifiersArgKey,
+			IsOptional:   isServiceAndPortIdentifiersArgOptional,
+			IsGreedy:     isServiceAndPortIdentifiersArgGreedy,
+			DefaultValue: []string{},
+		},
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	kurtosisBackend backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave identifier using arg key '%v'", enclaveIdentifierArgKey)
+	}
+
+	serviceAndPortIdentifiers, err := args.GetGreedyArg(serviceAndPortIdentifiersArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the service and port identifiers using arg key '%v'", serviceAndPortIdentifiersArgKey)
+	}
+
+	localPort, err := flags.GetUint32(localPortFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the local port using flag key '%v'", localPortFlagKey)
+	}
+	if localPort > math.MaxUint16 {
+		return stacktrace.NewError("The local port '%v' is not a valid port number", localPort)
+	}
+
+	shouldForwardAllPorts, err := flags.GetBool(allFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the all flag using flag key '%v'", allFlagKey)
+	}
+
+	if shouldForwardAllPorts {
+		if len(serviceAndPortIdentifiers) != 0 {
+			return stacktrace.NewError("A service and a port ID can't be passed together with the '--%v' flag", allFlagKey)
+		}
+		if localPort != 0 {
+			return stacktrace.NewError("The '--%v' flag can't be used together with the '--%v' flag", localPortFlagKey, allFlagKey)
+		}
+	} else if len(serviceAndPortIdentifiers) != numServiceAndPortIdentifiers {
+		return stacktrace.NewError("Expected a service identifier and a port ID, or the '--%v' flag, but got '%v'", allFlagKey, serviceAndPortIdentifiers)
+	}
+
+	clusterConfig, err := kurtosis_config_getter.GetKurtosisClusterConfig()
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected to be able to get Kurtosis cluster configuration, instead a non-nil error was returned")
+	}
+	if clusterConfig.GetClusterType() != resolved_config.KurtosisClusterType_Kubernetes {
+		return stacktrace.NewError(
+			"Port forwarding is only needed on Kubernetes clusters, but the current cluster is of type '%v'; its service ports are already published on the host, use '%v %v %v' to get them",
+			clusterConfig.GetClusterType(),
+			command_str_consts.KurtosisCmdStr,
+			command_str_consts.PortCmdStr,
+			command_str_consts.PortPrintCmdStr,
+		)
+	}
+
+	kubernetesConfig, err := kubernetes_kurtosis_backend.GetCLIKubernetesConfigForCluster(clusterConfig.GetKubernetesClusterName())
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the configuration for Kubernetes cluster '%v'", clusterConfig.GetKubernetesClusterName())
+	}
+	engineTlsConfig, err := engine_tls.GetEngineTlsConfig(clusterConfig.GetEngineTlsConfig())
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the engine TLS config")
+	}
+	connectionProvider, err := connection.NewGatewayConnectionProvider(ctx, kubernetesConfig, engineTlsConfig)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected to be able to instantiate a gateway connection provider, instead a non-nil error was returned")
+	}
+
+	// The engine isn't reachable from the host on Kubernetes, so it's reached through a gateway of our own rather than
+	// requiring a 'kurtosis gateway' or the Kurtosis Portal to be running
+	currentContext, err := store.GetContextsConfigStore().GetCurrentContext()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the current Kurtosis context")
+	}
+	gatewayAddress, stopGatewayFunc, err := engine_gateway.RunEngineGatewayInBackground(kurtosisBackend, connectionProvider)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred starting a gateway to the Kurtosis engine")
+	}
+	defer stopGatewayFunc()
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromEngineAddressWithCredentials(gatewayAddress, currentContext.GetEngineApiToken(), connectionProvider.GetTransportCredentials())
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred connecting to the Kurtosis engine through the gateway at '%v'", gatewayAddress)
+	}
+	enclaveCtx, err := kurtosisCtx.GetEnclaveContext(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave context for enclave '%v'", enclaveIdentifier)
+	}
+
+	var serviceIdentifiers []string
+	if shouldForwardAllPorts {
+		serviceNamesToUuids, err := enclaveCtx.GetServices()
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the services in enclave '%v'", enclaveIdentifier)
+		}
+		for serviceName := range serviceNamesToUuids {
+			serviceIdentifiers = append(serviceIdentifiers, string(serviceName))
+		}
+		sort.Strings(serviceIdentifiers)
+	} else {
+		serviceIdentifiers = []string{serviceAndPortIdentifiers[serviceIdentifierIndex]}
+	}
+
+	portForwarders := []*live_user_service_port_forwarder.LiveUserServicePortForwarder{}
+	defer func() {
+		for _, portForwarder := range portForwarders {
+			portForwarder.Stop()
+		}
+	}()
+	tablePrinter := output_printers.NewTablePrinter(serviceColumnHeader, portIdColumnHeader, urlColumnHeader)
+	for _, serviceIdentifier := range serviceIdentifiers {
+		serviceCtx, err := enclaveCtx.GetServiceContext(serviceIdentifier)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the service context for service '%v'", serviceIdentifier)
+		}
+
+		privatePorts := serviceCtx.GetPrivatePorts()
+		localPortNumbers := map[string]uint16{}
+		if !shouldForwardAllPorts {
+			portIdentifier := serviceAndPortIdentifiers[portIdentifierIndex]
+			privatePort, found := privatePorts[portIdentifier]
+			if !found {
+				return stacktrace.NewError("Port ID '%v' is not found for service '%v' in enclave '%v'", portIdentifier, serviceIdentifier, enclaveIdentifier)
+			}
+			privatePorts = map[string]*services.PortSpec{portIdentifier: privatePort}
+			if localPort != 0 {
+				localPortNumbers[portIdentifier] = uint16(localPort)
+			}
+		}
+
+		remotePortSpecs, err := getRemotePortSpecs(privatePorts)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the ports to forward for service '%v'", serviceIdentifier)
+		}
+		if len(remotePortSpecs) == 0 {
+			continue
+		}
+
+		portForwarder := live_user_service_port_forwarder.NewLiveUserServicePortForwarder(
+			kurtosisBackend,
+			connectionProvider,
+			enclave.EnclaveUUID(enclaveCtx.GetEnclaveUuid()),
+			service.ServiceUUID(serviceCtx.GetServiceUUID()),
+			service.ServiceName(serviceCtx.GetServiceName()),
+			remotePortSpecs,
+			localPortNumbers,
+		)
+		if err := portForwarder.Start(); err != nil {
+			return stacktrace.Propagate(err, "An error occurred forwarding the ports of service '%v'", serviceIdentifier)
+		}
+		portForwarders = append(portForwarders, portForwarder)
+
+		for _, portId := range getSortedPortIds(portForwarder.GetLocalPortNumbers()) {
+			url := formatLocalUrl(privatePorts[portId].GetMaybeApplicationProtocol(), portForwarder.GetLocalPortNumbers()[portId])
+			if err := tablePrinter.AddRow(string(serviceCtx.GetServiceName()), portId, url); err != nil {
+				return stacktrace.Propagate(err, "An error occurred adding the row for port '%v' of service '%v' to the table", portId, serviceIdentifier)
+			}
+		}
+	}
+	tablePrinter.Print()
+
+	out.PrintOutLn("\nForwarding the ports above until interrupted, press Ctrl+C to stop")
+	interruptChan := make(chan os.Signal, interruptChanBufferSize)
+	signal.Notify(interruptChan, os.Interrupt, syscall.SIGTERM)
+	select {
+	case <-interruptChan:
+	case <-ctx.Done():
+	}
+	return nil
+}
+
+// Kubernetes port-forwarding only supports TCP, so the other ports are skipped with a warning
+func getRemotePortSpecs(privatePorts map[string]*services.PortSpec) (map[string]*port_spec.PortSpec, error) {
+	remotePortSpecs := map[string]*port_spec.PortSpec{}
+	for portId, privatePort := range privatePorts {
+		if privatePort.GetTransportProtocol() != services.TransportProtocol_TCP {
+			logrus.Warnf("Port '%v' won't be forwarded as it uses protocol '%v', but Kubernetes port forwarding only supports TCP", portId, kurtosis_core_rpc_api_bindings.Port_TransportProtocol(privatePort.GetTransportProtocol()).String())
+			continue
+		}
+		remotePortSpec, err := port_spec.NewPortSpec(privatePort.GetNumber(), port_spec.TransportProtocol_TCP, privatePort.GetMaybeApplicationProtocol(), nil, "")
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating the port spec for port '%v'", portId)
+		}
+		remotePortSpecs[portId] = remotePortSpec
+	}
+	return remotePortSpecs, nil
+}
+
+func getSortedPortIds(localPortNumbers map[string]uint16) []string {
+	portIds := []string{}
+	for portId := range localPortNumbers {
+		portIds = append(portIds, portId)
+	}
+	sort.Strings(portIds)
+	return portIds
+}
+
+func formatLocalUrl(maybeApplicationProtocol string, localPortNumber uint16) string {
+	if maybeApplicationProtocol == "" {
+		return fmt.Sprintf("%v:%v", localHostIpStr, localPortNumber)
+	}
+	return fmt.Sprintf("%v://%v:%v", maybeApplicationProtocol, localHostIpStr, localPortNumber)
+}
@@ -0,0 +1,25 @@
+package forward
+
+import (
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatLocalUrl(t *testing.T) {
+	require.Equal(t, "http://127.0.0.1:8080", formatLocalUrl("http", 8080))
+	require.Equal(t, "127.0.0.1:5432", formatLocalUrl("", 5432))
+}
+
+func TestGetRemotePortSpecs_SkipsNonTcpPorts(t *testing.T) {
+	privatePorts := map[string]*services.PortSpec{
+		"http":      services.NewPortSpec(80, services.TransportProtocol_TCP, "http"),
+		"discovery": services.NewPortSpec(30303, services.TransportProtocol_UDP, ""),
+	}
+	remotePortSpecs, err := getRemotePortSpecs(privatePorts)
+	require.NoError(t, err)
+	require.Len(t, remotePortSpecs, 1)
+	require.Equal(t, uint16(80), remotePortSpecs["http"].GetNumber())
+	require.Equal(t, "http", *remotePortSpecs["http"].GetMaybeApplicationProtocol())
+}
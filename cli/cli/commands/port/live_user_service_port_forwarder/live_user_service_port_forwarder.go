@@ -0,0 +1,154 @@
+package live_user_service_port_forwarder
+
+import (
+	"context"
+
+	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_gateway/connection"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_gateway/live_engine_client_supplier"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+// Class that will constantly poll the Kubernetes backend, like the LiveEngineClientSupplier does for the engine, to keep
+// the ports of a user service forwarded to the same local ports, reconnecting when the pod of the service is restarted
+type LiveUserServicePortForwarder struct {
+	kubernetesBackend backend_interface.KurtosisBackend
+
+	connectionProvider *connection.GatewayConnectionProvider
+
+	enclaveUuid enclave.EnclaveUUID
+
+	serviceUuid service.ServiceUUID
+
+	serviceName service.ServiceName
+
+	// Port spec ID -> port spec of the ports of the service to forward
+	remotePortSpecs map[string]*port_spec.PortSpec
+
+	// Port spec ID -> local port number. The local ports bound by the first connection are kept here so that the
+	// reconnections bind the same ones
+	localPortNumbers map[string]uint16
+
+	currentConnection connection.GatewayConnectionToKurtosis
+
+	stopUpdaterSignalChan chan interface{}
+}
+
+func NewLiveUserServicePortForwarder(
+	kurtosisBackend backend_interface.KurtosisBackend,
+	connectionProvider *connection.GatewayConnectionProvider,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	serviceName service.ServiceName,
+	remotePortSpecs map[string]*port_spec.PortSpec,
+	localPortNumbers map[string]uint16,
+) *LiveUserServicePortForwarder {
+	return &LiveUserServicePortForwarder{
+		kubernetesBackend:     kurtosisBackend,
+		connectionProvider:    connectionProvider,
+		enclaveUuid:           enclaveUuid,
+		serviceUuid:           serviceUuid,
+		serviceName:           serviceName,
+		remotePortSpecs:       remotePortSpecs,
+		localPortNumbers:      localPortNumbers,
+		currentConnection:     nil,
+		stopUpdaterSignalChan: nil,
+	}
+}
+
+// Start forwards the ports of the service, failing if the service isn't running, and starts watching it to reconnect
+// when its pod is restarted
+func (forwarder *LiveUserServicePortForwarder) Start() error {
+	if forwarder.stopUpdaterSignalChan != nil {
+		return stacktrace.NewError("Cannot start live port forwarder for service '%v' because it's already started", forwarder.serviceName)
+	}
+	if err := forwarder.connect(); err != nil {
+		return stacktrace.Propagate(err, "An error occurred forwarding the ports of service '%v'", forwarder.serviceName)
+	}
+	// From now on reconnections must bind the same local ports, so that the addresses given to the user stay valid
+	localPortNumbers := map[string]uint16{}
+	for portId, localPortSpec := range forwarder.currentConnection.GetLocalPorts() {
+		localPortNumbers[portId] = localPortSpec.GetNumber()
+	}
+	forwarder.localPortNumbers = localPortNumbers
+
+	forwarder.stopUpdaterSignalChan = live_engine_client_supplier.StartPolling(forwarder.replaceConnectionIfNecessaryBestEffort)
+	return nil
+}
+
+// GetLocalPortNumbers returns the local port numbers forwarded to the ports of the service, keyed by port spec ID
+// Ports that couldn't be forwarded (e.g. UDP ports) are not in the map
+func (forwarder *LiveUserServicePortForwarder) GetLocalPortNumbers() map[string]uint16 {
+	return forwarder.localPortNumbers
+}
+
+func (forwarder *LiveUserServicePortForwarder) Stop() {
+	if forwarder.stopUpdaterSignalChan == nil {
+		return
+	}
+	forwarder.stopUpdaterSignalChan <- nil
+	forwarder.stopUpdaterSignalChan = nil
+	if forwarder.currentConnection != nil {
+		forwarder.currentConnection.Stop()
+		forwarder.currentConnection = nil
+	}
+}
+
+// This function will check whether the service is running and compare it with the current state of the forwarder.
+// The connection is dropped while the service isn't running and re-created once it is running again.
+// NOT thread-safe!
+func (forwarder *LiveUserServicePortForwarder) replaceConnectionIfNecessaryBestEffort() {
+	runningServiceFilters := &service.ServiceFilters{
+		Names: nil,
+		UUIDs: map[service.ServiceUUID]bool{
+			forwarder.serviceUuid: true,
+		},
+		Statuses: map[container.ContainerStatus]bool{
+			container.ContainerStatus_Running: true,
+		},
+	}
+	runningServices, err := forwarder.kubernetesBackend.GetUserServices(context.Background(), forwarder.enclaveUuid, runningServiceFilters)
+	if err != nil {
+		logrus.Errorf("An error occurred checking whether service '%v' is running:\n%v", forwarder.serviceName, err)
+		return
+	}
+
+	if len(runningServices) == 0 {
+		if forwarder.currentConnection != nil {
+			logrus.Infof("Service '%v' is not running anymore; its ports will be forwarded again once it's back", forwarder.serviceName)
+			forwarder.currentConnection.Stop()
+			forwarder.currentConnection = nil
+		}
+		return
+	}
+
+	// No need to reconnect if we're already connected, the connection itself recovers from lost connections to the pod
+	if forwarder.currentConnection != nil {
+		return
+	}
+
+	if err := forwarder.connect(); err != nil {
+		logrus.Errorf("An error occurred forwarding the ports of service '%v' again:\n%v", forwarder.serviceName, err)
+		return
+	}
+	logrus.Infof("Forwarding the ports of service '%v' again", forwarder.serviceName)
+}
+
+func (forwarder *LiveUserServicePortForwarder) connect() error {
+	newConnection, err := forwarder.connectionProvider.ForUserServiceWithLocalPorts(
+		string(forwarder.enclaveUuid),
+		string(forwarder.serviceName),
+		forwarder.remotePortSpecs,
+		forwarder.localPortNumbers,
+	)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected to be able to get a port forwarded connection to service '%v', instead a non-nil error was returned", forwarder.serviceName)
+	}
+	forwarder.currentConnection = newConnection
+	return nil
+}
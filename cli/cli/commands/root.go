@@ -41,6 +41,8 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/port"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/portal"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/run"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/schedule"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/secret"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/twitter"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/version"
@@ -137,6 +139,8 @@ func init() {
 	RootCmd.AddCommand(port.PortCmd)
 	RootCmd.AddCommand(portal.PortalCmd)
 	RootCmd.AddCommand(run.StarlarkRunCmd.MustGetCobraCommand())
+	RootCmd.AddCommand(schedule.ScheduleCmd)
+	RootCmd.AddCommand(secret.SecretCmd)
 	RootCmd.AddCommand(service.ServiceCmd)
 	RootCmd.AddCommand(loki.LokiCmd)
 	RootCmd.AddCommand(_import.ImportCmd.MustGetCobraCommand())
@@ -76,6 +76,11 @@ const (
 
 	enclaveProductionModeFlagKey = "production"
 
+	enclaveTtlFlagKey         = "ttl"
+	enclaveIdleTimeoutFlagKey = "idle-timeout"
+	// Signifies that the enclave has no TTL or idle timeout
+	noEnclaveLifetimeLimit = ""
+
 	showEnclaveInspectFlagKey = "show-enclave-inspect"
 	showEnclaveInspectDefault = "true"
 
@@ -141,6 +146,9 @@ const (
 	withMermaidFlagKey = "with-mermaid"
 	defaultWithMermaid = "false"
 
+	watchFlagKey = "watch"
+	defaultWatch = "false"
+
 	httpProtocolRegexStr           = "^(http|https)://"
 	shouldCloneNormalRepo          = false
 	packageReplaceKeyInKurtosisYml = "replace:"
@@ -238,6 +246,18 @@ var StarlarkRunCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisC
 			Type:      flags.FlagType_Bool,
 			Default:   "false",
 		},
+		{
+			Key:     enclaveTtlFlagKey,
+			Usage:   "Only used when a new enclave gets created. How long the enclave may live (e.g. '30m', '2h') before the engine destroys it. The enclave never expires if not set",
+			Type:    flags.FlagType_String,
+			Default: noEnclaveLifetimeLimit,
+		},
+		{
+			Key:     enclaveIdleTimeoutFlagKey,
+			Usage:   "Only used when a new enclave gets created. How long the enclave may go unused (e.g. '30m', '2h') before the engine stops it. The enclave is never stopped for inactivity if not set",
+			Type:    flags.FlagType_String,
+			Default: noEnclaveLifetimeLimit,
+		},
 		{
 			Key:     noConnectFlagKey,
 			Usage:   "If true then user service ports are not forwarded locally. Default false",
@@ -298,6 +318,14 @@ var StarlarkRunCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisC
 			Type:    flags.FlagType_Bool,
 			Default: defaultWithMermaid,
 		},
+		{
+			Key: watchFlagKey,
+			Usage: "If true, keeps watching the local package directory after the run and re-runs the package in the same enclave " +
+				"every time one of its files changes; files ignored by '.kurtosisignore' don't trigger a re-run. Instructions " +
+				"that didn't change since the previous run are skipped",
+			Type:    flags.FlagType_Bool,
+			Default: defaultWatch,
+		},
 	},
 	Args: []*args.ArgConfig{
 		// TODO add a `Usage` description here when ArgConfig supports it
@@ -403,6 +431,16 @@ func run(
 		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", enclaveProductionModeFlagKey)
 	}
 
+	enclaveTtl, err := getDurationFlag(flags, enclaveTtlFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave TTL using flag with key '%v'", enclaveTtlFlagKey)
+	}
+
+	enclaveIdleTimeout, err := getDurationFlag(flags, enclaveIdleTimeoutFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave idle timeout using flag with key '%v'", enclaveIdleTimeoutFlagKey)
+	}
+
 	experimentalFlags, err := parseExperimentalFlag(flags)
 	if err != nil {
 		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", mainFunctionNameFlagKey)
@@ -453,9 +491,24 @@ func run(
 		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", withMermaidFlagKey)
 	}
 
+	shouldWatch, err := flags.GetBool(watchFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", watchFlagKey)
+	}
+	if shouldWatch {
+		if isDependenciesOnly {
+			return stacktrace.NewError("The '%v' and '%v' flags can't be used together", watchFlagKey, dependenciesFlagKey)
+		}
+		packageDirpath, err := getWatchablePackageDirpath(starlarkScriptOrPackagePath)
+		if err != nil {
+			return stacktrace.Propagate(err, "The '%v' flag can't be used with '%v'", watchFlagKey, starlarkScriptOrPackagePath)
+		}
+		starlarkScriptOrPackagePath = packageDirpath
+	}
+
 	if packageArgs == inputArgsAreEmptyBracesByDefault && packageArgsFile != packageArgsFileDefaultValue {
 		logrus.Debugf("'%v' is empty but '%v' is provided so we will go with the '%v' value", inputArgsArgKey, packageArgsFileFlagKey, packageArgsFileFlagKey)
-		packageArgs, err = getArgsFromFilepathOrURL(packageArgsFile)
+		packageArgs, err = GetArgsFromFilepathOrURL(packageArgsFile)
 		if err != nil {
 			return stacktrace.Propagate(err, "An error occurred while getting the package args from filepath or URL '%s'", packageArgsFile)
 		}
@@ -482,7 +535,7 @@ func run(
 		return stacktrace.Propagate(err, "An error occurred connecting to the local Kurtosis engine")
 	}
 
-	enclaveCtx, isNewEnclave, err := getOrCreateEnclaveContext(ctx, userRequestedEnclaveIdentifier, kurtosisCtx, isProduction)
+	enclaveCtx, isNewEnclave, err := getOrCreateEnclaveContext(ctx, userRequestedEnclaveIdentifier, kurtosisCtx, isProduction, enclaveTtl, enclaveIdleTimeout)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred getting the enclave context for enclave '%v'", userRequestedEnclaveIdentifier)
 	}
@@ -593,6 +646,10 @@ func run(
 		connect = kurtosis_core_rpc_api_bindings.Connect_NO_CONNECT
 	}
 
+	if shouldWatch {
+		return watchPackage(ctx, enclaveCtx, starlarkScriptOrPackagePath, starlarkRunConfig, verbosity, dryRun, isParallel, connect)
+	}
+
 	if isRemotePackage {
 		responseLineChan, cancelFunc, errRunningKurtosis = executeRemotePackage(ctx, enclaveCtx, starlarkScriptOrPackagePath, starlarkRunConfig)
 	} else {
@@ -743,7 +800,8 @@ func ReadAndPrintResponseLinesUntilClosed(
 	// This channel will receive a signal when the user presses an interrupt
 	interruptChan := make(chan os.Signal, interruptChanBufferSize)
 	signal.Notify(interruptChan, os.Interrupt)
-	defer close(interruptChan)
+	// Stopping the notifications rather than closing the channel, as a later interrupt would be sent to the closed channel
+	defer signal.Stop(interruptChan)
 
 	var printer output_printers.ExecutionPrinter
 	if isParallelRun {
@@ -788,11 +846,17 @@ func getOrCreateEnclaveContext(
 	enclaveIdentifierOrName string,
 	kurtosisContext *kurtosis_context.KurtosisContext,
 	isProduction bool,
+	// Zero disables the corresponding limit
+	enclaveTtl time.Duration,
+	enclaveIdleTimeout time.Duration,
 ) (*enclaves.EnclaveContext, bool, error) {
 
 	if enclaveIdentifierOrName != autogenerateEnclaveIdentifierKeyword {
 		_, err := kurtosisContext.GetEnclave(ctx, enclaveIdentifierOrName)
 		if err == nil {
+			if enclaveTtl > 0 || enclaveIdleTimeout > 0 {
+				logrus.Warnf("Ignoring the '%v' and '%v' flags as enclave '%v' already exists; use '%v %v' to change its expiration time", enclaveTtlFlagKey, enclaveIdleTimeoutFlagKey, enclaveIdentifierOrName, command_str_consts.EnclaveCmdStr, command_str_consts.EnclaveExtendCmdStr)
+			}
 			enclaveContext, err := kurtosisContext.GetEnclaveContext(ctx, enclaveIdentifierOrName)
 			if err != nil {
 				return nil, false, stacktrace.Propagate(err, "An error occurred while getting context for existing enclave with identifier '%v'", enclaveIdentifierOrName)
@@ -803,7 +867,9 @@ func getOrCreateEnclaveContext(
 	logrus.Infof("Creating a new enclave for Starlark to run inside...")
 	var enclaveContext *enclaves.EnclaveContext
 	var err error
-	if isProduction {
+	if enclaveTtl > 0 || enclaveIdleTimeout > 0 {
+		enclaveContext, err = kurtosisContext.CreateEnclaveWithLifetime(ctx, enclaveIdentifierOrName, isProduction, enclaveTtl, enclaveIdleTimeout)
+	} else if isProduction {
 		enclaveContext, err = kurtosisContext.CreateProductionEnclave(ctx, enclaveIdentifierOrName)
 	} else {
 		enclaveContext, err = kurtosisContext.CreateEnclave(ctx, enclaveIdentifierOrName)
@@ -815,6 +881,25 @@ func getOrCreateEnclaveContext(
 	return enclaveContext, isNewEnclaveFlagWhenCreated, nil
 }
 
+// Returns zero if the flag wasn't set
+func getDurationFlag(flags *flags.ParsedFlags, flagKey string) (time.Duration, error) {
+	durationStr, err := flags.GetString(flagKey)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", flagKey)
+	}
+	if durationStr == noEnclaveLifetimeLimit {
+		return 0, nil
+	}
+	duration, err := time.ParseDuration(durationStr)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred parsing duration '%v' of flag '%v'; valid durations look like '30m' or '2h'", durationStr, flagKey)
+	}
+	if duration <= 0 {
+		return 0, stacktrace.NewError("The '%v' flag must be a positive duration but was '%v'", flagKey, durationStr)
+	}
+	return duration, nil
+}
+
 func getPlanYaml(
 	ctx context.Context,
 	enclaveCtx *enclaves.EnclaveContext,
@@ -966,7 +1051,8 @@ func validateSerializedArgs(serializedArgs string) error {
 		"Error validating args, because it is not a valid JSON or YAML.")
 }
 
-func getArgsFromFilepathOrURL(packageArgsFile string) (string, error) {
+// GetArgsFromFilepathOrURL reads the package args file, which may be local or remote, and validates that it is JSON or YAML
+func GetArgsFromFilepathOrURL(packageArgsFile string) (string, error) {
 	var packageArgsFileBytes []byte
 
 	if isHttpUrl(packageArgsFile) {
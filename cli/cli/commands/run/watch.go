@@ -0,0 +1,237 @@
+package run
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"os/signal"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/enclaves"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/starlark_run_config"
+	command_args_run "github.com/kurtosis-tech/kurtosis/cli/cli/command_args/run"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/kurtosis/path-compression"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	watchPollInterval = time.Second
+
+	appliedInstructionLinePrefix = "  - "
+)
+
+type packageFileState struct {
+	size    int64
+	modTime time.Time
+	mode    os.FileMode
+}
+
+// packageRunSummary keeps track of which instructions of a run were applied and which were skipped for being
+// identical to the ones already run in the enclave
+type packageRunSummary struct {
+	appliedInstructionDescriptions []string
+	skippedInstructionsCount       int
+}
+
+// getWatchablePackageDirpath returns the directory of the local package to watch, failing for remote packages and
+// standalone scripts
+func getWatchablePackageDirpath(packagePath string) (string, error) {
+	if strings.HasPrefix(packagePath, githubDomainPrefix) {
+		return "", stacktrace.NewError("Only local packages can be watched but '%v' is a remote package", packagePath)
+	}
+	fileOrDir, err := os.Stat(packagePath)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "There was an error reading file or package from disk at '%v'", packagePath)
+	}
+	if isStandaloneScript(fileOrDir, kurtosisYMLFilePath) {
+		return "", stacktrace.NewError("Only local packages can be watched but '%v' is a standalone script", packagePath)
+	}
+	if isKurtosisYMLFileInPackageDir(fileOrDir, kurtosisYMLFilePath) {
+		return path.Dir(packagePath), nil
+	}
+	return packagePath, nil
+}
+
+// watchPackage runs the package, and re-uploads and re-runs it every time its files change until the user interrupts
+// it. Failed runs don't stop the watch, so that the package can be fixed and saved again.
+func watchPackage(
+	ctx context.Context,
+	enclaveCtx *enclaves.EnclaveContext,
+	packageDirpath string,
+	runConfig *starlark_run_config.StarlarkRunConfig,
+	verbosity command_args_run.Verbosity,
+	dryRun bool,
+	isParallel bool,
+	connect kurtosis_core_rpc_api_bindings.Connect,
+) error {
+	// The printing of the runs listens to interrupts as well; both channels get them
+	interruptChan := make(chan os.Signal, interruptChanBufferSize)
+	signal.Notify(interruptChan, os.Interrupt)
+	defer signal.Stop(interruptChan)
+
+	watchedFiles, err := getPackageFileStates(packageDirpath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred listing the files of package '%v'", packageDirpath)
+	}
+	runWatchedPackage(ctx, enclaveCtx, packageDirpath, runConfig, verbosity, dryRun, isParallel, connect)
+
+	ticker := time.NewTicker(watchPollInterval)
+	defer ticker.Stop()
+	for {
+		if wasInterrupted(interruptChan) {
+			return nil
+		}
+		out.PrintOutLn(fmt.Sprintf("Watching '%v' for changes, press Ctrl+C to stop", packageDirpath))
+		if !waitForPackageChange(ctx, interruptChan, ticker, packageDirpath, watchedFiles) {
+			return nil
+		}
+		// The files are listed before the run so that changes saved while it runs trigger the next one
+		changedFiles, err := getPackageFileStates(packageDirpath)
+		if err != nil {
+			// Like while waiting for a change, files can disappear while they're listed; the change is picked up again on the next check
+			logrus.Debugf("An error occurred listing the files of package '%v', retrying on the next check:\n%v", packageDirpath, err)
+			continue
+		}
+		watchedFiles = changedFiles
+		out.PrintOutLn(fmt.Sprintf("Detected changes in '%v', re-running the package", packageDirpath))
+		runWatchedPackage(ctx, enclaveCtx, packageDirpath, runConfig, verbosity, dryRun, isParallel, connect)
+	}
+}
+
+// waitForPackageChange returns true when the files of the package differ from the watched ones, and false when the
+// watch has to stop
+func waitForPackageChange(
+	ctx context.Context,
+	interruptChan <-chan os.Signal,
+	ticker *time.Ticker,
+	packageDirpath string,
+	watchedFiles map[string]packageFileState,
+) bool {
+	for {
+		select {
+		case <-interruptChan:
+			logrus.Debugf("Received signal interruption while watching package '%v'", packageDirpath)
+			return false
+		case <-ctx.Done():
+			return false
+		case <-ticker.C:
+			currentFiles, err := getPackageFileStates(packageDirpath)
+			if err != nil {
+				// Files can disappear while the directory is being listed, e.g. while an editor saves them, so this is retried
+				logrus.Debugf("An error occurred listing the files in '%v', retrying on the next check:\n%v", packageDirpath, err)
+				continue
+			}
+			if !maps.Equal(watchedFiles, currentFiles) {
+				return true
+			}
+		}
+	}
+}
+
+// runWatchedPackage uploads and runs the package once, logging rather than returning errors so that the watch goes on
+func runWatchedPackage(
+	ctx context.Context,
+	enclaveCtx *enclaves.EnclaveContext,
+	packageDirpath string,
+	runConfig *starlark_run_config.StarlarkRunConfig,
+	verbosity command_args_run.Verbosity,
+	dryRun bool,
+	isParallel bool,
+	connect kurtosis_core_rpc_api_bindings.Connect,
+) {
+	responseLineChan, cancelFunc, err := executePackage(ctx, enclaveCtx, packageDirpath, runConfig)
+	if err != nil {
+		logrus.Errorf("An error occurred running package '%v'; it will be re-run on the next change:\n%v", packageDirpath, err)
+		return
+	}
+
+	summary := &packageRunSummary{
+		appliedInstructionDescriptions: []string{},
+		skippedInstructionsCount:       0,
+	}
+	// The printing can stop reading before the run ends, e.g. when interrupted, so the summarizing stops along with this function
+	summarizerCtx, cancelSummarizer := context.WithCancel(ctx)
+	defer cancelSummarizer()
+	summarizedLineChan := make(chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine)
+	go func() {
+		defer close(summarizedLineChan)
+		for responseLine := range responseLineChan {
+			summary.record(responseLine)
+			select {
+			case summarizedLineChan <- responseLine:
+			case <-summarizerCtx.Done():
+				return
+			}
+		}
+	}()
+
+	if err := ReadAndPrintResponseLinesUntilClosed(summarizedLineChan, cancelFunc, verbosity, dryRun, isParallel); err != nil {
+		logrus.Errorf("Running package '%v' failed; it will be re-run on the next change", packageDirpath)
+		logrus.Debugf("Error was:\n%v", err)
+		return
+	}
+	if err := enclaveCtx.ConnectServices(ctx, connect); err != nil {
+		logrus.Warnf("An error occurred configuring the user services port forwarding\nError was: %v", err)
+	}
+	out.PrintOutLn(summary.String())
+}
+
+func (summary *packageRunSummary) record(responseLine *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine) {
+	instruction := responseLine.GetInstruction()
+	if instruction == nil {
+		return
+	}
+	if instruction.GetIsSkipped() {
+		summary.skippedInstructionsCount++
+		return
+	}
+	summary.appliedInstructionDescriptions = append(summary.appliedInstructionDescriptions, instruction.GetDescription())
+}
+
+func (summary *packageRunSummary) String() string {
+	summaryLines := []string{
+		fmt.Sprintf("Applied %d instructions and skipped %d unchanged ones", len(summary.appliedInstructionDescriptions), summary.skippedInstructionsCount),
+	}
+	for _, description := range summary.appliedInstructionDescriptions {
+		summaryLines = append(summaryLines, appliedInstructionLinePrefix+description)
+	}
+	return strings.Join(summaryLines, "\n")
+}
+
+// getPackageFileStates returns the state of the files of the package that aren't ignored by '.kurtosisignore', keyed
+// by their path relative to the package directory
+func getPackageFileStates(packageDirpath string) (map[string]packageFileState, error) {
+	relativeFilepaths, err := path_compression.ListFilesInPath(packageDirpath)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred listing the files in '%v'", packageDirpath)
+	}
+	fileStates := map[string]packageFileState{}
+	for _, relativeFilepath := range relativeFilepaths {
+		fileInfo, err := os.Stat(filepath.Join(packageDirpath, relativeFilepath))
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred inspecting file '%v' in '%v'", relativeFilepath, packageDirpath)
+		}
+		fileStates[relativeFilepath] = packageFileState{
+			size:    fileInfo.Size(),
+			modTime: fileInfo.ModTime(),
+			mode:    fileInfo.Mode(),
+		}
+	}
+	return fileStates, nil
+}
+
+func wasInterrupted(interruptChan <-chan os.Signal) bool {
+	select {
+	case <-interruptChan:
+		return true
+	default:
+		return false
+	}
+}
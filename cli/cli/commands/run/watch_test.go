@@ -0,0 +1,80 @@
+package run
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/stretchr/testify/require"
+)
+
+const testFilePerms = 0644
+
+func TestPackageRunSummary_CountsAppliedAndSkippedInstructions(t *testing.T) {
+	summary := &packageRunSummary{
+		appliedInstructionDescriptions: []string{},
+		skippedInstructionsCount:       0,
+	}
+	summary.record(newTestInstructionLine("Adding service 'api'", false))
+	summary.record(newTestInstructionLine("Adding service 'db'", true))
+	summary.record(&kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine{RunResponseLine: nil})
+
+	expectedSummary := "Applied 1 instructions and skipped 1 unchanged ones\n" +
+		"  - Adding service 'api'"
+	require.Equal(t, expectedSummary, summary.String())
+}
+
+func TestGetPackageFileStates_LeavesOutIgnoredFiles(t *testing.T) {
+	packageDirpath := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(packageDirpath, kurtosisYMLFilePath), []byte("name: github.com/test/test"), testFilePerms))
+	require.NoError(t, os.WriteFile(filepath.Join(packageDirpath, ".kurtosisignore"), []byte("*.tmp\n"), testFilePerms))
+	require.NoError(t, os.WriteFile(filepath.Join(packageDirpath, "main.star"), []byte("def run(plan):\n    pass\n"), testFilePerms))
+
+	fileStates, err := getPackageFileStates(packageDirpath)
+	require.NoError(t, err)
+
+	// Ignored files changing don't trigger a re-run
+	require.NoError(t, os.WriteFile(filepath.Join(packageDirpath, "editor.tmp"), []byte("swap"), testFilePerms))
+	unchangedFileStates, err := getPackageFileStates(packageDirpath)
+	require.NoError(t, err)
+	require.Equal(t, fileStates, unchangedFileStates)
+
+	require.NoError(t, os.WriteFile(filepath.Join(packageDirpath, "main.star"), []byte("def run(plan):\n    return 1\n"), testFilePerms))
+	changedFileStates, err := getPackageFileStates(packageDirpath)
+	require.NoError(t, err)
+	require.NotEqual(t, fileStates, changedFileStates)
+}
+
+func TestGetWatchablePackageDirpath(t *testing.T) {
+	packageDirpath := t.TempDir()
+	kurtosisYmlFilepath := filepath.Join(packageDirpath, kurtosisYMLFilePath)
+	require.NoError(t, os.WriteFile(kurtosisYmlFilepath, []byte("name: github.com/test/test"), testFilePerms))
+	scriptFilepath := filepath.Join(packageDirpath, "main.star")
+	require.NoError(t, os.WriteFile(scriptFilepath, []byte("def run(plan):\n    pass\n"), testFilePerms))
+
+	watchedDirpath, err := getWatchablePackageDirpath(packageDirpath)
+	require.NoError(t, err)
+	require.Equal(t, packageDirpath, watchedDirpath)
+
+	watchedDirpath, err = getWatchablePackageDirpath(kurtosisYmlFilepath)
+	require.NoError(t, err)
+	require.Equal(t, packageDirpath, watchedDirpath)
+
+	_, err = getWatchablePackageDirpath(scriptFilepath)
+	require.Error(t, err)
+
+	_, err = getWatchablePackageDirpath("github.com/kurtosis-tech/awesome-kurtosis")
+	require.Error(t, err)
+}
+
+func newTestInstructionLine(description string, isSkipped bool) *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine {
+	return &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine{
+		RunResponseLine: &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine_Instruction{
+			Instruction: &kurtosis_core_rpc_api_bindings.StarlarkInstruction{
+				Description: description,
+				IsSkipped:   isSkipped,
+			},
+		},
+	}
+}
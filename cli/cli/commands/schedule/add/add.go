@@ -0,0 +1,196 @@
+package add
+
+import (
+	"context"
+	"strings"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	_run "github.com/kurtosis-tech/kurtosis/cli/cli/commands/run"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/schedule/ls"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	packageIdArgKey = "package-id"
+
+	cronExpressionFlagKey = "cron"
+	cronExpressionDefault = ""
+
+	packageArgsFileFlagKey      = "args-file"
+	packageArgsFileDefaultValue = ""
+
+	enclaveModeFlagKey = "enclave-mode"
+	enclaveModeFresh   = "fresh"
+	enclaveModeReuse   = "reuse"
+
+	enclaveNameFlagKey = "enclave"
+	enclaveNameDefault = ""
+
+	shouldDestroyEnclaveFlagKey = "destroy-enclave"
+	shouldDestroyEnclaveDefault = "false"
+
+	scheduleUuidKey    = "UUID"
+	cronExpressionKey  = "Cron"
+	packageIdKey       = "Package"
+	enclaveModeKey     = "Enclave Mode"
+	enclaveNameKey     = "Enclave"
+	nextRunTimeKey     = "Next Run"
+	destroyEnclaveKey  = "Destroy Enclave"
+	destroyEnclaveTrue = "yes"
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+)
+
+var enclaveModes = map[string]kurtosis_engine_rpc_api_bindings.ScheduleEnclaveMode{
+	enclaveModeFresh: kurtosis_engine_rpc_api_bindings.ScheduleEnclaveMode_ScheduleEnclaveMode_FRESH,
+	enclaveModeReuse: kurtosis_engine_rpc_api_bindings.ScheduleEnclaveMode_ScheduleEnclaveMode_REUSE,
+}
+
+var ScheduleAddCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.ScheduleAddCmdStr,
+	ShortDescription: "Runs a package on a cron schedule",
+	LongDescription: "Makes the engine run a remote package every time the cron expression matches, either in a new enclave " +
+		"or always in the same one. The engine keeps the schedule across restarts, and the outcome of the latest runs " +
+		"can be seen with '" + command_str_consts.KurtosisCmdStr + " " + command_str_consts.ScheduleCmdStr + " " + command_str_consts.ScheduleLsCmdStr + "'",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags: []*flags.FlagConfig{
+		{
+			Key: cronExpressionFlagKey,
+			Usage: "The standard 5-field cron expression (minute, hour, day of month, month, day of week) of the runs, evaluated in UTC, " +
+				"e.g. '0 2 * * *' for every night at 2am. The '@hourly', '@daily', '@weekly', '@monthly' and '@yearly' shortcuts are also accepted.",
+			Type:    flags.FlagType_String,
+			Default: cronExpressionDefault,
+		},
+		{
+			Key:     packageArgsFileFlagKey,
+			Usage:   "The file (JSON/YAML) that will be used to pass in arguments to the Kurtosis package. Can be a URL or file path. It is read once, when the schedule is added.",
+			Type:    flags.FlagType_String,
+			Default: packageArgsFileDefaultValue,
+		},
+		{
+			Key: enclaveModeFlagKey,
+			Usage: "Either '" + enclaveModeFresh + "', to create a new enclave for every run, or '" + enclaveModeReuse + "', " +
+				"to run the package in the enclave given by the '" + enclaveNameFlagKey + "' flag every time, creating it if it doesn't exist",
+			Type:    flags.FlagType_String,
+			Default: enclaveModeFresh,
+		},
+		{
+			Key: enclaveNameFlagKey,
+			Usage: "The enclave the package runs in with the '" + enclaveModeReuse + "' mode, or the prefix of the names of the new enclaves " +
+				"with the '" + enclaveModeFresh + "' mode, which get the time of the run appended",
+			Type:    flags.FlagType_String,
+			Default: enclaveNameDefault,
+		},
+		{
+			Key:     shouldDestroyEnclaveFlagKey,
+			Usage:   "If true, the enclave of each run is destroyed once the run is over, whatever its outcome. Only allowed with the '" + enclaveModeFresh + "' mode.",
+			Type:    flags.FlagType_Bool,
+			Default: shouldDestroyEnclaveDefault,
+		},
+	},
+	Args: []*args.ArgConfig{
+		{
+			Key: packageIdArgKey,
+		},
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	packageId, err := args.GetNonGreedyArg(packageIdArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the package ID using key '%v'", packageIdArgKey)
+	}
+
+	cronExpression, err := flags.GetString(cronExpressionFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", cronExpressionFlagKey)
+	}
+	if strings.TrimSpace(cronExpression) == "" {
+		return stacktrace.NewError("A cron expression is required; pass it with the '%v' flag, e.g. --%v \"0 2 * * *\"", cronExpressionFlagKey, cronExpressionFlagKey)
+	}
+
+	packageArgsFile, err := flags.GetString(packageArgsFileFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", packageArgsFileFlagKey)
+	}
+
+	enclaveModeStr, err := flags.GetString(enclaveModeFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", enclaveModeFlagKey)
+	}
+	enclaveMode, found := enclaveModes[strings.ToLower(enclaveModeStr)]
+	if !found {
+		return stacktrace.NewError("Invalid value '%v' for the '%v' flag; it must be either '%v' or '%v'", enclaveModeStr, enclaveModeFlagKey, enclaveModeFresh, enclaveModeReuse)
+	}
+
+	enclaveName, err := flags.GetString(enclaveNameFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", enclaveNameFlagKey)
+	}
+
+	shouldDestroyEnclave, err := flags.GetBool(shouldDestroyEnclaveFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", shouldDestroyEnclaveFlagKey)
+	}
+
+	addScheduleArgs := &kurtosis_engine_rpc_api_bindings.AddScheduleArgs{
+		CronExpression:       cronExpression,
+		PackageId:            packageId,
+		SerializedParams:     nil,
+		EnclaveMode:          enclaveMode,
+		EnclaveName:          nil,
+		ShouldDestroyEnclave: &shouldDestroyEnclave,
+	}
+	if packageArgsFile != packageArgsFileDefaultValue {
+		serializedParams, err := _run.GetArgsFromFilepathOrURL(packageArgsFile)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred while getting the package args from filepath or URL '%s'", packageArgsFile)
+		}
+		addScheduleArgs.SerializedParams = &serializedParams
+	}
+	if enclaveName != enclaveNameDefault {
+		addScheduleArgs.EnclaveName = &enclaveName
+	}
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating Kurtosis Context from local engine")
+	}
+
+	schedule, err := kurtosisCtx.AddSchedule(ctx, addScheduleArgs)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred scheduling package '%v'", packageId)
+	}
+
+	keyValuePrinter := output_printers.NewKeyValuePrinter()
+	keyValuePrinter.AddPair(scheduleUuidKey, schedule.GetScheduleUuid())
+	keyValuePrinter.AddPair(cronExpressionKey, schedule.GetCronExpression())
+	keyValuePrinter.AddPair(packageIdKey, schedule.GetPackageId())
+	keyValuePrinter.AddPair(enclaveModeKey, ls.GetEnclaveModeStr(schedule.GetEnclaveMode()))
+	keyValuePrinter.AddPair(enclaveNameKey, schedule.GetEnclaveName())
+	if schedule.GetShouldDestroyEnclave() {
+		keyValuePrinter.AddPair(destroyEnclaveKey, destroyEnclaveTrue)
+	}
+	keyValuePrinter.AddPair(nextRunTimeKey, ls.GetNextRunTimeStr(schedule))
+	keyValuePrinter.Print()
+
+	return nil
+}
@@ -0,0 +1,164 @@
+package ls
+
+import (
+	"context"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	scheduleUuidColumnHeader   = "UUID"
+	cronExpressionColumnHeader = "Cron"
+	packageIdColumnHeader      = "Package"
+	enclaveModeColumnHeader    = "Enclave Mode"
+	enclaveNameColumnHeader    = "Enclave"
+	nextRunTimeColumnHeader    = "Next Run"
+	lastRunTimeColumnHeader    = "Last Run"
+	lastRunStatusColumnHeader  = "Last Run Status"
+	lastRunEnclaveColumnHeader = "Last Run Enclave"
+
+	fullUuidsFlagKey       = "full-uuids"
+	fullUuidFlagKeyDefault = "false"
+
+	// Shown instead of a time when the schedule will never run again, or hasn't run yet
+	noRunTimeStr = "-"
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+)
+
+var enclaveModeStrs = map[kurtosis_engine_rpc_api_bindings.ScheduleEnclaveMode]string{
+	kurtosis_engine_rpc_api_bindings.ScheduleEnclaveMode_ScheduleEnclaveMode_FRESH: "fresh",
+	kurtosis_engine_rpc_api_bindings.ScheduleEnclaveMode_ScheduleEnclaveMode_REUSE: "reuse",
+}
+
+var scheduledRunStatusStrs = map[kurtosis_engine_rpc_api_bindings.ScheduledRunStatus]string{
+	kurtosis_engine_rpc_api_bindings.ScheduledRunStatus_ScheduledRunStatus_RUNNING:   "RUNNING",
+	kurtosis_engine_rpc_api_bindings.ScheduledRunStatus_ScheduledRunStatus_SUCCEEDED: "SUCCEEDED",
+	kurtosis_engine_rpc_api_bindings.ScheduledRunStatus_ScheduledRunStatus_FAILED:    "FAILED",
+}
+
+var ScheduleLsCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:                command_str_consts.ScheduleLsCmdStr,
+	ShortDescription:          "Lists the scheduled package runs",
+	LongDescription:           "Lists the schedules of the engine, with the time of their next run and the outcome of their last one",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags: []*flags.FlagConfig{
+		{
+			Key:     fullUuidsFlagKey,
+			Usage:   "If true then Kurtosis prints full UUIDs instead of shortened UUIDs. Default false.",
+			Type:    flags.FlagType_Bool,
+			Default: fullUuidFlagKeyDefault,
+		},
+	},
+	Args:    []*args.ArgConfig{},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	_ *args.ParsedArgs,
+) error {
+	showFullUuids, err := flags.GetBool(fullUuidsFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", fullUuidsFlagKey)
+	}
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating Kurtosis Context from local engine")
+	}
+
+	schedules, err := kurtosisCtx.GetSchedules(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the schedules")
+	}
+
+	tablePrinter := output_printers.NewTablePrinter(
+		scheduleUuidColumnHeader,
+		cronExpressionColumnHeader,
+		packageIdColumnHeader,
+		enclaveModeColumnHeader,
+		enclaveNameColumnHeader,
+		nextRunTimeColumnHeader,
+		lastRunTimeColumnHeader,
+		lastRunStatusColumnHeader,
+		lastRunEnclaveColumnHeader,
+	)
+	for _, schedule := range schedules {
+		scheduleUuid := schedule.GetShortenedUuid()
+		if showFullUuids {
+			scheduleUuid = schedule.GetScheduleUuid()
+		}
+
+		lastRunTimeStr := noRunTimeStr
+		lastRunStatusStr := ""
+		lastRunEnclaveName := ""
+		if numRecentRuns := len(schedule.GetRecentRuns()); numRecentRuns > 0 {
+			lastRun := schedule.GetRecentRuns()[numRecentRuns-1]
+			lastRunTimeStr = formatRunTime(lastRun.GetStartTime().AsTime())
+			lastRunStatusStr = getScheduledRunStatusStr(lastRun.GetStatus())
+			lastRunEnclaveName = lastRun.GetEnclaveName()
+		}
+
+		if err := tablePrinter.AddRow(
+			scheduleUuid,
+			schedule.GetCronExpression(),
+			schedule.GetPackageId(),
+			GetEnclaveModeStr(schedule.GetEnclaveMode()),
+			schedule.GetEnclaveName(),
+			GetNextRunTimeStr(schedule),
+			lastRunTimeStr,
+			lastRunStatusStr,
+			lastRunEnclaveName,
+		); err != nil {
+			return stacktrace.NewError("An error occurred adding row for schedule '%v' to the table printer", schedule.GetScheduleUuid())
+		}
+	}
+	tablePrinter.Print()
+
+	return nil
+}
+
+func GetEnclaveModeStr(enclaveMode kurtosis_engine_rpc_api_bindings.ScheduleEnclaveMode) string {
+	enclaveModeStr, found := enclaveModeStrs[enclaveMode]
+	if !found {
+		return enclaveMode.String()
+	}
+	return enclaveModeStr
+}
+
+// GetNextRunTimeStr returns a placeholder for the schedules whose cron expression never matches again
+func GetNextRunTimeStr(schedule *kurtosis_engine_rpc_api_bindings.Schedule) string {
+	if schedule.NextRunTime == nil {
+		return noRunTimeStr
+	}
+	return formatRunTime(schedule.GetNextRunTime().AsTime())
+}
+
+func getScheduledRunStatusStr(status kurtosis_engine_rpc_api_bindings.ScheduledRunStatus) string {
+	statusStr, found := scheduledRunStatusStrs[status]
+	if !found {
+		return status.String()
+	}
+	return statusStr
+}
+
+func formatRunTime(runTime time.Time) string {
+	return runTime.Local().Format(time.RFC1123)
+}
@@ -0,0 +1,24 @@
+package schedule
+
+import (
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/schedule/add"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/schedule/ls"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/schedule/rm"
+	"github.com/spf13/cobra"
+)
+
+// ScheduleCmd Suppressing exhaustruct requirement because this struct has ~40 properties
+// nolint: exhaustruct
+var ScheduleCmd = &cobra.Command{
+	Use:   command_str_consts.ScheduleCmdStr,
+	Short: "Manage scheduled package runs",
+	Long:  "Contains actions for managing the packages that the engine runs on a cron schedule, e.g. to spin up a fresh devnet every night",
+	RunE:  nil,
+}
+
+func init() {
+	ScheduleCmd.AddCommand(add.ScheduleAddCmd.MustGetCobraCommand())
+	ScheduleCmd.AddCommand(ls.ScheduleLsCmd.MustGetCobraCommand())
+	ScheduleCmd.AddCommand(rm.ScheduleRmCmd.MustGetCobraCommand())
+}
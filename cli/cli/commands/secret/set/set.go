@@ -0,0 +1,106 @@
+package set
+
+import (
+	"context"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	enclaveIdentifierArgKey = "enclave"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	secretNameArgKey  = "name"
+	secretValueArgKey = "value"
+
+	readValueFromStdinInput = "-"
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+)
+
+var SecretSetCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.SecretSetCmdStr,
+	ShortDescription: "Stores a secret in an enclave",
+	LongDescription: "Stores a secret in the enclave secret store, overwriting any existing secret with the same name. " +
+		"Pass '" + readValueFromStdinInput + "' as the value to read it from stdin and keep it out of the shell history",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags:                     []*flags.FlagConfig{},
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+		{
+			Key: secretNameArgKey,
+		},
+		{
+			Key: secretValueArgKey,
+		},
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	_ *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave identifier value using key '%v'", enclaveIdentifierArgKey)
+	}
+
+	secretName, err := args.GetNonGreedyArg(secretNameArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the secret name using key '%v'", secretNameArgKey)
+	}
+
+	secretValue, err := args.GetNonGreedyArg(secretValueArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the secret value using key '%v'", secretValueArgKey)
+	}
+	if secretValue == readValueFromStdinInput {
+		valueBytes, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred reading the secret value from stdin")
+		}
+		secretValue = strings.TrimRight(string(valueBytes), "\r\n")
+	}
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating Kurtosis Context from local engine")
+	}
+
+	enclaveCtx, err := kurtosisCtx.GetEnclaveContext(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave context for enclave '%v'", enclaveIdentifier)
+	}
+
+	if err := enclaveCtx.SetSecret(ctx, secretName, secretValue); err != nil {
+		return stacktrace.Propagate(err, "An error occurred storing secret '%v' in enclave '%v'", secretName, enclaveIdentifier)
+	}
+	logrus.Infof("Secret '%v' stored in enclave '%v'", secretName, enclaveIdentifier)
+	return nil
+}
@@ -3,7 +3,11 @@ package exec
 import (
 	"context"
 	"fmt"
+	"io"
+	"os"
 
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
@@ -18,6 +22,8 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
 	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/term"
 )
 
 const (
@@ -37,6 +43,14 @@ const (
 	containerUserShortKey = "u"
 	containerUserDefault  = "root"
 
+	interactiveFlagKey      = "interactive"
+	interactiveFlagShortKey = "i"
+	interactiveFlagDefault  = "false"
+
+	ttyFlagKey      = "tty"
+	ttyFlagShortKey = "t"
+	ttyFlagDefault  = "false"
+
 	kurtosisBackendCtxKey = "kurtosis-backend"
 	engineClientCtxKey    = "engine-client"
 
@@ -50,13 +64,29 @@ var ServiceShellCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosis
 	LongDescription:           "Execute a command in a service. Note if the command being run is multiple words you should wrap it in quotes",
 	KurtosisBackendContextKey: kurtosisBackendCtxKey,
 	EngineClientContextKey:    engineClientCtxKey,
-	Flags: []*flags.FlagConfig{{
-		Key:       containerUserKey,
-		Usage:     "optional service container user for command",
-		Shorthand: containerUserShortKey,
-		Type:      flags.FlagType_String,
-		Default:   containerUserDefault,
-	}},
+	Flags: []*flags.FlagConfig{
+		{
+			Key:       containerUserKey,
+			Usage:     "optional service container user for command",
+			Shorthand: containerUserShortKey,
+			Type:      flags.FlagType_String,
+			Default:   containerUserDefault,
+		},
+		{
+			Key:       interactiveFlagKey,
+			Usage:     "Keep stdin open and forward it to the command. The output of the command is streamed as it is produced",
+			Shorthand: interactiveFlagShortKey,
+			Type:      flags.FlagType_Bool,
+			Default:   interactiveFlagDefault,
+		},
+		{
+			Key:       ttyFlagKey,
+			Usage:     "Allocate a pseudo-TTY for the command, for example to run an interactive shell together with --interactive",
+			Shorthand: ttyFlagShortKey,
+			Type:      flags.FlagType_Bool,
+			Default:   ttyFlagDefault,
+		},
+	},
 	Args: []*args.ArgConfig{
 		enclave_id_arg.NewEnclaveIdentifierArg(
 			enclaveIdentifierArgKey,
@@ -130,6 +160,21 @@ func run(
 		containerUser = ""
 	}
 
+	isInteractive, err := flags.GetBool(interactiveFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the interactive flag '%v'", interactiveFlagKey)
+	}
+	shouldAllocateTty, err := flags.GetBool(ttyFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the TTY flag '%v'", ttyFlagKey)
+	}
+	if isInteractive || shouldAllocateTty {
+		if containerUser != "" {
+			return stacktrace.NewError("The '%v' flag can't be used together with the '%v' and '%v' flags", containerUserKey, interactiveFlagKey, ttyFlagKey)
+		}
+		return runStreamed(ctx, serviceCtx, []string{binShCommand, binShCommandFlag, execCommandToRun}, isInteractive, shouldAllocateTty)
+	}
+
 	results, resultErrors, err := kurtosisBackend.RunUserServiceExecCommands(ctx, enclaveUuid, containerUser, map[service.ServiceUUID][]string{
 		serviceUuid: {
 			binShCommand,
@@ -161,3 +206,53 @@ func run(
 	out.PrintOutLn(successResult.GetOutput())
 	return nil
 }
+
+// runStreamed runs the command through the API container, which streams its output back as it is produced and
+// forwards stdin and terminal resizes to it. It works the same way on Docker and Kubernetes
+func runStreamed(ctx context.Context, serviceCtx *services.ServiceContext, command []string, isInteractive bool, shouldAllocateTty bool) error {
+	var stdin io.Reader
+	if isInteractive {
+		stdin = os.Stdin
+	}
+
+	var terminalSizes chan *kurtosis_core_rpc_api_bindings.TerminalSize
+	stdinFd := int(os.Stdin.Fd())
+	if shouldAllocateTty && term.IsTerminal(stdinFd) {
+		oldState, err := term.MakeRaw(stdinFd)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred making STDIN stream raw")
+		}
+		defer func() {
+			if err := term.Restore(stdinFd, oldState); err != nil {
+				logrus.Warn("An error occurred while restoring the terminal to its normal state. Your terminal might look funny; we recommend closing and starting a new terminal.")
+			}
+		}()
+
+		terminalSizes = make(chan *kurtosis_core_rpc_api_bindings.TerminalSize, 1)
+		stopWatchingTerminalSize := watchTerminalSize(stdinFd, terminalSizes)
+		defer stopWatchingTerminalSize()
+	}
+
+	exitCode, err := serviceCtx.StreamExecCommand(ctx, command, stdin, os.Stdout, os.Stderr, shouldAllocateTty, terminalSizes)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred executing command '%v' in service '%v'", command, serviceCtx.GetServiceName())
+	}
+	if exitCode != 0 {
+		return stacktrace.NewError("The command was successfully executed but returned a non-zero exit code: '%d'", exitCode)
+	}
+	return nil
+}
+
+// sendTerminalSize sends the current size of the terminal, if it can be read
+func sendTerminalSize(terminalFd int, terminalSizes chan *kurtosis_core_rpc_api_bindings.TerminalSize) {
+	width, height, err := term.GetSize(terminalFd)
+	if err != nil {
+		logrus.Debugf("Unable to get the size of the terminal:\n%v", err)
+		return
+	}
+	select {
+	case terminalSizes <- &kurtosis_core_rpc_api_bindings.TerminalSize{Width: uint32(width), Height: uint32(height)}:
+	default:
+		// the previous size hasn't been sent yet
+	}
+}
@@ -0,0 +1,35 @@
+//go:build !windows
+
+package exec
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+)
+
+// watchTerminalSize sends the size of the terminal, and sends it again every time the terminal is resized. The returned
+// function stops watching
+func watchTerminalSize(terminalFd int, terminalSizes chan *kurtosis_core_rpc_api_bindings.TerminalSize) func() {
+	sendTerminalSize(terminalFd, terminalSizes)
+
+	resizeSignals := make(chan os.Signal, 1)
+	signal.Notify(resizeSignals, syscall.SIGWINCH)
+	stop := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-stop:
+				return
+			case <-resizeSignals:
+				sendTerminalSize(terminalFd, terminalSizes)
+			}
+		}
+	}()
+	return func() {
+		signal.Stop(resizeSignals)
+		close(stop)
+	}
+}
@@ -0,0 +1,13 @@
+//go:build windows
+
+package exec
+
+import (
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+)
+
+// watchTerminalSize only sends the initial size of the terminal, as Windows doesn't signal terminal resizes
+func watchTerminalSize(terminalFd int, terminalSizes chan *kurtosis_core_rpc_api_bindings.TerminalSize) func() {
+	sendTerminalSize(terminalFd, terminalSizes)
+	return func() {}
+}
@@ -0,0 +1,135 @@
+package restart
+
+import (
+	"context"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/service_identifier_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/service_helpers"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/metrics_client_factory"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/shared_starlark_calls"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	enclaveIdentifierArgKey = "enclave"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	serviceIdentifierArgKey        = "service"
+	isServiceIdentifierArgOptional = false
+	isServiceIdentifierArgGreedy   = true
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+)
+
+var ServiceRestartCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:                command_str_consts.ServiceRestartCmdStr,
+	ShortDescription:          "Restarts a service",
+	LongDescription:           "Stops and starts again the service with the given service identifier in the given enclave. With the dependency flags, the services it depends on and/or the services depending on it get restarted too: they're all stopped, dependents first, and then started, each one after its dependencies are ready",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+		service_identifier_arg.NewServiceIdentifierArg(
+			serviceIdentifierArgKey,
+			enclaveIdentifierArgKey,
+			isServiceIdentifierArgOptional,
+			isServiceIdentifierArgGreedy,
+		),
+	},
+	Flags:   service_helpers.NewServiceDependencyFlags(),
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave identifier value using key '%v'", enclaveIdentifierArgKey)
+	}
+
+	serviceIdentifiers, err := args.GetGreedyArg(serviceIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the service identifier value using key '%v'", serviceIdentifierArgKey)
+	}
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating Kurtosis Context from local engine")
+	}
+
+	enclaveCtx, err := kurtosisCtx.GetEnclaveContext(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting an enclave context from enclave info for enclave '%v'", enclaveIdentifier)
+	}
+
+	metricsClient, closeMetricsClientFunc, err := metrics_client_factory.GetMetricsClient()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting metrics client.")
+	}
+	defer func() {
+		if err = closeMetricsClientFunc(); err != nil {
+			logrus.Warnf("An error occurred closing metrics client:\n%v", closeMetricsClientFunc())
+		}
+	}()
+
+	servicesToRestart, err := service_helpers.GetServicesInStartOrder(ctx, kurtosisCtx, enclaveCtx, enclaveIdentifier, serviceIdentifiers, flags)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the services to restart in enclave '%v'", enclaveIdentifier)
+	}
+
+	// All the services get stopped, dependents first, before any of them is started again so that none of them is
+	// started against a dependency about to go away
+	for serviceIdx := len(servicesToRestart) - 1; serviceIdx >= 0; serviceIdx-- {
+		serviceToStop := servicesToRestart[serviceIdx]
+		if !serviceToStop.IsRunning {
+			continue
+		}
+		logrus.Infof("Stopping service '%v'", serviceToStop.Name)
+
+		err = metricsClient.TrackStopService(enclaveIdentifier, string(serviceToStop.Name))
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred tracking service update metric.")
+		}
+
+		if err := shared_starlark_calls.StopServiceStarlarkCommand(ctx, enclaveCtx, serviceToStop.Name); err != nil {
+			return stacktrace.Propagate(err, "An error occurred stopping service '%v' from enclave '%v'", serviceToStop.Name, enclaveIdentifier)
+		}
+	}
+
+	for _, serviceToStart := range servicesToRestart {
+		logrus.Infof("Starting service '%v'", serviceToStart.Name)
+
+		err = metricsClient.TrackStartService(enclaveIdentifier, string(serviceToStart.Name))
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred tracking service update metric.")
+		}
+
+		if err := service_helpers.StartServiceAndWaitForReadiness(ctx, enclaveCtx, serviceToStart.Name); err != nil {
+			return stacktrace.Propagate(err, "An error occurred starting service '%v' from enclave '%v'", serviceToStart.Name, enclaveIdentifier)
+		}
+	}
+	return nil
+}
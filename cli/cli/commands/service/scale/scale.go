@@ -0,0 +1,273 @@
+package scale
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/starlark_run_config"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/service_helpers"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/kurtosis_config_getter"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/user_services"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	enclaveIdentifierArgKey = "enclave"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	replicaSetArgKey        = "replica-set"
+	isReplicaSetArgOptional = false
+	isReplicaSetArgGreedy   = false
+
+	replicasArgKey        = "replicas"
+	isReplicasArgOptional = false
+	isReplicasArgGreedy   = false
+
+	privilegedFlagKey = "privileged"
+	defaultPrivileged = "false"
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+
+	minReplicas = 1
+)
+
+var ServiceScaleCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.ServiceScaleCmdStr,
+	ShortDescription: "Scale a replica set",
+	LongDescription: "Changes the number of replicas of a replica set added with 'ServiceConfig(replicas = ...)'. " +
+		"When scaling up, the replica set gets added again with the config of the replica with the lowest index, " +
+		"which starts the missing replicas up to index <replicas> - 1; replicas with the same config keep running. " +
+		"When scaling down, the replicas with the highest indexes are removed first",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags: []*flags.FlagConfig{
+		{
+			Key:     privilegedFlagKey,
+			Usage:   "Allows Docker-only privileged containers, host bind mounts, and host PID namespace for the added replicas",
+			Type:    flags.FlagType_Bool,
+			Default: defaultPrivileged,
+		},
+	},
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+		{
+			Key:                   replicaSetArgKey,
+			IsOptional:            isReplicaSetArgOptional,
+			IsGreedy:              isReplicaSetArgGreedy,
+			DefaultValue:          nil,
+			ArgCompletionProvider: nil,
+			ValidationFunc:        nil,
+		},
+		{
+			Key:                   replicasArgKey,
+			IsOptional:            isReplicasArgOptional,
+			IsGreedy:              isReplicasArgGreedy,
+			DefaultValue:          nil,
+			ArgCompletionProvider: nil,
+			ValidationFunc:        nil,
+		},
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for non-greedy enclave identifier arg '%v' but none was found; this is a bug in the Kurtosis CLI!", enclaveIdentifierArgKey)
+	}
+	replicaSetName, err := args.GetNonGreedyArg(replicaSetArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for non-greedy replica set arg '%v' but none was found; this is a bug in the Kurtosis CLI!", replicaSetArgKey)
+	}
+	replicasStr, err := args.GetNonGreedyArg(replicasArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for non-greedy replicas arg '%v' but none was found; this is a bug in the Kurtosis CLI!", replicasArgKey)
+	}
+	replicas, err := strconv.Atoi(replicasStr)
+	if err != nil || replicas < minReplicas {
+		return stacktrace.NewError("The number of replicas must be an integer greater or equal to %d, got '%v'", minReplicas, replicasStr)
+	}
+
+	privilegedFlag, err := flags.GetBool(privilegedFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", privilegedFlagKey)
+	}
+	clusterConfig, err := kurtosis_config_getter.GetKurtosisClusterConfig()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while getting Kurtosis cluster config")
+	}
+	allowPrivilegedMode := privilegedFlag || clusterConfig.GetAllowPrivilegedMode()
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating Kurtosis Context from local engine")
+	}
+	enclaveCtx, err := kurtosisCtx.GetEnclaveContext(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting an enclave context from enclave info for enclave '%v'", enclaveIdentifier)
+	}
+	enclaveInfo, err := kurtosisCtx.GetEnclave(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave for identifier '%v'", enclaveIdentifier)
+	}
+	allServicesMap := map[string]bool{}
+	userServices, err := user_services.GetUserServiceInfoMapFromAPIContainer(ctx, enclaveInfo, allServicesMap)
+	if err != nil {
+		return stacktrace.Propagate(err, "Failed to get service info from API container in enclave '%v'", enclaveInfo.GetEnclaveUuid())
+	}
+	currentReplicas := getReplicas(userServices, replicaSetName)
+	if len(currentReplicas) == 0 {
+		return stacktrace.NewError("No replica set named '%v' was found in enclave '%v'", replicaSetName, enclaveIdentifier)
+	}
+	if len(currentReplicas) == replicas {
+		out.PrintOutLn(fmt.Sprintf("Replica set '%v' already has %d replicas", replicaSetName, replicas))
+		return nil
+	}
+
+	scaleStarlarkStr := getScaleStarlarkScript(replicaSetName, currentReplicas, replicas)
+	logrus.Debugf("Scale replica set starlark:\n%v", scaleStarlarkStr)
+
+	logrus.Infof("Scaling replica set '%v' in enclave '%v' from %d to %d replicas...", replicaSetName, enclaveIdentifier, len(currentReplicas), replicas)
+	runConfig := starlark_run_config.NewRunStarlarkConfig(starlark_run_config.WithAllowPrivilegedMode(allowPrivilegedMode))
+	starlarkRunResult, err := enclaveCtx.RunStarlarkScriptBlocking(ctx, scaleStarlarkStr, runConfig)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error has occurred when running Starlark to scale replica set '%v'", replicaSetName)
+	}
+	if starlarkRunResult.InterpretationError != nil {
+		return stacktrace.NewError("An error has occurred when scaling replica set '%v': %s\nThis is a bug in Kurtosis, please report.", replicaSetName, starlarkRunResult.InterpretationError)
+	}
+	if len(starlarkRunResult.ValidationErrors) > 0 {
+		return stacktrace.NewError("An error occurred when validating the scaling of replica set '%v' in enclave '%v': %s", replicaSetName, enclaveIdentifier, starlarkRunResult.ValidationErrors)
+	}
+	if starlarkRunResult.ExecutionError != nil {
+		return stacktrace.NewError("An error occurred scaling replica set '%v' in enclave '%v': %s", replicaSetName, enclaveIdentifier, starlarkRunResult.ExecutionError)
+	}
+
+	out.PrintOutLn(string(starlarkRunResult.RunOutput))
+	return nil
+}
+
+// getReplicas returns the replicas of the replica set by index, a replica being a service labelled with the replica
+// set name and named after one of its indexes
+func getReplicas(
+	userServices map[string]*kurtosis_core_rpc_api_bindings.ServiceInfo,
+	replicaSetName string,
+) map[int]*kurtosis_core_rpc_api_bindings.ServiceInfo {
+	replicas := map[int]*kurtosis_core_rpc_api_bindings.ServiceInfo{}
+	for _, serviceInfo := range userServices {
+		if serviceInfo.GetLabels()[service.ReplicaSetLabelKey] != replicaSetName {
+			continue
+		}
+		replicaIndex, isReplica := service.GetReplicaIndex(replicaSetName, service.ServiceName(serviceInfo.GetName()))
+		if !isReplica {
+			continue
+		}
+		replicas[replicaIndex] = serviceInfo
+	}
+	return replicas
+}
+
+// getScaleStarlarkScript returns the script removing the replicas with the highest indexes when scaling down. When
+// scaling up, it runs the add_service instruction of the replica set again with the config of the replica with the
+// lowest index, so that the replicas are named after the indexes from 0 to the number of replicas - 1
+func getScaleStarlarkScript(
+	replicaSetName string,
+	currentReplicas map[int]*kurtosis_core_rpc_api_bindings.ServiceInfo,
+	replicas int,
+) string {
+	currentIndexes := []int{}
+	for replicaIndex := range currentReplicas {
+		currentIndexes = append(currentIndexes, replicaIndex)
+	}
+	sort.Ints(currentIndexes)
+
+	script := strings.Builder{}
+	script.WriteString("def run(plan):\n")
+	if replicas < len(currentIndexes) {
+		for _, replicaIndex := range currentIndexes[replicas:] {
+			fmt.Fprintf(&script, "\tplan.remove_service(name = %q)\n", service.GetReplicaServiceName(replicaSetName, replicaIndex))
+		}
+		return script.String()
+	}
+
+	for _, replicaIndex := range currentIndexes {
+		if replicaIndex >= replicas {
+			fmt.Fprintf(&script, "\tplan.remove_service(name = %q)\n", service.GetReplicaServiceName(replicaSetName, replicaIndex))
+		}
+	}
+	templateReplica := currentReplicas[currentIndexes[0]]
+	fmt.Fprintf(&script, "\tplan.add_service(name = %q, config = %s)\n", replicaSetName, getReplicaSetServiceConfigStarlark(templateReplica, replicas))
+	return script.String()
+}
+
+// getReplicaSetServiceConfigStarlark returns the config of the replica set with the given number of replicas, copied
+// from one of its replicas without what the replica set sets on each of them
+func getReplicaSetServiceConfigStarlark(templateReplica *kurtosis_core_rpc_api_bindings.ServiceInfo, replicas int) string {
+	serviceConfig := service_helpers.ServiceInfoToServiceConfig(templateReplica)
+	envVars := map[string]string{}
+	for key, value := range serviceConfig.EnvVars {
+		envVars[key] = value
+	}
+	delete(envVars, service.ReplicaIndexEnvVar)
+	labels := map[string]string{}
+	for key, value := range serviceConfig.Labels {
+		labels[key] = value
+	}
+	delete(labels, service.ReplicaSetLabelKey)
+
+	serviceConfigStarlark := services.GetFullServiceConfigStarlark(
+		serviceConfig.Image,
+		services.ConvertJsonPortToApiPort(serviceConfig.PrivatePorts),
+		serviceConfig.Files,
+		serviceConfig.Entrypoint,
+		serviceConfig.Cmd,
+		envVars,
+		serviceConfig.MaxMillicpus,
+		serviceConfig.MaxMemory,
+		serviceConfig.MinMillicpus,
+		serviceConfig.MinMemory,
+		serviceConfig.User,
+		serviceConfig.Tolerations,
+		serviceConfig.NodeSelectors,
+		labels,
+		serviceConfig.TiniEnabled,
+		serviceConfig.TtyEnabled,
+		serviceConfig.PrivateIPAddressPlaceholder,
+		serviceConfig.Privileged,
+		serviceConfig.BindMounts,
+		serviceConfig.HostPIDNamespace,
+	)
+	// the config of a single service has no replicas field, so it's appended as the last one
+	return fmt.Sprintf("%s, replicas = %d)", strings.TrimSuffix(serviceConfigStarlark, ")"), replicas)
+}
@@ -0,0 +1,81 @@
+package scale
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testReplicaSetName = "worker"
+	testImageName      = "worker-image"
+)
+
+func TestGetReplicas(t *testing.T) {
+	userServices := map[string]*kurtosis_core_rpc_api_bindings.ServiceInfo{
+		"worker-0":  newTestServiceInfo("worker-0", testReplicaSetName),
+		"worker-2":  newTestServiceInfo("worker-2", testReplicaSetName),
+		"worker-db": newTestServiceInfo("worker-db", testReplicaSetName),
+		"worker-1":  newTestServiceInfo("worker-1", ""),
+		"other-0":   newTestServiceInfo("other-0", "other"),
+	}
+
+	replicas := getReplicas(userServices, testReplicaSetName)
+	require.Len(t, replicas, 2)
+	require.Equal(t, "worker-0", replicas[0].GetName())
+	require.Equal(t, "worker-2", replicas[2].GetName())
+}
+
+func TestGetScaleStarlarkScript_RemovesHighestIndexes(t *testing.T) {
+	currentReplicas := map[int]*kurtosis_core_rpc_api_bindings.ServiceInfo{
+		0: newTestServiceInfo("worker-0", testReplicaSetName),
+		1: newTestServiceInfo("worker-1", testReplicaSetName),
+		3: newTestServiceInfo("worker-3", testReplicaSetName),
+	}
+
+	require.Equal(t, `def run(plan):
+	plan.remove_service(name = "worker-1")
+	plan.remove_service(name = "worker-3")
+`, getScaleStarlarkScript(testReplicaSetName, currentReplicas, 1))
+}
+
+func TestGetScaleStarlarkScript_AddsReplicaSet(t *testing.T) {
+	currentReplicas := map[int]*kurtosis_core_rpc_api_bindings.ServiceInfo{
+		1: newTestServiceInfo("worker-1", testReplicaSetName),
+		3: newTestServiceInfo("worker-3", testReplicaSetName),
+		5: newTestServiceInfo("worker-5", testReplicaSetName),
+	}
+
+	script := getScaleStarlarkScript(testReplicaSetName, currentReplicas, 4)
+	require.Contains(t, script, `plan.remove_service(name = "worker-5")`)
+	require.NotContains(t, script, `plan.remove_service(name = "worker-3")`)
+	require.Contains(t, script, `plan.add_service(name = "worker", config = ServiceConfig(image="worker-image", tini_enabled=False, replicas = 4))`)
+	// the replica set sets the index and the label of every replica itself
+	require.NotContains(t, script, service.ReplicaIndexEnvVar)
+	require.NotContains(t, script, service.ReplicaSetLabelKey)
+	// the template replica keeps its own index
+	require.Equal(t, "1", currentReplicas[1].GetContainer().GetEnvVars()[service.ReplicaIndexEnvVar])
+}
+
+func newTestServiceInfo(serviceName string, replicaSetName string) *kurtosis_core_rpc_api_bindings.ServiceInfo {
+	labels := map[string]string{}
+	envVars := map[string]string{}
+	if replicaSetName != "" {
+		labels[service.ReplicaSetLabelKey] = replicaSetName
+		if replicaIndex, isReplica := service.GetReplicaIndex(replicaSetName, service.ServiceName(serviceName)); isReplica {
+			envVars[service.ReplicaIndexEnvVar] = strconv.Itoa(replicaIndex)
+		}
+	}
+	//nolint:exhaustruct
+	return &kurtosis_core_rpc_api_bindings.ServiceInfo{
+		Name:   serviceName,
+		Labels: labels,
+		Container: &kurtosis_core_rpc_api_bindings.Container{
+			ImageName: testImageName,
+			EnvVars:   envVars,
+		},
+	}
+}
@@ -11,10 +11,13 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/exec"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/inspect"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/logs"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/restart"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/rm"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/scale"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/shell"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/start"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/stop"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/sync"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/update"
 	"github.com/spf13/cobra"
 )
@@ -35,6 +38,9 @@ func init() {
 	ServiceCmd.AddCommand(shell.ServiceShellCmd.MustGetCobraCommand())
 	ServiceCmd.AddCommand(start.ServiceStartCmd.MustGetCobraCommand())
 	ServiceCmd.AddCommand(stop.ServiceStopCmd.MustGetCobraCommand())
+	ServiceCmd.AddCommand(restart.ServiceRestartCmd.MustGetCobraCommand())
 	ServiceCmd.AddCommand(inspect.ServiceInspectCmd.MustGetCobraCommand())
 	ServiceCmd.AddCommand(update.ServiceUpdateCmd.MustGetCobraCommand())
+	ServiceCmd.AddCommand(sync.ServiceSyncCmd.MustGetCobraCommand())
+	ServiceCmd.AddCommand(scale.ServiceScaleCmd.MustGetCobraCommand())
 }
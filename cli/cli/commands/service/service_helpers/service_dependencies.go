@@ -0,0 +1,250 @@
+package service_helpers
+
+import (
+	"context"
+	"sort"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/enclaves"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/shared_starlark_calls"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/user_services"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	WithDependenciesFlagKey = "with-dependencies"
+	WithDependentsFlagKey   = "with-dependents"
+
+	withDependencyFlagsDefault = "false"
+)
+
+// ServiceToOperateOn is a service a start, stop or restart command operates on
+type ServiceToOperateOn struct {
+	Name services.ServiceName
+	// IsRequested is true when the service was given on the command line, and false when it was pulled in as a
+	// dependency or a dependent of one
+	IsRequested bool
+	IsRunning   bool
+}
+
+// NewServiceDependencyFlags returns the flags of the commands which can operate on the dependencies and the dependents
+// of the given services as well
+func NewServiceDependencyFlags() []*flags.FlagConfig {
+	return []*flags.FlagConfig{
+		{
+			Key:     WithDependenciesFlagKey,
+			Usage:   "Also operate on the services the given services depend on, as recorded in the enclave plan",
+			Type:    flags.FlagType_Bool,
+			Default: withDependencyFlagsDefault,
+		},
+		{
+			Key:     WithDependentsFlagKey,
+			Usage:   "Also operate on the services depending on the given services, as recorded in the enclave plan",
+			Type:    flags.FlagType_Bool,
+			Default: withDependencyFlagsDefault,
+		},
+	}
+}
+
+// IsOperatingOnServiceDependencies returns true when the flags ask to operate on the dependencies or the dependents of
+// the given services as well
+func IsOperatingOnServiceDependencies(parsedFlags *flags.ParsedFlags) (bool, error) {
+	withDependencies, err := parsedFlags.GetBool(WithDependenciesFlagKey)
+	if err != nil {
+		return false, stacktrace.Propagate(err, "An error occurred getting the value of flag '%v'", WithDependenciesFlagKey)
+	}
+	withDependents, err := parsedFlags.GetBool(WithDependentsFlagKey)
+	if err != nil {
+		return false, stacktrace.Propagate(err, "An error occurred getting the value of flag '%v'", WithDependentsFlagKey)
+	}
+	return withDependencies || withDependents, nil
+}
+
+// GetServicesInStartOrder returns the given services, along with their dependencies and dependents when the flags ask
+// for them, ordered so that every service comes after the services it depends on. Stopping them goes in the reverse
+// order. Without any of the flags, the services are returned in the order they were given.
+func GetServicesInStartOrder(
+	ctx context.Context,
+	kurtosisCtx *kurtosis_context.KurtosisContext,
+	enclaveCtx *enclaves.EnclaveContext,
+	enclaveIdentifier string,
+	serviceIdentifiers []string,
+	parsedFlags *flags.ParsedFlags,
+) ([]*ServiceToOperateOn, error) {
+	withDependencies, err := parsedFlags.GetBool(WithDependenciesFlagKey)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the value of flag '%v'", WithDependenciesFlagKey)
+	}
+	withDependents, err := parsedFlags.GetBool(WithDependentsFlagKey)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the value of flag '%v'", WithDependentsFlagKey)
+	}
+
+	requestedServiceNames := []services.ServiceName{}
+	for _, serviceIdentifier := range serviceIdentifiers {
+		serviceContext, err := enclaveCtx.GetServiceContext(serviceIdentifier)
+		if err != nil {
+			return nil, stacktrace.NewError("Couldn't validate whether the service exists for identifier '%v'", serviceIdentifier)
+		}
+		requestedServiceNames = append(requestedServiceNames, serviceContext.GetServiceName())
+	}
+
+	isServiceRunning, err := getServicesRunningStatus(ctx, kurtosisCtx, enclaveIdentifier)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the status of the services in enclave '%v'", enclaveIdentifier)
+	}
+
+	orderedServiceNames := requestedServiceNames
+	if withDependencies || withDependents {
+		serviceDependencies, err := enclaveCtx.GetServiceDependencies(ctx)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the dependencies between the services of enclave '%v'", enclaveIdentifier)
+		}
+		// Services which were removed since the plan added them have nothing left to operate on
+		existingServiceDependencies := map[services.ServiceName][]services.ServiceName{}
+		for serviceName, dependencies := range serviceDependencies {
+			if _, found := isServiceRunning[serviceName]; !found {
+				continue
+			}
+			existingServiceDependencies[serviceName] = []services.ServiceName{}
+			for _, dependency := range dependencies {
+				if _, found := isServiceRunning[dependency]; found {
+					existingServiceDependencies[serviceName] = append(existingServiceDependencies[serviceName], dependency)
+				}
+			}
+		}
+		orderedServiceNames, err = orderServicesToStart(requestedServiceNames, existingServiceDependencies, withDependencies, withDependents)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred ordering the services to operate on in enclave '%v'", enclaveIdentifier)
+		}
+	}
+
+	isRequested := map[services.ServiceName]bool{}
+	for _, serviceName := range requestedServiceNames {
+		isRequested[serviceName] = true
+	}
+	servicesToOperateOn := []*ServiceToOperateOn{}
+	for _, serviceName := range orderedServiceNames {
+		servicesToOperateOn = append(servicesToOperateOn, &ServiceToOperateOn{
+			Name:        serviceName,
+			IsRequested: isRequested[serviceName],
+			IsRunning:   isServiceRunning[serviceName],
+		})
+	}
+	return servicesToOperateOn, nil
+}
+
+// StartServiceAndWaitForReadiness starts the service and then runs the ready conditions it was added with, if any, so
+// that the services depending on it are started once it's actually ready
+func StartServiceAndWaitForReadiness(ctx context.Context, enclaveCtx *enclaves.EnclaveContext, serviceName services.ServiceName) error {
+	if err := shared_starlark_calls.StartServiceStarlarkCommand(ctx, enclaveCtx, serviceName); err != nil {
+		return stacktrace.Propagate(err, "An error occurred starting service '%v'", serviceName)
+	}
+	if err := enclaveCtx.WaitForServiceReadiness(ctx, serviceName); err != nil {
+		return stacktrace.Propagate(err, "Service '%v' was started but didn't become ready", serviceName)
+	}
+	return nil
+}
+
+func getServicesRunningStatus(ctx context.Context, kurtosisCtx *kurtosis_context.KurtosisContext, enclaveIdentifier string) (map[services.ServiceName]bool, error) {
+	enclaveInfo, err := kurtosisCtx.GetEnclave(ctx, enclaveIdentifier)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the enclave for identifier '%v'", enclaveIdentifier)
+	}
+	allServicesMap := map[string]bool{}
+	userServices, err := user_services.GetUserServiceInfoMapFromAPIContainer(ctx, enclaveInfo, allServicesMap)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to get service info from API container in enclave '%v'", enclaveInfo.GetEnclaveUuid())
+	}
+	isServiceRunning := map[services.ServiceName]bool{}
+	for _, serviceInfo := range userServices {
+		isServiceRunning[services.ServiceName(serviceInfo.GetName())] = serviceInfo.GetServiceStatus() == kurtosis_core_rpc_api_bindings.ServiceStatus_RUNNING
+	}
+	return isServiceRunning, nil
+}
+
+// orderServicesToStart adds the dependencies and dependents of the requested services to them, following the edges
+// transitively, and sorts the result topologically. Services which don't depend on each other are ordered by name so
+// that the order doesn't change from one run to the next.
+func orderServicesToStart(
+	requestedServiceNames []services.ServiceName,
+	serviceDependencies map[services.ServiceName][]services.ServiceName,
+	withDependencies bool,
+	withDependents bool,
+) ([]services.ServiceName, error) {
+	serviceDependents := map[services.ServiceName][]services.ServiceName{}
+	for serviceName, dependencies := range serviceDependencies {
+		for _, dependency := range dependencies {
+			serviceDependents[dependency] = append(serviceDependents[dependency], serviceName)
+		}
+	}
+
+	selectedServices := map[services.ServiceName]bool{}
+	for _, serviceName := range requestedServiceNames {
+		selectedServices[serviceName] = true
+	}
+	if withDependencies {
+		addReachableServices(requestedServiceNames, serviceDependencies, selectedServices)
+	}
+	if withDependents {
+		addReachableServices(requestedServiceNames, serviceDependents, selectedServices)
+	}
+
+	remainingDependenciesCount := map[services.ServiceName]int{}
+	for serviceName := range selectedServices {
+		remainingDependenciesCount[serviceName] = 0
+		for _, dependency := range serviceDependencies[serviceName] {
+			if selectedServices[dependency] {
+				remainingDependenciesCount[serviceName]++
+			}
+		}
+	}
+
+	orderedServiceNames := []services.ServiceName{}
+	for len(remainingDependenciesCount) > 0 {
+		readyServiceNames := []services.ServiceName{}
+		for serviceName, count := range remainingDependenciesCount {
+			if count == 0 {
+				readyServiceNames = append(readyServiceNames, serviceName)
+			}
+		}
+		if len(readyServiceNames) == 0 {
+			return nil, stacktrace.NewError("The services depend on each other in a cycle, so there's no order to operate on them in")
+		}
+		sort.Slice(readyServiceNames, func(i, j int) bool {
+			return readyServiceNames[i] < readyServiceNames[j]
+		})
+		for _, serviceName := range readyServiceNames {
+			delete(remainingDependenciesCount, serviceName)
+			for _, dependent := range serviceDependents[serviceName] {
+				if _, found := remainingDependenciesCount[dependent]; found {
+					remainingDependenciesCount[dependent]--
+				}
+			}
+		}
+		orderedServiceNames = append(orderedServiceNames, readyServiceNames...)
+	}
+	return orderedServiceNames, nil
+}
+
+func addReachableServices(
+	serviceNames []services.ServiceName,
+	edges map[services.ServiceName][]services.ServiceName,
+	reachedServices map[services.ServiceName]bool,
+) {
+	servicesToVisit := append([]services.ServiceName{}, serviceNames...)
+	for len(servicesToVisit) > 0 {
+		serviceToVisit := servicesToVisit[0]
+		servicesToVisit = servicesToVisit[1:]
+		for _, reachedService := range edges[serviceToVisit] {
+			if reachedServices[reachedService] {
+				continue
+			}
+			reachedServices[reachedService] = true
+			servicesToVisit = append(servicesToVisit, reachedService)
+		}
+	}
+}
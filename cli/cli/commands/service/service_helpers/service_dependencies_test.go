@@ -0,0 +1,44 @@
+package service_helpers
+
+import (
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
+	"github.com/stretchr/testify/require"
+)
+
+// db <- api <- (worker, frontend), and cache on its own
+var testServiceDependencies = map[services.ServiceName][]services.ServiceName{
+	"db":       {},
+	"api":      {"db"},
+	"worker":   {"api"},
+	"frontend": {"api"},
+	"cache":    {},
+}
+
+func TestOrderServicesToStart_WithDependencies(t *testing.T) {
+	orderedServiceNames, err := orderServicesToStart([]services.ServiceName{"worker"}, testServiceDependencies, true, false)
+	require.NoError(t, err)
+	require.Equal(t, []services.ServiceName{"db", "api", "worker"}, orderedServiceNames)
+}
+
+func TestOrderServicesToStart_WithDependents(t *testing.T) {
+	orderedServiceNames, err := orderServicesToStart([]services.ServiceName{"db"}, testServiceDependencies, false, true)
+	require.NoError(t, err)
+	require.Equal(t, []services.ServiceName{"db", "api", "frontend", "worker"}, orderedServiceNames)
+}
+
+func TestOrderServicesToStart_WithDependenciesAndDependents(t *testing.T) {
+	orderedServiceNames, err := orderServicesToStart([]services.ServiceName{"api", "cache"}, testServiceDependencies, true, true)
+	require.NoError(t, err)
+	require.Equal(t, []services.ServiceName{"cache", "db", "api", "frontend", "worker"}, orderedServiceNames)
+}
+
+func TestOrderServicesToStart_FailsOnCycle(t *testing.T) {
+	serviceDependencies := map[services.ServiceName][]services.ServiceName{
+		"a": {"b"},
+		"b": {"a"},
+	}
+	_, err := orderServicesToStart([]services.ServiceName{"a"}, serviceDependencies, true, false)
+	require.Error(t, err)
+}
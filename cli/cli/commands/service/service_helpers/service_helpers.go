@@ -100,33 +100,36 @@ func GetServiceInfo(ctx context.Context, kurtosisCtx *kurtosis_context.KurtosisC
 		break
 	}
 
-	isTiniEnabled := service.GetTiniEnabled()
-	isTtyEnabled := service.GetTtyEnabled()
-	serviceConfig := &services.ServiceConfig{
-		Image:                       service.GetContainer().GetImageName(),
-		PrivatePorts:                services.ConvertApiPortToJsonPort(service.GetPrivatePorts()),
-		PublicPorts:                 services.ConvertApiPortToJsonPort(service.GetMaybePublicPorts()),
-		Files:                       services.ConvertApiFilesArtifactsToJsonFiles(service.GetServiceDirPathsToFilesArtifactsList()),
-		Entrypoint:                  service.GetContainer().GetEntrypointArgs(),
-		Cmd:                         service.GetContainer().GetCmdArgs(),
-		EnvVars:                     service.GetContainer().GetEnvVars(),
+	return service, ServiceInfoToServiceConfig(service), nil
+}
+
+// ServiceInfoToServiceConfig returns the config a service is running with, as reported by the APIC
+func ServiceInfoToServiceConfig(serviceInfo *kurtosis_core_rpc_api_bindings.ServiceInfo) *services.ServiceConfig {
+	isTiniEnabled := serviceInfo.GetTiniEnabled()
+	isTtyEnabled := serviceInfo.GetTtyEnabled()
+	return &services.ServiceConfig{
+		Image:                       serviceInfo.GetContainer().GetImageName(),
+		PrivatePorts:                services.ConvertApiPortToJsonPort(serviceInfo.GetPrivatePorts()),
+		PublicPorts:                 services.ConvertApiPortToJsonPort(serviceInfo.GetMaybePublicPorts()),
+		Files:                       services.ConvertApiFilesArtifactsToJsonFiles(serviceInfo.GetServiceDirPathsToFilesArtifactsList()),
+		Entrypoint:                  serviceInfo.GetContainer().GetEntrypointArgs(),
+		Cmd:                         serviceInfo.GetContainer().GetCmdArgs(),
+		EnvVars:                     serviceInfo.GetContainer().GetEnvVars(),
 		PrivateIPAddressPlaceholder: "", // leave empty for now
-		MaxMillicpus:                service.GetMaxMillicpus(),
-		MinMillicpus:                service.GetMinMillicpus(),
-		MaxMemory:                   service.GetMaxMemoryMegabytes(),
-		MinMemory:                   service.GetMinMemoryMegabytes(),
-		User:                        services.ConvertApiUserToJsonUser(service.GetUser()),
-		Tolerations:                 services.ConvertApiTolerationsToJsonTolerations(service.GetTolerations()),
-		NodeSelectors:               service.GetNodeSelectors(),
-		Labels:                      service.GetLabels(),
+		MaxMillicpus:                serviceInfo.GetMaxMillicpus(),
+		MinMillicpus:                serviceInfo.GetMinMillicpus(),
+		MaxMemory:                   serviceInfo.GetMaxMemoryMegabytes(),
+		MinMemory:                   serviceInfo.GetMinMemoryMegabytes(),
+		User:                        services.ConvertApiUserToJsonUser(serviceInfo.GetUser()),
+		Tolerations:                 services.ConvertApiTolerationsToJsonTolerations(serviceInfo.GetTolerations()),
+		NodeSelectors:               serviceInfo.GetNodeSelectors(),
+		Labels:                      serviceInfo.GetLabels(),
 		TiniEnabled:                 &isTiniEnabled,
 		TtyEnabled:                  &isTtyEnabled,
-		Privileged:                  service.GetPrivileged(),
-		BindMounts:                  service.GetBindMounts(),
-		HostPIDNamespace:            service.GetHostPidNamespace(),
+		Privileged:                  serviceInfo.GetPrivileged(),
+		BindMounts:                  serviceInfo.GetBindMounts(),
+		HostPIDNamespace:            serviceInfo.GetHostPidNamespace(),
 	}
-
-	return service, serviceConfig, nil
 }
 
 func GetAddServiceStarlarkScript(serviceName string, serviceConfigStarlark string) string {
@@ -2,13 +2,9 @@ package start
 
 import (
 	"context"
-	"fmt"
-	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/starlark_run_config"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/metrics_client_factory"
 	"github.com/sirupsen/logrus"
 
-	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/enclaves"
-	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
@@ -17,6 +13,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/service_helpers"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
 	"github.com/kurtosis-tech/stacktrace"
@@ -33,17 +30,12 @@ const (
 
 	kurtosisBackendCtxKey = "kurtosis-backend"
 	engineClientCtxKey    = "engine-client"
-
-	starlarkScript = `
-def run(plan, args):
-	plan.start_service(name=args["service_name"])
-`
 )
 
 var ServiceStartCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
 	CommandStr:                command_str_consts.ServiceStartCmdStr,
 	ShortDescription:          "Restarts a stopped service",
-	LongDescription:           "Restarts the temporarily stopped service with the given service identifier in the given enclave",
+	LongDescription:           "Restarts the temporarily stopped service with the given service identifier in the given enclave. With the dependency flags, the services it depends on and/or the services depending on it get started too, each one after its dependencies are ready",
 	KurtosisBackendContextKey: kurtosisBackendCtxKey,
 	EngineClientContextKey:    engineClientCtxKey,
 	Args: []*args.ArgConfig{
@@ -60,7 +52,7 @@ var ServiceStartCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosis
 			isServiceIdentifierArgGreedy,
 		),
 	},
-	Flags:   []*flags.FlagConfig{},
+	Flags:   service_helpers.NewServiceDependencyFlags(),
 	RunFunc: run,
 }
 
@@ -69,7 +61,7 @@ func run(
 	_ backend_interface.KurtosisBackend,
 	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
 	_ metrics_client.MetricsClient,
-	_ *flags.ParsedFlags,
+	flags *flags.ParsedFlags,
 	args *args.ParsedArgs,
 ) error {
 	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
@@ -102,42 +94,27 @@ func run(
 		}
 	}()
 
-	for _, serviceIdentifier := range serviceIdentifiers {
-		logrus.Infof("Starting service '%v'", serviceIdentifier)
-		serviceContext, err := enclaveCtx.GetServiceContext(serviceIdentifier)
-		if err != nil {
-			return stacktrace.NewError("Couldn't validate whether the service exists for identifier '%v'", serviceIdentifier)
-		}
+	servicesToStart, err := service_helpers.GetServicesInStartOrder(ctx, kurtosisCtx, enclaveCtx, enclaveIdentifier, serviceIdentifiers, flags)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the services to start in enclave '%v'", enclaveIdentifier)
+	}
 
-		serviceName := serviceContext.GetServiceName()
+	for _, serviceToStart := range servicesToStart {
+		serviceName := serviceToStart.Name
+		if !serviceToStart.IsRequested && serviceToStart.IsRunning {
+			logrus.Infof("Service '%v' is already running", serviceName)
+			continue
+		}
+		logrus.Infof("Starting service '%v'", serviceName)
 
 		err = metricsClient.TrackStartService(enclaveIdentifier, string(serviceName))
 		if err != nil {
 			return stacktrace.Propagate(err, "An error occurred tracking service update metric.")
 		}
 
-		if err := startServiceStarlarkCommand(ctx, enclaveCtx, serviceName); err != nil {
-			return stacktrace.Propagate(err, "An error occurred starting service '%v' from enclave '%v'", serviceIdentifier, enclaveIdentifier)
+		if err := service_helpers.StartServiceAndWaitForReadiness(ctx, enclaveCtx, serviceName); err != nil {
+			return stacktrace.Propagate(err, "An error occurred starting service '%v' from enclave '%v'", serviceName, enclaveIdentifier)
 		}
-
-	}
-	return nil
-}
-
-func startServiceStarlarkCommand(ctx context.Context, enclaveCtx *enclaves.EnclaveContext, serviceName services.ServiceName) error {
-	serviceNameString := fmt.Sprintf(`{"service_name": "%s"}`, serviceName)
-	runResult, err := enclaveCtx.RunStarlarkScriptBlocking(ctx, starlarkScript, starlark_run_config.NewRunStarlarkConfig(starlark_run_config.WithSerializedParams(serviceNameString)))
-	if err != nil {
-		return stacktrace.Propagate(err, "An unexpected error occurred on Starlark for starting service")
-	}
-	if runResult.ExecutionError != nil {
-		return stacktrace.NewError("An error occurred during Starlark script execution for starting service: %s", runResult.ExecutionError.GetErrorMessage())
-	}
-	if runResult.InterpretationError != nil {
-		return stacktrace.NewError("An error occurred during Starlark script interpretation for starting service: %s", runResult.InterpretationError.GetErrorMessage())
-	}
-	if len(runResult.ValidationErrors) > 0 {
-		return stacktrace.NewError("An error occurred during Starlark script validation for starting service: %v", runResult.ValidationErrors)
 	}
 	return nil
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/service_helpers"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/metrics_client_factory"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/shared_starlark_calls"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
@@ -34,7 +35,7 @@ const (
 var ServiceStopCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
 	CommandStr:                command_str_consts.ServiceStopCmdStr,
 	ShortDescription:          "Stops a service",
-	LongDescription:           "Stops temporarily a service with the given service identifier in the given enclave",
+	LongDescription:           "Stops temporarily a service with the given service identifier in the given enclave. With the dependency flags, the services it depends on and/or the services depending on it get stopped too, each one before its dependencies",
 	KurtosisBackendContextKey: kurtosisBackendCtxKey,
 	EngineClientContextKey:    engineClientCtxKey,
 	Args: []*args.ArgConfig{
@@ -51,7 +52,7 @@ var ServiceStopCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisC
 			isServiceIdentifierArgGreedy,
 		),
 	},
-	Flags:   []*flags.FlagConfig{},
+	Flags:   service_helpers.NewServiceDependencyFlags(),
 	RunFunc: run,
 }
 
@@ -60,7 +61,7 @@ func run(
 	_ backend_interface.KurtosisBackend,
 	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
 	_ metrics_client.MetricsClient,
-	_ *flags.ParsedFlags,
+	flags *flags.ParsedFlags,
 	args *args.ParsedArgs,
 ) error {
 	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
@@ -93,14 +94,46 @@ func run(
 		}
 	}()
 
-	for _, serviceIdentifier := range serviceIdentifiers {
-		logrus.Infof("Stopping service '%v'", serviceIdentifier)
-		serviceContext, err := enclaveCtx.GetServiceContext(serviceIdentifier)
-		if err != nil {
-			return stacktrace.NewError("Couldn't validate whether the service exists for identifier '%v'", serviceIdentifier)
+	isOperatingOnServiceDependencies, err := service_helpers.IsOperatingOnServiceDependencies(flags)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the service dependency flags")
+	}
+	if !isOperatingOnServiceDependencies {
+		for _, serviceIdentifier := range serviceIdentifiers {
+			logrus.Infof("Stopping service '%v'", serviceIdentifier)
+			serviceContext, err := enclaveCtx.GetServiceContext(serviceIdentifier)
+			if err != nil {
+				return stacktrace.NewError("Couldn't validate whether the service exists for identifier '%v'", serviceIdentifier)
+			}
+
+			serviceName := serviceContext.GetServiceName()
+
+			err = metricsClient.TrackStopService(enclaveIdentifier, string(serviceName))
+			if err != nil {
+				return stacktrace.Propagate(err, "An error occurred tracking service update metric.")
+			}
+
+			if err := shared_starlark_calls.StopServiceStarlarkCommand(ctx, enclaveCtx, serviceName); err != nil {
+				return stacktrace.Propagate(err, "An error occurred stopping service '%v' from enclave '%v'", serviceIdentifier, enclaveIdentifier)
+			}
 		}
+		return nil
+	}
 
-		serviceName := serviceContext.GetServiceName()
+	servicesToStop, err := service_helpers.GetServicesInStartOrder(ctx, kurtosisCtx, enclaveCtx, enclaveIdentifier, serviceIdentifiers, flags)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the services to stop in enclave '%v'", enclaveIdentifier)
+	}
+
+	// Services get stopped before the services they depend on
+	for serviceIdx := len(servicesToStop) - 1; serviceIdx >= 0; serviceIdx-- {
+		serviceToStop := servicesToStop[serviceIdx]
+		serviceName := serviceToStop.Name
+		if !serviceToStop.IsRequested && !serviceToStop.IsRunning {
+			logrus.Infof("Service '%v' is already stopped", serviceName)
+			continue
+		}
+		logrus.Infof("Stopping service '%v'", serviceName)
 
 		err = metricsClient.TrackStopService(enclaveIdentifier, string(serviceName))
 		if err != nil {
@@ -108,7 +141,7 @@ func run(
 		}
 
 		if err := shared_starlark_calls.StopServiceStarlarkCommand(ctx, enclaveCtx, serviceName); err != nil {
-			return stacktrace.Propagate(err, "An error occurred stopping service '%v' from enclave '%v'", serviceIdentifier, enclaveIdentifier)
+			return stacktrace.Propagate(err, "An error occurred stopping service '%v' from enclave '%v'", serviceName, enclaveIdentifier)
 		}
 	}
 	return nil
@@ -0,0 +1,461 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/service_identifier_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/user_services"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
+	"github.com/kurtosis-tech/kurtosis/path-compression"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	enclaveIdentifierArgKey = "enclave"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	serviceIdentifierArgKey  = "service"
+	isServiceGuidArgOptional = false
+	isServiceGuidArgGreedy   = false
+
+	syncSpecArgKey        = "sync-spec"
+	isSyncSpecArgOptional = false
+	isSyncSpecArgGreedy   = false
+
+	watchFlagKey      = "watch"
+	watchFlagShortKey = "w"
+	watchFlagDefault  = "false"
+
+	execFlagKey     = "exec"
+	execFlagDefault = ""
+
+	syncSpecSeparator = ":"
+	// How often the local directory is checked for changes when watching it
+	watchPollInterval = 1 * time.Second
+	// Syncs failing while watching, e.g. because the engine or the service is briefly unreachable, are retried on the
+	// next check until this many fail in a row
+	maxConsecutiveWatchSyncFailures = 10
+
+	interruptChanBufferSize = 1
+
+	binShCommand     = "sh"
+	binShCommandFlag = "-c"
+	// The commands are run as the default user of the container
+	defaultContainerUser = ""
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+)
+
+var ServiceSyncCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.ServiceSyncCmdStr,
+	ShortDescription: "Syncs a local directory into a running service",
+	LongDescription: "Copies the files of a local directory into a directory of a running service, given as '<local-dir>:<container-path>'. " +
+		"With --watch, it keeps watching the local directory and only pushes the files that changed, removing from the service the files " +
+		"and directories deleted locally. Files excluded by the .kurtosisignore file of the local directory are never synced. Use --exec to run a command " +
+		"in the service after every sync, e.g. to reload the process serving the files",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags: []*flags.FlagConfig{
+		{
+			Key:       watchFlagKey,
+			Usage:     "Keep watching the local directory and sync the files as they change, until interrupted with Ctrl+C",
+			Shorthand: watchFlagShortKey,
+			Type:      flags.FlagType_Bool,
+			Default:   watchFlagDefault,
+		},
+		{
+			Key:     execFlagKey,
+			Usage:   "A command to run in the service with 'sh -c' after every sync that changed files, e.g. to restart or reload the process using them",
+			Type:    flags.FlagType_String,
+			Default: execFlagDefault,
+		},
+	},
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+		service_identifier_arg.NewServiceIdentifierArg(
+			serviceIdentifierArgKey,
+			enclaveIdentifierArgKey,
+			isServiceGuidArgOptional,
+			isServiceGuidArgGreedy,
+		),
+		{
+			Key:        syncSpecArgKey,
+			IsOptional: isSyncSpecArgOptional,
+			IsGreedy:   isSyncSpecArgGreedy,
+		},
+	},
+	RunFunc: run,
+}
+
+// What's compared to tell whether a local file changed since it was last synced
+type fileState struct {
+	size    int64
+	modTime time.Time
+	mode    os.FileMode
+}
+
+type fileSyncer struct {
+	kurtosisBackend  backend_interface.KurtosisBackend
+	enclaveUuid      enclave.EnclaveUUID
+	serviceUuid      service.ServiceUUID
+	localDirpath     string
+	containerDirpath string
+	afterSyncCommand string
+}
+
+func run(
+	ctx context.Context,
+	kurtosisBackend backend_interface.KurtosisBackend,
+	engineClient kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave identifier using arg key '%v'", enclaveIdentifierArgKey)
+	}
+
+	serviceIdentifier, err := args.GetNonGreedyArg(serviceIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the service identifier using arg key '%v'", serviceIdentifierArgKey)
+	}
+
+	syncSpec, err := args.GetNonGreedyArg(syncSpecArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the sync spec using arg key '%v'", syncSpecArgKey)
+	}
+	localDirpath, containerDirpath, err := parseSyncSpec(syncSpec)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred parsing sync spec '%v'", syncSpec)
+	}
+
+	shouldWatch, err := flags.GetBool(watchFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the watch flag '%v'", watchFlagKey)
+	}
+	afterSyncCommand, err := flags.GetString(execFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the exec flag '%v'", execFlagKey)
+	}
+
+	enclaveInfo, err := getEnclaveInfo(ctx, engineClient, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave with identifier '%v'", enclaveIdentifier)
+	}
+	serviceUuid, err := getServiceUuid(ctx, enclaveInfo, serviceIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the service with identifier '%v' in enclave '%v'", serviceIdentifier, enclaveIdentifier)
+	}
+
+	syncer := &fileSyncer{
+		kurtosisBackend:  kurtosisBackend,
+		enclaveUuid:      enclave.EnclaveUUID(enclaveInfo.GetEnclaveUuid()),
+		serviceUuid:      serviceUuid,
+		localDirpath:     localDirpath,
+		containerDirpath: containerDirpath,
+		afterSyncCommand: afterSyncCommand,
+	}
+
+	// The first sync pushes every file, without removing from the service the files that don't exist locally
+	syncedFiles, err := getLocalFileStates(localDirpath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred listing the files in '%v'", localDirpath)
+	}
+	if err = syncer.sync(ctx, getSortedPaths(syncedFiles), []string{}, []string{}); err != nil {
+		return stacktrace.Propagate(err, "An error occurred syncing '%v' into '%v' on service '%v'", localDirpath, containerDirpath, serviceIdentifier)
+	}
+	if !shouldWatch {
+		return nil
+	}
+
+	out.PrintOutLn(fmt.Sprintf("Watching '%v' for changes, press Ctrl+C to stop", localDirpath))
+	interruptChan := make(chan os.Signal, interruptChanBufferSize)
+	signal.Notify(interruptChan, os.Interrupt)
+	defer signal.Stop(interruptChan)
+	ticker := time.NewTicker(watchPollInterval)
+	defer ticker.Stop()
+	consecutiveSyncFailures := 0
+	for {
+		select {
+		case <-interruptChan:
+			logrus.Debugf("Received signal interruption in service sync Kurtosis CLI command")
+			return nil
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			currentFiles, err := getLocalFileStates(localDirpath)
+			if err != nil {
+				// Files can disappear while the directory is being listed, e.g. while an editor saves them, so this is retried
+				logrus.Debugf("An error occurred listing the files in '%v', retrying on the next check:\n%v", localDirpath, err)
+				continue
+			}
+			changedFilepaths, removedFilepaths := getChangedAndRemovedFilepaths(syncedFiles, currentFiles)
+			if len(changedFilepaths) == 0 && len(removedFilepaths) == 0 {
+				continue
+			}
+			removedDirpaths := getRemovedDirpaths(localDirpath, removedFilepaths)
+			if err = syncer.sync(ctx, changedFilepaths, removedFilepaths, removedDirpaths); err != nil {
+				consecutiveSyncFailures++
+				if consecutiveSyncFailures >= maxConsecutiveWatchSyncFailures {
+					return stacktrace.Propagate(err, "Syncing the changes of '%v' into '%v' on service '%v' failed %d times in a row", localDirpath, containerDirpath, serviceIdentifier, consecutiveSyncFailures)
+				}
+				// The files synced so far are left as they were, so the next check pushes all these changes again
+				logrus.Warnf("An error occurred syncing the changes of '%v' into '%v' on service '%v', retrying on the next check:\n%v", localDirpath, containerDirpath, serviceIdentifier, err)
+				continue
+			}
+			consecutiveSyncFailures = 0
+			syncedFiles = currentFiles
+		}
+	}
+}
+
+// getEnclaveInfo resolves the enclave name, UUID or shortened UUID to the running enclave it identifies
+func getEnclaveInfo(ctx context.Context, engineClient kurtosis_engine_rpc_api_bindings.EngineServiceClient, enclaveIdentifier string) (*kurtosis_engine_rpc_api_bindings.EnclaveInfo, error) {
+	enclaveIdentifiers, err := engineClient.GetExistingAndHistoricalEnclaveIdentifiers(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the enclave identifiers from the engine")
+	}
+	matchingEnclaveUuids := []string{}
+	for _, identifiers := range enclaveIdentifiers.GetAllIdentifiers() {
+		if identifiers.GetName() == enclaveIdentifier || identifiers.GetShortenedUuid() == enclaveIdentifier || identifiers.GetEnclaveUuid() == enclaveIdentifier {
+			matchingEnclaveUuids = append(matchingEnclaveUuids, identifiers.GetEnclaveUuid())
+		}
+	}
+	if len(matchingEnclaveUuids) == 0 {
+		return nil, stacktrace.NewError("No enclave found with identifier '%v'", enclaveIdentifier)
+	}
+	// Only the running enclave is returned among the ones that had the same name
+	enclavesResponse, err := engineClient.GetEnclavesByUuids(ctx, &kurtosis_engine_rpc_api_bindings.GetEnclavesByUuidsArgs{
+		EnclaveUuids: matchingEnclaveUuids,
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the enclaves with UUIDs '%v' from the engine", matchingEnclaveUuids)
+	}
+	if len(enclavesResponse.GetEnclaveInfo()) != 1 {
+		return nil, stacktrace.NewError("Expected exactly one running enclave with identifier '%v' but found %d", enclaveIdentifier, len(enclavesResponse.GetEnclaveInfo()))
+	}
+	for _, enclaveInfo := range enclavesResponse.GetEnclaveInfo() {
+		return enclaveInfo, nil
+	}
+	return nil, stacktrace.NewError("No running enclave found with identifier '%v'; this is a bug in the Kurtosis CLI", enclaveIdentifier)
+}
+
+// getServiceUuid resolves the service name, UUID or shortened UUID to the UUID of the service it identifies
+func getServiceUuid(ctx context.Context, enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo, serviceIdentifier string) (service.ServiceUUID, error) {
+	serviceInfos, err := user_services.GetUserServiceInfoMapFromAPIContainer(ctx, enclaveInfo, map[string]bool{serviceIdentifier: true})
+	if err != nil {
+		return "", stacktrace.Propagate(err, "Failed to get service info from API container in enclave '%v'", enclaveInfo.GetEnclaveUuid())
+	}
+	if len(serviceInfos) != 1 {
+		return "", stacktrace.NewError("Expected exactly one service with identifier '%v' but found %d", serviceIdentifier, len(serviceInfos))
+	}
+	for _, serviceInfo := range serviceInfos {
+		return service.ServiceUUID(serviceInfo.GetServiceUuid()), nil
+	}
+	return "", stacktrace.NewError("No service found with identifier '%v'; this is a bug in the Kurtosis CLI", serviceIdentifier)
+}
+
+// parseSyncSpec splits '<local-dir>:<container-path>' on its last colon, so that Windows paths like 'C:\src' work
+func parseSyncSpec(syncSpec string) (string, string, error) {
+	separatorIdx := strings.LastIndex(syncSpec, syncSpecSeparator)
+	if separatorIdx < 0 {
+		return "", "", stacktrace.NewError("Expected the sync spec to be of the form '<local-dir>%v<container-path>' but it has no '%v'", syncSpecSeparator, syncSpecSeparator)
+	}
+	localDirpath := syncSpec[:separatorIdx]
+	containerDirpath := syncSpec[separatorIdx+len(syncSpecSeparator):]
+	if localDirpath == "" {
+		return "", "", stacktrace.NewError("The local directory of the sync spec is empty")
+	}
+	if !path.IsAbs(containerDirpath) {
+		return "", "", stacktrace.NewError("The container path of the sync spec must be absolute but was '%v'", containerDirpath)
+	}
+
+	absoluteLocalDirpath, err := filepath.Abs(localDirpath)
+	if err != nil {
+		return "", "", stacktrace.Propagate(err, "An error occurred getting the absolute path of '%v'", localDirpath)
+	}
+	localDirInfo, err := os.Stat(absoluteLocalDirpath)
+	if err != nil {
+		return "", "", stacktrace.Propagate(err, "An error occurred inspecting local directory '%v'", absoluteLocalDirpath)
+	}
+	if !localDirInfo.IsDir() {
+		return "", "", stacktrace.NewError("Expected '%v' to be a directory but it isn't", absoluteLocalDirpath)
+	}
+	return absoluteLocalDirpath, path.Clean(containerDirpath), nil
+}
+
+// getLocalFileStates returns the state of the files to sync, keyed by their path relative to the local directory
+func getLocalFileStates(localDirpath string) (map[string]fileState, error) {
+	relativeFilepaths, err := path_compression.ListFilesInPath(localDirpath)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred listing the files in '%v'", localDirpath)
+	}
+	fileStates := map[string]fileState{}
+	for _, relativeFilepath := range relativeFilepaths {
+		fileInfo, err := os.Stat(filepath.Join(localDirpath, relativeFilepath))
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred inspecting file '%v' in '%v'", relativeFilepath, localDirpath)
+		}
+		fileStates[relativeFilepath] = fileState{
+			size:    fileInfo.Size(),
+			modTime: fileInfo.ModTime(),
+			mode:    fileInfo.Mode(),
+		}
+	}
+	return fileStates, nil
+}
+
+// getChangedAndRemovedFilepaths returns, sorted, the files that were added or modified and the ones that were removed
+func getChangedAndRemovedFilepaths(previousFiles map[string]fileState, currentFiles map[string]fileState) ([]string, []string) {
+	changedFilepaths := []string{}
+	for relativeFilepath, currentState := range currentFiles {
+		previousState, found := previousFiles[relativeFilepath]
+		if !found || previousState.size != currentState.size || !previousState.modTime.Equal(currentState.modTime) || previousState.mode != currentState.mode {
+			changedFilepaths = append(changedFilepaths, relativeFilepath)
+		}
+	}
+	removedFilepaths := []string{}
+	for relativeFilepath := range previousFiles {
+		if _, found := currentFiles[relativeFilepath]; !found {
+			removedFilepaths = append(removedFilepaths, relativeFilepath)
+		}
+	}
+	sort.Strings(changedFilepaths)
+	sort.Strings(removedFilepaths)
+	return changedFilepaths, removedFilepaths
+}
+
+// getRemovedDirpaths returns, sorted, the directories of the removed files that don't exist locally anymore, leaving out
+// the ones inside another removed directory as removing the outermost one removes them too
+func getRemovedDirpaths(localDirpath string, removedFilepaths []string) []string {
+	removedDirpaths := map[string]bool{}
+	for _, removedFilepath := range removedFilepaths {
+		for dirpath := filepath.Dir(removedFilepath); dirpath != "." && !removedDirpaths[dirpath]; dirpath = filepath.Dir(dirpath) {
+			if _, err := os.Lstat(filepath.Join(localDirpath, dirpath)); !os.IsNotExist(err) {
+				break
+			}
+			removedDirpaths[dirpath] = true
+		}
+	}
+	outermostRemovedDirpaths := []string{}
+	for dirpath := range removedDirpaths {
+		if !removedDirpaths[filepath.Dir(dirpath)] {
+			outermostRemovedDirpaths = append(outermostRemovedDirpaths, dirpath)
+		}
+	}
+	sort.Strings(outermostRemovedDirpaths)
+	return outermostRemovedDirpaths
+}
+
+func getSortedPaths(fileStates map[string]fileState) []string {
+	relativeFilepaths := []string{}
+	for relativeFilepath := range fileStates {
+		relativeFilepaths = append(relativeFilepaths, relativeFilepath)
+	}
+	sort.Strings(relativeFilepaths)
+	return relativeFilepaths
+}
+
+func (syncer *fileSyncer) sync(ctx context.Context, changedFilepaths []string, removedFilepaths []string, removedDirpaths []string) error {
+	if len(changedFilepaths) > 0 {
+		if err := syncer.copyFiles(ctx, changedFilepaths); err != nil {
+			return stacktrace.Propagate(err, "An error occurred copying %d changed files to the service", len(changedFilepaths))
+		}
+	}
+	if len(removedFilepaths) > 0 {
+		rmCommand := []string{"rm", "-f", "--"}
+		for _, removedFilepath := range removedFilepaths {
+			rmCommand = append(rmCommand, path.Join(syncer.containerDirpath, filepath.ToSlash(removedFilepath)))
+		}
+		if _, err := syncer.runCommand(ctx, rmCommand); err != nil {
+			return stacktrace.Propagate(err, "An error occurred removing %d files from the service", len(removedFilepaths))
+		}
+	}
+	if len(removedDirpaths) > 0 {
+		rmCommand := []string{"rm", "-rf", "--"}
+		for _, removedDirpath := range removedDirpaths {
+			rmCommand = append(rmCommand, path.Join(syncer.containerDirpath, filepath.ToSlash(removedDirpath)))
+		}
+		if _, err := syncer.runCommand(ctx, rmCommand); err != nil {
+			return stacktrace.Propagate(err, "An error occurred removing %d directories from the service", len(removedDirpaths))
+		}
+	}
+	out.PrintOutLn(fmt.Sprintf("Synced %d changed and %d removed files into '%v'", len(changedFilepaths), len(removedFilepaths), syncer.containerDirpath))
+
+	if syncer.afterSyncCommand == "" {
+		return nil
+	}
+	output, err := syncer.runCommand(ctx, []string{binShCommand, binShCommandFlag, syncer.afterSyncCommand})
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred running command '%v' after the sync", syncer.afterSyncCommand)
+	}
+	if output != "" {
+		out.PrintOutLn(output)
+	}
+	return nil
+}
+
+// copyFiles streams the TAR of the files to the backend as it's being written, rather than writing it to disk first
+func (syncer *fileSyncer) copyFiles(ctx context.Context, relativeFilepaths []string) error {
+	tarReader, tarWriter := io.Pipe()
+	go func() {
+		tarWriter.CloseWithError(path_compression.ArchiveFilesToTar(ctx, syncer.localDirpath, relativeFilepaths, tarWriter))
+	}()
+	// Closing the reader unblocks the archiving if the backend stopped reading early
+	defer tarReader.Close()
+
+	if err := syncer.kurtosisBackend.CopyFilesToUserService(ctx, syncer.enclaveUuid, syncer.serviceUuid, syncer.containerDirpath, tarReader); err != nil {
+		return stacktrace.Propagate(err, "An error occurred copying files into '%v' on service '%v'", syncer.containerDirpath, syncer.serviceUuid)
+	}
+	return nil
+}
+
+// runCommand returns the output of the command, which must succeed
+func (syncer *fileSyncer) runCommand(ctx context.Context, command []string) (string, error) {
+	results, resultErrors, err := syncer.kurtosisBackend.RunUserServiceExecCommands(ctx, syncer.enclaveUuid, defaultContainerUser, map[service.ServiceUUID][]string{
+		syncer.serviceUuid: command,
+	})
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred executing command '%v' in service '%v'", command, syncer.serviceUuid)
+	}
+	if err, found := resultErrors[syncer.serviceUuid]; found {
+		return "", stacktrace.Propagate(err, "An error occurred executing command '%v' in service '%v'", command, syncer.serviceUuid)
+	}
+	result, found := results[syncer.serviceUuid]
+	if !found {
+		return "", stacktrace.NewError("The status of the execution of command '%v' in service '%v' is unknown. It wasn't returned neither as a success nor a failure. This is a bug in Kurtosis.", command, syncer.serviceUuid)
+	}
+	if result.GetExitCode() != 0 {
+		return "", stacktrace.NewError("Command '%v' returned non-zero exit code '%d'. Output was:\n%v", command, result.GetExitCode(), result.GetOutput())
+	}
+	return result.GetOutput(), nil
+}
@@ -0,0 +1,66 @@
+package sync
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSyncSpec(t *testing.T) {
+	localDirpath := t.TempDir()
+
+	parsedLocalDirpath, containerDirpath, err := parseSyncSpec(localDirpath + ":/app/src/")
+	require.NoError(t, err)
+	require.Equal(t, localDirpath, parsedLocalDirpath)
+	require.Equal(t, "/app/src", containerDirpath)
+
+	_, _, err = parseSyncSpec(localDirpath)
+	require.Error(t, err)
+
+	_, _, err = parseSyncSpec(localDirpath + ":app/src")
+	require.Error(t, err)
+
+	_, _, err = parseSyncSpec(":/app/src")
+	require.Error(t, err)
+}
+
+func TestGetChangedAndRemovedFilepaths(t *testing.T) {
+	modTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	previousFiles := map[string]fileState{
+		"unchanged.go":   {size: 10, modTime: modTime, mode: 0644},
+		"modified.go":    {size: 10, modTime: modTime, mode: 0644},
+		"resized.go":     {size: 10, modTime: modTime, mode: 0644},
+		"executable.sh":  {size: 10, modTime: modTime, mode: 0644},
+		"src/removed.go": {size: 10, modTime: modTime, mode: 0644},
+	}
+	currentFiles := map[string]fileState{
+		"unchanged.go":  {size: 10, modTime: modTime, mode: 0644},
+		"modified.go":   {size: 10, modTime: modTime.Add(time.Second), mode: 0644},
+		"resized.go":    {size: 11, modTime: modTime, mode: 0644},
+		"executable.sh": {size: 10, modTime: modTime, mode: os.FileMode(0755)},
+		"src/added.go":  {size: 10, modTime: modTime, mode: 0644},
+	}
+
+	changedFilepaths, removedFilepaths := getChangedAndRemovedFilepaths(previousFiles, currentFiles)
+	require.Equal(t, []string{"executable.sh", "modified.go", "resized.go", "src/added.go"}, changedFilepaths)
+	require.Equal(t, []string{"src/removed.go"}, removedFilepaths)
+}
+
+func TestGetRemovedDirpaths(t *testing.T) {
+	localDirpath := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(localDirpath, "kept", "emptied"), 0755))
+
+	removedFilepaths := []string{
+		"removed.go",
+		filepath.Join("kept", "removed.go"),
+		filepath.Join("kept", "emptied", "removed.go"),
+		filepath.Join("kept", "deleted", "removed.go"),
+		filepath.Join("deleted", "removed.go"),
+		filepath.Join("deleted", "nested", "removed.go"),
+	}
+	removedDirpaths := getRemovedDirpaths(localDirpath, removedFilepaths)
+	require.Equal(t, []string{"deleted", filepath.Join("kept", "deleted")}, removedDirpaths)
+}
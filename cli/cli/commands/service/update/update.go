@@ -3,9 +3,11 @@ package update
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/enclaves"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/starlark_run_config"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
@@ -19,6 +21,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/service_helpers"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/kurtosis_config_getter"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/metrics_client_factory"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/user_services"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
@@ -32,7 +35,7 @@ const (
 	isEnclaveIdArgGreedy    = false
 
 	serviceIdentifierArgKey        = "service"
-	isServiceIdentifierArgOptional = false
+	isServiceIdentifierArgOptional = true // don't need to pass this in if they use the label selector flag
 	isServiceIdentifierArgGreedy   = false
 
 	kurtosisBackendCtxKey = "kurtosis-backend"
@@ -40,6 +43,20 @@ const (
 
 	privilegedFlagKey = "privileged"
 	defaultPrivileged = "false"
+
+	labelSelectorFlagKey = "label-selector"
+	defaultLabelSelector = ""
+
+	maxUnavailableFlagKey = "max-unavailable"
+	defaultMaxUnavailable = "1"
+
+	onFailureFlagKey  = "on-failure"
+	onFailureRollback = "rollback"
+	onFailureAbort    = "abort"
+	defaultOnFailure  = onFailureRollback
+
+	labelSelectorRequirementsDelimiter = ","
+	labelSelectorKeyValueDelimiter     = "="
 )
 
 var (
@@ -58,12 +75,20 @@ var (
 		service_helpers.PortIdSpecDelimiter,
 		serviceAddSpec,
 	)
+	longDescription = fmt.Sprintf(
+		"Update a service, or all the services matching the labels given with the '%v' flag. "+
+			"Services selected by labels are updated in batches of '%v' services, every batch having to be ready before "+
+			"the next one is updated; if a batch fails, the update is rolled back or aborted depending on the '%v' flag",
+		labelSelectorFlagKey,
+		maxUnavailableFlagKey,
+		onFailureFlagKey,
+	)
 )
 
 var ServiceUpdateCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
 	CommandStr:                command_str_consts.ServiceUpdateCmdStr,
 	ShortDescription:          "Update a service",
-	LongDescription:           "Update a service",
+	LongDescription:           longDescription,
 	KurtosisBackendContextKey: kurtosisBackendCtxKey,
 	EngineClientContextKey:    engineClientCtxKey,
 	Flags: []*flags.FlagConfig{
@@ -142,6 +167,33 @@ var ServiceUpdateCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosi
 			Type:    flags.FlagType_Bool,
 			Default: defaultPrivileged,
 		},
+		{
+			Key: labelSelectorFlagKey,
+			Usage: fmt.Sprintf(
+				"Update all the services having the given labels instead of a single service, in the form \"KEY1%vVALUE1%vKEY2%vVALUE2\"",
+				labelSelectorKeyValueDelimiter,
+				labelSelectorRequirementsDelimiter,
+				labelSelectorKeyValueDelimiter,
+			),
+			Type:    flags.FlagType_String,
+			Default: defaultLabelSelector,
+		},
+		{
+			Key:     maxUnavailableFlagKey,
+			Usage:   fmt.Sprintf("Maximum number of services updated at the same time when updating the services selected with '%v'", labelSelectorFlagKey),
+			Type:    flags.FlagType_Uint32,
+			Default: defaultMaxUnavailable,
+		},
+		{
+			Key: onFailureFlagKey,
+			Usage: fmt.Sprintf(
+				"What to do when a batch of services fails to update or to become ready, either '%v' to put back the previous config of the services updated so far, or '%v' to leave them as they are",
+				onFailureRollback,
+				onFailureAbort,
+			),
+			Type:    flags.FlagType_String,
+			Default: defaultOnFailure,
+		},
 	},
 	Args: []*args.ArgConfig{
 		enclave_id_arg.NewEnclaveIdentifierArg(
@@ -150,12 +202,7 @@ var ServiceUpdateCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosi
 			isEnclaveIdArgOptional,
 			isEnclaveIdArgGreedy,
 		),
-		service_identifier_arg.NewServiceIdentifierArg(
-			serviceIdentifierArgKey,
-			enclaveIdentifierArgKey,
-			isServiceIdentifierArgGreedy,
-			isServiceIdentifierArgOptional,
-		),
+		newOptionalServiceIdentifierArg(),
 	},
 	RunFunc: run,
 }
@@ -179,6 +226,17 @@ func run(
 		return stacktrace.Propagate(err, "Expected a value for non-greedy enclave identifier arg '%v' but none was found; this is a bug in the Kurtosis CLI!", serviceIdentifierArgKey)
 	}
 
+	labelSelectorStr, err := flags.GetString(labelSelectorFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the label selector using key '%v'", labelSelectorFlagKey)
+	}
+	if serviceName == "" && labelSelectorStr == "" {
+		return stacktrace.NewError("Either a service or a label selector with the '%v' flag must be provided", labelSelectorFlagKey)
+	}
+	if serviceName != "" && labelSelectorStr != "" {
+		return stacktrace.NewError("A service and a label selector with the '%v' flag can't both be provided", labelSelectorFlagKey)
+	}
+
 	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred creating Kurtosis Context from local engine")
@@ -198,11 +256,6 @@ func run(
 		}
 	}()
 
-	err = metricsClient.TrackServiceUpdate(enclaveIdentifier, serviceName)
-	if err != nil {
-		return stacktrace.Propagate(err, "An error occurred tracking service update metric.")
-	}
-
 	imageStr, err := flags.GetString(service_helpers.ImageKey)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred getting the image using key '%v'", service_helpers.ImageKey)
@@ -262,6 +315,17 @@ func run(
 		)
 	}
 
+	runConfig := starlark_run_config.NewRunStarlarkConfig(starlark_run_config.WithAllowPrivilegedMode(allowPrivilegedMode))
+
+	if labelSelectorStr != "" {
+		return updateServicesMatchingLabelSelector(ctx, kurtosisCtx, enclaveCtx, metricsClient, flags, enclaveIdentifier, labelSelectorStr, overridesServiceConfig, runConfig)
+	}
+
+	err = metricsClient.TrackServiceUpdate(enclaveIdentifier, serviceName)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred tracking service update metric.")
+	}
+
 	_, currServiceConfig, err := service_helpers.GetServiceInfo(ctx, kurtosisCtx, enclaveIdentifier, serviceName)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred getting service info of service '%v' in enclave '%v'.", serviceName, enclaveIdentifier)
@@ -269,29 +333,7 @@ func run(
 
 	updatedServiceConfig := createUpdatedServiceConfigFromOverrides(overridesServiceConfig, currServiceConfig)
 
-	// call getServiceConfig
-	serviceConfigStr := services.GetFullServiceConfigStarlark(
-		updatedServiceConfig.Image,
-		services.ConvertJsonPortToApiPort(updatedServiceConfig.PrivatePorts),
-		updatedServiceConfig.Files,
-		updatedServiceConfig.Entrypoint,
-		updatedServiceConfig.Cmd,
-		updatedServiceConfig.EnvVars,
-		updatedServiceConfig.MaxMillicpus,
-		updatedServiceConfig.MaxMemory,
-		updatedServiceConfig.MinMillicpus,
-		updatedServiceConfig.MinMemory,
-		updatedServiceConfig.User,
-		updatedServiceConfig.Tolerations,
-		updatedServiceConfig.NodeSelectors,
-		updatedServiceConfig.Labels,
-		updatedServiceConfig.TiniEnabled,
-		updatedServiceConfig.TtyEnabled,
-		updatedServiceConfig.PrivateIPAddressPlaceholder,
-		updatedServiceConfig.Privileged,
-		updatedServiceConfig.BindMounts,
-		updatedServiceConfig.HostPIDNamespace,
-	)
+	serviceConfigStr := getServiceConfigStarlark(updatedServiceConfig)
 
 	addServiceStarlarkStr := service_helpers.GetAddServiceStarlarkScript(serviceName, serviceConfigStr)
 	logrus.Debugf("Update service starlark:\n%v", addServiceStarlarkStr)
@@ -303,7 +345,7 @@ func run(
 		enclaveIdentifier,
 		addServiceStarlarkStr,
 		enclaveCtx,
-		starlark_run_config.NewRunStarlarkConfig(starlark_run_config.WithAllowPrivilegedMode(allowPrivilegedMode)),
+		runConfig,
 	)
 	if err != nil {
 		return err //already wrapped
@@ -313,6 +355,184 @@ func run(
 	return nil
 }
 
+// newOptionalServiceIdentifierArg returns the service arg, which is left empty when the services to update are
+// selected by labels
+func newOptionalServiceIdentifierArg() *args.ArgConfig {
+	serviceIdentifierArg := service_identifier_arg.NewServiceIdentifierArg(
+		serviceIdentifierArgKey,
+		enclaveIdentifierArgKey,
+		isServiceIdentifierArgOptional,
+		isServiceIdentifierArgGreedy,
+	)
+	validateServiceIdentifier := serviceIdentifierArg.ValidationFunc
+	serviceIdentifierArg.ValidationFunc = func(ctx context.Context, flags *flags.ParsedFlags, args *args.ParsedArgs) error {
+		if serviceIdentifier, err := args.GetNonGreedyArg(serviceIdentifierArgKey); err == nil && serviceIdentifier == "" {
+			return nil
+		}
+		return validateServiceIdentifier(ctx, flags, args)
+	}
+	return serviceIdentifierArg
+}
+
+func updateServicesMatchingLabelSelector(
+	ctx context.Context,
+	kurtosisCtx *kurtosis_context.KurtosisContext,
+	enclaveCtx *enclaves.EnclaveContext,
+	metricsClient metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	enclaveIdentifier string,
+	labelSelectorStr string,
+	overridesServiceConfig *services.ServiceConfig,
+	runConfig *starlark_run_config.StarlarkRunConfig,
+) error {
+	labelSelector, err := parseLabelSelector(labelSelectorStr)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred parsing label selector '%v'", labelSelectorStr)
+	}
+	maxUnavailable, err := flags.GetUint32(maxUnavailableFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the max unavailable value using key '%v'", maxUnavailableFlagKey)
+	}
+	if maxUnavailable == 0 {
+		return stacktrace.NewError("The '%v' flag must be at least 1", maxUnavailableFlagKey)
+	}
+	onFailure, err := flags.GetString(onFailureFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the on failure value using key '%v'", onFailureFlagKey)
+	}
+	if onFailure != onFailureRollback && onFailure != onFailureAbort {
+		return stacktrace.NewError("Invalid value '%v' for the '%v' flag, it must be either '%v' or '%v'", onFailure, onFailureFlagKey, onFailureRollback, onFailureAbort)
+	}
+
+	enclaveInfo, err := kurtosisCtx.GetEnclave(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave for identifier '%v'", enclaveIdentifier)
+	}
+	allServicesMap := map[string]bool{}
+	userServices, err := user_services.GetUserServiceInfoMapFromAPIContainer(ctx, enclaveInfo, allServicesMap)
+	if err != nil {
+		return stacktrace.Propagate(err, "Failed to get service info from API container in enclave '%v'", enclaveInfo.GetEnclaveUuid())
+	}
+	matchingServices := getServicesMatchingLabelSelector(userServices, labelSelector)
+	if len(matchingServices) == 0 {
+		return stacktrace.NewError("No service in enclave '%v' matches label selector '%v'", enclaveIdentifier, labelSelectorStr)
+	}
+
+	serviceConfigStrs := map[string]string{}
+	for _, serviceInfo := range matchingServices {
+		if err := metricsClient.TrackServiceUpdate(enclaveIdentifier, serviceInfo.GetName()); err != nil {
+			return stacktrace.Propagate(err, "An error occurred tracking service update metric.")
+		}
+		updatedServiceConfig := createUpdatedServiceConfigFromOverrides(overridesServiceConfig, service_helpers.ServiceInfoToServiceConfig(serviceInfo))
+		serviceConfigStrs[serviceInfo.GetName()] = getServiceConfigStarlark(updatedServiceConfig)
+	}
+
+	updateServicesStarlarkStr := getUpdateServicesStarlarkScript(serviceConfigStrs, maxUnavailable, onFailure)
+	logrus.Debugf("Update services starlark:\n%v", updateServicesStarlarkStr)
+
+	logrus.Infof("Running update services starlark for the %d services matching label selector '%v' in enclave '%v', %d at a time...", len(matchingServices), labelSelectorStr, enclaveIdentifier, maxUnavailable)
+	starlarkRunResult, err := enclaveCtx.RunStarlarkScriptBlocking(ctx, updateServicesStarlarkStr, runConfig)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error has occurred when running Starlark to update services")
+	}
+	if starlarkRunResult.InterpretationError != nil {
+		return stacktrace.NewError("An error has occurred when updating services: %s\nThis is a bug in Kurtosis, please report.", starlarkRunResult.InterpretationError)
+	}
+	if len(starlarkRunResult.ValidationErrors) > 0 {
+		return stacktrace.NewError("An error occurred when validating the update of services matching label selector '%v' in enclave '%v': %s", labelSelectorStr, enclaveIdentifier, starlarkRunResult.ValidationErrors)
+	}
+	if starlarkRunResult.ExecutionError != nil {
+		return stacktrace.NewError("An error occurred updating services matching label selector '%v' in enclave '%v': %s", labelSelectorStr, enclaveIdentifier, starlarkRunResult.ExecutionError)
+	}
+
+	out.PrintOutLn(string(starlarkRunResult.RunOutput))
+	return nil
+}
+
+// parseLabelSelector parses a selector of the form "KEY1=VALUE1,KEY2=VALUE2", a service matching it when it has all
+// the given labels
+func parseLabelSelector(labelSelectorStr string) (map[string]string, error) {
+	labelSelector := map[string]string{}
+	for _, requirement := range strings.Split(labelSelectorStr, labelSelectorRequirementsDelimiter) {
+		keyValue := strings.SplitN(requirement, labelSelectorKeyValueDelimiter, 2)
+		if len(keyValue) != 2 || strings.TrimSpace(keyValue[0]) == "" {
+			return nil, stacktrace.NewError("Label requirement '%v' is not of the form \"KEY%vVALUE\"", requirement, labelSelectorKeyValueDelimiter)
+		}
+		key := strings.TrimSpace(keyValue[0])
+		if _, found := labelSelector[key]; found {
+			return nil, stacktrace.NewError("Label '%v' is given more than once", key)
+		}
+		labelSelector[key] = strings.TrimSpace(keyValue[1])
+	}
+	return labelSelector, nil
+}
+
+// getServicesMatchingLabelSelector returns the services having all the labels of the selector, sorted by name
+func getServicesMatchingLabelSelector(
+	userServices map[string]*kurtosis_core_rpc_api_bindings.ServiceInfo,
+	labelSelector map[string]string,
+) []*kurtosis_core_rpc_api_bindings.ServiceInfo {
+	matchingServices := []*kurtosis_core_rpc_api_bindings.ServiceInfo{}
+	for _, serviceInfo := range userServices {
+		isMatching := true
+		for key, value := range labelSelector {
+			if serviceValue, found := serviceInfo.GetLabels()[key]; !found || serviceValue != value {
+				isMatching = false
+				break
+			}
+		}
+		if isMatching {
+			matchingServices = append(matchingServices, serviceInfo)
+		}
+	}
+	sort.Slice(matchingServices, func(i, j int) bool {
+		return matchingServices[i].GetName() < matchingServices[j].GetName()
+	})
+	return matchingServices
+}
+
+func getUpdateServicesStarlarkScript(serviceConfigStrs map[string]string, maxUnavailable uint32, onFailure string) string {
+	serviceNames := []string{}
+	for serviceName := range serviceConfigStrs {
+		serviceNames = append(serviceNames, serviceName)
+	}
+	sort.Strings(serviceNames)
+
+	configs := strings.Builder{}
+	for _, serviceName := range serviceNames {
+		fmt.Fprintf(&configs, "\t\t%q: %s,\n", serviceName, serviceConfigStrs[serviceName])
+	}
+	return fmt.Sprintf(`def run(plan):
+	plan.update_services(configs = {
+%s	}, strategy = RollingUpdate(max_unavailable = %d, on_failure = %q))
+`, configs.String(), maxUnavailable, onFailure)
+}
+
+func getServiceConfigStarlark(serviceConfig *services.ServiceConfig) string {
+	return services.GetFullServiceConfigStarlark(
+		serviceConfig.Image,
+		services.ConvertJsonPortToApiPort(serviceConfig.PrivatePorts),
+		serviceConfig.Files,
+		serviceConfig.Entrypoint,
+		serviceConfig.Cmd,
+		serviceConfig.EnvVars,
+		serviceConfig.MaxMillicpus,
+		serviceConfig.MaxMemory,
+		serviceConfig.MinMillicpus,
+		serviceConfig.MinMemory,
+		serviceConfig.User,
+		serviceConfig.Tolerations,
+		serviceConfig.NodeSelectors,
+		serviceConfig.Labels,
+		serviceConfig.TiniEnabled,
+		serviceConfig.TtyEnabled,
+		serviceConfig.PrivateIPAddressPlaceholder,
+		serviceConfig.Privileged,
+		serviceConfig.BindMounts,
+		serviceConfig.HostPIDNamespace,
+	)
+}
+
 func generateExampleForPortFlag() string {
 	return fmt.Sprintf(
 		`Example: "PORTID1%v1234%vudp%vPORTID2%vhttp%v5678%vPORTID3%vhttp%v6000%vudp"`,
@@ -475,7 +695,7 @@ func createUpdatedServiceConfigFromOverrides(overridesServiceConfig, currService
 		MinMemory:                   0,
 		User:                        nil,
 		Tolerations:                 nil,
-		Labels:                      nil,
+		Labels:                      currServiceConfig.Labels,
 		NodeSelectors:               nil,
 		TiniEnabled:                 nil,
 		TtyEnabled:                  nil,
@@ -3,6 +3,7 @@ package update
 import (
 	"testing"
 
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
 	"github.com/stretchr/testify/require"
 )
@@ -363,3 +364,50 @@ func TestCreateUpdatedServiceConfigFromOverridesPreservesPrivilegedFields(t *tes
 	require.Equal(t, map[string]string{"/var/run/docker.sock": "/docker.sock"}, updated.BindMounts)
 	require.True(t, updated.HostPIDNamespace)
 }
+
+func TestParseLabelSelector(t *testing.T) {
+	labelSelector, err := parseLabelSelector("app=web, tier = frontend")
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"app": "web", "tier": "frontend"}, labelSelector)
+
+	_, err = parseLabelSelector("app")
+	require.Error(t, err)
+
+	_, err = parseLabelSelector("=web")
+	require.Error(t, err)
+
+	_, err = parseLabelSelector("app=web,app=api")
+	require.Error(t, err)
+}
+
+func TestGetServicesMatchingLabelSelector(t *testing.T) {
+	userServices := map[string]*kurtosis_core_rpc_api_bindings.ServiceInfo{
+		"web-2": {Name: "web-2", Labels: map[string]string{"app": "web", "tier": "frontend"}},
+		"web-1": {Name: "web-1", Labels: map[string]string{"app": "web", "tier": "frontend"}},
+		"api":   {Name: "api", Labels: map[string]string{"app": "api", "tier": "frontend"}},
+		"db":    {Name: "db", Labels: nil},
+	}
+
+	matchingServices := getServicesMatchingLabelSelector(userServices, map[string]string{"app": "web", "tier": "frontend"})
+	require.Len(t, matchingServices, 2)
+	require.Equal(t, "web-1", matchingServices[0].GetName())
+	require.Equal(t, "web-2", matchingServices[1].GetName())
+
+	require.Len(t, getServicesMatchingLabelSelector(userServices, map[string]string{"tier": "frontend"}), 3)
+	require.Empty(t, getServicesMatchingLabelSelector(userServices, map[string]string{"tier": "backend"}))
+}
+
+func TestGetUpdateServicesStarlarkScript(t *testing.T) {
+	script := getUpdateServicesStarlarkScript(map[string]string{
+		"web-2": `ServiceConfig(image="web:2")`,
+		"web-1": `ServiceConfig(image="web:2")`,
+	}, 1, onFailureRollback)
+
+	expectedScript := `def run(plan):
+	plan.update_services(configs = {
+		"web-1": ServiceConfig(image="web:2"),
+		"web-2": ServiceConfig(image="web:2"),
+	}, strategy = RollingUpdate(max_unavailable = 1, on_failure = "rollback"))
+`
+	require.Equal(t, expectedScript, script)
+}
@@ -0,0 +1,199 @@
+package cluster_federation
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/engine_tls"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/kurtosis_config_getter"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/resolved_config"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_gateway/connection"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_gateway/run/engine_gateway"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/engine"
+	"github.com/kurtosis-tech/kurtosis/contexts-config-store/store"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/kurtosis-tech/stacktrace"
+	"strings"
+)
+
+const (
+	// Enclave names can't contain this character, so it unambiguously separates the cluster from the enclave
+	ClusterQualifiedEnclaveIdentifierSeparator = "/"
+
+	clusterQualifiedEnclaveIdentifierParts = 2
+
+	remoteContextsNotSupportedExplanation = "other clusters are only reachable from the local context, switch to it with 'kurtosis context set default' first"
+)
+
+// EnsureCurrentContextIsLocal returns an error if the current Kurtosis context is remote. Only the current cluster is
+// reachable through a remote context, so enclaves of other clusters can't be listed nor referred to from there
+func EnsureCurrentContextIsLocal() error {
+	currentContext, err := store.GetContextsConfigStore().GetCurrentContext()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the current Kurtosis context")
+	}
+	if store.IsRemote(currentContext) {
+		return stacktrace.NewError("The current Kurtosis context '%v' is remote; %v", currentContext.GetName(), remoteContextsNotSupportedExplanation)
+	}
+	return nil
+}
+
+// SplitClusterQualifiedEnclaveIdentifier splits an identifier of the form 'cluster/enclave' into its cluster name and
+// enclave identifier. The cluster name is empty when the identifier isn't qualified, meaning the current cluster
+func SplitClusterQualifiedEnclaveIdentifier(identifier string) (string, string) {
+	parts := strings.SplitN(identifier, ClusterQualifiedEnclaveIdentifierSeparator, clusterQualifiedEnclaveIdentifierParts)
+	if len(parts) != clusterQualifiedEnclaveIdentifierParts {
+		return "", identifier
+	}
+	return parts[0], parts[1]
+}
+
+// JoinClusterQualifiedEnclaveIdentifier is the inverse of SplitClusterQualifiedEnclaveIdentifier
+func JoinClusterQualifiedEnclaveIdentifier(clusterName string, enclaveIdentifier string) string {
+	if clusterName == "" {
+		return enclaveIdentifier
+	}
+	return clusterName + ClusterQualifiedEnclaveIdentifierSeparator + enclaveIdentifier
+}
+
+// NewKurtosisContextForEnclave returns a KurtosisContext connected to the engine of the cluster the (possibly
+// cluster-qualified) enclave identifier points to, along with the bare enclave identifier to use with it and a function
+// to call once done with the context. The current cluster setting is left untouched
+func NewKurtosisContextForEnclave(ctx context.Context, enclaveIdentifier string) (*kurtosis_context.KurtosisContext, string, func(), error) {
+	clusterName, bareEnclaveIdentifier := SplitClusterQualifiedEnclaveIdentifier(enclaveIdentifier)
+	kurtosisCtx, closeFunc, err := NewKurtosisContextForCluster(ctx, clusterName)
+	if err != nil {
+		return nil, "", nil, stacktrace.Propagate(err, "An error occurred connecting to the engine of the cluster of enclave '%v'", enclaveIdentifier)
+	}
+	return kurtosisCtx, bareEnclaveIdentifier, closeFunc, nil
+}
+
+// NewKurtosisContextForCluster returns a KurtosisContext connected to the engine of the given cluster, along with a
+// function to call once done with the context. An empty cluster name means the current cluster.
+// The engine of a cluster other than the current one must already be running; it won't be started.
+func NewKurtosisContextForCluster(ctx context.Context, clusterName string) (*kurtosis_context.KurtosisContext, func(), error) {
+	noopCloseFunc := func() {}
+
+	currentClusterName, err := kurtosis_config_getter.GetCurrentKurtosisClusterName()
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the current cluster name")
+	}
+	if clusterName == "" || clusterName == currentClusterName {
+		kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+		if err != nil {
+			return nil, nil, stacktrace.Propagate(err, "An error occurred connecting to the local Kurtosis engine")
+		}
+		return kurtosisCtx, noopCloseFunc, nil
+	}
+
+	currentContext, err := store.GetContextsConfigStore().GetCurrentContext()
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the current Kurtosis context")
+	}
+	if store.IsRemote(currentContext) {
+		return nil, nil, stacktrace.NewError("Cluster '%v' can't be reached because the current Kurtosis context '%v' is remote; %v", clusterName, currentContext.GetName(), remoteContextsNotSupportedExplanation)
+	}
+
+	clusterConfig, err := kurtosis_config_getter.GetKurtosisClusterConfigByName(clusterName)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the config of cluster '%v'", clusterName)
+	}
+	kurtosisBackend, err := clusterConfig.GetFederatedKurtosisBackend(ctx)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the Kurtosis backend of cluster '%v'", clusterName)
+	}
+	// The current context only holds the TLS material of the current cluster's engine
+	engineTlsConfig, err := engine_tls.GetEngineTlsConfig(clusterConfig.GetEngineTlsConfig())
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the engine TLS config of cluster '%v'", clusterName)
+	}
+	transportCredentials, err := kurtosis_context.NewEngineTransportCredentials(engine_tls.NewContextTlsConfig(engineTlsConfig))
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred creating the credentials to reach the engine of cluster '%v' with", clusterName)
+	}
+	engineApiToken, err := getEngineApiTokenForCluster(clusterName, clusterConfig.GetEngineApiTokens(), currentContext.GetEngineApiToken())
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the API token to authenticate to the engine of cluster '%v' with", clusterName)
+	}
+
+	switch clusterConfig.GetClusterType() {
+	case resolved_config.KurtosisClusterType_Docker, resolved_config.KurtosisClusterType_Podman:
+		runningEngine, err := getRunningEngine(ctx, kurtosisBackend)
+		if err != nil {
+			return nil, nil, stacktrace.Propagate(err, "An error occurred getting the running engine of cluster '%v'", clusterName)
+		}
+		engineAddress := fmt.Sprintf("%v:%v", runningEngine.GetPublicIPAddress(), runningEngine.GetPublicGRPCPort().GetNumber())
+		kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromEngineAddressWithCredentials(engineAddress, engineApiToken, transportCredentials)
+		if err != nil {
+			return nil, nil, stacktrace.Propagate(err, "An error occurred connecting to the engine of cluster '%v' at '%v'", clusterName, engineAddress)
+		}
+		return kurtosisCtx, noopCloseFunc, nil
+	case resolved_config.KurtosisClusterType_Kubernetes:
+		// Engines running in Kubernetes aren't reachable from the host, so we go through a gateway that lives as long
+		// as the context
+		if _, err := getRunningEngine(ctx, kurtosisBackend); err != nil {
+			return nil, nil, stacktrace.Propagate(err, "An error occurred getting the running engine of cluster '%v'", clusterName)
+		}
+		kubernetesConfig, err := kubernetes_kurtosis_backend.GetCLIKubernetesConfigForCluster(clusterConfig.GetKubernetesClusterName())
+		if err != nil {
+			return nil, nil, stacktrace.Propagate(err, "An error occurred creating the Kubernetes configuration of cluster '%v'", clusterName)
+		}
+		connectionProvider, err := connection.NewGatewayConnectionProvider(ctx, kubernetesConfig, engineTlsConfig)
+		if err != nil {
+			return nil, nil, stacktrace.Propagate(err, "An error occurred creating a gateway connection provider for cluster '%v'", clusterName)
+		}
+		gatewayAddress, stopGatewayFunc, err := engine_gateway.RunEngineGatewayInBackground(kurtosisBackend, connectionProvider)
+		if err != nil {
+			return nil, nil, stacktrace.Propagate(err, "An error occurred starting a gateway to the engine of cluster '%v'", clusterName)
+		}
+		kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromEngineAddressWithCredentials(gatewayAddress, engineApiToken, transportCredentials)
+		if err != nil {
+			stopGatewayFunc()
+			return nil, nil, stacktrace.Propagate(err, "An error occurred connecting to the engine of cluster '%v' through the gateway at '%v'", clusterName, gatewayAddress)
+		}
+		return kurtosisCtx, stopGatewayFunc, nil
+	}
+	return nil, nil, stacktrace.NewError("Cluster '%v' has unrecognized type '%v'; this is a bug in Kurtosis", clusterName, clusterConfig.GetClusterType())
+}
+
+// getEngineApiTokenForCluster returns the token of the current context if it's one of the API tokens configured for the
+// engine of the cluster, or no token if that engine API is open. The token of the current context is a credential of
+// the current cluster, so it's never sent to an engine that isn't configured to accept it
+func getEngineApiTokenForCluster(clusterName string, clusterEngineApiTokens []args.ApiToken, currentContextEngineApiToken string) (string, error) {
+	if len(clusterEngineApiTokens) == 0 {
+		return "", nil
+	}
+	for _, clusterEngineApiToken := range clusterEngineApiTokens {
+		if currentContextEngineApiToken != "" && clusterEngineApiToken.Token == currentContextEngineApiToken {
+			return currentContextEngineApiToken, nil
+		}
+	}
+	return "", stacktrace.NewError("The engine of cluster '%v' requires an API token but the one of the current Kurtosis context isn't among the tokens configured for that cluster; set one of them with 'kurtosis %v %v'", clusterName, command_str_consts.ContextCmdStr, command_str_consts.ContextSetTokenCmdStr)
+}
+
+func getRunningEngine(ctx context.Context, kurtosisBackend backend_interface.KurtosisBackend) (*engine.Engine, error) {
+	runningEngineFilters := &engine.EngineFilters{
+		GUIDs: nil,
+		Statuses: map[container.ContainerStatus]bool{
+			container.ContainerStatus_Running: true,
+		},
+	}
+	runningEngines, err := kurtosisBackend.GetEngines(ctx, runningEngineFilters)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the running engines")
+	}
+	if len(runningEngines) == 0 {
+		return nil, stacktrace.NewError("No running engine was found; start it with 'kurtosis cluster set' followed by 'kurtosis engine start'")
+	}
+	if len(runningEngines) > 1 {
+		return nil, stacktrace.NewError("Found %v running engines, which should never happen", len(runningEngines))
+	}
+	for _, runningEngine := range runningEngines {
+		return runningEngine, nil
+	}
+	return nil, stacktrace.NewError("No running engine was found; this is a bug in Kurtosis")
+}
@@ -0,0 +1,59 @@
+package cluster_federation
+
+import (
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestSplitClusterQualifiedEnclaveIdentifier_Unqualified(t *testing.T) {
+	clusterName, enclaveIdentifier := SplitClusterQualifiedEnclaveIdentifier("my-enclave")
+	require.Empty(t, clusterName)
+	require.Equal(t, "my-enclave", enclaveIdentifier)
+}
+
+func TestSplitClusterQualifiedEnclaveIdentifier_Qualified(t *testing.T) {
+	clusterName, enclaveIdentifier := SplitClusterQualifiedEnclaveIdentifier("minikube/my-enclave")
+	require.Equal(t, "minikube", clusterName)
+	require.Equal(t, "my-enclave", enclaveIdentifier)
+}
+
+func TestJoinClusterQualifiedEnclaveIdentifier_RoundTrips(t *testing.T) {
+	identifier := JoinClusterQualifiedEnclaveIdentifier("docker", "my-enclave")
+	require.Equal(t, "docker/my-enclave", identifier)
+
+	clusterName, enclaveIdentifier := SplitClusterQualifiedEnclaveIdentifier(identifier)
+	require.Equal(t, "docker", clusterName)
+	require.Equal(t, "my-enclave", enclaveIdentifier)
+
+	require.Equal(t, "my-enclave", JoinClusterQualifiedEnclaveIdentifier("", "my-enclave"))
+}
+
+func TestGetEngineApiTokenForCluster_OpenEngineApiGetsNoToken(t *testing.T) {
+	engineApiToken, err := getEngineApiTokenForCluster("minikube", nil, "current-context-token")
+	require.NoError(t, err)
+	require.Empty(t, engineApiToken)
+}
+
+func TestGetEngineApiTokenForCluster_ConfiguredTokenIsSent(t *testing.T) {
+	clusterEngineApiTokens := []args.ApiToken{
+		{Token: "other-token", User: "alice", Role: args.ApiTokenRole_Viewer},
+		{Token: "current-context-token", User: "bob", Role: args.ApiTokenRole_Operator},
+	}
+	engineApiToken, err := getEngineApiTokenForCluster("minikube", clusterEngineApiTokens, "current-context-token")
+	require.NoError(t, err)
+	require.Equal(t, "current-context-token", engineApiToken)
+}
+
+func TestGetEngineApiTokenForCluster_UnconfiguredTokenIsNotSent(t *testing.T) {
+	clusterEngineApiTokens := []args.ApiToken{
+		{Token: "other-token", User: "alice", Role: args.ApiTokenRole_Operator},
+	}
+	engineApiToken, err := getEngineApiTokenForCluster("minikube", clusterEngineApiTokens, "current-context-token")
+	require.Error(t, err)
+	require.Empty(t, engineApiToken)
+
+	engineApiToken, err = getEngineApiTokenForCluster("minikube", clusterEngineApiTokens, "")
+	require.Error(t, err)
+	require.Empty(t, engineApiToken)
+}
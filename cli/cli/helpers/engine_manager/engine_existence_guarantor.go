@@ -16,6 +16,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/resolved_config"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/user_support_constants"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
 	"github.com/kurtosis-tech/kurtosis/engine/launcher/engine_server_launcher"
 	"github.com/kurtosis-tech/kurtosis/kurtosis_version"
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
@@ -105,6 +106,18 @@ type engineExistenceGuarantor struct {
 	logsCollectorFilters []logs_collector.Filter
 
 	logsCollectorParsers []logs_collector.Parser
+
+	// API tokens the engine will require on its API; empty means the engine API is open
+	apiTokens []args.ApiToken
+
+	// TLS material the engine will serve its API with; nil means the engine API is plaintext
+	tlsConfig *args.TlsConfig
+
+	// Packages the engine will keep pre-run in idle enclaves
+	enclaveTemplates []args.EnclaveTemplate
+
+	// Endpoints the engine will send its events to
+	webhooks []args.Webhook
 }
 
 func newEngineExistenceGuarantorWithDefaultVersion(
@@ -129,6 +142,10 @@ func newEngineExistenceGuarantorWithDefaultVersion(
 	shouldEnablePersistentVolumeLogsCollection bool,
 	logsCollectorFilters []logs_collector.Filter,
 	logsCollectorParsers []logs_collector.Parser,
+	apiTokens []args.ApiToken,
+	tlsConfig *args.TlsConfig,
+	enclaveTemplates []args.EnclaveTemplate,
+	webhooks []args.Webhook,
 ) *engineExistenceGuarantor {
 	return newEngineExistenceGuarantorWithCustomVersion(
 		ctx,
@@ -153,6 +170,10 @@ func newEngineExistenceGuarantorWithDefaultVersion(
 		shouldEnablePersistentVolumeLogsCollection,
 		logsCollectorFilters,
 		logsCollectorParsers,
+		apiTokens,
+		tlsConfig,
+		enclaveTemplates,
+		webhooks,
 	)
 }
 
@@ -179,6 +200,10 @@ func newEngineExistenceGuarantorWithCustomVersion(
 	shouldEnablePersistentVolumeLogsCollection bool,
 	logsCollectorFilters []logs_collector.Filter,
 	logsCollectorParsers []logs_collector.Parser,
+	apiTokens []args.ApiToken,
+	tlsConfig *args.TlsConfig,
+	enclaveTemplates []args.EnclaveTemplate,
+	webhooks []args.Webhook,
 ) *engineExistenceGuarantor {
 	return &engineExistenceGuarantor{
 		ctx:                                  ctx,
@@ -205,6 +230,10 @@ func newEngineExistenceGuarantorWithCustomVersion(
 		shouldEnablePersistentVolumeLogsCollection: shouldEnablePersistentVolumeLogsCollection,
 		logsCollectorFilters:                       logsCollectorFilters,
 		logsCollectorParsers:                       logsCollectorParsers,
+		apiTokens:                                  apiTokens,
+		tlsConfig:                                  tlsConfig,
+		enclaveTemplates:                           enclaveTemplates,
+		webhooks:                                   webhooks,
 	}
 }
 
@@ -270,6 +299,10 @@ func (guarantor *engineExistenceGuarantor) VisitStopped() error {
 			guarantor.shouldEnablePersistentVolumeLogsCollection,
 			guarantor.logsCollectorFilters,
 			guarantor.logsCollectorParsers,
+			guarantor.apiTokens,
+			guarantor.tlsConfig,
+			guarantor.enclaveTemplates,
+			guarantor.webhooks,
 		)
 	} else {
 		_, _, engineLaunchErr = guarantor.engineServerLauncher.LaunchWithCustomVersion(
@@ -296,6 +329,10 @@ func (guarantor *engineExistenceGuarantor) VisitStopped() error {
 			guarantor.shouldEnablePersistentVolumeLogsCollection,
 			guarantor.logsCollectorFilters,
 			guarantor.logsCollectorParsers,
+			guarantor.apiTokens,
+			guarantor.tlsConfig,
+			guarantor.enclaveTemplates,
+			guarantor.webhooks,
 		)
 	}
 	if engineLaunchErr != nil {
@@ -9,6 +9,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/engine_tls"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/grafloki"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/kurtosis_config_getter"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/otel"
@@ -21,11 +22,12 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/engine"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
 	"github.com/kurtosis-tech/kurtosis/contexts-config-store/store"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
 	"github.com/kurtosis-tech/kurtosis/engine/launcher/engine_server_launcher"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -224,6 +226,11 @@ func (manager *EngineManager) StartEngineIdempotentlyWithDefaultVersion(
 	}
 	additionalSinks = combineSinks(additionalSinks, lokiSink)
 
+	engineTlsConfig, err := manager.getEngineTlsConfig()
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the engine TLS config")
+	}
+
 	if !manager.skipConfiguredOtel && manager.clusterConfig.GetBackendLogCollector() == resolved_config.BackendLogCollectorOtel {
 		otelEndpoints, otelStartErr := otel.StartOtel(ctx, clusterType)
 		if otelStartErr != nil {
@@ -256,6 +263,10 @@ func (manager *EngineManager) StartEngineIdempotentlyWithDefaultVersion(
 		manager.clusterConfig.ShouldEnableDefaultLogsSink(),
 		manager.clusterConfig.GetLogsCollectorConfig().Filters,
 		manager.clusterConfig.GetLogsCollectorConfig().Parsers,
+		manager.clusterConfig.GetEngineApiTokens(),
+		engineTlsConfig,
+		manager.clusterConfig.GetEnclaveTemplates(),
+		manager.clusterConfig.GetWebhooks(),
 	)
 	// TODO Need to handle the Kubernetes case, where a gateway needs to be started after the engine is started but
 	//  before we can return an EngineClient
@@ -301,6 +312,11 @@ func (manager *EngineManager) StartEngineIdempotentlyWithCustomVersion(ctx conte
 	}
 	additionalSinks = combineSinks(additionalSinks, lokiSink)
 
+	engineTlsConfig, err := manager.getEngineTlsConfig()
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the engine TLS config")
+	}
+
 	if !manager.skipConfiguredOtel && manager.clusterConfig.GetBackendLogCollector() == resolved_config.BackendLogCollectorOtel {
 		otelEndpoints, otelStartErr := otel.StartOtel(ctx, clusterType)
 		if otelStartErr != nil {
@@ -334,6 +350,10 @@ func (manager *EngineManager) StartEngineIdempotentlyWithCustomVersion(ctx conte
 		manager.clusterConfig.ShouldEnableDefaultLogsSink(),
 		manager.clusterConfig.GetLogsCollectorConfig().Filters,
 		manager.clusterConfig.GetLogsCollectorConfig().Parsers,
+		manager.clusterConfig.GetEngineApiTokens(),
+		engineTlsConfig,
+		manager.clusterConfig.GetEnclaveTemplates(),
+		manager.clusterConfig.GetWebhooks(),
 	)
 	engineClient, engineClientCloseFunc, err := manager.startEngineWithGuarantor(ctx, status, engineGuarantor)
 	if err != nil {
@@ -524,9 +544,44 @@ func (manager *EngineManager) startEngineWithGuarantor(ctx context.Context, curr
 	return engineClient, clientCloseFunc, nil
 }
 
+// getEngineTlsConfig returns the TLS material the engine of the cluster is started with, and stores what clients need
+// of it in the current context so that they can reach the engine
+func (manager *EngineManager) getEngineTlsConfig() (*args.TlsConfig, error) {
+	engineTlsConfig, err := engine_tls.GetEngineTlsConfig(manager.clusterConfig.GetEngineTlsConfig())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the engine TLS material of the cluster")
+	}
+
+	contextsConfigStore := store.GetContextsConfigStore()
+	currentContext, err := contextsConfigStore.GetCurrentContext()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred retrieving the current context")
+	}
+	contextTlsConfig := engine_tls.NewContextTlsConfig(engineTlsConfig)
+	if proto.Equal(currentContext.GetEngineTlsConfig(), contextTlsConfig) {
+		return engineTlsConfig, nil
+	}
+	if err := contextsConfigStore.SetEngineTlsConfig(currentContext.GetUuid(), contextTlsConfig); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred storing the engine TLS config in the current context")
+	}
+	return engineTlsConfig, nil
+}
+
 func getEngineClientFromHostMachineIpAndPort(hostMachineIpAndPort *hostMachineIpAndPort) (kurtosis_engine_rpc_api_bindings.EngineServiceClient, func() error, error) {
 	url := hostMachineIpAndPort.GetURL()
-	conn, err := grpc.NewClient(url, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	currentContext, err := store.GetContextsConfigStore().GetCurrentContext()
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred retrieving the current context")
+	}
+	transportCredentials, err := kurtosis_context.NewEngineTransportCredentials(currentContext.GetEngineTlsConfig())
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred creating the credentials to reach the engine with")
+	}
+	dialOpts := []grpc.DialOption{grpc.WithTransportCredentials(transportCredentials)}
+	if engineApiToken := currentContext.GetEngineApiToken(); engineApiToken != "" {
+		dialOpts = append(dialOpts, grpc.WithPerRPCCredentials(kurtosis_context.NewEngineApiTokenCredentials(engineApiToken)))
+	}
+	conn, err := grpc.NewClient(url, dialOpts...)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred dialling Kurtosis engine at URL '%v'", url)
 	}
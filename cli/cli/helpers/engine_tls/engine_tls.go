@@ -0,0 +1,282 @@
+package engine_tls
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/util/tls_credentials"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/host_machine_directories"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/resolved_config"
+	"github.com/kurtosis-tech/kurtosis/contexts-config-store/api/golang/generated"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	caCertFilename     = "ca.pem"
+	caKeyFilename      = "ca-key.pem"
+	serverCertFilename = "server.pem"
+	serverKeyFilename  = "server-key.pem"
+	clientCertFilename = "client.pem"
+	clientKeyFilename  = "client-key.pem"
+
+	certFilePerms = 0644
+	keyFilePerms  = 0600
+
+	caCommonName     = "Kurtosis engine CA"
+	clientCommonName = "kurtosis-client"
+	localhostName    = "localhost"
+
+	certificatePemBlockType = "CERTIFICATE"
+	ecKeyPemBlockType       = "EC PRIVATE KEY"
+
+	certificateValidity = 5 * 365 * 24 * time.Hour
+	// Tolerates clocks of the engine's host running a bit behind the CLI's
+	certificateBackdating = time.Hour
+
+	serialNumberBits = 128
+)
+
+// GetEngineTlsConfig returns the TLS material the engine is started with. It's read from the files set in the
+// cluster config or, if there are none, from the self-signed material the CLI generates the first time it's needed
+func GetEngineTlsConfig(engineTlsConfig *resolved_config.EngineTlsConfig) (*args.TlsConfig, error) {
+	if engineTlsConfig == nil {
+		return nil, nil
+	}
+
+	var caCertFilepath, caKeyFilepath, serverCertFilepath, serverKeyFilepath, clientCertFilepath, clientKeyFilepath string
+	if engineTlsConfig.HasCertificateFiles() {
+		caCertFilepath = engineTlsConfig.CaCertFilepath
+		caKeyFilepath = engineTlsConfig.CaKeyFilepath
+		serverCertFilepath = engineTlsConfig.ServerCertFilepath
+		serverKeyFilepath = engineTlsConfig.ServerKeyFilepath
+		clientCertFilepath = engineTlsConfig.ClientCertFilepath
+		clientKeyFilepath = engineTlsConfig.ClientKeyFilepath
+	} else {
+		var err error
+		caCertFilepath, caKeyFilepath, serverCertFilepath, serverKeyFilepath, clientCertFilepath, clientKeyFilepath, err = getOrGenerateSelfSignedFiles()
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the self-signed engine TLS material")
+		}
+	}
+
+	caCert, err := readOptionalFile(caCertFilepath)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the CA certificate")
+	}
+	caKey, err := readOptionalFile(caKeyFilepath)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the CA key")
+	}
+	serverCert, err := readOptionalFile(serverCertFilepath)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the server certificate")
+	}
+	serverKey, err := readOptionalFile(serverKeyFilepath)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the server key")
+	}
+	clientCert, err := readOptionalFile(clientCertFilepath)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the client certificate")
+	}
+	clientKey, err := readOptionalFile(clientKeyFilepath)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the client key")
+	}
+
+	// Catch unusable material here rather than in the engine container logs
+	if _, err := tls_credentials.NewServerTlsConfig(caCert, serverCert, serverKey, engineTlsConfig.RequireClientCertificate); err != nil {
+		return nil, stacktrace.Propagate(err, "The engine TLS server material isn't valid")
+	}
+	if _, err := tls_credentials.NewClientTlsConfig(caCert, clientCert, clientKey); err != nil {
+		return nil, stacktrace.Propagate(err, "The engine TLS client material isn't valid")
+	}
+	if _, err := tls_credentials.NewCertificateIssuer(caCert, caKey); err != nil {
+		return nil, stacktrace.Propagate(err, "The engine TLS CA key can't sign certificates with the CA certificate")
+	}
+
+	return args.NewTlsConfig(
+		string(caCert),
+		string(caKey),
+		string(serverCert),
+		string(serverKey),
+		string(clientCert),
+		string(clientKey),
+		engineTlsConfig.RequireClientCertificate,
+	), nil
+}
+
+// NewContextTlsConfig returns the part of the engine TLS material that clients of the engine need, to be stored in
+// the context the engine belongs to. It returns nil if the engine serves plaintext
+func NewContextTlsConfig(engineTlsConfig *args.TlsConfig) *generated.TlsConfig {
+	if engineTlsConfig == nil {
+		return nil
+	}
+	return &generated.TlsConfig{
+		CertificateAuthority: []byte(engineTlsConfig.CaCertificate),
+		ClientCertificate:    []byte(engineTlsConfig.ClientCertificate),
+		ClientKey:            []byte(engineTlsConfig.ClientKey),
+	}
+}
+
+// ====================================================================================================
+//
+//	Private Helper Functions
+//
+// ====================================================================================================
+func getOrGenerateSelfSignedFiles() (string, string, string, string, string, string, error) {
+	filepaths := []string{}
+	allFilesExist := true
+	for _, filename := range []string{caCertFilename, caKeyFilename, serverCertFilename, serverKeyFilename, clientCertFilename, clientKeyFilename} {
+		filepath, err := host_machine_directories.GetEngineTlsFilepath(filename)
+		if err != nil {
+			return "", "", "", "", "", "", stacktrace.Propagate(err, "An error occurred getting the filepath of engine TLS file '%v'", filename)
+		}
+		if _, err := os.Stat(filepath); err != nil {
+			allFilesExist = false
+		}
+		filepaths = append(filepaths, filepath)
+	}
+	caCertFilepath, caKeyFilepath, serverCertFilepath, serverKeyFilepath, clientCertFilepath, clientKeyFilepath := filepaths[0], filepaths[1], filepaths[2], filepaths[3], filepaths[4], filepaths[5]
+	// Material generated before the engine kept the CA key gets regenerated, since the key is gone
+	if allFilesExist {
+		return caCertFilepath, caKeyFilepath, serverCertFilepath, serverKeyFilepath, clientCertFilepath, clientKeyFilepath, nil
+	}
+
+	logrus.Infof("Generating a self-signed CA and certificates for the engine TLS in '%v'...", caCertFilepath)
+	caCert, caKey, serverCert, serverKey, clientCert, clientKey, err := generateSelfSignedMaterial()
+	if err != nil {
+		return "", "", "", "", "", "", stacktrace.Propagate(err, "An error occurred generating the self-signed engine TLS material")
+	}
+	filesToWrite := []struct {
+		filepath string
+		content  []byte
+		perms    os.FileMode
+	}{
+		{caCertFilepath, caCert, certFilePerms},
+		{caKeyFilepath, caKey, keyFilePerms},
+		{serverCertFilepath, serverCert, certFilePerms},
+		{serverKeyFilepath, serverKey, keyFilePerms},
+		{clientCertFilepath, clientCert, certFilePerms},
+		{clientKeyFilepath, clientKey, keyFilePerms},
+	}
+	for _, fileToWrite := range filesToWrite {
+		if err := os.WriteFile(fileToWrite.filepath, fileToWrite.content, fileToWrite.perms); err != nil {
+			return "", "", "", "", "", "", stacktrace.Propagate(err, "An error occurred writing engine TLS file '%v'", fileToWrite.filepath)
+		}
+	}
+	logrus.Infof("Self-signed engine TLS material generated")
+	return caCertFilepath, caKeyFilepath, serverCertFilepath, serverKeyFilepath, clientCertFilepath, clientKeyFilepath, nil
+}
+
+// generateSelfSignedMaterial returns PEM-encoded CA certificate and key, server certificate and key, and client
+// certificate and key. The CA key is kept for the engine to sign the API containers' certificates with
+func generateSelfSignedMaterial() ([]byte, []byte, []byte, []byte, []byte, []byte, error) {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, nil, nil, nil, nil, stacktrace.Propagate(err, "An error occurred generating the CA key")
+	}
+	caTemplate, err := newCertificateTemplate(caCommonName)
+	if err != nil {
+		return nil, nil, nil, nil, nil, nil, stacktrace.Propagate(err, "An error occurred creating the CA certificate template")
+	}
+	caTemplate.IsCA = true
+	caTemplate.BasicConstraintsValid = true
+	caTemplate.KeyUsage = x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature
+	caCertDer, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	if err != nil {
+		return nil, nil, nil, nil, nil, nil, stacktrace.Propagate(err, "An error occurred creating the CA certificate")
+	}
+	caCert, err := x509.ParseCertificate(caCertDer)
+	if err != nil {
+		return nil, nil, nil, nil, nil, nil, stacktrace.Propagate(err, "An error occurred parsing the CA certificate that was just created")
+	}
+
+	serverTemplate, err := newCertificateTemplate(tls_credentials.ServerName)
+	if err != nil {
+		return nil, nil, nil, nil, nil, nil, stacktrace.Propagate(err, "An error occurred creating the server certificate template")
+	}
+	serverTemplate.DNSNames = []string{tls_credentials.ServerName, localhostName}
+	serverTemplate.IPAddresses = []net.IP{net.IPv4(127, 0, 0, 1), net.IPv6loopback}
+	serverTemplate.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
+	serverCert, serverKey, err := createSignedCertificate(serverTemplate, caCert, caKey)
+	if err != nil {
+		return nil, nil, nil, nil, nil, nil, stacktrace.Propagate(err, "An error occurred creating the server certificate")
+	}
+
+	clientTemplate, err := newCertificateTemplate(clientCommonName)
+	if err != nil {
+		return nil, nil, nil, nil, nil, nil, stacktrace.Propagate(err, "An error occurred creating the client certificate template")
+	}
+	clientTemplate.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
+	clientCert, clientKey, err := createSignedCertificate(clientTemplate, caCert, caKey)
+	if err != nil {
+		return nil, nil, nil, nil, nil, nil, stacktrace.Propagate(err, "An error occurred creating the client certificate")
+	}
+
+	caKeyDer, err := x509.MarshalECPrivateKey(caKey)
+	if err != nil {
+		return nil, nil, nil, nil, nil, nil, stacktrace.Propagate(err, "An error occurred serializing the CA key")
+	}
+
+	return encodePem(certificatePemBlockType, caCertDer), encodePem(ecKeyPemBlockType, caKeyDer), serverCert, serverKey, clientCert, clientKey, nil
+}
+
+func newCertificateTemplate(commonName string) (*x509.Certificate, error) {
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), serialNumberBits))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred generating a certificate serial number")
+	}
+	now := time.Now()
+	// nolint:exhaustruct
+	return &x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    now.Add(-certificateBackdating),
+		NotAfter:     now.Add(certificateValidity),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}, nil
+}
+
+func createSignedCertificate(template *x509.Certificate, caCert *x509.Certificate, caKey *ecdsa.PrivateKey) ([]byte, []byte, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred generating the key")
+	}
+	certDer, err := x509.CreateCertificate(rand.Reader, template, caCert, &key.PublicKey, caKey)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred signing the certificate")
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred serializing the key")
+	}
+	return encodePem(certificatePemBlockType, certDer), encodePem(ecKeyPemBlockType, keyDer), nil
+}
+
+func encodePem(blockType string, der []byte) []byte {
+	// nolint:exhaustruct
+	return pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+}
+
+// The client certificate and key are optional, so an empty filepath yields empty content
+func readOptionalFile(filepath string) ([]byte, error) {
+	if filepath == "" {
+		return nil, nil
+	}
+	content, err := os.ReadFile(filepath)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading file '%v'", filepath)
+	}
+	return content, nil
+}
@@ -0,0 +1,102 @@
+package engine_tls
+
+import (
+	"crypto/tls"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/util/tls_credentials"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	tcpNetwork       = "tcp"
+	loopbackAddress  = "127.0.0.1:0"
+	handshakeTimeout = 5 * time.Second
+)
+
+func TestGenerateSelfSignedMaterial_MutualTlsHandshake(t *testing.T) {
+	caCert, _, serverCert, serverKey, clientCert, clientKey, err := generateSelfSignedMaterial()
+	require.NoError(t, err)
+
+	serverTlsConfig, err := tls_credentials.NewServerTlsConfig(caCert, serverCert, serverKey, true)
+	require.NoError(t, err)
+	clientTlsConfig, err := tls_credentials.NewClientTlsConfig(caCert, clientCert, clientKey)
+	require.NoError(t, err)
+
+	require.NoError(t, handshake(serverTlsConfig, clientTlsConfig))
+}
+
+func TestGenerateSelfSignedMaterial_CaKeySignsApiContainerCertificates(t *testing.T) {
+	caCert, caKey, _, _, clientCert, clientKey, err := generateSelfSignedMaterial()
+	require.NoError(t, err)
+	certificateIssuer, err := tls_credentials.NewCertificateIssuer(caCert, caKey)
+	require.NoError(t, err)
+	apiContainerCert, apiContainerKey, err := certificateIssuer.IssueServerCertificate("api-container", time.Hour)
+	require.NoError(t, err)
+
+	serverTlsConfig, err := tls_credentials.NewServerTlsConfig(caCert, apiContainerCert, apiContainerKey, true)
+	require.NoError(t, err)
+	clientTlsConfig, err := tls_credentials.NewClientTlsConfig(caCert, clientCert, clientKey)
+	require.NoError(t, err)
+
+	require.NoError(t, handshake(serverTlsConfig, clientTlsConfig))
+}
+
+func TestGenerateSelfSignedMaterial_ServerRejectsClientWithoutCertificate(t *testing.T) {
+	caCert, _, serverCert, serverKey, _, _, err := generateSelfSignedMaterial()
+	require.NoError(t, err)
+
+	serverTlsConfig, err := tls_credentials.NewServerTlsConfig(caCert, serverCert, serverKey, true)
+	require.NoError(t, err)
+	clientTlsConfig, err := tls_credentials.NewClientTlsConfig(caCert, nil, nil)
+	require.NoError(t, err)
+
+	require.Error(t, handshake(serverTlsConfig, clientTlsConfig))
+}
+
+func TestGenerateSelfSignedMaterial_ClientRejectsOtherCa(t *testing.T) {
+	_, _, serverCert, serverKey, _, _, err := generateSelfSignedMaterial()
+	require.NoError(t, err)
+	otherCaCert, _, _, _, _, _, err := generateSelfSignedMaterial()
+	require.NoError(t, err)
+
+	serverTlsConfig, err := tls_credentials.NewServerTlsConfig(nil, serverCert, serverKey, false)
+	require.NoError(t, err)
+	clientTlsConfig, err := tls_credentials.NewClientTlsConfig(otherCaCert, nil, nil)
+	require.NoError(t, err)
+
+	require.Error(t, handshake(serverTlsConfig, clientTlsConfig))
+}
+
+// handshake returns the first error either side of a TLS handshake over loopback hits
+func handshake(serverTlsConfig *tls.Config, clientTlsConfig *tls.Config) error {
+	listener, err := tls.Listen(tcpNetwork, loopbackAddress, serverTlsConfig)
+	if err != nil {
+		return err
+	}
+	defer listener.Close()
+
+	serverErrChan := make(chan error, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			serverErrChan <- err
+			return
+		}
+		defer conn.Close()
+		_ = conn.SetDeadline(time.Now().Add(handshakeTimeout))
+		serverErrChan <- conn.(*tls.Conn).Handshake()
+	}()
+
+	dialer := &net.Dialer{Timeout: handshakeTimeout} // nolint:exhaustruct
+	conn, err := tls.DialWithDialer(dialer, tcpNetwork, listener.Addr().String(), clientTlsConfig)
+	if err != nil {
+		<-serverErrChan
+		return err
+	}
+	defer conn.Close()
+	// With TLS 1.3 the client is done with the handshake before the server has checked its certificate
+	return <-serverErrChan
+}
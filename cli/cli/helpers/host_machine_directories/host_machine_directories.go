@@ -35,6 +35,7 @@ const (
 
 	// ------------ Names of dirs inside Kurtosis directory --------------
 	engineDataDirname      = "engine-data"
+	engineTlsDirname       = "engine-tls"
 	portalSubDirname       = "portal"
 	kurtosisCliLogsDirname = "cli"
 )
@@ -160,6 +161,16 @@ func GetPortalPidFilePath() (string, error) {
 	return portalPidFilePath, nil
 }
 
+// GetEngineTlsFilepath returns where the CLI keeps the given file of the TLS material it generated for the engine
+func GetEngineTlsFilepath(filename string) (string, error) {
+	xdgRelFilepath := getRelativeFilepathForXDG(path.Join(engineTlsDirname, filename))
+	engineTlsFilepath, err := xdg.ConfigFile(xdgRelFilepath)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the engine TLS filepath from relative path '%v'", xdgRelFilepath)
+	}
+	return engineTlsFilepath, nil
+}
+
 func GetGitHubUsernameFilePath() (string, error) {
 	xdgRelFilepath := getRelativeFilepathForXDG(githubUsernameFilename)
 	githubUsernameFilePath, err := xdg.StateFile(xdgRelFilepath)
@@ -5,6 +5,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/resolved_config"
 	"github.com/kurtosis-tech/stacktrace"
+	"sort"
 )
 
 const (
@@ -59,3 +60,42 @@ func GetKurtosisClusterConfig() (*resolved_config.KurtosisClusterConfig, error)
 
 	return clusterConfig, nil
 }
+
+// GetCurrentKurtosisClusterName returns the name of the cluster the CLI is currently pointed at
+func GetCurrentKurtosisClusterName() (string, error) {
+	clusterName, err := getKurtosisClusterName()
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the current Kurtosis cluster name")
+	}
+	return clusterName, nil
+}
+
+// GetKurtosisClusterConfigByName returns the config of the given cluster, whether or not it's the current one
+func GetKurtosisClusterConfigByName(clusterName string) (*resolved_config.KurtosisClusterConfig, error) {
+	kurtosisConfig, err := getKurtosisConfig()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while getting Kurtosis configuration")
+	}
+
+	clusterConfig, found := kurtosisConfig.GetKurtosisClusters()[clusterName]
+	if !found {
+		return nil, stacktrace.NewError("Expected to find Kurtosis configuration for cluster '%v', instead found nothing", clusterName)
+	}
+
+	return clusterConfig, nil
+}
+
+// GetKurtosisClusterNames returns the names of all the configured clusters, sorted alphabetically
+func GetKurtosisClusterNames() ([]string, error) {
+	kurtosisConfig, err := getKurtosisConfig()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while getting Kurtosis configuration")
+	}
+
+	clusterNames := []string{}
+	for clusterName := range kurtosisConfig.GetKurtosisClusters() {
+		clusterNames = append(clusterNames, clusterName)
+	}
+	sort.Strings(clusterNames)
+	return clusterNames, nil
+}
@@ -77,6 +77,12 @@ func (printer *DefaultExecutionPrinter) PrintKurtosisExecutionResponseLineToStdO
 		if err := printer.printPersistentLineToStdOut(formattedInstructionWithNewline); err != nil {
 			return stacktrace.Propagate(err, "Error printing Kurtosis instruction: \n%v", formattedInstruction)
 		}
+	} else if responseLine.GetInstructionOutput() != nil {
+		if verbosity != run.OutputOnly {
+			if err := printer.printPersistentLineToStdOut(responseLine.GetInstructionOutput().GetOutputLine()); err != nil {
+				return stacktrace.Propagate(err, "Error printing Kurtosis instruction output line: \n%v", responseLine.GetInstructionOutput().GetOutputLine())
+			}
+		}
 	} else if responseLine.GetInstructionResult() != nil {
 		formattedInstructionResult := formatInstructionResult(responseLine.GetInstructionResult(), verbosity)
 		if err := printer.printPersistentLineToStdOut(formattedInstructionResult); err != nil {
@@ -147,6 +147,12 @@ func (printer *ParallelExecutionPrinter) PrintKurtosisExecutionResponseLineToStd
 			ID:   instructionId,
 			Name: formatInstruction(instruction, verbosity),
 		}
+	} else if responseLine.GetInstructionOutput() != nil && verbosity != run.OutputOnly {
+		output := responseLine.GetInstructionOutput()
+		msg = InstructionOutputMsg{
+			ID:         output.GetInstructionId(),
+			OutputLine: output.GetOutputLine(),
+		}
 	} else if responseLine.GetInstructionResult() != nil {
 		result := responseLine.GetInstructionResult()
 		instructionId := result.GetInstructionId()
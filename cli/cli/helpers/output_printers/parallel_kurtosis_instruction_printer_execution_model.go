@@ -87,6 +87,11 @@ type InstructionProgressMsg struct {
 	Message  string
 }
 
+type InstructionOutputMsg struct {
+	ID         string
+	OutputLine string
+}
+
 type InstructionCompletedMsg struct {
 	ID     string
 	Result string
@@ -183,6 +188,12 @@ func (m *ExecutionModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 		}
 
+		return m, tea.Batch(cmds...)
+	case InstructionOutputMsg:
+		if instruction, exists := m.instructions[msg.ID]; exists {
+			instruction.InfoMessages = append(instruction.InfoMessages, msg.OutputLine)
+		}
+
 		return m, tea.Batch(cmds...)
 	case InstructionCompletedMsg:
 		if instruction, exists := m.instructions[msg.ID]; exists {
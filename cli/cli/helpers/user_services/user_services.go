@@ -6,10 +6,11 @@ import (
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/enclave_liveness_validator"
+	"github.com/kurtosis-tech/kurtosis/contexts-config-store/store"
 	"github.com/kurtosis-tech/stacktrace"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 	"sort"
 	"strings"
 )
@@ -105,7 +106,21 @@ func GetUserServiceInfoMapFromAPIContainer(ctx context.Context, enclaveInfo *kur
 		apicHostMachineIp,
 		apicHostMachineGrpcPort,
 	)
-	conn, err := grpc.NewClient(apiContainerHostGrpcUrl, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	currentContext, err := store.GetContextsConfigStore().GetCurrentContext()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred retrieving the current context")
+	}
+	// The API containers serve the certificate of the engine they belong to
+	transportCredentials, err := kurtosis_context.NewEngineTransportCredentials(currentContext.GetEngineTlsConfig())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the credentials to reach the API container with")
+	}
+	// The API containers accept the same API tokens as the engine they belong to
+	dialOpts := []grpc.DialOption{grpc.WithTransportCredentials(transportCredentials)}
+	if engineApiToken := currentContext.GetEngineApiToken(); engineApiToken != "" {
+		dialOpts = append(dialOpts, grpc.WithPerRPCCredentials(kurtosis_context.NewEngineApiTokenCredentials(engineApiToken)))
+	}
+	conn, err := grpc.NewClient(apiContainerHostGrpcUrl, dialOpts...)
 	if err != nil {
 		return nil, stacktrace.Propagate(
 			err,
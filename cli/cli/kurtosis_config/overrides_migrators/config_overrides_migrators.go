@@ -106,6 +106,10 @@ func migrateFromV8(uncastedConfig interface{}) (interface{}, error) {
 				ShouldEnableDefaultLogsSink: oldClusterConfig.ShouldEnableDefaultLogsSink,
 				AllowPrivilegedMode:         oldClusterConfig.AllowPrivilegedMode,
 				BackendLogCollector:         nil,
+				EngineAuth:                  nil,
+				EngineTls:                   nil,
+				EnclaveTemplates:            nil,
+				Webhooks:                    nil,
 			}
 
 			newClusters[oldClusterName] = newClusterConfig
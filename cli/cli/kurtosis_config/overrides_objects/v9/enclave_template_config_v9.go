@@ -0,0 +1,20 @@
+package v9
+
+/*
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+                           DO NOT CHANGE THIS FILE!
+  If you change this file, it will break config for users who have instantiated an
+           overrides file with this version of config overrides!
+    Instead, to make changes, you will need to add a new version of the config
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+*/
+
+type EnclaveTemplateConfigV9 struct {
+	Name *string `yaml:"name,omitempty"`
+	// Package is the locator of the package to pre-run, as accepted by `kurtosis run`
+	Package *string `yaml:"package,omitempty"`
+	// Args is the JSON the package is run with; unset means the package's default args
+	Args *string `yaml:"args,omitempty"`
+	// PoolSize is how many idle enclaves with the package already running the engine keeps around (default: 1)
+	PoolSize *uint8 `yaml:"pool-size,omitempty"`
+}
@@ -0,0 +1,22 @@
+package v9
+
+/*
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+                           DO NOT CHANGE THIS FILE!
+  If you change this file, it will break config for users who have instantiated an
+           overrides file with this version of config overrides!
+    Instead, to make changes, you will need to add a new version of the config
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+*/
+
+type EngineAuthConfigV9 struct {
+	// ApiTokens are the static bearer tokens the engine API accepts, each tied to a user and a role
+	ApiTokens []*EngineApiTokenV9 `yaml:"api-tokens,omitempty"`
+}
+
+type EngineApiTokenV9 struct {
+	Token *string `yaml:"token,omitempty"`
+	User  *string `yaml:"user,omitempty"`
+	// Role is one of "viewer", "operator" or "admin"
+	Role *string `yaml:"role,omitempty"`
+}
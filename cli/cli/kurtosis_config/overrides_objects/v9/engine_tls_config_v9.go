@@ -0,0 +1,28 @@
+package v9
+
+/*
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+                           DO NOT CHANGE THIS FILE!
+  If you change this file, it will break config for users who have instantiated an
+           overrides file with this version of config overrides!
+    Instead, to make changes, you will need to add a new version of the config
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+*/
+
+type EngineTlsConfigV9 struct {
+	Enabled *bool `yaml:"enabled,omitempty"`
+
+	// RequireClientCertificate makes the engine and the API containers only accept clients presenting a certificate
+	// signed by the CA (mTLS)
+	RequireClientCertificate *bool `yaml:"require-client-certificate,omitempty"`
+
+	// Paths to PEM files. If none are set, the CLI generates a self-signed CA along with the server and client
+	// certificates the first time the engine starts, and reuses them afterwards. The engine signs the API containers'
+	// certificates with the CA key
+	CaCertFile     *string `yaml:"ca-cert-file,omitempty"`
+	CaKeyFile      *string `yaml:"ca-key-file,omitempty"`
+	ServerCertFile *string `yaml:"server-cert-file,omitempty"`
+	ServerKeyFile  *string `yaml:"server-key-file,omitempty"`
+	ClientCertFile *string `yaml:"client-cert-file,omitempty"`
+	ClientKeyFile  *string `yaml:"client-key-file,omitempty"`
+}
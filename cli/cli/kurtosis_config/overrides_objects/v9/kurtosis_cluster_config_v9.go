@@ -28,4 +28,17 @@ type KurtosisClusterConfigV9 struct {
 	// When set to "otel" and the cluster type is Docker, `kurtosis engine start`/`restart` auto-starts the OpenTelemetry side
 	// containers (collector + ClickHouse) and configures the engine's Vector aggregator to ship logs to the collector.
 	BackendLogCollector *string `yaml:"backend-log-collector,omitempty"`
+
+	// EngineAuth makes the engine API require a bearer token on every request. Unset means the engine API is open.
+	EngineAuth *EngineAuthConfigV9 `yaml:"engine-auth,omitempty"`
+
+	// EngineTls makes the engine and API container gRPC servers serve TLS. Unset means they serve plaintext.
+	EngineTls *EngineTlsConfigV9 `yaml:"engine-tls,omitempty"`
+
+	// EnclaveTemplates are packages the engine keeps pre-run in idle enclaves, so that `kurtosis enclave add --from-template`
+	// hands out an enclave with the package already running. Only Kubernetes clusters support them.
+	EnclaveTemplates []*EnclaveTemplateConfigV9 `yaml:"enclave-templates,omitempty"`
+
+	// Webhooks are endpoints the engine POSTs the events of its enclaves to
+	Webhooks []*WebhookConfigV9 `yaml:"webhooks,omitempty"`
 }
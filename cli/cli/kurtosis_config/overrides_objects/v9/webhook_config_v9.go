@@ -0,0 +1,18 @@
+package v9
+
+/*
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+                           DO NOT CHANGE THIS FILE!
+  If you change this file, it will break config for users who have instantiated an
+           overrides file with this version of config overrides!
+    Instead, to make changes, you will need to add a new version of the config
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+*/
+
+type WebhookConfigV9 struct {
+	Url *string `yaml:"url,omitempty"`
+	// Secret signs the requests with HMAC-SHA256 in the X-Kurtosis-Signature header; unset means unsigned requests
+	Secret *string `yaml:"secret,omitempty"`
+	// EventTypes are the names of the events sent to the webhook, e.g. ENCLAVE_CREATED; unset means every event
+	EventTypes []string `yaml:"event-types,omitempty"`
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/configs"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
 	"github.com/kurtosis-tech/kurtosis/engine/launcher/engine_server_launcher"
 	"github.com/kurtosis-tech/stacktrace"
 	apiv1 "k8s.io/api/core/v1"
@@ -22,6 +23,8 @@ const (
 	defaultKubernetesEnclaveDataVolumeSizeInMegabytes = uint(1024)
 	// this will schedule engine on node selected by k8s scheduler
 	defaultEngineNodeName = ""
+
+	defaultEnclaveTemplatePoolSize = uint8(1)
 )
 
 // BackendLogCollector selects the log-collector stack the engine wires up at start.
@@ -50,14 +53,40 @@ type kurtosisBackendSupplier func(ctx context.Context) (backend_interface.Kurtos
 
 type KurtosisClusterConfig struct {
 	kurtosisBackendSupplier     kurtosisBackendSupplier
+	federatedBackendSupplier    kurtosisBackendSupplier
 	engineBackendConfigSupplier engine_server_launcher.KurtosisBackendConfigSupplier
 	clusterType                 KurtosisClusterType
+	// only set for Kubernetes clusters
+	kubernetesClusterName       string
 	logsAggregator              LogsAggregatorConfig
 	logsCollector               LogsCollectorConfig
 	graflokiConfig              GrafanaLokiConfig
 	shouldEnableDefaultLogsSink bool
 	allowPrivilegedMode         bool
 	backendLogCollector         BackendLogCollector
+	engineApiTokens             []args.ApiToken
+	// nil if the engine serves plaintext
+	engineTls *EngineTlsConfig
+	// packages the engine keeps pre-run in idle enclaves
+	enclaveTemplates []args.EnclaveTemplate
+	// endpoints the engine sends its events to
+	webhooks []args.Webhook
+}
+
+// EngineTlsConfig holds where the TLS material of the engine and API container gRPC servers lives. When no files
+// are set, the CLI generates the material itself
+type EngineTlsConfig struct {
+	RequireClientCertificate bool
+	CaCertFilepath           string
+	CaKeyFilepath            string
+	ServerCertFilepath       string
+	ServerKeyFilepath        string
+	ClientCertFilepath       string
+	ClientKeyFilepath        string
+}
+
+func (tlsConfig *EngineTlsConfig) HasCertificateFiles() bool {
+	return tlsConfig.CaCertFilepath != ""
 }
 
 type LogsAggregatorConfig struct {
@@ -91,11 +120,17 @@ func NewKurtosisClusterConfigFromOverrides(clusterId string, overrides *v9.Kurto
 		)
 	}
 
-	backendSupplier, engineBackendConfigSupplier, err := getSuppliers(clusterId, clusterType, overrides.Config)
+	backendSupplier, federatedBackendSupplier, engineBackendConfigSupplier, err := getSuppliers(clusterId, clusterType, overrides.Config)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred getting the suppliers that cluster '%v' will use", clusterId)
 	}
 
+	kubernetesClusterName := ""
+	if clusterType == KurtosisClusterType_Kubernetes {
+		// getSuppliers already validated that the Kubernetes cluster name is set
+		kubernetesClusterName = *overrides.Config.KubernetesClusterName
+	}
+
 	logsAggregator := LogsAggregatorConfig{
 		Sinks: nil,
 	}
@@ -173,16 +208,42 @@ func NewKurtosisClusterConfigFromOverrides(clusterId string, overrides *v9.Kurto
 		backendLogCollector = candidate
 	}
 
+	engineApiTokens, err := getEngineApiTokens(clusterId, overrides.EngineAuth)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred validating the engine auth config of cluster '%v'", clusterId)
+	}
+
+	engineTls, err := getEngineTlsConfig(clusterId, overrides.EngineTls)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred validating the engine TLS config of cluster '%v'", clusterId)
+	}
+
+	enclaveTemplates, err := getEnclaveTemplates(clusterId, clusterType, overrides.EnclaveTemplates)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred validating the enclave templates of cluster '%v'", clusterId)
+	}
+
+	webhooks, err := getWebhooks(clusterId, overrides.Webhooks)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred validating the webhooks of cluster '%v'", clusterId)
+	}
+
 	return &KurtosisClusterConfig{
 		kurtosisBackendSupplier:     backendSupplier,
+		federatedBackendSupplier:    federatedBackendSupplier,
 		engineBackendConfigSupplier: engineBackendConfigSupplier,
 		clusterType:                 clusterType,
+		kubernetesClusterName:       kubernetesClusterName,
 		logsAggregator:              logsAggregator,
 		logsCollector:               logsCollector,
 		graflokiConfig:              grafloki,
 		shouldEnableDefaultLogsSink: shouldEnableDefaultLogsSink,
 		allowPrivilegedMode:         allowPrivilegedMode,
 		backendLogCollector:         backendLogCollector,
+		engineApiTokens:             engineApiTokens,
+		engineTls:                   engineTls,
+		enclaveTemplates:            enclaveTemplates,
+		webhooks:                    webhooks,
 	}, nil
 }
 
@@ -194,6 +255,17 @@ func (clusterConfig *KurtosisClusterConfig) GetKurtosisBackend(ctx context.Conte
 	return backend, nil
 }
 
+// GetFederatedKurtosisBackend returns a backend for this cluster that can be used while another cluster is the current
+// one. Unlike GetKurtosisBackend, for Kubernetes clusters it uses the kubeconfig context of the configured Kubernetes
+// cluster rather than the current kubeconfig context
+func (clusterConfig *KurtosisClusterConfig) GetFederatedKurtosisBackend(ctx context.Context) (backend_interface.KurtosisBackend, error) {
+	backend, err := clusterConfig.federatedBackendSupplier(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting a federated Kurtosis backend")
+	}
+	return backend, nil
+}
+
 func (clusterConfig *KurtosisClusterConfig) GetEngineBackendConfigSupplier() engine_server_launcher.KurtosisBackendConfigSupplier {
 	return clusterConfig.engineBackendConfigSupplier
 }
@@ -202,6 +274,12 @@ func (clusterConfig *KurtosisClusterConfig) GetClusterType() KurtosisClusterType
 	return clusterConfig.clusterType
 }
 
+// GetKubernetesClusterName returns the name of the Kubernetes cluster this cluster points to, or an empty string if
+// this isn't a Kubernetes cluster
+func (clusterConfig *KurtosisClusterConfig) GetKubernetesClusterName() string {
+	return clusterConfig.kubernetesClusterName
+}
+
 func (clusterConfig *KurtosisClusterConfig) GetLogsAggregatorConfig() LogsAggregatorConfig {
 	return clusterConfig.logsAggregator
 }
@@ -226,22 +304,44 @@ func (clusterConfig *KurtosisClusterConfig) GetBackendLogCollector() BackendLogC
 	return clusterConfig.backendLogCollector
 }
 
+// GetEngineApiTokens returns the API tokens the engine will require on its API; if empty the engine API is open
+func (clusterConfig *KurtosisClusterConfig) GetEngineApiTokens() []args.ApiToken {
+	return clusterConfig.engineApiTokens
+}
+
+// GetEngineTlsConfig returns nil if the engine and API containers serve plaintext
+func (clusterConfig *KurtosisClusterConfig) GetEngineTlsConfig() *EngineTlsConfig {
+	return clusterConfig.engineTls
+}
+
+// GetEnclaveTemplates returns the packages the engine will keep pre-run in idle enclaves
+func (clusterConfig *KurtosisClusterConfig) GetEnclaveTemplates() []args.EnclaveTemplate {
+	return clusterConfig.enclaveTemplates
+}
+
+// GetWebhooks returns the endpoints the engine will send its events to
+func (clusterConfig *KurtosisClusterConfig) GetWebhooks() []args.Webhook {
+	return clusterConfig.webhooks
+}
+
 // ====================================================================================================
 //
 //	Private Helpers
 //
 // ====================================================================================================
 func getSuppliers(clusterId string, clusterType KurtosisClusterType, kubernetesConfig *v9.KubernetesClusterConfigV9) (
+	kurtosisBackendSupplier,
 	kurtosisBackendSupplier,
 	engine_server_launcher.KurtosisBackendConfigSupplier,
 	error,
 ) {
 	var backendSupplier kurtosisBackendSupplier
+	var federatedBackendSupplier kurtosisBackendSupplier
 	var engineConfigSupplier engine_server_launcher.KurtosisBackendConfigSupplier
 	switch clusterType {
 	case KurtosisClusterType_Docker:
 		if kubernetesConfig != nil {
-			return nil, nil, stacktrace.NewError(
+			return nil, nil, nil, stacktrace.NewError(
 				"Cluster '%v' defines cluster config, but config must not be provided when cluster type is '%v'",
 				clusterId,
 				clusterType.String(),
@@ -266,10 +366,12 @@ func getSuppliers(clusterId string, clusterType KurtosisClusterType, kubernetesC
 			return backend, nil
 		}
 
+		// Docker backends don't depend on the current cluster
+		federatedBackendSupplier = backendSupplier
 		engineConfigSupplier = engine_server_launcher.NewDockerKurtosisBackendConfigSupplier()
 	case KurtosisClusterType_Podman:
 		if kubernetesConfig != nil {
-			return nil, nil, stacktrace.NewError(
+			return nil, nil, nil, stacktrace.NewError(
 				"Cluster '%v' defines cluster config, but config must not be provided when cluster type is '%v'",
 				clusterId,
 				clusterType.String(),
@@ -294,28 +396,29 @@ func getSuppliers(clusterId string, clusterType KurtosisClusterType, kubernetesC
 			return backend, nil
 		}
 
+		federatedBackendSupplier = backendSupplier
 		engineConfigSupplier = engine_server_launcher.NewPodmanKurtosisBackendConfigSupplier()
 	case KurtosisClusterType_Kubernetes:
 		if kubernetesConfig == nil {
-			return nil, nil, stacktrace.NewError(
+			return nil, nil, nil, stacktrace.NewError(
 				"Cluster '%v' doesn't define cluster config, but config must be provided when cluster type is '%v'",
 				clusterId,
 				clusterType.String(),
 			)
 		}
 		if kubernetesConfig.KubernetesClusterName == nil {
-			return nil, nil, stacktrace.NewError(
+			return nil, nil, nil, stacktrace.NewError(
 				"Type of cluster '%v' is '%v' but has no Kubernetes cluster name in its config map",
 				clusterId,
 				clusterType,
 			)
 		}
 
-		// TODO Use the Kubernetes cluster name when constructing the KubernetesBackend!
-		_ = *kubernetesConfig.KubernetesClusterName
+		// TODO Use the Kubernetes cluster name when constructing the KubernetesBackend of the current cluster too!
+		kubernetesClusterName := *kubernetesConfig.KubernetesClusterName
 
 		if kubernetesConfig.StorageClass == nil {
-			return nil, nil, stacktrace.NewError(
+			return nil, nil, nil, stacktrace.NewError(
 				"Type of cluster '%v' is '%v' but no storage class was defined in the config",
 				clusterId,
 				clusterType,
@@ -350,16 +453,164 @@ func getSuppliers(clusterId string, clusterType KurtosisClusterType, kubernetesC
 			return backend, nil
 		}
 
+		federatedBackendSupplier = func(ctx context.Context) (backend_interface.KurtosisBackend, error) {
+			backend, err := kubernetes_kurtosis_backend.GetCLIBackendForKubernetesCluster(ctx, kubernetesClusterName, storageClass, engineNodeName, nodeSelectors, tolerations)
+			if err != nil {
+				return nil, stacktrace.Propagate(
+					err,
+					"An error occurred getting Kurtosis Kubernetes backend for CLI from cluster '%v' targeting Kubernetes cluster '%v'",
+					clusterId,
+					kubernetesClusterName,
+				)
+			}
+			return backend, nil
+		}
+
 		engineConfigSupplier = engine_server_launcher.NewKubernetesKurtosisBackendConfigSupplier(storageClass, enclaveDataVolumeSizeInMb)
 	default:
 		// This should never happen because we enforce this via unit tests
-		return nil, nil, stacktrace.NewError(
+		return nil, nil, nil, stacktrace.NewError(
 			"Cluster '%v' has unrecognized type '%v'; this is a bug in Kurtosis",
 			clusterId,
 			clusterType.String(),
 		)
 	}
-	return backendSupplier, engineConfigSupplier, nil
+	return backendSupplier, federatedBackendSupplier, engineConfigSupplier, nil
+}
+
+func getEngineApiTokens(clusterId string, engineAuthConfig *v9.EngineAuthConfigV9) ([]args.ApiToken, error) {
+	if engineAuthConfig == nil {
+		return nil, nil
+	}
+	result := []args.ApiToken{}
+	seenTokens := map[string]bool{}
+	for idx, apiTokenConfig := range engineAuthConfig.ApiTokens {
+		if apiTokenConfig == nil || apiTokenConfig.Token == nil || strings.TrimSpace(*apiTokenConfig.Token) == "" {
+			return nil, stacktrace.NewError("API token #%v of cluster '%v' has no token", idx, clusterId)
+		}
+		if apiTokenConfig.User == nil || strings.TrimSpace(*apiTokenConfig.User) == "" {
+			return nil, stacktrace.NewError("API token #%v of cluster '%v' has no user", idx, clusterId)
+		}
+		if apiTokenConfig.Role == nil {
+			return nil, stacktrace.NewError("API token of user '%v' in cluster '%v' has no role", *apiTokenConfig.User, clusterId)
+		}
+		role := args.ApiTokenRole(*apiTokenConfig.Role)
+		if !role.IsValid() {
+			return nil, stacktrace.NewError(
+				"API token of user '%v' in cluster '%v' has unrecognized role '%v'; valid values are: %v",
+				*apiTokenConfig.User,
+				clusterId,
+				*apiTokenConfig.Role,
+				strings.Join(args.ApiTokenRoleStrings(), ", "),
+			)
+		}
+		if seenTokens[*apiTokenConfig.Token] {
+			return nil, stacktrace.NewError("API token of user '%v' in cluster '%v' is used by more than one user", *apiTokenConfig.User, clusterId)
+		}
+		seenTokens[*apiTokenConfig.Token] = true
+		result = append(result, *args.NewApiToken(*apiTokenConfig.Token, *apiTokenConfig.User, role))
+	}
+	return result, nil
+}
+
+func getEnclaveTemplates(clusterId string, clusterType KurtosisClusterType, enclaveTemplateConfigs []*v9.EnclaveTemplateConfigV9) ([]args.EnclaveTemplate, error) {
+	if len(enclaveTemplateConfigs) == 0 {
+		return nil, nil
+	}
+	// The templates are backed by the enclave pool, which only exists on Kubernetes
+	if clusterType != KurtosisClusterType_Kubernetes {
+		return nil, stacktrace.NewError("Cluster '%v' sets enclave-templates, but only clusters of type '%v' support them", clusterId, KurtosisClusterType_Kubernetes.String())
+	}
+	result := []args.EnclaveTemplate{}
+	seenNames := map[string]bool{}
+	for idx, enclaveTemplateConfig := range enclaveTemplateConfigs {
+		if enclaveTemplateConfig == nil || enclaveTemplateConfig.Name == nil || strings.TrimSpace(*enclaveTemplateConfig.Name) == "" {
+			return nil, stacktrace.NewError("Enclave template #%v of cluster '%v' has no name", idx, clusterId)
+		}
+		name := *enclaveTemplateConfig.Name
+		if enclaveTemplateConfig.Package == nil || strings.TrimSpace(*enclaveTemplateConfig.Package) == "" {
+			return nil, stacktrace.NewError("Enclave template '%v' of cluster '%v' has no package", name, clusterId)
+		}
+		serializedParams := ""
+		if enclaveTemplateConfig.Args != nil {
+			serializedParams = *enclaveTemplateConfig.Args
+		}
+		poolSize := defaultEnclaveTemplatePoolSize
+		if enclaveTemplateConfig.PoolSize != nil {
+			poolSize = *enclaveTemplateConfig.PoolSize
+		}
+		if poolSize == 0 {
+			return nil, stacktrace.NewError("Enclave template '%v' of cluster '%v' has a pool-size of zero; remove the template instead", name, clusterId)
+		}
+		if seenNames[name] {
+			return nil, stacktrace.NewError("Enclave template name '%v' is used more than once in cluster '%v'", name, clusterId)
+		}
+		seenNames[name] = true
+		result = append(result, *args.NewEnclaveTemplate(name, *enclaveTemplateConfig.Package, serializedParams, poolSize))
+	}
+	return result, nil
+}
+
+func getWebhooks(clusterId string, webhookConfigs []*v9.WebhookConfigV9) ([]args.Webhook, error) {
+	if len(webhookConfigs) == 0 {
+		return nil, nil
+	}
+	result := []args.Webhook{}
+	for idx, webhookConfig := range webhookConfigs {
+		if webhookConfig == nil || webhookConfig.Url == nil || strings.TrimSpace(*webhookConfig.Url) == "" {
+			return nil, stacktrace.NewError("Webhook #%v of cluster '%v' has no url", idx, clusterId)
+		}
+		secret := ""
+		if webhookConfig.Secret != nil {
+			secret = *webhookConfig.Secret
+		}
+		result = append(result, *args.NewWebhook(*webhookConfig.Url, secret, webhookConfig.EventTypes))
+	}
+	return result, nil
+}
+
+func getEngineTlsConfig(clusterId string, engineTlsConfig *v9.EngineTlsConfigV9) (*EngineTlsConfig, error) {
+	if engineTlsConfig == nil || engineTlsConfig.Enabled == nil || !*engineTlsConfig.Enabled {
+		return nil, nil
+	}
+	derefOrEmpty := func(value *string) string {
+		if value == nil {
+			return ""
+		}
+		return strings.TrimSpace(*value)
+	}
+	result := &EngineTlsConfig{
+		RequireClientCertificate: engineTlsConfig.RequireClientCertificate != nil && *engineTlsConfig.RequireClientCertificate,
+		CaCertFilepath:           derefOrEmpty(engineTlsConfig.CaCertFile),
+		CaKeyFilepath:            derefOrEmpty(engineTlsConfig.CaKeyFile),
+		ServerCertFilepath:       derefOrEmpty(engineTlsConfig.ServerCertFile),
+		ServerKeyFilepath:        derefOrEmpty(engineTlsConfig.ServerKeyFile),
+		ClientCertFilepath:       derefOrEmpty(engineTlsConfig.ClientCertFile),
+		ClientKeyFilepath:        derefOrEmpty(engineTlsConfig.ClientKeyFile),
+	}
+
+	hasServerFiles := result.CaCertFilepath != "" || result.CaKeyFilepath != "" || result.ServerCertFilepath != "" || result.ServerKeyFilepath != ""
+	hasClientFiles := result.ClientCertFilepath != "" || result.ClientKeyFilepath != ""
+	if !hasServerFiles && !hasClientFiles {
+		// The CLI generates everything
+		return result, nil
+	}
+	if result.CaCertFilepath == "" || result.CaKeyFilepath == "" || result.ServerCertFilepath == "" || result.ServerKeyFilepath == "" {
+		return nil, stacktrace.NewError(
+			"Cluster '%v' sets some of the engine TLS files but not all of ca-cert-file, ca-key-file, server-cert-file and server-key-file; set all of them, or none to let the CLI generate them",
+			clusterId,
+		)
+	}
+	if (result.ClientCertFilepath == "") != (result.ClientKeyFilepath == "") {
+		return nil, stacktrace.NewError("Cluster '%v' sets only one of the engine TLS client-cert-file and client-key-file", clusterId)
+	}
+	if result.RequireClientCertificate && !hasClientFiles {
+		return nil, stacktrace.NewError(
+			"Cluster '%v' requires engine client certificates but sets no client-cert-file and client-key-file for the CLI and the engine to present",
+			clusterId,
+		)
+	}
+	return result, nil
 }
 
 func convertTolerations(configTolerations []*v9.KubernetesTolerationV9) []apiv1.Toleration {
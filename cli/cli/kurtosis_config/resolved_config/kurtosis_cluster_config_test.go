@@ -7,6 +7,7 @@ import (
 
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
 	"github.com/stretchr/testify/require"
 )
 
@@ -20,6 +21,10 @@ func TestNewKurtosisClusterConfigEmptyOverrides(t *testing.T) {
 		ShouldEnableDefaultLogsSink: nil,
 		AllowPrivilegedMode:         nil,
 		BackendLogCollector:         nil,
+		EngineAuth:                  nil,
+		EngineTls:                   nil,
+		EnclaveTemplates:            nil,
+		Webhooks:                    nil,
 	}
 	_, err := NewKurtosisClusterConfigFromOverrides("test", &kurtosisClusterConfigOverrides)
 	require.Error(t, err)
@@ -36,6 +41,10 @@ func TestNewKurtosisClusterConfigDockerType(t *testing.T) {
 		ShouldEnableDefaultLogsSink: nil,
 		AllowPrivilegedMode:         nil,
 		BackendLogCollector:         nil,
+		EngineAuth:                  nil,
+		EngineTls:                   nil,
+		EnclaveTemplates:            nil,
+		Webhooks:                    nil,
 	}
 	_, err := NewKurtosisClusterConfigFromOverrides("test", &kurtosisClusterConfigOverrides)
 	require.NoError(t, err)
@@ -53,6 +62,10 @@ func TestNewKurtosisClusterConfigAllowPrivilegedMode(t *testing.T) {
 		ShouldEnableDefaultLogsSink: nil,
 		AllowPrivilegedMode:         &allowPrivilegedMode,
 		BackendLogCollector:         nil,
+		EngineAuth:                  nil,
+		EngineTls:                   nil,
+		EnclaveTemplates:            nil,
+		Webhooks:                    nil,
 	}
 	clusterConfig, err := NewKurtosisClusterConfigFromOverrides("test", &kurtosisClusterConfigOverrides)
 	require.NoError(t, err)
@@ -70,6 +83,10 @@ func TestNewKurtosisClusterConfigKubernetesNoConfig(t *testing.T) {
 		ShouldEnableDefaultLogsSink: nil,
 		AllowPrivilegedMode:         nil,
 		BackendLogCollector:         nil,
+		EngineAuth:                  nil,
+		EngineTls:                   nil,
+		EnclaveTemplates:            nil,
+		Webhooks:                    nil,
 	}
 	_, err := NewKurtosisClusterConfigFromOverrides("test", &kurtosisClusterConfigOverrides)
 	require.Error(t, err)
@@ -86,6 +103,10 @@ func TestNewKurtosisClusterConfigNonsenseType(t *testing.T) {
 		ShouldEnableDefaultLogsSink: nil,
 		AllowPrivilegedMode:         nil,
 		BackendLogCollector:         nil,
+		EngineAuth:                  nil,
+		EngineTls:                   nil,
+		EnclaveTemplates:            nil,
+		Webhooks:                    nil,
 	}
 	_, err := NewKurtosisClusterConfigFromOverrides("test", &kurtosisClusterConfigOverrides)
 	require.Error(t, err)
@@ -111,6 +132,10 @@ func TestNewKurtosisClusterConfigKubernetesPartialConfig(t *testing.T) {
 		ShouldEnableDefaultLogsSink: nil,
 		AllowPrivilegedMode:         nil,
 		BackendLogCollector:         nil,
+		EngineAuth:                  nil,
+		EngineTls:                   nil,
+		EnclaveTemplates:            nil,
+		Webhooks:                    nil,
 	}
 	_, err := NewKurtosisClusterConfigFromOverrides("test", &kurtosisClusterConfigOverrides)
 	require.Error(t, err)
@@ -139,6 +164,10 @@ func TestNewKurtosisClusterConfigKubernetesFullConfig(t *testing.T) {
 		ShouldEnableDefaultLogsSink: nil,
 		AllowPrivilegedMode:         nil,
 		BackendLogCollector:         nil,
+		EngineAuth:                  nil,
+		EngineTls:                   nil,
+		EnclaveTemplates:            nil,
+		Webhooks:                    nil,
 	}
 	_, err := NewKurtosisClusterConfigFromOverrides("test", &kurtosisClusterConfigOverrides)
 	require.NoError(t, err)
@@ -167,6 +196,10 @@ func TestNewKurtosisClusterConfigLogsAggregatorNoConfig(t *testing.T) {
 		ShouldEnableDefaultLogsSink: nil,
 		AllowPrivilegedMode:         nil,
 		BackendLogCollector:         nil,
+		EngineAuth:                  nil,
+		EngineTls:                   nil,
+		EnclaveTemplates:            nil,
+		Webhooks:                    nil,
 	}
 	_, err := NewKurtosisClusterConfigFromOverrides("test", &kurtosisClusterConfigOverrides)
 	require.NoError(t, err)
@@ -201,6 +234,10 @@ func TestNewKurtosisClusterConfigLogsAggregatorReservedSinkId(t *testing.T) {
 		LogsCollector:               nil,
 		AllowPrivilegedMode:         nil,
 		BackendLogCollector:         nil,
+		EngineAuth:                  nil,
+		EngineTls:                   nil,
+		EnclaveTemplates:            nil,
+		Webhooks:                    nil,
 	}
 	_, err := NewKurtosisClusterConfigFromOverrides("test", &kurtosisClusterConfigOverrides)
 	require.Error(t, err)
@@ -235,6 +272,10 @@ func TestNewKurtosisClusterConfigLogsAggregatorFullConfig(t *testing.T) {
 		LogsCollector:               nil,
 		AllowPrivilegedMode:         nil,
 		BackendLogCollector:         nil,
+		EngineAuth:                  nil,
+		EngineTls:                   nil,
+		EnclaveTemplates:            nil,
+		Webhooks:                    nil,
 	}
 	_, err := NewKurtosisClusterConfigFromOverrides("test", &kurtosisClusterConfigOverrides)
 	require.NoError(t, err)
@@ -269,6 +310,10 @@ func TestNewKurtosisClusterConfigGraflokiNoConfig(t *testing.T) {
 		LogsCollector:               nil,
 		AllowPrivilegedMode:         nil,
 		BackendLogCollector:         nil,
+		EngineAuth:                  nil,
+		EngineTls:                   nil,
+		EnclaveTemplates:            nil,
+		Webhooks:                    nil,
 	}
 	_, err := NewKurtosisClusterConfigFromOverrides("test", &kurtosisClusterConfigOverrides)
 	require.NoError(t, err)
@@ -303,6 +348,10 @@ func TestNewKurtosisClusterConfigGraflokiFullConfig(t *testing.T) {
 		LogsCollector:               nil,
 		AllowPrivilegedMode:         nil,
 		BackendLogCollector:         nil,
+		EngineAuth:                  nil,
+		EngineTls:                   nil,
+		EnclaveTemplates:            nil,
+		Webhooks:                    nil,
 	}
 	actualKurtosisClusterConfig, err := NewKurtosisClusterConfigFromOverrides("test", &kurtosisClusterConfigOverrides)
 	require.NotNil(t, actualKurtosisClusterConfig.graflokiConfig)
@@ -336,6 +385,10 @@ func TestNewKurtosisClusterConfigShouldEnableDefaultLogsSink(t *testing.T) {
 		ShouldEnableDefaultLogsSink: &ShouldEnableDefaultLogsSink,
 		AllowPrivilegedMode:         nil,
 		BackendLogCollector:         nil,
+		EngineAuth:                  nil,
+		EngineTls:                   nil,
+		EnclaveTemplates:            nil,
+		Webhooks:                    nil,
 	}
 	_, err := NewKurtosisClusterConfigFromOverrides("test", &kurtosisClusterConfigOverrides)
 	require.NoError(t, err)
@@ -364,6 +417,10 @@ func TestNewKurtosisClusterConfigLogsCollectorNoConfig(t *testing.T) {
 		ShouldEnableDefaultLogsSink: nil,
 		AllowPrivilegedMode:         nil,
 		BackendLogCollector:         nil,
+		EngineAuth:                  nil,
+		EngineTls:                   nil,
+		EnclaveTemplates:            nil,
+		Webhooks:                    nil,
 	}
 	actualKurtosisClusterConfig, err := NewKurtosisClusterConfigFromOverrides("test", &kurtosisClusterConfigOverrides)
 	require.NoError(t, err)
@@ -432,6 +489,10 @@ func TestNewKurtosisClusterConfigLogsCollectorFullConfig(t *testing.T) {
 		ShouldEnableDefaultLogsSink: nil,
 		AllowPrivilegedMode:         nil,
 		BackendLogCollector:         nil,
+		EngineAuth:                  nil,
+		EngineTls:                   nil,
+		EnclaveTemplates:            nil,
+		Webhooks:                    nil,
 	}
 	actualKurtosisClusterConfig, err := NewKurtosisClusterConfigFromOverrides("test", &kurtosisClusterConfigOverrides)
 	require.NoError(t, err)
@@ -445,3 +506,177 @@ func TestNewKurtosisClusterConfigLogsCollectorFullConfig(t *testing.T) {
 	require.Equal(t, "grep", actualKurtosisClusterConfig.logsCollector.Filters[0].Name)
 	require.Equal(t, "lua", actualKurtosisClusterConfig.logsCollector.Filters[1].Name)
 }
+
+func TestNewKurtosisClusterConfigEngineAuth(t *testing.T) {
+	dockerType := KurtosisClusterType_Docker.String()
+	token := "secret-token"
+	user := "alice"
+	role := "operator"
+	kurtosisClusterConfigOverrides := v9.KurtosisClusterConfigV9{
+		Type:                        &dockerType,
+		Config:                      nil,
+		LogsAggregator:              nil,
+		LogsCollector:               nil,
+		GrafanaLokiConfig:           nil,
+		ShouldEnableDefaultLogsSink: nil,
+		AllowPrivilegedMode:         nil,
+		BackendLogCollector:         nil,
+		EngineAuth: &v9.EngineAuthConfigV9{
+			ApiTokens: []*v9.EngineApiTokenV9{
+				{Token: &token, User: &user, Role: &role},
+			},
+		},
+		EngineTls:        nil,
+		EnclaveTemplates: nil,
+		Webhooks:         nil,
+	}
+	clusterConfig, err := NewKurtosisClusterConfigFromOverrides("test", &kurtosisClusterConfigOverrides)
+	require.NoError(t, err)
+	require.Len(t, clusterConfig.GetEngineApiTokens(), 1)
+	require.Equal(t, user, clusterConfig.GetEngineApiTokens()[0].User)
+	require.Equal(t, args.ApiTokenRole_Operator, clusterConfig.GetEngineApiTokens()[0].Role)
+
+	invalidRole := "superuser"
+	kurtosisClusterConfigOverrides.EngineAuth.ApiTokens[0].Role = &invalidRole
+	_, err = NewKurtosisClusterConfigFromOverrides("test", &kurtosisClusterConfigOverrides)
+	require.Error(t, err)
+}
+
+func TestNewKurtosisClusterConfigEngineTls(t *testing.T) {
+	dockerType := KurtosisClusterType_Docker.String()
+	enabled := true
+	requireClientCertificate := true
+	kurtosisClusterConfigOverrides := v9.KurtosisClusterConfigV9{
+		Type:                        &dockerType,
+		Config:                      nil,
+		LogsAggregator:              nil,
+		LogsCollector:               nil,
+		GrafanaLokiConfig:           nil,
+		ShouldEnableDefaultLogsSink: nil,
+		AllowPrivilegedMode:         nil,
+		BackendLogCollector:         nil,
+		EngineAuth:                  nil,
+		EngineTls: &v9.EngineTlsConfigV9{
+			Enabled:                  &enabled,
+			RequireClientCertificate: &requireClientCertificate,
+			CaCertFile:               nil,
+			CaKeyFile:                nil,
+			ServerCertFile:           nil,
+			ServerKeyFile:            nil,
+			ClientCertFile:           nil,
+			ClientKeyFile:            nil,
+		},
+		EnclaveTemplates: nil,
+		Webhooks:         nil,
+	}
+	clusterConfig, err := NewKurtosisClusterConfigFromOverrides("test", &kurtosisClusterConfigOverrides)
+	require.NoError(t, err)
+	require.NotNil(t, clusterConfig.GetEngineTlsConfig())
+	require.True(t, clusterConfig.GetEngineTlsConfig().RequireClientCertificate)
+	require.False(t, clusterConfig.GetEngineTlsConfig().HasCertificateFiles())
+
+	// With client certificates required, the CLI needs a client certificate of its own
+	caCertFile := "/certs/ca.pem"
+	serverCertFile := "/certs/server.pem"
+	serverKeyFile := "/certs/server-key.pem"
+	kurtosisClusterConfigOverrides.EngineTls.CaCertFile = &caCertFile
+	kurtosisClusterConfigOverrides.EngineTls.ServerCertFile = &serverCertFile
+	kurtosisClusterConfigOverrides.EngineTls.ServerKeyFile = &serverKeyFile
+	requireClientCertificate = false
+	// The engine can't sign the API containers' certificates without the CA key
+	_, err = NewKurtosisClusterConfigFromOverrides("test", &kurtosisClusterConfigOverrides)
+	require.Error(t, err)
+
+	caKeyFile := "/certs/ca-key.pem"
+	kurtosisClusterConfigOverrides.EngineTls.CaKeyFile = &caKeyFile
+	requireClientCertificate = true
+	_, err = NewKurtosisClusterConfigFromOverrides("test", &kurtosisClusterConfigOverrides)
+	require.Error(t, err)
+
+	requireClientCertificate = false
+	clusterConfig, err = NewKurtosisClusterConfigFromOverrides("test", &kurtosisClusterConfigOverrides)
+	require.NoError(t, err)
+	require.True(t, clusterConfig.GetEngineTlsConfig().HasCertificateFiles())
+
+	enabled = false
+	clusterConfig, err = NewKurtosisClusterConfigFromOverrides("test", &kurtosisClusterConfigOverrides)
+	require.NoError(t, err)
+	require.Nil(t, clusterConfig.GetEngineTlsConfig())
+}
+
+func TestNewKurtosisClusterConfigEnclaveTemplates(t *testing.T) {
+	kubernetesType := KurtosisClusterType_Kubernetes.String()
+	kubernetesClusterName := "some-name"
+	kubernetesStorageClass := "some-storage-class"
+	kubernetesEnclaveSizeInMB := uint(5)
+	templateName := "devnet"
+	templatePackage := "github.com/ethpandaops/ethereum-package"
+	kurtosisClusterConfigOverrides := v9.KurtosisClusterConfigV9{
+		Type: &kubernetesType,
+		Config: &v9.KubernetesClusterConfigV9{
+			KubernetesClusterName:  &kubernetesClusterName,
+			StorageClass:           &kubernetesStorageClass,
+			EnclaveSizeInMegabytes: &kubernetesEnclaveSizeInMB,
+			EngineNodeName:         nil,
+			NodeSelectors:          nil,
+			Tolerations:            nil,
+		},
+		LogsAggregator:              nil,
+		LogsCollector:               nil,
+		GrafanaLokiConfig:           nil,
+		ShouldEnableDefaultLogsSink: nil,
+		AllowPrivilegedMode:         nil,
+		BackendLogCollector:         nil,
+		EngineAuth:                  nil,
+		EngineTls:                   nil,
+		EnclaveTemplates: []*v9.EnclaveTemplateConfigV9{
+			{Name: &templateName, Package: &templatePackage, Args: nil, PoolSize: nil},
+		},
+		Webhooks: nil,
+	}
+	clusterConfig, err := NewKurtosisClusterConfigFromOverrides("test", &kurtosisClusterConfigOverrides)
+	require.NoError(t, err)
+	require.Equal(t, []args.EnclaveTemplate{*args.NewEnclaveTemplate(templateName, templatePackage, "", defaultEnclaveTemplatePoolSize)}, clusterConfig.GetEnclaveTemplates())
+
+	// Two templates can't share a name
+	kurtosisClusterConfigOverrides.EnclaveTemplates = append(kurtosisClusterConfigOverrides.EnclaveTemplates, kurtosisClusterConfigOverrides.EnclaveTemplates[0])
+	_, err = NewKurtosisClusterConfigFromOverrides("test", &kurtosisClusterConfigOverrides)
+	require.Error(t, err)
+
+	// Only Kubernetes has an enclave pool to back the templates
+	dockerType := KurtosisClusterType_Docker.String()
+	kurtosisClusterConfigOverrides.Type = &dockerType
+	kurtosisClusterConfigOverrides.Config = nil
+	kurtosisClusterConfigOverrides.EnclaveTemplates = kurtosisClusterConfigOverrides.EnclaveTemplates[:1]
+	_, err = NewKurtosisClusterConfigFromOverrides("test", &kurtosisClusterConfigOverrides)
+	require.Error(t, err)
+}
+
+func TestNewKurtosisClusterConfigWebhooks(t *testing.T) {
+	dockerType := KurtosisClusterType_Docker.String()
+	webhookUrl := "https://hooks.example.com/kurtosis"
+	webhookSecret := "s3cr3t"
+	kurtosisClusterConfigOverrides := v9.KurtosisClusterConfigV9{
+		Type:                        &dockerType,
+		Config:                      nil,
+		LogsAggregator:              nil,
+		LogsCollector:               nil,
+		GrafanaLokiConfig:           nil,
+		ShouldEnableDefaultLogsSink: nil,
+		AllowPrivilegedMode:         nil,
+		BackendLogCollector:         nil,
+		EngineAuth:                  nil,
+		EngineTls:                   nil,
+		EnclaveTemplates:            nil,
+		Webhooks: []*v9.WebhookConfigV9{
+			{Url: &webhookUrl, Secret: &webhookSecret, EventTypes: []string{"ENCLAVE_CREATED"}},
+		},
+	}
+	clusterConfig, err := NewKurtosisClusterConfigFromOverrides("test", &kurtosisClusterConfigOverrides)
+	require.NoError(t, err)
+	require.Equal(t, []args.Webhook{*args.NewWebhook(webhookUrl, webhookSecret, []string{"ENCLAVE_CREATED"})}, clusterConfig.GetWebhooks())
+
+	kurtosisClusterConfigOverrides.Webhooks = []*v9.WebhookConfigV9{{Url: nil, Secret: nil, EventTypes: nil}}
+	_, err = NewKurtosisClusterConfigFromOverrides("test", &kurtosisClusterConfigOverrides)
+	require.Error(t, err)
+}
@@ -0,0 +1,51 @@
+package connection
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// The gateway proxies calls made by the CLI and the SDKs, so the credentials they sent have to reach the engine
+const authorizationMetadataKey = "authorization"
+
+func forwardAuthorizationUnaryInterceptor(
+	ctx context.Context,
+	method string,
+	req interface{},
+	reply interface{},
+	clientConn *grpc.ClientConn,
+	invoker grpc.UnaryInvoker,
+	opts ...grpc.CallOption,
+) error {
+	return invoker(withForwardedAuthorization(ctx), method, req, reply, clientConn, opts...)
+}
+
+func forwardAuthorizationStreamInterceptor(
+	ctx context.Context,
+	desc *grpc.StreamDesc,
+	clientConn *grpc.ClientConn,
+	method string,
+	streamer grpc.Streamer,
+	opts ...grpc.CallOption,
+) (grpc.ClientStream, error) {
+	return streamer(withForwardedAuthorization(ctx), desc, clientConn, method, opts...)
+}
+
+// withForwardedAuthorization copies the authorization metadata of the call the gateway received into the call it makes
+func withForwardedAuthorization(ctx context.Context) context.Context {
+	incomingMetadata, found := metadata.FromIncomingContext(ctx)
+	if !found {
+		return ctx
+	}
+	authorizationValues := incomingMetadata.Get(authorizationMetadataKey)
+	if len(authorizationValues) == 0 {
+		return ctx
+	}
+	outgoingMetadata, _ := metadata.FromOutgoingContext(ctx)
+	if len(outgoingMetadata.Get(authorizationMetadataKey)) > 0 {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, authorizationMetadataKey, authorizationValues[0])
+}
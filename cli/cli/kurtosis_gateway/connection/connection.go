@@ -7,7 +7,7 @@ import (
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/credentials"
 	k8s_rest "k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/portforward"
 	"k8s.io/client-go/transport/spdy"
@@ -47,11 +47,16 @@ type gatewayConnectionToKurtosisImpl struct {
 	remotePortNumberToPortSpecIdMap map[uint16]string
 
 	urlString string
+
+	// Credentials gRPC connections to the forwarded ports are made with
+	transportCredentials credentials.TransportCredentials
 }
 
-// newLocalPortToPodPortConnection binds a random local port to the remote port keyed with an identifier string
+// newLocalPortToPodPortConnection binds a local port to the remote port keyed with an identifier string
 // remotePortSpecs is a map keyed with an identifier string of port specs on the remote pod to forward requests to
-func newLocalPortToPodPortConnection(kubernetesRestConfig *k8s_rest.Config, podProxyEndpointUrl *url.URL, remotePortSpecs map[string]*port_spec.PortSpec) (*gatewayConnectionToKurtosisImpl, error) {
+// localPortNumbers is a map keyed with the same identifier strings of the local ports to bind; a random local port is
+// bound for the remote ports that are not in it
+func newLocalPortToPodPortConnection(kubernetesRestConfig *k8s_rest.Config, podProxyEndpointUrl *url.URL, remotePortSpecs map[string]*port_spec.PortSpec, localPortNumbers map[string]uint16, transportCredentials credentials.TransportCredentials) (*gatewayConnectionToKurtosisImpl, error) {
 	var portforwardStdOut bytes.Buffer
 	var portforwardStdErr bytes.Buffer
 	portforwardStopChannel := make(chan struct{}, 1)
@@ -70,8 +75,9 @@ func newLocalPortToPodPortConnection(kubernetesRestConfig *k8s_rest.Config, podP
 			logrus.Warnf("The port with id '%v' won't be able to be forwarded from Kubernetes, it uses protocol '%v', but Kubernetes port forwarding only support the '%v' protocol", portspecId, portSpec.GetTransportProtocol(), port_spec.TransportProtocol_TCP)
 			continue
 		}
-		// For our purposes, local-port is set to 0, meaning the host will assign us a random local port
-		portString := fmt.Sprintf("0:%v", portSpec.GetNumber())
+		// Unless a local port was requested, local-port is set to 0, meaning the host will assign us a random local port
+		localPortNumber := localPortNumbers[portspecId]
+		portString := fmt.Sprintf("%v:%v", localPortNumber, portSpec.GetNumber())
 		portStrings = append(portStrings, portString)
 		// Keep track of the portspec ID for the remote ports we connect to
 		remotePortNumberToPortSpecIdMapping[portSpec.GetNumber()] = portspecId
@@ -85,6 +91,7 @@ func newLocalPortToPodPortConnection(kubernetesRestConfig *k8s_rest.Config, podP
 		stopChannel:                     stopChannel,
 		remotePortNumberToPortSpecIdMap: remotePortNumberToPortSpecIdMapping,
 		urlString:                       podProxyEndpointUrl.String(),
+		transportCredentials:            transportCredentials,
 	}
 
 	// Connection to pod portforwarder endpoint
@@ -211,7 +218,12 @@ func (connection *gatewayConnectionToKurtosisImpl) GetGrpcClientConn() (resultCl
 	}
 	localGrpcPortNum := localPorts[grpcPortId].GetNumber()
 	localGrpcServerAddress := fmt.Sprintf("%v:%v", localHostIpStr, localGrpcPortNum)
-	grpcConnection, err := grpc.NewClient(localGrpcServerAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	grpcConnection, err := grpc.NewClient(
+		localGrpcServerAddress,
+		grpc.WithTransportCredentials(connection.transportCredentials),
+		grpc.WithUnaryInterceptor(forwardAuthorizationUnaryInterceptor),
+		grpc.WithStreamInterceptor(forwardAuthorizationStreamInterceptor),
+	)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Expected to be able to create a GRPC client connection on address '%v', but a non-nil error was returned", localGrpcServerAddress)
 	}
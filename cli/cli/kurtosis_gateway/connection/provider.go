@@ -2,16 +2,21 @@ package connection
 
 import (
 	"context"
+	"crypto/tls"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_label_key"
 	"net/url"
 
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/api/golang/util/tls_credentials"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_value_consts"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/engine"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
 	"github.com/kurtosis-tech/stacktrace"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/kubernetes"
@@ -29,14 +34,24 @@ const (
 
 var noWait *port_spec.Wait = nil
 
+// random local ports are bound to the engine and API container ports
+var randomLocalPortNumbers map[string]uint16 = nil
+
 type GatewayConnectionProvider struct {
 	config                          *restclient.Config
 	kubernetesManager               *kubernetes_manager.KubernetesManager
 	providerContext                 context.Context
 	enclaveIdToEnclaveNamespaceName map[string]string
+
+	// Credentials the engine and the API containers are reached with through the port forwards
+	transportCredentials credentials.TransportCredentials
+	// TLS config the gateway servers serve with; nil means they serve plaintext, like the engine they stand in for
+	serverTlsConfig *tls.Config
 }
 
-func NewGatewayConnectionProvider(ctx context.Context, kubernetesConfig *restclient.Config) (*GatewayConnectionProvider, error) {
+// NewGatewayConnectionProvider creates a provider of connections to the Kurtosis cluster the given Kubernetes config
+// points to. The engine TLS config is the one the engine of that cluster was started with; nil if it serves plaintext
+func NewGatewayConnectionProvider(ctx context.Context, kubernetesConfig *restclient.Config, engineTlsConfig *args.TlsConfig) (*GatewayConnectionProvider, error) {
 	// Necessary to set these fields for kubernetes portforwarder
 	kubernetesConfig.NegotiatedSerializer = scheme.Codecs.WithoutConversion()
 	kubernetesConfig.GroupVersion = &schema.GroupVersion{Group: "", Version: "v1"}
@@ -47,14 +62,50 @@ func NewGatewayConnectionProvider(ctx context.Context, kubernetesConfig *restcli
 	}
 	kubernetesManager := kubernetes_manager.NewKubernetesManager(clientSet, kubernetesConfig, emptyStorageClassName)
 
+	transportCredentials := insecure.NewCredentials()
+	var serverTlsConfig *tls.Config
+	if engineTlsConfig != nil {
+		transportCredentials, err = tls_credentials.NewClientTransportCredentials(
+			[]byte(engineTlsConfig.CaCertificate),
+			[]byte(engineTlsConfig.ClientCertificate),
+			[]byte(engineTlsConfig.ClientKey),
+		)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating the credentials to reach the engine with")
+		}
+		// The gateway stands in for the engine, so it presents the engine's certificate to its own clients
+		serverTlsConfig, err = tls_credentials.NewServerTlsConfig(
+			[]byte(engineTlsConfig.CaCertificate),
+			[]byte(engineTlsConfig.ServerCertificate),
+			[]byte(engineTlsConfig.ServerKey),
+			engineTlsConfig.RequireClientCertificate,
+		)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating the TLS config of the gateway servers")
+		}
+	}
+
 	return &GatewayConnectionProvider{
 		config:                          kubernetesConfig,
 		kubernetesManager:               kubernetesManager,
 		providerContext:                 ctx,
 		enclaveIdToEnclaveNamespaceName: map[string]string{},
+		transportCredentials:            transportCredentials,
+		serverTlsConfig:                 serverTlsConfig,
 	}, nil
 }
 
+// GetTransportCredentials returns the credentials the engine, the API containers, and the gateway servers standing in
+// for them are reached with
+func (provider *GatewayConnectionProvider) GetTransportCredentials() credentials.TransportCredentials {
+	return provider.transportCredentials
+}
+
+// GetServerTlsConfig returns the TLS config gateway servers serve with, or nil if they serve plaintext
+func (provider *GatewayConnectionProvider) GetServerTlsConfig() *tls.Config {
+	return provider.serverTlsConfig
+}
+
 func (provider *GatewayConnectionProvider) ForEngine(engine *engine.Engine) (GatewayConnectionToKurtosis, error) {
 	// Forward public GRPC ports of engine
 	enginePublicGrpcPortSpec, err := port_spec.NewPortSpec(kurtosis_context.DefaultGrpcEngineServerPortNum, port_spec.TransportProtocol_TCP, httpApplicationProtocol, noWait, emptyUrl)
@@ -68,7 +119,7 @@ func (provider *GatewayConnectionProvider) ForEngine(engine *engine.Engine) (Gat
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Expected to be able to find an api endpoint for Kubernetes portforward to engine '%v', instead a non-nil error was returned", engine.GetGUID())
 	}
-	engineConnection, err := newLocalPortToPodPortConnection(provider.config, podPortforwardEndpoint, enginePorts)
+	engineConnection, err := newLocalPortToPodPortConnection(provider.config, podPortforwardEndpoint, enginePorts, randomLocalPortNumbers, provider.transportCredentials)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Expected to be able to get a connection to engine '%v', instead a non-nil error was returned", engine.GetGUID())
 	}
@@ -91,7 +142,7 @@ func (provider *GatewayConnectionProvider) ForEnclaveApiContainer(enclaveInfo *k
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Expected to be able to get an endpoint for portforwarding to the API Container in enclave '%v', instead a non-nil error was returned", enclaveId)
 	}
-	apiContainerConnection, err := newLocalPortToPodPortConnection(provider.config, podPortforwardEndpoint, apiContainerPorts)
+	apiContainerConnection, err := newLocalPortToPodPortConnection(provider.config, podPortforwardEndpoint, apiContainerPorts, randomLocalPortNumbers, provider.transportCredentials)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Expected to be able to connect to api container in enclave '%v', instead a non-nil error was returned", enclaveId)
 	}
@@ -100,12 +151,18 @@ func (provider *GatewayConnectionProvider) ForEnclaveApiContainer(enclaveInfo *k
 }
 
 func (provider *GatewayConnectionProvider) ForUserServiceIfRunning(enclaveId string, serviceName string, servicePortSpecs map[string]*port_spec.PortSpec) (GatewayConnectionToKurtosis, error) {
+	return provider.ForUserServiceWithLocalPorts(enclaveId, serviceName, servicePortSpecs, map[string]uint16{})
+}
+
+// ForUserServiceWithLocalPorts is like ForUserServiceIfRunning, but binds the local ports in localPortNumbers (keyed by
+// port spec ID) instead of random ones
+func (provider *GatewayConnectionProvider) ForUserServiceWithLocalPorts(enclaveId string, serviceName string, servicePortSpecs map[string]*port_spec.PortSpec, localPortNumbers map[string]uint16) (GatewayConnectionToKurtosis, error) {
 	enclaveNamespaceName, err := provider.getEnclaveNamespaceNameForEnclaveId(enclaveId)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "an error occurred while getting the enclave namespace name")
 	}
 	podPortforwardEndpoint := provider.getUserServicePortForwardEndpoint(enclaveNamespaceName, serviceName)
-	userServiceConnection, err := newLocalPortToPodPortConnection(provider.config, podPortforwardEndpoint, servicePortSpecs)
+	userServiceConnection, err := newLocalPortToPodPortConnection(provider.config, podPortforwardEndpoint, servicePortSpecs, localPortNumbers, provider.transportCredentials)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Expected to be able to connect to user service with name '%v', instead a non-nil error was returned", serviceName)
 	}
@@ -61,30 +61,7 @@ func (supplier *LiveEngineClientSupplier) Start() error {
 	supplier.replaceEngineIfNecessaryBestEffort()
 
 	// Start health checking the engine
-	stopUpdaterSignalChan := make(chan interface{})
-	go func() {
-		poller := time.NewTicker(pollInterval)
-		defer poller.Stop()
-
-		for {
-			select {
-			case <-poller.C:
-				supplier.replaceEngineIfNecessaryBestEffort()
-			case <-stopUpdaterSignalChan:
-				return
-			}
-		}
-	}()
-	shouldStopUpdater := true
-	defer func() {
-		if shouldStopUpdater {
-			stopUpdaterSignalChan <- nil
-		}
-	}()
-
-	supplier.stopUpdaterSignalChan = stopUpdaterSignalChan
-
-	shouldStopUpdater = false
+	supplier.stopUpdaterSignalChan = StartPolling(supplier.replaceEngineIfNecessaryBestEffort)
 	return nil
 }
 
@@ -203,6 +180,26 @@ func (supplier *LiveEngineClientSupplier) replaceCurrentEngineInfo(newEngine *en
 	return nil
 }
 
+// StartPolling calls the given function every poll interval, until something is sent on the returned channel. Other
+// live connections to the cluster, like the port forwards to user services, poll the backend the same way
+func StartPolling(pollFunc func()) chan interface{} {
+	stopUpdaterSignalChan := make(chan interface{})
+	go func() {
+		poller := time.NewTicker(pollInterval)
+		defer poller.Stop()
+
+		for {
+			select {
+			case <-poller.C:
+				pollFunc()
+			case <-stopUpdaterSignalChan:
+				return
+			}
+		}
+	}()
+	return stopUpdaterSignalChan
+}
+
 func closeEngineInfo(info *engineInfo) {
 	if info == nil {
 		return
@@ -5,7 +5,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_gateway/connection"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_gateway/server/api_container_gateway"
-	minimal_grpc_server "github.com/kurtosis-tech/minimal-grpc-server/golang/server"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_gateway/server/common"
 	"github.com/kurtosis-tech/stacktrace"
 	"google.golang.org/grpc"
 	"time"
@@ -36,9 +36,10 @@ func RunApiContainerGatewayUntilStopped(connectionProvider *connection.GatewayCo
 	apiContainerGatewayServiceRegistrationFunc := func(grpcServer *grpc.Server) {
 		kurtosis_core_rpc_api_bindings.RegisterApiContainerServiceServer(grpcServer, apiContainerGatewayServer)
 	}
-	apiContainerGatewayGrpcServer := minimal_grpc_server.NewMinimalGRPCServer(
+	apiContainerGatewayGrpcServer := common.NewGatewayGrpcServer(
 		gatewayPort,
 		grpcServerStopGracePeriod,
+		connectionProvider.GetServerTlsConfig(),
 		[]func(*grpc.Server){
 			apiContainerGatewayServiceRegistrationFunc,
 		},
@@ -0,0 +1,62 @@
+package engine_gateway
+
+import (
+	"net"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_gateway/connection"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_gateway/live_engine_client_supplier"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_gateway/server/engine_gateway"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+const (
+	// Lets the OS pick a free port, so that several background gateways can run side by side
+	backgroundGatewayListenAddress = localHostIpStr + ":0"
+)
+
+// RunEngineGatewayInBackground starts an engine gateway on a random local port, and returns the address to reach it
+// along with a function that stops it. Unlike RunEngineGatewayUntilInterrupted, it doesn't block, which lets the CLI
+// talk to the engine of a Kubernetes cluster other than the current one for the duration of a single command
+func RunEngineGatewayInBackground(kurtosisBackend backend_interface.KurtosisBackend, connectionProvider *connection.GatewayConnectionProvider) (string, func(), error) {
+	engineClientSupplier := live_engine_client_supplier.NewLiveEngineClientSupplier(kurtosisBackend, connectionProvider)
+	if err := engineClientSupplier.Start(); err != nil {
+		return "", nil, stacktrace.Propagate(err, "Expected to be able to start supplier for live Kurtosis engine clients, instead a non-nil error was returned")
+	}
+	shouldStopEngineClientSupplier := true
+	defer func() {
+		if shouldStopEngineClientSupplier {
+			engineClientSupplier.Stop()
+		}
+	}()
+
+	listener, err := net.Listen("tcp", backgroundGatewayListenAddress)
+	if err != nil {
+		return "", nil, stacktrace.Propagate(err, "An error occurred listening on '%v' for the engine gateway", backgroundGatewayListenAddress)
+	}
+
+	engineGatewayServer, gatewayCloseFunc := engine_gateway.NewEngineGatewayServiceServer(connectionProvider, engineClientSupplier)
+	grpcServerOpts := []grpc.ServerOption{}
+	if serverTlsConfig := connectionProvider.GetServerTlsConfig(); serverTlsConfig != nil {
+		grpcServerOpts = append(grpcServerOpts, grpc.Creds(credentials.NewTLS(serverTlsConfig)))
+	}
+	grpcServer := grpc.NewServer(grpcServerOpts...)
+	kurtosis_engine_rpc_api_bindings.RegisterEngineServiceServer(grpcServer, engineGatewayServer)
+	go func() {
+		if err := grpcServer.Serve(listener); err != nil {
+			logrus.Debugf("The background engine gateway server exited with an error:\n%v", err)
+		}
+	}()
+
+	stopFunc := func() {
+		grpcServer.Stop()
+		gatewayCloseFunc()
+		engineClientSupplier.Stop()
+	}
+	shouldStopEngineClientSupplier = false
+	return listener.Addr().String(), stopFunc, nil
+}
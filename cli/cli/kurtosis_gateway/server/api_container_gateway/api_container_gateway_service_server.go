@@ -147,6 +147,46 @@ func (service *ApiContainerGatewayServiceServer) ExecCommand(ctx context.Context
 	return remoteApiContainerResponse, nil
 }
 
+func (service *ApiContainerGatewayServiceServer) StreamExecCommand(server kurtosis_core_rpc_api_bindings.ApiContainerService_StreamExecCommandServer) error {
+	client, err := service.remoteApiContainerClient.StreamExecCommand(server.Context())
+	if err != nil {
+		return stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+
+	// The inputs (stdin, terminal sizes) are forwarded in the background while the outputs are forwarded below
+	go func() {
+		for {
+			input, readErr := server.Recv()
+			if readErr != nil {
+				if readErr != io.EOF {
+					logrus.Debugf("Error reading exec command input on gateway:\n%v", readErr)
+				}
+				if closeErr := client.CloseSend(); closeErr != nil {
+					logrus.Debugf("Error closing the exec command input stream on gateway:\n%v", closeErr)
+				}
+				return
+			}
+			if writeErr := client.Send(input); writeErr != nil {
+				logrus.Debugf("Error forwarding exec command input on gateway:\n%v", writeErr)
+				return
+			}
+		}
+	}()
+
+	for {
+		output, readErr := client.Recv()
+		if readErr == io.EOF {
+			return nil
+		}
+		if readErr != nil {
+			return stacktrace.Propagate(readErr, "Error reading exec command output from Kurtosis core stream")
+		}
+		if writeErr := server.Send(output); writeErr != nil {
+			return stacktrace.Propagate(writeErr, "Received an exec command output but failed forwarding it back to the user")
+		}
+	}
+}
+
 func (service *ApiContainerGatewayServiceServer) WaitForHttpGetEndpointAvailability(ctx context.Context, args *kurtosis_core_rpc_api_bindings.WaitForHttpGetEndpointAvailabilityArgs) (*emptypb.Empty, error) {
 	remoteApiContainerResponse, err := service.remoteApiContainerClient.WaitForHttpGetEndpointAvailability(ctx, args)
 	if err != nil {
@@ -175,6 +215,22 @@ func (service *ApiContainerGatewayServiceServer) UploadFilesArtifact(server kurt
 	return nil
 }
 
+func (service *ApiContainerGatewayServiceServer) GetMissingFilesArtifactBlobs(ctx context.Context, args *kurtosis_core_rpc_api_bindings.GetMissingFilesArtifactBlobsArgs) (*kurtosis_core_rpc_api_bindings.GetMissingFilesArtifactBlobsResponse, error) {
+	remoteApiContainerResponse, err := service.remoteApiContainerClient.GetMissingFilesArtifactBlobs(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	return remoteApiContainerResponse, nil
+}
+
+func (service *ApiContainerGatewayServiceServer) GetFilesArtifactHistory(ctx context.Context, args *kurtosis_core_rpc_api_bindings.GetFilesArtifactHistoryArgs) (*kurtosis_core_rpc_api_bindings.GetFilesArtifactHistoryResponse, error) {
+	remoteApiContainerResponse, err := service.remoteApiContainerClient.GetFilesArtifactHistory(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	return remoteApiContainerResponse, nil
+}
+
 func (service *ApiContainerGatewayServiceServer) StoreWebFilesArtifact(ctx context.Context, args *kurtosis_core_rpc_api_bindings.StoreWebFilesArtifactArgs) (*kurtosis_core_rpc_api_bindings.StoreWebFilesArtifactResponse, error) {
 	remoteApiContainerResponse, err := service.remoteApiContainerClient.StoreWebFilesArtifact(ctx, args)
 	if err != nil {
@@ -230,6 +286,33 @@ func (service *ApiContainerGatewayServiceServer) GetStarlarkRun(ctx context.Cont
 	return remoteApiContainerResponse, nil
 }
 
+func (service *ApiContainerGatewayServiceServer) ListStarlarkRunRecords(ctx context.Context, args *emptypb.Empty) (*kurtosis_core_rpc_api_bindings.ListStarlarkRunRecordsResponse, error) {
+	remoteApiContainerResponse, err := service.remoteApiContainerClient.ListStarlarkRunRecords(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	return remoteApiContainerResponse, nil
+}
+
+func (service *ApiContainerGatewayServiceServer) GetStarlarkRunRecord(ctx context.Context, args *kurtosis_core_rpc_api_bindings.GetStarlarkRunRecordArgs) (*kurtosis_core_rpc_api_bindings.StarlarkRunRecord, error) {
+	remoteApiContainerResponse, err := service.remoteApiContainerClient.GetStarlarkRunRecord(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	return remoteApiContainerResponse, nil
+}
+
+func (service *ApiContainerGatewayServiceServer) GetStarlarkRunRecordOutput(args *kurtosis_core_rpc_api_bindings.GetStarlarkRunRecordArgs, streamToWriteTo kurtosis_core_rpc_api_bindings.ApiContainerService_GetStarlarkRunRecordOutputServer) error {
+	streamToReadFrom, err := service.remoteApiContainerClient.GetStarlarkRunRecordOutput(streamToWriteTo.Context(), args)
+	if err != nil {
+		return stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	if err := common.ForwardKurtosisExecutionStream[kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine](streamToReadFrom, streamToWriteTo); err != nil {
+		return stacktrace.Propagate(err, "Error forwarding the output of Starlark run '%v' from Kurtosis core back to the user", args.GetRunUuid())
+	}
+	return nil
+}
+
 func (service *ApiContainerGatewayServiceServer) GetStarlarkScriptPlanYaml(ctx context.Context, args *kurtosis_core_rpc_api_bindings.StarlarkScriptPlanYamlArgs) (*kurtosis_core_rpc_api_bindings.PlanYaml, error) {
 	remoteApiContainerResponse, err := service.remoteApiContainerClient.GetStarlarkScriptPlanYaml(ctx, args)
 	if err != nil {
@@ -246,6 +329,110 @@ func (service *ApiContainerGatewayServiceServer) GetStarlarkPackagePlanYaml(ctx
 	return remoteApiContainerResponse, nil
 }
 
+func (service *ApiContainerGatewayServiceServer) SetSecret(ctx context.Context, args *kurtosis_core_rpc_api_bindings.SetSecretArgs) (*emptypb.Empty, error) {
+	remoteApiContainerResponse, err := service.remoteApiContainerClient.SetSecret(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	return remoteApiContainerResponse, nil
+}
+
+func (service *ApiContainerGatewayServiceServer) ListSecrets(ctx context.Context, args *emptypb.Empty) (*kurtosis_core_rpc_api_bindings.ListSecretsResponse, error) {
+	remoteApiContainerResponse, err := service.remoteApiContainerClient.ListSecrets(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	return remoteApiContainerResponse, nil
+}
+
+func (service *ApiContainerGatewayServiceServer) RemoveSecret(ctx context.Context, args *kurtosis_core_rpc_api_bindings.RemoveSecretArgs) (*emptypb.Empty, error) {
+	remoteApiContainerResponse, err := service.remoteApiContainerClient.RemoveSecret(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	return remoteApiContainerResponse, nil
+}
+
+func (service *ApiContainerGatewayServiceServer) ResumeServices(ctx context.Context, args *emptypb.Empty) (*kurtosis_core_rpc_api_bindings.ResumeServicesResponse, error) {
+	remoteApiContainerResponse, err := service.remoteApiContainerClient.ResumeServices(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	return remoteApiContainerResponse, nil
+}
+
+func (service *ApiContainerGatewayServiceServer) GetSecretsRecipientPublicKey(ctx context.Context, args *emptypb.Empty) (*kurtosis_core_rpc_api_bindings.GetSecretsRecipientPublicKeyResponse, error) {
+	remoteApiContainerResponse, err := service.remoteApiContainerClient.GetSecretsRecipientPublicKey(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	return remoteApiContainerResponse, nil
+}
+
+func (service *ApiContainerGatewayServiceServer) ExportEnclavePlan(ctx context.Context, args *kurtosis_core_rpc_api_bindings.ExportEnclavePlanArgs) (*kurtosis_core_rpc_api_bindings.ExportEnclavePlanResponse, error) {
+	remoteApiContainerResponse, err := service.remoteApiContainerClient.ExportEnclavePlan(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	return remoteApiContainerResponse, nil
+}
+
+func (service *ApiContainerGatewayServiceServer) ReplayEnclavePlan(args *kurtosis_core_rpc_api_bindings.ReplayEnclavePlanArgs, streamToWriteTo kurtosis_core_rpc_api_bindings.ApiContainerService_ReplayEnclavePlanServer) error {
+	logrus.Debug("Replaying enclave plan")
+	streamToReadFrom, err := service.remoteApiContainerClient.ReplayEnclavePlan(streamToWriteTo.Context(), args)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred starting the replay of the enclave plan")
+	}
+	if err := common.ForwardKurtosisExecutionStream[kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine](streamToReadFrom, streamToWriteTo); err != nil {
+		return stacktrace.Propagate(err, "Error forwarding stream from Kurtosis core back to the user")
+	}
+	return nil
+}
+
+func (service *ApiContainerGatewayServiceServer) WatchEvents(args *kurtosis_core_rpc_api_bindings.WatchEventsArgs, streamToWriteTo kurtosis_core_rpc_api_bindings.ApiContainerService_WatchEventsServer) error {
+	logrus.Debug("Watching enclave events")
+	streamToReadFrom, err := service.remoteApiContainerClient.WatchEvents(streamToWriteTo.Context(), args)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred starting to watch the enclave events")
+	}
+	if err := common.ForwardKurtosisExecutionStream[kurtosis_core_rpc_api_bindings.ApiContainerEvent](streamToReadFrom, streamToWriteTo); err != nil {
+		return stacktrace.Propagate(err, "Error forwarding the enclave events from Kurtosis core back to the user")
+	}
+	return nil
+}
+
+func (service *ApiContainerGatewayServiceServer) GetServiceDependencies(ctx context.Context, args *emptypb.Empty) (*kurtosis_core_rpc_api_bindings.GetServiceDependenciesResponse, error) {
+	remoteApiContainerResponse, err := service.remoteApiContainerClient.GetServiceDependencies(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	return remoteApiContainerResponse, nil
+}
+
+func (service *ApiContainerGatewayServiceServer) WaitForServiceReadiness(ctx context.Context, args *kurtosis_core_rpc_api_bindings.WaitForServiceReadinessArgs) (*emptypb.Empty, error) {
+	remoteApiContainerResponse, err := service.remoteApiContainerClient.WaitForServiceReadiness(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	return remoteApiContainerResponse, nil
+}
+
+func (service *ApiContainerGatewayServiceServer) RecordServicesToResume(ctx context.Context, args *emptypb.Empty) (*emptypb.Empty, error) {
+	remoteApiContainerResponse, err := service.remoteApiContainerClient.RecordServicesToResume(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	return remoteApiContainerResponse, nil
+}
+
+func (service *ApiContainerGatewayServiceServer) GetLastActivityTime(ctx context.Context, args *emptypb.Empty) (*kurtosis_core_rpc_api_bindings.GetLastActivityTimeResponse, error) {
+	remoteApiContainerResponse, err := service.remoteApiContainerClient.GetLastActivityTime(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	return remoteApiContainerResponse, nil
+}
+
 // ====================================================================================================
 //
 //	Private helper methods
@@ -0,0 +1,25 @@
+package common
+
+import (
+	"crypto/tls"
+	"time"
+
+	minimal_grpc_server "github.com/kurtosis-tech/minimal-grpc-server/golang/server"
+	"google.golang.org/grpc"
+)
+
+// NewGatewayGrpcServer creates a server that serves TLS with the given config, or plaintext if it's nil
+func NewGatewayGrpcServer(listenPort uint16, stopGracePeriod time.Duration, serverTlsConfig *tls.Config, serviceRegistrationFuncs []func(*grpc.Server)) *minimal_grpc_server.MinimalGRPCServer {
+	if serverTlsConfig == nil {
+		return minimal_grpc_server.NewMinimalGRPCServer(listenPort, stopGracePeriod, serviceRegistrationFuncs)
+	}
+	// The minimal gRPC server only asks for client certificates when it's given a pool to verify them against, which
+	// the TLS config only has when they're required
+	return minimal_grpc_server.NewMinimalHttpsGRPCServer(
+		listenPort,
+		stopGracePeriod,
+		serverTlsConfig.ClientCAs,
+		&serverTlsConfig.Certificates[0],
+		serviceRegistrationFuncs,
+	)
+}
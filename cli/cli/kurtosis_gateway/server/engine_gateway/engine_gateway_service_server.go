@@ -16,7 +16,9 @@ import (
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	restclient "k8s.io/client-go/rest"
 )
@@ -212,6 +214,66 @@ func (service *EngineGatewayServiceServer) StopEnclave(ctx context.Context, args
 	return &emptypb.Empty{}, nil
 }
 
+func (service *EngineGatewayServiceServer) StartEnclave(ctx context.Context, args *kurtosis_engine_rpc_api_bindings.StartEnclaveArgs) (*kurtosis_engine_rpc_api_bindings.StartEnclaveResponse, error) {
+	remoteEngineClient, err := service.engineClientSupplier.GetEngineClient()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Expected to be able to get a client for a live Kurtosis engine, instead a non nil error was returned")
+	}
+	remoteEngineResponse, err := remoteEngineClient.StartEnclave(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred calling remote engine to start enclave '%v'", args.EnclaveIdentifier)
+	}
+	if remoteEngineResponse.EnclaveInfo == nil {
+		return nil, stacktrace.NewError("Expected the response from the remote engine to have info on the enclave '%v', instead no enclave information was found.", args.EnclaveIdentifier)
+	}
+	startedEnclaveInfo := remoteEngineResponse.GetEnclaveInfo()
+	startedEnclaveId := startedEnclaveInfo.GetEnclaveUuid()
+
+	// A gateway left over from before the enclave was stopped points to the old API container, so it's replaced
+	service.idempotentKillRunningGatewayForEnclaveId(startedEnclaveId)
+	runningApiContainerGateway, err := service.startRunningGatewayForEnclave(startedEnclaveInfo)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Expected to be able to start a local gateway for enclave '%v', instead a non-nil err was returned", startedEnclaveId)
+	}
+	remoteEngineResponse.EnclaveInfo.ApiContainerHostMachineInfo = runningApiContainerGateway.hostMachineInfo
+
+	return remoteEngineResponse, nil
+}
+
+func (service *EngineGatewayServiceServer) ExtendEnclave(ctx context.Context, args *kurtosis_engine_rpc_api_bindings.ExtendEnclaveArgs) (*kurtosis_engine_rpc_api_bindings.ExtendEnclaveResponse, error) {
+	remoteEngineClient, err := service.engineClientSupplier.GetEngineClient()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Expected to be able to get a client for a live Kurtosis engine, instead a non nil error was returned")
+	}
+	remoteEngineResponse, err := remoteEngineClient.ExtendEnclave(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred calling remote engine to extend enclave '%v'", args.EnclaveIdentifier)
+	}
+	extendedEnclaveInfo := remoteEngineResponse.GetEnclaveInfo()
+	if runningApiContainerGateway, isRunning := service.enclaveIdToRunningGatewayMap[extendedEnclaveInfo.GetEnclaveUuid()]; isRunning {
+		extendedEnclaveInfo.ApiContainerHostMachineInfo = runningApiContainerGateway.hostMachineInfo
+	}
+
+	return remoteEngineResponse, nil
+}
+
+func (service *EngineGatewayServiceServer) CloneEnclave(ctx context.Context, args *kurtosis_engine_rpc_api_bindings.CloneEnclaveArgs) (*kurtosis_engine_rpc_api_bindings.CloneEnclaveResponse, error) {
+	remoteEngineClient, err := service.engineClientSupplier.GetEngineClient()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Expected to be able to get a client for a live Kurtosis engine, instead a non nil error was returned")
+	}
+	remoteEngineResponse, err := remoteEngineClient.CloneEnclave(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred calling remote engine to clone enclave '%v'", args.GetSourceEnclaveIdentifier())
+	}
+	clonedEnclaveInfo := remoteEngineResponse.GetEnclaveInfo()
+	if runningApiContainerGateway, isRunning := service.enclaveIdToRunningGatewayMap[clonedEnclaveInfo.GetEnclaveUuid()]; isRunning {
+		clonedEnclaveInfo.ApiContainerHostMachineInfo = runningApiContainerGateway.hostMachineInfo
+	}
+
+	return remoteEngineResponse, nil
+}
+
 func (service *EngineGatewayServiceServer) DestroyEnclave(ctx context.Context, args *kurtosis_engine_rpc_api_bindings.DestroyEnclaveArgs) (*emptypb.Empty, error) {
 	remoteEngineClient, err := service.engineClientSupplier.GetEngineClient()
 	if err != nil {
@@ -273,6 +335,59 @@ func (service *EngineGatewayServiceServer) GetServiceLogs(
 	return nil
 }
 
+func (service *EngineGatewayServiceServer) WatchEvents(
+	args *kurtosis_engine_rpc_api_bindings.WatchEventsArgs,
+	streamToWriteTo kurtosis_engine_rpc_api_bindings.EngineService_WatchEventsServer,
+) error {
+	remoteEngineClient, err := service.engineClientSupplier.GetEngineClient()
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected to be able to get a client for a live Kurtosis engine, instead a non nil error was returned")
+	}
+	streamToReadFrom, err := remoteEngineClient.WatchEvents(streamToWriteTo.Context(), args)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred starting to watch the engine events")
+	}
+	if err := common.ForwardKurtosisExecutionStream[kurtosis_engine_rpc_api_bindings.EngineEvent](streamToReadFrom, streamToWriteTo); err != nil {
+		return stacktrace.Propagate(err, "Error forwarding the engine events from Kurtosis engine back to the user")
+	}
+	return nil
+}
+
+func (service *EngineGatewayServiceServer) AddSchedule(ctx context.Context, args *kurtosis_engine_rpc_api_bindings.AddScheduleArgs) (*kurtosis_engine_rpc_api_bindings.AddScheduleResponse, error) {
+	remoteEngineClient, err := service.engineClientSupplier.GetEngineClient()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Expected to be able to get a client for a live Kurtosis engine, instead a non nil error was returned")
+	}
+	remoteEngineResponse, err := remoteEngineClient.AddSchedule(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred calling remote engine to add a schedule for package '%v'", args.GetPackageId())
+	}
+	return remoteEngineResponse, nil
+}
+
+func (service *EngineGatewayServiceServer) GetSchedules(ctx context.Context, emptyArgs *emptypb.Empty) (*kurtosis_engine_rpc_api_bindings.GetSchedulesResponse, error) {
+	remoteEngineClient, err := service.engineClientSupplier.GetEngineClient()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Expected to be able to get a client for a live Kurtosis engine, instead a non nil error was returned")
+	}
+	remoteEngineResponse, err := remoteEngineClient.GetSchedules(ctx, emptyArgs)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the schedules through the remote engine")
+	}
+	return remoteEngineResponse, nil
+}
+
+func (service *EngineGatewayServiceServer) RemoveSchedule(ctx context.Context, args *kurtosis_engine_rpc_api_bindings.RemoveScheduleArgs) (*emptypb.Empty, error) {
+	remoteEngineClient, err := service.engineClientSupplier.GetEngineClient()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Expected to be able to get a client for a live Kurtosis engine, instead a non nil error was returned")
+	}
+	if _, err := remoteEngineClient.RemoveSchedule(ctx, args); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred calling remote engine to remove schedule '%v'", args.GetScheduleIdentifier())
+	}
+	return &emptypb.Empty{}, nil
+}
+
 // Private functions for managing our running enclave api container gateways
 func (service *EngineGatewayServiceServer) startRunningGatewayForEnclave(enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo) (*runningApiContainerGateway, error) {
 	service.mutex.Lock()
@@ -313,7 +428,7 @@ func (service *EngineGatewayServiceServer) startRunningGatewayForEnclave(enclave
 		}
 	}()
 	// Need to wait for the GRPC server spun up in the goFunc to be ready
-	if err := waitForGatewayReady(apiContainerHostMachineInfo); err != nil {
+	if err := waitForGatewayReady(apiContainerHostMachineInfo, service.connectionProvider.GetTransportCredentials()); err != nil {
 		logrus.Errorf("Expected Gateway to be reachable, instead an error was returned:\n%v", err)
 	}
 
@@ -336,11 +451,11 @@ func (service *EngineGatewayServiceServer) startRunningGatewayForEnclave(enclave
 }
 
 // Calls `GetServices` and waits for the gateway to be ready
-func waitForGatewayReady(apiContainerHostMachineInfo *kurtosis_engine_rpc_api_bindings.EnclaveAPIContainerHostMachineInfo) error {
+func waitForGatewayReady(apiContainerHostMachineInfo *kurtosis_engine_rpc_api_bindings.EnclaveAPIContainerHostMachineInfo, transportCredentials credentials.TransportCredentials) error {
 	backgroundCtx := context.Background()
 	gatewayAddress := fmt.Sprintf("%v:%v", apiContainerHostMachineInfo.IpOnHostMachine, apiContainerHostMachineInfo.GrpcPortOnHostMachine)
 
-	conn, err := grpc.NewClient(gatewayAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.NewClient(gatewayAddress, grpc.WithTransportCredentials(transportCredentials))
 	if err != nil {
 		return stacktrace.Propagate(err, "Expected to be dial in to API container running at address '%v', instead a non-nil error was returned", gatewayAddress)
 	}
@@ -353,7 +468,9 @@ func waitForGatewayReady(apiContainerHostMachineInfo *kurtosis_engine_rpc_api_bi
 	defer cancelFunc()
 	getServicesHealthCheckParams := &kurtosis_core_rpc_api_bindings.GetServicesArgs{ServiceIdentifiers: nil}
 	_, err = apiContainerClient.GetServices(ctxWithTimeout, getServicesHealthCheckParams, grpc.WaitForReady(waitForGatewayGrpcReady))
-	if err != nil {
+	// The health check carries no API token, so an API container that authenticates its callers answering that it's
+	// missing means the gateway is up
+	if err != nil && status.Code(err) != codes.Unauthenticated {
 		return stacktrace.Propagate(err, "Expected to be to call `GetServices` and wait for server to be ready, instead a non-nil error was returned")
 	}
 
@@ -2,6 +2,7 @@ package connect_server
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"log"
 	"net/http"
@@ -26,6 +27,7 @@ type ConnectServer struct {
 	path            string
 	handler         http.Handler
 	stopGracePeriod time.Duration // How long we'll give the server to stop after asking nicely before we kill it
+	tlsConfig       *tls.Config   // If nil, the server serves plaintext HTTP/2 (h2c)
 }
 
 func NewConnectServer(listenPort uint16, stopGracePeriod time.Duration, handler http.Handler, path string) *ConnectServer {
@@ -34,6 +36,17 @@ func NewConnectServer(listenPort uint16, stopGracePeriod time.Duration, handler
 		stopGracePeriod: stopGracePeriod,
 		handler:         handler,
 		path:            path,
+		tlsConfig:       nil,
+	}
+}
+
+func NewConnectServerWithTls(listenPort uint16, stopGracePeriod time.Duration, handler http.Handler, path string, tlsConfig *tls.Config) *ConnectServer {
+	return &ConnectServer{
+		listenPort:      listenPort,
+		stopGracePeriod: stopGracePeriod,
+		handler:         handler,
+		path:            path,
+		tlsConfig:       tlsConfig,
 	}
 }
 func (server *ConnectServer) RunServerUntilInterrupted() error {
@@ -66,13 +79,21 @@ func (server *ConnectServer) RunServerUntilStopped(
 
 	// nolint:exhaustruct
 	httpServer := http.Server{
-		Addr:     fmt.Sprintf(":%v", server.listenPort),
-		Handler:  cors.Handler(h2c.NewHandler(mux, &http2.Server{})),
-		ErrorLog: log.New(logrus.StandardLogger().Out, ConnectHTTPServerLogPrefix, log.Ldate|log.Ltime|log.Lshortfile),
+		Addr:      fmt.Sprintf(":%v", server.listenPort),
+		Handler:   cors.Handler(h2c.NewHandler(mux, &http2.Server{})),
+		ErrorLog:  log.New(logrus.StandardLogger().Out, ConnectHTTPServerLogPrefix, log.Ldate|log.Ltime|log.Lshortfile),
+		TLSConfig: server.tlsConfig,
 	}
 
 	go func() {
-		if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		var err error
+		if server.tlsConfig != nil {
+			// The certificate and key are already in the TLS config
+			err = httpServer.ListenAndServeTLS("", "")
+		} else {
+			err = httpServer.ListenAndServe()
+		}
+		if err != nil && err != http.ErrServerClosed {
 			logrus.Infof("Error occurred while starting the server, error: %+v", err)
 		}
 	}()
@@ -32,6 +32,13 @@ const (
 
 	GitHubAuthStorageDirPath   = "/kurtosis-data/github-auth/"
 	DockerConfigStorageDirPath = "/root/.docker/"
+	// Where the engine keeps the data that must survive engine restarts, like the scheduled runs
+	EngineDataStorageDirPath = "/kurtosis-data/engine/"
+	// Where the API containers find the blobs of the files artifacts, shared by all the enclaves so that the same
+	// content is stored once
+	FilesArtifactsBlobStorageDirPath = "/kurtosis-data/files-artifacts-blobs/"
+	// The directory, inside the files artifacts blob storage, with a directory per enclave listing the blobs it uses
+	FilesArtifactsBlobReferencesDirname = "references"
 
 	EmptyApplicationURL = ""
 )
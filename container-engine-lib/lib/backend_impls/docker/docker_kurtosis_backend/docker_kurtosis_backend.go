@@ -7,6 +7,7 @@ import (
 
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_build_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_registry_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/interactive_exec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/nix_build_spec"
 
 	"github.com/sirupsen/logrus"
@@ -332,6 +333,16 @@ func (backend *DockerKurtosisBackend) RunUserServiceExecCommandWithStreamedOutpu
 	return user_service_functions.RunUserServiceExecCommandWithStreamedOutput(ctx, enclaveUuid, serviceUuid, cmd, backend.dockerManager)
 }
 
+func (backend *DockerKurtosisBackend) RunUserServiceInteractiveExecCommand(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	cmd []string,
+	execStreams *interactive_exec.InteractiveExecStreams,
+) (int32, error) {
+	return user_service_functions.RunUserServiceInteractiveExecCommand(ctx, enclaveUuid, serviceUuid, cmd, execStreams, backend.dockerManager)
+}
+
 func (backend *DockerKurtosisBackend) GetShellOnUserService(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID) error {
 	return user_service_functions.GetShellOnUserService(ctx, enclaveUuid, serviceUuid, backend.dockerManager)
 }
@@ -347,6 +358,16 @@ func (backend *DockerKurtosisBackend) CopyFilesFromUserService(
 	return user_service_functions.CopyFilesFromUserService(ctx, enclaveUuid, serviceUuid, srcPathOnContainer, output, backend.dockerManager)
 }
 
+func (backend *DockerKurtosisBackend) CopyFilesToUserService(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	dstDirpathOnService string,
+	tarContent io.Reader,
+) error {
+	return user_service_functions.CopyFilesToUserService(ctx, enclaveUuid, serviceUuid, dstDirpathOnService, tarContent, backend.dockerManager)
+}
+
 func (backend *DockerKurtosisBackend) StopUserServices(
 	ctx context.Context,
 	enclaveUuid enclave.EnclaveUUID,
@@ -647,6 +668,25 @@ func (backend *DockerKurtosisBackend) getGitHubAuthStorageVolume(ctx context.Con
 	return volume.Name, nil
 }
 
+// Guaranteed to either return a files artifacts blob storage volume name or throw an error
+func (backend *DockerKurtosisBackend) getFilesArtifactsBlobStorageVolume(ctx context.Context) (string, error) {
+	volumeSearchLabels := map[string]string{
+		docker_label_key.VolumeTypeDockerLabelKey.GetString(): label_value_consts.FilesArtifactsBlobStorageVolumeTypeDockerLabelValue.GetString(),
+	}
+	foundVolumes, err := backend.dockerManager.GetVolumesByLabels(ctx, volumeSearchLabels)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting files artifacts blob storage volumes matching labels '%+v'", volumeSearchLabels)
+	}
+	if len(foundVolumes) > 1 {
+		return "", stacktrace.NewError("Found multiple files artifacts blob storage volumes. This should never happen")
+	}
+	if len(foundVolumes) == 0 {
+		return "", stacktrace.NewError("No files artifacts blob storage volume found.")
+	}
+	volume := foundVolumes[0]
+	return volume.Name, nil
+}
+
 // Guaranteed to either return a Docker config storage volume name or throw an error
 func (backend *DockerKurtosisBackend) getDockerConfigStorageVolume(ctx context.Context) (string, error) {
 	volumeSearchLabels := map[string]string{
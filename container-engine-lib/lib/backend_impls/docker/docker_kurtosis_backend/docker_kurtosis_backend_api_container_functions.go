@@ -88,6 +88,11 @@ func (backend *DockerKurtosisBackend) CreateAPIContainer(
 		return nil, stacktrace.Propagate(err, "An error occurred getting the Docker config storage volume name.")
 	}
 
+	filesArtifactsBlobStorageVolumeName, err := backend.getFilesArtifactsBlobStorageVolume(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the files artifacts blob storage volume name.")
+	}
+
 	// Get the Docker network ID where we'll start the new API container
 	enclaveNetwork, err := backend.getEnclaveNetworkByEnclaveUuid(ctx, enclaveUuid)
 	if err != nil {
@@ -206,9 +211,10 @@ func (backend *DockerKurtosisBackend) CreateAPIContainer(
 	}
 
 	volumeMounts := map[string]string{
-		enclaveDataVolumeName:         enclaveDataVolumeDirpath,
-		githubAuthStorageVolumeName:   consts.GitHubAuthStorageDirPath,
-		dockerConfigStorageVolumeName: consts.DockerConfigStorageDirPath,
+		enclaveDataVolumeName:               enclaveDataVolumeDirpath,
+		githubAuthStorageVolumeName:         consts.GitHubAuthStorageDirPath,
+		dockerConfigStorageVolumeName:       consts.DockerConfigStorageDirPath,
+		filesArtifactsBlobStorageVolumeName: consts.FilesArtifactsBlobStorageDirPath,
 	}
 
 	labelStrs := map[string]string{}
@@ -2,6 +2,10 @@ package docker_kurtosis_backend
 
 import (
 	"context"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
 	"strings"
 	"time"
 
@@ -10,12 +14,18 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/logs_aggregator_functions"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/logs_aggregator_functions/implementations/vector"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/shared_helpers"
+	user_service_functions "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/user_services_functions"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager/types"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_operation_parallelizer"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/docker_label_key"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/docker_label_value"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/label_value_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/compute_resources"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 )
@@ -26,6 +36,12 @@ const (
 	shouldFetchStoppedContainersWhenDisconnectingFromEnclaveNetworks = false
 
 	serializedArgs = "SERIALIZED_ARGS"
+
+	// We use this image and version because we already are using this in other projects so there is a high probability
+	// that the image is in the local machine's cache
+	filesArtifactsBlobReleaserImage               = "alpine:3.17"
+	filesArtifactsBlobReleaserContainerNamePrefix = "kurtosis-files-artifacts-blob-releaser"
+	filesArtifactsBlobReleaserSuccessExitCode     = 0
 )
 
 // TODO: MIGRATE THIS FOLDER TO USE STRUCTURE OF USER_SERVICE_FUNCTIONS MODULE
@@ -146,7 +162,7 @@ func (backend *DockerKurtosisBackend) CreateEnclave(ctx context.Context, enclave
 	}()
 
 	// TODO: return production mode for create enclave request as well
-	newEnclave := enclave.NewEnclave(enclaveUuid, enclaveName, enclave.EnclaveStatus_Empty, &creationTime, false)
+	newEnclave := enclave.NewEnclave(enclaveUuid, enclaveName, enclave.EnclaveStatus_Empty, &creationTime, false, nil, nil, "", nil, nil)
 
 	if err := backend.ConnectReverseProxyToNetwork(ctx, networkId); err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred connecting the reverse proxy to the enclave network with ID '%v'", networkId)
@@ -180,6 +196,31 @@ func (backend *DockerKurtosisBackend) GetEnclaves(
 		return nil, stacktrace.Propagate(err, "An error occurred getting enclave networks matching filters '%+v'", filters)
 	}
 
+	enclaveLifetimes, err := backend.getEnclaveLifetimes(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the lifetimes of the enclaves")
+	}
+
+	enclaveResourceQuotas, err := backend.getEnclaveResourceQuotas(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the resource quotas of the enclaves")
+	}
+
+	enclaveOwners, err := backend.getEnclaveOwners(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the owners of the enclaves")
+	}
+
+	enclaveApiContainerSettings, err := backend.getEnclaveApiContainerSettings(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the API container settings of the enclaves")
+	}
+
+	enclaveLastActivityTimes, err := backend.getEnclaveLastActivityTimes(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the last activity times of the enclaves")
+	}
+
 	result := map[enclave.EnclaveUUID]*enclave.Enclave{}
 	for enclaveUuid, matchingNetworkInfo := range allMatchingNetworkInfo {
 		productionMode := false
@@ -214,6 +255,11 @@ func (backend *DockerKurtosisBackend) GetEnclaves(
 			matchingNetworkInfo.enclaveStatus,
 			creationTime,
 			productionMode,
+			enclaveLifetimes[enclaveUuid],
+			enclaveResourceQuotas[enclaveUuid],
+			enclaveOwners[enclaveUuid],
+			enclaveApiContainerSettings[enclaveUuid],
+			enclaveLastActivityTimes[enclaveUuid],
 		)
 	}
 	return result, nil
@@ -367,6 +413,17 @@ func (backend *DockerKurtosisBackend) DestroyEnclaves(
 		erroredEnclaveUuids[enclaveUuid] = volumeRemovalErr
 	}
 
+	// The blobs the destroyed enclaves were the only ones to use get removed by the next API container starting
+	for enclaveUuid := range successfulVolumeRemovalEnclaveUuids {
+		networkInfo, found := matchingNetworkInfo[enclaveUuid]
+		if !found {
+			return nil, nil, stacktrace.NewError("Would have attempted to release the files artifacts blobs of enclave '%v' that didn't match the filters", enclaveUuid)
+		}
+		if err := backend.releaseFilesArtifactsBlobs(ctx, enclaveUuid, networkInfo.dockerNetwork.GetId()); err != nil {
+			logrus.Warnf("An error occurred releasing the files artifacts blobs of enclave '%v', the ones only it used will stay on disk:\n%v", enclaveUuid, err)
+		}
+	}
+
 	// Disconnect the external containers from the enclave networks being removed
 	networksToDisconnect := map[enclave.EnclaveUUID]string{}
 	for enclaveUuid := range successfulVolumeRemovalEnclaveUuids {
@@ -423,11 +480,239 @@ func (backend *DockerKurtosisBackend) UpdateEnclave(
 	return stacktrace.NewError("UpdateEnclave isn't implemented for Docker yet")
 }
 
+func (backend *DockerKurtosisBackend) UpdateEnclaveLifetime(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	lifetime *enclave.EnclaveLifetime,
+) error {
+	var lifetimeVolumeAttrs object_attributes_provider.DockerObjectAttributes
+	if lifetime != nil {
+		enclaveObjAttrsProvider, err := backend.objAttrsProvider.ForEnclave(enclaveUuid)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred while trying to generate an object attributes provider for the enclave with ID '%v'", enclaveUuid)
+		}
+		lifetimeVolumeAttrs, err = enclaveObjAttrsProvider.ForEnclaveLifetimeVolume(lifetime)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred while trying to get the enclave lifetime volume attributes for the enclave with ID '%v'", enclaveUuid)
+		}
+	}
+
+	if err := backend.replaceEnclaveMetadataVolume(ctx, enclaveUuid, label_value_consts.EnclaveLifetimeVolumeTypeDockerLabelValue, lifetimeVolumeAttrs); err != nil {
+		return stacktrace.Propagate(err, "An error occurred updating the lifetime of enclave '%v'", enclaveUuid)
+	}
+	return nil
+}
+
+func (backend *DockerKurtosisBackend) UpdateEnclaveResourceQuota(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	resourceQuota *enclave.EnclaveResourceQuota,
+) error {
+	var resourceQuotaVolumeAttrs object_attributes_provider.DockerObjectAttributes
+	if resourceQuota != nil && !resourceQuota.IsEmpty() {
+		enclaveObjAttrsProvider, err := backend.objAttrsProvider.ForEnclave(enclaveUuid)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred while trying to generate an object attributes provider for the enclave with ID '%v'", enclaveUuid)
+		}
+		resourceQuotaVolumeAttrs, err = enclaveObjAttrsProvider.ForEnclaveResourceQuotaVolume(resourceQuota)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred while trying to get the enclave resource quota volume attributes for the enclave with ID '%v'", enclaveUuid)
+		}
+	}
+
+	if err := backend.replaceEnclaveMetadataVolume(ctx, enclaveUuid, label_value_consts.EnclaveResourceQuotaVolumeTypeDockerLabelValue, resourceQuotaVolumeAttrs); err != nil {
+		return stacktrace.Propagate(err, "An error occurred updating the resource quota of enclave '%v'", enclaveUuid)
+	}
+	return nil
+}
+
+func (backend *DockerKurtosisBackend) UpdateEnclaveOwner(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	owner string,
+) error {
+	var ownerVolumeAttrs object_attributes_provider.DockerObjectAttributes
+	if owner != "" {
+		enclaveObjAttrsProvider, err := backend.objAttrsProvider.ForEnclave(enclaveUuid)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred while trying to generate an object attributes provider for the enclave with ID '%v'", enclaveUuid)
+		}
+		ownerVolumeAttrs, err = enclaveObjAttrsProvider.ForEnclaveOwnerVolume(owner)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred while trying to get the enclave owner volume attributes for the enclave with ID '%v'", enclaveUuid)
+		}
+	}
+
+	if err := backend.replaceEnclaveMetadataVolume(ctx, enclaveUuid, label_value_consts.EnclaveOwnerVolumeTypeDockerLabelValue, ownerVolumeAttrs); err != nil {
+		return stacktrace.Propagate(err, "An error occurred updating the owner of enclave '%v'", enclaveUuid)
+	}
+	return nil
+}
+
+func (backend *DockerKurtosisBackend) UpdateEnclaveApiContainerSettings(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	apiContainerSettings *enclave.EnclaveApiContainerSettings,
+) error {
+	var apiContainerSettingsVolumeAttrs object_attributes_provider.DockerObjectAttributes
+	if apiContainerSettings != nil {
+		enclaveObjAttrsProvider, err := backend.objAttrsProvider.ForEnclave(enclaveUuid)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred while trying to generate an object attributes provider for the enclave with ID '%v'", enclaveUuid)
+		}
+		apiContainerSettingsVolumeAttrs, err = enclaveObjAttrsProvider.ForEnclaveApiContainerSettingsVolume(apiContainerSettings)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred while trying to get the enclave API container settings volume attributes for the enclave with ID '%v'", enclaveUuid)
+		}
+	}
+
+	if err := backend.replaceEnclaveMetadataVolume(ctx, enclaveUuid, label_value_consts.EnclaveApiContainerSettingsVolumeTypeDockerLabelValue, apiContainerSettingsVolumeAttrs); err != nil {
+		return stacktrace.Propagate(err, "An error occurred updating the API container settings of enclave '%v'", enclaveUuid)
+	}
+	return nil
+}
+
+func (backend *DockerKurtosisBackend) UpdateEnclaveLastActivityTime(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	lastActivityTime time.Time,
+) error {
+	enclaveObjAttrsProvider, err := backend.objAttrsProvider.ForEnclave(enclaveUuid)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while trying to generate an object attributes provider for the enclave with ID '%v'", enclaveUuid)
+	}
+	lastActivityVolumeAttrs, err := enclaveObjAttrsProvider.ForEnclaveLastActivityVolume(lastActivityTime)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while trying to get the enclave last activity volume attributes for the enclave with ID '%v'", enclaveUuid)
+	}
+
+	if err := backend.replaceEnclaveMetadataVolume(ctx, enclaveUuid, label_value_consts.EnclaveLastActivityVolumeTypeDockerLabelValue, lastActivityVolumeAttrs); err != nil {
+		return stacktrace.Propagate(err, "An error occurred updating the last activity time of enclave '%v'", enclaveUuid)
+	}
+	return nil
+}
+
+func (backend *DockerKurtosisBackend) CopyEnclavePersistentDirectories(
+	ctx context.Context,
+	sourceEnclaveUuid enclave.EnclaveUUID,
+	destinationEnclaveUuid enclave.EnclaveUUID,
+) error {
+	enclaveFilters := &enclave.EnclaveFilters{
+		UUIDs: map[enclave.EnclaveUUID]bool{
+			sourceEnclaveUuid:      true,
+			destinationEnclaveUuid: true,
+		},
+		Statuses: nil,
+	}
+	matchingNetworkInfo, err := backend.getMatchingEnclaveNetworkInfo(ctx, enclaveFilters)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the networks of enclaves '%v' and '%v'", sourceEnclaveUuid, destinationEnclaveUuid)
+	}
+	if _, found := matchingNetworkInfo[sourceEnclaveUuid]; !found {
+		return stacktrace.NewError("Cannot copy the persistent directories of enclave '%v' because it doesn't exist", sourceEnclaveUuid)
+	}
+	destinationNetworkInfo, found := matchingNetworkInfo[destinationEnclaveUuid]
+	if !found {
+		return stacktrace.NewError("Cannot copy persistent directories into enclave '%v' because it doesn't exist", destinationEnclaveUuid)
+	}
+
+	if err := user_service_functions.CopyPersistentDirectories(
+		ctx,
+		sourceEnclaveUuid,
+		destinationEnclaveUuid,
+		destinationNetworkInfo.dockerNetwork.GetId(),
+		backend.objAttrsProvider,
+		backend.dockerManager,
+	); err != nil {
+		return stacktrace.Propagate(err, "An error occurred copying the persistent directories of enclave '%v' into enclave '%v'", sourceEnclaveUuid, destinationEnclaveUuid)
+	}
+	return nil
+}
+
+func (backend *DockerKurtosisBackend) GetPersistentDirectoryKeys(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+) (map[service_directory.DirectoryPersistentKey]bool, error) {
+	persistentKeys, err := user_service_functions.GetPersistentDirectoryKeys(ctx, enclaveUuid, backend.dockerManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the persistent directories of enclave '%v'", enclaveUuid)
+	}
+	return persistentKeys, nil
+}
+
+func (backend *DockerKurtosisBackend) ExportPersistentDirectory(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	persistentKey service_directory.DirectoryPersistentKey,
+	output io.Writer,
+) error {
+	enclaveNetworkId, err := backend.getEnclaveNetworkId(ctx, enclaveUuid)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the network of enclave '%v'", enclaveUuid)
+	}
+	if err := user_service_functions.ExportPersistentDirectory(
+		ctx,
+		enclaveUuid,
+		enclaveNetworkId,
+		persistentKey,
+		output,
+		backend.objAttrsProvider,
+		backend.dockerManager,
+	); err != nil {
+		return stacktrace.Propagate(err, "An error occurred exporting persistent directory '%v' of enclave '%v'", persistentKey, enclaveUuid)
+	}
+	return nil
+}
+
+func (backend *DockerKurtosisBackend) ImportPersistentDirectory(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	persistentKey service_directory.DirectoryPersistentKey,
+	// Docker volumes can't be sized
+	_ service_directory.DirectoryPersistentSize,
+	tarContent io.Reader,
+) error {
+	enclaveNetworkId, err := backend.getEnclaveNetworkId(ctx, enclaveUuid)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the network of enclave '%v'", enclaveUuid)
+	}
+	if err := user_service_functions.ImportPersistentDirectory(
+		ctx,
+		enclaveUuid,
+		enclaveNetworkId,
+		persistentKey,
+		tarContent,
+		backend.objAttrsProvider,
+		backend.dockerManager,
+	); err != nil {
+		return stacktrace.Propagate(err, "An error occurred importing persistent directory '%v' of enclave '%v'", persistentKey, enclaveUuid)
+	}
+	return nil
+}
+
 // ====================================================================================================
 //
 //	Private helper methods
 //
 // ====================================================================================================
+func (backend *DockerKurtosisBackend) getEnclaveNetworkId(ctx context.Context, enclaveUuid enclave.EnclaveUUID) (string, error) {
+	enclaveFilters := &enclave.EnclaveFilters{
+		UUIDs: map[enclave.EnclaveUUID]bool{
+			enclaveUuid: true,
+		},
+		Statuses: nil,
+	}
+	matchingNetworkInfo, err := backend.getMatchingEnclaveNetworkInfo(ctx, enclaveFilters)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the network of enclave '%v'", enclaveUuid)
+	}
+	networkInfo, found := matchingNetworkInfo[enclaveUuid]
+	if !found {
+		return "", stacktrace.NewError("Enclave '%v' doesn't exist", enclaveUuid)
+	}
+	return networkInfo.dockerNetwork.GetId(), nil
+}
+
 func (backend *DockerKurtosisBackend) getMatchingEnclaveNetworkInfo(
 	ctx context.Context,
 	filters *enclave.EnclaveFilters,
@@ -603,6 +888,274 @@ func (backend *DockerKurtosisBackend) disconnectExternalContainersFromEnclaveNet
 	return successfulEnclaveUuids, erroredEnclaveUuids, nil
 }
 
+// Removes the enclave's volumes of the given type and, if new attributes are provided, creates a new one with them
+func (backend *DockerKurtosisBackend) replaceEnclaveMetadataVolume(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	volumeType *docker_label_value.DockerLabelValue,
+	newVolumeAttrs object_attributes_provider.DockerObjectAttributes,
+) error {
+	enclaveFilters := &enclave.EnclaveFilters{
+		UUIDs: map[enclave.EnclaveUUID]bool{
+			enclaveUuid: true,
+		},
+		Statuses: nil,
+	}
+	matchingNetworkInfo, err := backend.getMatchingEnclaveNetworkInfo(ctx, enclaveFilters)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the network of enclave '%v'", enclaveUuid)
+	}
+	if _, found := matchingNetworkInfo[enclaveUuid]; !found {
+		return stacktrace.NewError("Cannot update enclave '%v' because it doesn't exist", enclaveUuid)
+	}
+
+	volumeSearchLabels := map[string]string{
+		docker_label_key.AppIDDockerLabelKey.GetString():       label_value_consts.AppIDDockerLabelValue.GetString(),
+		docker_label_key.EnclaveUUIDDockerLabelKey.GetString(): string(enclaveUuid),
+		docker_label_key.VolumeTypeDockerLabelKey.GetString():  volumeType.GetString(),
+	}
+	existingVolumes, err := backend.dockerManager.GetVolumesByLabels(ctx, volumeSearchLabels)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the '%v' volumes of enclave '%v' using labels '%+v'", volumeType.GetString(), enclaveUuid, volumeSearchLabels)
+	}
+	for _, existingVolume := range existingVolumes {
+		if err := backend.dockerManager.RemoveVolume(ctx, existingVolume.Name); err != nil {
+			return stacktrace.Propagate(err, "An error occurred removing the previous '%v' volume '%v' of enclave '%v'", volumeType.GetString(), existingVolume.Name, enclaveUuid)
+		}
+	}
+
+	if newVolumeAttrs == nil {
+		return nil
+	}
+
+	volumeNameStr := newVolumeAttrs.GetName().GetString()
+	volumeLabelStrs := map[string]string{}
+	for labelKey, labelValue := range newVolumeAttrs.GetLabels() {
+		volumeLabelStrs[labelKey.GetString()] = labelValue.GetString()
+	}
+	if err := backend.dockerManager.CreateVolume(ctx, volumeNameStr, volumeLabelStrs); err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating enclave '%v' volume with name '%v' and labels '%+v'", volumeType.GetString(), volumeNameStr, volumeLabelStrs)
+	}
+	return nil
+}
+
+// Returns the lifetime of every enclave that has one, keyed by enclave UUID
+func (backend *DockerKurtosisBackend) getEnclaveLifetimes(ctx context.Context) (map[enclave.EnclaveUUID]*enclave.EnclaveLifetime, error) {
+	lifetimeVolumeSearchLabels := map[string]string{
+		docker_label_key.AppIDDockerLabelKey.GetString():      label_value_consts.AppIDDockerLabelValue.GetString(),
+		docker_label_key.VolumeTypeDockerLabelKey.GetString(): label_value_consts.EnclaveLifetimeVolumeTypeDockerLabelValue.GetString(),
+	}
+	lifetimeVolumes, err := backend.dockerManager.GetVolumesByLabels(ctx, lifetimeVolumeSearchLabels)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the enclave lifetime volumes using labels '%+v'", lifetimeVolumeSearchLabels)
+	}
+
+	result := map[enclave.EnclaveUUID]*enclave.EnclaveLifetime{}
+	for _, lifetimeVolume := range lifetimeVolumes {
+		enclaveUuidStr, found := lifetimeVolume.Labels[docker_label_key.EnclaveUUIDDockerLabelKey.GetString()]
+		if !found {
+			return nil, stacktrace.NewError("Expected to find label '%v' on enclave lifetime volume '%v' but none was found", docker_label_key.EnclaveUUIDDockerLabelKey.GetString(), lifetimeVolume.Name)
+		}
+
+		var expirationTime *time.Time
+		if expirationTimeStr, found := lifetimeVolume.Labels[docker_label_key.EnclaveExpirationTimeLabelKey.GetString()]; found {
+			parsedExpirationTime, err := time.Parse(time.RFC3339, expirationTimeStr)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred parsing enclave expiration time '%v' using this format '%v'", expirationTimeStr, time.RFC3339)
+			}
+			expirationTime = &parsedExpirationTime
+		}
+
+		var idleTimeout *time.Duration
+		if idleTimeoutStr, found := lifetimeVolume.Labels[docker_label_key.EnclaveIdleTimeoutLabelKey.GetString()]; found {
+			parsedIdleTimeout, err := time.ParseDuration(idleTimeoutStr)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred parsing enclave idle timeout '%v'", idleTimeoutStr)
+			}
+			idleTimeout = &parsedIdleTimeout
+		}
+
+		result[enclave.EnclaveUUID(enclaveUuidStr)] = enclave.NewEnclaveLifetime(expirationTime, idleTimeout)
+	}
+	return result, nil
+}
+
+// Returns the resource quota of every enclave that has one, keyed by enclave UUID
+func (backend *DockerKurtosisBackend) getEnclaveResourceQuotas(ctx context.Context) (map[enclave.EnclaveUUID]*enclave.EnclaveResourceQuota, error) {
+	resourceQuotaVolumeSearchLabels := map[string]string{
+		docker_label_key.AppIDDockerLabelKey.GetString():      label_value_consts.AppIDDockerLabelValue.GetString(),
+		docker_label_key.VolumeTypeDockerLabelKey.GetString(): label_value_consts.EnclaveResourceQuotaVolumeTypeDockerLabelValue.GetString(),
+	}
+	resourceQuotaVolumes, err := backend.dockerManager.GetVolumesByLabels(ctx, resourceQuotaVolumeSearchLabels)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the enclave resource quota volumes using labels '%+v'", resourceQuotaVolumeSearchLabels)
+	}
+
+	result := map[enclave.EnclaveUUID]*enclave.EnclaveResourceQuota{}
+	for _, resourceQuotaVolume := range resourceQuotaVolumes {
+		enclaveUuidStr, found := resourceQuotaVolume.Labels[docker_label_key.EnclaveUUIDDockerLabelKey.GetString()]
+		if !found {
+			return nil, stacktrace.NewError("Expected to find label '%v' on enclave resource quota volume '%v' but none was found", docker_label_key.EnclaveUUIDDockerLabelKey.GetString(), resourceQuotaVolume.Name)
+		}
+
+		quotaValues := map[*docker_label_key.DockerLabelKey]uint64{}
+		for _, labelKey := range []*docker_label_key.DockerLabelKey{
+			docker_label_key.EnclaveCpuQuotaLabelKey,
+			docker_label_key.EnclaveMemoryQuotaLabelKey,
+			docker_label_key.EnclaveMaxServicesQuotaLabelKey,
+			docker_label_key.EnclaveDiskQuotaLabelKey,
+		} {
+			quotaValueStr, found := resourceQuotaVolume.Labels[labelKey.GetString()]
+			if !found {
+				continue
+			}
+			quotaValue, err := strconv.ParseUint(quotaValueStr, 10, 64)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred parsing the value '%v' of label '%v' on enclave resource quota volume '%v'", quotaValueStr, labelKey.GetString(), resourceQuotaVolume.Name)
+			}
+			quotaValues[labelKey] = quotaValue
+		}
+
+		result[enclave.EnclaveUUID(enclaveUuidStr)] = enclave.NewEnclaveResourceQuota(
+			compute_resources.CpuMilliCores(quotaValues[docker_label_key.EnclaveCpuQuotaLabelKey]),
+			compute_resources.MemoryInMegaBytes(quotaValues[docker_label_key.EnclaveMemoryQuotaLabelKey]),
+			uint32(quotaValues[docker_label_key.EnclaveMaxServicesQuotaLabelKey]),
+			quotaValues[docker_label_key.EnclaveDiskQuotaLabelKey],
+		)
+	}
+	return result, nil
+}
+
+// Returns the owner of every enclave that has one, keyed by enclave UUID
+func (backend *DockerKurtosisBackend) getEnclaveOwners(ctx context.Context) (map[enclave.EnclaveUUID]string, error) {
+	ownerVolumeSearchLabels := map[string]string{
+		docker_label_key.AppIDDockerLabelKey.GetString():      label_value_consts.AppIDDockerLabelValue.GetString(),
+		docker_label_key.VolumeTypeDockerLabelKey.GetString(): label_value_consts.EnclaveOwnerVolumeTypeDockerLabelValue.GetString(),
+	}
+	ownerVolumes, err := backend.dockerManager.GetVolumesByLabels(ctx, ownerVolumeSearchLabels)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the enclave owner volumes using labels '%+v'", ownerVolumeSearchLabels)
+	}
+
+	result := map[enclave.EnclaveUUID]string{}
+	for _, ownerVolume := range ownerVolumes {
+		enclaveUuidStr, found := ownerVolume.Labels[docker_label_key.EnclaveUUIDDockerLabelKey.GetString()]
+		if !found {
+			return nil, stacktrace.NewError("Expected to find label '%v' on enclave owner volume '%v' but none was found", docker_label_key.EnclaveUUIDDockerLabelKey.GetString(), ownerVolume.Name)
+		}
+		result[enclave.EnclaveUUID(enclaveUuidStr)] = ownerVolume.Labels[docker_label_key.EnclaveOwnerLabelKey.GetString()]
+	}
+	return result, nil
+}
+
+// Returns the API container settings of every enclave that has them, keyed by enclave UUID
+func (backend *DockerKurtosisBackend) getEnclaveApiContainerSettings(ctx context.Context) (map[enclave.EnclaveUUID]*enclave.EnclaveApiContainerSettings, error) {
+	apiContainerSettingsVolumeSearchLabels := map[string]string{
+		docker_label_key.AppIDDockerLabelKey.GetString():      label_value_consts.AppIDDockerLabelValue.GetString(),
+		docker_label_key.VolumeTypeDockerLabelKey.GetString(): label_value_consts.EnclaveApiContainerSettingsVolumeTypeDockerLabelValue.GetString(),
+	}
+	apiContainerSettingsVolumes, err := backend.dockerManager.GetVolumesByLabels(ctx, apiContainerSettingsVolumeSearchLabels)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the enclave API container settings volumes using labels '%+v'", apiContainerSettingsVolumeSearchLabels)
+	}
+
+	result := map[enclave.EnclaveUUID]*enclave.EnclaveApiContainerSettings{}
+	for _, apiContainerSettingsVolume := range apiContainerSettingsVolumes {
+		enclaveUuidStr, found := apiContainerSettingsVolume.Labels[docker_label_key.EnclaveUUIDDockerLabelKey.GetString()]
+		if !found {
+			return nil, stacktrace.NewError("Expected to find label '%v' on enclave API container settings volume '%v' but none was found", docker_label_key.EnclaveUUIDDockerLabelKey.GetString(), apiContainerSettingsVolume.Name)
+		}
+		result[enclave.EnclaveUUID(enclaveUuidStr)] = enclave.NewEnclaveApiContainerSettings(
+			apiContainerSettingsVolume.Labels[docker_label_key.EnclaveApiContainerVersionTagLabelKey.GetString()],
+			apiContainerSettingsVolume.Labels[docker_label_key.EnclaveApiContainerLogLevelLabelKey.GetString()],
+		)
+	}
+	return result, nil
+}
+
+// Returns the last activity time of every enclave that has one, keyed by enclave UUID
+func (backend *DockerKurtosisBackend) getEnclaveLastActivityTimes(ctx context.Context) (map[enclave.EnclaveUUID]*time.Time, error) {
+	lastActivityVolumeSearchLabels := map[string]string{
+		docker_label_key.AppIDDockerLabelKey.GetString():      label_value_consts.AppIDDockerLabelValue.GetString(),
+		docker_label_key.VolumeTypeDockerLabelKey.GetString(): label_value_consts.EnclaveLastActivityVolumeTypeDockerLabelValue.GetString(),
+	}
+	lastActivityVolumes, err := backend.dockerManager.GetVolumesByLabels(ctx, lastActivityVolumeSearchLabels)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the enclave last activity volumes using labels '%+v'", lastActivityVolumeSearchLabels)
+	}
+
+	result := map[enclave.EnclaveUUID]*time.Time{}
+	for _, lastActivityVolume := range lastActivityVolumes {
+		enclaveUuidStr, found := lastActivityVolume.Labels[docker_label_key.EnclaveUUIDDockerLabelKey.GetString()]
+		if !found {
+			return nil, stacktrace.NewError("Expected to find label '%v' on enclave last activity volume '%v' but none was found", docker_label_key.EnclaveUUIDDockerLabelKey.GetString(), lastActivityVolume.Name)
+		}
+		lastActivityTimeStr, found := lastActivityVolume.Labels[docker_label_key.EnclaveLastActivityTimeLabelKey.GetString()]
+		if !found {
+			continue
+		}
+		lastActivityTime, err := time.Parse(time.RFC3339, lastActivityTimeStr)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred parsing enclave last activity time '%v' using this format '%v'", lastActivityTimeStr, time.RFC3339)
+		}
+		result[enclave.EnclaveUUID(enclaveUuidStr)] = &lastActivityTime
+	}
+	return result, nil
+}
+
+// releaseFilesArtifactsBlobs removes the references of the enclave to the files artifacts blobs shared by the enclaves,
+// using a short-lived container that mounts the blob storage volume
+func (backend *DockerKurtosisBackend) releaseFilesArtifactsBlobs(ctx context.Context, enclaveUuid enclave.EnclaveUUID, targetNetworkId string) error {
+	filesArtifactsBlobStorageVolumeName, err := backend.getFilesArtifactsBlobStorageVolume(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the files artifacts blob storage volume name")
+	}
+	uuid, err := uuid_generator.GenerateUUIDString()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred generating a UUID for the files artifacts blob releaser container name")
+	}
+	containerName := fmt.Sprintf("%s-%s", filesArtifactsBlobReleaserContainerNamePrefix, uuid)
+
+	entrypointArgs := []string{
+		"/bin/sh",
+		"-c",
+		fmt.Sprintf("rm -rf %s", path.Join(consts.FilesArtifactsBlobStorageDirPath, consts.FilesArtifactsBlobReferencesDirname, string(enclaveUuid))),
+	}
+	volumeMounts := map[string]string{
+		filesArtifactsBlobStorageVolumeName: consts.FilesArtifactsBlobStorageDirPath,
+	}
+
+	createAndStartArgs := docker_manager.NewCreateAndStartContainerArgsBuilder(
+		filesArtifactsBlobReleaserImage,
+		containerName,
+		targetNetworkId,
+	).WithEntrypointArgs(
+		entrypointArgs,
+	).WithVolumeMounts(
+		volumeMounts,
+	).Build()
+	containerId, _, err := backend.dockerManager.CreateAndStartContainer(ctx, createAndStartArgs)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred starting the files artifacts blob releaser container with these args '%+v'", createAndStartArgs)
+	}
+	defer func() {
+		// Background context so we still run this even if the input context was cancelled
+		if err := backend.dockerManager.RemoveContainer(context.Background(), containerId); err != nil {
+			logrus.Errorf("Tried to remove the files artifacts blob releaser container with ID '%v' but doing so threw an error:\n%v", containerId, err)
+			logrus.Errorf("ACTION REQUIRED: You'll need to manually remove the container with ID '%v'!!!!!!", containerId)
+		}
+	}()
+
+	exitCode, err := backend.dockerManager.WaitForExit(ctx, containerId)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred waiting for files artifacts blob releaser container '%v' to exit", containerName)
+	}
+	if exitCode != filesArtifactsBlobReleaserSuccessExitCode {
+		return stacktrace.NewError("Files artifacts blob releaser container '%v' finished with non-%v exit code '%v'", containerName, filesArtifactsBlobReleaserSuccessExitCode, exitCode)
+	}
+	return nil
+}
+
 func getAllEnclaveVolumes(
 	ctx context.Context,
 	dockerManager *docker_manager.DockerManager,
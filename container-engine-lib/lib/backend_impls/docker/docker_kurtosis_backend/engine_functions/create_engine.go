@@ -279,6 +279,33 @@ func CreateEngine(
 		return nil, stacktrace.Propagate(err, "An error occurred creating Docker config storage.")
 	}
 
+	// The engine data outlives the engine container, so the volume is created idempotently and never emptied here
+	engineDataStorageVolObjAttrs, err := objAttrsProvider.ForEngineDataStorageVolume()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred retrieving object attributes for the engine data storage.")
+	}
+	engineDataStorageVolNameStr := engineDataStorageVolObjAttrs.GetName().GetString()
+	engineDataStorageVolLabelStrs := map[string]string{}
+	for labelKey, labelValue := range engineDataStorageVolObjAttrs.GetLabels() {
+		engineDataStorageVolLabelStrs[labelKey.GetString()] = labelValue.GetString()
+	}
+	if err = dockerManager.CreateVolume(ctx, engineDataStorageVolNameStr, engineDataStorageVolLabelStrs); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the engine data storage volume.")
+	}
+
+	// The files artifacts blobs are shared by the API containers of all the enclaves, the engine doesn't mount them
+	filesArtifactsBlobStorageVolObjAttrs, err := objAttrsProvider.ForFilesArtifactsBlobStorageVolume()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred retrieving object attributes for the files artifacts blob storage.")
+	}
+	filesArtifactsBlobStorageVolLabelStrs := map[string]string{}
+	for labelKey, labelValue := range filesArtifactsBlobStorageVolObjAttrs.GetLabels() {
+		filesArtifactsBlobStorageVolLabelStrs[labelKey.GetString()] = labelValue.GetString()
+	}
+	if err = dockerManager.CreateVolume(ctx, filesArtifactsBlobStorageVolObjAttrs.GetName().GetString(), filesArtifactsBlobStorageVolLabelStrs); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the files artifacts blob storage volume.")
+	}
+
 	// Get the correct socket path based on DOCKER_HOST or runtime (Docker/Podman)
 	hostSocketPath := shared_helpers.GetDockerSocketPath(dockerManager.IsPodman())
 	bindMounts := map[string]string{
@@ -291,6 +318,7 @@ func CreateEngine(
 		logsStorageVolNameStr:         logsAggregatorContainer.GetLogsBaseDirPath(),
 		githubAuthStorageVolNameStr:   consts.GitHubAuthStorageDirPath,
 		dockerConfigStorageVolNameStr: consts.DockerConfigStorageDirPath,
+		engineDataStorageVolNameStr:   consts.EngineDataStorageDirPath,
 	}
 
 	if serverArgs.OnBastionHost {
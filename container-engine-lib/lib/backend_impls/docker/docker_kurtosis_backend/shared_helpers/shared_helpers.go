@@ -76,6 +76,9 @@ type UserServiceDockerResources struct {
 
 	// Will never be nil but may be empty if no expander volumes exist
 	ExpanderVolumeNames []string
+
+	// The containers running alongside the service container, empty if the service has no sidecars
+	SidecarContainers []*types.Container
 }
 
 func GetEnclaveNetworkByEnclaveUuid(ctx context.Context, enclaveUuid enclave.EnclaveUUID, dockerManager *docker_manager.DockerManager) (*types.Network, error) {
@@ -518,6 +521,46 @@ func DumpContainers(ctx context.Context, dockerManager *docker_manager.DockerMan
 	return nil
 }
 
+// GetOrphanedUserServiceSidecarContainers gets the sidecar containers of the services matching the UUIDs (or of all the
+// services if none is given) whose service container doesn't exist anymore, e.g. because it was removed outside of
+// Kurtosis. They aren't part of the Docker resources of any service, so they have to be removed on their own
+func GetOrphanedUserServiceSidecarContainers(
+	ctx context.Context,
+	enclaveId enclave.EnclaveUUID,
+	maybeUuidsToMatch map[service.ServiceUUID]bool,
+	dockerManager *docker_manager.DockerManager,
+) (map[service.ServiceUUID][]*types.Container, error) {
+	sidecarContainersByServiceUuid, err := getUserServiceSidecarContainers(ctx, enclaveId, dockerManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting user service sidecar containers in enclave '%v'", enclaveId)
+	}
+	if len(sidecarContainersByServiceUuid) == 0 {
+		return sidecarContainersByServiceUuid, nil
+	}
+
+	userServiceContainerSearchLabels := map[string]string{
+		docker_label_key.AppIDDockerLabelKey.GetString():         label_value_consts.AppIDDockerLabelValue.GetString(),
+		docker_label_key.EnclaveUUIDDockerLabelKey.GetString():   string(enclaveId),
+		docker_label_key.ContainerTypeDockerLabelKey.GetString(): label_value_consts.UserServiceContainerTypeDockerLabelValue.GetString(),
+	}
+	userServiceContainers, err := dockerManager.GetContainersByLabels(ctx, userServiceContainerSearchLabels, shouldGetStoppedContainersWhenGettingServiceInfo)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting user service containers in enclave '%v' by labels: %+v", enclaveId, userServiceContainerSearchLabels)
+	}
+	for _, container := range userServiceContainers {
+		delete(sidecarContainersByServiceUuid, service.ServiceUUID(container.GetLabels()[docker_label_key.GUIDDockerLabelKey.GetString()]))
+	}
+
+	if len(maybeUuidsToMatch) > 0 {
+		for serviceUuid := range sidecarContainersByServiceUuid {
+			if _, found := maybeUuidsToMatch[serviceUuid]; !found {
+				delete(sidecarContainersByServiceUuid, serviceUuid)
+			}
+		}
+	}
+	return sidecarContainersByServiceUuid, nil
+}
+
 // ====================================================================================================
 //
 //	Private Helper Functions
@@ -560,6 +603,7 @@ func getMatchingUserServiceDockerResources(
 			resourceObj = &UserServiceDockerResources{
 				ServiceContainer:    nil,
 				ExpanderVolumeNames: nil,
+				SidecarContainers:   nil,
 			}
 		}
 		resourceObj.ServiceContainer = container
@@ -595,12 +639,54 @@ func getMatchingUserServiceDockerResources(
 			resourceObj = &UserServiceDockerResources{
 				ServiceContainer:    nil,
 				ExpanderVolumeNames: nil,
+				SidecarContainers:   nil,
 			}
 		}
 		resourceObj.ExpanderVolumeNames = append(resourceObj.ExpanderVolumeNames, volume.Name)
 		result[serviceUuid] = resourceObj
 	}
 
+	// Grab sidecars, which are only meaningful for the services whose container still exists. The other ones are
+	// orphans, which GetOrphanedUserServiceSidecarContainers returns so that they can be removed
+	sidecarContainersByServiceUuid, err := getUserServiceSidecarContainers(ctx, enclaveId, dockerManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting user service sidecar containers in enclave '%v'", enclaveId)
+	}
+	for serviceUuid, sidecarContainers := range sidecarContainersByServiceUuid {
+		resourceObj, found := result[serviceUuid]
+		if !found || resourceObj.ServiceContainer == nil {
+			continue
+		}
+		resourceObj.SidecarContainers = sidecarContainers
+	}
+
+	return result, nil
+}
+
+func getUserServiceSidecarContainers(
+	ctx context.Context,
+	enclaveId enclave.EnclaveUUID,
+	dockerManager *docker_manager.DockerManager,
+) (map[service.ServiceUUID][]*types.Container, error) {
+	sidecarContainerSearchLabels := map[string]string{
+		docker_label_key.AppIDDockerLabelKey.GetString():         label_value_consts.AppIDDockerLabelValue.GetString(),
+		docker_label_key.EnclaveUUIDDockerLabelKey.GetString():   string(enclaveId),
+		docker_label_key.ContainerTypeDockerLabelKey.GetString(): label_value_consts.UserServiceSidecarContainerTypeDockerLabelValue.GetString(),
+	}
+	sidecarContainers, err := dockerManager.GetContainersByLabels(ctx, sidecarContainerSearchLabels, shouldGetStoppedContainersWhenGettingServiceInfo)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting user service sidecar containers in enclave '%v' by labels: %+v", enclaveId, sidecarContainerSearchLabels)
+	}
+
+	result := map[service.ServiceUUID][]*types.Container{}
+	for _, container := range sidecarContainers {
+		serviceUuidStr, found := container.GetLabels()[docker_label_key.UserServiceGUIDDockerLabelKey.GetString()]
+		if !found {
+			return nil, stacktrace.NewError("Found user service sidecar container '%v' that didn't have expected service GUID label '%v'", container.GetId(), docker_label_key.UserServiceGUIDDockerLabelKey.GetString())
+		}
+		serviceUuid := service.ServiceUUID(serviceUuidStr)
+		result[serviceUuid] = append(result[serviceUuid], container)
+	}
 	return result, nil
 }
 
@@ -0,0 +1,262 @@
+package user_service_functions
+
+import (
+	"context"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db/free_ip_addr_tracker"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	initContainerSuccessExitCode = 0
+)
+
+// Runs the init containers of a service one after the other, each one having to exit successfully before the next one
+// starts. They're attached to the enclave network and mount the same volumes as the service container, which doesn't
+// exist yet
+func runInitContainers(
+	ctx context.Context,
+	serviceName service.ServiceName,
+	serviceUuid service.ServiceUUID,
+	initContainers []*service.AdditionalContainer,
+	enclaveObjAttrsProvider object_attributes_provider.DockerEnclaveObjectAttributesProvider,
+	freeIpAddrProvider *free_ip_addr_tracker.FreeIpAddrTracker,
+	enclaveNetworkId string,
+	volumeMounts map[string]string,
+	dockerManager *docker_manager.DockerManager,
+) error {
+	for _, initContainer := range initContainers {
+		if err := runInitContainer(ctx, serviceName, serviceUuid, initContainer, enclaveObjAttrsProvider, freeIpAddrProvider, enclaveNetworkId, volumeMounts, dockerManager); err != nil {
+			return stacktrace.Propagate(err, "An error occurred running init container '%v' of service '%v'", initContainer.GetName(), serviceName)
+		}
+	}
+	return nil
+}
+
+func runInitContainer(
+	ctx context.Context,
+	serviceName service.ServiceName,
+	serviceUuid service.ServiceUUID,
+	initContainer *service.AdditionalContainer,
+	enclaveObjAttrsProvider object_attributes_provider.DockerEnclaveObjectAttributesProvider,
+	freeIpAddrProvider *free_ip_addr_tracker.FreeIpAddrTracker,
+	enclaveNetworkId string,
+	volumeMounts map[string]string,
+	dockerManager *docker_manager.DockerManager,
+) error {
+	containerAttrs, err := enclaveObjAttrsProvider.ForUserServiceInitContainer(serviceName, serviceUuid, initContainer.GetName())
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the container attributes of init container '%v'", initContainer.GetName())
+	}
+	containerName := containerAttrs.GetName().GetString()
+	containerLabels := map[string]string{}
+	for labelKey, labelValue := range containerAttrs.GetLabels() {
+		containerLabels[labelKey.GetString()] = labelValue.GetString()
+	}
+
+	ipAddr, err := freeIpAddrProvider.GetFreeIpAddr()
+	if err != nil {
+		return stacktrace.Propagate(err, "Couldn't get a free IP to give the init container '%v'", containerName)
+	}
+	defer func() {
+		if err := freeIpAddrProvider.ReleaseIpAddr(ipAddr); err != nil {
+			logrus.Errorf("Error releasing IP address '%v' of init container '%v'", ipAddr, containerName)
+		}
+	}()
+
+	createAndStartArgsBuilder := docker_manager.NewCreateAndStartContainerArgsBuilder(
+		initContainer.GetContainerImageName(),
+		containerName,
+		enclaveNetworkId,
+	).WithStaticIP(
+		ipAddr,
+	).WithEnvironmentVariables(
+		initContainer.GetEnvVars(),
+	).WithVolumeMounts(
+		volumeMounts,
+	).WithLabels(
+		containerLabels,
+	).WithSkipAddingToBridgeNetworkIfStaticIpIsSet(
+		skipAddingToBridgeNetwork,
+	)
+	if entrypointArgs := initContainer.GetEntrypointArgs(); entrypointArgs != nil {
+		createAndStartArgsBuilder.WithEntrypointArgs(entrypointArgs)
+	}
+	if cmdArgs := initContainer.GetCmdArgs(); cmdArgs != nil {
+		createAndStartArgsBuilder.WithCmdArgs(cmdArgs)
+	}
+	containerId, _, err := dockerManager.CreateAndStartContainer(ctx, createAndStartArgsBuilder.Build())
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred starting init container '%v'", containerName)
+	}
+	// The init container is removed once it's done, its logs being part of the returned error if it failed
+	defer func() {
+		if err := dockerManager.RemoveContainer(context.Background(), containerId); err != nil {
+			logrus.Errorf("We tried to remove init container '%v' with ID '%v' that we started, but doing so threw an error:\n%v", containerName, containerId, err)
+			logrus.Errorf("ACTION REQUIRED: You'll need to remove init container '%v' manually", containerName)
+		}
+	}()
+
+	exitCode, err := dockerManager.WaitForExit(ctx, containerId)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred waiting for init container '%v' to exit", containerName)
+	}
+	if exitCode != initContainerSuccessExitCode {
+		containerLogsBlockStr, err := getFilesArtifactsExpanderContainerLogsBlockStr(ctx, containerId, dockerManager)
+		if err != nil {
+			return stacktrace.NewError("Init container '%v' finished with non-%v exit code '%v' so we tried to get the logs, but doing so failed with an error:\n%v", containerName, initContainerSuccessExitCode, exitCode, err)
+		}
+		return stacktrace.NewError("Init container '%v' finished with non-%v exit code '%v' and logs:\n%v", containerName, initContainerSuccessExitCode, exitCode, containerLogsBlockStr)
+	}
+	return nil
+}
+
+// Starts the sidecars of a service in the network namespace of its container, mounting the same volumes. If one of
+// them fails to start, the ones already started are removed
+func startSidecars(
+	ctx context.Context,
+	serviceName service.ServiceName,
+	serviceUuid service.ServiceUUID,
+	sidecars []*service.AdditionalContainer,
+	serviceContainerId string,
+	enclaveObjAttrsProvider object_attributes_provider.DockerEnclaveObjectAttributesProvider,
+	enclaveNetworkId string,
+	volumeMounts map[string]string,
+	restartPolicy docker_manager.RestartPolicy,
+	dockerManager *docker_manager.DockerManager,
+) error {
+	startedSidecarContainerIds := []string{}
+	shouldRemoveSidecars := true
+	defer func() {
+		if !shouldRemoveSidecars {
+			return
+		}
+		for _, containerId := range startedSidecarContainerIds {
+			// Use background context so we remove these even if input context was cancelled
+			if err := dockerManager.RemoveContainer(context.Background(), containerId); err != nil {
+				logrus.Errorf("Starting the sidecars of service '%v' failed so we tried to remove sidecar container with ID '%v' that we started, but doing so threw an error:\n%v", serviceName, containerId, err)
+				logrus.Errorf("ACTION REQUIRED: You'll need to remove sidecar container with ID '%v' manually", containerId)
+			}
+		}
+	}()
+
+	for _, sidecar := range sidecars {
+		containerAttrs, err := enclaveObjAttrsProvider.ForUserServiceSidecarContainer(serviceName, serviceUuid, sidecar.GetName())
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the container attributes of sidecar '%v' of service '%v'", sidecar.GetName(), serviceName)
+		}
+		containerName := containerAttrs.GetName().GetString()
+		containerLabels := map[string]string{}
+		for labelKey, labelValue := range containerAttrs.GetLabels() {
+			containerLabels[labelKey.GetString()] = labelValue.GetString()
+		}
+
+		// No static IP is set, so the sidecar is only part of the network namespace of the service container
+		createAndStartArgsBuilder := docker_manager.NewCreateAndStartContainerArgsBuilder(
+			sidecar.GetContainerImageName(),
+			containerName,
+			enclaveNetworkId,
+		).WithNetworkMode(
+			docker_manager.NewContainerNetworkMode(serviceContainerId),
+		).WithEnvironmentVariables(
+			sidecar.GetEnvVars(),
+		).WithVolumeMounts(
+			volumeMounts,
+		).WithLabels(
+			containerLabels,
+		).WithRestartPolicy(
+			restartPolicy,
+		)
+		if entrypointArgs := sidecar.GetEntrypointArgs(); entrypointArgs != nil {
+			createAndStartArgsBuilder.WithEntrypointArgs(entrypointArgs)
+		}
+		if cmdArgs := sidecar.GetCmdArgs(); cmdArgs != nil {
+			createAndStartArgsBuilder.WithCmdArgs(cmdArgs)
+		}
+		containerId, _, err := dockerManager.CreateAndStartContainer(ctx, createAndStartArgsBuilder.Build())
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred starting sidecar '%v' of service '%v'", sidecar.GetName(), serviceName)
+		}
+		startedSidecarContainerIds = append(startedSidecarContainerIds, containerId)
+	}
+	shouldRemoveSidecars = false
+	return nil
+}
+
+// Runs the given operation on the sidecar containers of every service, returning the services for which it failed.
+// The services without sidecars are left untouched
+func runOperationOnSidecars(
+	ctx context.Context,
+	dockerResources map[service.ServiceUUID]*shared_helpers.UserServiceDockerResources,
+	dockerManager *docker_manager.DockerManager,
+	operationDescription string,
+	operation func(ctx context.Context, containerId string) error,
+) map[service.ServiceUUID]error {
+	erroredUuids := map[service.ServiceUUID]error{}
+	for serviceUuid, resources := range dockerResources {
+		for _, sidecarContainer := range resources.SidecarContainers {
+			if err := operation(ctx, sidecarContainer.GetId()); err != nil {
+				erroredUuids[serviceUuid] = stacktrace.Propagate(err, "An error occurred %v sidecar container '%v' of service '%v'", operationDescription, sidecarContainer.GetName(), serviceUuid)
+				break
+			}
+		}
+	}
+	return erroredUuids
+}
+
+func stopSidecars(
+	ctx context.Context,
+	dockerResources map[service.ServiceUUID]*shared_helpers.UserServiceDockerResources,
+	dockerManager *docker_manager.DockerManager,
+) map[service.ServiceUUID]error {
+	return runOperationOnSidecars(ctx, dockerResources, dockerManager, "stopping", func(ctx context.Context, containerId string) error {
+		return dockerManager.StopContainer(ctx, containerId, stopContainerTimeout)
+	})
+}
+
+func restartSidecars(
+	ctx context.Context,
+	dockerResources map[service.ServiceUUID]*shared_helpers.UserServiceDockerResources,
+	dockerManager *docker_manager.DockerManager,
+) map[service.ServiceUUID]error {
+	return runOperationOnSidecars(ctx, dockerResources, dockerManager, "starting", dockerManager.StartContainer)
+}
+
+func removeSidecars(
+	ctx context.Context,
+	dockerResources map[service.ServiceUUID]*shared_helpers.UserServiceDockerResources,
+	dockerManager *docker_manager.DockerManager,
+) map[service.ServiceUUID]error {
+	return runOperationOnSidecars(ctx, dockerResources, dockerManager, "removing", dockerManager.RemoveContainer)
+}
+
+// Removes the sidecar containers left behind by the services matching the UUIDs whose service container doesn't exist
+// anymore, returning the services for which it failed
+func removeOrphanedSidecars(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuids map[service.ServiceUUID]bool,
+	dockerManager *docker_manager.DockerManager,
+) (map[service.ServiceUUID]error, error) {
+	orphanedSidecarContainers, err := shared_helpers.GetOrphanedUserServiceSidecarContainers(ctx, enclaveUuid, serviceUuids, dockerManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the orphaned sidecar containers of services '%+v'", serviceUuids)
+	}
+	erroredUuids := map[service.ServiceUUID]error{}
+	for serviceUuid, sidecarContainers := range orphanedSidecarContainers {
+		for _, sidecarContainer := range sidecarContainers {
+			if err := dockerManager.RemoveContainer(ctx, sidecarContainer.GetId()); err != nil {
+				erroredUuids[serviceUuid] = stacktrace.Propagate(err, "An error occurred removing sidecar container '%v' left behind by service '%v'", sidecarContainer.GetName(), serviceUuid)
+				break
+			}
+			logrus.Debugf("Removed sidecar container '%v' left behind by service '%v'", sidecarContainer.GetName(), serviceUuid)
+		}
+	}
+	return erroredUuids, nil
+}
@@ -0,0 +1,71 @@
+package user_service_functions
+
+import (
+	"bytes"
+	"context"
+	"io"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	mkdirSuccessExitCode = 0
+	// Runs the command as the default user of the container, so that the directory created belongs to it
+	defaultContainerUserId = ""
+)
+
+func CopyFilesToUserService(
+	ctx context.Context,
+	enclaveId enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	dstDirpathOnContainer string,
+	tarContent io.Reader,
+	dockerManager *docker_manager.DockerManager,
+) error {
+	serviceObj, serviceDockerResources, err := getSingleUserServiceObjAndResourcesNoMutex(ctx, enclaveId, serviceUuid, dockerManager)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting user service with UUID '%v' in enclave with ID '%v'", serviceUuid, enclaveId)
+	}
+	if serviceObj.GetContainer().GetStatus() != container.ContainerStatus_Running {
+		return stacktrace.NewError(
+			"Cannot copy files to '%v' on service '%v' because the service status is '%v'",
+			dstDirpathOnContainer,
+			serviceUuid,
+			serviceObj.GetContainer().GetStatus().String())
+	}
+	containerId := serviceDockerResources.ServiceContainer.GetId()
+
+	// Docker only copies into directories that already exist
+	mkdirOutput := &bytes.Buffer{}
+	mkdirCmd := []string{"mkdir", "-p", dstDirpathOnContainer}
+	exitCode, err := dockerManager.RunUserServiceExecCommands(ctx, containerId, defaultContainerUserId, mkdirCmd, mkdirOutput)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating directory '%v' on service '%v'", dstDirpathOnContainer, serviceUuid)
+	}
+	if exitCode != mkdirSuccessExitCode {
+		return stacktrace.NewError(
+			"Creating directory '%v' on service '%v' exited with non-%v exit code %v and the following output:\n%v",
+			dstDirpathOnContainer,
+			serviceUuid,
+			mkdirSuccessExitCode,
+			exitCode,
+			mkdirOutput.String(),
+		)
+	}
+
+	if err := dockerManager.CopyToContainer(ctx, containerId, dstDirpathOnContainer, tarContent); err != nil {
+		return stacktrace.Propagate(
+			err,
+			"An error occurred copying files to '%v' in container '%v' for user service '%v' in enclave '%v'",
+			dstDirpathOnContainer,
+			serviceDockerResources.ServiceContainer.GetName(),
+			serviceUuid,
+			enclaveId,
+		)
+	}
+	return nil
+}
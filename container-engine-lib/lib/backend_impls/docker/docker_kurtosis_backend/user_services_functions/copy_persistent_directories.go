@@ -0,0 +1,149 @@
+package user_service_functions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/docker_label_key"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/label_value_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// We use this image and version because we already are using this in other projects so there is a high probability
+	// that the image is in the local machine's cache
+	persistentDirectoryCopierImage               = "alpine:3.17"
+	persistentDirectoryCopierContainerNamePrefix = "kurtosis-persistent-directory-copier"
+
+	persistentDirectoryCopierSourceDirpath      = "/source"
+	persistentDirectoryCopierDestinationDirpath = "/destination"
+
+	persistentDirectoryCopierSuccessExitCode = 0
+)
+
+// CopyPersistentDirectories copies every persistent directory volume of the source enclave into a new volume with the
+// same persistent key in the destination enclave, using a short-lived container that mounts both volumes
+func CopyPersistentDirectories(
+	ctx context.Context,
+	sourceEnclaveUuid enclave.EnclaveUUID,
+	destinationEnclaveUuid enclave.EnclaveUUID,
+	destinationEnclaveNetworkId string,
+	objAttrsProvider object_attributes_provider.DockerObjectAttributesProvider,
+	dockerManager *docker_manager.DockerManager,
+) error {
+	sourceVolumeSearchLabels := map[string]string{
+		docker_label_key.AppIDDockerLabelKey.GetString():       label_value_consts.AppIDDockerLabelValue.GetString(),
+		docker_label_key.EnclaveUUIDDockerLabelKey.GetString(): string(sourceEnclaveUuid),
+		docker_label_key.VolumeTypeDockerLabelKey.GetString():  label_value_consts.PersistentDirectoryVolumeTypeDockerLabelValue.GetString(),
+	}
+	sourceVolumes, err := dockerManager.GetVolumesByLabels(ctx, sourceVolumeSearchLabels)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the persistent directory volumes of enclave '%v' using labels '%+v'", sourceEnclaveUuid, sourceVolumeSearchLabels)
+	}
+	if len(sourceVolumes) == 0 {
+		return nil
+	}
+
+	destinationObjAttrsProvider, err := objAttrsProvider.ForEnclave(destinationEnclaveUuid)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while trying to generate an object attributes provider for the enclave with ID '%v'", destinationEnclaveUuid)
+	}
+
+	for _, sourceVolume := range sourceVolumes {
+		persistentKey, found := object_attributes_provider.GetPersistentKeyFromPersistentDirectoryVolumeName(sourceVolume.Name, sourceEnclaveUuid)
+		if !found {
+			return stacktrace.NewError("Volume '%v' is labeled as a persistent directory of enclave '%v' but its name doesn't match the persistent directory naming; this is a bug in Kurtosis", sourceVolume.Name, sourceEnclaveUuid)
+		}
+
+		destinationVolumeAttrs, err := destinationObjAttrsProvider.ForSinglePersistentDirectoryVolume(persistentKey)
+		if err != nil {
+			return stacktrace.Propagate(err, "Error creating persistent directory labels for '%s'", persistentKey)
+		}
+		destinationVolumeName := destinationVolumeAttrs.GetName().GetString()
+		destinationVolumeLabelStrs := map[string]string{}
+		for key, value := range destinationVolumeAttrs.GetLabels() {
+			destinationVolumeLabelStrs[key.GetString()] = value.GetString()
+		}
+
+		existingDestinationVolumes, err := dockerManager.GetVolumesByName(ctx, destinationVolumeName)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred checking for persistent volume existence")
+		}
+		if len(existingDestinationVolumes) > 0 {
+			return stacktrace.NewError("Persistent directory '%v' already exists in enclave '%v'; refusing to overwrite it", persistentKey, destinationEnclaveUuid)
+		}
+		if err := dockerManager.CreateVolume(ctx, destinationVolumeName, destinationVolumeLabelStrs); err != nil {
+			return stacktrace.Propagate(err, "An error occurred creating persistent directory volume '%s' in enclave '%v'", persistentKey, destinationEnclaveUuid)
+		}
+
+		if err := copyVolumeContents(ctx, sourceVolume.Name, destinationVolumeName, destinationEnclaveNetworkId, dockerManager); err != nil {
+			return stacktrace.Propagate(err, "An error occurred copying persistent directory '%v' from enclave '%v' to enclave '%v'", persistentKey, sourceEnclaveUuid, destinationEnclaveUuid)
+		}
+		logrus.Debugf("Copied persistent directory '%v' from enclave '%v' to enclave '%v'", persistentKey, sourceEnclaveUuid, destinationEnclaveUuid)
+	}
+	return nil
+}
+
+func copyVolumeContents(
+	ctx context.Context,
+	sourceVolumeName string,
+	destinationVolumeName string,
+	targetNetworkId string,
+	dockerManager *docker_manager.DockerManager,
+) error {
+	uuid, err := uuid_generator.GenerateUUIDString()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred generating a UUID for the persistent directory copier container name")
+	}
+	containerName := fmt.Sprintf("%s-%s", persistentDirectoryCopierContainerNamePrefix, uuid)
+
+	// The trailing '/.' copies the directory contents, hidden files included, rather than the directory itself
+	entrypointArgs := []string{
+		"/bin/sh",
+		"-c",
+		fmt.Sprintf("cp -a %s/. %s/", persistentDirectoryCopierSourceDirpath, persistentDirectoryCopierDestinationDirpath),
+	}
+	volumeMounts := map[string]string{
+		sourceVolumeName:      persistentDirectoryCopierSourceDirpath,
+		destinationVolumeName: persistentDirectoryCopierDestinationDirpath,
+	}
+
+	createAndStartArgs := docker_manager.NewCreateAndStartContainerArgsBuilder(
+		persistentDirectoryCopierImage,
+		containerName,
+		targetNetworkId,
+	).WithEntrypointArgs(
+		entrypointArgs,
+	).WithVolumeMounts(
+		volumeMounts,
+	).Build()
+	containerId, _, err := dockerManager.CreateAndStartContainer(ctx, createAndStartArgs)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred starting the persistent directory copier container with these args '%+v'", createAndStartArgs)
+	}
+	defer func() {
+		// Background context so we still run this even if the input context was cancelled
+		if err := dockerManager.RemoveContainer(context.Background(), containerId); err != nil {
+			logrus.Errorf("Tried to remove the persistent directory copier container with ID '%v' but doing so threw an error:\n%v", containerId, err)
+			logrus.Errorf("ACTION REQUIRED: You'll need to manually remove the container with ID '%v'!!!!!!", containerId)
+		}
+	}()
+
+	exitCode, err := dockerManager.WaitForExit(ctx, containerId)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred waiting for persistent directory copier container '%v' to exit", containerName)
+	}
+	if exitCode != persistentDirectoryCopierSuccessExitCode {
+		containerLogsBlockStr, err := getFilesArtifactsExpanderContainerLogsBlockStr(ctx, containerId, dockerManager)
+		if err != nil {
+			return stacktrace.NewError("Persistent directory copier container '%v' finished with non-%v exit code '%v' and getting its logs failed with an error:\n%v", containerName, persistentDirectoryCopierSuccessExitCode, exitCode, err)
+		}
+		return stacktrace.NewError("Persistent directory copier container '%v' finished with non-%v exit code '%v' and logs:\n%v", containerName, persistentDirectoryCopierSuccessExitCode, exitCode, containerLogsBlockStr)
+	}
+	return nil
+}
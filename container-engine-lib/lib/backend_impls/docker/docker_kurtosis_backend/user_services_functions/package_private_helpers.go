@@ -128,6 +128,23 @@ func destroyUserServicesUnlocked(
 		successfulUuids[uuid] = true
 	}
 
+	// The services without a container may still have sidecars, which would be left running forever once deregistered
+	uuidsToDeregister := map[service.ServiceUUID]bool{}
+	for uuid := range registrationsToDeregister {
+		uuidsToDeregister[uuid] = true
+	}
+	if len(uuidsToDeregister) > 0 {
+		orphanedSidecarsErroredUuids, err := removeOrphanedSidecars(ctx, enclaveUuid, uuidsToDeregister, dockerManager)
+		if err != nil {
+			return nil, nil, stacktrace.Propagate(err, "An error occurred removing the sidecars left behind by the services to destroy")
+		}
+		for uuid, sidecarsErr := range orphanedSidecarsErroredUuids {
+			erroredUuids[uuid] = sidecarsErr
+			delete(successfulUuids, uuid)
+			delete(registrationsToDeregister, uuid)
+		}
+	}
+
 	// Finalize deregistration
 	for uuid, registration := range registrationsToDeregister {
 		ipAddr := registration.GetPrivateIP()
@@ -196,8 +213,17 @@ func removeUserServiceDockerResources(
 		}
 	}
 
+	// Sidecars live in the network namespace of the service container, so they're removed before it
+	sidecarsErroredUuids := removeSidecars(ctx, resourcesToRemove, dockerManager)
+	for serviceUuid, sidecarsErr := range sidecarsErroredUuids {
+		erroredUuids[serviceUuid] = sidecarsErr
+	}
+
 	kurtosisObjectsToRemoveByContainerId := map[string]*service.Service{}
 	for serviceUuid, resources := range resourcesToRemove {
+		if _, found := sidecarsErroredUuids[serviceUuid]; found {
+			continue
+		}
 		// Safe to skip the is-found check because we verified the map keys are identical earlier
 		serviceObj := serviceObjectsToRemove[serviceUuid]
 
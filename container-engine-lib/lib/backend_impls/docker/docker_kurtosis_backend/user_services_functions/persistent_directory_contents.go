@@ -0,0 +1,197 @@
+package user_service_functions
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/docker_label_key"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/label_value_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	persistentDirectoryAccessorContainerNamePrefix = "kurtosis-persistent-directory-accessor"
+	persistentDirectoryAccessorMountpoint          = "/persistent-directory"
+)
+
+// Keeps the accessor container alive until we're done copying files in and out of it
+var persistentDirectoryAccessorEntrypointArgs = []string{"tail", "-f", "/dev/null"}
+
+// GetPersistentDirectoryKeys returns the keys of the persistent directory volumes existing in the enclave
+func GetPersistentDirectoryKeys(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	dockerManager *docker_manager.DockerManager,
+) (map[service_directory.DirectoryPersistentKey]bool, error) {
+	volumeSearchLabels := map[string]string{
+		docker_label_key.AppIDDockerLabelKey.GetString():       label_value_consts.AppIDDockerLabelValue.GetString(),
+		docker_label_key.EnclaveUUIDDockerLabelKey.GetString(): string(enclaveUuid),
+		docker_label_key.VolumeTypeDockerLabelKey.GetString():  label_value_consts.PersistentDirectoryVolumeTypeDockerLabelValue.GetString(),
+	}
+	volumes, err := dockerManager.GetVolumesByLabels(ctx, volumeSearchLabels)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the persistent directory volumes of enclave '%v' using labels '%+v'", enclaveUuid, volumeSearchLabels)
+	}
+	persistentKeys := map[service_directory.DirectoryPersistentKey]bool{}
+	for _, volume := range volumes {
+		persistentKey, found := object_attributes_provider.GetPersistentKeyFromPersistentDirectoryVolumeName(volume.Name, enclaveUuid)
+		if !found {
+			return nil, stacktrace.NewError("Volume '%v' is labeled as a persistent directory of enclave '%v' but its name doesn't match the persistent directory naming; this is a bug in Kurtosis", volume.Name, enclaveUuid)
+		}
+		persistentKeys[persistentKey] = true
+	}
+	return persistentKeys, nil
+}
+
+// ExportPersistentDirectory writes to output an uncompressed TAR of the contents of the persistent directory volume,
+// read through a short-lived container mounting it
+func ExportPersistentDirectory(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	enclaveNetworkId string,
+	persistentKey service_directory.DirectoryPersistentKey,
+	output io.Writer,
+	objAttrsProvider object_attributes_provider.DockerObjectAttributesProvider,
+	dockerManager *docker_manager.DockerManager,
+) error {
+	volumeName, _, err := getPersistentDirectoryVolumeNameAndLabels(enclaveUuid, persistentKey, objAttrsProvider)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the volume name of persistent directory '%v'", persistentKey)
+	}
+	existingVolumes, err := dockerManager.GetVolumesByName(ctx, volumeName)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred checking for persistent volume existence")
+	}
+	if len(existingVolumes) == 0 {
+		return stacktrace.NewError("Persistent directory '%v' doesn't exist in enclave '%v'", persistentKey, enclaveUuid)
+	}
+
+	containerId, err := startPersistentDirectoryAccessorContainer(ctx, volumeName, enclaveNetworkId, dockerManager)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred starting a container mounting persistent directory '%v'", persistentKey)
+	}
+	defer removePersistentDirectoryAccessorContainer(containerId, dockerManager)
+
+	tarContent, err := dockerManager.CopyFromContainer(ctx, containerId, persistentDirectoryAccessorMountpoint)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred copying the contents of persistent directory '%v' out of container '%v'", persistentKey, containerId)
+	}
+	defer tarContent.Close()
+	if _, err := io.Copy(output, tarContent); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the contents of persistent directory '%v'", persistentKey)
+	}
+	return nil
+}
+
+// ImportPersistentDirectory replaces the contents of the persistent directory volume, creating it if it doesn't exist
+// yet, by the files of the uncompressed TAR
+func ImportPersistentDirectory(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	enclaveNetworkId string,
+	persistentKey service_directory.DirectoryPersistentKey,
+	tarContent io.Reader,
+	objAttrsProvider object_attributes_provider.DockerObjectAttributesProvider,
+	dockerManager *docker_manager.DockerManager,
+) error {
+	volumeName, volumeLabelStrs, err := getPersistentDirectoryVolumeNameAndLabels(enclaveUuid, persistentKey, objAttrsProvider)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the volume name of persistent directory '%v'", persistentKey)
+	}
+	existingVolumes, err := dockerManager.GetVolumesByName(ctx, volumeName)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred checking for persistent volume existence")
+	}
+	if len(existingVolumes) == 0 {
+		// Docker doesn't support sized volumes, same as when a service creates the persistent directory
+		if err := dockerManager.CreateVolume(ctx, volumeName, volumeLabelStrs); err != nil {
+			return stacktrace.Propagate(err, "An error occurred creating persistent directory volume '%s' in enclave '%v'", persistentKey, enclaveUuid)
+		}
+	}
+
+	containerId, err := startPersistentDirectoryAccessorContainer(ctx, volumeName, enclaveNetworkId, dockerManager)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred starting a container mounting persistent directory '%v'", persistentKey)
+	}
+	defer removePersistentDirectoryAccessorContainer(containerId, dockerManager)
+
+	clearOutput := &bytes.Buffer{}
+	clearCmd := []string{"find", persistentDirectoryAccessorMountpoint, "-mindepth", "1", "-delete"}
+	exitCode, err := dockerManager.RunUserServiceExecCommands(ctx, containerId, defaultContainerUserId, clearCmd, clearOutput)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred clearing the contents of persistent directory '%v'", persistentKey)
+	}
+	if exitCode != persistentDirectoryCopierSuccessExitCode {
+		return stacktrace.NewError("Clearing command '%v' exited with non-%v exit code '%v' and output:\n%v", clearCmd, persistentDirectoryCopierSuccessExitCode, exitCode, clearOutput.String())
+	}
+
+	if err := dockerManager.CopyToContainer(ctx, containerId, persistentDirectoryAccessorMountpoint, tarContent); err != nil {
+		return stacktrace.Propagate(err, "An error occurred copying the contents of persistent directory '%v' into container '%v'", persistentKey, containerId)
+	}
+	logrus.Debugf("Imported the contents of persistent directory '%v' in enclave '%v'", persistentKey, enclaveUuid)
+	return nil
+}
+
+func getPersistentDirectoryVolumeNameAndLabels(
+	enclaveUuid enclave.EnclaveUUID,
+	persistentKey service_directory.DirectoryPersistentKey,
+	objAttrsProvider object_attributes_provider.DockerObjectAttributesProvider,
+) (string, map[string]string, error) {
+	enclaveObjAttrsProvider, err := objAttrsProvider.ForEnclave(enclaveUuid)
+	if err != nil {
+		return "", nil, stacktrace.Propagate(err, "An error occurred while trying to generate an object attributes provider for the enclave with ID '%v'", enclaveUuid)
+	}
+	volumeAttrs, err := enclaveObjAttrsProvider.ForSinglePersistentDirectoryVolume(persistentKey)
+	if err != nil {
+		return "", nil, stacktrace.Propagate(err, "Error creating persistent directory labels for '%s'", persistentKey)
+	}
+	volumeLabelStrs := map[string]string{}
+	for key, value := range volumeAttrs.GetLabels() {
+		volumeLabelStrs[key.GetString()] = value.GetString()
+	}
+	return volumeAttrs.GetName().GetString(), volumeLabelStrs, nil
+}
+
+func startPersistentDirectoryAccessorContainer(
+	ctx context.Context,
+	volumeName string,
+	targetNetworkId string,
+	dockerManager *docker_manager.DockerManager,
+) (string, error) {
+	uuid, err := uuid_generator.GenerateUUIDString()
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred generating a UUID for the persistent directory accessor container name")
+	}
+	containerName := fmt.Sprintf("%s-%s", persistentDirectoryAccessorContainerNamePrefix, uuid)
+
+	createAndStartArgs := docker_manager.NewCreateAndStartContainerArgsBuilder(
+		persistentDirectoryCopierImage,
+		containerName,
+		targetNetworkId,
+	).WithEntrypointArgs(
+		persistentDirectoryAccessorEntrypointArgs,
+	).WithVolumeMounts(
+		map[string]string{volumeName: persistentDirectoryAccessorMountpoint},
+	).Build()
+	containerId, _, err := dockerManager.CreateAndStartContainer(ctx, createAndStartArgs)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred starting the persistent directory accessor container with these args '%+v'", createAndStartArgs)
+	}
+	return containerId, nil
+}
+
+func removePersistentDirectoryAccessorContainer(containerId string, dockerManager *docker_manager.DockerManager) {
+	// Background context so we still run this even if the input context was cancelled
+	if err := dockerManager.RemoveContainer(context.Background(), containerId); err != nil {
+		logrus.Errorf("Tried to remove the persistent directory accessor container with ID '%v' but doing so threw an error:\n%v", containerId, err)
+		logrus.Errorf("ACTION REQUIRED: You'll need to manually remove the container with ID '%v'!!!!!!", containerId)
+	}
+}
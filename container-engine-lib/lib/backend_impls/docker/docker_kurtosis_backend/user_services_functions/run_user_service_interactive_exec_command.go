@@ -0,0 +1,39 @@
+package user_service_functions
+
+import (
+	"context"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/interactive_exec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+func RunUserServiceInteractiveExecCommand(
+	ctx context.Context,
+	enclaveId enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	cmd []string,
+	execStreams *interactive_exec.InteractiveExecStreams,
+	dockerManager *docker_manager.DockerManager,
+) (int32, error) {
+	serviceObj, serviceDockerResources, err := getSingleUserServiceObjAndResourcesNoMutex(ctx, enclaveId, serviceUuid, dockerManager)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred getting service object and Docker resources for service '%v' in enclave '%v'", serviceUuid, enclaveId)
+	}
+	if serviceObj.GetContainer().GetStatus() != container.ContainerStatus_Running {
+		return 0, stacktrace.NewError(
+			"Cannot execute command '%+v' on service '%v' because the service status is '%v'",
+			cmd,
+			serviceUuid,
+			serviceObj.GetContainer().GetStatus().String())
+	}
+
+	exitCode, err := dockerManager.RunInteractiveExecCommand(ctx, serviceDockerResources.ServiceContainer.GetId(), cmd, execStreams)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred running command '%+v' on service '%v'", cmd, serviceUuid)
+	}
+	return exitCode, nil
+}
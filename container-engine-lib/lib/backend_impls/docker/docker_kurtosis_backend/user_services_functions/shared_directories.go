@@ -0,0 +1,67 @@
+package user_service_functions
+
+import (
+	"context"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// Gets the volumes backing the shared directories of a service, creating the ones that don't exist yet. Unlike
+// persistent directories, these volumes are never removed when starting the service fails as other services may be
+// using them already; they're removed along with the enclave
+func getOrCreateSharedDirectories(
+	ctx context.Context,
+	serviceUuid service.ServiceUUID,
+	objAttrsProvider object_attributes_provider.DockerEnclaveObjectAttributesProvider,
+	serviceMountpointsToSharedDirectory map[string]service_directory.SharedDirectory,
+	dockerManager *docker_manager.DockerManager,
+) (map[string]string, error) {
+	sharedDirectories := map[string]string{}
+	for serviceDirPath, sharedDirectory := range serviceMountpointsToSharedDirectory {
+		volumeAttrs, err := objAttrsProvider.ForSharedDirectoryVolume(sharedDirectory.Name)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Error creating the volume attributes of shared directory '%s'", sharedDirectory.Name)
+		}
+
+		volumeName := volumeAttrs.GetName().GetString()
+		if _, found := sharedDirectories[volumeName]; found {
+			return nil, stacktrace.NewError("Shared directory '%s' is mounted more than once in service '%v'", sharedDirectory.Name, serviceUuid)
+		}
+		volumeLabelsStrs := map[string]string{}
+		for key, value := range volumeAttrs.GetLabels() {
+			volumeLabelsStrs[key.GetString()] = value.GetString()
+		}
+
+		// The name filter matches on substrings, so the volume names are compared exactly
+		potentiallyExistingVolumes, err := dockerManager.GetVolumesByName(ctx, volumeName)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred checking for shared directory volume existence")
+		}
+		volumeExists := false
+		for _, existingVolumeName := range potentiallyExistingVolumes {
+			if existingVolumeName == volumeName {
+				volumeExists = true
+				break
+			}
+		}
+
+		// Creating a volume that already exists is a no-op in Docker, so services sharing a directory can be started
+		// in parallel
+		if !volumeExists {
+			if err = dockerManager.CreateVolume(ctx, volumeName, volumeLabelsStrs); err != nil {
+				return nil, stacktrace.Propagate(
+					err,
+					"An error occurred creating the volume of shared directory '%s' for service '%v'",
+					sharedDirectory.Name,
+					serviceUuid,
+				)
+			}
+		}
+		sharedDirectories[volumeName] = serviceDirPath
+	}
+	return sharedDirectories, nil
+}
@@ -319,6 +319,12 @@ func RemoveRegisteredUserServiceProcesses(
 		return nil, nil, stacktrace.Propagate(err, "An error occurred getting user services matching filters '%+v'", removeServiceFilters)
 	}
 
+	// Sidecars live in the network namespace of the service container, so they're removed before it
+	for serviceUuid, sidecarsErr := range removeSidecars(ctx, allDockerResources, dockerManager) {
+		failedServicesPool[serviceUuid] = sidecarsErr
+		delete(allDockerResources, serviceUuid)
+	}
+
 	servicesToStartByContainerId := map[string]*service.Service{}
 	for uuid, serviceResources := range allDockerResources {
 		serviceObj, found := allServiceObjs[uuid]
@@ -435,6 +441,17 @@ func restartUserServices(
 		return nil, nil, stacktrace.Propagate(err, "An error occurred starting user service containers matching filters '%+v'", startServiceFilters)
 	}
 
+	// Sidecars share the network namespace of the service container, so they can only be started once it's running
+	startedServicesDockerResources := map[service.ServiceUUID]*shared_helpers.UserServiceDockerResources{}
+	for uuidStr := range successfulUuidStrs {
+		serviceUuid := service.ServiceUUID(uuidStr)
+		startedServicesDockerResources[serviceUuid] = allDockerResources[serviceUuid]
+	}
+	for serviceUuid, sidecarsErr := range restartSidecars(ctx, startedServicesDockerResources, dockerManager) {
+		erroredUuidStrs[string(serviceUuid)] = sidecarsErr
+		delete(successfulUuidStrs, string(serviceUuid))
+	}
+
 	successfulServices := map[service.ServiceUUID]*service.Service{}
 	for uuidStr := range successfulUuidStrs {
 		serviceUuid := service.ServiceUUID(uuidStr)
@@ -580,6 +597,7 @@ func createStartServiceOperation(
 		privileged := serviceConfig.GetPrivileged()
 		bindMounts := serviceConfig.GetBindMounts()
 		hostPIDNamespace := serviceConfig.GetHostPIDNamespace()
+		networkAliases := serviceConfig.GetNetworkAliases()
 		if privileged || len(bindMounts) > 0 || hostPIDNamespace {
 			logrus.Warnf("service '%v' is starting with privileged=%v, bind_mounts=%v, host_pid_namespace=%v; this grants the container elevated host access", id, privileged, bindMounts, hostPIDNamespace)
 		}
@@ -679,6 +697,50 @@ func createStartServiceOperation(
 			}
 		}
 
+		if sharedDirectories := serviceConfig.GetSharedDirectories(); sharedDirectories != nil {
+			candidateVolumeMounts, err := getOrCreateSharedDirectories(
+				ctx,
+				serviceUUID,
+				enclaveObjAttrsProvider,
+				sharedDirectories.ServiceDirpathToSharedDirectory,
+				dockerManager,
+			)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred getting or creating shared directory volumes")
+			}
+			for dirpath, volumeName := range candidateVolumeMounts {
+				if _, found := volumeMounts[dirpath]; found {
+					return nil, stacktrace.NewError("An error occurred getting or creating shared directory volumes. Multiple volumes were mounted on the same path.")
+				}
+				volumeMounts[dirpath] = volumeName
+			}
+		}
+
+		// Sidecars left behind by a previous container of the service would keep the names of the new ones
+		orphanedSidecarsErroredUuids, err := removeOrphanedSidecars(ctx, serviceRegistration.GetEnclaveID(), map[service.ServiceUUID]bool{serviceUUID: true}, dockerManager)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred removing the sidecars left behind by user service with UUID '%v'", serviceUUID)
+		}
+		if sidecarsErr, found := orphanedSidecarsErroredUuids[serviceUUID]; found {
+			return nil, stacktrace.Propagate(sidecarsErr, "An error occurred removing the sidecars left behind by user service with UUID '%v'", serviceUUID)
+		}
+
+		if initContainers := serviceConfig.GetInitContainers(); len(initContainers) > 0 {
+			if err := runInitContainers(
+				ctx,
+				id,
+				serviceUUID,
+				initContainers,
+				enclaveObjAttrsProvider,
+				freeIpAddrProvider,
+				enclaveNetworkId,
+				volumeMounts,
+				dockerManager,
+			); err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred running the init containers of user service with UUID '%v'", serviceUUID)
+			}
+		}
+
 		containerAttrs, err := enclaveObjAttrsProvider.ForUserServiceContainer(
 			id,
 			serviceUUID,
@@ -737,6 +799,8 @@ func createStartServiceOperation(
 			labelStrs,
 		).WithAlias(
 			string(id),
+		).WithAdditionalAliases(
+			networkAliases,
 		).WithCPUAllocationMillicpus(
 			cpuAllocationMillicpus,
 		).WithMemoryAllocationMegabytes(
@@ -753,6 +817,12 @@ func createStartServiceOperation(
 			user,
 		)
 
+		// the registry credentials get resolved at execution time so the image is pulled with them here rather than
+		// during validation
+		if imageRegistrySpec := serviceConfig.GetImageRegistrySpec(); imageRegistrySpec != nil {
+			createAndStartArgsBuilder.WithImageRegistrySpec(imageRegistrySpec)
+		}
+
 		if shouldTurnOnLogsCollection {
 			if logsCollectorAddress == "" {
 				return nil, stacktrace.NewError("Expected to have a logs collector server address value to send the user service logs, but it is empty")
@@ -851,6 +921,23 @@ func createStartServiceOperation(
 			}
 		}()
 
+		if sidecars := serviceConfig.GetSidecars(); len(sidecars) > 0 {
+			if err := startSidecars(
+				ctx,
+				id,
+				serviceUUID,
+				sidecars,
+				containerId,
+				enclaveObjAttrsProvider,
+				enclaveNetworkId,
+				volumeMounts,
+				restartPolicy,
+				dockerManager,
+			); err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred starting the sidecars of user service with UUID '%v'", serviceUUID)
+			}
+		}
+
 		_, _, maybePublicIp, maybePublicPortSpecs, err := shared_helpers.GetIpAndPortInfoFromContainer(
 			containerName.GetString(),
 			labelStrs,
@@ -33,6 +33,12 @@ func StopUserServices(
 		return nil, nil, stacktrace.Propagate(err, "An error occurred getting user services matching filters '%+v'", filters)
 	}
 
+	// Sidecars live in the network namespace of the service container, so they're stopped before it
+	sidecarsErroredUuids := stopSidecars(ctx, allDockerResources, dockerManager)
+	for serviceUuid := range sidecarsErroredUuids {
+		delete(allDockerResources, serviceUuid)
+	}
+
 	servicesToStopByContainerId := map[string]*service.Service{}
 	for uuid, serviceResources := range allDockerResources {
 		serviceObj, found := allServiceObjs[uuid]
@@ -98,6 +104,13 @@ func StopUserServices(
 			uuidStr,
 		)
 	}
+	for serviceUuid, sidecarsErr := range sidecarsErroredUuids {
+		erroredUuids[serviceUuid] = stacktrace.Propagate(
+			sidecarsErr,
+			"An error occurred stopping service '%v'",
+			serviceUuid,
+		)
+	}
 
 	return successfulUuids, erroredUuids, nil
 }
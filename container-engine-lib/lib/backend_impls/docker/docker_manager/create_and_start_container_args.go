@@ -15,6 +15,7 @@ type CreateAndStartContainerArgs struct {
 	dockerImage                              string
 	name                                     string
 	alias                                    string
+	additionalAliases                        []string
 	interactiveModeTtySize                   *InteractiveModeTtySize // If nil interactive mode will be disabled; if non-nil then interactive mode will be enabled
 	networkId                                string
 	staticIp                                 net.IP
@@ -53,6 +54,7 @@ type CreateAndStartContainerArgsBuilder struct {
 	dockerImage                              string
 	name                                     string
 	alias                                    string
+	additionalAliases                        []string
 	interactiveModeTtySize                   *InteractiveModeTtySize // If nil interactive mode will be disabled; if non-nil then interactive mode will be enabled
 	networkId                                string
 	staticIp                                 net.IP
@@ -98,6 +100,7 @@ func NewCreateAndStartContainerArgsBuilder(dockerImage string, name string, netw
 		dockerImage:                              dockerImage,
 		name:                                     name,
 		alias:                                    "",
+		additionalAliases:                        nil,
 		interactiveModeTtySize:                   nil,
 		networkId:                                networkId,
 		staticIp:                                 nil,
@@ -168,6 +171,7 @@ func (builder *CreateAndStartContainerArgsBuilder) Build() *CreateAndStartContai
 		name:                                     builder.name,
 		labels:                                   builder.labels,
 		alias:                                    builder.alias,
+		additionalAliases:                        builder.additionalAliases,
 		interactiveModeTtySize:                   builder.interactiveModeTtySize,
 		networkId:                                builder.networkId,
 		staticIp:                                 builder.staticIp,
@@ -207,6 +211,13 @@ func (builder *CreateAndStartContainerArgsBuilder) WithAlias(alias string) *Crea
 	return builder
 }
 
+// Extra aliases the container is reachable at on the network, on top of the one set with WithAlias. Several containers
+// can share the same alias, in which case Docker resolves it to all of them
+func (builder *CreateAndStartContainerArgsBuilder) WithAdditionalAliases(additionalAliases []string) *CreateAndStartContainerArgsBuilder {
+	builder.additionalAliases = additionalAliases
+	return builder
+}
+
 // If non-nil, the container will be started in interactive mode, with a container TTY
 // set to the specified dimensions
 func (builder *CreateAndStartContainerArgsBuilder) WithInteractiveModeTtySize(size *InteractiveModeTtySize) *CreateAndStartContainerArgsBuilder {
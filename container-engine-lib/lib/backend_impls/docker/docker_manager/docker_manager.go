@@ -42,6 +42,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_build_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_registry_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/interactive_exec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/nix_build_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/concurrent_writer"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/image_utils"
@@ -704,6 +705,7 @@ func (manager *DockerManager) CreateAndStartContainer(
 	var networkConfig *network.NetworkingConfig
 	if args.staticIp != nil && args.skipAddingToBridgeNetworkIfStaticIpIsSet {
 		targetNetworkEndPointSettings := getEndpointSettingsForIpAddress(args.staticIp.String(), args.alias)
+		targetNetworkEndPointSettings.Aliases = append(targetNetworkEndPointSettings.Aliases, args.additionalAliases...)
 		endpointSettingsByNetworkId := map[string]*network.EndpointSettings{}
 		endpointSettingsByNetworkId[args.networkId] = targetNetworkEndPointSettings
 		networkConfig = &network.NetworkingConfig{
@@ -1241,12 +1243,25 @@ func (manager *DockerManager) RunExecCommandWithStreamedOutput(context context.C
 		}
 		defer attachResp.Close()
 
+		// No TTY is allocated so stdout and stderr are multiplexed in the same stream, and need to be demultiplexed
+		// before being split into lines
+		demultiplexedOutputReader, demultiplexedOutputWriter := io.Pipe()
+		go func() {
+			_, err := stdcopy.StdCopy(demultiplexedOutputWriter, demultiplexedOutputWriter, attachResp.Reader)
+			//nolint:errcheck
+			demultiplexedOutputWriter.CloseWithError(err)
+		}()
+
 		// Stream output from docker through output channel
-		reader := bufio.NewReader(attachResp.Reader)
+		reader := bufio.NewReader(demultiplexedOutputReader)
 		for {
 			execOutputLine, err := reader.ReadString(streamOutputDelimiter)
 			if err != nil {
 				if err == io.EOF {
+					// the last line might not be terminated by a delimiter
+					if execOutputLine != "" {
+						execOutputChan <- execOutputLine
+					}
 					break
 				} else {
 					return
@@ -1278,6 +1293,112 @@ func (manager *DockerManager) RunExecCommandWithStreamedOutput(context context.C
 	return execOutputChan, finalExecResultChan, nil
 }
 
+/*
+RunInteractiveExecCommand
+Runs the command in the container with the given streams attached, and returns its exit code once it has exited
+*/
+func (manager *DockerManager) RunInteractiveExecCommand(ctx context.Context, containerId string, command []string, execStreams *interactive_exec.InteractiveExecStreams) (int32, error) {
+	dockerClient := manager.dockerClient
+	shouldAttachStdin := execStreams.GetStdin() != nil
+	execConfig := container.ExecOptions{
+		User:         "",
+		Privileged:   false,
+		Tty:          execStreams.IsTty(),
+		ConsoleSize:  nil,
+		AttachStdin:  shouldAttachStdin,
+		AttachStderr: true,
+		AttachStdout: true,
+		Detach:       false,
+		DetachKeys:   "",
+		Env:          nil,
+		WorkingDir:   "",
+		Cmd:          command,
+	}
+
+	createResp, err := dockerClient.ContainerExecCreate(ctx, containerId, execConfig)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred creating the exec process")
+	}
+
+	execId := createResp.ID
+	if execId == "" {
+		return 0, stacktrace.NewError("Got back an empty exec ID when running '%v' on container '%v'", command, containerId)
+	}
+
+	execStartConfig := container.ExecStartOptions{
+		Detach:      false,
+		Tty:         execStreams.IsTty(),
+		ConsoleSize: nil,
+	}
+
+	// As in RunUserServiceExecCommands, attaching starts the exec command
+	attachResp, err := dockerClient.ContainerExecAttach(ctx, execId, execStartConfig)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred starting/attaching to the exec command")
+	}
+	defer attachResp.Close()
+
+	if shouldAttachStdin {
+		go func() {
+			if _, err := io.Copy(attachResp.Conn, execStreams.GetStdin()); err != nil {
+				logrus.Debugf("Stopped forwarding stdin to exec '%v' because of error:\n%v", execId, err)
+			}
+			// lets the command know there's no more input
+			if err := attachResp.CloseWrite(); err != nil {
+				logrus.Debugf("An error occurred closing the stdin of exec '%v':\n%v", execId, err)
+			}
+		}()
+	}
+
+	if execStreams.IsTty() && execStreams.GetTerminalSizes() != nil {
+		resizeCtx, cancelResize := context.WithCancel(ctx)
+		defer cancelResize()
+		go func() {
+			for {
+				select {
+				case <-resizeCtx.Done():
+					return
+				case terminalSize, ok := <-execStreams.GetTerminalSizes():
+					if !ok {
+						return
+					}
+					resizeOptions := container.ResizeOptions{
+						Height: uint(terminalSize.Height),
+						Width:  uint(terminalSize.Width),
+					}
+					if err := dockerClient.ContainerExecResize(resizeCtx, execId, resizeOptions); err != nil {
+						logrus.Debugf("An error occurred resizing the TTY of exec '%v':\n%v", execId, err)
+					}
+				}
+			}
+		}()
+	}
+
+	if execStreams.IsTty() {
+		// with a TTY the output isn't multiplexed, stderr being merged into stdout
+		if _, err := io.Copy(execStreams.GetStdout(), attachResp.Reader); err != nil {
+			return 0, stacktrace.Propagate(err, "An error occurred copying the exec command output to stdout")
+		}
+	} else {
+		if _, err := stdcopy.StdCopy(execStreams.GetStdout(), execStreams.GetStderr(), attachResp.Reader); err != nil {
+			return 0, stacktrace.Propagate(err, "An error occurred copying the exec command output to stdout and stderr")
+		}
+	}
+
+	inspectResponse, err := dockerClient.ContainerExecInspect(ctx, execId)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred inspecting the exec to get the response code")
+	}
+	if inspectResponse.Running {
+		return 0, stacktrace.NewError("Expected exec to have stopped, but it's still running!")
+	}
+	unsizedExitCode := inspectResponse.ExitCode
+	if unsizedExitCode > math.MaxInt32 || unsizedExitCode < math.MinInt32 {
+		return 0, stacktrace.NewError("Could not cast unsized int '%v' to int32 because it does not fit", unsizedExitCode)
+	}
+	return int32(unsizedExitCode), nil
+}
+
 /*
 ConnectContainerToNetwork
 Connects the container with the given container ID to the network with the given network ID, using the given IP address
@@ -1689,6 +1810,18 @@ func (manager *DockerManager) CopyFromContainer(ctx context.Context, containerId
 	return tarStreamReadCloser, nil
 }
 
+// CopyToContainer extracts the given TAR content into the directory at dstPath, which must already exist in the container
+func (manager *DockerManager) CopyToContainer(ctx context.Context, containerId string, dstPath string, tarContent io.Reader) error {
+	copyOptions := container.CopyToContainerOptions{
+		AllowOverwriteDirWithFile: false,
+		CopyUIDGID:                false,
+	}
+	if err := manager.dockerClientNoTimeout.CopyToContainer(ctx, containerId, dstPath, tarContent, copyOptions); err != nil {
+		return stacktrace.Propagate(err, "An error occurred copying content to '%v' in container with ID '%v'", dstPath, containerId)
+	}
+	return nil
+}
+
 // GetAvailableCPUAndMemory returns free memory in megabytes, free cpu in millicores, information on whether cpu information is complete
 func (manager *DockerManager) GetAvailableCPUAndMemory(ctx context.Context) (compute_resources.MemoryInMegaBytes, compute_resources.CpuMilliCores, error) {
 	availableMemoryInBytes, availableCpuInMilliCores, err := getFreeMemoryAndCPU(ctx, manager.dockerClient)
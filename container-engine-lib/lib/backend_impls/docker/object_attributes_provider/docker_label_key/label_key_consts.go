@@ -32,6 +32,22 @@ const (
 
 	enclaveCreationTime = labelNamespaceStr + "enclave-creation-time"
 
+	enclaveExpirationTimeLabelKeyStr = labelNamespaceStr + "enclave-expiration-time"
+
+	enclaveIdleTimeoutLabelKeyStr = labelNamespaceStr + "enclave-idle-timeout"
+
+	enclaveCpuQuotaLabelKeyStr         = labelNamespaceStr + "enclave-cpu-quota"
+	enclaveMemoryQuotaLabelKeyStr      = labelNamespaceStr + "enclave-memory-quota"
+	enclaveMaxServicesQuotaLabelKeyStr = labelNamespaceStr + "enclave-max-services-quota"
+	enclaveDiskQuotaLabelKeyStr        = labelNamespaceStr + "enclave-disk-quota"
+
+	enclaveOwnerLabelKeyStr = labelNamespaceStr + "enclave-owner"
+
+	enclaveApiContainerVersionTagLabelKeyStr = labelNamespaceStr + "enclave-api-container-version-tag"
+	enclaveApiContainerLogLevelLabelKeyStr   = labelNamespaceStr + "enclave-api-container-log-level"
+
+	enclaveLastActivityTimeLabelKeyStr = labelNamespaceStr + "enclave-last-activity-time"
+
 	privateIpAddrLabelKeyStr = labelNamespaceStr + "private-ip"
 
 	// We create a duplicate of the enclave uuid and service uuid label key because:
@@ -65,6 +81,16 @@ var PortSpecsDockerLabelKey = MustCreateNewDockerLabelKey(portSpecsLabelKeyStr)
 var EnclaveUUIDDockerLabelKey = MustCreateNewDockerLabelKey(enclaveIdLabelKeyStr)
 var EnclaveNameDockerLabelKey = MustCreateNewDockerLabelKey(enclaveNameLabelKeyStr)
 var EnclaveCreationTimeLabelKey = MustCreateNewDockerLabelKey(enclaveCreationTime)
+var EnclaveExpirationTimeLabelKey = MustCreateNewDockerLabelKey(enclaveExpirationTimeLabelKeyStr)
+var EnclaveIdleTimeoutLabelKey = MustCreateNewDockerLabelKey(enclaveIdleTimeoutLabelKeyStr)
+var EnclaveCpuQuotaLabelKey = MustCreateNewDockerLabelKey(enclaveCpuQuotaLabelKeyStr)
+var EnclaveMemoryQuotaLabelKey = MustCreateNewDockerLabelKey(enclaveMemoryQuotaLabelKeyStr)
+var EnclaveMaxServicesQuotaLabelKey = MustCreateNewDockerLabelKey(enclaveMaxServicesQuotaLabelKeyStr)
+var EnclaveDiskQuotaLabelKey = MustCreateNewDockerLabelKey(enclaveDiskQuotaLabelKeyStr)
+var EnclaveOwnerLabelKey = MustCreateNewDockerLabelKey(enclaveOwnerLabelKeyStr)
+var EnclaveApiContainerVersionTagLabelKey = MustCreateNewDockerLabelKey(enclaveApiContainerVersionTagLabelKeyStr)
+var EnclaveApiContainerLogLevelLabelKey = MustCreateNewDockerLabelKey(enclaveApiContainerLogLevelLabelKeyStr)
+var EnclaveLastActivityTimeLabelKey = MustCreateNewDockerLabelKey(enclaveLastActivityTimeLabelKeyStr)
 var PrivateIPDockerLabelKey = MustCreateNewDockerLabelKey(privateIpAddrLabelKeyStr)
 var UserServiceGUIDDockerLabelKey = MustCreateNewDockerLabelKey(userServiceGuidDockerLabelKeyStr)
 var LogsEnclaveNameDockerLabelKey = MustCreateNewDockerLabelKey(logsOnlyEnclaveNameLabelKeyStr)
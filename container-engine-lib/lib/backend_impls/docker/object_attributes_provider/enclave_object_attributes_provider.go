@@ -13,6 +13,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/docker_object_name"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/docker_port_spec_serializer"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/label_value_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
@@ -25,12 +26,19 @@ const (
 	apiContainerNamePrefix = "kurtosis-api"
 
 	artifactExpansionVolumeNameFragment = "files-artifact-expansion"
+	sharedDirectoryVolumeNameFragment   = "shared-directory"
 
 	artifactsExpanderContainerNameFragment = "files-artifacts-expander"
 	logsCollectorFragment                  = "kurtosis-logs-collector"
 	// The collector is per enclave so this is a suffix
 	logsCollectorVolumeFragment = logsCollectorFragment + "-vol"
 
+	enclaveLifetimeVolumeFragment             = "kurtosis-enclave-lifetime"
+	enclaveResourceQuotaVolumeFragment        = "kurtosis-enclave-resource-quota"
+	enclaveOwnerVolumeFragment                = "kurtosis-enclave-owner"
+	enclaveApiContainerSettingsVolumeFragment = "kurtosis-enclave-api-container-settings"
+	enclaveLastActivityVolumeFragment         = "kurtosis-enclave-last-activity"
+
 	anyCharacterPrefixRegexToken = ".*"
 )
 
@@ -49,6 +57,16 @@ type DockerEnclaveObjectAttributesProvider interface {
 		privatePorts map[string]*port_spec.PortSpec,
 		userLabels map[string]string,
 	) (DockerObjectAttributes, error)
+	ForUserServiceInitContainer(
+		serviceName service.ServiceName,
+		serviceUuid service.ServiceUUID,
+		initContainerName string,
+	) (DockerObjectAttributes, error)
+	ForUserServiceSidecarContainer(
+		serviceName service.ServiceName,
+		serviceUuid service.ServiceUUID,
+		sidecarName string,
+	) (DockerObjectAttributes, error)
 	ForFilesArtifactsExpanderContainer(
 		serviceUUID service.ServiceUUID,
 	) (DockerObjectAttributes, error)
@@ -58,8 +76,16 @@ type DockerEnclaveObjectAttributesProvider interface {
 	ForSinglePersistentDirectoryVolume(
 		persistentKey service_directory.DirectoryPersistentKey,
 	) (DockerObjectAttributes, error)
+	ForSharedDirectoryVolume(
+		sharedDirectoryName service_directory.SharedDirectoryName,
+	) (DockerObjectAttributes, error)
 	ForLogsCollector(tcpPortId string, tcpPortSpec *port_spec.PortSpec, httpPortId string, httpPortSpec *port_spec.PortSpec) (DockerObjectAttributes, error)
 	ForLogsCollectorVolume() (DockerObjectAttributes, error)
+	ForEnclaveLifetimeVolume(lifetime *enclave.EnclaveLifetime) (DockerObjectAttributes, error)
+	ForEnclaveResourceQuotaVolume(resourceQuota *enclave.EnclaveResourceQuota) (DockerObjectAttributes, error)
+	ForEnclaveOwnerVolume(owner string) (DockerObjectAttributes, error)
+	ForEnclaveApiContainerSettingsVolume(apiContainerSettings *enclave.EnclaveApiContainerSettings) (DockerObjectAttributes, error)
+	ForEnclaveLastActivityVolume(lastActivityTime time.Time) (DockerObjectAttributes, error)
 }
 
 // Private so it can't be instantiated
@@ -371,6 +397,78 @@ func (provider *dockerEnclaveObjectAttributesProviderImpl) ForSinglePersistentDi
 	return objectAttributes, nil
 }
 
+// In Docker we get one volume per shared directory, mounted by all the services using it. The name has a fragment so it
+// can't collide with the volume of a persistent directory having the same key (name--shared-directory--enclave_uuid)
+func (provider *dockerEnclaveObjectAttributesProviderImpl) ForSharedDirectoryVolume(
+	sharedDirectoryName service_directory.SharedDirectoryName,
+) (
+	DockerObjectAttributes,
+	error,
+) {
+	guidStr, err := uuid_generator.GenerateUUIDString()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred generating a UUID for the volume of shared directory '%v'", sharedDirectoryName)
+	}
+
+	name, err := provider.getNameForEnclaveObject([]string{
+		string(sharedDirectoryName),
+		sharedDirectoryVolumeNameFragment,
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the name of the volume of shared directory '%v'", sharedDirectoryName)
+	}
+
+	labels, err := provider.getLabelsForEnclaveObjectWithGUID(guidStr)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting labels for the volume of shared directory '%v' with UUID '%v'", sharedDirectoryName, guidStr)
+	}
+
+	labels[docker_label_key.VolumeTypeDockerLabelKey] = label_value_consts.SharedDirectoryVolumeTypeDockerLabelValue
+
+	objectAttributes, err := newDockerObjectAttributesImpl(name, labels)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while creating the ObjectAttributesImpl with the name '%s' and labels '%+v'", name, labels)
+	}
+
+	return objectAttributes, nil
+}
+
+// GetPersistentKeyFromPersistentDirectoryVolumeName reverses the naming done by ForSinglePersistentDirectoryVolume,
+// returning false if the volume name doesn't belong to a persistent directory of the given enclave
+func GetPersistentKeyFromPersistentDirectoryVolumeName(
+	volumeName string,
+	enclaveUuid enclave.EnclaveUUID,
+) (service_directory.DirectoryPersistentKey, bool) {
+	enclaveSuffix := objectNameElementSeparator + string(enclaveUuid)
+	persistentKey, found := strings.CutSuffix(volumeName, enclaveSuffix)
+	if !found || persistentKey == "" {
+		return "", false
+	}
+	return service_directory.DirectoryPersistentKey(persistentKey), true
+}
+
+func (provider *dockerEnclaveObjectAttributesProviderImpl) ForUserServiceInitContainer(
+	serviceName service.ServiceName,
+	serviceUuid service.ServiceUUID,
+	initContainerName string,
+) (
+	DockerObjectAttributes,
+	error,
+) {
+	return provider.getAttributesForUserServiceAdditionalContainer(serviceName, serviceUuid, initContainerName, label_value_consts.UserServiceInitContainerTypeDockerLabelValue)
+}
+
+func (provider *dockerEnclaveObjectAttributesProviderImpl) ForUserServiceSidecarContainer(
+	serviceName service.ServiceName,
+	serviceUuid service.ServiceUUID,
+	sidecarName string,
+) (
+	DockerObjectAttributes,
+	error,
+) {
+	return provider.getAttributesForUserServiceAdditionalContainer(serviceName, serviceUuid, sidecarName, label_value_consts.UserServiceSidecarContainerTypeDockerLabelValue)
+}
+
 // We'll have at most one files artifact expansion container per service, because the single container will handle
 // all expansion
 func (provider *dockerEnclaveObjectAttributesProviderImpl) ForFilesArtifactsExpanderContainer(
@@ -460,6 +558,102 @@ func (provider *dockerEnclaveObjectAttributesProviderImpl) ForLogsCollectorVolum
 	return objectAttributes, nil
 }
 
+// Docker network labels can't be changed once the network exists, so the enclave lifetime, which can be extended, is
+// stored in the labels of an empty volume that gets replaced on every update
+func (provider *dockerEnclaveObjectAttributesProviderImpl) ForEnclaveLifetimeVolume(lifetime *enclave.EnclaveLifetime) (DockerObjectAttributes, error) {
+	name, err := provider.getNameForEnclaveObject([]string{enclaveLifetimeVolumeFragment})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the name for the enclave lifetime volume object")
+	}
+
+	labels := provider.getLabelsForEnclaveObject()
+	labels[docker_label_key.VolumeTypeDockerLabelKey] = label_value_consts.EnclaveLifetimeVolumeTypeDockerLabelValue
+
+	if lifetime.GetExpirationTime() != nil {
+		expirationTimeStr := lifetime.GetExpirationTime().Format(time.RFC3339)
+		expirationTimeLabelValue, err := docker_label_value.CreateNewDockerLabelValue(expirationTimeStr)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating a Docker label value object from enclave expiration time string '%v'", expirationTimeStr)
+		}
+		labels[docker_label_key.EnclaveExpirationTimeLabelKey] = expirationTimeLabelValue
+	}
+	if lifetime.GetIdleTimeout() != nil {
+		idleTimeoutStr := lifetime.GetIdleTimeout().String()
+		idleTimeoutLabelValue, err := docker_label_value.CreateNewDockerLabelValue(idleTimeoutStr)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating a Docker label value object from enclave idle timeout string '%v'", idleTimeoutStr)
+		}
+		labels[docker_label_key.EnclaveIdleTimeoutLabelKey] = idleTimeoutLabelValue
+	}
+
+	objectAttributes, err := newDockerObjectAttributesImpl(name, labels)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while creating the ObjectAttributesImpl with the name '%s' and labels '%+v'", name, labels)
+	}
+
+	return objectAttributes, nil
+}
+
+// Like the lifetime, the resource quota is stored in the labels of an empty volume so it can be set after the enclave
+// network has been created
+func (provider *dockerEnclaveObjectAttributesProviderImpl) ForEnclaveResourceQuotaVolume(resourceQuota *enclave.EnclaveResourceQuota) (DockerObjectAttributes, error) {
+	name, err := provider.getNameForEnclaveObject([]string{enclaveResourceQuotaVolumeFragment})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the name for the enclave resource quota volume object")
+	}
+
+	labels := provider.getLabelsForEnclaveObject()
+	labels[docker_label_key.VolumeTypeDockerLabelKey] = label_value_consts.EnclaveResourceQuotaVolumeTypeDockerLabelValue
+
+	quotaValues := map[*docker_label_key.DockerLabelKey]uint64{
+		docker_label_key.EnclaveCpuQuotaLabelKey:         uint64(resourceQuota.GetCpuMilliCores()),
+		docker_label_key.EnclaveMemoryQuotaLabelKey:      uint64(resourceQuota.GetMemoryMegaBytes()),
+		docker_label_key.EnclaveMaxServicesQuotaLabelKey: uint64(resourceQuota.GetMaxServices()),
+		docker_label_key.EnclaveDiskQuotaLabelKey:        resourceQuota.GetDiskMegaBytes(),
+	}
+	for labelKey, quotaValue := range quotaValues {
+		if quotaValue == 0 {
+			continue
+		}
+		quotaValueStr := strconv.FormatUint(quotaValue, 10)
+		quotaLabelValue, err := docker_label_value.CreateNewDockerLabelValue(quotaValueStr)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating a Docker label value object from enclave quota '%v'", quotaValueStr)
+		}
+		labels[labelKey] = quotaLabelValue
+	}
+
+	objectAttributes, err := newDockerObjectAttributesImpl(name, labels)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while creating the ObjectAttributesImpl with the name '%s' and labels '%+v'", name, labels)
+	}
+
+	return objectAttributes, nil
+}
+
+func (provider *dockerEnclaveObjectAttributesProviderImpl) ForEnclaveOwnerVolume(owner string) (DockerObjectAttributes, error) {
+	name, err := provider.getNameForEnclaveObject([]string{enclaveOwnerVolumeFragment})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the name for the enclave owner volume object")
+	}
+
+	labels := provider.getLabelsForEnclaveObject()
+	labels[docker_label_key.VolumeTypeDockerLabelKey] = label_value_consts.EnclaveOwnerVolumeTypeDockerLabelValue
+
+	ownerLabelValue, err := docker_label_value.CreateNewDockerLabelValue(owner)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating a Docker label value object from enclave owner '%v'", owner)
+	}
+	labels[docker_label_key.EnclaveOwnerLabelKey] = ownerLabelValue
+
+	objectAttributes, err := newDockerObjectAttributesImpl(name, labels)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while creating the ObjectAttributesImpl with the name '%s' and labels '%+v'", name, labels)
+	}
+
+	return objectAttributes, nil
+}
+
 // ====================================================================================================
 //
 //	Private Helper Functions
@@ -494,6 +688,50 @@ func (provider *dockerEnclaveObjectAttributesProviderImpl) getNameForUserService
 	return name, nil
 }
 
+// The init containers and sidecars of a service are named after it and labelled with its UUID, but they don't get the
+// user service container type so they're never mistaken for the service container itself
+func (provider *dockerEnclaveObjectAttributesProviderImpl) getAttributesForUserServiceAdditionalContainer(
+	serviceName service.ServiceName,
+	serviceUuid service.ServiceUUID,
+	additionalContainerName string,
+	containerTypeLabelValue *docker_label_value.DockerLabelValue,
+) (DockerObjectAttributes, error) {
+	serviceUuidStr := string(serviceUuid)
+
+	guidStr, err := uuid_generator.GenerateUUIDString()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred generating a UUID for container '%v' of service '%v'", additionalContainerName, serviceName)
+	}
+
+	nameStr := strings.Join(
+		[]string{
+			string(serviceName), additionalContainerName, serviceUuidStr,
+		},
+		objectNameElementSeparator,
+	)
+	name, err := docker_object_name.CreateNewDockerObjectName(nameStr)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating Docker object name from string '%v'", nameStr)
+	}
+
+	labels, err := provider.getLabelsForEnclaveObjectWithGUID(guidStr)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting labels for container '%v' of service '%v' with UUID '%v'", additionalContainerName, serviceName, guidStr)
+	}
+	serviceUuidLabelValue, err := docker_label_value.CreateNewDockerLabelValue(serviceUuidStr)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating a Docker label value from service UUID string '%v'", serviceUuidStr)
+	}
+	labels[docker_label_key.UserServiceGUIDDockerLabelKey] = serviceUuidLabelValue
+	labels[docker_label_key.ContainerTypeDockerLabelKey] = containerTypeLabelValue
+
+	objectAttributes, err := newDockerObjectAttributesImpl(name, labels)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while creating the ObjectAttributesImpl with the name '%s' and labels '%+v'", name, labels)
+	}
+	return objectAttributes, nil
+}
+
 func (provider *dockerEnclaveObjectAttributesProviderImpl) getLabelsForEnclaveObject() map[*docker_label_key.DockerLabelKey]*docker_label_value.DockerLabelValue {
 	return map[*docker_label_key.DockerLabelKey]*docker_label_value.DockerLabelValue{
 		docker_label_key.EnclaveUUIDDockerLabelKey:     provider.enclaveId,
@@ -590,3 +828,59 @@ func getLabelKeyValuesAsStrings(labels map[*docker_label_key.DockerLabelKey]*doc
 	}
 	return result
 }
+
+func (provider *dockerEnclaveObjectAttributesProviderImpl) ForEnclaveApiContainerSettingsVolume(apiContainerSettings *enclave.EnclaveApiContainerSettings) (DockerObjectAttributes, error) {
+	name, err := provider.getNameForEnclaveObject([]string{enclaveApiContainerSettingsVolumeFragment})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the name for the enclave API container settings volume object")
+	}
+
+	labels := provider.getLabelsForEnclaveObject()
+	labels[docker_label_key.VolumeTypeDockerLabelKey] = label_value_consts.EnclaveApiContainerSettingsVolumeTypeDockerLabelValue
+
+	settingValues := map[*docker_label_key.DockerLabelKey]string{
+		docker_label_key.EnclaveApiContainerVersionTagLabelKey: apiContainerSettings.GetVersionTag(),
+		docker_label_key.EnclaveApiContainerLogLevelLabelKey:   apiContainerSettings.GetLogLevel(),
+	}
+	for labelKey, settingValue := range settingValues {
+		if settingValue == "" {
+			continue
+		}
+		settingLabelValue, err := docker_label_value.CreateNewDockerLabelValue(settingValue)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating a Docker label value object from enclave API container setting '%v'", settingValue)
+		}
+		labels[labelKey] = settingLabelValue
+	}
+
+	objectAttributes, err := newDockerObjectAttributesImpl(name, labels)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while creating the ObjectAttributesImpl with the name '%s' and labels '%+v'", name, labels)
+	}
+
+	return objectAttributes, nil
+}
+
+func (provider *dockerEnclaveObjectAttributesProviderImpl) ForEnclaveLastActivityVolume(lastActivityTime time.Time) (DockerObjectAttributes, error) {
+	name, err := provider.getNameForEnclaveObject([]string{enclaveLastActivityVolumeFragment})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the name for the enclave last activity volume object")
+	}
+
+	labels := provider.getLabelsForEnclaveObject()
+	labels[docker_label_key.VolumeTypeDockerLabelKey] = label_value_consts.EnclaveLastActivityVolumeTypeDockerLabelValue
+
+	lastActivityTimeStr := lastActivityTime.Format(time.RFC3339)
+	lastActivityTimeLabelValue, err := docker_label_value.CreateNewDockerLabelValue(lastActivityTimeStr)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating a Docker label value object from enclave last activity time string '%v'", lastActivityTimeStr)
+	}
+	labels[docker_label_key.EnclaveLastActivityTimeLabelKey] = lastActivityTimeLabelValue
+
+	objectAttributes, err := newDockerObjectAttributesImpl(name, labels)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while creating the ObjectAttributesImpl with the name '%s' and labels '%+v'", name, labels)
+	}
+
+	return objectAttributes, nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/docker_label_key"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
 	"github.com/stretchr/testify/require"
 )
 
@@ -87,3 +88,55 @@ func TestForUserServiceContainer(t *testing.T) {
 	require.Contains(t, objLabels, docker_label_key.LogsEnclaveNameDockerLabelKey)
 	require.Equal(t, enclaveName, objLabels[docker_label_key.LogsEnclaveNameDockerLabelKey].GetString())
 }
+
+func TestForUserServiceSidecarContainer(t *testing.T) {
+	objAttrsProvider := GetDockerObjectAttributesProvider()
+	enclaveObjAttrsProvider, err := objAttrsProvider.ForEnclaveWithName(enclaveUuid, enclaveName)
+	require.NoError(t, err, "An unexpected error occurred getting the enclave object attributes provider")
+
+	serviceName := service.ServiceName("nginx")
+	serviceUuid := service.ServiceUUID("3771c85af16a40a18201acf4b4b5ad28")
+	containerAttrs, err := enclaveObjAttrsProvider.ForUserServiceSidecarContainer(serviceName, serviceUuid, "log-shipper")
+	require.NoError(t, err, "An unexpected error occurred getting the sidecar container attributes")
+	require.Equal(t, "nginx--log-shipper--3771c85af16a40a18201acf4b4b5ad28", containerAttrs.GetName().GetString())
+
+	objLabels := containerAttrs.GetLabels()
+	require.Equal(t, "user-service-sidecar", objLabels[docker_label_key.ContainerTypeDockerLabelKey].GetString())
+	require.Equal(t, string(serviceUuid), objLabels[docker_label_key.UserServiceGUIDDockerLabelKey].GetString())
+	require.Equal(t, enclaveUuid, objLabels[docker_label_key.EnclaveUUIDDockerLabelKey].GetString())
+	// the sidecar has its own UUID, so it's never found when looking up the service container by UUID
+	require.NotEqual(t, string(serviceUuid), objLabels[docker_label_key.GUIDDockerLabelKey].GetString())
+}
+
+func TestGetPersistentKeyFromPersistentDirectoryVolumeName(t *testing.T) {
+	objAttrsProvider := GetDockerObjectAttributesProvider()
+	enclaveObjAttrsProvider, err := objAttrsProvider.ForEnclaveWithName(enclaveUuid, enclaveName)
+	require.NoError(t, err, "An unexpected error occurred getting the enclave object attributes provider")
+
+	persistentKey := service_directory.DirectoryPersistentKey("postgres-data")
+	volumeAttrs, err := enclaveObjAttrsProvider.ForSinglePersistentDirectoryVolume(persistentKey)
+	require.NoError(t, err, "An unexpected error occurred getting the persistent directory volume attributes")
+
+	parsedPersistentKey, found := GetPersistentKeyFromPersistentDirectoryVolumeName(volumeAttrs.GetName().GetString(), enclaveUuid)
+	require.True(t, found)
+	require.Equal(t, persistentKey, parsedPersistentKey)
+
+	_, found = GetPersistentKeyFromPersistentDirectoryVolumeName(volumeAttrs.GetName().GetString(), "3771c85af16a40a18201acf4b4b5ad28")
+	require.False(t, found)
+}
+
+func TestForSharedDirectoryVolume(t *testing.T) {
+	objAttrsProvider := GetDockerObjectAttributesProvider()
+	enclaveObjAttrsProvider, err := objAttrsProvider.ForEnclaveWithName(enclaveUuid, enclaveName)
+	require.NoError(t, err, "An unexpected error occurred getting the enclave object attributes provider")
+
+	volumeAttrs, err := enclaveObjAttrsProvider.ForSharedDirectoryVolume("ipc")
+	require.NoError(t, err, "An unexpected error occurred getting the shared directory volume attributes")
+	require.Equal(t, "ipc--shared-directory--"+enclaveUuid, volumeAttrs.GetName().GetString())
+	require.Equal(t, "shared-directory", volumeAttrs.GetLabels()[docker_label_key.VolumeTypeDockerLabelKey].GetString())
+
+	// the volume of a persistent directory with the same key is a different one
+	persistentVolumeAttrs, err := enclaveObjAttrsProvider.ForSinglePersistentDirectoryVolume("ipc")
+	require.NoError(t, err, "An unexpected error occurred getting the persistent directory volume attributes")
+	require.NotContains(t, volumeAttrs.GetName().GetString(), persistentVolumeAttrs.GetName().GetString())
+}
@@ -21,16 +21,26 @@ const (
 	apiContainerContainerTypeLabelValueStr           = "api-container"
 	userServiceContainerTypeLabelValueStr            = "user-service"
 	filesArtifactsExpanderContainerTypeLabelValueStr = "files-artifacts-expander"
+	userServiceInitContainerTypeLabelValueStr        = "user-service-init-container"
+	userServiceSidecarContainerTypeLabelValueStr     = "user-service-sidecar"
 
-	enclaveDataVolumeTypeLabelValueStr            = "enclave-data"
-	filesArtifactExpansionVolumeTypeLabelValueStr = "files-artifacts-expansion"
-	persistentDirectoryVolumeTypeLabelValueStr    = "persistent-directory"
-	logsAggregatorDataVolumeTypeLabelValueStr     = "logs-aggregator-data"
-	logsAggregatorConfigVolumeTypeLabelValueStr   = "logs-aggregator-config"
-	logsStorageVolumeTypeLabelValueStr            = "kurtosis-logs-storage"
-	logsCollectorVolumeTypeLabelValueStr          = "logs-collector-data"
-	githubAuthStorageVolumeTypeLabelValueStr      = "github-auth-storage"
-	dockerConfigStorageVolumeTypeLabelValueStr    = "docker-config-storage"
+	enclaveDataVolumeTypeLabelValueStr                 = "enclave-data"
+	filesArtifactExpansionVolumeTypeLabelValueStr      = "files-artifacts-expansion"
+	persistentDirectoryVolumeTypeLabelValueStr         = "persistent-directory"
+	sharedDirectoryVolumeTypeLabelValueStr             = "shared-directory"
+	logsAggregatorDataVolumeTypeLabelValueStr          = "logs-aggregator-data"
+	logsAggregatorConfigVolumeTypeLabelValueStr        = "logs-aggregator-config"
+	logsStorageVolumeTypeLabelValueStr                 = "kurtosis-logs-storage"
+	logsCollectorVolumeTypeLabelValueStr               = "logs-collector-data"
+	githubAuthStorageVolumeTypeLabelValueStr           = "github-auth-storage"
+	dockerConfigStorageVolumeTypeLabelValueStr         = "docker-config-storage"
+	enclaveLifetimeVolumeTypeLabelValueStr             = "enclave-lifetime"
+	enclaveResourceQuotaVolumeTypeLabelValueStr        = "enclave-resource-quota"
+	enclaveOwnerVolumeTypeLabelValueStr                = "enclave-owner"
+	enclaveApiContainerSettingsVolumeTypeLabelValueStr = "enclave-api-container-settings"
+	enclaveLastActivityVolumeTypeLabelValueStr         = "enclave-last-activity"
+	engineDataStorageVolumeTypeLabelValueStr           = "engine-data-storage"
+	filesArtifactsBlobStorageVolumeTypeLabelValueStr   = "files-artifacts-blob-storage"
 )
 
 // !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! DO NOT CHANGE THESE VALUES !!!!!!!!!!!!!!!!!!!!!!!!!!!!!
@@ -50,13 +60,23 @@ var ReverseProxyTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLab
 var APIContainerContainerTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(apiContainerContainerTypeLabelValueStr)
 var UserServiceContainerTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(userServiceContainerTypeLabelValueStr)
 var FilesArtifactExpanderContainerTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(filesArtifactsExpanderContainerTypeLabelValueStr)
+var UserServiceInitContainerTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(userServiceInitContainerTypeLabelValueStr)
+var UserServiceSidecarContainerTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(userServiceSidecarContainerTypeLabelValueStr)
 
 var EnclaveDataVolumeTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(enclaveDataVolumeTypeLabelValueStr)
 var FilesArtifactExpansionVolumeTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(filesArtifactExpansionVolumeTypeLabelValueStr)
 var PersistentDirectoryVolumeTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(persistentDirectoryVolumeTypeLabelValueStr)
+var SharedDirectoryVolumeTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(sharedDirectoryVolumeTypeLabelValueStr)
 var LogsAggregatorDataVolumeTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(logsAggregatorDataVolumeTypeLabelValueStr)
 var LogsAggregatorConfigVolumeTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(logsAggregatorConfigVolumeTypeLabelValueStr)
 var LogsStorageVolumeTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(logsStorageVolumeTypeLabelValueStr)
 var LogsCollectorVolumeTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(logsCollectorVolumeTypeLabelValueStr)
 var GitHubAuthStorageVolumeTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(githubAuthStorageVolumeTypeLabelValueStr)
 var DockerConfigStorageVolumeTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(dockerConfigStorageVolumeTypeLabelValueStr)
+var EnclaveLifetimeVolumeTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(enclaveLifetimeVolumeTypeLabelValueStr)
+var EnclaveResourceQuotaVolumeTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(enclaveResourceQuotaVolumeTypeLabelValueStr)
+var EnclaveOwnerVolumeTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(enclaveOwnerVolumeTypeLabelValueStr)
+var EnclaveApiContainerSettingsVolumeTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(enclaveApiContainerSettingsVolumeTypeLabelValueStr)
+var EnclaveLastActivityVolumeTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(enclaveLastActivityVolumeTypeLabelValueStr)
+var EngineDataStorageVolumeTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(engineDataStorageVolumeTypeLabelValueStr)
+var FilesArtifactsBlobStorageVolumeTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(filesArtifactsBlobStorageVolumeTypeLabelValueStr)
@@ -17,15 +17,17 @@ import (
 )
 
 const (
-	engineServerNamePrefix         = "kurtosis-engine"
-	logsAggregatorName             = "kurtosis-logs-aggregator"
-	logsStorageVolumeName          = "kurtosis-logs-storage"
-	logsAggregatorDataVolumeName   = logsAggregatorName + "-data"
-	logsAggregatorConfigVolumeName = logsAggregatorName + "-config"
-	githubAuthStorageVolumeName    = "kurtosis-github-auth-storage"
-	dockerConfigStorageVolumeName  = "kurtosis-docker-config-storage"
-	engineRESTAPIPortStr           = "engine-rest-api"
-	reverseProxyNamePrefix         = "kurtosis-reverse-proxy"
+	engineServerNamePrefix              = "kurtosis-engine"
+	logsAggregatorName                  = "kurtosis-logs-aggregator"
+	logsStorageVolumeName               = "kurtosis-logs-storage"
+	logsAggregatorDataVolumeName        = logsAggregatorName + "-data"
+	logsAggregatorConfigVolumeName      = logsAggregatorName + "-config"
+	githubAuthStorageVolumeName         = "kurtosis-github-auth-storage"
+	dockerConfigStorageVolumeName       = "kurtosis-docker-config-storage"
+	engineDataStorageVolumeName         = "kurtosis-engine-data-storage"
+	filesArtifactsBlobStorageVolumeName = "kurtosis-files-artifacts-blob-storage"
+	engineRESTAPIPortStr                = "engine-rest-api"
+	reverseProxyNamePrefix              = "kurtosis-reverse-proxy"
 )
 
 type DockerObjectAttributesProvider interface {
@@ -45,6 +47,8 @@ type DockerObjectAttributesProvider interface {
 	ForReverseProxy(engineGuid engine.EngineGUID) (DockerObjectAttributes, error)
 	ForGitHubAuthStorageVolume() (DockerObjectAttributes, error)
 	ForDockerConfigStorageVolume() (DockerObjectAttributes, error)
+	ForEngineDataStorageVolume() (DockerObjectAttributes, error)
+	ForFilesArtifactsBlobStorageVolume() (DockerObjectAttributes, error)
 }
 
 func GetDockerObjectAttributesProvider() DockerObjectAttributesProvider {
@@ -324,3 +328,37 @@ func (provider *dockerObjectAttributesProviderImpl) getTraefikLabelsForEngine(re
 
 	return labels, nil
 }
+
+func (provider *dockerObjectAttributesProviderImpl) ForEngineDataStorageVolume() (DockerObjectAttributes, error) {
+	name, err := docker_object_name.CreateNewDockerObjectName(engineDataStorageVolumeName)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating a Docker object name object from string '%v'", engineDataStorageVolumeName)
+	}
+
+	labels := map[*docker_label_key.DockerLabelKey]*docker_label_value.DockerLabelValue{
+		docker_label_key.VolumeTypeDockerLabelKey: label_value_consts.EngineDataStorageVolumeTypeDockerLabelValue,
+	}
+
+	objectAttributes, err := newDockerObjectAttributesImpl(name, labels)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while creating the ObjectAttributesImpl with the name '%s' and labels '%+v'", name, labels)
+	}
+	return objectAttributes, nil
+}
+
+func (provider *dockerObjectAttributesProviderImpl) ForFilesArtifactsBlobStorageVolume() (DockerObjectAttributes, error) {
+	name, err := docker_object_name.CreateNewDockerObjectName(filesArtifactsBlobStorageVolumeName)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating a Docker object name object from string '%v'", filesArtifactsBlobStorageVolumeName)
+	}
+
+	labels := map[*docker_label_key.DockerLabelKey]*docker_label_value.DockerLabelValue{
+		docker_label_key.VolumeTypeDockerLabelKey: label_value_consts.FilesArtifactsBlobStorageVolumeTypeDockerLabelValue,
+	}
+
+	objectAttributes, err := newDockerObjectAttributesImpl(name, labels)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while creating the ObjectAttributesImpl with the name '%s' and labels '%+v'", name, labels)
+	}
+	return objectAttributes, nil
+}
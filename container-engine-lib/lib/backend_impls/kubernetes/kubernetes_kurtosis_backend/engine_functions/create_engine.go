@@ -19,6 +19,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_label_key"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_label_value"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_value_consts"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/engine"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
@@ -39,6 +40,13 @@ const (
 	logsCollectorTcpPortNum                              = 9712
 	defaultHttpLogsAggregatorPortNum                     = 8686
 	logsVolumeName                                       = "logsdb"
+
+	// The engine keeps its database, e.g. the schedules of the runs, there; it must be the directory the engine opens
+	// its database in, which is the same as on Docker
+	engineDataVolumeName        = "engine-data"
+	engineDataDirPath           = "/kurtosis-data/engine/"
+	engineDataVolumeSizeInBytes = 1024 * 1024 * 1024
+	isEngineDataVolumeReadOnly  = false
 )
 
 var (
@@ -187,9 +195,15 @@ func CreateEngine(
 		}()
 	}
 
+	// The claim goes away with the namespace, so it doesn't need to be removed if something fails
+	engineDataVolumeClaim, err := createEngineDataPersistentVolumeClaim(ctx, namespaceName, engineAttributesProvider, kubernetesManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the engine data persistent volume claim")
+	}
+
 	logsAggregatorDeployment := vector.NewVectorLogsAggregatorResourcesManager()
 
-	enginePod, enginePodLabels, err := createEnginePod(ctx, namespaceName, engineNodeSelectors, configTolerations, engineAttributesProvider, imageOrgAndRepo, imageVersionTag, envVars, privatePortSpecs, logsAggregatorDeployment.GetLogsBaseDirPath(), serviceAccount.Name, kubernetesManager)
+	enginePod, enginePodLabels, err := createEnginePod(ctx, namespaceName, engineNodeSelectors, configTolerations, engineAttributesProvider, imageOrgAndRepo, imageVersionTag, envVars, privatePortSpecs, logsAggregatorDeployment.GetLogsBaseDirPath(), engineDataVolumeClaim.Name, serviceAccount.Name, kubernetesManager)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating the engine pod")
 	}
@@ -281,6 +295,11 @@ func CreateEngine(
 		return nil, stacktrace.Propagate(err, "An error occurred waiting for the engine grpc port '%v/%v' to become available", privateGrpcPortSpec.GetTransportProtocol(), privateGrpcPortSpec.GetNumber())
 	}
 
+	// The volume only exists once the engine pod got scheduled, which it did now that the engine is listening
+	if err := retainEngineDataVolume(ctx, namespaceName, engineDataVolumeClaim.Name, kubernetesManager); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred retaining the engine data volume so that it outlives the engine")
+	}
+
 	// TODO UNCOMMENT THIS ONCE WE HAVE GRPC-PROXY WIRED UP!!
 	/*
 		if err := waitForPortAvailabilityUsingNetstat(
@@ -516,6 +535,7 @@ func createEnginePod(
 	envVars map[string]string,
 	privatePorts map[string]*port_spec.PortSpec,
 	logsBaseDirPath string,
+	engineDataVolumeClaimName string,
 	serviceAccountName string,
 	kubernetesManager *kubernetes_manager.KubernetesManager,
 ) (*apiv1.Pod, map[*kubernetes_label_key.KubernetesLabelKey]*kubernetes_label_value.KubernetesLabelValue, error) {
@@ -570,15 +590,34 @@ func createEnginePod(
 					MountPropagation:  nil,
 					SubPathExpr:       "",
 				},
+				{
+					Name:              engineDataVolumeName,
+					ReadOnly:          isEngineDataVolumeReadOnly,
+					RecursiveReadOnly: nil,
+					MountPath:         engineDataDirPath,
+					SubPath:           "",
+					MountPropagation:  nil,
+					SubPathExpr:       "",
+				},
 			},
 		},
 	}
 
+	// nolint: exhaustruct
 	engineVolumes := []apiv1.Volume{
 		{
 			Name:         logsVolumeName,
 			VolumeSource: kubernetesManager.GetVolumeSourceForHostPath(logsBaseDirPath),
 		},
+		{
+			Name: engineDataVolumeName,
+			VolumeSource: apiv1.VolumeSource{
+				PersistentVolumeClaim: &apiv1.PersistentVolumeClaimVolumeSource{
+					ClaimName: engineDataVolumeClaimName,
+					ReadOnly:  isEngineDataVolumeReadOnly,
+				},
+			},
+		},
 	}
 	engineInitContainers := []apiv1.Container{}
 
@@ -602,6 +641,84 @@ func createEnginePod(
 	return pod, enginePodLabels, nil
 }
 
+// createEngineDataPersistentVolumeClaim claims the engine data volume that a previous engine left behind, if any, so
+// that the new engine finds the data of the previous one. Otherwise a new volume is claimed
+func createEngineDataPersistentVolumeClaim(
+	ctx context.Context,
+	namespace string,
+	engineAttributesProvider object_attributes_provider.KubernetesEngineObjectAttributesProvider,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) (*apiv1.PersistentVolumeClaim, error) {
+	volumeClaimAttributes, err := engineAttributesProvider.ForEngineDataPersistentVolumeClaim()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the engine data persistent volume claim attributes")
+	}
+	volumeClaimName := volumeClaimAttributes.GetName().GetString()
+	volumeClaimLabelStrs := shared_helpers.GetStringMapFromLabelMap(volumeClaimAttributes.GetLabels())
+
+	retainedVolumes, err := kubernetesManager.GetPersistentVolumesByLabels(ctx, getEngineDataVolumeLabels())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the engine data volumes left behind by previous engines")
+	}
+	var retainedVolume *apiv1.PersistentVolume
+	for idx, volume := range retainedVolumes.Items {
+		if volume.Status.Phase != apiv1.VolumeReleased && volume.Status.Phase != apiv1.VolumeAvailable {
+			continue
+		}
+		if retainedVolume == nil || retainedVolume.CreationTimestamp.Before(&volume.CreationTimestamp) {
+			retainedVolume = &retainedVolumes.Items[idx]
+		}
+	}
+
+	if retainedVolume == nil {
+		volumeClaim, err := kubernetesManager.CreatePersistentVolumeClaim(ctx, namespace, volumeClaimName, volumeClaimLabelStrs, engineDataVolumeSizeInBytes)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating persistent volume claim '%v' for a new engine data volume", volumeClaimName)
+		}
+		return volumeClaim, nil
+	}
+
+	logrus.Debugf("Reusing the engine data volume '%v' left behind by a previous engine", retainedVolume.Name)
+	availableVolume, err := kubernetesManager.MakePersistentVolumeAvailable(ctx, retainedVolume)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred making engine data volume '%v' available to the new engine", retainedVolume.Name)
+	}
+	volumeClaim, err := kubernetesManager.CreatePersistentVolumeClaimForVolume(ctx, namespace, volumeClaimName, volumeClaimLabelStrs, availableVolume)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating persistent volume claim '%v' for engine data volume '%v'", volumeClaimName, availableVolume.Name)
+	}
+	return volumeClaim, nil
+}
+
+// retainEngineDataVolume keeps the volume bound to the claim when the claim is removed along with the engine namespace,
+// which is what makes the engine data survive the engine being stopped or restarted
+func retainEngineDataVolume(
+	ctx context.Context,
+	namespace string,
+	volumeClaimName string,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) error {
+	volumeClaim, err := kubernetesManager.GetPersistentVolumeClaim(ctx, namespace, volumeClaimName)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting engine data persistent volume claim '%v'", volumeClaimName)
+	}
+	volumeName := volumeClaim.Spec.VolumeName
+	if volumeName == "" {
+		return stacktrace.NewError("Engine data persistent volume claim '%v' isn't bound to a volume even though the engine is running", volumeClaimName)
+	}
+	if err := kubernetesManager.RetainPersistentVolume(ctx, volumeName, getEngineDataVolumeLabels()); err != nil {
+		return stacktrace.Propagate(err, "An error occurred retaining engine data volume '%v'", volumeName)
+	}
+	return nil
+}
+
+func getEngineDataVolumeLabels() map[string]string {
+	return map[string]string{
+		kubernetes_label_key.AppIDKubernetesLabelKey.GetString():              label_value_consts.AppIDKubernetesLabelValue.GetString(),
+		kubernetes_label_key.KurtosisVolumeTypeKubernetesLabelKey.GetString(): label_value_consts.EngineDataVolumeTypeKubernetesLabelValue.GetString(),
+	}
+}
+
 func createEngineService(
 	ctx context.Context,
 	namespace string,
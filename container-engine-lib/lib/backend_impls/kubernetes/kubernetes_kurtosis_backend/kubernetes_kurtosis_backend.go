@@ -11,6 +11,7 @@ import (
 
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_build_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_registry_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/interactive_exec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/nix_build_spec"
 	apiv1 "k8s.io/api/core/v1"
 
@@ -430,6 +431,25 @@ func (backend *KubernetesKurtosisBackend) RunUserServiceExecCommandWithStreamedO
 		backend.kubernetesManager)
 }
 
+func (backend *KubernetesKurtosisBackend) RunUserServiceInteractiveExecCommand(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	cmd []string,
+	execStreams *interactive_exec.InteractiveExecStreams,
+) (int32, error) {
+	return user_services_functions.RunUserServiceInteractiveExecCommand(
+		ctx,
+		enclaveUuid,
+		serviceUuid,
+		cmd,
+		execStreams,
+		backend.cliModeArgs,
+		backend.apiContainerModeArgs,
+		backend.engineServerModeArgs,
+		backend.kubernetesManager)
+}
+
 func (backend *KubernetesKurtosisBackend) GetShellOnUserService(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID) (resultErr error) {
 	objectAndResources, err := shared_helpers.GetSingleUserServiceObjectsAndResources(ctx, enclaveUuid, serviceUuid, backend.cliModeArgs, backend.apiContainerModeArgs, backend.engineServerModeArgs, backend.kubernetesManager)
 	if err != nil {
@@ -458,6 +478,25 @@ func (backend *KubernetesKurtosisBackend) CopyFilesFromUserService(
 		backend.kubernetesManager)
 }
 
+func (backend *KubernetesKurtosisBackend) CopyFilesToUserService(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	dstDirpathOnService string,
+	tarContent io.Reader,
+) error {
+	return user_services_functions.CopyFilesToUserService(
+		ctx,
+		enclaveUuid,
+		serviceUuid,
+		dstDirpathOnService,
+		tarContent,
+		backend.cliModeArgs,
+		backend.apiContainerModeArgs,
+		backend.engineServerModeArgs,
+		backend.kubernetesManager)
+}
+
 func (backend *KubernetesKurtosisBackend) StopUserServices(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *service.ServiceFilters) (resultSuccessfulGuids map[service.ServiceUUID]bool, resultErroredGuids map[service.ServiceUUID]error, resultErr error) {
 	return user_services_functions.StopUserServices(
 		ctx,
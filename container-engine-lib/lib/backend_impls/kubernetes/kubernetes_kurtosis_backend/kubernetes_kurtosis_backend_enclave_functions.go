@@ -8,25 +8,39 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/logs_collector_functions"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/logs_collector_functions/implementations/fluentbit"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_label_key"
+	"io"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/user_services_functions"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_resource_collectors"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_annotation_key_consts"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_value_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/compute_resources"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/operation_parallelizer"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	apiv1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	applyconfigurationsv1 "k8s.io/client-go/applyconfigurations/core/v1"
 )
 
 // TODO: MIGRATE THIS FOLDER TO USE STRUCTURE OF USER_SERVICE_FUNCTIONS MODULE
 
+const (
+	// Each enclave has its own namespace, so these names don't need to be unique across enclaves
+	enclaveResourceQuotaName = "kurtosis-enclave-resource-quota"
+	enclaveLimitRangeName    = "kurtosis-enclave-limit-range"
+
+	enclaveQuotaMegabytesToBytesFactor = 1_000_000
+)
+
 // Any of these values being nil indicates that the resource doesn't exist
 type enclaveKubernetesResources struct {
 	// Will never be nil because enclaves are defined by namespaces
@@ -171,6 +185,331 @@ func (backend *KubernetesKurtosisBackend) UpdateEnclave(
 	return nil
 }
 
+func (backend *KubernetesKurtosisBackend) UpdateEnclaveLifetime(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	lifetime *enclave.EnclaveLifetime,
+) error {
+	_, kubernetesResources, err := backend.getSingleEnclaveAndKubernetesResources(ctx, enclaveUuid)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting enclave object and Kubernetes resources for enclave ID '%v'", enclaveUuid)
+	}
+	namespace := kubernetesResources.namespace
+	if namespace == nil {
+		return stacktrace.NewError("Cannot update the lifetime of enclave '%v' because no Kubernetes namespace exists for it", enclaveUuid)
+	}
+
+	// Annotations left out of an apply get removed, so the existing ones are applied again along with the new lifetime
+	updatedAnnotations := map[string]string{}
+	for annotationKey, annotationValue := range namespace.Annotations {
+		updatedAnnotations[annotationKey] = annotationValue
+	}
+	delete(updatedAnnotations, kubernetes_annotation_key_consts.EnclaveExpirationTimeAnnotationKey.GetString())
+	delete(updatedAnnotations, kubernetes_annotation_key_consts.EnclaveIdleTimeoutAnnotationKey.GetString())
+	if lifetime != nil && lifetime.GetExpirationTime() != nil {
+		updatedAnnotations[kubernetes_annotation_key_consts.EnclaveExpirationTimeAnnotationKey.GetString()] = lifetime.GetExpirationTime().Format(time.RFC3339)
+	}
+	if lifetime != nil && lifetime.GetIdleTimeout() != nil {
+		updatedAnnotations[kubernetes_annotation_key_consts.EnclaveIdleTimeoutAnnotationKey.GetString()] = lifetime.GetIdleTimeout().String()
+	}
+
+	namespaceApplyConfigurator := func(namespaceApplyConfig *applyconfigurationsv1.NamespaceApplyConfiguration) {
+		namespaceApplyConfig.WithAnnotations(updatedAnnotations)
+	}
+
+	if _, err := backend.kubernetesManager.UpdateNamespace(ctx, namespace.GetName(), namespaceApplyConfigurator); err != nil {
+		return stacktrace.Propagate(err, "An error occurred updating the lifetime of enclave with UUID '%v', it was trying to apply these new annotations '%+v'", enclaveUuid, updatedAnnotations)
+	}
+
+	return nil
+}
+
+func (backend *KubernetesKurtosisBackend) UpdateEnclaveOwner(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	owner string,
+) error {
+	_, kubernetesResources, err := backend.getSingleEnclaveAndKubernetesResources(ctx, enclaveUuid)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting enclave object and Kubernetes resources for enclave ID '%v'", enclaveUuid)
+	}
+	namespace := kubernetesResources.namespace
+	if namespace == nil {
+		return stacktrace.NewError("Cannot update the owner of enclave '%v' because no Kubernetes namespace exists for it", enclaveUuid)
+	}
+
+	// Annotations left out of an apply get removed, so the existing ones are applied again along with the new owner
+	updatedAnnotations := map[string]string{}
+	for annotationKey, annotationValue := range namespace.Annotations {
+		updatedAnnotations[annotationKey] = annotationValue
+	}
+	delete(updatedAnnotations, kubernetes_annotation_key_consts.EnclaveOwnerAnnotationKey.GetString())
+	if owner != "" {
+		updatedAnnotations[kubernetes_annotation_key_consts.EnclaveOwnerAnnotationKey.GetString()] = owner
+	}
+
+	namespaceApplyConfigurator := func(namespaceApplyConfig *applyconfigurationsv1.NamespaceApplyConfiguration) {
+		namespaceApplyConfig.WithAnnotations(updatedAnnotations)
+	}
+	if _, err := backend.kubernetesManager.UpdateNamespace(ctx, namespace.GetName(), namespaceApplyConfigurator); err != nil {
+		return stacktrace.Propagate(err, "An error occurred updating the owner of enclave with UUID '%v', it was trying to apply these new annotations '%+v'", enclaveUuid, updatedAnnotations)
+	}
+
+	return nil
+}
+
+func (backend *KubernetesKurtosisBackend) UpdateEnclaveApiContainerSettings(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	apiContainerSettings *enclave.EnclaveApiContainerSettings,
+) error {
+	_, kubernetesResources, err := backend.getSingleEnclaveAndKubernetesResources(ctx, enclaveUuid)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting enclave object and Kubernetes resources for enclave ID '%v'", enclaveUuid)
+	}
+	namespace := kubernetesResources.namespace
+	if namespace == nil {
+		return stacktrace.NewError("Cannot update the API container settings of enclave '%v' because no Kubernetes namespace exists for it", enclaveUuid)
+	}
+
+	// Annotations left out of an apply get removed, so the existing ones are applied again along with the new settings
+	updatedAnnotations := map[string]string{}
+	for annotationKey, annotationValue := range namespace.Annotations {
+		updatedAnnotations[annotationKey] = annotationValue
+	}
+	delete(updatedAnnotations, kubernetes_annotation_key_consts.EnclaveApiContainerVersionTagAnnotationKey.GetString())
+	delete(updatedAnnotations, kubernetes_annotation_key_consts.EnclaveApiContainerLogLevelAnnotationKey.GetString())
+	if apiContainerSettings != nil {
+		updatedAnnotations[kubernetes_annotation_key_consts.EnclaveApiContainerVersionTagAnnotationKey.GetString()] = apiContainerSettings.GetVersionTag()
+		updatedAnnotations[kubernetes_annotation_key_consts.EnclaveApiContainerLogLevelAnnotationKey.GetString()] = apiContainerSettings.GetLogLevel()
+	}
+
+	namespaceApplyConfigurator := func(namespaceApplyConfig *applyconfigurationsv1.NamespaceApplyConfiguration) {
+		namespaceApplyConfig.WithAnnotations(updatedAnnotations)
+	}
+	if _, err := backend.kubernetesManager.UpdateNamespace(ctx, namespace.GetName(), namespaceApplyConfigurator); err != nil {
+		return stacktrace.Propagate(err, "An error occurred updating the API container settings of enclave with UUID '%v', it was trying to apply these new annotations '%+v'", enclaveUuid, updatedAnnotations)
+	}
+
+	return nil
+}
+
+func (backend *KubernetesKurtosisBackend) UpdateEnclaveLastActivityTime(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	lastActivityTime time.Time,
+) error {
+	_, kubernetesResources, err := backend.getSingleEnclaveAndKubernetesResources(ctx, enclaveUuid)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting enclave object and Kubernetes resources for enclave ID '%v'", enclaveUuid)
+	}
+	namespace := kubernetesResources.namespace
+	if namespace == nil {
+		return stacktrace.NewError("Cannot update the last activity time of enclave '%v' because no Kubernetes namespace exists for it", enclaveUuid)
+	}
+
+	// Annotations left out of an apply get removed, so the existing ones are applied again along with the new time
+	updatedAnnotations := map[string]string{}
+	for annotationKey, annotationValue := range namespace.Annotations {
+		updatedAnnotations[annotationKey] = annotationValue
+	}
+	updatedAnnotations[kubernetes_annotation_key_consts.EnclaveLastActivityTimeAnnotationKey.GetString()] = lastActivityTime.Format(time.RFC3339)
+
+	namespaceApplyConfigurator := func(namespaceApplyConfig *applyconfigurationsv1.NamespaceApplyConfiguration) {
+		namespaceApplyConfig.WithAnnotations(updatedAnnotations)
+	}
+	if _, err := backend.kubernetesManager.UpdateNamespace(ctx, namespace.GetName(), namespaceApplyConfigurator); err != nil {
+		return stacktrace.Propagate(err, "An error occurred updating the last activity time of enclave with UUID '%v', it was trying to apply these new annotations '%+v'", enclaveUuid, updatedAnnotations)
+	}
+
+	return nil
+}
+
+func (backend *KubernetesKurtosisBackend) CopyEnclavePersistentDirectories(
+	ctx context.Context,
+	sourceEnclaveUuid enclave.EnclaveUUID,
+	destinationEnclaveUuid enclave.EnclaveUUID,
+) error {
+	_, sourceKubernetesResources, err := backend.getSingleEnclaveAndKubernetesResources(ctx, sourceEnclaveUuid)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting enclave object and Kubernetes resources for enclave ID '%v'", sourceEnclaveUuid)
+	}
+	if sourceKubernetesResources.namespace == nil {
+		return stacktrace.NewError("Cannot copy the persistent directories of enclave '%v' because no Kubernetes namespace exists for it", sourceEnclaveUuid)
+	}
+	_, destinationKubernetesResources, err := backend.getSingleEnclaveAndKubernetesResources(ctx, destinationEnclaveUuid)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting enclave object and Kubernetes resources for enclave ID '%v'", destinationEnclaveUuid)
+	}
+	if destinationKubernetesResources.namespace == nil {
+		return stacktrace.NewError("Cannot copy persistent directories into enclave '%v' because no Kubernetes namespace exists for it", destinationEnclaveUuid)
+	}
+
+	if err := user_services_functions.CopyPersistentDirectories(
+		ctx,
+		sourceEnclaveUuid,
+		sourceKubernetesResources.namespace.GetName(),
+		destinationEnclaveUuid,
+		destinationKubernetesResources.namespace.GetName(),
+		backend.objAttrsProvider,
+		backend.kubernetesManager,
+	); err != nil {
+		return stacktrace.Propagate(err, "An error occurred copying the persistent directories of enclave '%v' into enclave '%v'", sourceEnclaveUuid, destinationEnclaveUuid)
+	}
+	return nil
+}
+
+func (backend *KubernetesKurtosisBackend) GetPersistentDirectoryKeys(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+) (map[service_directory.DirectoryPersistentKey]bool, error) {
+	namespaceName, err := backend.getEnclaveNamespaceName(ctx, enclaveUuid)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the namespace of enclave '%v'", enclaveUuid)
+	}
+	persistentKeys, err := user_services_functions.GetPersistentDirectoryKeys(ctx, enclaveUuid, namespaceName, backend.objAttrsProvider, backend.kubernetesManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the persistent directories of enclave '%v'", enclaveUuid)
+	}
+	return persistentKeys, nil
+}
+
+func (backend *KubernetesKurtosisBackend) ExportPersistentDirectory(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	persistentKey service_directory.DirectoryPersistentKey,
+	output io.Writer,
+) error {
+	namespaceName, err := backend.getEnclaveNamespaceName(ctx, enclaveUuid)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the namespace of enclave '%v'", enclaveUuid)
+	}
+	if err := user_services_functions.ExportPersistentDirectory(
+		ctx,
+		enclaveUuid,
+		namespaceName,
+		persistentKey,
+		output,
+		backend.objAttrsProvider,
+		backend.kubernetesManager,
+	); err != nil {
+		return stacktrace.Propagate(err, "An error occurred exporting persistent directory '%v' of enclave '%v'", persistentKey, enclaveUuid)
+	}
+	return nil
+}
+
+func (backend *KubernetesKurtosisBackend) ImportPersistentDirectory(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	persistentKey service_directory.DirectoryPersistentKey,
+	size service_directory.DirectoryPersistentSize,
+	tarContent io.Reader,
+) error {
+	namespaceName, err := backend.getEnclaveNamespaceName(ctx, enclaveUuid)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the namespace of enclave '%v'", enclaveUuid)
+	}
+	if err := user_services_functions.ImportPersistentDirectory(
+		ctx,
+		enclaveUuid,
+		namespaceName,
+		persistentKey,
+		size,
+		tarContent,
+		backend.objAttrsProvider,
+		backend.kubernetesManager,
+	); err != nil {
+		return stacktrace.Propagate(err, "An error occurred importing persistent directory '%v' of enclave '%v'", persistentKey, enclaveUuid)
+	}
+	return nil
+}
+
+func (backend *KubernetesKurtosisBackend) UpdateEnclaveResourceQuota(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	resourceQuota *enclave.EnclaveResourceQuota,
+) error {
+	_, kubernetesResources, err := backend.getSingleEnclaveAndKubernetesResources(ctx, enclaveUuid)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting enclave object and Kubernetes resources for enclave ID '%v'", enclaveUuid)
+	}
+	namespace := kubernetesResources.namespace
+	if namespace == nil {
+		return stacktrace.NewError("Cannot update the resource quota of enclave '%v' because no Kubernetes namespace exists for it", enclaveUuid)
+	}
+	namespaceName := namespace.GetName()
+
+	quotaValuesByAnnotationKey := map[string]uint64{}
+	if resourceQuota != nil {
+		quotaValuesByAnnotationKey[kubernetes_annotation_key_consts.EnclaveCpuQuotaAnnotationKey.GetString()] = uint64(resourceQuota.GetCpuMilliCores())
+		quotaValuesByAnnotationKey[kubernetes_annotation_key_consts.EnclaveMemoryQuotaAnnotationKey.GetString()] = uint64(resourceQuota.GetMemoryMegaBytes())
+		quotaValuesByAnnotationKey[kubernetes_annotation_key_consts.EnclaveMaxServicesQuotaAnnotationKey.GetString()] = uint64(resourceQuota.GetMaxServices())
+		quotaValuesByAnnotationKey[kubernetes_annotation_key_consts.EnclaveDiskQuotaAnnotationKey.GetString()] = resourceQuota.GetDiskMegaBytes()
+	}
+
+	// Annotations left out of an apply get removed, so the existing ones are applied again along with the new quota
+	updatedAnnotations := map[string]string{}
+	for annotationKey, annotationValue := range namespace.Annotations {
+		updatedAnnotations[annotationKey] = annotationValue
+	}
+	delete(updatedAnnotations, kubernetes_annotation_key_consts.EnclaveCpuQuotaAnnotationKey.GetString())
+	delete(updatedAnnotations, kubernetes_annotation_key_consts.EnclaveMemoryQuotaAnnotationKey.GetString())
+	delete(updatedAnnotations, kubernetes_annotation_key_consts.EnclaveMaxServicesQuotaAnnotationKey.GetString())
+	delete(updatedAnnotations, kubernetes_annotation_key_consts.EnclaveDiskQuotaAnnotationKey.GetString())
+	for annotationKey, quotaValue := range quotaValuesByAnnotationKey {
+		if quotaValue != 0 {
+			updatedAnnotations[annotationKey] = strconv.FormatUint(quotaValue, 10)
+		}
+	}
+
+	namespaceApplyConfigurator := func(namespaceApplyConfig *applyconfigurationsv1.NamespaceApplyConfiguration) {
+		namespaceApplyConfig.WithAnnotations(updatedAnnotations)
+	}
+	if _, err := backend.kubernetesManager.UpdateNamespace(ctx, namespaceName, namespaceApplyConfigurator); err != nil {
+		return stacktrace.Propagate(err, "An error occurred updating the resource quota of enclave with UUID '%v', it was trying to apply these new annotations '%+v'", enclaveUuid, updatedAnnotations)
+	}
+
+	// The service count isn't mapped to a pod count because the API container and the files artifacts expanders
+	// run as pods in the enclave namespace too; the API container enforces it instead
+	hardLimits := apiv1.ResourceList{}
+	containerDefaultRequests := apiv1.ResourceList{}
+	if resourceQuota != nil && resourceQuota.GetCpuMilliCores() != 0 {
+		hardLimits[apiv1.ResourceRequestsCPU] = *resource.NewMilliQuantity(int64(resourceQuota.GetCpuMilliCores()), resource.DecimalSI)
+		// A quota on requests makes Kubernetes reject containers that don't declare one, which is what services
+		// without a min CPU look like, so those get a zero request by default
+		containerDefaultRequests[apiv1.ResourceCPU] = *resource.NewMilliQuantity(0, resource.DecimalSI)
+	}
+	if resourceQuota != nil && resourceQuota.GetMemoryMegaBytes() != 0 {
+		hardLimits[apiv1.ResourceRequestsMemory] = *resource.NewQuantity(int64(resourceQuota.GetMemoryMegaBytes())*enclaveQuotaMegabytesToBytesFactor, resource.DecimalSI)
+		containerDefaultRequests[apiv1.ResourceMemory] = *resource.NewQuantity(0, resource.DecimalSI)
+	}
+	if resourceQuota != nil && resourceQuota.GetDiskMegaBytes() != 0 {
+		hardLimits[apiv1.ResourceRequestsStorage] = *resource.NewQuantity(int64(resourceQuota.GetDiskMegaBytes())*enclaveQuotaMegabytesToBytesFactor, resource.DecimalSI)
+	}
+
+	if len(hardLimits) == 0 {
+		if err := backend.kubernetesManager.RemoveResourceQuota(ctx, namespaceName, enclaveResourceQuotaName); err != nil {
+			return stacktrace.Propagate(err, "An error occurred removing the resource quota of enclave '%v'", enclaveUuid)
+		}
+	} else {
+		if _, err := backend.kubernetesManager.ApplyResourceQuota(ctx, namespaceName, enclaveResourceQuotaName, namespace.Labels, hardLimits); err != nil {
+			return stacktrace.Propagate(err, "An error occurred applying the resource quota of enclave '%v'", enclaveUuid)
+		}
+	}
+
+	if len(containerDefaultRequests) == 0 {
+		if err := backend.kubernetesManager.RemoveLimitRange(ctx, namespaceName, enclaveLimitRangeName); err != nil {
+			return stacktrace.Propagate(err, "An error occurred removing the limit range of enclave '%v'", enclaveUuid)
+		}
+	} else {
+		if _, err := backend.kubernetesManager.ApplyLimitRange(ctx, namespaceName, enclaveLimitRangeName, namespace.Labels, containerDefaultRequests); err != nil {
+			return stacktrace.Propagate(err, "An error occurred applying the limit range of enclave '%v'", enclaveUuid)
+		}
+	}
+
+	return nil
+}
+
 func (backend *KubernetesKurtosisBackend) StopEnclaves(
 	ctx context.Context,
 	filters *enclave.EnclaveFilters,
@@ -576,12 +915,32 @@ func getEnclaveObjectsFromKubernetesResources(
 
 		enclaveName := getEnclaveNameFromEnclaveNamespace(resourcesForEnclaveId.namespace)
 
+		enclaveLifetime, err := getEnclaveLifetimeFromEnclaveNamespace(resourcesForEnclaveId.namespace)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the enclave's lifetime from the enclave's namespace '%+v'", resourcesForEnclaveId.namespace)
+		}
+
+		enclaveResourceQuota, err := getEnclaveResourceQuotaFromEnclaveNamespace(resourcesForEnclaveId.namespace)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the enclave's resource quota from the enclave's namespace '%+v'", resourcesForEnclaveId.namespace)
+		}
+
+		enclaveLastActivityTime, err := getEnclaveLastActivityTimeFromEnclaveNamespace(resourcesForEnclaveId.namespace)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the enclave's last activity time from the enclave's namespace '%+v'", resourcesForEnclaveId.namespace)
+		}
+
 		enclaveObj := enclave.NewEnclave(
 			enclaveId,
 			enclaveName,
 			enclaveStatus,
 			enclaveCreationTime,
 			false,
+			enclaveLifetime,
+			enclaveResourceQuota,
+			getEnclaveOwnerFromEnclaveNamespace(resourcesForEnclaveId.namespace),
+			getEnclaveApiContainerSettingsFromEnclaveNamespace(resourcesForEnclaveId.namespace),
+			enclaveLastActivityTime,
 		)
 
 		result[enclaveId] = enclaveObj
@@ -628,6 +987,72 @@ func getEnclaveCreationTimeFromEnclaveNamespace(namespace *apiv1.Namespace) (*ti
 	return &enclaveCreationTime, nil
 }
 
+// Returns nil if the enclave has no lifetime
+func getEnclaveLifetimeFromEnclaveNamespace(namespace *apiv1.Namespace) (*enclave.EnclaveLifetime, error) {
+	namespaceAnnotations := namespace.Annotations
+
+	var expirationTime *time.Time
+	if expirationTimeStr, found := namespaceAnnotations[kubernetes_annotation_key_consts.EnclaveExpirationTimeAnnotationKey.GetString()]; found {
+		parsedExpirationTime, err := time.Parse(time.RFC3339, expirationTimeStr)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred parsing enclave expiration time '%v' using this format '%v'", expirationTimeStr, time.RFC3339)
+		}
+		expirationTime = &parsedExpirationTime
+	}
+
+	var idleTimeout *time.Duration
+	if idleTimeoutStr, found := namespaceAnnotations[kubernetes_annotation_key_consts.EnclaveIdleTimeoutAnnotationKey.GetString()]; found {
+		parsedIdleTimeout, err := time.ParseDuration(idleTimeoutStr)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred parsing enclave idle timeout '%v'", idleTimeoutStr)
+		}
+		idleTimeout = &parsedIdleTimeout
+	}
+
+	if expirationTime == nil && idleTimeout == nil {
+		return nil, nil
+	}
+	return enclave.NewEnclaveLifetime(expirationTime, idleTimeout), nil
+}
+
+// Returns nil if the enclave has no resource quota
+func getEnclaveResourceQuotaFromEnclaveNamespace(namespace *apiv1.Namespace) (*enclave.EnclaveResourceQuota, error) {
+	namespaceAnnotations := namespace.Annotations
+
+	quotaValues := map[string]uint64{}
+	for _, annotationKey := range []string{
+		kubernetes_annotation_key_consts.EnclaveCpuQuotaAnnotationKey.GetString(),
+		kubernetes_annotation_key_consts.EnclaveMemoryQuotaAnnotationKey.GetString(),
+		kubernetes_annotation_key_consts.EnclaveMaxServicesQuotaAnnotationKey.GetString(),
+		kubernetes_annotation_key_consts.EnclaveDiskQuotaAnnotationKey.GetString(),
+	} {
+		quotaValueStr, found := namespaceAnnotations[annotationKey]
+		if !found {
+			continue
+		}
+		quotaValue, err := strconv.ParseUint(quotaValueStr, 10, 64)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred parsing the value '%v' of enclave namespace annotation '%v'", quotaValueStr, annotationKey)
+		}
+		quotaValues[annotationKey] = quotaValue
+	}
+
+	if len(quotaValues) == 0 {
+		return nil, nil
+	}
+	return enclave.NewEnclaveResourceQuota(
+		compute_resources.CpuMilliCores(quotaValues[kubernetes_annotation_key_consts.EnclaveCpuQuotaAnnotationKey.GetString()]),
+		compute_resources.MemoryInMegaBytes(quotaValues[kubernetes_annotation_key_consts.EnclaveMemoryQuotaAnnotationKey.GetString()]),
+		uint32(quotaValues[kubernetes_annotation_key_consts.EnclaveMaxServicesQuotaAnnotationKey.GetString()]),
+		quotaValues[kubernetes_annotation_key_consts.EnclaveDiskQuotaAnnotationKey.GetString()],
+	), nil
+}
+
+// Returns an empty string if the enclave has no owner
+func getEnclaveOwnerFromEnclaveNamespace(namespace *apiv1.Namespace) string {
+	return namespace.Annotations[kubernetes_annotation_key_consts.EnclaveOwnerAnnotationKey.GetString()]
+}
+
 func getEnclaveNameFromEnclaveNamespace(namespace *apiv1.Namespace) string {
 	namespaceAnnotations := namespace.Annotations
 
@@ -639,3 +1064,26 @@ func getEnclaveNameFromEnclaveNamespace(namespace *apiv1.Namespace) string {
 
 	return enclaveCreationTimeStr
 }
+
+// Returns nil if the enclave has no API container settings
+func getEnclaveApiContainerSettingsFromEnclaveNamespace(namespace *apiv1.Namespace) *enclave.EnclaveApiContainerSettings {
+	versionTag, foundVersionTag := namespace.Annotations[kubernetes_annotation_key_consts.EnclaveApiContainerVersionTagAnnotationKey.GetString()]
+	logLevel, foundLogLevel := namespace.Annotations[kubernetes_annotation_key_consts.EnclaveApiContainerLogLevelAnnotationKey.GetString()]
+	if !foundVersionTag && !foundLogLevel {
+		return nil
+	}
+	return enclave.NewEnclaveApiContainerSettings(versionTag, logLevel)
+}
+
+// Returns nil if no activity was recorded for the enclave
+func getEnclaveLastActivityTimeFromEnclaveNamespace(namespace *apiv1.Namespace) (*time.Time, error) {
+	lastActivityTimeStr, found := namespace.Annotations[kubernetes_annotation_key_consts.EnclaveLastActivityTimeAnnotationKey.GetString()]
+	if !found {
+		return nil, nil
+	}
+	lastActivityTime, err := time.Parse(time.RFC3339, lastActivityTimeStr)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing enclave last activity time '%v' using this format '%v'", lastActivityTimeStr, time.RFC3339)
+	}
+	return &lastActivityTime, nil
+}
@@ -3,6 +3,7 @@ package kubernetes_kurtosis_backend
 import (
 	"context"
 	"os"
+	"sort"
 
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_label_key"
@@ -41,6 +42,65 @@ func GetCLIBackend(ctx context.Context, storageClass string, engineNodeName stri
 	return wrappedBackend, nil
 }
 
+// GetCLIBackendForKubernetesCluster is like GetCLIBackend, but talks to the given Kubernetes cluster instead of the one
+// of the current kubeconfig context
+func GetCLIBackendForKubernetesCluster(ctx context.Context, kubernetesClusterName string, storageClass string, engineNodeName string, nodeSelectors map[string]string, tolerations []apiv1.Toleration) (backend_interface.KurtosisBackend, error) {
+	kubernetesConfig, err := GetCLIKubernetesConfigForCluster(kubernetesClusterName)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the configuration for Kubernetes cluster '%v'", kubernetesClusterName)
+	}
+
+	backendSupplier := func(_ context.Context, kubernetesManager *kubernetes_manager.KubernetesManager) (*KubernetesKurtosisBackend, error) {
+		return NewCLIModeKubernetesKurtosisBackend(kubernetesManager, engineNodeName, nodeSelectors, tolerations), nil
+	}
+
+	wrappedBackend, err := getWrappedKubernetesKurtosisBackend(
+		ctx,
+		kubernetesConfig,
+		backendSupplier,
+		storageClass,
+	)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred wrapping the CLI Kubernetes backend for Kubernetes cluster '%v'", kubernetesClusterName)
+	}
+
+	return wrappedBackend, nil
+}
+
+// GetCLIKubernetesConfigForCluster returns the configuration of the kubeconfig context for the given Kubernetes cluster,
+// which is the context with the same name as the cluster if any, or else the first context using the cluster
+func GetCLIKubernetesConfigForCluster(kubernetesClusterName string) (*rest.Config, error) {
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	rawKubeconfig, err := loadingRules.Load()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred loading the kubeconfig")
+	}
+
+	kubeconfigContextName := ""
+	if _, found := rawKubeconfig.Contexts[kubernetesClusterName]; found {
+		kubeconfigContextName = kubernetesClusterName
+	} else {
+		candidateContextNames := []string{}
+		for contextName, kubeconfigContext := range rawKubeconfig.Contexts {
+			if kubeconfigContext.Cluster == kubernetesClusterName {
+				candidateContextNames = append(candidateContextNames, contextName)
+			}
+		}
+		if len(candidateContextNames) == 0 {
+			return nil, stacktrace.NewError("No kubeconfig context was found for Kubernetes cluster '%v'", kubernetesClusterName)
+		}
+		sort.Strings(candidateContextNames)
+		kubeconfigContextName = candidateContextNames[0]
+	}
+
+	configOverrides := &clientcmd.ConfigOverrides{CurrentContext: kubeconfigContextName} //nolint:exhaustruct
+	kubernetesConfig, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, configOverrides).ClientConfig()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating kubernetes configuration for kubeconfig context '%v'", kubeconfigContextName)
+	}
+	return kubernetesConfig, nil
+}
+
 func GetEngineServerBackend(
 	ctx context.Context, storageClass string,
 ) (backend_interface.KurtosisBackend, error) {
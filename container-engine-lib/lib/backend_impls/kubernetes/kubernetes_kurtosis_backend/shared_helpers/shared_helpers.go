@@ -357,6 +357,7 @@ func GetUserServiceKubernetesResourcesMatchingGuids(
 		} else if numPodsForGuid == 1 {
 			kubernetesPod := kubernetesPodsForGuid[0]
 
+			// The sidecars of the service are init containers, so the service container is the only container
 			numContainersForPod := len(kubernetesPod.Spec.Containers)
 			if numContainersForPod != 1 {
 				return nil, stacktrace.NewError("Found %v containers associated with service GUID '%v'; this is a bug in Kurtosis", numContainersForPod, serviceUuid)
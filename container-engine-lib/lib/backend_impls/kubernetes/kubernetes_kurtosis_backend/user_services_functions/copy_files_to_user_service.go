@@ -0,0 +1,95 @@
+package user_services_functions
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/interactive_exec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/stacktrace"
+	apiv1 "k8s.io/api/core/v1"
+)
+
+const (
+	shouldAllocateTtyForTarExtraction = false
+)
+
+// extracts the TAR read from STDIN into the directory, creating it first
+var extractTarCommandString = `if command -v 'tar' > /dev/null; then mkdir -p '%v' && tar xf - -C '%v'; else echo "Cannot copy files to path '%v' because the tar binary doesn't exist on the machine" >&2; exit 1; fi`
+
+func CopyFilesToUserService(
+	ctx context.Context,
+	enclaveId enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	dstDirpath string,
+	tarContent io.Reader,
+	cliModeArgs *shared_helpers.CliModeArgs,
+	apiContainerModeArgs *shared_helpers.ApiContainerModeArgs,
+	engineServerModeArgs *shared_helpers.EngineServerModeArgs,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) error {
+	namespaceName, err := shared_helpers.GetEnclaveNamespaceName(ctx, enclaveId, cliModeArgs, apiContainerModeArgs, engineServerModeArgs, kubernetesManager)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting namespace name for enclave '%v'", enclaveId)
+	}
+
+	objectAndResources, err := shared_helpers.GetSingleUserServiceObjectsAndResources(ctx, enclaveId, serviceUuid, cliModeArgs, apiContainerModeArgs, engineServerModeArgs, kubernetesManager)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting user service object & Kubernetes resources for service '%v' in enclave '%v'", serviceUuid, enclaveId)
+	}
+	pod := objectAndResources.KubernetesResources.Pod
+	if pod == nil || pod.Status.Phase != apiv1.PodRunning {
+		return stacktrace.NewError(
+			"Cannot copy files to path '%v' on service '%v' in enclave '%v' because it has no running pod",
+			dstDirpath,
+			serviceUuid,
+			enclaveId,
+		)
+	}
+
+	commandToRun := fmt.Sprintf(extractTarCommandString, dstDirpath, dstDirpath, dstDirpath)
+	shWrappedCommandToRun := []string{
+		"sh",
+		"-c",
+		commandToRun,
+	}
+
+	// The TAR goes through STDIN, the same way 'kubectl cp' does it
+	stdOutOutput := &bytes.Buffer{}
+	stdErrOutput := &bytes.Buffer{}
+	execStreams := interactive_exec.NewInteractiveExecStreams(tarContent, stdOutOutput, stdErrOutput, shouldAllocateTtyForTarExtraction, nil)
+	exitCode, err := kubernetesManager.RunInteractiveExecCommand(
+		ctx,
+		namespaceName,
+		pod.Name,
+		userServiceContainerName,
+		shWrappedCommandToRun,
+		execStreams,
+	)
+	if err != nil {
+		return stacktrace.Propagate(
+			err,
+			"An error occurred running command '%v' on pod '%v' for service '%v' in namespace '%v'",
+			commandToRun,
+			pod.Name,
+			serviceUuid,
+			namespaceName,
+		)
+	}
+	if exitCode != tarSuccessExitCode {
+		return stacktrace.NewError(
+			"Command '%v' exited with non-%v exit code %v and the following STDERR:\n%v",
+			commandToRun,
+			tarSuccessExitCode,
+			exitCode,
+			stdErrOutput.String(),
+		)
+	}
+
+	return nil
+}
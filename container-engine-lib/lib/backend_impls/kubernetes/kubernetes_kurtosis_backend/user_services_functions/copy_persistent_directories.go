@@ -0,0 +1,265 @@
+package user_services_functions
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_label_key"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_value_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/interactive_exec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	apiv1 "k8s.io/api/core/v1"
+)
+
+const (
+	persistentDirectoryCopierImage         = "busybox"
+	persistentDirectoryCopierPodNamePrefix = "kurtosis-persistent-directory-copier"
+	persistentDirectoryCopierContainerName = "persistent-directory-copier"
+	persistentDirectoryCopierMountpoint    = "/persistent-directory"
+
+	persistentDirectoryCopierSuccessExitCode = int32(0)
+
+	noPersistentDirectoryCopierServiceAccount = ""
+)
+
+// CopyPersistentDirectories copies every persistent directory claim of the source enclave into a new claim with the
+// same persistent key in the destination enclave.
+// Volume snapshots can only be restored in the namespace they were taken in, and each enclave lives in its own
+// namespace, so the contents are instead streamed as a tar archive between a helper pod mounting each claim
+func CopyPersistentDirectories(
+	ctx context.Context,
+	sourceEnclaveUuid enclave.EnclaveUUID,
+	sourceNamespace string,
+	destinationEnclaveUuid enclave.EnclaveUUID,
+	destinationNamespace string,
+	objAttrsProvider object_attributes_provider.KubernetesObjectAttributesProvider,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) error {
+	sourceObjAttrsProvider := objAttrsProvider.ForEnclave(sourceEnclaveUuid)
+	destinationObjAttrsProvider := objAttrsProvider.ForEnclave(destinationEnclaveUuid)
+
+	enclaveDataDirVolumeAttrs, err := sourceObjAttrsProvider.ForEnclaveDataDirVolume()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the labels for enclave data dir volume")
+	}
+
+	claimSearchLabels := map[string]string{
+		kubernetes_label_key.KurtosisResourceTypeKubernetesLabelKey.GetString(): label_value_consts.EnclaveKurtosisResourceTypeKubernetesLabelValue.GetString(),
+		kubernetes_label_key.EnclaveUUIDKubernetesLabelKey.GetString():          string(sourceEnclaveUuid),
+	}
+	sourceClaims, err := kubernetesManager.GetPersistentVolumeClaimsByLabels(ctx, sourceNamespace, claimSearchLabels)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the persistent volume claims of enclave '%v'", sourceEnclaveUuid)
+	}
+
+	// The claims are ReadWriteOnce so the helper pod has to land on the node where a running service already mounts them
+	noPodLabels := map[string]string{}
+	sourcePods, err := kubernetesManager.GetPodsByLabels(ctx, sourceNamespace, noPodLabels)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the pods of enclave '%v'", sourceEnclaveUuid)
+	}
+
+	for _, sourceClaim := range sourceClaims.Items {
+		if sourceClaim.Name == enclaveDataDirVolumeAttrs.GetName().GetString() {
+			continue
+		}
+		persistentKeyStr, found := sourceClaim.Labels[kubernetes_label_key.IDKubernetesLabelKey.GetString()]
+		if !found {
+			continue
+		}
+		persistentKey := service_directory.DirectoryPersistentKey(persistentKeyStr)
+
+		destinationVolumeAttrs, err := destinationObjAttrsProvider.ForSinglePersistentDirectoryVolume(persistentKey)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred creating the labels for persist service directory '%s'", persistentKey)
+		}
+		destinationVolumeLabelsStrs := map[string]string{}
+		for key, value := range destinationVolumeAttrs.GetLabels() {
+			destinationVolumeLabelsStrs[key.GetString()] = value.GetString()
+		}
+		requestedStorage := sourceClaim.Spec.Resources.Requests[apiv1.ResourceStorage]
+		destinationClaim, err := kubernetesManager.CreatePersistentVolumeClaim(
+			ctx,
+			destinationNamespace,
+			destinationVolumeAttrs.GetName().GetString(),
+			destinationVolumeLabelsStrs,
+			requestedStorage.Value(),
+		)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred creating the persistent volume claim for '%s' in enclave '%v'", persistentKey, destinationEnclaveUuid)
+		}
+
+		sourceNodeSelectors := map[string]string{}
+		if nodeName, found := getNodeMountingClaim(sourcePods.Items, sourceClaim.Name); found {
+			sourceNodeSelectors[apiv1.LabelHostname] = nodeName
+		}
+
+		if err := copyClaimContents(ctx, sourceNamespace, sourceClaim.Name, sourceNodeSelectors, destinationNamespace, destinationClaim.Name, kubernetesManager); err != nil {
+			return stacktrace.Propagate(err, "An error occurred copying persistent directory '%v' from enclave '%v' to enclave '%v'", persistentKey, sourceEnclaveUuid, destinationEnclaveUuid)
+		}
+		logrus.Debugf("Copied persistent directory '%v' from enclave '%v' to enclave '%v'", persistentKey, sourceEnclaveUuid, destinationEnclaveUuid)
+	}
+	return nil
+}
+
+func copyClaimContents(
+	ctx context.Context,
+	sourceNamespace string,
+	sourceClaimName string,
+	sourceNodeSelectors map[string]string,
+	destinationNamespace string,
+	destinationClaimName string,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) error {
+	sourcePod, err := createPersistentDirectoryCopierPod(ctx, sourceNamespace, sourceClaimName, sourceNodeSelectors, kubernetesManager)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the pod mounting claim '%v' in namespace '%v'", sourceClaimName, sourceNamespace)
+	}
+	defer removePersistentDirectoryCopierPod(sourcePod, kubernetesManager)
+
+	noDestinationNodeSelectors := map[string]string{}
+	destinationPod, err := createPersistentDirectoryCopierPod(ctx, destinationNamespace, destinationClaimName, noDestinationNodeSelectors, kubernetesManager)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the pod mounting claim '%v' in namespace '%v'", destinationClaimName, destinationNamespace)
+	}
+	// The destination claim is ReadWriteOnce as well, so the pod has to be gone before a service can mount the claim
+	defer removePersistentDirectoryCopierPod(destinationPod, kubernetesManager)
+
+	archiveReader, archiveWriter := io.Pipe()
+	archiveErrChan := make(chan error, 1)
+	go func() {
+		archiveCmd := []string{"tar", "-C", persistentDirectoryCopierMountpoint, "-cf", "-", "."}
+		archiveStderr := &bytes.Buffer{}
+		archiveStreams := interactive_exec.NewInteractiveExecStreams(nil, archiveWriter, archiveStderr, false, nil)
+		exitCode, err := kubernetesManager.RunInteractiveExecCommand(ctx, sourceNamespace, sourcePod.Name, persistentDirectoryCopierContainerName, archiveCmd, archiveStreams)
+		if err == nil && exitCode != persistentDirectoryCopierSuccessExitCode {
+			err = stacktrace.NewError("Archiving command '%v' exited with non-%v exit code '%v' and output:\n%v", archiveCmd, persistentDirectoryCopierSuccessExitCode, exitCode, archiveStderr.String())
+		}
+		// A nil error closes the pipe normally, which the extracting side reads as the end of the archive
+		archiveWriter.CloseWithError(err)
+		archiveErrChan <- err
+	}()
+
+	extractCmd := []string{"tar", "-C", persistentDirectoryCopierMountpoint, "-xf", "-"}
+	extractStderr := &bytes.Buffer{}
+	extractStreams := interactive_exec.NewInteractiveExecStreams(archiveReader, io.Discard, extractStderr, false, nil)
+	exitCode, extractErr := kubernetesManager.RunInteractiveExecCommand(ctx, destinationNamespace, destinationPod.Name, persistentDirectoryCopierContainerName, extractCmd, extractStreams)
+	// Unblocks the archiving side in case the extraction stopped before reading the whole archive
+	archiveReader.Close()
+
+	if archiveErr := <-archiveErrChan; archiveErr != nil {
+		return stacktrace.Propagate(archiveErr, "An error occurred archiving the contents of claim '%v' in namespace '%v'", sourceClaimName, sourceNamespace)
+	}
+	if extractErr != nil {
+		return stacktrace.Propagate(extractErr, "An error occurred extracting the contents into claim '%v' in namespace '%v'", destinationClaimName, destinationNamespace)
+	}
+	if exitCode != persistentDirectoryCopierSuccessExitCode {
+		return stacktrace.NewError("Extracting command '%v' exited with non-%v exit code '%v' and output:\n%v", extractCmd, persistentDirectoryCopierSuccessExitCode, exitCode, extractStderr.String())
+	}
+	return nil
+}
+
+func createPersistentDirectoryCopierPod(
+	ctx context.Context,
+	namespace string,
+	claimName string,
+	nodeSelectors map[string]string,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) (*apiv1.Pod, error) {
+	podUuid, err := uuid_generator.GenerateUUIDString()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred generating a UUID for the persistent directory copier pod name")
+	}
+	podName := fmt.Sprintf("%s-%s", persistentDirectoryCopierPodNamePrefix, podUuid)
+
+	volumeWithClaim := &kubernetesVolumeWithClaim{
+		VolumeClaimName: claimName,
+	}
+	container := apiv1.Container{
+		Name:  persistentDirectoryCopierContainerName,
+		Image: persistentDirectoryCopierImage,
+		Command: []string{
+			"sh",
+			"-c",
+			"sleep 10000000s",
+		},
+		Args:       nil,
+		WorkingDir: "",
+		Ports:      nil,
+		EnvFrom:    nil,
+		Env:        nil,
+		Resources: apiv1.ResourceRequirements{
+			Limits:   nil,
+			Requests: nil,
+			Claims:   nil,
+		},
+		ResizePolicy: nil,
+		VolumeMounts: []apiv1.VolumeMount{
+			*volumeWithClaim.GetVolumeMount(persistentDirectoryCopierMountpoint),
+		},
+		VolumeDevices:            nil,
+		LivenessProbe:            nil,
+		ReadinessProbe:           nil,
+		StartupProbe:             nil,
+		Lifecycle:                nil,
+		TerminationMessagePath:   "",
+		TerminationMessagePolicy: "",
+		ImagePullPolicy:          "",
+		SecurityContext:          nil,
+		Stdin:                    false,
+		StdinOnce:                false,
+		TTY:                      false,
+		RestartPolicy:            nil,
+		RestartPolicyRules:       nil,
+	}
+
+	pod, err := kubernetesManager.CreatePod(
+		ctx,
+		namespace,
+		podName,
+		nil,
+		nil,
+		nil,
+		[]apiv1.Container{container},
+		[]apiv1.Volume{*volumeWithClaim.GetVolume()},
+		noPersistentDirectoryCopierServiceAccount,
+		apiv1.RestartPolicyNever,
+		nil,
+		nodeSelectors,
+	)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating pod '%v' in namespace '%v'", podName, namespace)
+	}
+	return pod, nil
+}
+
+func removePersistentDirectoryCopierPod(pod *apiv1.Pod, kubernetesManager *kubernetes_manager.KubernetesManager) {
+	// Background context so we still run this even if the input context was cancelled
+	if err := kubernetesManager.RemovePod(context.Background(), pod); err != nil {
+		logrus.Warnf("Attempted to remove pod '%v' in namespace '%v' but an error occurred:\n%v", pod.Name, pod.Namespace, err)
+		logrus.Warn("You may have to remove this pod manually.")
+	}
+}
+
+// Returns the node of a pod that mounts the given claim, if there's any
+func getNodeMountingClaim(pods []apiv1.Pod, claimName string) (string, bool) {
+	for _, pod := range pods {
+		if pod.Spec.NodeName == "" {
+			continue
+		}
+		for _, volume := range pod.Spec.Volumes {
+			if volume.PersistentVolumeClaim != nil && volume.PersistentVolumeClaim.ClaimName == claimName {
+				return pod.Spec.NodeName, true
+			}
+		}
+	}
+	return "", false
+}
@@ -74,5 +74,8 @@ func DestroyUserServices(
 			}
 		}
 	}
+	if err := removeUnusedReplicaSetServices(ctx, namespaceName, kubernetesManager); err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred removing the services of the replica sets without replicas in enclave '%v'", enclaveId)
+	}
 	return successfulGuids, erroredGuids, nil
 }
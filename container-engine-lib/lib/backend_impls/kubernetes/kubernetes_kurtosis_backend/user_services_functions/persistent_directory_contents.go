@@ -0,0 +1,167 @@
+package user_services_functions
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_label_key"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_value_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/interactive_exec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	apiv1 "k8s.io/api/core/v1"
+)
+
+// clears the mounted claim before extracting the TAR read from STDIN into it
+var replaceClaimContentsCommandString = `find '%v' -mindepth 1 -delete && tar -C '%v' -xf -`
+
+// GetPersistentDirectoryKeys returns the keys of the persistent directory claims existing in the enclave namespace
+func GetPersistentDirectoryKeys(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	namespace string,
+	objAttrsProvider object_attributes_provider.KubernetesObjectAttributesProvider,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) (map[service_directory.DirectoryPersistentKey]bool, error) {
+	enclaveDataDirVolumeAttrs, err := objAttrsProvider.ForEnclave(enclaveUuid).ForEnclaveDataDirVolume()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the labels for enclave data dir volume")
+	}
+
+	claimSearchLabels := map[string]string{
+		kubernetes_label_key.KurtosisResourceTypeKubernetesLabelKey.GetString(): label_value_consts.EnclaveKurtosisResourceTypeKubernetesLabelValue.GetString(),
+		kubernetes_label_key.EnclaveUUIDKubernetesLabelKey.GetString():          string(enclaveUuid),
+	}
+	claims, err := kubernetesManager.GetPersistentVolumeClaimsByLabels(ctx, namespace, claimSearchLabels)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the persistent volume claims of enclave '%v'", enclaveUuid)
+	}
+
+	persistentKeys := map[service_directory.DirectoryPersistentKey]bool{}
+	for _, claim := range claims.Items {
+		if claim.Name == enclaveDataDirVolumeAttrs.GetName().GetString() {
+			continue
+		}
+		persistentKeyStr, found := claim.Labels[kubernetes_label_key.IDKubernetesLabelKey.GetString()]
+		if !found {
+			continue
+		}
+		persistentKeys[service_directory.DirectoryPersistentKey(persistentKeyStr)] = true
+	}
+	return persistentKeys, nil
+}
+
+// ExportPersistentDirectory writes to output an uncompressed TAR of the contents of the persistent directory claim,
+// archived by a helper pod mounting it
+func ExportPersistentDirectory(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	namespace string,
+	persistentKey service_directory.DirectoryPersistentKey,
+	output io.Writer,
+	objAttrsProvider object_attributes_provider.KubernetesObjectAttributesProvider,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) error {
+	volumeAttrs, err := objAttrsProvider.ForEnclave(enclaveUuid).ForSinglePersistentDirectoryVolume(persistentKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the labels for persist service directory '%s'", persistentKey)
+	}
+	claimName := volumeAttrs.GetName().GetString()
+	if _, err := kubernetesManager.GetPersistentVolumeClaim(ctx, namespace, claimName); err != nil {
+		return stacktrace.Propagate(err, "Persistent directory '%v' doesn't exist in enclave '%v'", persistentKey, enclaveUuid)
+	}
+
+	pod, err := createPersistentDirectoryCopierPodOnMountingNode(ctx, namespace, claimName, kubernetesManager)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the pod mounting claim '%v' in namespace '%v'", claimName, namespace)
+	}
+	defer removePersistentDirectoryCopierPod(pod, kubernetesManager)
+
+	archiveCmd := []string{"tar", "-C", persistentDirectoryCopierMountpoint, "-cf", "-", "."}
+	archiveStderr := &bytes.Buffer{}
+	archiveStreams := interactive_exec.NewInteractiveExecStreams(nil, output, archiveStderr, false, nil)
+	exitCode, err := kubernetesManager.RunInteractiveExecCommand(ctx, namespace, pod.Name, persistentDirectoryCopierContainerName, archiveCmd, archiveStreams)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred archiving the contents of claim '%v' in namespace '%v'", claimName, namespace)
+	}
+	if exitCode != persistentDirectoryCopierSuccessExitCode {
+		return stacktrace.NewError("Archiving command '%v' exited with non-%v exit code '%v' and output:\n%v", archiveCmd, persistentDirectoryCopierSuccessExitCode, exitCode, archiveStderr.String())
+	}
+	return nil
+}
+
+// ImportPersistentDirectory replaces the contents of the persistent directory claim, creating it with the given size
+// if it doesn't exist yet, by the files of the uncompressed TAR
+func ImportPersistentDirectory(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	namespace string,
+	persistentKey service_directory.DirectoryPersistentKey,
+	size service_directory.DirectoryPersistentSize,
+	tarContent io.Reader,
+	objAttrsProvider object_attributes_provider.KubernetesObjectAttributesProvider,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) error {
+	volumeAttrs, err := objAttrsProvider.ForEnclave(enclaveUuid).ForSinglePersistentDirectoryVolume(persistentKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the labels for persist service directory '%s'", persistentKey)
+	}
+	claimName := volumeAttrs.GetName().GetString()
+	if _, err := kubernetesManager.GetPersistentVolumeClaim(ctx, namespace, claimName); err != nil {
+		volumeLabelsStrs := map[string]string{}
+		for key, value := range volumeAttrs.GetLabels() {
+			volumeLabelsStrs[key.GetString()] = value.GetString()
+		}
+		if _, err := kubernetesManager.CreatePersistentVolumeClaim(ctx, namespace, claimName, volumeLabelsStrs, int64(size)); err != nil {
+			return stacktrace.Propagate(err, "An error occurred creating the persistent volume claim for '%s' in enclave '%v'", persistentKey, enclaveUuid)
+		}
+	}
+
+	pod, err := createPersistentDirectoryCopierPodOnMountingNode(ctx, namespace, claimName, kubernetesManager)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the pod mounting claim '%v' in namespace '%v'", claimName, namespace)
+	}
+	defer removePersistentDirectoryCopierPod(pod, kubernetesManager)
+
+	replaceCmd := []string{
+		"sh",
+		"-c",
+		fmt.Sprintf(replaceClaimContentsCommandString, persistentDirectoryCopierMountpoint, persistentDirectoryCopierMountpoint),
+	}
+	extractStderr := &bytes.Buffer{}
+	extractStreams := interactive_exec.NewInteractiveExecStreams(tarContent, io.Discard, extractStderr, false, nil)
+	exitCode, err := kubernetesManager.RunInteractiveExecCommand(ctx, namespace, pod.Name, persistentDirectoryCopierContainerName, replaceCmd, extractStreams)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred extracting the contents into claim '%v' in namespace '%v'", claimName, namespace)
+	}
+	if exitCode != persistentDirectoryCopierSuccessExitCode {
+		return stacktrace.NewError("Extracting command '%v' exited with non-%v exit code '%v' and output:\n%v", replaceCmd, persistentDirectoryCopierSuccessExitCode, exitCode, extractStderr.String())
+	}
+	logrus.Debugf("Imported the contents of persistent directory '%v' in enclave '%v'", persistentKey, enclaveUuid)
+	return nil
+}
+
+// The claims are ReadWriteOnce so the helper pod has to land on the node where a running service may already mount it
+func createPersistentDirectoryCopierPodOnMountingNode(
+	ctx context.Context,
+	namespace string,
+	claimName string,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) (*apiv1.Pod, error) {
+	noPodLabels := map[string]string{}
+	pods, err := kubernetesManager.GetPodsByLabels(ctx, namespace, noPodLabels)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the pods in namespace '%v'", namespace)
+	}
+	nodeSelectors := map[string]string{}
+	if nodeName, found := getNodeMountingClaim(pods.Items, claimName); found {
+		nodeSelectors[apiv1.LabelHostname] = nodeName
+	}
+	return createPersistentDirectoryCopierPod(ctx, namespace, claimName, nodeSelectors, kubernetesManager)
+}
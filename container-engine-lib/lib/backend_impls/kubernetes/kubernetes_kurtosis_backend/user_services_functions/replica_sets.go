@@ -0,0 +1,111 @@
+package user_services_functions
+
+import (
+	"context"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_label_key"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_value_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+// Creates the headless service named after the replica set if it doesn't exist yet. It resolves to the pods of all the
+// replicas, like the network alias shared by the replicas does on Docker. Like the claims of shared directories, it
+// isn't removed when starting the replica fails as the other replicas may be using it
+func prepareReplicaSetService(
+	ctx context.Context,
+	namespace string,
+	objAttributeProviders object_attributes_provider.KubernetesEnclaveObjectAttributesProvider,
+	replicaSetName string,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) error {
+	serviceAttrs, err := objAttributeProviders.ForReplicaSetService(replicaSetName)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the attributes of the service of replica set '%s'", replicaSetName)
+	}
+	serviceLabelsStrs := shared_helpers.GetStringMapFromLabelMap(serviceAttrs.GetLabels())
+
+	existingServices, err := kubernetesManager.GetServicesByLabels(ctx, namespace, serviceLabelsStrs)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the service of replica set '%s'", replicaSetName)
+	}
+	if existingServices != nil && len(existingServices.Items) > 0 {
+		return nil
+	}
+
+	replicaPodLabels, err := getReplicaSetPodLabels(replicaSetName)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the labels of the pods of replica set '%s'", replicaSetName)
+	}
+	serviceName := serviceAttrs.GetName().GetString()
+	serviceAnnotationsStrs := shared_helpers.GetStringMapFromAnnotationMap(serviceAttrs.GetAnnotations())
+	if _, err := kubernetesManager.CreateHeadlessService(ctx, namespace, serviceName, serviceLabelsStrs, serviceAnnotationsStrs, replicaPodLabels); err != nil {
+		// the replicas are started in parallel, so another one may have created it in the meantime
+		createdServices, getErr := kubernetesManager.GetServicesByLabels(ctx, namespace, serviceLabelsStrs)
+		if getErr != nil || createdServices == nil || len(createdServices.Items) == 0 {
+			return stacktrace.Propagate(err, "An error occurred creating service '%s' of replica set '%s'", serviceName, replicaSetName)
+		}
+	}
+	return nil
+}
+
+// Removes the services of the replica sets that no registered service is a replica of anymore, so that their names
+// can be given to new services
+func removeUnusedReplicaSetServices(
+	ctx context.Context,
+	namespace string,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) error {
+	replicaSetServices, err := kubernetesManager.GetServicesByLabels(ctx, namespace, map[string]string{
+		kubernetes_label_key.KurtosisResourceTypeKubernetesLabelKey.GetString(): label_value_consts.ReplicaSetKurtosisResourceTypeKubernetesLabelValue.GetString(),
+	})
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the services of the replica sets in namespace '%s'", namespace)
+	}
+	if replicaSetServices == nil || len(replicaSetServices.Items) == 0 {
+		return nil
+	}
+	userServiceServices, err := kubernetesManager.GetServicesByLabels(ctx, namespace, map[string]string{
+		kubernetes_label_key.KurtosisResourceTypeKubernetesLabelKey.GetString(): label_value_consts.UserServiceKurtosisResourceTypeKubernetesLabelValue.GetString(),
+	})
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the services of the user services in namespace '%s'", namespace)
+	}
+
+	for _, replicaSetService := range replicaSetServices.Items {
+		replicaSetName := replicaSetService.Labels[kubernetes_label_key.IDKubernetesLabelKey.GetString()]
+		isUsed := false
+		for _, userServiceService := range userServiceServices.Items {
+			userServiceName := service.ServiceName(userServiceService.Labels[kubernetes_label_key.IDKubernetesLabelKey.GetString()])
+			if _, isReplica := service.GetReplicaIndex(replicaSetName, userServiceName); isReplica {
+				isUsed = true
+				break
+			}
+		}
+		if isUsed {
+			continue
+		}
+		replicaSetServiceToRemove := replicaSetService
+		if err := kubernetesManager.RemoveService(ctx, &replicaSetServiceToRemove); err != nil {
+			return stacktrace.Propagate(err, "An error occurred removing service '%s' of replica set '%s'", replicaSetService.Name, replicaSetName)
+		}
+		logrus.Debugf("Removed service '%s' of replica set '%s' as it has no replica left", replicaSetService.Name, replicaSetName)
+	}
+	return nil
+}
+
+// The replicas are matched by the label the replica set sets on them, which becomes a custom label of their pods
+func getReplicaSetPodLabels(replicaSetName string) (map[string]string, error) {
+	replicaSetLabelKey, err := kubernetes_label_key.CreateNewKubernetesUserCustomLabelKey(service.ReplicaSetLabelKey)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the Kubernetes label key of the replica set label")
+	}
+	return map[string]string{
+		kubernetes_label_key.KurtosisResourceTypeKubernetesLabelKey.GetString(): label_value_consts.UserServiceKurtosisResourceTypeKubernetesLabelValue.GetString(),
+		replicaSetLabelKey.GetString():                                          replicaSetName,
+	}, nil
+}
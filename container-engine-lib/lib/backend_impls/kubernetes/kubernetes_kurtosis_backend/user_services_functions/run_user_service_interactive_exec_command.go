@@ -0,0 +1,61 @@
+package user_services_functions
+
+import (
+	"context"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/interactive_exec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+func RunUserServiceInteractiveExecCommand(
+	ctx context.Context,
+	enclaveId enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	cmd []string,
+	execStreams *interactive_exec.InteractiveExecStreams,
+	cliModeArgs *shared_helpers.CliModeArgs,
+	apiContainerModeArgs *shared_helpers.ApiContainerModeArgs,
+	engineServerModeArgs *shared_helpers.EngineServerModeArgs,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) (int32, error) {
+	namespaceName, err := shared_helpers.GetEnclaveNamespaceName(ctx, enclaveId, cliModeArgs, apiContainerModeArgs, engineServerModeArgs, kubernetesManager)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred getting namespace name for enclave '%v'", enclaveId)
+	}
+
+	objectAndResources, err := shared_helpers.GetSingleUserServiceObjectsAndResources(ctx, enclaveId, serviceUuid, cliModeArgs, apiContainerModeArgs, engineServerModeArgs, kubernetesManager)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred getting user service object & Kubernetes resources for service '%v' in enclave '%v'", serviceUuid, enclaveId)
+	}
+	userServiceKubernetesService := objectAndResources.Service
+	if userServiceKubernetesService == nil || objectAndResources.KubernetesResources.Pod == nil {
+		return 0, stacktrace.NewError(
+			"Cannot execute command '%+v' on service '%v' because it has no running pod",
+			cmd,
+			serviceUuid)
+	}
+	if userServiceKubernetesService.GetContainer().GetStatus() != container.ContainerStatus_Running {
+		return 0, stacktrace.NewError(
+			"Cannot execute command '%+v' on service '%v' because the service status is '%v'",
+			cmd,
+			serviceUuid,
+			userServiceKubernetesService.GetContainer().GetStatus().String())
+	}
+
+	exitCode, err := kubernetesManager.RunInteractiveExecCommand(
+		ctx,
+		namespaceName,
+		objectAndResources.KubernetesResources.Pod.Name,
+		userServiceContainerName,
+		cmd,
+		execStreams)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred running command '%+v' on service '%v'", cmd, serviceUuid)
+	}
+	return exitCode, nil
+}
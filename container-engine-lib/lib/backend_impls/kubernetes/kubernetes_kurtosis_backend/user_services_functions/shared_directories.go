@@ -0,0 +1,62 @@
+package user_services_functions
+
+import (
+	"context"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// Gets the ReadWriteMany claims backing the shared directories of a service, creating the ones that don't exist yet.
+// A claim that its storage class can't provision makes the pod of the service fail to start rather than stay pending.
+// Unlike persistent directories, these claims are never removed when starting the service fails as other services may
+// be using them already; they're removed along with the enclave namespace
+func prepareSharedDirectoriesResources(
+	ctx context.Context,
+	namespace string,
+	objAttributeProviders object_attributes_provider.KubernetesEnclaveObjectAttributesProvider,
+	serviceMountpointsToSharedDirectory map[string]service_directory.SharedDirectory,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) (map[string]*kubernetesVolumeWithClaim, error) {
+	sharedVolumesAndClaims := map[string]*kubernetesVolumeWithClaim{}
+	claimNamesInUse := map[string]bool{}
+	for dirPath, sharedDirectory := range serviceMountpointsToSharedDirectory {
+		volumeAttrs, err := objAttributeProviders.ForSharedDirectoryVolume(sharedDirectory.Name)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating the labels for shared directory '%s'", sharedDirectory.Name)
+		}
+
+		volumeClaimName := volumeAttrs.GetName().GetString()
+		// A pod can't have two volumes with the same name
+		if claimNamesInUse[volumeClaimName] {
+			return nil, stacktrace.NewError("Shared directory '%s' is mounted more than once in the same service", sharedDirectory.Name)
+		}
+		claimNamesInUse[volumeClaimName] = true
+		volumeLabelsStrs := map[string]string{}
+		for key, value := range volumeAttrs.GetLabels() {
+			volumeLabelsStrs[key.GetString()] = value.GetString()
+		}
+
+		existingVolumeClaim, err := kubernetesManager.GetPersistentVolumeClaimIfExists(ctx, namespace, volumeClaimName)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the persistent volume claim for shared directory '%s'", sharedDirectory.Name)
+		}
+		if existingVolumeClaim == nil {
+			if _, err = kubernetesManager.CreateReadWriteManyPersistentVolumeClaim(ctx, namespace, volumeClaimName, volumeLabelsStrs, int64(sharedDirectory.Size)); err != nil {
+				// the services mounting the shared directory may be started in parallel, so another one may have
+				// created it in the meantime
+				createdVolumeClaim, getErr := kubernetesManager.GetPersistentVolumeClaimIfExists(ctx, namespace, volumeClaimName)
+				if getErr != nil || createdVolumeClaim == nil {
+					return nil, stacktrace.Propagate(err, "An error occurred creating the persistent volume claim for shared directory '%s'", sharedDirectory.Name)
+				}
+			}
+		}
+
+		sharedVolumesAndClaims[dirPath] = &kubernetesVolumeWithClaim{
+			VolumeClaimName: volumeClaimName,
+		}
+	}
+	return sharedVolumesAndClaims, nil
+}
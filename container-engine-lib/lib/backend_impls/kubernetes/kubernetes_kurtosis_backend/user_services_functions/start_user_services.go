@@ -491,6 +491,28 @@ func createStartServiceOperation(
 			}
 		}()
 
+		if sharedDirectories := serviceConfig.GetSharedDirectories(); sharedDirectories != nil {
+			sharedVolumesWithClaims, err := prepareSharedDirectoriesResources(
+				ctx,
+				namespaceName,
+				enclaveObjAttributesProvider,
+				sharedDirectories.ServiceDirpathToSharedDirectory,
+				kubernetesManager)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred getting or creating the persistent volume claims of the shared directories of service '%s'", serviceName)
+			}
+			for serviceMountDirPath, volumeAndClaim := range sharedVolumesWithClaims {
+				podVolumes = append(podVolumes, *volumeAndClaim.GetVolume())
+				userServiceContainerVolumeMounts = append(userServiceContainerVolumeMounts, *volumeAndClaim.GetVolumeMount(serviceMountDirPath))
+			}
+		}
+
+		if replicaSetName, isReplica := serviceConfig.GetReplicaSetName(); isReplica {
+			if err := prepareReplicaSetService(ctx, namespaceName, enclaveObjAttributesProvider, replicaSetName, kubernetesManager); err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred getting or creating the service of replica set '%s' of service '%s'", replicaSetName, serviceName)
+			}
+		}
+
 		// Create the pod
 		podAttributes, err := enclaveObjAttributesProvider.ForUserServicePod(serviceUuid, serviceName, privatePorts, serviceConfig.GetLabels())
 		if err != nil {
@@ -518,6 +540,12 @@ func createStartServiceOperation(
 		if err != nil {
 			return nil, stacktrace.Propagate(err, "An error occurred creating the container specs for the user service pod with image '%v'", containerImageName)
 		}
+		// User init containers run after the files artifacts expansion ones, so they can see the expanded files. The
+		// sidecars are native sidecars, i.e. init containers that keep running, so they are started once the user init
+		// containers are done and before the service container, and they don't keep the pod alive once it exits
+		podInitContainers = append(podInitContainers, getAdditionalContainerSpecs(serviceConfig.GetInitContainers(), userServiceContainerVolumeMounts, imageDownloadMode, nil)...)
+		sidecarRestartPolicy := apiv1.ContainerRestartPolicyAlways
+		podInitContainers = append(podInitContainers, getAdditionalContainerSpecs(serviceConfig.GetSidecars(), userServiceContainerVolumeMounts, imageDownloadMode, &sidecarRestartPolicy)...)
 
 		podName := podAttributes.GetName().GetString()
 		createdPod, err := kubernetesManager.CreatePod(
@@ -877,6 +905,44 @@ func getUserServicePodContainerSpecs(
 	return containers, nil
 }
 
+// Gets the specs of the init containers or sidecars of a service, which mount the same volumes as the service container
+// The restart policy is only set for sidecars, as it is what makes an init container a native sidecar
+func getAdditionalContainerSpecs(
+	additionalContainers []*service.AdditionalContainer,
+	containerMounts []apiv1.VolumeMount,
+	imageDownloadMode image_download_mode.ImageDownloadMode,
+	maybeRestartPolicy *apiv1.ContainerRestartPolicy,
+) []apiv1.Container {
+	imagePullPolicy := apiv1.PullIfNotPresent
+	if imageDownloadMode == image_download_mode.ImageDownloadMode_Always {
+		imagePullPolicy = apiv1.PullAlways
+	}
+
+	containers := []apiv1.Container{}
+	for _, additionalContainer := range additionalContainers {
+		var containerEnvVars []apiv1.EnvVar
+		for varName, varValue := range additionalContainer.GetEnvVars() {
+			containerEnvVars = append(containerEnvVars, apiv1.EnvVar{
+				Name:      varName,
+				Value:     varValue,
+				ValueFrom: nil,
+			})
+		}
+		// nolint: exhaustruct
+		containers = append(containers, apiv1.Container{
+			Name:            additionalContainer.GetName(),
+			Image:           additionalContainer.GetContainerImageName(),
+			Command:         additionalContainer.GetEntrypointArgs(),
+			Args:            additionalContainer.GetCmdArgs(),
+			Env:             containerEnvVars,
+			VolumeMounts:    containerMounts,
+			ImagePullPolicy: imagePullPolicy,
+			RestartPolicy:   maybeRestartPolicy,
+		})
+	}
+	return containers
+}
+
 func getKubernetesServicePortsFromPrivatePortSpecs(privatePorts map[string]*port_spec.PortSpec) ([]apiv1.ServicePort, error) {
 	result := []apiv1.ServicePort{}
 	for portId, portSpec := range privatePorts {
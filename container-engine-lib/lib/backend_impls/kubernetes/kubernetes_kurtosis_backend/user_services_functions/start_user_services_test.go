@@ -1,7 +1,10 @@
 package user_services_functions
 
 import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
 	"testing"
 )
 
@@ -11,3 +14,19 @@ func TestConvertMemoryAllocationToBytesReturnsCorrectValue(t *testing.T) {
 	memoryAllocationBytes := convertMegabytesToBytes(memoryAllocationMegabytes)
 	require.Equal(t, uint64(400000000), memoryAllocationBytes)
 }
+
+func TestGetAdditionalContainerSpecs_SidecarsAreNativeSidecars(t *testing.T) {
+	additionalContainers := []*service.AdditionalContainer{
+		service.NewAdditionalContainer("log-shipper", "fluent-bit", nil, nil, map[string]string{}),
+	}
+	sidecarRestartPolicy := apiv1.ContainerRestartPolicyAlways
+
+	initContainerSpecs := getAdditionalContainerSpecs(additionalContainers, nil, image_download_mode.ImageDownloadMode_Missing, nil)
+	require.Len(t, initContainerSpecs, 1)
+	require.Nil(t, initContainerSpecs[0].RestartPolicy)
+
+	sidecarSpecs := getAdditionalContainerSpecs(additionalContainers, nil, image_download_mode.ImageDownloadMode_Missing, &sidecarRestartPolicy)
+	require.Len(t, sidecarSpecs, 1)
+	require.Equal(t, "log-shipper", sidecarSpecs[0].Name)
+	require.Equal(t, &sidecarRestartPolicy, sidecarSpecs[0].RestartPolicy)
+}
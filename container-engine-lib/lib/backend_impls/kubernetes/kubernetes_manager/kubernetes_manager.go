@@ -15,6 +15,7 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -27,6 +28,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/wait"
 
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/interactive_exec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/channel_writer"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
@@ -58,6 +60,9 @@ const (
 	// Pods in this state don't really recover on their own
 	imagePullBackOffContainerReason = "ImagePullBackOff"
 
+	// The annotation the persistent volume controller sets on a claim to name the provisioner of its volume
+	storageProvisionerAnnotationKey = "volume.kubernetes.io/storage-provisioner"
+
 	containerStatusLineBulletPoint = " - "
 
 	// Kubernetes unfortunately doesn't have a good way to get the exit code out, so we have to parse it out of a string
@@ -129,6 +134,24 @@ var (
 		FieldManager:    fieldManager,
 		FieldValidation: "",
 	}
+	globalApplyOptions = metav1.ApplyOptions{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "",
+			APIVersion: "",
+		},
+		DryRun:       nil,
+		Force:        true, //We need to use force to avoid conflict errors
+		FieldManager: fieldManager,
+	}
+	globalUpdateOptions = metav1.UpdateOptions{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "",
+			APIVersion: "",
+		},
+		DryRun:          nil,
+		FieldManager:    fieldManager,
+		FieldValidation: "",
+	}
 	globalGetOptions = metav1.GetOptions{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "",
@@ -155,6 +178,15 @@ var (
 	}
 )
 
+var noPersistentVolume *apiv1.PersistentVolume = nil
+
+// The provisioners of node-local volumes, which never provision ReadWriteMany claims: these claims stay pending forever
+// instead of failing. This includes the default storage class of kind and k3s
+var readWriteOnceOnlyStorageProvisioners = map[string]bool{
+	"rancher.io/local-path": true,
+	"openebs.io/local":      true,
+}
+
 type KubernetesManager struct {
 	// The underlying K8s client that will be used to modify the K8s environment
 	kubernetesClientSet *kubernetes.Clientset
@@ -179,6 +211,16 @@ func NewKubernetesManager(kubernetesClientSet *kubernetes.Clientset, kuberneteRe
 
 // CreateService creates a k8s service in the specified namespace. It connects pods to the service according to the pod labels passed in
 func (manager *KubernetesManager) CreateService(ctx context.Context, namespace string, name string, serviceLabels map[string]string, serviceAnnotations map[string]string, matchPodLabels map[string]string, serviceType apiv1.ServiceType, ports []apiv1.ServicePort) (*apiv1.Service, error) {
+	return manager.createService(ctx, namespace, name, serviceLabels, serviceAnnotations, matchPodLabels, serviceType, ports, "")
+}
+
+// CreateHeadlessService creates a service without a cluster IP, whose name resolves to the IPs of all the pods it
+// matches instead
+func (manager *KubernetesManager) CreateHeadlessService(ctx context.Context, namespace string, name string, serviceLabels map[string]string, serviceAnnotations map[string]string, matchPodLabels map[string]string) (*apiv1.Service, error) {
+	return manager.createService(ctx, namespace, name, serviceLabels, serviceAnnotations, matchPodLabels, apiv1.ServiceTypeClusterIP, nil, apiv1.ClusterIPNone)
+}
+
+func (manager *KubernetesManager) createService(ctx context.Context, namespace string, name string, serviceLabels map[string]string, serviceAnnotations map[string]string, matchPodLabels map[string]string, serviceType apiv1.ServiceType, ports []apiv1.ServicePort, clusterIP string) (*apiv1.Service, error) {
 	servicesClient := manager.kubernetesClientSet.CoreV1().Services(namespace)
 
 	objectMeta := metav1.ObjectMeta{
@@ -207,7 +249,7 @@ func (manager *KubernetesManager) CreateService(ctx context.Context, namespace s
 	serviceSpec := apiv1.ServiceSpec{
 		Ports:                         ports,
 		Selector:                      matchPodLabels, // these labels are used to match with the Pod
-		ClusterIP:                     "",
+		ClusterIP:                     clusterIP,
 		ClusterIPs:                    nil,
 		Type:                          serviceType,
 		ExternalIPs:                   nil,
@@ -372,17 +414,101 @@ func (manager *KubernetesManager) GetPersistentVolumesByLabels(ctx context.Conte
 	return &persistentVolumesNotMarkedForDeletionserviceList, nil
 }
 
+// RetainPersistentVolume makes the volume outlive its claim, and adds the labels to it so that it can be found again
+// once the claim is gone
+func (manager *KubernetesManager) RetainPersistentVolume(ctx context.Context, persistentVolumeName string, labels map[string]string) error {
+	persistentVolumesClient := manager.kubernetesClientSet.CoreV1().PersistentVolumes()
+
+	persistentVolume, err := persistentVolumesClient.Get(ctx, persistentVolumeName, globalGetOptions)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting persistent volume '%v'", persistentVolumeName)
+	}
+
+	persistentVolume.Spec.PersistentVolumeReclaimPolicy = apiv1.PersistentVolumeReclaimRetain
+	if persistentVolume.Labels == nil {
+		persistentVolume.Labels = map[string]string{}
+	}
+	for labelKey, labelValue := range labels {
+		persistentVolume.Labels[labelKey] = labelValue
+	}
+
+	if _, err = persistentVolumesClient.Update(ctx, persistentVolume, globalUpdateOptions); err != nil {
+		return stacktrace.Propagate(err, "An error occurred updating persistent volume '%v' to retain it", persistentVolumeName)
+	}
+	return nil
+}
+
+// MakePersistentVolumeAvailable removes the reference a released volume keeps to its former claim, which is what
+// prevents Kubernetes from binding the volume to a new claim
+func (manager *KubernetesManager) MakePersistentVolumeAvailable(ctx context.Context, persistentVolume *apiv1.PersistentVolume) (*apiv1.PersistentVolume, error) {
+	persistentVolumesClient := manager.kubernetesClientSet.CoreV1().PersistentVolumes()
+
+	persistentVolume.Spec.ClaimRef = nil
+	updatedPersistentVolume, err := persistentVolumesClient.Update(ctx, persistentVolume, globalUpdateOptions)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred removing the claim reference of persistent volume '%v'", persistentVolume.Name)
+	}
+	return updatedPersistentVolume, nil
+}
+
 func (manager *KubernetesManager) CreatePersistentVolumeClaim(
 	ctx context.Context,
 	namespace string,
 	volumeClaimName string,
 	labels map[string]string,
 	requiredSize int64,
+) (*apiv1.PersistentVolumeClaim, error) {
+	// ReadWriteOncePod would be better, but it's a fairly recent feature
+	return manager.createPersistentVolumeClaimWithAccessMode(ctx, namespace, volumeClaimName, labels, requiredSize, apiv1.ReadWriteOnce, noPersistentVolume)
+}
+
+// CreatePersistentVolumeClaimForVolume creates a claim bound to an existing persistent volume, rather than to a new
+// one, so that the data of the volume can be used again by a new pod. The volume must not be bound to another claim
+func (manager *KubernetesManager) CreatePersistentVolumeClaimForVolume(
+	ctx context.Context,
+	namespace string,
+	volumeClaimName string,
+	labels map[string]string,
+	persistentVolume *apiv1.PersistentVolume,
+) (*apiv1.PersistentVolumeClaim, error) {
+	volumeCapacity := persistentVolume.Spec.Capacity[apiv1.ResourceStorage]
+	return manager.createPersistentVolumeClaimWithAccessMode(ctx, namespace, volumeClaimName, labels, volumeCapacity.Value(), apiv1.ReadWriteOnce, persistentVolume)
+}
+
+// CreateReadWriteManyPersistentVolumeClaim creates a claim that pods on different nodes can mount at the same time,
+// which requires the storage class of the cluster to support the ReadWriteMany access mode
+func (manager *KubernetesManager) CreateReadWriteManyPersistentVolumeClaim(
+	ctx context.Context,
+	namespace string,
+	volumeClaimName string,
+	labels map[string]string,
+	requiredSize int64,
+) (*apiv1.PersistentVolumeClaim, error) {
+	return manager.createPersistentVolumeClaimWithAccessMode(ctx, namespace, volumeClaimName, labels, requiredSize, apiv1.ReadWriteMany, noPersistentVolume)
+}
+
+func (manager *KubernetesManager) createPersistentVolumeClaimWithAccessMode(
+	ctx context.Context,
+	namespace string,
+	volumeClaimName string,
+	labels map[string]string,
+	requiredSize int64,
+	accessMode apiv1.PersistentVolumeAccessMode,
+	// nil to have a new volume provisioned for the claim
+	persistentVolume *apiv1.PersistentVolume,
 ) (*apiv1.PersistentVolumeClaim, error) {
 	if requiredSize == 0 {
 		return nil, stacktrace.NewError("Cannot create volume '%v' of 0 size; need a value greater than 0", volumeClaimName)
 	}
 
+	volumeName := "" // we use dynamic provisioning this should happen automagically
+	storageClassName := &manager.storageClass
+	if persistentVolume != nil {
+		// the storage class of the claim must be the one of the volume for the volume to be bound to it
+		volumeName = persistentVolume.Name
+		storageClassName = &persistentVolume.Spec.StorageClassName
+	}
+
 	volumeClaimsClient := manager.kubernetesClientSet.CoreV1().PersistentVolumeClaims(namespace)
 
 	volumeClaimsDefinition := apiv1.PersistentVolumeClaim{
@@ -411,7 +537,7 @@ func (manager *KubernetesManager) CreatePersistentVolumeClaim(
 		},
 		Spec: apiv1.PersistentVolumeClaimSpec{
 			AccessModes: []apiv1.PersistentVolumeAccessMode{
-				apiv1.ReadWriteOnce, // ReadWriteOncePod would be better, but it's a fairly recent feature
+				accessMode,
 			},
 			Selector: nil,
 			Resources: apiv1.VolumeResourceRequirements{
@@ -422,8 +548,8 @@ func (manager *KubernetesManager) CreatePersistentVolumeClaim(
 					apiv1.ResourceStorage: *resource.NewQuantity(requiredSize, resource.BinarySI),
 				},
 			},
-			VolumeName:                "", // we use dynamic provisioning this should happen automagically
-			StorageClassName:          &manager.storageClass,
+			VolumeName:                volumeName,
+			StorageClassName:          storageClassName,
 			VolumeMode:                nil,
 			DataSource:                nil,
 			DataSourceRef:             nil,
@@ -480,6 +606,56 @@ func (manager *KubernetesManager) GetPersistentVolumeClaim(
 	return volumeClaim, nil
 }
 
+// GetPersistentVolumeClaimIfExists returns a nil claim if it doesn't exist, unlike GetPersistentVolumeClaim which
+// can't tell that apart from failing to get it
+func (manager *KubernetesManager) GetPersistentVolumeClaimIfExists(
+	ctx context.Context,
+	namespace string,
+	volumeClaimName string,
+) (*apiv1.PersistentVolumeClaim, error) {
+	volumesClient := manager.kubernetesClientSet.CoreV1().PersistentVolumeClaims(namespace)
+	volumeClaim, err := volumesClient.Get(ctx, volumeClaimName, globalGetOptions)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the persistent volume claim '%s' in namespace '%s'", volumeClaimName, namespace)
+	}
+	if volumeClaim.GetObjectMeta().GetDeletionTimestamp() != nil {
+		return nil, stacktrace.NewError("Persistent volume claim with name '%s' in namespace '%s' has been marked for deletion", volumeClaimName, namespace)
+	}
+	return volumeClaim, nil
+}
+
+func (manager *KubernetesManager) GetPersistentVolumeClaimsByLabels(
+	ctx context.Context,
+	namespace string,
+	persistentVolumeClaimLabels map[string]string,
+) (*apiv1.PersistentVolumeClaimList, error) {
+	volumeClaimsClient := manager.kubernetesClientSet.CoreV1().PersistentVolumeClaims(namespace)
+
+	listOptions := buildListOptionsFromLabels(persistentVolumeClaimLabels)
+	volumeClaimsResult, err := volumeClaimsClient.List(ctx, listOptions)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to list persistent volume claims with labels '%+v' in namespace '%s'", persistentVolumeClaimLabels, namespace)
+	}
+
+	// Only return objects not tombstoned by Kubernetes
+	var volumeClaimsNotMarkedForDeletionList []apiv1.PersistentVolumeClaim
+	for _, volumeClaim := range volumeClaimsResult.Items {
+		deletionTimestamp := volumeClaim.GetObjectMeta().GetDeletionTimestamp()
+		if deletionTimestamp == nil {
+			volumeClaimsNotMarkedForDeletionList = append(volumeClaimsNotMarkedForDeletionList, volumeClaim)
+		}
+	}
+	volumeClaimsNotMarkedForDeletionClaimList := apiv1.PersistentVolumeClaimList{
+		Items:    volumeClaimsNotMarkedForDeletionList,
+		TypeMeta: volumeClaimsResult.TypeMeta,
+		ListMeta: volumeClaimsResult.ListMeta,
+	}
+	return &volumeClaimsNotMarkedForDeletionClaimList, nil
+}
+
 // ---------------------------namespaces------------------------------------------------------------------------------
 
 func (manager *KubernetesManager) CreateNamespace(
@@ -620,6 +796,62 @@ func (manager *KubernetesManager) GetNamespacesByLabels(ctx context.Context, nam
 	return &namespacesNotMarkedForDeletionnamespaceList, nil
 }
 
+// ---------------------------resource quotas------------------------------------------------------------------------------
+
+// ApplyResourceQuota creates the resource quota or, if it already exists, replaces its hard limits
+func (manager *KubernetesManager) ApplyResourceQuota(ctx context.Context, namespace string, name string, labels map[string]string, hardLimits apiv1.ResourceList) (*apiv1.ResourceQuota, error) {
+	client := manager.kubernetesClientSet.CoreV1().ResourceQuotas(namespace)
+
+	resourceQuotaToApply := applyconfigurationsv1.ResourceQuota(name, namespace).
+		WithLabels(labels).
+		WithSpec(applyconfigurationsv1.ResourceQuotaSpec().WithHard(hardLimits))
+
+	result, err := client.Apply(ctx, resourceQuotaToApply, globalApplyOptions)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to apply resource quota '%v' with hard limits '%+v' in namespace '%v'", name, hardLimits, namespace)
+	}
+	return result, nil
+}
+
+// RemoveResourceQuota removes the resource quota, doing nothing if it doesn't exist
+func (manager *KubernetesManager) RemoveResourceQuota(ctx context.Context, namespace string, name string) error {
+	client := manager.kubernetesClientSet.CoreV1().ResourceQuotas(namespace)
+
+	if err := client.Delete(ctx, name, globalDeleteOptions); err != nil && !apierrors.IsNotFound(err) {
+		return stacktrace.Propagate(err, "Failed to delete resource quota '%v' in namespace '%v'", name, namespace)
+	}
+	return nil
+}
+
+// ApplyLimitRange creates the limit range or, if it already exists, replaces its limits
+func (manager *KubernetesManager) ApplyLimitRange(ctx context.Context, namespace string, name string, labels map[string]string, containerDefaultRequests apiv1.ResourceList) (*apiv1.LimitRange, error) {
+	client := manager.kubernetesClientSet.CoreV1().LimitRanges(namespace)
+
+	limitRangeToApply := applyconfigurationsv1.LimitRange(name, namespace).
+		WithLabels(labels).
+		WithSpec(applyconfigurationsv1.LimitRangeSpec().WithLimits(
+			applyconfigurationsv1.LimitRangeItem().
+				WithType(apiv1.LimitTypeContainer).
+				WithDefaultRequest(containerDefaultRequests),
+		))
+
+	result, err := client.Apply(ctx, limitRangeToApply, globalApplyOptions)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to apply limit range '%v' with container default requests '%+v' in namespace '%v'", name, containerDefaultRequests, namespace)
+	}
+	return result, nil
+}
+
+// RemoveLimitRange removes the limit range, doing nothing if it doesn't exist
+func (manager *KubernetesManager) RemoveLimitRange(ctx context.Context, namespace string, name string) error {
+	client := manager.kubernetesClientSet.CoreV1().LimitRanges(namespace)
+
+	if err := client.Delete(ctx, name, globalDeleteOptions); err != nil && !apierrors.IsNotFound(err) {
+		return stacktrace.Propagate(err, "Failed to delete limit range '%v' in namespace '%v'", name, namespace)
+	}
+	return nil
+}
+
 // ---------------------------service accounts------------------------------------------------------------------------------
 
 func (manager *KubernetesManager) CreateServiceAccount(ctx context.Context, name string, namespace string, labels map[string]string, imagePullSecrets []apiv1.LocalObjectReference) (*apiv1.ServiceAccount, error) {
@@ -2210,7 +2442,7 @@ func (manager *KubernetesManager) RunExecCommandWithStreamedOutput(
 		Stdin:     false,
 		Stdout:    true,
 		Stderr:    true,
-		TTY:       true,
+		TTY:       false,
 		Container: containerName,
 		Command:   command,
 	}
@@ -2249,7 +2481,7 @@ func (manager *KubernetesManager) RunExecCommandWithStreamedOutput(
 			Stdin:             nil,
 			Stdout:            channelWriter,
 			Stderr:            channelWriter,
-			Tty:               true,
+			Tty:               false,
 			TerminalSizeQueue: nil,
 		}); err != nil {
 			// Kubernetes returns the exit code of the command via a string in the error message, so we have to extract it
@@ -2269,11 +2501,90 @@ func (manager *KubernetesManager) RunExecCommandWithStreamedOutput(
 
 			// Don't send output in final result because it was already streamed
 			finalExecResultChan <- exec_result.NewExecResult(exitCode, "")
+			return
 		}
+		finalExecResultChan <- exec_result.NewExecResult(successExecCommandExitCode, "")
 	}()
 	return execOutputChan, finalExecResultChan, nil
 }
 
+// RunInteractiveExecCommand runs the command in the container with the given streams attached, and returns its exit
+// code once it has exited
+func (manager *KubernetesManager) RunInteractiveExecCommand(
+	ctx context.Context,
+	namespaceName string,
+	podName string,
+	containerName string,
+	command []string,
+	execStreams *interactive_exec.InteractiveExecStreams,
+) (int32, error) {
+	shouldAttachStdin := execStreams.GetStdin() != nil
+	execOptions := &apiv1.PodExecOptions{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "",
+			APIVersion: "",
+		},
+		Stdin:  shouldAttachStdin,
+		Stdout: true,
+		// with a TTY, stderr is merged into stdout
+		Stderr:    !execStreams.IsTty(),
+		TTY:       execStreams.IsTty(),
+		Container: containerName,
+		Command:   command,
+	}
+
+	//Create a RESTful command request.
+	request := manager.kubernetesClientSet.CoreV1().RESTClient().
+		Post().
+		Namespace(namespaceName).
+		Resource("pods").
+		Name(podName).
+		SubResource("exec").
+		VersionedParams(execOptions, scheme.ParameterCodec)
+	if request == nil {
+		return 0, stacktrace.NewError("Failed to build a working RESTful request for the command '%s'.", execOptions.Command)
+	}
+
+	exec, err := remotecommand.NewSPDYExecutor(manager.kuberneteRestConfig, http.MethodPost, request.URL())
+	if err != nil {
+		return 0, stacktrace.Propagate(err,
+			"Failed to build an executor for the command '%s' with the RESTful endpoint '%s'.",
+			execOptions.Command,
+			request.URL().String())
+	}
+
+	streamOptions := remotecommand.StreamOptions{
+		Stdin:             nil,
+		Stdout:            execStreams.GetStdout(),
+		Stderr:            nil,
+		Tty:               execStreams.IsTty(),
+		TerminalSizeQueue: nil,
+	}
+	if shouldAttachStdin {
+		streamOptions.Stdin = execStreams.GetStdin()
+	}
+	if !execStreams.IsTty() {
+		streamOptions.Stderr = execStreams.GetStderr()
+	}
+	if execStreams.IsTty() && execStreams.GetTerminalSizes() != nil {
+		streamOptions.TerminalSizeQueue = &terminalSizeQueue{
+			ctx:           ctx,
+			terminalSizes: execStreams.GetTerminalSizes(),
+		}
+	}
+
+	if err = exec.StreamWithContext(ctx, streamOptions); err != nil {
+		// Kubernetes returns the exit code of the command via a string in the error message, so we have to extract it
+		statusError := err.Error()
+		exitCode, err := getExitCodeFromStatusMessage(statusError)
+		if err != nil {
+			return 0, stacktrace.Propagate(err, "There was an error trying to parse the message '%s' to an exit code.", statusError)
+		}
+		return exitCode, nil
+	}
+	return successExecCommandExitCode, nil
+}
+
 // RemoveDirPathFromNode removes the contents and path to [dirPathToRemove] by creating a pod in [namespace] with privileged access to the [nodeName]'s filesystem
 // The host filesystem is mounted onto the pod as a volume and then a rm -rf is run at the location on the pod where [dirPathToRemove] is mounted
 func (manager *KubernetesManager) RemoveDirPathFromNode(ctx context.Context, namespace string, nodeName string, dirPathToRemove string) error {
@@ -3030,6 +3341,9 @@ func (manager *KubernetesManager) waitForPodAvailability(ctx context.Context, na
 					)
 				}
 			}
+			if err := manager.checkReadWriteManyVolumeClaimsCanBeProvisioned(ctx, namespaceName, pod); err != nil {
+				return stacktrace.Propagate(err, "Pod '%v' in namespace '%v' can't start as one of its volumes can't be provisioned", podName, namespaceName)
+			}
 			for _, containerStatus := range pod.Status.ContainerStatuses {
 				containerName := containerStatus.Name
 				maybeContainerWaitingState := containerStatus.State.Waiting
@@ -3080,6 +3394,35 @@ func (manager *KubernetesManager) waitForPodAvailability(ctx context.Context, na
 	)
 }
 
+// Returns an error if a pending ReadWriteMany claim of the pod is in the hands of a provisioner that can't provision it,
+// as the pod would otherwise wait for it until it times out
+func (manager *KubernetesManager) checkReadWriteManyVolumeClaimsCanBeProvisioned(ctx context.Context, namespaceName string, pod *apiv1.Pod) error {
+	for _, volume := range pod.Spec.Volumes {
+		if volume.PersistentVolumeClaim == nil {
+			continue
+		}
+		volumeClaimName := volume.PersistentVolumeClaim.ClaimName
+		volumeClaim, err := manager.GetPersistentVolumeClaimIfExists(ctx, namespaceName, volumeClaimName)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting persistent volume claim '%v' of pod '%v'", volumeClaimName, pod.Name)
+		}
+		if volumeClaim == nil || volumeClaim.Status.Phase != apiv1.ClaimPending || !slices.Contains(volumeClaim.Spec.AccessModes, apiv1.ReadWriteMany) {
+			continue
+		}
+		storageProvisioner := volumeClaim.Annotations[storageProvisionerAnnotationKey]
+		if readWriteOnceOnlyStorageProvisioners[storageProvisioner] {
+			return stacktrace.NewError(
+				"Persistent volume claim '%v' needs the ReadWriteMany access mode but its storage provisioner '%v' only "+
+					"supports ReadWriteOnce, so it will never be provisioned. Use a storage class supporting ReadWriteMany "+
+					"(e.g. one backed by NFS) through the 'storage-class' of the Kubernetes cluster config of Kurtosis",
+				volumeClaimName,
+				storageProvisioner,
+			)
+		}
+	}
+	return nil
+}
+
 // waitForPodDeletion waits for the pod to be fully deleted if it has been marked for deletion
 func (manager *KubernetesManager) waitForPodDeletion(ctx context.Context, namespaceName string, podName string) error {
 	// Wait for the pod to start running
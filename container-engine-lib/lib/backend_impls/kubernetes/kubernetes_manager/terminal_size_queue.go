@@ -0,0 +1,30 @@
+package kubernetes_manager
+
+import (
+	"context"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/interactive_exec"
+	"k8s.io/client-go/tools/remotecommand"
+)
+
+// terminalSizeQueue forwards the terminal sizes received on a channel to the TTY of a pod exec
+type terminalSizeQueue struct {
+	ctx           context.Context
+	terminalSizes <-chan interactive_exec.TerminalSize
+}
+
+// Next blocks until the terminal is resized, and returns nil once no more sizes will be sent
+func (queue *terminalSizeQueue) Next() *remotecommand.TerminalSize {
+	select {
+	case <-queue.ctx.Done():
+		return nil
+	case terminalSize, ok := <-queue.terminalSizes:
+		if !ok {
+			return nil
+		}
+		return &remotecommand.TerminalSize{
+			Width:  terminalSize.Width,
+			Height: terminalSize.Height,
+		}
+	}
+}
@@ -23,7 +23,8 @@ import (
 const (
 	namespacePrefix = "kt"
 
-	enclaveDataDirFragment = "enclave-data-dir"
+	enclaveDataDirFragment  = "enclave-data-dir"
+	sharedDirectoryFragment = "shared-directory"
 
 	traefikIngressRouterEntrypointsValue = "web"
 )
@@ -45,6 +46,12 @@ type KubernetesEnclaveObjectAttributesProvider interface {
 	ForSinglePersistentDirectoryVolume(
 		persistentKey service_directory.DirectoryPersistentKey,
 	) (KubernetesObjectAttributes, error)
+	ForSharedDirectoryVolume(
+		sharedDirectoryName service_directory.SharedDirectoryName,
+	) (KubernetesObjectAttributes, error)
+	ForReplicaSetService(
+		replicaSetName string,
+	) (KubernetesObjectAttributes, error)
 	ForUserServiceIngress(
 		uuid service.ServiceUUID,
 		id service.ServiceName,
@@ -284,6 +291,69 @@ func (provider *kubernetesEnclaveObjectAttributesProviderImpl) ForSinglePersiste
 	return objectAttributes, nil
 }
 
+// The claim of a shared directory is named after the hash of its name, as the name itself could make it longer than
+// what Kubernetes allows and collide with the claim of a persistent directory
+func (provider *kubernetesEnclaveObjectAttributesProviderImpl) ForSharedDirectoryVolume(sharedDirectoryName service_directory.SharedDirectoryName) (KubernetesObjectAttributes, error) {
+	hasher := md5.New()
+	hasher.Write([]byte(provider.enclaveId))
+	hasher.Write([]byte(sharedDirectoryName))
+	sharedDirectoryNameHash := hex.EncodeToString(hasher.Sum(nil))
+
+	labels, err := provider.getLabelsForEnclaveObjectWithIDAndGUID(string(sharedDirectoryName), sharedDirectoryNameHash)
+	if err != nil {
+		return nil, stacktrace.Propagate(
+			err,
+			"Failed to get labels for shared directory volume with name '%s' and UUID '%s'",
+			sharedDirectoryName,
+			sharedDirectoryNameHash,
+		)
+	}
+
+	annotations := map[*kubernetes_annotation_key.KubernetesAnnotationKey]*kubernetes_annotation_value.KubernetesAnnotationValue{}
+
+	name, err := getCompositeKubernetesObjectName([]string{
+		sharedDirectoryFragment,
+		sharedDirectoryNameHash,
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to create shared directory volume name for hash: '%s'", sharedDirectoryNameHash)
+	}
+	objectAttributes, err := newKubernetesObjectAttributesImpl(name, labels, annotations)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to create shared directory volume object attributes")
+	}
+
+	return objectAttributes, nil
+}
+
+// The service of a replica set is named after the replica set, as its name is the hostname shared by the replicas
+func (provider *kubernetesEnclaveObjectAttributesProviderImpl) ForReplicaSetService(replicaSetName string) (KubernetesObjectAttributes, error) {
+	name, err := getKubernetesObjectName(service.ServiceName(replicaSetName))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to get name for replica set service")
+	}
+
+	labels, err := provider.getLabelsForEnclaveObject()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to get labels for replica set service with name '%s'", replicaSetName)
+	}
+	idLabelValue, err := kubernetes_label_value.CreateNewKubernetesLabelValue(replicaSetName)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating a Kubernetes label value from replica set name '%v'", replicaSetName)
+	}
+	labels[kubernetes_label_key.IDKubernetesLabelKey] = idLabelValue
+	labels[kubernetes_label_key.KurtosisResourceTypeKubernetesLabelKey] = label_value_consts.ReplicaSetKurtosisResourceTypeKubernetesLabelValue
+
+	annotations := map[*kubernetes_annotation_key.KubernetesAnnotationKey]*kubernetes_annotation_value.KubernetesAnnotationValue{}
+
+	objectAttributes, err := newKubernetesObjectAttributesImpl(name, labels, annotations)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to create replica set service object attributes")
+	}
+
+	return objectAttributes, nil
+}
+
 func (provider *kubernetesEnclaveObjectAttributesProviderImpl) ForUserServiceIngress(
 	serviceUUID service.ServiceUUID,
 	serviceName service.ServiceName,
@@ -35,6 +35,8 @@ type KubernetesEngineObjectAttributesProvider interface {
 	ForEngineClusterRoleBindings() (KubernetesObjectAttributes, error)
 
 	ForEngineIngress() (KubernetesObjectAttributes, error)
+
+	ForEngineDataPersistentVolumeClaim() (KubernetesObjectAttributes, error)
 }
 
 // Private so it can't be instantiated
@@ -235,6 +237,32 @@ func (provider *kubernetesEngineObjectAttributesProviderImpl) ForEngineIngress()
 	return objectAttributes, nil
 }
 
+// ForEngineDataPersistentVolumeClaim labels the claim with the volume type, which is also what the retained engine data
+// volume is labeled with, and found by, once the engine is gone
+func (provider *kubernetesEngineObjectAttributesProviderImpl) ForEngineDataPersistentVolumeClaim() (KubernetesObjectAttributes, error) {
+	name, err := provider.getEngineObjectName()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating a Kubernetes object name for the engine data persistent volume claim")
+	}
+
+	labels, err := provider.getEngineObjectLabels()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting Kubernetes labels")
+	}
+	labels[kubernetes_label_key.KurtosisVolumeTypeKubernetesLabelKey] = label_value_consts.EngineDataVolumeTypeKubernetesLabelValue
+
+	// No custom annotations for engine data persistent volume claim
+	annotations := map[*kubernetes_annotation_key.KubernetesAnnotationKey]*kubernetes_annotation_value.KubernetesAnnotationValue{}
+
+	objectAttributes, err := newKubernetesObjectAttributesImpl(name, labels, annotations)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while creating the Kubernetes object attributes with the name "+
+			"'%s' and labels '%+v', and annotations '%+v'", name.GetString(), labels, annotations)
+	}
+
+	return objectAttributes, nil
+}
+
 // ====================================================================================================
 //
 //	Private Helper Methods
@@ -16,6 +16,22 @@ const (
 
 	enclaveNameKeyStr = labelKeyPrefixStr + "enclave-name"
 
+	enclaveExpirationTimeKeyStr = labelKeyPrefixStr + "enclave-expiration-time"
+
+	enclaveIdleTimeoutKeyStr = labelKeyPrefixStr + "enclave-idle-timeout"
+
+	enclaveCpuQuotaKeyStr         = labelKeyPrefixStr + "enclave-cpu-quota"
+	enclaveMemoryQuotaKeyStr      = labelKeyPrefixStr + "enclave-memory-quota"
+	enclaveMaxServicesQuotaKeyStr = labelKeyPrefixStr + "enclave-max-services-quota"
+	enclaveDiskQuotaKeyStr        = labelKeyPrefixStr + "enclave-disk-quota"
+
+	enclaveOwnerKeyStr = labelKeyPrefixStr + "enclave-owner"
+
+	enclaveApiContainerVersionTagKeyStr = labelKeyPrefixStr + "enclave-api-container-version-tag"
+	enclaveApiContainerLogLevelKeyStr   = labelKeyPrefixStr + "enclave-api-container-log-level"
+
+	enclaveLastActivityTimeKeyStr = labelKeyPrefixStr + "enclave-last-activity-time"
+
 	// Traefik ingress router
 	traefikKeyIngressRouterPrefixStr = "traefik.ingress.kubernetes.io/router."
 	traefikKeyEntrypointsStr         = traefikKeyIngressRouterPrefixStr + "entrypoints"
@@ -27,4 +43,14 @@ const (
 var PortSpecsKubernetesAnnotationKey = kubernetes_annotation_key.MustCreateNewKubernetesAnnotationKey(portSpecsAnnotationKeyStr)
 var EnclaveCreationTimeAnnotationKey = kubernetes_annotation_key.MustCreateNewKubernetesAnnotationKey(enclaveCreationTimeKeyStr)
 var EnclaveNameAnnotationKey = kubernetes_annotation_key.MustCreateNewKubernetesAnnotationKey(enclaveNameKeyStr)
+var EnclaveExpirationTimeAnnotationKey = kubernetes_annotation_key.MustCreateNewKubernetesAnnotationKey(enclaveExpirationTimeKeyStr)
+var EnclaveIdleTimeoutAnnotationKey = kubernetes_annotation_key.MustCreateNewKubernetesAnnotationKey(enclaveIdleTimeoutKeyStr)
+var EnclaveCpuQuotaAnnotationKey = kubernetes_annotation_key.MustCreateNewKubernetesAnnotationKey(enclaveCpuQuotaKeyStr)
+var EnclaveMemoryQuotaAnnotationKey = kubernetes_annotation_key.MustCreateNewKubernetesAnnotationKey(enclaveMemoryQuotaKeyStr)
+var EnclaveMaxServicesQuotaAnnotationKey = kubernetes_annotation_key.MustCreateNewKubernetesAnnotationKey(enclaveMaxServicesQuotaKeyStr)
+var EnclaveDiskQuotaAnnotationKey = kubernetes_annotation_key.MustCreateNewKubernetesAnnotationKey(enclaveDiskQuotaKeyStr)
+var EnclaveOwnerAnnotationKey = kubernetes_annotation_key.MustCreateNewKubernetesAnnotationKey(enclaveOwnerKeyStr)
+var EnclaveApiContainerVersionTagAnnotationKey = kubernetes_annotation_key.MustCreateNewKubernetesAnnotationKey(enclaveApiContainerVersionTagKeyStr)
+var EnclaveApiContainerLogLevelAnnotationKey = kubernetes_annotation_key.MustCreateNewKubernetesAnnotationKey(enclaveApiContainerLogLevelKeyStr)
+var EnclaveLastActivityTimeAnnotationKey = kubernetes_annotation_key.MustCreateNewKubernetesAnnotationKey(enclaveLastActivityTimeKeyStr)
 var TraefikIngressRouterEntrypointsAnnotationKey = kubernetes_annotation_key.MustCreateNewKubernetesAnnotationKey(traefikKeyEntrypointsStr)
@@ -8,17 +8,37 @@ import (
 )
 
 var labelKeyStrsToEnsure = map[string]string{
-	labelKeyPrefixStr:         "kurtosistech.com/",
-	portSpecsAnnotationKeyStr: "kurtosistech.com/ports",
-	enclaveCreationTimeKeyStr: "kurtosistech.com/enclave-creation-time",
-	enclaveNameKeyStr:         "kurtosistech.com/enclave-name",
-	traefikKeyEntrypointsStr:  "traefik.ingress.kubernetes.io/router.entrypoints",
+	labelKeyPrefixStr:                   "kurtosistech.com/",
+	portSpecsAnnotationKeyStr:           "kurtosistech.com/ports",
+	enclaveCreationTimeKeyStr:           "kurtosistech.com/enclave-creation-time",
+	enclaveNameKeyStr:                   "kurtosistech.com/enclave-name",
+	enclaveExpirationTimeKeyStr:         "kurtosistech.com/enclave-expiration-time",
+	enclaveIdleTimeoutKeyStr:            "kurtosistech.com/enclave-idle-timeout",
+	enclaveCpuQuotaKeyStr:               "kurtosistech.com/enclave-cpu-quota",
+	enclaveMemoryQuotaKeyStr:            "kurtosistech.com/enclave-memory-quota",
+	enclaveMaxServicesQuotaKeyStr:       "kurtosistech.com/enclave-max-services-quota",
+	enclaveDiskQuotaKeyStr:              "kurtosistech.com/enclave-disk-quota",
+	enclaveOwnerKeyStr:                  "kurtosistech.com/enclave-owner",
+	enclaveApiContainerVersionTagKeyStr: "kurtosistech.com/enclave-api-container-version-tag",
+	enclaveApiContainerLogLevelKeyStr:   "kurtosistech.com/enclave-api-container-log-level",
+	enclaveLastActivityTimeKeyStr:       "kurtosistech.com/enclave-last-activity-time",
+	traefikKeyEntrypointsStr:            "traefik.ingress.kubernetes.io/router.entrypoints",
 }
 
 var labelKeysToEnsure = map[*kubernetes_annotation_key.KubernetesAnnotationKey]string{
 	PortSpecsKubernetesAnnotationKey:             "kurtosistech.com/ports",
 	EnclaveCreationTimeAnnotationKey:             "kurtosistech.com/enclave-creation-time",
 	EnclaveNameAnnotationKey:                     "kurtosistech.com/enclave-name",
+	EnclaveExpirationTimeAnnotationKey:           "kurtosistech.com/enclave-expiration-time",
+	EnclaveIdleTimeoutAnnotationKey:              "kurtosistech.com/enclave-idle-timeout",
+	EnclaveCpuQuotaAnnotationKey:                 "kurtosistech.com/enclave-cpu-quota",
+	EnclaveMemoryQuotaAnnotationKey:              "kurtosistech.com/enclave-memory-quota",
+	EnclaveMaxServicesQuotaAnnotationKey:         "kurtosistech.com/enclave-max-services-quota",
+	EnclaveDiskQuotaAnnotationKey:                "kurtosistech.com/enclave-disk-quota",
+	EnclaveOwnerAnnotationKey:                    "kurtosistech.com/enclave-owner",
+	EnclaveApiContainerVersionTagAnnotationKey:   "kurtosistech.com/enclave-api-container-version-tag",
+	EnclaveApiContainerLogLevelAnnotationKey:     "kurtosistech.com/enclave-api-container-log-level",
+	EnclaveLastActivityTimeAnnotationKey:         "kurtosistech.com/enclave-last-activity-time",
 	TraefikIngressRouterEntrypointsAnnotationKey: "traefik.ingress.kubernetes.io/router.entrypoints",
 }
 
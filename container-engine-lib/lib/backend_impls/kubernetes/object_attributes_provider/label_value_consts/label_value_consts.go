@@ -15,11 +15,14 @@ const (
 	engineKurtosisResourceTypeLabelValueStr = "kurtosis-engine"
 	logsCollectorResourceTypeLabelValueStr  = "kurtosis-logs-collector"
 	logsAggregatorResourceTypeLabelValueStr = "kurtosis-logs-aggregator"
+	// The engine data volume outlives the engines, which find it again by this value
+	engineDataVolumeTypeLabelValueStr = "kurtosis-engine-data"
 	// !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! DO NOT CHANGE THESE VALUES !!!!!!!!!!!!!!!!!!!!!!!!!!!!!
 
 	enclaveKurtosisResourceTypeLabelValueStr      = "enclave"
 	apiContainerKurtosisResourceTypeLabelValueStr = "api-container"
 	userServiceKurtosisResourceTypeLabelValueStr  = "user-service"
+	replicaSetKurtosisResourceTypeLabelValueStr   = "replica-set"
 
 	enclaveDataVolumeTypeLabelValueStr             = "enclave-data"
 	filesArtifactsExpansionVolumeTypeLabelValueStr = "files-artifacts-expansion"
@@ -32,12 +35,14 @@ const (
 // If you add new immutable values to this section, MAKE SURE TO UPDATE THE UNIT TEST!
 var AppIDKubernetesLabelValue = kubernetes_label_value.MustCreateNewKubernetesLabelValue(appIdLabelValueStr)
 var EngineKurtosisResourceTypeKubernetesLabelValue = kubernetes_label_value.MustCreateNewKubernetesLabelValue(engineKurtosisResourceTypeLabelValueStr)
+var EngineDataVolumeTypeKubernetesLabelValue = kubernetes_label_value.MustCreateNewKubernetesLabelValue(engineDataVolumeTypeLabelValueStr)
 
 // !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! DO NOT CHANGE THESE VALUES !!!!!!!!!!!!!!!!!!!!!!!!!!!!!
 
 var EnclaveKurtosisResourceTypeKubernetesLabelValue = kubernetes_label_value.MustCreateNewKubernetesLabelValue(enclaveKurtosisResourceTypeLabelValueStr)
 var APIContainerKurtosisResourceTypeKubernetesLabelValue = kubernetes_label_value.MustCreateNewKubernetesLabelValue(apiContainerKurtosisResourceTypeLabelValueStr)
 var UserServiceKurtosisResourceTypeKubernetesLabelValue = kubernetes_label_value.MustCreateNewKubernetesLabelValue(userServiceKurtosisResourceTypeLabelValueStr)
+var ReplicaSetKurtosisResourceTypeKubernetesLabelValue = kubernetes_label_value.MustCreateNewKubernetesLabelValue(replicaSetKurtosisResourceTypeLabelValueStr)
 var EnclaveDataVolumeTypeKubernetesLabelValue = kubernetes_label_value.MustCreateNewKubernetesLabelValue(enclaveDataVolumeTypeLabelValueStr)
 var FilesArtifactsExpansionVolumeTypeKubernetesLabelValue = kubernetes_label_value.MustCreateNewKubernetesLabelValue(filesArtifactsExpansionVolumeTypeLabelValueStr)
 var LogsCollectorKurtosisResourceTypeKubernetesLabelValue = kubernetes_label_value.MustCreateNewKubernetesLabelValue(logsCollectorResourceTypeLabelValueStr)
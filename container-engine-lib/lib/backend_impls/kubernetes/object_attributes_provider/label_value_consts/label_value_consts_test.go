@@ -10,12 +10,14 @@ var labelValueStrsToEnsure = map[string]string{
 	appIdLabelValueStr:                      "kurtosis",
 	engineKurtosisResourceTypeLabelValueStr: "kurtosis-engine",
 	logsCollectorResourceTypeLabelValueStr:  "kurtosis-logs-collector",
+	engineDataVolumeTypeLabelValueStr:       "kurtosis-engine-data",
 }
 
 var labelValuesToEnsure = map[*kubernetes_label_value.KubernetesLabelValue]string{
 	AppIDKubernetesLabelValue:                             "kurtosis",
 	EngineKurtosisResourceTypeKubernetesLabelValue:        "kurtosis-engine",
 	LogsCollectorKurtosisResourceTypeKubernetesLabelValue: "kurtosis-logs-collector",
+	EngineDataVolumeTypeKubernetesLabelValue:              "kurtosis-engine-data",
 }
 
 // !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! IMPORTANT !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
@@ -7,6 +7,7 @@ import (
 
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_build_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_registry_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/interactive_exec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/nix_build_spec"
 
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
@@ -20,6 +21,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/reverse_proxy"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
 	"github.com/kurtosis-tech/stacktrace"
 )
 
@@ -163,6 +165,108 @@ func (backend *MetricsReportingKurtosisBackend) UpdateEnclave(
 	return nil
 }
 
+func (backend *MetricsReportingKurtosisBackend) UpdateEnclaveLifetime(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	lifetime *enclave.EnclaveLifetime,
+) error {
+	if err := backend.underlying.UpdateEnclaveLifetime(ctx, enclaveUuid, lifetime); err != nil {
+		return stacktrace.Propagate(err, "An error occurred updating the lifetime of enclave with UUID '%v'", enclaveUuid)
+	}
+	return nil
+}
+
+func (backend *MetricsReportingKurtosisBackend) UpdateEnclaveResourceQuota(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	resourceQuota *enclave.EnclaveResourceQuota,
+) error {
+	if err := backend.underlying.UpdateEnclaveResourceQuota(ctx, enclaveUuid, resourceQuota); err != nil {
+		return stacktrace.Propagate(err, "An error occurred updating the resource quota of enclave with UUID '%v'", enclaveUuid)
+	}
+	return nil
+}
+
+func (backend *MetricsReportingKurtosisBackend) UpdateEnclaveOwner(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	owner string,
+) error {
+	if err := backend.underlying.UpdateEnclaveOwner(ctx, enclaveUuid, owner); err != nil {
+		return stacktrace.Propagate(err, "An error occurred updating the owner of enclave with UUID '%v'", enclaveUuid)
+	}
+	return nil
+}
+
+func (backend *MetricsReportingKurtosisBackend) UpdateEnclaveApiContainerSettings(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	apiContainerSettings *enclave.EnclaveApiContainerSettings,
+) error {
+	if err := backend.underlying.UpdateEnclaveApiContainerSettings(ctx, enclaveUuid, apiContainerSettings); err != nil {
+		return stacktrace.Propagate(err, "An error occurred updating the API container settings of enclave with UUID '%v'", enclaveUuid)
+	}
+	return nil
+}
+
+func (backend *MetricsReportingKurtosisBackend) UpdateEnclaveLastActivityTime(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	lastActivityTime time.Time,
+) error {
+	if err := backend.underlying.UpdateEnclaveLastActivityTime(ctx, enclaveUuid, lastActivityTime); err != nil {
+		return stacktrace.Propagate(err, "An error occurred updating the last activity time of enclave with UUID '%v'", enclaveUuid)
+	}
+	return nil
+}
+
+func (backend *MetricsReportingKurtosisBackend) CopyEnclavePersistentDirectories(
+	ctx context.Context,
+	sourceEnclaveUuid enclave.EnclaveUUID,
+	destinationEnclaveUuid enclave.EnclaveUUID,
+) error {
+	if err := backend.underlying.CopyEnclavePersistentDirectories(ctx, sourceEnclaveUuid, destinationEnclaveUuid); err != nil {
+		return stacktrace.Propagate(err, "An error occurred copying the persistent directories of enclave '%v' into enclave '%v'", sourceEnclaveUuid, destinationEnclaveUuid)
+	}
+	return nil
+}
+
+func (backend *MetricsReportingKurtosisBackend) GetPersistentDirectoryKeys(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+) (map[service_directory.DirectoryPersistentKey]bool, error) {
+	persistentKeys, err := backend.underlying.GetPersistentDirectoryKeys(ctx, enclaveUuid)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the persistent directories of enclave '%v'", enclaveUuid)
+	}
+	return persistentKeys, nil
+}
+
+func (backend *MetricsReportingKurtosisBackend) ExportPersistentDirectory(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	persistentKey service_directory.DirectoryPersistentKey,
+	output io.Writer,
+) error {
+	if err := backend.underlying.ExportPersistentDirectory(ctx, enclaveUuid, persistentKey, output); err != nil {
+		return stacktrace.Propagate(err, "An error occurred exporting persistent directory '%v' of enclave '%v'", persistentKey, enclaveUuid)
+	}
+	return nil
+}
+
+func (backend *MetricsReportingKurtosisBackend) ImportPersistentDirectory(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	persistentKey service_directory.DirectoryPersistentKey,
+	size service_directory.DirectoryPersistentSize,
+	tarContent io.Reader,
+) error {
+	if err := backend.underlying.ImportPersistentDirectory(ctx, enclaveUuid, persistentKey, size, tarContent); err != nil {
+		return stacktrace.Propagate(err, "An error occurred importing persistent directory '%v' of enclave '%v'", persistentKey, enclaveUuid)
+	}
+	return nil
+}
+
 func (backend *MetricsReportingKurtosisBackend) StopEnclaves(
 	ctx context.Context,
 	filters *enclave.EnclaveFilters,
@@ -358,6 +462,20 @@ func (backend *MetricsReportingKurtosisBackend) RunUserServiceExecCommandWithStr
 	return backend.underlying.RunUserServiceExecCommandWithStreamedOutput(ctx, enclaveUuid, serviceUuid, cmd)
 }
 
+func (backend *MetricsReportingKurtosisBackend) RunUserServiceInteractiveExecCommand(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	cmd []string,
+	execStreams *interactive_exec.InteractiveExecStreams,
+) (int32, error) {
+	exitCode, err := backend.underlying.RunUserServiceInteractiveExecCommand(ctx, enclaveUuid, serviceUuid, cmd, execStreams)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred running interactive exec command '%+v' on service '%v' in enclave '%v'", cmd, serviceUuid, enclaveUuid)
+	}
+	return exitCode, nil
+}
+
 func (backend *MetricsReportingKurtosisBackend) GetShellOnUserService(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID) (resultErr error) {
 	err := backend.underlying.GetShellOnUserService(ctx, enclaveUuid, serviceUuid)
 	if err != nil {
@@ -385,6 +503,25 @@ func (backend *MetricsReportingKurtosisBackend) CopyFilesFromUserService(
 	return nil
 }
 
+func (backend *MetricsReportingKurtosisBackend) CopyFilesToUserService(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	dstDirpathOnService string,
+	tarContent io.Reader,
+) error {
+	if err := backend.underlying.CopyFilesToUserService(ctx, enclaveUuid, serviceUuid, dstDirpathOnService, tarContent); err != nil {
+		return stacktrace.Propagate(
+			err,
+			"An error occurred copying files to directory '%v' in user service with UUID '%v' in enclave with UUID '%v'",
+			dstDirpathOnService,
+			serviceUuid,
+			enclaveUuid,
+		)
+	}
+	return nil
+}
+
 func (backend *MetricsReportingKurtosisBackend) StopUserServices(
 	ctx context.Context,
 	enclaveUuid enclave.EnclaveUUID,
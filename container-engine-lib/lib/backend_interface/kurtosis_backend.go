@@ -7,6 +7,7 @@ import (
 
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_build_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_registry_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/interactive_exec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/nix_build_spec"
 
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/api_container"
@@ -19,6 +20,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/reverse_proxy"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
 )
 
 // TODO This mega-backend should really have its individual functionalities split up into
@@ -102,6 +104,76 @@ type KurtosisBackend interface {
 		creationTime *time.Time,
 	) error
 
+	// Replaces the lifetime of an enclave; a nil lifetime means the enclave is kept until it's destroyed by hand
+	UpdateEnclaveLifetime(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+		lifetime *enclave.EnclaveLifetime,
+	) error
+
+	// Replaces the resource quota of an enclave; a nil or empty quota lifts every cap
+	UpdateEnclaveResourceQuota(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+		resourceQuota *enclave.EnclaveResourceQuota,
+	) error
+
+	// Records the engine API user who owns an enclave; an empty owner clears it
+	UpdateEnclaveOwner(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+		owner string,
+	) error
+
+	// Records the settings the API container of an enclave was launched with; nil settings clear them
+	UpdateEnclaveApiContainerSettings(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+		apiContainerSettings *enclave.EnclaveApiContainerSettings,
+	) error
+
+	// Records the last time the enclave was used, so that its idle timeout survives engine restarts
+	UpdateEnclaveLastActivityTime(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+		lastActivityTime time.Time,
+	) error
+
+	// Copies the contents of every persistent directory of the source enclave into a persistent directory with the
+	// same key in the destination enclave, so services later started there with that key find the data already in place
+	CopyEnclavePersistentDirectories(
+		ctx context.Context,
+		sourceEnclaveUuid enclave.EnclaveUUID,
+		destinationEnclaveUuid enclave.EnclaveUUID,
+	) error
+
+	// Gets the keys of the persistent directories existing in the enclave
+	GetPersistentDirectoryKeys(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+	) (
+		map[service_directory.DirectoryPersistentKey]bool,
+		error,
+	)
+
+	// Writes an uncompressed TAR of the contents of the enclave persistent directory with the given key to the output
+	ExportPersistentDirectory(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+		persistentKey service_directory.DirectoryPersistentKey,
+		output io.Writer,
+	) error
+
+	// Replaces the contents of the enclave persistent directory with the given key by the files of the uncompressed TAR,
+	// creating the directory with the given size first if it doesn't exist yet
+	ImportPersistentDirectory(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+		persistentKey service_directory.DirectoryPersistentKey,
+		size service_directory.DirectoryPersistentSize,
+		tarContent io.Reader,
+	) error
+
 	// Gets enclaves matching the given filters
 	GetEnclaves(
 		ctx context.Context,
@@ -300,6 +372,15 @@ type KurtosisBackend interface {
 		cmd []string,
 	) (execOutputChan chan string, finalExecResultChan chan *exec_result.ExecResult, resultErr error)
 
+	// Executes a command inside an user service with the given streams attached, blocking until the command exits
+	RunUserServiceInteractiveExecCommand(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+		serviceUuid service.ServiceUUID,
+		cmd []string,
+		execStreams *interactive_exec.InteractiveExecStreams,
+	) (exitCode int32, resultErr error)
+
 	// Get a connection with user service to execute commands in
 	GetShellOnUserService(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID) (resultErr error)
 
@@ -312,6 +393,16 @@ type KurtosisBackend interface {
 		output io.Writer,
 	) error
 
+	// Extracts the files, packaged as a TAR, read from the given reader into the given directory of the user service,
+	// creating the directory if it doesn't exist. Files already in the directory are overwritten.
+	CopyFilesToUserService(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+		serviceUuid service.ServiceUUID,
+		dstDirpathOnService string,
+		tarContent io.Reader,
+	) error
+
 	// StopUserServices stops the user containers for the services matching the given filters
 	StopUserServices(
 		ctx context.Context,
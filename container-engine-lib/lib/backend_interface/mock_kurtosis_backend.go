@@ -20,6 +20,8 @@ import (
 
 	image_registry_spec "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_registry_spec"
 
+	interactive_exec "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/interactive_exec"
+
 	io "io"
 
 	logs_aggregator "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
@@ -34,6 +36,8 @@ import (
 
 	service "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 
+	service_directory "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
+
 	time "time"
 )
 
@@ -104,6 +108,50 @@ func (_c *MockKurtosisBackend_BuildImage_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// CopyEnclavePersistentDirectories provides a mock function with given fields: ctx, sourceEnclaveUuid, destinationEnclaveUuid
+func (_m *MockKurtosisBackend) CopyEnclavePersistentDirectories(ctx context.Context, sourceEnclaveUuid enclave.EnclaveUUID, destinationEnclaveUuid enclave.EnclaveUUID) error {
+	ret := _m.Called(ctx, sourceEnclaveUuid, destinationEnclaveUuid)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, enclave.EnclaveUUID) error); ok {
+		r0 = rf(ctx, sourceEnclaveUuid, destinationEnclaveUuid)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockKurtosisBackend_CopyEnclavePersistentDirectories_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CopyEnclavePersistentDirectories'
+type MockKurtosisBackend_CopyEnclavePersistentDirectories_Call struct {
+	*mock.Call
+}
+
+// CopyEnclavePersistentDirectories is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sourceEnclaveUuid enclave.EnclaveUUID
+//   - destinationEnclaveUuid enclave.EnclaveUUID
+func (_e *MockKurtosisBackend_Expecter) CopyEnclavePersistentDirectories(ctx interface{}, sourceEnclaveUuid interface{}, destinationEnclaveUuid interface{}) *MockKurtosisBackend_CopyEnclavePersistentDirectories_Call {
+	return &MockKurtosisBackend_CopyEnclavePersistentDirectories_Call{Call: _e.mock.On("CopyEnclavePersistentDirectories", ctx, sourceEnclaveUuid, destinationEnclaveUuid)}
+}
+
+func (_c *MockKurtosisBackend_CopyEnclavePersistentDirectories_Call) Run(run func(ctx context.Context, sourceEnclaveUuid enclave.EnclaveUUID, destinationEnclaveUuid enclave.EnclaveUUID)) *MockKurtosisBackend_CopyEnclavePersistentDirectories_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(enclave.EnclaveUUID))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_CopyEnclavePersistentDirectories_Call) Return(_a0 error) *MockKurtosisBackend_CopyEnclavePersistentDirectories_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_CopyEnclavePersistentDirectories_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, enclave.EnclaveUUID) error) *MockKurtosisBackend_CopyEnclavePersistentDirectories_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CopyFilesFromUserService provides a mock function with given fields: ctx, enclaveUuid, serviceUuid, srcPathOnService, output
 func (_m *MockKurtosisBackend) CopyFilesFromUserService(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, srcPathOnService string, output io.Writer) error {
 	ret := _m.Called(ctx, enclaveUuid, serviceUuid, srcPathOnService, output)
@@ -150,6 +198,52 @@ func (_c *MockKurtosisBackend_CopyFilesFromUserService_Call) RunAndReturn(run fu
 	return _c
 }
 
+// CopyFilesToUserService provides a mock function with given fields: ctx, enclaveUuid, serviceUuid, dstDirpathOnService, tarContent
+func (_m *MockKurtosisBackend) CopyFilesToUserService(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, dstDirpathOnService string, tarContent io.Reader) error {
+	ret := _m.Called(ctx, enclaveUuid, serviceUuid, dstDirpathOnService, tarContent)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, service.ServiceUUID, string, io.Reader) error); ok {
+		r0 = rf(ctx, enclaveUuid, serviceUuid, dstDirpathOnService, tarContent)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockKurtosisBackend_CopyFilesToUserService_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CopyFilesToUserService'
+type MockKurtosisBackend_CopyFilesToUserService_Call struct {
+	*mock.Call
+}
+
+// CopyFilesToUserService is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+//   - serviceUuid service.ServiceUUID
+//   - dstDirpathOnService string
+//   - tarContent io.Reader
+func (_e *MockKurtosisBackend_Expecter) CopyFilesToUserService(ctx interface{}, enclaveUuid interface{}, serviceUuid interface{}, dstDirpathOnService interface{}, tarContent interface{}) *MockKurtosisBackend_CopyFilesToUserService_Call {
+	return &MockKurtosisBackend_CopyFilesToUserService_Call{Call: _e.mock.On("CopyFilesToUserService", ctx, enclaveUuid, serviceUuid, dstDirpathOnService, tarContent)}
+}
+
+func (_c *MockKurtosisBackend_CopyFilesToUserService_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, dstDirpathOnService string, tarContent io.Reader)) *MockKurtosisBackend_CopyFilesToUserService_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(service.ServiceUUID), args[3].(string), args[4].(io.Reader))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_CopyFilesToUserService_Call) Return(_a0 error) *MockKurtosisBackend_CopyFilesToUserService_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_CopyFilesToUserService_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, service.ServiceUUID, string, io.Reader) error) *MockKurtosisBackend_CopyFilesToUserService_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateAPIContainer provides a mock function with given fields: ctx, image, enclaveUuid, grpcPortNum, enclaveDataVolumeDirpath, ownIpAddressEnvVar, customEnvVars, shouldStartInDebugMode
 func (_m *MockKurtosisBackend) CreateAPIContainer(ctx context.Context, image string, enclaveUuid enclave.EnclaveUUID, grpcPortNum uint16, enclaveDataVolumeDirpath string, ownIpAddressEnvVar string, customEnvVars map[string]string, shouldStartInDebugMode bool) (*api_container.APIContainer, error) {
 	ret := _m.Called(ctx, image, enclaveUuid, grpcPortNum, enclaveDataVolumeDirpath, ownIpAddressEnvVar, customEnvVars, shouldStartInDebugMode)
@@ -972,6 +1066,51 @@ func (_c *MockKurtosisBackend_DumpKurtosis_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// ExportPersistentDirectory provides a mock function with given fields: ctx, enclaveUuid, persistentKey, output
+func (_m *MockKurtosisBackend) ExportPersistentDirectory(ctx context.Context, enclaveUuid enclave.EnclaveUUID, persistentKey service_directory.DirectoryPersistentKey, output io.Writer) error {
+	ret := _m.Called(ctx, enclaveUuid, persistentKey, output)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, service_directory.DirectoryPersistentKey, io.Writer) error); ok {
+		r0 = rf(ctx, enclaveUuid, persistentKey, output)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockKurtosisBackend_ExportPersistentDirectory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportPersistentDirectory'
+type MockKurtosisBackend_ExportPersistentDirectory_Call struct {
+	*mock.Call
+}
+
+// ExportPersistentDirectory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+//   - persistentKey service_directory.DirectoryPersistentKey
+//   - output io.Writer
+func (_e *MockKurtosisBackend_Expecter) ExportPersistentDirectory(ctx interface{}, enclaveUuid interface{}, persistentKey interface{}, output interface{}) *MockKurtosisBackend_ExportPersistentDirectory_Call {
+	return &MockKurtosisBackend_ExportPersistentDirectory_Call{Call: _e.mock.On("ExportPersistentDirectory", ctx, enclaveUuid, persistentKey, output)}
+}
+
+func (_c *MockKurtosisBackend_ExportPersistentDirectory_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, persistentKey service_directory.DirectoryPersistentKey, output io.Writer)) *MockKurtosisBackend_ExportPersistentDirectory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(service_directory.DirectoryPersistentKey), args[3].(io.Writer))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_ExportPersistentDirectory_Call) Return(_a0 error) *MockKurtosisBackend_ExportPersistentDirectory_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_ExportPersistentDirectory_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, service_directory.DirectoryPersistentKey, io.Writer) error) *MockKurtosisBackend_ExportPersistentDirectory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FetchImage provides a mock function with given fields: ctx, image, registrySpec, downloadMode
 func (_m *MockKurtosisBackend) FetchImage(ctx context.Context, image string, registrySpec *image_registry_spec.ImageRegistrySpec, downloadMode image_download_mode.ImageDownloadMode) (bool, string, error) {
 	ret := _m.Called(ctx, image, registrySpec, downloadMode)
@@ -1417,6 +1556,61 @@ func (_c *MockKurtosisBackend_GetLogsCollectorForEnclave_Call) RunAndReturn(run
 	return _c
 }
 
+// GetPersistentDirectoryKeys provides a mock function with given fields: ctx, enclaveUuid
+func (_m *MockKurtosisBackend) GetPersistentDirectoryKeys(ctx context.Context, enclaveUuid enclave.EnclaveUUID) (map[service_directory.DirectoryPersistentKey]bool, error) {
+	ret := _m.Called(ctx, enclaveUuid)
+
+	var r0 map[service_directory.DirectoryPersistentKey]bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID) (map[service_directory.DirectoryPersistentKey]bool, error)); ok {
+		return rf(ctx, enclaveUuid)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID) map[service_directory.DirectoryPersistentKey]bool); ok {
+		r0 = rf(ctx, enclaveUuid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[service_directory.DirectoryPersistentKey]bool)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, enclave.EnclaveUUID) error); ok {
+		r1 = rf(ctx, enclaveUuid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockKurtosisBackend_GetPersistentDirectoryKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPersistentDirectoryKeys'
+type MockKurtosisBackend_GetPersistentDirectoryKeys_Call struct {
+	*mock.Call
+}
+
+// GetPersistentDirectoryKeys is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+func (_e *MockKurtosisBackend_Expecter) GetPersistentDirectoryKeys(ctx interface{}, enclaveUuid interface{}) *MockKurtosisBackend_GetPersistentDirectoryKeys_Call {
+	return &MockKurtosisBackend_GetPersistentDirectoryKeys_Call{Call: _e.mock.On("GetPersistentDirectoryKeys", ctx, enclaveUuid)}
+}
+
+func (_c *MockKurtosisBackend_GetPersistentDirectoryKeys_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID)) *MockKurtosisBackend_GetPersistentDirectoryKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_GetPersistentDirectoryKeys_Call) Return(_a0 map[service_directory.DirectoryPersistentKey]bool, _a1 error) *MockKurtosisBackend_GetPersistentDirectoryKeys_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_GetPersistentDirectoryKeys_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID) (map[service_directory.DirectoryPersistentKey]bool, error)) *MockKurtosisBackend_GetPersistentDirectoryKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetReverseProxy provides a mock function with given fields: ctx
 func (_m *MockKurtosisBackend) GetReverseProxy(ctx context.Context) (*reverse_proxy.ReverseProxy, error) {
 	ret := _m.Called(ctx)
@@ -1637,6 +1831,52 @@ func (_c *MockKurtosisBackend_GetUserServices_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// ImportPersistentDirectory provides a mock function with given fields: ctx, enclaveUuid, persistentKey, size, tarContent
+func (_m *MockKurtosisBackend) ImportPersistentDirectory(ctx context.Context, enclaveUuid enclave.EnclaveUUID, persistentKey service_directory.DirectoryPersistentKey, size service_directory.DirectoryPersistentSize, tarContent io.Reader) error {
+	ret := _m.Called(ctx, enclaveUuid, persistentKey, size, tarContent)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, service_directory.DirectoryPersistentKey, service_directory.DirectoryPersistentSize, io.Reader) error); ok {
+		r0 = rf(ctx, enclaveUuid, persistentKey, size, tarContent)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockKurtosisBackend_ImportPersistentDirectory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportPersistentDirectory'
+type MockKurtosisBackend_ImportPersistentDirectory_Call struct {
+	*mock.Call
+}
+
+// ImportPersistentDirectory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+//   - persistentKey service_directory.DirectoryPersistentKey
+//   - size service_directory.DirectoryPersistentSize
+//   - tarContent io.Reader
+func (_e *MockKurtosisBackend_Expecter) ImportPersistentDirectory(ctx interface{}, enclaveUuid interface{}, persistentKey interface{}, size interface{}, tarContent interface{}) *MockKurtosisBackend_ImportPersistentDirectory_Call {
+	return &MockKurtosisBackend_ImportPersistentDirectory_Call{Call: _e.mock.On("ImportPersistentDirectory", ctx, enclaveUuid, persistentKey, size, tarContent)}
+}
+
+func (_c *MockKurtosisBackend_ImportPersistentDirectory_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, persistentKey service_directory.DirectoryPersistentKey, size service_directory.DirectoryPersistentSize, tarContent io.Reader)) *MockKurtosisBackend_ImportPersistentDirectory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(service_directory.DirectoryPersistentKey), args[3].(service_directory.DirectoryPersistentSize), args[4].(io.Reader))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_ImportPersistentDirectory_Call) Return(_a0 error) *MockKurtosisBackend_ImportPersistentDirectory_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_ImportPersistentDirectory_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, service_directory.DirectoryPersistentKey, service_directory.DirectoryPersistentSize, io.Reader) error) *MockKurtosisBackend_ImportPersistentDirectory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NixBuild provides a mock function with given fields: ctx, nixBuildSpec
 func (_m *MockKurtosisBackend) NixBuild(ctx context.Context, nixBuildSpec *nix_build_spec.NixBuildSpec) (string, error) {
 	ret := _m.Called(ctx, nixBuildSpec)
@@ -2006,6 +2246,62 @@ func (_c *MockKurtosisBackend_RunUserServiceExecCommands_Call) RunAndReturn(run
 	return _c
 }
 
+// RunUserServiceInteractiveExecCommand provides a mock function with given fields: ctx, enclaveUuid, serviceUuid, cmd, execStreams
+func (_m *MockKurtosisBackend) RunUserServiceInteractiveExecCommand(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, cmd []string, execStreams *interactive_exec.InteractiveExecStreams) (int32, error) {
+	ret := _m.Called(ctx, enclaveUuid, serviceUuid, cmd, execStreams)
+
+	var r0 int32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, service.ServiceUUID, []string, *interactive_exec.InteractiveExecStreams) (int32, error)); ok {
+		return rf(ctx, enclaveUuid, serviceUuid, cmd, execStreams)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, service.ServiceUUID, []string, *interactive_exec.InteractiveExecStreams) int32); ok {
+		r0 = rf(ctx, enclaveUuid, serviceUuid, cmd, execStreams)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, enclave.EnclaveUUID, service.ServiceUUID, []string, *interactive_exec.InteractiveExecStreams) error); ok {
+		r1 = rf(ctx, enclaveUuid, serviceUuid, cmd, execStreams)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockKurtosisBackend_RunUserServiceInteractiveExecCommand_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunUserServiceInteractiveExecCommand'
+type MockKurtosisBackend_RunUserServiceInteractiveExecCommand_Call struct {
+	*mock.Call
+}
+
+// RunUserServiceInteractiveExecCommand is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+//   - serviceUuid service.ServiceUUID
+//   - cmd []string
+//   - execStreams *interactive_exec.InteractiveExecStreams
+func (_e *MockKurtosisBackend_Expecter) RunUserServiceInteractiveExecCommand(ctx interface{}, enclaveUuid interface{}, serviceUuid interface{}, cmd interface{}, execStreams interface{}) *MockKurtosisBackend_RunUserServiceInteractiveExecCommand_Call {
+	return &MockKurtosisBackend_RunUserServiceInteractiveExecCommand_Call{Call: _e.mock.On("RunUserServiceInteractiveExecCommand", ctx, enclaveUuid, serviceUuid, cmd, execStreams)}
+}
+
+func (_c *MockKurtosisBackend_RunUserServiceInteractiveExecCommand_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, cmd []string, execStreams *interactive_exec.InteractiveExecStreams)) *MockKurtosisBackend_RunUserServiceInteractiveExecCommand_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(service.ServiceUUID), args[3].([]string), args[4].(*interactive_exec.InteractiveExecStreams))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_RunUserServiceInteractiveExecCommand_Call) Return(exitCode int32, resultErr error) *MockKurtosisBackend_RunUserServiceInteractiveExecCommand_Call {
+	_c.Call.Return(exitCode, resultErr)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_RunUserServiceInteractiveExecCommand_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, service.ServiceUUID, []string, *interactive_exec.InteractiveExecStreams) (int32, error)) *MockKurtosisBackend_RunUserServiceInteractiveExecCommand_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // StartRegisteredUserServices provides a mock function with given fields: ctx, enclaveUuid, services
 func (_m *MockKurtosisBackend) StartRegisteredUserServices(ctx context.Context, enclaveUuid enclave.EnclaveUUID, services map[service.ServiceUUID]*service.ServiceConfig) (map[service.ServiceUUID]*service.Service, map[service.ServiceUUID]error, error) {
 	ret := _m.Called(ctx, enclaveUuid, services)
@@ -2438,6 +2734,226 @@ func (_c *MockKurtosisBackend_UpdateEnclave_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// UpdateEnclaveApiContainerSettings provides a mock function with given fields: ctx, enclaveUuid, apiContainerSettings
+func (_m *MockKurtosisBackend) UpdateEnclaveApiContainerSettings(ctx context.Context, enclaveUuid enclave.EnclaveUUID, apiContainerSettings *enclave.EnclaveApiContainerSettings) error {
+	ret := _m.Called(ctx, enclaveUuid, apiContainerSettings)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, *enclave.EnclaveApiContainerSettings) error); ok {
+		r0 = rf(ctx, enclaveUuid, apiContainerSettings)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockKurtosisBackend_UpdateEnclaveApiContainerSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateEnclaveApiContainerSettings'
+type MockKurtosisBackend_UpdateEnclaveApiContainerSettings_Call struct {
+	*mock.Call
+}
+
+// UpdateEnclaveApiContainerSettings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+//   - apiContainerSettings *enclave.EnclaveApiContainerSettings
+func (_e *MockKurtosisBackend_Expecter) UpdateEnclaveApiContainerSettings(ctx interface{}, enclaveUuid interface{}, apiContainerSettings interface{}) *MockKurtosisBackend_UpdateEnclaveApiContainerSettings_Call {
+	return &MockKurtosisBackend_UpdateEnclaveApiContainerSettings_Call{Call: _e.mock.On("UpdateEnclaveApiContainerSettings", ctx, enclaveUuid, apiContainerSettings)}
+}
+
+func (_c *MockKurtosisBackend_UpdateEnclaveApiContainerSettings_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, apiContainerSettings *enclave.EnclaveApiContainerSettings)) *MockKurtosisBackend_UpdateEnclaveApiContainerSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(*enclave.EnclaveApiContainerSettings))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_UpdateEnclaveApiContainerSettings_Call) Return(_a0 error) *MockKurtosisBackend_UpdateEnclaveApiContainerSettings_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_UpdateEnclaveApiContainerSettings_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, *enclave.EnclaveApiContainerSettings) error) *MockKurtosisBackend_UpdateEnclaveApiContainerSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateEnclaveLastActivityTime provides a mock function with given fields: ctx, enclaveUuid, lastActivityTime
+func (_m *MockKurtosisBackend) UpdateEnclaveLastActivityTime(ctx context.Context, enclaveUuid enclave.EnclaveUUID, lastActivityTime time.Time) error {
+	ret := _m.Called(ctx, enclaveUuid, lastActivityTime)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, time.Time) error); ok {
+		r0 = rf(ctx, enclaveUuid, lastActivityTime)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockKurtosisBackend_UpdateEnclaveLastActivityTime_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateEnclaveLastActivityTime'
+type MockKurtosisBackend_UpdateEnclaveLastActivityTime_Call struct {
+	*mock.Call
+}
+
+// UpdateEnclaveLastActivityTime is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+//   - lastActivityTime time.Time
+func (_e *MockKurtosisBackend_Expecter) UpdateEnclaveLastActivityTime(ctx interface{}, enclaveUuid interface{}, lastActivityTime interface{}) *MockKurtosisBackend_UpdateEnclaveLastActivityTime_Call {
+	return &MockKurtosisBackend_UpdateEnclaveLastActivityTime_Call{Call: _e.mock.On("UpdateEnclaveLastActivityTime", ctx, enclaveUuid, lastActivityTime)}
+}
+
+func (_c *MockKurtosisBackend_UpdateEnclaveLastActivityTime_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, lastActivityTime time.Time)) *MockKurtosisBackend_UpdateEnclaveLastActivityTime_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_UpdateEnclaveLastActivityTime_Call) Return(_a0 error) *MockKurtosisBackend_UpdateEnclaveLastActivityTime_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_UpdateEnclaveLastActivityTime_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, time.Time) error) *MockKurtosisBackend_UpdateEnclaveLastActivityTime_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateEnclaveLifetime provides a mock function with given fields: ctx, enclaveUuid, lifetime
+func (_m *MockKurtosisBackend) UpdateEnclaveLifetime(ctx context.Context, enclaveUuid enclave.EnclaveUUID, lifetime *enclave.EnclaveLifetime) error {
+	ret := _m.Called(ctx, enclaveUuid, lifetime)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, *enclave.EnclaveLifetime) error); ok {
+		r0 = rf(ctx, enclaveUuid, lifetime)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockKurtosisBackend_UpdateEnclaveLifetime_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateEnclaveLifetime'
+type MockKurtosisBackend_UpdateEnclaveLifetime_Call struct {
+	*mock.Call
+}
+
+// UpdateEnclaveLifetime is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+//   - lifetime *enclave.EnclaveLifetime
+func (_e *MockKurtosisBackend_Expecter) UpdateEnclaveLifetime(ctx interface{}, enclaveUuid interface{}, lifetime interface{}) *MockKurtosisBackend_UpdateEnclaveLifetime_Call {
+	return &MockKurtosisBackend_UpdateEnclaveLifetime_Call{Call: _e.mock.On("UpdateEnclaveLifetime", ctx, enclaveUuid, lifetime)}
+}
+
+func (_c *MockKurtosisBackend_UpdateEnclaveLifetime_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, lifetime *enclave.EnclaveLifetime)) *MockKurtosisBackend_UpdateEnclaveLifetime_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(*enclave.EnclaveLifetime))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_UpdateEnclaveLifetime_Call) Return(_a0 error) *MockKurtosisBackend_UpdateEnclaveLifetime_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_UpdateEnclaveLifetime_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, *enclave.EnclaveLifetime) error) *MockKurtosisBackend_UpdateEnclaveLifetime_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateEnclaveOwner provides a mock function with given fields: ctx, enclaveUuid, owner
+func (_m *MockKurtosisBackend) UpdateEnclaveOwner(ctx context.Context, enclaveUuid enclave.EnclaveUUID, owner string) error {
+	ret := _m.Called(ctx, enclaveUuid, owner)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, string) error); ok {
+		r0 = rf(ctx, enclaveUuid, owner)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockKurtosisBackend_UpdateEnclaveOwner_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateEnclaveOwner'
+type MockKurtosisBackend_UpdateEnclaveOwner_Call struct {
+	*mock.Call
+}
+
+// UpdateEnclaveOwner is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+//   - owner string
+func (_e *MockKurtosisBackend_Expecter) UpdateEnclaveOwner(ctx interface{}, enclaveUuid interface{}, owner interface{}) *MockKurtosisBackend_UpdateEnclaveOwner_Call {
+	return &MockKurtosisBackend_UpdateEnclaveOwner_Call{Call: _e.mock.On("UpdateEnclaveOwner", ctx, enclaveUuid, owner)}
+}
+
+func (_c *MockKurtosisBackend_UpdateEnclaveOwner_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, owner string)) *MockKurtosisBackend_UpdateEnclaveOwner_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_UpdateEnclaveOwner_Call) Return(_a0 error) *MockKurtosisBackend_UpdateEnclaveOwner_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_UpdateEnclaveOwner_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, string) error) *MockKurtosisBackend_UpdateEnclaveOwner_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateEnclaveResourceQuota provides a mock function with given fields: ctx, enclaveUuid, resourceQuota
+func (_m *MockKurtosisBackend) UpdateEnclaveResourceQuota(ctx context.Context, enclaveUuid enclave.EnclaveUUID, resourceQuota *enclave.EnclaveResourceQuota) error {
+	ret := _m.Called(ctx, enclaveUuid, resourceQuota)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, *enclave.EnclaveResourceQuota) error); ok {
+		r0 = rf(ctx, enclaveUuid, resourceQuota)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockKurtosisBackend_UpdateEnclaveResourceQuota_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateEnclaveResourceQuota'
+type MockKurtosisBackend_UpdateEnclaveResourceQuota_Call struct {
+	*mock.Call
+}
+
+// UpdateEnclaveResourceQuota is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+//   - resourceQuota *enclave.EnclaveResourceQuota
+func (_e *MockKurtosisBackend_Expecter) UpdateEnclaveResourceQuota(ctx interface{}, enclaveUuid interface{}, resourceQuota interface{}) *MockKurtosisBackend_UpdateEnclaveResourceQuota_Call {
+	return &MockKurtosisBackend_UpdateEnclaveResourceQuota_Call{Call: _e.mock.On("UpdateEnclaveResourceQuota", ctx, enclaveUuid, resourceQuota)}
+}
+
+func (_c *MockKurtosisBackend_UpdateEnclaveResourceQuota_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, resourceQuota *enclave.EnclaveResourceQuota)) *MockKurtosisBackend_UpdateEnclaveResourceQuota_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(*enclave.EnclaveResourceQuota))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_UpdateEnclaveResourceQuota_Call) Return(_a0 error) *MockKurtosisBackend_UpdateEnclaveResourceQuota_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_UpdateEnclaveResourceQuota_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, *enclave.EnclaveResourceQuota) error) *MockKurtosisBackend_UpdateEnclaveResourceQuota_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 type mockConstructorTestingTNewMockKurtosisBackend interface {
 	mock.TestingT
 	Cleanup(func())
@@ -10,10 +10,20 @@ type Enclave struct {
 	status              EnclaveStatus
 	creationTime        *time.Time
 	isProductionEnclave bool
+	// nil if the enclave lives until it's destroyed by hand
+	lifetime *EnclaveLifetime
+	// nil if the services in the enclave can use as much as the host allows
+	resourceQuota *EnclaveResourceQuota
+	// Name of the engine API user who created the enclave; empty if the engine didn't require authentication
+	owner string
+	// nil if the enclave was created before its API container settings were recorded
+	apiContainerSettings *EnclaveApiContainerSettings
+	// nil if no activity was recorded for the enclave
+	lastActivityTime *time.Time
 }
 
-func NewEnclave(id EnclaveUUID, name string, status EnclaveStatus, creationTime *time.Time, productionMode bool) *Enclave {
-	return &Enclave{uuid: id, name: name, status: status, creationTime: creationTime, isProductionEnclave: productionMode}
+func NewEnclave(id EnclaveUUID, name string, status EnclaveStatus, creationTime *time.Time, productionMode bool, lifetime *EnclaveLifetime, resourceQuota *EnclaveResourceQuota, owner string, apiContainerSettings *EnclaveApiContainerSettings, lastActivityTime *time.Time) *Enclave {
+	return &Enclave{uuid: id, name: name, status: status, creationTime: creationTime, isProductionEnclave: productionMode, lifetime: lifetime, resourceQuota: resourceQuota, owner: owner, apiContainerSettings: apiContainerSettings, lastActivityTime: lastActivityTime}
 }
 
 func (enclave *Enclave) GetUUID() EnclaveUUID {
@@ -35,3 +45,23 @@ func (enclave *Enclave) GetName() string {
 func (enclave *Enclave) IsProductionEnclave() bool {
 	return enclave.isProductionEnclave
 }
+
+func (enclave *Enclave) GetLifetime() *EnclaveLifetime {
+	return enclave.lifetime
+}
+
+func (enclave *Enclave) GetResourceQuota() *EnclaveResourceQuota {
+	return enclave.resourceQuota
+}
+
+func (enclave *Enclave) GetOwner() string {
+	return enclave.owner
+}
+
+func (enclave *Enclave) GetApiContainerSettings() *EnclaveApiContainerSettings {
+	return enclave.apiContainerSettings
+}
+
+func (enclave *Enclave) GetLastActivityTime() *time.Time {
+	return enclave.lastActivityTime
+}
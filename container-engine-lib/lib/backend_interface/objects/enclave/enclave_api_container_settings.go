@@ -0,0 +1,22 @@
+package enclave
+
+// EnclaveApiContainerSettings are the settings the API container of an enclave was launched with, so that it can be
+// launched again the same way when the enclave gets started after being stopped
+type EnclaveApiContainerSettings struct {
+	// Blank if the API container runs the version matching the engine
+	versionTag string
+
+	logLevel string
+}
+
+func NewEnclaveApiContainerSettings(versionTag string, logLevel string) *EnclaveApiContainerSettings {
+	return &EnclaveApiContainerSettings{versionTag: versionTag, logLevel: logLevel}
+}
+
+func (settings *EnclaveApiContainerSettings) GetVersionTag() string {
+	return settings.versionTag
+}
+
+func (settings *EnclaveApiContainerSettings) GetLogLevel() string {
+	return settings.logLevel
+}
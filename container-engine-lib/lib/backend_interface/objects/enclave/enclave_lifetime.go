@@ -0,0 +1,24 @@
+package enclave
+
+import "time"
+
+// EnclaveLifetime bounds how long an enclave is kept around. A nil field means the corresponding limit doesn't apply
+type EnclaveLifetime struct {
+	// The time after which the enclave gets destroyed
+	expirationTime *time.Time
+
+	// How long the enclave can go unused before it gets stopped
+	idleTimeout *time.Duration
+}
+
+func NewEnclaveLifetime(expirationTime *time.Time, idleTimeout *time.Duration) *EnclaveLifetime {
+	return &EnclaveLifetime{expirationTime: expirationTime, idleTimeout: idleTimeout}
+}
+
+func (lifetime *EnclaveLifetime) GetExpirationTime() *time.Time {
+	return lifetime.expirationTime
+}
+
+func (lifetime *EnclaveLifetime) GetIdleTimeout() *time.Duration {
+	return lifetime.idleTimeout
+}
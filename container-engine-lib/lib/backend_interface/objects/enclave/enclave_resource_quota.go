@@ -0,0 +1,54 @@
+package enclave
+
+import "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/compute_resources"
+
+// EnclaveResourceQuota caps the resources the services of an enclave can claim in total. A zero value means the
+// corresponding resource isn't capped
+type EnclaveResourceQuota struct {
+	// Sum of the min CPU of all services
+	cpuMilliCores compute_resources.CpuMilliCores
+
+	// Sum of the min memory of all services
+	memoryMegaBytes compute_resources.MemoryInMegaBytes
+
+	// Number of services
+	maxServices uint32
+
+	// Sum of the size of all persistent directories
+	diskMegaBytes uint64
+}
+
+func NewEnclaveResourceQuota(
+	cpuMilliCores compute_resources.CpuMilliCores,
+	memoryMegaBytes compute_resources.MemoryInMegaBytes,
+	maxServices uint32,
+	diskMegaBytes uint64,
+) *EnclaveResourceQuota {
+	return &EnclaveResourceQuota{
+		cpuMilliCores:   cpuMilliCores,
+		memoryMegaBytes: memoryMegaBytes,
+		maxServices:     maxServices,
+		diskMegaBytes:   diskMegaBytes,
+	}
+}
+
+func (quota *EnclaveResourceQuota) GetCpuMilliCores() compute_resources.CpuMilliCores {
+	return quota.cpuMilliCores
+}
+
+func (quota *EnclaveResourceQuota) GetMemoryMegaBytes() compute_resources.MemoryInMegaBytes {
+	return quota.memoryMegaBytes
+}
+
+func (quota *EnclaveResourceQuota) GetMaxServices() uint32 {
+	return quota.maxServices
+}
+
+func (quota *EnclaveResourceQuota) GetDiskMegaBytes() uint64 {
+	return quota.diskMegaBytes
+}
+
+// IsEmpty returns true if the quota doesn't cap anything
+func (quota *EnclaveResourceQuota) IsEmpty() bool {
+	return quota.cpuMilliCores == 0 && quota.memoryMegaBytes == 0 && quota.maxServices == 0 && quota.diskMegaBytes == 0
+}
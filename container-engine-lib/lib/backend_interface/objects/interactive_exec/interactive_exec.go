@@ -0,0 +1,60 @@
+package interactive_exec
+
+import "io"
+
+type TerminalSize struct {
+	Width  uint16
+	Height uint16
+}
+
+// InteractiveExecStreams holds the streams attached to a command run inside a user service
+type InteractiveExecStreams struct {
+	// nil if the command shouldn't get any stdin
+	stdin io.Reader
+
+	stdout io.Writer
+
+	// unused when a TTY is allocated, as the TTY merges stderr into stdout
+	stderr io.Writer
+
+	tty bool
+
+	// the sizes of the terminal of the user, used to resize the TTY. nil if the size never changes
+	terminalSizes <-chan TerminalSize
+}
+
+func NewInteractiveExecStreams(
+	stdin io.Reader,
+	stdout io.Writer,
+	stderr io.Writer,
+	tty bool,
+	terminalSizes <-chan TerminalSize,
+) *InteractiveExecStreams {
+	return &InteractiveExecStreams{
+		stdin:         stdin,
+		stdout:        stdout,
+		stderr:        stderr,
+		tty:           tty,
+		terminalSizes: terminalSizes,
+	}
+}
+
+func (streams *InteractiveExecStreams) GetStdin() io.Reader {
+	return streams.stdin
+}
+
+func (streams *InteractiveExecStreams) GetStdout() io.Writer {
+	return streams.stdout
+}
+
+func (streams *InteractiveExecStreams) GetStderr() io.Writer {
+	return streams.stderr
+}
+
+func (streams *InteractiveExecStreams) IsTty() bool {
+	return streams.tty
+}
+
+func (streams *InteractiveExecStreams) GetTerminalSizes() <-chan TerminalSize {
+	return streams.terminalSizes
+}
@@ -0,0 +1,47 @@
+package service
+
+// AdditionalContainer is a container run next to the main container of a service, either to completion before it
+// starts (init container) or alongside it for the lifetime of the service (sidecar). It shares the network namespace
+// and the files artifacts and persistent directories of the main container
+type AdditionalContainer struct {
+	// Fields are public so the service config they're part of can be marshalled
+	Name string
+
+	ContainerImageName string
+
+	EntrypointArgs []string
+
+	CmdArgs []string
+
+	EnvVars map[string]string
+}
+
+func NewAdditionalContainer(name string, containerImageName string, entrypointArgs []string, cmdArgs []string, envVars map[string]string) *AdditionalContainer {
+	return &AdditionalContainer{
+		Name:               name,
+		ContainerImageName: containerImageName,
+		EntrypointArgs:     entrypointArgs,
+		CmdArgs:            cmdArgs,
+		EnvVars:            envVars,
+	}
+}
+
+func (container *AdditionalContainer) GetName() string {
+	return container.Name
+}
+
+func (container *AdditionalContainer) GetContainerImageName() string {
+	return container.ContainerImageName
+}
+
+func (container *AdditionalContainer) GetEntrypointArgs() []string {
+	return container.EntrypointArgs
+}
+
+func (container *AdditionalContainer) GetCmdArgs() []string {
+	return container.CmdArgs
+}
+
+func (container *AdditionalContainer) GetEnvVars() map[string]string {
+	return container.EnvVars
+}
@@ -0,0 +1,57 @@
+package service
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	// ReplicaSetLabelKey is the label holding the name of the replica set a service is a replica of
+	ReplicaSetLabelKey = "kurtosis-replica-set"
+
+	// ReplicaIndexEnvVar is the environment variable holding the index of a replica in its replica set
+	ReplicaIndexEnvVar = "KURTOSIS_REPLICA_INDEX"
+
+	replicaServiceNameFormat = "%s-%d"
+	replicaIndexSeparator    = "-"
+)
+
+// GetReplicaServiceName returns the name of the replica of the replica set with the given index
+func GetReplicaServiceName(replicaSetName string, replicaIndex int) ServiceName {
+	return ServiceName(fmt.Sprintf(replicaServiceNameFormat, replicaSetName, replicaIndex))
+}
+
+// GetReplicaIndex returns the index of the replica of the replica set named after the service, or false if the
+// service name isn't the name of one of its replicas
+func GetReplicaIndex(replicaSetName string, serviceName ServiceName) (int, bool) {
+	indexStr, found := strings.CutPrefix(string(serviceName), replicaSetName+replicaIndexSeparator)
+	if !found {
+		return 0, false
+	}
+	index, err := strconv.Atoi(indexStr)
+	if err != nil || index < 0 || strconv.Itoa(index) != indexStr {
+		return 0, false
+	}
+	return index, true
+}
+
+// SetReplicaSet makes the service the replica of the replica set with the given index: the replica set name is set
+// as its replica set label and as a network alias shared with the other replicas, and its index as an env var
+func (serviceConfig *ServiceConfig) SetReplicaSet(replicaSetName string, replicaIndex int) {
+	if serviceConfig.privateServiceConfig.Labels == nil {
+		serviceConfig.privateServiceConfig.Labels = map[string]string{}
+	}
+	serviceConfig.privateServiceConfig.Labels[ReplicaSetLabelKey] = replicaSetName
+	if serviceConfig.privateServiceConfig.EnvVars == nil {
+		serviceConfig.privateServiceConfig.EnvVars = map[string]string{}
+	}
+	serviceConfig.privateServiceConfig.EnvVars[ReplicaIndexEnvVar] = strconv.Itoa(replicaIndex)
+	serviceConfig.privateServiceConfig.NetworkAliases = []string{replicaSetName}
+}
+
+// GetReplicaSetName returns the name of the replica set the service is a replica of, or false if it isn't a replica
+func (serviceConfig *ServiceConfig) GetReplicaSetName() (string, bool) {
+	replicaSetName, found := serviceConfig.privateServiceConfig.Labels[ReplicaSetLabelKey]
+	return replicaSetName, found
+}
@@ -0,0 +1,37 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetReplicaIndex(t *testing.T) {
+	require.Equal(t, ServiceName("worker-3"), GetReplicaServiceName("worker", 3))
+
+	index, found := GetReplicaIndex("worker", "worker-3")
+	require.True(t, found)
+	require.Equal(t, 3, index)
+
+	for _, serviceName := range []ServiceName{"worker", "worker-", "worker-a", "worker-03", "worker--1", "other-1", "worker-1-1"} {
+		_, found = GetReplicaIndex("worker", serviceName)
+		require.False(t, found, "'%v' shouldn't be a replica of 'worker'", serviceName)
+	}
+}
+
+func TestSetReplicaSet(t *testing.T) {
+	config, err := CreateServiceConfig("test-image", nil, nil, nil, nil, nil, nil, nil, map[string]string{"KEY": "value"}, nil, nil, 0, 0, "", 0, 0, nil, nil, nil, map[string]string{}, image_download_mode.ImageDownloadMode_Missing, true, false, []string{}, false, NewGpuConfig(0, nil, 0, nil, "", ""))
+	require.NoError(t, err)
+	_, found := config.GetReplicaSetName()
+	require.False(t, found)
+
+	config.SetReplicaSet("worker", 2)
+	replicaSetName, found := config.GetReplicaSetName()
+	require.True(t, found)
+	require.Equal(t, "worker", replicaSetName)
+	require.Equal(t, map[string]string{ReplicaSetLabelKey: "worker"}, config.GetLabels())
+	require.Equal(t, map[string]string{"KEY": "value", ReplicaIndexEnvVar: "2"}, config.GetEnvVars())
+	require.Equal(t, []string{"worker"}, config.GetNetworkAliases())
+	require.NoError(t, ValidateServiceConfigLabels(config.GetLabels()))
+}
@@ -97,6 +97,19 @@ type privateServiceConfig struct {
 	// Whether to start the container in the host PID namespace. Docker backend only.
 	HostPIDNamespace bool
 
+	// Extra DNS names the service is reachable at inside the enclave, on top of its name. Several services can share
+	// the same alias, e.g. the replicas of a replica set, in which case it resolves to all of them. Docker backend only.
+	NetworkAliases []string
+
+	// Containers run to completion one after the other, before the main container starts
+	InitContainers []*AdditionalContainer
+
+	// Containers run alongside the main container, started after it
+	Sidecars []*AdditionalContainer
+
+	// Directories shared with the other services mounting them, keyed by their path in the container
+	SharedDirectories *service_directory.SharedDirectories
+
 	// GpuConfig bundles GPU device selection, shared-memory size, and ulimits.
 	// All three only apply to GPU workloads; use NewGpuConfig to construct.
 	GpuConfig GpuConfig
@@ -165,6 +178,10 @@ func CreateServiceConfig(
 		Privileged:                   false,
 		BindMounts:                   nil,
 		HostPIDNamespace:             false,
+		NetworkAliases:               nil,
+		InitContainers:               nil,
+		Sidecars:                     nil,
+		SharedDirectories:            nil,
 		GpuConfig:                    gpuConfig,
 	}
 	return &ServiceConfig{internalServiceConfig}, nil
@@ -214,14 +231,26 @@ func (serviceConfig *ServiceConfig) GetEntrypointArgs() []string {
 	return serviceConfig.privateServiceConfig.EntrypointArgs
 }
 
+func (serviceConfig *ServiceConfig) SetEntrypointArgs(entrypointArgs []string) {
+	serviceConfig.privateServiceConfig.EntrypointArgs = entrypointArgs
+}
+
 func (serviceConfig *ServiceConfig) GetCmdArgs() []string {
 	return serviceConfig.privateServiceConfig.CmdArgs
 }
 
+func (serviceConfig *ServiceConfig) SetCmdArgs(cmdArgs []string) {
+	serviceConfig.privateServiceConfig.CmdArgs = cmdArgs
+}
+
 func (serviceConfig *ServiceConfig) GetEnvVars() map[string]string {
 	return serviceConfig.privateServiceConfig.EnvVars
 }
 
+func (serviceConfig *ServiceConfig) SetEnvVars(envVars map[string]string) {
+	serviceConfig.privateServiceConfig.EnvVars = envVars
+}
+
 func (serviceConfig *ServiceConfig) GetFilesArtifactsExpansion() *service_directory.FilesArtifactsExpansion {
 	return serviceConfig.privateServiceConfig.FilesArtifactExpansion
 }
@@ -337,6 +366,12 @@ func (serviceConfig *ServiceConfig) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// Clone returns a shallow copy of the service config, whose fields can be set without affecting the original
+func (serviceConfig *ServiceConfig) Clone() *ServiceConfig {
+	privateServiceConfigCopy := *serviceConfig.privateServiceConfig
+	return &ServiceConfig{privateServiceConfig: &privateServiceConfigCopy}
+}
+
 func GetEmptyServiceConfig() *ServiceConfig {
 	emptyServiceConfig, _ := CreateServiceConfig(
 		"",
@@ -419,3 +454,35 @@ func (serviceConfig *ServiceConfig) GetHostPIDNamespace() bool {
 func (serviceConfig *ServiceConfig) SetHostPIDNamespace(hostPIDNamespace bool) {
 	serviceConfig.privateServiceConfig.HostPIDNamespace = hostPIDNamespace
 }
+
+func (serviceConfig *ServiceConfig) GetNetworkAliases() []string {
+	return serviceConfig.privateServiceConfig.NetworkAliases
+}
+
+func (serviceConfig *ServiceConfig) SetNetworkAliases(networkAliases []string) {
+	serviceConfig.privateServiceConfig.NetworkAliases = networkAliases
+}
+
+func (serviceConfig *ServiceConfig) GetInitContainers() []*AdditionalContainer {
+	return serviceConfig.privateServiceConfig.InitContainers
+}
+
+func (serviceConfig *ServiceConfig) SetInitContainers(initContainers []*AdditionalContainer) {
+	serviceConfig.privateServiceConfig.InitContainers = initContainers
+}
+
+func (serviceConfig *ServiceConfig) GetSidecars() []*AdditionalContainer {
+	return serviceConfig.privateServiceConfig.Sidecars
+}
+
+func (serviceConfig *ServiceConfig) SetSidecars(sidecars []*AdditionalContainer) {
+	serviceConfig.privateServiceConfig.Sidecars = sidecars
+}
+
+func (serviceConfig *ServiceConfig) GetSharedDirectories() *service_directory.SharedDirectories {
+	return serviceConfig.privateServiceConfig.SharedDirectories
+}
+
+func (serviceConfig *ServiceConfig) SetSharedDirectories(sharedDirectories *service_directory.SharedDirectories) {
+	serviceConfig.privateServiceConfig.SharedDirectories = sharedDirectories
+}
@@ -245,3 +245,42 @@ func TestServiceConfigTtyField(t *testing.T) {
 	require.NoError(t, err)
 	require.False(t, ttyDisabledConfig.GetTtyEnabled())
 }
+
+func TestServiceConfigAdditionalContainersMarshalling(t *testing.T) {
+	config, err := CreateServiceConfig("test-image", nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, 0, 0, "", 0, 0, map[string]string{}, nil, nil, map[string]string{}, image_download_mode.ImageDownloadMode_Missing, true, false, []string{}, false, NewGpuConfig(0, nil, 0, nil, "", ""))
+	require.NoError(t, err)
+	require.Nil(t, config.GetInitContainers())
+	require.Nil(t, config.GetSidecars())
+
+	config.SetInitContainers([]*AdditionalContainer{
+		NewAdditionalContainer("chown-data", "busybox", nil, []string{"chown", "-R", "1000", "/data"}, map[string]string{}),
+	})
+	config.SetSidecars([]*AdditionalContainer{
+		NewAdditionalContainer("log-shipper", "fluent-bit", []string{"/fluent-bit/bin/fluent-bit"}, nil, map[string]string{"OUTPUT": "stdout"}),
+	})
+
+	marshaledConfig, err := json.Marshal(config)
+	require.NoError(t, err)
+
+	// nolint: exhaustruct
+	newConfig := &ServiceConfig{}
+	err = json.Unmarshal(marshaledConfig, newConfig)
+	require.NoError(t, err)
+
+	require.Equal(t, config.GetInitContainers(), newConfig.GetInitContainers())
+	require.Equal(t, config.GetSidecars(), newConfig.GetSidecars())
+}
+
+func TestServiceConfigClone(t *testing.T) {
+	config, err := CreateServiceConfig("test-image", nil, nil, nil, nil, nil, nil, []string{"--password", "{{kurtosis:secret:password}}"}, map[string]string{"TOKEN": "{{kurtosis:secret:token}}"}, nil, nil, 0, 0, "", 0, 0, map[string]string{}, nil, nil, map[string]string{}, image_download_mode.ImageDownloadMode_Missing, true, false, []string{}, false, NewGpuConfig(1, nil, 0, nil, "", ""))
+	require.NoError(t, err)
+
+	clonedConfig := config.Clone()
+	clonedConfig.SetCmdArgs([]string{"--password", "sup3r-s3cr3t"})
+	clonedConfig.SetEnvVars(map[string]string{"TOKEN": "tok-sup3r-s3cr3t"})
+
+	require.Equal(t, []string{"--password", "{{kurtosis:secret:password}}"}, config.GetCmdArgs())
+	require.Equal(t, map[string]string{"TOKEN": "{{kurtosis:secret:token}}"}, config.GetEnvVars())
+	require.Equal(t, config.GetContainerImageName(), clonedConfig.GetContainerImageName())
+	require.Equal(t, config.GetGpuConfig(), clonedConfig.GetGpuConfig())
+}
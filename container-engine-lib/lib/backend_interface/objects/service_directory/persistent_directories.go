@@ -24,6 +24,12 @@ type DirectoryPersistentSize int64
 type PersistentDirectory struct {
 	PersistentKey DirectoryPersistentKey
 	Size          DirectoryPersistentSize
+
+	// The files artifact whose contents initialize the directory when it gets created; empty if not seeded from one
+	SeedFilesArtifactIdentifier string
+
+	// The URL of a tarball whose contents initialize the directory when it gets created; empty if not seeded from one
+	SeedUrl string
 }
 
 type PersistentDirectories struct {
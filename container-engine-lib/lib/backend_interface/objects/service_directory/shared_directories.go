@@ -0,0 +1,25 @@
+package service_directory
+
+type SharedDirectoryName string
+
+// SharedDirectory is a writable directory living as long as the enclave, which several services can mount at the same
+// time, unlike a persistent directory
+type SharedDirectory struct {
+	Name SharedDirectoryName
+	Size DirectoryPersistentSize
+}
+
+type SharedDirectories struct {
+	ServiceDirpathToSharedDirectory map[string]SharedDirectory
+}
+
+func NewSharedDirectories(sharedDirectories map[string]SharedDirectory) *SharedDirectories {
+	return &SharedDirectories{
+		ServiceDirpathToSharedDirectory: sharedDirectories,
+	}
+}
+
+// IsSharedDirectoryNameValid checks the name against the same RFC-1035 regex as persistent directory keys
+func IsSharedDirectoryNameValid(name SharedDirectoryName) bool {
+	return compiledWordWrappedPersistentKeyRegex.MatchString(string(name))
+}
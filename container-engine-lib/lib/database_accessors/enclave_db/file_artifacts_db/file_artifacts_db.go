@@ -8,6 +8,7 @@ import (
 	"github.com/kurtosis-tech/stacktrace"
 	bolt "go.etcd.io/bbolt"
 	bbolterrors "go.etcd.io/bbolt/errors"
+	"time"
 )
 
 type FileArtifactPersisted struct {
@@ -19,6 +20,20 @@ type fileArtifactData struct {
 	ArtifactNameToArtifactUuid map[string]string
 	ShortenedUuidToFullUuid    map[string][]string
 	ArtifactContentMd5         map[string][]byte
+	// Files artifacts are stored as content-addressed blobs, the manifest listing the files of an artifact being a blob itself
+	ArtifactManifestBlobHash map[string]string
+	BlobReferenceCount       map[string]uint32
+	// The versions each files artifact went through, oldest first, the last one being its current content
+	ArtifactVersions map[string][]*FileArtifactVersion
+}
+
+type FileArtifactVersion struct {
+	Version          uint32
+	ManifestBlobHash string
+	ContentMd5       []byte
+	CreationTime     time.Time
+	// Description of what stored this version, usually the Starlark instruction
+	Producer string
 }
 
 var (
@@ -104,11 +119,57 @@ func (fileArtifactDb *FileArtifactPersisted) DeleteContentMd5(artifactName strin
 	delete(fileArtifactDb.data.ArtifactContentMd5, artifactName)
 }
 
+func (fileArtifactDb *FileArtifactPersisted) SetManifestBlobHash(artifactUuid string, manifestBlobHash string) {
+	fileArtifactDb.data.ArtifactManifestBlobHash[artifactUuid] = manifestBlobHash
+}
+
+func (fileArtifactDb *FileArtifactPersisted) GetManifestBlobHash(artifactUuid string) (string, bool) {
+	value, found := fileArtifactDb.data.ArtifactManifestBlobHash[artifactUuid]
+	return value, found
+}
+
+func (fileArtifactDb *FileArtifactPersisted) DeleteManifestBlobHash(artifactUuid string) {
+	delete(fileArtifactDb.data.ArtifactManifestBlobHash, artifactUuid)
+}
+
+func (fileArtifactDb *FileArtifactPersisted) SetBlobReferenceCount(blobHash string, referenceCount uint32) {
+	fileArtifactDb.data.BlobReferenceCount[blobHash] = referenceCount
+}
+
+func (fileArtifactDb *FileArtifactPersisted) GetBlobReferenceCount(blobHash string) uint32 {
+	return fileArtifactDb.data.BlobReferenceCount[blobHash]
+}
+
+func (fileArtifactDb *FileArtifactPersisted) GetBlobReferenceCountMap() map[string]uint32 {
+	return fileArtifactDb.data.BlobReferenceCount
+}
+
+func (fileArtifactDb *FileArtifactPersisted) DeleteBlobReferenceCount(blobHash string) {
+	delete(fileArtifactDb.data.BlobReferenceCount, blobHash)
+}
+
+func (fileArtifactDb *FileArtifactPersisted) SetVersions(artifactUuid string, versions []*FileArtifactVersion) {
+	fileArtifactDb.data.ArtifactVersions[artifactUuid] = versions
+}
+
+func (fileArtifactDb *FileArtifactPersisted) GetVersions(artifactUuid string) ([]*FileArtifactVersion, bool) {
+	value, found := fileArtifactDb.data.ArtifactVersions[artifactUuid]
+	return value, found
+}
+
+func (fileArtifactDb *FileArtifactPersisted) DeleteVersions(artifactUuid string) {
+	delete(fileArtifactDb.data.ArtifactVersions, artifactUuid)
+}
+
 func GetOrCreateNewFileArtifactsDb() (*FileArtifactPersisted, error) {
+	// the maps are initialized before unmarshalling so that data persisted before a field existed still loads
 	data := fileArtifactData{
 		map[string]string{},
 		map[string][]string{},
 		map[string][]byte{},
+		map[string]string{},
+		map[string]uint32{},
+		map[string][]*FileArtifactVersion{},
 	}
 	// using the noEnclaveDatabaseDirpath because at this point we know that the enclave database has been created, so we are getting it from this call
 	noEnclaveDatabaseDirpath := ""
@@ -158,6 +219,9 @@ func GetFileArtifactsDbForTesting(db *enclave_db.EnclaveDB, nameToUuid map[strin
 		nameToUuid,
 		map[string][]string{},
 		map[string][]byte{},
+		map[string]string{},
+		map[string]uint32{},
+		map[string][]*FileArtifactVersion{},
 	})
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Failed to hydrate pre-existing file artifacts")
@@ -3,7 +3,9 @@ package file_artifacts_db
 import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/test_helpers"
 	"github.com/stretchr/testify/require"
+	bolt "go.etcd.io/bbolt"
 	"testing"
+	"time"
 )
 
 func TestFileArtifactPersistance(t *testing.T) {
@@ -18,14 +20,56 @@ func TestFileArtifactPersistance(t *testing.T) {
 	fileArtifactDb.SetArtifactUuid("1", "1")
 	fileArtifactDb.SetContentMd5("1", []byte("1"))
 	fileArtifactDb.SetFullUuid("1", []string{"1"})
+	fileArtifactDb.SetManifestBlobHash("1", "a")
+	fileArtifactDb.SetBlobReferenceCount("a", 2)
+	creationTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	fileArtifactDb.SetVersions("1", []*FileArtifactVersion{
+		{Version: 1, ManifestBlobHash: "b", ContentMd5: []byte("0"), CreationTime: creationTime, Producer: "upload_files"},
+		{Version: 2, ManifestBlobHash: "a", ContentMd5: []byte("1"), CreationTime: creationTime, Producer: "render_templates"},
+	})
 	require.Nil(t, fileArtifactDb.Persist())
 	fileArtifactDb, err = getFileArtifactsDbFromEnclaveDb(enclaveDb, &fileArtifactData{
 		map[string]string{},
 		map[string][]string{},
 		map[string][]byte{},
+		map[string]string{},
+		map[string]uint32{},
+		map[string][]*FileArtifactVersion{},
 	})
 	require.Nil(t, err)
 	require.Len(t, fileArtifactDb.GetArtifactUuidMap(), 1)
 	require.Len(t, fileArtifactDb.GetFullUuidMap(), 1)
 	require.Len(t, fileArtifactDb.GetContentMd5Map(), 1)
+	manifestBlobHash, found := fileArtifactDb.GetManifestBlobHash("1")
+	require.True(t, found)
+	require.Equal(t, "a", manifestBlobHash)
+	require.Equal(t, uint32(2), fileArtifactDb.GetBlobReferenceCount("a"))
+	versions, found := fileArtifactDb.GetVersions("1")
+	require.True(t, found)
+	require.Len(t, versions, 2)
+	require.Equal(t, uint32(2), versions[1].Version)
+	require.Equal(t, "render_templates", versions[1].Producer)
+	require.True(t, creationTime.Equal(versions[1].CreationTime))
+}
+
+func TestFileArtifactPersistance_DataPersistedBeforeBlobs(t *testing.T) {
+	enclaveDb, cleaningFunction, err := test_helpers.CreateEnclaveDbForTesting()
+	require.Nil(t, err)
+	defer cleaningFunction()
+	_, err = GetFileArtifactsDbForTesting(enclaveDb, map[string]string{})
+	require.Nil(t, err)
+	err = enclaveDb.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(fileArtifactBucketName).Put(fileArtifactDataStructKey, []byte(`{"ArtifactNameToArtifactUuid":{"1":"1"},"ShortenedUuidToFullUuid":{"1":["1"]},"ArtifactContentMd5":{"1":"MQ=="}}`))
+	})
+	require.Nil(t, err)
+
+	fileArtifactDb, err := GetFileArtifactsDbForTesting(enclaveDb, map[string]string{})
+	require.Nil(t, err)
+	require.Len(t, fileArtifactDb.GetArtifactUuidMap(), 1)
+	_, found := fileArtifactDb.GetManifestBlobHash("1")
+	require.False(t, found)
+	_, found = fileArtifactDb.GetVersions("1")
+	require.False(t, found)
+	fileArtifactDb.SetBlobReferenceCount("a", 1)
+	require.Equal(t, uint32(1), fileArtifactDb.GetBlobReferenceCount("a"))
 }
@@ -0,0 +1,53 @@
+package engine_db
+
+import (
+	"os"
+	"path"
+	"time"
+
+	"github.com/kurtosis-tech/stacktrace"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	readWritePermissionToDatabase = 0666
+	databaseDirPerms              = 0755
+	engineDbFileName              = "engine.db"
+	timeOut                       = 10 * time.Second
+)
+
+// EngineDB holds what the engine needs to keep across its restarts, as opposed to the EnclaveDB which lives and dies
+// with its enclave
+type EngineDB struct {
+	*bolt.DB
+}
+
+// GetOrCreateEngineDatabase opens the engine database in the given directory, creating the directory and the database
+// if they don't exist yet
+func GetOrCreateEngineDatabase(engineDatabaseDirpath string) (*EngineDB, error) {
+	if err := os.MkdirAll(engineDatabaseDirpath, databaseDirPerms); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the engine database directory '%v'", engineDatabaseDirpath)
+	}
+
+	engineDatabaseFilepath := path.Join(engineDatabaseDirpath, engineDbFileName)
+	database, err := bolt.Open(engineDatabaseFilepath, readWritePermissionToDatabase, &bolt.Options{
+		Timeout:         timeOut, //to fail if any other process is locking the file
+		NoGrowSync:      false,
+		NoFreelistSync:  false,
+		FreelistType:    "",
+		ReadOnly:        false,
+		MmapFlags:       0,
+		InitialMmapSize: 0,
+		PageSize:        0,
+		NoSync:          false,
+		OpenFile:        nil,
+		Mlock:           false,
+		PreLoadFreelist: false,
+		Logger:          nil,
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while opening the engine database '%v'", engineDatabaseFilepath)
+	}
+
+	return &EngineDB{database}, nil
+}
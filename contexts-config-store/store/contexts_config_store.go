@@ -30,6 +30,16 @@ type ContextsConfigStore interface {
 	// RemoveContext removes the contexts passed as an argument.
 	// It does nothing if the contextUuid does not point to any known context.
 	RemoveContext(contextUuid *generated.ContextUuid) error
+
+	// SetEngineApiToken stores the API token to send to the engine of the context passed as an argument. An empty
+	// token removes the stored one.
+	// It throws an error if the contextUuid does not point to any known context.
+	SetEngineApiToken(contextUuid *generated.ContextUuid, engineApiToken string) error
+
+	// SetEngineTlsConfig stores the TLS material to reach the engine of the context passed as an argument with. A nil
+	// config removes the stored one.
+	// It throws an error if the contextUuid does not point to any known context.
+	SetEngineTlsConfig(contextUuid *generated.ContextUuid, engineTlsConfig *generated.TlsConfig) error
 }
 
 func GetContextsConfigStore() ContextsConfigStore {
@@ -39,6 +49,12 @@ func GetContextsConfigStore() ContextsConfigStore {
 	return contextsConfigStore
 }
 
+// ContextsConfigStoreExists returns whether contexts were ever stored on this machine. When they weren't, e.g. inside a
+// container, there's no current context to read and the default local context applies
+func ContextsConfigStoreExists() (bool, error) {
+	return persistence.ContextsConfigFileExists()
+}
+
 func IsRemote(kurtosisContext *generated.KurtosisContext) bool {
 	var isRemote bool
 	_, _ = golang.Visit[struct{}](kurtosisContext, golang.KurtosisContextVisitor[struct{}]{
@@ -5,6 +5,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/contexts-config-store/api/golang/generated"
 	"github.com/kurtosis-tech/kurtosis/contexts-config-store/store/persistence"
 	"github.com/kurtosis-tech/stacktrace"
+	"google.golang.org/protobuf/proto"
 	"strings"
 	"sync"
 )
@@ -162,3 +163,64 @@ func (store *contextConfigStoreImpl) RemoveContext(contextUuid *generated.Contex
 	}
 	return nil
 }
+
+func (store *contextConfigStoreImpl) SetEngineApiToken(contextUuid *generated.ContextUuid, engineApiToken string) error {
+	err := store.updateContext(contextUuid, func(updatedContext *generated.KurtosisContext) {
+		if engineApiToken == "" {
+			updatedContext.EngineApiToken = nil
+		} else {
+			updatedContext.EngineApiToken = &engineApiToken
+		}
+	})
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred storing the engine API token of context '%s'", contextUuid.GetValue())
+	}
+	return nil
+}
+
+func (store *contextConfigStoreImpl) SetEngineTlsConfig(contextUuid *generated.ContextUuid, engineTlsConfig *generated.TlsConfig) error {
+	err := store.updateContext(contextUuid, func(updatedContext *generated.KurtosisContext) {
+		updatedContext.EngineTlsConfig = engineTlsConfig
+	})
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred storing the engine TLS config of context '%s'", contextUuid.GetValue())
+	}
+	return nil
+}
+
+// updateContext persists the result of applying updateFunc to a copy of the context, so the contexts loaded from the
+// storage are left untouched
+func (store *contextConfigStoreImpl) updateContext(contextUuid *generated.ContextUuid, updateFunc func(updatedContext *generated.KurtosisContext)) error {
+	store.Lock()
+	defer store.Unlock()
+
+	contextsConfig, err := store.storage.LoadContextsConfig()
+	if err != nil {
+		return stacktrace.Propagate(err, "Unable to load the list of contexts currently stored")
+	}
+
+	foundContextToUpdate := false
+	var contextUuidsInStore []string
+	var updatedContextsList []*generated.KurtosisContext
+	for _, kurtosisContextInStore := range contextsConfig.GetContexts() {
+		contextUuidsInStore = append(contextUuidsInStore, kurtosisContextInStore.GetUuid().GetValue())
+		if kurtosisContextInStore.GetUuid().GetValue() != contextUuid.GetValue() {
+			updatedContextsList = append(updatedContextsList, kurtosisContextInStore)
+			continue
+		}
+		foundContextToUpdate = true
+		updatedContext := proto.Clone(kurtosisContextInStore).(*generated.KurtosisContext)
+		updateFunc(updatedContext)
+		updatedContextsList = append(updatedContextsList, updatedContext)
+	}
+	if !foundContextToUpdate {
+		return stacktrace.NewError("Context with UUID '%s' does not exist in store. Known contexts are: '%s'",
+			contextUuid.GetValue(), strings.Join(contextUuidsInStore, contextUuidsSeparator))
+	}
+
+	newContextConfigToPersist := api.NewKurtosisContextsConfig(contextsConfig.GetCurrentContextUuid(), updatedContextsList...)
+	if err = store.storage.PersistContextsConfig(newContextConfigToPersist); err != nil {
+		return stacktrace.Propagate(err, "Unable to persist the updated context '%s' to store", contextUuid.GetValue())
+	}
+	return nil
+}
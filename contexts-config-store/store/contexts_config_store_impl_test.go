@@ -3,6 +3,7 @@ package store
 import (
 	"fmt"
 	api "github.com/kurtosis-tech/kurtosis/contexts-config-store/api/golang"
+	"github.com/kurtosis-tech/kurtosis/contexts-config-store/api/golang/generated"
 	"github.com/kurtosis-tech/kurtosis/contexts-config-store/store/persistence"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -226,3 +227,67 @@ func TestRemoveContext_NonExistingContext(t *testing.T) {
 	require.True(t, found)
 	storage.AssertNotCalled(t, persistMethod.Name, mock.Anything)
 }
+
+func TestSetEngineApiToken(t *testing.T) {
+	// Setup storage mock
+	storage := persistence.NewMockConfigPersistence(t)
+	contextsConfig := api.NewKurtosisContextsConfig(contextUuid, localContext, otherLocalContext)
+	storage.EXPECT().LoadContextsConfig().Return(contextsConfig, nil)
+
+	engineApiToken := "engine-api-token"
+	otherLocalContextWithToken := api.NewLocalOnlyContext(otherContextUuid, "other-context-name")
+	otherLocalContextWithToken.EngineApiToken = &engineApiToken
+	expectContextsConfigAfterUpdate := api.NewKurtosisContextsConfig(contextUuid, localContext, otherLocalContextWithToken)
+	storage.EXPECT().PersistContextsConfig(mock.MatchedBy(func(persisted proto.Message) bool {
+		return proto.Equal(expectContextsConfigAfterUpdate, persisted)
+	})).Times(1).Return(nil)
+
+	// Run test
+	testContextConfigStore := NewContextConfigStore(storage)
+	err := testContextConfigStore.SetEngineApiToken(otherContextUuid, engineApiToken)
+	require.NoError(t, err)
+	// The context loaded from the storage must not be modified
+	require.Nil(t, otherLocalContext.EngineApiToken)
+}
+
+func TestSetEngineTlsConfig(t *testing.T) {
+	// Setup storage mock
+	storage := persistence.NewMockConfigPersistence(t)
+	contextsConfig := api.NewKurtosisContextsConfig(contextUuid, localContext, otherLocalContext)
+	storage.EXPECT().LoadContextsConfig().Return(contextsConfig, nil)
+
+	engineTlsConfig := &generated.TlsConfig{
+		CertificateAuthority: []byte("ca"),
+		ClientCertificate:    []byte("client-cert"),
+		ClientKey:            []byte("client-key"),
+	}
+	otherLocalContextWithTlsConfig := api.NewLocalOnlyContext(otherContextUuid, "other-context-name")
+	otherLocalContextWithTlsConfig.EngineTlsConfig = engineTlsConfig
+	expectContextsConfigAfterUpdate := api.NewKurtosisContextsConfig(contextUuid, localContext, otherLocalContextWithTlsConfig)
+	storage.EXPECT().PersistContextsConfig(mock.MatchedBy(func(persisted proto.Message) bool {
+		return proto.Equal(expectContextsConfigAfterUpdate, persisted)
+	})).Times(1).Return(nil)
+
+	// Run test
+	testContextConfigStore := NewContextConfigStore(storage)
+	err := testContextConfigStore.SetEngineTlsConfig(otherContextUuid, engineTlsConfig)
+	require.NoError(t, err)
+	require.Nil(t, otherLocalContext.EngineTlsConfig)
+}
+
+func TestSetEngineApiToken_NonExistingContextFailure(t *testing.T) {
+	// Setup storage mock
+	storage := persistence.NewMockConfigPersistence(t)
+	contextsConfig := api.NewKurtosisContextsConfig(contextUuid, localContext)
+	storage.EXPECT().LoadContextsConfig().Return(contextsConfig, nil)
+
+	// Run test
+	testContextConfigStore := NewContextConfigStore(storage)
+	err := testContextConfigStore.SetEngineApiToken(otherContextUuid, "engine-api-token")
+	require.Error(t, err)
+
+	// Need to check the method exist first because if the method name changes in the future this test would do nothing
+	persistMethod, found := reflect.TypeOf(storage).MethodByName(persistMethodName)
+	require.True(t, found)
+	storage.AssertNotCalled(t, persistMethod.Name, mock.Anything)
+}
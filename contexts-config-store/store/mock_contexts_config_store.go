@@ -252,6 +252,92 @@ func (_c *MockContextsConfigStore_SetContext_Call) RunAndReturn(run func(*genera
 	return _c
 }
 
+// SetEngineApiToken provides a mock function with given fields: contextUuid, engineApiToken
+func (_m *MockContextsConfigStore) SetEngineApiToken(contextUuid *generated.ContextUuid, engineApiToken string) error {
+	ret := _m.Called(contextUuid, engineApiToken)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*generated.ContextUuid, string) error); ok {
+		r0 = rf(contextUuid, engineApiToken)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockContextsConfigStore_SetEngineApiToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetEngineApiToken'
+type MockContextsConfigStore_SetEngineApiToken_Call struct {
+	*mock.Call
+}
+
+// SetEngineApiToken is a helper method to define mock.On call
+//   - contextUuid *generated.ContextUuid
+//   - engineApiToken string
+func (_e *MockContextsConfigStore_Expecter) SetEngineApiToken(contextUuid interface{}, engineApiToken interface{}) *MockContextsConfigStore_SetEngineApiToken_Call {
+	return &MockContextsConfigStore_SetEngineApiToken_Call{Call: _e.mock.On("SetEngineApiToken", contextUuid, engineApiToken)}
+}
+
+func (_c *MockContextsConfigStore_SetEngineApiToken_Call) Run(run func(contextUuid *generated.ContextUuid, engineApiToken string)) *MockContextsConfigStore_SetEngineApiToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*generated.ContextUuid), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockContextsConfigStore_SetEngineApiToken_Call) Return(_a0 error) *MockContextsConfigStore_SetEngineApiToken_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContextsConfigStore_SetEngineApiToken_Call) RunAndReturn(run func(*generated.ContextUuid, string) error) *MockContextsConfigStore_SetEngineApiToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetEngineTlsConfig provides a mock function with given fields: contextUuid, engineTlsConfig
+func (_m *MockContextsConfigStore) SetEngineTlsConfig(contextUuid *generated.ContextUuid, engineTlsConfig *generated.TlsConfig) error {
+	ret := _m.Called(contextUuid, engineTlsConfig)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*generated.ContextUuid, *generated.TlsConfig) error); ok {
+		r0 = rf(contextUuid, engineTlsConfig)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockContextsConfigStore_SetEngineTlsConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetEngineTlsConfig'
+type MockContextsConfigStore_SetEngineTlsConfig_Call struct {
+	*mock.Call
+}
+
+// SetEngineTlsConfig is a helper method to define mock.On call
+//   - contextUuid *generated.ContextUuid
+//   - engineTlsConfig *generated.TlsConfig
+func (_e *MockContextsConfigStore_Expecter) SetEngineTlsConfig(contextUuid interface{}, engineTlsConfig interface{}) *MockContextsConfigStore_SetEngineTlsConfig_Call {
+	return &MockContextsConfigStore_SetEngineTlsConfig_Call{Call: _e.mock.On("SetEngineTlsConfig", contextUuid, engineTlsConfig)}
+}
+
+func (_c *MockContextsConfigStore_SetEngineTlsConfig_Call) Run(run func(contextUuid *generated.ContextUuid, engineTlsConfig *generated.TlsConfig)) *MockContextsConfigStore_SetEngineTlsConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*generated.ContextUuid), args[1].(*generated.TlsConfig))
+	})
+	return _c
+}
+
+func (_c *MockContextsConfigStore_SetEngineTlsConfig_Call) Return(_a0 error) *MockContextsConfigStore_SetEngineTlsConfig_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContextsConfigStore_SetEngineTlsConfig_Call) RunAndReturn(run func(*generated.ContextUuid, *generated.TlsConfig) error) *MockContextsConfigStore_SetEngineTlsConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 type mockConstructorTestingTNewMockContextsConfigStore interface {
 	mock.TestingT
 	Cleanup(func())
@@ -113,6 +113,20 @@ func (persistence *FileBackedConfigPersistence) persistContextsConfigInternal(ne
 	return nil
 }
 
+// ContextsConfigFileExists returns whether a contexts config file was ever written on this machine, without creating
+// the file or its directory
+func ContextsConfigFileExists() (bool, error) {
+	contextsConfigFilePath := path.Join(xdg.ConfigHome, applicationDirname, contextConfigFileName)
+	if _, err := os.Stat(contextsConfigFilePath); err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, stacktrace.Propagate(err, "Unexpected error checking if context config file exists at '%s'",
+			contextsConfigFilePath)
+	}
+	return true, nil
+}
+
 func getContextsConfigFilePath() (string, error) {
 	contextConfigFilePath, err := xdg.ConfigFile(path.Join(applicationDirname, contextConfigFileName))
 	if err != nil {
@@ -1,11 +1,13 @@
 package persistence
 
 import (
+	"github.com/adrg/xdg"
 	"github.com/kurtosis-tech/kurtosis/contexts-config-store/api/golang/generated"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
 	"os"
+	"path"
 	"testing"
 )
 
@@ -72,3 +74,22 @@ func TestLoadConfig(t *testing.T) {
 	require.Nil(t, err)
 	require.True(t, proto.Equal(contextConfig, result))
 }
+
+func TestContextsConfigFileExists(t *testing.T) {
+	// cleanups run last in first out, so the paths get reloaded once the environment is restored
+	t.Cleanup(xdg.Reload)
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	xdg.Reload()
+
+	exists, err := ContextsConfigFileExists()
+	require.Nil(t, err)
+	require.False(t, exists)
+	// checking doesn't create anything
+	_, err = os.Stat(path.Join(xdg.ConfigHome, applicationDirname))
+	require.True(t, os.IsNotExist(err))
+
+	require.Nil(t, NewFileBackedConfigPersistence().PersistContextsConfig(contextConfig))
+	exists, err = ContextsConfigFileExists()
+	require.Nil(t, err)
+	require.True(t, exists)
+}
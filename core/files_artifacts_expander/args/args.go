@@ -22,6 +22,16 @@ type FilesArtifactsExpanderArgs struct {
 	APIContainerIpAddress   string                   `json:"apiContainerIpAddress"`
 	ApiContainerPort        uint16                   `json:"apiContainerPort"`
 	FilesArtifactExpansions []FilesArtifactExpansion `json:"filesArtifactExpansions"`
+
+	// Nil if the API container serves plaintext
+	ApiContainerTls *ApiContainerTlsConfig `json:"apiContainerTls"`
+
+	// Presented to the API container on every call; empty if the API container doesn't authenticate callers
+	ApiContainerApiToken string `json:"apiContainerApiToken"`
+}
+
+var skipValidation = map[string]bool{
+	"apiContainerApiToken": true,
 }
 
 type FilesArtifactExpansion struct {
@@ -31,11 +41,23 @@ type FilesArtifactExpansion struct {
 	DirPathToExpandTo string `json:"dirPathToExpandTo"`
 }
 
-func NewFilesArtifactsExpanderArgs(apiContainerIpAddress string, apiContainerPort uint16, filesArtifactExpansions []FilesArtifactExpansion) (*FilesArtifactsExpanderArgs, error) {
+// ApiContainerTlsConfig holds the PEM-encoded material the expander reaches an API container serving TLS with
+type ApiContainerTlsConfig struct {
+	CaCertificate string `json:"caCertificate"`
+
+	// Presented to API containers requiring client certificates
+	ClientCertificate string `json:"clientCertificate"`
+
+	ClientKey string `json:"clientKey"`
+}
+
+func NewFilesArtifactsExpanderArgs(apiContainerIpAddress string, apiContainerPort uint16, filesArtifactExpansions []FilesArtifactExpansion, apiContainerTls *ApiContainerTlsConfig, apiContainerApiToken string) (*FilesArtifactsExpanderArgs, error) {
 	result := &FilesArtifactsExpanderArgs{
 		APIContainerIpAddress:   apiContainerIpAddress,
 		ApiContainerPort:        apiContainerPort,
 		FilesArtifactExpansions: filesArtifactExpansions,
+		ApiContainerTls:         apiContainerTls,
+		ApiContainerApiToken:    apiContainerApiToken,
 	}
 	logrus.Debugf("Expander args: %+v", result)
 	if err := result.validate(); err != nil {
@@ -54,6 +76,10 @@ func (args FilesArtifactsExpanderArgs) validate() error {
 		field := reflectValType.Field(i)
 		jsonFieldName := field.Tag.Get(jsonFieldTag)
 
+		if _, found := skipValidation[jsonFieldName]; found {
+			continue
+		}
+
 		// Ensure no empty strings
 		strVal := reflectVal.Field(i).String()
 		if strings.TrimSpace(strVal) == "" {
@@ -34,6 +34,16 @@ func GetEnvFromArgs(args *FilesArtifactsExpanderArgs) (resultEnvVars map[string]
 
 func GetArgsFromEnv() (*FilesArtifactsExpanderArgs, error) {
 	serializedParamsStr, found := os.LookupEnv(serializedArgsEnvVar)
+	return getArgsFromSerializedArgs(serializedParamsStr, found)
+}
+
+// GetArgsFromEnvVars is GetArgsFromEnv for the environment variables GetEnvFromArgs returned
+func GetArgsFromEnvVars(envVars map[string]string) (*FilesArtifactsExpanderArgs, error) {
+	serializedParamsStr, found := envVars[serializedArgsEnvVar]
+	return getArgsFromSerializedArgs(serializedParamsStr, found)
+}
+
+func getArgsFromSerializedArgs(serializedParamsStr string, found bool) (*FilesArtifactsExpanderArgs, error) {
 	if !found {
 		return nil, stacktrace.NewError("Expected to find args environment variable '%v', instead found no such environment variable", serializedArgsEnvVar)
 	}
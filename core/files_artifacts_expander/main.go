@@ -10,11 +10,14 @@ import (
 	"fmt"
 	"github.com/gammazero/workerpool"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/util/api_token_credentials"
+	"github.com/kurtosis-tech/kurtosis/api/golang/util/tls_credentials"
 	"github.com/kurtosis-tech/kurtosis/core/files_artifacts_expander/args"
 	"github.com/kurtosis-tech/kurtosis/grpc-file-transfer/golang/grpc_file_streaming"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 	"net"
 	"os"
@@ -76,7 +79,15 @@ func runMain() error {
 	}
 	apiContainerPortNum := filesArtifactExpanderArgs.ApiContainerPort
 	grpcUrl := fmt.Sprintf("%v:%v", apiContainerIpAddr, apiContainerPortNum)
-	apiContainerConnection, err := grpc.NewClient(grpcUrl, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	transportCredentials, err := getApiContainerTransportCredentials(filesArtifactExpanderArgs.ApiContainerTls)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the credentials to reach the API container with")
+	}
+	dialOpts := []grpc.DialOption{grpc.WithTransportCredentials(transportCredentials)}
+	if filesArtifactExpanderArgs.ApiContainerApiToken != "" {
+		dialOpts = append(dialOpts, grpc.WithPerRPCCredentials(api_token_credentials.NewApiTokenCredentials(filesArtifactExpanderArgs.ApiContainerApiToken)))
+	}
+	apiContainerConnection, err := grpc.NewClient(grpcUrl, dialOpts...)
 	if err != nil {
 		return stacktrace.Propagate(err, "Expected to be able to create a client connection to API container at address '%v', instead a non-nil error was returned", grpcUrl)
 	}
@@ -172,3 +183,18 @@ func expandFilesArtifact(ctx context.Context, apiContainerClient kurtosis_core_r
 	}
 	return nil
 }
+
+func getApiContainerTransportCredentials(apiContainerTls *args.ApiContainerTlsConfig) (credentials.TransportCredentials, error) {
+	if apiContainerTls == nil {
+		return insecure.NewCredentials(), nil
+	}
+	transportCredentials, err := tls_credentials.NewClientTransportCredentials(
+		[]byte(apiContainerTls.CaCertificate),
+		[]byte(apiContainerTls.ClientCertificate),
+		[]byte(apiContainerTls.ClientKey),
+	)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating TLS credentials from the API container TLS config")
+	}
+	return transportCredentials, nil
+}
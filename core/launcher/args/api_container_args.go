@@ -55,6 +55,12 @@ type APIContainerArgs struct {
 
 	// The Cloud Instance ID of the current user if available
 	CloudInstanceID metrics_client.CloudInstanceID `json:"cloud_instance_id"`
+
+	// TLS material for the gRPC server; if nil, it serves plaintext
+	Tls *TlsConfig `json:"tls"`
+
+	// API tokens the gRPC server requires callers to present; if empty, every caller is let through
+	ApiTokens []ApiToken `json:"apiTokens"`
 }
 
 var skipValidation = map[string]bool{
@@ -118,6 +124,8 @@ func NewAPIContainerArgs(
 	isCI bool,
 	cloudUserID metrics_client.CloudUserID,
 	cloudInstanceID metrics_client.CloudInstanceID,
+	tlsConfig *TlsConfig,
+	apiTokens []ApiToken,
 ) (*APIContainerArgs, error) {
 	result := &APIContainerArgs{
 		Version:                     version,
@@ -134,6 +142,8 @@ func NewAPIContainerArgs(
 		IsCI:                        isCI,
 		CloudUserID:                 cloudUserID,
 		CloudInstanceID:             cloudInstanceID,
+		Tls:                         tlsConfig,
+		ApiTokens:                   apiTokens,
 	}
 
 	if err := result.validate(); err != nil {
@@ -161,5 +171,12 @@ func (args APIContainerArgs) validate() error {
 			return stacktrace.NewError("JSON field '%s' is whitespace or empty string", jsonFieldName)
 		}
 	}
+
+	if err := validateTlsConfig(args.Tls); err != nil {
+		return stacktrace.Propagate(err, "An error occurred validating the API container TLS config")
+	}
+	if err := validateApiTokens(args.ApiTokens); err != nil {
+		return stacktrace.Propagate(err, "An error occurred validating the API container API tokens")
+	}
 	return nil
 }
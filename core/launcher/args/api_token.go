@@ -0,0 +1,82 @@
+package args
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// ApiTokenRole is what a caller authenticated with an API token is allowed to do on the API container. These are the
+// roles of the engine's API tokens; each role can do everything the roles before it can
+type ApiTokenRole string
+
+const (
+	// ApiTokenRole_Viewer can inspect the enclave
+	ApiTokenRole_Viewer ApiTokenRole = "viewer"
+	// ApiTokenRole_Operator can also run things in the enclave, if it owns it
+	ApiTokenRole_Operator ApiTokenRole = "operator"
+	// ApiTokenRole_Admin can also run things in enclaves owned by someone else
+	ApiTokenRole_Admin ApiTokenRole = "admin"
+)
+
+var allApiTokenRoles = []ApiTokenRole{
+	ApiTokenRole_Viewer,
+	ApiTokenRole_Operator,
+	ApiTokenRole_Admin,
+}
+
+// ApiToken is an API token the API container accepts, along with the user it identifies and that user's role. Only the
+// SHA-256 hash of the token is handed to the API container, so the tokens themselves don't end up in its container spec.
+// When the API container gets no API tokens it doesn't authenticate callers at all
+type ApiToken struct {
+	TokenSha256 string `json:"tokenSha256"`
+
+	User string `json:"user"`
+
+	Role ApiTokenRole `json:"role"`
+}
+
+func NewApiToken(token string, user string, role ApiTokenRole) ApiToken {
+	return ApiToken{
+		TokenSha256: HashApiToken(token),
+		User:        user,
+		Role:        role,
+	}
+}
+
+// HashApiToken returns the hex-encoded SHA-256 hash API tokens are compared by
+func HashApiToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
+
+// Includes returns true if the role can do everything the other role can
+func (role ApiTokenRole) Includes(other ApiTokenRole) bool {
+	return role.rank() >= other.rank()
+}
+
+func (role ApiTokenRole) rank() int {
+	for idx, validRole := range allApiTokenRoles {
+		if role == validRole {
+			return idx
+		}
+	}
+	return -1
+}
+
+func validateApiTokens(apiTokens []ApiToken) error {
+	for _, apiToken := range apiTokens {
+		if strings.TrimSpace(apiToken.TokenSha256) == "" {
+			return stacktrace.NewError("API token of user '%v' has no hash", apiToken.User)
+		}
+		if strings.TrimSpace(apiToken.User) == "" {
+			return stacktrace.NewError("An API token has no user")
+		}
+		if apiToken.Role.rank() < 0 {
+			return stacktrace.NewError("API token of user '%v' has unrecognized role '%v'", apiToken.User, apiToken.Role)
+		}
+	}
+	return nil
+}
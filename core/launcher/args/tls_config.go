@@ -0,0 +1,50 @@
+package args
+
+import (
+	"strings"
+
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// TlsConfig holds the PEM-encoded material the API container serves its gRPC API with. The server certificate is issued
+// by the engine for this API container only, and no other key is handed to it
+type TlsConfig struct {
+	// CA that signed the server certificate and, if client certificates are required, the client certificates
+	CaCertificate string `json:"caCertificate"`
+
+	ServerCertificate string `json:"serverCertificate"`
+
+	ServerKey string `json:"serverKey"`
+
+	// If true, only clients presenting a certificate signed by the CA, or by the CA the API container signs the files
+	// artifacts expanders' certificates with, are let in (mTLS)
+	RequireClientCertificate bool `json:"requireClientCertificate"`
+}
+
+func NewTlsConfig(
+	caCertificate string,
+	serverCertificate string,
+	serverKey string,
+	requireClientCertificate bool,
+) *TlsConfig {
+	return &TlsConfig{
+		CaCertificate:            caCertificate,
+		ServerCertificate:        serverCertificate,
+		ServerKey:                serverKey,
+		RequireClientCertificate: requireClientCertificate,
+	}
+}
+
+// A nil TLS config means the API container serves plaintext
+func validateTlsConfig(tlsConfig *TlsConfig) error {
+	if tlsConfig == nil {
+		return nil
+	}
+	if strings.TrimSpace(tlsConfig.CaCertificate) == "" {
+		return stacktrace.NewError("TLS is enabled but no CA certificate was provided")
+	}
+	if strings.TrimSpace(tlsConfig.ServerCertificate) == "" || strings.TrimSpace(tlsConfig.ServerKey) == "" {
+		return stacktrace.NewError("TLS is enabled but the server certificate or key is missing")
+	}
+	return nil
+}
@@ -7,6 +7,8 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"net"
 	"os"
@@ -20,6 +22,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages/git_package_content_provider"
 
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/util/tls_credentials"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/backend_creator"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
@@ -29,11 +32,13 @@ import (
 	"github.com/kurtosis-tech/kurtosis/core/launcher/args"
 	"github.com/kurtosis-tech/kurtosis/core/launcher/args/kurtosis_backend_config"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/enclave_events"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/secret_store"
 	"github.com/kurtosis-tech/kurtosis/core/server/commons/enclave_data_directory"
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/analytics_logger"
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
@@ -112,7 +117,7 @@ func runMain() error {
 	}
 	logrus.SetLevel(logLevel)
 
-	enclaveDataDir := enclave_data_directory.NewEnclaveDataDirectory(serverArgs.EnclaveDataVolumeDirpath)
+	enclaveDataDir := enclave_data_directory.NewEnclaveDataDirectory(serverArgs.EnclaveDataVolumeDirpath, serverArgs.EnclaveUUID)
 
 	clusterConfig := serverArgs.KurtosisBackendConfig
 	if clusterConfig == nil {
@@ -180,8 +185,19 @@ func runMain() error {
 		return stacktrace.NewError("Backend type '%v' was not recognized by API container.", serverArgs.KurtosisBackendType.String())
 	}
 
+	secretsStoreDirpath, err := enclaveDataDir.GetSecretsStoreDirpath()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the secrets store directory path")
+	}
+	secretStore, err := secret_store.CreateSecretStore(enclaveDb, secretsStoreDirpath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the secret store")
+	}
+	// secrets are resolved at execution time, so any log line printed afterwards might contain one
+	logrus.AddHook(secret_store.NewLogMaskingHook(secretStore))
+
 	starlarkValueSerde := createStarlarkValueSerde()
-	runtimeValueStore, err := runtime_value_store.CreateRuntimeValueStore(starlarkValueSerde, enclaveDb)
+	runtimeValueStore, err := runtime_value_store.CreateRuntimeValueStore(starlarkValueSerde, enclaveDb, secretStore)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred creating the runtime value store")
 	}
@@ -191,10 +207,37 @@ func runMain() error {
 		return stacktrace.Propagate(err, "An error occurred while creating the interpretation time value store")
 	}
 
-	serviceNetwork, err := createServiceNetwork(kurtosisBackend, enclaveDataDir, serverArgs, ownIpAddress, enclaveDb)
+	// When callers have to authenticate, the files artifacts expanders get a token of their own
+	apiTokens := serverArgs.ApiTokens
+	expanderApiToken := ""
+	if len(serverArgs.ApiTokens) > 0 {
+		expanderApiToken, err = server.GetOrCreateFilesArtifactsExpanderApiToken(enclaveDb)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the API token of the files artifacts expanders")
+		}
+		apiTokens = append(apiTokens, server.NewFilesArtifactsExpanderApiToken(expanderApiToken))
+	}
+	authenticator := server.NewApiContainerAuthenticator(apiTokens, kurtosisBackend, enclave.EnclaveUUID(serverArgs.EnclaveUUID))
+	if authenticator.IsEnabled() {
+		logrus.Infof("API container authentication is enabled with %v API token(s)", len(serverArgs.ApiTokens))
+	}
+
+	// When client certificates are required, the files artifacts expanders get short-lived ones signed by a CA of the
+	// enclave's own, rather than anything the engine's CA signed
+	var expanderCertificateIssuer *tls_credentials.CertificateIssuer
+	if serverArgs.Tls != nil && serverArgs.Tls.RequireClientCertificate {
+		expanderCertificateIssuer, err = server.GetOrCreateFilesArtifactsExpanderCertificateIssuer(enclaveDb)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the CA of the files artifacts expanders")
+		}
+	}
+
+	enclaveEventBus := enclave_events.NewEnclaveEventBus()
+	serviceNetwork, err := createServiceNetwork(kurtosisBackend, enclaveDataDir, serverArgs, ownIpAddress, enclaveDb, enclaveEventBus, expanderCertificateIssuer, expanderApiToken, secretStore)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred creating the service network")
 	}
+	go enclave_events.NewServiceCrashWatcher(serviceNetwork, enclaveEventBus).Run(ctx)
 
 	logger := logrus.StandardLogger()
 	metricsClient, closeClientFunc, err := metrics_client.CreateMetricsClient(
@@ -239,11 +282,17 @@ func runMain() error {
 		return stacktrace.Propagate(err, "An error occurred creating the starlark run repository")
 	}
 
+	starlarkRunRecordRepository, err := starlark_run.GetOrCreateNewStarlarkRunRecordRepository(enclaveDb)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the starlark run record repository")
+	}
+
 	//Creation of ApiContainerService
 	restartPolicy := kurtosis_core_rpc_api_bindings.RestartPolicy_NEVER
 	if serverArgs.IsProductionEnclave {
 		restartPolicy = kurtosis_core_rpc_api_bindings.RestartPolicy_ALWAYS
 	}
+	activityTracker := server.NewActivityTracker()
 	apiContainerService, err := server.NewApiContainerService(
 		filesArtifactStore,
 		serviceNetwork,
@@ -254,22 +303,36 @@ func runMain() error {
 		metricsClient,
 		githubAuthProvider,
 		starlarkRunRepository,
+		starlarkRunRecordRepository,
 		interpretationTimeValueStore,
+		secretStore,
+		enclaveEventBus,
+		activityTracker,
 	)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred creating the API container service")
 	}
 
 	apiContainerServiceRegistrationFunc := func(grpcServer *grpc.Server) {
-		kurtosis_core_rpc_api_bindings.RegisterApiContainerServiceServer(grpcServer, apiContainerService)
+		// Registered by hand rather than with RegisterApiContainerServiceServer so that the calls get authenticated and
+		// count as activity; calls that fail authentication don't count
+		serviceDesc := server.WithInterceptors(
+			&kurtosis_core_rpc_api_bindings.ApiContainerService_ServiceDesc,
+			[]grpc.UnaryServerInterceptor{authenticator.UnaryServerInterceptor, activityTracker.UnaryServerInterceptor},
+			[]grpc.StreamServerInterceptor{authenticator.StreamServerInterceptor, activityTracker.StreamServerInterceptor},
+		)
+		grpcServer.RegisterService(serviceDesc, apiContainerService)
 	}
-	apiContainerServer := minimal_grpc_server.NewMinimalGRPCServer(
-		serverArgs.GrpcListenPortNum,
-		grpcServerStopGracePeriod,
+	apiContainerServer, err := createApiContainerServer(
+		serverArgs,
+		expanderCertificateIssuer,
 		[]func(*grpc.Server){
 			apiContainerServiceRegistrationFunc,
 		},
 	)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the API container server")
+	}
 
 	logrus.Info("Running server...")
 	if err := apiContainerServer.RunUntilInterrupted(); err != nil {
@@ -279,20 +342,70 @@ func runMain() error {
 	return nil
 }
 
+// The server serves TLS, and requires client certificates signed by the CA or by the files artifacts expander CA if
+// asked to, when the engine hands it TLS material
+func createApiContainerServer(
+	serverArgs *args.APIContainerArgs,
+	expanderCertificateIssuer *tls_credentials.CertificateIssuer,
+	serviceRegistrationFuncs []func(*grpc.Server),
+) (*minimal_grpc_server.MinimalGRPCServer, error) {
+	tlsConfig := serverArgs.Tls
+	if tlsConfig == nil {
+		return minimal_grpc_server.NewMinimalGRPCServer(serverArgs.GrpcListenPortNum, grpcServerStopGracePeriod, serviceRegistrationFuncs), nil
+	}
+
+	serverCertificate, err := tls.X509KeyPair([]byte(tlsConfig.ServerCertificate), []byte(tlsConfig.ServerKey))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the API container's server certificate and key")
+	}
+	// The minimal gRPC server only asks for client certificates when it's given a pool to verify them against
+	var clientCertificatePool *x509.CertPool
+	if tlsConfig.RequireClientCertificate {
+		clientCertificatePool, err = tls_credentials.NewCertPool([]byte(tlsConfig.CaCertificate))
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred reading the CA certificate client certificates are verified against")
+		}
+		if !clientCertificatePool.AppendCertsFromPEM(expanderCertificateIssuer.GetCaCertificatePem()) {
+			return nil, stacktrace.NewError("The files artifacts expander CA certificate couldn't be added to the client certificates pool")
+		}
+	}
+	logrus.Infof("The API container server will serve TLS (client certificates required: %v)", tlsConfig.RequireClientCertificate)
+	return minimal_grpc_server.NewMinimalHttpsGRPCServer(
+		serverArgs.GrpcListenPortNum,
+		grpcServerStopGracePeriod,
+		clientCertificatePool,
+		&serverCertificate,
+		serviceRegistrationFuncs,
+	), nil
+}
+
 func createServiceNetwork(
 	kurtosisBackend backend_interface.KurtosisBackend,
 	enclaveDataDir *enclave_data_directory.EnclaveDataDirectory,
 	args *args.APIContainerArgs,
 	ownIpAddress net.IP,
 	enclaveDb *enclave_db.EnclaveDB,
+	enclaveEventBus *enclave_events.EnclaveEventBus,
+	expanderCertificateIssuer *tls_credentials.CertificateIssuer,
+	expanderApiToken string,
+	secretStore *secret_store.SecretStore,
 ) (service_network.ServiceNetwork, error) {
 	enclaveIdStr := args.EnclaveUUID
 	enclaveUuid := enclave.EnclaveUUID(enclaveIdStr)
 
-	apiContainerInfo := service_network.NewApiContainerInfo(
+	// The files artifacts expanders call the API container, so they get what they need to reach it over TLS and
+	// authenticate to it
+	caCertificate := ""
+	if args.Tls != nil {
+		caCertificate = args.Tls.CaCertificate
+	}
+	apiContainerInfo := service_network.NewApiContainerInfoWithExpanderCredentials(
 		ownIpAddress,
 		args.GrpcListenPortNum,
 		args.Version,
+		caCertificate,
+		expanderCertificateIssuer,
+		expanderApiToken,
 	)
 
 	serviceNetwork, err := service_network.NewDefaultServiceNetwork(
@@ -301,6 +414,8 @@ func createServiceNetwork(
 		kurtosisBackend,
 		enclaveDataDir,
 		enclaveDb,
+		enclaveEventBus,
+		secretStore,
 	)
 
 	if err != nil {
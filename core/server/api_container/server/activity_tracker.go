@@ -0,0 +1,61 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"google.golang.org/grpc"
+)
+
+// The engine calls these to watch over the enclave, so they don't count as using it
+var methodsNotCountedAsActivity = map[string]bool{
+	kurtosis_core_rpc_api_bindings.ApiContainerService_WatchEvents_FullMethodName:         true,
+	kurtosis_core_rpc_api_bindings.ApiContainerService_GetLastActivityTime_FullMethodName: true,
+}
+
+// ActivityTracker records the last time a client used the API container, so that the engine can tell whether the
+// enclave is idle even when it's used without going through the engine
+type ActivityTracker struct {
+	mutex *sync.RWMutex
+
+	// nil until the API container gets used
+	lastActivityTime *time.Time
+}
+
+func NewActivityTracker() *ActivityTracker {
+	return &ActivityTracker{
+		mutex:            &sync.RWMutex{},
+		lastActivityTime: nil,
+	}
+}
+
+// GetLastActivityTime returns nil if the API container wasn't used since it started
+func (tracker *ActivityTracker) GetLastActivityTime() *time.Time {
+	tracker.mutex.RLock()
+	defer tracker.mutex.RUnlock()
+	return tracker.lastActivityTime
+}
+
+func (tracker *ActivityTracker) UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	tracker.recordActivity(info.FullMethod)
+	return handler(ctx, req)
+}
+
+func (tracker *ActivityTracker) StreamServerInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	tracker.recordActivity(info.FullMethod)
+	// Long-running streams like Starlark runs count as activity both when they start and when they end
+	defer tracker.recordActivity(info.FullMethod)
+	return handler(srv, stream)
+}
+
+func (tracker *ActivityTracker) recordActivity(fullMethod string) {
+	if methodsNotCountedAsActivity[fullMethod] {
+		return
+	}
+	now := time.Now()
+	tracker.mutex.Lock()
+	defer tracker.mutex.Unlock()
+	tracker.lastActivityTime = &now
+}
@@ -0,0 +1,78 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestActivityTracker_CountsCallsThroughTheServiceDescription(t *testing.T) {
+	activityTracker := NewActivityTracker()
+	apicService := &ApiContainerService{activityTracker: activityTracker}
+	serviceDesc := WithInterceptors(
+		&kurtosis_core_rpc_api_bindings.ApiContainerService_ServiceDesc,
+		[]grpc.UnaryServerInterceptor{activityTracker.UnaryServerInterceptor},
+		[]grpc.StreamServerInterceptor{activityTracker.StreamServerInterceptor},
+	)
+
+	getLastActivityTimeHandler := findMethodHandler(t, serviceDesc, "GetLastActivityTime")
+	decodeEmpty := func(interface{}) error { return nil }
+	noServerInterceptor := grpc.UnaryServerInterceptor(nil)
+
+	// The engine asking whether the enclave is idle doesn't make it busy
+	response, err := getLastActivityTimeHandler(apicService, context.Background(), decodeEmpty, noServerInterceptor)
+	require.NoError(t, err)
+	require.Nil(t, response.(*kurtosis_core_rpc_api_bindings.GetLastActivityTimeResponse).LastActivityTime)
+	require.Nil(t, activityTracker.GetLastActivityTime())
+
+	activityTracker.UnaryServerInterceptor(context.Background(), &emptypb.Empty{}, &grpc.UnaryServerInfo{Server: nil, FullMethod: kurtosis_core_rpc_api_bindings.ApiContainerService_GetServices_FullMethodName}, func(context.Context, interface{}) (interface{}, error) {
+		return nil, nil
+	})
+	lastActivityTime := activityTracker.GetLastActivityTime()
+	require.NotNil(t, lastActivityTime)
+
+	response, err = getLastActivityTimeHandler(apicService, context.Background(), decodeEmpty, noServerInterceptor)
+	require.NoError(t, err)
+	require.Equal(t, lastActivityTime.UnixNano(), response.(*kurtosis_core_rpc_api_bindings.GetLastActivityTimeResponse).LastActivityTime.AsTime().UnixNano())
+}
+
+func TestWithInterceptors_ServerInterceptorStillRuns(t *testing.T) {
+	activityTracker := NewActivityTracker()
+	apicService := &ApiContainerService{activityTracker: activityTracker}
+	calledMethods := []string{}
+	recordingInterceptor := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		calledMethods = append(calledMethods, info.FullMethod)
+		return handler(ctx, req)
+	}
+	serviceDesc := WithInterceptors(
+		&kurtosis_core_rpc_api_bindings.ApiContainerService_ServiceDesc,
+		[]grpc.UnaryServerInterceptor{recordingInterceptor},
+		[]grpc.StreamServerInterceptor{activityTracker.StreamServerInterceptor},
+	)
+
+	getLastActivityTimeHandler := findMethodHandler(t, serviceDesc, "GetLastActivityTime")
+	_, err := getLastActivityTimeHandler(apicService, context.Background(), func(interface{}) error { return nil }, recordingInterceptor)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		kurtosis_core_rpc_api_bindings.ApiContainerService_GetLastActivityTime_FullMethodName,
+		kurtosis_core_rpc_api_bindings.ApiContainerService_GetLastActivityTime_FullMethodName,
+	}, calledMethods)
+
+	// The original description is left untouched
+	require.Len(t, serviceDesc.Methods, len(kurtosis_core_rpc_api_bindings.ApiContainerService_ServiceDesc.Methods))
+	require.Len(t, serviceDesc.Streams, len(kurtosis_core_rpc_api_bindings.ApiContainerService_ServiceDesc.Streams))
+}
+
+func findMethodHandler(t *testing.T, serviceDesc *grpc.ServiceDesc, methodName string) grpc.MethodHandler {
+	for _, methodDesc := range serviceDesc.Methods {
+		if methodDesc.MethodName == methodName {
+			return methodDesc.Handler
+		}
+	}
+	require.FailNow(t, "Method not found in the service description", methodName)
+	return nil
+}
@@ -0,0 +1,219 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"strings"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/kurtosis-tech/kurtosis/core/launcher/args"
+	"github.com/kurtosis-tech/stacktrace"
+	bolt "go.etcd.io/bbolt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// gRPC metadata keys are lowercase; callers send the same Authorization header as to the engine
+	authorizationMetadataKey = "authorization"
+	bearerTokenPrefix        = "Bearer "
+
+	// The files artifacts expanders only download files artifacts, so they're viewers
+	filesArtifactsExpanderUser             = "kurtosis-files-artifacts-expander"
+	filesArtifactsExpanderApiTokenNumBytes = 32
+)
+
+var (
+	filesArtifactsExpanderApiTokenBucketName = []byte("files-artifacts-expander-api-token")
+	filesArtifactsExpanderApiTokenKey        = []byte("token")
+)
+
+// Methods callers with the viewer role may call. Every other method requires the operator role and, unless the caller
+// is an admin, owning the enclave, so a new method is locked down until it's added here
+var viewerApiContainerMethods = map[string]bool{
+	kurtosis_core_rpc_api_bindings.ApiContainerService_GetServices_FullMethodName:                                true,
+	kurtosis_core_rpc_api_bindings.ApiContainerService_GetExistingAndHistoricalServiceIdentifiers_FullMethodName: true,
+	kurtosis_core_rpc_api_bindings.ApiContainerService_DownloadFilesArtifact_FullMethodName:                      true,
+	kurtosis_core_rpc_api_bindings.ApiContainerService_ListFilesArtifactNamesAndUuids_FullMethodName:             true,
+	kurtosis_core_rpc_api_bindings.ApiContainerService_InspectFilesArtifactContents_FullMethodName:               true,
+	kurtosis_core_rpc_api_bindings.ApiContainerService_GetFilesArtifactHistory_FullMethodName:                    true,
+	kurtosis_core_rpc_api_bindings.ApiContainerService_GetStarlarkRun_FullMethodName:                             true,
+	kurtosis_core_rpc_api_bindings.ApiContainerService_ListStarlarkRunRecords_FullMethodName:                     true,
+	kurtosis_core_rpc_api_bindings.ApiContainerService_GetStarlarkRunRecord_FullMethodName:                       true,
+	kurtosis_core_rpc_api_bindings.ApiContainerService_WatchEvents_FullMethodName:                                true,
+	kurtosis_core_rpc_api_bindings.ApiContainerService_GetServiceDependencies_FullMethodName:                     true,
+	kurtosis_core_rpc_api_bindings.ApiContainerService_GetLastActivityTime_FullMethodName:                        true,
+}
+
+// ApiContainerAuthenticator authenticates every call to the API container with the engine's API tokens and applies the
+// engine's rules: viewers can inspect the enclave, and running things in it is restricted to its owner and admins
+type ApiContainerAuthenticator struct {
+	apiTokens []args.ApiToken
+
+	// The owner is read from the backend on every check since it's set by the engine, possibly after the API container
+	// started
+	kurtosisBackend backend_interface.KurtosisBackend
+
+	enclaveUuid enclave.EnclaveUUID
+}
+
+func NewApiContainerAuthenticator(
+	apiTokens []args.ApiToken,
+	kurtosisBackend backend_interface.KurtosisBackend,
+	enclaveUuid enclave.EnclaveUUID,
+) *ApiContainerAuthenticator {
+	return &ApiContainerAuthenticator{
+		apiTokens:       apiTokens,
+		kurtosisBackend: kurtosisBackend,
+		enclaveUuid:     enclaveUuid,
+	}
+}
+
+// IsEnabled returns false if the API container was started without API tokens, in which case every caller is let through
+func (authenticator *ApiContainerAuthenticator) IsEnabled() bool {
+	return len(authenticator.apiTokens) > 0
+}
+
+func (authenticator *ApiContainerAuthenticator) UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := authenticator.authenticate(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (authenticator *ApiContainerAuthenticator) StreamServerInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := authenticator.authenticate(stream.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, stream)
+}
+
+// authenticate returns a gRPC status error if the caller may not call the method
+func (authenticator *ApiContainerAuthenticator) authenticate(ctx context.Context, fullMethod string) error {
+	if !authenticator.IsEnabled() {
+		return nil
+	}
+
+	apiToken, err := authenticator.getApiToken(ctx)
+	if err != nil {
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+	if viewerApiContainerMethods[fullMethod] {
+		return nil
+	}
+	if !apiToken.Role.Includes(args.ApiTokenRole_Operator) {
+		return status.Error(codes.PermissionDenied, stacktrace.NewError(
+			"User '%v' has role '%v' but calling '%v' requires role '%v'",
+			apiToken.User,
+			apiToken.Role,
+			fullMethod,
+			args.ApiTokenRole_Operator,
+		).Error())
+	}
+	if apiToken.Role.Includes(args.ApiTokenRole_Admin) {
+		return nil
+	}
+
+	// If the owner can't be read, the call is refused rather than let through
+	enclaveOwner, err := authenticator.getEnclaveOwner(ctx)
+	if err != nil {
+		return status.Error(codes.Internal, stacktrace.Propagate(err, "An error occurred getting the owner of enclave '%v' to authorize calling '%v'", authenticator.enclaveUuid, fullMethod).Error())
+	}
+	if enclaveOwner != apiToken.User {
+		return status.Error(codes.PermissionDenied, stacktrace.NewError(
+			"User '%v' doesn't own enclave '%v'; only its owner or an '%v' can call '%v'",
+			apiToken.User,
+			authenticator.enclaveUuid,
+			args.ApiTokenRole_Admin,
+			fullMethod,
+		).Error())
+	}
+	return nil
+}
+
+func (authenticator *ApiContainerAuthenticator) getApiToken(ctx context.Context) (*args.ApiToken, error) {
+	incomingMetadata, found := metadata.FromIncomingContext(ctx)
+	if !found {
+		return nil, stacktrace.NewError("The API container requires an API token but the call had no metadata")
+	}
+	authorizationValues := incomingMetadata.Get(authorizationMetadataKey)
+	if len(authorizationValues) == 0 {
+		return nil, stacktrace.NewError("The API container requires an API token but the call had no '%v' metadata", authorizationMetadataKey)
+	}
+	if !strings.HasPrefix(authorizationValues[0], bearerTokenPrefix) {
+		return nil, stacktrace.NewError("The '%v' metadata of the call doesn't hold a bearer token", authorizationMetadataKey)
+	}
+	tokenSha256 := args.HashApiToken(strings.TrimPrefix(authorizationValues[0], bearerTokenPrefix))
+
+	// Every token is compared, so how long the check takes doesn't tell which tokens exist
+	var matchingApiToken *args.ApiToken
+	for idx := range authenticator.apiTokens {
+		apiToken := authenticator.apiTokens[idx]
+		if subtle.ConstantTimeCompare([]byte(apiToken.TokenSha256), []byte(tokenSha256)) == 1 {
+			matchingApiToken = &apiToken
+		}
+	}
+	if matchingApiToken == nil {
+		return nil, stacktrace.NewError("The API token of the call isn't valid")
+	}
+	return matchingApiToken, nil
+}
+
+func (authenticator *ApiContainerAuthenticator) getEnclaveOwner(ctx context.Context) (string, error) {
+	enclaveFilters := &enclave.EnclaveFilters{
+		UUIDs: map[enclave.EnclaveUUID]bool{
+			authenticator.enclaveUuid: true,
+		},
+		Statuses: nil,
+	}
+	enclaves, err := authenticator.kurtosisBackend.GetEnclaves(ctx, enclaveFilters)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting enclave '%v' from the backend", authenticator.enclaveUuid)
+	}
+	enclaveObj, found := enclaves[authenticator.enclaveUuid]
+	if !found {
+		return "", stacktrace.NewError("Enclave '%v' wasn't found in the backend", authenticator.enclaveUuid)
+	}
+	return enclaveObj.GetOwner(), nil
+}
+
+// GetOrCreateFilesArtifactsExpanderApiToken returns the API token the files artifacts expanders of the enclave call the
+// API container with, creating it the first time. It's kept in the enclave database because it ends up in the service
+// configs, which outlive the API container when the enclave gets stopped and started again
+func GetOrCreateFilesArtifactsExpanderApiToken(enclaveDb *enclave_db.EnclaveDB) (string, error) {
+	var expanderApiToken string
+	if err := enclaveDb.Update(func(tx *bolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists(filesArtifactsExpanderApiTokenBucketName)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred creating the files artifacts expander API token bucket")
+		}
+		if existingToken := bucket.Get(filesArtifactsExpanderApiTokenKey); existingToken != nil {
+			expanderApiToken = string(existingToken)
+			return nil
+		}
+		tokenBytes := make([]byte, filesArtifactsExpanderApiTokenNumBytes)
+		if _, err := rand.Read(tokenBytes); err != nil {
+			return stacktrace.Propagate(err, "An error occurred generating the files artifacts expander API token")
+		}
+		expanderApiToken = hex.EncodeToString(tokenBytes)
+		if err := bucket.Put(filesArtifactsExpanderApiTokenKey, []byte(expanderApiToken)); err != nil {
+			return stacktrace.Propagate(err, "An error occurred saving the files artifacts expander API token")
+		}
+		return nil
+	}); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the files artifacts expander API token from the enclave db")
+	}
+	return expanderApiToken, nil
+}
+
+// NewFilesArtifactsExpanderApiToken returns the API token entry that lets the files artifacts expanders in
+func NewFilesArtifactsExpanderApiToken(expanderApiToken string) args.ApiToken {
+	return args.NewApiToken(expanderApiToken, filesArtifactsExpanderUser, args.ApiTokenRole_Viewer)
+}
@@ -0,0 +1,96 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/core/launcher/args"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	authTestEnclaveUuid = enclave.EnclaveUUID("enclave-uuid")
+
+	viewerToken   = "viewer-token"
+	operatorToken = "operator-token"
+	adminToken    = "admin-token"
+
+	enclaveOwnerUser = "bob"
+)
+
+var authTestApiTokens = []args.ApiToken{
+	args.NewApiToken(viewerToken, "alice", args.ApiTokenRole_Viewer),
+	args.NewApiToken(operatorToken, enclaveOwnerUser, args.ApiTokenRole_Operator),
+	args.NewApiToken(adminToken, "carol", args.ApiTokenRole_Admin),
+}
+
+func TestApiContainerAuthenticator_DisabledLetsEveryoneThrough(t *testing.T) {
+	authenticator := NewApiContainerAuthenticator(nil, nil, authTestEnclaveUuid)
+	require.NoError(t, authenticator.authenticate(context.Background(), kurtosis_core_rpc_api_bindings.ApiContainerService_RunStarlarkScript_FullMethodName))
+}
+
+func TestApiContainerAuthenticator_RejectsMissingAndUnknownTokens(t *testing.T) {
+	authenticator := NewApiContainerAuthenticator(authTestApiTokens, nil, authTestEnclaveUuid)
+
+	err := authenticator.authenticate(context.Background(), kurtosis_core_rpc_api_bindings.ApiContainerService_GetServices_FullMethodName)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	err = authenticator.authenticate(withApiToken("unknown-token"), kurtosis_core_rpc_api_bindings.ApiContainerService_GetServices_FullMethodName)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestApiContainerAuthenticator_ViewersCanOnlyInspect(t *testing.T) {
+	authenticator := NewApiContainerAuthenticator(authTestApiTokens, nil, authTestEnclaveUuid)
+
+	require.NoError(t, authenticator.authenticate(withApiToken(viewerToken), kurtosis_core_rpc_api_bindings.ApiContainerService_GetServices_FullMethodName))
+
+	err := authenticator.authenticate(withApiToken(viewerToken), kurtosis_core_rpc_api_bindings.ApiContainerService_RunStarlarkScript_FullMethodName)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestApiContainerAuthenticator_OnlyOwnerAndAdminsCanRunThings(t *testing.T) {
+	backend := backend_interface.NewMockKurtosisBackend(t)
+	authenticator := NewApiContainerAuthenticator(authTestApiTokens, backend, authTestEnclaveUuid)
+
+	// Admins don't need the owner to be looked up
+	require.NoError(t, authenticator.authenticate(withApiToken(adminToken), kurtosis_core_rpc_api_bindings.ApiContainerService_RunStarlarkScript_FullMethodName))
+
+	expectEnclaveOwner(backend, enclaveOwnerUser)
+	require.NoError(t, authenticator.authenticate(withApiToken(operatorToken), kurtosis_core_rpc_api_bindings.ApiContainerService_RunStarlarkScript_FullMethodName))
+
+	expectEnclaveOwner(backend, "someone-else")
+	err := authenticator.authenticate(withApiToken(operatorToken), kurtosis_core_rpc_api_bindings.ApiContainerService_RunStarlarkScript_FullMethodName)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestApiContainerAuthenticator_FailsClosedWhenTheOwnerCantBeRead(t *testing.T) {
+	backend := backend_interface.NewMockKurtosisBackend(t)
+	authenticator := NewApiContainerAuthenticator(authTestApiTokens, backend, authTestEnclaveUuid)
+
+	backend.EXPECT().GetEnclaves(mock.Anything, mock.Anything).Return(nil, errors.New("backend unreachable")).Once()
+	err := authenticator.authenticate(withApiToken(operatorToken), kurtosis_core_rpc_api_bindings.ApiContainerService_RunStarlarkScript_FullMethodName)
+	require.Error(t, err)
+	require.Equal(t, codes.Internal, status.Code(err))
+}
+
+func withApiToken(token string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(authorizationMetadataKey, bearerTokenPrefix+token))
+}
+
+func expectEnclaveOwner(backend *backend_interface.MockKurtosisBackend, owner string) {
+	enclaveObj := enclave.NewEnclave(authTestEnclaveUuid, string(authTestEnclaveUuid), enclave.EnclaveStatus_Running, nil, false, nil, nil, owner, nil, nil)
+	backend.EXPECT().GetEnclaves(mock.Anything, mock.Anything).Return(
+		map[enclave.EnclaveUUID]*enclave.Enclave{
+			authTestEnclaveUuid: enclaveObj,
+		},
+		nil,
+	).Once()
+}
@@ -18,9 +18,11 @@ import (
 	"os"
 	"path"
 	"strings"
+	"sync"
 	"time"
 	"unicode"
 
+	"github.com/go-git/go-git/v5"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_user"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/docker_compose_transpiler"
@@ -29,6 +31,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/instructions_plan/resolver"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/interpretation_time_value_store"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/plan_yaml"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/secret_store"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/starlark_run"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages/git_package_content_provider"
 
@@ -41,9 +44,12 @@ import (
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/shared_utils"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/interactive_exec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/enclave_events"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_constants"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages"
@@ -52,7 +58,9 @@ import (
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
@@ -73,15 +81,51 @@ const (
 
 	emptyFileArtifactIdentifier = ""
 	unlimitedLineCount          = math.MaxInt
-	allFilePermissionsForOwner  = 0700
 
 	defaultImageDownloadMode = kurtosis_core_rpc_api_bindings.ImageDownloadMode_missing
 	isScript                 = true
 	isNotScript              = false
 	isNotRemote              = false
 	defaultParallelism       = 4
+
+	// terminal resizes received while the previous ones haven't been applied yet are dropped
+	terminalSizesBufferSize = 10
+
+	// The output of a Starlark run is sent back this many lines at a time, and saved as soon as this many lines are waiting
+	starlarkRunOutputPageSize      = 100
+	starlarkRunOutputSaveBatchSize = 100
+
+	replayedEnclavePlanMainFunctionName          = "run"
+	doNotRunReplayedEnclavePlanInNonBlockingMode = false
+	doNotRunReplayedEnclavePlanInParallel        = false
+	doCheckResourcesOfReplayedEnclavePlan        = true
+	allowPrivilegedModeForReplayedEnclavePlan    = true
+
+	// describe what stored files artifacts through the API rather than through a Starlark instruction
+	uploadedFilesArtifactProducer         = "files artifact upload"
+	webFilesArtifactProducerFormatStr     = "files artifact download from '%v'"
+	serviceFilesArtifactProducerFormatStr = "files copied from service '%v' at path '%v'"
 )
 
+// Guaranteed (by a unit test) to be a 1:1 mapping between the enclave event types and the API event types
+var apiContainerEventTypesByEnclaveEventType = map[enclave_events.EnclaveEventType]kurtosis_core_rpc_api_bindings.ApiContainerEventType{
+	enclave_events.EnclaveEventType_StarlarkRunStarted:   kurtosis_core_rpc_api_bindings.ApiContainerEventType_STARLARK_RUN_STARTED,
+	enclave_events.EnclaveEventType_StarlarkRunFinished:  kurtosis_core_rpc_api_bindings.ApiContainerEventType_STARLARK_RUN_FINISHED,
+	enclave_events.EnclaveEventType_FilesArtifactCreated: kurtosis_core_rpc_api_bindings.ApiContainerEventType_FILES_ARTIFACT_CREATED,
+	enclave_events.EnclaveEventType_ServiceAdded:         kurtosis_core_rpc_api_bindings.ApiContainerEventType_SERVICE_ADDED,
+	enclave_events.EnclaveEventType_ServiceStarted:       kurtosis_core_rpc_api_bindings.ApiContainerEventType_SERVICE_STARTED,
+	enclave_events.EnclaveEventType_ServiceStopped:       kurtosis_core_rpc_api_bindings.ApiContainerEventType_SERVICE_STOPPED,
+	enclave_events.EnclaveEventType_ServiceCrashed:       kurtosis_core_rpc_api_bindings.ApiContainerEventType_SERVICE_CRASHED,
+}
+
+// Guaranteed (by a unit test) to be a 1:1 mapping between the Starlark run statuses and the API ones
+var apiStarlarkRunStatusesByStarlarkRunStatus = map[starlark_run.StarlarkRunStatus]kurtosis_core_rpc_api_bindings.StarlarkRunStatus{
+	starlark_run.StarlarkRunStatus_InProgress:     kurtosis_core_rpc_api_bindings.StarlarkRunStatus_STARLARK_RUN_IN_PROGRESS,
+	starlark_run.StarlarkRunStatus_Succeeded:      kurtosis_core_rpc_api_bindings.StarlarkRunStatus_STARLARK_RUN_SUCCEEDED,
+	starlark_run.StarlarkRunStatus_Failed:         kurtosis_core_rpc_api_bindings.StarlarkRunStatus_STARLARK_RUN_FAILED,
+	starlark_run.StarlarkRunStatus_OutcomeUnknown: kurtosis_core_rpc_api_bindings.StarlarkRunStatus_STARLARK_RUN_OUTCOME_UNKNOWN,
+}
+
 // Guaranteed (by a unit test) to be a 1:1 mapping between API port protos and port spec protos
 var apiContainerPortProtoToPortSpecPortProto = map[kurtosis_core_rpc_api_bindings.Port_TransportProtocol]port_spec.TransportProtocol{
 	kurtosis_core_rpc_api_bindings.Port_TCP:  port_spec.TransportProtocol_TCP,
@@ -102,6 +146,8 @@ type ApiContainerService struct {
 
 	starlarkRunRepository *starlark_run.StarlarkRunRepository
 
+	starlarkRunRecordRepository *starlark_run.StarlarkRunRecordRepository
+
 	metricsClient metrics_client.MetricsClient
 
 	githubAuthProvider *git_package_content_provider.GitHubPackageAuthProvider
@@ -112,6 +158,17 @@ type ApiContainerService struct {
 	// TODO: Either mutex protect the interpretationTimeValueStore OR compose the interpretationTimeValueStore of a separate mutex protected `serviceConfigRepository` object
 	// and allow both ApiContainerService and interpretationTimeValueStore to have access to that
 	interpretationTimeValueStore *interpretation_time_value_store.InterpretationTimeValueStore
+
+	secretStore *secret_store.SecretStore
+
+	// The recipient of the secrets of the enclave this one gets cloned from, set by the last call to
+	// GetSecretsRecipientPublicKey
+	secretsRecipientMutex *sync.Mutex
+	secretsRecipient      *secret_store.SecretsRecipient
+
+	eventBus *enclave_events.EnclaveEventBus
+
+	activityTracker *ActivityTracker
 }
 
 func NewApiContainerService(
@@ -124,7 +181,11 @@ func NewApiContainerService(
 	metricsClient metrics_client.MetricsClient,
 	githubAuthProvider *git_package_content_provider.GitHubPackageAuthProvider,
 	starlarkRunRepository *starlark_run.StarlarkRunRepository,
+	starlarkRunRecordRepository *starlark_run.StarlarkRunRecordRepository,
 	interpretationTimeValueStore *interpretation_time_value_store.InterpretationTimeValueStore,
+	secretStore *secret_store.SecretStore,
+	eventBus *enclave_events.EnclaveEventBus,
+	activityTracker *ActivityTracker,
 ) (*ApiContainerService, error) {
 
 	if err := initStarlarkRun(starlarkRunRepository, restartPolicy); err != nil {
@@ -138,9 +199,15 @@ func NewApiContainerService(
 		startosisInterpreter:         startosisInterpreter,
 		packageContentProvider:       startosisModuleContentProvider,
 		starlarkRunRepository:        starlarkRunRepository,
+		starlarkRunRecordRepository:  starlarkRunRecordRepository,
 		metricsClient:                metricsClient,
 		githubAuthProvider:           githubAuthProvider,
 		interpretationTimeValueStore: interpretationTimeValueStore,
+		secretStore:                  secretStore,
+		secretsRecipientMutex:        &sync.Mutex{},
+		secretsRecipient:             nil,
+		eventBus:                     eventBus,
+		activityTracker:              activityTracker,
 	}
 
 	return service, nil
@@ -287,15 +354,16 @@ func (apicService *ApiContainerService) InspectFilesArtifactContents(_ context.C
 		return nil, stacktrace.NewError("An error occurred because files artifact identifier is empty '%v'", artifactIdentifier)
 	}
 
-	_, filesArtifact, _, found, err := apicService.filesArtifactStore.GetFile(artifactIdentifier)
+	filesArtifactContent, _, found, err := apicService.filesArtifactStore.OpenFile(artifactIdentifier)
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "An error occurred getting files artifact '%v'", artifactIdentifier)
+		return nil, stacktrace.Propagate(err, "An error occurred reading the content of files artifact '%v'", artifactIdentifier)
 	}
 	if !found {
 		return nil, stacktrace.NewError("An error occurred getting files artifact '%v', it doesn't exist in this enclave", artifactIdentifier)
 	}
+	defer filesArtifactContent.Close()
 
-	fileDescriptions, err := getFileDescriptionsFromArtifact(filesArtifact.GetAbsoluteFilepath())
+	fileDescriptions, err := getFileDescriptionsFromArtifact(filesArtifactContent)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred getting file descriptions from '%v'", artifactIdentifier)
 	}
@@ -305,6 +373,39 @@ func (apicService *ApiContainerService) InspectFilesArtifactContents(_ context.C
 	}, nil
 }
 
+func (apicService *ApiContainerService) GetFilesArtifactHistory(_ context.Context, args *kurtosis_core_rpc_api_bindings.GetFilesArtifactHistoryArgs) (*kurtosis_core_rpc_api_bindings.GetFilesArtifactHistoryResponse, error) {
+	artifactIdentifier := args.GetIdentifier()
+	if strings.TrimSpace(artifactIdentifier) == "" {
+		return nil, stacktrace.NewError("Cannot get the history of a files artifact with an empty identifier")
+	}
+
+	filesArtifactUuid, versions, found, err := apicService.filesArtifactStore.GetFileVersions(artifactIdentifier)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the versions of files artifact '%v'", artifactIdentifier)
+	}
+	if !found {
+		return nil, stacktrace.NewError("An error occurred getting files artifact '%v', it doesn't exist in this enclave", artifactIdentifier)
+	}
+
+	versionsApi := []*kurtosis_core_rpc_api_bindings.FilesArtifactVersion{}
+	for _, version := range versions {
+		var creationTime *timestamppb.Timestamp
+		if !version.GetCreationTime().IsZero() {
+			creationTime = timestamppb.New(version.GetCreationTime())
+		}
+		versionsApi = append(versionsApi, &kurtosis_core_rpc_api_bindings.FilesArtifactVersion{
+			Version:      version.GetVersion(),
+			CreationTime: creationTime,
+			Producer:     version.GetProducer(),
+			IsCurrent:    version.IsCurrent(),
+		})
+	}
+	return &kurtosis_core_rpc_api_bindings.GetFilesArtifactHistoryResponse{
+		FileUuid: string(filesArtifactUuid),
+		Versions: versionsApi,
+	}, nil
+}
+
 func (apicService *ApiContainerService) RunStarlarkPackage(args *kurtosis_core_rpc_api_bindings.RunStarlarkPackageArgs, stream kurtosis_core_rpc_api_bindings.ApiContainerService_RunStarlarkPackageServer) error {
 
 	var scriptWithRunFunction string
@@ -413,11 +514,86 @@ func (apicService *ApiContainerService) ExecCommand(ctx context.Context, args *k
 	}
 	resp := &kurtosis_core_rpc_api_bindings.ExecCommandResponse{
 		ExitCode:  execResult.GetExitCode(),
-		LogOutput: execResult.GetOutput(),
+		LogOutput: apicService.secretStore.MaskSecrets(execResult.GetOutput()),
 	}
 	return resp, nil
 }
 
+func (apicService *ApiContainerService) StreamExecCommand(stream kurtosis_core_rpc_api_bindings.ApiContainerService_StreamExecCommandServer) error {
+	firstArgs, err := stream.Recv()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred receiving the command to execute")
+	}
+	startArgs := firstArgs.GetStart()
+	if startArgs == nil {
+		return stacktrace.NewError("Expected the first message of the stream to contain the command to execute, but it did not")
+	}
+	serviceIdentifier := startArgs.GetServiceIdentifier()
+	command := startArgs.GetCommandArgs()
+
+	var stdinReader *io.PipeReader
+	var stdinWriter *io.PipeWriter
+	var execStdin io.Reader
+	if startArgs.GetAttachStdin() {
+		stdinReader, stdinWriter = io.Pipe()
+		execStdin = stdinReader
+		// unblocks the goroutine forwarding stdin if the command exited without reading all of it
+		defer stdinReader.Close()
+	}
+
+	terminalSizes := make(chan interactive_exec.TerminalSize, terminalSizesBufferSize)
+	if startArgs.TerminalSize != nil {
+		sendTerminalSize(terminalSizes, startArgs.GetTerminalSize())
+	}
+
+	// forwards the inputs of the client until it closes its side of the stream, or this call returns
+	go func() {
+		defer close(terminalSizes)
+		for {
+			args, err := stream.Recv()
+			if err != nil {
+				if stdinWriter != nil {
+					stdinWriter.Close()
+				}
+				return
+			}
+			switch input := args.GetStreamExecCommandInput().(type) {
+			case *kurtosis_core_rpc_api_bindings.StreamExecCommandArgs_Stdin:
+				if stdinWriter == nil {
+					continue
+				}
+				if _, err := stdinWriter.Write(input.Stdin); err != nil {
+					logrus.Debugf("Stopped forwarding stdin to command '%v' on service '%v' because of error:\n%v", command, serviceIdentifier, err)
+				}
+			case *kurtosis_core_rpc_api_bindings.StreamExecCommandArgs_CloseStdin:
+				if stdinWriter != nil {
+					stdinWriter.Close()
+				}
+			case *kurtosis_core_rpc_api_bindings.StreamExecCommandArgs_TerminalSize:
+				sendTerminalSize(terminalSizes, input.TerminalSize)
+			case *kurtosis_core_rpc_api_bindings.StreamExecCommandArgs_Start:
+				logrus.Warnf("Ignoring a second request to start a command while command '%v' is running on service '%v'", command, serviceIdentifier)
+			}
+		}
+	}()
+
+	sendMutex := &sync.Mutex{}
+	stdout := newStreamExecCommandOutputWriter(sendMutex, stream, binding_constructors.NewStreamExecCommandStdoutResponse, apicService.secretStore.MaskSecrets)
+	stderr := newStreamExecCommandOutputWriter(sendMutex, stream, binding_constructors.NewStreamExecCommandStderrResponse, apicService.secretStore.MaskSecrets)
+	execStreams := interactive_exec.NewInteractiveExecStreams(execStdin, stdout, stderr, startArgs.GetTty(), terminalSizes)
+	exitCode, err := apicService.serviceNetwork.RunInteractiveExec(stream.Context(), serviceIdentifier, command, execStreams)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred running exec command '%v' against service '%v' in the service network", command, serviceIdentifier)
+	}
+
+	sendMutex.Lock()
+	defer sendMutex.Unlock()
+	if err := stream.Send(binding_constructors.NewStreamExecCommandExitCodeResponse(exitCode)); err != nil {
+		return stacktrace.Propagate(err, "An error occurred sending exit code '%d' of command '%v' to the client", exitCode, command)
+	}
+	return nil
+}
+
 func (apicService *ApiContainerService) WaitForHttpGetEndpointAvailability(ctx context.Context, args *kurtosis_core_rpc_api_bindings.WaitForHttpGetEndpointAvailabilityArgs) (*emptypb.Empty, error) {
 
 	serviceIdentifier := args.GetServiceIdentifier()
@@ -483,6 +659,7 @@ func (apicService *ApiContainerService) GetServices(ctx context.Context, args *k
 			serviceInfos[serviceIdentifier] = serviceInfo
 		}
 		resp := binding_constructors.NewGetServicesResponse(serviceInfos)
+		apicService.secretStore.MaskSecretsInMessage(resp)
 		return resp, nil
 	}
 
@@ -497,6 +674,8 @@ func (apicService *ApiContainerService) GetServices(ctx context.Context, args *k
 	}
 
 	resp := binding_constructors.NewGetServicesResponse(serviceInfos)
+	// the env vars and cmd of the services may carry the secrets resolved at execution time
+	apicService.secretStore.MaskSecretsInMessage(resp)
 	return resp, nil
 }
 
@@ -549,7 +728,7 @@ func (apicService *ApiContainerService) UploadFilesArtifact(server kurtosis_core
 			// finished receiving all the chunks and assembling them into a single byte array
 			// TODO: pass in the md5 from the CLI (which currently drops it because APIC API doesn't accept it)
 			//  for now it's fine, it's just that file hash comparison for this file will always return false
-			filesArtifactUuid, err := apicService.serviceNetwork.UploadFilesArtifact(assembledContent, []byte{}, maybeArtifactName)
+			filesArtifactUuid, err := apicService.serviceNetwork.UploadFilesArtifact(assembledContent, []byte{}, maybeArtifactName, uploadedFilesArtifactProducer)
 			if err != nil {
 				return nil, stacktrace.Propagate(err, "An error occurred while trying to upload the file")
 			}
@@ -563,39 +742,30 @@ func (apicService *ApiContainerService) UploadFilesArtifact(server kurtosis_core
 	return nil
 }
 
+func (apicService *ApiContainerService) GetMissingFilesArtifactBlobs(_ context.Context, args *kurtosis_core_rpc_api_bindings.GetMissingFilesArtifactBlobsArgs) (*kurtosis_core_rpc_api_bindings.GetMissingFilesArtifactBlobsResponse, error) {
+	missingBlobSha256s, err := apicService.filesArtifactStore.GetMissingBlobs(args.GetBlobSha256S())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the files artifact blobs that are missing")
+	}
+	return &kurtosis_core_rpc_api_bindings.GetMissingFilesArtifactBlobsResponse{
+		MissingBlobSha256S: missingBlobSha256s,
+	}, nil
+}
+
 func (apicService *ApiContainerService) DownloadFilesArtifact(args *kurtosis_core_rpc_api_bindings.DownloadFilesArtifactArgs, server kurtosis_core_rpc_api_bindings.ApiContainerService_DownloadFilesArtifactServer) error {
 	artifactIdentifier := args.Identifier
 	if strings.TrimSpace(artifactIdentifier) == "" {
 		return stacktrace.NewError("Cannot download file with empty files artifact identifier")
 	}
 
-	_, filesArtifact, _, found, err := apicService.filesArtifactStore.GetFile(artifactIdentifier)
+	file, fileSize, found, err := apicService.filesArtifactStore.OpenFile(artifactIdentifier)
 	if err != nil {
-		return stacktrace.Propagate(err, "An error occurred getting files artifact '%v'", artifactIdentifier)
+		return stacktrace.Propagate(err, "An error occurred reading the content of files artifact '%v'", artifactIdentifier)
 	}
 	if !found {
 		return stacktrace.NewError("An error occurred getting files artifact '%v', it doesn't exist in this enclave", artifactIdentifier)
 	}
-
-	file, err := os.OpenFile(filesArtifact.GetAbsoluteFilepath(), os.O_RDONLY, allFilePermissionsForOwner)
-	if err != nil {
-		return stacktrace.Propagate(err, "An error occurred reading files artifact file at '%s'",
-			filesArtifact.GetAbsoluteFilepath())
-	}
 	defer file.Close()
-	fileInfo, err := file.Stat()
-	if err != nil {
-		return stacktrace.Propagate(err, "An error occurred inspecting files artifact file at '%s'",
-			filesArtifact.GetAbsoluteFilepath())
-	}
-	var fileSize uint64
-	if fileInfo.Size() >= 0 {
-		fileSize = uint64(fileInfo.Size())
-	} else {
-		return stacktrace.Propagate(err, "An error occurred inspecting files artifact file at '%s'. The size of "+
-			"the file is a negative integer",
-			filesArtifact.GetAbsoluteFilepath())
-	}
 
 	serverStream := grpc_file_streaming.NewServerStream[kurtosis_core_rpc_api_bindings.StreamedDataChunk, []byte](server)
 	err = serverStream.SendData(
@@ -631,10 +801,11 @@ func (apicService *ApiContainerService) StoreWebFilesArtifact(_ context.Context,
 
 	// TODO: we should probably wrap the web file into a file artifact here, not sure how files look in the APIC since
 	//  it might not even be a TGZ.
-	filesArtifactUuId, err := apicService.filesArtifactStore.StoreFile(body, []byte{}, artifactName)
+	filesArtifactUuId, err := apicService.filesArtifactStore.StoreFile(body, []byte{}, artifactName, fmt.Sprintf(webFilesArtifactProducerFormatStr, url))
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred storing the file from URL '%v' in the files artifact store", url)
 	}
+	apicService.eventBus.Publish(enclave_events.NewFilesArtifactCreatedEvent(artifactName, string(filesArtifactUuId)))
 
 	response := &kurtosis_core_rpc_api_bindings.StoreWebFilesArtifactResponse{Uuid: string(filesArtifactUuId)}
 	return response, nil
@@ -645,7 +816,7 @@ func (apicService *ApiContainerService) StoreFilesArtifactFromService(ctx contex
 	srcPath := args.SourcePath
 	name := args.Name
 
-	filesArtifactId, err := apicService.serviceNetwork.CopyFilesFromService(ctx, serviceIdentifier, srcPath, name)
+	filesArtifactId, err := apicService.serviceNetwork.CopyFilesFromService(ctx, serviceIdentifier, srcPath, name, fmt.Sprintf(serviceFilesArtifactProducerFormatStr, serviceIdentifier, srcPath))
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred copying source '%v' from service with identifier '%v'", srcPath, serviceIdentifier)
 	}
@@ -696,6 +867,65 @@ func (apicService *ApiContainerService) GetStarlarkRun(_ context.Context, _ *emp
 	return getStarlarkRunResponse, nil
 }
 
+func (apicService *ApiContainerService) ListStarlarkRunRecords(_ context.Context, _ *emptypb.Empty) (*kurtosis_core_rpc_api_bindings.ListStarlarkRunRecordsResponse, error) {
+	runRecords, err := apicService.starlarkRunRecordRepository.GetAll()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the starlark run records from the repository")
+	}
+
+	apiRunRecords := []*kurtosis_core_rpc_api_bindings.StarlarkRunRecord{}
+	for _, runRecord := range runRecords {
+		apiRunRecords = append(apiRunRecords, convertStarlarkRunRecordToApi(runRecord))
+	}
+	return &kurtosis_core_rpc_api_bindings.ListStarlarkRunRecordsResponse{StarlarkRunRecords: apiRunRecords}, nil
+}
+
+func (apicService *ApiContainerService) GetStarlarkRunRecord(_ context.Context, args *kurtosis_core_rpc_api_bindings.GetStarlarkRunRecordArgs) (*kurtosis_core_rpc_api_bindings.StarlarkRunRecord, error) {
+	runUuid := args.GetRunUuid()
+	runRecord, err := apicService.starlarkRunRecordRepository.Get(runUuid)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the record of Starlark run '%v' from the repository", runUuid)
+	}
+	if runRecord == nil {
+		return nil, stacktrace.NewError("No Starlark run with UUID '%v' was found in this enclave", runUuid)
+	}
+	return convertStarlarkRunRecordToApi(runRecord), nil
+}
+
+func (apicService *ApiContainerService) GetStarlarkRunRecordOutput(args *kurtosis_core_rpc_api_bindings.GetStarlarkRunRecordArgs, stream kurtosis_core_rpc_api_bindings.ApiContainerService_GetStarlarkRunRecordOutputServer) error {
+	runUuid := args.GetRunUuid()
+	runRecord, err := apicService.starlarkRunRecordRepository.Get(runUuid)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the record of Starlark run '%v' from the repository", runUuid)
+	}
+	if runRecord == nil {
+		return stacktrace.NewError("No Starlark run with UUID '%v' was found in this enclave", runUuid)
+	}
+
+	// the output is read a page at a time, so that neither the whole output nor a long database transaction is held
+	// while the lines are sent
+	for firstLineIndex := 0; ; firstLineIndex += starlarkRunOutputPageSize {
+		serializedOutputLines, err := apicService.starlarkRunRecordRepository.GetOutputLines(runUuid, firstLineIndex, starlarkRunOutputPageSize)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the output lines of Starlark run '%v' starting at line %v", runUuid, firstLineIndex)
+		}
+		for _, serializedOutputLine := range serializedOutputLines {
+			// Suppressing exhaustruct requirement because the line is filled by the unmarshalling
+			// nolint: exhaustruct
+			outputLine := &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine{}
+			if err := proto.Unmarshal(serializedOutputLine, outputLine); err != nil {
+				return stacktrace.Propagate(err, "An error occurred deserializing an output line of Starlark run '%v'", runUuid)
+			}
+			if err := stream.Send(outputLine); err != nil {
+				return stacktrace.Propagate(err, "An error occurred sending an output line of Starlark run '%v'", runUuid)
+			}
+		}
+		if len(serializedOutputLines) < starlarkRunOutputPageSize {
+			return nil
+		}
+	}
+}
+
 func (apicService *ApiContainerService) GetStarlarkPackagePlanYaml(ctx context.Context, args *kurtosis_core_rpc_api_bindings.StarlarkPackagePlanYamlArgs) (*kurtosis_core_rpc_api_bindings.PlanYaml, error) {
 	packageIdFromArgs := args.GetPackageId()
 	serializedParams := args.GetSerializedParams()
@@ -826,6 +1056,183 @@ func transformPortSpecMapToApiPortsMap(apiPorts map[string]*port_spec.PortSpec)
 	return result, nil
 }
 
+func (apicService *ApiContainerService) SetSecret(_ context.Context, args *kurtosis_core_rpc_api_bindings.SetSecretArgs) (*emptypb.Empty, error) {
+	secretName := args.GetName()
+	if err := apicService.secretStore.Set(secretName, args.GetValue()); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred setting secret '%s'", secretName)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (apicService *ApiContainerService) ListSecrets(_ context.Context, _ *emptypb.Empty) (*kurtosis_core_rpc_api_bindings.ListSecretsResponse, error) {
+	return &kurtosis_core_rpc_api_bindings.ListSecretsResponse{SecretNames: apicService.secretStore.ListNames()}, nil
+}
+
+func (apicService *ApiContainerService) RemoveSecret(_ context.Context, args *kurtosis_core_rpc_api_bindings.RemoveSecretArgs) (*emptypb.Empty, error) {
+	secretName := args.GetName()
+	if err := apicService.secretStore.Remove(secretName); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred removing secret '%s'", secretName)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (apicService *ApiContainerService) ResumeServices(ctx context.Context, _ *emptypb.Empty) (*kurtosis_core_rpc_api_bindings.ResumeServicesResponse, error) {
+	resumedServiceNames, err := apicService.startosisRunner.ResumeServices(ctx, apicService.serviceNetwork)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred resuming the services of the enclave")
+	}
+	resumedServiceNameStrs := []string{}
+	for _, resumedServiceName := range resumedServiceNames {
+		resumedServiceNameStrs = append(resumedServiceNameStrs, string(resumedServiceName))
+	}
+	return &kurtosis_core_rpc_api_bindings.ResumeServicesResponse{ResumedServiceNames: resumedServiceNameStrs}, nil
+}
+
+func (apicService *ApiContainerService) GetServiceDependencies(_ context.Context, _ *emptypb.Empty) (*kurtosis_core_rpc_api_bindings.GetServiceDependenciesResponse, error) {
+	dependenciesByServiceName := map[string]*kurtosis_core_rpc_api_bindings.ServiceDependencies{}
+	for serviceName, dependencies := range apicService.startosisRunner.GetServiceDependencies() {
+		dependencyNameStrs := []string{}
+		for _, dependency := range dependencies {
+			dependencyNameStrs = append(dependencyNameStrs, string(dependency))
+		}
+		dependenciesByServiceName[string(serviceName)] = &kurtosis_core_rpc_api_bindings.ServiceDependencies{ServiceNames: dependencyNameStrs}
+	}
+	return &kurtosis_core_rpc_api_bindings.GetServiceDependenciesResponse{DependenciesByServiceName: dependenciesByServiceName}, nil
+}
+
+func (apicService *ApiContainerService) WaitForServiceReadiness(ctx context.Context, args *kurtosis_core_rpc_api_bindings.WaitForServiceReadinessArgs) (*emptypb.Empty, error) {
+	serviceName := service.ServiceName(args.GetServiceName())
+	if err := apicService.startosisRunner.WaitForServiceReadiness(ctx, apicService.serviceNetwork, serviceName); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred waiting for service '%v' to be ready", serviceName)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (apicService *ApiContainerService) GetLastActivityTime(_ context.Context, _ *emptypb.Empty) (*kurtosis_core_rpc_api_bindings.GetLastActivityTimeResponse, error) {
+	response := &kurtosis_core_rpc_api_bindings.GetLastActivityTimeResponse{LastActivityTime: nil}
+	if lastActivityTime := apicService.activityTracker.GetLastActivityTime(); lastActivityTime != nil {
+		response.LastActivityTime = timestamppb.New(*lastActivityTime)
+	}
+	return response, nil
+}
+
+func (apicService *ApiContainerService) GetSecretsRecipientPublicKey(_ context.Context, _ *emptypb.Empty) (*kurtosis_core_rpc_api_bindings.GetSecretsRecipientPublicKeyResponse, error) {
+	secretsRecipient, err := secret_store.NewSecretsRecipient()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the secrets recipient")
+	}
+	apicService.secretsRecipientMutex.Lock()
+	defer apicService.secretsRecipientMutex.Unlock()
+	apicService.secretsRecipient = secretsRecipient
+	return &kurtosis_core_rpc_api_bindings.GetSecretsRecipientPublicKeyResponse{PublicKey: secretsRecipient.GetPublicKey()}, nil
+}
+
+func (apicService *ApiContainerService) ExportEnclavePlan(_ context.Context, args *kurtosis_core_rpc_api_bindings.ExportEnclavePlanArgs) (*kurtosis_core_rpc_api_bindings.ExportEnclavePlanResponse, error) {
+	exportedEnclavePlan, err := apicService.startosisRunner.ExportEnclavePlan()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred exporting the enclave plan")
+	}
+	serializedExportedEnclavePlan, err := exportedEnclavePlan.Serialize()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred serializing the exported enclave plan")
+	}
+	response := &kurtosis_core_rpc_api_bindings.ExportEnclavePlanResponse{
+		SerializedExportedEnclavePlan: serializedExportedEnclavePlan,
+		ReplayedFilesArtifactNames:    exportedEnclavePlan.ReplayedFilesArtifactNames,
+		SealedSecrets:                 nil,
+	}
+	if args.SecretsRecipientPublicKey != nil {
+		sealedSecrets, err := apicService.secretStore.ExportSealed(args.GetSecretsRecipientPublicKey())
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred exporting the secrets of the enclave")
+		}
+		response.SealedSecrets = sealedSecrets
+	}
+	return response, nil
+}
+
+func (apicService *ApiContainerService) ReplayEnclavePlan(args *kurtosis_core_rpc_api_bindings.ReplayEnclavePlanArgs, stream kurtosis_core_rpc_api_bindings.ApiContainerService_ReplayEnclavePlanServer) error {
+	exportedEnclavePlan, err := enclave_plan_persistence.DeserializeExportedEnclavePlan(args.GetSerializedExportedEnclavePlan())
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred deserializing the exported enclave plan to replay")
+	}
+	// The secrets go first, as the replayed instructions may refer to them
+	if args.SealedSecrets != nil {
+		if err := apicService.importSealedSecrets(args.GetSealedSecrets()); err != nil {
+			return stacktrace.Propagate(err, "An error occurred importing the secrets exported along with the enclave plan to replay")
+		}
+	}
+	if err := apicService.startosisRunner.ImportEnclavePlanRuntimeValues(exportedEnclavePlan); err != nil {
+		return stacktrace.Propagate(err, "An error occurred importing the runtime values of the enclave plan to replay")
+	}
+
+	noPackageReplaceOptions := map[string]string{}
+	noExperimentalFeatures := []kurtosis_core_rpc_api_bindings.KurtosisFeatureFlag{}
+	apicService.runStarlark(
+		defaultParallelism,
+		defaultStartosisDryRun,
+		startosis_constants.PackageIdPlaceholderForStandaloneScript,
+		noPackageReplaceOptions,
+		replayedEnclavePlanMainFunctionName,
+		startosis_constants.PlaceHolderMainFileForPlaceStandAloneScript,
+		exportedEnclavePlan.GetReplayScript(),
+		startosis_constants.EmptyInputArgs,
+		convertFromImageDownloadModeAPI(defaultImageDownloadMode),
+		doNotRunReplayedEnclavePlanInNonBlockingMode,
+		doNotRunReplayedEnclavePlanInParallel,
+		doCheckResourcesOfReplayedEnclavePlan,
+		noExperimentalFeatures,
+		// the instructions already went through the privileged mode check when they ran in the source enclave
+		allowPrivilegedModeForReplayedEnclavePlan,
+		stream,
+	)
+	return nil
+}
+
+// importSealedSecrets stores secrets sealed for the current secrets recipient, which can't be used again afterwards
+func (apicService *ApiContainerService) importSealedSecrets(sealedSecrets []byte) error {
+	apicService.secretsRecipientMutex.Lock()
+	defer apicService.secretsRecipientMutex.Unlock()
+	if apicService.secretsRecipient == nil {
+		return stacktrace.NewError("Sealed secrets were given but no secrets recipient public key was requested from this API container")
+	}
+	if err := apicService.secretStore.ImportSealed(apicService.secretsRecipient, sealedSecrets); err != nil {
+		return stacktrace.Propagate(err, "An error occurred importing the sealed secrets")
+	}
+	apicService.secretsRecipient = nil
+	return nil
+}
+
+func (apicService *ApiContainerService) WatchEvents(args *kurtosis_core_rpc_api_bindings.WatchEventsArgs, stream kurtosis_core_rpc_api_bindings.ApiContainerService_WatchEventsServer) error {
+	events, unsubscribe := apicService.eventBus.SubscribeAfter(args.GetAfterStreamId(), args.GetAfterSequenceNumber())
+	defer unsubscribe()
+	lastSentSequenceNumber := uint64(0)
+	if args.GetAfterStreamId() == apicService.eventBus.GetStreamId() {
+		lastSentSequenceNumber = args.GetAfterSequenceNumber()
+	}
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case event, isChanOpen := <-events:
+			if !isChanOpen {
+				return stacktrace.NewError("The client didn't keep up with the events of the enclave; watch them again after event %v of stream '%v' to get the missed ones", lastSentSequenceNumber, apicService.eventBus.GetStreamId())
+			}
+			if err := stream.Send(convertEnclaveEventToApi(apicService.eventBus.GetStreamId(), event)); err != nil {
+				return stacktrace.Propagate(err, "An error occurred sending event '%v' to the client", event.Type)
+			}
+			lastSentSequenceNumber = event.SequenceNumber
+		}
+	}
+}
+
+func (apicService *ApiContainerService) RecordServicesToResume(ctx context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
+	if err := apicService.startosisRunner.RecordServicesToResume(ctx, apicService.serviceNetwork); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred recording the services of the enclave to resume")
+	}
+	return &emptypb.Empty{}, nil
+}
+
 func transformServiceDirPathsToFileArtifactsToApiPortsFilesArtifactsList(serviceDirPathsToFilesArtifactsIdentifiers map[string][]string) map[string]*kurtosis_core_rpc_api_bindings.FilesArtifactsList {
 	result := map[string]*kurtosis_core_rpc_api_bindings.FilesArtifactsList{}
 	for svcName, filesArtifactsIdentifiers := range serviceDirPathsToFilesArtifactsIdentifiers {
@@ -1056,18 +1463,27 @@ func (apicService *ApiContainerService) runStarlark(
 	allowPrivilegedMode bool,
 	stream grpc.ServerStream,
 ) {
+	// dry runs don't change the enclave so they are left out of its history
+	var runRecord *starlark_run.StarlarkRunRecord
+	if !dryRun {
+		apicService.eventBus.Publish(enclave_events.NewStarlarkRunStartedEvent())
+		runRecord = apicService.startStarlarkRunRecord(packageId, serializedParams, relativePathToMainFile, mainFunctionName)
+	}
+	runStatus := starlark_run.StarlarkRunStatus_OutcomeUnknown
 	responseLineStream := apicService.startosisRunner.Run(stream.Context(), dryRun, parallelism, packageId, packageReplaceOptions, mainFunctionName, relativePathToMainFile, serializedStarlark, serializedParams, imageDownloadMode, nonBlockingMode, shouldExecuteInParallel, shouldCheckResources, experimentalFeatures, allowPrivilegedMode)
 	for {
 		select {
 		case <-stream.Context().Done():
 			// TODO: maybe add the ability to kill the execution
 			logrus.Infof("Stream was closed by client. The script ouput won't be returned anymore but note that the execution won't be interrupted. There's currently no way to stop a Kurtosis script execution.")
+			apicService.finishStarlarkRunRecord(runRecord, runStatus)
 			return
 		case responseLine, isChanOpen := <-responseLineStream:
 			if !isChanOpen {
 				// Channel closed means that this function returned, so we won't receive any message through the stream anymore
 				// We expect the stream to be closed soon and the above case to exit that function
 				logrus.Info("Startosis script execution returned, no more output to stream.")
+				apicService.finishStarlarkRunRecord(runRecord, runStatus)
 				return
 			}
 
@@ -1085,6 +1501,14 @@ func (apicService *ApiContainerService) runStarlark(
 				}
 
 				isSuccessful := runFinishedEvent.GetIsRunSuccessful()
+				if isSuccessful {
+					runStatus = starlark_run.StarlarkRunStatus_Succeeded
+				} else {
+					runStatus = starlark_run.StarlarkRunStatus_Failed
+				}
+				if !dryRun {
+					apicService.eventBus.Publish(enclave_events.NewStarlarkRunFinishedEvent(isSuccessful))
+				}
 				numberOfServicesAfterRunFinished := 0
 				if serviceNames, err := apicService.serviceNetwork.GetServiceNames(); err != nil {
 					logrus.Warn("Couldn't figure out the number of services after run finished, will be logging 0 in the metrics")
@@ -1095,6 +1519,10 @@ func (apicService *ApiContainerService) runStarlark(
 					logrus.Warn("An error occurred tracking the run-finished event")
 				}
 			}
+			// secrets are resolved at execution time so they can show up in instruction results or errors
+			apicService.secretStore.MaskSecretsInMessage(responseLine)
+			addResponseLineToStarlarkRunRecord(runRecord, responseLine)
+			apicService.saveStarlarkRunRecordOutputIfNeeded(runRecord)
 			// in addition to send the msg to the RPC stream, we also print the lines to the APIC logs at debug level
 			logrus.Debugf("Received response line from Starlark runner: '%v'", responseLine)
 			if err := stream.SendMsg(responseLine); err != nil {
@@ -1104,6 +1532,110 @@ func (apicService *ApiContainerService) runStarlark(
 	}
 }
 
+// startStarlarkRunRecord saves the record of a run that is starting. Failing to record a run doesn't fail the run, so
+// this returns nil if the record couldn't be saved
+func (apicService *ApiContainerService) startStarlarkRunRecord(
+	packageId string,
+	serializedParams string,
+	relativePathToMainFile string,
+	mainFunctionName string,
+) *starlark_run.StarlarkRunRecord {
+	runUuid, err := uuid_generator.GenerateUUIDString()
+	if err != nil {
+		logrus.Errorf("An error occurred generating the UUID of the Starlark run, it won't be recorded in the enclave's history. Error was:\n%v", err)
+		return nil
+	}
+	runRecord := starlark_run.NewStarlarkRunRecord(
+		runUuid,
+		packageId,
+		apicService.getPackageGitCommitSha(packageId),
+		serializedParams,
+		relativePathToMainFile,
+		mainFunctionName,
+		time.Now(),
+	)
+	if err := apicService.starlarkRunRecordRepository.Save(runRecord); err != nil {
+		logrus.Errorf("An error occurred saving Starlark run '%v', it won't be recorded in the enclave's history. Error was:\n%v", runUuid, err)
+		return nil
+	}
+	return runRecord
+}
+
+func (apicService *ApiContainerService) finishStarlarkRunRecord(runRecord *starlark_run.StarlarkRunRecord, runStatus starlark_run.StarlarkRunStatus) {
+	if runRecord == nil {
+		return
+	}
+	runRecord.Finish(runStatus, time.Now())
+	if err := apicService.starlarkRunRecordRepository.Save(runRecord); err != nil {
+		logrus.Errorf("An error occurred saving the outcome of Starlark run '%v' in the enclave's history. Error was:\n%v", runRecord.GetRunUuid(), err)
+	}
+}
+
+// getPackageGitCommitSha returns the commit the package on disk is at, or an empty string if the package isn't a Git
+// repository, which is the case of the standalone scripts and of the packages uploaded without their .git directory
+func (apicService *ApiContainerService) getPackageGitCommitSha(packageId string) string {
+	if packageId == startosis_constants.PackageIdPlaceholderForStandaloneScript {
+		return ""
+	}
+	packageRootPathOnDisk, interpretationError := apicService.packageContentProvider.GetOnDiskAbsolutePackagePath(packageId)
+	if interpretationError != nil {
+		logrus.Debugf("Couldn't find package '%v' on disk to get its Git commit. Error was:\n%v", packageId, interpretationError)
+		return ""
+	}
+	repo, err := git.PlainOpenWithOptions(packageRootPathOnDisk, &git.PlainOpenOptions{
+		DetectDotGit:          true,
+		EnableDotGitCommonDir: false,
+	})
+	if err != nil {
+		logrus.Debugf("Package '%v' isn't a Git repository, its commit won't be recorded. Error was:\n%v", packageId, err)
+		return ""
+	}
+	head, err := repo.Head()
+	if err != nil {
+		logrus.Debugf("Couldn't get the HEAD of the Git repository of package '%v'. Error was:\n%v", packageId, err)
+		return ""
+	}
+	return head.Hash().String()
+}
+
+// addResponseLineToStarlarkRunRecord keeps the line in the output of the run, except progress updates which are only
+// meaningful while the run is in progress
+func addResponseLineToStarlarkRunRecord(runRecord *starlark_run.StarlarkRunRecord, responseLine *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine) {
+	if runRecord == nil || responseLine.GetProgressInfo() != nil {
+		return
+	}
+	if starlarkError := responseLine.GetError(); starlarkError != nil {
+		runRecord.AddError(getStarlarkErrorMessage(starlarkError))
+	}
+	serializedResponseLine, err := proto.Marshal(responseLine)
+	if err != nil {
+		logrus.Errorf("An error occurred serializing a response line of Starlark run '%v', it will be missing from the run's recorded output. Error was:\n%v", runRecord.GetRunUuid(), err)
+		return
+	}
+	runRecord.AddSerializedOutputLine(serializedResponseLine)
+}
+
+// saveStarlarkRunRecordOutputIfNeeded saves the output lines of the run once enough of them are waiting, so that the
+// output is neither held in memory until the run finishes nor saved one line at a time
+func (apicService *ApiContainerService) saveStarlarkRunRecordOutputIfNeeded(runRecord *starlark_run.StarlarkRunRecord) {
+	if runRecord == nil || runRecord.GetNumberOfPendingOutputLines() < starlarkRunOutputSaveBatchSize {
+		return
+	}
+	if err := apicService.starlarkRunRecordRepository.Save(runRecord); err != nil {
+		logrus.Errorf("An error occurred saving the output of Starlark run '%v' in the enclave's history; the lines will be saved again along with the next ones. Error was:\n%v", runRecord.GetRunUuid(), err)
+	}
+}
+
+func getStarlarkErrorMessage(starlarkError *kurtosis_core_rpc_api_bindings.StarlarkError) string {
+	if interpretationError := starlarkError.GetInterpretationError(); interpretationError != nil {
+		return interpretationError.GetErrorMessage()
+	}
+	if validationError := starlarkError.GetValidationError(); validationError != nil {
+		return validationError.GetErrorMessage()
+	}
+	return starlarkError.GetExecutionError().GetErrorMessage()
+}
+
 func (apicService *ApiContainerService) getServiceInfosFromServiceObjs(services map[service.ServiceUUID]*service.Service) (map[string]*kurtosis_core_rpc_api_bindings.ServiceInfo, error) {
 	serviceInfos := map[string]*kurtosis_core_rpc_api_bindings.ServiceInfo{}
 	for uuid, serviceObj := range services {
@@ -1233,16 +1765,10 @@ func getServiceInfoFromServiceObj(serviceObj *service.Service, serviceConfig *se
 	return serviceInfoResponse, nil
 }
 
-func getFileDescriptionsFromArtifact(artifactPath string) ([]*kurtosis_core_rpc_api_bindings.FileArtifactContentsFileDescription, error) {
-	file, err := os.Open(artifactPath)
+func getFileDescriptionsFromArtifact(artifactContent io.Reader) ([]*kurtosis_core_rpc_api_bindings.FileArtifactContentsFileDescription, error) {
+	gzipReader, err := gzip.NewReader(artifactContent)
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "Failed to get file descriptions for artifact path '%v'", artifactPath)
-	}
-	defer file.Close()
-
-	gzipReader, err := gzip.NewReader(file)
-	if err != nil {
-		return nil, stacktrace.Propagate(err, "Failed to get create gzip reader for artifact path '%v'", artifactPath)
+		return nil, stacktrace.Propagate(err, "Failed to get create gzip reader for artifact content")
 	}
 	defer gzipReader.Close()
 
@@ -1255,7 +1781,7 @@ func getFileDescriptionsFromArtifact(artifactPath string) ([]*kurtosis_core_rpc_
 			break
 		}
 		if err != nil {
-			return nil, stacktrace.Propagate(err, "Failed to get header from artifact path '%v'", artifactPath)
+			return nil, stacktrace.Propagate(err, "Failed to get header from artifact content")
 		}
 
 		filePath := header.Name
@@ -1331,6 +1857,58 @@ func convertFromImageDownloadModeAPI(api_mode kurtosis_core_rpc_api_bindings.Ima
 	}
 }
 
+func convertEnclaveEventToApi(streamId string, event *enclave_events.EnclaveEvent) *kurtosis_core_rpc_api_bindings.ApiContainerEvent {
+	apiEvent := &kurtosis_core_rpc_api_bindings.ApiContainerEvent{
+		Type:              apiContainerEventTypesByEnclaveEventType[event.Type],
+		Timestamp:         timestamppb.New(event.Timestamp),
+		ServiceName:       nil,
+		ServiceUuid:       nil,
+		FilesArtifactName: nil,
+		FilesArtifactUuid: nil,
+		IsRunSuccessful:   nil,
+		StreamId:          streamId,
+		SequenceNumber:    event.SequenceNumber,
+	}
+	switch event.Type {
+	case enclave_events.EnclaveEventType_ServiceAdded, enclave_events.EnclaveEventType_ServiceStarted, enclave_events.EnclaveEventType_ServiceStopped, enclave_events.EnclaveEventType_ServiceCrashed:
+		apiEvent.ServiceName = &event.ServiceName
+		apiEvent.ServiceUuid = &event.ServiceUuid
+	case enclave_events.EnclaveEventType_FilesArtifactCreated:
+		apiEvent.FilesArtifactName = &event.FilesArtifactName
+		apiEvent.FilesArtifactUuid = &event.FilesArtifactUuid
+	case enclave_events.EnclaveEventType_StarlarkRunFinished:
+		apiEvent.IsRunSuccessful = &event.IsRunSuccessful
+	case enclave_events.EnclaveEventType_StarlarkRunStarted:
+	}
+	return apiEvent
+}
+
+func convertStarlarkRunRecordToApi(runRecord *starlark_run.StarlarkRunRecord) *kurtosis_core_rpc_api_bindings.StarlarkRunRecord {
+	var packageGitCommitSha *string
+	if runRecord.GetPackageGitCommitSha() != "" {
+		sha := runRecord.GetPackageGitCommitSha()
+		packageGitCommitSha = &sha
+	}
+	var endTime *timestamppb.Timestamp
+	if !runRecord.GetEndTime().IsZero() {
+		endTime = timestamppb.New(runRecord.GetEndTime())
+	}
+	return &kurtosis_core_rpc_api_bindings.StarlarkRunRecord{
+		RunUuid:                runRecord.GetRunUuid(),
+		PackageId:              runRecord.GetPackageId(),
+		PackageGitCommitSha:    packageGitCommitSha,
+		SerializedParams:       runRecord.GetSerializedParams(),
+		RelativePathToMainFile: runRecord.GetRelativePathToMainFile(),
+		MainFunctionName:       runRecord.GetMainFunctionName(),
+		StartTime:              timestamppb.New(runRecord.GetStartTime()),
+		EndTime:                endTime,
+		Status:                 apiStarlarkRunStatusesByStarlarkRunStatus[runRecord.GetStatus()],
+		Errors:                 runRecord.GetErrors(),
+		NumberOfOutputLines:    uint32(runRecord.GetNumberOfOutputLines()),
+		IsOutputTruncated:      runRecord.IsOutputTruncated(),
+	}
+}
+
 func initStarlarkRun(
 	starlarkRunRepository *starlark_run.StarlarkRunRepository,
 	restartPolicy kurtosis_core_rpc_api_bindings.RestartPolicy,
@@ -1361,3 +1939,18 @@ func initStarlarkRun(
 
 	return nil
 }
+
+// sendTerminalSize queues the terminal size without blocking, dropping it if too many resizes are pending
+func sendTerminalSize(terminalSizes chan interactive_exec.TerminalSize, terminalSize *kurtosis_core_rpc_api_bindings.TerminalSize) {
+	if terminalSize.GetWidth() > math.MaxUint16 || terminalSize.GetHeight() > math.MaxUint16 {
+		logrus.Debugf("Ignoring terminal size %dx%d as it is too large", terminalSize.GetWidth(), terminalSize.GetHeight())
+		return
+	}
+	select {
+	case terminalSizes <- interactive_exec.TerminalSize{
+		Width:  uint16(terminalSize.GetWidth()),
+		Height: uint16(terminalSize.GetHeight()),
+	}:
+	default:
+	}
+}
@@ -37,6 +37,24 @@ func TestOneToOneApiAndPortSpecProtoMapping(t *testing.T) {
 	}
 }
 
+func TestOneToOneEnclaveEventTypeAndApiEventTypeMapping(t *testing.T) {
+	require.Equal(t, len(kurtosis_core_rpc_api_bindings.ApiContainerEventType_name), len(apiContainerEventTypesByEnclaveEventType))
+	seenApiEventTypes := map[kurtosis_core_rpc_api_bindings.ApiContainerEventType]bool{}
+	for enclaveEventType, apiEventType := range apiContainerEventTypesByEnclaveEventType {
+		require.False(t, seenApiEventTypes[apiEventType], "API event type '%v' is mapped to more than one enclave event type, including '%v'", apiEventType, enclaveEventType)
+		seenApiEventTypes[apiEventType] = true
+	}
+}
+
+func TestOneToOneStarlarkRunStatusAndApiStarlarkRunStatusMapping(t *testing.T) {
+	require.Equal(t, len(kurtosis_core_rpc_api_bindings.StarlarkRunStatus_name), len(apiStarlarkRunStatusesByStarlarkRunStatus))
+	seenApiStatuses := map[kurtosis_core_rpc_api_bindings.StarlarkRunStatus]bool{}
+	for status, apiStatus := range apiStarlarkRunStatusesByStarlarkRunStatus {
+		require.False(t, seenApiStatuses[apiStatus], "API Starlark run status '%v' is mapped to more than one status, including '%v'", apiStatus, status)
+		seenApiStatuses[apiStatus] = true
+	}
+}
+
 func TestGetTextRepresentation(t *testing.T) {
 	input := `my
 line
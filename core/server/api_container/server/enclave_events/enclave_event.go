@@ -0,0 +1,88 @@
+package enclave_events
+
+import (
+	"time"
+)
+
+type EnclaveEventType string
+
+const (
+	EnclaveEventType_StarlarkRunStarted   EnclaveEventType = "STARLARK_RUN_STARTED"
+	EnclaveEventType_StarlarkRunFinished  EnclaveEventType = "STARLARK_RUN_FINISHED"
+	EnclaveEventType_FilesArtifactCreated EnclaveEventType = "FILES_ARTIFACT_CREATED"
+	EnclaveEventType_ServiceAdded         EnclaveEventType = "SERVICE_ADDED"
+	EnclaveEventType_ServiceStarted       EnclaveEventType = "SERVICE_STARTED"
+	EnclaveEventType_ServiceStopped       EnclaveEventType = "SERVICE_STOPPED"
+	EnclaveEventType_ServiceCrashed       EnclaveEventType = "SERVICE_CRASHED"
+)
+
+// EnclaveEvent is something that happened inside the enclave. Only the fields relevant to the event type are set
+type EnclaveEvent struct {
+	Type EnclaveEventType
+
+	Timestamp time.Time
+
+	ServiceName string
+	ServiceUuid string
+
+	FilesArtifactName string
+	FilesArtifactUuid string
+
+	// Only set for EnclaveEventType_StarlarkRunFinished
+	IsRunSuccessful bool
+
+	// Set by the bus when the event is published
+	SequenceNumber uint64
+}
+
+func NewServiceEvent(eventType EnclaveEventType, serviceName string, serviceUuid string) *EnclaveEvent {
+	return &EnclaveEvent{
+		Type:              eventType,
+		Timestamp:         time.Now(),
+		ServiceName:       serviceName,
+		ServiceUuid:       serviceUuid,
+		FilesArtifactName: "",
+		FilesArtifactUuid: "",
+		IsRunSuccessful:   false,
+		SequenceNumber:    0,
+	}
+}
+
+func NewFilesArtifactCreatedEvent(filesArtifactName string, filesArtifactUuid string) *EnclaveEvent {
+	return &EnclaveEvent{
+		Type:              EnclaveEventType_FilesArtifactCreated,
+		Timestamp:         time.Now(),
+		ServiceName:       "",
+		ServiceUuid:       "",
+		FilesArtifactName: filesArtifactName,
+		FilesArtifactUuid: filesArtifactUuid,
+		IsRunSuccessful:   false,
+		SequenceNumber:    0,
+	}
+}
+
+func NewStarlarkRunStartedEvent() *EnclaveEvent {
+	return &EnclaveEvent{
+		Type:              EnclaveEventType_StarlarkRunStarted,
+		Timestamp:         time.Now(),
+		ServiceName:       "",
+		ServiceUuid:       "",
+		FilesArtifactName: "",
+		FilesArtifactUuid: "",
+		IsRunSuccessful:   false,
+		SequenceNumber:    0,
+	}
+}
+
+func NewStarlarkRunFinishedEvent(isRunSuccessful bool) *EnclaveEvent {
+	return &EnclaveEvent{
+		Type:              EnclaveEventType_StarlarkRunFinished,
+		Timestamp:         time.Now(),
+		ServiceName:       "",
+		ServiceUuid:       "",
+		FilesArtifactName: "",
+		FilesArtifactUuid: "",
+		IsRunSuccessful:   isRunSuccessful,
+		SequenceNumber:    0,
+	}
+}
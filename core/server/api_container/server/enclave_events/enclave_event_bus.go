@@ -0,0 +1,128 @@
+package enclave_events
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// A subscriber that has this many events waiting to be read is unsubscribed, its channel getting closed, so that a
+	// slow client never blocks the operations publishing the events. The client can subscribe again after the last
+	// event it read to get the events it missed, as long as they are still retained
+	subscriberBufferSize = 256
+
+	// The number of most recent events kept to be sent to the subscribers resuming after an event
+	maxRetainedEvents = 1024
+
+	firstSequenceNumber = 1
+)
+
+// EnclaveEventBus fans out the events happening in the enclave to everyone watching them. Each event gets a sequence
+// number, and the most recent ones are retained so that watchers connecting late or reconnecting don't miss any
+type EnclaveEventBus struct {
+	mutex *sync.Mutex
+
+	// Changes every time the API container restarts, as the sequence numbers start over
+	streamId string
+
+	nextSequenceNumber uint64
+
+	retainedEvents []*EnclaveEvent
+
+	subscribers map[uint64]chan *EnclaveEvent
+
+	nextSubscriberId uint64
+}
+
+func NewEnclaveEventBus() *EnclaveEventBus {
+	return &EnclaveEventBus{
+		mutex:              &sync.Mutex{},
+		streamId:           strconv.FormatInt(time.Now().UnixNano(), 10),
+		nextSequenceNumber: firstSequenceNumber,
+		retainedEvents:     []*EnclaveEvent{},
+		subscribers:        map[uint64]chan *EnclaveEvent{},
+		nextSubscriberId:   0,
+	}
+}
+
+func (bus *EnclaveEventBus) GetStreamId() string {
+	return bus.streamId
+}
+
+// Publish sets the sequence number of the event and sends it to every subscriber
+func (bus *EnclaveEventBus) Publish(event *EnclaveEvent) {
+	bus.mutex.Lock()
+	defer bus.mutex.Unlock()
+
+	event.SequenceNumber = bus.nextSequenceNumber
+	bus.nextSequenceNumber++
+	bus.retainedEvents = append(bus.retainedEvents, event)
+	if len(bus.retainedEvents) > maxRetainedEvents {
+		bus.retainedEvents = bus.retainedEvents[len(bus.retainedEvents)-maxRetainedEvents:]
+	}
+
+	for subscriberId, subscriberChan := range bus.subscribers {
+		select {
+		case subscriberChan <- event:
+		default:
+			logrus.Warnf("Subscriber '%v' of the enclave events isn't keeping up; unsubscribing it after event %v", subscriberId, event.SequenceNumber-1)
+			delete(bus.subscribers, subscriberId)
+			close(subscriberChan)
+		}
+	}
+}
+
+// Subscribe returns a channel receiving every event published from now on, and a function that must be called once the
+// events aren't needed anymore, which closes the channel. The channel also gets closed if the subscriber doesn't keep up
+func (bus *EnclaveEventBus) Subscribe() (<-chan *EnclaveEvent, func()) {
+	bus.mutex.Lock()
+	defer bus.mutex.Unlock()
+
+	return bus.subscribe([]*EnclaveEvent{})
+}
+
+// SubscribeAfter is like Subscribe, but the channel first receives the retained events published after the given event
+// of the stream. If the stream ID isn't the one of this bus, all the retained events are received first
+func (bus *EnclaveEventBus) SubscribeAfter(streamId string, sequenceNumber uint64) (<-chan *EnclaveEvent, func()) {
+	bus.mutex.Lock()
+	defer bus.mutex.Unlock()
+
+	if streamId != bus.streamId {
+		return bus.subscribe(bus.retainedEvents)
+	}
+	for idx, retainedEvent := range bus.retainedEvents {
+		if retainedEvent.SequenceNumber > sequenceNumber {
+			return bus.subscribe(bus.retainedEvents[idx:])
+		}
+	}
+	return bus.subscribe([]*EnclaveEvent{})
+}
+
+// subscribe must be called with the mutex held
+func (bus *EnclaveEventBus) subscribe(eventsToReplay []*EnclaveEvent) (<-chan *EnclaveEvent, func()) {
+	subscriberId := bus.nextSubscriberId
+	bus.nextSubscriberId++
+	subscriberChan := make(chan *EnclaveEvent, len(eventsToReplay)+subscriberBufferSize)
+	for _, event := range eventsToReplay {
+		subscriberChan <- event
+	}
+	bus.subscribers[subscriberId] = subscriberChan
+
+	unsubscribeOnce := &sync.Once{}
+	unsubscribeFunc := func() {
+		unsubscribeOnce.Do(func() {
+			bus.mutex.Lock()
+			defer bus.mutex.Unlock()
+			// the bus already closed the channel if the subscriber didn't keep up
+			if _, found := bus.subscribers[subscriberId]; !found {
+				return
+			}
+			delete(bus.subscribers, subscriberId)
+			close(subscriberChan)
+		})
+	}
+	return subscriberChan, unsubscribeFunc
+}
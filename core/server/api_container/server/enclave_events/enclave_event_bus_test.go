@@ -0,0 +1,77 @@
+package enclave_events
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublishReachesEverySubscriber(t *testing.T) {
+	bus := NewEnclaveEventBus()
+	firstEvents, unsubscribeFirst := bus.Subscribe()
+	defer unsubscribeFirst()
+	secondEvents, unsubscribeSecond := bus.Subscribe()
+	defer unsubscribeSecond()
+
+	event := NewServiceEvent(EnclaveEventType_ServiceAdded, "db", "0123456789abcdef0123456789abcdef")
+	bus.Publish(event)
+
+	require.Equal(t, event, <-firstEvents)
+	require.Equal(t, event, <-secondEvents)
+}
+
+func TestUnsubscribeClosesChannel(t *testing.T) {
+	bus := NewEnclaveEventBus()
+	events, unsubscribe := bus.Subscribe()
+	unsubscribe()
+	// calling it twice must be harmless
+	unsubscribe()
+
+	bus.Publish(NewStarlarkRunStartedEvent())
+	_, isChanOpen := <-events
+	require.False(t, isChanOpen)
+}
+
+func TestPublishUnsubscribesSlowSubscribers(t *testing.T) {
+	bus := NewEnclaveEventBus()
+	events, unsubscribe := bus.Subscribe()
+	defer unsubscribe()
+
+	for i := 0; i < subscriberBufferSize+10; i++ {
+		bus.Publish(NewStarlarkRunStartedEvent())
+	}
+	require.Len(t, events, subscriberBufferSize)
+	for i := 0; i < subscriberBufferSize; i++ {
+		<-events
+	}
+	_, isChanOpen := <-events
+	require.False(t, isChanOpen)
+}
+
+func TestSubscribeAfterReplaysTheMissedEvents(t *testing.T) {
+	bus := NewEnclaveEventBus()
+	for i := 0; i < 3; i++ {
+		bus.Publish(NewStarlarkRunStartedEvent())
+	}
+
+	events, unsubscribe := bus.SubscribeAfter(bus.GetStreamId(), 1)
+	defer unsubscribe()
+	bus.Publish(NewStarlarkRunFinishedEvent(true))
+
+	for _, expectedSequenceNumber := range []uint64{2, 3, 4} {
+		require.Equal(t, expectedSequenceNumber, (<-events).SequenceNumber)
+	}
+}
+
+func TestSubscribeAfterReplaysAllRetainedEventsOfAnotherStream(t *testing.T) {
+	bus := NewEnclaveEventBus()
+	for i := 0; i < maxRetainedEvents+1; i++ {
+		bus.Publish(NewStarlarkRunStartedEvent())
+	}
+
+	events, unsubscribe := bus.SubscribeAfter("previous-stream", maxRetainedEvents)
+	defer unsubscribe()
+
+	require.Len(t, events, maxRetainedEvents)
+	require.Equal(t, uint64(2), (<-events).SequenceNumber)
+}
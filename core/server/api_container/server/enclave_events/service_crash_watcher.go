@@ -0,0 +1,88 @@
+package enclave_events
+
+import (
+	"context"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	serviceCrashCheckInterval = 10 * time.Second
+)
+
+type servicesProvider interface {
+	GetServices(ctx context.Context) (map[service.ServiceUUID]*service.Service, error)
+}
+
+// ServiceCrashWatcher publishes an event for every service that Kurtosis started but whose container isn't running
+// anymore, without anyone having stopped it. A service is only reported once, until its container runs again
+type ServiceCrashWatcher struct {
+	services servicesProvider
+
+	eventBus *EnclaveEventBus
+
+	reportedServiceUuids map[service.ServiceUUID]bool
+}
+
+func NewServiceCrashWatcher(services servicesProvider, eventBus *EnclaveEventBus) *ServiceCrashWatcher {
+	return &ServiceCrashWatcher{
+		services:             services,
+		eventBus:             eventBus,
+		reportedServiceUuids: map[service.ServiceUUID]bool{},
+	}
+}
+
+// Run checks the services periodically until the context is cancelled
+func (watcher *ServiceCrashWatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(serviceCrashCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			services, err := watcher.services.GetServices(ctx)
+			if err != nil {
+				logrus.Warnf("An error occurred getting the services to check whether any crashed; will retry later. Error was:\n%v", err)
+				continue
+			}
+			for _, crashedService := range watcher.getNewlyCrashedServices(services) {
+				registration := crashedService.GetRegistration()
+				watcher.eventBus.Publish(NewServiceEvent(EnclaveEventType_ServiceCrashed, string(registration.GetName()), string(registration.GetUUID())))
+			}
+		}
+	}
+}
+
+func (watcher *ServiceCrashWatcher) getNewlyCrashedServices(services map[service.ServiceUUID]*service.Service) []*service.Service {
+	newlyCrashedServices := []*service.Service{}
+	for serviceUuid, serviceObj := range services {
+		if !isServiceCrashed(serviceObj) {
+			delete(watcher.reportedServiceUuids, serviceUuid)
+			continue
+		}
+		if watcher.reportedServiceUuids[serviceUuid] {
+			continue
+		}
+		watcher.reportedServiceUuids[serviceUuid] = true
+		newlyCrashedServices = append(newlyCrashedServices, serviceObj)
+	}
+	// services that were removed can't crash again
+	for serviceUuid := range watcher.reportedServiceUuids {
+		if _, found := services[serviceUuid]; !found {
+			delete(watcher.reportedServiceUuids, serviceUuid)
+		}
+	}
+	return newlyCrashedServices
+}
+
+func isServiceCrashed(serviceObj *service.Service) bool {
+	if serviceObj.GetRegistration().GetStatus() != service.ServiceStatus_Started {
+		return false
+	}
+	serviceContainer := serviceObj.GetContainer()
+	return serviceContainer != nil && serviceContainer.GetStatus() != container.ContainerStatus_Running
+}
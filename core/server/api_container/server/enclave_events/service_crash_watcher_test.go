@@ -0,0 +1,49 @@
+package enclave_events
+
+import (
+	"net"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testServiceName = service.ServiceName("db")
+	testServiceUuid = service.ServiceUUID("0123456789abcdef0123456789abcdef")
+	testEnclaveUuid = "fedcba9876543210fedcba9876543210"
+)
+
+func TestGetNewlyCrashedServices_ReportsCrashOnce(t *testing.T) {
+	watcher := NewServiceCrashWatcher(nil, NewEnclaveEventBus())
+
+	crashedServices := map[service.ServiceUUID]*service.Service{
+		testServiceUuid: newServiceForTest(service.ServiceStatus_Started, container.ContainerStatus_Stopped),
+	}
+	require.Len(t, watcher.getNewlyCrashedServices(crashedServices), 1)
+	require.Empty(t, watcher.getNewlyCrashedServices(crashedServices))
+
+	// once the container runs again, a new crash is reported again
+	runningServices := map[service.ServiceUUID]*service.Service{
+		testServiceUuid: newServiceForTest(service.ServiceStatus_Started, container.ContainerStatus_Running),
+	}
+	require.Empty(t, watcher.getNewlyCrashedServices(runningServices))
+	require.Len(t, watcher.getNewlyCrashedServices(crashedServices), 1)
+}
+
+func TestGetNewlyCrashedServices_IgnoresStoppedServices(t *testing.T) {
+	watcher := NewServiceCrashWatcher(nil, NewEnclaveEventBus())
+
+	stoppedServices := map[service.ServiceUUID]*service.Service{
+		testServiceUuid: newServiceForTest(service.ServiceStatus_Stopped, container.ContainerStatus_Stopped),
+	}
+	require.Empty(t, watcher.getNewlyCrashedServices(stoppedServices))
+}
+
+func newServiceForTest(serviceStatus service.ServiceStatus, containerStatus container.ContainerStatus) *service.Service {
+	registration := service.NewServiceRegistration(testServiceName, testServiceUuid, testEnclaveUuid, net.IP{}, string(testServiceName))
+	registration.SetStatus(serviceStatus)
+	serviceContainer := container.NewContainer(containerStatus, "postgres", nil, nil, nil)
+	return service.NewService(registration, nil, nil, nil, serviceContainer)
+}
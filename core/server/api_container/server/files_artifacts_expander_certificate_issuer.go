@@ -0,0 +1,65 @@
+package server
+
+import (
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/util/tls_credentials"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/kurtosis-tech/stacktrace"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	filesArtifactsExpanderCaCommonName = "Kurtosis files artifacts expander CA"
+
+	// The CA lives as long as the enclave; the certificates it issues are short-lived
+	filesArtifactsExpanderCaValidity = 10 * 365 * 24 * time.Hour
+)
+
+var (
+	filesArtifactsExpanderCaBucketName     = []byte("files-artifacts-expander-ca")
+	filesArtifactsExpanderCaCertificateKey = []byte("certificate")
+	filesArtifactsExpanderCaKeyKey         = []byte("key")
+)
+
+// GetOrCreateFilesArtifactsExpanderCertificateIssuer returns the CA the client certificates of the files artifacts
+// expanders of the enclave are signed with, creating it the first time. Only the API container of the enclave trusts
+// it, so the expanders' certificates can't be used to call the engine or other enclaves. It's kept in the enclave
+// database so that expanders started before the API container got restarted can still reach it
+func GetOrCreateFilesArtifactsExpanderCertificateIssuer(enclaveDb *enclave_db.EnclaveDB) (*tls_credentials.CertificateIssuer, error) {
+	var certificateIssuer *tls_credentials.CertificateIssuer
+	if err := enclaveDb.Update(func(tx *bolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists(filesArtifactsExpanderCaBucketName)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred creating the files artifacts expander CA bucket")
+		}
+		existingCaCertificate := bucket.Get(filesArtifactsExpanderCaCertificateKey)
+		existingCaKey := bucket.Get(filesArtifactsExpanderCaKeyKey)
+		if existingCaCertificate != nil && existingCaKey != nil {
+			certificateIssuer, err = tls_credentials.NewCertificateIssuer(existingCaCertificate, existingCaKey)
+			if err != nil {
+				return stacktrace.Propagate(err, "An error occurred reading the files artifacts expander CA")
+			}
+			return nil
+		}
+
+		certificateIssuer, err = tls_credentials.NewSelfSignedCertificateIssuer(filesArtifactsExpanderCaCommonName, filesArtifactsExpanderCaValidity)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred generating the files artifacts expander CA")
+		}
+		caKey, err := certificateIssuer.GetCaKeyPem()
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred serializing the files artifacts expander CA key")
+		}
+		if err := bucket.Put(filesArtifactsExpanderCaCertificateKey, certificateIssuer.GetCaCertificatePem()); err != nil {
+			return stacktrace.Propagate(err, "An error occurred saving the files artifacts expander CA certificate")
+		}
+		if err := bucket.Put(filesArtifactsExpanderCaKeyKey, caKey); err != nil {
+			return stacktrace.Propagate(err, "An error occurred saving the files artifacts expander CA key")
+		}
+		return nil
+	}); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the files artifacts expander CA from the enclave db")
+	}
+	return certificateIssuer, nil
+}
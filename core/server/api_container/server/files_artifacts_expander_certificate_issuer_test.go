@@ -0,0 +1,32 @@
+package server
+
+import (
+	"os"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/stretchr/testify/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	expanderCaTestDbFilePerm = 0666
+)
+
+func TestGetOrCreateFilesArtifactsExpanderCertificateIssuer_KeepsTheSameCa(t *testing.T) {
+	file, err := os.CreateTemp("", "*.db")
+	require.NoError(t, err)
+	defer os.Remove(file.Name())
+	db, err := bolt.Open(file.Name(), expanderCaTestDbFilePerm, nil)
+	require.NoError(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	certificateIssuer, err := GetOrCreateFilesArtifactsExpanderCertificateIssuer(enclaveDb)
+	require.NoError(t, err)
+
+	// The API container getting restarted must keep trusting the certificates the expanders already got
+	reloadedCertificateIssuer, err := GetOrCreateFilesArtifactsExpanderCertificateIssuer(enclaveDb)
+	require.NoError(t, err)
+	require.Equal(t, certificateIssuer.GetCaCertificatePem(), reloadedCertificateIssuer.GetCaCertificatePem())
+}
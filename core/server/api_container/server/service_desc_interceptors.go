@@ -0,0 +1,83 @@
+package server
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+// WithInterceptors returns a copy of the service description whose handlers go through the given interceptors, in the
+// given order, before the ones of the gRPC server. The minimal gRPC server the API container runs doesn't take
+// interceptors of its own, so this is how calls get intercepted
+func WithInterceptors(
+	serviceDesc *grpc.ServiceDesc,
+	unaryInterceptors []grpc.UnaryServerInterceptor,
+	streamInterceptors []grpc.StreamServerInterceptor,
+) *grpc.ServiceDesc {
+	result := *serviceDesc
+
+	result.Methods = make([]grpc.MethodDesc, len(serviceDesc.Methods))
+	for idx, methodDesc := range serviceDesc.Methods {
+		originalHandler := methodDesc.Handler
+		methodDesc.Handler = func(srv interface{}, ctx context.Context, dec func(interface{}) error, serverInterceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+			// The generated handlers skip the interceptor, and the server info along with it, when none is given, so one
+			// is always passed down
+			chainedInterceptor := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+				return runUnaryInterceptors(ctx, req, info, unaryInterceptors, func(ctx context.Context, req interface{}) (interface{}, error) {
+					if serverInterceptor == nil {
+						return handler(ctx, req)
+					}
+					return serverInterceptor(ctx, req, info, handler)
+				})
+			}
+			return originalHandler(srv, ctx, dec, chainedInterceptor)
+		}
+		result.Methods[idx] = methodDesc
+	}
+
+	result.Streams = make([]grpc.StreamDesc, len(serviceDesc.Streams))
+	for idx, streamDesc := range serviceDesc.Streams {
+		originalHandler := streamDesc.Handler
+		info := &grpc.StreamServerInfo{
+			FullMethod:     "/" + serviceDesc.ServiceName + "/" + streamDesc.StreamName,
+			IsClientStream: streamDesc.ClientStreams,
+			IsServerStream: streamDesc.ServerStreams,
+		}
+		streamDesc.Handler = func(srv interface{}, stream grpc.ServerStream) error {
+			return runStreamInterceptors(srv, stream, info, streamInterceptors, originalHandler)
+		}
+		result.Streams[idx] = streamDesc
+	}
+
+	return &result
+}
+
+func runUnaryInterceptors(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	unaryInterceptors []grpc.UnaryServerInterceptor,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if len(unaryInterceptors) == 0 {
+		return handler(ctx, req)
+	}
+	return unaryInterceptors[0](ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return runUnaryInterceptors(ctx, req, info, unaryInterceptors[1:], handler)
+	})
+}
+
+func runStreamInterceptors(
+	srv interface{},
+	stream grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	streamInterceptors []grpc.StreamServerInterceptor,
+	handler grpc.StreamHandler,
+) error {
+	if len(streamInterceptors) == 0 {
+		return handler(srv, stream)
+	}
+	return streamInterceptors[0](srv, stream, info, func(srv interface{}, stream grpc.ServerStream) error {
+		return runStreamInterceptors(srv, stream, info, streamInterceptors[1:], handler)
+	})
+}
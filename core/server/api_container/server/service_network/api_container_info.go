@@ -1,6 +1,21 @@
 package service_network
 
-import "net"
+import (
+	"net"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/util/tls_credentials"
+	"github.com/kurtosis-tech/kurtosis/core/files_artifacts_expander/args"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	filesArtifactsExpanderCertificateCommonName = "kurtosis-files-artifacts-expander"
+
+	// The expanders only run while their service starts, and their certificates get issued again every time services
+	// are started, so they don't need to last long
+	filesArtifactsExpanderCertificateValidity = 24 * time.Hour
+)
 
 type ApiContainerInfo struct {
 	ipAddress net.IP
@@ -8,17 +23,41 @@ type ApiContainerInfo struct {
 	grpcPortNum uint16
 
 	version string
+
+	// CA the files artifacts expanders verify the API container against; empty if it serves plaintext
+	caCertificate string
+
+	// Signs the client certificates of the files artifacts expanders; nil unless the API container requires client
+	// certificates. Only this API container trusts it
+	expanderCertificateIssuer *tls_credentials.CertificateIssuer
+
+	// What the files artifacts expanders authenticate to the API container with; empty if it doesn't authenticate callers
+	expanderApiToken string
 }
 
 func NewApiContainerInfo(
 	ipAddress net.IP,
 	grpcPortNum uint16,
 	version string,
+) *ApiContainerInfo {
+	return NewApiContainerInfoWithExpanderCredentials(ipAddress, grpcPortNum, version, "", nil, "")
+}
+
+func NewApiContainerInfoWithExpanderCredentials(
+	ipAddress net.IP,
+	grpcPortNum uint16,
+	version string,
+	caCertificate string,
+	expanderCertificateIssuer *tls_credentials.CertificateIssuer,
+	expanderApiToken string,
 ) *ApiContainerInfo {
 	return &ApiContainerInfo{
-		ipAddress:   ipAddress,
-		grpcPortNum: grpcPortNum,
-		version:     version,
+		ipAddress:                 ipAddress,
+		grpcPortNum:               grpcPortNum,
+		version:                   version,
+		caCertificate:             caCertificate,
+		expanderCertificateIssuer: expanderCertificateIssuer,
+		expanderApiToken:          expanderApiToken,
 	}
 }
 
@@ -33,3 +72,30 @@ func (apic *ApiContainerInfo) GetGrpcPortNum() uint16 {
 func (apic *ApiContainerInfo) GetVersion() string {
 	return apic.version
 }
+
+// GetExpanderTlsConfig returns what a files artifacts expander needs to reach the API container, or nil if it serves
+// plaintext. If the API container requires client certificates, each call issues a new short-lived one
+func (apic *ApiContainerInfo) GetExpanderTlsConfig() (*args.ApiContainerTlsConfig, error) {
+	if apic.caCertificate == "" {
+		return nil, nil
+	}
+	expanderTlsConfig := &args.ApiContainerTlsConfig{
+		CaCertificate:     apic.caCertificate,
+		ClientCertificate: "",
+		ClientKey:         "",
+	}
+	if apic.expanderCertificateIssuer == nil {
+		return expanderTlsConfig, nil
+	}
+	clientCertificate, clientKey, err := apic.expanderCertificateIssuer.IssueClientCertificate(filesArtifactsExpanderCertificateCommonName, filesArtifactsExpanderCertificateValidity)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred issuing a files artifacts expander client certificate")
+	}
+	expanderTlsConfig.ClientCertificate = string(clientCertificate)
+	expanderTlsConfig.ClientKey = string(clientKey)
+	return expanderTlsConfig, nil
+}
+
+func (apic *ApiContainerInfo) GetExpanderApiToken() string {
+	return apic.expanderApiToken
+}
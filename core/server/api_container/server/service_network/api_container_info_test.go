@@ -0,0 +1,59 @@
+package service_network
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/util/tls_credentials"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	apiContainerInfoTestCaCertificate = "ca-certificate"
+	apiContainerInfoTestCaValidity    = 24 * time.Hour
+)
+
+func TestGetExpanderTlsConfig_PlaintextAndServerOnlyTls(t *testing.T) {
+	plaintextApiContainerInfo := NewApiContainerInfo(net.IPv4(1, 2, 3, 4), 1234, "version")
+	expanderTlsConfig, err := plaintextApiContainerInfo.GetExpanderTlsConfig()
+	require.NoError(t, err)
+	require.Nil(t, expanderTlsConfig)
+
+	tlsApiContainerInfo := NewApiContainerInfoWithExpanderCredentials(net.IPv4(1, 2, 3, 4), 1234, "version", apiContainerInfoTestCaCertificate, nil, "")
+	expanderTlsConfig, err = tlsApiContainerInfo.GetExpanderTlsConfig()
+	require.NoError(t, err)
+	require.Equal(t, apiContainerInfoTestCaCertificate, expanderTlsConfig.CaCertificate)
+	require.Empty(t, expanderTlsConfig.ClientCertificate)
+	require.Empty(t, expanderTlsConfig.ClientKey)
+}
+
+func TestGetExpanderTlsConfig_IssuesShortLivedClientCertificates(t *testing.T) {
+	certificateIssuer, err := tls_credentials.NewSelfSignedCertificateIssuer("expander CA", apiContainerInfoTestCaValidity)
+	require.NoError(t, err)
+	apiContainerInfo := NewApiContainerInfoWithExpanderCredentials(net.IPv4(1, 2, 3, 4), 1234, "version", apiContainerInfoTestCaCertificate, certificateIssuer, "")
+
+	expanderTlsConfig, err := apiContainerInfo.GetExpanderTlsConfig()
+	require.NoError(t, err)
+	require.Equal(t, apiContainerInfoTestCaCertificate, expanderTlsConfig.CaCertificate)
+	clientKeyPair, err := tls.X509KeyPair([]byte(expanderTlsConfig.ClientCertificate), []byte(expanderTlsConfig.ClientKey))
+	require.NoError(t, err)
+	clientCertificate, err := x509.ParseCertificate(clientKeyPair.Certificate[0])
+	require.NoError(t, err)
+
+	expanderCaPool, err := tls_credentials.NewCertPool(certificateIssuer.GetCaCertificatePem())
+	require.NoError(t, err)
+	_, err = clientCertificate.Verify(x509.VerifyOptions{
+		Roots:     expanderCaPool,
+		KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	require.NoError(t, err)
+	require.WithinDuration(t, time.Now().Add(filesArtifactsExpanderCertificateValidity), clientCertificate.NotAfter, time.Minute)
+
+	// Every call gets a certificate of its own
+	otherExpanderTlsConfig, err := apiContainerInfo.GetExpanderTlsConfig()
+	require.NoError(t, err)
+	require.NotEqual(t, expanderTlsConfig.ClientKey, otherExpanderTlsConfig.ClientKey)
+}
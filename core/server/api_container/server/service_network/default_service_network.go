@@ -20,14 +20,19 @@ import (
 	"time"
 
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/interactive_exec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db/service_registration"
+	expander_args "github.com/kurtosis-tech/kurtosis/core/files_artifacts_expander/args"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/enclave_events"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/render_templates"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_identifiers"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/secret_store"
 	path_compression "github.com/kurtosis-tech/kurtosis/path-compression"
 
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_registry_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
@@ -55,6 +60,9 @@ const (
 
 	publicPortsSuffix = "-public"
 
+	execOutputLineSeparator  = "\n"
+	execOutputCarriageReturn = "\r"
+
 	serviceLogsHeader = "== SERVICE '%s' LOGS ==================================="
 	serviceLogsFooter = "== FINISHED SERVICE '%s' LOGS ==================================="
 
@@ -85,6 +93,16 @@ type DefaultServiceNetwork struct {
 	// This contains all service identifiers ever successfully created
 	serviceIdentifiersRepository *service_identifiers.ServiceIdentifiersRepository
 	serviceIdentifiersMutex      *sync.Mutex // VERY IMPORTANT TO CHECK AT THE START OF EVERY METHOD!
+
+	// Services added, started and stopped, and files artifacts created, are published here
+	eventBus *enclave_events.EnclaveEventBus
+
+	// Makes checking whether a persistent directory exists and seeding it atomic across services starting in parallel
+	persistentDirectorySeedingMutex *sync.Mutex
+
+	// Resolves the secrets referenced by the service configs when they're handed to the backend. Service configs are
+	// registered with the secret placeholders, so that secret values never end up in the enclave db
+	secretStore *secret_store.SecretStore
 }
 
 func NewDefaultServiceNetwork(
@@ -93,6 +111,8 @@ func NewDefaultServiceNetwork(
 	kurtosisBackend backend_interface.KurtosisBackend,
 	enclaveDataDir *enclave_data_directory.EnclaveDataDirectory,
 	enclaveDb *enclave_db.EnclaveDB,
+	eventBus *enclave_events.EnclaveEventBus,
+	secretStore *secret_store.SecretStore,
 ) (*DefaultServiceNetwork, error) {
 	serviceIdentifiersRepository, err := service_identifiers.GetOrCreateNewServiceIdentifiersRepository(enclaveDb)
 	if err != nil {
@@ -115,6 +135,10 @@ func NewDefaultServiceNetwork(
 		serviceRegistrationMutex:      &sync.Mutex{},
 		serviceIdentifiersRepository:  serviceIdentifiersRepository,
 		serviceIdentifiersMutex:       &sync.Mutex{},
+		eventBus:                      eventBus,
+
+		persistentDirectorySeedingMutex: &sync.Mutex{},
+		secretStore:                     secretStore,
 	}, nil
 }
 
@@ -171,30 +195,42 @@ func (network *DefaultServiceNetwork) AddServices(
 		return startedServices, failedServices, nil
 	}
 
-	// Save the services currently running in enclave for later
-	network.serviceRegistrationMutex.Lock()
-	currentlyRunningServicesInEnclave := map[service.ServiceName]bool{}
-	allServiceNamesFromServiceRegistrations, err := network.serviceRegistrationRepository.GetAllServiceNames()
-	if err != nil {
-		return nil, nil, stacktrace.Propagate(err, "An error occurred getting all service names from service registration repository")
-	}
-	for serviceName := range allServiceNamesFromServiceRegistrations {
-		currentlyRunningServicesInEnclave[serviceName] = true
-	}
-	network.serviceRegistrationMutex.Unlock()
-
-	// We register all the services one by one
+	// The quota is checked and the services registered under the same lock so that concurrent calls can't each see
+	// room for their own services and overshoot the quota together
 	serviceSuccessfullyRegistered := map[service.ServiceName]*service.ServiceRegistration{}
 	servicesToStart := map[service.ServiceUUID]*service.ServiceConfig{}
-	for serviceName, serviceConfig := range serviceConfigs {
+	err := func() error {
+		network.serviceRegistrationMutex.Lock()
+		defer network.serviceRegistrationMutex.Unlock()
 
-		serviceRegistration, err := network.registerService(ctx, serviceName)
+		// Save the services currently running in enclave for later
+		currentlyRunningServicesInEnclave := map[service.ServiceName]bool{}
+		allServiceNamesFromServiceRegistrations, err := network.serviceRegistrationRepository.GetAllServiceNames()
 		if err != nil {
-			failedServices[serviceName] = stacktrace.Propagate(err, "Failed registering service with name: '%s'", serviceName)
-			continue
+			return stacktrace.Propagate(err, "An error occurred getting all service names from service registration repository")
+		}
+		for serviceName := range allServiceNamesFromServiceRegistrations {
+			currentlyRunningServicesInEnclave[serviceName] = true
 		}
-		serviceSuccessfullyRegistered[serviceName] = serviceRegistration
-		servicesToStart[serviceRegistration.GetUUID()] = serviceConfig
+		if err := network.checkEnclaveResourceQuotaWithoutMutex(ctx, serviceConfigs); err != nil {
+			return stacktrace.Propagate(err, "Adding the requested services would exceed the resource quota of the enclave")
+		}
+
+		// We register all the services one by one
+		for serviceName, serviceConfig := range serviceConfigs {
+
+			serviceRegistration, err := network.registerService(ctx, serviceName)
+			if err != nil {
+				failedServices[serviceName] = stacktrace.Propagate(err, "Failed registering service with name: '%s'", serviceName)
+				continue
+			}
+			serviceSuccessfullyRegistered[serviceName] = serviceRegistration
+			servicesToStart[serviceRegistration.GetUUID()] = serviceConfig
+		}
+		return nil
+	}()
+	if err != nil {
+		return nil, nil, err
 	}
 	defer func() {
 		if batchSuccessfullyStarted {
@@ -277,6 +313,9 @@ func (network *DefaultServiceNetwork) AddServices(
 	}
 
 	batchSuccessfullyStarted = true
+	for serviceName, startedService := range startedServices {
+		network.eventBus.Publish(enclave_events.NewServiceEvent(enclave_events.EnclaveEventType_ServiceAdded, string(serviceName), string(startedService.GetRegistration().GetUUID())))
+	}
 	return startedServices, map[service.ServiceName]error{}, nil
 }
 
@@ -312,6 +351,17 @@ func (network *DefaultServiceNetwork) UpdateServices(ctx context.Context, update
 		return successfullyUpdatedService, failedServicesPool, nil
 	}
 
+	// The services are counted with their new config before any of them gets removed, under the same lock as when
+	// adding services so that updates and additions running concurrently can't overshoot the quota together
+	err := func() error {
+		network.serviceRegistrationMutex.Lock()
+		defer network.serviceRegistrationMutex.Unlock()
+		return network.checkEnclaveResourceQuotaWithoutMutex(ctx, updateServiceConfigs)
+	}()
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "Updating the requested services would exceed the resource quota of the enclave")
+	}
+
 	// First, remove the service
 	serviceUuidToNameMap := map[service.ServiceUUID]service.ServiceName{}
 	serviceUuidsToRemove := map[service.ServiceUUID]bool{}
@@ -482,7 +532,15 @@ func (network *DefaultServiceNetwork) StartServices(
 	}
 
 	for serviceUuid, serviceRegistration := range serviceRegistrations {
-		serviceConfigs[serviceUuid] = serviceRegistration.GetConfig()
+		serviceConfig := serviceRegistration.GetConfig()
+		if err := network.reissueFilesArtifactsExpanderCertificate(serviceConfig); err != nil {
+			return nil, nil, stacktrace.Propagate(err, "An error occurred issuing a new files artifacts expander certificate for service '%v'", serviceRegistration.GetName())
+		}
+		serviceConfigWithSecrets, err := network.resolveSecrets(serviceConfig)
+		if err != nil {
+			return nil, nil, stacktrace.Propagate(err, "An error occurred resolving the secrets referenced by the config of service '%v'", serviceRegistration.GetName())
+		}
+		serviceConfigs[serviceUuid] = serviceConfigWithSecrets
 	}
 
 	successfulServices, failedServices, err := network.kurtosisBackend.StartRegisteredUserServices(ctx, network.enclaveUuid, serviceConfigs)
@@ -498,6 +556,7 @@ func (network *DefaultServiceNetwork) StartServices(
 			continue
 		}
 		successfulUuids[successfulUuid] = true
+		network.eventBus.Publish(enclave_events.NewServiceEvent(enclave_events.EnclaveEventType_ServiceStarted, string(serviceName), string(successfulUuid)))
 	}
 
 	for erroredUuid, err := range failedServices {
@@ -570,6 +629,7 @@ func (network *DefaultServiceNetwork) StopServices(
 			delete(successfulUuids, successfulUuid)
 			continue
 		}
+		network.eventBus.Publish(enclave_events.NewServiceEvent(enclave_events.EnclaveEventType_ServiceStopped, string(serviceName), string(successfulUuid)))
 	}
 
 	return successfulUuids, erroredUuids, nil
@@ -616,6 +676,59 @@ func (network *DefaultServiceNetwork) RunExec(ctx context.Context, serviceIdenti
 		userServiceCommand, serviceIdentifier, serviceUuid)
 }
 
+// RunExecWithStreamedOutput runs the command like RunExec, but passes each line of its output to outputLineConsumer as
+// soon as it is produced. The returned result contains the full output
+func (network *DefaultServiceNetwork) RunExecWithStreamedOutput(ctx context.Context, serviceIdentifier string, userServiceCommand []string, outputLineConsumer func(outputLine string)) (*exec_result.ExecResult, error) {
+	serviceRegistration, err := network.getServiceRegistrationForIdentifierLocked(serviceIdentifier)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while getting service registration for identifier '%v'", serviceIdentifier)
+	}
+
+	execOutputChan, finalExecResultChan, err := network.kurtosisBackend.RunUserServiceExecCommandWithStreamedOutput(
+		ctx, network.enclaveUuid, serviceRegistration.GetUUID(), userServiceCommand)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred running exec command '%v' against service '%v'", userServiceCommand, serviceIdentifier)
+	}
+
+	// the backends send chunks of output which aren't necessarily whole lines
+	output := strings.Builder{}
+	partialLine := ""
+	for outputChunk := range execOutputChan {
+		output.WriteString(outputChunk)
+		lines := strings.Split(partialLine+outputChunk, execOutputLineSeparator)
+		partialLine = lines[len(lines)-1]
+		for _, line := range lines[:len(lines)-1] {
+			outputLineConsumer(strings.TrimSuffix(line, execOutputCarriageReturn))
+		}
+	}
+	if partialLine != "" {
+		outputLineConsumer(strings.TrimSuffix(partialLine, execOutputCarriageReturn))
+	}
+
+	finalExecResult, found := <-finalExecResultChan
+	if !found {
+		// the backends send the error through the output channel when the command could not be run to completion
+		return nil, stacktrace.NewError("Exec command '%v' on service '%s' ended without returning an exit code. Its output was:\n%s",
+			userServiceCommand, serviceIdentifier, output.String())
+	}
+	return exec_result.NewExecResult(finalExecResult.GetExitCode(), output.String()), nil
+}
+
+// RunInteractiveExec runs the command in the service with the given streams attached, blocking until it exits
+func (network *DefaultServiceNetwork) RunInteractiveExec(ctx context.Context, serviceIdentifier string, userServiceCommand []string, execStreams *interactive_exec.InteractiveExecStreams) (int32, error) {
+	serviceRegistration, err := network.getServiceRegistrationForIdentifierLocked(serviceIdentifier)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred while getting service registration for identifier '%v'", serviceIdentifier)
+	}
+
+	exitCode, err := network.kurtosisBackend.RunUserServiceInteractiveExecCommand(
+		ctx, network.enclaveUuid, serviceRegistration.GetUUID(), userServiceCommand, execStreams)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred running interactive exec command '%v' against service '%v'", userServiceCommand, serviceIdentifier)
+	}
+	return exitCode, nil
+}
+
 func (network *DefaultServiceNetwork) RunExecs(ctx context.Context, userServiceCommands map[string][]string) (map[service.ServiceUUID]*exec_result.ExecResult, map[service.ServiceUUID]error, error) {
 	// NOTE: This will block all other operations while this command is running!!!! We might need to change this so it's
 	// asynchronous
@@ -752,13 +865,13 @@ func (network *DefaultServiceNetwork) GetServiceNames() (map[service.ServiceName
 	return serviceNames, nil
 }
 
-func (network *DefaultServiceNetwork) CopyFilesFromService(ctx context.Context, serviceIdentifier string, srcPath string, artifactName string) (enclave_data_directory.FilesArtifactUUID, error) {
+func (network *DefaultServiceNetwork) CopyFilesFromService(ctx context.Context, serviceIdentifier string, srcPath string, artifactName string, producer string) (enclave_data_directory.FilesArtifactUUID, error) {
 	serviceName, err := network.getServiceNameForIdentifierUnlocked(serviceIdentifier)
 	if err != nil {
 		return "", stacktrace.Propagate(err, "An error occurred while fetching name for service identifier '%v'", serviceIdentifier)
 	}
 
-	filesArtifactUuid, err := network.copyFilesFromServiceUnlocked(ctx, serviceName, srcPath, artifactName)
+	filesArtifactUuid, err := network.copyFilesFromServiceUnlocked(ctx, serviceName, srcPath, artifactName, producer)
 	if err != nil {
 		return "", stacktrace.Propagate(err, "There was an error in copying files over to disk")
 	}
@@ -777,24 +890,25 @@ func (network *DefaultServiceNetwork) ExistServiceRegistration(serviceName servi
 	return exist, nil
 }
 
-func (network *DefaultServiceNetwork) RenderTemplates(templatesAndDataByDestinationRelFilepath map[string]*render_templates.TemplateData, artifactName string) (enclave_data_directory.FilesArtifactUUID, error) {
-	filesArtifactUuid, err := network.renderTemplatesUnlocked(templatesAndDataByDestinationRelFilepath, artifactName)
+func (network *DefaultServiceNetwork) RenderTemplates(templatesAndDataByDestinationRelFilepath map[string]*render_templates.TemplateData, artifactName string, producer string) (enclave_data_directory.FilesArtifactUUID, error) {
+	filesArtifactUuid, err := network.renderTemplatesUnlocked(templatesAndDataByDestinationRelFilepath, artifactName, producer)
 	if err != nil {
 		return "", stacktrace.Propagate(err, "There was an error in rendering templates to disk")
 	}
 	return filesArtifactUuid, nil
 }
 
-func (network *DefaultServiceNetwork) UploadFilesArtifact(data io.Reader, contentMd5 []byte, artifactName string) (enclave_data_directory.FilesArtifactUUID, error) {
+func (network *DefaultServiceNetwork) UploadFilesArtifact(data io.Reader, contentMd5 []byte, artifactName string, producer string) (enclave_data_directory.FilesArtifactUUID, error) {
 	filesArtifactStore, err := network.enclaveDataDir.GetFilesArtifactStore()
 	if err != nil {
 		return "", stacktrace.Propagate(err, "An error occurred while getting files artifact store")
 	}
 
-	filesArtifactUuid, err := filesArtifactStore.StoreFile(data, contentMd5, artifactName)
+	filesArtifactUuid, err := filesArtifactStore.StoreFile(data, contentMd5, artifactName, producer)
 	if err != nil {
 		return "", stacktrace.Propagate(err, "An error occurred while trying to store files.")
 	}
+	network.eventBus.Publish(enclave_events.NewFilesArtifactCreatedEvent(artifactName, string(filesArtifactUuid)))
 	return filesArtifactUuid, nil
 }
 
@@ -803,20 +917,24 @@ func (network *DefaultServiceNetwork) GetFilesArtifactMd5(artifactName string) (
 	if err != nil {
 		return "", nil, false, stacktrace.Propagate(err, "An error occurred while getting files artifact store")
 	}
-	filesArtifactUuid, _, currentlyStoredFileContentHash, found, err := filesArtifactStore.GetFile(artifactName)
+	filesArtifactUuid, currentlyStoredFileContentHash, found, err := filesArtifactStore.GetFile(artifactName)
 	if err != nil {
 		return "", nil, false, stacktrace.Propagate(err, "An error occurred retrieving the files artifact from the store")
 	}
 	return filesArtifactUuid, currentlyStoredFileContentHash, found, nil
 }
 
-func (network *DefaultServiceNetwork) UpdateFilesArtifact(fileArtifactUuid enclave_data_directory.FilesArtifactUUID, updatedContent io.Reader, contentMd5 []byte) error {
+func (network *DefaultServiceNetwork) UpdateFilesArtifact(fileArtifactUuid enclave_data_directory.FilesArtifactUUID, updatedContent io.Reader, contentMd5 []byte, producer string) error {
 	filesArtifactStore, err := network.enclaveDataDir.GetFilesArtifactStore()
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred while getting files artifact store")
 	}
 
-	if err = filesArtifactStore.UpdateFile(fileArtifactUuid, updatedContent, contentMd5); err != nil {
+	pinnedVersions, err := network.getFilesArtifactVersionsPinnedByServices(filesArtifactStore, fileArtifactUuid)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the versions of files artifact '%v' pinned by services", fileArtifactUuid)
+	}
+	if err = filesArtifactStore.UpdateFile(fileArtifactUuid, updatedContent, contentMd5, producer, pinnedVersions); err != nil {
 		return stacktrace.Propagate(err, "An error occurred while trying to update a files artifact.")
 	}
 	return nil
@@ -847,11 +965,81 @@ func (network *DefaultServiceNetwork) GetEnclaveUuid() enclave.EnclaveUUID {
 	return network.enclaveUuid
 }
 
+func (network *DefaultServiceNetwork) GetEnclaveResourceQuota(ctx context.Context) (*enclave.EnclaveResourceQuota, error) {
+	// The quota is read from the backend every time since the engine sets it after the API container has started
+	enclaveFilters := &enclave.EnclaveFilters{
+		UUIDs: map[enclave.EnclaveUUID]bool{
+			network.enclaveUuid: true,
+		},
+		Statuses: nil,
+	}
+	enclaves, err := network.kurtosisBackend.GetEnclaves(ctx, enclaveFilters)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting enclave '%v' from the backend", network.enclaveUuid)
+	}
+	enclaveObj, found := enclaves[network.enclaveUuid]
+	if !found {
+		return nil, stacktrace.NewError("Enclave '%v' wasn't found in the backend", network.enclaveUuid)
+	}
+	return enclaveObj.GetResourceQuota(), nil
+}
+
+// checkEnclaveResourceQuotaWithoutMutex checks that the services of the enclave fit in its resource quota once the given
+// services are added, or updated if they already exist
+func (network *DefaultServiceNetwork) checkEnclaveResourceQuotaWithoutMutex(ctx context.Context, serviceConfigs map[service.ServiceName]*service.ServiceConfig) error {
+	serviceResourceClaims, err := network.getServiceResourceClaimsWithoutMutex()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the resources claimed by the services already in the enclave")
+	}
+	enclaveResourceQuota, err := network.GetEnclaveResourceQuota(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the resource quota of the enclave")
+	}
+	for serviceName, serviceConfig := range serviceConfigs {
+		serviceResourceClaims[serviceName] = NewServiceResourceClaim(serviceConfig)
+	}
+	return CheckEnclaveResourceQuota(enclaveResourceQuota, serviceResourceClaims)
+}
+
+func (network *DefaultServiceNetwork) GetServiceResourceClaims() (map[service.ServiceName]*ServiceResourceClaim, error) {
+	network.serviceRegistrationMutex.Lock()
+	defer network.serviceRegistrationMutex.Unlock()
+
+	return network.getServiceResourceClaimsWithoutMutex()
+}
+
+func (network *DefaultServiceNetwork) GetServiceConfig(serviceName service.ServiceName) (*service.ServiceConfig, error) {
+	network.serviceRegistrationMutex.Lock()
+	defer network.serviceRegistrationMutex.Unlock()
+
+	serviceRegistration, err := network.serviceRegistrationRepository.Get(serviceName)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the registration of service '%v'", serviceName)
+	}
+	serviceConfig := serviceRegistration.GetConfig()
+	if serviceConfig == nil {
+		return nil, stacktrace.NewError("Service '%v' is registered but has no config, which means it was never started", serviceName)
+	}
+	return serviceConfig, nil
+}
+
 // ====================================================================================================
 //
 //	Private helper methods
 //
 // ====================================================================================================
+func (network *DefaultServiceNetwork) getServiceResourceClaimsWithoutMutex() (map[service.ServiceName]*ServiceResourceClaim, error) {
+	serviceRegistrations, err := network.serviceRegistrationRepository.GetAll()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting all service registrations from the repository")
+	}
+	serviceResourceClaims := map[service.ServiceName]*ServiceResourceClaim{}
+	for serviceName, serviceRegistration := range serviceRegistrations {
+		serviceResourceClaims[serviceName] = NewServiceResourceClaim(serviceRegistration.GetConfig())
+	}
+	return serviceResourceClaims, nil
+}
+
 // registerService handles all the operations necessary to register a service before is can be started with
 // startRegisteredService. If something fails along the way, the function takes care of rolling back the previous
 // changes such that the enclave remains in the state before the call
@@ -952,6 +1140,132 @@ func (network *DefaultServiceNetwork) unregisterService(ctx context.Context, ser
 	return nil
 }
 
+// reissueFilesArtifactsExpanderCertificate gives the files artifacts expander of the service a new client certificate,
+// since the one issued when the service got added is short-lived
+func (network *DefaultServiceNetwork) reissueFilesArtifactsExpanderCertificate(serviceConfig *service.ServiceConfig) error {
+	filesArtifactsExpansion := serviceConfig.GetFilesArtifactsExpansion()
+	if filesArtifactsExpansion == nil {
+		return nil
+	}
+	expanderArgs, err := expander_args.GetArgsFromEnvVars(filesArtifactsExpansion.ExpanderEnvVars)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred reading the files artifacts expander args")
+	}
+	expanderTlsConfig, err := network.apiContainerInfo.GetExpanderTlsConfig()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the TLS config of the files artifacts expander")
+	}
+	expanderArgs.ApiContainerTls = expanderTlsConfig
+	expanderEnvVars, err := expander_args.GetEnvFromArgs(expanderArgs)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing the files artifacts expander args")
+	}
+	filesArtifactsExpansion.ExpanderEnvVars = expanderEnvVars
+	return nil
+}
+
+// resolveSecrets returns a copy of the service config with the secrets it references resolved, to be handed to the
+// backend. The config itself is left untouched, as it's the one that gets persisted
+func (network *DefaultServiceNetwork) resolveSecrets(serviceConfig *service.ServiceConfig) (*service.ServiceConfig, error) {
+	if network.secretStore == nil {
+		return serviceConfig, nil
+	}
+	serviceConfigWithSecrets := serviceConfig.Clone()
+
+	entrypointArgs, err := network.resolveSecretsInArgs(serviceConfig.GetEntrypointArgs())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred resolving the secrets in the entrypoint args")
+	}
+	serviceConfigWithSecrets.SetEntrypointArgs(entrypointArgs)
+	cmdArgs, err := network.resolveSecretsInArgs(serviceConfig.GetCmdArgs())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred resolving the secrets in the cmd args")
+	}
+	serviceConfigWithSecrets.SetCmdArgs(cmdArgs)
+	envVars, err := network.resolveSecretsInEnvVars(serviceConfig.GetEnvVars())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred resolving the secrets in the env vars")
+	}
+	serviceConfigWithSecrets.SetEnvVars(envVars)
+
+	if registrySpec := serviceConfig.GetImageRegistrySpec(); registrySpec != nil {
+		username, err := network.secretStore.ReplaceSecretsInString(registrySpec.GetUsername())
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred resolving the secrets in the username of the registry spec for image '%v'", registrySpec.GetImageName())
+		}
+		password, err := network.secretStore.ReplaceSecretsInString(registrySpec.GetPassword())
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred resolving the secrets in the password of the registry spec for image '%v'", registrySpec.GetImageName())
+		}
+		serviceConfigWithSecrets.SetImageRegistrySpec(image_registry_spec.NewImageRegistrySpec(registrySpec.GetImageName(), username, password, registrySpec.GetRegistryAddr()))
+	}
+
+	initContainers, err := network.resolveSecretsInAdditionalContainers(serviceConfig.GetInitContainers())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred resolving the secrets in the init containers")
+	}
+	serviceConfigWithSecrets.SetInitContainers(initContainers)
+	sidecars, err := network.resolveSecretsInAdditionalContainers(serviceConfig.GetSidecars())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred resolving the secrets in the sidecars")
+	}
+	serviceConfigWithSecrets.SetSidecars(sidecars)
+	return serviceConfigWithSecrets, nil
+}
+
+func (network *DefaultServiceNetwork) resolveSecretsInAdditionalContainers(containers []*service.AdditionalContainer) ([]*service.AdditionalContainer, error) {
+	if containers == nil {
+		return nil, nil
+	}
+	containersWithSecrets := make([]*service.AdditionalContainer, len(containers))
+	for idx, container := range containers {
+		entrypointArgs, err := network.resolveSecretsInArgs(container.GetEntrypointArgs())
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred resolving the secrets in the entrypoint args of container '%v'", container.GetName())
+		}
+		cmdArgs, err := network.resolveSecretsInArgs(container.GetCmdArgs())
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred resolving the secrets in the cmd args of container '%v'", container.GetName())
+		}
+		envVars, err := network.resolveSecretsInEnvVars(container.GetEnvVars())
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred resolving the secrets in the env vars of container '%v'", container.GetName())
+		}
+		containersWithSecrets[idx] = service.NewAdditionalContainer(container.GetName(), container.GetContainerImageName(), entrypointArgs, cmdArgs, envVars)
+	}
+	return containersWithSecrets, nil
+}
+
+func (network *DefaultServiceNetwork) resolveSecretsInArgs(args []string) ([]string, error) {
+	if args == nil {
+		return nil, nil
+	}
+	argsWithSecrets := make([]string, len(args))
+	for idx, arg := range args {
+		argWithSecrets, err := network.secretStore.ReplaceSecretsInString(arg)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred resolving the secrets in arg #%d", idx)
+		}
+		argsWithSecrets[idx] = argWithSecrets
+	}
+	return argsWithSecrets, nil
+}
+
+func (network *DefaultServiceNetwork) resolveSecretsInEnvVars(envVars map[string]string) (map[string]string, error) {
+	if envVars == nil {
+		return nil, nil
+	}
+	envVarsWithSecrets := make(map[string]string, len(envVars))
+	for envVarName, envVarValue := range envVars {
+		envVarValueWithSecrets, err := network.secretStore.ReplaceSecretsInString(envVarValue)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred resolving the secrets in env var '%v'", envVarName)
+		}
+		envVarsWithSecrets[envVarName] = envVarValueWithSecrets
+	}
+	return envVarsWithSecrets, nil
+}
+
 // startRegisteredService handles the logistic of starting a service in the relevant Kurtosis backend:
 func (network *DefaultServiceNetwork) startRegisteredService(
 	ctx context.Context,
@@ -969,9 +1283,18 @@ func (network *DefaultServiceNetwork) startRegisteredService(
 		return nil, stacktrace.NewError("Memory allocation, `%d`, is too low. Kurtosis requires the memory limit to be at least `%d` megabytes for service with UUID '%v'.", serviceConfig.GetMemoryAllocationMegabytes(), minMemoryLimit, serviceUuid)
 	}
 
+	if err := network.seedPersistentDirectories(ctx, serviceConfig); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred seeding the persistent directories of service with UUID '%v'", serviceUuid)
+	}
+
+	serviceConfigWithSecrets, err := network.resolveSecrets(serviceConfig)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred resolving the secrets referenced by the config of service with UUID '%v'", serviceUuid)
+	}
+
 	// TODO(gb): make the backend also handle starting service sequentially to simplify the logic there as well
 	serviceConfigMap := map[service.ServiceUUID]*service.ServiceConfig{
-		serviceUuid: serviceConfig,
+		serviceUuid: serviceConfigWithSecrets,
 	}
 	successfulServices, failedServices, err := network.kurtosisBackend.StartRegisteredUserServices(ctx, network.enclaveUuid, serviceConfigMap)
 	if err != nil {
@@ -1137,7 +1460,7 @@ func (network *DefaultServiceNetwork) startRegisteredServices(
 }
 
 // This method is not thread safe. Only call this from a method where there is a mutex lock on the network.
-func (network *DefaultServiceNetwork) copyFilesFromServiceUnlocked(ctx context.Context, serviceName service.ServiceName, srcPath string, artifactName string) (enclave_data_directory.FilesArtifactUUID, error) {
+func (network *DefaultServiceNetwork) copyFilesFromServiceUnlocked(ctx context.Context, serviceName service.ServiceName, srcPath string, artifactName string, producer string) (enclave_data_directory.FilesArtifactUUID, error) {
 
 	serviceRegistrationObj, err := network.serviceRegistrationRepository.Get(serviceName)
 	if err != nil {
@@ -1150,10 +1473,17 @@ func (network *DefaultServiceNetwork) copyFilesFromServiceUnlocked(ctx context.C
 		return "", stacktrace.Propagate(err, "An error occurred getting the files artifact store")
 	}
 
-	existingFilesArtifactUuid, _, _, filesArtifactAlreadyExists, err := store.GetFile(artifactName)
+	existingFilesArtifactUuid, _, filesArtifactAlreadyExists, err := store.GetFile(artifactName)
 	if err != nil {
 		return "", stacktrace.Propagate(err, "An unexpected error occurred checking for file artifact '%s' existence in the store", artifactName)
 	}
+	var pinnedVersions map[uint32]bool
+	if filesArtifactAlreadyExists {
+		pinnedVersions, err = network.getFilesArtifactVersionsPinnedByServices(store, existingFilesArtifactUuid)
+		if err != nil {
+			return "", stacktrace.Propagate(err, "An error occurred getting the versions of files artifact '%v' pinned by services", artifactName)
+		}
+	}
 
 	pipeReader, pipeWriter := io.Pipe()
 	defer pipeWriter.Close()
@@ -1172,9 +1502,9 @@ func (network *DefaultServiceNetwork) copyFilesFromServiceUnlocked(ctx context.C
 			filesArtifactUuid = existingFilesArtifactUuid
 			// we're not able to check file hash here b/c we don't get it from the CompressPath helper method
 			// TODO: Maybe compute the hash ad-hoc here, but it would require us to consume the reader
-			storeOrUpdateErr = store.UpdateFile(filesArtifactUuid, pipeReader, []byte{})
+			storeOrUpdateErr = store.UpdateFile(filesArtifactUuid, pipeReader, []byte{}, producer, pinnedVersions)
 		} else {
-			filesArtifactUuid, storeOrUpdateErr = store.StoreFile(pipeReader, []byte{}, artifactName)
+			filesArtifactUuid, storeOrUpdateErr = store.StoreFile(pipeReader, []byte{}, artifactName, producer)
 		}
 		storeFilesArtifactResultChan <- storeFilesArtifactResult{
 			err:               storeOrUpdateErr,
@@ -1196,6 +1526,9 @@ func (network *DefaultServiceNetwork) copyFilesFromServiceUnlocked(ctx context.C
 		)
 	}
 
+	if !filesArtifactAlreadyExists {
+		network.eventBus.Publish(enclave_events.NewFilesArtifactCreatedEvent(artifactName, string(storeFileResult.filesArtifactUuid)))
+	}
 	return storeFileResult.filesArtifactUuid, nil
 }
 
@@ -1218,8 +1551,41 @@ func (network *DefaultServiceNetwork) gzipAndPushTarredFileBytesToOutput(
 	return nil
 }
 
+// getFilesArtifactVersionsPinnedByServices returns the versions of the files artifact that the services of the enclave
+// mount through identifiers like 'my-config@v2', which must be kept however many versions get added
+func (network *DefaultServiceNetwork) getFilesArtifactVersionsPinnedByServices(store *enclave_data_directory.FilesArtifactStore, filesArtifactUuid enclave_data_directory.FilesArtifactUUID) (map[uint32]bool, error) {
+	serviceRegistrations, err := network.serviceRegistrationRepository.GetAll()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the service registrations")
+	}
+	pinnedVersions := map[uint32]bool{}
+	for _, serviceRegistration := range serviceRegistrations {
+		// services registered but not started yet don't have a config
+		if serviceRegistration.GetConfig() == nil || serviceRegistration.GetConfig().GetFilesArtifactsExpansion() == nil {
+			continue
+		}
+		filesArtifactsExpansion := serviceRegistration.GetConfig().GetFilesArtifactsExpansion()
+		for _, artifactIdentifiers := range filesArtifactsExpansion.ServiceDirpathsToArtifactIdentifiers {
+			for _, artifactIdentifier := range artifactIdentifiers {
+				baseArtifactIdentifier, pinnedVersion, isPinned := enclave_data_directory.SplitFilesArtifactVersionPin(artifactIdentifier)
+				if !isPinned {
+					continue
+				}
+				pinnedFilesArtifactUuid, _, found, err := store.GetFile(baseArtifactIdentifier)
+				if err != nil {
+					return nil, stacktrace.Propagate(err, "An error occurred getting files artifact '%v' mounted by service '%v'", baseArtifactIdentifier, serviceRegistration.GetName())
+				}
+				if found && pinnedFilesArtifactUuid == filesArtifactUuid {
+					pinnedVersions[pinnedVersion] = true
+				}
+			}
+		}
+	}
+	return pinnedVersions, nil
+}
+
 // This method is not thread safe. Only call this from a method where there is a mutex lock on the network.
-func (network *DefaultServiceNetwork) renderTemplatesUnlocked(templatesAndDataByDestinationRelFilepath map[string]*render_templates.TemplateData, artifactName string) (enclave_data_directory.FilesArtifactUUID, error) {
+func (network *DefaultServiceNetwork) renderTemplatesUnlocked(templatesAndDataByDestinationRelFilepath map[string]*render_templates.TemplateData, artifactName string, producer string) (enclave_data_directory.FilesArtifactUUID, error) {
 	tempDirForRenderedTemplates, err := os.MkdirTemp("", tempDirForRenderedTemplatesPrefix)
 	if err != nil {
 		return "", stacktrace.Propagate(err, "An error occurred while creating a temp dir for rendered templates '%v'", tempDirForRenderedTemplates)
@@ -1243,7 +1609,7 @@ func (network *DefaultServiceNetwork) renderTemplatesUnlocked(templatesAndDataBy
 	if err != nil {
 		return "", stacktrace.Propagate(err, "An error occurred while getting files artifact store")
 	}
-	existingFilesArtifactUuid, _, existingFilesArtifactMd5, found, err := store.GetFile(artifactName)
+	existingFilesArtifactUuid, existingFilesArtifactMd5, found, err := store.GetFile(artifactName)
 	if err != nil {
 		return "", stacktrace.Propagate(err, "An unexpected error occurred checking for file artifact '%s' existence in the store", artifactName)
 	}
@@ -1255,10 +1621,14 @@ func (network *DefaultServiceNetwork) renderTemplatesUnlocked(templatesAndDataBy
 		// If one of the md5 is empty, we can't really assume the files are equal so we fallback to updating them
 		// Otherwise we check the equality of their MD5 and if they are different we update them
 		if len(existingFilesArtifactMd5) == 0 || len(compressedFileMd5) == 0 || !bytes.Equal(existingFilesArtifactMd5, compressedFileMd5) {
-			storeOrUpdateErr = store.UpdateFile(existingFilesArtifactUuid, compressedFile, compressedFileMd5)
+			pinnedVersions, err := network.getFilesArtifactVersionsPinnedByServices(store, existingFilesArtifactUuid)
+			if err != nil {
+				return "", stacktrace.Propagate(err, "An error occurred getting the versions of files artifact '%v' pinned by services", artifactName)
+			}
+			storeOrUpdateErr = store.UpdateFile(existingFilesArtifactUuid, compressedFile, compressedFileMd5, producer, pinnedVersions)
 		}
 	} else {
-		filesArtifactUuid, storeOrUpdateErr = store.StoreFile(compressedFile, compressedFileMd5, artifactName)
+		filesArtifactUuid, storeOrUpdateErr = store.StoreFile(compressedFile, compressedFileMd5, artifactName, producer)
 	}
 	if storeOrUpdateErr != nil {
 		return "", stacktrace.Propagate(err, "An error occurred while storing the file '%v' in the files artifact store", compressedFile)
@@ -1273,6 +1643,9 @@ func (network *DefaultServiceNetwork) renderTemplatesUnlocked(templatesAndDataBy
 	}()
 
 	shouldDeleteFilesArtifact = false
+	if !found {
+		network.eventBus.Publish(enclave_events.NewFilesArtifactCreatedEvent(artifactName, string(filesArtifactUuid)))
+	}
 	return filesArtifactUuid, nil
 }
 
@@ -21,8 +21,11 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/enclave_events"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/secret_store"
 	"github.com/kurtosis-tech/kurtosis/core/server/commons/enclave_data_directory"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/pkg/errors"
@@ -84,10 +87,14 @@ func TestAddService_Successful(t *testing.T) {
 		backend,
 		unusedEnclaveDataDir,
 		enclaveDb,
+		enclave_events.NewEnclaveEventBus(),
+		nil,
 	)
 	require.Nil(t, err)
 
 	// The service is registered before being started
+	expectEnclaveResourceQuota(ctx, backend, nil)
+
 	backend.EXPECT().RegisterUserServices(
 		ctx,
 		enclaveName,
@@ -140,6 +147,88 @@ func TestAddService_Successful(t *testing.T) {
 	require.Len(t, allExistingAndHistoricalIdentifiers, 1)
 }
 
+func TestAddService_PersistsSecretPlaceholders(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	serviceInternalTestId := 1
+	serviceName := testServiceNameFromInt(serviceInternalTestId)
+	serviceUuid := testServiceUuidFromInt(serviceInternalTestId)
+	successfulServiceIp := testIpFromInt(serviceInternalTestId)
+	serviceRegistration := service.NewServiceRegistration(serviceName, serviceUuid, enclaveName, successfulServiceIp, string(serviceName))
+	serviceObj := service.NewService(serviceRegistration, map[string]*port_spec.PortSpec{}, successfulServiceIp, map[string]*port_spec.PortSpec{}, container.NewContainer(container.ContainerStatus_Running, testContainerImageName, nil, nil, nil))
+	serviceConfig := testServiceConfig(t, testContainerImageName)
+	serviceConfig.SetEnvVars(map[string]string{"PASSWORD": "{{kurtosis:secret:db-password}}"})
+	serviceConfig.SetSidecars([]*service.AdditionalContainer{
+		service.NewAdditionalContainer("backup", testContainerImageName, nil, []string{"--password={{kurtosis:secret:db-password}}"}, nil),
+	})
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	secretStore, err := secret_store.CreateSecretStore(enclaveDb, t.TempDir())
+	require.NoError(t, err)
+	require.NoError(t, secretStore.Set("db-password", "sup3r-s3cr3t"))
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		apiContainerInfo,
+		backend,
+		unusedEnclaveDataDir,
+		enclaveDb,
+		enclave_events.NewEnclaveEventBus(),
+		secretStore,
+	)
+	require.Nil(t, err)
+
+	expectEnclaveResourceQuota(ctx, backend, nil)
+
+	backend.EXPECT().RegisterUserServices(
+		ctx,
+		enclaveName,
+		map[service.ServiceName]bool{
+			serviceName: true,
+		},
+	).Times(1).Return(
+		map[service.ServiceName]*service.ServiceRegistration{
+			serviceName: serviceRegistration,
+		},
+		map[service.ServiceName]error{},
+		nil,
+	)
+
+	// The backend gets the secrets resolved
+	backend.EXPECT().StartRegisteredUserServices(
+		ctx,
+		enclaveName,
+		mock.MatchedBy(func(services map[service.ServiceUUID]*service.ServiceConfig) bool {
+			startedServiceConfig, foundService := services[serviceUuid]
+			return foundService &&
+				startedServiceConfig.GetEnvVars()["PASSWORD"] == "sup3r-s3cr3t" &&
+				startedServiceConfig.GetSidecars()[0].GetCmdArgs()[0] == "--password=sup3r-s3cr3t"
+		})).Times(1).Return(
+		map[service.ServiceUUID]*service.Service{
+			serviceUuid: serviceObj,
+		},
+		map[service.ServiceUUID]error{},
+		nil)
+
+	_, err = network.AddService(ctx, serviceName, serviceConfig)
+	require.Nil(t, err)
+
+	// While the registered config keeps the placeholders
+	registeredServiceConfig, err := network.GetServiceConfig(serviceName)
+	require.NoError(t, err)
+	require.Equal(t, "{{kurtosis:secret:db-password}}", registeredServiceConfig.GetEnvVars()["PASSWORD"])
+	require.Equal(t, "--password={{kurtosis:secret:db-password}}", registeredServiceConfig.GetSidecars()[0].GetCmdArgs()[0])
+	require.Equal(t, "{{kurtosis:secret:db-password}}", serviceConfig.GetEnvVars()["PASSWORD"])
+}
+
 func TestAddService_FailedToStart(t *testing.T) {
 	ctx := context.Background()
 	backend := backend_interface.NewMockKurtosisBackend(t)
@@ -165,10 +254,14 @@ func TestAddService_FailedToStart(t *testing.T) {
 		backend,
 		unusedEnclaveDataDir,
 		enclaveDb,
+		enclave_events.NewEnclaveEventBus(),
+		nil,
 	)
 	require.Nil(t, err)
 
 	// The service is registered before being started
+	expectEnclaveResourceQuota(ctx, backend, nil)
+
 	backend.EXPECT().RegisterUserServices(
 		ctx,
 		enclaveName,
@@ -259,12 +352,16 @@ func TestAddServices_Success(t *testing.T) {
 		backend,
 		unusedEnclaveDataDir,
 		enclaveDb,
+		enclave_events.NewEnclaveEventBus(),
+		nil,
 	)
 	require.Nil(t, err)
 
 	// Configure the mock to also be testing that the right functions are called along the way
 
 	// The services are registered one by one before being started
+	expectEnclaveResourceQuota(ctx, backend, nil)
+
 	backend.EXPECT().RegisterUserServices(
 		ctx,
 		enclaveName,
@@ -352,12 +449,16 @@ func TestAddServices_FailureRollsBackTheEntireBatch(t *testing.T) {
 		backend,
 		unusedEnclaveDataDir,
 		enclaveDb,
+		enclave_events.NewEnclaveEventBus(),
+		nil,
 	)
 	require.Nil(t, err)
 
 	// Configure the mock to also be testing that the right functions are called along the way
 
 	// The services are registered one by one before being started
+	expectEnclaveResourceQuota(ctx, backend, nil)
+
 	backend.EXPECT().RegisterUserServices(
 		ctx,
 		enclaveName,
@@ -503,9 +604,13 @@ func TestAddServices_FailedToRegisterService(t *testing.T) {
 		backend,
 		unusedEnclaveDataDir,
 		enclaveDb,
+		enclave_events.NewEnclaveEventBus(),
+		nil,
 	)
 	require.Nil(t, err)
 
+	expectEnclaveResourceQuota(ctx, backend, nil)
+
 	backend.EXPECT().RegisterUserServices(
 		ctx,
 		enclaveName,
@@ -532,6 +637,119 @@ func TestAddServices_FailedToRegisterService(t *testing.T) {
 	require.Len(t, failure, 1)
 }
 
+func TestAddServices_ExceedsResourceQuota(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		apiContainerInfo,
+		backend,
+		unusedEnclaveDataDir,
+		enclaveDb,
+		enclave_events.NewEnclaveEventBus(),
+		nil,
+	)
+	require.Nil(t, err)
+
+	// Nothing gets registered since the batch doesn't fit in the quota
+	expectEnclaveResourceQuota(ctx, backend, enclave.NewEnclaveResourceQuota(0, 0, 1, 0))
+
+	serviceConfigs := map[service.ServiceName]*service.ServiceConfig{
+		testServiceNameFromInt(1): testServiceConfig(t, testContainerImageName),
+		testServiceNameFromInt(2): testServiceConfig(t, testContainerImageName),
+	}
+	success, failure, err := network.AddServices(ctx, serviceConfigs, 1)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "quota allows at most 1")
+	require.Nil(t, success)
+	require.Nil(t, failure)
+}
+
+func TestAddServices_ConcurrentBatchesDontExceedResourceQuota(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		apiContainerInfo,
+		backend,
+		unusedEnclaveDataDir,
+		enclaveDb,
+		enclave_events.NewEnclaveEventBus(),
+		nil,
+	)
+	require.Nil(t, err)
+
+	// Both batches see the quota, but only the first one to take the lock gets registered and started
+	enclaveObj := enclave.NewEnclave(enclaveName, string(enclaveName), enclave.EnclaveStatus_Running, nil, false, nil, enclave.NewEnclaveResourceQuota(0, 0, 1, 0), "", nil, nil)
+	backend.EXPECT().GetEnclaves(ctx, mock.Anything).Times(2).Return(
+		map[enclave.EnclaveUUID]*enclave.Enclave{
+			enclaveName: enclaveObj,
+		},
+		nil,
+	)
+	backend.EXPECT().RegisterUserServices(ctx, enclaveName, mock.Anything).RunAndReturn(
+		func(_ context.Context, _ enclave.EnclaveUUID, serviceNames map[service.ServiceName]bool) (map[service.ServiceName]*service.ServiceRegistration, map[service.ServiceName]error, error) {
+			serviceRegistrations := map[service.ServiceName]*service.ServiceRegistration{}
+			for serviceName := range serviceNames {
+				serviceRegistrations[serviceName] = service.NewServiceRegistration(serviceName, service.ServiceUUID(serviceName), enclaveName, testIpFromInt(1), string(serviceName))
+			}
+			return serviceRegistrations, map[service.ServiceName]error{}, nil
+		},
+	).Times(1)
+	backend.EXPECT().StartRegisteredUserServices(ctx, enclaveName, mock.Anything).RunAndReturn(
+		func(_ context.Context, _ enclave.EnclaveUUID, serviceConfigs map[service.ServiceUUID]*service.ServiceConfig) (map[service.ServiceUUID]*service.Service, map[service.ServiceUUID]error, error) {
+			services := map[service.ServiceUUID]*service.Service{}
+			for serviceUuid := range serviceConfigs {
+				serviceRegistration := service.NewServiceRegistration(service.ServiceName(serviceUuid), serviceUuid, enclaveName, testIpFromInt(1), string(serviceUuid))
+				services[serviceUuid] = service.NewService(serviceRegistration, map[string]*port_spec.PortSpec{}, testIpFromInt(1), map[string]*port_spec.PortSpec{}, container.NewContainer(container.ContainerStatus_Running, testContainerImageName, nil, nil, nil))
+			}
+			return services, map[service.ServiceUUID]error{}, nil
+		},
+	).Times(1)
+
+	numBatches := 2
+	errs := make(chan error, numBatches)
+	for i := 1; i <= numBatches; i++ {
+		serviceConfigs := map[service.ServiceName]*service.ServiceConfig{
+			testServiceNameFromInt(i): testServiceConfig(t, testContainerImageName),
+		}
+		go func() {
+			_, _, err := network.AddServices(ctx, serviceConfigs, 1)
+			errs <- err
+		}()
+	}
+	var quotaErrs []error
+	for i := 0; i < numBatches; i++ {
+		if err := <-errs; err != nil {
+			quotaErrs = append(quotaErrs, err)
+		}
+	}
+	require.Len(t, quotaErrs, 1)
+	require.Contains(t, quotaErrs[0].Error(), "quota allows at most 1")
+
+	allServiceRegistrations, err := network.serviceRegistrationRepository.GetAll()
+	require.NoError(t, err)
+	require.Len(t, allServiceRegistrations, 1)
+}
+
 func TestStopService_Successful(t *testing.T) {
 	ctx := context.Background()
 	backend := backend_interface.NewMockKurtosisBackend(t)
@@ -557,6 +775,8 @@ func TestStopService_Successful(t *testing.T) {
 		backend,
 		unusedEnclaveDataDir,
 		enclaveDb,
+		enclave_events.NewEnclaveEventBus(),
+		nil,
 	)
 	require.Nil(t, err)
 
@@ -615,6 +835,8 @@ func TestStopService_StopUserServicesFailed(t *testing.T) {
 		backend,
 		unusedEnclaveDataDir,
 		enclaveDb,
+		enclave_events.NewEnclaveEventBus(),
+		nil,
 	)
 	require.Nil(t, err)
 	err = network.serviceRegistrationRepository.Save(serviceRegistration)
@@ -670,6 +892,8 @@ func TestStopService_ServiceAlreadyStopped(t *testing.T) {
 		backend,
 		unusedEnclaveDataDir,
 		enclaveDb,
+		enclave_events.NewEnclaveEventBus(),
+		nil,
 	)
 	require.Nil(t, err)
 	err = network.serviceRegistrationRepository.Save(serviceRegistration)
@@ -701,6 +925,62 @@ func TestStopService_ServiceAlreadyStopped(t *testing.T) {
 	require.Equal(t, serviceRegistrationAfterBeingStopped.GetStatus(), service.ServiceStatus_Stopped)
 }
 
+func TestRunExecWithStreamedOutput_SplitsOutputInLines(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	serviceInternalTestId := 1
+	serviceName := testServiceNameFromInt(serviceInternalTestId)
+	serviceUuid := testServiceUuidFromInt(serviceInternalTestId)
+	serviceRegistration := service.NewServiceRegistration(serviceName, serviceUuid, enclaveName, testIpFromInt(serviceInternalTestId), string(serviceName))
+	serviceRegistration.SetStatus(service.ServiceStatus_Started)
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		apiContainerInfo,
+		backend,
+		unusedEnclaveDataDir,
+		enclaveDb,
+		enclave_events.NewEnclaveEventBus(),
+		nil,
+	)
+	require.Nil(t, err)
+
+	err = network.serviceRegistrationRepository.Save(serviceRegistration)
+	require.NoError(t, err)
+
+	command := []string{"sh", "-c", "migrate"}
+	execOutputChan := make(chan string)
+	finalExecResultChan := make(chan *exec_result.ExecResult, 1)
+	backend.EXPECT().RunUserServiceExecCommandWithStreamedOutput(ctx, enclaveName, serviceUuid, command).Times(1).Return(execOutputChan, finalExecResultChan, nil)
+	go func() {
+		// chunks sent by the backends aren't necessarily whole lines
+		for _, outputChunk := range []string{"line 1\nli", "ne 2\r\n", "line 3"} {
+			execOutputChan <- outputChunk
+		}
+		close(execOutputChan)
+		finalExecResultChan <- exec_result.NewExecResult(3, "")
+		close(finalExecResultChan)
+	}()
+
+	var outputLines []string
+	execResult, err := network.RunExecWithStreamedOutput(ctx, string(serviceName), command, func(outputLine string) {
+		outputLines = append(outputLines, outputLine)
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"line 1", "line 2", "line 3"}, outputLines)
+	require.Equal(t, int32(3), execResult.GetExitCode())
+	require.Equal(t, "line 1\nline 2\r\nline 3", execResult.GetOutput())
+}
+
 func TestStartService_Successful(t *testing.T) {
 	ctx := context.Background()
 	backend := backend_interface.NewMockKurtosisBackend(t)
@@ -729,6 +1009,8 @@ func TestStartService_Successful(t *testing.T) {
 		backend,
 		unusedEnclaveDataDir,
 		enclaveDb,
+		enclave_events.NewEnclaveEventBus(),
+		nil,
 	)
 	require.Nil(t, err)
 	err = network.serviceRegistrationRepository.Save(serviceRegistration)
@@ -783,6 +1065,8 @@ func TestStartService_StartRegisteredUserServicesFailed(t *testing.T) {
 		backend,
 		unusedEnclaveDataDir,
 		enclaveDb,
+		enclave_events.NewEnclaveEventBus(),
+		nil,
 	)
 	require.Nil(t, err)
 	err = network.serviceRegistrationRepository.Save(serviceRegistration)
@@ -837,6 +1121,8 @@ func TestStartService_ServiceAlreadyStarted(t *testing.T) {
 		backend,
 		unusedEnclaveDataDir,
 		enclaveDb,
+		enclave_events.NewEnclaveEventBus(),
+		nil,
 	)
 	require.Nil(t, err)
 	err = network.serviceRegistrationRepository.Save(serviceRegistration)
@@ -882,6 +1168,8 @@ func TestUpdateService(t *testing.T) {
 		backend,
 		unusedEnclaveDataDir,
 		enclaveDb,
+		enclave_events.NewEnclaveEventBus(),
+		nil,
 	)
 	require.Nil(t, err)
 
@@ -940,6 +1228,9 @@ func TestUpdateService(t *testing.T) {
 	err = network.serviceRegistrationRepository.Save(failedToBeRecreatedServiceRegistration)
 	require.NoError(t, err)
 
+	// The quota is checked before anything gets removed
+	expectEnclaveResourceQuota(ctx, backend, nil)
+
 	// The service will be removed first
 	backend.EXPECT().RemoveRegisteredUserServiceProcesses(
 		ctx,
@@ -1028,6 +1319,58 @@ func TestUpdateService(t *testing.T) {
 	require.Nil(t, newFailedToBeRecreatedServiceRegistration.GetConfig())
 }
 
+func TestUpdateServices_ExceedsResourceQuota(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		apiContainerInfo,
+		backend,
+		unusedEnclaveDataDir,
+		enclaveDb,
+		enclave_events.NewEnclaveEventBus(),
+		nil,
+	)
+	require.Nil(t, err)
+
+	existingServiceIndex := 1
+	existingServiceRegistration := service.NewServiceRegistration(
+		testServiceNameFromInt(existingServiceIndex),
+		testServiceUuidFromInt(existingServiceIndex),
+		enclaveName,
+		testIpFromInt(existingServiceIndex),
+		testServiceHostnameFromInt(existingServiceIndex))
+	existingServiceRegistration.SetConfig(testServiceConfig(t, testContainerImageName))
+	existingServiceRegistration.SetStatus(service.ServiceStatus_Started)
+	require.NoError(t, network.serviceRegistrationRepository.Save(existingServiceRegistration))
+
+	// Nothing gets removed since the updated service doesn't fit in the quota
+	expectEnclaveResourceQuota(ctx, backend, enclave.NewEnclaveResourceQuota(500, 0, 0, 0))
+
+	updatedServiceConfig := testServiceConfig(t, "kurtosistech/new-service-image")
+	updatedServiceConfig.SetMinCPUAllocationMillicpus(600)
+	success, failure, err := network.UpdateServices(ctx, map[service.ServiceName]*service.ServiceConfig{
+		existingServiceRegistration.GetName(): updatedServiceConfig,
+	}, 1)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "600 millicores")
+	require.Nil(t, success)
+	require.Nil(t, failure)
+
+	serviceRegistration, err := network.serviceRegistrationRepository.Get(existingServiceRegistration.GetName())
+	require.NoError(t, err)
+	require.Equal(t, service.ServiceStatus_Started, serviceRegistration.GetStatus())
+}
+
 func TestScanPort(t *testing.T) {
 	localhost := net.ParseIP(localhostIPAddrStr)
 
@@ -1204,6 +1547,16 @@ func openFreeTCPAndUDPLocalHostPortAddressesForTesting() (*netip.AddrPort, *neti
 	return &tcpAddressPort, &udpAddressPort, closeBothListenersFunc, nil
 }
 
+func expectEnclaveResourceQuota(ctx context.Context, backend *backend_interface.MockKurtosisBackend, resourceQuota *enclave.EnclaveResourceQuota) {
+	enclaveObj := enclave.NewEnclave(enclaveName, string(enclaveName), enclave.EnclaveStatus_Running, nil, false, nil, resourceQuota, "", nil, nil)
+	backend.EXPECT().GetEnclaves(ctx, mock.Anything).Times(1).Return(
+		map[enclave.EnclaveUUID]*enclave.Enclave{
+			enclaveName: enclaveObj,
+		},
+		nil,
+	)
+}
+
 func testServiceConfig(t *testing.T, imageName string) *service.ServiceConfig {
 	serviceConfig, err := service.CreateServiceConfig(imageName, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, 0, 0, "", 0, 0, map[string]string{}, nil, nil, map[string]string{}, image_download_mode.ImageDownloadMode_Missing, true, false, []string{}, false, service.NewGpuConfig(0, nil, 0, nil, "", ""))
 	require.NoError(t, err)
@@ -0,0 +1,127 @@
+package service_network
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/compute_resources"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	// Same factor the Kubernetes backend uses to turn megabytes into the bytes it hands to Kubernetes
+	bytesInMegabyte = 1_000_000
+)
+
+// ServiceResourceClaim is what a single service counts against the resource quota of its enclave
+type ServiceResourceClaim struct {
+	cpuMilliCores compute_resources.CpuMilliCores
+
+	memoryMegaBytes compute_resources.MemoryInMegaBytes
+
+	// Keyed by persistent key because services sharing a persistent directory share the underlying volume too
+	persistentDirectorySizes map[service_directory.DirectoryPersistentKey]service_directory.DirectoryPersistentSize
+
+	// Keyed by name for the same reason, as all the services mounting a shared directory share its volume
+	sharedDirectorySizes map[service_directory.SharedDirectoryName]service_directory.DirectoryPersistentSize
+}
+
+// NewServiceResourceClaim returns the claim of a service with the given config; a nil config claims nothing beyond
+// the service slot itself
+func NewServiceResourceClaim(serviceConfig *service.ServiceConfig) *ServiceResourceClaim {
+	claim := &ServiceResourceClaim{
+		cpuMilliCores:            0,
+		memoryMegaBytes:          0,
+		persistentDirectorySizes: map[service_directory.DirectoryPersistentKey]service_directory.DirectoryPersistentSize{},
+		sharedDirectorySizes:     map[service_directory.SharedDirectoryName]service_directory.DirectoryPersistentSize{},
+	}
+	if serviceConfig == nil {
+		return claim
+	}
+
+	claim.cpuMilliCores = compute_resources.CpuMilliCores(serviceConfig.GetMinCPUAllocationMillicpus())
+	claim.memoryMegaBytes = compute_resources.MemoryInMegaBytes(serviceConfig.GetMinMemoryAllocationMegabytes())
+	if serviceConfig.GetPersistentDirectories() != nil {
+		for _, persistentDirectory := range serviceConfig.GetPersistentDirectories().ServiceDirpathToPersistentDirectory {
+			claim.persistentDirectorySizes[persistentDirectory.PersistentKey] = persistentDirectory.Size
+		}
+	}
+	if serviceConfig.GetSharedDirectories() != nil {
+		for _, sharedDirectory := range serviceConfig.GetSharedDirectories().ServiceDirpathToSharedDirectory {
+			claim.sharedDirectorySizes[sharedDirectory.Name] = sharedDirectory.Size
+		}
+	}
+	return claim
+}
+
+// CheckEnclaveResourceQuota returns an error if the services with the given claims don't fit in the quota together.
+// A nil quota fits everything
+func CheckEnclaveResourceQuota(
+	resourceQuota *enclave.EnclaveResourceQuota,
+	serviceResourceClaims map[service.ServiceName]*ServiceResourceClaim,
+) error {
+	if resourceQuota == nil {
+		return nil
+	}
+
+	numServices := len(serviceResourceClaims)
+	if resourceQuota.GetMaxServices() != 0 && numServices > int(resourceQuota.GetMaxServices()) {
+		return stacktrace.NewError(
+			"The enclave would have %d services but its quota allows at most %d",
+			numServices,
+			resourceQuota.GetMaxServices(),
+		)
+	}
+
+	var totalCpuMilliCores compute_resources.CpuMilliCores
+	var totalMemoryMegaBytes compute_resources.MemoryInMegaBytes
+	persistentDirectorySizes := map[service_directory.DirectoryPersistentKey]service_directory.DirectoryPersistentSize{}
+	sharedDirectorySizes := map[service_directory.SharedDirectoryName]service_directory.DirectoryPersistentSize{}
+	for _, claim := range serviceResourceClaims {
+		totalCpuMilliCores += claim.cpuMilliCores
+		totalMemoryMegaBytes += claim.memoryMegaBytes
+		for persistentKey, size := range claim.persistentDirectorySizes {
+			if size > persistentDirectorySizes[persistentKey] {
+				persistentDirectorySizes[persistentKey] = size
+			}
+		}
+		for sharedDirectoryName, size := range claim.sharedDirectorySizes {
+			if size > sharedDirectorySizes[sharedDirectoryName] {
+				sharedDirectorySizes[sharedDirectoryName] = size
+			}
+		}
+	}
+
+	if resourceQuota.GetCpuMilliCores() != 0 && totalCpuMilliCores > resourceQuota.GetCpuMilliCores() {
+		return stacktrace.NewError(
+			"The services in the enclave would claim %d millicores of min CPU in total but the enclave's quota is %d millicores",
+			totalCpuMilliCores,
+			resourceQuota.GetCpuMilliCores(),
+		)
+	}
+	if resourceQuota.GetMemoryMegaBytes() != 0 && totalMemoryMegaBytes > resourceQuota.GetMemoryMegaBytes() {
+		return stacktrace.NewError(
+			"The services in the enclave would claim %d megabytes of min memory in total but the enclave's quota is %d megabytes",
+			totalMemoryMegaBytes,
+			resourceQuota.GetMemoryMegaBytes(),
+		)
+	}
+
+	var totalDiskBytes uint64
+	for _, size := range persistentDirectorySizes {
+		totalDiskBytes += uint64(size)
+	}
+	for _, size := range sharedDirectorySizes {
+		totalDiskBytes += uint64(size)
+	}
+	// Rounded up so a directory that's a few bytes over the quota doesn't slip through
+	totalDiskMegaBytes := (totalDiskBytes + bytesInMegabyte - 1) / bytesInMegabyte
+	if resourceQuota.GetDiskMegaBytes() != 0 && totalDiskMegaBytes > resourceQuota.GetDiskMegaBytes() {
+		return stacktrace.NewError(
+			"The persistent and shared directories in the enclave would take %d megabytes of disk in total but the enclave's quota is %d megabytes",
+			totalDiskMegaBytes,
+			resourceQuota.GetDiskMegaBytes(),
+		)
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package service_network
+
+import (
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/compute_resources"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testPersistentKey = service_directory.DirectoryPersistentKey("data")
+)
+
+func TestCheckEnclaveResourceQuota_NilQuotaFitsEverything(t *testing.T) {
+	claims := map[service.ServiceName]*ServiceResourceClaim{
+		"service-1": newTestServiceResourceClaim(100_000, 100_000, nil),
+	}
+	require.NoError(t, CheckEnclaveResourceQuota(nil, claims))
+}
+
+func TestCheckEnclaveResourceQuota_ZeroValuesAreUncapped(t *testing.T) {
+	quota := enclave.NewEnclaveResourceQuota(0, 0, 0, 0)
+	claims := map[service.ServiceName]*ServiceResourceClaim{
+		"service-1": newTestServiceResourceClaim(100_000, 100_000, nil),
+		"service-2": newTestServiceResourceClaim(100_000, 100_000, nil),
+	}
+	require.NoError(t, CheckEnclaveResourceQuota(quota, claims))
+}
+
+func TestCheckEnclaveResourceQuota_Cpu(t *testing.T) {
+	quota := enclave.NewEnclaveResourceQuota(1000, 0, 0, 0)
+	claims := map[service.ServiceName]*ServiceResourceClaim{
+		"service-1": newTestServiceResourceClaim(500, 0, nil),
+		"service-2": newTestServiceResourceClaim(500, 0, nil),
+	}
+	require.NoError(t, CheckEnclaveResourceQuota(quota, claims))
+
+	claims["service-3"] = newTestServiceResourceClaim(1, 0, nil)
+	err := CheckEnclaveResourceQuota(quota, claims)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "1001 millicores")
+}
+
+func TestCheckEnclaveResourceQuota_Memory(t *testing.T) {
+	quota := enclave.NewEnclaveResourceQuota(0, 1024, 0, 0)
+	claims := map[service.ServiceName]*ServiceResourceClaim{
+		"service-1": newTestServiceResourceClaim(0, 1024, nil),
+		"service-2": newTestServiceResourceClaim(0, 1, nil),
+	}
+	err := CheckEnclaveResourceQuota(quota, claims)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "1025 megabytes")
+}
+
+func TestCheckEnclaveResourceQuota_MaxServices(t *testing.T) {
+	quota := enclave.NewEnclaveResourceQuota(0, 0, 2, 0)
+	claims := map[service.ServiceName]*ServiceResourceClaim{
+		"service-1": NewServiceResourceClaim(nil),
+		"service-2": NewServiceResourceClaim(nil),
+	}
+	require.NoError(t, CheckEnclaveResourceQuota(quota, claims))
+
+	claims["service-3"] = NewServiceResourceClaim(nil)
+	require.Error(t, CheckEnclaveResourceQuota(quota, claims))
+}
+
+func TestCheckEnclaveResourceQuota_SharedPersistentDirectoryIsCountedOnce(t *testing.T) {
+	quota := enclave.NewEnclaveResourceQuota(0, 0, 0, 1000)
+	persistentDirectorySizes := map[service_directory.DirectoryPersistentKey]service_directory.DirectoryPersistentSize{
+		testPersistentKey: 600 * bytesInMegabyte,
+	}
+	claims := map[service.ServiceName]*ServiceResourceClaim{
+		"service-1": newTestServiceResourceClaim(0, 0, persistentDirectorySizes),
+		"service-2": newTestServiceResourceClaim(0, 0, persistentDirectorySizes),
+	}
+	require.NoError(t, CheckEnclaveResourceQuota(quota, claims))
+
+	claims["service-3"] = newTestServiceResourceClaim(0, 0, map[service_directory.DirectoryPersistentKey]service_directory.DirectoryPersistentSize{
+		"other-data": 400*bytesInMegabyte + 1,
+	})
+	err := CheckEnclaveResourceQuota(quota, claims)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "1001 megabytes")
+}
+
+func newTestServiceResourceClaim(
+	cpuMilliCores uint64,
+	memoryMegaBytes uint64,
+	persistentDirectorySizes map[service_directory.DirectoryPersistentKey]service_directory.DirectoryPersistentSize,
+) *ServiceResourceClaim {
+	claim := NewServiceResourceClaim(nil)
+	claim.cpuMilliCores = compute_resources.CpuMilliCores(cpuMilliCores)
+	claim.memoryMegaBytes = compute_resources.MemoryInMegaBytes(memoryMegaBytes)
+	for persistentKey, size := range persistentDirectorySizes {
+		claim.persistentDirectorySizes[persistentKey] = size
+	}
+	return claim
+}
+
+func TestCheckEnclaveResourceQuota_SharedDirectoryIsCountedOnce(t *testing.T) {
+	quota := enclave.NewEnclaveResourceQuota(0, 0, 0, 1000)
+	sharedDirectory := service_directory.SharedDirectory{
+		Name: "shared-data",
+		Size: 600 * bytesInMegabyte,
+	}
+	serviceConfig, err := service.CreateServiceConfig("image", nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, 0, 0, "", 0, 0, map[string]string{}, nil, nil, map[string]string{}, image_download_mode.ImageDownloadMode_Missing, true, false, []string{}, false, service.NewGpuConfig(0, nil, 0, nil, "", ""))
+	require.NoError(t, err)
+	serviceConfig.SetSharedDirectories(service_directory.NewSharedDirectories(map[string]service_directory.SharedDirectory{
+		"/data": sharedDirectory,
+	}))
+	claims := map[service.ServiceName]*ServiceResourceClaim{
+		"service-1": NewServiceResourceClaim(serviceConfig),
+		"service-2": NewServiceResourceClaim(serviceConfig),
+	}
+	require.NoError(t, CheckEnclaveResourceQuota(quota, claims))
+
+	claims["service-3"] = newTestServiceResourceClaim(0, 0, map[service_directory.DirectoryPersistentKey]service_directory.DirectoryPersistentSize{
+		testPersistentKey: 400*bytesInMegabyte + 1,
+	})
+	err = CheckEnclaveResourceQuota(quota, claims)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "1001 megabytes")
+}
@@ -10,6 +10,8 @@ import (
 
 	exec_result "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
 
+	interactive_exec "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/interactive_exec"
+
 	http "net/http"
 
 	io "io"
@@ -157,23 +159,23 @@ func (_c *MockServiceNetwork_AddServices_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
-// CopyFilesFromService provides a mock function with given fields: ctx, serviceIdentifier, srcPath, artifactName
-func (_m *MockServiceNetwork) CopyFilesFromService(ctx context.Context, serviceIdentifier string, srcPath string, artifactName string) (enclave_data_directory.FilesArtifactUUID, error) {
-	ret := _m.Called(ctx, serviceIdentifier, srcPath, artifactName)
+// CopyFilesFromService provides a mock function with given fields: ctx, serviceIdentifier, srcPath, artifactName, producer
+func (_m *MockServiceNetwork) CopyFilesFromService(ctx context.Context, serviceIdentifier string, srcPath string, artifactName string, producer string) (enclave_data_directory.FilesArtifactUUID, error) {
+	ret := _m.Called(ctx, serviceIdentifier, srcPath, artifactName, producer)
 
 	var r0 enclave_data_directory.FilesArtifactUUID
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (enclave_data_directory.FilesArtifactUUID, error)); ok {
-		return rf(ctx, serviceIdentifier, srcPath, artifactName)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) (enclave_data_directory.FilesArtifactUUID, error)); ok {
+		return rf(ctx, serviceIdentifier, srcPath, artifactName, producer)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) enclave_data_directory.FilesArtifactUUID); ok {
-		r0 = rf(ctx, serviceIdentifier, srcPath, artifactName)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) enclave_data_directory.FilesArtifactUUID); ok {
+		r0 = rf(ctx, serviceIdentifier, srcPath, artifactName, producer)
 	} else {
 		r0 = ret.Get(0).(enclave_data_directory.FilesArtifactUUID)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
-		r1 = rf(ctx, serviceIdentifier, srcPath, artifactName)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string) error); ok {
+		r1 = rf(ctx, serviceIdentifier, srcPath, artifactName, producer)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -191,13 +193,14 @@ type MockServiceNetwork_CopyFilesFromService_Call struct {
 //   - serviceIdentifier string
 //   - srcPath string
 //   - artifactName string
-func (_e *MockServiceNetwork_Expecter) CopyFilesFromService(ctx interface{}, serviceIdentifier interface{}, srcPath interface{}, artifactName interface{}) *MockServiceNetwork_CopyFilesFromService_Call {
-	return &MockServiceNetwork_CopyFilesFromService_Call{Call: _e.mock.On("CopyFilesFromService", ctx, serviceIdentifier, srcPath, artifactName)}
+//   - producer string
+func (_e *MockServiceNetwork_Expecter) CopyFilesFromService(ctx interface{}, serviceIdentifier interface{}, srcPath interface{}, artifactName interface{}, producer interface{}) *MockServiceNetwork_CopyFilesFromService_Call {
+	return &MockServiceNetwork_CopyFilesFromService_Call{Call: _e.mock.On("CopyFilesFromService", ctx, serviceIdentifier, srcPath, artifactName, producer)}
 }
 
-func (_c *MockServiceNetwork_CopyFilesFromService_Call) Run(run func(ctx context.Context, serviceIdentifier string, srcPath string, artifactName string)) *MockServiceNetwork_CopyFilesFromService_Call {
+func (_c *MockServiceNetwork_CopyFilesFromService_Call) Run(run func(ctx context.Context, serviceIdentifier string, srcPath string, artifactName string, producer string)) *MockServiceNetwork_CopyFilesFromService_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string))
 	})
 	return _c
 }
@@ -207,7 +210,7 @@ func (_c *MockServiceNetwork_CopyFilesFromService_Call) Return(_a0 enclave_data_
 	return _c
 }
 
-func (_c *MockServiceNetwork_CopyFilesFromService_Call) RunAndReturn(run func(context.Context, string, string, string) (enclave_data_directory.FilesArtifactUUID, error)) *MockServiceNetwork_CopyFilesFromService_Call {
+func (_c *MockServiceNetwork_CopyFilesFromService_Call) RunAndReturn(run func(context.Context, string, string, string, string) (enclave_data_directory.FilesArtifactUUID, error)) *MockServiceNetwork_CopyFilesFromService_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -796,23 +799,23 @@ func (_c *MockServiceNetwork_RemoveService_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
-// RenderTemplates provides a mock function with given fields: templatesAndDataByDestinationRelFilepath, artifactName
-func (_m *MockServiceNetwork) RenderTemplates(templatesAndDataByDestinationRelFilepath map[string]*render_templates.TemplateData, artifactName string) (enclave_data_directory.FilesArtifactUUID, error) {
-	ret := _m.Called(templatesAndDataByDestinationRelFilepath, artifactName)
+// RenderTemplates provides a mock function with given fields: templatesAndDataByDestinationRelFilepath, artifactName, producer
+func (_m *MockServiceNetwork) RenderTemplates(templatesAndDataByDestinationRelFilepath map[string]*render_templates.TemplateData, artifactName string, producer string) (enclave_data_directory.FilesArtifactUUID, error) {
+	ret := _m.Called(templatesAndDataByDestinationRelFilepath, artifactName, producer)
 
 	var r0 enclave_data_directory.FilesArtifactUUID
 	var r1 error
-	if rf, ok := ret.Get(0).(func(map[string]*render_templates.TemplateData, string) (enclave_data_directory.FilesArtifactUUID, error)); ok {
-		return rf(templatesAndDataByDestinationRelFilepath, artifactName)
+	if rf, ok := ret.Get(0).(func(map[string]*render_templates.TemplateData, string, string) (enclave_data_directory.FilesArtifactUUID, error)); ok {
+		return rf(templatesAndDataByDestinationRelFilepath, artifactName, producer)
 	}
-	if rf, ok := ret.Get(0).(func(map[string]*render_templates.TemplateData, string) enclave_data_directory.FilesArtifactUUID); ok {
-		r0 = rf(templatesAndDataByDestinationRelFilepath, artifactName)
+	if rf, ok := ret.Get(0).(func(map[string]*render_templates.TemplateData, string, string) enclave_data_directory.FilesArtifactUUID); ok {
+		r0 = rf(templatesAndDataByDestinationRelFilepath, artifactName, producer)
 	} else {
 		r0 = ret.Get(0).(enclave_data_directory.FilesArtifactUUID)
 	}
 
-	if rf, ok := ret.Get(1).(func(map[string]*render_templates.TemplateData, string) error); ok {
-		r1 = rf(templatesAndDataByDestinationRelFilepath, artifactName)
+	if rf, ok := ret.Get(1).(func(map[string]*render_templates.TemplateData, string, string) error); ok {
+		r1 = rf(templatesAndDataByDestinationRelFilepath, artifactName, producer)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -828,13 +831,14 @@ type MockServiceNetwork_RenderTemplates_Call struct {
 // RenderTemplates is a helper method to define mock.On call
 //   - templatesAndDataByDestinationRelFilepath map[string]*render_templates.TemplateData
 //   - artifactName string
-func (_e *MockServiceNetwork_Expecter) RenderTemplates(templatesAndDataByDestinationRelFilepath interface{}, artifactName interface{}) *MockServiceNetwork_RenderTemplates_Call {
-	return &MockServiceNetwork_RenderTemplates_Call{Call: _e.mock.On("RenderTemplates", templatesAndDataByDestinationRelFilepath, artifactName)}
+//   - producer string
+func (_e *MockServiceNetwork_Expecter) RenderTemplates(templatesAndDataByDestinationRelFilepath interface{}, artifactName interface{}, producer interface{}) *MockServiceNetwork_RenderTemplates_Call {
+	return &MockServiceNetwork_RenderTemplates_Call{Call: _e.mock.On("RenderTemplates", templatesAndDataByDestinationRelFilepath, artifactName, producer)}
 }
 
-func (_c *MockServiceNetwork_RenderTemplates_Call) Run(run func(templatesAndDataByDestinationRelFilepath map[string]*render_templates.TemplateData, artifactName string)) *MockServiceNetwork_RenderTemplates_Call {
+func (_c *MockServiceNetwork_RenderTemplates_Call) Run(run func(templatesAndDataByDestinationRelFilepath map[string]*render_templates.TemplateData, artifactName string, producer string)) *MockServiceNetwork_RenderTemplates_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(map[string]*render_templates.TemplateData), args[1].(string))
+		run(args[0].(map[string]*render_templates.TemplateData), args[1].(string), args[2].(string))
 	})
 	return _c
 }
@@ -844,7 +848,7 @@ func (_c *MockServiceNetwork_RenderTemplates_Call) Return(_a0 enclave_data_direc
 	return _c
 }
 
-func (_c *MockServiceNetwork_RenderTemplates_Call) RunAndReturn(run func(map[string]*render_templates.TemplateData, string) (enclave_data_directory.FilesArtifactUUID, error)) *MockServiceNetwork_RenderTemplates_Call {
+func (_c *MockServiceNetwork_RenderTemplates_Call) RunAndReturn(run func(map[string]*render_templates.TemplateData, string, string) (enclave_data_directory.FilesArtifactUUID, error)) *MockServiceNetwork_RenderTemplates_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -905,6 +909,63 @@ func (_c *MockServiceNetwork_RunExec_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// RunExecWithStreamedOutput provides a mock function with given fields: ctx, serviceIdentifier, userServiceCommand, outputLineConsumer
+func (_m *MockServiceNetwork) RunExecWithStreamedOutput(ctx context.Context, serviceIdentifier string, userServiceCommand []string, outputLineConsumer func(string)) (*exec_result.ExecResult, error) {
+	ret := _m.Called(ctx, serviceIdentifier, userServiceCommand, outputLineConsumer)
+
+	var r0 *exec_result.ExecResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string, func(string)) (*exec_result.ExecResult, error)); ok {
+		return rf(ctx, serviceIdentifier, userServiceCommand, outputLineConsumer)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string, func(string)) *exec_result.ExecResult); ok {
+		r0 = rf(ctx, serviceIdentifier, userServiceCommand, outputLineConsumer)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*exec_result.ExecResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string, func(string)) error); ok {
+		r1 = rf(ctx, serviceIdentifier, userServiceCommand, outputLineConsumer)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServiceNetwork_RunExecWithStreamedOutput_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunExecWithStreamedOutput'
+type MockServiceNetwork_RunExecWithStreamedOutput_Call struct {
+	*mock.Call
+}
+
+// RunExecWithStreamedOutput is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serviceIdentifier string
+//   - userServiceCommand []string
+//   - outputLineConsumer func(string)
+func (_e *MockServiceNetwork_Expecter) RunExecWithStreamedOutput(ctx interface{}, serviceIdentifier interface{}, userServiceCommand interface{}, outputLineConsumer interface{}) *MockServiceNetwork_RunExecWithStreamedOutput_Call {
+	return &MockServiceNetwork_RunExecWithStreamedOutput_Call{Call: _e.mock.On("RunExecWithStreamedOutput", ctx, serviceIdentifier, userServiceCommand, outputLineConsumer)}
+}
+
+func (_c *MockServiceNetwork_RunExecWithStreamedOutput_Call) Run(run func(ctx context.Context, serviceIdentifier string, userServiceCommand []string, outputLineConsumer func(string))) *MockServiceNetwork_RunExecWithStreamedOutput_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]string), args[3].(func(string)))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_RunExecWithStreamedOutput_Call) Return(_a0 *exec_result.ExecResult, _a1 error) *MockServiceNetwork_RunExecWithStreamedOutput_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceNetwork_RunExecWithStreamedOutput_Call) RunAndReturn(run func(context.Context, string, []string, func(string)) (*exec_result.ExecResult, error)) *MockServiceNetwork_RunExecWithStreamedOutput_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RunExecs provides a mock function with given fields: ctx, userServiceCommands
 func (_m *MockServiceNetwork) RunExecs(ctx context.Context, userServiceCommands map[string][]string) (map[service.ServiceUUID]*exec_result.ExecResult, map[service.ServiceUUID]error, error) {
 	ret := _m.Called(ctx, userServiceCommands)
@@ -969,6 +1030,61 @@ func (_c *MockServiceNetwork_RunExecs_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// RunInteractiveExec provides a mock function with given fields: ctx, serviceIdentifier, userServiceCommand, execStreams
+func (_m *MockServiceNetwork) RunInteractiveExec(ctx context.Context, serviceIdentifier string, userServiceCommand []string, execStreams *interactive_exec.InteractiveExecStreams) (int32, error) {
+	ret := _m.Called(ctx, serviceIdentifier, userServiceCommand, execStreams)
+
+	var r0 int32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string, *interactive_exec.InteractiveExecStreams) (int32, error)); ok {
+		return rf(ctx, serviceIdentifier, userServiceCommand, execStreams)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string, *interactive_exec.InteractiveExecStreams) int32); ok {
+		r0 = rf(ctx, serviceIdentifier, userServiceCommand, execStreams)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string, *interactive_exec.InteractiveExecStreams) error); ok {
+		r1 = rf(ctx, serviceIdentifier, userServiceCommand, execStreams)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServiceNetwork_RunInteractiveExec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunInteractiveExec'
+type MockServiceNetwork_RunInteractiveExec_Call struct {
+	*mock.Call
+}
+
+// RunInteractiveExec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serviceIdentifier string
+//   - userServiceCommand []string
+//   - execStreams *interactive_exec.InteractiveExecStreams
+func (_e *MockServiceNetwork_Expecter) RunInteractiveExec(ctx interface{}, serviceIdentifier interface{}, userServiceCommand interface{}, execStreams interface{}) *MockServiceNetwork_RunInteractiveExec_Call {
+	return &MockServiceNetwork_RunInteractiveExec_Call{Call: _e.mock.On("RunInteractiveExec", ctx, serviceIdentifier, userServiceCommand, execStreams)}
+}
+
+func (_c *MockServiceNetwork_RunInteractiveExec_Call) Run(run func(ctx context.Context, serviceIdentifier string, userServiceCommand []string, execStreams *interactive_exec.InteractiveExecStreams)) *MockServiceNetwork_RunInteractiveExec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]string), args[3].(*interactive_exec.InteractiveExecStreams))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_RunInteractiveExec_Call) Return(_a0 int32, _a1 error) *MockServiceNetwork_RunInteractiveExec_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceNetwork_RunInteractiveExec_Call) RunAndReturn(run func(context.Context, string, []string, *interactive_exec.InteractiveExecStreams) (int32, error)) *MockServiceNetwork_RunInteractiveExec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // StartService provides a mock function with given fields: ctx, serviceIdentifier
 func (_m *MockServiceNetwork) StartService(ctx context.Context, serviceIdentifier string) error {
 	ret := _m.Called(ctx, serviceIdentifier)
@@ -1183,13 +1299,13 @@ func (_c *MockServiceNetwork_StopServices_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
-// UpdateFilesArtifact provides a mock function with given fields: fileArtifactUuid, updatedContent, contentMd5
-func (_m *MockServiceNetwork) UpdateFilesArtifact(fileArtifactUuid enclave_data_directory.FilesArtifactUUID, updatedContent io.Reader, contentMd5 []byte) error {
-	ret := _m.Called(fileArtifactUuid, updatedContent, contentMd5)
+// UpdateFilesArtifact provides a mock function with given fields: fileArtifactUuid, updatedContent, contentMd5, producer
+func (_m *MockServiceNetwork) UpdateFilesArtifact(fileArtifactUuid enclave_data_directory.FilesArtifactUUID, updatedContent io.Reader, contentMd5 []byte, producer string) error {
+	ret := _m.Called(fileArtifactUuid, updatedContent, contentMd5, producer)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(enclave_data_directory.FilesArtifactUUID, io.Reader, []byte) error); ok {
-		r0 = rf(fileArtifactUuid, updatedContent, contentMd5)
+	if rf, ok := ret.Get(0).(func(enclave_data_directory.FilesArtifactUUID, io.Reader, []byte, string) error); ok {
+		r0 = rf(fileArtifactUuid, updatedContent, contentMd5, producer)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -1206,13 +1322,14 @@ type MockServiceNetwork_UpdateFilesArtifact_Call struct {
 //   - fileArtifactUuid enclave_data_directory.FilesArtifactUUID
 //   - updatedContent io.Reader
 //   - contentMd5 []byte
-func (_e *MockServiceNetwork_Expecter) UpdateFilesArtifact(fileArtifactUuid interface{}, updatedContent interface{}, contentMd5 interface{}) *MockServiceNetwork_UpdateFilesArtifact_Call {
-	return &MockServiceNetwork_UpdateFilesArtifact_Call{Call: _e.mock.On("UpdateFilesArtifact", fileArtifactUuid, updatedContent, contentMd5)}
+//   - producer string
+func (_e *MockServiceNetwork_Expecter) UpdateFilesArtifact(fileArtifactUuid interface{}, updatedContent interface{}, contentMd5 interface{}, producer interface{}) *MockServiceNetwork_UpdateFilesArtifact_Call {
+	return &MockServiceNetwork_UpdateFilesArtifact_Call{Call: _e.mock.On("UpdateFilesArtifact", fileArtifactUuid, updatedContent, contentMd5, producer)}
 }
 
-func (_c *MockServiceNetwork_UpdateFilesArtifact_Call) Run(run func(fileArtifactUuid enclave_data_directory.FilesArtifactUUID, updatedContent io.Reader, contentMd5 []byte)) *MockServiceNetwork_UpdateFilesArtifact_Call {
+func (_c *MockServiceNetwork_UpdateFilesArtifact_Call) Run(run func(fileArtifactUuid enclave_data_directory.FilesArtifactUUID, updatedContent io.Reader, contentMd5 []byte, producer string)) *MockServiceNetwork_UpdateFilesArtifact_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(enclave_data_directory.FilesArtifactUUID), args[1].(io.Reader), args[2].([]byte))
+		run(args[0].(enclave_data_directory.FilesArtifactUUID), args[1].(io.Reader), args[2].([]byte), args[3].(string))
 	})
 	return _c
 }
@@ -1222,7 +1339,7 @@ func (_c *MockServiceNetwork_UpdateFilesArtifact_Call) Return(_a0 error) *MockSe
 	return _c
 }
 
-func (_c *MockServiceNetwork_UpdateFilesArtifact_Call) RunAndReturn(run func(enclave_data_directory.FilesArtifactUUID, io.Reader, []byte) error) *MockServiceNetwork_UpdateFilesArtifact_Call {
+func (_c *MockServiceNetwork_UpdateFilesArtifact_Call) RunAndReturn(run func(enclave_data_directory.FilesArtifactUUID, io.Reader, []byte, string) error) *MockServiceNetwork_UpdateFilesArtifact_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1348,23 +1465,23 @@ func (_c *MockServiceNetwork_UpdateServices_Call) RunAndReturn(run func(context.
 	return _c
 }
 
-// UploadFilesArtifact provides a mock function with given fields: data, contentMd5, artifactName
-func (_m *MockServiceNetwork) UploadFilesArtifact(data io.Reader, contentMd5 []byte, artifactName string) (enclave_data_directory.FilesArtifactUUID, error) {
-	ret := _m.Called(data, contentMd5, artifactName)
+// UploadFilesArtifact provides a mock function with given fields: data, contentMd5, artifactName, producer
+func (_m *MockServiceNetwork) UploadFilesArtifact(data io.Reader, contentMd5 []byte, artifactName string, producer string) (enclave_data_directory.FilesArtifactUUID, error) {
+	ret := _m.Called(data, contentMd5, artifactName, producer)
 
 	var r0 enclave_data_directory.FilesArtifactUUID
 	var r1 error
-	if rf, ok := ret.Get(0).(func(io.Reader, []byte, string) (enclave_data_directory.FilesArtifactUUID, error)); ok {
-		return rf(data, contentMd5, artifactName)
+	if rf, ok := ret.Get(0).(func(io.Reader, []byte, string, string) (enclave_data_directory.FilesArtifactUUID, error)); ok {
+		return rf(data, contentMd5, artifactName, producer)
 	}
-	if rf, ok := ret.Get(0).(func(io.Reader, []byte, string) enclave_data_directory.FilesArtifactUUID); ok {
-		r0 = rf(data, contentMd5, artifactName)
+	if rf, ok := ret.Get(0).(func(io.Reader, []byte, string, string) enclave_data_directory.FilesArtifactUUID); ok {
+		r0 = rf(data, contentMd5, artifactName, producer)
 	} else {
 		r0 = ret.Get(0).(enclave_data_directory.FilesArtifactUUID)
 	}
 
-	if rf, ok := ret.Get(1).(func(io.Reader, []byte, string) error); ok {
-		r1 = rf(data, contentMd5, artifactName)
+	if rf, ok := ret.Get(1).(func(io.Reader, []byte, string, string) error); ok {
+		r1 = rf(data, contentMd5, artifactName, producer)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1381,13 +1498,14 @@ type MockServiceNetwork_UploadFilesArtifact_Call struct {
 //   - data io.Reader
 //   - contentMd5 []byte
 //   - artifactName string
-func (_e *MockServiceNetwork_Expecter) UploadFilesArtifact(data interface{}, contentMd5 interface{}, artifactName interface{}) *MockServiceNetwork_UploadFilesArtifact_Call {
-	return &MockServiceNetwork_UploadFilesArtifact_Call{Call: _e.mock.On("UploadFilesArtifact", data, contentMd5, artifactName)}
+//   - producer string
+func (_e *MockServiceNetwork_Expecter) UploadFilesArtifact(data interface{}, contentMd5 interface{}, artifactName interface{}, producer interface{}) *MockServiceNetwork_UploadFilesArtifact_Call {
+	return &MockServiceNetwork_UploadFilesArtifact_Call{Call: _e.mock.On("UploadFilesArtifact", data, contentMd5, artifactName, producer)}
 }
 
-func (_c *MockServiceNetwork_UploadFilesArtifact_Call) Run(run func(data io.Reader, contentMd5 []byte, artifactName string)) *MockServiceNetwork_UploadFilesArtifact_Call {
+func (_c *MockServiceNetwork_UploadFilesArtifact_Call) Run(run func(data io.Reader, contentMd5 []byte, artifactName string, producer string)) *MockServiceNetwork_UploadFilesArtifact_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(io.Reader), args[1].([]byte), args[2].(string))
+		run(args[0].(io.Reader), args[1].([]byte), args[2].(string), args[3].(string))
 	})
 	return _c
 }
@@ -1397,7 +1515,168 @@ func (_c *MockServiceNetwork_UploadFilesArtifact_Call) Return(_a0 enclave_data_d
 	return _c
 }
 
-func (_c *MockServiceNetwork_UploadFilesArtifact_Call) RunAndReturn(run func(io.Reader, []byte, string) (enclave_data_directory.FilesArtifactUUID, error)) *MockServiceNetwork_UploadFilesArtifact_Call {
+func (_c *MockServiceNetwork_UploadFilesArtifact_Call) RunAndReturn(run func(io.Reader, []byte, string, string) (enclave_data_directory.FilesArtifactUUID, error)) *MockServiceNetwork_UploadFilesArtifact_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetEnclaveResourceQuota provides a mock function with given fields: ctx
+func (_m *MockServiceNetwork) GetEnclaveResourceQuota(ctx context.Context) (*enclave.EnclaveResourceQuota, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *enclave.EnclaveResourceQuota
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*enclave.EnclaveResourceQuota, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *enclave.EnclaveResourceQuota); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*enclave.EnclaveResourceQuota)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServiceNetwork_GetEnclaveResourceQuota_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEnclaveResourceQuota'
+type MockServiceNetwork_GetEnclaveResourceQuota_Call struct {
+	*mock.Call
+}
+
+// GetEnclaveResourceQuota is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockServiceNetwork_Expecter) GetEnclaveResourceQuota(ctx interface{}) *MockServiceNetwork_GetEnclaveResourceQuota_Call {
+	return &MockServiceNetwork_GetEnclaveResourceQuota_Call{Call: _e.mock.On("GetEnclaveResourceQuota", ctx)}
+}
+
+func (_c *MockServiceNetwork_GetEnclaveResourceQuota_Call) Run(run func(ctx context.Context)) *MockServiceNetwork_GetEnclaveResourceQuota_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_GetEnclaveResourceQuota_Call) Return(_a0 *enclave.EnclaveResourceQuota, _a1 error) *MockServiceNetwork_GetEnclaveResourceQuota_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceNetwork_GetEnclaveResourceQuota_Call) RunAndReturn(run func(context.Context) (*enclave.EnclaveResourceQuota, error)) *MockServiceNetwork_GetEnclaveResourceQuota_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetServiceConfig provides a mock function with given fields: serviceName
+func (_m *MockServiceNetwork) GetServiceConfig(serviceName service.ServiceName) (*service.ServiceConfig, error) {
+	ret := _m.Called(serviceName)
+
+	var r0 *service.ServiceConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(service.ServiceName) (*service.ServiceConfig, error)); ok {
+		return rf(serviceName)
+	}
+	if rf, ok := ret.Get(0).(func(service.ServiceName) *service.ServiceConfig); ok {
+		r0 = rf(serviceName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*service.ServiceConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(service.ServiceName) error); ok {
+		r1 = rf(serviceName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServiceNetwork_GetServiceConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetServiceConfig'
+type MockServiceNetwork_GetServiceConfig_Call struct {
+	*mock.Call
+}
+
+// GetServiceConfig is a helper method to define mock.On call
+//   - serviceName service.ServiceName
+func (_e *MockServiceNetwork_Expecter) GetServiceConfig(serviceName interface{}) *MockServiceNetwork_GetServiceConfig_Call {
+	return &MockServiceNetwork_GetServiceConfig_Call{Call: _e.mock.On("GetServiceConfig", serviceName)}
+}
+
+func (_c *MockServiceNetwork_GetServiceConfig_Call) Run(run func(serviceName service.ServiceName)) *MockServiceNetwork_GetServiceConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(service.ServiceName))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_GetServiceConfig_Call) Return(_a0 *service.ServiceConfig, _a1 error) *MockServiceNetwork_GetServiceConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceNetwork_GetServiceConfig_Call) RunAndReturn(run func(service.ServiceName) (*service.ServiceConfig, error)) *MockServiceNetwork_GetServiceConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetServiceResourceClaims provides a mock function with given fields:
+func (_m *MockServiceNetwork) GetServiceResourceClaims() (map[service.ServiceName]*ServiceResourceClaim, error) {
+	ret := _m.Called()
+
+	var r0 map[service.ServiceName]*ServiceResourceClaim
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (map[service.ServiceName]*ServiceResourceClaim, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() map[service.ServiceName]*ServiceResourceClaim); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[service.ServiceName]*ServiceResourceClaim)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServiceNetwork_GetServiceResourceClaims_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetServiceResourceClaims'
+type MockServiceNetwork_GetServiceResourceClaims_Call struct {
+	*mock.Call
+}
+
+// GetServiceResourceClaims is a helper method to define mock.On call
+func (_e *MockServiceNetwork_Expecter) GetServiceResourceClaims() *MockServiceNetwork_GetServiceResourceClaims_Call {
+	return &MockServiceNetwork_GetServiceResourceClaims_Call{Call: _e.mock.On("GetServiceResourceClaims")}
+}
+
+func (_c *MockServiceNetwork_GetServiceResourceClaims_Call) Run(run func()) *MockServiceNetwork_GetServiceResourceClaims_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_GetServiceResourceClaims_Call) Return(_a0 map[service.ServiceName]*ServiceResourceClaim, _a1 error) *MockServiceNetwork_GetServiceResourceClaims_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceNetwork_GetServiceResourceClaims_Call) RunAndReturn(run func() (map[service.ServiceName]*ServiceResourceClaim, error)) *MockServiceNetwork_GetServiceResourceClaims_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,123 @@
+package service_network
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+var gzipMagicBytes = []byte{0x1f, 0x8b}
+
+// seedPersistentDirectories initializes the persistent directories of the service that have a seed and don't exist
+// yet in the enclave. Directories that already exist keep their contents, so a seed only applies the first time.
+func (network *DefaultServiceNetwork) seedPersistentDirectories(ctx context.Context, serviceConfig *service.ServiceConfig) error {
+	persistentDirectories := serviceConfig.GetPersistentDirectories()
+	if persistentDirectories == nil {
+		return nil
+	}
+	directoriesToSeed := map[service_directory.DirectoryPersistentKey]service_directory.PersistentDirectory{}
+	for _, persistentDirectory := range persistentDirectories.ServiceDirpathToPersistentDirectory {
+		if persistentDirectory.SeedFilesArtifactIdentifier == "" && persistentDirectory.SeedUrl == "" {
+			continue
+		}
+		directoriesToSeed[persistentDirectory.PersistentKey] = persistentDirectory
+	}
+	if len(directoriesToSeed) == 0 {
+		return nil
+	}
+
+	// Services sharing a persistent key can start in parallel, and only one of them should seed it
+	network.persistentDirectorySeedingMutex.Lock()
+	defer network.persistentDirectorySeedingMutex.Unlock()
+
+	existingPersistentKeys, err := network.kurtosisBackend.GetPersistentDirectoryKeys(ctx, network.enclaveUuid)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the persistent directories of enclave '%v'", network.enclaveUuid)
+	}
+	for persistentKey, persistentDirectory := range directoriesToSeed {
+		if existingPersistentKeys[persistentKey] {
+			logrus.Debugf("Persistent directory '%v' already exists so it won't be seeded", persistentKey)
+			continue
+		}
+		if err := network.seedPersistentDirectory(ctx, persistentDirectory); err != nil {
+			return stacktrace.Propagate(err, "An error occurred seeding persistent directory '%v'", persistentKey)
+		}
+	}
+	return nil
+}
+
+func (network *DefaultServiceNetwork) seedPersistentDirectory(ctx context.Context, persistentDirectory service_directory.PersistentDirectory) error {
+	var seedContent io.ReadCloser
+	if persistentDirectory.SeedFilesArtifactIdentifier != "" {
+		filesArtifactStore, err := network.enclaveDataDir.GetFilesArtifactStore()
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred while getting files artifact store")
+		}
+		filesArtifactContent, _, found, err := filesArtifactStore.OpenFile(persistentDirectory.SeedFilesArtifactIdentifier)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred opening files artifact '%v'", persistentDirectory.SeedFilesArtifactIdentifier)
+		}
+		if !found {
+			return stacktrace.NewError("Files artifact '%v' doesn't exist", persistentDirectory.SeedFilesArtifactIdentifier)
+		}
+		seedContent = filesArtifactContent
+	} else {
+		downloadedContent, err := downloadSeedTarball(ctx, persistentDirectory.SeedUrl)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred downloading the tarball at '%v'", persistentDirectory.SeedUrl)
+		}
+		seedContent = downloadedContent
+	}
+	defer seedContent.Close()
+
+	tarContent, err := decompressIfGzipped(seedContent)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred decompressing the contents seeding persistent directory '%v'", persistentDirectory.PersistentKey)
+	}
+	if err := network.kurtosisBackend.ImportPersistentDirectory(ctx, network.enclaveUuid, persistentDirectory.PersistentKey, persistentDirectory.Size, tarContent); err != nil {
+		return stacktrace.Propagate(err, "An error occurred importing the seed contents into persistent directory '%v'", persistentDirectory.PersistentKey)
+	}
+	logrus.Infof("Seeded persistent directory '%v'", persistentDirectory.PersistentKey)
+	return nil
+}
+
+func downloadSeedTarball(ctx context.Context, url string) (io.ReadCloser, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred building the request to '%v'", url)
+	}
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred requesting '%v'", url)
+	}
+	if response.StatusCode != http.StatusOK {
+		response.Body.Close()
+		return nil, stacktrace.NewError("Requesting '%v' returned status '%v'", url, response.Status)
+	}
+	return response.Body, nil
+}
+
+// decompressIfGzipped lets seeds be plain or gzipped tarballs; files artifacts are always stored gzipped
+func decompressIfGzipped(content io.Reader) (io.Reader, error) {
+	bufferedContent := bufio.NewReader(content)
+	header, err := bufferedContent.Peek(len(gzipMagicBytes))
+	if err != nil && err != io.EOF {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the header of the content")
+	}
+	if !bytes.Equal(header, gzipMagicBytes) {
+		return bufferedContent, nil
+	}
+	gzipReader, err := gzip.NewReader(bufferedContent)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred opening the gzipped content")
+	}
+	return gzipReader, nil
+}
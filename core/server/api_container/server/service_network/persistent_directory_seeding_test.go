@@ -0,0 +1,131 @@
+package service_network
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/enclave_events"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	seededPersistentKey  = service_directory.DirectoryPersistentKey("data")
+	seededFilename       = "genesis.json"
+	seededFileContent    = "{}"
+	seededPersistentSize = service_directory.DirectoryPersistentSize(1024)
+)
+
+func TestSeedPersistentDirectories_SeedsMissingDirectoryFromUrl(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+	network := newSeedingTestServiceNetwork(t, backend)
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+		_, _ = writer.Write(gzippedTarWithFile(t, seededFilename, seededFileContent))
+	}))
+	defer server.Close()
+
+	backend.EXPECT().GetPersistentDirectoryKeys(ctx, enclaveName).Times(1).Return(map[service_directory.DirectoryPersistentKey]bool{}, nil)
+	var importedFilename, importedFileContent string
+	backend.EXPECT().ImportPersistentDirectory(ctx, enclaveName, seededPersistentKey, seededPersistentSize, mock.Anything).RunAndReturn(
+		func(_ context.Context, _ enclave.EnclaveUUID, _ service_directory.DirectoryPersistentKey, _ service_directory.DirectoryPersistentSize, tarContent io.Reader) error {
+			tarReader := tar.NewReader(tarContent)
+			header, err := tarReader.Next()
+			require.NoError(t, err)
+			importedFilename = header.Name
+			content, err := io.ReadAll(tarReader)
+			require.NoError(t, err)
+			importedFileContent = string(content)
+			return nil
+		}).Times(1)
+
+	err := network.seedPersistentDirectories(ctx, testSeededServiceConfig(t, server.URL))
+	require.NoError(t, err)
+	require.Equal(t, seededFilename, importedFilename)
+	require.Equal(t, seededFileContent, importedFileContent)
+}
+
+func TestSeedPersistentDirectories_KeepsExistingDirectory(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+	network := newSeedingTestServiceNetwork(t, backend)
+
+	backend.EXPECT().GetPersistentDirectoryKeys(ctx, enclaveName).Times(1).Return(map[service_directory.DirectoryPersistentKey]bool{
+		seededPersistentKey: true,
+	}, nil)
+
+	// No seed is downloaded nor imported since the directory already has contents of its own
+	err := network.seedPersistentDirectories(ctx, testSeededServiceConfig(t, "http://localhost:1/unreachable.tgz"))
+	require.NoError(t, err)
+}
+
+func TestDecompressIfGzipped_PassesPlainTarThrough(t *testing.T) {
+	plainContent := []byte("not gzipped")
+	content, err := decompressIfGzipped(bytes.NewReader(plainContent))
+	require.NoError(t, err)
+	readContent, err := io.ReadAll(content)
+	require.NoError(t, err)
+	require.Equal(t, plainContent, readContent)
+}
+
+func newSeedingTestServiceNetwork(t *testing.T, backend *backend_interface.MockKurtosisBackend) *DefaultServiceNetwork {
+	file, err := os.CreateTemp("/tmp", "*.db")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.Remove(file.Name()) })
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		apiContainerInfo,
+		backend,
+		unusedEnclaveDataDir,
+		&enclave_db.EnclaveDB{DB: db},
+		enclave_events.NewEnclaveEventBus(),
+		nil,
+	)
+	require.NoError(t, err)
+	return network
+}
+
+func testSeededServiceConfig(t *testing.T, seedUrl string) *service.ServiceConfig {
+	persistentDirectories := service_directory.NewPersistentDirectories(map[string]service_directory.PersistentDirectory{
+		"/data": {
+			PersistentKey:               seededPersistentKey,
+			Size:                        seededPersistentSize,
+			SeedFilesArtifactIdentifier: "",
+			SeedUrl:                     seedUrl,
+		},
+	})
+	serviceConfig, err := service.CreateServiceConfig(testContainerImageName, nil, nil, nil, nil, nil, nil, nil, nil, nil, persistentDirectories, 0, 0, "", 0, 0, map[string]string{}, nil, nil, map[string]string{}, image_download_mode.ImageDownloadMode_Missing, true, false, []string{}, false, service.NewGpuConfig(0, nil, 0, nil, "", ""))
+	require.NoError(t, err)
+	return serviceConfig
+}
+
+func gzippedTarWithFile(t *testing.T, filename string, content string) []byte {
+	output := &bytes.Buffer{}
+	gzipWriter := gzip.NewWriter(output)
+	tarWriter := tar.NewWriter(gzipWriter)
+	require.NoError(t, tarWriter.WriteHeader(&tar.Header{Name: filename, Mode: 0644, Size: int64(len(content))}))
+	_, err := tarWriter.Write([]byte(content))
+	require.NoError(t, err)
+	require.NoError(t, tarWriter.Close())
+	require.NoError(t, gzipWriter.Close())
+	return output.Bytes()
+}
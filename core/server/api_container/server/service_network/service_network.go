@@ -7,6 +7,7 @@ import (
 
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/interactive_exec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/render_templates"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_identifiers"
@@ -82,6 +83,10 @@ type ServiceNetwork interface {
 
 	RunExec(ctx context.Context, serviceIdentifier string, userServiceCommand []string) (*exec_result.ExecResult, error)
 
+	RunExecWithStreamedOutput(ctx context.Context, serviceIdentifier string, userServiceCommand []string, outputLineConsumer func(outputLine string)) (*exec_result.ExecResult, error)
+
+	RunInteractiveExec(ctx context.Context, serviceIdentifier string, userServiceCommand []string, execStreams *interactive_exec.InteractiveExecStreams) (int32, error)
+
 	RunExecs(
 		ctx context.Context,
 		userServiceCommands map[string][]string,
@@ -97,7 +102,7 @@ type ServiceNetwork interface {
 
 	GetServices(ctx context.Context) (map[service.ServiceUUID]*service.Service, error)
 
-	CopyFilesFromService(ctx context.Context, serviceIdentifier string, srcPath string, artifactName string) (enclave_data_directory.FilesArtifactUUID, error)
+	CopyFilesFromService(ctx context.Context, serviceIdentifier string, srcPath string, artifactName string, producer string) (enclave_data_directory.FilesArtifactUUID, error)
 
 	GetServiceNames() (map[service.ServiceName]bool, error)
 
@@ -105,17 +110,26 @@ type ServiceNetwork interface {
 
 	ExistServiceRegistration(serviceName service.ServiceName) (bool, error)
 
-	RenderTemplates(templatesAndDataByDestinationRelFilepath map[string]*render_templates.TemplateData, artifactName string) (enclave_data_directory.FilesArtifactUUID, error)
+	RenderTemplates(templatesAndDataByDestinationRelFilepath map[string]*render_templates.TemplateData, artifactName string, producer string) (enclave_data_directory.FilesArtifactUUID, error)
 
-	UploadFilesArtifact(data io.Reader, contentMd5 []byte, artifactName string) (enclave_data_directory.FilesArtifactUUID, error)
+	UploadFilesArtifact(data io.Reader, contentMd5 []byte, artifactName string, producer string) (enclave_data_directory.FilesArtifactUUID, error)
 
 	GetFilesArtifactMd5(artifactName string) (enclave_data_directory.FilesArtifactUUID, []byte, bool, error)
 
-	UpdateFilesArtifact(fileArtifactUuid enclave_data_directory.FilesArtifactUUID, updatedContent io.Reader, contentMd5 []byte) error
+	UpdateFilesArtifact(fileArtifactUuid enclave_data_directory.FilesArtifactUUID, updatedContent io.Reader, contentMd5 []byte, producer string) error
 
 	GetUniqueNameForFileArtifact() (string, error)
 
 	GetApiContainerInfo() *ApiContainerInfo
 
 	GetEnclaveUuid() enclave.EnclaveUUID
+
+	// GetEnclaveResourceQuota returns nil if the enclave isn't capped
+	GetEnclaveResourceQuota(ctx context.Context) (*enclave.EnclaveResourceQuota, error)
+
+	// GetServiceResourceClaims returns what each registered service counts against the enclave resource quota
+	GetServiceResourceClaims() (map[service.ServiceName]*ServiceResourceClaim, error)
+
+	// GetServiceConfig returns the config the service is currently registered with, with its magic strings replaced
+	GetServiceConfig(serviceName service.ServiceName) (*service.ServiceConfig, error)
 }
@@ -26,9 +26,17 @@ type InstructionDependencyGraph struct {
 	printInstructionUuids       map[types.ScheduledInstructionUuid]bool
 	waitInstructionUuids        []types.ScheduledInstructionUuid
 
+	controlFlowInstructionUuids map[types.ScheduledInstructionUuid]bool
+	conditionalOn               map[types.ScheduledInstructionUuid][]ConditionalDependency
+
 	// Right now, Services, Files Artifacts, and Runtime Values are all represented as strings for simplicity
 	// In the future, we may add types to represent each output but not needed for now
 	outputsToInstructionUuids map[string]types.ScheduledInstructionUuid
+
+	// The services and files artifacts each instruction consumes, including the ones no instruction of the sequence
+	// produces, e.g. because an earlier run added them to the enclave
+	consumedServices       map[types.ScheduledInstructionUuid][]string
+	consumedFilesArtifacts map[types.ScheduledInstructionUuid][]string
 }
 
 type InstructionWithDependencies struct {
@@ -36,6 +44,17 @@ type InstructionWithDependencies struct {
 	ShortDescriptor    string                           `yaml:"shortDescriptor"`
 	Dependencies       []types.ScheduledInstructionUuid `yaml:"dependencies"`
 	IsPrintInstruction bool                             `yaml:"isPrintInstruction"`
+
+	// control flow instructions (plan.if_, plan.for_each) and the instructions planned in their branches, which only
+	// run if the control flow instruction selects the branch at execution time
+	IsControlFlowInstruction bool                    `yaml:"isControlFlowInstruction,omitempty"`
+	ConditionalOn            []ConditionalDependency `yaml:"conditionalOn,omitempty"`
+}
+
+// ConditionalDependency is the branch of a control flow instruction an instruction was planned in
+type ConditionalDependency struct {
+	InstructionUuid types.ScheduledInstructionUuid `yaml:"instructionUuid"`
+	Branch          string                         `yaml:"branch"`
 }
 
 func NewInstructionDependencyGraph(instructionsSequence []types.ScheduledInstructionUuid) *InstructionDependencyGraph {
@@ -61,6 +80,10 @@ func NewInstructionDependencyGraph(instructionsSequence []types.ScheduledInstruc
 		instructionShortDescriptors: instructionShortDescriptors,
 		printInstructionUuids:       printInstructionUuids,
 		waitInstructionUuids:        []types.ScheduledInstructionUuid{},
+		controlFlowInstructionUuids: map[types.ScheduledInstructionUuid]bool{},
+		conditionalOn:               map[types.ScheduledInstructionUuid][]ConditionalDependency{},
+		consumedServices:            map[types.ScheduledInstructionUuid][]string{},
+		consumedFilesArtifacts:      map[types.ScheduledInstructionUuid][]string{},
 	}
 }
 
@@ -77,6 +100,7 @@ func (graph *InstructionDependencyGraph) ProducesRuntimeValue(instruction types.
 }
 
 func (graph *InstructionDependencyGraph) ConsumesService(instruction types.ScheduledInstructionUuid, serviceName string) {
+	graph.consumedServices[instruction] = append(graph.consumedServices[instruction], serviceName)
 	instructionThatProducedService, ok := graph.outputsToInstructionUuids[serviceName]
 	if ok {
 		graph.addDependency(instruction, instructionThatProducedService)
@@ -84,12 +108,25 @@ func (graph *InstructionDependencyGraph) ConsumesService(instruction types.Sched
 }
 
 func (graph *InstructionDependencyGraph) ConsumesFilesArtifact(instruction types.ScheduledInstructionUuid, filesArtifactName string) {
+	graph.consumedFilesArtifacts[instruction] = append(graph.consumedFilesArtifacts[instruction], filesArtifactName)
 	instructionThatProducedFilesArtifact, ok := graph.outputsToInstructionUuids[filesArtifactName]
 	if ok {
 		graph.addDependency(instruction, instructionThatProducedFilesArtifact)
 	}
 }
 
+// GetConsumedServices returns the names of the services the instruction consumes, whether or not an instruction of the
+// sequence produces them
+func (graph *InstructionDependencyGraph) GetConsumedServices(instruction types.ScheduledInstructionUuid) []string {
+	return slices.Clone(graph.consumedServices[instruction])
+}
+
+// GetConsumedFilesArtifacts returns the names of the files artifacts the instruction consumes, whether or not an
+// instruction of the sequence produces them
+func (graph *InstructionDependencyGraph) GetConsumedFilesArtifacts(instruction types.ScheduledInstructionUuid) []string {
+	return slices.Clone(graph.consumedFilesArtifacts[instruction])
+}
+
 func (graph *InstructionDependencyGraph) ConsumesAnyRuntimeValuesInString(instruction types.ScheduledInstructionUuid, stringPotentiallyContainingRuntimeValues string) {
 	for _, runtimeValue := range magic_string_helper.GetRuntimeValuesFromString(stringPotentiallyContainingRuntimeValues) {
 		graph.consumesRuntimeValue(instruction, runtimeValue)
@@ -157,6 +194,22 @@ func (graph *InstructionDependencyGraph) AddRemoveServiceInstruction(instruction
 	}
 }
 
+// AddControlFlowInstruction tracks an instruction that decides at execution time which of the instructions planned in
+// its branches run
+func (graph *InstructionDependencyGraph) AddControlFlowInstruction(instruction types.ScheduledInstructionUuid) {
+	graph.controlFlowInstructionUuids[instruction] = true
+}
+
+// AddConditionalInstruction tracks an instruction planned in a branch of a control flow instruction. The instruction
+// depends on the control flow instruction as it can't run before the branch has been selected
+func (graph *InstructionDependencyGraph) AddConditionalInstruction(instruction types.ScheduledInstructionUuid, controlFlowInstruction types.ScheduledInstructionUuid, branch string) {
+	graph.conditionalOn[instruction] = append(graph.conditionalOn[instruction], ConditionalDependency{
+		InstructionUuid: controlFlowInstruction,
+		Branch:          branch,
+	})
+	graph.addDependency(instruction, controlFlowInstruction)
+}
+
 func (graph *InstructionDependencyGraph) UpdateInstructionShortDescriptor(instruction types.ScheduledInstructionUuid, shortDescriptor string) {
 	graph.instructionShortDescriptors[instruction] = shortDescriptor
 }
@@ -195,6 +248,9 @@ func (graph *InstructionDependencyGraph) GenerateInstructionsWithDependencies()
 			ShortDescriptor:    graph.instructionShortDescriptors[instruction],
 			IsPrintInstruction: graph.printInstructionUuids[instruction],
 			Dependencies:       dependencies,
+
+			IsControlFlowInstruction: graph.controlFlowInstructionUuids[instruction],
+			ConditionalOn:            graph.conditionalOn[instruction],
 		})
 	}
 	return instructionsWithDependencies
@@ -175,6 +175,30 @@ func TestComplexDependencyChain(t *testing.T) {
 	require.Len(t, dependencies[instruction1], 0)
 }
 
+func TestConditionalInstructionDependencies(t *testing.T) {
+	instruction1 := types.ScheduledInstructionUuid("instruction1")
+	instruction2 := types.ScheduledInstructionUuid("instruction2")
+	instruction3 := types.ScheduledInstructionUuid("instruction3")
+
+	graph := NewInstructionDependencyGraph([]types.ScheduledInstructionUuid{instruction1, instruction2, instruction3})
+
+	graph.AddControlFlowInstruction(instruction1)
+	graph.AddConditionalInstruction(instruction2, instruction1, "then")
+	graph.AddConditionalInstruction(instruction3, instruction1, "else")
+
+	dependencies := graph.GenerateDependencyGraph()
+
+	require.Equal(t, []types.ScheduledInstructionUuid{instruction1}, dependencies[instruction2])
+	require.Equal(t, []types.ScheduledInstructionUuid{instruction1}, dependencies[instruction3])
+	require.Len(t, dependencies[instruction1], 0)
+
+	instructionsWithDependencies := graph.GenerateInstructionsWithDependencies()
+	require.True(t, instructionsWithDependencies[0].IsControlFlowInstruction)
+	require.Empty(t, instructionsWithDependencies[0].ConditionalOn)
+	require.Equal(t, []ConditionalDependency{{InstructionUuid: instruction1, Branch: "then"}}, instructionsWithDependencies[1].ConditionalOn)
+	require.Equal(t, []ConditionalDependency{{InstructionUuid: instruction1, Branch: "else"}}, instructionsWithDependencies[2].ConditionalOn)
+}
+
 func TestRemoveServiceFromDependencyGraph(t *testing.T) {
 	instruction1 := types.ScheduledInstructionUuid("remove-service(name = 'service-1')")
 
@@ -225,7 +249,7 @@ func getTestRuntimeValue(t *testing.T) string {
 	enclaveDb := getEnclaveDBForTest(t)
 
 	dummySerde := newDummyStarlarkValueSerDeForTest()
-	runtimeValueStore, err := runtime_value_store.CreateRuntimeValueStore(dummySerde, enclaveDb)
+	runtimeValueStore, err := runtime_value_store.CreateRuntimeValueStore(dummySerde, enclaveDb, nil)
 	require.NoError(t, err)
 
 	stringValueUuid, err := runtimeValueStore.CreateValue()
@@ -3,6 +3,8 @@ package enclave_plan_persistence
 import (
 	"bytes"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"maps"
+	"slices"
 )
 
 type EnclavePlanInstruction struct {
@@ -18,6 +20,41 @@ type EnclavePlanInstruction struct {
 
 	// mapping between files artifact name and files artifact MD5
 	FilesArtifacts map[string][]byte `json:"filesArtifacts"` // FSA byte arrays are automatically serialized as base64 encoded strings
+
+	// mapping between service name and the serialized ready conditions the service was added with
+	ReadyConditions map[string]string `json:"readyConditions,omitempty"`
+
+	// Set on the instructions planned in the branches of control flow instructions, which are persisted once however
+	// many times they ran
+	ControlFlowRuns *ControlFlowRuns `json:"controlFlowRuns,omitempty"`
+
+	// The services and files artifacts the instruction consumes, as it reported them to the dependency graph. Unset on
+	// the instructions persisted before they were recorded
+	ConsumedOutputs *ConsumedOutputs `json:"consumedOutputs,omitempty"`
+}
+
+type ConsumedOutputs struct {
+	ServiceNames []string `json:"serviceNames"`
+
+	FilesArtifactNames []string `json:"filesArtifactNames"`
+}
+
+// ControlFlowRuns records each run of an instruction nested in control flow instructions, so that the runs can be
+// replayed without the control flow instructions, whose Starlark code doesn't contain their branches
+type ControlFlowRuns struct {
+	// The outermost control flow instruction the instruction is nested in. The runs are replayed in its place
+	ControlFlowInstructionUuid string `json:"controlFlowInstructionUuid"`
+
+	Runs []*ControlFlowRun `json:"runs"`
+}
+
+type ControlFlowRun struct {
+	// Orders the run among the runs of all the instructions nested in the same outermost control flow instruction
+	Sequence int `json:"sequence"`
+
+	// runtime value UUID -> field name -> Starlark serialized field value
+	// The values the enclosing control flow instructions set for the run, i.e. the current item of a for_each
+	IterationRuntimeValues map[string]map[string]string `json:"iterationRuntimeValues"`
 }
 
 // HasOnlyServiceName is a convenience function that returns true if the enclave plan instruction has only
@@ -63,12 +100,57 @@ func (enclavePlanInstruction *EnclavePlanInstruction) Clone() *EnclavePlanInstru
 		copy(filesArtifactMd5, clonedFilesArtifactMd5)
 		clonedFilesArtifacts[filesArtifactName] = clonedFilesArtifactMd5
 	}
+	var clonedReadyConditions map[string]string
+	if enclavePlanInstruction.ReadyConditions != nil {
+		clonedReadyConditions = maps.Clone(enclavePlanInstruction.ReadyConditions)
+	}
+	var clonedControlFlowRuns *ControlFlowRuns
+	if enclavePlanInstruction.ControlFlowRuns != nil {
+		clonedControlFlowRuns = &ControlFlowRuns{
+			ControlFlowInstructionUuid: enclavePlanInstruction.ControlFlowRuns.ControlFlowInstructionUuid,
+			Runs:                       make([]*ControlFlowRun, len(enclavePlanInstruction.ControlFlowRuns.Runs)),
+		}
+		for idx, run := range enclavePlanInstruction.ControlFlowRuns.Runs {
+			clonedIterationRuntimeValues := make(map[string]map[string]string, len(run.IterationRuntimeValues))
+			for runtimeValueUuid, fields := range run.IterationRuntimeValues {
+				clonedIterationRuntimeValues[runtimeValueUuid] = maps.Clone(fields)
+			}
+			clonedControlFlowRuns.Runs[idx] = &ControlFlowRun{
+				Sequence:               run.Sequence,
+				IterationRuntimeValues: clonedIterationRuntimeValues,
+			}
+		}
+	}
+	var clonedConsumedOutputs *ConsumedOutputs
+	if enclavePlanInstruction.ConsumedOutputs != nil {
+		clonedConsumedOutputs = &ConsumedOutputs{
+			ServiceNames:       slices.Clone(enclavePlanInstruction.ConsumedOutputs.ServiceNames),
+			FilesArtifactNames: slices.Clone(enclavePlanInstruction.ConsumedOutputs.FilesArtifactNames),
+		}
+	}
 	return &EnclavePlanInstruction{
-		Uuid:           enclavePlanInstruction.Uuid,
-		Type:           enclavePlanInstruction.Type,
-		StarlarkCode:   enclavePlanInstruction.StarlarkCode,
-		ReturnedValue:  enclavePlanInstruction.ReturnedValue,
-		ServiceNames:   clonedServiceNames,
-		FilesArtifacts: clonedFilesArtifacts,
+		Uuid:            enclavePlanInstruction.Uuid,
+		Type:            enclavePlanInstruction.Type,
+		StarlarkCode:    enclavePlanInstruction.StarlarkCode,
+		ReturnedValue:   enclavePlanInstruction.ReturnedValue,
+		ServiceNames:    clonedServiceNames,
+		FilesArtifacts:  clonedFilesArtifacts,
+		ReadyConditions: clonedReadyConditions,
+		ControlFlowRuns: clonedControlFlowRuns,
+		ConsumedOutputs: clonedConsumedOutputs,
+	}
+}
+
+// AddControlFlowRun records a run of the instruction nested in the given outermost control flow instruction
+func (enclavePlanInstruction *EnclavePlanInstruction) AddControlFlowRun(controlFlowInstructionUuid string, sequence int, iterationRuntimeValues map[string]map[string]string) {
+	if enclavePlanInstruction.ControlFlowRuns == nil {
+		enclavePlanInstruction.ControlFlowRuns = &ControlFlowRuns{
+			ControlFlowInstructionUuid: controlFlowInstructionUuid,
+			Runs:                       []*ControlFlowRun{},
+		}
 	}
+	enclavePlanInstruction.ControlFlowRuns.Runs = append(enclavePlanInstruction.ControlFlowRuns.Runs, &ControlFlowRun{
+		Sequence:               sequence,
+		IterationRuntimeValues: iterationRuntimeValues,
+	})
 }
@@ -17,6 +17,10 @@ type EnclavePlanInstructionBuilder struct {
 	serviceNames []string
 
 	filesArtifacts map[string][]byte
+
+	readyConditions map[string]string
+
+	consumedOutputs *ConsumedOutputs
 }
 
 func NewEnclavePlanInstructionBuilder() *EnclavePlanInstructionBuilder {
@@ -27,6 +31,8 @@ func NewEnclavePlanInstructionBuilder() *EnclavePlanInstructionBuilder {
 		returnedValue:   "",
 		serviceNames:    []string{},
 		filesArtifacts:  map[string][]byte{},
+		readyConditions: nil,
+		consumedOutputs: nil,
 	}
 }
 
@@ -60,16 +66,34 @@ func (builder *EnclavePlanInstructionBuilder) AddFilesArtifact(filesArtifactName
 	return builder
 }
 
+func (builder *EnclavePlanInstructionBuilder) AddReadyCondition(serviceName service.ServiceName, serializedReadyCondition string) *EnclavePlanInstructionBuilder {
+	if builder.readyConditions == nil {
+		builder.readyConditions = map[string]string{}
+	}
+	builder.readyConditions[string(serviceName)] = serializedReadyCondition
+	return builder
+}
+
+func (builder *EnclavePlanInstructionBuilder) SetConsumedOutputs(serviceNames []string, filesArtifactNames []string) *EnclavePlanInstructionBuilder {
+	builder.consumedOutputs = &ConsumedOutputs{
+		ServiceNames:       append([]string{}, serviceNames...),
+		FilesArtifactNames: append([]string{}, filesArtifactNames...),
+	}
+	return builder
+}
+
 func (builder *EnclavePlanInstructionBuilder) Build() (*EnclavePlanInstruction, error) {
 	if builder.uuid == "" || builder.instructionType == "" || builder.starlarkCode == "" || builder.returnedValue == "" {
 		return nil, stacktrace.NewError("Some required attributes aren't set on this builder")
 	}
 	return &EnclavePlanInstruction{
-		Uuid:           builder.uuid,
-		Type:           builder.instructionType,
-		StarlarkCode:   builder.starlarkCode,
-		ReturnedValue:  builder.returnedValue,
-		ServiceNames:   builder.serviceNames,
-		FilesArtifacts: builder.filesArtifacts,
+		Uuid:            builder.uuid,
+		Type:            builder.instructionType,
+		StarlarkCode:    builder.starlarkCode,
+		ReturnedValue:   builder.returnedValue,
+		ServiceNames:    builder.serviceNames,
+		FilesArtifacts:  builder.filesArtifacts,
+		ReadyConditions: builder.readyConditions,
+		ConsumedOutputs: builder.consumedOutputs,
 	}, nil
 }
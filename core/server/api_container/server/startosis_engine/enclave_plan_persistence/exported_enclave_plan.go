@@ -0,0 +1,71 @@
+package enclave_plan_persistence
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	replayScriptMainFunctionDeclaration = "def run(plan):"
+	replayScriptNoopStatement           = "pass"
+	replayScriptIndentation             = "    "
+	replayScriptPlanPrefix              = "plan."
+)
+
+// ExportedEnclavePlan is an enclave plan packaged to be replayed in another enclave. On top of the instructions, it
+// carries the runtime values their Starlark code refers to through magic strings, so that the replayed instructions
+// resolve them the same way
+type ExportedEnclavePlan struct {
+	EnclavePlan *EnclavePlan `json:"enclavePlan"`
+
+	// runtime value UUID -> field name -> Starlark serialized field value
+	// Values that were created but never set are exported with an empty map
+	RuntimeValues map[string]map[string]string `json:"runtimeValues"`
+
+	// service name -> UUID of the runtime value holding the service's IP address and hostname
+	// The destination enclave associates the same UUID with the service, and sets its value when the service is re-created
+	ServiceRuntimeValueUuids map[string]string `json:"serviceRuntimeValueUuids"`
+
+	// Names of the files artifacts that replaying the plan produces again, and that therefore shouldn't be copied
+	ReplayedFilesArtifactNames []string `json:"replayedFilesArtifactNames"`
+}
+
+func NewExportedEnclavePlan() *ExportedEnclavePlan {
+	return &ExportedEnclavePlan{
+		EnclavePlan:                NewEnclavePlan(),
+		RuntimeValues:              map[string]map[string]string{},
+		ServiceRuntimeValueUuids:   map[string]string{},
+		ReplayedFilesArtifactNames: []string{},
+	}
+}
+
+func DeserializeExportedEnclavePlan(serializedExportedEnclavePlan []byte) (*ExportedEnclavePlan, error) {
+	exportedEnclavePlan := NewExportedEnclavePlan()
+	if err := json.Unmarshal(serializedExportedEnclavePlan, exportedEnclavePlan); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred deserializing the exported enclave plan")
+	}
+	return exportedEnclavePlan, nil
+}
+
+func (exportedEnclavePlan *ExportedEnclavePlan) Serialize() ([]byte, error) {
+	serializedExportedEnclavePlan, err := json.Marshal(exportedEnclavePlan)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred serializing the exported enclave plan")
+	}
+	return serializedExportedEnclavePlan, nil
+}
+
+// GetReplayScript returns a standalone Starlark script running every instruction of the plan, in order
+func (exportedEnclavePlan *ExportedEnclavePlan) GetReplayScript() string {
+	scriptLines := []string{replayScriptMainFunctionDeclaration}
+	for _, instruction := range exportedEnclavePlan.EnclavePlan.GeneratePlan() {
+		scriptLines = append(scriptLines, fmt.Sprintf("%s%s%s", replayScriptIndentation, replayScriptPlanPrefix, instruction.StarlarkCode))
+	}
+	if exportedEnclavePlan.EnclavePlan.Size() == 0 {
+		scriptLines = append(scriptLines, replayScriptIndentation+replayScriptNoopStatement)
+	}
+	return strings.Join(scriptLines, "\n") + "\n"
+}
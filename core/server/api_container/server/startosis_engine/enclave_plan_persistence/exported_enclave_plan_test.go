@@ -0,0 +1,37 @@
+package enclave_plan_persistence
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetReplayScript(t *testing.T) {
+	exportedEnclavePlan := NewExportedEnclavePlan()
+	exportedEnclavePlan.EnclavePlan.AppendInstruction(&EnclavePlanInstruction{Type: "add_service", StarlarkCode: `add_service(name="db", config=ServiceConfig(image="postgres"))`})
+	exportedEnclavePlan.EnclavePlan.AppendInstruction(&EnclavePlanInstruction{Type: "print", StarlarkCode: `print(msg="done")`})
+
+	expectedScript := `def run(plan):
+    plan.add_service(name="db", config=ServiceConfig(image="postgres"))
+    plan.print(msg="done")
+`
+	require.Equal(t, expectedScript, exportedEnclavePlan.GetReplayScript())
+}
+
+func TestGetReplayScript_EmptyPlan(t *testing.T) {
+	require.Equal(t, "def run(plan):\n    pass\n", NewExportedEnclavePlan().GetReplayScript())
+}
+
+func TestExportedEnclavePlanSerializationRoundTrip(t *testing.T) {
+	exportedEnclavePlan := NewExportedEnclavePlan()
+	exportedEnclavePlan.EnclavePlan.AppendInstruction(&EnclavePlanInstruction{Type: "print", StarlarkCode: `print(msg="done")`})
+	exportedEnclavePlan.RuntimeValues["abcd12a3948149d9afa2ef93abb4ec52"] = map[string]string{"code": "0"}
+	exportedEnclavePlan.ServiceRuntimeValueUuids["db"] = "0123456789abcdef0123456789abcdef"
+	exportedEnclavePlan.ReplayedFilesArtifactNames = []string{"task-output"}
+
+	serialized, err := exportedEnclavePlan.Serialize()
+	require.NoError(t, err)
+	deserialized, err := DeserializeExportedEnclavePlan(serialized)
+	require.NoError(t, err)
+	require.Equal(t, exportedEnclavePlan, deserialized)
+}
@@ -0,0 +1,51 @@
+package instructions_plan
+
+import (
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/types"
+)
+
+// ControlFlow is implemented by the instructions deciding at execution time which of the instructions planned in their
+// branches are run (i.e. plan.if_ and plan.for_each). The interpreter plans every branch, and the executor asks the
+// control flow instruction, once it has been executed, how many times each branch should run.
+type ControlFlow interface {
+	// NumberOfIterations returns the number of times the instructions planned in the branch should be executed. It
+	// returns 0 when the branch should be skipped entirely
+	NumberOfIterations(branch string) int
+
+	// PrepareIteration is called by the executor before running the instructions of the branch for the given iteration
+	PrepareIteration(branch string, iteration int) error
+
+	// GetIterationRuntimeValueUuids returns the runtime values PrepareIteration sets for the branch, which the
+	// instructions planned in it can reference (i.e. the current item of a plan.for_each)
+	GetIterationRuntimeValueUuids(branch string) []string
+}
+
+// ControlFlowBlock identifies a branch of a control flow instruction. Every instruction added to the plan while a
+// block is open is tagged with it
+type ControlFlowBlock struct {
+	controlFlowInstructionUuid types.ScheduledInstructionUuid
+
+	controlFlow ControlFlow
+
+	branch string
+}
+
+func (block *ControlFlowBlock) GetControlFlowInstructionUuid() types.ScheduledInstructionUuid {
+	return block.controlFlowInstructionUuid
+}
+
+func (block *ControlFlowBlock) GetBranch() string {
+	return block.branch
+}
+
+func (block *ControlFlowBlock) NumberOfIterations() int {
+	return block.controlFlow.NumberOfIterations(block.branch)
+}
+
+func (block *ControlFlowBlock) PrepareIteration(iteration int) error {
+	return block.controlFlow.PrepareIteration(block.branch, iteration)
+}
+
+func (block *ControlFlowBlock) GetIterationRuntimeValueUuids() []string {
+	return block.controlFlow.GetIterationRuntimeValueUuids(block.branch)
+}
@@ -28,6 +28,9 @@ type InstructionsPlan struct {
 	packageDependencies map[string]bool
 
 	uuidGenerator types.ScheduledInstructionUuidGenerator
+
+	// stack of the control flow blocks currently being interpreted. Instructions added to the plan are tagged with it
+	openControlFlowBlocks []*ControlFlowBlock
 }
 
 func NewInstructionsPlan() *InstructionsPlan {
@@ -37,6 +40,7 @@ func NewInstructionsPlan() *InstructionsPlan {
 		instructionsSequence:       []types.ScheduledInstructionUuid{},
 		packageDependencies:        map[string]bool{},
 		uuidGenerator:              types.NewScheduledInstructionUuidGenerator(),
+		openControlFlowBlocks:      []*ControlFlowBlock{},
 	}
 }
 
@@ -47,6 +51,7 @@ func NewInstructionsPlanForDependencyGraphTests() *InstructionsPlan {
 		instructionsSequence:       []types.ScheduledInstructionUuid{},
 		packageDependencies:        map[string]bool{},
 		uuidGenerator:              types.NewScheduledInstructionUuidGeneratorForTests(),
+		openControlFlowBlocks:      []*ControlFlowBlock{},
 	}
 }
 
@@ -66,6 +71,7 @@ func (plan *InstructionsPlan) AddInstruction(instruction kurtosis_instruction.Ku
 
 	scheduledInstructionUuid := types.ScheduledInstructionUuid(generatedUuid)
 	scheduledInstruction := NewScheduledInstruction(scheduledInstructionUuid, instruction, returnedValue)
+	scheduledInstruction.controlFlowBlocks = plan.currentControlFlowBlocks()
 
 	plan.scheduledInstructionsIndex[scheduledInstructionUuid] = scheduledInstruction
 	plan.instructionsSequence = append(plan.instructionsSequence, scheduledInstructionUuid)
@@ -76,12 +82,55 @@ func (plan *InstructionsPlan) AddScheduledInstruction(scheduledInstruction *Sche
 	newScheduledInstructionUuid := scheduledInstruction.uuid
 	newScheduledInstruction := NewScheduledInstruction(newScheduledInstructionUuid, scheduledInstruction.kurtosisInstruction, scheduledInstruction.returnedValue)
 	newScheduledInstruction.Executed(scheduledInstruction.IsExecuted())
+	newScheduledInstruction.controlFlowBlocks = append(plan.currentControlFlowBlocks(), scheduledInstruction.controlFlowBlocks...)
 
 	plan.scheduledInstructionsIndex[newScheduledInstructionUuid] = newScheduledInstruction
 	plan.instructionsSequence = append(plan.instructionsSequence, newScheduledInstructionUuid)
 	return newScheduledInstruction
 }
 
+// OpenControlFlowBlock opens a block for the given branch of a control flow instruction. All instructions added until
+// the block is closed are tagged with it, such that the executor runs them only as many times as the control flow
+// instruction decides at execution time
+func (plan *InstructionsPlan) OpenControlFlowBlock(controlFlowInstructionUuid types.ScheduledInstructionUuid, controlFlow ControlFlow, branch string) error {
+	if _, found := plan.scheduledInstructionsIndex[controlFlowInstructionUuid]; !found {
+		return stacktrace.NewError("Unable to open a control flow block for branch '%s' as instruction '%s' is not part of the plan. This is a Kurtosis internal bug", branch, controlFlowInstructionUuid)
+	}
+	plan.openControlFlowBlocks = append(plan.openControlFlowBlocks, &ControlFlowBlock{
+		controlFlowInstructionUuid: controlFlowInstructionUuid,
+		controlFlow:                controlFlow,
+		branch:                     branch,
+	})
+	return nil
+}
+
+// CloseControlFlowBlock closes the innermost open control flow block
+func (plan *InstructionsPlan) CloseControlFlowBlock() error {
+	if len(plan.openControlFlowBlocks) == 0 {
+		return stacktrace.NewError("Unable to close a control flow block as none is open. This is a Kurtosis internal bug")
+	}
+	plan.openControlFlowBlocks = plan.openControlFlowBlocks[:len(plan.openControlFlowBlocks)-1]
+	return nil
+}
+
+// GetLastInstructionUuid returns the UUID of the instruction that was last added to the plan
+func (plan *InstructionsPlan) GetLastInstructionUuid() (types.ScheduledInstructionUuid, error) {
+	if len(plan.instructionsSequence) == 0 {
+		return "", stacktrace.NewError("The plan doesn't contain any instruction")
+	}
+	return plan.instructionsSequence[len(plan.instructionsSequence)-1], nil
+}
+
+// HasControlFlow returns true if at least one instruction of the plan is nested in a control flow block
+func (plan *InstructionsPlan) HasControlFlow() bool {
+	for _, scheduledInstruction := range plan.scheduledInstructionsIndex {
+		if len(scheduledInstruction.controlFlowBlocks) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
 // GeneratePlan unwraps the plan into a list of instructions
 func (plan *InstructionsPlan) GeneratePlan() ([]*ScheduledInstruction, *startosis_errors.InterpretationError) {
 	var generatedPlan []*ScheduledInstruction
@@ -132,6 +181,14 @@ func (plan *InstructionsPlan) generatePlanYaml(planYaml *plan_yaml.PlanYamlGener
 		if !found {
 			return nil, startosis_errors.NewInterpretationError("Unexpected error generating the Kurtosis Instructions plan. Instruction with UUID '%s' was scheduled but could not be found in Kurtosis instruction index", instructionUuid)
 		}
+		var controlFlowBranches []plan_yaml.ControlFlowBranch
+		for _, controlFlowBlock := range instruction.controlFlowBlocks {
+			controlFlowBranches = append(controlFlowBranches, plan_yaml.ControlFlowBranch{
+				ControlFlowInstructionUuid: string(controlFlowBlock.GetControlFlowInstructionUuid()),
+				Branch:                     controlFlowBlock.GetBranch(),
+			})
+		}
+		planYaml.SetCurrentInstruction(string(instructionUuid), controlFlowBranches)
 		err := instruction.kurtosisInstruction.UpdatePlan(planYaml)
 		if err != nil {
 			return nil, startosis_errors.WrapWithInterpretationError(err, "An error occurred updating the plan with instruction: %v.", instructionUuid)
@@ -153,6 +210,9 @@ func (plan *InstructionsPlan) generateInstructionsDependencies() (*dependency_gr
 		if err != nil {
 			return nil, startosis_errors.WrapWithInterpretationError(err, "An error occurred updating the dependency graph with instruction: %v.", instructionUuid)
 		}
+		for _, controlFlowBlock := range instruction.controlFlowBlocks {
+			instructionsDependencies.AddConditionalInstruction(instructionUuid, controlFlowBlock.GetControlFlowInstructionUuid(), controlFlowBlock.GetBranch())
+		}
 	}
 	return instructionsDependencies, nil
 }
@@ -164,3 +224,9 @@ func (plan *InstructionsPlan) AddPackageDependency(packageDependency string) {
 func (plan *InstructionsPlan) Size() int {
 	return len(plan.instructionsSequence)
 }
+
+func (plan *InstructionsPlan) currentControlFlowBlocks() []*ControlFlowBlock {
+	controlFlowBlocks := make([]*ControlFlowBlock, len(plan.openControlFlowBlocks))
+	copy(controlFlowBlocks, plan.openControlFlowBlocks)
+	return controlFlowBlocks
+}
@@ -18,6 +18,9 @@ type ScheduledInstruction struct {
 
 	returnedValue starlark.Value
 
+	// the control flow blocks this instruction was planned in, outermost first. Empty for top-level instructions
+	controlFlowBlocks []*ControlFlowBlock
+
 	executed bool
 }
 
@@ -26,6 +29,7 @@ func NewScheduledInstruction(uuid types.ScheduledInstructionUuid, kurtosisInstru
 		uuid:                uuid,
 		kurtosisInstruction: kurtosisInstruction,
 		returnedValue:       returnedValue,
+		controlFlowBlocks:   []*ControlFlowBlock{},
 		executed:            false,
 	}
 }
@@ -42,6 +46,10 @@ func (instruction *ScheduledInstruction) GetReturnedValue() starlark.Value {
 	return instruction.returnedValue
 }
 
+func (instruction *ScheduledInstruction) GetControlFlowBlocks() []*ControlFlowBlock {
+	return instruction.controlFlowBlocks
+}
+
 func (instruction *ScheduledInstruction) Executed(isExecuted bool) *ScheduledInstruction {
 	instruction.executed = isExecuted
 	return instruction
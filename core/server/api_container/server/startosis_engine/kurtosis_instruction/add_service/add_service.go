@@ -38,7 +38,8 @@ const (
 	ServiceConfigArgName = "config"
 	ForceUpdateArgName   = "force_update"
 
-	addServiceDescriptionFormatStr = "Adding service with name '%v' and image '%v'"
+	addServiceDescriptionFormatStr    = "Adding service with name '%v' and image '%v'"
+	addReplicaSetDescriptionFormatStr = "Adding '%v' replicas of service with name '%v' and image '%v'"
 
 	defaultForceUpdate = false
 )
@@ -105,6 +106,7 @@ func NewAddService(
 				description:                  "",  // populated at interpretation time
 				returnValue:                  nil, // populated at interpretation time
 				imageVal:                     nil, // populated at interpretation time
+				replicas:                     nil, // populated at interpretation time
 				imageDownloadMode:            imageDownloadMode,
 				forceUpdate:                  defaultForceUpdate, // populated at interpretation time
 				allowPrivilegedMode:          allowPrivilegedMode,
@@ -139,6 +141,10 @@ type AddServiceCapabilities struct {
 	returnValue *kurtosis_types.Service
 	description string
 
+	// Set when the service has several replicas, in which case the instruction adds them all like an add_services
+	// instruction would, and returns them as a replica set
+	replicas *AddServicesCapabilities
+
 	imageDownloadMode   image_download_mode.ImageDownloadMode
 	forceUpdate         bool
 	allowPrivilegedMode bool
@@ -163,6 +169,24 @@ func (builtin *AddServiceCapabilities) Interpret(locatorOfModuleInWhichThisBuilt
 		return nil, startosis_errors.NewInterpretationError("Unable to extract image attribute off of service config.")
 	}
 	builtin.imageVal = rawImageVal
+
+	forceUpdate := defaultForceUpdate
+	if arguments.IsSet(ForceUpdateArgName) {
+		forceUpdateValue, err := builtin_argument.ExtractArgumentValue[starlark.Bool](arguments, ForceUpdateArgName)
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", ForceUpdateArgName)
+		}
+		forceUpdate = bool(forceUpdateValue)
+	}
+
+	replicas, interpretationErr := serviceConfig.GetReplicas()
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if replicas > 1 {
+		return builtin.interpretReplicaSet(locatorOfModuleInWhichThisBuiltInIsBeingCalled, arguments, serviceName, serviceConfig, replicas, forceUpdate)
+	}
+
 	apiServiceConfig, readyCondition, interpretationErr := shared_helpers.ValidateAndConvertConfigAndReadyCondition(
 		builtin.serviceNetwork,
 		serviceConfig,
@@ -179,15 +203,6 @@ func (builtin *AddServiceCapabilities) Interpret(locatorOfModuleInWhichThisBuilt
 		return nil, interpretationErr
 	}
 
-	forceUpdate := defaultForceUpdate
-	if arguments.IsSet(ForceUpdateArgName) {
-		forceUpdateValue, err := builtin_argument.ExtractArgumentValue[starlark.Bool](arguments, ForceUpdateArgName)
-		if err != nil {
-			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", ForceUpdateArgName)
-		}
-		forceUpdate = bool(forceUpdateValue)
-	}
-
 	builtin.serviceName = service.ServiceName(serviceName.GoString())
 	builtin.serviceConfig = apiServiceConfig
 	builtin.readyCondition = readyCondition
@@ -213,7 +228,100 @@ func (builtin *AddServiceCapabilities) Interpret(locatorOfModuleInWhichThisBuilt
 	return builtin.returnValue, nil
 }
 
+// interpretReplicaSet interprets the instruction for a service with several replicas, named after the service
+// followed by their index. They share the same config, plus their index as an env var and the service name as
+// replica set label, which also becomes a network alias resolving to all of them. This is the only way a service
+// becomes a replica: the replica set label can't be set on a service config
+func (builtin *AddServiceCapabilities) interpretReplicaSet(
+	locatorOfModuleInWhichThisBuiltInIsBeingCalled string,
+	arguments *builtin_argument.ArgumentValuesSet,
+	replicaSetName starlark.String,
+	serviceConfig *service_config.ServiceConfig,
+	replicas int,
+	forceUpdate bool,
+) (starlark.Value, *startosis_errors.InterpretationError) {
+	serviceConfigs := map[service.ServiceName]*service.ServiceConfig{}
+	readyConditions := map[service.ServiceName]*service_config.ReadyCondition{}
+	replicaNames := []service.ServiceName{}
+	for replicaIndex := 0; replicaIndex < replicas; replicaIndex++ {
+		// every replica gets its own converted config, as they're updated separately
+		apiServiceConfig, readyCondition, interpretationErr := shared_helpers.ValidateAndConvertConfigAndReadyCondition(
+			builtin.serviceNetwork,
+			serviceConfig,
+			locatorOfModuleInWhichThisBuiltInIsBeingCalled,
+			builtin.packageId,
+			builtin.packageContentProvider,
+			builtin.packageReplaceOptions,
+			builtin.imageDownloadMode,
+		)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+		if interpretationErr := privileged_mode.ValidateServiceConfig(apiServiceConfig, builtin.allowPrivilegedMode, builtin.kurtosisBackendType); interpretationErr != nil {
+			return nil, interpretationErr
+		}
+		apiServiceConfig.SetReplicaSet(replicaSetName.GoString(), replicaIndex)
+
+		replicaName := service.GetReplicaServiceName(replicaSetName.GoString(), replicaIndex)
+		serviceConfigs[replicaName] = apiServiceConfig
+		readyConditions[replicaName] = readyCondition
+		replicaNames = append(replicaNames, replicaName)
+	}
+
+	resultUuids, returnValue, interpretationErr := makeAndPersistAddServicesInterpretationReturnValue(serviceConfigs, builtin.runtimeValueStore, builtin.interpretationTimeValueStore)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	replicaValues := []*kurtosis_types.Service{}
+	for _, replicaName := range replicaNames {
+		builtin.interpretationTimeValueStore.PutServiceConfig(replicaName, serviceConfigs[replicaName])
+		replicaValue, found, err := returnValue.Get(starlark.String(replicaName))
+		if err != nil || !found {
+			return nil, startosis_errors.NewInterpretationError("Expected to find the return value of replica '%s', but none was found; this is a bug in Kurtosis", replicaName)
+		}
+		replicaService, ok := replicaValue.(*kurtosis_types.Service)
+		if !ok {
+			return nil, startosis_errors.NewInterpretationError("Expected the return value of replica '%s' to be a '%s', but got '%s'", replicaName, kurtosis_types.ServiceTypeName, reflect.TypeOf(replicaValue))
+		}
+		replicaValues = append(replicaValues, replicaService)
+	}
+	replicaSet, interpretationErr := kurtosis_types.CreateReplicaSet(replicaSetName, replicaValues)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+
+	builtin.serviceName = service.ServiceName(replicaSetName.GoString())
+	builtin.forceUpdate = forceUpdate
+	builtin.description = builtin_argument.GetDescriptionOrFallBack(arguments, fmt.Sprintf(addReplicaSetDescriptionFormatStr, replicas, builtin.serviceName, serviceConfigs[replicaNames[0]].GetContainerImageName()))
+	builtin.replicas = &AddServicesCapabilities{
+		serviceNetwork:               builtin.serviceNetwork,
+		runtimeValueStore:            builtin.runtimeValueStore,
+		packageId:                    builtin.packageId,
+		packageContentProvider:       builtin.packageContentProvider,
+		packageReplaceOptions:        builtin.packageReplaceOptions,
+		interpretationTimeValueStore: builtin.interpretationTimeValueStore,
+		serviceConfigs:               serviceConfigs,
+		readyConditions:              readyConditions,
+		resultUuids:                  resultUuids,
+		returnValue:                  returnValue,
+		description:                  builtin.description,
+		imageDownloadMode:            builtin.imageDownloadMode,
+		forceUpdate:                  forceUpdate,
+		allowPrivilegedMode:          builtin.allowPrivilegedMode,
+		kurtosisBackendType:          builtin.kurtosisBackendType,
+		keepUnchangedServices:        true,
+	}
+	return replicaSet, nil
+}
+
 func (builtin *AddServiceCapabilities) Validate(_ *builtin_argument.ArgumentValuesSet, validatorEnvironment *startosis_validator.ValidatorEnvironment) *startosis_errors.ValidationError {
+	if builtin.replicas != nil {
+		// the replica set name resolves to all the replicas, so it can't also be the name of a service
+		if validatorEnvironment.DoesServiceNameExist(builtin.serviceName) != startosis_validator.ComponentNotFound {
+			return startosis_errors.NewValidationError("A service named '%s' already exists, so it can't be the name of a replica set", builtin.serviceName)
+		}
+		return builtin.replicas.Validate(nil, validatorEnvironment)
+	}
 	if validationErr := validateSingleService(validatorEnvironment, builtin.serviceName, builtin.serviceConfig); validationErr != nil {
 		return validationErr
 	}
@@ -221,6 +329,9 @@ func (builtin *AddServiceCapabilities) Validate(_ *builtin_argument.ArgumentValu
 }
 
 func (builtin *AddServiceCapabilities) Execute(ctx context.Context, _ *builtin_argument.ArgumentValuesSet) (string, error) {
+	if builtin.replicas != nil {
+		return builtin.replicas.Execute(ctx, nil)
+	}
 	// update service config to use new service config set by a set_service instruction, if one exists
 	if builtin.interpretationTimeValueStore.ExistsNewServiceConfigForService(builtin.serviceName) {
 		newServiceConfig, err := builtin.interpretationTimeValueStore.GetNewServiceConfig(builtin.serviceName)
@@ -246,7 +357,7 @@ func (builtin *AddServiceCapabilities) Execute(ctx context.Context, _ *builtin_a
 	if err != nil {
 		return "", stacktrace.Propagate(err, "Unexpected error occurred starting service '%s'", replacedServiceName)
 	}
-	if err := runServiceReadinessCheck(
+	if err := RunServiceReadinessCheck(
 		ctx,
 		builtin.serviceNetwork,
 		builtin.runtimeValueStore,
@@ -265,6 +376,10 @@ func (builtin *AddServiceCapabilities) Execute(ctx context.Context, _ *builtin_a
 }
 
 func (builtin *AddServiceCapabilities) TryResolveWith(instructionsAreEqual bool, other *enclave_plan_persistence.EnclavePlanInstruction, enclaveComponents *enclave_structure.EnclaveComponents) enclave_structure.InstructionResolutionStatus {
+	if builtin.replicas != nil {
+		return builtin.replicas.tryResolveWith(AddServiceBuiltinName, instructionsAreEqual, other, enclaveComponents)
+	}
+
 	// if other instruction is nil or other instruction is not an add_service instruction, status is unknown
 	if other == nil {
 		enclaveComponents.AddService(builtin.serviceName, enclave_structure.ComponentIsNew)
@@ -320,14 +435,26 @@ func (builtin *AddServiceCapabilities) TryResolveWith(instructionsAreEqual bool,
 }
 
 func (builtin *AddServiceCapabilities) FillPersistableAttributes(builder *enclave_plan_persistence.EnclavePlanInstructionBuilder) {
+	if builtin.replicas != nil {
+		builder.SetType(AddServiceBuiltinName)
+		for replicaName := range builtin.replicas.serviceConfigs {
+			builder.AddServiceName(replicaName)
+		}
+		fillReadyConditions(builder, builtin.replicas.readyConditions)
+		return
+	}
 	builder.SetType(
 		AddServiceBuiltinName,
 	).AddServiceName(
 		builtin.serviceName,
 	)
+	fillReadyConditions(builder, map[service.ServiceName]*service_config.ReadyCondition{builtin.serviceName: builtin.readyCondition})
 }
 
 func (builtin *AddServiceCapabilities) UpdatePlan(planYaml *plan_yaml.PlanYamlGenerator) error {
+	if builtin.replicas != nil {
+		return builtin.replicas.UpdatePlan(planYaml)
+	}
 	err := updatePlanYamlWithService(planYaml, builtin.serviceName, builtin.returnValue, builtin.serviceConfig, builtin.imageVal)
 	if err != nil {
 		return err
@@ -341,6 +468,13 @@ func (builtin *AddServiceCapabilities) Description() string {
 
 // UpdateDependencyGraph updates the dependency graph with the effects of running this instruction
 func (builtin *AddServiceCapabilities) UpdateDependencyGraph(instructionsUuid types.ScheduledInstructionUuid, dependencyGraph *dependency_graph.InstructionDependencyGraph) error {
+	if builtin.replicas != nil {
+		if err := builtin.replicas.UpdateDependencyGraph(instructionsUuid, dependencyGraph); err != nil {
+			return stacktrace.Propagate(err, "An error occurred updating the dependency graph with the replicas of service '%s'", builtin.serviceName)
+		}
+		dependencyGraph.UpdateInstructionShortDescriptor(instructionsUuid, fmt.Sprintf("add_service(%s, %d replicas)", builtin.serviceName, len(builtin.replicas.serviceConfigs)))
+		return nil
+	}
 	shortDescriptor := fmt.Sprintf("add_service(%s)", builtin.serviceName)
 	dependencyGraph.UpdateInstructionShortDescriptor(instructionsUuid, shortDescriptor)
 
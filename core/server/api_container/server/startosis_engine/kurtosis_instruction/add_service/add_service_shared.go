@@ -9,6 +9,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/dependency_graph"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers/magic_string_helper"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
@@ -64,6 +65,9 @@ func makeAddServiceInterpretationReturnValue(serviceName starlark.String, servic
 	}
 	ipAddress := starlark.String(fmt.Sprintf(magic_string_helper.RuntimeValueReplacementPlaceholderFormat, resultUuid, ipAddressRuntimeValue))
 	hostname := starlark.String(fmt.Sprintf(magic_string_helper.RuntimeValueReplacementPlaceholderFormat, resultUuid, hostnameRuntimeValue))
+	if replicaSetName, isReplica := serviceConfig.GetReplicaSetName(); isReplica {
+		return kurtosis_types.CreateReplica(serviceName, hostname, ipAddress, portSpecsDict, starlark.String(replicaSetName))
+	}
 	returnValue, interpretationErr := kurtosis_types.CreateService(serviceName, hostname, ipAddress, portSpecsDict)
 	if interpretationErr != nil {
 		return nil, interpretationErr
@@ -81,10 +85,35 @@ func validateSingleService(validatorEnvironment *startosis_validator.ValidatorEn
 			if !service_directory.IsPersistentKeyValid(directory.PersistentKey) {
 				return startosis_errors.NewValidationError("%s", invalidPersistentKeyErrorText(directory.PersistentKey))
 			}
+			if directory.SeedFilesArtifactIdentifier != "" && validatorEnvironment.DoesArtifactNameExist(directory.SeedFilesArtifactIdentifier) == startosis_validator.ComponentNotFound {
+				return startosis_errors.NewValidationError("There was an error validating '%s' as artifact name '%s' seeding persistent directory '%s' does not exist", AddServiceBuiltinName, directory.SeedFilesArtifactIdentifier, directory.PersistentKey)
+			}
 			validatorEnvironment.AddPersistentKey(directory.PersistentKey)
 		}
 	}
 
+	if sharedDirectories := serviceConfig.GetSharedDirectories(); sharedDirectories != nil {
+		for _, directory := range sharedDirectories.ServiceDirpathToSharedDirectory {
+			if !service_directory.IsSharedDirectoryNameValid(directory.Name) {
+				return startosis_errors.NewValidationError("%s", invalidSharedDirectoryNameErrorText(directory.Name))
+			}
+		}
+	}
+
+	// the name of a replica set is the network alias of its replicas, so it can't be the name of a service, and only
+	// the add_service instruction of a replica set can add or update its replicas
+	if validatorEnvironment.DoesReplicaSetNameExist(string(serviceName)) {
+		return startosis_errors.NewValidationError("There was an error validating '%s' as '%s' is the name of a replica set, which can't also be the name of a service", AddServiceBuiltinName, serviceName)
+	}
+	replicaSetName, isReplica := serviceConfig.GetReplicaSetName()
+	existingReplicaSetName, isExistingReplica := validatorEnvironment.GetServiceReplicaSetName(serviceName)
+	if isExistingReplica && existingReplicaSetName != replicaSetName {
+		return startosis_errors.NewValidationError("There was an error validating '%s' as service '%s' is a replica of replica set '%s', which can only be updated by adding the replica set again", AddServiceBuiltinName, serviceName, existingReplicaSetName)
+	}
+	if isReplica && !isExistingReplica && validatorEnvironment.DoesServiceNameExist(serviceName) != startosis_validator.ComponentNotFound {
+		return startosis_errors.NewValidationError("There was an error validating '%s' as a service named '%s' already exists, so it can't be a replica of replica set '%s'", AddServiceBuiltinName, serviceName, replicaSetName)
+	}
+
 	if validatorEnvironment.DoesServiceNameExist(serviceName) == startosis_validator.ComponentCreatedOrUpdatedDuringPackageRun {
 		return startosis_errors.NewValidationError("There was an error validating '%s' as service with the name '%s' already exists inside the package. Adding two different services with the same name isn't allowed; we recommend prefixing/suffixing the two service names or using two different names entirely.", AddServiceBuiltinName, serviceName)
 	}
@@ -106,12 +135,32 @@ func validateSingleService(validatorEnvironment *startosis_validator.ValidatorEn
 		return validationErr
 	}
 
+	if validationErr := validatorEnvironment.ClaimEnclaveResources(serviceConfig, serviceName); validationErr != nil {
+		return validationErr
+	}
+
 	validatorEnvironment.AddServiceName(serviceName)
+	validatorEnvironment.SetServiceReplicaSetName(serviceName, replicaSetName)
+
+	if validationErr := validateImageAndPorts(validatorEnvironment, serviceName, serviceConfig); validationErr != nil {
+		return validationErr
+	}
+	validatorEnvironment.ConsumeMemory(serviceConfig.GetMinMemoryAllocationMegabytes(), serviceName)
+	validatorEnvironment.ConsumeCPU(serviceConfig.GetMinCPUAllocationMillicpus(), serviceName)
+	return nil
+}
 
+// validateImageAndPorts registers the image of the service to be pulled or built during validation, and checks and
+// registers the IDs of its ports
+func validateImageAndPorts(validatorEnvironment *startosis_validator.ValidatorEnvironment, serviceName service.ServiceName, serviceConfig *service.ServiceConfig) *startosis_errors.ValidationError {
 	if serviceConfig.GetImageBuildSpec() != nil {
 		validatorEnvironment.AppendRequiredImageBuild(serviceConfig.GetContainerImageName(), serviceConfig.GetImageBuildSpec())
 	} else if serviceConfig.GetImageRegistrySpec() != nil {
-		validatorEnvironment.AppendImageToPullWithAuth(serviceConfig.GetContainerImageName(), serviceConfig.GetImageRegistrySpec())
+		// credentials referencing secrets or runtime values are only resolved at execution, the image then gets pulled
+		// when the service starts
+		if !magic_string_helper.ImageRegistrySpecHasRuntimeValues(serviceConfig.GetImageRegistrySpec()) {
+			validatorEnvironment.AppendImageToPullWithAuth(serviceConfig.GetContainerImageName(), serviceConfig.GetImageRegistrySpec())
+		}
 	} else if serviceConfig.GetNixBuildSpec() != nil {
 		validatorEnvironment.AppendRequiredNixBuild(serviceConfig.GetContainerImageName(), serviceConfig.GetNixBuildSpec())
 	} else {
@@ -126,8 +175,6 @@ func validateSingleService(validatorEnvironment *startosis_validator.ValidatorEn
 		portIds = append(portIds, portId)
 	}
 	validatorEnvironment.AddPrivatePortIDForService(portIds, serviceName)
-	validatorEnvironment.ConsumeMemory(serviceConfig.GetMinMemoryAllocationMegabytes(), serviceName)
-	validatorEnvironment.ConsumeCPU(serviceConfig.GetMinCPUAllocationMillicpus(), serviceName)
 	return nil
 }
 
@@ -161,6 +208,16 @@ func invalidPersistentKeyErrorText(
 	)
 }
 
+func invalidSharedDirectoryNameErrorText(
+	sharedDirectoryName service_directory.SharedDirectoryName,
+) string {
+	return fmt.Sprintf(
+		"Shared directory name '%v' is invalid as it contains disallowed characters. Shared directory name must adhere to the RFC 1035 standard, specifically implementing this regex and be 1-63 characters long: %s. This means the name must only contain lowercase alphanumeric characters or '-', and must start with a lowercase alphabet and end with a lowercase alphanumeric character.",
+		sharedDirectoryName,
+		service_directory.WordWrappedPersistentKeyRegex,
+	)
+}
+
 func replaceMagicStrings(
 	runtimeValueStore *runtime_value_store.RuntimeValueStore,
 	serviceName service.ServiceName,
@@ -176,43 +233,28 @@ func replaceMagicStrings(
 		return "", nil, stacktrace.Propagate(err, "Error occurred while replacing runtime values in service name for '%s'", serviceName)
 	}
 
-	var entrypoints []string
-	if serviceConfig.GetEntrypointArgs() != nil {
-		entrypoints = make([]string, len(serviceConfig.GetEntrypointArgs()))
-		for index, entryPointArg := range serviceConfig.GetEntrypointArgs() {
-			entryPointArgWithRuntimeValueReplaced, err := magic_string_helper.ReplaceRuntimeValueInString(entryPointArg, runtimeValueStore)
-			if err != nil {
-				return "", nil, stacktrace.Propagate(err, "Error occurred while replacing runtime value in entry point args for '%v'", entryPointArg)
-			}
-			entrypoints[index] = entryPointArgWithRuntimeValueReplaced
-		}
+	// secrets are left in as the rendered config gets persisted; the service network resolves them when starting the service
+	entrypoints, err := replaceRuntimeValuesInArgs(runtimeValueStore, serviceConfig.GetEntrypointArgs())
+	if err != nil {
+		return "", nil, stacktrace.Propagate(err, "Error occurred while replacing runtime values in entry point args")
 	}
 
-	var cmdArgs []string
-	if serviceConfig.GetCmdArgs() != nil {
-		cmdArgs = make([]string, len(serviceConfig.GetCmdArgs()))
-		for index, cmdArg := range serviceConfig.GetCmdArgs() {
-			cmdArgWithRuntimeValueReplaced, err := magic_string_helper.ReplaceRuntimeValueInString(cmdArg, runtimeValueStore)
-			if err != nil {
-				return "", nil, stacktrace.Propagate(err, "Error occurred while replacing runtime value in command args for '%v'", cmdArg)
-			}
-			cmdArgs[index] = cmdArgWithRuntimeValueReplaced
-		}
+	cmdArgs, err := replaceRuntimeValuesInArgs(runtimeValueStore, serviceConfig.GetCmdArgs())
+	if err != nil {
+		return "", nil, stacktrace.Propagate(err, "Error occurred while replacing runtime values in command args")
 	}
 
-	var envVars map[string]string
-	if serviceConfig.GetEnvVars() != nil {
-		envVars = make(map[string]string, len(serviceConfig.GetEnvVars()))
-		for envVarName, envVarValue := range serviceConfig.GetEnvVars() {
-			envVarValueWithRuntimeValueReplaced, err := magic_string_helper.ReplaceRuntimeValueInString(envVarValue, runtimeValueStore)
-			if err != nil {
-				return "", nil, stacktrace.Propagate(err, "Error occurred while replacing runtime value in command args for '%s': '%s'", envVarName, envVarValue)
-			}
-			envVars[envVarName] = envVarValueWithRuntimeValueReplaced
-		}
+	envVars, err := replaceRuntimeValuesInEnvVars(runtimeValueStore, serviceConfig.GetEnvVars())
+	if err != nil {
+		return "", nil, stacktrace.Propagate(err, "Error occurred while replacing runtime values in env vars")
 	}
 
-	renderedServiceConfig, err := service.CreateServiceConfig(serviceConfig.GetContainerImageName(), serviceConfig.GetImageBuildSpec(), serviceConfig.GetImageRegistrySpec(), serviceConfig.GetNixBuildSpec(), serviceConfig.GetPrivatePorts(), serviceConfig.GetPublicPorts(), entrypoints, cmdArgs, envVars, serviceConfig.GetFilesArtifactsExpansion(), serviceConfig.GetPersistentDirectories(), serviceConfig.GetCPUAllocationMillicpus(), serviceConfig.GetMemoryAllocationMegabytes(), serviceConfig.GetPrivateIPAddrPlaceholder(), serviceConfig.GetMinCPUAllocationMillicpus(), serviceConfig.GetMinMemoryAllocationMegabytes(), serviceConfig.GetLabels(), serviceConfig.GetUser(), serviceConfig.GetTolerations(), serviceConfig.GetNodeSelectors(), serviceConfig.GetImageDownloadMode(), serviceConfig.GetTiniEnabled(), serviceConfig.GetTtyEnabled(), serviceConfig.GetDevices(), serviceConfig.GetPublishUdp(), serviceConfig.GetGpuConfig())
+	imageRegistrySpec, err := magic_string_helper.ReplaceRuntimeValuesInImageRegistrySpec(serviceConfig.GetImageRegistrySpec(), runtimeValueStore)
+	if err != nil {
+		return "", nil, stacktrace.Propagate(err, "Error occurred while replacing runtime values in the image registry spec for '%s'", serviceName)
+	}
+
+	renderedServiceConfig, err := service.CreateServiceConfig(serviceConfig.GetContainerImageName(), serviceConfig.GetImageBuildSpec(), imageRegistrySpec, serviceConfig.GetNixBuildSpec(), serviceConfig.GetPrivatePorts(), serviceConfig.GetPublicPorts(), entrypoints, cmdArgs, envVars, serviceConfig.GetFilesArtifactsExpansion(), serviceConfig.GetPersistentDirectories(), serviceConfig.GetCPUAllocationMillicpus(), serviceConfig.GetMemoryAllocationMegabytes(), serviceConfig.GetPrivateIPAddrPlaceholder(), serviceConfig.GetMinCPUAllocationMillicpus(), serviceConfig.GetMinMemoryAllocationMegabytes(), serviceConfig.GetLabels(), serviceConfig.GetUser(), serviceConfig.GetTolerations(), serviceConfig.GetNodeSelectors(), serviceConfig.GetImageDownloadMode(), serviceConfig.GetTiniEnabled(), serviceConfig.GetTtyEnabled(), serviceConfig.GetDevices(), serviceConfig.GetPublishUdp(), serviceConfig.GetGpuConfig())
 
 	if err != nil {
 		return "", nil, stacktrace.Propagate(err, "An error occurred creating a service config")
@@ -236,10 +278,87 @@ func replaceMagicStrings(
 		renderedServiceConfig.SetBindMounts(serviceConfig.GetBindMounts())
 	}
 
+	if len(serviceConfig.GetNetworkAliases()) > 0 {
+		renderedServiceConfig.SetNetworkAliases(serviceConfig.GetNetworkAliases())
+	}
+
+	if len(serviceConfig.GetInitContainers()) > 0 {
+		initContainers, err := replaceRuntimeValuesInAdditionalContainers(runtimeValueStore, serviceConfig.GetInitContainers())
+		if err != nil {
+			return "", nil, stacktrace.Propagate(err, "Error occurred while replacing runtime values in the init containers of '%s'", serviceName)
+		}
+		renderedServiceConfig.SetInitContainers(initContainers)
+	}
+
+	if len(serviceConfig.GetSidecars()) > 0 {
+		sidecars, err := replaceRuntimeValuesInAdditionalContainers(runtimeValueStore, serviceConfig.GetSidecars())
+		if err != nil {
+			return "", nil, stacktrace.Propagate(err, "Error occurred while replacing runtime values in the sidecars of '%s'", serviceName)
+		}
+		renderedServiceConfig.SetSidecars(sidecars)
+	}
+
+	if serviceConfig.GetSharedDirectories() != nil {
+		renderedServiceConfig.SetSharedDirectories(serviceConfig.GetSharedDirectories())
+	}
+
 	return service.ServiceName(serviceNameStr), renderedServiceConfig, nil
 }
 
-func runServiceReadinessCheck(
+// replaceRuntimeValuesInAdditionalContainers renders the args and env vars of the init containers or sidecars the same
+// way as the ones of the main container
+func replaceRuntimeValuesInAdditionalContainers(runtimeValueStore *runtime_value_store.RuntimeValueStore, containers []*service.AdditionalContainer) ([]*service.AdditionalContainer, error) {
+	renderedContainers := make([]*service.AdditionalContainer, len(containers))
+	for index, container := range containers {
+		entrypointArgs, err := replaceRuntimeValuesInArgs(runtimeValueStore, container.GetEntrypointArgs())
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Error occurred while replacing runtime values in entry point args of container '%s'", container.GetName())
+		}
+		cmdArgs, err := replaceRuntimeValuesInArgs(runtimeValueStore, container.GetCmdArgs())
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Error occurred while replacing runtime values in command args of container '%s'", container.GetName())
+		}
+		envVars, err := replaceRuntimeValuesInEnvVars(runtimeValueStore, container.GetEnvVars())
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Error occurred while replacing runtime values in env vars of container '%s'", container.GetName())
+		}
+		renderedContainers[index] = service.NewAdditionalContainer(container.GetName(), container.GetContainerImageName(), entrypointArgs, cmdArgs, envVars)
+	}
+	return renderedContainers, nil
+}
+
+func replaceRuntimeValuesInArgs(runtimeValueStore *runtime_value_store.RuntimeValueStore, args []string) ([]string, error) {
+	if args == nil {
+		return nil, nil
+	}
+	renderedArgs := make([]string, len(args))
+	for index, arg := range args {
+		argWithRuntimeValueReplaced, err := magic_string_helper.ReplaceRuntimeValueInStringKeepingSecrets(arg, runtimeValueStore)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Error occurred while replacing runtime value in arg '%v'", arg)
+		}
+		renderedArgs[index] = argWithRuntimeValueReplaced
+	}
+	return renderedArgs, nil
+}
+
+func replaceRuntimeValuesInEnvVars(runtimeValueStore *runtime_value_store.RuntimeValueStore, envVars map[string]string) (map[string]string, error) {
+	if envVars == nil {
+		return nil, nil
+	}
+	renderedEnvVars := make(map[string]string, len(envVars))
+	for envVarName, envVarValue := range envVars {
+		envVarValueWithRuntimeValueReplaced, err := magic_string_helper.ReplaceRuntimeValueInStringKeepingSecrets(envVarValue, runtimeValueStore)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Error occurred while replacing runtime value in env var '%s': '%s'", envVarName, envVarValue)
+		}
+		renderedEnvVars[envVarName] = envVarValueWithRuntimeValueReplaced
+	}
+	return renderedEnvVars, nil
+}
+
+// RunServiceReadinessCheck blocks until the service satisfies its ready conditions, if any
+func RunServiceReadinessCheck(
 	ctx context.Context,
 	serviceNetwork service_network.ServiceNetwork,
 	runtimeValueStore *runtime_value_store.RuntimeValueStore,
@@ -348,6 +467,8 @@ func addServiceToDependencyGraph(
 		envVarValues = append(envVarValues, v)
 	}
 	dependencyGraph.ConsumesAnyRuntimeValuesInList(instructionUuid, envVarValues)
+	consumeRuntimeValuesOfAdditionalContainers(instructionUuid, dependencyGraph, serviceConfig.GetInitContainers())
+	consumeRuntimeValuesOfAdditionalContainers(instructionUuid, dependencyGraph, serviceConfig.GetSidecars())
 
 	dependencyGraph.ProducesService(instructionUuid, serviceName)
 	ipAddress, err := service.GetIpAddress()
@@ -363,6 +484,22 @@ func addServiceToDependencyGraph(
 	return nil
 }
 
+func consumeRuntimeValuesOfAdditionalContainers(
+	instructionUuid types.ScheduledInstructionUuid,
+	dependencyGraph *dependency_graph.InstructionDependencyGraph,
+	containers []*service.AdditionalContainer,
+) {
+	for _, container := range containers {
+		dependencyGraph.ConsumesAnyRuntimeValuesInList(instructionUuid, container.GetEntrypointArgs())
+		dependencyGraph.ConsumesAnyRuntimeValuesInList(instructionUuid, container.GetCmdArgs())
+		envVarValues := make([]string, 0, len(container.GetEnvVars()))
+		for _, v := range container.GetEnvVars() {
+			envVarValues = append(envVarValues, v)
+		}
+		dependencyGraph.ConsumesAnyRuntimeValuesInList(instructionUuid, envVarValues)
+	}
+}
+
 func updatePlanYamlWithService(
 	planYaml *plan_yaml.PlanYamlGenerator,
 	serviceName service.ServiceName,
@@ -403,3 +540,36 @@ func updatePlanYamlWithService(
 	}
 	return nil
 }
+
+// fillReadyConditions persists the ready conditions of the services alongside the instruction, for them to be run
+// again when the services are started outside a Starlark run
+func fillReadyConditions(builder *enclave_plan_persistence.EnclavePlanInstructionBuilder, readyConditions map[service.ServiceName]*service_config.ReadyCondition) {
+	for serviceName, readyCondition := range readyConditions {
+		if readyCondition == nil {
+			continue
+		}
+		builder.AddReadyCondition(serviceName, readyCondition.String())
+	}
+}
+
+// GetReadyConditionsFromEnclavePlanInstruction returns the ready conditions, by service name, persisted with an
+// add_service or add_services instruction of the enclave plan. Services added without ready conditions, as well as
+// instructions of any other type, are left out of the returned map
+func GetReadyConditionsFromEnclavePlanInstruction(
+	starlarkValueSerde *kurtosis_types.StarlarkValueSerde,
+	enclavePlanInstruction *enclave_plan_persistence.EnclavePlanInstruction,
+) (map[service.ServiceName]*service_config.ReadyCondition, error) {
+	readyConditions := map[service.ServiceName]*service_config.ReadyCondition{}
+	for serviceNameStr, serializedReadyCondition := range enclavePlanInstruction.ReadyConditions {
+		readyConditionValue, interpretationErr := starlarkValueSerde.Deserialize(serializedReadyCondition)
+		if interpretationErr != nil {
+			return nil, stacktrace.Propagate(interpretationErr, "An error occurred deserializing the ready conditions of service '%v' persisted with instruction '%v'", serviceNameStr, enclavePlanInstruction.Uuid)
+		}
+		readyCondition, ok := readyConditionValue.(*service_config.ReadyCondition)
+		if !ok {
+			return nil, stacktrace.NewError("Expected the ready conditions of service '%v' persisted with instruction '%v' to be a '%v', but got '%v'", serviceNameStr, enclavePlanInstruction.Uuid, service_config.ReadyConditionTypeName, readyConditionValue.Type())
+		}
+		readyConditions[service.ServiceName(serviceNameStr)] = readyCondition
+	}
+	return readyConditions, nil
+}
@@ -9,8 +9,12 @@ import (
 
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers/magic_string_helper"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/service_config"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/recipe"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
 	"github.com/stretchr/testify/require"
 	bolt "go.etcd.io/bbolt"
@@ -26,7 +30,7 @@ func TestAddServiceShared_EntryPointArgsRuntimeValueAreReplaced(t *testing.T) {
 
 	dummySerde := shared_helpers.NewDummyStarlarkValueSerDeForTest()
 
-	runtimeValueStore, err := runtime_value_store.CreateRuntimeValueStore(dummySerde, enclaveDb)
+	runtimeValueStore, err := runtime_value_store.CreateRuntimeValueStore(dummySerde, enclaveDb, nil)
 	require.NoError(t, err)
 
 	stringValueUuid, err := runtimeValueStore.CreateValue()
@@ -53,7 +57,7 @@ func TestAddServiceShared_CmdArgsRuntimeValueAreReplaced(t *testing.T) {
 
 	dummySerde := shared_helpers.NewDummyStarlarkValueSerDeForTest()
 
-	runtimeValueStore, err := runtime_value_store.CreateRuntimeValueStore(dummySerde, enclaveDb)
+	runtimeValueStore, err := runtime_value_store.CreateRuntimeValueStore(dummySerde, enclaveDb, nil)
 	require.NoError(t, err)
 	stringValueUuid, err := runtimeValueStore.CreateValue()
 	require.Nil(t, err, "error creating a runtime value UUID")
@@ -79,7 +83,7 @@ func TestAddServiceShared_EnvVarsWithRuntimeValueAreReplaced(t *testing.T) {
 
 	dummySerde := shared_helpers.NewDummyStarlarkValueSerDeForTest()
 
-	runtimeValueStore, err := runtime_value_store.CreateRuntimeValueStore(dummySerde, enclaveDb)
+	runtimeValueStore, err := runtime_value_store.CreateRuntimeValueStore(dummySerde, enclaveDb, nil)
 	require.NoError(t, err)
 	stringValueUuid, err := runtimeValueStore.CreateValue()
 	require.Nil(t, err, "error creating a runtime value UUID")
@@ -105,12 +109,46 @@ func TestAddServiceShared_EnvVarsWithRuntimeValueAreReplaced(t *testing.T) {
 	require.Equal(t, expectedEnvVars, replacedServiceConfig.GetEnvVars())
 }
 
+func TestAddServiceShared_SidecarEnvVarsWithRuntimeValueAreReplaced(t *testing.T) {
+	enclaveDb := getEnclaveDBForTest(t)
+
+	dummySerde := shared_helpers.NewDummyStarlarkValueSerDeForTest()
+
+	runtimeValueStore, err := runtime_value_store.CreateRuntimeValueStore(dummySerde, enclaveDb, nil)
+	require.NoError(t, err)
+	stringValueUuid, err := runtimeValueStore.CreateValue()
+	require.Nil(t, err, "error creating a runtime value UUID")
+	runtimeValueName := "value"
+	err = runtimeValueStore.SetValue(stringValueUuid, map[string]starlark.Comparable{
+		runtimeValueName: starlark.String("10.0.0.3"),
+	})
+	require.NoError(t, err)
+	runtimeValue := fmt.Sprintf(magic_string_helper.RuntimeValueReplacementPlaceholderFormat, stringValueUuid, runtimeValueName)
+
+	serviceName := service.ServiceName("example-datastore-server-2")
+	serviceConfig, err := service.CreateServiceConfig(testContainerImageName, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, 0, 0, "", 0, 0, map[string]string{}, nil, nil, map[string]string{}, image_download_mode.ImageDownloadMode_Missing, true, false, []string{}, false, service.NewGpuConfig(0, nil, 0, nil, "", ""))
+	require.NoError(t, err)
+	serviceConfig.SetSidecars([]*service.AdditionalContainer{
+		service.NewAdditionalContainer("log-shipper", "fluent-bit", nil, []string{"--host", runtimeValue}, map[string]string{
+			"OUTPUT_HOST": runtimeValue,
+		}),
+	})
+
+	_, replacedServiceConfig, err := replaceMagicStrings(runtimeValueStore, serviceName, serviceConfig)
+	require.Nil(t, err)
+	require.Len(t, replacedServiceConfig.GetSidecars(), 1)
+	require.Equal(t, map[string]string{"OUTPUT_HOST": "10.0.0.3"}, replacedServiceConfig.GetSidecars()[0].GetEnvVars())
+	require.Equal(t, []string{"--host", "10.0.0.3"}, replacedServiceConfig.GetSidecars()[0].GetCmdArgs())
+	// the original config is left untouched
+	require.Equal(t, runtimeValue, serviceConfig.GetSidecars()[0].GetEnvVars()["OUTPUT_HOST"])
+}
+
 func TestAddServiceShared_ServiceNameWithRuntimeValuesAreReplaced(t *testing.T) {
 	enclaveDb := getEnclaveDBForTest(t)
 
 	dummySerde := shared_helpers.NewDummyStarlarkValueSerDeForTest()
 
-	runtimeValueStore, err := runtime_value_store.CreateRuntimeValueStore(dummySerde, enclaveDb)
+	runtimeValueStore, err := runtime_value_store.CreateRuntimeValueStore(dummySerde, enclaveDb, nil)
 	require.NoError(t, err)
 	stringValueUuid, err := runtimeValueStore.CreateValue()
 	require.Nil(t, err, "error creating a runtime value UUID")
@@ -130,6 +168,64 @@ func TestAddServiceShared_ServiceNameWithRuntimeValuesAreReplaced(t *testing.T)
 	require.Equal(t, service.ServiceName("database-1"), replacedServiceName)
 }
 
+func TestGetReadyConditionsFromEnclavePlanInstruction_PersistedWithInstruction(t *testing.T) {
+	serde := getSerdeWithServiceConfigTypesForTest()
+	readyConditionValue, interpretationErr := serde.Deserialize(`ReadyCondition(recipe=GetHttpRequestRecipe(port_id="http", endpoint="/health"), field="code", assertion="==", target_value=200)`)
+	require.Nil(t, interpretationErr)
+	readyCondition, ok := readyConditionValue.(*service_config.ReadyCondition)
+	require.True(t, ok)
+
+	builder := enclave_plan_persistence.NewEnclavePlanInstructionBuilder().SetUuid("instruction-uuid").SetType(AddServicesBuiltinName).SetStarlarkCode(`add_services(configs={})`).SetReturnedValue("None")
+	fillReadyConditions(builder, map[service.ServiceName]*service_config.ReadyCondition{
+		"node-1": readyCondition,
+		"node-2": nil,
+	})
+	enclavePlanInstruction, err := builder.Build()
+	require.NoError(t, err)
+
+	// services without ready conditions aren't persisted
+	require.Len(t, enclavePlanInstruction.ReadyConditions, 1)
+
+	readyConditions, err := GetReadyConditionsFromEnclavePlanInstruction(serde, enclavePlanInstruction)
+	require.NoError(t, err)
+	require.Len(t, readyConditions, 1)
+	field, interpretationErr := readyConditions["node-1"].GetField()
+	require.Nil(t, interpretationErr)
+	require.Equal(t, "code", field)
+}
+
+func TestGetReadyConditionsFromEnclavePlanInstruction_OtherInstructionsHaveNone(t *testing.T) {
+	enclavePlanInstruction := &enclave_plan_persistence.EnclavePlanInstruction{
+		Uuid:            "instruction-uuid",
+		Type:            "exec",
+		StarlarkCode:    `exec(service_name="node", recipe=ExecRecipe(command=["true"]))`,
+		ReturnedValue:   "",
+		ServiceNames:    []string{"node"},
+		FilesArtifacts:  nil,
+		ReadyConditions: nil,
+	}
+
+	readyConditions, err := GetReadyConditionsFromEnclavePlanInstruction(getSerdeWithServiceConfigTypesForTest(), enclavePlanInstruction)
+	require.NoError(t, err)
+	require.Empty(t, readyConditions)
+}
+
+func getSerdeWithServiceConfigTypesForTest() *kurtosis_types.StarlarkValueSerde {
+	starlarkEnv := starlark.StringDict{
+		service_config.ServiceConfigTypeName:  starlark.NewBuiltin(service_config.ServiceConfigTypeName, service_config.NewServiceConfigType().CreateBuiltin()),
+		service_config.ReadyConditionTypeName: starlark.NewBuiltin(service_config.ReadyConditionTypeName, service_config.NewReadyConditionType().CreateBuiltin()),
+		recipe.GetHttpRecipeTypeName:          starlark.NewBuiltin(recipe.GetHttpRecipeTypeName, recipe.NewGetHttpRequestRecipeType().CreateBuiltin()),
+	}
+	starlarkThread := &starlark.Thread{
+		Name:       "test-serde-thread",
+		Print:      nil,
+		Load:       nil,
+		OnMaxSteps: nil,
+		Steps:      0,
+	}
+	return kurtosis_types.NewStarlarkValueSerde(starlarkThread, starlarkEnv)
+}
+
 func getEnclaveDBForTest(t *testing.T) *enclave_db.EnclaveDB {
 	file, err := os.CreateTemp("/tmp", "*.db")
 	defer func() {
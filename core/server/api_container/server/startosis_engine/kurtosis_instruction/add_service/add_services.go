@@ -1,12 +1,15 @@
 package add_service
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"reflect"
 	"strings"
 	"sync"
 
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/core/launcher/args"
@@ -96,6 +99,8 @@ func NewAddServices(
 				forceUpdate:         defaultForceUpdate, // populated at interpretation time
 				allowPrivilegedMode: allowPrivilegedMode,
 				kurtosisBackendType: kurtosisBackendType,
+
+				keepUnchangedServices: false,
 			}
 		},
 
@@ -127,6 +132,10 @@ type AddServicesCapabilities struct {
 	forceUpdate         bool
 	allowPrivilegedMode bool
 	kurtosisBackendType args.KurtosisBackendType
+
+	// Set for the replicas of a replica set, so that the replicas already running with the same config keep running
+	// when the replica set is scaled up
+	keepUnchangedServices bool
 }
 
 func (builtin *AddServicesCapabilities) Interpret(locatorOfModuleInWhichThisBuiltInIsBeingCalled string, arguments *builtin_argument.ArgumentValuesSet) (starlark.Value, *startosis_errors.InterpretationError) {
@@ -213,16 +222,27 @@ func (builtin *AddServicesCapabilities) Execute(ctx context.Context, _ *builtin_
 
 	serviceToUpdate := map[service.ServiceName]*service.ServiceConfig{}
 	serviceToCreate := map[service.ServiceName]*service.ServiceConfig{}
+	unchangedServices := map[service.ServiceName]*service.Service{}
 	for serviceName, serviceConfig := range renderedServiceConfigs {
 		exist, err := builtin.serviceNetwork.ExistServiceRegistration(serviceName)
 		if err != nil {
 			return "", stacktrace.Propagate(err, "An error occurred getting service registration for service '%s'", serviceName)
 		}
-		if exist {
-			serviceToUpdate[serviceName] = serviceConfig
-		} else {
+		if !exist {
 			serviceToCreate[serviceName] = serviceConfig
+			continue
 		}
+		if builtin.keepUnchangedServices && !builtin.forceUpdate {
+			unchangedService, err := builtin.getUnchangedService(ctx, serviceName, serviceConfig)
+			if err != nil {
+				return "", stacktrace.Propagate(err, "An error occurred checking whether service '%s' already runs with its config", serviceName)
+			}
+			if unchangedService != nil {
+				unchangedServices[serviceName] = unchangedService
+				continue
+			}
+		}
+		serviceToUpdate[serviceName] = serviceConfig
 	}
 
 	updatedServices, failedToBeUpdatedServices, err := builtin.serviceNetwork.UpdateServices(ctx, serviceToUpdate, parallelism)
@@ -286,11 +306,23 @@ func (builtin *AddServicesCapabilities) Execute(ctx context.Context, _ *builtin_
 		}
 		fmt.Fprintf(&instructionResult, "\n  Service '%s' added with UUID '%s'", serviceName, serviceObj.GetRegistration().GetUUID())
 	}
+	for serviceName, serviceObj := range unchangedServices {
+		if err := fillAddServiceReturnValueWithRuntimeValues(serviceObj, builtin.resultUuids[serviceName], builtin.runtimeValueStore); err != nil {
+			return "", stacktrace.Propagate(err, "An error occurred while adding service return values with result key UUID '%s'", builtin.resultUuids[serviceName])
+		}
+		fmt.Fprintf(&instructionResult, "\n  Service '%s' left running with UUID '%s'", serviceName, serviceObj.GetRegistration().GetUUID())
+	}
 	shouldDeleteAllStartedServices = false
 	return instructionResult.String(), nil
 }
 
 func (builtin *AddServicesCapabilities) TryResolveWith(instructionsAreEqual bool, other *enclave_plan_persistence.EnclavePlanInstruction, enclaveComponents *enclave_structure.EnclaveComponents) enclave_structure.InstructionResolutionStatus {
+	return builtin.tryResolveWith(AddServicesBuiltinName, instructionsAreEqual, other, enclaveComponents)
+}
+
+// tryResolveWith resolves the instruction with an instruction of the given type, which is add_services unless the
+// services are the replicas of an add_service instruction
+func (builtin *AddServicesCapabilities) tryResolveWith(instructionType string, instructionsAreEqual bool, other *enclave_plan_persistence.EnclavePlanInstruction, enclaveComponents *enclave_structure.EnclaveComponents) enclave_structure.InstructionResolutionStatus {
 	// if force_update is set, always recreate all services regardless of config equality
 	if builtin.forceUpdate {
 		for serviceName := range builtin.serviceConfigs {
@@ -305,7 +337,7 @@ func (builtin *AddServicesCapabilities) TryResolveWith(instructionsAreEqual bool
 		}
 		return enclave_structure.InstructionIsEqual
 	}
-	// if other instruction is nil or other instruction is not an add_services instruction, status is unknown
+	// if other instruction is nil or other instruction is not of the same type, status is unknown
 	if other == nil {
 		for serviceName := range builtin.serviceConfigs {
 			enclaveComponents.AddService(serviceName, enclave_structure.ComponentIsNew)
@@ -313,7 +345,7 @@ func (builtin *AddServicesCapabilities) TryResolveWith(instructionsAreEqual bool
 		return enclave_structure.InstructionIsUnknown
 	}
 
-	if other.Type != AddServicesBuiltinName {
+	if other.Type != instructionType {
 		for serviceName := range builtin.serviceConfigs {
 			enclaveComponents.AddService(serviceName, enclave_structure.ComponentIsNew)
 		}
@@ -377,6 +409,34 @@ func (builtin *AddServicesCapabilities) FillPersistableAttributes(builder *encla
 	for serviceName := range builtin.serviceConfigs {
 		builder.AddServiceName(serviceName)
 	}
+	fillReadyConditions(builder, builtin.readyConditions)
+}
+
+// getUnchangedService returns the service if it's running with the given config already, nil otherwise
+func (builtin *AddServicesCapabilities) getUnchangedService(ctx context.Context, serviceName service.ServiceName, serviceConfig *service.ServiceConfig) (*service.Service, error) {
+	existingServiceConfig, err := builtin.serviceNetwork.GetServiceConfig(serviceName)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the config of service '%s'", serviceName)
+	}
+	existingServiceConfigJson, err := json.Marshal(existingServiceConfig)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred serializing the config of service '%s'", serviceName)
+	}
+	serviceConfigJson, err := json.Marshal(serviceConfig)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred serializing the new config of service '%s'", serviceName)
+	}
+	if !bytes.Equal(existingServiceConfigJson, serviceConfigJson) {
+		return nil, nil
+	}
+	existingService, err := builtin.serviceNetwork.GetService(ctx, string(serviceName))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting service '%s'", serviceName)
+	}
+	if existingService.GetContainer() == nil || existingService.GetContainer().GetStatus() != container.ContainerStatus_Running {
+		return nil, nil
+	}
+	return existingService, nil
 }
 
 func (builtin *AddServicesCapabilities) removeAllStartedServices(
@@ -521,7 +581,7 @@ func (builtin *AddServicesCapabilities) runServiceReadinessCheck(
 		return
 	}
 
-	if err := runServiceReadinessCheck(
+	if err := RunServiceReadinessCheck(
 		ctx,
 		builtin.serviceNetwork,
 		builtin.runtimeValueStore,
@@ -570,6 +630,13 @@ func validateAndConvertConfigsAndReadyConditions(
 		if !isDictValueAServiceConfig {
 			return nil, nil, startosis_errors.NewInterpretationError("One value of the '%s' dictionary is not a ServiceConfig (was '%s'). Values of this argument should correspond to the config of the service to be added", ConfigsArgName, reflect.TypeOf(dictValue))
 		}
+		replicas, interpretationErr := serviceConfig.GetReplicas()
+		if interpretationErr != nil {
+			return nil, nil, interpretationErr
+		}
+		if replicas > 1 {
+			return nil, nil, startosis_errors.NewInterpretationError("The config of service '%s' has '%d' replicas, but replicas are only supported by the '%s' instruction", serviceNameStr.GoString(), replicas, AddServiceBuiltinName)
+		}
 		apiServiceConfig, interpretationErr := serviceConfig.ToKurtosisType(serviceNetwork, locatorOfModuleInWhichThisBuiltInIsBeingCalled, packageId, packageContentProvider, packageReplaceOptions, imageDownloadMode)
 		if interpretationErr != nil {
 			return nil, nil, interpretationErr
@@ -0,0 +1,493 @@
+package add_service
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/launcher/args"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/dependency_graph"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_structure"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/interpretation_time_value_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/privileged_mode"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/rolling_update"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/service_config"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/plan_yaml"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_constants"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/types"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"go.starlark.net/starlark"
+)
+
+const (
+	UpdateServicesBuiltinName = "update_services"
+
+	StrategyArgName = "strategy"
+
+	updateServicesDescriptionFormatStr = "Updating '%v' services with names '%v'"
+	rollingUpdateDescriptionFormatStr  = "Updating '%v' services with names '%v', %v at a time"
+)
+
+func NewUpdateServices(
+	serviceNetwork service_network.ServiceNetwork,
+	runtimeValueStore *runtime_value_store.RuntimeValueStore,
+	packageId string,
+	packageContentProvider startosis_packages.PackageContentProvider,
+	packageReplaceOptions map[string]string,
+	interpretationTimeValueStore *interpretation_time_value_store.InterpretationTimeValueStore,
+	imageDownloadMode image_download_mode.ImageDownloadMode,
+	allowPrivilegedMode bool,
+	kurtosisBackendType args.KurtosisBackendType) *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return &kurtosis_plan_instruction.KurtosisPlanInstruction{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: UpdateServicesBuiltinName,
+
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              ConfigsArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.Dict],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						// we just try to convert the configs here to validate their shape, to avoid code duplication with Interpret
+						_, ok := value.(*starlark.Dict)
+						if !ok {
+							return startosis_errors.NewInterpretationError("The '%s' argument is not a dictionary of ServiceConfig (was '%s').", ConfigsArgName, reflect.TypeOf(value))
+						}
+						return nil
+					},
+				},
+				{
+					Name:              StrategyArgName,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*rolling_update.RollingUpdate],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						_, ok := value.(*rolling_update.RollingUpdate)
+						if !ok {
+							return startosis_errors.NewInterpretationError("The '%s' argument is not a %s (was '%s').", StrategyArgName, rolling_update.RollingUpdateTypeName, reflect.TypeOf(value))
+						}
+						return nil
+					},
+				},
+			},
+		},
+
+		Capabilities: func() kurtosis_plan_instruction.KurtosisPlanInstructionCapabilities {
+			return &UpdateServicesCapabilities{
+				serviceNetwork:               serviceNetwork,
+				runtimeValueStore:            runtimeValueStore,
+				packageId:                    packageId,
+				packageContentProvider:       packageContentProvider,
+				packageReplaceOptions:        packageReplaceOptions,
+				interpretationTimeValueStore: interpretationTimeValueStore,
+
+				serviceConfigs:      nil,                              // populated at interpretation time
+				readyConditions:     nil,                              // populated at interpretation time
+				maxUnavailable:      0,                                // populated at interpretation time
+				onFailure:           "",                               // populated at interpretation time
+				resultUuids:         map[service.ServiceName]string{}, // populated at interpretation time
+				returnValue:         nil,                              // populated at interpretation time
+				description:         "",                               // populated at interpretation time
+				imageDownloadMode:   imageDownloadMode,
+				allowPrivilegedMode: allowPrivilegedMode,
+				kurtosisBackendType: kurtosisBackendType,
+			}
+		},
+
+		DefaultDisplayArguments: map[string]bool{
+			ConfigsArgName:  true,
+			StrategyArgName: true,
+		},
+	}
+}
+
+// UpdateServicesCapabilities updates existing services in batches of at most maxUnavailable services, the services of
+// a batch having to be ready before the next batch gets updated. Without a strategy, all the services make up a single
+// batch, which is the same as updating them with add_services.
+type UpdateServicesCapabilities struct {
+	serviceNetwork               service_network.ServiceNetwork
+	runtimeValueStore            *runtime_value_store.RuntimeValueStore
+	packageId                    string
+	packageContentProvider       startosis_packages.PackageContentProvider
+	packageReplaceOptions        map[string]string
+	interpretationTimeValueStore *interpretation_time_value_store.InterpretationTimeValueStore
+
+	serviceConfigs  map[service.ServiceName]*service.ServiceConfig
+	readyConditions map[service.ServiceName]*service_config.ReadyCondition
+
+	maxUnavailable int
+	onFailure      string
+
+	resultUuids map[service.ServiceName]string
+	returnValue *starlark.Dict
+	description string
+
+	imageDownloadMode   image_download_mode.ImageDownloadMode
+	allowPrivilegedMode bool
+	kurtosisBackendType args.KurtosisBackendType
+}
+
+func (builtin *UpdateServicesCapabilities) Interpret(locatorOfModuleInWhichThisBuiltInIsBeingCalled string, arguments *builtin_argument.ArgumentValuesSet) (starlark.Value, *startosis_errors.InterpretationError) {
+	serviceConfigsDict, err := builtin_argument.ExtractArgumentValue[*starlark.Dict](arguments, ConfigsArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", ConfigsArgName)
+	}
+	serviceConfigs, readyConditions, interpretationErr := validateAndConvertConfigsAndReadyConditions(
+		builtin.serviceNetwork,
+		serviceConfigsDict,
+		locatorOfModuleInWhichThisBuiltInIsBeingCalled,
+		builtin.packageId,
+		builtin.packageContentProvider,
+		builtin.packageReplaceOptions,
+		builtin.imageDownloadMode,
+	)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	for _, serviceConfig := range serviceConfigs {
+		if interpretationErr := privileged_mode.ValidateServiceConfig(serviceConfig, builtin.allowPrivilegedMode, builtin.kurtosisBackendType); interpretationErr != nil {
+			return nil, interpretationErr
+		}
+	}
+	builtin.serviceConfigs = serviceConfigs
+	builtin.readyConditions = readyConditions
+
+	builtin.maxUnavailable = len(serviceConfigs)
+	builtin.onFailure = rolling_update.OnFailureAbort
+	description := fmt.Sprintf(updateServicesDescriptionFormatStr, len(serviceConfigs), getSortedNamesAsCommaSeparatedList(serviceConfigs))
+	if arguments.IsSet(StrategyArgName) {
+		strategy, err := builtin_argument.ExtractArgumentValue[*rolling_update.RollingUpdate](arguments, StrategyArgName)
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", StrategyArgName)
+		}
+		if builtin.maxUnavailable, interpretationErr = strategy.GetMaxUnavailable(); interpretationErr != nil {
+			return nil, interpretationErr
+		}
+		if builtin.onFailure, interpretationErr = strategy.GetOnFailure(); interpretationErr != nil {
+			return nil, interpretationErr
+		}
+		description = fmt.Sprintf(rollingUpdateDescriptionFormatStr, len(serviceConfigs), getSortedNamesAsCommaSeparatedList(serviceConfigs), builtin.maxUnavailable)
+	}
+	builtin.description = builtin_argument.GetDescriptionOrFallBack(arguments, description)
+
+	// The services keep their result UUIDs, so the values referring to them resolve to the updated services
+	resultUuids, returnValue, interpretationErr := makeAndPersistAddServicesInterpretationReturnValue(builtin.serviceConfigs, builtin.runtimeValueStore, builtin.interpretationTimeValueStore)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	for serviceName, serviceConfig := range builtin.serviceConfigs {
+		builtin.interpretationTimeValueStore.PutServiceConfig(serviceName, serviceConfig)
+	}
+	builtin.resultUuids = resultUuids
+	builtin.returnValue = returnValue
+	return returnValue, nil
+}
+
+func (builtin *UpdateServicesCapabilities) Validate(_ *builtin_argument.ArgumentValuesSet, validatorEnvironment *startosis_validator.ValidatorEnvironment) *startosis_errors.ValidationError {
+	for _, serviceName := range getSortedServiceNames(builtin.serviceConfigs) {
+		serviceConfig := builtin.serviceConfigs[serviceName]
+		if validatorEnvironment.DoesServiceNameExist(serviceName) == startosis_validator.ComponentNotFound {
+			return startosis_errors.NewValidationError("Service '%v' required by '%v' instruction doesn't exist", serviceName, UpdateServicesBuiltinName)
+		}
+		if replicaSetName, isReplica := validatorEnvironment.GetServiceReplicaSetName(serviceName); isReplica {
+			return startosis_errors.NewValidationError("Service '%v' is a replica of replica set '%v', which can only be updated by adding the replica set again", serviceName, replicaSetName)
+		}
+		if serviceConfig.GetFilesArtifactsExpansion() != nil {
+			for _, artifactNames := range serviceConfig.GetFilesArtifactsExpansion().ServiceDirpathsToArtifactIdentifiers {
+				for _, artifactName := range artifactNames {
+					if validatorEnvironment.DoesArtifactNameExist(artifactName) == startosis_validator.ComponentNotFound {
+						return startosis_errors.NewValidationError("There was an error validating '%s' as artifact name '%s' does not exist", UpdateServicesBuiltinName, artifactName)
+					}
+				}
+			}
+		}
+		// replaces what the service claimed with its current config
+		if validationErr := validatorEnvironment.ClaimEnclaveResources(serviceConfig, serviceName); validationErr != nil {
+			return validationErr
+		}
+		if validationErr := validateImageAndPorts(validatorEnvironment, serviceName, serviceConfig); validationErr != nil {
+			return validationErr
+		}
+	}
+	return nil
+}
+
+func (builtin *UpdateServicesCapabilities) Execute(ctx context.Context, _ *builtin_argument.ArgumentValuesSet) (string, error) {
+	parallelism, ok := ctx.Value(startosis_constants.ParallelismParam).(int)
+	if !ok {
+		return "", stacktrace.NewError("An error occurred when getting parallelism level from execution context")
+	}
+	renderedServiceConfigs := make(map[service.ServiceName]*service.ServiceConfig, len(builtin.serviceConfigs))
+	for serviceName, serviceConfig := range builtin.serviceConfigs {
+		renderedServiceName, renderedServiceConfig, err := replaceMagicStrings(builtin.runtimeValueStore, serviceName, serviceConfig)
+		if err != nil {
+			return "", stacktrace.Propagate(err, "An error occurred replacing a magic string in '%s' instruction arguments for service: '%s'. Execution cannot proceed", UpdateServicesBuiltinName, serviceName)
+		}
+		renderedServiceConfigs[renderedServiceName] = renderedServiceConfig
+	}
+
+	readyConditions, err := builtin.getReadyConditions(ctx)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the ready conditions of services '%v'", getSortedNamesAsCommaSeparatedList(renderedServiceConfigs))
+	}
+
+	updatedServices, err := builtin.runRollingUpdate(ctx, renderedServiceConfigs, readyConditions, parallelism)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred updating services '%v'", getSortedNamesAsCommaSeparatedList(renderedServiceConfigs))
+	}
+
+	instructionResult := strings.Builder{}
+	fmt.Fprintf(&instructionResult, "Successfully updated the following '%d' services:", len(updatedServices))
+	for _, serviceName := range getSortedServiceNames(renderedServiceConfigs) {
+		serviceObj := updatedServices[serviceName]
+		if err := fillAddServiceReturnValueWithRuntimeValues(serviceObj, builtin.resultUuids[serviceName], builtin.runtimeValueStore); err != nil {
+			return "", stacktrace.Propagate(err, "An error occurred while filling service return values with result key UUID '%s'", builtin.resultUuids[serviceName])
+		}
+		fmt.Fprintf(&instructionResult, "\n  Service '%s' updated with UUID '%s'", serviceName, serviceObj.GetRegistration().GetUUID())
+	}
+	return instructionResult.String(), nil
+}
+
+func (builtin *UpdateServicesCapabilities) TryResolveWith(instructionsAreEqual bool, other *enclave_plan_persistence.EnclavePlanInstruction, enclaveComponents *enclave_structure.EnclaveComponents) enclave_structure.InstructionResolutionStatus {
+	if other == nil || other.Type != UpdateServicesBuiltinName {
+		return enclave_structure.InstructionIsUnknown
+	}
+
+	// the services have to be updated again if they were re-created by a previous instruction, or if the files
+	// mounted in them changed
+	isAnyDependencyUpdated := false
+	for serviceName, serviceConfig := range builtin.serviceConfigs {
+		if enclaveComponents.HasServiceBeenUpdated(serviceName) {
+			isAnyDependencyUpdated = true
+		}
+		if filesArtifactsExpansion := serviceConfig.GetFilesArtifactsExpansion(); filesArtifactsExpansion != nil {
+			for _, filesArtifactNames := range filesArtifactsExpansion.ServiceDirpathsToArtifactIdentifiers {
+				for _, filesArtifactName := range filesArtifactNames {
+					if enclaveComponents.HasFilesArtifactBeenUpdated(filesArtifactName) {
+						isAnyDependencyUpdated = true
+					}
+				}
+			}
+		}
+	}
+	if instructionsAreEqual && !isAnyDependencyUpdated {
+		return enclave_structure.InstructionIsEqual
+	}
+	for serviceName := range builtin.serviceConfigs {
+		enclaveComponents.AddService(serviceName, enclave_structure.ComponentIsUpdated)
+	}
+	return enclave_structure.InstructionIsUpdate
+}
+
+func (builtin *UpdateServicesCapabilities) FillPersistableAttributes(builder *enclave_plan_persistence.EnclavePlanInstructionBuilder) {
+	builder.SetType(UpdateServicesBuiltinName)
+	for _, serviceName := range getSortedServiceNames(builtin.serviceConfigs) {
+		builder.AddServiceName(serviceName)
+	}
+}
+
+func (builtin *UpdateServicesCapabilities) UpdatePlan(_ *plan_yaml.PlanYamlGenerator) error {
+	// the services were added to the plan by the instructions adding them, updating them doesn't affect it
+	return nil
+}
+
+func (builtin *UpdateServicesCapabilities) UpdateDependencyGraph(instructionUuid types.ScheduledInstructionUuid, dependencyGraph *dependency_graph.InstructionDependencyGraph) error {
+	serviceNames := getSortedServiceNames(builtin.serviceConfigs)
+	serviceNameStrs := make([]string, 0, len(serviceNames))
+	for _, serviceName := range serviceNames {
+		serviceNameStr := string(serviceName)
+		serviceNameStrs = append(serviceNameStrs, serviceNameStr)
+
+		serviceObjVal, found, err := builtin.returnValue.Get(starlark.String(serviceName))
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the return value for service '%s'", serviceNameStr)
+		}
+		if !found {
+			return stacktrace.NewError("Expected to find a Service object in the return value for service '%s', but none was found; this is a bug in Kurtosis", serviceNameStr)
+		}
+		serviceObj, ok := serviceObjVal.(*kurtosis_types.Service)
+		if !ok {
+			return stacktrace.NewError("Expected to be able to cast the return value for service '%s' to a Service object, but got '%s'", serviceNameStr, reflect.TypeOf(serviceObjVal))
+		}
+		// the instructions using the services after this one depend on it, as they use the updated services
+		if err := addServiceToDependencyGraph(instructionUuid, dependencyGraph, serviceNameStr, serviceObj, builtin.serviceConfigs[serviceName]); err != nil {
+			return stacktrace.Propagate(err, "An error occurred updating the dependency graph with service '%s'", serviceNameStr)
+		}
+	}
+	shortDescriptor := fmt.Sprintf("update_services(%d services: %s)", len(serviceNames), strings.Join(serviceNameStrs, ", "))
+	dependencyGraph.UpdateInstructionShortDescriptor(instructionUuid, shortDescriptor)
+	return nil
+}
+
+func (builtin *UpdateServicesCapabilities) Description() string {
+	return builtin.description
+}
+
+// getReadyConditions returns the ready conditions each batch is waited for with. The services updated with a config
+// that doesn't declare any, like the configs the CLI builds out of the running services, keep the ones they were added
+// with in the enclave plan
+func (builtin *UpdateServicesCapabilities) getReadyConditions(ctx context.Context) (map[service.ServiceName]*service_config.ReadyCondition, error) {
+	readyConditions := map[service.ServiceName]*service_config.ReadyCondition{}
+	for serviceName, readyCondition := range builtin.readyConditions {
+		if readyCondition != nil {
+			readyConditions[serviceName] = readyCondition
+		}
+	}
+	getEnclavePlanReadyConditions, ok := ctx.Value(startosis_constants.EnclavePlanReadyConditionsGetterParam).(func() (map[service.ServiceName]*service_config.ReadyCondition, error))
+	if !ok || len(readyConditions) == len(builtin.serviceConfigs) {
+		return readyConditions, nil
+	}
+	enclavePlanReadyConditions, err := getEnclavePlanReadyConditions()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the ready conditions of the services of the enclave plan")
+	}
+	for serviceName := range builtin.serviceConfigs {
+		if _, found := readyConditions[serviceName]; found {
+			continue
+		}
+		if readyCondition, found := enclavePlanReadyConditions[serviceName]; found {
+			readyConditions[serviceName] = readyCondition
+		}
+	}
+	return readyConditions, nil
+}
+
+// runRollingUpdate updates the services batch by batch, in the order of their names, and handles a failed batch
+// according to the strategy
+func (builtin *UpdateServicesCapabilities) runRollingUpdate(
+	ctx context.Context,
+	renderedServiceConfigs map[service.ServiceName]*service.ServiceConfig,
+	readyConditions map[service.ServiceName]*service_config.ReadyCondition,
+	parallelism int,
+) (map[service.ServiceName]*service.Service, error) {
+	// the configs to roll back to are fetched before anything changes, as updating a service replaces its config
+	previousServiceConfigs := map[service.ServiceName]*service.ServiceConfig{}
+	for serviceName := range renderedServiceConfigs {
+		previousServiceConfig, err := builtin.serviceNetwork.GetServiceConfig(serviceName)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the current config of service '%v'", serviceName)
+		}
+		previousServiceConfigs[serviceName] = previousServiceConfig
+	}
+
+	batches := splitServiceNamesInBatches(getSortedServiceNames(renderedServiceConfigs), builtin.maxUnavailable)
+	updatedServices := map[service.ServiceName]*service.Service{}
+	for batchIdx, batch := range batches {
+		logrus.Debugf("Updating batch %d of %d: %v", batchIdx+1, len(batches), batch)
+		batchServiceConfigs := map[service.ServiceName]*service.ServiceConfig{}
+		for _, serviceName := range batch {
+			batchServiceConfigs[serviceName] = renderedServiceConfigs[serviceName]
+		}
+		updatedBatchServices, err := builtin.updateBatch(ctx, batchServiceConfigs, readyConditions, parallelism)
+		if err != nil {
+			return nil, builtin.handleFailedBatch(ctx, batches, batchIdx, previousServiceConfigs, parallelism, err)
+		}
+		for serviceName, updatedService := range updatedBatchServices {
+			updatedServices[serviceName] = updatedService
+		}
+	}
+	return updatedServices, nil
+}
+
+// updateBatch updates the services of the batch and waits for them to be ready
+func (builtin *UpdateServicesCapabilities) updateBatch(
+	ctx context.Context,
+	batchServiceConfigs map[service.ServiceName]*service.ServiceConfig,
+	readyConditions map[service.ServiceName]*service_config.ReadyCondition,
+	parallelism int,
+) (map[service.ServiceName]*service.Service, error) {
+	updatedServices, failedServices, err := builtin.serviceNetwork.UpdateServices(ctx, batchServiceConfigs, parallelism)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Unexpected error occurred updating services '%v'", getSortedNamesAsCommaSeparatedList(batchServiceConfigs))
+	}
+	if len(failedServices) > 0 {
+		return nil, stacktrace.NewError("Some errors occurred updating the following services, errors were:\n%v", failedServices)
+	}
+	for _, serviceName := range getSortedServiceNames(batchServiceConfigs) {
+		updatedService, found := updatedServices[serviceName]
+		if !found {
+			return nil, stacktrace.NewError("Service '%v' was expected to be updated but it wasn't; this is a bug in Kurtosis", serviceName)
+		}
+		if err := RunServiceReadinessCheck(ctx, builtin.serviceNetwork, builtin.runtimeValueStore, serviceName, updatedService, readyConditions[serviceName]); err != nil {
+			return nil, stacktrace.Propagate(err, "Service '%v' was updated but didn't become ready", serviceName)
+		}
+	}
+	return updatedServices, nil
+}
+
+// handleFailedBatch either leaves the services as they are or rolls back every service updated so far, the failed
+// batch included, and returns the error to fail the instruction with
+func (builtin *UpdateServicesCapabilities) handleFailedBatch(
+	ctx context.Context,
+	batches [][]service.ServiceName,
+	failedBatchIdx int,
+	previousServiceConfigs map[service.ServiceName]*service.ServiceConfig,
+	parallelism int,
+	batchErr error,
+) error {
+	failedBatchDescription := fmt.Sprintf("batch %d of %d with services %v", failedBatchIdx+1, len(batches), batches[failedBatchIdx])
+	if builtin.onFailure != rolling_update.OnFailureRollback {
+		return stacktrace.Propagate(batchErr, "Updating %s failed. The update was aborted: the services of the previous batches keep their new config and the services of the next batches keep their previous one", failedBatchDescription)
+	}
+
+	servicesToRollBack := map[service.ServiceName]*service.ServiceConfig{}
+	for _, batch := range batches[:failedBatchIdx+1] {
+		for _, serviceName := range batch {
+			servicesToRollBack[serviceName] = previousServiceConfigs[serviceName]
+		}
+	}
+	logrus.Infof("Updating %s failed, rolling back services '%v' to their previous config", failedBatchDescription, getSortedNamesAsCommaSeparatedList(servicesToRollBack))
+	_, failedRollbacks, err := builtin.serviceNetwork.UpdateServices(ctx, servicesToRollBack, parallelism)
+	if err != nil {
+		return stacktrace.Propagate(err, "Updating %s failed, and then an error occurred rolling back the services updated so far. The update error was:\n%v", failedBatchDescription, batchErr)
+	}
+	if len(failedRollbacks) > 0 {
+		return stacktrace.NewError("Updating %s failed, and then rolling back the following services failed too:\n%v\nThe update error was:\n%v", failedBatchDescription, failedRollbacks, batchErr)
+	}
+	return stacktrace.Propagate(batchErr, "Updating %s failed, so the %d services updated so far were rolled back to their previous config", failedBatchDescription, len(servicesToRollBack))
+}
+
+func splitServiceNamesInBatches(serviceNames []service.ServiceName, batchSize int) [][]service.ServiceName {
+	batches := [][]service.ServiceName{}
+	for batchStartIdx := 0; batchStartIdx < len(serviceNames); batchStartIdx += batchSize {
+		batchEndIdx := batchStartIdx + batchSize
+		if batchEndIdx > len(serviceNames) {
+			batchEndIdx = len(serviceNames)
+		}
+		batches = append(batches, serviceNames[batchStartIdx:batchEndIdx])
+	}
+	return batches
+}
+
+func getSortedServiceNames(serviceConfigs map[service.ServiceName]*service.ServiceConfig) []service.ServiceName {
+	serviceNames := make([]service.ServiceName, 0, len(serviceConfigs))
+	for serviceName := range serviceConfigs {
+		serviceNames = append(serviceNames, serviceName)
+	}
+	sort.Slice(serviceNames, func(i, j int) bool {
+		return serviceNames[i] < serviceNames[j]
+	})
+	return serviceNames
+}
+
+func getSortedNamesAsCommaSeparatedList(serviceConfigs map[service.ServiceName]*service.ServiceConfig) string {
+	serviceNames := []string{}
+	for _, serviceName := range getSortedServiceNames(serviceConfigs) {
+		serviceNames = append(serviceNames, string(serviceName))
+	}
+	return strings.Join(serviceNames, ",")
+}
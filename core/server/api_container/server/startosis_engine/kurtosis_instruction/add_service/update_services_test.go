@@ -0,0 +1,215 @@
+package add_service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/launcher/args"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/rolling_update"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/service_config"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_constants"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testRollingUpdateServiceName1 = service.ServiceName("service-1")
+	testRollingUpdateServiceName2 = service.ServiceName("service-2")
+	testRollingUpdateServiceName3 = service.ServiceName("service-3")
+
+	testRollingUpdateParallelism = 4
+)
+
+func TestSplitServiceNamesInBatches(t *testing.T) {
+	serviceNames := []service.ServiceName{testRollingUpdateServiceName1, testRollingUpdateServiceName2, testRollingUpdateServiceName3}
+
+	require.Equal(t, [][]service.ServiceName{
+		{testRollingUpdateServiceName1, testRollingUpdateServiceName2},
+		{testRollingUpdateServiceName3},
+	}, splitServiceNamesInBatches(serviceNames, 2))
+	require.Equal(t, [][]service.ServiceName{serviceNames}, splitServiceNamesInBatches(serviceNames, 3))
+	require.Equal(t, [][]service.ServiceName{serviceNames}, splitServiceNamesInBatches(serviceNames, 10))
+}
+
+func TestRunRollingUpdate_FailedBatchIsRolledBack(t *testing.T) {
+	serviceNetwork := service_network.NewMockServiceNetwork(t)
+	newServiceConfigs, previousServiceConfigs := getRollingUpdateTestConfigs(t, serviceNetwork)
+
+	// the first batch is updated and the second one fails, so both get their previous config back
+	serviceNetwork.EXPECT().UpdateServices(mock.Anything, map[service.ServiceName]*service.ServiceConfig{
+		testRollingUpdateServiceName1: newServiceConfigs[testRollingUpdateServiceName1],
+		testRollingUpdateServiceName2: newServiceConfigs[testRollingUpdateServiceName2],
+	}, testRollingUpdateParallelism).Times(1).Return(getRollingUpdateTestServices(testRollingUpdateServiceName1, testRollingUpdateServiceName2), map[service.ServiceName]error{}, nil)
+	serviceNetwork.EXPECT().UpdateServices(mock.Anything, map[service.ServiceName]*service.ServiceConfig{
+		testRollingUpdateServiceName3: newServiceConfigs[testRollingUpdateServiceName3],
+	}, testRollingUpdateParallelism).Times(1).Return(map[service.ServiceName]*service.Service{}, map[service.ServiceName]error{
+		testRollingUpdateServiceName3: errors.New("image not found"),
+	}, nil)
+	serviceNetwork.EXPECT().UpdateServices(mock.Anything, previousServiceConfigs, testRollingUpdateParallelism).Times(1).Return(getRollingUpdateTestServices(testRollingUpdateServiceName1, testRollingUpdateServiceName2, testRollingUpdateServiceName3), map[service.ServiceName]error{}, nil)
+
+	capabilities := newUpdateServicesCapabilitiesForTest(serviceNetwork, newServiceConfigs, 2, rolling_update.OnFailureRollback)
+	updatedServices, err := capabilities.runRollingUpdate(context.Background(), newServiceConfigs, nil, testRollingUpdateParallelism)
+	require.Error(t, err)
+	require.Nil(t, updatedServices)
+	require.Contains(t, err.Error(), "the 3 services updated so far were rolled back to their previous config")
+	require.Contains(t, err.Error(), "image not found")
+}
+
+func TestRunRollingUpdate_FailedBatchIsAborted(t *testing.T) {
+	serviceNetwork := service_network.NewMockServiceNetwork(t)
+	newServiceConfigs, _ := getRollingUpdateTestConfigs(t, serviceNetwork)
+
+	// the services of the first batch keep their new config, and the third service is never updated
+	serviceNetwork.EXPECT().UpdateServices(mock.Anything, map[service.ServiceName]*service.ServiceConfig{
+		testRollingUpdateServiceName1: newServiceConfigs[testRollingUpdateServiceName1],
+	}, testRollingUpdateParallelism).Times(1).Return(getRollingUpdateTestServices(testRollingUpdateServiceName1), map[service.ServiceName]error{}, nil)
+	serviceNetwork.EXPECT().UpdateServices(mock.Anything, map[service.ServiceName]*service.ServiceConfig{
+		testRollingUpdateServiceName2: newServiceConfigs[testRollingUpdateServiceName2],
+	}, testRollingUpdateParallelism).Times(1).Return(nil, nil, errors.New("unexpected error"))
+
+	capabilities := newUpdateServicesCapabilitiesForTest(serviceNetwork, newServiceConfigs, 1, rolling_update.OnFailureAbort)
+	updatedServices, err := capabilities.runRollingUpdate(context.Background(), newServiceConfigs, nil, testRollingUpdateParallelism)
+	require.Error(t, err)
+	require.Nil(t, updatedServices)
+	require.Contains(t, err.Error(), "Updating batch 2 of 3 with services [service-2] failed. The update was aborted")
+}
+
+func TestRunRollingUpdate_AllBatchesSucceed(t *testing.T) {
+	serviceNetwork := service_network.NewMockServiceNetwork(t)
+	newServiceConfigs, _ := getRollingUpdateTestConfigs(t, serviceNetwork)
+
+	serviceNetwork.EXPECT().UpdateServices(mock.Anything, map[service.ServiceName]*service.ServiceConfig{
+		testRollingUpdateServiceName1: newServiceConfigs[testRollingUpdateServiceName1],
+		testRollingUpdateServiceName2: newServiceConfigs[testRollingUpdateServiceName2],
+	}, testRollingUpdateParallelism).Times(1).Return(getRollingUpdateTestServices(testRollingUpdateServiceName1, testRollingUpdateServiceName2), map[service.ServiceName]error{}, nil)
+	serviceNetwork.EXPECT().UpdateServices(mock.Anything, map[service.ServiceName]*service.ServiceConfig{
+		testRollingUpdateServiceName3: newServiceConfigs[testRollingUpdateServiceName3],
+	}, testRollingUpdateParallelism).Times(1).Return(getRollingUpdateTestServices(testRollingUpdateServiceName3), map[service.ServiceName]error{}, nil)
+
+	capabilities := newUpdateServicesCapabilitiesForTest(serviceNetwork, newServiceConfigs, 2, rolling_update.OnFailureRollback)
+	updatedServices, err := capabilities.runRollingUpdate(context.Background(), newServiceConfigs, nil, testRollingUpdateParallelism)
+	require.NoError(t, err)
+	require.Len(t, updatedServices, 3)
+}
+
+func TestGetReadyConditions_FallsBackOnEnclavePlanReadyConditions(t *testing.T) {
+	declaredReadyCondition := &service_config.ReadyCondition{KurtosisValueTypeDefault: nil}
+	enclavePlanReadyCondition1 := &service_config.ReadyCondition{KurtosisValueTypeDefault: nil}
+	enclavePlanReadyCondition2 := &service_config.ReadyCondition{KurtosisValueTypeDefault: nil}
+
+	capabilities := newUpdateServicesCapabilitiesForTest(nil, map[service.ServiceName]*service.ServiceConfig{
+		testRollingUpdateServiceName1: nil,
+		testRollingUpdateServiceName2: nil,
+		testRollingUpdateServiceName3: nil,
+	}, 1, rolling_update.OnFailureRollback)
+	capabilities.readyConditions = map[service.ServiceName]*service_config.ReadyCondition{
+		testRollingUpdateServiceName1: declaredReadyCondition,
+		testRollingUpdateServiceName2: nil,
+		testRollingUpdateServiceName3: nil,
+	}
+
+	// without the enclave plan, only the declared ready conditions are used
+	readyConditions, err := capabilities.getReadyConditions(context.Background())
+	require.NoError(t, err)
+	require.Len(t, readyConditions, 1)
+	require.Same(t, declaredReadyCondition, readyConditions[testRollingUpdateServiceName1])
+
+	ctx := context.WithValue(context.Background(), startosis_constants.EnclavePlanReadyConditionsGetterParam, func() (map[service.ServiceName]*service_config.ReadyCondition, error) {
+		return map[service.ServiceName]*service_config.ReadyCondition{
+			testRollingUpdateServiceName1: enclavePlanReadyCondition1,
+			testRollingUpdateServiceName2: enclavePlanReadyCondition2,
+		}, nil
+	})
+	readyConditions, err = capabilities.getReadyConditions(ctx)
+	require.NoError(t, err)
+	require.Len(t, readyConditions, 2)
+	require.Same(t, declaredReadyCondition, readyConditions[testRollingUpdateServiceName1])
+	require.Same(t, enclavePlanReadyCondition2, readyConditions[testRollingUpdateServiceName2])
+}
+
+func TestValidate_UpdatedServiceMustFitInEnclaveResourceQuota(t *testing.T) {
+	previousServiceConfig := getRollingUpdateTestConfig(t, "previous-image")
+	previousServiceConfig.SetMinCPUAllocationMillicpus(400)
+	validatorEnvironment := startosis_validator.NewValidatorEnvironment(
+		map[service.ServiceName]bool{testRollingUpdateServiceName1: true},
+		map[string]bool{},
+		map[service.ServiceName][]string{},
+		0,
+		0,
+		false,
+		image_download_mode.ImageDownloadMode_Missing,
+		enclave.NewEnclaveResourceQuota(500, 0, 0, 0),
+		map[service.ServiceName]*service_network.ServiceResourceClaim{
+			testRollingUpdateServiceName1: service_network.NewServiceResourceClaim(previousServiceConfig),
+		},
+		map[service.ServiceName]string{},
+	)
+
+	// the update replaces what the service claimed before
+	newServiceConfig := getRollingUpdateTestConfig(t, "new-image")
+	newServiceConfig.SetMinCPUAllocationMillicpus(500)
+	builtin := newUpdateServicesCapabilitiesForTest(nil, map[service.ServiceName]*service.ServiceConfig{testRollingUpdateServiceName1: newServiceConfig}, 1, rolling_update.OnFailureAbort)
+	require.Nil(t, builtin.Validate(nil, validatorEnvironment))
+
+	newServiceConfig.SetMinCPUAllocationMillicpus(600)
+	validationErr := builtin.Validate(nil, validatorEnvironment)
+	require.NotNil(t, validationErr)
+	require.Contains(t, validationErr.Error(), "resource quota")
+}
+
+func getRollingUpdateTestConfigs(t *testing.T, serviceNetwork *service_network.MockServiceNetwork) (map[service.ServiceName]*service.ServiceConfig, map[service.ServiceName]*service.ServiceConfig) {
+	newServiceConfigs := map[service.ServiceName]*service.ServiceConfig{}
+	previousServiceConfigs := map[service.ServiceName]*service.ServiceConfig{}
+	for _, serviceName := range []service.ServiceName{testRollingUpdateServiceName1, testRollingUpdateServiceName2, testRollingUpdateServiceName3} {
+		newServiceConfigs[serviceName] = getRollingUpdateTestConfig(t, "new-image")
+		previousServiceConfigs[serviceName] = getRollingUpdateTestConfig(t, "previous-image")
+		serviceNetwork.EXPECT().GetServiceConfig(serviceName).Times(1).Return(previousServiceConfigs[serviceName], nil)
+	}
+	return newServiceConfigs, previousServiceConfigs
+}
+
+func getRollingUpdateTestConfig(t *testing.T, imageName string) *service.ServiceConfig {
+	serviceConfig, err := service.CreateServiceConfig(imageName, nil, nil, nil, nil, nil, []string{}, []string{}, map[string]string{}, nil, nil, 0, 0, "", 0, 0, map[string]string{}, nil, nil, nil, image_download_mode.ImageDownloadMode_Missing, true, false, []string{}, false, service.NewGpuConfig(0, nil, 0, nil, "", ""))
+	require.NoError(t, err)
+	return serviceConfig
+}
+
+func getRollingUpdateTestServices(serviceNames ...service.ServiceName) map[service.ServiceName]*service.Service {
+	services := map[service.ServiceName]*service.Service{}
+	for _, serviceName := range serviceNames {
+		services[serviceName] = service.NewService(service.NewServiceRegistration(serviceName, service.ServiceUUID(serviceName), "", nil, string(serviceName)), nil, nil, nil, nil)
+	}
+	return services
+}
+
+func newUpdateServicesCapabilitiesForTest(
+	serviceNetwork service_network.ServiceNetwork,
+	serviceConfigs map[service.ServiceName]*service.ServiceConfig,
+	maxUnavailable int,
+	onFailure string,
+) *UpdateServicesCapabilities {
+	return &UpdateServicesCapabilities{
+		serviceNetwork:               serviceNetwork,
+		runtimeValueStore:            nil,
+		packageId:                    "",
+		packageContentProvider:       nil,
+		packageReplaceOptions:        nil,
+		interpretationTimeValueStore: nil,
+		serviceConfigs:               serviceConfigs,
+		readyConditions:              nil,
+		maxUnavailable:               maxUnavailable,
+		onFailure:                    onFailure,
+		resultUuids:                  nil,
+		returnValue:                  nil,
+		description:                  "",
+		imageDownloadMode:            image_download_mode.ImageDownloadMode_Missing,
+		allowPrivilegedMode:          false,
+		kurtosisBackendType:          args.KurtosisBackendType_Docker,
+	}
+}
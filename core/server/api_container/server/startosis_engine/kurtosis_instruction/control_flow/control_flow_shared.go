@@ -0,0 +1,44 @@
+package control_flow
+
+import (
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/instructions_plan"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers/magic_string_helper"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/types"
+	"github.com/kurtosis-tech/stacktrace"
+	"go.starlark.net/starlark"
+)
+
+// interpretBranch calls the Starlark function of a branch of a control flow instruction, such that all the instructions
+// it adds to the plan are tagged with the branch
+func interpretBranch(
+	thread *starlark.Thread,
+	instructionsPlan *instructions_plan.InstructionsPlan,
+	controlFlowInstructionUuid types.ScheduledInstructionUuid,
+	controlFlow instructions_plan.ControlFlow,
+	branch string,
+	branchFunction starlark.Callable,
+	branchFunctionArgs starlark.Tuple,
+) error {
+	if err := instructionsPlan.OpenControlFlowBlock(controlFlowInstructionUuid, controlFlow, branch); err != nil {
+		return err
+	}
+	if _, err := starlark.Call(thread, branchFunction, branchFunctionArgs, nil); err != nil {
+		return err
+	}
+	return instructionsPlan.CloseControlFlowBlock()
+}
+
+// resolveRuntimeValue returns the value with its runtime values replaced if it is a string, or the value itself
+// otherwise
+func resolveRuntimeValue(value starlark.Value, runtimeValueStore *runtime_value_store.RuntimeValueStore) (starlark.Value, error) {
+	valueStr, ok := value.(starlark.String)
+	if !ok {
+		return value, nil
+	}
+	resolvedValue, err := magic_string_helper.GetOrReplaceRuntimeValueFromString(valueStr.GoString(), runtimeValueStore)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred replacing the runtime values in '%s'", valueStr.GoString())
+	}
+	return resolvedValue, nil
+}
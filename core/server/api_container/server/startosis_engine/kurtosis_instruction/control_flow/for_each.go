@@ -0,0 +1,243 @@
+package control_flow
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/dependency_graph"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_structure"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/instructions_plan"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers/magic_string_helper"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/plan_yaml"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/types"
+	"github.com/kurtosis-tech/stacktrace"
+	"go.starlark.net/starlark"
+)
+
+const (
+	ForEachBuiltinName = "for_each"
+
+	ItemsArgName = "items"
+	BodyArgName  = "body"
+
+	BodyBranch = "body"
+
+	itemRuntimeValueField = "item"
+	itemsLineSeparator    = "\n"
+
+	forEachDescriptionFormatStr = "Iterating over '%v'"
+)
+
+func NewForEach(runtimeValueStore *runtime_value_store.RuntimeValueStore) *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return &kurtosis_plan_instruction.KurtosisPlanInstruction{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: ForEachBuiltinName,
+
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              ItemsArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Value],
+					Validator:         validateItems,
+				},
+				{
+					Name:              BodyArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Callable],
+					Validator:         nil,
+				},
+			},
+		},
+
+		Capabilities: func() kurtosis_plan_instruction.KurtosisPlanInstructionCapabilities {
+			return &ForEachCapabilities{
+				runtimeValueStore: runtimeValueStore,
+
+				items:         nil, // populated at interpretation time
+				bodyFunction:  nil, // populated at interpretation time
+				itemUuid:      "",  // populated at interpretation time
+				description:   "",  // populated at interpretation time
+				resolvedItems: nil, // populated at execution time
+			}
+		},
+
+		DefaultDisplayArguments: map[string]bool{
+			ItemsArgName: true,
+		},
+	}
+}
+
+type ForEachCapabilities struct {
+	runtimeValueStore *runtime_value_store.RuntimeValueStore
+
+	items        starlark.Value
+	bodyFunction starlark.Callable
+
+	// the body is interpreted once, with a runtime value standing for the current item. The executor sets it before
+	// each iteration
+	itemUuid string
+
+	description string
+
+	resolvedItems []starlark.Comparable
+}
+
+func (builtin *ForEachCapabilities) Interpret(_ string, arguments *builtin_argument.ArgumentValuesSet) (starlark.Value, *startosis_errors.InterpretationError) {
+	items, err := builtin_argument.ExtractArgumentValue[starlark.Value](arguments, ItemsArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", ItemsArgName)
+	}
+	bodyFunction, err := builtin_argument.ExtractArgumentValue[starlark.Callable](arguments, BodyArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", BodyArgName)
+	}
+
+	itemUuid, err := builtin.runtimeValueStore.CreateValue()
+	if err != nil {
+		return nil, startosis_errors.NewInterpretationError("An error occurred while generating UUID for future reference for %v instruction", ForEachBuiltinName)
+	}
+
+	builtin.items = items
+	builtin.bodyFunction = bodyFunction
+	builtin.itemUuid = itemUuid
+	builtin.description = builtin_argument.GetDescriptionOrFallBack(arguments, fmt.Sprintf(forEachDescriptionFormatStr, builtin.items))
+	return starlark.None, nil
+}
+
+// InterpretBody plans the instructions of the body once. The executor repeats them for each item
+func (builtin *ForEachCapabilities) InterpretBody(thread *starlark.Thread, instructionsPlan *instructions_plan.InstructionsPlan, instructionUuid types.ScheduledInstructionUuid) error {
+	item := starlark.String(builtin.getItemRuntimeValue())
+	return interpretBranch(thread, instructionsPlan, instructionUuid, builtin, BodyBranch, builtin.bodyFunction, starlark.Tuple{item})
+}
+
+func (builtin *ForEachCapabilities) Validate(_ *builtin_argument.ArgumentValuesSet, _ *startosis_validator.ValidatorEnvironment) *startosis_errors.ValidationError {
+	return nil
+}
+
+func (builtin *ForEachCapabilities) Execute(_ context.Context, _ *builtin_argument.ArgumentValuesSet) (string, error) {
+	resolvedItems, err := builtin.resolveItems()
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred resolving the items to iterate over '%v'", builtin.items)
+	}
+	builtin.resolvedItems = resolvedItems
+	return fmt.Sprintf("Iterating over %d items", len(resolvedItems)), nil
+}
+
+func (builtin *ForEachCapabilities) NumberOfIterations(_ string) int {
+	return len(builtin.resolvedItems)
+}
+
+func (builtin *ForEachCapabilities) PrepareIteration(_ string, iteration int) error {
+	if iteration >= len(builtin.resolvedItems) {
+		return stacktrace.NewError("Iteration %d is out of the %d items resolved. This is a Kurtosis internal bug", iteration, len(builtin.resolvedItems))
+	}
+	item := builtin.resolvedItems[iteration]
+	if err := builtin.runtimeValueStore.SetValue(builtin.itemUuid, map[string]starlark.Comparable{itemRuntimeValueField: item}); err != nil {
+		return stacktrace.Propagate(err, "An error occurred setting item '%v' using key UUID '%s' in the runtime value store", item, builtin.itemUuid)
+	}
+	return nil
+}
+
+func (builtin *ForEachCapabilities) GetIterationRuntimeValueUuids(_ string) []string {
+	return []string{builtin.itemUuid}
+}
+
+// TryResolveWith always aborts, as the number of times the body ran is only known at execution time
+func (builtin *ForEachCapabilities) TryResolveWith(_ bool, _ *enclave_plan_persistence.EnclavePlanInstruction, _ *enclave_structure.EnclaveComponents) enclave_structure.InstructionResolutionStatus {
+	return enclave_structure.InstructionIsNotResolvableAbort
+}
+
+func (builtin *ForEachCapabilities) FillPersistableAttributes(builder *enclave_plan_persistence.EnclavePlanInstructionBuilder) {
+	builder.SetType(ForEachBuiltinName)
+}
+
+// UpdatePlan adds the loop to the plan, the instructions of the body adding themselves as conditional on it
+func (builtin *ForEachCapabilities) UpdatePlan(planYaml *plan_yaml.PlanYamlGenerator) error {
+	planYaml.AddForEach(builtin.items, builtin.getItemRuntimeValue())
+	return nil
+}
+
+func (builtin *ForEachCapabilities) Description() string {
+	return builtin.description
+}
+
+// UpdateDependencyGraph updates the dependency graph with the effects of running this instruction.
+func (builtin *ForEachCapabilities) UpdateDependencyGraph(instructionUuid types.ScheduledInstructionUuid, dependencyGraph *dependency_graph.InstructionDependencyGraph) error {
+	shortDescriptor := fmt.Sprintf("for_each(%s)", builtin.description)
+	dependencyGraph.UpdateInstructionShortDescriptor(instructionUuid, shortDescriptor)
+	dependencyGraph.AddControlFlowInstruction(instructionUuid)
+
+	switch items := builtin.items.(type) {
+	case starlark.String:
+		dependencyGraph.ConsumesAnyRuntimeValuesInString(instructionUuid, items.GoString())
+	case starlark.Iterable:
+		iterator := items.Iterate()
+		defer iterator.Done()
+		var item starlark.Value
+		for iterator.Next(&item) {
+			if itemStr, ok := item.(starlark.String); ok {
+				dependencyGraph.ConsumesAnyRuntimeValuesInString(instructionUuid, itemStr.GoString())
+			}
+		}
+	}
+	dependencyGraph.ProducesRuntimeValue(instructionUuid, builtin.getItemRuntimeValue())
+	return nil
+}
+
+func (builtin *ForEachCapabilities) getItemRuntimeValue() string {
+	return fmt.Sprintf(magic_string_helper.RuntimeValueReplacementPlaceholderFormat, builtin.itemUuid, itemRuntimeValueField)
+}
+
+// resolveItems returns the items of a list, with their runtime values replaced, or the lines of the string the runtime
+// value resolves to (for example the output of an exec)
+func (builtin *ForEachCapabilities) resolveItems() ([]starlark.Comparable, error) {
+	resolvedItems, err := resolveRuntimeValue(builtin.items, builtin.runtimeValueStore)
+	if err != nil {
+		return nil, err
+	}
+	if resolvedItemsStr, ok := resolvedItems.(starlark.String); ok {
+		var lines []starlark.Comparable
+		for _, line := range strings.Split(resolvedItemsStr.GoString(), itemsLineSeparator) {
+			if trimmedLine := strings.TrimSpace(line); trimmedLine != "" {
+				lines = append(lines, starlark.String(trimmedLine))
+			}
+		}
+		return lines, nil
+	}
+	iterableItems, ok := resolvedItems.(starlark.Iterable)
+	if !ok {
+		return nil, stacktrace.NewError("Expected the items to resolve to a list or a string but got '%s'", resolvedItems.Type())
+	}
+	var items []starlark.Comparable
+	iterator := iterableItems.Iterate()
+	defer iterator.Done()
+	var item starlark.Value
+	for iterator.Next(&item) {
+		resolvedItem, err := resolveRuntimeValue(item, builtin.runtimeValueStore)
+		if err != nil {
+			return nil, err
+		}
+		comparableItem, ok := resolvedItem.(starlark.Comparable)
+		if !ok {
+			return nil, stacktrace.NewError("Item '%v' of type '%s' is not supported", resolvedItem, resolvedItem.Type())
+		}
+		items = append(items, comparableItem)
+	}
+	return items, nil
+}
+
+func validateItems(value starlark.Value) *startosis_errors.InterpretationError {
+	switch value.(type) {
+	case starlark.String, *starlark.List, starlark.Tuple:
+		return nil
+	}
+	return startosis_errors.NewInterpretationError("'%s' argument should be a list or a string containing a runtime value, got '%s'", ItemsArgName, value.Type())
+}
@@ -0,0 +1,257 @@
+package control_flow
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/dependency_graph"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_structure"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/instructions_plan"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/verify"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/plan_yaml"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/types"
+	"github.com/kurtosis-tech/stacktrace"
+	"go.starlark.net/starlark"
+)
+
+const (
+	// `if` is a reserved Starlark keyword
+	IfBuiltinName = "if_"
+
+	ConditionArgName   = "condition"
+	AssertionArgName   = "assertion"
+	TargetValueArgName = "target_value"
+	ThenArgName        = "then"
+	ElseArgName        = "else_"
+
+	ThenBranch = "then"
+	ElseBranch = "else"
+
+	ifDescriptionFormatStr = "Evaluating condition '%v'"
+)
+
+func NewIf(runtimeValueStore *runtime_value_store.RuntimeValueStore) *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return &kurtosis_plan_instruction.KurtosisPlanInstruction{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: IfBuiltinName,
+
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              ConditionArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Value],
+					Validator:         nil,
+				},
+				{
+					Name:              AssertionArgName,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator:         verify.ValidateVerificationToken,
+				},
+				{
+					Name:              TargetValueArgName,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Comparable],
+					Validator:         nil,
+				},
+				{
+					Name:              ThenArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Callable],
+					Validator:         nil,
+				},
+				{
+					Name:              ElseArgName,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Callable],
+					Validator:         nil,
+				},
+			},
+		},
+
+		Capabilities: func() kurtosis_plan_instruction.KurtosisPlanInstructionCapabilities {
+			return &IfCapabilities{
+				runtimeValueStore: runtimeValueStore,
+
+				condition:       nil,   // populated at interpretation time
+				assertion:       "",    // populated at interpretation time
+				target:          nil,   // populated at interpretation time
+				thenFunction:    nil,   // populated at interpretation time
+				elseFunction:    nil,   // populated at interpretation time
+				description:     "",    // populated at interpretation time
+				conditionResult: false, // populated at execution time
+			}
+		},
+
+		DefaultDisplayArguments: map[string]bool{
+			ConditionArgName:   true,
+			AssertionArgName:   true,
+			TargetValueArgName: true,
+		},
+	}
+}
+
+type IfCapabilities struct {
+	runtimeValueStore *runtime_value_store.RuntimeValueStore
+
+	condition    starlark.Value
+	assertion    string
+	target       starlark.Comparable
+	thenFunction starlark.Callable
+	elseFunction starlark.Callable
+
+	description string
+
+	conditionResult bool
+}
+
+func (builtin *IfCapabilities) Interpret(_ string, arguments *builtin_argument.ArgumentValuesSet) (starlark.Value, *startosis_errors.InterpretationError) {
+	condition, err := builtin_argument.ExtractArgumentValue[starlark.Value](arguments, ConditionArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", ConditionArgName)
+	}
+	builtin.condition = condition
+
+	if arguments.IsSet(AssertionArgName) != arguments.IsSet(TargetValueArgName) {
+		return nil, startosis_errors.NewInterpretationError("'%s' and '%s' arguments should either be both set or both unset", AssertionArgName, TargetValueArgName)
+	}
+	if arguments.IsSet(AssertionArgName) {
+		assertion, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, AssertionArgName)
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", AssertionArgName)
+		}
+		target, err := builtin_argument.ExtractArgumentValue[starlark.Comparable](arguments, TargetValueArgName)
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", TargetValueArgName)
+		}
+		builtin.assertion = assertion.GoString()
+		builtin.target = target
+	}
+
+	thenFunction, err := builtin_argument.ExtractArgumentValue[starlark.Callable](arguments, ThenArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", ThenArgName)
+	}
+	builtin.thenFunction = thenFunction
+	if arguments.IsSet(ElseArgName) {
+		elseFunction, err := builtin_argument.ExtractArgumentValue[starlark.Callable](arguments, ElseArgName)
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", ElseArgName)
+		}
+		builtin.elseFunction = elseFunction
+	}
+
+	builtin.description = builtin_argument.GetDescriptionOrFallBack(arguments, fmt.Sprintf(ifDescriptionFormatStr, builtin.condition))
+	return starlark.None, nil
+}
+
+// InterpretBody plans the instructions of both branches. Only one of them will be run, once the condition has been
+// evaluated by the executor
+func (builtin *IfCapabilities) InterpretBody(thread *starlark.Thread, instructionsPlan *instructions_plan.InstructionsPlan, instructionUuid types.ScheduledInstructionUuid) error {
+	if err := interpretBranch(thread, instructionsPlan, instructionUuid, builtin, ThenBranch, builtin.thenFunction, starlark.Tuple{}); err != nil {
+		return err
+	}
+	if builtin.elseFunction == nil {
+		return nil
+	}
+	return interpretBranch(thread, instructionsPlan, instructionUuid, builtin, ElseBranch, builtin.elseFunction, starlark.Tuple{})
+}
+
+func (builtin *IfCapabilities) Validate(_ *builtin_argument.ArgumentValuesSet, _ *startosis_validator.ValidatorEnvironment) *startosis_errors.ValidationError {
+	return nil
+}
+
+func (builtin *IfCapabilities) Execute(_ context.Context, _ *builtin_argument.ArgumentValuesSet) (string, error) {
+	conditionResult, err := builtin.evaluateCondition()
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred evaluating condition '%v'", builtin.condition)
+	}
+	builtin.conditionResult = conditionResult
+	if conditionResult {
+		return fmt.Sprintf("Condition evaluated to true, running the '%s' branch", ThenBranch), nil
+	}
+	if builtin.elseFunction == nil {
+		return "Condition evaluated to false, skipping the instructions of the 'then' branch", nil
+	}
+	return fmt.Sprintf("Condition evaluated to false, running the '%s' branch", ElseBranch), nil
+}
+
+func (builtin *IfCapabilities) NumberOfIterations(branch string) int {
+	if (branch == ThenBranch) == builtin.conditionResult {
+		return 1
+	}
+	return 0
+}
+
+func (builtin *IfCapabilities) PrepareIteration(_ string, _ int) error {
+	return nil
+}
+
+func (builtin *IfCapabilities) GetIterationRuntimeValueUuids(_ string) []string {
+	return nil
+}
+
+// TryResolveWith always aborts, as the instructions planned in the branches can't be matched against the enclave plan
+// before knowing which branch ran
+func (builtin *IfCapabilities) TryResolveWith(_ bool, _ *enclave_plan_persistence.EnclavePlanInstruction, _ *enclave_structure.EnclaveComponents) enclave_structure.InstructionResolutionStatus {
+	return enclave_structure.InstructionIsNotResolvableAbort
+}
+
+func (builtin *IfCapabilities) FillPersistableAttributes(builder *enclave_plan_persistence.EnclavePlanInstructionBuilder) {
+	builder.SetType(IfBuiltinName)
+}
+
+// UpdatePlan adds the condition to the plan, the instructions of the branches adding themselves as conditional on it
+func (builtin *IfCapabilities) UpdatePlan(planYaml *plan_yaml.PlanYamlGenerator) error {
+	planYaml.AddIf(builtin.condition, builtin.assertion, builtin.target)
+	return nil
+}
+
+func (builtin *IfCapabilities) Description() string {
+	return builtin.description
+}
+
+// UpdateDependencyGraph updates the dependency graph with the effects of running this instruction.
+func (builtin *IfCapabilities) UpdateDependencyGraph(instructionUuid types.ScheduledInstructionUuid, dependencyGraph *dependency_graph.InstructionDependencyGraph) error {
+	shortDescriptor := fmt.Sprintf("if_(%s)", builtin.description)
+	dependencyGraph.UpdateInstructionShortDescriptor(instructionUuid, shortDescriptor)
+	dependencyGraph.AddControlFlowInstruction(instructionUuid)
+
+	if conditionStr, ok := builtin.condition.(starlark.String); ok {
+		dependencyGraph.ConsumesAnyRuntimeValuesInString(instructionUuid, conditionStr.GoString())
+	}
+	if targetStr, ok := builtin.target.(starlark.String); ok {
+		dependencyGraph.ConsumesAnyRuntimeValuesInString(instructionUuid, targetStr.GoString())
+	}
+	return nil
+}
+
+func (builtin *IfCapabilities) evaluateCondition() (bool, error) {
+	currentValue, err := resolveRuntimeValue(builtin.condition, builtin.runtimeValueStore)
+	if err != nil {
+		return false, err
+	}
+	if builtin.assertion == "" {
+		return bool(currentValue.Truth()), nil
+	}
+	comparableCurrentValue, ok := currentValue.(starlark.Comparable)
+	if !ok {
+		return false, stacktrace.NewError("Value '%v' of type '%s' can't be compared", currentValue, currentValue.Type())
+	}
+	target, err := resolveRuntimeValue(builtin.target, builtin.runtimeValueStore)
+	if err != nil {
+		return false, err
+	}
+	comparableTarget, ok := target.(starlark.Comparable)
+	if !ok {
+		return false, stacktrace.NewError("Target value '%v' of type '%s' can't be compared", target, target.Type())
+	}
+	return verify.Compare(comparableCurrentValue, builtin.assertion, comparableTarget)
+}
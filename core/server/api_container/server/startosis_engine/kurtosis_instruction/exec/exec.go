@@ -19,6 +19,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/plan_yaml"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/recipe"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_constants"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/types"
@@ -192,7 +193,12 @@ func (builtin *ExecCapabilities) Execute(ctx context.Context, _ *builtin_argumen
 	if err != nil {
 		return "", stacktrace.Propagate(err, "An error occurred while getting service '%s'", serviceNameStr)
 	}
-	result, err := builtin.execRecipe.Execute(ctx, builtin.serviceNetwork, builtin.runtimeValueStore, service)
+	var result map[string]starlark.Comparable
+	if outputLineConsumer, ok := ctx.Value(startosis_constants.InstructionOutputLineConsumerParam).(func(string)); ok {
+		result, err = builtin.execRecipe.ExecuteWithStreamedOutput(ctx, builtin.serviceNetwork, builtin.runtimeValueStore, service, outputLineConsumer)
+	} else {
+		result, err = builtin.execRecipe.Execute(ctx, builtin.serviceNetwork, builtin.runtimeValueStore, service)
+	}
 	if err != nil {
 		return "", stacktrace.Propagate(err, "An error occurred while executing exec recipe on service '%s'", serviceNameStr)
 	}
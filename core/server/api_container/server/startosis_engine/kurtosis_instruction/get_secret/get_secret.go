@@ -0,0 +1,115 @@
+package get_secret
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/dependency_graph"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_structure"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers/magic_string_helper"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/plan_yaml"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/types"
+	"go.starlark.net/starlark"
+)
+
+const (
+	GetSecretBuiltinName = "get_secret"
+	SecretNameArgName    = "name"
+
+	descriptionFormatStr = "Fetching secret '%v'"
+)
+
+func NewGetSecret(runtimeValueStore *runtime_value_store.RuntimeValueStore) *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return &kurtosis_plan_instruction.KurtosisPlanInstruction{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: GetSecretBuiltinName,
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              SecretNameArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, SecretNameArgName)
+					},
+				},
+			},
+			Deprecation: nil,
+		},
+		Capabilities: func() kurtosis_plan_instruction.KurtosisPlanInstructionCapabilities {
+			return &GetSecretCapabilities{
+				runtimeValueStore: runtimeValueStore,
+				secretName:        "", // populated at interpretation time
+				description:       "", // populated at interpretation time
+			}
+		},
+		DefaultDisplayArguments: map[string]bool{
+			SecretNameArgName: true,
+		},
+	}
+}
+
+type GetSecretCapabilities struct {
+	runtimeValueStore *runtime_value_store.RuntimeValueStore
+
+	secretName  string
+	description string
+}
+
+func (builtin *GetSecretCapabilities) Interpret(_ string, arguments *builtin_argument.ArgumentValuesSet) (starlark.Value, *startosis_errors.InterpretationError) {
+	secretNameArgumentValue, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, SecretNameArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", SecretNameArgName)
+	}
+	builtin.secretName = secretNameArgumentValue.GoString()
+	builtin.description = builtin_argument.GetDescriptionOrFallBack(arguments, fmt.Sprintf(descriptionFormatStr, builtin.secretName))
+
+	// the secret value is never known at interpretation time, so that it doesn't end up in the plan, the plan yaml
+	// or the persisted enclave plan. The placeholder gets replaced by the actual value when the instruction using it
+	// gets executed, the same way runtime values are
+	return starlark.String(fmt.Sprintf(magic_string_helper.SecretReplacementPlaceholderFormat, builtin.secretName)), nil
+}
+
+func (builtin *GetSecretCapabilities) Validate(_ *builtin_argument.ArgumentValuesSet, _ *startosis_validator.ValidatorEnvironment) *startosis_errors.ValidationError {
+	if _, err := builtin.runtimeValueStore.GetSecret(builtin.secretName); err != nil {
+		return startosis_errors.WrapWithValidationError(err, "Secret '%v' required by '%v' instruction can't be found; set it with 'kurtosis secret set'", builtin.secretName, GetSecretBuiltinName)
+	}
+	return nil
+}
+
+func (builtin *GetSecretCapabilities) Execute(_ context.Context, _ *builtin_argument.ArgumentValuesSet) (string, error) {
+	// Note this is a no-op
+	return fmt.Sprintf("Fetched secret '%v'", builtin.secretName), nil
+}
+
+func (builtin *GetSecretCapabilities) TryResolveWith(instructionsAreEqual bool, _ *enclave_plan_persistence.EnclavePlanInstruction, _ *enclave_structure.EnclaveComponents) enclave_structure.InstructionResolutionStatus {
+	if instructionsAreEqual {
+		return enclave_structure.InstructionIsEqual
+	}
+	return enclave_structure.InstructionIsUnknown
+}
+
+func (builtin *GetSecretCapabilities) FillPersistableAttributes(builder *enclave_plan_persistence.EnclavePlanInstructionBuilder) {
+	builder.SetType(GetSecretBuiltinName)
+}
+
+func (builtin *GetSecretCapabilities) UpdatePlan(planYaml *plan_yaml.PlanYamlGenerator) error {
+	// get secret does not affect the planYaml
+	return nil
+}
+
+func (builtin *GetSecretCapabilities) UpdateDependencyGraph(instructionUuid types.ScheduledInstructionUuid, dependencyGraph *dependency_graph.InstructionDependencyGraph) error {
+	shortDescriptor := fmt.Sprintf("get_secret(%s)", builtin.secretName)
+	dependencyGraph.UpdateInstructionShortDescriptor(instructionUuid, shortDescriptor)
+	return nil
+}
+
+func (builtin *GetSecretCapabilities) Description() string {
+	return builtin.description
+}
@@ -3,6 +3,7 @@ package get_services
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/dependency_graph"
@@ -12,6 +13,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/plan_yaml"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
@@ -55,7 +57,10 @@ func (builtin *GetServicesCapabilities) Interpret(_ string, arguments *builtin_a
 	if err != nil {
 		return nil, startosis_errors.WrapWithInterpretationError(err, "An error occurred while fetching service.")
 	}
-	servicesList := &starlark.List{}
+	// the replicas of a replica set are returned as a single ReplicaSet, in place of its first replica
+	servicesAndReplicaSets := []starlark.Value{}
+	replicaSetIndexes := map[string]int{}
+	replicasByReplicaSet := map[string][]*kurtosis_types.Service{}
 	for _, serviceVal := range services {
 		name, err := serviceVal.GetName()
 		if err != nil {
@@ -63,10 +68,49 @@ func (builtin *GetServicesCapabilities) Interpret(_ string, arguments *builtin_a
 		}
 		builtin.serviceNames = append(builtin.serviceNames, name)
 
-		_ = servicesList.Append(serviceVal)
+		replicaSetName, isReplica, err := serviceVal.GetReplicaSetName()
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "An error occurred getting the replica set of service: %v", serviceVal)
+		}
+		if !isReplica {
+			servicesAndReplicaSets = append(servicesAndReplicaSets, serviceVal)
+			continue
+		}
+		if _, found := replicaSetIndexes[replicaSetName]; !found {
+			replicaSetIndexes[replicaSetName] = len(servicesAndReplicaSets)
+			servicesAndReplicaSets = append(servicesAndReplicaSets, nil)
+		}
+		replicasByReplicaSet[replicaSetName] = append(replicasByReplicaSet[replicaSetName], serviceVal)
+	}
+	for replicaSetName, replicas := range replicasByReplicaSet {
+		replicaSet, interpretationErr := createReplicaSet(replicaSetName, replicas)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+		servicesAndReplicaSets[replicaSetIndexes[replicaSetName]] = replicaSet
 	}
 
-	return servicesList, nil
+	return starlark.NewList(servicesAndReplicaSets), nil
+}
+
+// createReplicaSet creates the replica set with its replicas sorted by index
+func createReplicaSet(replicaSetName string, replicas []*kurtosis_types.Service) (*kurtosis_types.ReplicaSet, *startosis_errors.InterpretationError) {
+	replicaIndexes := map[*kurtosis_types.Service]int{}
+	for _, replica := range replicas {
+		replicaName, interpretationErr := replica.GetName()
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+		replicaIndex, found := service.GetReplicaIndex(replicaSetName, replicaName)
+		if !found {
+			return nil, startosis_errors.NewInterpretationError("Service '%v' is a replica of replica set '%v', but its name isn't the one of a replica of it; this is a bug in Kurtosis", replicaName, replicaSetName)
+		}
+		replicaIndexes[replica] = replicaIndex
+	}
+	sort.Slice(replicas, func(i, j int) bool {
+		return replicaIndexes[replicas[i]] < replicaIndexes[replicas[j]]
+	})
+	return kurtosis_types.CreateReplicaSet(starlark.String(replicaSetName), replicas)
 }
 
 func (builtin *GetServicesCapabilities) Validate(_ *builtin_argument.ArgumentValuesSet, validatorEnvironment *startosis_validator.ValidatorEnvironment) *startosis_errors.ValidationError {
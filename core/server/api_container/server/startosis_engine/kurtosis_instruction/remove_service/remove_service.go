@@ -93,6 +93,7 @@ func (builtin *RemoveServiceCapabilities) Validate(_ *builtin_argument.ArgumentV
 	validatorEnvironment.RemoveServiceFromPrivatePortIDMapping(builtin.serviceName)
 	validatorEnvironment.FreeMemory(builtin.serviceName)
 	validatorEnvironment.FreeCPU(builtin.serviceName)
+	validatorEnvironment.ReleaseEnclaveResources(builtin.serviceName)
 	return nil
 }
 
@@ -282,6 +282,9 @@ func upsertServiceConfigs(currServiceConfig, serviceConfigOverride *service.Serv
 	if serviceConfigOverride.GetPersistentDirectories() != nil {
 		return nil, startosis_errors.NewInterpretationError("Overriding persistent directories is currently not supported.")
 	}
+	if serviceConfigOverride.GetSharedDirectories() != nil {
+		return nil, startosis_errors.NewInterpretationError("Overriding shared directories is currently not supported.")
+	}
 
 	return currServiceConfig, nil
 }
@@ -0,0 +1,12 @@
+package shared_helpers
+
+import "fmt"
+
+const (
+	filesArtifactProducerFormatStr = "%s: %s"
+)
+
+// GetFilesArtifactProducer describes the instruction storing a files artifact, so that its versions can be told apart
+func GetFilesArtifactProducer(builtinName string, instructionDescription string) string {
+	return fmt.Sprintf(filesArtifactProducerFormatStr, builtinName, instructionDescription)
+}
@@ -4,6 +4,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_registry_spec"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
 	"github.com/kurtosis-tech/stacktrace"
 	"go.starlark.net/starlark"
@@ -25,6 +26,10 @@ const (
 	runtimeValueReplacementRegex             = "(?P<" + allSubgroupName + ">\\{\\{" + kurtosisNamespace + ":(?P<" + runtimeValueSubgroupName + ">" + uuidFormat + ")" + ":(?P<" + runtimeValueFieldSubgroupName + ">" + runtimeValueKeyRegexp + ")\\.runtime_value\\}\\})"
 	RuntimeValueReplacementPlaceholderFormat = "{{" + kurtosisNamespace + ":%v:%v.runtime_value}}"
 
+	secretNameSubgroupName             = "secret_name"
+	secretReplacementRegex             = "(?P<" + allSubgroupName + ">\\{\\{" + kurtosisNamespace + ":secret:(?P<" + secretNameSubgroupName + ">" + runtimeValueKeyRegexp + ")\\}\\})"
+	SecretReplacementPlaceholderFormat = "{{" + kurtosisNamespace + ":secret:%v}}"
+
 	subExpNotFound = -1
 )
 
@@ -32,7 +37,25 @@ const (
 // Treat this as a constant
 var compiledRuntimeValueReplacementRegex = regexp.MustCompile(runtimeValueReplacementRegex)
 
+// The compiled regular expression to do secret replacements
+// Treat this as a constant
+var compiledSecretReplacementRegex = regexp.MustCompile(secretReplacementRegex)
+
 func ReplaceRuntimeValueInString(originalString string, recipeEngine *runtime_value_store.RuntimeValueStore) (string, error) {
+	replacedString, err := ReplaceRuntimeValueInStringKeepingSecrets(originalString, recipeEngine)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error happened replacing runtime values in string '%v'", originalString)
+	}
+	replacedString, err = replaceSecretsInString(replacedString, recipeEngine)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error happened replacing secrets in string '%v'", originalString)
+	}
+	return replacedString, nil
+}
+
+// ReplaceRuntimeValueInStringKeepingSecrets replaces the runtime values in the string but leaves the secret
+// placeholders in, for strings that end up persisted. The secrets are then resolved only when the string is used
+func ReplaceRuntimeValueInStringKeepingSecrets(originalString string, recipeEngine *runtime_value_store.RuntimeValueStore) (string, error) {
 	matches := compiledRuntimeValueReplacementRegex.FindAllStringSubmatch(originalString, unlimitedMatches)
 	replacedString := originalString
 	for _, match := range matches {
@@ -77,6 +100,24 @@ func GetRuntimeValuesFromString(originalString string) []string {
 	return runtimeValues
 }
 
+// GetRuntimeValueUuidsFromString returns the UUIDs of the runtime values referenced in the string, in order of appearance
+// and without duplicates
+func GetRuntimeValueUuidsFromString(originalString string) []string {
+	matches := compiledRuntimeValueReplacementRegex.FindAllStringSubmatch(originalString, unlimitedMatches)
+	runtimeValueMatchIndex := compiledRuntimeValueReplacementRegex.SubexpIndex(runtimeValueSubgroupName)
+	runtimeValueUuids := []string{}
+	seenRuntimeValueUuids := map[string]bool{}
+	for _, match := range matches {
+		runtimeValueUuid := match[runtimeValueMatchIndex]
+		if seenRuntimeValueUuids[runtimeValueUuid] {
+			continue
+		}
+		seenRuntimeValueUuids[runtimeValueUuid] = true
+		runtimeValueUuids = append(runtimeValueUuids, runtimeValueUuid)
+	}
+	return runtimeValueUuids
+}
+
 func getRuntimeValueFromRegexMatch(match []string, runtimeValueStore *runtime_value_store.RuntimeValueStore) (starlark.Comparable, error) {
 	runtimeValueMatchIndex := compiledRuntimeValueReplacementRegex.SubexpIndex(runtimeValueSubgroupName)
 	if runtimeValueMatchIndex == subExpNotFound {
@@ -96,3 +137,67 @@ func getRuntimeValueFromRegexMatch(match []string, runtimeValueStore *runtime_va
 	}
 	return selectedRuntimeValue, nil
 }
+
+// ReplaceRuntimeValuesInImageRegistrySpec returns a copy of the registry spec with the runtime values referenced by its
+// credentials resolved. The secrets it references are left in, as the spec is part of the service config that gets
+// persisted. It returns nil if the spec is nil
+func ReplaceRuntimeValuesInImageRegistrySpec(registrySpec *image_registry_spec.ImageRegistrySpec, runtimeValueStore *runtime_value_store.RuntimeValueStore) (*image_registry_spec.ImageRegistrySpec, error) {
+	if registrySpec == nil {
+		return nil, nil
+	}
+	username, err := ReplaceRuntimeValueInStringKeepingSecrets(registrySpec.GetUsername(), runtimeValueStore)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred replacing runtime values in the username of the registry spec for image '%v'", registrySpec.GetImageName())
+	}
+	password, err := ReplaceRuntimeValueInStringKeepingSecrets(registrySpec.GetPassword(), runtimeValueStore)
+	if err != nil {
+		// the password is deliberately not part of the error as it may be a secret
+		return nil, stacktrace.Propagate(err, "An error occurred replacing runtime values in the password of the registry spec for image '%v'", registrySpec.GetImageName())
+	}
+	return image_registry_spec.NewImageRegistrySpec(registrySpec.GetImageName(), username, password, registrySpec.GetRegistryAddr()), nil
+}
+
+// ImageRegistrySpecHasRuntimeValues returns true if the registry credentials reference runtime values or secrets, which
+// can only be resolved at execution time
+func ImageRegistrySpecHasRuntimeValues(registrySpec *image_registry_spec.ImageRegistrySpec) bool {
+	if registrySpec == nil {
+		return false
+	}
+	for _, credential := range []string{registrySpec.GetUsername(), registrySpec.GetPassword()} {
+		if compiledRuntimeValueReplacementRegex.MatchString(credential) || compiledSecretReplacementRegex.MatchString(credential) {
+			return true
+		}
+	}
+	return false
+}
+
+func GetSecretNamesFromString(originalString string) []string {
+	matches := compiledSecretReplacementRegex.FindAllStringSubmatch(originalString, unlimitedMatches)
+	secretNameMatchIndex := compiledSecretReplacementRegex.SubexpIndex(secretNameSubgroupName)
+	secretNames := make([]string, len(matches))
+	for i, match := range matches {
+		secretNames[i] = match[secretNameMatchIndex]
+	}
+	return secretNames
+}
+
+func replaceSecretsInString(originalString string, runtimeValueStore *runtime_value_store.RuntimeValueStore) (string, error) {
+	matches := compiledSecretReplacementRegex.FindAllStringSubmatch(originalString, unlimitedMatches)
+	if len(matches) == 0 {
+		return originalString, nil
+	}
+	allMatchIndex := compiledSecretReplacementRegex.SubexpIndex(allSubgroupName)
+	secretNameMatchIndex := compiledSecretReplacementRegex.SubexpIndex(secretNameSubgroupName)
+	if allMatchIndex == subExpNotFound || secretNameMatchIndex == subExpNotFound {
+		return "", stacktrace.NewError("There was an error in finding the sub groups in regexp '%v'. This is a Kurtosis Bug", compiledSecretReplacementRegex.String())
+	}
+	replacedString := originalString
+	for _, match := range matches {
+		secretValue, err := runtimeValueStore.GetSecret(match[secretNameMatchIndex])
+		if err != nil {
+			return "", stacktrace.Propagate(err, "An error happened getting secret '%v'", match[secretNameMatchIndex])
+		}
+		replacedString = strings.Replace(replacedString, match[allMatchIndex], secretValue, singleMatch)
+	}
+	return replacedString, nil
+}
@@ -2,9 +2,11 @@ package magic_string_helper
 
 import (
 	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_registry_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/secret_store"
 	"github.com/stretchr/testify/require"
 	bolt "go.etcd.io/bbolt"
 	"go.starlark.net/starlark"
@@ -17,6 +19,8 @@ const (
 	testRuntimeValueField          = "field.subfield"
 	testExpectedInterpolatedString = starlark.String("test_string is not 0")
 	starlarkThreadName             = "starlark-value-serde-for-test-in-magic-helper-thread"
+	testSecretName                 = "api-token"
+	testSecretValue                = "s3cr3t"
 )
 
 var testIntRuntimeValue = starlark.MakeInt(0)
@@ -26,7 +30,7 @@ func TestGetOrReplaceRuntimeValueFromString_BasicFetch(t *testing.T) {
 
 	dummySerde := newDummyStarlarkValueSerDeForTest()
 
-	runtimeValueStore, err := runtime_value_store.CreateRuntimeValueStore(dummySerde, enclaveDb)
+	runtimeValueStore, err := runtime_value_store.CreateRuntimeValueStore(dummySerde, enclaveDb, nil)
 	require.NoError(t, err)
 	stringValueUuid, err := runtimeValueStore.CreateValue()
 	require.Nil(t, err)
@@ -49,7 +53,7 @@ func TestGetOrReplaceRuntimeValueFromString_Interpolated(t *testing.T) {
 
 	dummySerde := newDummyStarlarkValueSerDeForTest()
 
-	runtimeValueStore, err := runtime_value_store.CreateRuntimeValueStore(dummySerde, enclaveDb)
+	runtimeValueStore, err := runtime_value_store.CreateRuntimeValueStore(dummySerde, enclaveDb, nil)
 	require.NoError(t, err)
 	stringValueUuid, err := runtimeValueStore.CreateValue()
 	require.Nil(t, err)
@@ -72,7 +76,7 @@ func TestReplaceRuntimeValueFromString(t *testing.T) {
 
 	dummySerde := newDummyStarlarkValueSerDeForTest()
 
-	runtimeValueStore, err := runtime_value_store.CreateRuntimeValueStore(dummySerde, enclaveDb)
+	runtimeValueStore, err := runtime_value_store.CreateRuntimeValueStore(dummySerde, enclaveDb, nil)
 	require.NoError(t, err)
 	stringValueUuid, err := runtimeValueStore.CreateValue()
 	require.Nil(t, err)
@@ -90,6 +94,56 @@ func TestReplaceRuntimeValueFromString(t *testing.T) {
 	require.Equal(t, resolvedInterpolatedString, testExpectedInterpolatedString.GoString())
 }
 
+func TestReplaceRuntimeValueFromString_Secret(t *testing.T) {
+	enclaveDb := getEnclaveDBForTest(t)
+
+	dummySerde := newDummyStarlarkValueSerDeForTest()
+
+	secretStore, err := secret_store.CreateSecretStore(enclaveDb, t.TempDir())
+	require.NoError(t, err)
+	require.NoError(t, secretStore.Set(testSecretName, testSecretValue))
+
+	runtimeValueStore, err := runtime_value_store.CreateRuntimeValueStore(dummySerde, enclaveDb, secretStore)
+	require.NoError(t, err)
+	secretPlaceholder := fmt.Sprintf(SecretReplacementPlaceholderFormat, testSecretName)
+	require.Equal(t, []string{testSecretName}, GetSecretNamesFromString("Bearer "+secretPlaceholder))
+
+	resolvedString, err := ReplaceRuntimeValueInString("Bearer "+secretPlaceholder, runtimeValueStore)
+	require.NoError(t, err)
+	require.Equal(t, "Bearer "+testSecretValue, resolvedString)
+
+	_, err = ReplaceRuntimeValueInString(fmt.Sprintf(SecretReplacementPlaceholderFormat, "missing"), runtimeValueStore)
+	require.Error(t, err)
+
+	keptString, err := ReplaceRuntimeValueInStringKeepingSecrets("Bearer "+secretPlaceholder, runtimeValueStore)
+	require.NoError(t, err)
+	require.Equal(t, "Bearer "+secretPlaceholder, keptString)
+}
+
+func TestGetRuntimeValueUuidsFromString(t *testing.T) {
+	firstUuid := "0123456789abcdef0123456789abcdef"
+	secondUuid := "fedcba9876543210fedcba9876543210"
+	originalString := fmt.Sprintf(
+		"%v:%v %v",
+		fmt.Sprintf(RuntimeValueReplacementPlaceholderFormat, firstUuid, "ip_address"),
+		fmt.Sprintf(RuntimeValueReplacementPlaceholderFormat, secondUuid, testRuntimeValueField),
+		fmt.Sprintf(RuntimeValueReplacementPlaceholderFormat, firstUuid, "hostname"),
+	)
+	require.Equal(t, []string{firstUuid, secondUuid}, GetRuntimeValueUuidsFromString(originalString))
+	require.Empty(t, GetRuntimeValueUuidsFromString("no magic string here"))
+}
+
+func TestImageRegistrySpecHasRuntimeValues(t *testing.T) {
+	secretPassword := fmt.Sprintf(SecretReplacementPlaceholderFormat, testSecretName)
+	require.True(t, ImageRegistrySpecHasRuntimeValues(image_registry_spec.NewImageRegistrySpec("image", "user", secretPassword, "registry")))
+
+	runtimeValueUsername := fmt.Sprintf(RuntimeValueReplacementPlaceholderFormat, "0123456789abcdef0123456789abcdef", "output")
+	require.True(t, ImageRegistrySpecHasRuntimeValues(image_registry_spec.NewImageRegistrySpec("image", runtimeValueUsername, "password", "registry")))
+
+	require.False(t, ImageRegistrySpecHasRuntimeValues(image_registry_spec.NewImageRegistrySpec("image", "user", "password", "registry")))
+	require.False(t, ImageRegistrySpecHasRuntimeValues(nil))
+}
+
 func getEnclaveDBForTest(t *testing.T) *enclave_db.EnclaveDB {
 	file, err := os.CreateTemp("/tmp", "*.db")
 	defer func() {
@@ -193,7 +193,11 @@ func validateTasksCommon(validatorEnvironment *startosis_validator.ValidatorEnvi
 	if serviceConfig.GetImageBuildSpec() != nil {
 		validatorEnvironment.AppendRequiredImageBuild(serviceConfig.GetContainerImageName(), serviceConfig.GetImageBuildSpec())
 	} else if serviceConfig.GetImageRegistrySpec() != nil {
-		validatorEnvironment.AppendImageToPullWithAuth(serviceConfig.GetContainerImageName(), serviceConfig.GetImageRegistrySpec())
+		// credentials referencing secrets or runtime values are only resolved at execution, the image then gets pulled
+		// when the task container starts
+		if !magic_string_helper.ImageRegistrySpecHasRuntimeValues(serviceConfig.GetImageRegistrySpec()) {
+			validatorEnvironment.AppendImageToPullWithAuth(serviceConfig.GetContainerImageName(), serviceConfig.GetImageRegistrySpec())
+		}
 	} else if serviceConfig.GetNixBuildSpec() != nil {
 		validatorEnvironment.AppendRequiredNixBuild(serviceConfig.GetContainerImageName(), serviceConfig.GetNixBuildSpec())
 	} else {
@@ -255,13 +259,13 @@ func validatePathIsUniqueWhileCreatingFileArtifact(storeSpecList []*store_spec.S
 	return nil
 }
 
-func copyFilesFromTask(ctx context.Context, serviceNetwork service_network.ServiceNetwork, serviceName string, storeSpecList []*store_spec.StoreSpec) error {
+func copyFilesFromTask(ctx context.Context, serviceNetwork service_network.ServiceNetwork, serviceName string, storeSpecList []*store_spec.StoreSpec, producer string) error {
 	if storeSpecList == nil {
 		return nil
 	}
 
 	for _, storeSpec := range storeSpecList {
-		_, err := serviceNetwork.CopyFilesFromService(ctx, serviceName, storeSpec.GetSrc(), storeSpec.GetName())
+		_, err := serviceNetwork.CopyFilesFromService(ctx, serviceName, storeSpec.GetSrc(), storeSpec.GetName(), producer)
 		if err != nil {
 			return stacktrace.Propagate(err, "%s", fmt.Sprintf("error occurred while copying file or directory at path: %v", storeSpec.GetSrc()))
 		}
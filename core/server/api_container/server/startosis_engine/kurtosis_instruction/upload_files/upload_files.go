@@ -10,6 +10,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/dependency_graph"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_structure"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
@@ -200,14 +201,14 @@ func (builtin *UploadFilesCapabilities) Execute(_ context.Context, _ *builtin_ar
 	if found && (len(builtin.filesArtifactMd5) > 0 && bytes.Equal(currentlyStoredFileContentMd5, builtin.filesArtifactMd5)) {
 		instructionResult = fmt.Sprintf("Files with artifact name '%s' resolved with artifact UUID '%s' as content were matching", builtin.artifactName, currentlyStoredFileArtifactUuid)
 	} else if found {
-		err = builtin.serviceNetwork.UpdateFilesArtifact(currentlyStoredFileArtifactUuid, filesArtifactContentReader, builtin.filesArtifactMd5)
+		err = builtin.serviceNetwork.UpdateFilesArtifact(currentlyStoredFileArtifactUuid, filesArtifactContentReader, builtin.filesArtifactMd5, shared_helpers.GetFilesArtifactProducer(UploadFilesBuiltinName, builtin.description))
 		if err != nil {
 			return "", stacktrace.Propagate(err, "An error occurred while updating the compressed contents with md5 '%s' to artifact '%s' (UUID: '%s')",
 				builtin.filesArtifactMd5, builtin.artifactName, currentlyStoredFileArtifactUuid)
 		}
 		instructionResult = fmt.Sprintf("Files with artifact name '%s' with artifact UUID '%s' updated", builtin.artifactName, currentlyStoredFileArtifactUuid)
 	} else {
-		filesArtifactUuid, err := builtin.serviceNetwork.UploadFilesArtifact(filesArtifactContentReader, builtin.filesArtifactMd5, builtin.artifactName)
+		filesArtifactUuid, err := builtin.serviceNetwork.UploadFilesArtifact(filesArtifactContentReader, builtin.filesArtifactMd5, builtin.artifactName, shared_helpers.GetFilesArtifactProducer(UploadFilesBuiltinName, builtin.description))
 		if err != nil {
 			return "", stacktrace.Propagate(err, "An error occurred while uploading the compressed contents with md5 '%s' to create new artifact with name '%s'",
 				builtin.filesArtifactMd5, builtin.artifactName)
@@ -185,22 +185,32 @@ func (builtin *VerifyCapabilities) UpdateDependencyGraph(instructionUuid types.S
 // Verify verifies whether the currentValue matches the targetValue w.r.t. the assertion operator
 // TODO: This and ValidateVerificationToken below are used by both verify and wait. Refactor it to a better place
 func Verify(currentValue starlark.Comparable, assertion string, targetValue starlark.Comparable) error {
+	isVerified, err := Compare(currentValue, assertion, targetValue)
+	if err != nil {
+		return err
+	}
+	if !isVerified {
+		return stacktrace.NewError("Verification failed '%v' '%v' '%v'", currentValue, assertion, targetValue)
+	}
+	return nil
+}
+
+// Compare returns whether the currentValue matches the targetValue w.r.t. the assertion operator. An error is returned
+// only if the two values can't be compared
+func Compare(currentValue starlark.Comparable, assertion string, targetValue starlark.Comparable) (bool, error) {
 	if comparisonToken, found := StringTokenToComparisonStarlarkToken[assertion]; found {
 		if currentValue.Type() != targetValue.Type() {
-			return stacktrace.NewError("Verify failed because '%v' is type '%v' and '%v' is type '%v'", currentValue, currentValue.Type(), targetValue, targetValue.Type())
+			return false, stacktrace.NewError("Verify failed because '%v' is type '%v' and '%v' is type '%v'", currentValue, currentValue.Type(), targetValue, targetValue.Type())
 		}
 		result, err := currentValue.CompareSameType(comparisonToken, targetValue, 1)
 		if err != nil {
-			return stacktrace.Propagate(err, "Verify comparison failed '%v' '%v' '%v'", currentValue, assertion, targetValue)
+			return false, stacktrace.Propagate(err, "Verify comparison failed '%v' '%v' '%v'", currentValue, assertion, targetValue)
 		}
-		if !result {
-			return stacktrace.NewError("Verification failed '%v' '%v' '%v'", currentValue, assertion, targetValue)
-		}
-		return nil
+		return result, nil
 	} else if assertion == InCollectionAssertionToken || assertion == NotInCollectionAssertionToken {
 		iterableTarget, ok := targetValue.(starlark.Iterable)
 		if !ok {
-			return stacktrace.NewError("Verification failed, expected an iterable object but got '%v'", targetValue.Type())
+			return false, stacktrace.NewError("Verification failed, expected an iterable object but got '%v'", targetValue.Type())
 		}
 
 		iterator := iterableTarget.Iterate()
@@ -209,19 +219,16 @@ func Verify(currentValue starlark.Comparable, assertion string, targetValue star
 		currentValuePresentInIterable := false
 		for idx := 0; iterator.Next(&item); idx++ {
 			if item == currentValue {
-				if assertion == InCollectionAssertionToken {
-					return nil
-				}
 				currentValuePresentInIterable = true
 				break
 			}
 		}
-		if assertion == NotInCollectionAssertionToken && !currentValuePresentInIterable {
-			return nil
+		if assertion == InCollectionAssertionToken {
+			return currentValuePresentInIterable, nil
 		}
-		return stacktrace.NewError("Verification failed '%v' '%v' '%v'", currentValue, assertion, targetValue)
+		return !currentValuePresentInIterable, nil
 	}
-	return stacktrace.NewError("The '%s' token '%s' seems invalid. This is a Kurtosis bug as it should have been validated earlier", AssertionArgName, assertion)
+	return false, stacktrace.NewError("The '%s' token '%s' seems invalid. This is a Kurtosis bug as it should have been validated earlier", AssertionArgName, assertion)
 }
 
 func ValidateVerificationToken(value starlark.Value) *startosis_errors.InterpretationError {
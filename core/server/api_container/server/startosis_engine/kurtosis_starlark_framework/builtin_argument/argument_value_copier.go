@@ -33,7 +33,10 @@ func deepCopyArgumentValueInternal(genericArgValue starlark.Value) (starlark.Val
 		starlark.NoneType,
 		starlark.String,
 		starlarktime.Time,
-		starlarktime.Duration:
+		starlarktime.Duration,
+		// functions passed to control flow instructions are called at interpretation time, they're never mutated
+		*starlark.Function,
+		*starlark.Builtin:
 		valueCopy = argValue
 	case *starlark.List:
 		copiedList := make([]starlark.Value, argValue.Len())
@@ -163,6 +163,11 @@ func ServiceLabelsValidator(value starlark.Value, attributeName string) *startos
 	if err := service.ValidateServiceConfigLabels(labelsMap); err != nil {
 		return startosis_errors.WrapWithInterpretationError(err, "An error occurred validating service config labels '%+v'", labelsMap)
 	}
+	// the replica set label gives the service the network alias of the replica set, so only the replicas created by
+	// the replica set can get it
+	if _, found := labelsMap[service.ReplicaSetLabelKey]; found {
+		return startosis_errors.NewInterpretationError("Label '%s' is reserved to the replicas of replica sets and can't be set on a service config", service.ReplicaSetLabelKey)
+	}
 	return nil
 }
 
@@ -1,6 +1,7 @@
 package builtin_argument
 
 import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/stretchr/testify/require"
 	"go.starlark.net/starlark"
 	"math"
@@ -124,3 +125,18 @@ func TestDurationOrNone_InvalidEmptyString(t *testing.T) {
 	require.NotNil(t, err)
 	require.Equal(t, "Value for 'test_duration_or_none_invalid_empty_string' was an empty string. This is disallowed", err.Error())
 }
+
+func TestServiceLabelsValidator_Valid(t *testing.T) {
+	value := starlark.NewDict(1)
+	require.NoError(t, value.SetKey(starlark.String("app"), starlark.String("api")))
+	err := ServiceLabelsValidator(value, "labels")
+	require.Nil(t, err)
+}
+
+func TestServiceLabelsValidator_ReplicaSetLabel(t *testing.T) {
+	value := starlark.NewDict(1)
+	require.NoError(t, value.SetKey(starlark.String(service.ReplicaSetLabelKey), starlark.String("api")))
+	err := ServiceLabelsValidator(value, "labels")
+	require.NotNil(t, err)
+	require.Equal(t, "Label 'kurtosis-replica-set' is reserved to the replicas of replica sets and can't be set on a service config", err.Error())
+}
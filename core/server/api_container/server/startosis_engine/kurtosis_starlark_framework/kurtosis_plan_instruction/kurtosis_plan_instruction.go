@@ -95,7 +95,7 @@ func (builtin *KurtosisPlanInstructionWrapper) CreateBuiltin() func(thread *star
 				returnedValue,
 			).Executed(true)
 			builtin.instructionsPlan.AddScheduledInstruction(scheduledInstruction).Executed(true)
-			return returnedValue, nil
+			return builtin.interpretBodyIfAny(thread, instructionWrapper, returnedValue)
 		case enclave_structure.InstructionIsUpdate:
 			// otherwise add the instruction as a new one to the plan and return its own returned value
 			if err := builtin.instructionsPlan.AddInstruction(instructionWrapper, returnedFutureValue); err != nil {
@@ -103,7 +103,7 @@ func (builtin *KurtosisPlanInstructionWrapper) CreateBuiltin() func(thread *star
 					instructionWrapper.String(),
 					instructionWrapper.GetPositionInOriginalScript().String())
 			}
-			return returnedFutureValue, nil
+			return builtin.interpretBodyIfAny(thread, instructionWrapper, returnedFutureValue)
 		case enclave_structure.InstructionIsUnknown:
 			if err := builtin.instructionsPlan.AddInstruction(instructionWrapper, returnedFutureValue); err != nil {
 				return nil, startosis_errors.WrapWithInterpretationError(err,
@@ -116,7 +116,7 @@ func (builtin *KurtosisPlanInstructionWrapper) CreateBuiltin() func(thread *star
 				logrus.Debugf("Marking the plan as invalid as instruction '%s' differs from '%s'",
 					instructionWrapper.String(), enclavePlanInstructionPulledFromMaskMaybe.StarlarkCode)
 			}
-			return returnedFutureValue, nil
+			return builtin.interpretBodyIfAny(thread, instructionWrapper, returnedFutureValue)
 		case enclave_structure.InstructionIsNotResolvableAbort:
 			// if the instructions differs, then the mask is invalid
 			builtin.instructionPlanMask.MarkAsInvalid()
@@ -128,8 +128,25 @@ func (builtin *KurtosisPlanInstructionWrapper) CreateBuiltin() func(thread *star
 					instructionWrapper.String(),
 					instructionWrapper.GetPositionInOriginalScript().String())
 			}
-			return returnedFutureValue, nil
+			return builtin.interpretBodyIfAny(thread, instructionWrapper, returnedFutureValue)
 		}
 		return nil, stacktrace.NewError("Unexpected error, resolution status of instruction '%s' did not match any of the covered case.", instructionResolutionStatus)
 	}
 }
+
+// interpretBodyIfAny interprets the Starlark functions passed to control flow instructions. It must be called once the
+// instruction has been added to the plan, such that the instructions in its body are planned after it
+func (builtin *KurtosisPlanInstructionWrapper) interpretBodyIfAny(thread *starlark.Thread, instructionWrapper *kurtosisPlanInstructionInternal, returnedValue starlark.Value) (starlark.Value, error) {
+	capabilitiesWithBody, ok := instructionWrapper.capabilities.(KurtosisPlanInstructionWithBodyCapabilities)
+	if !ok {
+		return returnedValue, nil
+	}
+	instructionUuid, err := builtin.instructionsPlan.GetLastInstructionUuid()
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to get the UUID of instruction '%s' that was just added to the plan. This is a Kurtosis internal bug", instructionWrapper.String())
+	}
+	if err := capabilitiesWithBody.InterpretBody(thread, builtin.instructionsPlan, instructionUuid); err != nil {
+		return nil, err
+	}
+	return returnedValue, nil
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/dependency_graph"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_structure"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/instructions_plan"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/plan_yaml"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
@@ -36,3 +37,13 @@ type KurtosisPlanInstructionCapabilities interface {
 	// UpdateDependencyGraph updates the dependency graph with the effects of running this instruction
 	UpdateDependencyGraph(instructionUuid types.ScheduledInstructionUuid, dependencyGraph *dependency_graph.InstructionDependencyGraph) error
 }
+
+// KurtosisPlanInstructionWithBodyCapabilities is implemented by the control flow instructions, whose arguments contain
+// Starlark functions adding instructions to the plan.
+type KurtosisPlanInstructionWithBodyCapabilities interface {
+	KurtosisPlanInstructionCapabilities
+
+	// InterpretBody is called right after the instruction was added to the plan under instructionUuid. It calls the
+	// Starlark functions passed as arguments, opening a control flow block on the plan for each branch
+	InterpretBody(thread *starlark.Thread, instructionsPlan *instructions_plan.InstructionsPlan, instructionUuid types.ScheduledInstructionUuid) error
+}
@@ -0,0 +1,131 @@
+package test_engine
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/launcher/args"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/interpretation_time_value_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/add_service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/service_config"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages/mock_package_content_provider"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+)
+
+var (
+	testReplicaName0 = service.GetReplicaServiceName(string(testServiceName), 0)
+	testReplicaName1 = service.GetReplicaServiceName(string(testServiceName), 1)
+)
+
+type addServiceReplicasTestCase struct {
+	*testing.T
+	serviceNetwork               *service_network.MockServiceNetwork
+	runtimeValueStore            *runtime_value_store.RuntimeValueStore
+	packageContentProvider       *mock_package_content_provider.MockPackageContentProvider
+	interpretationTimeValueStore *interpretation_time_value_store.InterpretationTimeValueStore
+}
+
+func (suite *KurtosisPlanInstructionTestSuite) TestAddServiceWithReplicas() {
+	testReplica0 := service.NewService(service.NewServiceRegistration(testReplicaName0, testServiceUuid, testEnclaveUuid, nil, string(testReplicaName0)), nil, nil, nil, container.NewContainer(container.ContainerStatus_Running, testContainerImageName, nil, nil, nil))
+	testReplica1 := service.NewService(service.NewServiceRegistration(testReplicaName1, testServiceUuid2, testEnclaveUuid, nil, string(testReplicaName1)), nil, nil, nil, container.NewContainer(container.ContainerStatus_Running, testContainerImageName, nil, nil, nil))
+
+	suite.serviceNetwork.EXPECT().ExistServiceRegistration(testReplicaName0).Times(1).Return(false, nil)
+	suite.serviceNetwork.EXPECT().ExistServiceRegistration(testReplicaName1).Times(1).Return(false, nil)
+	suite.serviceNetwork.EXPECT().UpdateServices(
+		mock.Anything,
+		map[service.ServiceName]*service.ServiceConfig{},
+		mock.Anything,
+	).Times(1).Return(
+		map[service.ServiceName]*service.Service{},
+		map[service.ServiceName]error{},
+		nil,
+	)
+	suite.serviceNetwork.EXPECT().AddServices(
+		mock.Anything,
+		mock.MatchedBy(func(configs map[service.ServiceName]*service.ServiceConfig) bool {
+			suite.Require().Len(configs, 2)
+			for replicaIndex, replicaName := range []service.ServiceName{testReplicaName0, testReplicaName1} {
+				suite.Require().Contains(configs, replicaName)
+
+				expectedServiceConfig, err := service.CreateServiceConfig(testContainerImageName, nil, nil, nil, map[string]*port_spec.PortSpec{}, map[string]*port_spec.PortSpec{}, nil, nil, map[string]string{}, nil, nil, 0, 0, service_config.DefaultPrivateIPAddrPlaceholder, 0, 0, map[string]string{}, nil, nil, map[string]string{}, image_download_mode.ImageDownloadMode_Missing, true, false, []string{}, false, service.NewGpuConfig(0, nil, 0, nil, "", ""))
+				require.NoError(suite.T(), err)
+				expectedServiceConfig.SetReplicaSet(string(testServiceName), replicaIndex)
+				suite.Assert().Equal(expectedServiceConfig, configs[replicaName])
+			}
+			return true
+		}),
+		mock.Anything,
+	).Times(1).Return(
+		map[service.ServiceName]*service.Service{
+			testReplicaName0: testReplica0,
+			testReplicaName1: testReplica1,
+		},
+		map[service.ServiceName]error{},
+		nil,
+	)
+
+	suite.run(&addServiceReplicasTestCase{
+		T:                            suite.T(),
+		serviceNetwork:               suite.serviceNetwork,
+		runtimeValueStore:            suite.runtimeValueStore,
+		packageContentProvider:       suite.packageContentProvider,
+		interpretationTimeValueStore: suite.interpretationTimeValueStore,
+	})
+}
+
+func (t *addServiceReplicasTestCase) GetInstruction() *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return add_service.NewAddService(
+		t.serviceNetwork,
+		t.runtimeValueStore,
+		testModulePackageId,
+		t.packageContentProvider,
+		testNoPackageReplaceOptions,
+		t.interpretationTimeValueStore,
+		image_download_mode.ImageDownloadMode_Missing,
+		false,
+		args.KurtosisBackendType_Docker)
+}
+
+func (t *addServiceReplicasTestCase) GetStarlarkCode() string {
+	serviceConfig := fmt.Sprintf("ServiceConfig(image=%q, replicas=2)", testContainerImageName)
+	return fmt.Sprintf(`%s(%s=%q, %s=%s)`, add_service.AddServiceBuiltinName, add_service.ServiceNameArgName, testServiceName, add_service.ServiceConfigArgName, serviceConfig)
+}
+
+func (t *addServiceReplicasTestCase) GetStarlarkCodeForAssertion() string {
+	return ""
+}
+
+func (t *addServiceReplicasTestCase) Assert(interpretationResult starlark.Value, executionResult *string) {
+	replicaSet, ok := interpretationResult.(*kurtosis_types.ReplicaSet)
+	require.True(t, ok, "interpretation result should be a replica set")
+	replicaSetName, interpretationErr := replicaSet.GetName()
+	require.Nil(t, interpretationErr)
+	require.Equal(t, string(testServiceName), replicaSetName)
+
+	replicas, interpretationErr := replicaSet.GetReplicas()
+	require.Nil(t, interpretationErr)
+	require.Len(t, replicas, 2)
+	for replicaIndex, replicaName := range []service.ServiceName{testReplicaName0, testReplicaName1} {
+		actualReplicaName, interpretationErr := replicas[replicaIndex].GetName()
+		require.Nil(t, interpretationErr)
+		require.Equal(t, replicaName, actualReplicaName)
+		actualReplicaSetName, isReplica, interpretationErr := replicas[replicaIndex].GetReplicaSetName()
+		require.Nil(t, interpretationErr)
+		require.True(t, isReplica)
+		require.Equal(t, string(testServiceName), actualReplicaSetName)
+	}
+
+	require.Contains(t, *executionResult, "Successfully added the following '2' services:")
+	require.Contains(t, *executionResult, fmt.Sprintf("Service '%s' added with UUID '%s'", testReplicaName0, testServiceUuid))
+	require.Contains(t, *executionResult, fmt.Sprintf("Service '%s' added with UUID '%s'", testReplicaName1, testServiceUuid2))
+}
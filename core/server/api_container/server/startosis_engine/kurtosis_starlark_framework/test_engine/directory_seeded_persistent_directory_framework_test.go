@@ -0,0 +1,43 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/directory"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+type directorySeededPersistentDirectoryTestCase struct {
+	*testing.T
+}
+
+func (suite *KurtosisTypeConstructorTestSuite) TestDirectorySeededPersistentDirectory() {
+	suite.run(&directorySeededPersistentDirectoryTestCase{
+		T: suite.T(),
+	})
+}
+
+func (t *directorySeededPersistentDirectoryTestCase) GetStarlarkCode() string {
+	return fmt.Sprintf("%s(%s=%q, %s=%q)", directory.DirectoryTypeName, directory.PersistentKeyAttr, testPersistentDirectoryKey, directory.SeedUrlAttr, testPersistentDirectorySeedUrl)
+}
+
+func (t *directorySeededPersistentDirectoryTestCase) Assert(typeValue builtin_argument.KurtosisValueType) {
+	directoryStarlark, ok := typeValue.(*directory.Directory)
+	require.True(t, ok)
+
+	persistentKey, found, err := directoryStarlark.GetPersistentKeyIfSet()
+	require.Nil(t, err)
+	require.True(t, found)
+	require.Equal(t, testPersistentDirectoryKey, persistentKey)
+
+	seedArtifactName, found, err := directoryStarlark.GetSeedArtifactNameIfSet()
+	require.Nil(t, err)
+	require.False(t, found)
+	require.Empty(t, seedArtifactName)
+
+	seedUrl, found, err := directoryStarlark.GetSeedUrlIfSet()
+	require.Nil(t, err)
+	require.True(t, found)
+	require.Equal(t, testPersistentDirectorySeedUrl, seedUrl)
+}
@@ -0,0 +1,53 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/control_flow"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers/magic_string_helper"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"testing"
+)
+
+const (
+	forEachItemsRuntimeValueField = "output"
+)
+
+type forEachTestCase struct {
+	*testing.T
+	runtimeValueStore *runtime_value_store.RuntimeValueStore
+	itemsUuid         string
+}
+
+func (suite *KurtosisPlanInstructionTestSuite) TestForEach() {
+	itemsUuid, err := suite.runtimeValueStore.CreateValue()
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.runtimeValueStore.SetValue(itemsUuid, map[string]starlark.Comparable{forEachItemsRuntimeValueField: starlark.String("node-1\nnode-2\n\nnode-3\n")}))
+
+	suite.run(&forEachTestCase{
+		T:                 suite.T(),
+		runtimeValueStore: suite.runtimeValueStore,
+		itemsUuid:         itemsUuid,
+	})
+}
+
+func (t *forEachTestCase) GetInstruction() *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return control_flow.NewForEach(t.runtimeValueStore)
+}
+
+func (t *forEachTestCase) GetStarlarkCode() string {
+	items := fmt.Sprintf(magic_string_helper.RuntimeValueReplacementPlaceholderFormat, t.itemsUuid, forEachItemsRuntimeValueField)
+	return fmt.Sprintf("%s(%s=%q, %s=lambda item: None)", control_flow.ForEachBuiltinName, control_flow.ItemsArgName, items, control_flow.BodyArgName)
+}
+
+func (t *forEachTestCase) GetStarlarkCodeForAssertion() string {
+	items := fmt.Sprintf(magic_string_helper.RuntimeValueReplacementPlaceholderFormat, t.itemsUuid, forEachItemsRuntimeValueField)
+	return fmt.Sprintf("%s(%s=%q, %s=<function lambda>)", control_flow.ForEachBuiltinName, control_flow.ItemsArgName, items, control_flow.BodyArgName)
+}
+
+func (t *forEachTestCase) Assert(interpretationResult starlark.Value, executionResult *string) {
+	require.Equal(t, starlark.None, interpretationResult)
+	require.Equal(t, "Iterating over 3 items", *executionResult)
+}
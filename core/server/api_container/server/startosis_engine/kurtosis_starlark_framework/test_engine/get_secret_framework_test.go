@@ -0,0 +1,49 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/get_secret"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers/magic_string_helper"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"testing"
+)
+
+const (
+	testSecretName = "registry-password"
+)
+
+type getSecretTestCase struct {
+	*testing.T
+	runtimeValueStore *runtime_value_store.RuntimeValueStore
+}
+
+func (suite *KurtosisPlanInstructionTestSuite) TestGetSecret() {
+	suite.run(&getSecretTestCase{
+		T:                 suite.T(),
+		runtimeValueStore: suite.runtimeValueStore,
+	})
+}
+
+func (t *getSecretTestCase) GetInstruction() *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return get_secret.NewGetSecret(t.runtimeValueStore)
+}
+
+func (t *getSecretTestCase) GetStarlarkCode() string {
+	return fmt.Sprintf("%s(%s=%q)", get_secret.GetSecretBuiltinName, get_secret.SecretNameArgName, testSecretName)
+}
+
+func (t *getSecretTestCase) GetStarlarkCodeForAssertion() string {
+	return ""
+}
+
+func (t *getSecretTestCase) Assert(interpretationResult starlark.Value, executionResult *string) {
+	// the secret value must never be known at interpretation time, only its placeholder
+	expectedInterpretationResult := starlark.String(fmt.Sprintf(magic_string_helper.SecretReplacementPlaceholderFormat, testSecretName))
+	require.Equal(t, expectedInterpretationResult, interpretationResult)
+
+	expectedExecutionResult := fmt.Sprintf("Fetched secret '%s'", testSecretName)
+	require.Equal(t, expectedExecutionResult, *executionResult)
+}
@@ -0,0 +1,53 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/control_flow"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers/magic_string_helper"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"testing"
+)
+
+const (
+	ifConditionRuntimeValueField = "code"
+)
+
+type ifTestCase struct {
+	*testing.T
+	runtimeValueStore *runtime_value_store.RuntimeValueStore
+	conditionUuid     string
+}
+
+func (suite *KurtosisPlanInstructionTestSuite) TestIf() {
+	conditionUuid, err := suite.runtimeValueStore.CreateValue()
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.runtimeValueStore.SetValue(conditionUuid, map[string]starlark.Comparable{ifConditionRuntimeValueField: starlark.MakeInt(0)}))
+
+	suite.run(&ifTestCase{
+		T:                 suite.T(),
+		runtimeValueStore: suite.runtimeValueStore,
+		conditionUuid:     conditionUuid,
+	})
+}
+
+func (t *ifTestCase) GetInstruction() *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return control_flow.NewIf(t.runtimeValueStore)
+}
+
+func (t *ifTestCase) GetStarlarkCode() string {
+	condition := fmt.Sprintf(magic_string_helper.RuntimeValueReplacementPlaceholderFormat, t.conditionUuid, ifConditionRuntimeValueField)
+	return fmt.Sprintf("%s(%s=%q, %s=%q, %s=%d, %s=lambda: None, %s=lambda: None)", control_flow.IfBuiltinName, control_flow.ConditionArgName, condition, control_flow.AssertionArgName, "==", control_flow.TargetValueArgName, 0, control_flow.ThenArgName, control_flow.ElseArgName)
+}
+
+func (t *ifTestCase) GetStarlarkCodeForAssertion() string {
+	condition := fmt.Sprintf(magic_string_helper.RuntimeValueReplacementPlaceholderFormat, t.conditionUuid, ifConditionRuntimeValueField)
+	return fmt.Sprintf("%s(%s=%q, %s=%q, %s=%d, %s=<function lambda>, %s=<function lambda>)", control_flow.IfBuiltinName, control_flow.ConditionArgName, condition, control_flow.AssertionArgName, "==", control_flow.TargetValueArgName, 0, control_flow.ThenArgName, control_flow.ElseArgName)
+}
+
+func (t *ifTestCase) Assert(interpretationResult starlark.Value, executionResult *string) {
+	require.Equal(t, starlark.None, interpretationResult)
+	require.Equal(t, "Condition evaluated to true, running the 'then' branch", *executionResult)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/render_templates"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"go.starlark.net/starlark"
 	"testing"
@@ -48,7 +49,7 @@ func (suite *KurtosisPlanInstructionTestSuite) TestRenderMultipleTemplates() {
 		nil,
 	)
 
-	suite.serviceNetwork.EXPECT().RenderTemplates(templatesAndData, mockedFileArtifactName).Times(1).Return(testArtifactUuid, nil)
+	suite.serviceNetwork.EXPECT().RenderTemplates(templatesAndData, mockedFileArtifactName, mock.Anything).Times(1).Return(testArtifactUuid, nil)
 
 	suite.run(&renderMultipleTemplatesTestCase{
 		T:                 suite.T(),
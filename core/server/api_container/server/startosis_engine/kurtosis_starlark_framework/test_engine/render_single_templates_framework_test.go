@@ -34,7 +34,7 @@ func (suite *KurtosisPlanInstructionTestSuite) TestRenderSingleTemplate() {
 		renderTemplate_SingleTemplate_filePath: templateData,
 	}
 
-	suite.serviceNetwork.EXPECT().RenderTemplates(templateAndData, testArtifactName).Times(1).Return(testArtifactUuid, nil)
+	suite.serviceNetwork.EXPECT().RenderTemplates(templateAndData, testArtifactName, fmt.Sprintf("render_templates: Rendering a template to a files artifact with name '%v'", testArtifactName)).Times(1).Return(testArtifactUuid, nil)
 
 	suite.run(&renderSingleTemplateTestCase{
 		T:                 suite.T(),
@@ -0,0 +1,57 @@
+package test_engine
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/service_config"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages"
+	"github.com/stretchr/testify/require"
+)
+
+type serviceConfigReplicasTestCase struct {
+	*testing.T
+	serviceNetwork         *service_network.MockServiceNetwork
+	packageContentProvider *startosis_packages.MockPackageContentProvider
+}
+
+func (suite *KurtosisTypeConstructorTestSuite) TestServiceConfigWithReplicas() {
+	suite.run(&serviceConfigReplicasTestCase{
+		T:                      suite.T(),
+		serviceNetwork:         suite.serviceNetwork,
+		packageContentProvider: suite.packageContentProvider,
+	})
+}
+
+func (t *serviceConfigReplicasTestCase) GetStarlarkCode() string {
+	return fmt.Sprintf("%s(%s=%q, %s=3)",
+		service_config.ServiceConfigTypeName,
+		service_config.ImageAttr, testContainerImageName,
+		service_config.ReplicasAttr)
+}
+
+func (t *serviceConfigReplicasTestCase) Assert(typeValue builtin_argument.KurtosisValueType) {
+	serviceConfigStarlark, ok := typeValue.(*service_config.ServiceConfig)
+	require.True(t, ok)
+
+	replicas, interpretationErr := serviceConfigStarlark.GetReplicas()
+	require.Nil(t, interpretationErr)
+	require.Equal(t, 3, replicas)
+
+	serviceConfigResult, interpretationErr := serviceConfigStarlark.ToKurtosisType(
+		t.serviceNetwork,
+		testModuleMainFileLocator,
+		testModulePackageId,
+		t.packageContentProvider,
+		testNoPackageReplaceOptions,
+		image_download_mode.ImageDownloadMode_Missing)
+	require.Nil(t, interpretationErr)
+
+	// the replicas only become members of their replica set when the add_service instruction creates them
+	_, isReplica := serviceConfigResult.GetReplicaSetName()
+	require.False(t, isReplica)
+	require.Empty(t, serviceConfigResult.GetNetworkAliases())
+}
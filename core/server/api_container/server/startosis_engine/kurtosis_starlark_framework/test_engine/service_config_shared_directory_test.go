@@ -0,0 +1,73 @@
+package test_engine
+
+import (
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/directory"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/service_config"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testSharedDirectoryName    = "ipc"
+	testSharedDirectoryDirpath = "/ipc"
+)
+
+type serviceConfigSharedDirectoryTestCase struct {
+	*testing.T
+	serviceNetwork         *service_network.MockServiceNetwork
+	packageContentProvider *startosis_packages.MockPackageContentProvider
+}
+
+func (suite *KurtosisTypeConstructorTestSuite) TestServiceConfigWithSharedDirectory() {
+	suite.serviceNetwork.EXPECT().GetApiContainerInfo().Times(1).Return(
+		service_network.NewApiContainerInfo(net.IPv4(0, 0, 0, 0), 0, "0.0.0"),
+	)
+
+	suite.run(&serviceConfigSharedDirectoryTestCase{
+		T:                      suite.T(),
+		serviceNetwork:         suite.serviceNetwork,
+		packageContentProvider: suite.packageContentProvider,
+	})
+}
+
+func (t *serviceConfigSharedDirectoryTestCase) GetStarlarkCode() string {
+	sharedDirectory := fmt.Sprintf("%s(%s=%q, %s=%d)",
+		directory.SharedDirectoryTypeName,
+		directory.SharedDirectoryNameAttr, testSharedDirectoryName,
+		directory.SizeKeyAttr, testPersistentDirectorySize)
+	return fmt.Sprintf("%s(%s=%q, %s={%q: %s})",
+		service_config.ServiceConfigTypeName,
+		service_config.ImageAttr, testContainerImageName,
+		service_config.FilesAttr, testSharedDirectoryDirpath, sharedDirectory)
+}
+
+func (t *serviceConfigSharedDirectoryTestCase) Assert(typeValue builtin_argument.KurtosisValueType) {
+	serviceConfigStarlark, ok := typeValue.(*service_config.ServiceConfig)
+	require.True(t, ok)
+
+	serviceConfig, interpretationErr := serviceConfigStarlark.ToKurtosisType(
+		t.serviceNetwork,
+		testModuleMainFileLocator,
+		testModulePackageId,
+		t.packageContentProvider,
+		testNoPackageReplaceOptions,
+		image_download_mode.ImageDownloadMode_Missing)
+	require.Nil(t, interpretationErr)
+
+	require.Empty(t, serviceConfig.GetPersistentDirectories().ServiceDirpathToPersistentDirectory)
+	expectedSharedDirectories := service_directory.NewSharedDirectories(map[string]service_directory.SharedDirectory{
+		testSharedDirectoryDirpath: {
+			Name: testSharedDirectoryName,
+			Size: service_directory.DirectoryPersistentSize(testPersistentDirectorySizeInBytes),
+		},
+	})
+	require.Equal(t, expectedSharedDirectories, serviceConfig.GetSharedDirectories())
+}
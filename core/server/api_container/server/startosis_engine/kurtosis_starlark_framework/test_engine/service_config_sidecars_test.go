@@ -0,0 +1,83 @@
+package test_engine
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/service_config"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testInitContainerName  = "init-schema"
+	testInitContainerImage = "postgres:alpine"
+	testSidecarName        = "log-shipper"
+	testSidecarImage       = "fluent/fluent-bit:latest"
+)
+
+type serviceConfigSidecarsTestCase struct {
+	*testing.T
+	serviceNetwork         *service_network.MockServiceNetwork
+	packageContentProvider *startosis_packages.MockPackageContentProvider
+}
+
+func (suite *KurtosisTypeConstructorTestSuite) TestServiceConfigWithInitContainersAndSidecars() {
+	suite.run(&serviceConfigSidecarsTestCase{
+		T:                      suite.T(),
+		serviceNetwork:         suite.serviceNetwork,
+		packageContentProvider: suite.packageContentProvider,
+	})
+}
+
+func (t *serviceConfigSidecarsTestCase) GetStarlarkCode() string {
+	initContainer := fmt.Sprintf("%s(%s=%q, %s=%q, %s=[%q, %q], %s={%q: %q})",
+		service_config.ContainerSpecTypeName,
+		service_config.ContainerSpecNameAttr, testInitContainerName,
+		service_config.ContainerSpecImageAttr, testInitContainerImage,
+		service_config.ContainerSpecCmdAttr, "psql", "-f",
+		service_config.ContainerSpecEnvVarsAttr, "PGHOST", "localhost")
+	sidecar := fmt.Sprintf("%s(%s=%q, %s=%q, %s=[%q])",
+		service_config.ContainerSpecTypeName,
+		service_config.ContainerSpecNameAttr, testSidecarName,
+		service_config.ContainerSpecImageAttr, testSidecarImage,
+		service_config.ContainerSpecEntrypointAttr, "/fluent-bit/bin/fluent-bit")
+	return fmt.Sprintf("%s(%s=%q, %s=[%s], %s=[%s])",
+		service_config.ServiceConfigTypeName,
+		service_config.ImageAttr, testContainerImageName,
+		service_config.InitContainersAttr, initContainer,
+		service_config.SidecarsAttr, sidecar)
+}
+
+func (t *serviceConfigSidecarsTestCase) Assert(typeValue builtin_argument.KurtosisValueType) {
+	serviceConfigStarlark, ok := typeValue.(*service_config.ServiceConfig)
+	require.True(t, ok)
+
+	serviceConfig, interpretationErr := serviceConfigStarlark.ToKurtosisType(
+		t.serviceNetwork,
+		testModuleMainFileLocator,
+		testModulePackageId,
+		t.packageContentProvider,
+		testNoPackageReplaceOptions,
+		image_download_mode.ImageDownloadMode_Missing)
+	require.Nil(t, interpretationErr)
+
+	require.Len(t, serviceConfig.GetInitContainers(), 1)
+	initContainer := serviceConfig.GetInitContainers()[0]
+	require.Equal(t, testInitContainerName, initContainer.GetName())
+	require.Equal(t, testInitContainerImage, initContainer.GetContainerImageName())
+	require.Equal(t, []string{"psql", "-f"}, initContainer.GetCmdArgs())
+	require.Nil(t, initContainer.GetEntrypointArgs())
+	require.Equal(t, map[string]string{"PGHOST": "localhost"}, initContainer.GetEnvVars())
+
+	require.Len(t, serviceConfig.GetSidecars(), 1)
+	sidecar := serviceConfig.GetSidecars()[0]
+	require.Equal(t, testSidecarName, sidecar.GetName())
+	require.Equal(t, testSidecarImage, sidecar.GetContainerImageName())
+	require.Nil(t, sidecar.GetCmdArgs())
+	require.Equal(t, []string{"/fluent-bit/bin/fluent-bit"}, sidecar.GetEntrypointArgs())
+	require.Empty(t, sidecar.GetEnvVars())
+}
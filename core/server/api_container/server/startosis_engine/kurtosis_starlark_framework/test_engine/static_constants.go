@@ -81,6 +81,7 @@ var (
 	testPersistentDirectoryKey               = "persistent-dir-test"
 	testPersistentDirectorySize        int64 = 30
 	testPersistentDirectorySizeInBytes       = testPersistentDirectorySize * 1024 * 1024
+	testPersistentDirectorySeedUrl           = "https://example.com/genesis.tgz"
 
 	testEntryPointSlice = []string{
 		"127.0.0.0",
@@ -22,6 +22,7 @@ func (suite *KurtosisPlanInstructionTestSuite) TestStoreServiceFiles() {
 		string(testServiceName),
 		testSrcPath,
 		testArtifactName,
+		fmt.Sprintf("store_service_files: Storing files from service '%v' at path '%v' to files artifact with name '%v'", testServiceName, testSrcPath, testArtifactName),
 	).Times(1).Return(
 		testArtifactUuid,
 		nil,
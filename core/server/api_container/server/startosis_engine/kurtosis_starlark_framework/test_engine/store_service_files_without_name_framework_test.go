@@ -27,6 +27,7 @@ func (suite *KurtosisPlanInstructionTestSuite) TestStoreServiceFilesWithoutName(
 		string(testServiceName),
 		testSrcPath,
 		mockedFileArtifactName,
+		mock.Anything,
 	).Times(1).Return(
 		testArtifactUuid,
 		nil,
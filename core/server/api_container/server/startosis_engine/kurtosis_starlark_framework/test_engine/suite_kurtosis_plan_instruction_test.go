@@ -45,7 +45,7 @@ func (suite *KurtosisPlanInstructionTestSuite) SetupTest() {
 
 	enclaveDb := getEnclaveDBForTest(suite.T())
 	serde := kurtosis_types.NewStarlarkValueSerde(suite.starlarkThread, suite.starlarkEnv)
-	runtimeValueStoreForTest, err := runtime_value_store.CreateRuntimeValueStore(serde, enclaveDb)
+	runtimeValueStoreForTest, err := runtime_value_store.CreateRuntimeValueStore(serde, enclaveDb, nil)
 	suite.Require().NoError(err)
 	suite.runtimeValueStore = runtimeValueStoreForTest
 
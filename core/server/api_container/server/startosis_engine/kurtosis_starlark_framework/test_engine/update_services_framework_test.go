@@ -0,0 +1,108 @@
+package test_engine
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/launcher/args"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/interpretation_time_value_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/add_service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/rolling_update"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages/mock_package_content_provider"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+)
+
+type updateServicesTestCase struct {
+	*testing.T
+	serviceNetwork               *service_network.MockServiceNetwork
+	runtimeValueStore            *runtime_value_store.RuntimeValueStore
+	packageContentProvider       *mock_package_content_provider.MockPackageContentProvider
+	interpretationTimeValueStore *interpretation_time_value_store.InterpretationTimeValueStore
+}
+
+func (suite *KurtosisPlanInstructionTestSuite) TestUpdateServices() {
+	testService1 := service.NewService(service.NewServiceRegistration(testServiceName, testServiceUuid, testEnclaveUuid, nil, string(testServiceName)), nil, nil, nil, container.NewContainer(container.ContainerStatus_Running, testContainerImageName, nil, nil, nil))
+	testService2 := service.NewService(service.NewServiceRegistration(testServiceName2, testServiceUuid2, testEnclaveUuid, nil, string(testServiceName2)), nil, nil, nil, container.NewContainer(container.ContainerStatus_Running, testContainerImageName, nil, nil, nil))
+
+	previousServiceConfig, err := service.CreateServiceConfig("previous-image", nil, nil, nil, nil, nil, []string{}, []string{}, map[string]string{}, nil, nil, 0, 0, "IP-ADDRESS", 0, 0, map[string]string{}, nil, nil, nil, image_download_mode.ImageDownloadMode_Missing, true, false, []string{}, false, service.NewGpuConfig(0, nil, 0, nil, "", ""))
+	require.NoError(suite.T(), err)
+	suite.serviceNetwork.EXPECT().GetServiceConfig(testServiceName).Times(1).Return(previousServiceConfig, nil)
+	suite.serviceNetwork.EXPECT().GetServiceConfig(testServiceName2).Times(1).Return(previousServiceConfig, nil)
+
+	// with max_unavailable=1, the services are updated one after the other, in the order of their names
+	suite.serviceNetwork.EXPECT().UpdateServices(
+		mock.Anything,
+		mock.MatchedBy(func(configs map[service.ServiceName]*service.ServiceConfig) bool {
+			_, found := configs[testServiceName]
+			return len(configs) == 1 && found
+		}),
+		mock.Anything,
+	).Times(1).Return(
+		map[service.ServiceName]*service.Service{testServiceName: testService1},
+		map[service.ServiceName]error{},
+		nil,
+	)
+	suite.serviceNetwork.EXPECT().UpdateServices(
+		mock.Anything,
+		mock.MatchedBy(func(configs map[service.ServiceName]*service.ServiceConfig) bool {
+			_, found := configs[testServiceName2]
+			return len(configs) == 1 && found
+		}),
+		mock.Anything,
+	).Times(1).Return(
+		map[service.ServiceName]*service.Service{testServiceName2: testService2},
+		map[service.ServiceName]error{},
+		nil,
+	)
+
+	suite.run(&updateServicesTestCase{
+		T:                            suite.T(),
+		serviceNetwork:               suite.serviceNetwork,
+		runtimeValueStore:            suite.runtimeValueStore,
+		packageContentProvider:       suite.packageContentProvider,
+		interpretationTimeValueStore: suite.interpretationTimeValueStore,
+	})
+}
+
+func (t *updateServicesTestCase) GetInstruction() *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return add_service.NewUpdateServices(
+		t.serviceNetwork,
+		t.runtimeValueStore,
+		testModulePackageId,
+		t.packageContentProvider,
+		testNoPackageReplaceOptions,
+		t.interpretationTimeValueStore,
+		image_download_mode.ImageDownloadMode_Missing,
+		false,
+		args.KurtosisBackendType_Docker)
+}
+
+func (t *updateServicesTestCase) GetStarlarkCode() string {
+	serviceConfig := fmt.Sprintf("ServiceConfig(image=%q)", testContainerImageName)
+	strategy := fmt.Sprintf("%s(%s=1, %s=%q)", rolling_update.RollingUpdateTypeName, rolling_update.MaxUnavailableAttr, rolling_update.OnFailureAttr, rolling_update.OnFailureRollback)
+	return fmt.Sprintf(`%s(%s={%q: %s, %q: %s}, %s=%s)`, add_service.UpdateServicesBuiltinName, add_service.ConfigsArgName, testServiceName, serviceConfig, testServiceName2, serviceConfig, add_service.StrategyArgName, strategy)
+}
+
+func (t *updateServicesTestCase) GetStarlarkCodeForAssertion() string {
+	return ""
+}
+
+func (t *updateServicesTestCase) Assert(interpretationResult starlark.Value, executionResult *string) {
+	resultDict, ok := interpretationResult.(*starlark.Dict)
+	require.True(t, ok, "interpretation result should be a dictionary")
+	require.Equal(t, 2, resultDict.Len())
+	require.Contains(t, resultDict.Keys(), starlark.String(testServiceName))
+	require.Contains(t, resultDict.Keys(), starlark.String(testServiceName2))
+
+	require.Contains(t, *executionResult, "Successfully updated the following '2' services:")
+	require.Contains(t, *executionResult, fmt.Sprintf("Service '%s' updated with UUID '%s'", testServiceName, testServiceUuid))
+	require.Contains(t, *executionResult, fmt.Sprintf("Service '%s' updated with UUID '%s'", testServiceName2, testServiceUuid2))
+}
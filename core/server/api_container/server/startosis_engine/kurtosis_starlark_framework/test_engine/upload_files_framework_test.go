@@ -34,6 +34,7 @@ func (suite *KurtosisPlanInstructionTestSuite) TestUploadFiles() {
 		mock.Anything, // data gets written to disk and compressed to it's a bit tricky to replicate here.
 		mock.Anything, // and same for the hash
 		testArtifactName,
+		mock.Anything,
 	).Times(1).Return(
 		testArtifactUuid,
 		nil,
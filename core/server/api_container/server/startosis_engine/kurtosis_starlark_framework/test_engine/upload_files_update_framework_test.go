@@ -33,6 +33,7 @@ func (suite *KurtosisPlanInstructionTestSuite) TestUploadFilesUpdate() {
 		testArtifactUuid,
 		mock.Anything, // data gets written to disk and compressed to it's a bit tricky to replicate here.
 		mock.Anything, // and same for the hash
+		mock.Anything,
 	).Times(1).Return(
 		nil,
 	)
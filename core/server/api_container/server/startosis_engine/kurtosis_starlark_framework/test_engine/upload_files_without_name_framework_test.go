@@ -39,6 +39,7 @@ func (suite *KurtosisPlanInstructionTestSuite) TestUploadFilesWithoutName() {
 		mock.Anything, // data gets written to disk and compressed to it's a bit tricky to replicate here.
 		mock.Anything, // and same for the hash
 		mockedFileArtifactName,
+		mock.Anything,
 	).Times(1).Return(
 		testArtifactUuid,
 		nil,
@@ -9,6 +9,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
 	"go.starlark.net/starlark"
 	"math"
+	"net/url"
 )
 
 const (
@@ -17,6 +18,11 @@ const (
 	ArtifactNamesAttr = "artifact_names"
 	PersistentKeyAttr = "persistent_key"
 	SizeKeyAttr       = "size"
+	SeedArtifactAttr  = "seed_artifact_name"
+	SeedUrlAttr       = "seed_url"
+
+	httpScheme  = "http"
+	httpsScheme = "https"
 
 	atleastOneMegabyte       = 1
 	megaByteToByteMultiplier = 1024 * 1024
@@ -52,6 +58,20 @@ func NewDirectoryType() *kurtosis_type_constructor.KurtosisTypeConstructor {
 						return builtin_argument.Int64InRange(value, SizeKeyAttr, atleastOneMegabyte, math.MaxInt64)
 					},
 				},
+				{
+					Name:              SeedArtifactAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, SeedArtifactAttr)
+					},
+				},
+				{
+					Name:              SeedUrlAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator:         validateSeedUrl,
+				},
 			},
 		},
 
@@ -59,6 +79,21 @@ func NewDirectoryType() *kurtosis_type_constructor.KurtosisTypeConstructor {
 	}
 }
 
+func validateSeedUrl(value starlark.Value) *startosis_errors.InterpretationError {
+	if interpretationErr := builtin_argument.NonEmptyString(value, SeedUrlAttr); interpretationErr != nil {
+		return interpretationErr
+	}
+	seedUrl, ok := value.(starlark.String)
+	if !ok {
+		return startosis_errors.NewInterpretationError("Attribute '%s' is expected to be a string. Got '%s'", SeedUrlAttr, value.Type())
+	}
+	parsedUrl, err := url.ParseRequestURI(seedUrl.GoString())
+	if err != nil || (parsedUrl.Scheme != httpScheme && parsedUrl.Scheme != httpsScheme) {
+		return startosis_errors.NewInterpretationError("Attribute '%s' is expected to be an http or https URL. Got '%s'", SeedUrlAttr, seedUrl.GoString())
+	}
+	return nil
+}
+
 func instantiate(arguments *builtin_argument.ArgumentValuesSet) (builtin_argument.KurtosisValueType, *startosis_errors.InterpretationError) {
 	kurtosisValueType, interpretationErr := kurtosis_type_constructor.CreateKurtosisStarlarkTypeDefault(DirectoryTypeName, arguments)
 	if interpretationErr != nil {
@@ -82,6 +117,8 @@ func CreateDirectoryFromFilesArtifact(
 		starlark.String(filesArtifactName),
 		nil,
 		nil,
+		nil,
+		nil,
 	}
 
 	argumentDefinitions := NewDirectoryType().Arguments
@@ -162,3 +199,29 @@ func (directory *Directory) GetSizeOrDefault() (int64, *startosis_errors.Interpr
 	}
 	return sizeInt64 * megaByteToByteMultiplier, nil
 }
+
+// GetSeedArtifactNameIfSet returns the files artifact that should initialize the persistent directory when it gets created
+func (directory *Directory) GetSeedArtifactNameIfSet() (string, bool, *startosis_errors.InterpretationError) {
+	seedArtifactName, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](
+		directory.KurtosisValueTypeDefault, SeedArtifactAttr)
+	if interpretationErr != nil {
+		return "", false, interpretationErr
+	}
+	if !found {
+		return "", false, nil
+	}
+	return seedArtifactName.GoString(), true, nil
+}
+
+// GetSeedUrlIfSet returns the URL of the tarball that should initialize the persistent directory when it gets created
+func (directory *Directory) GetSeedUrlIfSet() (string, bool, *startosis_errors.InterpretationError) {
+	seedUrl, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](
+		directory.KurtosisValueTypeDefault, SeedUrlAttr)
+	if interpretationErr != nil {
+		return "", false, interpretationErr
+	}
+	if !found {
+		return "", false, nil
+	}
+	return seedUrl.GoString(), true, nil
+}
@@ -0,0 +1,103 @@
+package directory
+
+import (
+	"math"
+
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_type_constructor"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_constants"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"go.starlark.net/starlark"
+)
+
+const (
+	SharedDirectoryTypeName = "SharedDirectory"
+
+	SharedDirectoryNameAttr = "name"
+)
+
+func NewSharedDirectoryType() *kurtosis_type_constructor.KurtosisTypeConstructor {
+	return &kurtosis_type_constructor.KurtosisTypeConstructor{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: SharedDirectoryTypeName,
+
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              SharedDirectoryNameAttr,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, SharedDirectoryNameAttr)
+					},
+				},
+				{
+					Name:              SizeKeyAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Int],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.Int64InRange(value, SizeKeyAttr, atleastOneMegabyte, math.MaxInt64)
+					},
+				},
+			},
+		},
+
+		Instantiate: instantiateSharedDirectory,
+	}
+}
+
+func instantiateSharedDirectory(arguments *builtin_argument.ArgumentValuesSet) (builtin_argument.KurtosisValueType, *startosis_errors.InterpretationError) {
+	kurtosisValueType, interpretationErr := kurtosis_type_constructor.CreateKurtosisStarlarkTypeDefault(SharedDirectoryTypeName, arguments)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	return &SharedDirectory{
+		KurtosisValueTypeDefault: kurtosisValueType,
+	}, nil
+}
+
+// SharedDirectory is a writable directory that several services can mount at the same time, for as long as the
+// enclave exists
+type SharedDirectory struct {
+	*kurtosis_type_constructor.KurtosisValueTypeDefault
+}
+
+func (sharedDirectory *SharedDirectory) Copy() (builtin_argument.KurtosisValueType, error) {
+	copiedValueType, err := sharedDirectory.KurtosisValueTypeDefault.Copy()
+	if err != nil {
+		return nil, err
+	}
+	return &SharedDirectory{
+		KurtosisValueTypeDefault: copiedValueType,
+	}, nil
+}
+
+func (sharedDirectory *SharedDirectory) GetName() (string, *startosis_errors.InterpretationError) {
+	name, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](
+		sharedDirectory.KurtosisValueTypeDefault, SharedDirectoryNameAttr)
+	if interpretationErr != nil {
+		return "", interpretationErr
+	}
+	if !found {
+		return "", startosis_errors.NewInterpretationError("Required attribute '%s' could not be found on type '%s'", SharedDirectoryNameAttr, SharedDirectoryTypeName)
+	}
+	return name.GoString(), nil
+}
+
+// GetSizeOrDefault returns the size of the directory in bytes, which only matters on Kubernetes. As for persistent
+// directories, it's only used when the directory gets created
+func (sharedDirectory *SharedDirectory) GetSizeOrDefault() (int64, *startosis_errors.InterpretationError) {
+	size, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.Int](
+		sharedDirectory.KurtosisValueTypeDefault, SizeKeyAttr)
+	if interpretationErr != nil {
+		return 0, interpretationErr
+	}
+	if !found {
+		return startosis_constants.DefaultPersistentDirectorySize, nil
+	}
+	sizeInt64, ok := size.Int64()
+	if !ok {
+		return 0, startosis_errors.NewInterpretationError("Couldn't convert size '%v' to int64", size)
+	}
+	return sizeInt64 * megaByteToByteMultiplier, nil
+}
@@ -0,0 +1,131 @@
+package kurtosis_types
+
+import (
+	"reflect"
+
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_type_constructor"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"go.starlark.net/starlark"
+)
+
+const (
+	ReplicaSetTypeName = "ReplicaSet"
+
+	ReplicaSetNameAttr     = "name"
+	ReplicaSetHostnameAttr = "hostname"
+	ReplicasAttr           = "replicas"
+)
+
+func NewReplicaSetType() *kurtosis_type_constructor.KurtosisTypeConstructor {
+	return &kurtosis_type_constructor.KurtosisTypeConstructor{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: ReplicaSetTypeName,
+
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              ReplicaSetNameAttr,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, ReplicaSetNameAttr)
+					},
+				},
+				{
+					Name:              ReplicaSetHostnameAttr,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, ReplicaSetHostnameAttr)
+					},
+				},
+				{
+					Name:              ReplicasAttr,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.List],
+					Validator:         nil,
+				},
+			},
+		},
+
+		Instantiate: instantiateReplicaSet,
+	}
+}
+
+func instantiateReplicaSet(arguments *builtin_argument.ArgumentValuesSet) (builtin_argument.KurtosisValueType, *startosis_errors.InterpretationError) {
+	kurtosisValueType, interpretationErr := kurtosis_type_constructor.CreateKurtosisStarlarkTypeDefault(ReplicaSetTypeName, arguments)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	return &ReplicaSet{
+		KurtosisValueTypeDefault: kurtosisValueType,
+	}, nil
+}
+
+// ReplicaSet is the group of the identical services added by an add_service instruction with several replicas. Its
+// hostname resolves to all its replicas
+type ReplicaSet struct {
+	*kurtosis_type_constructor.KurtosisValueTypeDefault
+}
+
+// CreateReplicaSet creates the replica set with the given replicas, which should be sorted by index
+func CreateReplicaSet(replicaSetName starlark.String, replicas []*Service) (*ReplicaSet, *startosis_errors.InterpretationError) {
+	replicasList := &starlark.List{}
+	for _, replica := range replicas {
+		if err := replicasList.Append(replica); err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "An error occurred adding a replica to replica set '%s'", replicaSetName.GoString())
+		}
+	}
+	args := []starlark.Value{
+		replicaSetName,
+		replicaSetName, // the replica set name is the network alias shared by all its replicas
+		replicasList,
+	}
+
+	argumentDefinitions := NewReplicaSetType().Arguments
+	argumentValuesSet := builtin_argument.NewArgumentValuesSet(argumentDefinitions, args)
+	kurtosisDefaultValue, interpretationErr := kurtosis_type_constructor.CreateKurtosisStarlarkTypeDefault(ReplicaSetTypeName, argumentValuesSet)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	return &ReplicaSet{
+		KurtosisValueTypeDefault: kurtosisDefaultValue,
+	}, nil
+}
+
+func (replicaSet *ReplicaSet) Copy() (builtin_argument.KurtosisValueType, error) {
+	copiedValueType, err := replicaSet.KurtosisValueTypeDefault.Copy()
+	if err != nil {
+		return nil, err
+	}
+	return &ReplicaSet{
+		KurtosisValueTypeDefault: copiedValueType,
+	}, nil
+}
+
+func (replicaSet *ReplicaSet) GetName() (string, *startosis_errors.InterpretationError) {
+	replicaSetName, _, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](
+		replicaSet.KurtosisValueTypeDefault, ReplicaSetNameAttr)
+	if interpretationErr != nil {
+		return "", interpretationErr
+	}
+	return replicaSetName.GoString(), nil
+}
+
+func (replicaSet *ReplicaSet) GetReplicas() ([]*Service, *startosis_errors.InterpretationError) {
+	replicasList, _, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.List](
+		replicaSet.KurtosisValueTypeDefault, ReplicasAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	replicas := []*Service{}
+	for idx := 0; idx < replicasList.Len(); idx++ {
+		replica, ok := replicasList.Index(idx).(*Service)
+		if !ok {
+			return nil, startosis_errors.NewInterpretationError("Expected the replicas of a '%s' to be '%s' objects, but got '%s'", ReplicaSetTypeName, ServiceTypeName, reflect.TypeOf(replicasList.Index(idx)))
+		}
+		replicas = append(replicas, replica)
+	}
+	return replicas, nil
+}
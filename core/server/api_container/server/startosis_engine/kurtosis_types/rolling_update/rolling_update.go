@@ -0,0 +1,113 @@
+package rolling_update
+
+import (
+	"math"
+
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_type_constructor"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"go.starlark.net/starlark"
+)
+
+const (
+	RollingUpdateTypeName = "RollingUpdate"
+
+	MaxUnavailableAttr = "max_unavailable"
+	OnFailureAttr      = "on_failure"
+
+	// OnFailureRollback puts every service updated so far, including the ones of the failed batch, back on the config
+	// they had before the update
+	OnFailureRollback = "rollback"
+	// OnFailureAbort stops the update at the failed batch, leaving the services of the previous batches updated
+	OnFailureAbort = "abort"
+
+	defaultMaxUnavailable = 1
+	defaultOnFailure      = OnFailureRollback
+
+	minMaxUnavailable = 1
+)
+
+func NewRollingUpdateType() *kurtosis_type_constructor.KurtosisTypeConstructor {
+	return &kurtosis_type_constructor.KurtosisTypeConstructor{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: RollingUpdateTypeName,
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              MaxUnavailableAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Int],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.Int64InRange(value, MaxUnavailableAttr, minMaxUnavailable, math.MaxInt32)
+					},
+				},
+				{
+					Name:              OnFailureAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.StringValues(value, OnFailureAttr, []string{OnFailureRollback, OnFailureAbort})
+					},
+				},
+			},
+			Deprecation: nil,
+		},
+		Instantiate: instantiate,
+	}
+}
+
+func instantiate(arguments *builtin_argument.ArgumentValuesSet) (builtin_argument.KurtosisValueType, *startosis_errors.InterpretationError) {
+	kurtosisValueType, interpretationErr := kurtosis_type_constructor.CreateKurtosisStarlarkTypeDefault(RollingUpdateTypeName, arguments)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	return &RollingUpdate{
+		KurtosisValueTypeDefault: kurtosisValueType,
+	}, nil
+}
+
+// RollingUpdate is the strategy updating services a batch at a time, each batch being updated only once the services
+// of the previous one are ready
+type RollingUpdate struct {
+	*kurtosis_type_constructor.KurtosisValueTypeDefault
+}
+
+func (rollingUpdate *RollingUpdate) Copy() (builtin_argument.KurtosisValueType, error) {
+	copiedValueType, err := rollingUpdate.KurtosisValueTypeDefault.Copy()
+	if err != nil {
+		return nil, err
+	}
+	return &RollingUpdate{
+		KurtosisValueTypeDefault: copiedValueType,
+	}, nil
+}
+
+// GetMaxUnavailable returns how many services get updated at the same time
+func (rollingUpdate *RollingUpdate) GetMaxUnavailable() (int, *startosis_errors.InterpretationError) {
+	maxUnavailable, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.Int](
+		rollingUpdate.KurtosisValueTypeDefault, MaxUnavailableAttr)
+	if interpretationErr != nil {
+		return 0, interpretationErr
+	}
+	if !found {
+		return defaultMaxUnavailable, nil
+	}
+	maxUnavailableInt64, ok := maxUnavailable.Int64()
+	if !ok {
+		return 0, startosis_errors.NewInterpretationError("Couldn't parse '%v' attribute '%v' as an integer", MaxUnavailableAttr, maxUnavailable)
+	}
+	return int(maxUnavailableInt64), nil
+}
+
+// GetOnFailure returns either OnFailureRollback or OnFailureAbort
+func (rollingUpdate *RollingUpdate) GetOnFailure() (string, *startosis_errors.InterpretationError) {
+	onFailure, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](
+		rollingUpdate.KurtosisValueTypeDefault, OnFailureAttr)
+	if interpretationErr != nil {
+		return "", interpretationErr
+	}
+	if !found {
+		return defaultOnFailure, nil
+	}
+	return onFailure.GoString(), nil
+}
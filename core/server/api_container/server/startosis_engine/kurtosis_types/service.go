@@ -16,6 +16,7 @@ const (
 	IpAddressAttr   = "ip_address"
 	PortsAttr       = "ports"
 	ServiceNameAttr = "name"
+	ReplicaSetAttr  = "replica_set"
 )
 
 func NewServiceType() *kurtosis_type_constructor.KurtosisTypeConstructor {
@@ -56,6 +57,14 @@ func NewServiceType() *kurtosis_type_constructor.KurtosisTypeConstructor {
 						return nil
 					},
 				},
+				{
+					Name:              ReplicaSetAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, ReplicaSetAttr)
+					},
+				},
 			},
 		},
 
@@ -78,11 +87,21 @@ type Service struct {
 }
 
 func CreateService(serviceName starlark.String, hostname starlark.String, ipAddress starlark.String, ports *starlark.Dict) (*Service, *startosis_errors.InterpretationError) {
+	return createService(serviceName, hostname, ipAddress, ports, nil)
+}
+
+// CreateReplica creates the service object of a replica of the given replica set
+func CreateReplica(serviceName starlark.String, hostname starlark.String, ipAddress starlark.String, ports *starlark.Dict, replicaSetName starlark.String) (*Service, *startosis_errors.InterpretationError) {
+	return createService(serviceName, hostname, ipAddress, ports, replicaSetName)
+}
+
+func createService(serviceName starlark.String, hostname starlark.String, ipAddress starlark.String, ports *starlark.Dict, maybeReplicaSetName starlark.Value) (*Service, *startosis_errors.InterpretationError) {
 	args := []starlark.Value{
 		serviceName,
 		hostname,
 		ipAddress,
 		ports,
+		maybeReplicaSetName,
 	}
 
 	argumentDefinitions := NewServiceType().Arguments
@@ -141,3 +160,13 @@ func (serviceObj *Service) GetPorts() (*starlark.Dict, *startosis_errors.Interpr
 	}
 	return ports, nil
 }
+
+// GetReplicaSetName returns the name of the replica set the service is a replica of, or false if it isn't a replica
+func (serviceObj *Service) GetReplicaSetName() (string, bool, *startosis_errors.InterpretationError) {
+	replicaSetName, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](
+		serviceObj.KurtosisValueTypeDefault, ReplicaSetAttr)
+	if interpretationErr != nil {
+		return "", false, interpretationErr
+	}
+	return replicaSetName.GoString(), found, nil
+}
@@ -0,0 +1,189 @@
+package service_config
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_type_constructor"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"go.starlark.net/starlark"
+)
+
+const (
+	ContainerSpecTypeName = "ContainerSpec"
+
+	ContainerSpecNameAttr       = "name"
+	ContainerSpecImageAttr      = "image"
+	ContainerSpecCmdAttr        = "cmd"
+	ContainerSpecEntrypointAttr = "entrypoint"
+	ContainerSpecEnvVarsAttr    = "env_vars"
+
+	// The name ends up being a container name in a Kubernetes pod, so it has to be a valid DNS label
+	containerSpecNameRegexp = "^[a-z0-9]([-a-z0-9]{0,61}[a-z0-9])?$"
+)
+
+// The names of the containers Kurtosis already puts in the pod of a service on Kubernetes
+var reservedContainerSpecNames = map[string]bool{
+	"user-service-container":  true,
+	"files-artifact-expander": true,
+}
+
+func NewContainerSpecType() *kurtosis_type_constructor.KurtosisTypeConstructor {
+	return &kurtosis_type_constructor.KurtosisTypeConstructor{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: ContainerSpecTypeName,
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              ContainerSpecNameAttr,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.StringRegexp(value, ContainerSpecNameAttr, containerSpecNameRegexp)
+					},
+				},
+				{
+					Name:              ContainerSpecImageAttr,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, ContainerSpecImageAttr)
+					},
+				},
+				{
+					Name:              ContainerSpecCmdAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.List],
+					Validator:         nil,
+				},
+				{
+					Name:              ContainerSpecEntrypointAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.List],
+					Validator:         nil,
+				},
+				{
+					Name:              ContainerSpecEnvVarsAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.Dict],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.StringMappingToString(value, ContainerSpecEnvVarsAttr)
+					},
+				},
+			},
+			Deprecation: nil,
+		},
+		Instantiate: instantiateContainerSpec,
+	}
+}
+
+func instantiateContainerSpec(arguments *builtin_argument.ArgumentValuesSet) (builtin_argument.KurtosisValueType, *startosis_errors.InterpretationError) {
+	kurtosisValueType, interpretationErr := kurtosis_type_constructor.CreateKurtosisStarlarkTypeDefault(ContainerSpecTypeName, arguments)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	return &ContainerSpec{
+		kurtosisValueType,
+	}, nil
+}
+
+// ContainerSpec describes an init container or a sidecar of a service
+type ContainerSpec struct {
+	*kurtosis_type_constructor.KurtosisValueTypeDefault
+}
+
+func (containerSpec *ContainerSpec) Copy() (builtin_argument.KurtosisValueType, error) {
+	copiedValueType, err := containerSpec.KurtosisValueTypeDefault.Copy()
+	if err != nil {
+		return nil, err
+	}
+	return &ContainerSpec{
+		KurtosisValueTypeDefault: copiedValueType,
+	}, nil
+}
+
+func (containerSpec *ContainerSpec) ToKurtosisType() (*service.AdditionalContainer, *startosis_errors.InterpretationError) {
+	name, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](containerSpec.KurtosisValueTypeDefault, ContainerSpecNameAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if !found {
+		return nil, startosis_errors.NewInterpretationError("Required attribute '%s' could not be found on type '%s'", ContainerSpecNameAttr, ContainerSpecTypeName)
+	}
+
+	image, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](containerSpec.KurtosisValueTypeDefault, ContainerSpecImageAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if !found {
+		return nil, startosis_errors.NewInterpretationError("Required attribute '%s' could not be found on type '%s'", ContainerSpecImageAttr, ContainerSpecTypeName)
+	}
+
+	var cmdArgs []string
+	cmdStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.List](containerSpec.KurtosisValueTypeDefault, ContainerSpecCmdAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found && cmdStarlark.Len() > 0 {
+		cmdArgs, interpretationErr = kurtosis_types.SafeCastToStringSlice(cmdStarlark, ContainerSpecCmdAttr)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+	}
+
+	var entrypointArgs []string
+	entrypointStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.List](containerSpec.KurtosisValueTypeDefault, ContainerSpecEntrypointAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found && entrypointStarlark.Len() > 0 {
+		entrypointArgs, interpretationErr = kurtosis_types.SafeCastToStringSlice(entrypointStarlark, ContainerSpecEntrypointAttr)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+	}
+
+	envVars := map[string]string{}
+	envVarsStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.Dict](containerSpec.KurtosisValueTypeDefault, ContainerSpecEnvVarsAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found && envVarsStarlark.Len() > 0 {
+		envVars, interpretationErr = kurtosis_types.SafeCastToMapStringString(envVarsStarlark, ContainerSpecEnvVarsAttr)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+	}
+
+	return service.NewAdditionalContainer(name.GoString(), image.GoString(), entrypointArgs, cmdArgs, envVars), nil
+}
+
+// ConvertContainerSpecs converts the list of ContainerSpec passed via the given attribute, making sure each name is
+// used once across all the additional containers of the service
+func ConvertContainerSpecs(
+	containerSpecsList *starlark.List,
+	attrName string,
+	usedNames map[string]bool,
+) ([]*service.AdditionalContainer, *startosis_errors.InterpretationError) {
+	var additionalContainers []*service.AdditionalContainer
+	for index := 0; index < containerSpecsList.Len(); index++ {
+		containerSpec, ok := containerSpecsList.Index(index).(*ContainerSpec)
+		if !ok {
+			return nil, startosis_errors.NewInterpretationError("Expected item at index '%v' of the list passed via '%v' attr to be a '%v' but it wasn't", index, attrName, ContainerSpecTypeName)
+		}
+		additionalContainer, interpretationErr := containerSpec.ToKurtosisType()
+		if interpretationErr != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(interpretationErr, "Error occurred while converting object at '%v' of '%v' list to internal type", index, attrName)
+		}
+		name := additionalContainer.GetName()
+		if reservedContainerSpecNames[name] {
+			return nil, startosis_errors.NewInterpretationError("The name '%v' of the '%v' at index '%v' of '%v' is reserved by Kurtosis", name, ContainerSpecTypeName, index, attrName)
+		}
+		if usedNames[name] {
+			return nil, startosis_errors.NewInterpretationError("The name '%v' of the '%v' at index '%v' of '%v' is already used by another init container or sidecar of the service", name, ContainerSpecTypeName, index, attrName)
+		}
+		usedNames[name] = true
+		additionalContainers = append(additionalContainers, additionalContainer)
+	}
+	return additionalContainers, nil
+}
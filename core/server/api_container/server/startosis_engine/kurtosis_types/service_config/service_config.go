@@ -61,6 +61,9 @@ const (
 	PrivilegedAttr                  = "privileged"
 	BindMountsAttr                  = "bind_mounts"
 	HostPIDNamespaceAttr            = "host_pid_namespace"
+	ReplicasAttr                    = "replicas"
+	InitContainersAttr              = "init_containers"
+	SidecarsAttr                    = "sidecars"
 
 	DefaultPrivateIPAddrPlaceholder = "KURTOSIS_IP_ADDR_PLACEHOLDER"
 
@@ -69,6 +72,8 @@ const (
 	filesArtifactsExpanderImage string = "kurtosistech/files-artifacts-expander"
 
 	minimumMemoryAllocationMegabytes = 6
+
+	defaultReplicas = 1
 )
 
 // allowedBindMountHostPaths restricts what host paths bind_mounts may target. This is intentionally
@@ -294,6 +299,26 @@ func NewServiceConfigType() *kurtosis_type_constructor.KurtosisTypeConstructor {
 						return ValidateBindMounts(value)
 					},
 				},
+				{
+					Name:              ReplicasAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Int],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.Int64InRange(value, ReplicasAttr, defaultReplicas, math.MaxInt32)
+					},
+				},
+				{
+					Name:              InitContainersAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.List],
+					Validator:         nil,
+				},
+				{
+					Name:              SidecarsAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.List],
+					Validator:         nil,
+				},
 			},
 		},
 
@@ -418,13 +443,14 @@ func (config *ServiceConfig) ToKurtosisType(
 
 	var filesArtifactExpansions *service_directory.FilesArtifactsExpansion
 	var persistentDirectories *service_directory.PersistentDirectories
+	var sharedDirectories *service_directory.SharedDirectories
 	filesStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.Dict](config.KurtosisValueTypeDefault, FilesAttr)
 	if interpretationErr != nil {
 		return nil, interpretationErr
 	}
 	if found {
 		var filesArtifactsMountDirpathsMap map[string][]string
-		filesArtifactsMountDirpathsMap, persistentDirectoriesDirpathsMap, interpretationErr := convertFilesArguments(FilesAttr, filesStarlark)
+		filesArtifactsMountDirpathsMap, persistentDirectoriesDirpathsMap, sharedDirectoriesDirpathsMap, interpretationErr := convertFilesArguments(FilesAttr, filesStarlark)
 		if interpretationErr != nil {
 			return nil, interpretationErr
 		}
@@ -433,6 +459,9 @@ func (config *ServiceConfig) ToKurtosisType(
 			return nil, interpretationErr
 		}
 		persistentDirectories = convertPersistentDirectoryMounts(persistentDirectoriesDirpathsMap)
+		if len(sharedDirectoriesDirpathsMap) > 0 {
+			sharedDirectories = service_directory.NewSharedDirectories(sharedDirectoriesDirpathsMap)
+		}
 	}
 
 	var entryPointArgs []string
@@ -733,6 +762,32 @@ func (config *ServiceConfig) ToKurtosisType(
 		}
 	}
 
+	// names are shared between init containers and sidecars as they all end up in the same pod on Kubernetes
+	additionalContainerNames := map[string]bool{}
+	var initContainers []*service.AdditionalContainer
+	initContainersStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.List](config.KurtosisValueTypeDefault, InitContainersAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found && initContainersStarlark.Len() > 0 {
+		initContainers, interpretationErr = ConvertContainerSpecs(initContainersStarlark, InitContainersAttr, additionalContainerNames)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+	}
+
+	var sidecars []*service.AdditionalContainer
+	sidecarsStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.List](config.KurtosisValueTypeDefault, SidecarsAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found && sidecarsStarlark.Len() > 0 {
+		sidecars, interpretationErr = ConvertContainerSpecs(sidecarsStarlark, SidecarsAttr, additionalContainerNames)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+	}
+
 	serviceConfig, err := service.CreateServiceConfig(
 		imageName,
 		maybeImageBuildSpec,
@@ -777,9 +832,34 @@ func (config *ServiceConfig) ToKurtosisType(
 	if len(bindMounts) > 0 {
 		serviceConfig.SetBindMounts(bindMounts)
 	}
+	if sharedDirectories != nil {
+		serviceConfig.SetSharedDirectories(sharedDirectories)
+	}
+	if len(initContainers) > 0 {
+		serviceConfig.SetInitContainers(initContainers)
+	}
+	if len(sidecars) > 0 {
+		serviceConfig.SetSidecars(sidecars)
+	}
 	return serviceConfig, nil
 }
 
+// GetReplicas returns the number of replicas of the service, 1 when the attribute isn't set
+func (config *ServiceConfig) GetReplicas() (int, *startosis_errors.InterpretationError) {
+	replicasStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.Int](config.KurtosisValueTypeDefault, ReplicasAttr)
+	if interpretationErr != nil {
+		return 0, interpretationErr
+	}
+	if !found {
+		return defaultReplicas, nil
+	}
+	replicas, ok := replicasStarlark.Int64()
+	if !ok {
+		return 0, startosis_errors.NewInterpretationError("Couldn't convert '%s' attribute '%v' to an integer", ReplicasAttr, replicasStarlark)
+	}
+	return int(replicas), nil
+}
+
 func (config *ServiceConfig) GetReadyCondition() (*ReadyCondition, *startosis_errors.InterpretationError) {
 	readyConditions, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*ReadyCondition](config.KurtosisValueTypeDefault, ReadyConditionsAttr)
 	if interpretationErr != nil {
@@ -817,10 +897,16 @@ func ConvertFilesArtifactsMounts(filesArtifactsMountDirpathsMap map[string][]str
 	//  passing the APIC info DOWN to the backend and have the backend create the expander itself.
 	//  Here writing those info into each service config is dumb
 	apiContainerInfo := serviceNetwork.GetApiContainerInfo()
+	expanderTlsConfig, err := apiContainerInfo.GetExpanderTlsConfig()
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "An error occurred getting the TLS config of the files artifacts expander")
+	}
 	filesArtifactsExpanderArgs, err := args.NewFilesArtifactsExpanderArgs(
 		apiContainerInfo.GetIpAddress().String(),
 		apiContainerInfo.GetGrpcPortNum(),
 		filesArtifactsExpansions,
+		expanderTlsConfig,
+		apiContainerInfo.GetExpanderApiToken(),
 	)
 	if err != nil {
 		return nil, startosis_errors.NewInterpretationError("An error occurred creating files artifacts expander args")
@@ -865,59 +951,100 @@ func convertPortMapEntry(attrNameForLogging string, key starlark.Value, value st
 	return keyStr.GoString(), servicePortSpec, nil
 }
 
-func convertFilesArguments(attrNameForLogging string, filesDict *starlark.Dict) (map[string][]string, map[string]service_directory.PersistentDirectory, *startosis_errors.InterpretationError) {
+func convertFilesArguments(attrNameForLogging string, filesDict *starlark.Dict) (map[string][]string, map[string]service_directory.PersistentDirectory, map[string]service_directory.SharedDirectory, *startosis_errors.InterpretationError) {
 	filesArtifacts := map[string][]string{}
 	persistentDirectories := map[string]service_directory.PersistentDirectory{}
+	sharedDirectories := map[string]service_directory.SharedDirectory{}
+	sharedDirectoryNames := map[string]bool{}
 	for _, fileItem := range filesDict.Items() {
 		rawDirPath := fileItem[0]
 		dirPath, ok := rawDirPath.(starlark.String)
 		if !ok {
-			return nil, nil, startosis_errors.NewInterpretationError("Unable to convert key of '%s' dictionary '%v' to string", attrNameForLogging, filesDict)
+			return nil, nil, nil, startosis_errors.NewInterpretationError("Unable to convert key of '%s' dictionary '%v' to string", attrNameForLogging, filesDict)
 		}
 
 		var interpretationErr *startosis_errors.InterpretationError
 		rawFileValue := fileItem[1]
+		if sharedDirectoryObj, isSharedDirectoryArg := rawFileValue.(*directory.SharedDirectory); isSharedDirectoryArg {
+			sharedDirectoryName, interpretationErr := sharedDirectoryObj.GetName()
+			if interpretationErr != nil {
+				return nil, nil, nil, interpretationErr
+			}
+			sharedDirectorySize, interpretationErr := sharedDirectoryObj.GetSizeOrDefault()
+			if interpretationErr != nil {
+				return nil, nil, nil, interpretationErr
+			}
+			// a service mounts the single volume backing a shared directory only once
+			if sharedDirectoryNames[sharedDirectoryName] {
+				return nil, nil, nil, startosis_errors.NewInterpretationError("'%s' '%s' is mounted more than once in '%s' dictionary '%v'",
+					directory.SharedDirectoryTypeName, sharedDirectoryName, attrNameForLogging, filesDict)
+			}
+			sharedDirectoryNames[sharedDirectoryName] = true
+			sharedDirectories[dirPath.GoString()] = service_directory.SharedDirectory{
+				Name: service_directory.SharedDirectoryName(sharedDirectoryName),
+				Size: service_directory.DirectoryPersistentSize(sharedDirectorySize),
+			}
+			continue
+		}
 		directoryObj, isDirectoryArg := rawFileValue.(*directory.Directory)
 		if !isDirectoryArg {
 
 			// we're also supporting raw strings as well and transform them into files artifact name.
 			fileArtifactNameStr, isSimpleStringArg := rawFileValue.(starlark.String)
 			if !isSimpleStringArg {
-				return nil, nil, startosis_errors.NewInterpretationError("Unable to convert value of '%s' dictionary '%v' to a Directory object", attrNameForLogging, filesDict)
+				return nil, nil, nil, startosis_errors.NewInterpretationError("Unable to convert value of '%s' dictionary '%v' to a Directory or SharedDirectory object", attrNameForLogging, filesDict)
 			}
 			directoryObj, interpretationErr = directory.CreateDirectoryFromFilesArtifact(fileArtifactNameStr.GoString())
 			if interpretationErr != nil {
-				return nil, nil, interpretationErr
+				return nil, nil, nil, interpretationErr
 			}
 		}
 		artifactNames, artifactNameSet, interpretationErr := directoryObj.GetArtifactNamesIfSet()
 		if interpretationErr != nil {
-			return nil, nil, interpretationErr
+			return nil, nil, nil, interpretationErr
 		}
 		persistentKey, persistentKeySet, interpretationErr := directoryObj.GetPersistentKeyIfSet()
 		if interpretationErr != nil {
-			return nil, nil, interpretationErr
+			return nil, nil, nil, interpretationErr
 		}
 		persistentDirectorySize, interpretationErr := directoryObj.GetSizeOrDefault()
 		if interpretationErr != nil {
-			return nil, nil, interpretationErr
+			return nil, nil, nil, interpretationErr
+		}
+		seedArtifactName, seedArtifactNameSet, interpretationErr := directoryObj.GetSeedArtifactNameIfSet()
+		if interpretationErr != nil {
+			return nil, nil, nil, interpretationErr
+		}
+		seedUrl, seedUrlSet, interpretationErr := directoryObj.GetSeedUrlIfSet()
+		if interpretationErr != nil {
+			return nil, nil, nil, interpretationErr
 		}
 		if artifactNameSet == persistentKeySet {
 			// this condition is a XOR
-			return nil, nil, startosis_errors.NewInterpretationError("Parameter '%s' and '%s' cannot be set on the same '%s' object: '%s'",
+			return nil, nil, nil, startosis_errors.NewInterpretationError("Parameter '%s' and '%s' cannot be set on the same '%s' object: '%s'",
 				directory.ArtifactNamesAttr, directory.PersistentKeyAttr, directory.DirectoryTypeName, directoryObj.String())
 		}
+		if seedArtifactNameSet && seedUrlSet {
+			return nil, nil, nil, startosis_errors.NewInterpretationError("Parameter '%s' and '%s' cannot be set on the same '%s' object: '%s'",
+				directory.SeedArtifactAttr, directory.SeedUrlAttr, directory.DirectoryTypeName, directoryObj.String())
+		}
 		if artifactNameSet {
+			if seedArtifactNameSet || seedUrlSet {
+				return nil, nil, nil, startosis_errors.NewInterpretationError("Parameters '%s' and '%s' can only be set on a '%s' object that has a '%s': '%s'",
+					directory.SeedArtifactAttr, directory.SeedUrlAttr, directory.DirectoryTypeName, directory.PersistentKeyAttr, directoryObj.String())
+			}
 			filesArtifacts[dirPath.GoString()] = artifactNames
 		} else {
 			// persistentKey is necessarily set since we checked the exclusivity above
 			persistentDirectories[dirPath.GoString()] = service_directory.PersistentDirectory{
-				PersistentKey: service_directory.DirectoryPersistentKey(persistentKey),
-				Size:          service_directory.DirectoryPersistentSize(persistentDirectorySize),
+				PersistentKey:               service_directory.DirectoryPersistentKey(persistentKey),
+				Size:                        service_directory.DirectoryPersistentSize(persistentDirectorySize),
+				SeedFilesArtifactIdentifier: seedArtifactName,
+				SeedUrl:                     seedUrl,
 			}
 		}
 	}
-	return filesArtifacts, persistentDirectories, nil
+	return filesArtifacts, persistentDirectories, sharedDirectories, nil
 }
 
 // If [image] is an ImageBuildSpec type, returns name for the image to build and ImageBuildSpec converted to KurtosisType
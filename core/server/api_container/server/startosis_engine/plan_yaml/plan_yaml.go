@@ -10,6 +10,9 @@ const (
 	shell  TaskType = "sh"
 	python TaskType = "python"
 	exec   TaskType = "exec"
+
+	ifControlFlow      ControlFlowType = "if"
+	forEachControlFlow ControlFlowType = "for_each"
 )
 
 // PlanYaml contains information about the effect of an InstructionsPlan or sequence of instructions on the state of the Enclave.
@@ -19,6 +22,7 @@ type PlanYaml struct {
 	Services       []*Service       `yaml:"services,omitempty"`
 	FilesArtifacts []*FilesArtifact `yaml:"filesArtifacts,omitempty"`
 	Tasks          []*Task          `yaml:"tasks,omitempty"`
+	ControlFlows   []*ControlFlow   `yaml:"controlFlows,omitempty"`
 
 	Images              []string `yaml:"images,omitempty"`
 	PackageDependencies []string `yaml:"packageDependencies,omitempty"`
@@ -36,6 +40,8 @@ type Service struct {
 	EnvVars    []*EnvironmentVariable `yaml:"envVars,omitempty"`
 	Ports      []*Port                `yaml:"ports,omitempty"`
 	Files      []*FileMount           `yaml:"files,omitempty"`
+
+	ConditionalOn []*ConditionalOn `yaml:"conditionalOn,omitempty"`
 }
 
 func (s *Service) MarshalYAML() (interface{}, error) {
@@ -61,6 +67,8 @@ type FilesArtifact struct {
 	Uuid  string   `yaml:"uuid,omitempty"`
 	Name  string   `yaml:"name,omitempty"`
 	Files []string `yaml:"files,omitempty"`
+
+	ConditionalOn []*ConditionalOn `yaml:"conditionalOn,omitempty"`
 }
 
 func (f *FilesArtifact) MarshalYAML() (interface{}, error) {
@@ -117,11 +125,38 @@ type Task struct {
 	// service name
 	ServiceName     string  `yaml:"serviceName,omitempty"`
 	AcceptableCodes []int64 `yaml:"acceptableCodes,omitempty"`
+
+	ConditionalOn []*ConditionalOn `yaml:"conditionalOn,omitempty"`
 }
 
 // TaskType represents the type of task (either python or shell)
 type TaskType string
 
+// ControlFlow represents an if_ or for_each instruction, deciding at execution time whether and how many times the
+// services, tasks and files artifacts conditional on it get created
+type ControlFlow struct {
+	Uuid string          `yaml:"uuid,omitempty"`
+	Type ControlFlowType `yaml:"type,omitempty"`
+
+	// only exists on if control flows
+	Condition string `yaml:"condition,omitempty"`
+
+	// only exists on for_each control flows
+	Items string `yaml:"items,omitempty"`
+
+	// set on control flows nested in the branch of another one
+	ConditionalOn []*ConditionalOn `yaml:"conditionalOn,omitempty"`
+}
+
+// ControlFlowType represents the type of control flow (either if or for_each)
+type ControlFlowType string
+
+// ConditionalOn references the branch of a control flow something was planned in
+type ConditionalOn struct {
+	ControlFlowUuid string `yaml:"controlFlowUuid,omitempty"`
+	Branch          string `yaml:"branch,omitempty"`
+}
+
 type Package struct {
 	PackageId       string
 	ContainerImages []string
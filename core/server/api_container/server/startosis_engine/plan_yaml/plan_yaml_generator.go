@@ -22,8 +22,16 @@ const (
 	codeFutureRefType      = "code"
 	hostnameFutureRefType  = "hostname"
 	outputFutureRefType    = "output"
+	itemFutureRefType      = "item"
 )
 
+// ControlFlowBranch identifies, by scheduled instruction UUID, the branch of a control flow instruction an instruction
+// was planned in
+type ControlFlowBranch struct {
+	ControlFlowInstructionUuid string
+	Branch                     string
+}
+
 // PlanYamlGenerator generates a PlanYaml representing the effect of an Instructions Plan or sequence of instructions on the state of the Enclave.
 type PlanYamlGenerator struct {
 	privatePlanYaml *PlanYaml
@@ -33,6 +41,12 @@ type PlanYamlGenerator struct {
 	latestUuid           int
 	imageSet             map[string]bool
 	packageDependencySet map[string]bool
+
+	// the plan yaml UUIDs of the control flows, by scheduled instruction UUID
+	controlFlowUuids map[string]string
+	// the instruction currently updating the plan, and the control flow branches it was planned in
+	currentInstructionUuid     string
+	currentControlFlowBranches []ControlFlowBranch
 }
 
 func CreateEmptyPlan(packageId string) *PlanYamlGenerator {
@@ -41,6 +55,7 @@ func CreateEmptyPlan(packageId string) *PlanYamlGenerator {
 			PackageId:           packageId,
 			Services:            []*Service{},
 			Tasks:               []*Task{},
+			ControlFlows:        []*ControlFlow{},
 			FilesArtifacts:      []*FilesArtifact{},
 			Images:              []string{},
 			PackageDependencies: []string{},
@@ -51,9 +66,47 @@ func CreateEmptyPlan(packageId string) *PlanYamlGenerator {
 		futureReferenceIndex: map[string]string{},
 		filesArtifactIndex:   map[string]*FilesArtifact{},
 		latestUuid:           0,
+
+		controlFlowUuids:           map[string]string{},
+		currentInstructionUuid:     "",
+		currentControlFlowBranches: nil,
 	}
 }
 
+// SetCurrentInstruction sets the instruction about to update the plan. What it adds to the plan is made conditional on
+// the given control flow branches
+func (planYaml *PlanYamlGenerator) SetCurrentInstruction(instructionUuid string, controlFlowBranches []ControlFlowBranch) {
+	planYaml.currentInstructionUuid = instructionUuid
+	planYaml.currentControlFlowBranches = controlFlowBranches
+}
+
+func (planYaml *PlanYamlGenerator) AddIf(condition starlark.Value, assertion string, targetValue starlark.Value) {
+	conditionStr := planYaml.swapFutureReference(starlarkValueToYamlString(condition))
+	if assertion != "" {
+		conditionStr = fmt.Sprintf("%s %s %s", conditionStr, assertion, planYaml.swapFutureReference(starlarkValueToYamlString(targetValue)))
+	}
+	planYaml.addControlFlowYaml(&ControlFlow{
+		Uuid:          planYaml.generateUuid(),
+		Type:          ifControlFlow,
+		Condition:     conditionStr,
+		Items:         "",
+		ConditionalOn: nil,
+	})
+}
+
+// AddForEach adds a for_each control flow, the item passed to its body being referenced as a future reference
+func (planYaml *PlanYamlGenerator) AddForEach(items starlark.Value, itemFutureReference string) {
+	uuid := planYaml.generateUuid()
+	planYaml.addControlFlowYaml(&ControlFlow{
+		Uuid:          uuid,
+		Type:          forEachControlFlow,
+		Condition:     "",
+		Items:         planYaml.swapFutureReference(starlarkValueToYamlString(items)),
+		ConditionalOn: nil,
+	})
+	planYaml.storeFutureReference(uuid, itemFutureReference, itemFutureRefType)
+}
+
 func (planYaml *PlanYamlGenerator) GenerateYaml() (string, error) {
 	planYaml.privatePlanYaml.Images = convertStrMapSetToSortedStrList(planYaml.imageSet)
 	planYaml.privatePlanYaml.PackageDependencies = convertStrMapSetToSortedStrList(planYaml.packageDependencySet)
@@ -348,7 +401,7 @@ func (planYaml *PlanYamlGenerator) AddExec(
 	taskYaml.RunCmd = cmdListWithFutureRefsSwapped
 	taskYaml.AcceptableCodes = acceptableCodes
 
-	planYaml.privatePlanYaml.Tasks = append(planYaml.privatePlanYaml.Tasks, taskYaml)
+	planYaml.addTaskYaml(taskYaml)
 	return nil
 }
 
@@ -441,7 +494,7 @@ func (planYaml *PlanYamlGenerator) getFileMountsFromFilesArtifacts(filesArtifact
 					Uuid:  planYaml.generateUuid(),
 					Files: []string{}, // don't know at interpretation what files are on the artifact when passed in via args
 				}
-				planYaml.addFilesArtifactYaml(filesArtifact)
+				planYaml.addArgFilesArtifactYaml(filesArtifact)
 			}
 			filesArtifacts = append(filesArtifacts, filesArtifact)
 		}
@@ -454,18 +507,49 @@ func (planYaml *PlanYamlGenerator) getFileMountsFromFilesArtifacts(filesArtifact
 }
 
 func (planYaml *PlanYamlGenerator) addServiceYaml(service *Service) {
+	service.ConditionalOn = planYaml.getCurrentConditionalOn()
 	planYaml.privatePlanYaml.Services = append(planYaml.privatePlanYaml.Services, service)
 }
 
 func (planYaml *PlanYamlGenerator) addFilesArtifactYaml(filesArtifact *FilesArtifact) {
+	filesArtifact.ConditionalOn = planYaml.getCurrentConditionalOn()
+	planYaml.addArgFilesArtifactYaml(filesArtifact)
+}
+
+// addArgFilesArtifactYaml adds a files artifact passed in via the args of the run function, which exists whatever the
+// control flow
+func (planYaml *PlanYamlGenerator) addArgFilesArtifactYaml(filesArtifact *FilesArtifact) {
 	planYaml.filesArtifactIndex[filesArtifact.Name] = filesArtifact
 	planYaml.privatePlanYaml.FilesArtifacts = append(planYaml.privatePlanYaml.FilesArtifacts, filesArtifact)
 }
 
 func (planYaml *PlanYamlGenerator) addTaskYaml(task *Task) {
+	task.ConditionalOn = planYaml.getCurrentConditionalOn()
 	planYaml.privatePlanYaml.Tasks = append(planYaml.privatePlanYaml.Tasks, task)
 }
 
+func (planYaml *PlanYamlGenerator) addControlFlowYaml(controlFlow *ControlFlow) {
+	controlFlow.ConditionalOn = planYaml.getCurrentConditionalOn()
+	planYaml.controlFlowUuids[planYaml.currentInstructionUuid] = controlFlow.Uuid
+	planYaml.privatePlanYaml.ControlFlows = append(planYaml.privatePlanYaml.ControlFlows, controlFlow)
+}
+
+func (planYaml *PlanYamlGenerator) getCurrentConditionalOn() []*ConditionalOn {
+	var conditionalOn []*ConditionalOn
+	for _, controlFlowBranch := range planYaml.currentControlFlowBranches {
+		controlFlowUuid, found := planYaml.controlFlowUuids[controlFlowBranch.ControlFlowInstructionUuid]
+		if !found {
+			// the control flow instruction updates the plan before the instructions planned in its branches
+			continue
+		}
+		conditionalOn = append(conditionalOn, &ConditionalOn{
+			ControlFlowUuid: controlFlowUuid,
+			Branch:          controlFlowBranch.Branch,
+		})
+	}
+	return conditionalOn
+}
+
 func (planYaml *PlanYamlGenerator) addImage(img string) {
 	planYaml.imageSet[img] = true
 }
@@ -497,3 +581,11 @@ func convertStrMapSetToSortedStrList(mapSet map[string]bool) []string {
 	slices.Sort(l)
 	return l
 }
+
+// starlarkValueToYamlString returns strings unquoted so that the future references they may contain get swapped
+func starlarkValueToYamlString(value starlark.Value) string {
+	if valueStr, ok := value.(starlark.String); ok {
+		return valueStr.GoString()
+	}
+	return value.String()
+}
@@ -6,6 +6,7 @@ import (
 	"reflect"
 	"strings"
 
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers/magic_string_helper"
@@ -89,6 +90,28 @@ func (recipe *ExecRecipe) Execute(
 	serviceNetwork service_network.ServiceNetwork,
 	runtimeValueStore *runtime_value_store.RuntimeValueStore,
 	service *service.Service,
+) (map[string]starlark.Comparable, error) {
+	return recipe.execute(ctx, serviceNetwork, runtimeValueStore, service, nil)
+}
+
+// ExecuteWithStreamedOutput executes the recipe like Execute, passing each line of the output of the command to
+// outputLineConsumer as soon as it is produced
+func (recipe *ExecRecipe) ExecuteWithStreamedOutput(
+	ctx context.Context,
+	serviceNetwork service_network.ServiceNetwork,
+	runtimeValueStore *runtime_value_store.RuntimeValueStore,
+	service *service.Service,
+	outputLineConsumer func(outputLine string),
+) (map[string]starlark.Comparable, error) {
+	return recipe.execute(ctx, serviceNetwork, runtimeValueStore, service, outputLineConsumer)
+}
+
+func (recipe *ExecRecipe) execute(
+	ctx context.Context,
+	serviceNetwork service_network.ServiceNetwork,
+	runtimeValueStore *runtime_value_store.RuntimeValueStore,
+	service *service.Service,
+	maybeOutputLineConsumer func(outputLine string),
 ) (map[string]starlark.Comparable, error) {
 	// parse argument
 	commandStarlarkList, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.List](
@@ -127,7 +150,13 @@ func (recipe *ExecRecipe) Execute(
 
 	serviceNameStr := string(service.GetRegistration().GetName())
 
-	execResult, err := serviceNetwork.RunExec(ctx, serviceNameStr, commandWithRuntimeValue)
+	var execResult *exec_result.ExecResult
+	var err error
+	if maybeOutputLineConsumer != nil {
+		execResult, err = serviceNetwork.RunExecWithStreamedOutput(ctx, serviceNameStr, commandWithRuntimeValue, maybeOutputLineConsumer)
+	} else {
+		execResult, err = serviceNetwork.RunExec(ctx, serviceNameStr, commandWithRuntimeValue)
+	}
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Failed to execute command '%v' on service '%s'", command, serviceNameStr)
 	}
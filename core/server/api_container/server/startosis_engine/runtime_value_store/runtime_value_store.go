@@ -5,6 +5,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/secret_store"
 	"github.com/kurtosis-tech/stacktrace"
 	"go.starlark.net/starlark"
 )
@@ -13,9 +14,13 @@ type RuntimeValueStore struct {
 	starlarkValueSerde                *kurtosis_types.StarlarkValueSerde
 	recipeResultRepository            *recipeResultRepository
 	serviceAssociatedValuesRepository *serviceAssociatedValuesRepository
+
+	// secrets are resolved at execution time like the other runtime values, but are never persisted in clear text
+	// can be nil, in which case referencing a secret fails at execution time
+	secretStore *secret_store.SecretStore
 }
 
-func CreateRuntimeValueStore(starlarkValueSerde *kurtosis_types.StarlarkValueSerde, enclaveDb *enclave_db.EnclaveDB) (*RuntimeValueStore, error) {
+func CreateRuntimeValueStore(starlarkValueSerde *kurtosis_types.StarlarkValueSerde, enclaveDb *enclave_db.EnclaveDB, secretStore *secret_store.SecretStore) (*RuntimeValueStore, error) {
 	associatedValuesRepository, err := getOrCreateNewServiceAssociatedValuesRepository(enclaveDb)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred getting or creating the service associated values repository")
@@ -30,6 +35,7 @@ func CreateRuntimeValueStore(starlarkValueSerde *kurtosis_types.StarlarkValueSer
 		starlarkValueSerde:                starlarkValueSerde,
 		recipeResultRepository:            recipeResultRepositoryObj,
 		serviceAssociatedValuesRepository: associatedValuesRepository,
+		secretStore:                       secretStore,
 	}
 
 	return runtimeValueStore, nil
@@ -93,3 +99,82 @@ func (re *RuntimeValueStore) GetValue(uuid string) (map[string]starlark.Comparab
 
 	return value, nil
 }
+
+func (re *RuntimeValueStore) GetSecret(name string) (string, error) {
+	if re.secretStore == nil {
+		return "", stacktrace.NewError("Secret '%s' was referenced but no secret store is configured for this enclave", name)
+	}
+	value, err := re.secretStore.Get(name)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting secret '%s'", name)
+	}
+	return value, nil
+}
+
+// ExportValue returns the fields of the runtime value serialized as Starlark strings, so the value can be imported in
+// another enclave with ImportValue. The returned map is empty if the value was created but never set
+func (re *RuntimeValueStore) ExportValue(uuid string) (map[string]string, error) {
+	value, err := re.recipeResultRepository.Get(uuid)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting recipe result value with UUID key '%s'", uuid)
+	}
+	serializedValue := map[string]string{}
+	for field, starlarkComparable := range value {
+		serializedValue[field] = re.starlarkValueSerde.Serialize(starlarkComparable)
+	}
+	return serializedValue, nil
+}
+
+// ImportValue stores a value previously returned by ExportValue under the same UUID
+func (re *RuntimeValueStore) ImportValue(uuid string, serializedValue map[string]string) error {
+	if len(serializedValue) == 0 {
+		if err := re.recipeResultRepository.SaveKey(uuid); err != nil {
+			return stacktrace.Propagate(err, "An error occurred saving key UUID '%s' on the recipe result repository", uuid)
+		}
+		return nil
+	}
+	value := map[string]starlark.Comparable{}
+	for field, serializedFieldValue := range serializedValue {
+		starlarkValue, err := re.starlarkValueSerde.Deserialize(serializedFieldValue)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred deserializing field '%s' of runtime value '%s'", field, uuid)
+		}
+		starlarkComparable, ok := starlarkValue.(starlark.Comparable)
+		if !ok {
+			return stacktrace.NewError("Failed to cast Starlark value '%s' to Starlark comparable type", starlarkValue)
+		}
+		value[field] = starlarkComparable
+	}
+	if err := re.SetValue(uuid, value); err != nil {
+		return stacktrace.Propagate(err, "An error occurred importing runtime value '%s'", uuid)
+	}
+	return nil
+}
+
+// GetValueAssociatedWithService returns the UUID of the runtime value associated with the service, if any
+func (re *RuntimeValueStore) GetValueAssociatedWithService(serviceName service.ServiceName) (string, bool, error) {
+	exist, err := re.serviceAssociatedValuesRepository.Exist(serviceName)
+	if err != nil {
+		return "", false, stacktrace.Propagate(err, "An error occurred checking if there are associated values for service '%s' in the service associated values repository", serviceName)
+	}
+	if !exist {
+		return "", false, nil
+	}
+	uuid, err := re.serviceAssociatedValuesRepository.Get(serviceName)
+	if err != nil {
+		return "", false, stacktrace.Propagate(err, "An error occurred getting associated values for service '%s'", serviceName)
+	}
+	return uuid, uuid != "", nil
+}
+
+// AssociateValueWithService makes GetOrCreateValueAssociatedWithService return the given UUID for the service, so that
+// magic strings referencing the service's runtime value keep resolving after the service is re-created elsewhere
+func (re *RuntimeValueStore) AssociateValueWithService(serviceName service.ServiceName, uuid string) error {
+	if err := re.recipeResultRepository.SaveKey(uuid); err != nil {
+		return stacktrace.Propagate(err, "An error occurred saving key UUID '%s' on the recipe result repository", uuid)
+	}
+	if err := re.serviceAssociatedValuesRepository.Save(serviceName, uuid); err != nil {
+		return stacktrace.Propagate(err, "An error occurred saving associated values '%s' for service '%s' in the service associated values repository", uuid, serviceName)
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package runtime_value_store
+
+import (
+	"os"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/stretchr/testify/require"
+	bolt "go.etcd.io/bbolt"
+	"go.starlark.net/starlark"
+)
+
+const (
+	testServiceName = service.ServiceName("db")
+)
+
+func TestExportAndImportValue(t *testing.T) {
+	sourceStore := getRuntimeValueStoreForTest(t)
+	destinationStore := getRuntimeValueStoreForTest(t)
+
+	setValueUuid, err := sourceStore.CreateValue()
+	require.NoError(t, err)
+	value := map[string]starlark.Comparable{
+		firstKey:  starlarkStringValue,
+		secondKey: starlarkIntValue,
+	}
+	require.NoError(t, sourceStore.SetValue(setValueUuid, value))
+	unsetValueUuid, err := sourceStore.CreateValue()
+	require.NoError(t, err)
+
+	exportedSetValue, err := sourceStore.ExportValue(setValueUuid)
+	require.NoError(t, err)
+	exportedUnsetValue, err := sourceStore.ExportValue(unsetValueUuid)
+	require.NoError(t, err)
+	require.Empty(t, exportedUnsetValue)
+
+	require.NoError(t, destinationStore.ImportValue(setValueUuid, exportedSetValue))
+	require.NoError(t, destinationStore.ImportValue(unsetValueUuid, exportedUnsetValue))
+
+	importedValue, err := destinationStore.GetValue(setValueUuid)
+	require.NoError(t, err)
+	require.Equal(t, value, importedValue)
+	// the value exists but isn't set, same as in the source store
+	_, err = destinationStore.GetValue(unsetValueUuid)
+	require.ErrorContains(t, err, "was found, but not set")
+}
+
+func TestAssociateValueWithService(t *testing.T) {
+	store := getRuntimeValueStoreForTest(t)
+
+	_, found, err := store.GetValueAssociatedWithService(testServiceName)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	require.NoError(t, store.AssociateValueWithService(testServiceName, randomUuid))
+
+	associatedValueUuid, found, err := store.GetValueAssociatedWithService(testServiceName)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, randomUuid, associatedValueUuid)
+
+	uuid, err := store.GetOrCreateValueAssociatedWithService(testServiceName)
+	require.NoError(t, err)
+	require.Equal(t, randomUuid, uuid)
+}
+
+func getRuntimeValueStoreForTest(t *testing.T) *RuntimeValueStore {
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer func() {
+		err = os.Remove(file.Name())
+		require.NoError(t, err)
+	}()
+
+	require.NoError(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.NoError(t, err)
+	enclaveDb := &enclave_db.EnclaveDB{
+		DB: db,
+	}
+
+	store, err := CreateRuntimeValueStore(newDummyStarlarkValueSerDeForTest(), enclaveDb, nil)
+	require.NoError(t, err)
+	return store
+}
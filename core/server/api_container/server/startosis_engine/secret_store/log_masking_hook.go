@@ -0,0 +1,29 @@
+package secret_store
+
+import (
+	"github.com/sirupsen/logrus"
+)
+
+// LogMaskingHook masks the secret values in every log entry before it gets formatted, so that secrets resolved
+// at execution time don't leak in the APIC logs
+type LogMaskingHook struct {
+	secretStore *SecretStore
+}
+
+func NewLogMaskingHook(secretStore *SecretStore) *LogMaskingHook {
+	return &LogMaskingHook{secretStore: secretStore}
+}
+
+func (hook *LogMaskingHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+func (hook *LogMaskingHook) Fire(entry *logrus.Entry) error {
+	entry.Message = hook.secretStore.MaskSecrets(entry.Message)
+	for key, value := range entry.Data {
+		if strValue, ok := value.(string); ok {
+			entry.Data[key] = hook.secretStore.MaskSecrets(strValue)
+		}
+	}
+	return nil
+}
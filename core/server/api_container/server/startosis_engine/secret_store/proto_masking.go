@@ -0,0 +1,58 @@
+package secret_store
+
+import (
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// MaskSecretsInMessage masks, in place, the secret values found in every string field of the message, including the
+// ones of nested messages, lists and maps
+func (store *SecretStore) MaskSecretsInMessage(message proto.Message) {
+	if message == nil {
+		return
+	}
+	store.maskSecretsInReflectMessage(message.ProtoReflect())
+}
+
+func (store *SecretStore) maskSecretsInReflectMessage(message protoreflect.Message) {
+	message.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		switch {
+		case field.IsList():
+			list := value.List()
+			for i := 0; i < list.Len(); i++ {
+				if maskedValue, isMasked := store.maskSecretsInValue(field, list.Get(i)); isMasked {
+					list.Set(i, maskedValue)
+				}
+			}
+		case field.IsMap():
+			mapValue := value.Map()
+			mapValue.Range(func(key protoreflect.MapKey, entryValue protoreflect.Value) bool {
+				if maskedValue, isMasked := store.maskSecretsInValue(field.MapValue(), entryValue); isMasked {
+					mapValue.Set(key, maskedValue)
+				}
+				return true
+			})
+		default:
+			if maskedValue, isMasked := store.maskSecretsInValue(field, value); isMasked {
+				message.Set(field, maskedValue)
+			}
+		}
+		return true
+	})
+}
+
+// maskSecretsInValue returns the masked value and true if the value is a string that had to be replaced. Messages are
+// masked in place
+func (store *SecretStore) maskSecretsInValue(field protoreflect.FieldDescriptor, value protoreflect.Value) (protoreflect.Value, bool) {
+	switch field.Kind() {
+	case protoreflect.StringKind:
+		maskedStr := store.MaskSecrets(value.String())
+		if maskedStr == value.String() {
+			return value, false
+		}
+		return protoreflect.ValueOfString(maskedStr), true
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		store.maskSecretsInReflectMessage(value.Message())
+	}
+	return value, false
+}
@@ -0,0 +1,311 @@
+package secret_store
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	encryptionKeyFilename = "secrets.key"
+	encryptionKeyLength   = 32
+	encryptionKeyFilePerm = 0600
+
+	// Matches the characters allowed in the field name of a runtime value, so that a secret name can always be
+	// embedded in a magic string
+	secretNameCharactersRegexp = "[a-zA-Z0-9-_\\.]+"
+	secretNameRegexp           = "^" + secretNameCharactersRegexp + "$"
+
+	// The magic string a secret is referenced by, e.g. {{kurtosis:secret:my-secret}}, capturing the name of the secret
+	secretPlaceholderRegexp         = "\\{\\{kurtosis:secret:(" + secretNameCharactersRegexp + ")\\}\\}"
+	secretPlaceholderNameMatchIndex = 1
+	unlimitedMatches                = -1
+	singleMatch                     = 1
+
+	maskedSecretFormat = "<secret:%v>"
+
+	// Shorter values, e.g. "1" or "true", would be masked everywhere they appear in the output rather than where the
+	// secret is
+	minSecretValueLength = 6
+)
+
+var (
+	secretBucketName = []byte("secret-repository")
+
+	compiledSecretNameRegexp        = regexp.MustCompile(secretNameRegexp)
+	compiledSecretPlaceholderRegexp = regexp.MustCompile(secretPlaceholderRegexp)
+)
+
+// SecretStore stores the enclave secrets in the enclave database, encrypted with a key that lives in the enclave data
+// directory. Decrypted values are kept in memory so that any output leaving the APIC can be masked cheaply
+type SecretStore struct {
+	enclaveDb *enclave_db.EnclaveDB
+	aead      cipher.AEAD
+
+	mutex *sync.RWMutex
+	// secret name -> decrypted value
+	values map[string]string
+}
+
+func CreateSecretStore(enclaveDb *enclave_db.EnclaveDB, secretsStoreDirpath string) (*SecretStore, error) {
+	encryptionKey, err := getOrCreateEncryptionKey(secretsStoreDirpath)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting or creating the secrets encryption key in '%s'", secretsStoreDirpath)
+	}
+	blockCipher, err := aes.NewCipher(encryptionKey)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the cipher used to encrypt secrets")
+	}
+	aead, err := cipher.NewGCM(blockCipher)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the AEAD used to encrypt secrets")
+	}
+
+	if err = enclaveDb.Update(func(tx *bolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists(secretBucketName)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred while creating the secret database bucket")
+		}
+		logrus.Debugf("Secret bucket: '%+v'", bucket)
+		return nil
+	}); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while building the secret store")
+	}
+
+	store := &SecretStore{
+		enclaveDb: enclaveDb,
+		aead:      aead,
+		mutex:     &sync.RWMutex{},
+		values:    map[string]string{},
+	}
+	if err = store.loadAll(); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred loading the secrets already stored in the enclave database")
+	}
+	return store, nil
+}
+
+// Set stores the secret, overwriting any existing value stored under the same name
+func (store *SecretStore) Set(name string, value string) error {
+	if !compiledSecretNameRegexp.MatchString(name) {
+		return stacktrace.NewError("Secret name '%s' is invalid; it should match '%s'", name, secretNameRegexp)
+	}
+	if value == "" {
+		return stacktrace.NewError("Secret '%s' can't have an empty value", name)
+	}
+	if len(value) < minSecretValueLength {
+		return stacktrace.NewError("The value of secret '%s' is too short; it should be at least %d characters long", name, minSecretValueLength)
+	}
+	encryptedValue, err := store.encrypt(value)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred encrypting the value of secret '%s'", name)
+	}
+
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	if err = store.enclaveDb.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(secretBucketName)
+		if err := bucket.Put([]byte(name), encryptedValue); err != nil {
+			return stacktrace.Propagate(err, "An error occurred while saving secret '%s' into the enclave db bucket", name)
+		}
+		return nil
+	}); err != nil {
+		return stacktrace.Propagate(err, "An error occurred while saving secret '%s' into the enclave db", name)
+	}
+	store.values[name] = value
+	return nil
+}
+
+// Get returns the clear text value of the secret. It should only be called at execution time
+func (store *SecretStore) Get(name string) (string, error) {
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+	value, found := store.values[name]
+	if !found {
+		return "", stacktrace.NewError("Secret '%s' does not exist in the enclave secret store", name)
+	}
+	return value, nil
+}
+
+func (store *SecretStore) Exists(name string) bool {
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+	_, found := store.values[name]
+	return found
+}
+
+// ListNames returns the sorted names of the secrets in the store
+func (store *SecretStore) ListNames() []string {
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+	names := make([]string, 0, len(store.values))
+	for name := range store.values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (store *SecretStore) Remove(name string) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	if _, found := store.values[name]; !found {
+		return stacktrace.NewError("Secret '%s' does not exist in the enclave secret store", name)
+	}
+	if err := store.enclaveDb.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(secretBucketName)
+		if err := bucket.Delete([]byte(name)); err != nil {
+			return stacktrace.Propagate(err, "An error occurred deleting secret '%s' from the secret bucket", name)
+		}
+		return nil
+	}); err != nil {
+		return stacktrace.Propagate(err, "An error occurred while deleting secret '%s' from the enclave db", name)
+	}
+	delete(store.values, name)
+	return nil
+}
+
+// ExportSealed returns every secret of the store sealed for the recipient, for them to be imported in another enclave
+func (store *SecretStore) ExportSealed(recipientPublicKey []byte) ([]byte, error) {
+	store.mutex.RLock()
+	secrets := maps.Clone(store.values)
+	store.mutex.RUnlock()
+	sealedSecrets, err := SealSecrets(recipientPublicKey, secrets)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred sealing the %d secrets of the store", len(secrets))
+	}
+	return sealedSecrets, nil
+}
+
+// ImportSealed stores the secrets sealed for the recipient, overwriting the secrets stored under the same names
+func (store *SecretStore) ImportSealed(recipient *SecretsRecipient, sealedSecrets []byte) error {
+	secrets, err := recipient.OpenSecrets(sealedSecrets)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred opening the sealed secrets")
+	}
+	for name, value := range secrets {
+		if err := store.Set(name, value); err != nil {
+			return stacktrace.Propagate(err, "An error occurred storing imported secret '%s'", name)
+		}
+	}
+	return nil
+}
+
+// ReplaceSecretsInString returns the string with every secret placeholder in it replaced by the value of the secret. It
+// should only be called at execution time, on strings that are never persisted
+func (store *SecretStore) ReplaceSecretsInString(str string) (string, error) {
+	matches := compiledSecretPlaceholderRegexp.FindAllStringSubmatch(str, unlimitedMatches)
+	replacedStr := str
+	for _, match := range matches {
+		value, err := store.Get(match[secretPlaceholderNameMatchIndex])
+		if err != nil {
+			return "", stacktrace.Propagate(err, "An error occurred getting secret '%s'", match[secretPlaceholderNameMatchIndex])
+		}
+		replacedStr = strings.Replace(replacedStr, match[0], value, singleMatch)
+	}
+	return replacedStr, nil
+}
+
+// MaskSecrets replaces every secret value found in the string with a placeholder naming the secret. Values shorter than
+// the minimum length, which can only come from a store created before it was enforced, are left alone
+func (store *SecretStore) MaskSecrets(str string) string {
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+	if len(store.values) == 0 {
+		return str
+	}
+	names := make([]string, 0, len(store.values))
+	for name := range store.values {
+		names = append(names, name)
+	}
+	// longest values first so that a secret containing another secret is masked as a whole
+	sort.Slice(names, func(i, j int) bool {
+		return len(store.values[names[i]]) > len(store.values[names[j]])
+	})
+	maskedStr := str
+	for _, name := range names {
+		if len(store.values[name]) < minSecretValueLength {
+			continue
+		}
+		maskedStr = strings.ReplaceAll(maskedStr, store.values[name], fmt.Sprintf(maskedSecretFormat, name))
+	}
+	return maskedStr
+}
+
+func (store *SecretStore) loadAll() error {
+	encryptedValues := map[string][]byte{}
+	if err := store.enclaveDb.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(secretBucketName)
+		return bucket.ForEach(func(name, encryptedValue []byte) error {
+			encryptedValues[string(name)] = append([]byte{}, encryptedValue...)
+			return nil
+		})
+	}); err != nil {
+		return stacktrace.Propagate(err, "An error occurred reading the secrets from the enclave db")
+	}
+	for name, encryptedValue := range encryptedValues {
+		value, err := store.decrypt(encryptedValue)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred decrypting secret '%s'", name)
+		}
+		store.values[name] = value
+	}
+	return nil
+}
+
+// encrypt returns the nonce followed by the sealed value
+func (store *SecretStore) encrypt(value string) ([]byte, error) {
+	nonce := make([]byte, store.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred generating the nonce")
+	}
+	return store.aead.Seal(nonce, nonce, []byte(value), nil), nil
+}
+
+func (store *SecretStore) decrypt(encryptedValue []byte) (string, error) {
+	nonceSize := store.aead.NonceSize()
+	if len(encryptedValue) < nonceSize {
+		return "", stacktrace.NewError("Encrypted value is shorter than the nonce; the secret store is likely corrupted")
+	}
+	value, err := store.aead.Open(nil, encryptedValue[:nonceSize], encryptedValue[nonceSize:], nil)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred opening the encrypted value")
+	}
+	return string(value), nil
+}
+
+func getOrCreateEncryptionKey(secretsStoreDirpath string) ([]byte, error) {
+	encryptionKeyFilepath := path.Join(secretsStoreDirpath, encryptionKeyFilename)
+	encryptionKey, err := os.ReadFile(encryptionKeyFilepath)
+	if err == nil {
+		if len(encryptionKey) != encryptionKeyLength {
+			return nil, stacktrace.NewError("Expected the encryption key at '%s' to be %d bytes long but it was %d", encryptionKeyFilepath, encryptionKeyLength, len(encryptionKey))
+		}
+		return encryptionKey, nil
+	}
+	if !os.IsNotExist(err) {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the encryption key at '%s'", encryptionKeyFilepath)
+	}
+
+	encryptionKey = make([]byte, encryptionKeyLength)
+	if _, err = io.ReadFull(rand.Reader, encryptionKey); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred generating the encryption key")
+	}
+	if err = os.WriteFile(encryptionKeyFilepath, encryptionKey, encryptionKeyFilePerm); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred writing the encryption key to '%s'", encryptionKeyFilepath)
+	}
+	return encryptionKey, nil
+}
@@ -0,0 +1,185 @@
+package secret_store
+
+import (
+	"os"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/stretchr/testify/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	secretName  = "registry-password"
+	secretValue = "sup3r-s3cr3t"
+
+	otherSecretName  = "api_token"
+	otherSecretValue = "tok-sup3r-s3cr3t-en"
+)
+
+func TestSetAndGet_Success(t *testing.T) {
+	store, _, _ := getSecretStoreForTest(t)
+
+	require.NoError(t, store.Set(secretName, secretValue))
+
+	value, err := store.Get(secretName)
+	require.NoError(t, err)
+	require.Equal(t, secretValue, value)
+	require.True(t, store.Exists(secretName))
+}
+
+func TestSet_InvalidName(t *testing.T) {
+	store, _, _ := getSecretStoreForTest(t)
+
+	require.Error(t, store.Set("my secret", secretValue))
+	require.Error(t, store.Set(secretName, ""))
+}
+
+func TestGet_DoesNotExist(t *testing.T) {
+	store, _, _ := getSecretStoreForTest(t)
+
+	_, err := store.Get(secretName)
+	require.ErrorContains(t, err, "does not exist")
+}
+
+func TestValueIsNotStoredInClearText(t *testing.T) {
+	store, enclaveDb, _ := getSecretStoreForTest(t)
+
+	require.NoError(t, store.Set(secretName, secretValue))
+
+	err := enclaveDb.View(func(tx *bolt.Tx) error {
+		storedValue := tx.Bucket(secretBucketName).Get([]byte(secretName))
+		require.NotEmpty(t, storedValue)
+		require.NotContains(t, string(storedValue), secretValue)
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestSecretsSurviveRestart(t *testing.T) {
+	store, enclaveDb, secretsDirpath := getSecretStoreForTest(t)
+
+	require.NoError(t, store.Set(secretName, secretValue))
+
+	restartedStore, err := CreateSecretStore(enclaveDb, secretsDirpath)
+	require.NoError(t, err)
+	value, err := restartedStore.Get(secretName)
+	require.NoError(t, err)
+	require.Equal(t, secretValue, value)
+}
+
+func TestExportSealedAndImportSealed(t *testing.T) {
+	sourceStore, _, _ := getSecretStoreForTest(t)
+	require.NoError(t, sourceStore.Set(secretName, secretValue))
+	require.NoError(t, sourceStore.Set(otherSecretName, otherSecretValue))
+
+	recipient, err := NewSecretsRecipient()
+	require.NoError(t, err)
+	sealedSecrets, err := sourceStore.ExportSealed(recipient.GetPublicKey())
+	require.NoError(t, err)
+	require.NotContains(t, string(sealedSecrets), secretValue)
+
+	// Only the recipient the secrets were sealed for can open them
+	otherRecipient, err := NewSecretsRecipient()
+	require.NoError(t, err)
+	_, err = otherRecipient.OpenSecrets(sealedSecrets)
+	require.Error(t, err)
+
+	destinationStore, _, _ := getSecretStoreForTest(t)
+	require.NoError(t, destinationStore.ImportSealed(recipient, sealedSecrets))
+	require.Equal(t, []string{otherSecretName, secretName}, destinationStore.ListNames())
+	value, err := destinationStore.Get(secretName)
+	require.NoError(t, err)
+	require.Equal(t, secretValue, value)
+}
+
+func TestListNamesAndRemove(t *testing.T) {
+	store, _, _ := getSecretStoreForTest(t)
+
+	require.NoError(t, store.Set(secretName, secretValue))
+	require.NoError(t, store.Set(otherSecretName, otherSecretValue))
+	require.Equal(t, []string{otherSecretName, secretName}, store.ListNames())
+
+	require.NoError(t, store.Remove(secretName))
+	require.Equal(t, []string{otherSecretName}, store.ListNames())
+	require.Error(t, store.Remove(secretName))
+}
+
+func TestMaskSecrets(t *testing.T) {
+	store, _, _ := getSecretStoreForTest(t)
+
+	require.NoError(t, store.Set(secretName, secretValue))
+	require.NoError(t, store.Set(otherSecretName, otherSecretValue))
+
+	maskedStr := store.MaskSecrets("login with " + secretValue + " then call with " + otherSecretValue)
+	require.Equal(t, "login with <secret:registry-password> then call with <secret:api_token>", maskedStr)
+}
+
+func TestReplaceSecretsInString(t *testing.T) {
+	store, _, _ := getSecretStoreForTest(t)
+
+	require.NoError(t, store.Set(secretName, secretValue))
+
+	replacedStr, err := store.ReplaceSecretsInString("--password={{kurtosis:secret:registry-password}} --user=admin")
+	require.NoError(t, err)
+	require.Equal(t, "--password="+secretValue+" --user=admin", replacedStr)
+
+	_, err = store.ReplaceSecretsInString("{{kurtosis:secret:api_token}}")
+	require.ErrorContains(t, err, "does not exist")
+}
+
+func TestSet_ValueTooShort(t *testing.T) {
+	store, _, _ := getSecretStoreForTest(t)
+
+	require.ErrorContains(t, store.Set(secretName, "true"), "too short")
+	require.False(t, store.Exists(secretName))
+}
+
+func TestMaskSecrets_SkipsValuesTooShort(t *testing.T) {
+	store, _, _ := getSecretStoreForTest(t)
+
+	require.NoError(t, store.Set(secretName, secretValue))
+	// stored before the minimum length was enforced
+	store.values[otherSecretName] = "1"
+
+	maskedStr := store.MaskSecrets("retried 1 time with " + secretValue)
+	require.Equal(t, "retried 1 time with <secret:registry-password>", maskedStr)
+}
+
+func TestMaskSecretsInMessage(t *testing.T) {
+	store, _, _ := getSecretStoreForTest(t)
+
+	require.NoError(t, store.Set(secretName, secretValue))
+
+	responseLine := &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine{
+		RunResponseLine: &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine_InstructionResult{
+			InstructionResult: &kurtosis_core_rpc_api_bindings.StarlarkInstructionResult{
+				SerializedInstructionResult: "Command returned with exit code '0' and the following output: " + secretValue,
+			},
+		},
+	}
+	store.MaskSecretsInMessage(responseLine)
+	require.Equal(t, "Command returned with exit code '0' and the following output: <secret:registry-password>", responseLine.GetInstructionResult().GetSerializedInstructionResult())
+}
+
+func getSecretStoreForTest(t *testing.T) (*SecretStore, *enclave_db.EnclaveDB, string) {
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer func() {
+		err = os.Remove(file.Name())
+		require.NoError(t, err)
+	}()
+
+	require.NoError(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.NoError(t, err)
+	enclaveDb := &enclave_db.EnclaveDB{
+		DB: db,
+	}
+
+	secretsDirpath := t.TempDir()
+	store, err := CreateSecretStore(enclaveDb, secretsDirpath)
+	require.NoError(t, err)
+
+	return store, enclaveDb, secretsDirpath
+}
@@ -0,0 +1,114 @@
+package secret_store
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"io"
+
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// Secrets leave an enclave sealed for the API container of the enclave they're copied to, so that whatever relays them
+// (the engine, a gateway) can't read them. They're encrypted with a key derived from an X25519 exchange between a
+// one-time key of the sender and the key of the recipient:
+// sealed secrets = sender public key | nonce | AES-GCM encrypted JSON map of secret name to value
+
+// SecretsRecipient holds the private key that secrets get sealed for
+type SecretsRecipient struct {
+	privateKey *ecdh.PrivateKey
+}
+
+func NewSecretsRecipient() (*SecretsRecipient, error) {
+	privateKey, err := ecdh.X25519().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred generating the secrets recipient key")
+	}
+	return &SecretsRecipient{privateKey: privateKey}, nil
+}
+
+func (recipient *SecretsRecipient) GetPublicKey() []byte {
+	return recipient.privateKey.PublicKey().Bytes()
+}
+
+// OpenSecrets returns the secrets sealed with the public key of this recipient
+func (recipient *SecretsRecipient) OpenSecrets(sealedSecrets []byte) (map[string]string, error) {
+	publicKeyLength := len(recipient.GetPublicKey())
+	if len(sealedSecrets) < publicKeyLength {
+		return nil, stacktrace.NewError("The sealed secrets are shorter than a public key")
+	}
+	senderPublicKey, err := ecdh.X25519().NewPublicKey(sealedSecrets[:publicKeyLength])
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the public key the secrets were sealed with")
+	}
+	aead, err := newSealingAead(recipient.privateKey, senderPublicKey, senderPublicKey, recipient.privateKey.PublicKey())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred deriving the key the secrets were sealed with")
+	}
+	encryptedSecrets := sealedSecrets[publicKeyLength:]
+	if len(encryptedSecrets) < aead.NonceSize() {
+		return nil, stacktrace.NewError("The sealed secrets are shorter than a nonce")
+	}
+	serializedSecrets, err := aead.Open(nil, encryptedSecrets[:aead.NonceSize()], encryptedSecrets[aead.NonceSize():], nil)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred opening the sealed secrets; they were likely sealed for another recipient")
+	}
+	secrets := map[string]string{}
+	if err := json.Unmarshal(serializedSecrets, &secrets); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred deserializing the opened secrets")
+	}
+	return secrets, nil
+}
+
+// SealSecrets encrypts the secrets such that only the holder of the private key of the recipient public key can read
+// them
+func SealSecrets(recipientPublicKeyBytes []byte, secrets map[string]string) ([]byte, error) {
+	recipientPublicKey, err := ecdh.X25519().NewPublicKey(recipientPublicKeyBytes)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the public key of the secrets recipient")
+	}
+	senderPrivateKey, err := ecdh.X25519().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred generating the key to seal the secrets with")
+	}
+	aead, err := newSealingAead(senderPrivateKey, recipientPublicKey, senderPrivateKey.PublicKey(), recipientPublicKey)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred deriving the key to seal the secrets with")
+	}
+	serializedSecrets, err := json.Marshal(secrets)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred serializing the secrets")
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred generating the nonce")
+	}
+	sealedSecrets := append([]byte{}, senderPrivateKey.PublicKey().Bytes()...)
+	sealedSecrets = append(sealedSecrets, nonce...)
+	return aead.Seal(sealedSecrets, nonce, serializedSecrets, nil), nil
+}
+
+// newSealingAead derives the encryption key from the shared secret and both public keys, so that it's bound to the
+// exchange it came from
+func newSealingAead(privateKey *ecdh.PrivateKey, peerPublicKey *ecdh.PublicKey, senderPublicKey *ecdh.PublicKey, recipientPublicKey *ecdh.PublicKey) (cipher.AEAD, error) {
+	sharedSecret, err := privateKey.ECDH(peerPublicKey)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred computing the shared secret")
+	}
+	keyHash := sha256.New()
+	keyHash.Write(sharedSecret)
+	keyHash.Write(senderPublicKey.Bytes())
+	keyHash.Write(recipientPublicKey.Bytes())
+	blockCipher, err := aes.NewCipher(keyHash.Sum(nil))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the cipher")
+	}
+	aead, err := cipher.NewGCM(blockCipher)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the AEAD")
+	}
+	return aead, nil
+}
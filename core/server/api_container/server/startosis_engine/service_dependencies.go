@@ -0,0 +1,122 @@
+package startosis_engine
+
+import (
+	"sort"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/dependency_graph"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/add_service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/get_files_artifact"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers/magic_string_helper"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/types"
+)
+
+// getServiceDependenciesFromEnclavePlan returns, by service name, the services each service added by the enclave plan
+// depends on. The instructions aren't interpreted again, so the dependencies are rebuilt from what was persisted of
+// them: an instruction consumes the runtime values referenced in its code and the services and files artifacts it
+// reported to the dependency graph when it was planned, and produces the runtime values referenced in its returned
+// value, the files artifacts it stores and, for add_service and add_services, its services. A service depends on the
+// services added by the instructions its add_service instruction consumes, either directly or through instructions
+// like exec or render_templates which don't add services themselves.
+func getServiceDependenciesFromEnclavePlan(enclavePlanInstructions []*enclave_plan_persistence.EnclavePlanInstruction) map[service.ServiceName][]service.ServiceName {
+	instructionsSequence := []types.ScheduledInstructionUuid{}
+	for _, enclavePlanInstruction := range enclavePlanInstructions {
+		instructionsSequence = append(instructionsSequence, types.ScheduledInstructionUuid(enclavePlanInstruction.Uuid))
+	}
+	dependencyGraph := dependency_graph.NewInstructionDependencyGraph(instructionsSequence)
+
+	servicesAddedByInstruction := map[types.ScheduledInstructionUuid][]service.ServiceName{}
+	for _, enclavePlanInstruction := range enclavePlanInstructions {
+		instructionUuid := types.ScheduledInstructionUuid(enclavePlanInstruction.Uuid)
+		isAddingServices := enclavePlanInstruction.Type == add_service.AddServiceBuiltinName || enclavePlanInstruction.Type == add_service.AddServicesBuiltinName
+
+		// what an instruction consumes is recorded before what it produces, as instructions can update their own outputs
+		dependencyGraph.ConsumesAnyRuntimeValuesInString(instructionUuid, enclavePlanInstruction.StarlarkCode)
+		if consumedOutputs := enclavePlanInstruction.ConsumedOutputs; consumedOutputs != nil {
+			for _, serviceName := range consumedOutputs.ServiceNames {
+				dependencyGraph.ConsumesService(instructionUuid, serviceName)
+			}
+			for _, filesArtifactName := range consumedOutputs.FilesArtifactNames {
+				dependencyGraph.ConsumesFilesArtifact(instructionUuid, filesArtifactName)
+			}
+		} else if !isAddingServices {
+			// instructions persisted before their consumed outputs were recorded only tell the services they operate on
+			for _, serviceName := range enclavePlanInstruction.ServiceNames {
+				dependencyGraph.ConsumesService(instructionUuid, serviceName)
+			}
+		}
+
+		for _, runtimeValue := range magic_string_helper.GetRuntimeValuesFromString(enclavePlanInstruction.ReturnedValue) {
+			dependencyGraph.ProducesRuntimeValue(instructionUuid, runtimeValue)
+		}
+		if enclavePlanInstruction.Type != get_files_artifact.GetFilesArtifactBuiltinName {
+			for filesArtifactName := range enclavePlanInstruction.FilesArtifacts {
+				dependencyGraph.ProducesFilesArtifact(instructionUuid, filesArtifactName)
+			}
+		}
+		if isAddingServices {
+			for _, serviceName := range enclavePlanInstruction.ServiceNames {
+				dependencyGraph.ProducesService(instructionUuid, serviceName)
+				servicesAddedByInstruction[instructionUuid] = append(servicesAddedByInstruction[instructionUuid], service.ServiceName(serviceName))
+			}
+		}
+	}
+
+	instructionsDependencies := dependencyGraph.GenerateDependencyGraph()
+	serviceDependencies := map[service.ServiceName][]service.ServiceName{}
+	for instructionUuid, serviceNames := range servicesAddedByInstruction {
+		dependencies := getServicesAddedByDependencies(instructionUuid, instructionsDependencies, servicesAddedByInstruction)
+		for _, serviceName := range serviceNames {
+			serviceDependencies[serviceName] = append(serviceDependencies[serviceName], dependencies...)
+		}
+	}
+	for serviceName, dependencies := range serviceDependencies {
+		serviceDependencies[serviceName] = sortAndDeduplicateServiceNames(serviceName, dependencies)
+	}
+	return serviceDependencies
+}
+
+// getServicesAddedByDependencies walks the dependencies of the instruction until it reaches instructions adding
+// services, whose own dependencies are then left to them
+func getServicesAddedByDependencies(
+	instructionUuid types.ScheduledInstructionUuid,
+	instructionsDependencies map[types.ScheduledInstructionUuid][]types.ScheduledInstructionUuid,
+	servicesAddedByInstruction map[types.ScheduledInstructionUuid][]service.ServiceName,
+) []service.ServiceName {
+	dependencies := []service.ServiceName{}
+	visitedInstructions := map[types.ScheduledInstructionUuid]bool{instructionUuid: true}
+	instructionsToVisit := append([]types.ScheduledInstructionUuid{}, instructionsDependencies[instructionUuid]...)
+	for len(instructionsToVisit) > 0 {
+		instructionToVisit := instructionsToVisit[0]
+		instructionsToVisit = instructionsToVisit[1:]
+		if visitedInstructions[instructionToVisit] {
+			continue
+		}
+		visitedInstructions[instructionToVisit] = true
+		if addedServices, found := servicesAddedByInstruction[instructionToVisit]; found {
+			dependencies = append(dependencies, addedServices...)
+			continue
+		}
+		instructionsToVisit = append(instructionsToVisit, instructionsDependencies[instructionToVisit]...)
+	}
+	return dependencies
+}
+
+// sortAndDeduplicateServiceNames also leaves out the service itself, which a service updated by a later add_service
+// instruction can depend on when its new config refers to its former runtime values
+func sortAndDeduplicateServiceNames(serviceName service.ServiceName, serviceNames []service.ServiceName) []service.ServiceName {
+	isServiceNameSeen := map[service.ServiceName]bool{serviceName: true}
+	result := []service.ServiceName{}
+	for _, dependency := range serviceNames {
+		if isServiceNameSeen[dependency] {
+			continue
+		}
+		isServiceNameSeen[dependency] = true
+		result = append(result, dependency)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i] < result[j]
+	})
+	return result
+}
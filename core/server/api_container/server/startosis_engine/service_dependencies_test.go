@@ -0,0 +1,135 @@
+package startosis_engine
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/add_service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/render_templates"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers/magic_string_helper"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// runtime values are keyed by UUIDs of their own, which the magic strings only match in this format
+	dbResultUuid          = "0123456789abcdef0123456789abcdef"
+	apiResultUuid         = "fedcba9876543210fedcba9876543210"
+	execResultUuid        = "00112233445566778899aabbccddeeff"
+	configFilesArtifact   = "api-config"
+	serviceReturnedFormat = `Service(name=%q, hostname=%q, ip_address=%q, ports={})`
+)
+
+func TestGetServiceDependenciesFromEnclavePlan(t *testing.T) {
+	dbIpAddress := fmt.Sprintf(magic_string_helper.RuntimeValueReplacementPlaceholderFormat, dbResultUuid, "ip_address")
+	dbHostname := fmt.Sprintf(magic_string_helper.RuntimeValueReplacementPlaceholderFormat, dbResultUuid, "hostname")
+	apiIpAddress := fmt.Sprintf(magic_string_helper.RuntimeValueReplacementPlaceholderFormat, apiResultUuid, "ip_address")
+	apiHostname := fmt.Sprintf(magic_string_helper.RuntimeValueReplacementPlaceholderFormat, apiResultUuid, "hostname")
+
+	enclavePlanInstructions := []*enclave_plan_persistence.EnclavePlanInstruction{
+		{
+			Uuid:            "db-instruction-uuid",
+			Type:            add_service.AddServiceBuiltinName,
+			StarlarkCode:    `add_service(name="db", config=ServiceConfig(image="postgres"))`,
+			ReturnedValue:   fmt.Sprintf(serviceReturnedFormat, "db", dbHostname, dbIpAddress),
+			ServiceNames:    []string{"db"},
+			FilesArtifacts:  map[string][]byte{},
+			ConsumedOutputs: noConsumedOutputs(),
+		},
+		{
+			Uuid:            "render-instruction-uuid",
+			Type:            render_templates.RenderTemplatesBuiltinName,
+			StarlarkCode:    fmt.Sprintf(`render_templates(config={"config.yml": struct(template="db: {{.Db}}", data={"Db": %q})}, name=%q)`, dbIpAddress, configFilesArtifact),
+			ReturnedValue:   fmt.Sprintf("%q", configFilesArtifact),
+			ServiceNames:    []string{},
+			FilesArtifacts:  map[string][]byte{configFilesArtifact: []byte("md5")},
+			ConsumedOutputs: noConsumedOutputs(),
+		},
+		{
+			Uuid:           "api-instruction-uuid",
+			Type:           add_service.AddServiceBuiltinName,
+			StarlarkCode:   fmt.Sprintf(`add_service(name="api", config=ServiceConfig(image="api", files={"/config": %q}))`, configFilesArtifact),
+			ReturnedValue:  fmt.Sprintf(serviceReturnedFormat, "api", apiHostname, apiIpAddress),
+			ServiceNames:   []string{"api"},
+			FilesArtifacts: map[string][]byte{},
+			ConsumedOutputs: &enclave_plan_persistence.ConsumedOutputs{
+				ServiceNames:       []string{},
+				FilesArtifactNames: []string{configFilesArtifact},
+			},
+		},
+		{
+			Uuid: "services-instruction-uuid",
+			Type: add_service.AddServicesBuiltinName,
+			// the name of the files artifact in the code of the worker is only a value of its environment
+			StarlarkCode:    fmt.Sprintf(`add_services(configs={"worker": ServiceConfig(image="worker", env_vars={"API": %q, "CONFIG_NAME": %q}), "cache": ServiceConfig(image="redis")})`, apiHostname, configFilesArtifact),
+			ReturnedValue:   "{}",
+			ServiceNames:    []string{"worker", "cache"},
+			FilesArtifacts:  map[string][]byte{},
+			ConsumedOutputs: noConsumedOutputs(),
+		},
+	}
+
+	serviceDependencies := getServiceDependenciesFromEnclavePlan(enclavePlanInstructions)
+
+	// cache is added by the same instruction as worker, so it gets the same dependencies
+	expectedServiceDependencies := map[service.ServiceName][]service.ServiceName{
+		"db":     {},
+		"api":    {"db"},
+		"worker": {"api"},
+		"cache":  {"api"},
+	}
+	require.Equal(t, expectedServiceDependencies, serviceDependencies)
+}
+
+func TestGetServiceDependenciesFromEnclavePlan_InstructionsWithoutConsumedOutputs(t *testing.T) {
+	dbIpAddress := fmt.Sprintf(magic_string_helper.RuntimeValueReplacementPlaceholderFormat, dbResultUuid, "ip_address")
+	dbHostname := fmt.Sprintf(magic_string_helper.RuntimeValueReplacementPlaceholderFormat, dbResultUuid, "hostname")
+	apiIpAddress := fmt.Sprintf(magic_string_helper.RuntimeValueReplacementPlaceholderFormat, apiResultUuid, "ip_address")
+	apiHostname := fmt.Sprintf(magic_string_helper.RuntimeValueReplacementPlaceholderFormat, apiResultUuid, "hostname")
+
+	execCode := fmt.Sprintf(magic_string_helper.RuntimeValueReplacementPlaceholderFormat, execResultUuid, "code")
+
+	// persisted before the consumed outputs were recorded: the exec on db only tells the service it operates on
+	enclavePlanInstructions := []*enclave_plan_persistence.EnclavePlanInstruction{
+		{
+			Uuid:           "db-instruction-uuid",
+			Type:           add_service.AddServiceBuiltinName,
+			StarlarkCode:   `add_service(name="db", config=ServiceConfig(image="postgres"))`,
+			ReturnedValue:  fmt.Sprintf(serviceReturnedFormat, "db", dbHostname, dbIpAddress),
+			ServiceNames:   []string{"db"},
+			FilesArtifacts: map[string][]byte{},
+		},
+		{
+			Uuid:           "exec-instruction-uuid",
+			Type:           "exec",
+			StarlarkCode:   `exec(service_name="db", recipe=ExecRecipe(command=["pg_dump"]))`,
+			ReturnedValue:  fmt.Sprintf(`{"code": %q}`, execCode),
+			ServiceNames:   []string{"db"},
+			FilesArtifacts: map[string][]byte{},
+		},
+		{
+			Uuid:           "api-instruction-uuid",
+			Type:           add_service.AddServiceBuiltinName,
+			StarlarkCode:   fmt.Sprintf(`add_service(name="api", config=ServiceConfig(image="api", env_vars={"DUMP_CODE": %q}))`, execCode),
+			ReturnedValue:  fmt.Sprintf(serviceReturnedFormat, "api", apiHostname, apiIpAddress),
+			ServiceNames:   []string{"api"},
+			FilesArtifacts: map[string][]byte{},
+		},
+	}
+
+	serviceDependencies := getServiceDependenciesFromEnclavePlan(enclavePlanInstructions)
+
+	expectedServiceDependencies := map[service.ServiceName][]service.ServiceName{
+		"db":  {},
+		"api": {"db"},
+	}
+	require.Equal(t, expectedServiceDependencies, serviceDependencies)
+}
+
+func noConsumedOutputs() *enclave_plan_persistence.ConsumedOutputs {
+	return &enclave_plan_persistence.ConsumedOutputs{
+		ServiceNames:       []string{},
+		FilesArtifactNames: []string{},
+	}
+}
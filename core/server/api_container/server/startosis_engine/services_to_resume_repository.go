@@ -0,0 +1,82 @@
+package startosis_engine
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/kurtosis-tech/stacktrace"
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	servicesToResumeBucketName = []byte("services-to-resume-repository")
+
+	emptyValue = []byte{}
+)
+
+// servicesToResumeRepository keeps the names of the services that were started when the enclave got stopped. The
+// bucket only exists between the enclave being stopped and its services being resumed
+type servicesToResumeRepository struct {
+	enclaveDb *enclave_db.EnclaveDB
+}
+
+func newServicesToResumeRepository(enclaveDb *enclave_db.EnclaveDB) *servicesToResumeRepository {
+	return &servicesToResumeRepository{
+		enclaveDb: enclaveDb,
+	}
+}
+
+// Save replaces the recorded services with the given ones
+func (repository *servicesToResumeRepository) Save(serviceNames []service.ServiceName) error {
+	if err := repository.enclaveDb.Update(func(tx *bolt.Tx) error {
+		if tx.Bucket(servicesToResumeBucketName) != nil {
+			if err := tx.DeleteBucket(servicesToResumeBucketName); err != nil {
+				return stacktrace.Propagate(err, "An error occurred deleting the previously recorded services to resume")
+			}
+		}
+		bucket, err := tx.CreateBucket(servicesToResumeBucketName)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred creating the services to resume bucket")
+		}
+		for _, serviceName := range serviceNames {
+			if err := bucket.Put([]byte(serviceName), emptyValue); err != nil {
+				return stacktrace.Propagate(err, "An error occurred recording service '%v' to resume", serviceName)
+			}
+		}
+		return nil
+	}); err != nil {
+		return stacktrace.Propagate(err, "An error occurred saving the services to resume '%v' into the enclave db", serviceNames)
+	}
+	return nil
+}
+
+// Get returns the recorded services, and false if none were recorded since the last Delete
+func (repository *servicesToResumeRepository) Get() (map[service.ServiceName]bool, bool, error) {
+	serviceNames := map[service.ServiceName]bool{}
+	isRecorded := false
+	if err := repository.enclaveDb.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(servicesToResumeBucketName)
+		if bucket == nil {
+			return nil
+		}
+		isRecorded = true
+		return bucket.ForEach(func(serviceNameKey, _ []byte) error {
+			serviceNames[service.ServiceName(serviceNameKey)] = true
+			return nil
+		})
+	}); err != nil {
+		return nil, false, stacktrace.Propagate(err, "An error occurred getting the services to resume from the enclave db")
+	}
+	return serviceNames, isRecorded, nil
+}
+
+func (repository *servicesToResumeRepository) Delete() error {
+	if err := repository.enclaveDb.Update(func(tx *bolt.Tx) error {
+		if tx.Bucket(servicesToResumeBucketName) == nil {
+			return nil
+		}
+		return tx.DeleteBucket(servicesToResumeBucketName)
+	}); err != nil {
+		return stacktrace.Propagate(err, "An error occurred deleting the services to resume from the enclave db")
+	}
+	return nil
+}
@@ -0,0 +1,180 @@
+package starlark_run
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+type StarlarkRunStatus string
+
+const (
+	StarlarkRunStatus_InProgress     StarlarkRunStatus = "IN_PROGRESS"
+	StarlarkRunStatus_Succeeded      StarlarkRunStatus = "SUCCEEDED"
+	StarlarkRunStatus_Failed         StarlarkRunStatus = "FAILED"
+	StarlarkRunStatus_OutcomeUnknown StarlarkRunStatus = "OUTCOME_UNKNOWN"
+
+	// The output lines of a run past this size aren't recorded, so that a chatty run can't fill the enclave database
+	maxRecordedOutputSizeInBytes = 16 * 1024 * 1024
+)
+
+// StarlarkRunRecord is the history entry of one Starlark run of the enclave, unlike StarlarkRun which only describes
+// the latest run so that it can be run again
+type StarlarkRunRecord struct {
+	// we do this way in order to have exported fields which can be marshalled
+	// and an unexported type for encapsulation
+	privateStarlarkRunRecord *privateStarlarkRunRecord
+
+	// The output lines added since the record was last saved. The repository stores them apart from the record, so
+	// that listing the runs doesn't load their output
+	pendingSerializedOutputLines [][]byte
+}
+
+type privateStarlarkRunRecord struct {
+	RunUuid                string
+	PackageId              string
+	PackageGitCommitSha    string
+	SerializedParams       string
+	RelativePathToMainFile string
+	MainFunctionName       string
+	StartTime              time.Time
+	EndTime                time.Time
+	Status                 StarlarkRunStatus
+	Errors                 []string
+	NumberOfOutputLines    int
+	OutputSizeInBytes      int
+	// Set once a line didn't fit in the recorded output size limit; that line and the following ones aren't recorded
+	IsOutputTruncated bool
+}
+
+func NewStarlarkRunRecord(
+	runUuid string,
+	packageId string,
+	packageGitCommitSha string,
+	serializedParams string,
+	relativePathToMainFile string,
+	mainFunctionName string,
+	startTime time.Time,
+) *StarlarkRunRecord {
+	privateStarlarkRunRecordObj := &privateStarlarkRunRecord{
+		RunUuid:                runUuid,
+		PackageId:              packageId,
+		PackageGitCommitSha:    packageGitCommitSha,
+		SerializedParams:       serializedParams,
+		RelativePathToMainFile: relativePathToMainFile,
+		MainFunctionName:       mainFunctionName,
+		StartTime:              startTime,
+		EndTime:                time.Time{},
+		Status:                 StarlarkRunStatus_InProgress,
+		Errors:                 []string{},
+		NumberOfOutputLines:    0,
+		OutputSizeInBytes:      0,
+		IsOutputTruncated:      false,
+	}
+
+	return &StarlarkRunRecord{
+		privateStarlarkRunRecord:     privateStarlarkRunRecordObj,
+		pendingSerializedOutputLines: [][]byte{},
+	}
+}
+
+func (record *StarlarkRunRecord) GetRunUuid() string {
+	return record.privateStarlarkRunRecord.RunUuid
+}
+
+func (record *StarlarkRunRecord) GetPackageId() string {
+	return record.privateStarlarkRunRecord.PackageId
+}
+
+// GetPackageGitCommitSha returns an empty string if the package isn't a Git repository
+func (record *StarlarkRunRecord) GetPackageGitCommitSha() string {
+	return record.privateStarlarkRunRecord.PackageGitCommitSha
+}
+
+func (record *StarlarkRunRecord) GetSerializedParams() string {
+	return record.privateStarlarkRunRecord.SerializedParams
+}
+
+func (record *StarlarkRunRecord) GetRelativePathToMainFile() string {
+	return record.privateStarlarkRunRecord.RelativePathToMainFile
+}
+
+func (record *StarlarkRunRecord) GetMainFunctionName() string {
+	return record.privateStarlarkRunRecord.MainFunctionName
+}
+
+func (record *StarlarkRunRecord) GetStartTime() time.Time {
+	return record.privateStarlarkRunRecord.StartTime
+}
+
+// GetEndTime returns the zero time while the run is in progress
+func (record *StarlarkRunRecord) GetEndTime() time.Time {
+	return record.privateStarlarkRunRecord.EndTime
+}
+
+func (record *StarlarkRunRecord) GetStatus() StarlarkRunStatus {
+	return record.privateStarlarkRunRecord.Status
+}
+
+func (record *StarlarkRunRecord) GetErrors() []string {
+	return record.privateStarlarkRunRecord.Errors
+}
+
+// GetNumberOfOutputLines returns the number of output lines recorded, including the ones not saved yet
+func (record *StarlarkRunRecord) GetNumberOfOutputLines() int {
+	return record.privateStarlarkRunRecord.NumberOfOutputLines
+}
+
+func (record *StarlarkRunRecord) IsOutputTruncated() bool {
+	return record.privateStarlarkRunRecord.IsOutputTruncated
+}
+
+// GetNumberOfPendingOutputLines returns the number of output lines that the next save will store
+func (record *StarlarkRunRecord) GetNumberOfPendingOutputLines() int {
+	return len(record.pendingSerializedOutputLines)
+}
+
+func (record *StarlarkRunRecord) AddError(errorMsg string) {
+	record.privateStarlarkRunRecord.Errors = append(record.privateStarlarkRunRecord.Errors, errorMsg)
+}
+
+// AddSerializedOutputLine records the line, unless the recorded output would get bigger than the size limit in which
+// case the output is marked as truncated and no more lines are recorded
+func (record *StarlarkRunRecord) AddSerializedOutputLine(serializedOutputLine []byte) {
+	if record.privateStarlarkRunRecord.IsOutputTruncated {
+		return
+	}
+	if record.privateStarlarkRunRecord.OutputSizeInBytes+len(serializedOutputLine) > maxRecordedOutputSizeInBytes {
+		record.privateStarlarkRunRecord.IsOutputTruncated = true
+		return
+	}
+	record.privateStarlarkRunRecord.NumberOfOutputLines++
+	record.privateStarlarkRunRecord.OutputSizeInBytes += len(serializedOutputLine)
+	record.pendingSerializedOutputLines = append(record.pendingSerializedOutputLines, serializedOutputLine)
+}
+
+// Finish sets the final status of the run along with its end time
+func (record *StarlarkRunRecord) Finish(status StarlarkRunStatus, endTime time.Time) {
+	record.privateStarlarkRunRecord.Status = status
+	record.privateStarlarkRunRecord.EndTime = endTime
+}
+
+func (record *StarlarkRunRecord) MarshalJSON() ([]byte, error) {
+	return json.Marshal(record.privateStarlarkRunRecord)
+}
+
+func (record *StarlarkRunRecord) UnmarshalJSON(data []byte) error {
+
+	// Suppressing exhaustruct requirement because we want an object with zero values
+	// nolint: exhaustruct
+	unmarshalledPrivateStructPtr := &privateStarlarkRunRecord{}
+
+	if err := json.Unmarshal(data, unmarshalledPrivateStructPtr); err != nil {
+		return stacktrace.Propagate(err, "An error occurred unmarshalling the private struct")
+	}
+
+	record.privateStarlarkRunRecord = unmarshalledPrivateStructPtr
+	record.pendingSerializedOutputLines = [][]byte{}
+	return nil
+}
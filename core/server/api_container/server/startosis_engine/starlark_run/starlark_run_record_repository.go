@@ -0,0 +1,170 @@
+package starlark_run
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"sort"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	// the records are keyed by run UUID
+	starlarkRunRecordBucketName = []byte("starlark-run-record-repository")
+
+	// holds a bucket per run UUID, in which the output lines are keyed by their index
+	starlarkRunOutputBucketName = []byte("starlark-run-output-repository")
+)
+
+// StarlarkRunRecordRepository keeps the history of all the Starlark runs of the enclave
+type StarlarkRunRecordRepository struct {
+	enclaveDb *enclave_db.EnclaveDB
+}
+
+func GetOrCreateNewStarlarkRunRecordRepository(enclaveDb *enclave_db.EnclaveDB) (*StarlarkRunRecordRepository, error) {
+	if err := enclaveDb.Update(func(tx *bolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists(starlarkRunRecordBucketName)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred while creating the starlark run record database bucket")
+		}
+		logrus.Debugf("Starlark run record bucket: '%+v'", bucket)
+		if _, err := tx.CreateBucketIfNotExists(starlarkRunOutputBucketName); err != nil {
+			return stacktrace.Propagate(err, "An error occurred while creating the starlark run output database bucket")
+		}
+
+		return nil
+	}); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while building the starlark run record repository")
+	}
+
+	starlarkRunRecordRepository := &StarlarkRunRecordRepository{
+		enclaveDb: enclaveDb,
+	}
+
+	return starlarkRunRecordRepository, nil
+}
+
+// Get returns the record of the run with the given UUID, or nil if there's none
+func (repository *StarlarkRunRecordRepository) Get(runUuid string) (*StarlarkRunRecord, error) {
+	var (
+		starlarkRunRecordObj *StarlarkRunRecord
+		err                  error
+	)
+
+	if err = repository.enclaveDb.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(starlarkRunRecordBucketName)
+
+		starlarkRunRecordBytes := bucket.Get([]byte(runUuid))
+		if starlarkRunRecordBytes == nil {
+			return nil
+		}
+
+		starlarkRunRecordObj = &StarlarkRunRecord{nil, nil}
+		if err = json.Unmarshal(starlarkRunRecordBytes, starlarkRunRecordObj); err != nil {
+			return stacktrace.Propagate(err, "An error occurred unmarshalling starlark run record '%v'", runUuid)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while getting starlark run record '%v' from the repository", runUuid)
+	}
+	return starlarkRunRecordObj, nil
+}
+
+// GetOutputLines returns, in order, at most maxNumberOfLines serialized output lines of the run starting at the given
+// index. Fewer lines are returned once the end of the output is reached
+func (repository *StarlarkRunRecordRepository) GetOutputLines(runUuid string, firstLineIndex int, maxNumberOfLines int) ([][]byte, error) {
+	serializedOutputLines := [][]byte{}
+	if err := repository.enclaveDb.View(func(tx *bolt.Tx) error {
+		runOutputBucket := tx.Bucket(starlarkRunOutputBucketName).Bucket([]byte(runUuid))
+		if runOutputBucket == nil {
+			return nil
+		}
+		cursor := runOutputBucket.Cursor()
+		for key, serializedOutputLine := cursor.Seek(getOutputLineKey(firstLineIndex)); key != nil && len(serializedOutputLines) < maxNumberOfLines; key, serializedOutputLine = cursor.Next() {
+			// the values are only valid during the transaction
+			serializedOutputLines = append(serializedOutputLines, append([]byte{}, serializedOutputLine...))
+		}
+		return nil
+	}); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while getting the output lines of starlark run '%v' from the repository", runUuid)
+	}
+	return serializedOutputLines, nil
+}
+
+// GetAll returns the records of all the runs, sorted by start time
+func (repository *StarlarkRunRecordRepository) GetAll() ([]*StarlarkRunRecord, error) {
+	starlarkRunRecords := []*StarlarkRunRecord{}
+
+	if err := repository.enclaveDb.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(starlarkRunRecordBucketName)
+
+		return bucket.ForEach(func(runUuid, starlarkRunRecordBytes []byte) error {
+			starlarkRunRecordObj := &StarlarkRunRecord{nil, nil}
+			if err := json.Unmarshal(starlarkRunRecordBytes, starlarkRunRecordObj); err != nil {
+				return stacktrace.Propagate(err, "An error occurred unmarshalling starlark run record '%v'", string(runUuid))
+			}
+			starlarkRunRecords = append(starlarkRunRecords, starlarkRunRecordObj)
+			return nil
+		})
+	}); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while getting the starlark run records from the repository")
+	}
+
+	sort.SliceStable(starlarkRunRecords, func(i, j int) bool {
+		return starlarkRunRecords[i].GetStartTime().Before(starlarkRunRecords[j].GetStartTime())
+	})
+	return starlarkRunRecords, nil
+}
+
+// Save creates the record of the run, or replaces it if it already exists, and stores the output lines added to it
+// since it was last saved
+func (repository *StarlarkRunRecordRepository) Save(
+	starlarkRunRecord *StarlarkRunRecord,
+) error {
+	runUuid := starlarkRunRecord.GetRunUuid()
+
+	if err := repository.enclaveDb.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(starlarkRunRecordBucketName)
+
+		jsonBytes, err := json.Marshal(starlarkRunRecord)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred marshalling starlark run record '%v'", runUuid)
+		}
+
+		if err := bucket.Put([]byte(runUuid), jsonBytes); err != nil {
+			return stacktrace.Propagate(err, "An error occurred while saving starlark run record '%v' into the enclave db bucket", runUuid)
+		}
+
+		if starlarkRunRecord.GetNumberOfPendingOutputLines() == 0 {
+			return nil
+		}
+		runOutputBucket, err := tx.Bucket(starlarkRunOutputBucketName).CreateBucketIfNotExists([]byte(runUuid))
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred while creating the output bucket of starlark run '%v'", runUuid)
+		}
+		// the lines are appended, so the bucket fills up its pages instead of splitting them in half
+		runOutputBucket.FillPercent = 1
+		firstPendingLineIndex := starlarkRunRecord.GetNumberOfOutputLines() - starlarkRunRecord.GetNumberOfPendingOutputLines()
+		for idx, serializedOutputLine := range starlarkRunRecord.pendingSerializedOutputLines {
+			if err := runOutputBucket.Put(getOutputLineKey(firstPendingLineIndex+idx), serializedOutputLine); err != nil {
+				return stacktrace.Propagate(err, "An error occurred while saving output line %v of starlark run '%v'", firstPendingLineIndex+idx, runUuid)
+			}
+		}
+		return nil
+	}); err != nil {
+		return stacktrace.Propagate(err, "An error occurred while saving starlark run record '%v' into the starlark run record repository", runUuid)
+	}
+	starlarkRunRecord.pendingSerializedOutputLines = [][]byte{}
+	return nil
+}
+
+// getOutputLineKey returns big endian keys so that bolt, which sorts the keys byte-wise, keeps the lines in order
+func getOutputLineKey(lineIndex int) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, uint64(lineIndex))
+	return key
+}
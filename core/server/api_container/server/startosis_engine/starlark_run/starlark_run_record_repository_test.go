@@ -0,0 +1,121 @@
+package starlark_run
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/stretchr/testify/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	firstRunUuidForTest  = "4f7a3f4c3e5b4d6f8a9b0c1d2e3f4a5b"
+	secondRunUuidForTest = "0a1b2c3d4e5f40718293a4b5c6d7e8f9"
+)
+
+func TestSaveAndGetStarlarkRunRecord_Success(t *testing.T) {
+	repository := getRecordRepositoryForTest(t)
+
+	record := NewStarlarkRunRecord(
+		firstRunUuidForTest,
+		"github.com/kurtosis-tech/django-package",
+		"5e2b9d1f0c4a8e7b6d3c2a1f0e9d8c7b6a5f4e3d",
+		"{\"postgres_user\": \"admin\"}",
+		"main.star",
+		"run",
+		time.Unix(1700000000, 0).UTC(),
+	)
+	require.NoError(t, repository.Save(record))
+
+	record.AddSerializedOutputLine([]byte("output"))
+	record.AddError("Evaluation error: undefined: plan.add_servic")
+	record.Finish(StarlarkRunStatus_Failed, time.Unix(1700000060, 0).UTC())
+	require.NoError(t, repository.Save(record))
+
+	recordFromRepository, err := repository.Get(firstRunUuidForTest)
+	require.NoError(t, err)
+	require.Equal(t, record, recordFromRepository)
+}
+
+func TestGetNilStarlarkRunRecord_Success(t *testing.T) {
+	repository := getRecordRepositoryForTest(t)
+
+	recordFromRepository, err := repository.Get(firstRunUuidForTest)
+	require.NoError(t, err)
+	require.Nil(t, recordFromRepository)
+}
+
+func TestGetAllStarlarkRunRecords_SortedByStartTime(t *testing.T) {
+	repository := getRecordRepositoryForTest(t)
+
+	// the run that started last has the smallest UUID, so that the order doesn't come from the keys
+	laterRecord := NewStarlarkRunRecord(secondRunUuidForTest, "github.com/kurtosis-tech/postgres-package", "", "{}", "main.star", "run", time.Unix(1700000100, 0).UTC())
+	earlierRecord := NewStarlarkRunRecord(firstRunUuidForTest, "github.com/kurtosis-tech/django-package", "", "{}", "main.star", "run", time.Unix(1700000000, 0).UTC())
+	require.NoError(t, repository.Save(laterRecord))
+	require.NoError(t, repository.Save(earlierRecord))
+
+	records, err := repository.GetAll()
+	require.NoError(t, err)
+	require.Equal(t, []*StarlarkRunRecord{earlierRecord, laterRecord}, records)
+}
+
+func TestSaveStoresTheOutputLinesApart_Success(t *testing.T) {
+	repository := getRecordRepositoryForTest(t)
+
+	record := NewStarlarkRunRecord(firstRunUuidForTest, "github.com/kurtosis-tech/django-package", "", "{}", "main.star", "run", time.Unix(1700000000, 0).UTC())
+	record.AddSerializedOutputLine([]byte("first"))
+	record.AddSerializedOutputLine([]byte("second"))
+	require.NoError(t, repository.Save(record))
+	require.Zero(t, record.GetNumberOfPendingOutputLines())
+	record.AddSerializedOutputLine([]byte("third"))
+	require.NoError(t, repository.Save(record))
+
+	recordFromRepository, err := repository.Get(firstRunUuidForTest)
+	require.NoError(t, err)
+	require.Equal(t, 3, recordFromRepository.GetNumberOfOutputLines())
+
+	firstPage, err := repository.GetOutputLines(firstRunUuidForTest, 0, 2)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{[]byte("first"), []byte("second")}, firstPage)
+	secondPage, err := repository.GetOutputLines(firstRunUuidForTest, 2, 2)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{[]byte("third")}, secondPage)
+
+	outputOfUnknownRun, err := repository.GetOutputLines(secondRunUuidForTest, 0, 2)
+	require.NoError(t, err)
+	require.Empty(t, outputOfUnknownRun)
+}
+
+func TestAddSerializedOutputLineTruncatesTheOutput(t *testing.T) {
+	record := NewStarlarkRunRecord(firstRunUuidForTest, "github.com/kurtosis-tech/django-package", "", "{}", "main.star", "run", time.Unix(1700000000, 0).UTC())
+	halfOfTheLimit := make([]byte, maxRecordedOutputSizeInBytes/2)
+	record.AddSerializedOutputLine(halfOfTheLimit)
+	record.AddSerializedOutputLine(halfOfTheLimit)
+	require.False(t, record.IsOutputTruncated())
+
+	record.AddSerializedOutputLine([]byte("over the limit"))
+	record.AddSerializedOutputLine([]byte("after the limit"))
+	require.True(t, record.IsOutputTruncated())
+	require.Equal(t, 2, record.GetNumberOfOutputLines())
+}
+
+func getRecordRepositoryForTest(t *testing.T) *StarlarkRunRecordRepository {
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer func() {
+		err = os.Remove(file.Name())
+		require.NoError(t, err)
+	}()
+
+	require.NoError(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.NoError(t, err)
+	enclaveDb := &enclave_db.EnclaveDB{
+		DB: db,
+	}
+	repository, err := GetOrCreateNewStarlarkRunRecordRepository(enclaveDb)
+	require.NoError(t, err)
+
+	return repository
+}
@@ -12,6 +12,12 @@ const (
 	PackageIdPlaceholderForStandaloneScript                          = "DEFAULT_PACKAGE_ID_FOR_SCRIPT"
 	PlaceHolderMainFileForPlaceStandAloneScript                      = ""
 	ParallelismParam                            StarlarkContextParam = "PARALLELISM"
+	// InstructionOutputLineConsumerParam holds a func(outputLine string) that instructions can call to stream their
+	// output while they are being executed
+	InstructionOutputLineConsumerParam StarlarkContextParam = "INSTRUCTION_OUTPUT_LINE_CONSUMER"
+	// EnclavePlanReadyConditionsGetterParam holds a func that returns, by service name, the ready conditions the services
+	// of the enclave plan were added with, for instructions operating on services whose config doesn't declare any
+	EnclavePlanReadyConditionsGetterParam StarlarkContextParam = "ENCLAVE_PLAN_READY_CONDITIONS_GETTER"
 
 	// DefaultPersistentDirectorySize 1Gi Megabytes is the default value and what most drivers support
 	DefaultPersistentDirectorySize int64 = 1024 * 1024 * 1024
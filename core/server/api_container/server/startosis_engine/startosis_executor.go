@@ -3,16 +3,29 @@ package startosis_engine
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/dependency_graph"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/instructions_plan"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/add_service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/control_flow"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/render_templates"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers/magic_string_helper"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/store_service_files"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/upload_files"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/service_config"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_constants"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/types"
@@ -29,7 +42,8 @@ const (
 )
 
 var (
-	skippedInstructionOutput = "SKIPPED - This instruction has already been run in this enclave"
+	skippedInstructionOutput       = "SKIPPED - This instruction has already been run in this enclave"
+	skippedControlFlowBranchOutput = "SKIPPED - This instruction is in a branch that was not selected at execution time"
 )
 
 type StartosisExecutor struct {
@@ -38,6 +52,8 @@ type StartosisExecutor struct {
 	enclavePlan        *enclave_plan_persistence.EnclavePlan
 	enclaveDb          *enclave_db.EnclaveDB
 	runtimeValueStore  *runtime_value_store.RuntimeValueStore
+
+	servicesToResumeRepository *servicesToResumeRepository
 }
 
 type ExecutionError struct {
@@ -51,6 +67,8 @@ func NewStartosisExecutor(starlarkValueSerde *kurtosis_types.StarlarkValueSerde,
 		enclaveDb:          enclaveDb,
 		enclavePlan:        enclavePlan,
 		runtimeValueStore:  runtimeValueStore,
+
+		servicesToResumeRepository: newServicesToResumeRepository(enclaveDb),
 	}
 }
 
@@ -65,6 +83,7 @@ func (executor *StartosisExecutor) Execute(ctx context.Context, dryRun bool, par
 	executor.mutex.Lock()
 	starlarkRunResponseLineStream := make(chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine)
 	ctxWithParallelism := context.WithValue(ctx, startosis_constants.ParallelismParam, parallelism)
+	ctxWithParallelism = context.WithValue(ctxWithParallelism, startosis_constants.EnclavePlanReadyConditionsGetterParam, executor.getEnclavePlanReadyConditions)
 	go func() {
 		defer func() {
 			executor.mutex.Unlock()
@@ -94,7 +113,11 @@ func (executor *StartosisExecutor) Execute(ctx context.Context, dryRun bool, par
 
 		totalNumberOfInstructions := uint32(len(instructionsSequence))
 		totalExecutionDuration := time.Duration(0)
-		for index, scheduledInstruction := range instructionsSequence {
+		persistedInstructions := map[types.ScheduledInstructionUuid]*enclave_plan_persistence.EnclavePlanInstruction{}
+		controlFlowRunSequence := 0
+
+		executeInstruction := func(index int) bool {
+			scheduledInstruction := instructionsSequence[index]
 			instructionNumber := uint32(index + 1)
 			progress := binding_constructors.NewStarlarkRunResponseLineFromSinglelineProgressInfo(
 				progressMsg, instructionNumber, totalNumberOfInstructions)
@@ -113,13 +136,16 @@ func (executor *StartosisExecutor) Execute(ctx context.Context, dryRun bool, par
 					instructionOutput = &skippedInstructionOutput
 				} else {
 					startTime := time.Now()
-					instructionOutput, err = instruction.Execute(ctxWithParallelism)
+					ctxWithOutputLineConsumer := context.WithValue(ctxWithParallelism, startosis_constants.InstructionOutputLineConsumerParam, func(outputLine string) {
+						starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromInstructionOutput(outputLine)
+					})
+					instructionOutput, err = instruction.Execute(ctxWithOutputLineConsumer)
 					duration = time.Since(startTime)
 					totalExecutionDuration += duration
 				}
 				if err != nil {
 					sendErrorAndFail(starlarkRunResponseLineStream, totalExecutionDuration, err, "An error occurred executing instruction (number %d) at %v:\n%v", instructionNumber, instruction.GetPositionInOriginalScript().String(), instruction.String())
-					return
+					return false
 				}
 				if instructionOutput != nil {
 					instructionOutputStr := *instructionOutput
@@ -128,17 +154,91 @@ func (executor *StartosisExecutor) Execute(ctx context.Context, dryRun bool, par
 					}
 					starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromInstructionResult(instructionOutputStr, duration)
 				}
-				// add the instruction into the current enclave plan
-				enclavePlanInstruction, err := scheduledInstruction.GetInstruction().GetPersistableAttributes().SetUuid(
-					string(scheduledInstruction.GetUuid()),
-				).SetReturnedValue(
-					executor.starlarkValueSerde.Serialize(scheduledInstruction.GetReturnedValue()),
-				).Build()
-				if err != nil {
-					sendErrorAndFail(starlarkRunResponseLineStream, totalExecutionDuration, err, "An error occurred persisting instruction (number %d) at %v after it's been executed:\n%v", instructionNumber, instruction.GetPositionInOriginalScript().String(), instruction.String())
+				// instructions in a for_each body run several times but are added only once to the enclave plan
+				enclavePlanInstruction, found := persistedInstructions[scheduledInstruction.GetUuid()]
+				if !found {
+					// add the instruction into the current enclave plan
+					var enclavePlanInstructionBuilder *enclave_plan_persistence.EnclavePlanInstructionBuilder
+					enclavePlanInstructionBuilder, err = getEnclavePlanInstructionBuilder(scheduledInstruction)
+					if err == nil {
+						enclavePlanInstruction, err = enclavePlanInstructionBuilder.SetUuid(
+							string(scheduledInstruction.GetUuid()),
+						).SetReturnedValue(
+							executor.starlarkValueSerde.Serialize(scheduledInstruction.GetReturnedValue()),
+						).Build()
+					}
+					if err != nil {
+						sendErrorAndFail(starlarkRunResponseLineStream, totalExecutionDuration, err, "An error occurred persisting instruction (number %d) at %v after it's been executed:\n%v", instructionNumber, instruction.GetPositionInOriginalScript().String(), instruction.String())
+						return false
+					}
+					persistedInstructions[scheduledInstruction.GetUuid()] = enclavePlanInstruction
+					executor.enclavePlan.AppendInstruction(enclavePlanInstruction)
+				}
+				if err = executor.recordControlFlowRun(enclavePlanInstruction, scheduledInstruction.GetControlFlowBlocks(), controlFlowRunSequence); err != nil {
+					sendErrorAndFail(starlarkRunResponseLineStream, totalExecutionDuration, err, "An error occurred recording the run of instruction (number %d) at %v:\n%v", instructionNumber, instruction.GetPositionInOriginalScript().String(), instruction.String())
+					return false
+				}
+				controlFlowRunSequence++
+			}
+			return true
+		}
+
+		skipInstruction := func(index int) {
+			scheduledInstruction := instructionsSequence[index]
+			instruction := scheduledInstruction.GetInstruction()
+			starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromInstruction(instruction.GetCanonicalInstruction(true))
+			starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromInstructionResult(skippedControlFlowBranchOutput, time.Duration(0))
+		}
+
+		// executeRange executes the instructions in [start, end) which are all nested in the same `depth` control
+		// flow blocks. Nested blocks are run as many times as their control flow instruction decided at execution time
+		var executeRange func(start int, end int, depth int) bool
+		executeRange = func(start int, end int, depth int) bool {
+			index := start
+			for index < end {
+				controlFlowBlocks := instructionsSequence[index].GetControlFlowBlocks()
+				if len(controlFlowBlocks) <= depth {
+					if !executeInstruction(index) {
+						return false
+					}
+					index++
+					continue
+				}
+				controlFlowBlock := controlFlowBlocks[depth]
+				blockEnd := index + 1
+				for blockEnd < end && len(instructionsSequence[blockEnd].GetControlFlowBlocks()) > depth && instructionsSequence[blockEnd].GetControlFlowBlocks()[depth] == controlFlowBlock {
+					blockEnd++
 				}
-				executor.enclavePlan.AppendInstruction(enclavePlanInstruction)
+				if dryRun {
+					// the control flow instruction was not executed, all branches are listed once
+					if !executeRange(index, blockEnd, depth+1) {
+						return false
+					}
+					index = blockEnd
+					continue
+				}
+				numberOfIterations := controlFlowBlock.NumberOfIterations()
+				if numberOfIterations == 0 {
+					for skippedIndex := index; skippedIndex < blockEnd; skippedIndex++ {
+						skipInstruction(skippedIndex)
+					}
+				}
+				for iteration := 0; iteration < numberOfIterations; iteration++ {
+					if err := controlFlowBlock.PrepareIteration(iteration); err != nil {
+						sendErrorAndFail(starlarkRunResponseLineStream, totalExecutionDuration, err, "An error occurred preparing iteration %d of the '%s' branch of instruction '%s'", iteration, controlFlowBlock.GetBranch(), controlFlowBlock.GetControlFlowInstructionUuid())
+						return false
+					}
+					if !executeRange(index, blockEnd, depth+1) {
+						return false
+					}
+				}
+				index = blockEnd
 			}
+			return true
+		}
+
+		if !executeRange(0, len(instructionsSequence), 0) {
+			return
 		}
 
 		if !dryRun {
@@ -162,6 +262,7 @@ func (executor *StartosisExecutor) ExecuteInParallel(ctx context.Context, dryRun
 	executor.mutex.Lock()
 	starlarkRunResponseLineStream := make(chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine)
 	ctxWithParallelism := context.WithValue(ctx, startosis_constants.ParallelismParam, parallelism)
+	ctxWithParallelism = context.WithValue(ctxWithParallelism, startosis_constants.EnclavePlanReadyConditionsGetterParam, executor.getEnclavePlanReadyConditions)
 	go func() {
 		ctxWithParallelismAndCancel, cancelParallelismCtxFunc := context.WithCancel(ctxWithParallelism)
 		defer cancelParallelismCtxFunc()
@@ -201,7 +302,12 @@ func (executor *StartosisExecutor) ExecuteInParallel(ctx context.Context, dryRun
 
 			instruction := scheduledInstruction.GetInstruction()
 			// add the instruction into the current enclave plan
-			enclavePlanInstruction, err := scheduledInstruction.GetInstruction().GetPersistableAttributes().SetUuid(
+			enclavePlanInstructionBuilder, err := getEnclavePlanInstructionBuilder(scheduledInstruction)
+			if err != nil {
+				sendErrorAndFail(starlarkRunResponseLineStream, time.Duration(0), err, "An error occurred persisting instruction (number %d) at %v after it's been executed:\n%v", instructionNumber, instruction.GetPositionInOriginalScript().String(), instruction.String())
+				return
+			}
+			enclavePlanInstruction, err := enclavePlanInstructionBuilder.SetUuid(
 				string(scheduledInstruction.GetUuid()),
 			).SetReturnedValue(
 				executor.starlarkValueSerde.Serialize(scheduledInstruction.GetReturnedValue()),
@@ -257,7 +363,10 @@ func (executor *StartosisExecutor) ExecuteInParallel(ctx context.Context, dryRun
 						instructionOutput = &skippedInstructionOutput
 					} else {
 						startTime := time.Now()
-						instructionOutput, err = instruction.Execute(ctxWithParallelismAndCancel)
+						ctxWithOutputLineConsumer := context.WithValue(ctxWithParallelismAndCancel, startosis_constants.InstructionOutputLineConsumerParam, func(outputLine string) {
+							starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromInstructionOutputWithInstructionId(outputLine, instructionUuidStr)
+						})
+						instructionOutput, err = instruction.Execute(ctxWithOutputLineConsumer)
 						duration = time.Since(startTime)
 						instructionDurations.Store(instructionUuid, duration)
 					}
@@ -311,6 +420,184 @@ func (executor *StartosisExecutor) GetCurrentEnclavePLan() *enclave_plan_persist
 	return executor.enclavePlan
 }
 
+// ExportEnclavePlan packages the current enclave plan so that it can be replayed in another enclave with
+// ImportEnclavePlanRuntimeValues followed by a run of the exported plan's replay script.
+// Instructions only producing files artifacts are left out, as the files artifacts are copied to the other enclave
+// as they are. Control flow instructions are replaced by the runs of the instructions planned in their branches, in
+// the order they ran, each run referencing its own copy of the values the control flow instructions set for it.
+func (executor *StartosisExecutor) ExportEnclavePlan() (*enclave_plan_persistence.ExportedEnclavePlan, error) {
+	executor.mutex.Lock()
+	defer executor.mutex.Unlock()
+
+	enclavePlanInstructions := executor.enclavePlan.GeneratePlan()
+	instructionsByControlFlowInstruction := map[string][]*enclave_plan_persistence.EnclavePlanInstruction{}
+	for _, enclavePlanInstruction := range enclavePlanInstructions {
+		if enclavePlanInstruction.ControlFlowRuns == nil {
+			continue
+		}
+		controlFlowInstructionUuid := enclavePlanInstruction.ControlFlowRuns.ControlFlowInstructionUuid
+		instructionsByControlFlowInstruction[controlFlowInstructionUuid] = append(instructionsByControlFlowInstruction[controlFlowInstructionUuid], enclavePlanInstruction)
+	}
+
+	exportedEnclavePlan := enclave_plan_persistence.NewExportedEnclavePlan()
+	replayedFilesArtifactNames := map[string]bool{}
+	runtimeValueUuids := []string{}
+	exportInstruction := func(enclavePlanInstruction *enclave_plan_persistence.EnclavePlanInstruction) error {
+		exportedEnclavePlan.EnclavePlan.AppendInstruction(enclavePlanInstruction)
+		runtimeValueUuids = append(runtimeValueUuids, magic_string_helper.GetRuntimeValueUuidsFromString(enclavePlanInstruction.StarlarkCode)...)
+		for filesArtifactName := range enclavePlanInstruction.FilesArtifacts {
+			replayedFilesArtifactNames[filesArtifactName] = true
+		}
+		for _, serviceNameStr := range enclavePlanInstruction.ServiceNames {
+			serviceName := service.ServiceName(serviceNameStr)
+			runtimeValueUuid, found, err := executor.runtimeValueStore.GetValueAssociatedWithService(serviceName)
+			if err != nil {
+				return stacktrace.Propagate(err, "An error occurred getting the runtime value associated with service '%s'", serviceName)
+			}
+			if found {
+				exportedEnclavePlan.ServiceRuntimeValueUuids[serviceNameStr] = runtimeValueUuid
+			}
+		}
+		return nil
+	}
+
+	for _, enclavePlanInstruction := range enclavePlanInstructions {
+		if enclavePlanInstruction.ControlFlowRuns != nil {
+			// replayed in place of the outermost control flow instruction
+			continue
+		}
+		switch enclavePlanInstruction.Type {
+		case control_flow.IfBuiltinName, control_flow.ForEachBuiltinName:
+			unrolledInstructions, err := unrollControlFlowRuns(instructionsByControlFlowInstruction[enclavePlanInstruction.Uuid], exportedEnclavePlan.RuntimeValues)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred unrolling the runs of the instructions planned in control flow instruction '%s'", enclavePlanInstruction.Uuid)
+			}
+			for _, unrolledInstruction := range unrolledInstructions {
+				if isFilesArtifactOnlyInstruction(unrolledInstruction) {
+					continue
+				}
+				if err := exportInstruction(unrolledInstruction); err != nil {
+					return nil, stacktrace.Propagate(err, "An error occurred exporting instruction '%s'", unrolledInstruction.Uuid)
+				}
+			}
+			continue
+		}
+		if isFilesArtifactOnlyInstruction(enclavePlanInstruction) {
+			continue
+		}
+		if err := exportInstruction(enclavePlanInstruction.Clone()); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred exporting instruction '%s'", enclavePlanInstruction.Uuid)
+		}
+	}
+	for filesArtifactName := range replayedFilesArtifactNames {
+		exportedEnclavePlan.ReplayedFilesArtifactNames = append(exportedEnclavePlan.ReplayedFilesArtifactNames, filesArtifactName)
+	}
+	sort.Strings(exportedEnclavePlan.ReplayedFilesArtifactNames)
+
+	serviceRuntimeValueUuids := map[string]bool{}
+	for _, runtimeValueUuid := range exportedEnclavePlan.ServiceRuntimeValueUuids {
+		serviceRuntimeValueUuids[runtimeValueUuid] = true
+	}
+	for _, runtimeValueUuid := range runtimeValueUuids {
+		// service values get set again when the services are re-created, with their new IP addresses
+		if serviceRuntimeValueUuids[runtimeValueUuid] {
+			continue
+		}
+		if _, found := exportedEnclavePlan.RuntimeValues[runtimeValueUuid]; found {
+			continue
+		}
+		serializedValue, err := executor.runtimeValueStore.ExportValue(runtimeValueUuid)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred exporting runtime value '%s'", runtimeValueUuid)
+		}
+		exportedEnclavePlan.RuntimeValues[runtimeValueUuid] = serializedValue
+	}
+	return exportedEnclavePlan, nil
+}
+
+// ImportEnclavePlanRuntimeValues stores the runtime values of an exported enclave plan in this enclave, so that the
+// magic strings in the plan's Starlark code resolve when the plan is replayed here
+func (executor *StartosisExecutor) ImportEnclavePlanRuntimeValues(exportedEnclavePlan *enclave_plan_persistence.ExportedEnclavePlan) error {
+	executor.mutex.Lock()
+	defer executor.mutex.Unlock()
+
+	for serviceNameStr, runtimeValueUuid := range exportedEnclavePlan.ServiceRuntimeValueUuids {
+		if err := executor.runtimeValueStore.AssociateValueWithService(service.ServiceName(serviceNameStr), runtimeValueUuid); err != nil {
+			return stacktrace.Propagate(err, "An error occurred associating runtime value '%s' with service '%s'", runtimeValueUuid, serviceNameStr)
+		}
+	}
+	for runtimeValueUuid, serializedValue := range exportedEnclavePlan.RuntimeValues {
+		if err := executor.runtimeValueStore.ImportValue(runtimeValueUuid, serializedValue); err != nil {
+			return stacktrace.Propagate(err, "An error occurred importing runtime value '%s'", runtimeValueUuid)
+		}
+	}
+	return nil
+}
+
+// recordControlFlowRun records the values the control flow instructions the instruction is nested in set for the
+// current run, as they get overwritten by the next iteration
+func (executor *StartosisExecutor) recordControlFlowRun(enclavePlanInstruction *enclave_plan_persistence.EnclavePlanInstruction, controlFlowBlocks []*instructions_plan.ControlFlowBlock, sequence int) error {
+	if len(controlFlowBlocks) == 0 {
+		return nil
+	}
+	iterationRuntimeValues := map[string]map[string]string{}
+	for _, controlFlowBlock := range controlFlowBlocks {
+		for _, runtimeValueUuid := range controlFlowBlock.GetIterationRuntimeValueUuids() {
+			serializedValue, err := executor.runtimeValueStore.ExportValue(runtimeValueUuid)
+			if err != nil {
+				return stacktrace.Propagate(err, "An error occurred exporting runtime value '%s' set by control flow instruction '%s'", runtimeValueUuid, controlFlowBlock.GetControlFlowInstructionUuid())
+			}
+			iterationRuntimeValues[runtimeValueUuid] = serializedValue
+		}
+	}
+	enclavePlanInstruction.AddControlFlowRun(string(controlFlowBlocks[0].GetControlFlowInstructionUuid()), sequence, iterationRuntimeValues)
+	return nil
+}
+
+// unrollControlFlowRuns returns a copy of the instructions for each of their runs, in the order they ran. The runtime
+// values set by the control flow instructions are given a new UUID per run, and their values are added to
+// runtimeValues
+func unrollControlFlowRuns(nestedInstructions []*enclave_plan_persistence.EnclavePlanInstruction, runtimeValues map[string]map[string]string) ([]*enclave_plan_persistence.EnclavePlanInstruction, error) {
+	type instructionRun struct {
+		instruction *enclave_plan_persistence.EnclavePlanInstruction
+		run         *enclave_plan_persistence.ControlFlowRun
+	}
+	instructionRuns := []*instructionRun{}
+	for _, nestedInstruction := range nestedInstructions {
+		for _, run := range nestedInstruction.ControlFlowRuns.Runs {
+			instructionRuns = append(instructionRuns, &instructionRun{instruction: nestedInstruction, run: run})
+		}
+	}
+	sort.SliceStable(instructionRuns, func(i, j int) bool {
+		return instructionRuns[i].run.Sequence < instructionRuns[j].run.Sequence
+	})
+
+	unrolledInstructions := []*enclave_plan_persistence.EnclavePlanInstruction{}
+	for _, instructionRunObj := range instructionRuns {
+		unrolledInstruction := instructionRunObj.instruction.Clone()
+		unrolledInstruction.ControlFlowRuns = nil
+		for runtimeValueUuid, serializedValue := range instructionRunObj.run.IterationRuntimeValues {
+			runRuntimeValueUuid, err := uuid_generator.GenerateUUIDString()
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred generating a UUID for a run of runtime value '%s'", runtimeValueUuid)
+			}
+			unrolledInstruction.StarlarkCode = strings.ReplaceAll(unrolledInstruction.StarlarkCode, runtimeValueUuid, runRuntimeValueUuid)
+			runtimeValues[runRuntimeValueUuid] = serializedValue
+		}
+		unrolledInstructions = append(unrolledInstructions, unrolledInstruction)
+	}
+	return unrolledInstructions, nil
+}
+
+// isFilesArtifactOnlyInstruction returns true for the instructions whose only effect is producing files artifacts
+func isFilesArtifactOnlyInstruction(enclavePlanInstruction *enclave_plan_persistence.EnclavePlanInstruction) bool {
+	switch enclavePlanInstruction.Type {
+	case upload_files.UploadFilesBuiltinName, render_templates.RenderTemplatesBuiltinName, store_service_files.StoreServiceFilesBuiltinName:
+		return true
+	}
+	return false
+}
+
 // how should I ensure this terminates all running goroutines?
 func sendErrorAndFail(starlarkRunResponseLineStream chan<- *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, totalExecutionDuration time.Duration, err error, msg string, msgArgs ...interface{}) {
 	propagatedErr := stacktrace.Propagate(err, msg, msgArgs...)
@@ -318,3 +605,189 @@ func sendErrorAndFail(starlarkRunResponseLineStream chan<- *kurtosis_core_rpc_ap
 	starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromExecutionError(serializedError)
 	starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromRunFailureEventWithDuration(totalExecutionDuration)
 }
+
+// RecordServicesToResume records the services currently started, right before the enclave stop stops all of them, so
+// that ResumeServices can tell them apart from the services that had been stopped on purpose
+func (executor *StartosisExecutor) RecordServicesToResume(ctx context.Context, serviceNetwork service_network.ServiceNetwork) error {
+	executor.mutex.Lock()
+	defer executor.mutex.Unlock()
+
+	allServices, err := serviceNetwork.GetServices(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the services in the enclave")
+	}
+	startedServiceNames := []service.ServiceName{}
+	for _, serviceObj := range allServices {
+		if serviceObj.GetRegistration().GetStatus() == service.ServiceStatus_Started {
+			startedServiceNames = append(startedServiceNames, serviceObj.GetRegistration().GetName())
+		}
+	}
+	if err := executor.servicesToResumeRepository.Save(startedServiceNames); err != nil {
+		return stacktrace.Propagate(err, "An error occurred recording the services to resume")
+	}
+	return nil
+}
+
+// ResumeServices starts again the services that were started when the enclave got stopped, which is what an enclave
+// looks like after it was stopped and started again. Those are the services recorded by RecordServicesToResume or,
+// when the enclave was stopped without recording them (e.g. by the engine), the services still registered as started
+// whose containers aren't running. Services are started one at a time, in the order they appear in the enclave plan,
+// and each one must pass its ready conditions before the next one starts so that services find their dependencies in
+// the same state as on the first run. Services stopped on purpose (e.g. with plan.stop_service) are left alone.
+// Returns the names of the resumed services, in the order they were started.
+func (executor *StartosisExecutor) ResumeServices(ctx context.Context, serviceNetwork service_network.ServiceNetwork) ([]service.ServiceName, error) {
+	executor.mutex.Lock()
+	defer executor.mutex.Unlock()
+
+	recordedServicesToResume, areServicesToResumeRecorded, err := executor.servicesToResumeRepository.Get()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the recorded services to resume")
+	}
+	allServices, err := serviceNetwork.GetServices(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the services in the enclave")
+	}
+	servicesToResume := map[service.ServiceName]bool{}
+	isServiceRegisteredAsStarted := map[service.ServiceName]bool{}
+	for _, serviceObj := range allServices {
+		serviceName := serviceObj.GetRegistration().GetName()
+		isStarted := serviceObj.GetRegistration().GetStatus() == service.ServiceStatus_Started
+		if serviceObj.GetContainer() != nil && serviceObj.GetContainer().GetStatus() == container.ContainerStatus_Running {
+			continue
+		}
+		if areServicesToResumeRecorded && !recordedServicesToResume[serviceName] {
+			continue
+		}
+		if !areServicesToResumeRecorded && !isStarted {
+			continue
+		}
+		servicesToResume[serviceName] = true
+		isServiceRegisteredAsStarted[serviceName] = isStarted
+	}
+	if len(servicesToResume) == 0 {
+		if err := executor.servicesToResumeRepository.Delete(); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred clearing the recorded services to resume")
+		}
+		return []service.ServiceName{}, nil
+	}
+
+	orderedServiceNames := []service.ServiceName{}
+	isServiceOrdered := map[service.ServiceName]bool{}
+	for _, enclavePlanInstruction := range executor.enclavePlan.GeneratePlan() {
+		for _, serviceNameStr := range enclavePlanInstruction.ServiceNames {
+			serviceName := service.ServiceName(serviceNameStr)
+			if !servicesToResume[serviceName] || isServiceOrdered[serviceName] {
+				continue
+			}
+			orderedServiceNames = append(orderedServiceNames, serviceName)
+			isServiceOrdered[serviceName] = true
+		}
+	}
+	readyConditions, err := executor.getEnclavePlanReadyConditions()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the ready conditions of the services of the enclave plan")
+	}
+	// Services not in the enclave plan (e.g. added before the enclave plan was persisted) have no known dependencies
+	servicesNotInEnclavePlan := []service.ServiceName{}
+	for serviceName := range servicesToResume {
+		if !isServiceOrdered[serviceName] {
+			servicesNotInEnclavePlan = append(servicesNotInEnclavePlan, serviceName)
+		}
+	}
+	sort.Slice(servicesNotInEnclavePlan, func(i, j int) bool {
+		return servicesNotInEnclavePlan[i] < servicesNotInEnclavePlan[j]
+	})
+	orderedServiceNames = append(orderedServiceNames, servicesNotInEnclavePlan...)
+
+	for _, serviceName := range orderedServiceNames {
+		logrus.Infof("Resuming service '%v'", serviceName)
+		// A service still registered as started is marked as stopped first for the start to bring its existing
+		// container back instead of creating a new one
+		if isServiceRegisteredAsStarted[serviceName] {
+			if err := serviceNetwork.StopService(ctx, string(serviceName)); err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred marking service '%v' as stopped before resuming it", serviceName)
+			}
+		}
+		if err := serviceNetwork.StartService(ctx, string(serviceName)); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred starting service '%v'", serviceName)
+		}
+		readyCondition, found := readyConditions[serviceName]
+		if !found {
+			continue
+		}
+		resumedService, err := serviceNetwork.GetService(ctx, string(serviceName))
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting service '%v' after resuming it", serviceName)
+		}
+		if err := add_service.RunServiceReadinessCheck(ctx, serviceNetwork, executor.runtimeValueStore, serviceName, resumedService, readyCondition); err != nil {
+			return nil, stacktrace.Propagate(err, "Service '%v' was resumed but didn't pass its ready conditions", serviceName)
+		}
+	}
+	if err := executor.servicesToResumeRepository.Delete(); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred clearing the recorded services to resume")
+	}
+	return orderedServiceNames, nil
+}
+
+// getEnclavePlanInstructionBuilder returns the persistable attributes of the instruction along with the services and
+// files artifacts it consumes, which the instruction reports by updating a dependency graph of its own
+func getEnclavePlanInstructionBuilder(scheduledInstruction *instructions_plan.ScheduledInstruction) (*enclave_plan_persistence.EnclavePlanInstructionBuilder, error) {
+	instructionUuid := scheduledInstruction.GetUuid()
+	dependencyGraph := dependency_graph.NewInstructionDependencyGraph([]types.ScheduledInstructionUuid{instructionUuid})
+	if err := scheduledInstruction.GetInstruction().UpdateDependencyGraph(instructionUuid, dependencyGraph); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the services and files artifacts consumed by instruction '%v'", instructionUuid)
+	}
+	return scheduledInstruction.GetInstruction().GetPersistableAttributes().SetConsumedOutputs(
+		dependencyGraph.GetConsumedServices(instructionUuid),
+		dependencyGraph.GetConsumedFilesArtifacts(instructionUuid),
+	), nil
+}
+
+// GetServiceDependencies returns, by service name, the services each service added by the enclave plan depends on, see
+// getServiceDependenciesFromEnclavePlan
+func (executor *StartosisExecutor) GetServiceDependencies() map[service.ServiceName][]service.ServiceName {
+	executor.mutex.Lock()
+	defer executor.mutex.Unlock()
+	return getServiceDependenciesFromEnclavePlan(executor.enclavePlan.GeneratePlan())
+}
+
+// WaitForServiceReadiness runs again the ready conditions the service was added with, so that a service started again
+// outside a Starlark run can be waited for the same way add_service did. Services without ready conditions, or that
+// aren't in the enclave plan, are considered ready right away.
+func (executor *StartosisExecutor) WaitForServiceReadiness(ctx context.Context, serviceNetwork service_network.ServiceNetwork, serviceName service.ServiceName) error {
+	executor.mutex.Lock()
+	defer executor.mutex.Unlock()
+
+	readyConditions, err := executor.getEnclavePlanReadyConditions()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the ready conditions of the services of the enclave plan")
+	}
+	readyCondition, found := readyConditions[serviceName]
+	if !found {
+		return nil
+	}
+	serviceObj, err := serviceNetwork.GetService(ctx, string(serviceName))
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting service '%v'", serviceName)
+	}
+	if err := add_service.RunServiceReadinessCheck(ctx, serviceNetwork, executor.runtimeValueStore, serviceName, serviceObj, readyCondition); err != nil {
+		return stacktrace.Propagate(err, "Service '%v' didn't pass its ready conditions", serviceName)
+	}
+	return nil
+}
+
+// getEnclavePlanReadyConditions returns the ready conditions of the services of the enclave plan declaring any
+func (executor *StartosisExecutor) getEnclavePlanReadyConditions() (map[service.ServiceName]*service_config.ReadyCondition, error) {
+	readyConditions := map[service.ServiceName]*service_config.ReadyCondition{}
+	for _, enclavePlanInstruction := range executor.enclavePlan.GeneratePlan() {
+		instructionReadyConditions, err := add_service.GetReadyConditionsFromEnclavePlanInstruction(executor.starlarkValueSerde, enclavePlanInstruction)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the ready conditions declared by instruction '%v' of the enclave plan", enclavePlanInstruction.Uuid)
+		}
+		// a later instruction updating a service replaces the ready conditions declared by the former ones
+		for serviceName, readyCondition := range instructionReadyConditions {
+			readyConditions[serviceName] = readyCondition
+		}
+	}
+	return readyConditions, nil
+}
@@ -3,6 +3,7 @@ package startosis_engine
 import (
 	"context"
 	"errors"
+	"fmt"
 	"os"
 	"strings"
 	"testing"
@@ -13,10 +14,13 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/instructions_plan"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/control_flow"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/mock_instruction"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers/magic_string_helper"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_constants"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	bolt "go.etcd.io/bbolt"
@@ -47,7 +51,7 @@ func TestExecuteKurtosisInstructions_ExecuteForReal_Success(t *testing.T) {
 
 	dummySerde := shared_helpers.NewDummyStarlarkValueSerDeForTest()
 
-	runtimeValueStore, createRuntimeValueStoreErr := runtime_value_store.CreateRuntimeValueStore(dummySerde, enclaveDb)
+	runtimeValueStore, createRuntimeValueStoreErr := runtime_value_store.CreateRuntimeValueStore(dummySerde, enclaveDb, nil)
 	require.NoError(t, createRuntimeValueStoreErr)
 
 	executor := NewStartosisExecutor(nil, runtimeValueStore, enclave_plan_persistence.NewEnclavePlan(), enclaveDb)
@@ -91,7 +95,7 @@ func TestExecuteKurtosisInstructions_ExecuteForReal_FailureHalfWay(t *testing.T)
 
 	dummySerde := shared_helpers.NewDummyStarlarkValueSerDeForTest()
 
-	runtimeValueStore, err := runtime_value_store.CreateRuntimeValueStore(dummySerde, enclaveDb)
+	runtimeValueStore, err := runtime_value_store.CreateRuntimeValueStore(dummySerde, enclaveDb, nil)
 	require.NoError(t, err)
 
 	executor := NewStartosisExecutor(nil, runtimeValueStore, enclave_plan_persistence.NewEnclavePlan(), enclaveDb)
@@ -136,7 +140,7 @@ func TestExecuteKurtosisInstructions_DoDryRun(t *testing.T) {
 
 	dummySerde := shared_helpers.NewDummyStarlarkValueSerDeForTest()
 
-	runtimeValueStore, createRuntimeValueStoreErr := runtime_value_store.CreateRuntimeValueStore(dummySerde, enclaveDb)
+	runtimeValueStore, createRuntimeValueStoreErr := runtime_value_store.CreateRuntimeValueStore(dummySerde, enclaveDb, nil)
 	require.NoError(t, createRuntimeValueStoreErr)
 
 	executor := NewStartosisExecutor(nil, runtimeValueStore, enclave_plan_persistence.NewEnclavePlan(), enclaveDb)
@@ -165,10 +169,209 @@ func TestExecuteKurtosisInstructions_DoDryRun(t *testing.T) {
 	require.Equal(t, serializedInstruction, expectedSerializedInstructions)
 }
 
+func TestExecuteKurtosisInstructions_StreamsInstructionOutput(t *testing.T) {
+	enclaveDb := getEnclaveDBForTest(t)
+
+	dummySerde := shared_helpers.NewDummyStarlarkValueSerDeForTest()
+
+	runtimeValueStore, createRuntimeValueStoreErr := runtime_value_store.CreateRuntimeValueStore(dummySerde, enclaveDb, nil)
+	require.NoError(t, createRuntimeValueStoreErr)
+
+	executor := NewStartosisExecutor(nil, runtimeValueStore, enclave_plan_persistence.NewEnclavePlan(), enclaveDb)
+
+	instructionsPlan := instructions_plan.NewInstructionsPlan()
+	instruction := mock_instruction.NewMockKurtosisInstruction(t)
+	instruction.EXPECT().GetCanonicalInstruction(mock.Anything).Maybe().Return(binding_constructors.NewStarlarkInstruction(
+		dummyPosition.ToAPIType(), "instruction1", "instruction1()", noInstructionArgsForTesting, isSkipped, "description1"))
+	instruction.EXPECT().GetPersistableAttributes().Maybe().Return(
+		enclave_plan_persistence.NewEnclavePlanInstructionBuilder().SetUuid(uuid.New().String()).SetType("instruction1").SetStarlarkCode("instruction1()").SetReturnedValue("None"),
+		nil,
+	)
+	instruction.EXPECT().UpdateDependencyGraph(mock.Anything, mock.Anything).Maybe().Return(nil)
+	instruction.EXPECT().Execute(mock.Anything).RunAndReturn(func(ctx context.Context) (*string, error) {
+		outputLineConsumer, ok := ctx.Value(startosis_constants.InstructionOutputLineConsumerParam).(func(string))
+		require.True(t, ok)
+		outputLineConsumer("migrating table 1")
+		outputLineConsumer("migrating table 2")
+		return nil, nil
+	})
+	require.NoError(t, instructionsPlan.AddInstruction(instruction, starlark.None))
+
+	scheduledInstructions, err := instructionsPlan.GeneratePlan()
+	require.Nil(t, err)
+
+	var outputLines []string
+	for executionResponseLine := range executor.Execute(context.Background(), executeForReal, noParallelism, 0, scheduledInstructions, noScriptOutputObject) {
+		require.Nil(t, executionResponseLine.GetError())
+		if executionResponseLine.GetInstructionOutput() != nil {
+			outputLines = append(outputLines, executionResponseLine.GetInstructionOutput().GetOutputLine())
+		}
+	}
+	require.Equal(t, []string{"migrating table 1", "migrating table 2"}, outputLines)
+}
+
+func TestExecuteKurtosisInstructions_ControlFlowBranches(t *testing.T) {
+	enclaveDb := getEnclaveDBForTest(t)
+
+	dummySerde := shared_helpers.NewDummyStarlarkValueSerDeForTest()
+
+	runtimeValueStore, err := runtime_value_store.CreateRuntimeValueStore(dummySerde, enclaveDb, nil)
+	require.NoError(t, err)
+
+	executor := NewStartosisExecutor(nil, runtimeValueStore, enclave_plan_persistence.NewEnclavePlan(), enclaveDb)
+
+	controlFlow := &controlFlowForTest{
+		iterationsPerBranch: map[string]int{"then": 1, "else": 0},
+		preparedIterations:  []int{},
+	}
+	ifInstruction := createMockInstruction(t, "if_", executeSuccessfully, "if")
+	thenInstruction := createMockInstruction(t, "then", executeSuccessfully, "then")
+	elseInstruction := createMockInstruction(t, "else", executeSuccessfully, "else")
+	instructionsPlan := instructions_plan.NewInstructionsPlan()
+	require.NoError(t, instructionsPlan.AddInstruction(ifInstruction, starlark.None))
+	ifInstructionUuid, err := instructionsPlan.GetLastInstructionUuid()
+	require.NoError(t, err)
+	require.NoError(t, instructionsPlan.OpenControlFlowBlock(ifInstructionUuid, controlFlow, "then"))
+	require.NoError(t, instructionsPlan.AddInstruction(thenInstruction, starlark.None))
+	require.NoError(t, instructionsPlan.CloseControlFlowBlock())
+	require.NoError(t, instructionsPlan.OpenControlFlowBlock(ifInstructionUuid, controlFlow, "else"))
+	require.NoError(t, instructionsPlan.AddInstruction(elseInstruction, starlark.None))
+	require.NoError(t, instructionsPlan.CloseControlFlowBlock())
+
+	_, _, executionErr := executeSynchronously(t, executor, executeForReal, instructionsPlan)
+	require.Nil(t, executionErr)
+
+	ifInstruction.AssertNumberOfCalls(t, "Execute", 1)
+	thenInstruction.AssertNumberOfCalls(t, "Execute", 1)
+	elseInstruction.AssertNumberOfCalls(t, "Execute", 0)
+	elseInstruction.AssertCalled(t, "GetCanonicalInstruction", true)
+	require.Equal(t, 2, executor.enclavePlan.Size()) // the skipped branch is not persisted
+}
+
+func TestExecuteKurtosisInstructions_ControlFlowLoop(t *testing.T) {
+	enclaveDb := getEnclaveDBForTest(t)
+
+	dummySerde := shared_helpers.NewDummyStarlarkValueSerDeForTest()
+
+	runtimeValueStore, err := runtime_value_store.CreateRuntimeValueStore(dummySerde, enclaveDb, nil)
+	require.NoError(t, err)
+
+	executor := NewStartosisExecutor(nil, runtimeValueStore, enclave_plan_persistence.NewEnclavePlan(), enclaveDb)
+
+	controlFlow := &controlFlowForTest{
+		iterationsPerBranch: map[string]int{"body": 3},
+		preparedIterations:  []int{},
+	}
+	forEachInstruction := createMockInstruction(t, "for_each", executeSuccessfully, "for_each")
+	bodyInstruction := createMockInstruction(t, "body", executeSuccessfully, "body")
+	lastInstruction := createMockInstruction(t, "last", executeSuccessfully, "last")
+	instructionsPlan := instructions_plan.NewInstructionsPlan()
+	require.NoError(t, instructionsPlan.AddInstruction(forEachInstruction, starlark.None))
+	forEachInstructionUuid, err := instructionsPlan.GetLastInstructionUuid()
+	require.NoError(t, err)
+	require.NoError(t, instructionsPlan.OpenControlFlowBlock(forEachInstructionUuid, controlFlow, "body"))
+	require.NoError(t, instructionsPlan.AddInstruction(bodyInstruction, starlark.None))
+	require.NoError(t, instructionsPlan.CloseControlFlowBlock())
+	require.NoError(t, instructionsPlan.AddInstruction(lastInstruction, starlark.None))
+	require.True(t, instructionsPlan.HasControlFlow())
+
+	_, _, executionErr := executeSynchronously(t, executor, executeForReal, instructionsPlan)
+	require.Nil(t, executionErr)
+
+	forEachInstruction.AssertNumberOfCalls(t, "Execute", 1)
+	bodyInstruction.AssertNumberOfCalls(t, "Execute", 3)
+	lastInstruction.AssertNumberOfCalls(t, "Execute", 1)
+	require.Equal(t, []int{0, 1, 2}, controlFlow.preparedIterations)
+	require.Equal(t, 3, executor.enclavePlan.Size()) // the body is persisted once
+}
+
+func TestExportEnclavePlan_UnrollsControlFlowLoop(t *testing.T) {
+	enclaveDb := getEnclaveDBForTest(t)
+
+	dummySerde := shared_helpers.NewDummyStarlarkValueSerDeForTest()
+
+	runtimeValueStore, err := runtime_value_store.CreateRuntimeValueStore(dummySerde, enclaveDb, nil)
+	require.NoError(t, err)
+	itemUuid, err := runtimeValueStore.CreateValue()
+	require.NoError(t, err)
+
+	executor := NewStartosisExecutor(nil, runtimeValueStore, enclave_plan_persistence.NewEnclavePlan(), enclaveDb)
+
+	controlFlow := &controlFlowForTest{
+		iterationsPerBranch:        map[string]int{"body": 2},
+		preparedIterations:         []int{},
+		iterationRuntimeValueUuids: []string{itemUuid},
+		runtimeValueStore:          runtimeValueStore,
+	}
+	itemMagicString := fmt.Sprintf(magic_string_helper.RuntimeValueReplacementPlaceholderFormat, itemUuid, "item")
+	forEachInstruction := createMockInstruction(t, control_flow.ForEachBuiltinName, executeSuccessfully, "for_each")
+	bodyInstruction := createMockInstructionWithStarlarkCode(t, "body", executeSuccessfully, "body", fmt.Sprintf("body(item=\"%s\")", itemMagicString))
+	lastInstruction := createMockInstruction(t, "last", executeSuccessfully, "last")
+	instructionsPlan := instructions_plan.NewInstructionsPlan()
+	require.NoError(t, instructionsPlan.AddInstruction(forEachInstruction, starlark.None))
+	forEachInstructionUuid, err := instructionsPlan.GetLastInstructionUuid()
+	require.NoError(t, err)
+	require.NoError(t, instructionsPlan.OpenControlFlowBlock(forEachInstructionUuid, controlFlow, "body"))
+	require.NoError(t, instructionsPlan.AddInstruction(bodyInstruction, starlark.None))
+	require.NoError(t, instructionsPlan.CloseControlFlowBlock())
+	require.NoError(t, instructionsPlan.AddInstruction(lastInstruction, starlark.None))
+
+	_, _, executionErr := executeSynchronously(t, executor, executeForReal, instructionsPlan)
+	require.Nil(t, executionErr)
+
+	exportedEnclavePlan, err := executor.ExportEnclavePlan()
+	require.NoError(t, err)
+	exportedInstructions := exportedEnclavePlan.EnclavePlan.GeneratePlan()
+	require.Len(t, exportedInstructions, 3) // the loop is replaced by the two runs of its body
+	for iteration, exportedInstruction := range exportedInstructions[:2] {
+		require.Equal(t, "body", exportedInstruction.Type)
+		require.Nil(t, exportedInstruction.ControlFlowRuns)
+		runtimeValueUuids := magic_string_helper.GetRuntimeValueUuidsFromString(exportedInstruction.StarlarkCode)
+		require.Len(t, runtimeValueUuids, 1)
+		require.NotEqual(t, itemUuid, runtimeValueUuids[0])
+		require.Equal(t, map[string]string{"item": fmt.Sprint(iteration)}, exportedEnclavePlan.RuntimeValues[runtimeValueUuids[0]])
+	}
+	require.Equal(t, "last", exportedInstructions[2].Type)
+	require.NotContains(t, exportedEnclavePlan.RuntimeValues, itemUuid)
+}
+
+type controlFlowForTest struct {
+	iterationsPerBranch        map[string]int
+	preparedIterations         []int
+	iterationRuntimeValueUuids []string
+
+	// when set, PrepareIteration sets the iteration number as the "item" field of the iteration runtime values
+	runtimeValueStore *runtime_value_store.RuntimeValueStore
+}
+
+func (controlFlow *controlFlowForTest) NumberOfIterations(branch string) int {
+	return controlFlow.iterationsPerBranch[branch]
+}
+
+func (controlFlow *controlFlowForTest) PrepareIteration(_ string, iteration int) error {
+	controlFlow.preparedIterations = append(controlFlow.preparedIterations, iteration)
+	if controlFlow.runtimeValueStore == nil {
+		return nil
+	}
+	for _, runtimeValueUuid := range controlFlow.iterationRuntimeValueUuids {
+		if err := controlFlow.runtimeValueStore.SetValue(runtimeValueUuid, map[string]starlark.Comparable{"item": starlark.MakeInt(iteration)}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (controlFlow *controlFlowForTest) GetIterationRuntimeValueUuids(_ string) []string {
+	return controlFlow.iterationRuntimeValueUuids
+}
+
 func createMockInstruction(t *testing.T, instructionName string, executeSuccessfully bool, description string) *mock_instruction.MockKurtosisInstruction {
+	return createMockInstructionWithStarlarkCode(t, instructionName, executeSuccessfully, description, instructionName+"()")
+}
+
+func createMockInstructionWithStarlarkCode(t *testing.T, instructionName string, executeSuccessfully bool, description string, stringifiedInstruction string) *mock_instruction.MockKurtosisInstruction {
 	instruction := mock_instruction.NewMockKurtosisInstruction(t)
 
-	stringifiedInstruction := instructionName + "()"
 	canonicalInstruction := binding_constructors.NewStarlarkInstruction(
 		dummyPosition.ToAPIType(), instructionName, stringifiedInstruction, noInstructionArgsForTesting, isSkipped, description)
 	instruction.EXPECT().GetCanonicalInstruction(mock.Anything).Maybe().Return(canonicalInstruction)
@@ -178,6 +381,7 @@ func createMockInstruction(t *testing.T, instructionName string, executeSuccessf
 		enclave_plan_persistence.NewEnclavePlanInstructionBuilder().SetUuid(uuid.New().String()).SetType(instructionName).SetStarlarkCode(stringifiedInstruction).SetReturnedValue("None"),
 		nil,
 	)
+	instruction.EXPECT().UpdateDependencyGraph(mock.Anything, mock.Anything).Maybe().Return(nil)
 
 	if executeSuccessfully {
 		instruction.EXPECT().Execute(mock.Anything).Maybe().Return(nil, nil)
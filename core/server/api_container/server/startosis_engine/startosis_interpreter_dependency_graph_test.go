@@ -40,7 +40,7 @@ func (suite *StartosisIntepreterDependencyGraphTestSuite) SetupTest() {
 	dummySerde := shared_helpers.NewDummyStarlarkValueSerDeForTest()
 
 	// mock runtime value store
-	runtimeValueStore, err := runtime_value_store.CreateRuntimeValueStore(dummySerde, enclaveDb)
+	runtimeValueStore, err := runtime_value_store.CreateRuntimeValueStore(dummySerde, enclaveDb, nil)
 	require.NoError(suite.T(), err)
 	suite.runtimeValueStore = runtimeValueStore
 
@@ -1780,3 +1780,81 @@ func (suite *StartosisIntepreterDependencyGraphTestSuite) TestStoreServiceFilesD
 
 	require.Equal(suite.T(), expectedDependencyGraph, instructionsDependencyGraph)
 }
+
+func (suite *StartosisIntepreterDependencyGraphTestSuite) TestInstructionsInControlFlowBranchesDependOnControlFlowInstruction() {
+	script := `def run(plan):
+	config = ServiceConfig(
+		image = "ubuntu",
+	)
+	plan.add_service(name = "serviceA", config = config)
+
+	exec_result = plan.exec(
+		service_name = "serviceA",
+		recipe = ExecRecipe(
+			command = ["ls"],
+		),
+	)
+
+	def on_success():
+		plan.exec(service_name = "serviceA", recipe = ExecRecipe(command = ["echo", "success"]))
+
+	def on_failure():
+		plan.exec(service_name = "serviceA", recipe = ExecRecipe(command = ["echo", "failure"]))
+
+	plan.if_(condition = exec_result["code"], assertion = "==", target_value = 0, then = on_success, else_ = on_failure)
+
+	def on_file(item):
+		plan.exec(service_name = "serviceA", recipe = ExecRecipe(command = ["cat", item]))
+
+	plan.for_each(items = exec_result["output"], body = on_file)
+`
+	// if_ (3) and for_each (6) are control flow instructions consuming the exec (2) result
+	// the instructions in their branches (4, 5, 7) depend on them, on top of the service they exec into
+	expectedDependencyGraph := map[types.ScheduledInstructionUuid][]types.ScheduledInstructionUuid{
+		types.ScheduledInstructionUuid("1"): {},
+		types.ScheduledInstructionUuid("2"): {
+			types.ScheduledInstructionUuid("1"),
+		},
+		types.ScheduledInstructionUuid("3"): {
+			types.ScheduledInstructionUuid("2"),
+		},
+		types.ScheduledInstructionUuid("4"): {
+			types.ScheduledInstructionUuid("2"),
+			types.ScheduledInstructionUuid("3"),
+		},
+		types.ScheduledInstructionUuid("5"): {
+			types.ScheduledInstructionUuid("3"),
+			types.ScheduledInstructionUuid("4"),
+		},
+		types.ScheduledInstructionUuid("6"): {
+			types.ScheduledInstructionUuid("2"),
+		},
+		types.ScheduledInstructionUuid("7"): {
+			types.ScheduledInstructionUuid("5"),
+			types.ScheduledInstructionUuid("6"),
+		},
+	}
+
+	inputArgs := `{}`
+	_, instructionsPlan, interpretationError := suite.interpreter.Interpret(
+		context.Background(),
+		startosis_constants.PackageIdPlaceholderForStandaloneScript,
+		useDefaultMainFunctionName,
+		noPackageReplaceOptions,
+		startosis_constants.PlaceHolderMainFileForPlaceStandAloneScript,
+		script,
+		inputArgs,
+		defaultNonBlockingMode,
+		emptyEnclaveComponents,
+		emptyInstructionsPlanMask,
+		image_download_mode.ImageDownloadMode_Always,
+		instructions_plan.NewInstructionsPlanForDependencyGraphTests(),
+	)
+	require.Nil(suite.T(), interpretationError)
+	require.True(suite.T(), instructionsPlan.HasControlFlow())
+
+	instructionsDependencyGraph, startosisInterpretationError := instructionsPlan.GenerateInstructionsDependencyGraph()
+	require.Nil(suite.T(), startosisInterpretationError)
+
+	require.Equal(suite.T(), expectedDependencyGraph, instructionsDependencyGraph)
+}
@@ -63,7 +63,7 @@ func (suite *StartosisInterpreterIdempotentTestSuite) SetupTest() {
 	}
 	starlarkValueSerde := kurtosis_types.NewStarlarkValueSerde(thread, starlarkEnv)
 
-	runtimeValueStore, err := runtime_value_store.CreateRuntimeValueStore(starlarkValueSerde, enclaveDb)
+	runtimeValueStore, err := runtime_value_store.CreateRuntimeValueStore(starlarkValueSerde, enclaveDb, nil)
 	require.NoError(suite.T(), err)
 
 	interpretationTimeValueStore, err := interpretation_time_value_store.CreateInterpretationTimeValueStore(enclaveDb, starlarkValueSerde)
@@ -6,6 +6,7 @@ import (
 	"net"
 	"testing"
 
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
@@ -18,6 +19,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_constants"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages/mock_package_content_provider"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
@@ -45,7 +47,7 @@ func (suite *StartosisIntepreterPlanYamlGeneratorTestSuite) SetupTest() {
 	dummySerde := shared_helpers.NewDummyStarlarkValueSerDeForTest()
 
 	// mock runtime value store
-	runtimeValueStore, err := runtime_value_store.CreateRuntimeValueStore(dummySerde, enclaveDb)
+	runtimeValueStore, err := runtime_value_store.CreateRuntimeValueStore(dummySerde, enclaveDb, nil)
 	require.NoError(suite.T(), err)
 	suite.runtimeValueStore = runtimeValueStore
 
@@ -865,3 +867,164 @@ images:
 `
 	require.Equal(suite.T(), expectedYaml, planYaml)
 }
+
+func (suite *StartosisIntepreterPlanYamlGeneratorTestSuite) TestControlFlowsAreConditional() {
+	script := `def run(plan, site_files_artifact):
+	plan.add_service(name = "web", config = ServiceConfig(image = "nginx:latest", files = {"/usr/share/nginx/html": site_files_artifact}))
+	result = plan.exec(service_name = "web", recipe = ExecRecipe(command = ["ls", "/data"]), description = "List data")
+
+	def with_cache():
+		plan.add_service(name = "db", config = ServiceConfig(image = "redis:latest"))
+
+	def without_cache():
+		plan.add_service(name = "db", config = ServiceConfig(image = "postgres:latest"))
+
+	plan.if_(condition = result["code"], assertion = "==", target_value = 0, then = with_cache, else_ = without_cache)
+
+	def load_file(item):
+		plan.exec(service_name = "db", recipe = ExecRecipe(command = ["load", item]), description = "Load file")
+
+	plan.for_each(items = result["output"], body = load_file)
+`
+	inputArgs := `{"site_files_artifact": "site-files"}`
+	_, instructionsPlan, interpretationError := suite.interpreter.Interpret(
+		context.Background(),
+		startosis_constants.PackageIdPlaceholderForStandaloneScript,
+		useDefaultMainFunctionName,
+		noPackageReplaceOptions,
+		startosis_constants.PlaceHolderMainFileForPlaceStandAloneScript,
+		script,
+		inputArgs,
+		defaultNonBlockingMode,
+		emptyEnclaveComponents,
+		emptyInstructionsPlanMask,
+		image_download_mode.ImageDownloadMode_Always,
+		instructions_plan.NewInstructionsPlan(),
+	)
+	require.Nil(suite.T(), interpretationError)
+	require.Equal(suite.T(), 7, instructionsPlan.Size())
+
+	planYaml, err := instructionsPlan.GenerateYaml(plan_yaml.CreateEmptyPlan(startosis_constants.PackageIdPlaceholderForStandaloneScript))
+	require.NoError(suite.T(), err)
+
+	expectedYaml := `packageId: DEFAULT_PACKAGE_ID_FOR_SCRIPT
+services:
+- uuid: "1"
+  name: web
+  image:
+    name: nginx:latest
+  files:
+  - mountPath: /usr/share/nginx/html
+    filesArtifacts:
+    - uuid: "2"
+      name: site-files
+- uuid: "5"
+  name: db
+  image:
+    name: redis:latest
+  conditionalOn:
+  - controlFlowUuid: "4"
+    branch: then
+- uuid: "6"
+  name: db
+  image:
+    name: postgres:latest
+  conditionalOn:
+  - controlFlowUuid: "4"
+    branch: else
+filesArtifacts:
+- uuid: "2"
+  name: site-files
+tasks:
+- uuid: "3"
+  name: List data
+  taskType: exec
+  command:
+  - ls
+  - /data
+  serviceName: web
+  acceptableCodes:
+  - 0
+- uuid: "8"
+  name: Load file
+  taskType: exec
+  command:
+  - load
+  - '{{ kurtosis.7.item }}'
+  serviceName: db
+  acceptableCodes:
+  - 0
+  conditionalOn:
+  - controlFlowUuid: "7"
+    branch: body
+controlFlows:
+- uuid: "4"
+  type: if
+  condition: '{{ kurtosis.3.code }} == 0'
+- uuid: "7"
+  type: for_each
+  items: '{{ kurtosis.3.output }}'
+images:
+- nginx:latest
+- postgres:latest
+- redis:latest
+`
+	require.Equal(suite.T(), expectedYaml, planYaml)
+}
+
+func (suite *StartosisIntepreterPlanYamlGeneratorTestSuite) TestControlFlowBranchesAreValidatedSeparately() {
+	script := `def run(plan, site_files_artifact):
+	plan.add_service(name = "web", config = ServiceConfig(image = "nginx:latest", files = {"/usr/share/nginx/html": site_files_artifact}))
+	result = plan.exec(service_name = "web", recipe = ExecRecipe(command = ["ls", "/data"]))
+
+	def with_cache():
+		plan.add_service(name = "db", config = ServiceConfig(image = "redis:latest"))
+
+	def without_cache():
+		plan.add_service(name = "db", config = ServiceConfig(image = "postgres:latest"))
+
+	plan.if_(condition = result["code"], assertion = "==", target_value = 0, then = with_cache, else_ = without_cache)
+	plan.exec(service_name = "db", recipe = ExecRecipe(command = ["ls"]))
+`
+	inputArgs := `{"site_files_artifact": "site-files"}`
+	_, instructionsPlan, interpretationError := suite.interpreter.Interpret(
+		context.Background(),
+		startosis_constants.PackageIdPlaceholderForStandaloneScript,
+		useDefaultMainFunctionName,
+		noPackageReplaceOptions,
+		startosis_constants.PlaceHolderMainFileForPlaceStandAloneScript,
+		script,
+		inputArgs,
+		defaultNonBlockingMode,
+		emptyEnclaveComponents,
+		emptyInstructionsPlanMask,
+		image_download_mode.ImageDownloadMode_Always,
+		instructions_plan.NewInstructionsPlan(),
+	)
+	require.Nil(suite.T(), interpretationError)
+
+	instructionsSequence, planErr := instructionsPlan.GeneratePlan()
+	require.Nil(suite.T(), planErr)
+
+	validatorEnvironment := startosis_validator.NewValidatorEnvironment(
+		map[service.ServiceName]bool{},
+		map[string]bool{"site-files": true},
+		map[service.ServiceName][]string{},
+		0,
+		0,
+		false,
+		image_download_mode.ImageDownloadMode_Always,
+		nil,
+		map[service.ServiceName]*service_network.ServiceResourceClaim{},
+		map[service.ServiceName]string{},
+	)
+	// each branch adds the db service against its own fork, and the service exists after the if_
+	starlarkRunResponseLineStream := make(chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, len(instructionsSequence))
+	isValidationFailure := (&StartosisValidator{}).validateAndUpdateEnvironment(instructionsSequence, validatorEnvironment, starlarkRunResponseLineStream)
+	close(starlarkRunResponseLineStream)
+	for responseLine := range starlarkRunResponseLineStream {
+		require.Nil(suite.T(), responseLine.GetError(), "Unexpected validation error: %v", responseLine)
+	}
+	require.False(suite.T(), isValidationFailure)
+	require.Equal(suite.T(), startosis_validator.ComponentCreatedOrUpdatedDuringPackageRun, validatorEnvironment.DoesServiceNameExist("db"))
+}
@@ -75,7 +75,7 @@ func (suite *StartosisInterpreterTestSuite) SetupTest() {
 
 	dummySerde := shared_helpers.NewDummyStarlarkValueSerDeForTest()
 
-	runtimeValueStore, err := runtime_value_store.CreateRuntimeValueStore(dummySerde, enclaveDb)
+	runtimeValueStore, err := runtime_value_store.CreateRuntimeValueStore(dummySerde, enclaveDb, nil)
 	require.NoError(suite.T(), err)
 	suite.runtimeValueStore = runtimeValueStore
 	suite.serviceNetwork = service_network.NewMockServiceNetwork(suite.T())
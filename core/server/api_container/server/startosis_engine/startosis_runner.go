@@ -8,6 +8,9 @@ import (
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_structure"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/instructions_plan"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/instructions_plan/resolver"
@@ -183,6 +186,11 @@ func (runner *StartosisRunner) Run(
 			startingExecutionMsg, defaultCurrentStepNumber, totalNumberOfInstructions, ExecutionInstructionId)
 		starlarkRunResponseLines <- progressInfo
 
+		if shouldExecuteInParallel && instructionsPlan.HasControlFlow() {
+			// branches selected at execution time can't be scheduled ahead of time from the dependency graph
+			logrus.Infof("The plan contains instructions nested in control flow instructions, they can't be executed in parallel")
+			shouldExecuteInParallel = false
+		}
 		var executionResponseLinesChan <-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine
 		if shouldExecuteInParallel {
 			logrus.Infof("Executing Kurtosis instructions in parallel with parallelism: %d", parallelism)
@@ -202,6 +210,46 @@ func (runner *StartosisRunner) Run(
 	return starlarkRunResponseLines
 }
 
+// ResumeServices starts again the services of the enclave that should be running but aren't, see
+// StartosisExecutor.ResumeServices
+func (runner *StartosisRunner) ResumeServices(ctx context.Context, serviceNetwork service_network.ServiceNetwork) ([]service.ServiceName, error) {
+	runner.mutex.Lock()
+	defer runner.mutex.Unlock()
+	return runner.startosisExecutor.ResumeServices(ctx, serviceNetwork)
+}
+
+func (runner *StartosisRunner) GetServiceDependencies() map[service.ServiceName][]service.ServiceName {
+	runner.mutex.Lock()
+	defer runner.mutex.Unlock()
+	return runner.startosisExecutor.GetServiceDependencies()
+}
+
+func (runner *StartosisRunner) WaitForServiceReadiness(ctx context.Context, serviceNetwork service_network.ServiceNetwork, serviceName service.ServiceName) error {
+	runner.mutex.Lock()
+	defer runner.mutex.Unlock()
+	return runner.startosisExecutor.WaitForServiceReadiness(ctx, serviceNetwork, serviceName)
+}
+
+func (runner *StartosisRunner) ExportEnclavePlan() (*enclave_plan_persistence.ExportedEnclavePlan, error) {
+	runner.mutex.Lock()
+	defer runner.mutex.Unlock()
+	return runner.startosisExecutor.ExportEnclavePlan()
+}
+
+func (runner *StartosisRunner) ImportEnclavePlanRuntimeValues(exportedEnclavePlan *enclave_plan_persistence.ExportedEnclavePlan) error {
+	runner.mutex.Lock()
+	defer runner.mutex.Unlock()
+	return runner.startosisExecutor.ImportEnclavePlanRuntimeValues(exportedEnclavePlan)
+}
+
+// RecordServicesToResume records the services of the enclave currently started, see
+// StartosisExecutor.RecordServicesToResume
+func (runner *StartosisRunner) RecordServicesToResume(ctx context.Context, serviceNetwork service_network.ServiceNetwork) error {
+	runner.mutex.Lock()
+	defer runner.mutex.Unlock()
+	return runner.startosisExecutor.RecordServicesToResume(ctx, serviceNetwork)
+}
+
 func forwardKurtosisResponseLineChannelUntilSourceIsClosed(sourceChan <-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, destChan chan<- *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine) (bool, bool) {
 	isSuccessful := false
 	isStarlarkRunFinished := false
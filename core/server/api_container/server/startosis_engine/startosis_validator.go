@@ -95,6 +95,30 @@ func (validator *StartosisValidator) Validate(ctx context.Context, instructionsS
 			}
 		}
 
+		enclaveResourceQuota, err := validator.serviceNetwork.GetEnclaveResourceQuota(ctx)
+		if err != nil {
+			wrappedValidationError := startosis_errors.WrapWithValidationError(err, "Couldn't create validator environment as we ran into errors fetching the resource quota of the enclave")
+			starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromValidationError(wrappedValidationError.ToAPIType())
+			starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromRunFailureEvent()
+			return
+		}
+
+		serviceResourceClaims, err := validator.serviceNetwork.GetServiceResourceClaims()
+		if err != nil {
+			wrappedValidationError := startosis_errors.WrapWithValidationError(err, "Couldn't create validator environment as we ran into errors fetching the resources claimed by the existing services")
+			starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromValidationError(wrappedValidationError.ToAPIType())
+			starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromRunFailureEvent()
+			return
+		}
+
+		serviceReplicaSetNames, err := getServiceNameToReplicaSetNameMap(serviceNames, validator.serviceNetwork)
+		if err != nil {
+			wrappedValidationError := startosis_errors.WrapWithValidationError(err, "Couldn't create validator environment as we ran into errors fetching the replica sets of the existing services")
+			starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromValidationError(wrappedValidationError.ToAPIType())
+			starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromRunFailureEvent()
+			return
+		}
+
 		environment := startosis_validator.NewValidatorEnvironment(
 			serviceNames,
 			validator.fileArtifactStore.ListFiles(),
@@ -102,7 +126,10 @@ func (validator *StartosisValidator) Validate(ctx context.Context, instructionsS
 			availableCpuInMilliCores,
 			availableMemoryInMegaBytes,
 			isResourceInformationComplete,
-			imageDownloadMode)
+			imageDownloadMode,
+			enclaveResourceQuota,
+			serviceResourceClaims,
+			serviceReplicaSetNames)
 
 		isValidationFailure = isValidationFailure ||
 			validator.validateAndUpdateEnvironment(instructionsSequence, environment, starlarkRunResponseLineStream)
@@ -123,13 +150,16 @@ func (validator *StartosisValidator) Validate(ctx context.Context, instructionsS
 
 func (validator *StartosisValidator) validateAndUpdateEnvironment(instructionsSequence []*instructions_plan.ScheduledInstruction, environment *startosis_validator.ValidatorEnvironment, starlarkRunResponseLineStream chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine) bool {
 	isValidationFailure := false
+	var openBranches []*branchValidation
+	branchEnvironment := environment
 	for _, scheduledInstruction := range instructionsSequence {
 		if scheduledInstruction.IsExecuted() {
 			// no need to validate the instruction as it won't be executed in this round
 			continue
 		}
+		openBranches, branchEnvironment = enterControlFlowBlocks(openBranches, scheduledInstruction.GetControlFlowBlocks(), branchEnvironment)
 		instruction := scheduledInstruction.GetInstruction()
-		err := instruction.ValidateAndUpdateEnvironment(environment)
+		err := instruction.ValidateAndUpdateEnvironment(branchEnvironment)
 		if err != nil {
 			wrappedValidationError := startosis_errors.WrapWithValidationError(err,
 				"Error while validating instruction %v. The instruction can be found at %v",
@@ -139,9 +169,61 @@ func (validator *StartosisValidator) validateAndUpdateEnvironment(instructionsSe
 			isValidationFailure = true
 		}
 	}
+	// closing every branch joins them back into the environment the images get validated against
+	enterControlFlowBlocks(openBranches, nil, branchEnvironment)
 	return isValidationFailure
 }
 
+// branchValidation is a branch of a control flow instruction being validated, against an environment forked from the
+// one preceding the control flow instruction
+type branchValidation struct {
+	block *instructions_plan.ControlFlowBlock
+
+	forkedFrom *startosis_validator.ValidatorEnvironment
+
+	// the environments the previous branches of the same control flow instruction ended with
+	previousBranchEnvironments []*startosis_validator.ValidatorEnvironment
+}
+
+// enterControlFlowBlocks closes the open branches the next instruction isn't part of and opens the ones it is, returning
+// them along with the environment to validate the instruction against. As only one branch of an if_ runs, each one is
+// validated against its own fork of the environment, and they're all joined once the control flow instruction ends
+func enterControlFlowBlocks(
+	openBranches []*branchValidation,
+	controlFlowBlocks []*instructions_plan.ControlFlowBlock,
+	environment *startosis_validator.ValidatorEnvironment,
+) ([]*branchValidation, *startosis_validator.ValidatorEnvironment) {
+	numCommonBlocks := 0
+	for numCommonBlocks < len(openBranches) && numCommonBlocks < len(controlFlowBlocks) && openBranches[numCommonBlocks].block == controlFlowBlocks[numCommonBlocks] {
+		numCommonBlocks++
+	}
+	for len(openBranches) > numCommonBlocks {
+		depth := len(openBranches) - 1
+		branch := openBranches[depth]
+		branch.previousBranchEnvironments = append(branch.previousBranchEnvironments, environment)
+		if depth < len(controlFlowBlocks) && controlFlowBlocks[depth].GetControlFlowInstructionUuid() == branch.block.GetControlFlowInstructionUuid() {
+			// next branch of the same control flow instruction
+			branch.block = controlFlowBlocks[depth]
+			environment = branch.forkedFrom.Fork()
+			numCommonBlocks++
+			break
+		}
+		// the environment the branches were forked from is one of the outcomes too, as no branch may run at all
+		branch.forkedFrom.Join(branch.previousBranchEnvironments)
+		environment = branch.forkedFrom
+		openBranches = openBranches[:depth]
+	}
+	for _, controlFlowBlock := range controlFlowBlocks[numCommonBlocks:] {
+		openBranches = append(openBranches, &branchValidation{
+			block:                      controlFlowBlock,
+			forkedFrom:                 environment,
+			previousBranchEnvironments: nil,
+		})
+		environment = environment.Fork()
+	}
+	return openBranches, environment
+}
+
 func (validator *StartosisValidator) validateImagesAccountingForProgress(ctx context.Context, environment *startosis_validator.ValidatorEnvironment, starlarkRunResponseLineStream chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine) bool {
 	isValidationFailure := false
 
@@ -305,3 +387,18 @@ func getServiceNameToPortIDsMap(serviceNames map[service.ServiceName]bool, netwo
 	}
 	return serviceToPrivatePortIds, nil
 }
+
+// getServiceNameToReplicaSetNameMap returns the name of the replica set of every existing service that is a replica
+func getServiceNameToReplicaSetNameMap(serviceNames map[service.ServiceName]bool, network service_network.ServiceNetwork) (map[service.ServiceName]string, error) {
+	serviceReplicaSetNames := map[service.ServiceName]string{}
+	for serviceName := range serviceNames {
+		serviceConfig, err := network.GetServiceConfig(serviceName)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred while fetching the config of service '%s' for its replica set", serviceName)
+		}
+		if replicaSetName, isReplica := serviceConfig.GetReplicaSetName(); isReplica {
+			serviceReplicaSetNames[serviceName] = replicaSetName
+		}
+	}
+	return serviceReplicaSetNames, nil
+}
@@ -1,14 +1,19 @@
 package startosis_validator
 
 import (
+	"maps"
+
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/compute_resources"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_build_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_registry_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/nix_build_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/commons/enclave_data_directory"
 	"github.com/sirupsen/logrus"
 )
 
@@ -27,9 +32,12 @@ type ValidatorEnvironment struct {
 	minCPUByServiceName           map[service.ServiceName]compute_resources.CpuMilliCores
 	minMemoryByServiceName        map[service.ServiceName]compute_resources.MemoryInMegaBytes
 	imageDownloadMode             image_download_mode.ImageDownloadMode
+	enclaveResourceQuota          *enclave.EnclaveResourceQuota
+	serviceResourceClaims         map[service.ServiceName]*service_network.ServiceResourceClaim
+	serviceReplicaSetNames        map[service.ServiceName]string
 }
 
-func NewValidatorEnvironment(serviceNames map[service.ServiceName]bool, artifactNames map[string]bool, serviceNameToPrivatePortIds map[service.ServiceName][]string, availableCpuInMilliCores compute_resources.CpuMilliCores, availableMemoryInMegaBytes compute_resources.MemoryInMegaBytes, isResourceInformationComplete bool, imageDownloadMode image_download_mode.ImageDownloadMode) *ValidatorEnvironment {
+func NewValidatorEnvironment(serviceNames map[service.ServiceName]bool, artifactNames map[string]bool, serviceNameToPrivatePortIds map[service.ServiceName][]string, availableCpuInMilliCores compute_resources.CpuMilliCores, availableMemoryInMegaBytes compute_resources.MemoryInMegaBytes, isResourceInformationComplete bool, imageDownloadMode image_download_mode.ImageDownloadMode, enclaveResourceQuota *enclave.EnclaveResourceQuota, serviceResourceClaims map[service.ServiceName]*service_network.ServiceResourceClaim, serviceReplicaSetNames map[service.ServiceName]string) *ValidatorEnvironment {
 	serviceNamesWithComponentExistence := map[service.ServiceName]ComponentExistence{}
 	for serviceName := range serviceNames {
 		serviceNamesWithComponentExistence[serviceName] = ComponentExistedBeforePackageRun
@@ -38,6 +46,8 @@ func NewValidatorEnvironment(serviceNames map[service.ServiceName]bool, artifact
 	for artifactName := range artifactNames {
 		artifactNamesWithComponentExistence[artifactName] = ComponentExistedBeforePackageRun
 	}
+	existingServiceReplicaSetNames := map[service.ServiceName]string{}
+	maps.Copy(existingServiceReplicaSetNames, serviceReplicaSetNames)
 	return &ValidatorEnvironment{
 		imagesToPull:                  map[string]*image_registry_spec.ImageRegistrySpec{},
 		imagesToBuild:                 map[string]*image_build_spec.ImageBuildSpec{},
@@ -53,6 +63,9 @@ func NewValidatorEnvironment(serviceNames map[service.ServiceName]bool, artifact
 		minMemoryByServiceName: map[service.ServiceName]compute_resources.MemoryInMegaBytes{},
 		minCPUByServiceName:    map[service.ServiceName]compute_resources.CpuMilliCores{},
 		imageDownloadMode:      imageDownloadMode,
+		enclaveResourceQuota:   enclaveResourceQuota,
+		serviceResourceClaims:  serviceResourceClaims,
+		serviceReplicaSetNames: existingServiceReplicaSetNames,
 	}
 }
 
@@ -82,6 +95,7 @@ func (environment *ValidatorEnvironment) AddServiceName(serviceName service.Serv
 
 func (environment *ValidatorEnvironment) RemoveServiceName(serviceName service.ServiceName) {
 	delete(environment.serviceNames, serviceName)
+	delete(environment.serviceReplicaSetNames, serviceName)
 }
 
 func (environment *ValidatorEnvironment) DoesServiceNameExist(serviceName service.ServiceName) ComponentExistence {
@@ -92,6 +106,32 @@ func (environment *ValidatorEnvironment) DoesServiceNameExist(serviceName servic
 	return serviceExistence
 }
 
+// SetServiceReplicaSetName records the service as a replica of the replica set, or as no replica if the replica set
+// name is empty
+func (environment *ValidatorEnvironment) SetServiceReplicaSetName(serviceName service.ServiceName, replicaSetName string) {
+	if replicaSetName == "" {
+		delete(environment.serviceReplicaSetNames, serviceName)
+		return
+	}
+	environment.serviceReplicaSetNames[serviceName] = replicaSetName
+}
+
+// GetServiceReplicaSetName returns the name of the replica set the service is a replica of, or false if it isn't one
+func (environment *ValidatorEnvironment) GetServiceReplicaSetName(serviceName service.ServiceName) (string, bool) {
+	replicaSetName, found := environment.serviceReplicaSetNames[serviceName]
+	return replicaSetName, found
+}
+
+// DoesReplicaSetNameExist returns whether at least one service is a replica of the replica set
+func (environment *ValidatorEnvironment) DoesReplicaSetNameExist(replicaSetName string) bool {
+	for _, serviceReplicaSetName := range environment.serviceReplicaSetNames {
+		if serviceReplicaSetName == replicaSetName {
+			return true
+		}
+	}
+	return false
+}
+
 func (environment *ValidatorEnvironment) AddPrivatePortIDForService(portIDs []string, serviceName service.ServiceName) {
 	environment.serviceNameToPrivatePortIDs[serviceName] = portIDs
 }
@@ -121,12 +161,19 @@ func (environment *ValidatorEnvironment) RemoveArtifactName(artifactName string)
 	delete(environment.artifactNames, artifactName)
 }
 
+// DoesArtifactNameExist accepts names pinning a version of the files artifact, like 'my-config@v2'. Whether the version
+// exists is only known at execution time.
 func (environment *ValidatorEnvironment) DoesArtifactNameExist(artifactName string) ComponentExistence {
 	filesArtifactExistence, found := environment.artifactNames[artifactName]
-	if !found {
-		return ComponentNotFound
+	if found {
+		return filesArtifactExistence
+	}
+	if baseArtifactName, _, isPinned := enclave_data_directory.SplitFilesArtifactVersionPin(artifactName); isPinned {
+		if filesArtifactExistence, found = environment.artifactNames[baseArtifactName]; found {
+			return filesArtifactExistence
+		}
 	}
-	return filesArtifactExistence
+	return ComponentNotFound
 }
 
 func (environment *ValidatorEnvironment) FreeMemory(serviceName service.ServiceName) {
@@ -177,6 +224,77 @@ func (environment *ValidatorEnvironment) HasEnoughMemory(memoryToConsume uint64,
 	return startosis_errors.NewValidationError("service '%v' requires '%v' megabytes of memory but based on our calculation we will only have '%v' megabytes available at the time we start the service", serviceNameForLogging, memoryToConsume, environment.availableMemoryInMegaBytes)
 }
 
+// ClaimEnclaveResources counts the service against the enclave resource quota, replacing what it claimed before if it
+// already existed. Nothing is claimed if the service doesn't fit
+func (environment *ValidatorEnvironment) ClaimEnclaveResources(serviceConfig *service.ServiceConfig, serviceName service.ServiceName) *startosis_errors.ValidationError {
+	previousClaim, hadPreviousClaim := environment.serviceResourceClaims[serviceName]
+	environment.serviceResourceClaims[serviceName] = service_network.NewServiceResourceClaim(serviceConfig)
+	if err := service_network.CheckEnclaveResourceQuota(environment.enclaveResourceQuota, environment.serviceResourceClaims); err != nil {
+		if hadPreviousClaim {
+			environment.serviceResourceClaims[serviceName] = previousClaim
+		} else {
+			delete(environment.serviceResourceClaims, serviceName)
+		}
+		return startosis_errors.WrapWithValidationError(err, "Service '%v' doesn't fit in the resource quota of the enclave", serviceName)
+	}
+	return nil
+}
+
+func (environment *ValidatorEnvironment) ReleaseEnclaveResources(serviceName service.ServiceName) {
+	delete(environment.serviceResourceClaims, serviceName)
+}
+
 func (environment *ValidatorEnvironment) AddPersistentKey(persistentKey service_directory.DirectoryPersistentKey) {
 	environment.persistentKeys[persistentKey] = ComponentCreatedOrUpdatedDuringPackageRun
 }
+
+// Fork returns a copy of the environment, so that a branch of a control flow instruction can be validated without
+// seeing what the other branches do
+func (environment *ValidatorEnvironment) Fork() *ValidatorEnvironment {
+	return &ValidatorEnvironment{
+		imagesToPull:                  maps.Clone(environment.imagesToPull),
+		imagesToBuild:                 maps.Clone(environment.imagesToBuild),
+		nixToBuild:                    maps.Clone(environment.nixToBuild),
+		serviceNames:                  maps.Clone(environment.serviceNames),
+		artifactNames:                 maps.Clone(environment.artifactNames),
+		persistentKeys:                maps.Clone(environment.persistentKeys),
+		serviceNameToPrivatePortIDs:   maps.Clone(environment.serviceNameToPrivatePortIDs),
+		availableCpuInMilliCores:      environment.availableCpuInMilliCores,
+		availableMemoryInMegaBytes:    environment.availableMemoryInMegaBytes,
+		isResourceInformationComplete: environment.isResourceInformationComplete,
+		minCPUByServiceName:           maps.Clone(environment.minCPUByServiceName),
+		minMemoryByServiceName:        maps.Clone(environment.minMemoryByServiceName),
+		imageDownloadMode:             environment.imageDownloadMode,
+		enclaveResourceQuota:          environment.enclaveResourceQuota,
+		serviceResourceClaims:         maps.Clone(environment.serviceResourceClaims),
+		serviceReplicaSetNames:        maps.Clone(environment.serviceReplicaSetNames),
+	}
+}
+
+// Join merges into this environment the environments forked from it to validate the branches of a control flow
+// instruction. Which branch runs, if any, is only known at execution time, so the images of every branch get fetched,
+// a component exists afterward if it exists after any of the branches, and the fewest resources left by a branch are
+// kept
+func (environment *ValidatorEnvironment) Join(branchEnvironments []*ValidatorEnvironment) {
+	for _, branchEnvironment := range branchEnvironments {
+		maps.Copy(environment.imagesToPull, branchEnvironment.imagesToPull)
+		maps.Copy(environment.imagesToBuild, branchEnvironment.imagesToBuild)
+		maps.Copy(environment.nixToBuild, branchEnvironment.nixToBuild)
+		joinComponentExistences(environment.serviceNames, branchEnvironment.serviceNames)
+		joinComponentExistences(environment.artifactNames, branchEnvironment.artifactNames)
+		joinComponentExistences(environment.persistentKeys, branchEnvironment.persistentKeys)
+		maps.Copy(environment.serviceNameToPrivatePortIDs, branchEnvironment.serviceNameToPrivatePortIDs)
+		environment.availableCpuInMilliCores = min(environment.availableCpuInMilliCores, branchEnvironment.availableCpuInMilliCores)
+		environment.availableMemoryInMegaBytes = min(environment.availableMemoryInMegaBytes, branchEnvironment.availableMemoryInMegaBytes)
+		maps.Copy(environment.minCPUByServiceName, branchEnvironment.minCPUByServiceName)
+		maps.Copy(environment.minMemoryByServiceName, branchEnvironment.minMemoryByServiceName)
+		maps.Copy(environment.serviceResourceClaims, branchEnvironment.serviceResourceClaims)
+		maps.Copy(environment.serviceReplicaSetNames, branchEnvironment.serviceReplicaSetNames)
+	}
+}
+
+func joinComponentExistences[K comparable](componentExistences map[K]ComponentExistence, branchComponentExistences map[K]ComponentExistence) {
+	for component, branchExistence := range branchComponentExistences {
+		componentExistences[component] = max(componentExistences[component], branchExistence)
+	}
+}
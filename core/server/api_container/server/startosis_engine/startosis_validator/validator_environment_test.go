@@ -3,8 +3,10 @@ package startosis_validator
 import (
 	"testing"
 
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/image_download_mode"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
 	"github.com/stretchr/testify/require"
 )
 
@@ -20,9 +22,11 @@ const (
 	tooMuchCpu                    = 5000
 )
 
+var noEnclaveResourceQuota *enclave.EnclaveResourceQuota
+
 func TestMultiplePortIdsForValidation(t *testing.T) {
 	emptyInitialMapping := map[service.ServiceName][]string{}
-	validatorEnvironment := NewValidatorEnvironment(nil, nil, emptyInitialMapping, availableCpuInMilliCores, availableMemoryInBytes, isResourceInformationComplete, image_download_mode.ImageDownloadMode_Missing)
+	validatorEnvironment := NewValidatorEnvironment(nil, nil, emptyInitialMapping, availableCpuInMilliCores, availableMemoryInBytes, isResourceInformationComplete, image_download_mode.ImageDownloadMode_Missing, noEnclaveResourceQuota, map[service.ServiceName]*service_network.ServiceResourceClaim{}, map[service.ServiceName]string{})
 	portIds := []string{
 		fooPortId,
 		fizzPortId,
@@ -37,3 +41,74 @@ func TestMultiplePortIdsForValidation(t *testing.T) {
 	require.Error(t, validatorEnvironment.HasEnoughCPU(tooMuchCpu, testBarService))
 	require.Error(t, validatorEnvironment.HasEnoughMemory(tooMuchMemory, testBarService))
 }
+
+func TestClaimEnclaveResources(t *testing.T) {
+	enclaveResourceQuota := enclave.NewEnclaveResourceQuota(1000, 0, 0, 0)
+	existingServiceResourceClaims := map[service.ServiceName]*service_network.ServiceResourceClaim{
+		testBarService: service_network.NewServiceResourceClaim(testServiceConfigWithMinCpu(t, 600)),
+	}
+	validatorEnvironment := NewValidatorEnvironment(nil, nil, map[service.ServiceName][]string{}, availableCpuInMilliCores, availableMemoryInBytes, isResourceInformationComplete, image_download_mode.ImageDownloadMode_Missing, enclaveResourceQuota, existingServiceResourceClaims, map[service.ServiceName]string{})
+
+	fooService := service.ServiceName("foo")
+	require.Error(t, validatorEnvironment.ClaimEnclaveResources(testServiceConfigWithMinCpu(t, 500), fooService))
+	require.Nil(t, validatorEnvironment.ClaimEnclaveResources(testServiceConfigWithMinCpu(t, 400), fooService))
+
+	// Updating a service replaces what it claimed before
+	require.Nil(t, validatorEnvironment.ClaimEnclaveResources(testServiceConfigWithMinCpu(t, 100), testBarService))
+	require.Nil(t, validatorEnvironment.ClaimEnclaveResources(testServiceConfigWithMinCpu(t, 900), fooService))
+
+	validatorEnvironment.ReleaseEnclaveResources(testBarService)
+	require.Nil(t, validatorEnvironment.ClaimEnclaveResources(testServiceConfigWithMinCpu(t, 1000), fooService))
+}
+
+func testServiceConfigWithMinCpu(t *testing.T, minCpuMilliCores uint64) *service.ServiceConfig {
+	serviceConfig, err := service.CreateServiceConfig("kurtosistech/test-container", nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, 0, 0, "", minCpuMilliCores, 0, map[string]string{}, nil, nil, map[string]string{}, image_download_mode.ImageDownloadMode_Missing, true, false, []string{}, false, service.NewGpuConfig(0, nil, 0, nil, "", ""))
+	require.NoError(t, err)
+	return serviceConfig
+}
+
+func TestDoesArtifactNameExistWithVersionPin(t *testing.T) {
+	validatorEnvironment := NewValidatorEnvironment(nil, map[string]bool{"config": true}, map[service.ServiceName][]string{}, availableCpuInMilliCores, availableMemoryInBytes, isResourceInformationComplete, image_download_mode.ImageDownloadMode_Missing, noEnclaveResourceQuota, map[service.ServiceName]*service_network.ServiceResourceClaim{}, map[service.ServiceName]string{})
+	require.Equal(t, ComponentExistedBeforePackageRun, validatorEnvironment.DoesArtifactNameExist("config"))
+	require.Equal(t, ComponentExistedBeforePackageRun, validatorEnvironment.DoesArtifactNameExist("config@v2"))
+	require.Equal(t, ComponentNotFound, validatorEnvironment.DoesArtifactNameExist("other@v2"))
+}
+
+func TestForkAndJoinBranches(t *testing.T) {
+	validatorEnvironment := NewValidatorEnvironment(nil, nil, map[service.ServiceName][]string{}, availableCpuInMilliCores, availableMemoryInBytes, isResourceInformationComplete, image_download_mode.ImageDownloadMode_Missing, noEnclaveResourceQuota, map[service.ServiceName]*service_network.ServiceResourceClaim{}, map[service.ServiceName]string{})
+
+	thenEnvironment := validatorEnvironment.Fork()
+	thenEnvironment.AddServiceName(testBarService)
+	require.Equal(t, ComponentCreatedOrUpdatedDuringPackageRun, thenEnvironment.DoesServiceNameExist(testBarService))
+	require.Equal(t, ComponentNotFound, validatorEnvironment.DoesServiceNameExist(testBarService))
+
+	// the else branch does not see what the then branch added
+	elseEnvironment := validatorEnvironment.Fork()
+	require.Equal(t, ComponentNotFound, elseEnvironment.DoesServiceNameExist(testBarService))
+	elseEnvironment.AddServiceName(testBarService)
+	elseEnvironment.ConsumeCPU(availableCpuInMilliCores, testBarService)
+
+	validatorEnvironment.Join([]*ValidatorEnvironment{thenEnvironment, elseEnvironment})
+	require.Equal(t, ComponentCreatedOrUpdatedDuringPackageRun, validatorEnvironment.DoesServiceNameExist(testBarService))
+	require.Error(t, validatorEnvironment.HasEnoughCPU(1, testBarService))
+}
+
+func TestServiceReplicaSetNames(t *testing.T) {
+	validatorEnvironment := NewValidatorEnvironment(map[service.ServiceName]bool{"worker-0": true}, nil, map[service.ServiceName][]string{}, availableCpuInMilliCores, availableMemoryInBytes, isResourceInformationComplete, image_download_mode.ImageDownloadMode_Missing, noEnclaveResourceQuota, map[service.ServiceName]*service_network.ServiceResourceClaim{}, map[service.ServiceName]string{"worker-0": "worker"})
+	require.True(t, validatorEnvironment.DoesReplicaSetNameExist("worker"))
+	require.False(t, validatorEnvironment.DoesReplicaSetNameExist("worker-0"))
+
+	replicaSetName, isReplica := validatorEnvironment.GetServiceReplicaSetName("worker-0")
+	require.True(t, isReplica)
+	require.Equal(t, "worker", replicaSetName)
+
+	// updated into a service that isn't a replica anymore
+	validatorEnvironment.SetServiceReplicaSetName("worker-0", "")
+	_, isReplica = validatorEnvironment.GetServiceReplicaSetName("worker-0")
+	require.False(t, isReplica)
+	require.False(t, validatorEnvironment.DoesReplicaSetNameExist("worker"))
+
+	validatorEnvironment.SetServiceReplicaSetName("worker-0", "worker")
+	validatorEnvironment.RemoveServiceName("worker-0")
+	require.False(t, validatorEnvironment.DoesReplicaSetNameExist("worker"))
+}
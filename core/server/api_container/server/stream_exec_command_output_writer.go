@@ -0,0 +1,47 @@
+package server
+
+import (
+	"sync"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// streamExecCommandOutputWriter sends what is written to it to the client of a StreamExecCommand call. The stdout and
+// stderr writers of the same call share the mutex, as a gRPC stream doesn't support concurrent sends
+type streamExecCommandOutputWriter struct {
+	mutex *sync.Mutex
+
+	stream kurtosis_core_rpc_api_bindings.ApiContainerService_StreamExecCommandServer
+
+	newResponse func(output []byte) *kurtosis_core_rpc_api_bindings.StreamExecCommandResponse
+
+	// Applied to every chunk of output on its own, so a secret split across two writes isn't masked
+	maskSecrets func(output string) string
+}
+
+func newStreamExecCommandOutputWriter(
+	mutex *sync.Mutex,
+	stream kurtosis_core_rpc_api_bindings.ApiContainerService_StreamExecCommandServer,
+	newResponse func(output []byte) *kurtosis_core_rpc_api_bindings.StreamExecCommandResponse,
+	maskSecrets func(output string) string,
+) *streamExecCommandOutputWriter {
+	return &streamExecCommandOutputWriter{
+		mutex:       mutex,
+		stream:      stream,
+		newResponse: newResponse,
+		maskSecrets: maskSecrets,
+	}
+}
+
+func (writer *streamExecCommandOutputWriter) Write(output []byte) (int, error) {
+	writer.mutex.Lock()
+	defer writer.mutex.Unlock()
+	// the masked output is a copy, as the caller is free to reuse the slice once Write returns while the message
+	// might still be queued
+	maskedOutput := []byte(writer.maskSecrets(string(output)))
+	if err := writer.stream.Send(writer.newResponse(maskedOutput)); err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred sending exec output to the client")
+	}
+	return len(output), nil
+}
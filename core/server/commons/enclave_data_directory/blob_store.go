@@ -0,0 +1,511 @@
+package enclave_data_directory
+
+import (
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"path"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// The directory, inside the blob store, where the content of every stored file is kept once
+	blobsDirname = "blobs"
+
+	// The directory, inside the blob store, with a directory per owner holding an empty file named after every blob the
+	// owner references. A blob no owner references is removed.
+	blobReferencesDirname = "references"
+
+	// The directory, inside the blob store, holding an empty file named after every leased blob, whose modification
+	// time is when the lease expires
+	blobLeasesDirname = "leases"
+
+	// The directory, inside the blob store, with a directory per owner where files get written before being moved to
+	// their final location, so that a half-written blob is never visible
+	blobsTmpDirname = "tmp"
+
+	// The file, inside the blob store, locked while blobs and their references are changed, as the owners sharing the
+	// store run in different processes
+	blobsLockFilename = "lock"
+
+	blobTmpFilePattern = "blob-*"
+
+	blobStoreFilePerms = 0666
+
+	// How long a blob found by a client stays stored without being referenced, so that the client has the time to
+	// upload the content referencing it
+	blobLeaseDuration = 10 * time.Minute
+)
+
+// Stores file contents gzipped and keyed by the hex encoded SHA-256 of their uncompressed content, so that the same
+// content is stored once no matter how many files artifacts contain it. The store can be shared by several owners,
+// the enclaves, each of them recording the blobs it references; a blob is removed once no owner references it and no
+// lease on it is running anymore.
+// Keeping track of how many times an owner references a blob is up to the owner.
+type blobStore struct {
+	ownerId string
+
+	blobsDirpath      string
+	referencesDirpath string
+	leasesDirpath     string
+	tmpDirpath        string
+	lockFilepath      string
+}
+
+func newBlobStore(blobStoreDirpath string, ownerId string) *blobStore {
+	return &blobStore{
+		ownerId:           ownerId,
+		blobsDirpath:      path.Join(blobStoreDirpath, blobsDirname),
+		referencesDirpath: path.Join(blobStoreDirpath, blobReferencesDirname),
+		leasesDirpath:     path.Join(blobStoreDirpath, blobLeasesDirname),
+		tmpDirpath:        path.Join(blobStoreDirpath, blobsTmpDirname),
+		lockFilepath:      path.Join(blobStoreDirpath, blobsLockFilename),
+	}
+}
+
+// addBlob stores the content of reader unless a blob with the same content already exists, and records that the owner
+// references it. It returns the hash of the content, its size and whether the owner didn't reference it before.
+func (store *blobStore) addBlob(reader io.Reader) (string, int64, bool, error) {
+	tmpFile, err := store.createTmpFile()
+	if err != nil {
+		return "", 0, false, stacktrace.Propagate(err, "An error occurred creating the temporary file to write the blob to")
+	}
+	tmpFilepath := tmpFile.Name()
+	defer func() {
+		if err := os.Remove(tmpFilepath); err != nil && !os.IsNotExist(err) {
+			logrus.Warnf("An error occurred removing temporary blob file '%v':\n%v", tmpFilepath, err)
+		}
+	}()
+	defer tmpFile.Close()
+
+	hasher := sha256.New()
+	gzipWriter := gzip.NewWriter(tmpFile)
+	size, err := io.Copy(io.MultiWriter(gzipWriter, hasher), reader)
+	if err != nil {
+		return "", 0, false, stacktrace.Propagate(err, "An error occurred writing the blob content after %v bytes", size)
+	}
+	if err = gzipWriter.Close(); err != nil {
+		return "", 0, false, stacktrace.Propagate(err, "An error occurred compressing the blob content")
+	}
+	if err = tmpFile.Close(); err != nil {
+		return "", 0, false, stacktrace.Propagate(err, "An error occurred closing temporary blob file '%v'", tmpFilepath)
+	}
+
+	blobHash := hex.EncodeToString(hasher.Sum(nil))
+	isNewReference, err := store.moveToBlob(tmpFilepath, blobHash, true)
+	if err != nil {
+		return "", 0, false, stacktrace.Propagate(err, "An error occurred storing blob '%v'", blobHash)
+	}
+	return blobHash, size, isNewReference, nil
+}
+
+// claimBlob records that the owner references a blob that is already stored, and returns whether it didn't before.
+// An error is returned if the blob isn't stored.
+func (store *blobStore) claimBlob(blobHash string) (bool, error) {
+	unlock, err := store.lock()
+	if err != nil {
+		return false, stacktrace.Propagate(err, "An error occurred locking the blob store")
+	}
+	defer unlock()
+
+	if !store.hasBlob(blobHash) {
+		return false, stacktrace.NewError("Blob '%v' isn't stored", blobHash)
+	}
+	isNewReference, err := store.addReferenceLocked(blobHash)
+	if err != nil {
+		return false, stacktrace.Propagate(err, "An error occurred referencing blob '%v'", blobHash)
+	}
+	return isNewReference, nil
+}
+
+// releaseBlob records that the owner doesn't reference the blob anymore, removing it if nothing else keeps it
+func (store *blobStore) releaseBlob(blobHash string) error {
+	unlock, err := store.lock()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred locking the blob store")
+	}
+	defer unlock()
+
+	if err = os.Remove(store.getReferenceFilepath(blobHash)); err != nil && !os.IsNotExist(err) {
+		return stacktrace.Propagate(err, "An error occurred removing the reference to blob '%v'", blobHash)
+	}
+	if err = store.removeIfUnusedLocked(blobHash); err != nil {
+		return stacktrace.Propagate(err, "An error occurred removing blob '%v' if it isn't used anymore", blobHash)
+	}
+	return nil
+}
+
+// leaseBlobs makes sure that the blobs stored among the given ones stay stored for a while, even if no owner
+// references them, and returns the hashes of the ones that aren't stored
+func (store *blobStore) leaseBlobs(blobHashes []string) ([]string, error) {
+	unlock, err := store.lock()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred locking the blob store")
+	}
+	defer unlock()
+
+	if err = store.removeExpiredLeasesLocked(); err != nil {
+		logrus.Warnf("An error occurred removing the expired blob leases, the blobs they were the last to keep will stay on disk:\n%v", err)
+	}
+	if err = store.ensureDirpathsExist(store.leasesDirpath); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred ensuring the blob leases dirpath '%v' exists", store.leasesDirpath)
+	}
+	leaseExpiry := time.Now().Add(blobLeaseDuration)
+	missingBlobHashes := []string{}
+	for _, blobHash := range blobHashes {
+		if !store.hasBlob(blobHash) {
+			missingBlobHashes = append(missingBlobHashes, blobHash)
+			continue
+		}
+		leaseFilepath := store.getLeaseFilepath(blobHash)
+		leaseFile, err := os.OpenFile(leaseFilepath, os.O_CREATE|os.O_WRONLY, blobStoreFilePerms)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating the lease file of blob '%v'", blobHash)
+		}
+		leaseFile.Close()
+		if err = os.Chtimes(leaseFilepath, leaseExpiry, leaseExpiry); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred setting the expiry of the lease on blob '%v'", blobHash)
+		}
+	}
+	return missingBlobHashes, nil
+}
+
+func (store *blobStore) hasBlob(blobHash string) bool {
+	// hashes can come from clients, so they mustn't be used as paths before being validated
+	if !isValidBlobHash(blobHash) {
+		return false
+	}
+	_, err := os.Stat(store.getBlobFilepath(blobHash))
+	return err == nil
+}
+
+// openBlob returns the uncompressed content of the blob, which must be closed by the caller
+func (store *blobStore) openBlob(blobHash string) (io.ReadCloser, error) {
+	blobFile, err := os.Open(store.getBlobFilepath(blobHash))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred opening blob '%v'", blobHash)
+	}
+	gzipReader, err := gzip.NewReader(blobFile)
+	if err != nil {
+		blobFile.Close()
+		return nil, stacktrace.Propagate(err, "An error occurred decompressing blob '%v'", blobHash)
+	}
+	return &blobReader{
+		Reader:   gzipReader,
+		blobFile: blobFile,
+	}, nil
+}
+
+// listReferencedBlobs returns the hashes of the blobs the owner references
+func (store *blobStore) listReferencedBlobs() ([]string, error) {
+	ownerReferencesDirpath := path.Join(store.referencesDirpath, store.ownerId)
+	referenceFiles, err := os.ReadDir(ownerReferencesDirpath)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred listing the blob references in '%v'", ownerReferencesDirpath)
+	}
+	var blobHashes []string
+	for _, referenceFile := range referenceFiles {
+		blobHashes = append(blobHashes, referenceFile.Name())
+	}
+	return blobHashes, nil
+}
+
+// removeUnusedBlobs removes the blobs that no owner references and no lease keeps, like the ones of the owners that
+// were removed, along with the expired leases
+func (store *blobStore) removeUnusedBlobs() error {
+	unlock, err := store.lock()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred locking the blob store")
+	}
+	defer unlock()
+
+	if err = store.removeExpiredLeasesLocked(); err != nil {
+		return stacktrace.Propagate(err, "An error occurred removing the expired blob leases")
+	}
+	usedBlobHashes, err := store.getUsedBlobHashesLocked()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the blobs that are used")
+	}
+	blobFiles, err := os.ReadDir(store.blobsDirpath)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred listing the blobs in '%v'", store.blobsDirpath)
+	}
+	for _, blobFile := range blobFiles {
+		if usedBlobHashes[blobFile.Name()] {
+			continue
+		}
+		if err = os.Remove(store.getBlobFilepath(blobFile.Name())); err != nil && !os.IsNotExist(err) {
+			logrus.Warnf("An error occurred removing unused blob '%v', it will stay on disk:\n%v", blobFile.Name(), err)
+		}
+	}
+	return nil
+}
+
+// importBlobs moves the blobs of another directory, like the one blobs were stored in before being shared, to the
+// store. The blobs aren't referenced by the owner once imported.
+func (store *blobStore) importBlobs(blobsDirpath string) error {
+	blobFiles, err := os.ReadDir(blobsDirpath)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred listing the blobs to import in '%v'", blobsDirpath)
+	}
+	for _, blobFile := range blobFiles {
+		blobHash := blobFile.Name()
+		if !isValidBlobHash(blobHash) {
+			continue
+		}
+		if err = store.importBlob(path.Join(blobsDirpath, blobHash), blobHash); err != nil {
+			return stacktrace.Propagate(err, "An error occurred importing blob '%v'", blobHash)
+		}
+	}
+	if err = os.RemoveAll(blobsDirpath); err != nil {
+		return stacktrace.Propagate(err, "An error occurred removing directory '%v' whose blobs were imported", blobsDirpath)
+	}
+	return nil
+}
+
+// createTmpFile creates a file that the caller is responsible for removing
+func (store *blobStore) createTmpFile() (*os.File, error) {
+	ownerTmpDirpath := path.Join(store.tmpDirpath, store.ownerId)
+	if err := store.ensureDirpathsExist(store.tmpDirpath, ownerTmpDirpath); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred ensuring the temporary files dirpath '%v' exists", ownerTmpDirpath)
+	}
+	tmpFile, err := os.CreateTemp(ownerTmpDirpath, blobTmpFilePattern)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating a temporary file in '%v'", ownerTmpDirpath)
+	}
+	return tmpFile, nil
+}
+
+// removeTmpFiles removes the temporary files of the owner left behind by a previous run that got interrupted
+func (store *blobStore) removeTmpFiles() error {
+	ownerTmpDirpath := path.Join(store.tmpDirpath, store.ownerId)
+	if err := os.RemoveAll(ownerTmpDirpath); err != nil {
+		return stacktrace.Propagate(err, "An error occurred removing the temporary files dirpath '%v'", ownerTmpDirpath)
+	}
+	return nil
+}
+
+func (store *blobStore) importBlob(blobFilepath string, blobHash string) error {
+	blobFile, err := os.Open(blobFilepath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred opening blob file '%v'", blobFilepath)
+	}
+	defer blobFile.Close()
+	// the blob is copied as the directory can be on another filesystem, where it can't be moved from
+	tmpFile, err := store.createTmpFile()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the temporary file to copy the blob to")
+	}
+	tmpFilepath := tmpFile.Name()
+	defer func() {
+		if err := os.Remove(tmpFilepath); err != nil && !os.IsNotExist(err) {
+			logrus.Warnf("An error occurred removing temporary blob file '%v':\n%v", tmpFilepath, err)
+		}
+	}()
+	defer tmpFile.Close()
+	if _, err = io.Copy(tmpFile, blobFile); err != nil {
+		return stacktrace.Propagate(err, "An error occurred copying blob file '%v'", blobFilepath)
+	}
+	if err = tmpFile.Close(); err != nil {
+		return stacktrace.Propagate(err, "An error occurred closing temporary blob file '%v'", tmpFilepath)
+	}
+	if _, err = store.moveToBlob(tmpFilepath, blobHash, false); err != nil {
+		return stacktrace.Propagate(err, "An error occurred storing blob '%v'", blobHash)
+	}
+	return nil
+}
+
+// moveToBlob moves the temporary file to the blob, unless the blob is already stored, optionally recording that the
+// owner references it. It returns whether the owner didn't reference the blob before.
+func (store *blobStore) moveToBlob(tmpFilepath string, blobHash string, shouldReference bool) (bool, error) {
+	unlock, err := store.lock()
+	if err != nil {
+		return false, stacktrace.Propagate(err, "An error occurred locking the blob store")
+	}
+	defer unlock()
+
+	if !store.hasBlob(blobHash) {
+		if err = store.ensureDirpathsExist(store.blobsDirpath); err != nil {
+			return false, stacktrace.Propagate(err, "An error occurred ensuring the blobs dirpath '%v' exists", store.blobsDirpath)
+		}
+		if err = os.Rename(tmpFilepath, store.getBlobFilepath(blobHash)); err != nil {
+			return false, stacktrace.Propagate(err, "An error occurred moving temporary blob file '%v' to its final location", tmpFilepath)
+		}
+	}
+	if !shouldReference {
+		return false, nil
+	}
+	isNewReference, err := store.addReferenceLocked(blobHash)
+	if err != nil {
+		return false, stacktrace.Propagate(err, "An error occurred referencing blob '%v'", blobHash)
+	}
+	return isNewReference, nil
+}
+
+// addReferenceLocked must be called with the store locked
+func (store *blobStore) addReferenceLocked(blobHash string) (bool, error) {
+	ownerReferencesDirpath := path.Join(store.referencesDirpath, store.ownerId)
+	if err := store.ensureDirpathsExist(store.referencesDirpath, ownerReferencesDirpath); err != nil {
+		return false, stacktrace.Propagate(err, "An error occurred ensuring the blob references dirpath '%v' exists", ownerReferencesDirpath)
+	}
+	referenceFile, err := os.OpenFile(store.getReferenceFilepath(blobHash), os.O_CREATE|os.O_EXCL|os.O_WRONLY, blobStoreFilePerms)
+	if os.IsExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, stacktrace.Propagate(err, "An error occurred creating the reference file of blob '%v'", blobHash)
+	}
+	referenceFile.Close()
+	return true, nil
+}
+
+// removeIfUnusedLocked must be called with the store locked
+func (store *blobStore) removeIfUnusedLocked(blobHash string) error {
+	leaseFileInfo, err := os.Stat(store.getLeaseFilepath(blobHash))
+	if err == nil && leaseFileInfo.ModTime().After(time.Now()) {
+		return nil
+	}
+	ownerReferencesDirs, err := os.ReadDir(store.referencesDirpath)
+	if err != nil && !os.IsNotExist(err) {
+		return stacktrace.Propagate(err, "An error occurred listing the owners referencing blobs in '%v'", store.referencesDirpath)
+	}
+	for _, ownerReferencesDir := range ownerReferencesDirs {
+		if _, err = os.Stat(path.Join(store.referencesDirpath, ownerReferencesDir.Name(), blobHash)); err == nil {
+			return nil
+		}
+	}
+	if err = os.Remove(store.getBlobFilepath(blobHash)); err != nil && !os.IsNotExist(err) {
+		return stacktrace.Propagate(err, "An error occurred removing blob '%v'", blobHash)
+	}
+	if err = os.Remove(store.getLeaseFilepath(blobHash)); err != nil && !os.IsNotExist(err) {
+		return stacktrace.Propagate(err, "An error occurred removing the expired lease on blob '%v'", blobHash)
+	}
+	return nil
+}
+
+// removeExpiredLeasesLocked must be called with the store locked
+func (store *blobStore) removeExpiredLeasesLocked() error {
+	leaseFiles, err := os.ReadDir(store.leasesDirpath)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred listing the blob leases in '%v'", store.leasesDirpath)
+	}
+	now := time.Now()
+	for _, leaseFile := range leaseFiles {
+		leaseFileInfo, err := leaseFile.Info()
+		if err != nil || leaseFileInfo.ModTime().After(now) {
+			continue
+		}
+		if err = store.removeIfUnusedLocked(leaseFile.Name()); err != nil {
+			return stacktrace.Propagate(err, "An error occurred removing blob '%v' whose lease expired if it isn't used anymore", leaseFile.Name())
+		}
+		if err = os.Remove(path.Join(store.leasesDirpath, leaseFile.Name())); err != nil && !os.IsNotExist(err) {
+			return stacktrace.Propagate(err, "An error occurred removing the expired lease on blob '%v'", leaseFile.Name())
+		}
+	}
+	return nil
+}
+
+// getUsedBlobHashesLocked returns the blobs referenced by an owner or leased. It must be called with the store locked.
+func (store *blobStore) getUsedBlobHashesLocked() (map[string]bool, error) {
+	usedBlobHashes := map[string]bool{}
+	ownerReferencesDirs, err := os.ReadDir(store.referencesDirpath)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, stacktrace.Propagate(err, "An error occurred listing the owners referencing blobs in '%v'", store.referencesDirpath)
+	}
+	for _, ownerReferencesDir := range ownerReferencesDirs {
+		ownerReferencesDirpath := path.Join(store.referencesDirpath, ownerReferencesDir.Name())
+		referenceFiles, err := os.ReadDir(ownerReferencesDirpath)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred listing the blob references in '%v'", ownerReferencesDirpath)
+		}
+		for _, referenceFile := range referenceFiles {
+			usedBlobHashes[referenceFile.Name()] = true
+		}
+	}
+	leaseFiles, err := os.ReadDir(store.leasesDirpath)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, stacktrace.Propagate(err, "An error occurred listing the blob leases in '%v'", store.leasesDirpath)
+	}
+	for _, leaseFile := range leaseFiles {
+		usedBlobHashes[leaseFile.Name()] = true
+	}
+	return usedBlobHashes, nil
+}
+
+// lock takes the lock of the store, which is held until the returned function is called
+func (store *blobStore) lock() (func(), error) {
+	if err := store.ensureDirpathsExist(); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred ensuring the blob store dirpath exists")
+	}
+	lockFile, err := os.OpenFile(store.lockFilepath, os.O_CREATE|os.O_RDWR, blobStoreFilePerms)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred opening blob store lock file '%v'", store.lockFilepath)
+	}
+	if err = syscall.Flock(int(lockFile.Fd()), syscall.LOCK_EX); err != nil {
+		lockFile.Close()
+		return nil, stacktrace.Propagate(err, "An error occurred locking blob store lock file '%v'", store.lockFilepath)
+	}
+	// closing the file releases the lock
+	return func() {
+		lockFile.Close()
+	}, nil
+}
+
+// ensureDirpathsExist creates the directory of the store, then the given ones in order
+func (store *blobStore) ensureDirpathsExist(dirpaths ...string) error {
+	for _, dirpath := range append([]string{path.Dir(store.lockFilepath)}, dirpaths...) {
+		if err := ensureDirpathExists(dirpath); err != nil {
+			return stacktrace.Propagate(err, "An error occurred ensuring dirpath '%v' exists", dirpath)
+		}
+	}
+	return nil
+}
+
+func (store *blobStore) getBlobFilepath(blobHash string) string {
+	return path.Join(store.blobsDirpath, blobHash)
+}
+
+func (store *blobStore) getReferenceFilepath(blobHash string) string {
+	return path.Join(store.referencesDirpath, store.ownerId, blobHash)
+}
+
+func (store *blobStore) getLeaseFilepath(blobHash string) string {
+	return path.Join(store.leasesDirpath, blobHash)
+}
+
+func isValidBlobHash(blobHash string) bool {
+	decodedBlobHash, err := hex.DecodeString(blobHash)
+	return err == nil && len(decodedBlobHash) == sha256.Size && blobHash == strings.ToLower(blobHash)
+}
+
+type blobReader struct {
+	*gzip.Reader
+	blobFile *os.File
+}
+
+func (reader *blobReader) Close() error {
+	if err := reader.Reader.Close(); err != nil {
+		reader.blobFile.Close()
+		return stacktrace.Propagate(err, "An error occurred closing the blob decompressor")
+	}
+	return reader.blobFile.Close()
+}
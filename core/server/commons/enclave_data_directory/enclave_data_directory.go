@@ -8,6 +8,8 @@ package enclave_data_directory
 import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db/file_artifacts_db"
 	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"os"
 	"path"
 	"sync"
 )
@@ -30,12 +32,23 @@ const (
 
 	// Name of directory INSIDE THE ENCLAVE DATA DIR containing the enclave database (currently the bolt dB is implemented)
 	enclaveDatabase = "enclave-database"
+
+	// Name of directory INSIDE THE ENCLAVE DATA DIR containing the key used to encrypt the enclave secrets
+	secretsStoreDirname = "secrets"
+
+	// Name of directory INSIDE THE ENCLAVE DATA DIR containing the blobs the files artifacts are stored in. The backends
+	// that can share it between the enclaves mount the same volume there in every API container, so that the blobs are
+	// deduplicated across enclaves.
+	filesArtifactsBlobStoreDirname = "files-artifacts-blobs"
 )
 
 // A directory containing all the data associated with a certain enclave (i.e. a Docker subnetwork where services are spun up)
 // An enclave is created either per-test (in the testing framework) or per interactive instance (with Kurtosis Interactive)
 type EnclaveDataDirectory struct {
 	absMountDirpath string
+
+	// Identifies the enclave among the ones sharing the files artifacts blobs
+	enclaveUuid string
 }
 
 var (
@@ -44,8 +57,8 @@ var (
 	once                      sync.Once
 )
 
-func NewEnclaveDataDirectory(absMountDirpath string) *EnclaveDataDirectory {
-	return &EnclaveDataDirectory{absMountDirpath: absMountDirpath}
+func NewEnclaveDataDirectory(absMountDirpath string, enclaveUuid string) *EnclaveDataDirectory {
+	return &EnclaveDataDirectory{absMountDirpath: absMountDirpath, enclaveUuid: enclaveUuid}
 }
 
 func (dir EnclaveDataDirectory) GetFilesArtifactStore() (*FilesArtifactStore, error) {
@@ -64,7 +77,18 @@ func (dir EnclaveDataDirectory) GetFilesArtifactStore() (*FilesArtifactStore, er
 			dbError = stacktrace.Propagate(err, "Failed to get file artifacts db")
 			return
 		}
-		currentFilesArtifactStore = newFilesArtifactStoreFromDb(absoluteDirpath, relativeDirpath, db)
+		blobs := newBlobStore(path.Join(dir.absMountDirpath, filesArtifactsBlobStoreDirname), dir.enclaveUuid)
+		// the blobs used to be stored in the files artifact store, which only the enclave can use
+		if err = blobs.importBlobs(path.Join(absoluteDirpath, blobsDirname)); err != nil {
+			logrus.Warnf("An error occurred moving the blobs of '%v' to the files artifacts blob store, the files artifacts having them may be broken:\n%v", absoluteDirpath, err)
+		}
+		if err = os.RemoveAll(path.Join(absoluteDirpath, blobsTmpDirname)); err != nil {
+			logrus.Warnf("An error occurred removing the temporary files of '%v', they will stay on disk:\n%v", absoluteDirpath, err)
+		}
+		currentFilesArtifactStore = newFilesArtifactStoreFromDb(absoluteDirpath, relativeDirpath, blobs, db)
+		if err = currentFilesArtifactStore.removeLeftovers(); err != nil {
+			logrus.Warnf("An error occurred removing what storing files artifacts may have left behind in '%v', it will stay on disk:\n%v", absoluteDirpath, err)
+		}
 	})
 
 	return currentFilesArtifactStore, dbError
@@ -93,3 +117,11 @@ func (dir EnclaveDataDirectory) GetEnclaveDataDirectoryPaths() (string, string,
 
 	return repositoriesStoreDirpath, tempRepositoriesStoreDirpath, githubAuthStoreDirpath, enclaveDatabaseDirpath, nil
 }
+
+func (dir EnclaveDataDirectory) GetSecretsStoreDirpath() (string, error) {
+	secretsStoreDirpath := path.Join(dir.absMountDirpath, secretsStoreDirname)
+	if err := ensureDirpathExists(secretsStoreDirpath); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred ensuring the secrets store dirpath '%v' exists.", secretsStoreDirpath)
+	}
+	return secretsStoreDirpath, nil
+}
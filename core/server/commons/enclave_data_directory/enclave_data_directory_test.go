@@ -18,7 +18,7 @@ func TestGetFilesArtifactStore(t *testing.T) {
 	enclaveDirpath, err := os.MkdirTemp("", "")
 	assert.Nil(t, err)
 
-	enclaveDir := NewEnclaveDataDirectory(enclaveDirpath)
+	enclaveDir := NewEnclaveDataDirectory(enclaveDirpath, "test-enclave")
 
 	artifactStore, err := enclaveDir.GetFilesArtifactStore()
 	assert.Nil(t, err)
@@ -0,0 +1,237 @@
+package enclave_data_directory
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"errors"
+	"io"
+	"time"
+
+	path_compression "github.com/kurtosis-tech/kurtosis/path-compression"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+// Describes the content of a files artifact. Each file points to the blob holding its content, so that the files
+// artifact can be written back as the gzipped tarball it was stored as.
+type filesArtifactManifest struct {
+	// Set when the content of the files artifact isn't a gzipped tarball, in which case it's stored as is in this blob
+	RawContentBlobHash string
+
+	Entries []*filesArtifactManifestEntry
+}
+
+type filesArtifactManifestEntry struct {
+	Name     string
+	Typeflag byte
+	Linkname string
+	Mode     int64
+	Uid      int
+	Gid      int
+	Uname    string
+	Gname    string
+	ModTime  time.Time
+	Size     int64
+
+	// Only set for regular files
+	BlobHash string
+}
+
+// getBlobHashes returns the hashes of the blobs the manifest references, once per reference
+func (manifest *filesArtifactManifest) getBlobHashes() []string {
+	if manifest.RawContentBlobHash != "" {
+		return []string{manifest.RawContentBlobHash}
+	}
+	var blobHashes []string
+	for _, entry := range manifest.Entries {
+		if entry.BlobHash != "" {
+			blobHashes = append(blobHashes, entry.BlobHash)
+		}
+	}
+	return blobHashes
+}
+
+// ingestFilesArtifactContent stores the content of every file of the gzipped tarball in the blob store. Anything else
+// than a non-empty gzipped tarball is stored as a single blob. On success, it returns the hashes of the blobs the
+// enclave didn't reference before so that the caller can release them if it fails to count its references to them.
+func ingestFilesArtifactContent(blobs *blobStore, content io.ReadSeeker) (*filesArtifactManifest, []string, error) {
+	var claimedBlobHashes []string
+	manifest, isArchive, err := ingestArchive(blobs, content, &claimedBlobHashes)
+	if err != nil || !isArchive {
+		releaseBlobs(blobs, claimedBlobHashes)
+		claimedBlobHashes = nil
+	}
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred storing the files of the archive")
+	}
+	if isArchive {
+		return manifest, claimedBlobHashes, nil
+	}
+
+	if _, err = content.Seek(0, io.SeekStart); err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred rewinding the files artifact content")
+	}
+	rawContentBlobHash, _, isNew, err := blobs.addBlob(content)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred storing the files artifact content")
+	}
+	if isNew {
+		claimedBlobHashes = append(claimedBlobHashes, rawContentBlobHash)
+	}
+	return &filesArtifactManifest{
+		RawContentBlobHash: rawContentBlobHash,
+		Entries:            nil,
+	}, claimedBlobHashes, nil
+}
+
+// ingestArchive returns false if the content turned out not to be a non-empty gzipped tarball. An error is only
+// returned for an archive whose references to already stored content can't be resolved.
+func ingestArchive(blobs *blobStore, content io.Reader, claimedBlobHashes *[]string) (*filesArtifactManifest, bool, error) {
+	gzipReader, err := gzip.NewReader(content)
+	if err != nil {
+		return nil, false, nil
+	}
+	defer gzipReader.Close()
+
+	tarReader := tar.NewReader(gzipReader)
+	manifest := &filesArtifactManifest{
+		RawContentBlobHash: "",
+		Entries:            []*filesArtifactManifestEntry{},
+	}
+	for {
+		header, err := tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			logrus.Debugf("Files artifact content isn't a valid gzipped tarball, it will be stored as is: %v", err)
+			return nil, false, nil
+		}
+		entry := &filesArtifactManifestEntry{
+			Name:     header.Name,
+			Typeflag: header.Typeflag,
+			Linkname: header.Linkname,
+			Mode:     header.Mode,
+			Uid:      header.Uid,
+			Gid:      header.Gid,
+			Uname:    header.Uname,
+			Gname:    header.Gname,
+			ModTime:  header.ModTime,
+			Size:     header.Size,
+			BlobHash: "",
+		}
+		manifest.Entries = append(manifest.Entries, entry)
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		if referencedBlobHash, found := header.PAXRecords[path_compression.BlobSha256PaxRecordKey]; found {
+			isNew, err := blobs.claimBlob(referencedBlobHash)
+			if err != nil {
+				return nil, false, stacktrace.Propagate(err, "File '%v' references content '%v' which isn't stored", header.Name, referencedBlobHash)
+			}
+			if isNew {
+				*claimedBlobHashes = append(*claimedBlobHashes, referencedBlobHash)
+			}
+			// the size is read from the blob rather than trusted from the client, as a wrong one would corrupt the archive
+			referencedBlobSize, err := getBlobSize(blobs, referencedBlobHash)
+			if err != nil {
+				return nil, false, stacktrace.Propagate(err, "An error occurred getting the size of content '%v' referenced by file '%v'", referencedBlobHash, header.Name)
+			}
+			entry.BlobHash = referencedBlobHash
+			entry.Size = referencedBlobSize
+			continue
+		}
+
+		blobHash, _, isNew, err := blobs.addBlob(tarReader)
+		if err != nil {
+			logrus.Debugf("Files artifact content couldn't be read as a gzipped tarball, it will be stored as is: %v", err)
+			return nil, false, nil
+		}
+		if isNew {
+			*claimedBlobHashes = append(*claimedBlobHashes, blobHash)
+		}
+		entry.BlobHash = blobHash
+	}
+	if len(manifest.Entries) == 0 {
+		return nil, false, nil
+	}
+	return manifest, true, nil
+}
+
+// writeFilesArtifactContent writes the files artifact back as it was stored
+func writeFilesArtifactContent(blobs *blobStore, manifest *filesArtifactManifest, writer io.Writer) error {
+	if manifest.RawContentBlobHash != "" {
+		if err := copyBlob(blobs, manifest.RawContentBlobHash, writer); err != nil {
+			return stacktrace.Propagate(err, "An error occurred writing the files artifact content")
+		}
+		return nil
+	}
+
+	gzipWriter := gzip.NewWriter(writer)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for _, entry := range manifest.Entries {
+		header := &tar.Header{ //nolint:exhaustruct
+			Typeflag: entry.Typeflag,
+			Name:     entry.Name,
+			Linkname: entry.Linkname,
+			Size:     entry.Size,
+			Mode:     entry.Mode,
+			Uid:      entry.Uid,
+			Gid:      entry.Gid,
+			Uname:    entry.Uname,
+			Gname:    entry.Gname,
+			ModTime:  entry.ModTime,
+		}
+		if err := tarWriter.WriteHeader(header); err != nil {
+			return stacktrace.Propagate(err, "An error occurred writing the archive header of file '%v'", entry.Name)
+		}
+		if entry.BlobHash == "" {
+			continue
+		}
+		if err := copyBlob(blobs, entry.BlobHash, tarWriter); err != nil {
+			return stacktrace.Propagate(err, "An error occurred writing the content of file '%v'", entry.Name)
+		}
+	}
+	if err := tarWriter.Close(); err != nil {
+		return stacktrace.Propagate(err, "An error occurred finishing the archive")
+	}
+	if err := gzipWriter.Close(); err != nil {
+		return stacktrace.Propagate(err, "An error occurred finishing the archive compression")
+	}
+	return nil
+}
+
+func copyBlob(blobs *blobStore, blobHash string, writer io.Writer) error {
+	blobContent, err := blobs.openBlob(blobHash)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred opening blob '%v'", blobHash)
+	}
+	defer blobContent.Close()
+	if _, err = io.Copy(writer, blobContent); err != nil {
+		return stacktrace.Propagate(err, "An error occurred copying the content of blob '%v'", blobHash)
+	}
+	return nil
+}
+
+func getBlobSize(blobs *blobStore, blobHash string) (int64, error) {
+	blobContent, err := blobs.openBlob(blobHash)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred opening blob '%v'", blobHash)
+	}
+	defer blobContent.Close()
+	blobSize, err := io.Copy(io.Discard, blobContent)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred reading the content of blob '%v'", blobHash)
+	}
+	return blobSize, nil
+}
+
+// releaseBlobs is best effort, a blob that can't be released only wastes disk space
+func releaseBlobs(blobs *blobStore, blobHashes []string) {
+	for _, blobHash := range blobHashes {
+		if err := blobs.releaseBlob(blobHash); err != nil {
+			logrus.Warnf("An error occurred releasing unreferenced blob '%v', it may stay on disk:\n%v", blobHash, err)
+		}
+	}
+}
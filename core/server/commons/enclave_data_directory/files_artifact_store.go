@@ -6,6 +6,8 @@
 package enclave_data_directory
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db/file_artifacts_db"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
@@ -13,8 +15,10 @@ import (
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	"io"
+	"os"
 	"strings"
 	"sync"
+	"time"
 )
 
 const (
@@ -23,19 +27,29 @@ const (
 	// TODO: this is something we can take a look in detail
 	// but we with random numbers as suffix, we should always be able to have some unique name available
 	maxFileArtifactNameRetriesDefault = 5
+
+	// The stores created for testing never share their blobs
+	blobsOwnerIdForTesting = "testing"
 )
 
+// FilesArtifactStore stores the content of every file of a files artifact in a blob shared with all the other files
+// artifacts that contain the same content, in this enclave or in the other enclaves the blob store is shared with.
+// Updating a files artifact adds a version to it, the last few versions being kept so that they can be compared or
+// pinned.
 type FilesArtifactStore struct {
+	// Only holds the files artifacts that were stored as a whole gzipped tarball, before blobs were introduced
 	fileCache                       *FileCache
+	blobs                           *blobStore
 	mutex                           *sync.RWMutex
 	fileArtifactDb                  *file_artifacts_db.FileArtifactPersisted
 	maxRetriesToGetFileArtifactName int
 	generateNatureThemeName         func() string
 }
 
-func newFilesArtifactStoreFromDb(absoluteDirpath string, dirpathRelativeToDataDirRoot string, db *file_artifacts_db.FileArtifactPersisted) *FilesArtifactStore {
+func newFilesArtifactStoreFromDb(absoluteDirpath string, dirpathRelativeToDataDirRoot string, blobs *blobStore, db *file_artifacts_db.FileArtifactPersisted) *FilesArtifactStore {
 	return &FilesArtifactStore{
 		fileCache:                       newFileCache(absoluteDirpath, dirpathRelativeToDataDirRoot),
+		blobs:                           blobs,
 		mutex:                           &sync.RWMutex{},
 		maxRetriesToGetFileArtifactName: maxFileArtifactNameRetriesDefault,
 		generateNatureThemeName:         name_generator.GenerateNatureThemeNameForFileArtifacts,
@@ -53,6 +67,7 @@ func NewFilesArtifactStoreForTesting(
 ) *FilesArtifactStore {
 	return &FilesArtifactStore{
 		fileCache:                       newFileCache(absoluteDirpath, dirpathRelativeToDataDirRoot),
+		blobs:                           newBlobStore(absoluteDirpath, blobsOwnerIdForTesting),
 		mutex:                           &sync.RWMutex{},
 		fileArtifactDb:                  fileArtifactDb,
 		maxRetriesToGetFileArtifactName: maxRetry,
@@ -60,8 +75,8 @@ func NewFilesArtifactStoreForTesting(
 	}
 }
 
-// StoreFile Saves file to disk.
-func (store FilesArtifactStore) StoreFile(reader io.Reader, contentMd5 []byte, artifactName string) (FilesArtifactUUID, error) {
+// StoreFile Saves file to disk. The producer describes what stored it, usually the Starlark instruction.
+func (store FilesArtifactStore) StoreFile(reader io.Reader, contentMd5 []byte, artifactName string, producer string) (FilesArtifactUUID, error) {
 	store.mutex.Lock()
 	defer store.mutex.Unlock()
 
@@ -74,7 +89,7 @@ func (store FilesArtifactStore) StoreFile(reader io.Reader, contentMd5 []byte, a
 		return "", stacktrace.NewError("Files artifact name '%v' has already been used", artifactName)
 	}
 
-	err = store.storeFilesToArtifactUuidUnlocked(artifactName, filesArtifactUuid, reader, contentMd5)
+	err = store.storeFilesToArtifactUuidUnlocked(artifactName, filesArtifactUuid, reader, contentMd5, producer)
 	if err != nil {
 		return "", stacktrace.Propagate(err, "An error occurred creating new files artifact UUID")
 	}
@@ -84,49 +99,179 @@ func (store FilesArtifactStore) StoreFile(reader io.Reader, contentMd5 []byte, a
 	return filesArtifactUuid, nil
 }
 
-func (store FilesArtifactStore) UpdateFile(filesArtifactUuid FilesArtifactUUID, reader io.Reader, contentMd5 []byte) error {
+// UpdateFile makes the content the current version of the files artifact. Nothing changes if it's identical to the
+// current version, other than its MD5. The pinned versions, used by services through identifiers like 'my-config@v2',
+// are kept even if they are older than the last versions kept.
+func (store FilesArtifactStore) UpdateFile(filesArtifactUuid FilesArtifactUUID, reader io.Reader, contentMd5 []byte, producer string, pinnedVersions map[uint32]bool) error {
 	store.mutex.Lock()
 	defer store.mutex.Unlock()
 
-	artifactName, err := store.removeFileUnlocked(filesArtifactUuid)
-	if err != nil {
-		return stacktrace.Propagate(err, "Error removing the files artifact '%s' prior to persisting its new content", filesArtifactUuid)
+	versions, found := store.getVersionsUnlocked(filesArtifactUuid)
+	if !found {
+		return stacktrace.NewError("Could not update files artifact '%s' as it doesn't exist in the store", filesArtifactUuid)
+	}
+	currentVersion := versions[len(versions)-1]
+	if currentVersion.ManifestBlobHash == "" {
+		manifestBlobHash, err := store.storeLegacyFileInBlobsUnlocked(filesArtifactUuid)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred keeping the current content of files artifact '%s' as a prior version", filesArtifactUuid)
+		}
+		currentVersion.ManifestBlobHash = manifestBlobHash
 	}
-	if err = store.storeFilesToArtifactUuidUnlocked(artifactName, filesArtifactUuid, reader, contentMd5); err != nil {
+
+	manifestBlobHash, err := store.storeContentUnlocked(reader)
+	if err != nil {
 		return stacktrace.Propagate(err, "Error persisting updated content for files artifact '%s'", filesArtifactUuid)
 	}
-	return nil
+	if manifestBlobHash == currentVersion.ManifestBlobHash {
+		// the current version already holds references to the same blobs
+		if err = store.dereferenceManifestUnlocked(manifestBlobHash); err != nil {
+			return stacktrace.Propagate(err, "An error occurred releasing the content of files artifact '%s' identical to its current version", filesArtifactUuid)
+		}
+		currentVersion.ContentMd5 = contentMd5
+	} else {
+		versions = append(versions, &file_artifacts_db.FileArtifactVersion{
+			Version:          currentVersion.Version + 1,
+			ManifestBlobHash: manifestBlobHash,
+			ContentMd5:       contentMd5,
+			CreationTime:     time.Now(),
+			Producer:         producer,
+		})
+	}
+	if len(versions) > maxFilesArtifactVersionsKept {
+		var keptVersions []*file_artifacts_db.FileArtifactVersion
+		for idx, version := range versions {
+			if idx >= len(versions)-maxFilesArtifactVersionsKept || pinnedVersions[version.Version] {
+				keptVersions = append(keptVersions, version)
+				continue
+			}
+			if err = store.dereferenceManifestUnlocked(version.ManifestBlobHash); err != nil {
+				logrus.Warnf("An error occurred releasing the content of version %d of files artifact '%s', it will stay on disk:\n%v", version.Version, filesArtifactUuid, err)
+			}
+		}
+		versions = keptVersions
+	}
+
+	store.fileArtifactDb.SetVersions(string(filesArtifactUuid), versions)
+	store.fileArtifactDb.SetManifestBlobHash(string(filesArtifactUuid), versions[len(versions)-1].ManifestBlobHash)
+	store.fileArtifactDb.SetContentMd5(string(filesArtifactUuid), contentMd5)
+	return store.persistUnlocked()
 }
 
-// GetFile Get the file by uuid, then by shortened uuid and finally by name
-func (store FilesArtifactStore) GetFile(artifactIdentifier string) (FilesArtifactUUID, *EnclaveDataDirFile, []byte, bool, error) {
+// GetFile Get the file by uuid, then by shortened uuid and finally by name. Any of them can be suffixed with
+// '@v<version>' to get a prior version of the files artifact, in which case the MD5 returned is the one of that version.
+func (store FilesArtifactStore) GetFile(artifactIdentifier string) (FilesArtifactUUID, []byte, bool, error) {
 	store.mutex.RLock()
 	defer store.mutex.RUnlock()
 
-	filesArtifactUuid := FilesArtifactUUID(artifactIdentifier)
-	fileArtifactUuid, file, contentMd5, found, err := store.getFileUnlocked(filesArtifactUuid)
-	if err == nil {
-		return fileArtifactUuid, file, contentMd5, found, nil
+	filesArtifactUuid, version, found, err := store.getVersionUnlocked(artifactIdentifier)
+	if err != nil {
+		return "", nil, false, stacktrace.Propagate(err, "An error occurred getting files artifact '%v'", artifactIdentifier)
+	}
+	if !found {
+		return "", nil, false, nil
 	}
+	return filesArtifactUuid, version.ContentMd5, true, nil
+}
 
-	filesArtifactUuids, found := store.fileArtifactDb.GetFullUuid(artifactIdentifier)
-	if found {
-		if len(filesArtifactUuids) > maxAllowedMatchesAgainstShortenedUuid {
-			return "", nil, nil, false, stacktrace.NewError("Tried using the shortened uuid '%v' to get file but found multiple matches '%v'. Use a complete uuid to be specific about what to get.", artifactIdentifier, filesArtifactUuids)
+// GetFileVersions returns the versions of the files artifact that are kept, oldest first
+func (store FilesArtifactStore) GetFileVersions(artifactIdentifier string) (FilesArtifactUUID, []*FilesArtifactVersion, bool, error) {
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+
+	filesArtifactUuid, found, err := store.getUuidUnlocked(artifactIdentifier)
+	if err != nil {
+		return "", nil, false, stacktrace.Propagate(err, "An error occurred getting files artifact '%v'", artifactIdentifier)
+	}
+	if !found {
+		return "", nil, false, nil
+	}
+	versions, _ := store.getVersionsUnlocked(filesArtifactUuid)
+	var result []*FilesArtifactVersion
+	for idx, version := range versions {
+		result = append(result, &FilesArtifactVersion{
+			version:      version.Version,
+			creationTime: version.CreationTime,
+			producer:     version.Producer,
+			isCurrent:    idx == len(versions)-1,
+		})
+	}
+	return filesArtifactUuid, result, true, nil
+}
+
+// OpenFile returns the content of the files artifact, as the gzipped tarball it was stored as, alongside its size.
+// The identifier is resolved like in GetFile. The caller is responsible for closing the content.
+func (store FilesArtifactStore) OpenFile(artifactIdentifier string) (io.ReadCloser, uint64, bool, error) {
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+
+	filesArtifactUuid, version, found, err := store.getVersionUnlocked(artifactIdentifier)
+	if err != nil {
+		return nil, 0, false, stacktrace.Propagate(err, "An error occurred getting files artifact '%v'", artifactIdentifier)
+	}
+	if !found {
+		return nil, 0, false, nil
+	}
+	if version.ManifestBlobHash == "" {
+		content, contentSize, err := store.openLegacyFileUnlocked(filesArtifactUuid)
+		if err != nil {
+			return nil, 0, false, stacktrace.Propagate(err, "An error occurred opening files artifact '%v'", filesArtifactUuid)
 		}
-		filesArtifactUuid = FilesArtifactUUID(filesArtifactUuids[0])
-		return store.getFileUnlocked(filesArtifactUuid)
+		return content, contentSize, true, nil
+	}
+	content, contentSize, err := store.openManifestUnlocked(version.ManifestBlobHash)
+	if err != nil {
+		return nil, 0, false, stacktrace.Propagate(err, "An error occurred opening version %d of files artifact '%v'", version.Version, filesArtifactUuid)
 	}
+	return content, contentSize, true, nil
+}
 
-	filesArtifactUuidGet, found := store.fileArtifactDb.GetArtifactUuid(artifactIdentifier)
-	if found {
-		return store.getFileUnlocked(FilesArtifactUUID(filesArtifactUuidGet))
+// openManifestUnlocked this is not thread safe, must be used from a thread safe context
+func (store FilesArtifactStore) openManifestUnlocked(manifestBlobHash string) (io.ReadCloser, uint64, error) {
+	manifest, err := store.getManifestUnlocked(manifestBlobHash)
+	if err != nil {
+		return nil, 0, stacktrace.Propagate(err, "An error occurred getting manifest '%v'", manifestBlobHash)
 	}
 
-	if err := store.fileArtifactDb.Persist(); err != nil {
-		return "", nil, nil, false, stacktrace.Propagate(err, "Failed persisting data on file artifacts db")
+	// The content is written to a temporary file rather than streamed, so that the blobs can't get removed while the
+	// caller is still reading it
+	contentFile, err := store.blobs.createTmpFile()
+	if err != nil {
+		return nil, 0, stacktrace.Propagate(err, "An error occurred creating the temporary file to write the files artifact to")
 	}
-	return "", nil, nil, false, nil
+	content := &removeOnCloseFile{File: contentFile}
+	shouldCloseContent := true
+	defer func() {
+		if shouldCloseContent {
+			content.Close()
+		}
+	}()
+	if err = writeFilesArtifactContent(store.blobs, manifest, contentFile); err != nil {
+		return nil, 0, stacktrace.Propagate(err, "An error occurred writing the content of the files artifact")
+	}
+	contentSize, err := contentFile.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return nil, 0, stacktrace.Propagate(err, "An error occurred getting the size of the files artifact")
+	}
+	if _, err = contentFile.Seek(0, io.SeekStart); err != nil {
+		return nil, 0, stacktrace.Propagate(err, "An error occurred rewinding the content of the files artifact")
+	}
+	shouldCloseContent = false
+	return content, uint64(contentSize), nil
+}
+
+// GetMissingBlobs returns the hashes, among the given ones, of the contents that aren't stored. The contents that are
+// stored are leased, so that they stay stored until the client uploads the content referencing them even if the files
+// artifacts having them get removed in the meantime.
+func (store FilesArtifactStore) GetMissingBlobs(blobHashes []string) ([]string, error) {
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+
+	missingBlobHashes, err := store.blobs.leaseBlobs(blobHashes)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred leasing the stored blobs")
+	}
+	return missingBlobHashes, nil
 }
 
 // RemoveFile Remove the file by uuid, then by shortened uuid and then by name
@@ -138,7 +283,7 @@ func (store FilesArtifactStore) RemoveFile(artifactIdentifier string) (deferedEr
 	filesArtifactUuid = FilesArtifactUUID(artifactIdentifier)
 	_, err := store.removeFileUnlocked(filesArtifactUuid)
 	if err == nil {
-		return nil
+		return store.persistUnlocked()
 	}
 
 	filesArtifactUuids, found := store.fileArtifactDb.GetFullUuid(artifactIdentifier)
@@ -151,7 +296,7 @@ func (store FilesArtifactStore) RemoveFile(artifactIdentifier string) (deferedEr
 		if err != nil {
 			return stacktrace.Propagate(err, "An error occurred removing files artifact with UUID '%s'", filesArtifactUuid)
 		}
-		return nil
+		return store.persistUnlocked()
 	}
 
 	filesArtifactUuidGet, found := store.fileArtifactDb.GetArtifactUuid(artifactIdentifier)
@@ -160,7 +305,7 @@ func (store FilesArtifactStore) RemoveFile(artifactIdentifier string) (deferedEr
 		if err != nil {
 			return stacktrace.Propagate(err, "An error occurred removing files artifact with UUID '%s'", filesArtifactUuid)
 		}
-		return nil
+		return store.persistUnlocked()
 	}
 
 	if err := store.fileArtifactDb.Persist(); err != nil {
@@ -226,16 +371,21 @@ func (store FilesArtifactStore) GenerateUniqueNameForFileArtifact() string {
 }
 
 // storeFilesToArtifactUuidUnlocked this is an non thread method to be used from thread safe contexts
-func (store FilesArtifactStore) storeFilesToArtifactUuidUnlocked(filesArtifactName string, filesArtifactUuid FilesArtifactUUID, reader io.Reader, contentMd5 []byte) error {
-
-	filename := strings.Join(
-		[]string{string(filesArtifactUuid), artifactExtension},
-		".",
-	)
-	_, err := store.fileCache.AddFile(filename, reader)
+func (store FilesArtifactStore) storeFilesToArtifactUuidUnlocked(filesArtifactName string, filesArtifactUuid FilesArtifactUUID, reader io.Reader, contentMd5 []byte, producer string) error {
+	manifestBlobHash, err := store.storeContentUnlocked(reader)
 	if err != nil {
-		return stacktrace.Propagate(err, "Could not store file '%s' to the file cache", filename)
+		return stacktrace.Propagate(err, "Could not store the content of files artifact '%s'", filesArtifactUuid)
 	}
+	store.fileArtifactDb.SetManifestBlobHash(string(filesArtifactUuid), manifestBlobHash)
+	store.fileArtifactDb.SetVersions(string(filesArtifactUuid), []*file_artifacts_db.FileArtifactVersion{
+		{
+			Version:          firstFilesArtifactVersion,
+			ManifestBlobHash: manifestBlobHash,
+			ContentMd5:       contentMd5,
+			CreationTime:     time.Now(),
+			Producer:         producer,
+		},
+	})
 	shortenedUuidSlice, _ := store.fileArtifactDb.GetFullUuid(uuid_generator.ShortenedUUIDString(string(filesArtifactUuid)))
 	store.fileArtifactDb.SetFullUuid(uuid_generator.ShortenedUUIDString(string(filesArtifactUuid)), append(shortenedUuidSlice, string(filesArtifactUuid)))
 	store.fileArtifactDb.SetContentMd5(string(filesArtifactUuid), contentMd5)
@@ -243,34 +393,194 @@ func (store FilesArtifactStore) storeFilesToArtifactUuidUnlocked(filesArtifactNa
 	return nil
 }
 
-// getFileUnlocked this is not thread safe, must be used from a thread safe context
-func (store FilesArtifactStore) getFileUnlocked(filesArtifactUuid FilesArtifactUUID) (FilesArtifactUUID, *EnclaveDataDirFile, []byte, bool, error) {
-	fileContentHash, found := store.fileArtifactDb.GetContentMd5(string(filesArtifactUuid))
+// storeContentUnlocked stores the content in blobs, references them and returns the hash of the manifest blob
+func (store FilesArtifactStore) storeContentUnlocked(reader io.Reader) (string, error) {
+	// The content is written to disk first as it has to be read a second time if it isn't a gzipped tarball
+	contentFile, err := store.blobs.createTmpFile()
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred creating the temporary file to receive the content in")
+	}
+	defer (&removeOnCloseFile{File: contentFile}).Close()
+	if _, err = io.Copy(contentFile, reader); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred receiving the content")
+	}
+	if _, err = contentFile.Seek(0, io.SeekStart); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred rewinding the received content")
+	}
+
+	manifest, claimedBlobHashes, err := ingestFilesArtifactContent(store.blobs, contentFile)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred storing the content in blobs")
+	}
+	serializedManifest, err := json.Marshal(manifest)
+	if err != nil {
+		releaseBlobs(store.blobs, claimedBlobHashes)
+		return "", stacktrace.Propagate(err, "An error occurred serializing the manifest")
+	}
+	manifestBlobHash, _, _, err := store.blobs.addBlob(bytes.NewReader(serializedManifest))
+	if err != nil {
+		releaseBlobs(store.blobs, claimedBlobHashes)
+		return "", stacktrace.Propagate(err, "An error occurred storing the manifest")
+	}
+
+	for _, blobHash := range append(manifest.getBlobHashes(), manifestBlobHash) {
+		store.fileArtifactDb.SetBlobReferenceCount(blobHash, store.fileArtifactDb.GetBlobReferenceCount(blobHash)+1)
+	}
+	return manifestBlobHash, nil
+}
+
+// getUuidUnlocked this is not thread safe, must be used from a thread safe context
+func (store FilesArtifactStore) getUuidUnlocked(artifactIdentifier string) (FilesArtifactUUID, bool, error) {
+	if _, found := store.fileArtifactDb.GetContentMd5(artifactIdentifier); found {
+		return FilesArtifactUUID(artifactIdentifier), true, nil
+	}
+
+	filesArtifactUuids, found := store.fileArtifactDb.GetFullUuid(artifactIdentifier)
+	if found {
+		if len(filesArtifactUuids) > maxAllowedMatchesAgainstShortenedUuid {
+			return "", false, stacktrace.NewError("Tried using the shortened uuid '%v' to get file but found multiple matches '%v'. Use a complete uuid to be specific about what to get.", artifactIdentifier, filesArtifactUuids)
+		}
+		return FilesArtifactUUID(filesArtifactUuids[0]), true, nil
+	}
+
+	filesArtifactUuid, found := store.fileArtifactDb.GetArtifactUuid(artifactIdentifier)
+	if found {
+		return FilesArtifactUUID(filesArtifactUuid), true, nil
+	}
+	return "", false, nil
+}
+
+// getVersionUnlocked returns the current version of the files artifact, unless the identifier pins another one.
+// This is not thread safe, must be used from a thread safe context.
+func (store FilesArtifactStore) getVersionUnlocked(artifactIdentifier string) (FilesArtifactUUID, *file_artifacts_db.FileArtifactVersion, bool, error) {
+	filesArtifactUuid, found, err := store.getUuidUnlocked(artifactIdentifier)
+	if err != nil {
+		return "", nil, false, stacktrace.Propagate(err, "An error occurred resolving files artifact identifier '%v'", artifactIdentifier)
+	}
+	if found {
+		versions, _ := store.getVersionsUnlocked(filesArtifactUuid)
+		return filesArtifactUuid, versions[len(versions)-1], true, nil
+	}
+
+	// a files artifact whose name ends like a version pin is still found above
+	baseArtifactIdentifier, pinnedVersion, isPinned := SplitFilesArtifactVersionPin(artifactIdentifier)
+	if !isPinned {
+		return "", nil, false, nil
+	}
+	filesArtifactUuid, found, err = store.getUuidUnlocked(baseArtifactIdentifier)
+	if err != nil {
+		return "", nil, false, stacktrace.Propagate(err, "An error occurred resolving files artifact identifier '%v'", baseArtifactIdentifier)
+	}
 	if !found {
-		return "", nil, nil, false, stacktrace.NewError("Could not retrieve file with ID '%s' as it doesn't exist in the store", filesArtifactUuid)
+		return "", nil, false, nil
 	}
-	filename := strings.Join(
-		[]string{string(filesArtifactUuid), artifactExtension},
-		".",
-	)
-	enclaveDataDirFile, err := store.fileCache.GetFile(filename)
+	versions, _ := store.getVersionsUnlocked(filesArtifactUuid)
+	var keptVersions []uint32
+	for _, version := range versions {
+		if version.Version == pinnedVersion {
+			return filesArtifactUuid, version, true, nil
+		}
+		keptVersions = append(keptVersions, version.Version)
+	}
+	return "", nil, false, stacktrace.NewError("Files artifact '%v' has no version %d, the versions kept are %v", baseArtifactIdentifier, pinnedVersion, keptVersions)
+}
+
+// getVersionsUnlocked returns the versions of the files artifact, oldest first. Files artifacts stored before
+// versions were recorded have a single one, without a manifest if they are stored as a whole gzipped tarball.
+// This is not thread safe, must be used from a thread safe context.
+func (store FilesArtifactStore) getVersionsUnlocked(filesArtifactUuid FilesArtifactUUID) ([]*file_artifacts_db.FileArtifactVersion, bool) {
+	contentMd5, found := store.fileArtifactDb.GetContentMd5(string(filesArtifactUuid))
+	if !found {
+		return nil, false
+	}
+	if versions, found := store.fileArtifactDb.GetVersions(string(filesArtifactUuid)); found && len(versions) > 0 {
+		return versions, true
+	}
+	manifestBlobHash, _ := store.fileArtifactDb.GetManifestBlobHash(string(filesArtifactUuid))
+	return []*file_artifacts_db.FileArtifactVersion{
+		{
+			Version:          firstFilesArtifactVersion,
+			ManifestBlobHash: manifestBlobHash,
+			ContentMd5:       contentMd5,
+			CreationTime:     time.Time{},
+			Producer:         "",
+		},
+	}, true
+}
+
+// storeLegacyFileInBlobsUnlocked moves a files artifact stored as a whole gzipped tarball to blobs and returns the
+// hash of its manifest. This is not thread safe, must be used from a thread safe context.
+func (store FilesArtifactStore) storeLegacyFileInBlobsUnlocked(filesArtifactUuid FilesArtifactUUID) (string, error) {
+	legacyContent, _, err := store.openLegacyFileUnlocked(filesArtifactUuid)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred opening files artifact '%v'", filesArtifactUuid)
+	}
+	manifestBlobHash, err := store.storeContentUnlocked(legacyContent)
+	legacyContent.Close()
 	if err != nil {
-		return "", nil, nil, false, stacktrace.Propagate(err, "Could not retrieve file with filename '%s' from the file cache", filename)
+		return "", stacktrace.Propagate(err, "An error occurred storing the content of files artifact '%v' in blobs", filesArtifactUuid)
+	}
+	if err = store.fileCache.RemoveFile(getLegacyFilename(filesArtifactUuid)); err != nil {
+		logrus.Warnf("An error occurred removing files artifact '%v' now stored in blobs, it will stay on disk:\n%v", filesArtifactUuid, err)
+	}
+	return manifestBlobHash, nil
+}
+
+// getManifestUnlocked this is not thread safe, must be used from a thread safe context
+func (store FilesArtifactStore) getManifestUnlocked(manifestBlobHash string) (*filesArtifactManifest, error) {
+	serializedManifest := &bytes.Buffer{}
+	if err := copyBlob(store.blobs, manifestBlobHash, serializedManifest); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading manifest blob '%s'", manifestBlobHash)
+	}
+	manifest := &filesArtifactManifest{
+		RawContentBlobHash: "",
+		Entries:            nil,
+	}
+	if err := json.Unmarshal(serializedManifest.Bytes(), manifest); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred deserializing manifest blob '%s'", manifestBlobHash)
 	}
-	return filesArtifactUuid, enclaveDataDirFile, fileContentHash, true, nil
+	return manifest, nil
+}
+
+// openLegacyFileUnlocked this is not thread safe, must be used from a thread safe context
+func (store FilesArtifactStore) openLegacyFileUnlocked(filesArtifactUuid FilesArtifactUUID) (io.ReadCloser, uint64, error) {
+	enclaveDataDirFile, err := store.fileCache.GetFile(getLegacyFilename(filesArtifactUuid))
+	if err != nil {
+		return nil, 0, stacktrace.Propagate(err, "Could not retrieve files artifact '%s' from the file cache", filesArtifactUuid)
+	}
+	file, err := os.Open(enclaveDataDirFile.GetAbsoluteFilepath())
+	if err != nil {
+		return nil, 0, stacktrace.Propagate(err, "An error occurred opening files artifact file at '%s'", enclaveDataDirFile.GetAbsoluteFilepath())
+	}
+	fileInfo, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, 0, stacktrace.Propagate(err, "An error occurred inspecting files artifact file at '%s'", enclaveDataDirFile.GetAbsoluteFilepath())
+	}
+	return file, uint64(fileInfo.Size()), nil
 }
 
 // removeFileUnlocked this is not thread safe, must be used from a thread safe context
 func (store FilesArtifactStore) removeFileUnlocked(filesArtifactUuid FilesArtifactUUID) (string, error) {
-	filename := strings.Join(
-		[]string{string(filesArtifactUuid), artifactExtension},
-		".",
-	)
+	versions, found := store.getVersionsUnlocked(filesArtifactUuid)
+	if !found {
+		return "", stacktrace.NewError("Could not remove files artifact '%v' as it doesn't exist in the store", filesArtifactUuid)
+	}
+	for _, version := range versions {
+		if version.ManifestBlobHash == "" {
+			if err := store.fileCache.RemoveFile(getLegacyFilename(filesArtifactUuid)); err != nil {
+				return "", stacktrace.Propagate(err, "There was an error in removing files artifact '%v' from the file store", filesArtifactUuid)
+			}
+			continue
+		}
+		if err := store.dereferenceManifestUnlocked(version.ManifestBlobHash); err != nil {
+			return "", stacktrace.Propagate(err, "An error occurred releasing the content of version %d of files artifact '%v'", version.Version, filesArtifactUuid)
+		}
+	}
+	store.fileArtifactDb.DeleteVersions(string(filesArtifactUuid))
+	store.fileArtifactDb.DeleteManifestBlobHash(string(filesArtifactUuid))
 
 	var artifactName string
-	if err := store.fileCache.RemoveFile(filename); err != nil {
-		return "", stacktrace.Propagate(err, "There was an error in removing '%v' from the file store", filename)
-	}
 	for name, artifactUuid := range store.fileArtifactDb.GetArtifactUuidMap() {
 		if artifactUuid == string(filesArtifactUuid) {
 			artifactName = name
@@ -298,3 +608,90 @@ func (store FilesArtifactStore) removeFileUnlocked(filesArtifactUuid FilesArtifa
 
 	return artifactName, nil
 }
+
+// dereferenceManifestUnlocked releases the references a version holds on the manifest and the blobs it lists
+func (store FilesArtifactStore) dereferenceManifestUnlocked(manifestBlobHash string) error {
+	manifest, err := store.getManifestUnlocked(manifestBlobHash)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting manifest '%v'", manifestBlobHash)
+	}
+	store.dereferenceBlobsUnlocked(append(manifest.getBlobHashes(), manifestBlobHash))
+	return nil
+}
+
+// dereferenceBlobsUnlocked releases the blobs that aren't referenced anymore
+func (store FilesArtifactStore) dereferenceBlobsUnlocked(blobHashes []string) {
+	var unreferencedBlobHashes []string
+	for _, blobHash := range blobHashes {
+		referenceCount := store.fileArtifactDb.GetBlobReferenceCount(blobHash)
+		if referenceCount > 1 {
+			store.fileArtifactDb.SetBlobReferenceCount(blobHash, referenceCount-1)
+			continue
+		}
+		store.fileArtifactDb.DeleteBlobReferenceCount(blobHash)
+		unreferencedBlobHashes = append(unreferencedBlobHashes, blobHash)
+	}
+	releaseBlobs(store.blobs, unreferencedBlobHashes)
+}
+
+// removeLeftovers removes what an APIC stopped in the middle of storing a files artifact may have left on disk, and
+// the blobs that the enclaves sharing the blob store don't use anymore, like the destroyed ones
+func (store FilesArtifactStore) removeLeftovers() error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	if err := store.blobs.removeTmpFiles(); err != nil {
+		return stacktrace.Propagate(err, "An error occurred removing temporary files")
+	}
+	// the blob store only knows whether the enclave references a blob, which has to match the counts of the database
+	for blobHash := range store.fileArtifactDb.GetBlobReferenceCountMap() {
+		if _, err := store.blobs.claimBlob(blobHash); err != nil {
+			logrus.Warnf("Blob '%v' referenced by the files artifacts of the enclave couldn't be claimed:\n%v", blobHash, err)
+		}
+	}
+	blobHashes, err := store.blobs.listReferencedBlobs()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred listing the blobs referenced by the enclave")
+	}
+	var unreferencedBlobHashes []string
+	for _, blobHash := range blobHashes {
+		if store.fileArtifactDb.GetBlobReferenceCount(blobHash) == 0 {
+			unreferencedBlobHashes = append(unreferencedBlobHashes, blobHash)
+		}
+	}
+	releaseBlobs(store.blobs, unreferencedBlobHashes)
+	if err = store.blobs.removeUnusedBlobs(); err != nil {
+		return stacktrace.Propagate(err, "An error occurred removing the blobs no enclave uses")
+	}
+	return nil
+}
+
+func (store FilesArtifactStore) persistUnlocked() error {
+	if err := store.fileArtifactDb.Persist(); err != nil {
+		return stacktrace.Propagate(err, "Failed persisting data on file artifacts db")
+	}
+	return nil
+}
+
+func getLegacyFilename(filesArtifactUuid FilesArtifactUUID) string {
+	return strings.Join(
+		[]string{string(filesArtifactUuid), artifactExtension},
+		".",
+	)
+}
+
+// removeOnCloseFile is a temporary file that is gone once closed
+type removeOnCloseFile struct {
+	*os.File
+}
+
+func (file *removeOnCloseFile) Close() error {
+	closeErr := file.File.Close()
+	if err := os.Remove(file.Name()); err != nil && !os.IsNotExist(err) {
+		logrus.Warnf("An error occurred removing temporary file '%v':\n%v", file.Name(), err)
+	}
+	if closeErr != nil {
+		return stacktrace.Propagate(closeErr, "An error occurred closing temporary file '%v'", file.Name())
+	}
+	return nil
+}
@@ -6,13 +6,28 @@
 package enclave_data_directory
 
 import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db/file_artifacts_db"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/test_helpers"
+	path_compression "github.com/kurtosis-tech/kurtosis/path-compression"
 	"github.com/stretchr/testify/require"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
+)
+
+const (
+	testProducer = "test"
+
+	testEnclaveUuid = "test-enclave"
 )
 
 func TestFileStore_StoreFileSimpleCase(t *testing.T) {
@@ -22,7 +37,7 @@ func TestFileStore_StoreFileSimpleCase(t *testing.T) {
 	reader := strings.NewReader(testContent)
 	targetArtifactName := "test-artifact-name"
 	fakeMd5 := []byte("blah")
-	filesArtifactUuid, err := fileStore.StoreFile(reader, fakeMd5, targetArtifactName)
+	filesArtifactUuid, err := fileStore.StoreFile(reader, fakeMd5, targetArtifactName, testProducer)
 	require.Equal(t, 32, len(filesArtifactUuid)) //UUID is 128 bits but in string it is hex represented chars so 32 chars
 	require.Nil(t, err)
 	require.Len(t, fileStore.fileArtifactDb.GetArtifactUuidMap(), 1)
@@ -30,17 +45,9 @@ func TestFileStore_StoreFileSimpleCase(t *testing.T) {
 	require.Len(t, fileStore.fileArtifactDb.GetContentMd5Map(), 1)
 	require.Contains(t, fileStore.fileArtifactDb.GetContentMd5Map(), string(filesArtifactUuid))
 
-	//Test that it saved where it said it would.
-	expectedFilename := strings.Join(
-		[]string{string(filesArtifactUuid), artifactExtension},
-		".",
-	)
-	expectedFilepath := filepath.Join(fileStore.fileCache.absoluteDirpath, expectedFilename)
-	_, dirErr := os.Stat(expectedFilepath)
-	require.Nil(t, dirErr)
-	file, readErr := os.ReadFile(expectedFilepath)
-	require.Nil(t, readErr)
-	require.Equal(t, []byte(testContent), file)
+	//Test that content that isn't an archive is stored as is, in a single blob
+	require.Len(t, fileStore.fileArtifactDb.GetBlobReferenceCountMap(), 2)
+	require.Equal(t, testContent, readFilesArtifactContentForTest(t, fileStore, string(filesArtifactUuid)))
 }
 
 func TestFileStore_StoringToExistingUUIDFails(t *testing.T) {
@@ -50,37 +57,33 @@ func TestFileStore_StoringToExistingUUIDFails(t *testing.T) {
 	reader := strings.NewReader(testContent)
 	testArtifactName := "test-artifact-name"
 	fakeMd5 := []byte("blah")
-	filesArtifactUuid, err := fileStore.StoreFile(reader, fakeMd5, testArtifactName)
+	filesArtifactUuid, err := fileStore.StoreFile(reader, fakeMd5, testArtifactName, testProducer)
 	require.Nil(t, err)
 	require.Equal(t, 32, len(filesArtifactUuid)) //UUID is 128 bits but in string it is hex represented chars so 32 chars
 
 	anotherTestContent := "This one should fail"
 	anotherReader := strings.NewReader(anotherTestContent)
-	_, err = fileStore.StoreFile(anotherReader, fakeMd5, testArtifactName)
+	_, err = fileStore.StoreFile(anotherReader, fakeMd5, testArtifactName, testProducer)
 	require.NotNil(t, err)
 }
 
-func TestFileStore_GetFilepathByUUIDProperFilepath(t *testing.T) {
+func TestFileStore_GetFileByUUIDProperContent(t *testing.T) {
 	fileStore, closer := getTestFileStore(t)
 	defer closer()
 	testContent := "Long Live Kurtosis!"
 	testArtifactName := "test-artifact"
 	reader := strings.NewReader(testContent)
 	fakeMd5 := []byte("blah")
-	uuid, err := fileStore.StoreFile(reader, fakeMd5, testArtifactName)
+	uuid, err := fileStore.StoreFile(reader, fakeMd5, testArtifactName, testProducer)
 	require.Nil(t, err)
 
-	returnedUuid, enclaveDataFile, returnedMd5, found, err := fileStore.GetFile(string(uuid))
+	returnedUuid, returnedMd5, found, err := fileStore.GetFile(string(uuid))
 	require.Nil(t, err)
 	require.True(t, found)
 	require.Equal(t, uuid, returnedUuid)
 	require.Equal(t, fakeMd5, returnedMd5)
 
-	_, dirErr := os.Stat(enclaveDataFile.absoluteFilepath)
-	require.Nil(t, dirErr)
-	file, readErr := os.ReadFile(enclaveDataFile.absoluteFilepath)
-	require.Nil(t, readErr)
-	require.Equal(t, []byte(testContent), file)
+	require.Equal(t, testContent, readFilesArtifactContentForTest(t, fileStore, string(returnedUuid)))
 }
 
 func TestFileStore_StoreFilesUniquely(t *testing.T) {
@@ -93,31 +96,25 @@ func TestFileStore_StoreFilesUniquely(t *testing.T) {
 	reader := strings.NewReader(testContent)
 	testArtifact1 := "test-artifact-1"
 	fakeMd5 := []byte("blah")
-	uuid, err := fileStore.StoreFile(reader, fakeMd5, testArtifact1)
+	uuid, err := fileStore.StoreFile(reader, fakeMd5, testArtifact1, testProducer)
 	require.Nil(t, err)
 
 	reader = strings.NewReader(otherTestContent)
 	testArtifact2 := "test-artifact-2"
 	fakeMd52 := []byte("blah2")
-	anotherUUID, err := fileStore.StoreFile(reader, fakeMd52, testArtifact2)
+	anotherUUID, err := fileStore.StoreFile(reader, fakeMd52, testArtifact2, testProducer)
 	require.Nil(t, err)
 	require.NotEqual(t, uuid, anotherUUID)
 
-	//Get their paths.
-	_, enclaveDataFile, _, found, err := fileStore.GetFile(string(uuid))
+	//Read and evaluate their content is different.
+	_, _, found, err := fileStore.GetFile(string(uuid))
 	require.Nil(t, err)
 	require.True(t, found)
-	_, anotherFilepath, _, anotherFound, err := fileStore.GetFile(string(anotherUUID))
+	_, _, anotherFound, err := fileStore.GetFile(string(anotherUUID))
 	require.Nil(t, err)
 	require.True(t, anotherFound)
-	require.NotEqual(t, enclaveDataFile, anotherFilepath)
-
-	//Read and evaluate their content is different.
-	file, readErr := os.ReadFile(enclaveDataFile.absoluteFilepath)
-	require.Nil(t, readErr)
-	anotherFile, readErr := os.ReadFile(anotherFilepath.absoluteFilepath)
-	require.Nil(t, readErr)
-	require.NotEqual(t, file, anotherFile)
+	require.Equal(t, testContent, readFilesArtifactContentForTest(t, fileStore, string(uuid)))
+	require.Equal(t, otherTestContent, readFilesArtifactContentForTest(t, fileStore, string(anotherUUID)))
 }
 
 func TestFileStore_RemoveFileRemovesFileFromDisk(t *testing.T) {
@@ -127,12 +124,12 @@ func TestFileStore_RemoveFileRemovesFileFromDisk(t *testing.T) {
 	reader := strings.NewReader(testContent)
 	testArtifactName := "test-artifact"
 	fakeMd5 := []byte("blah")
-	uuid, err := fileStore.StoreFile(reader, fakeMd5, testArtifactName)
+	uuid, err := fileStore.StoreFile(reader, fakeMd5, testArtifactName, testProducer)
 	require.Nil(t, err)
 	require.Len(t, fileStore.fileArtifactDb.GetFullUuidMap(), 1)
 	require.Len(t, fileStore.fileArtifactDb.GetArtifactUuidMap(), 1)
 
-	_, enclaveDataFile, _, found, err := fileStore.GetFile(string(uuid))
+	_, _, found, err := fileStore.GetFile(string(uuid))
 	require.Nil(t, err)
 	require.True(t, found)
 
@@ -141,10 +138,11 @@ func TestFileStore_RemoveFileRemovesFileFromDisk(t *testing.T) {
 	require.Len(t, fileStore.fileArtifactDb.GetFullUuidMap(), 0)
 	require.Len(t, fileStore.fileArtifactDb.GetArtifactUuidMap(), 0)
 	require.Len(t, fileStore.fileArtifactDb.GetContentMd5Map(), 0)
+	require.Len(t, fileStore.fileArtifactDb.GetBlobReferenceCountMap(), 0)
 
-	_, err = os.Stat(enclaveDataFile.absoluteFilepath)
-	require.NotNil(t, err)
-	require.True(t, os.IsNotExist(err))
+	blobFiles, err := os.ReadDir(fileStore.blobs.blobsDirpath)
+	require.Nil(t, err)
+	require.Empty(t, blobFiles)
 }
 
 func TestFileStore_RemoveFileFailsForNonExistentId(t *testing.T) {
@@ -167,13 +165,13 @@ func TestFilesArtifactStore_GetFileNamesAndUuids(t *testing.T) {
 	reader := strings.NewReader(testContent)
 	testArtifact1 := "test-artifact-1"
 	fakeMd5 := []byte("blah")
-	uuid, err := fileStore.StoreFile(reader, fakeMd5, testArtifact1)
+	uuid, err := fileStore.StoreFile(reader, fakeMd5, testArtifact1, testProducer)
 	require.Nil(t, err)
 
 	reader = strings.NewReader(otherTestContent)
 	testArtifact2 := "test-artifact-2"
 	fakeMd52 := []byte("blah")
-	anotherUUID, err := fileStore.StoreFile(reader, fakeMd52, testArtifact2)
+	anotherUUID, err := fileStore.StoreFile(reader, fakeMd52, testArtifact2, testProducer)
 	require.Nil(t, err)
 	require.NotEqual(t, uuid, anotherUUID)
 
@@ -183,18 +181,303 @@ func TestFilesArtifactStore_GetFileNamesAndUuids(t *testing.T) {
 	require.Contains(t, fileNameAndUuids, FileNameAndUuid{uuid: anotherUUID, name: testArtifact2})
 }
 
+func TestFileStore_StoreArchivesDeduplicatesFiles(t *testing.T) {
+	fileStore, closer := getTestFileStore(t)
+	defer closer()
+	sharedContent := "shared by both artifacts"
+	firstArchiveFiles := map[string]string{
+		"config/genesis.json": sharedContent,
+		"config/first.txt":    "only in the first artifact",
+	}
+	secondArchiveFiles := map[string]string{
+		"genesis.json": sharedContent,
+		"second.txt":   "only in the second artifact",
+	}
+
+	firstUuid, err := fileStore.StoreFile(bytes.NewReader(createArchiveForTest(t, firstArchiveFiles, nil)), []byte("first"), "first", testProducer)
+	require.Nil(t, err)
+	secondUuid, err := fileStore.StoreFile(bytes.NewReader(createArchiveForTest(t, secondArchiveFiles, nil)), []byte("second"), "second", testProducer)
+	require.Nil(t, err)
+
+	// 2 manifests + 3 distinct file contents
+	require.Len(t, fileStore.fileArtifactDb.GetBlobReferenceCountMap(), 5)
+	require.Equal(t, uint32(2), fileStore.fileArtifactDb.GetBlobReferenceCount(getSha256ForTest(sharedContent)))
+	require.Equal(t, firstArchiveFiles, readArchiveForTest(t, fileStore, string(firstUuid)))
+	require.Equal(t, secondArchiveFiles, readArchiveForTest(t, fileStore, string(secondUuid)))
+
+	require.Nil(t, fileStore.RemoveFile("first"))
+	require.Len(t, fileStore.fileArtifactDb.GetBlobReferenceCountMap(), 3)
+	missingBlobHashes, err := fileStore.GetMissingBlobs([]string{getSha256ForTest(sharedContent)})
+	require.Nil(t, err)
+	require.Empty(t, missingBlobHashes)
+	require.Equal(t, secondArchiveFiles, readArchiveForTest(t, fileStore, string(secondUuid)))
+
+	// the shared content is kept while the lease taken by looking for it runs
+	expireBlobLeasesForTest(t, fileStore)
+	require.Nil(t, fileStore.RemoveFile("second"))
+	require.Len(t, fileStore.fileArtifactDb.GetBlobReferenceCountMap(), 0)
+	blobFiles, err := os.ReadDir(fileStore.blobs.blobsDirpath)
+	require.Nil(t, err)
+	require.Empty(t, blobFiles)
+}
+
+func TestFileStore_StoreArchiveWithBlobReferences(t *testing.T) {
+	fileStore, closer := getTestFileStore(t)
+	defer closer()
+	storedContent := "already stored"
+	_, err := fileStore.StoreFile(bytes.NewReader(createArchiveForTest(t, map[string]string{"stored.txt": storedContent}, nil)), []byte{}, "stored", testProducer)
+	require.Nil(t, err)
+
+	missingBlobHash := getSha256ForTest("never stored")
+	missingBlobHashes, err := fileStore.GetMissingBlobs([]string{getSha256ForTest(storedContent), missingBlobHash, "../not-a-hash"})
+	require.Nil(t, err)
+	require.Equal(t, []string{missingBlobHash, "../not-a-hash"}, missingBlobHashes)
+
+	archiveWithReference := createArchiveForTest(t, map[string]string{"new.txt": "new"}, map[string]string{"reference.txt": getSha256ForTest(storedContent)})
+	filesArtifactUuid, err := fileStore.StoreFile(bytes.NewReader(archiveWithReference), []byte{}, "with-reference", testProducer)
+	require.Nil(t, err)
+	require.Equal(t, map[string]string{"new.txt": "new", "reference.txt": storedContent}, readArchiveForTest(t, fileStore, string(filesArtifactUuid)))
+	require.Equal(t, uint32(2), fileStore.fileArtifactDb.GetBlobReferenceCount(getSha256ForTest(storedContent)))
+
+	archiveWithMissingReference := createArchiveForTest(t, map[string]string{"other.txt": "other"}, map[string]string{"missing.txt": missingBlobHash})
+	_, err = fileStore.StoreFile(bytes.NewReader(archiveWithMissingReference), []byte{}, "with-missing-reference", testProducer)
+	require.NotNil(t, err)
+	require.False(t, fileStore.CheckIfArtifactNameExists("with-missing-reference"))
+	require.False(t, fileStore.blobs.hasBlob(getSha256ForTest("other")))
+}
+
+func TestFileStore_FilesArtifactStoredBeforeBlobs(t *testing.T) {
+	fileStore, closer := getTestFileStore(t)
+	defer closer()
+	filesArtifactUuid, err := NewFilesArtifactUUID()
+	require.Nil(t, err)
+	archive := createArchiveForTest(t, map[string]string{"legacy.txt": "stored as a whole"}, nil)
+	legacyFilepath := filepath.Join(fileStore.fileCache.absoluteDirpath, strings.Join([]string{string(filesArtifactUuid), artifactExtension}, "."))
+	require.Nil(t, os.WriteFile(legacyFilepath, archive, 0600))
+	fileStore.fileArtifactDb.SetArtifactUuid("legacy", string(filesArtifactUuid))
+	fileStore.fileArtifactDb.SetContentMd5(string(filesArtifactUuid), []byte{})
+
+	returnedUuid, _, found, err := fileStore.GetFile("legacy")
+	require.Nil(t, err)
+	require.True(t, found)
+	require.Equal(t, string(archive), readFilesArtifactContentForTest(t, fileStore, string(returnedUuid)))
+
+	require.Nil(t, fileStore.RemoveFile("legacy"))
+	_, err = os.Stat(legacyFilepath)
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestFileStore_UpdateFileKeepsPriorVersions(t *testing.T) {
+	fileStore, closer := getTestFileStore(t)
+	defer closer()
+	firstArchiveFiles := map[string]string{"config.yml": "port: 80"}
+	secondArchiveFiles := map[string]string{"config.yml": "port: 8080"}
+	filesArtifactUuid, err := fileStore.StoreFile(bytes.NewReader(createArchiveForTest(t, firstArchiveFiles, nil)), []byte("first"), "config", "upload_files")
+	require.Nil(t, err)
+	require.Nil(t, fileStore.UpdateFile(filesArtifactUuid, bytes.NewReader(createArchiveForTest(t, secondArchiveFiles, nil)), []byte("second"), "render_templates", nil))
+
+	require.Equal(t, secondArchiveFiles, readArchiveForTest(t, fileStore, "config"))
+	require.Equal(t, firstArchiveFiles, readArchiveForTest(t, fileStore, "config@v1"))
+	require.Equal(t, secondArchiveFiles, readArchiveForTest(t, fileStore, string(filesArtifactUuid)+"@v2"))
+	_, contentMd5, found, err := fileStore.GetFile("config@v1")
+	require.Nil(t, err)
+	require.True(t, found)
+	require.Equal(t, []byte("first"), contentMd5)
+	_, _, _, err = fileStore.OpenFile("config@v3")
+	require.NotNil(t, err)
+
+	returnedUuid, versions, found, err := fileStore.GetFileVersions("config")
+	require.Nil(t, err)
+	require.True(t, found)
+	require.Equal(t, filesArtifactUuid, returnedUuid)
+	require.Len(t, versions, 2)
+	require.Equal(t, uint32(1), versions[0].GetVersion())
+	require.Equal(t, "upload_files", versions[0].GetProducer())
+	require.False(t, versions[0].IsCurrent())
+	require.Equal(t, uint32(2), versions[1].GetVersion())
+	require.Equal(t, "render_templates", versions[1].GetProducer())
+	require.True(t, versions[1].IsCurrent())
+
+	// updating with the current content doesn't add a version
+	require.Nil(t, fileStore.UpdateFile(filesArtifactUuid, bytes.NewReader(createArchiveForTest(t, secondArchiveFiles, nil)), []byte("second"), "render_templates", nil))
+	_, versions, _, err = fileStore.GetFileVersions("config")
+	require.Nil(t, err)
+	require.Len(t, versions, 2)
+
+	require.Nil(t, fileStore.RemoveFile("config"))
+	require.Empty(t, fileStore.fileArtifactDb.GetBlobReferenceCountMap())
+}
+
+func TestFileStore_UpdateFileDropsOldestVersions(t *testing.T) {
+	fileStore, closer := getTestFileStore(t)
+	defer closer()
+	filesArtifactUuid, err := fileStore.StoreFile(strings.NewReader("content 1"), []byte{}, "config", testProducer)
+	require.Nil(t, err)
+	for versionIdx := 2; versionIdx <= maxFilesArtifactVersionsKept+2; versionIdx++ {
+		require.Nil(t, fileStore.UpdateFile(filesArtifactUuid, strings.NewReader(fmt.Sprintf("content %d", versionIdx)), []byte{}, testProducer, nil))
+	}
+
+	_, versions, _, err := fileStore.GetFileVersions("config")
+	require.Nil(t, err)
+	require.Len(t, versions, maxFilesArtifactVersionsKept)
+	require.Equal(t, uint32(3), versions[0].GetVersion())
+	require.False(t, fileStore.blobs.hasBlob(getSha256ForTest("content 1")))
+	require.False(t, fileStore.blobs.hasBlob(getSha256ForTest("content 2")))
+	require.Equal(t, "content 3", readFilesArtifactContentForTest(t, fileStore, "config@v3"))
+	// a manifest and a content blob per version kept
+	require.Len(t, fileStore.fileArtifactDb.GetBlobReferenceCountMap(), 2*maxFilesArtifactVersionsKept)
+}
+
+func TestFileStore_UpdateFileKeepsPinnedVersions(t *testing.T) {
+	fileStore, closer := getTestFileStore(t)
+	defer closer()
+	filesArtifactUuid, err := fileStore.StoreFile(strings.NewReader("content 1"), []byte{}, "config", testProducer)
+	require.Nil(t, err)
+	pinnedVersions := map[uint32]bool{2: true}
+	for versionIdx := 2; versionIdx <= maxFilesArtifactVersionsKept+2; versionIdx++ {
+		require.Nil(t, fileStore.UpdateFile(filesArtifactUuid, strings.NewReader(fmt.Sprintf("content %d", versionIdx)), []byte{}, testProducer, pinnedVersions))
+	}
+
+	_, versions, _, err := fileStore.GetFileVersions("config")
+	require.Nil(t, err)
+	require.Len(t, versions, maxFilesArtifactVersionsKept+1)
+	require.Equal(t, uint32(2), versions[0].GetVersion())
+	require.Equal(t, uint32(3), versions[1].GetVersion())
+	require.Equal(t, "content 2", readFilesArtifactContentForTest(t, fileStore, "config@v2"))
+	require.False(t, fileStore.blobs.hasBlob(getSha256ForTest("content 1")))
+
+	// the version is dropped by the next update once nothing pins it anymore
+	require.Nil(t, fileStore.UpdateFile(filesArtifactUuid, strings.NewReader("content 13"), []byte{}, testProducer, nil))
+	_, versions, _, err = fileStore.GetFileVersions("config")
+	require.Nil(t, err)
+	require.Len(t, versions, maxFilesArtifactVersionsKept)
+	require.Equal(t, uint32(4), versions[0].GetVersion())
+	require.False(t, fileStore.blobs.hasBlob(getSha256ForTest("content 2")))
+}
+
+func TestFileStore_UpdateFileStoredBeforeBlobs(t *testing.T) {
+	fileStore, closer := getTestFileStore(t)
+	defer closer()
+	filesArtifactUuid, err := NewFilesArtifactUUID()
+	require.Nil(t, err)
+	legacyArchiveFiles := map[string]string{"legacy.txt": "stored as a whole"}
+	legacyFilepath := filepath.Join(fileStore.fileCache.absoluteDirpath, strings.Join([]string{string(filesArtifactUuid), artifactExtension}, "."))
+	require.Nil(t, os.WriteFile(legacyFilepath, createArchiveForTest(t, legacyArchiveFiles, nil), 0600))
+	fileStore.fileArtifactDb.SetArtifactUuid("legacy", string(filesArtifactUuid))
+	fileStore.fileArtifactDb.SetContentMd5(string(filesArtifactUuid), []byte{})
+
+	updatedArchiveFiles := map[string]string{"legacy.txt": "stored in blobs"}
+	require.Nil(t, fileStore.UpdateFile(filesArtifactUuid, bytes.NewReader(createArchiveForTest(t, updatedArchiveFiles, nil)), []byte{}, testProducer, nil))
+	_, err = os.Stat(legacyFilepath)
+	require.True(t, os.IsNotExist(err))
+	require.Equal(t, legacyArchiveFiles, readArchiveForTest(t, fileStore, "legacy@v1"))
+	require.Equal(t, updatedArchiveFiles, readArchiveForTest(t, fileStore, "legacy"))
+}
+
+func TestFileStore_RemoveLeftovers(t *testing.T) {
+	fileStore, closer := getTestFileStore(t)
+	defer closer()
+	_, err := fileStore.StoreFile(strings.NewReader("referenced"), []byte{}, "referenced", testProducer)
+	require.Nil(t, err)
+	unreferencedBlobHash, _, _, err := fileStore.blobs.addBlob(strings.NewReader("unreferenced"))
+	require.Nil(t, err)
+	tmpFile, err := fileStore.blobs.createTmpFile()
+	require.Nil(t, err)
+	require.Nil(t, tmpFile.Close())
+
+	require.Nil(t, fileStore.removeLeftovers())
+	require.False(t, fileStore.blobs.hasBlob(unreferencedBlobHash))
+	require.True(t, fileStore.blobs.hasBlob(getSha256ForTest("referenced")))
+	_, err = os.Stat(tmpFile.Name())
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestFileStore_EnclavesSharingBlobsDeduplicateFiles(t *testing.T) {
+	blobStoreDirpath, err := os.MkdirTemp("", "")
+	require.Nil(t, err)
+	firstFileStore, firstCloser := getTestFileStoreSharingBlobs(t, blobStoreDirpath, "first-enclave")
+	defer firstCloser()
+	secondFileStore, secondCloser := getTestFileStoreSharingBlobs(t, blobStoreDirpath, "second-enclave")
+	defer secondCloser()
+	sharedContent := "stored by the first enclave"
+	sharedBlobHash := getSha256ForTest(sharedContent)
+	_, err = firstFileStore.StoreFile(bytes.NewReader(createArchiveForTest(t, map[string]string{"shared.txt": sharedContent}, nil)), []byte{}, "shared", testProducer)
+	require.Nil(t, err)
+
+	missingBlobHashes, err := secondFileStore.GetMissingBlobs([]string{sharedBlobHash})
+	require.Nil(t, err)
+	require.Empty(t, missingBlobHashes)
+	archiveWithReference := createArchiveForTest(t, nil, map[string]string{"shared.txt": sharedBlobHash})
+	_, err = secondFileStore.StoreFile(bytes.NewReader(archiveWithReference), []byte{}, "shared", testProducer)
+	require.Nil(t, err)
+
+	require.Nil(t, firstFileStore.RemoveFile("shared"))
+	require.Equal(t, map[string]string{"shared.txt": sharedContent}, readArchiveForTest(t, secondFileStore, "shared"))
+
+	// the lease the second enclave took isn't needed anymore
+	expireBlobLeasesForTest(t, secondFileStore)
+	require.Nil(t, secondFileStore.RemoveFile("shared"))
+	require.False(t, secondFileStore.blobs.hasBlob(sharedBlobHash))
+}
+
+func TestFileStore_GetMissingBlobsKeepsFoundBlobsStored(t *testing.T) {
+	fileStore, closer := getTestFileStore(t)
+	defer closer()
+	storedContent := "removed before being referenced"
+	storedBlobHash := getSha256ForTest(storedContent)
+	_, err := fileStore.StoreFile(bytes.NewReader(createArchiveForTest(t, map[string]string{"stored.txt": storedContent}, nil)), []byte{}, "stored", testProducer)
+	require.Nil(t, err)
+
+	missingBlobHashes, err := fileStore.GetMissingBlobs([]string{storedBlobHash})
+	require.Nil(t, err)
+	require.Empty(t, missingBlobHashes)
+	require.Nil(t, fileStore.RemoveFile("stored"))
+	require.True(t, fileStore.blobs.hasBlob(storedBlobHash))
+
+	archiveWithReference := createArchiveForTest(t, nil, map[string]string{"reference.txt": storedBlobHash})
+	_, err = fileStore.StoreFile(bytes.NewReader(archiveWithReference), []byte{}, "with-reference", testProducer)
+	require.Nil(t, err)
+	require.Equal(t, map[string]string{"reference.txt": storedContent}, readArchiveForTest(t, fileStore, "with-reference"))
+
+	// a blob leased but never referenced is removed once the lease expires
+	_, err = fileStore.StoreFile(strings.NewReader("never referenced again"), []byte{}, "unreferenced", testProducer)
+	require.Nil(t, err)
+	_, err = fileStore.GetMissingBlobs([]string{getSha256ForTest("never referenced again")})
+	require.Nil(t, err)
+	require.Nil(t, fileStore.RemoveFile("unreferenced"))
+	require.True(t, fileStore.blobs.hasBlob(getSha256ForTest("never referenced again")))
+	expireBlobLeasesForTest(t, fileStore)
+	require.Nil(t, fileStore.removeLeftovers())
+	require.False(t, fileStore.blobs.hasBlob(getSha256ForTest("never referenced again")))
+	require.True(t, fileStore.blobs.hasBlob(storedBlobHash))
+}
+
 func getTestFileStore(t *testing.T) (*FilesArtifactStore, func()) {
+	absDirpath, err := os.MkdirTemp("", "")
+	require.Nil(t, err)
+	return getTestFileStoreSharingBlobs(t, absDirpath, testEnclaveUuid)
+}
+
+func getTestFileStoreSharingBlobs(t *testing.T, blobStoreDirpath string, enclaveUuid string) (*FilesArtifactStore, func()) {
 	absDirpath, err := os.MkdirTemp("", "")
 	require.Nil(t, err)
 	db, closer, err := test_helpers.CreateEnclaveDbForTesting()
 	require.Nil(t, err)
 	fileArtifactDb, err := file_artifacts_db.GetFileArtifactsDbForTesting(db, map[string]string{})
 	require.Nil(t, err)
-	fileStore := newFilesArtifactStoreFromDb(absDirpath, "", fileArtifactDb)
-	require.Nil(t, err)
+	fileStore := newFilesArtifactStoreFromDb(absDirpath, "", newBlobStore(blobStoreDirpath, enclaveUuid), fileArtifactDb)
 	return fileStore, closer
 }
 
+func expireBlobLeasesForTest(t *testing.T, fileStore *FilesArtifactStore) {
+	leaseFiles, err := os.ReadDir(fileStore.blobs.leasesDirpath)
+	require.Nil(t, err)
+	expiry := time.Now().Add(-time.Second)
+	for _, leaseFile := range leaseFiles {
+		require.Nil(t, os.Chtimes(filepath.Join(fileStore.blobs.leasesDirpath, leaseFile.Name()), expiry, expiry))
+	}
+}
+
 func Test_generateUniqueNameForFileArtifact_MaxRetriesOver(t *testing.T) {
 	timesCalled := 0
 	// this method should be call 4 time (maxRetries + 1)
@@ -247,3 +530,57 @@ func Test_generateUniqueNameForFileArtifact_Found(t *testing.T) {
 	actual := fileArtifactStoreUnderTest.GenerateUniqueNameForFileArtifact()
 	require.Equal(t, "unique-name", actual)
 }
+
+func readFilesArtifactContentForTest(t *testing.T, fileStore *FilesArtifactStore, artifactIdentifier string) string {
+	content, contentSize, found, err := fileStore.OpenFile(artifactIdentifier)
+	require.Nil(t, err)
+	require.True(t, found)
+	defer content.Close()
+	contentBytes, err := io.ReadAll(content)
+	require.Nil(t, err)
+	require.Equal(t, uint64(len(contentBytes)), contentSize)
+	return string(contentBytes)
+}
+
+// createArchiveForTest returns a gzipped tarball with the given files, and entries referencing already stored content
+func createArchiveForTest(t *testing.T, contentByFilename map[string]string, referencedBlobHashByFilename map[string]string) []byte {
+	archive := &bytes.Buffer{}
+	gzipWriter := gzip.NewWriter(archive)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for filename, content := range contentByFilename {
+		require.Nil(t, tarWriter.WriteHeader(&tar.Header{Typeflag: tar.TypeReg, Name: filename, Size: int64(len(content)), Mode: 0644})) //nolint:exhaustruct
+		_, err := tarWriter.Write([]byte(content))
+		require.Nil(t, err)
+	}
+	for filename, referencedBlobHash := range referencedBlobHashByFilename {
+		require.Nil(t, tarWriter.WriteHeader(&tar.Header{Typeflag: tar.TypeReg, Name: filename, Size: 0, Mode: 0644, PAXRecords: map[string]string{ //nolint:exhaustruct
+			path_compression.BlobSha256PaxRecordKey: referencedBlobHash,
+		}}))
+	}
+	require.Nil(t, tarWriter.Close())
+	require.Nil(t, gzipWriter.Close())
+	return archive.Bytes()
+}
+
+func readArchiveForTest(t *testing.T, fileStore *FilesArtifactStore, artifactIdentifier string) map[string]string {
+	gzipReader, err := gzip.NewReader(strings.NewReader(readFilesArtifactContentForTest(t, fileStore, artifactIdentifier)))
+	require.Nil(t, err)
+	tarReader := tar.NewReader(gzipReader)
+	contentByFilename := map[string]string{}
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		require.Nil(t, err)
+		content, err := io.ReadAll(tarReader)
+		require.Nil(t, err)
+		contentByFilename[header.Name] = string(content)
+	}
+	return contentByFilename
+}
+
+func getSha256ForTest(content string) string {
+	contentSha256 := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(contentSha256[:])
+}
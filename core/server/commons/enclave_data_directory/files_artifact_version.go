@@ -0,0 +1,59 @@
+package enclave_data_directory
+
+import (
+	"regexp"
+	"strconv"
+	"time"
+)
+
+const (
+	// How many versions of a files artifact are kept, the oldest ones being dropped when it gets updated
+	maxFilesArtifactVersionsKept = 10
+
+	firstFilesArtifactVersion = uint32(1)
+
+	filesArtifactVersionPinBaseIdentifierGroupIdx = 1
+	filesArtifactVersionPinVersionGroupIdx        = 2
+)
+
+// A files artifact identifier can pin one of its versions by ending with '@v<version>', e.g. 'my-config@v2'
+var filesArtifactVersionPinRegex = regexp.MustCompile(`^(.+)@v([1-9][0-9]*)$`)
+
+type FilesArtifactVersion struct {
+	version      uint32
+	creationTime time.Time
+	producer     string
+	isCurrent    bool
+}
+
+func (version FilesArtifactVersion) GetVersion() uint32 {
+	return version.version
+}
+
+// GetCreationTime returns the zero time for files artifacts stored before versions were recorded
+func (version FilesArtifactVersion) GetCreationTime() time.Time {
+	return version.creationTime
+}
+
+// GetProducer describes what stored this version, it's empty for files artifacts stored before versions were recorded
+func (version FilesArtifactVersion) GetProducer() string {
+	return version.producer
+}
+
+func (version FilesArtifactVersion) IsCurrent() bool {
+	return version.isCurrent
+}
+
+// SplitFilesArtifactVersionPin splits an identifier like 'my-config@v2' into the identifier of the files artifact and
+// the version it pins. It returns false if the identifier doesn't pin any version.
+func SplitFilesArtifactVersionPin(artifactIdentifier string) (string, uint32, bool) {
+	matches := filesArtifactVersionPinRegex.FindStringSubmatch(artifactIdentifier)
+	if matches == nil {
+		return "", 0, false
+	}
+	version, err := strconv.ParseUint(matches[filesArtifactVersionPinVersionGroupIdx], 10, 32)
+	if err != nil {
+		return "", 0, false
+	}
+	return matches[filesArtifactVersionPinBaseIdentifierGroupIdx], uint32(version), true
+}
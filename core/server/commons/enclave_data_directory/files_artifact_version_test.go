@@ -0,0 +1,18 @@
+package enclave_data_directory
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestSplitFilesArtifactVersionPin(t *testing.T) {
+	artifactIdentifier, version, isPinned := SplitFilesArtifactVersionPin("my-config@v12")
+	require.True(t, isPinned)
+	require.Equal(t, "my-config", artifactIdentifier)
+	require.Equal(t, uint32(12), version)
+
+	for _, unpinnedIdentifier := range []string{"my-config", "my-config@v0", "my-config@v", "@v1", "my-config@v1.2", "my-config@v99999999999"} {
+		_, _, isPinned = SplitFilesArtifactVersionPin(unpinnedIdentifier)
+		require.False(t, isPinned, unpinnedIdentifier)
+	}
+}
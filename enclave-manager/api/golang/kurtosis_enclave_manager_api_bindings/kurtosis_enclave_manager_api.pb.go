@@ -1186,7 +1186,7 @@ var file_kurtosis_enclave_manager_api_proto_rawDesc = []byte{
 	0x53, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x50,
 	0x6c, 0x61, 0x6e, 0x59, 0x61, 0x6d, 0x6c, 0x41, 0x72, 0x67, 0x73, 0x52, 0x1b, 0x73, 0x74, 0x61,
 	0x72, 0x6c, 0x61, 0x72, 0x6b, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x50, 0x6c, 0x61, 0x6e,
-	0x59, 0x61, 0x6d, 0x6c, 0x41, 0x72, 0x67, 0x73, 0x32, 0xd6, 0x12, 0x0a, 0x1c, 0x4b, 0x75, 0x72,
+	0x59, 0x61, 0x6d, 0x6c, 0x41, 0x72, 0x67, 0x73, 0x32, 0xa2, 0x13, 0x0a, 0x1c, 0x4b, 0x75, 0x72,
 	0x74, 0x6f, 0x73, 0x69, 0x73, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x4d, 0x61, 0x6e, 0x61,
 	0x67, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x64, 0x0a, 0x05, 0x43, 0x68, 0x65,
 	0x63, 0x6b, 0x12, 0x2c, 0x2e, 0x6b, 0x75, 0x72, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x5f, 0x65, 0x6e,
@@ -1336,13 +1336,18 @@ var file_kurtosis_enclave_manager_api_proto_rawDesc = []byte{
 	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
 	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x00, 0x42, 0x64, 0x5a, 0x62, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x6b, 0x75, 0x72, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2d, 0x74, 0x65, 0x63, 0x68, 0x2f, 0x6b, 0x75,
-	0x72, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2f, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x2d, 0x6d,
-	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x6f, 0x6c, 0x61, 0x6e,
-	0x67, 0x2f, 0x6b, 0x75, 0x72, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x5f, 0x65, 0x6e, 0x63, 0x6c, 0x61,
-	0x76, 0x65, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x5f, 0x62,
-	0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x00, 0x12, 0x4a, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x20, 0x2e, 0x65, 0x6e, 0x67, 0x69,
+	0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x64, 0x5a,
+	0x62, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x72, 0x74,
+	0x6f, 0x73, 0x69, 0x73, 0x2d, 0x74, 0x65, 0x63, 0x68, 0x2f, 0x6b, 0x75, 0x72, 0x74, 0x6f, 0x73,
+	0x69, 0x73, 0x2f, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x2d, 0x6d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x2f, 0x6b, 0x75,
+	0x72, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x5f, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x5f, 0x62, 0x69, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1400,6 +1405,7 @@ var file_kurtosis_enclave_manager_api_proto_goTypes = []interface{}{
 	(*kurtosis_core_rpc_api_bindings.PlanYaml)(nil),                                        // 37: api_container_api.PlanYaml
 	(*kurtosis_backend_server_rpc_api_bindings.GetCloudInstanceConfigResponse)(nil),        // 38: kurtosis_cloud.GetCloudInstanceConfigResponse
 	(*kurtosis_backend_server_rpc_api_bindings.IsNewKurtosisVersionAvailableResponse)(nil), // 39: kurtosis_cloud.IsNewKurtosisVersionAvailableResponse
+	(*kurtosis_engine_rpc_api_bindings.GetSchedulesResponse)(nil),                          // 40: engine_api.GetSchedulesResponse
 }
 var file_kurtosis_enclave_manager_api_proto_depIdxs = []int32{
 	0,  // 0: kurtosis_enclave_manager.HealthCheckResponse.status:type_name -> kurtosis_enclave_manager.HealthCheckResponse.ServingStatus
@@ -1432,30 +1438,32 @@ var file_kurtosis_enclave_manager_api_proto_depIdxs = []int32{
 	14, // 27: kurtosis_enclave_manager.KurtosisEnclaveManagerServer.AddAlias:input_type -> kurtosis_enclave_manager.AddAliasRequest
 	24, // 28: kurtosis_enclave_manager.KurtosisEnclaveManagerServer.IsNewKurtosisVersionAvailable:input_type -> google.protobuf.Empty
 	24, // 29: kurtosis_enclave_manager.KurtosisEnclaveManagerServer.UpgradeKurtosisVersion:input_type -> google.protobuf.Empty
-	3,  // 30: kurtosis_enclave_manager.KurtosisEnclaveManagerServer.Check:output_type -> kurtosis_enclave_manager.HealthCheckResponse
-	28, // 31: kurtosis_enclave_manager.KurtosisEnclaveManagerServer.GetEnclaves:output_type -> engine_api.GetEnclavesResponse
-	28, // 32: kurtosis_enclave_manager.KurtosisEnclaveManagerServer.GetEnclavesByUuids:output_type -> engine_api.GetEnclavesResponse
-	29, // 33: kurtosis_enclave_manager.KurtosisEnclaveManagerServer.GetServices:output_type -> api_container_api.GetServicesResponse
-	30, // 34: kurtosis_enclave_manager.KurtosisEnclaveManagerServer.GetServiceLogs:output_type -> engine_api.GetServiceLogsResponse
-	31, // 35: kurtosis_enclave_manager.KurtosisEnclaveManagerServer.ListFilesArtifactNamesAndUuids:output_type -> api_container_api.ListFilesArtifactNamesAndUuidsResponse
-	32, // 36: kurtosis_enclave_manager.KurtosisEnclaveManagerServer.RunStarlarkPackage:output_type -> api_container_api.StarlarkRunResponseLine
-	32, // 37: kurtosis_enclave_manager.KurtosisEnclaveManagerServer.RunStarlarkScript:output_type -> api_container_api.StarlarkRunResponseLine
-	33, // 38: kurtosis_enclave_manager.KurtosisEnclaveManagerServer.CreateEnclave:output_type -> engine_api.CreateEnclaveResponse
-	34, // 39: kurtosis_enclave_manager.KurtosisEnclaveManagerServer.InspectFilesArtifactContents:output_type -> api_container_api.InspectFilesArtifactContentsResponse
-	35, // 40: kurtosis_enclave_manager.KurtosisEnclaveManagerServer.DownloadFilesArtifact:output_type -> api_container_api.StreamedDataChunk
-	24, // 41: kurtosis_enclave_manager.KurtosisEnclaveManagerServer.DestroyEnclave:output_type -> google.protobuf.Empty
-	36, // 42: kurtosis_enclave_manager.KurtosisEnclaveManagerServer.GetStarlarkRun:output_type -> api_container_api.GetStarlarkRunResponse
-	37, // 43: kurtosis_enclave_manager.KurtosisEnclaveManagerServer.GetStarlarkScriptPlanYaml:output_type -> api_container_api.PlanYaml
-	37, // 44: kurtosis_enclave_manager.KurtosisEnclaveManagerServer.GetStarlarkPackagePlanYaml:output_type -> api_container_api.PlanYaml
-	24, // 45: kurtosis_enclave_manager.KurtosisEnclaveManagerServer.CreateRepositoryWebhook:output_type -> google.protobuf.Empty
-	38, // 46: kurtosis_enclave_manager.KurtosisEnclaveManagerServer.GetCloudInstanceConfig:output_type -> kurtosis_cloud.GetCloudInstanceConfigResponse
-	24, // 47: kurtosis_enclave_manager.KurtosisEnclaveManagerServer.LockPort:output_type -> google.protobuf.Empty
-	24, // 48: kurtosis_enclave_manager.KurtosisEnclaveManagerServer.UnlockPort:output_type -> google.protobuf.Empty
-	24, // 49: kurtosis_enclave_manager.KurtosisEnclaveManagerServer.AddAlias:output_type -> google.protobuf.Empty
-	39, // 50: kurtosis_enclave_manager.KurtosisEnclaveManagerServer.IsNewKurtosisVersionAvailable:output_type -> kurtosis_cloud.IsNewKurtosisVersionAvailableResponse
-	24, // 51: kurtosis_enclave_manager.KurtosisEnclaveManagerServer.UpgradeKurtosisVersion:output_type -> google.protobuf.Empty
-	30, // [30:52] is the sub-list for method output_type
-	8,  // [8:30] is the sub-list for method input_type
+	24, // 30: kurtosis_enclave_manager.KurtosisEnclaveManagerServer.GetSchedules:input_type -> google.protobuf.Empty
+	3,  // 31: kurtosis_enclave_manager.KurtosisEnclaveManagerServer.Check:output_type -> kurtosis_enclave_manager.HealthCheckResponse
+	28, // 32: kurtosis_enclave_manager.KurtosisEnclaveManagerServer.GetEnclaves:output_type -> engine_api.GetEnclavesResponse
+	28, // 33: kurtosis_enclave_manager.KurtosisEnclaveManagerServer.GetEnclavesByUuids:output_type -> engine_api.GetEnclavesResponse
+	29, // 34: kurtosis_enclave_manager.KurtosisEnclaveManagerServer.GetServices:output_type -> api_container_api.GetServicesResponse
+	30, // 35: kurtosis_enclave_manager.KurtosisEnclaveManagerServer.GetServiceLogs:output_type -> engine_api.GetServiceLogsResponse
+	31, // 36: kurtosis_enclave_manager.KurtosisEnclaveManagerServer.ListFilesArtifactNamesAndUuids:output_type -> api_container_api.ListFilesArtifactNamesAndUuidsResponse
+	32, // 37: kurtosis_enclave_manager.KurtosisEnclaveManagerServer.RunStarlarkPackage:output_type -> api_container_api.StarlarkRunResponseLine
+	32, // 38: kurtosis_enclave_manager.KurtosisEnclaveManagerServer.RunStarlarkScript:output_type -> api_container_api.StarlarkRunResponseLine
+	33, // 39: kurtosis_enclave_manager.KurtosisEnclaveManagerServer.CreateEnclave:output_type -> engine_api.CreateEnclaveResponse
+	34, // 40: kurtosis_enclave_manager.KurtosisEnclaveManagerServer.InspectFilesArtifactContents:output_type -> api_container_api.InspectFilesArtifactContentsResponse
+	35, // 41: kurtosis_enclave_manager.KurtosisEnclaveManagerServer.DownloadFilesArtifact:output_type -> api_container_api.StreamedDataChunk
+	24, // 42: kurtosis_enclave_manager.KurtosisEnclaveManagerServer.DestroyEnclave:output_type -> google.protobuf.Empty
+	36, // 43: kurtosis_enclave_manager.KurtosisEnclaveManagerServer.GetStarlarkRun:output_type -> api_container_api.GetStarlarkRunResponse
+	37, // 44: kurtosis_enclave_manager.KurtosisEnclaveManagerServer.GetStarlarkScriptPlanYaml:output_type -> api_container_api.PlanYaml
+	37, // 45: kurtosis_enclave_manager.KurtosisEnclaveManagerServer.GetStarlarkPackagePlanYaml:output_type -> api_container_api.PlanYaml
+	24, // 46: kurtosis_enclave_manager.KurtosisEnclaveManagerServer.CreateRepositoryWebhook:output_type -> google.protobuf.Empty
+	38, // 47: kurtosis_enclave_manager.KurtosisEnclaveManagerServer.GetCloudInstanceConfig:output_type -> kurtosis_cloud.GetCloudInstanceConfigResponse
+	24, // 48: kurtosis_enclave_manager.KurtosisEnclaveManagerServer.LockPort:output_type -> google.protobuf.Empty
+	24, // 49: kurtosis_enclave_manager.KurtosisEnclaveManagerServer.UnlockPort:output_type -> google.protobuf.Empty
+	24, // 50: kurtosis_enclave_manager.KurtosisEnclaveManagerServer.AddAlias:output_type -> google.protobuf.Empty
+	39, // 51: kurtosis_enclave_manager.KurtosisEnclaveManagerServer.IsNewKurtosisVersionAvailable:output_type -> kurtosis_cloud.IsNewKurtosisVersionAvailableResponse
+	24, // 52: kurtosis_enclave_manager.KurtosisEnclaveManagerServer.UpgradeKurtosisVersion:output_type -> google.protobuf.Empty
+	40, // 53: kurtosis_enclave_manager.KurtosisEnclaveManagerServer.GetSchedules:output_type -> engine_api.GetSchedulesResponse
+	31, // [31:54] is the sub-list for method output_type
+	8,  // [8:31] is the sub-list for method input_type
 	8,  // [8:8] is the sub-list for extension type_name
 	8,  // [8:8] is the sub-list for extension extendee
 	0,  // [0:8] is the sub-list for field type_name
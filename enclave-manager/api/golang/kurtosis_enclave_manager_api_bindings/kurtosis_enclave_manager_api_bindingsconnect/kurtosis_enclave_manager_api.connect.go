@@ -104,6 +104,9 @@ const (
 	// KurtosisEnclaveManagerServerUpgradeKurtosisVersionProcedure is the fully-qualified name of the
 	// KurtosisEnclaveManagerServer's UpgradeKurtosisVersion RPC.
 	KurtosisEnclaveManagerServerUpgradeKurtosisVersionProcedure = "/kurtosis_enclave_manager.KurtosisEnclaveManagerServer/UpgradeKurtosisVersion"
+	// KurtosisEnclaveManagerServerGetSchedulesProcedure is the fully-qualified name of the
+	// KurtosisEnclaveManagerServer's GetSchedules RPC.
+	KurtosisEnclaveManagerServerGetSchedulesProcedure = "/kurtosis_enclave_manager.KurtosisEnclaveManagerServer/GetSchedules"
 )
 
 // KurtosisEnclaveManagerServerClient is a client for the
@@ -131,6 +134,7 @@ type KurtosisEnclaveManagerServerClient interface {
 	AddAlias(context.Context, *connect.Request[kurtosis_enclave_manager_api_bindings.AddAliasRequest]) (*connect.Response[emptypb.Empty], error)
 	IsNewKurtosisVersionAvailable(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_backend_server_rpc_api_bindings.IsNewKurtosisVersionAvailableResponse], error)
 	UpgradeKurtosisVersion(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[emptypb.Empty], error)
+	GetSchedules(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetSchedulesResponse], error)
 }
 
 // NewKurtosisEnclaveManagerServerClient constructs a client for the
@@ -254,6 +258,11 @@ func NewKurtosisEnclaveManagerServerClient(httpClient connect.HTTPClient, baseUR
 			baseURL+KurtosisEnclaveManagerServerUpgradeKurtosisVersionProcedure,
 			opts...,
 		),
+		getSchedules: connect.NewClient[emptypb.Empty, kurtosis_engine_rpc_api_bindings.GetSchedulesResponse](
+			httpClient,
+			baseURL+KurtosisEnclaveManagerServerGetSchedulesProcedure,
+			opts...,
+		),
 	}
 }
 
@@ -281,6 +290,7 @@ type kurtosisEnclaveManagerServerClient struct {
 	addAlias                       *connect.Client[kurtosis_enclave_manager_api_bindings.AddAliasRequest, emptypb.Empty]
 	isNewKurtosisVersionAvailable  *connect.Client[emptypb.Empty, kurtosis_backend_server_rpc_api_bindings.IsNewKurtosisVersionAvailableResponse]
 	upgradeKurtosisVersion         *connect.Client[emptypb.Empty, emptypb.Empty]
+	getSchedules                   *connect.Client[emptypb.Empty, kurtosis_engine_rpc_api_bindings.GetSchedulesResponse]
 }
 
 // Check calls kurtosis_enclave_manager.KurtosisEnclaveManagerServer.Check.
@@ -404,6 +414,11 @@ func (c *kurtosisEnclaveManagerServerClient) UpgradeKurtosisVersion(ctx context.
 	return c.upgradeKurtosisVersion.CallUnary(ctx, req)
 }
 
+// GetSchedules calls kurtosis_enclave_manager.KurtosisEnclaveManagerServer.GetSchedules.
+func (c *kurtosisEnclaveManagerServerClient) GetSchedules(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetSchedulesResponse], error) {
+	return c.getSchedules.CallUnary(ctx, req)
+}
+
 // KurtosisEnclaveManagerServerHandler is an implementation of the
 // kurtosis_enclave_manager.KurtosisEnclaveManagerServer service.
 type KurtosisEnclaveManagerServerHandler interface {
@@ -429,6 +444,7 @@ type KurtosisEnclaveManagerServerHandler interface {
 	AddAlias(context.Context, *connect.Request[kurtosis_enclave_manager_api_bindings.AddAliasRequest]) (*connect.Response[emptypb.Empty], error)
 	IsNewKurtosisVersionAvailable(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_backend_server_rpc_api_bindings.IsNewKurtosisVersionAvailableResponse], error)
 	UpgradeKurtosisVersion(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[emptypb.Empty], error)
+	GetSchedules(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetSchedulesResponse], error)
 }
 
 // NewKurtosisEnclaveManagerServerHandler builds an HTTP handler from the service implementation. It
@@ -547,6 +563,11 @@ func NewKurtosisEnclaveManagerServerHandler(svc KurtosisEnclaveManagerServerHand
 		svc.UpgradeKurtosisVersion,
 		opts...,
 	)
+	kurtosisEnclaveManagerServerGetSchedulesHandler := connect.NewUnaryHandler(
+		KurtosisEnclaveManagerServerGetSchedulesProcedure,
+		svc.GetSchedules,
+		opts...,
+	)
 	return "/kurtosis_enclave_manager.KurtosisEnclaveManagerServer/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case KurtosisEnclaveManagerServerCheckProcedure:
@@ -593,6 +614,8 @@ func NewKurtosisEnclaveManagerServerHandler(svc KurtosisEnclaveManagerServerHand
 			kurtosisEnclaveManagerServerIsNewKurtosisVersionAvailableHandler.ServeHTTP(w, r)
 		case KurtosisEnclaveManagerServerUpgradeKurtosisVersionProcedure:
 			kurtosisEnclaveManagerServerUpgradeKurtosisVersionHandler.ServeHTTP(w, r)
+		case KurtosisEnclaveManagerServerGetSchedulesProcedure:
+			kurtosisEnclaveManagerServerGetSchedulesHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -689,3 +712,7 @@ func (UnimplementedKurtosisEnclaveManagerServerHandler) IsNewKurtosisVersionAvai
 func (UnimplementedKurtosisEnclaveManagerServerHandler) UpgradeKurtosisVersion(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[emptypb.Empty], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kurtosis_enclave_manager.KurtosisEnclaveManagerServer.UpgradeKurtosisVersion is not implemented"))
 }
+
+func (UnimplementedKurtosisEnclaveManagerServerHandler) GetSchedules(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetSchedulesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kurtosis_enclave_manager.KurtosisEnclaveManagerServer.GetSchedules is not implemented"))
+}
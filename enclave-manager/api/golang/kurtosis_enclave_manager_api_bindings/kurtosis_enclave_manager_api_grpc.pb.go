@@ -45,6 +45,7 @@ const (
 	KurtosisEnclaveManagerServer_AddAlias_FullMethodName                       = "/kurtosis_enclave_manager.KurtosisEnclaveManagerServer/AddAlias"
 	KurtosisEnclaveManagerServer_IsNewKurtosisVersionAvailable_FullMethodName  = "/kurtosis_enclave_manager.KurtosisEnclaveManagerServer/IsNewKurtosisVersionAvailable"
 	KurtosisEnclaveManagerServer_UpgradeKurtosisVersion_FullMethodName         = "/kurtosis_enclave_manager.KurtosisEnclaveManagerServer/UpgradeKurtosisVersion"
+	KurtosisEnclaveManagerServer_GetSchedules_FullMethodName                   = "/kurtosis_enclave_manager.KurtosisEnclaveManagerServer/GetSchedules"
 )
 
 // KurtosisEnclaveManagerServerClient is the client API for KurtosisEnclaveManagerServer service.
@@ -73,6 +74,7 @@ type KurtosisEnclaveManagerServerClient interface {
 	AddAlias(ctx context.Context, in *AddAliasRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	IsNewKurtosisVersionAvailable(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*kurtosis_backend_server_rpc_api_bindings.IsNewKurtosisVersionAvailableResponse, error)
 	UpgradeKurtosisVersion(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetSchedules(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*kurtosis_engine_rpc_api_bindings.GetSchedulesResponse, error)
 }
 
 type kurtosisEnclaveManagerServerClient struct {
@@ -373,6 +375,15 @@ func (c *kurtosisEnclaveManagerServerClient) UpgradeKurtosisVersion(ctx context.
 	return out, nil
 }
 
+func (c *kurtosisEnclaveManagerServerClient) GetSchedules(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*kurtosis_engine_rpc_api_bindings.GetSchedulesResponse, error) {
+	out := new(kurtosis_engine_rpc_api_bindings.GetSchedulesResponse)
+	err := c.cc.Invoke(ctx, KurtosisEnclaveManagerServer_GetSchedules_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // KurtosisEnclaveManagerServerServer is the server API for KurtosisEnclaveManagerServer service.
 // All implementations should embed UnimplementedKurtosisEnclaveManagerServerServer
 // for forward compatibility
@@ -399,6 +410,7 @@ type KurtosisEnclaveManagerServerServer interface {
 	AddAlias(context.Context, *AddAliasRequest) (*emptypb.Empty, error)
 	IsNewKurtosisVersionAvailable(context.Context, *emptypb.Empty) (*kurtosis_backend_server_rpc_api_bindings.IsNewKurtosisVersionAvailableResponse, error)
 	UpgradeKurtosisVersion(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	GetSchedules(context.Context, *emptypb.Empty) (*kurtosis_engine_rpc_api_bindings.GetSchedulesResponse, error)
 }
 
 // UnimplementedKurtosisEnclaveManagerServerServer should be embedded to have forward compatible implementations.
@@ -471,6 +483,9 @@ func (UnimplementedKurtosisEnclaveManagerServerServer) IsNewKurtosisVersionAvail
 func (UnimplementedKurtosisEnclaveManagerServerServer) UpgradeKurtosisVersion(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpgradeKurtosisVersion not implemented")
 }
+func (UnimplementedKurtosisEnclaveManagerServerServer) GetSchedules(context.Context, *emptypb.Empty) (*kurtosis_engine_rpc_api_bindings.GetSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchedules not implemented")
+}
 
 // UnsafeKurtosisEnclaveManagerServerServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to KurtosisEnclaveManagerServerServer will
@@ -891,6 +906,24 @@ func _KurtosisEnclaveManagerServer_UpgradeKurtosisVersion_Handler(srv interface{
 	return interceptor(ctx, in, info, handler)
 }
 
+func _KurtosisEnclaveManagerServer_GetSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KurtosisEnclaveManagerServerServer).GetSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KurtosisEnclaveManagerServer_GetSchedules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KurtosisEnclaveManagerServerServer).GetSchedules(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // KurtosisEnclaveManagerServer_ServiceDesc is the grpc.ServiceDesc for KurtosisEnclaveManagerServer service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -970,6 +1003,10 @@ var KurtosisEnclaveManagerServer_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpgradeKurtosisVersion",
 			Handler:    _KurtosisEnclaveManagerServer_UpgradeKurtosisVersion_Handler,
 		},
+		{
+			MethodName: "GetSchedules",
+			Handler:    _KurtosisEnclaveManagerServer_GetSchedules_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
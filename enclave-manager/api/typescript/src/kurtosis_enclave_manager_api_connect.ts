@@ -5,7 +5,7 @@
 
 import { AddAliasRequest, CreateRepositoryWebhookRequest, DownloadFilesArtifactRequest, GetCloudInstanceConfigRequest, GetEnclavesByUuidsRequest, GetListFilesArtifactNamesAndUuidsRequest, GetServicesRequest, GetStarlarkRunRequest, HealthCheckRequest, HealthCheckResponse, InspectFilesArtifactContentsRequest, LockUnlockPortRequest, RunStarlarkPackageRequest, RunStarlarkScriptRequest, StarlarkPackagePlanYamlArgs, StarlarkScriptPlanYamlArgs } from "./kurtosis_enclave_manager_api_pb.js";
 import { Empty, MethodKind } from "@bufbuild/protobuf";
-import { CreateEnclaveArgs, CreateEnclaveResponse, DestroyEnclaveArgs, GetEnclavesResponse, GetServiceLogsArgs, GetServiceLogsResponse } from "./engine_service_pb.js";
+import { CreateEnclaveArgs, CreateEnclaveResponse, DestroyEnclaveArgs, GetEnclavesResponse, GetSchedulesResponse, GetServiceLogsArgs, GetServiceLogsResponse } from "./engine_service_pb.js";
 import { GetServicesResponse, GetStarlarkRunResponse, InspectFilesArtifactContentsResponse, ListFilesArtifactNamesAndUuidsResponse, PlanYaml, StarlarkRunResponseLine, StreamedDataChunk } from "./api_container_service_pb.js";
 import { GetCloudInstanceConfigResponse, IsNewKurtosisVersionAvailableResponse } from "./kurtosis_backend_server_api_pb.js";
 
@@ -213,6 +213,15 @@ export const KurtosisEnclaveManagerServer = {
       O: Empty,
       kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc kurtosis_enclave_manager.KurtosisEnclaveManagerServer.GetSchedules
+     */
+    getSchedules: {
+      name: "GetSchedules",
+      I: Empty,
+      O: GetSchedulesResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
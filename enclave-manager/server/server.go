@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -31,7 +32,9 @@ import (
 const (
 	listenPort                 = 8081
 	grpcServerStopGracePeriod  = 5 * time.Second
-	engineHostUrl              = "http://localhost:9710"
+	engineHost                 = "localhost:9710"
+	httpScheme                 = "http"
+	httpsScheme                = "https"
 	kurtosisCloudApiHost       = "https://cloud.kurtosis.com"
 	kurtosisCloudApiPort       = 8080
 	numberOfElementsAuthHeader = 2
@@ -54,12 +57,33 @@ type WebServer struct {
 	instanceConfigMap   map[string]*kurtosis_backend_server_rpc_api_bindings.GetCloudInstanceConfigResponse
 	apiKeyMap           map[string]*string
 	githubAccessToken   string
+	// Client and scheme the engine and the API containers are called with, which depend on whether they serve TLS
+	httpClient *http.Client
+	urlScheme  string
 }
 
 func NewWebserver(enforceAuth bool) (*WebServer, error) {
+	return NewWebserverWithTls(enforceAuth, nil)
+}
+
+// NewWebserverWithTls is NewWebserver for engines and API containers serving TLS, which are called with the given client
+// TLS config
+func NewWebserverWithTls(enforceAuth bool, clientTlsConfig *tls.Config) (*WebServer, error) {
+	httpClient := http.DefaultClient
+	urlScheme := httpScheme
+	if clientTlsConfig != nil {
+		// nolint:exhaustruct
+		httpClient = &http.Client{
+			Transport: &http.Transport{
+				TLSClientConfig:   clientTlsConfig,
+				ForceAttemptHTTP2: true,
+			},
+		}
+		urlScheme = httpsScheme
+	}
 	engineServiceClient := kurtosis_engine_rpc_api_bindingsconnect.NewEngineServiceClient(
-		http.DefaultClient,
-		engineHostUrl,
+		httpClient,
+		fmt.Sprintf("%s://%s", urlScheme, engineHost),
 	)
 	githubAuthToken := github_auth_storage_creator.GetGitHubAuthToken()
 	return &WebServer{
@@ -71,6 +95,8 @@ func NewWebserver(enforceAuth bool) (*WebServer, error) {
 		instanceConfigMap:   map[string]*kurtosis_backend_server_rpc_api_bindings.GetCloudInstanceConfigResponse{},
 		instanceConfig:      nil,
 		githubAccessToken:   githubAuthToken,
+		httpClient:          httpClient,
+		urlScheme:           urlScheme,
 	}, nil
 }
 
@@ -299,6 +325,26 @@ func (c *WebServer) GetEnclaves(ctx context.Context, req *connect.Request[emptyp
 	return resp, nil
 }
 
+func (c *WebServer) GetSchedules(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetSchedulesResponse], error) {
+	isValidRequest, _, err := c.ValidateRequestAuthorization(ctx, c.enforceAuth, req.Header())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Authentication attempt failed")
+	}
+	if !isValidRequest {
+		return nil, stacktrace.Propagate(err, "User not authorized")
+	}
+	schedules, err := (*c.engineServiceClient).GetSchedules(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	resp := &connect.Response[kurtosis_engine_rpc_api_bindings.GetSchedulesResponse]{
+		Msg: &kurtosis_engine_rpc_api_bindings.GetSchedulesResponse{
+			Schedules: schedules.Msg.Schedules,
+		},
+	}
+	return resp, nil
+}
+
 func (c *WebServer) GetEnclavesByUuids(ctx context.Context, req *connect.Request[kurtosis_enclave_manager_api_bindings.GetEnclavesByUuidsRequest]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEnclavesResponse], error) {
 	isValidRequest, _, err := c.ValidateRequestAuthorization(ctx, c.enforceAuth, req.Header())
 	if err != nil {
@@ -869,12 +915,12 @@ func (c *WebServer) createAPICClient(
 	ip string,
 	port int32,
 ) (*kurtosis_core_rpc_api_bindingsconnect.ApiContainerServiceClient, error) {
-	host, err := url.Parse(fmt.Sprintf("http://%s:%d", ip, port))
+	host, err := url.Parse(fmt.Sprintf("%s://%s:%d", c.urlScheme, ip, port))
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Failed to parse the connection url for the APIC")
 	}
 	apiContainerServiceClient := kurtosis_core_rpc_api_bindingsconnect.NewApiContainerServiceClient(
-		http.DefaultClient,
+		c.httpClient,
 		host.String(),
 		connect.WithGRPCWeb(),
 	)
@@ -996,7 +1042,13 @@ func extractJwtToken(header http.Header) (string, error) {
 }
 
 func RunEnclaveManagerApiServer(enforceAuth bool) error {
-	srv, err := NewWebserver(enforceAuth)
+	return RunEnclaveManagerApiServerWithTls(enforceAuth, nil)
+}
+
+// RunEnclaveManagerApiServerWithTls is RunEnclaveManagerApiServer for engines serving TLS. The enclave manager API itself
+// keeps serving plaintext, as the browser reaches it
+func RunEnclaveManagerApiServerWithTls(enforceAuth bool, clientTlsConfig *tls.Config) error {
+	srv, err := NewWebserverWithTls(enforceAuth, clientTlsConfig)
 	if err != nil {
 		logrus.Fatal("an error occurred while processing the auth settings, exiting!", err)
 		return err
@@ -96,6 +96,10 @@ export abstract class KurtosisClient {
     return asyncResult(this.client.getEnclaves({}, this.getHeaderOptions()), "KurtosisClient could not getEnclaves");
   }
 
+  async getSchedules() {
+    return asyncResult(this.client.getSchedules({}, this.getHeaderOptions()), "KurtosisClient could not getSchedules");
+  }
+
   async destroy(enclaveUUID: string) {
     return asyncResult(
       this.client.destroyEnclave(new DestroyEnclaveArgs({ enclaveIdentifier: enclaveUUID }), this.getHeaderOptions()),
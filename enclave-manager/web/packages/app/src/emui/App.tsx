@@ -12,6 +12,7 @@ import { EnclavesContextProvider } from "./enclaves/EnclavesContext";
 import Experiments from "./experiments/Experiments";
 import { ExperimentsContextProvider } from "./experiments/ExperimentsContext";
 import { Navbar } from "./Navbar";
+import Schedules from "./schedules/Schedules";
 import { SettingsContextProvider } from "./settings";
 
 const logLogo = (t: string) => console.log(`%c ${t}`, "background: black; color: #00C223");
@@ -87,6 +88,15 @@ const KurtosisRouter = () => {
                 ),
                 children: catalogRoutes(),
               },
+              {
+                path: "/schedules",
+                handle: {
+                  type: "schedules" as "schedules",
+                  crumb: () => ({ name: "Schedules", destination: "/schedules" }),
+                },
+                id: "schedules",
+                element: <Schedules />,
+              },
               {
                 path: "/experiments",
                 handle: {
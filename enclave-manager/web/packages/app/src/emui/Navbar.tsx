@@ -14,7 +14,7 @@ import {
 } from "@chakra-ui/react";
 import { CopyButton, NavButton, Navigation, NavigationDivider } from "kurtosis-ui-components";
 import { useState } from "react";
-import { FiHome, FiPackage } from "react-icons/fi";
+import { FiClock, FiHome, FiPackage } from "react-icons/fi";
 import { GoBug } from "react-icons/go";
 import { MdInfoOutline } from "react-icons/md";
 import { PiLinkSimpleBold } from "react-icons/pi";
@@ -42,6 +42,9 @@ export const Navbar = () => {
       <Link to={"/catalog"}>
         <NavButton label={"View catalog"} Icon={<FiPackage />} isActive={location.pathname.startsWith("/catalog")} />
       </Link>
+      <Link to={"/schedules"}>
+        <NavButton label={"View schedules"} Icon={<FiClock />} isActive={location.pathname.startsWith("/schedules")} />
+      </Link>
       {kurtosisClient.isRunningInCloud() && (
         <Link to={KURTOSIS_CLOUD_CONNECT_URL}>
           <NavButton label={"Link your CLI"} Icon={<PiLinkSimpleBold />} />
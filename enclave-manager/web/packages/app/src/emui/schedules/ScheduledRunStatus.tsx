@@ -0,0 +1,37 @@
+import { Tag, Tooltip } from "@chakra-ui/react";
+import { ScheduledRunStatus as ScheduledRunStatusEnum } from "enclave-manager-sdk/build/engine_service_pb";
+
+export function scheduledRunStatusToString(status: ScheduledRunStatusEnum) {
+  switch (status) {
+    case ScheduledRunStatusEnum.ScheduledRunStatus_RUNNING:
+      return "Running";
+    case ScheduledRunStatusEnum.ScheduledRunStatus_SUCCEEDED:
+      return "Succeeded";
+    case ScheduledRunStatusEnum.ScheduledRunStatus_FAILED:
+      return "Failed";
+  }
+}
+
+export function scheduledRunStatusToColorScheme(status: ScheduledRunStatusEnum) {
+  switch (status) {
+    case ScheduledRunStatusEnum.ScheduledRunStatus_RUNNING:
+      return "blue";
+    case ScheduledRunStatusEnum.ScheduledRunStatus_SUCCEEDED:
+      return "green";
+    case ScheduledRunStatusEnum.ScheduledRunStatus_FAILED:
+      return "red";
+  }
+}
+
+type ScheduledRunStatusProps = {
+  status: ScheduledRunStatusEnum;
+  errorMessage?: string;
+};
+
+export const ScheduledRunStatus = ({ status, errorMessage }: ScheduledRunStatusProps) => {
+  return (
+    <Tooltip closeDelay={1000} label={errorMessage} isDisabled={!errorMessage}>
+      <Tag colorScheme={scheduledRunStatusToColorScheme(status)}>{scheduledRunStatusToString(status)}</Tag>
+    </Tooltip>
+  );
+};
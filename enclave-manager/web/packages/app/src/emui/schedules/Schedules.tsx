@@ -0,0 +1,44 @@
+import { Flex, Text } from "@chakra-ui/react";
+import { Schedule } from "enclave-manager-sdk/build/engine_service_pb";
+import { AppPageLayout, KurtosisAlert, PageTitle, registerBreadcrumbHandler } from "kurtosis-ui-components";
+import { useEffect, useState } from "react";
+import { Result } from "true-myth";
+import { useKurtosisClient } from "../../client/enclaveManager/KurtosisClientContext";
+import { SchedulesTable } from "./SchedulesTable";
+
+registerBreadcrumbHandler("schedules", () => <></>);
+
+// How often the schedules are fetched again, so that the outcome of new runs shows up
+const SCHEDULES_REFRESH_INTERVAL_MS = 15 * 1000;
+
+const Schedules = () => {
+  const kurtosisClient = useKurtosisClient();
+  const [schedules, setSchedules] = useState<Result<Schedule[], string>>();
+
+  useEffect(() => {
+    const fetchSchedules = async () => {
+      const getSchedulesResponse = await kurtosisClient.getSchedules();
+      setSchedules(getSchedulesResponse.map((response) => response.schedules));
+    };
+    fetchSchedules();
+    const interval = setInterval(fetchSchedules, SCHEDULES_REFRESH_INTERVAL_MS);
+    return () => clearInterval(interval);
+  }, [kurtosisClient]);
+
+  return (
+    <AppPageLayout>
+      <Flex pl={"6px"} pb={"16px"} alignItems={"center"} justifyContent={"space-between"}>
+        <PageTitle>Schedules</PageTitle>
+      </Flex>
+      <Flex direction="column" pt={"24px"} width={"100%"} gap={8}>
+        {schedules?.isOk && schedules.value.length === 0 && (
+          <Text>No schedules yet. Add one with 'kurtosis schedule add'.</Text>
+        )}
+        {schedules?.isOk && schedules.value.length > 0 && <SchedulesTable schedules={schedules.value} />}
+        {schedules?.isErr && <KurtosisAlert message={schedules.error} />}
+      </Flex>
+    </AppPageLayout>
+  );
+};
+
+export default Schedules;
@@ -0,0 +1,102 @@
+import { Flex, Text } from "@chakra-ui/react";
+import { ColumnDef, createColumnHelper } from "@tanstack/react-table";
+import { Schedule, ScheduleEnclaveMode, ScheduledRun } from "enclave-manager-sdk/build/engine_service_pb";
+import { DataTable, FormatDateTime, isDefined } from "kurtosis-ui-components";
+import { DateTime } from "luxon";
+import { useMemo } from "react";
+import { ScheduledRunStatus } from "./ScheduledRunStatus";
+
+type ScheduleTableRow = {
+  uuid: string;
+  cronExpression: string;
+  packageId: string;
+  enclaveMode: string;
+  enclaveName: string;
+  nextRun: DateTime | null;
+  lastRun: ScheduledRun | null;
+};
+
+const scheduleToRow = (schedule: Schedule): ScheduleTableRow => {
+  const recentRuns = schedule.recentRuns;
+  return {
+    uuid: schedule.shortenedUuid,
+    cronExpression: schedule.cronExpression,
+    packageId: schedule.packageId,
+    enclaveMode: schedule.enclaveMode === ScheduleEnclaveMode.ScheduleEnclaveMode_REUSE ? "Reuse" : "Fresh",
+    enclaveName: schedule.enclaveName,
+    nextRun: schedule.nextRunTime ? DateTime.fromJSDate(schedule.nextRunTime.toDate()) : null,
+    lastRun: recentRuns.length > 0 ? recentRuns[recentRuns.length - 1] : null,
+  };
+};
+
+const columnHelper = createColumnHelper<ScheduleTableRow>();
+
+type SchedulesTableProps = {
+  schedules: Schedule[];
+};
+
+export const SchedulesTable = ({ schedules }: SchedulesTableProps) => {
+  const rows = useMemo(() => schedules.map(scheduleToRow), [schedules]);
+
+  const columns = useMemo<ColumnDef<ScheduleTableRow, any>[]>(
+    () => [
+      columnHelper.accessor("uuid", {
+        header: "UUID",
+      }),
+      columnHelper.accessor("cronExpression", {
+        header: "Cron",
+        cell: (cronCell) => <Text fontFamily={"Inconsolata"}>{cronCell.getValue()}</Text>,
+      }),
+      columnHelper.accessor("packageId", {
+        header: "Package",
+      }),
+      columnHelper.accessor("enclaveMode", {
+        header: "Enclave Mode",
+      }),
+      columnHelper.accessor("enclaveName", {
+        header: "Enclave",
+      }),
+      columnHelper.accessor("nextRun", {
+        header: "Next Run",
+        cell: (nextRunCell) => (
+          <FormatDateTime
+            fontSize={"xs"}
+            fontWeight={"semibold"}
+            dateTime={nextRunCell.getValue()}
+            format={"relative"}
+          />
+        ),
+        sortingFn: (a, b) => {
+          const nextRunA = a.original.nextRun;
+          const nextRunB = b.original.nextRun;
+          return (nextRunA?.toMillis() || Number.MAX_VALUE) - (nextRunB?.toMillis() || Number.MAX_VALUE);
+        },
+      }),
+      columnHelper.accessor("lastRun", {
+        header: "Last Run",
+        cell: (lastRunCell) => {
+          const lastRun = lastRunCell.getValue();
+          if (!isDefined(lastRun)) {
+            return <Text fontSize={"xs"}>Never</Text>;
+          }
+          return (
+            <Flex gap={"8px"} alignItems={"center"}>
+              <ScheduledRunStatus status={lastRun.status} errorMessage={lastRun.errorMessage} />
+              <FormatDateTime
+                fontSize={"xs"}
+                fontWeight={"semibold"}
+                dateTime={lastRun.startTime ? DateTime.fromJSDate(lastRun.startTime.toDate()) : null}
+                format={"relative"}
+              />
+              <Text fontSize={"xs"}>{lastRun.enclaveName}</Text>
+            </Flex>
+          );
+        },
+        enableSorting: false,
+      }),
+    ],
+    [],
+  );
+
+  return <DataTable columns={columns} data={rows} defaultSorting={[{ id: "nextRun", desc: false }]} />;
+};
@@ -0,0 +1,100 @@
+package args
+
+import (
+	"strings"
+
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// ApiTokenRole is what a caller authenticated with an API token is allowed to do on the engine API. Each role can do
+// everything the roles before it can
+type ApiTokenRole string
+
+const (
+	// ApiTokenRole_Viewer can inspect enclaves and read logs
+	ApiTokenRole_Viewer ApiTokenRole = "viewer"
+	// ApiTokenRole_Operator can also create, run things in, stop and destroy the enclaves it owns
+	ApiTokenRole_Operator ApiTokenRole = "operator"
+	// ApiTokenRole_Admin can also destroy enclaves owned by someone else and clean the engine
+	ApiTokenRole_Admin ApiTokenRole = "admin"
+)
+
+var allApiTokenRoles = []ApiTokenRole{
+	ApiTokenRole_Viewer,
+	ApiTokenRole_Operator,
+	ApiTokenRole_Admin,
+}
+
+// ApiToken is a bearer token the engine API accepts, along with the user it identifies and that user's role.
+// When the engine gets no API tokens it doesn't authenticate callers at all
+type ApiToken struct {
+	Token string `json:"token"`
+
+	User string `json:"user"`
+
+	Role ApiTokenRole `json:"role"`
+}
+
+func NewApiToken(token string, user string, role ApiTokenRole) *ApiToken {
+	return &ApiToken{
+		Token: token,
+		User:  user,
+		Role:  role,
+	}
+}
+
+func (role ApiTokenRole) IsValid() bool {
+	for _, validRole := range allApiTokenRoles {
+		if role == validRole {
+			return true
+		}
+	}
+	return false
+}
+
+// Includes returns true if the role can do everything the other role can
+func (role ApiTokenRole) Includes(other ApiTokenRole) bool {
+	return role.rank() >= other.rank()
+}
+
+func (role ApiTokenRole) rank() int {
+	for idx, validRole := range allApiTokenRoles {
+		if role == validRole {
+			return idx
+		}
+	}
+	return -1
+}
+
+func ApiTokenRoleStrings() []string {
+	result := []string{}
+	for _, role := range allApiTokenRoles {
+		result = append(result, string(role))
+	}
+	return result
+}
+
+func validateApiTokens(apiTokens []ApiToken) error {
+	seenTokens := map[string]bool{}
+	for _, apiToken := range apiTokens {
+		if strings.TrimSpace(apiToken.Token) == "" {
+			return stacktrace.NewError("API token of user '%v' is empty", apiToken.User)
+		}
+		if strings.TrimSpace(apiToken.User) == "" {
+			return stacktrace.NewError("An API token has no user")
+		}
+		if !apiToken.Role.IsValid() {
+			return stacktrace.NewError(
+				"API token of user '%v' has unrecognized role '%v'; valid values are: %v",
+				apiToken.User,
+				apiToken.Role,
+				strings.Join(ApiTokenRoleStrings(), ", "),
+			)
+		}
+		if seenTokens[apiToken.Token] {
+			return stacktrace.NewError("API token of user '%v' is used by more than one user", apiToken.User)
+		}
+		seenTokens[apiToken.Token] = true
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+package args
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestApiTokenRoleIncludes(t *testing.T) {
+	require.True(t, ApiTokenRole_Admin.Includes(ApiTokenRole_Operator))
+	require.True(t, ApiTokenRole_Operator.Includes(ApiTokenRole_Operator))
+	require.False(t, ApiTokenRole_Viewer.Includes(ApiTokenRole_Operator))
+	require.False(t, ApiTokenRole("superuser").Includes(ApiTokenRole_Viewer))
+}
+
+func TestValidateApiTokens(t *testing.T) {
+	require.NoError(t, validateApiTokens(nil))
+	require.NoError(t, validateApiTokens([]ApiToken{
+		*NewApiToken("token-1", "alice", ApiTokenRole_Admin),
+		*NewApiToken("token-2", "bob", ApiTokenRole_Viewer),
+	}))
+
+	require.Error(t, validateApiTokens([]ApiToken{*NewApiToken("", "alice", ApiTokenRole_Admin)}))
+	require.Error(t, validateApiTokens([]ApiToken{*NewApiToken("token-1", "", ApiTokenRole_Admin)}))
+	require.Error(t, validateApiTokens([]ApiToken{*NewApiToken("token-1", "alice", ApiTokenRole("superuser"))}))
+	require.Error(t, validateApiTokens([]ApiToken{
+		*NewApiToken("token-1", "alice", ApiTokenRole_Admin),
+		*NewApiToken("token-1", "bob", ApiTokenRole_Viewer),
+	}))
+}
@@ -74,6 +74,18 @@ type EngineServerArgs struct {
 	LogsCollectorFilters []logs_collector.Filter `json:"logsCollectorFilters"`
 
 	LogsCollectorParsers []logs_collector.Parser `json:"logsCollectorParsers"`
+
+	// Bearer tokens the engine API accepts; if empty, the engine API doesn't authenticate callers
+	ApiTokens []ApiToken `json:"apiTokens"`
+
+	// TLS material for the engine and API container gRPC servers; if nil, they serve plaintext
+	Tls *TlsConfig `json:"tls"`
+
+	// Packages the engine keeps pre-run in idle enclaves, so enclaves created from them are ready right away
+	EnclaveTemplates []EnclaveTemplate `json:"enclaveTemplates"`
+
+	// Endpoints the engine sends its events to
+	Webhooks []Webhook `json:"webhooks"`
 }
 
 var skipValidation = map[string]bool{
@@ -141,6 +153,10 @@ func NewEngineServerArgs(
 	logRetentionPeriod string,
 	logsCollectorFilters []logs_collector.Filter,
 	logsCollectorParsers []logs_collector.Parser,
+	apiTokens []ApiToken,
+	tlsConfig *TlsConfig,
+	enclaveTemplates []EnclaveTemplate,
+	webhooks []Webhook,
 ) (*EngineServerArgs, error) {
 	if enclaveEnvVars == "" {
 		enclaveEnvVars = emptyJsonField
@@ -165,6 +181,10 @@ func NewEngineServerArgs(
 		LogRetentionPeriod:          logRetentionPeriod,
 		LogsCollectorFilters:        logsCollectorFilters,
 		LogsCollectorParsers:        logsCollectorParsers,
+		ApiTokens:                   apiTokens,
+		Tls:                         tlsConfig,
+		EnclaveTemplates:            enclaveTemplates,
+		Webhooks:                    webhooks,
 	}
 	if err := result.validate(); err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred validating engine server args")
@@ -190,5 +210,18 @@ func (args EngineServerArgs) validate() error {
 			return stacktrace.NewError("JSON field '%s' is whitespace or empty string", jsonFieldName)
 		}
 	}
+
+	if err := validateApiTokens(args.ApiTokens); err != nil {
+		return stacktrace.Propagate(err, "An error occurred validating the engine API tokens")
+	}
+	if err := validateTlsConfig(args.Tls); err != nil {
+		return stacktrace.Propagate(err, "An error occurred validating the engine TLS config")
+	}
+	if err := validateEnclaveTemplates(args.EnclaveTemplates); err != nil {
+		return stacktrace.Propagate(err, "An error occurred validating the enclave templates")
+	}
+	if err := validateWebhooks(args.Webhooks); err != nil {
+		return stacktrace.Propagate(err, "An error occurred validating the webhooks")
+	}
 	return nil
 }
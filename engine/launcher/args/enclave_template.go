@@ -0,0 +1,51 @@
+package args
+
+import (
+	"strings"
+
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// EnclaveTemplate is a package, along with its args, that the engine pre-runs in idle enclaves so that enclaves
+// asked for with the template's name are handed out already booted
+type EnclaveTemplate struct {
+	Name string `json:"name"`
+
+	// Locator of the package to run, as accepted by `kurtosis run`
+	PackageId string `json:"packageId"`
+
+	// JSON args the package is run with; if empty, the package runs with its default args
+	SerializedParams string `json:"serializedParams"`
+
+	// How many idle enclaves with the package already running the engine keeps around
+	PoolSize uint8 `json:"poolSize"`
+}
+
+func NewEnclaveTemplate(name string, packageId string, serializedParams string, poolSize uint8) *EnclaveTemplate {
+	return &EnclaveTemplate{
+		Name:             name,
+		PackageId:        packageId,
+		SerializedParams: serializedParams,
+		PoolSize:         poolSize,
+	}
+}
+
+func validateEnclaveTemplates(enclaveTemplates []EnclaveTemplate) error {
+	seenNames := map[string]bool{}
+	for _, enclaveTemplate := range enclaveTemplates {
+		if strings.TrimSpace(enclaveTemplate.Name) == "" {
+			return stacktrace.NewError("An enclave template running package '%v' has no name", enclaveTemplate.PackageId)
+		}
+		if strings.TrimSpace(enclaveTemplate.PackageId) == "" {
+			return stacktrace.NewError("Enclave template '%v' has no package", enclaveTemplate.Name)
+		}
+		if enclaveTemplate.PoolSize == 0 {
+			return stacktrace.NewError("Enclave template '%v' has a pool size of zero, so no enclave would ever be created from it", enclaveTemplate.Name)
+		}
+		if seenNames[enclaveTemplate.Name] {
+			return stacktrace.NewError("Enclave template name '%v' is used by more than one template", enclaveTemplate.Name)
+		}
+		seenNames[enclaveTemplate.Name] = true
+	}
+	return nil
+}
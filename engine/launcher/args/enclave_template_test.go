@@ -0,0 +1,23 @@
+package args
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateEnclaveTemplates(t *testing.T) {
+	require.NoError(t, validateEnclaveTemplates(nil))
+	require.NoError(t, validateEnclaveTemplates([]EnclaveTemplate{
+		*NewEnclaveTemplate("devnet", "github.com/ethpandaops/ethereum-package", `{"participants": [{}]}`, 2),
+		*NewEnclaveTemplate("redis", "github.com/kurtosis-tech/redis-package", "", 1),
+	}))
+
+	require.Error(t, validateEnclaveTemplates([]EnclaveTemplate{*NewEnclaveTemplate("", "github.com/kurtosis-tech/redis-package", "", 1)}))
+	require.Error(t, validateEnclaveTemplates([]EnclaveTemplate{*NewEnclaveTemplate("redis", "", "", 1)}))
+	require.Error(t, validateEnclaveTemplates([]EnclaveTemplate{*NewEnclaveTemplate("redis", "github.com/kurtosis-tech/redis-package", "", 0)}))
+	require.Error(t, validateEnclaveTemplates([]EnclaveTemplate{
+		*NewEnclaveTemplate("redis", "github.com/kurtosis-tech/redis-package", "", 1),
+		*NewEnclaveTemplate("redis", "github.com/kurtosis-tech/redis-package", `{"port": 6380}`, 1),
+	}))
+}
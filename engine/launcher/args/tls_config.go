@@ -0,0 +1,70 @@
+package args
+
+import (
+	"strings"
+
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// TlsConfig holds the PEM-encoded material the engine serves its gRPC API with. The engine signs a server certificate
+// of their own for each API container it starts with the CA key, so the CA is all a client needs to trust them all
+type TlsConfig struct {
+	// CA that signed the server certificate and, if client certificates are required, the client certificates
+	CaCertificate string `json:"caCertificate"`
+
+	// Key of the CA, which never leaves the engine
+	CaKey string `json:"caKey"`
+
+	ServerCertificate string `json:"serverCertificate"`
+
+	ServerKey string `json:"serverKey"`
+
+	// Certificate and key the engine presents to the API containers when they require client certificates
+	ClientCertificate string `json:"clientCertificate"`
+
+	ClientKey string `json:"clientKey"`
+
+	// If true, the engine and the API containers only let in clients presenting a certificate signed by the CA (mTLS)
+	RequireClientCertificate bool `json:"requireClientCertificate"`
+}
+
+func NewTlsConfig(
+	caCertificate string,
+	caKey string,
+	serverCertificate string,
+	serverKey string,
+	clientCertificate string,
+	clientKey string,
+	requireClientCertificate bool,
+) *TlsConfig {
+	return &TlsConfig{
+		CaCertificate:            caCertificate,
+		CaKey:                    caKey,
+		ServerCertificate:        serverCertificate,
+		ServerKey:                serverKey,
+		ClientCertificate:        clientCertificate,
+		ClientKey:                clientKey,
+		RequireClientCertificate: requireClientCertificate,
+	}
+}
+
+// A nil TLS config means the engine serves plaintext
+func validateTlsConfig(tlsConfig *TlsConfig) error {
+	if tlsConfig == nil {
+		return nil
+	}
+	if strings.TrimSpace(tlsConfig.CaCertificate) == "" {
+		return stacktrace.NewError("TLS is enabled but no CA certificate was provided")
+	}
+	if strings.TrimSpace(tlsConfig.CaKey) == "" {
+		return stacktrace.NewError("TLS is enabled but the CA key the engine signs the API containers' certificates with is missing")
+	}
+	if strings.TrimSpace(tlsConfig.ServerCertificate) == "" || strings.TrimSpace(tlsConfig.ServerKey) == "" {
+		return stacktrace.NewError("TLS is enabled but the server certificate or key is missing")
+	}
+	if tlsConfig.RequireClientCertificate &&
+		(strings.TrimSpace(tlsConfig.ClientCertificate) == "" || strings.TrimSpace(tlsConfig.ClientKey) == "") {
+		return stacktrace.NewError("Client certificates are required but the engine wasn't given a client certificate and key to call the API containers with")
+	}
+	return nil
+}
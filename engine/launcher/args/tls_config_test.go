@@ -0,0 +1,20 @@
+package args
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateTlsConfig(t *testing.T) {
+	require.NoError(t, validateTlsConfig(nil))
+	require.NoError(t, validateTlsConfig(NewTlsConfig("ca", "ca-key", "server-cert", "server-key", "", "", false)))
+	require.NoError(t, validateTlsConfig(NewTlsConfig("ca", "ca-key", "server-cert", "server-key", "client-cert", "client-key", true)))
+
+	require.Error(t, validateTlsConfig(NewTlsConfig("", "ca-key", "server-cert", "server-key", "", "", false)))
+	require.Error(t, validateTlsConfig(NewTlsConfig("ca", "ca-key", "server-cert", "", "", "", false)))
+	// The engine signs the API containers' certificates, so it can't do without the CA key
+	require.Error(t, validateTlsConfig(NewTlsConfig("ca", "", "server-cert", "server-key", "", "", false)))
+	// The engine needs a client certificate of its own to call API containers that require one
+	require.Error(t, validateTlsConfig(NewTlsConfig("ca", "ca-key", "server-cert", "server-key", "", "", true)))
+}
@@ -0,0 +1,43 @@
+package args
+
+import (
+	"net/url"
+
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// Webhook is an endpoint the engine POSTs its events to as JSON
+type Webhook struct {
+	Url string `json:"url"`
+
+	// If set, every request carries the hex-encoded HMAC-SHA256 of its body keyed with this secret, so the receiver
+	// can check the events come from this engine
+	Secret string `json:"secret"`
+
+	// Names of the event types sent to the endpoint, e.g. ENCLAVE_CREATED; if empty, every event is sent
+	EventTypes []string `json:"eventTypes"`
+}
+
+func NewWebhook(webhookUrl string, secret string, eventTypes []string) *Webhook {
+	return &Webhook{
+		Url:        webhookUrl,
+		Secret:     secret,
+		EventTypes: eventTypes,
+	}
+}
+
+func validateWebhooks(webhooks []Webhook) error {
+	for _, webhook := range webhooks {
+		parsedUrl, err := url.Parse(webhook.Url)
+		if err != nil {
+			return stacktrace.Propagate(err, "Webhook URL '%v' isn't a valid URL", webhook.Url)
+		}
+		if parsedUrl.Scheme != "http" && parsedUrl.Scheme != "https" {
+			return stacktrace.NewError("Webhook URL '%v' must use the http or https scheme", webhook.Url)
+		}
+		if parsedUrl.Host == "" {
+			return stacktrace.NewError("Webhook URL '%v' has no host", webhook.Url)
+		}
+	}
+	return nil
+}
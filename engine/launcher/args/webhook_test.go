@@ -0,0 +1,20 @@
+package args
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateWebhooks(t *testing.T) {
+	require.NoError(t, validateWebhooks(nil))
+	require.NoError(t, validateWebhooks([]Webhook{
+		*NewWebhook("https://hooks.example.com/kurtosis", "s3cr3t", []string{"ENCLAVE_CREATED"}),
+		*NewWebhook("http://localhost:8080", "", nil),
+	}))
+
+	require.Error(t, validateWebhooks([]Webhook{*NewWebhook("", "", nil)}))
+	require.Error(t, validateWebhooks([]Webhook{*NewWebhook("hooks.example.com/kurtosis", "", nil)}))
+	require.Error(t, validateWebhooks([]Webhook{*NewWebhook("ftp://hooks.example.com", "", nil)}))
+	require.Error(t, validateWebhooks([]Webhook{*NewWebhook("https://", "", nil)}))
+}
@@ -56,6 +56,10 @@ func (launcher *EngineServerLauncher) LaunchWithDefaultVersion(
 	shouldEnablePersistentVolumeLogsCollection bool,
 	logsCollectorFilters []logs_collector.Filter,
 	logsCollectorParsers []logs_collector.Parser,
+	apiTokens []args.ApiToken,
+	tlsConfig *args.TlsConfig,
+	enclaveTemplates []args.EnclaveTemplate,
+	webhooks []args.Webhook,
 ) (
 	resultPublicIpAddr net.IP,
 	resultPublicGrpcPortSpec *port_spec.PortSpec,
@@ -85,6 +89,10 @@ func (launcher *EngineServerLauncher) LaunchWithDefaultVersion(
 		shouldEnablePersistentVolumeLogsCollection,
 		logsCollectorFilters,
 		logsCollectorParsers,
+		apiTokens,
+		tlsConfig,
+		enclaveTemplates,
+		webhooks,
 	)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred launching the engine server container with default version tag '%v'", kurtosis_version.KurtosisVersion)
@@ -116,6 +124,10 @@ func (launcher *EngineServerLauncher) LaunchWithCustomVersion(
 	shouldEnablePersistentVolumeLogsCollection bool,
 	logsCollectorFilters []logs_collector.Filter,
 	logsCollectorParsers []logs_collector.Parser,
+	apiTokens []args.ApiToken,
+	tlsConfig *args.TlsConfig,
+	enclaveTemplates []args.EnclaveTemplate,
+	webhooks []args.Webhook,
 ) (
 	resultPublicIpAddr net.IP,
 	resultPublicGrpcPortSpec *port_spec.PortSpec,
@@ -143,6 +155,10 @@ func (launcher *EngineServerLauncher) LaunchWithCustomVersion(
 		logRetentionPeriod,
 		logsCollectorFilters,
 		logsCollectorParsers,
+		apiTokens,
+		tlsConfig,
+		enclaveTemplates,
+		webhooks,
 	)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred creating the engine server args")
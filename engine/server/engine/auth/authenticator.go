@@ -0,0 +1,202 @@
+package auth
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"net/http"
+	"sort"
+	"strings"
+
+	api_container_args "github.com/kurtosis-tech/kurtosis/core/launcher/args"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	AuthorizationHeaderKey = "Authorization"
+
+	bearerTokenPrefix = "Bearer "
+
+	// The user the engine calls the API containers as
+	engineApiContainerUser = "kurtosis-engine"
+
+	engineApiContainerTokenDerivationKey = "kurtosis-engine-api-container-token"
+)
+
+type principalContextKey struct{}
+
+// Principal is the engine API user a request was authenticated as
+type Principal struct {
+	user string
+	role args.ApiTokenRole
+}
+
+func NewPrincipal(user string, role args.ApiTokenRole) *Principal {
+	return &Principal{user: user, role: role}
+}
+
+func (principal *Principal) GetUser() string {
+	return principal.user
+}
+
+func (principal *Principal) GetRole() args.ApiTokenRole {
+	return principal.role
+}
+
+// Authenticator maps the bearer tokens sent to the engine API to the users they belong to
+type Authenticator struct {
+	apiTokens []args.ApiToken
+}
+
+func NewAuthenticator(apiTokens []args.ApiToken) *Authenticator {
+	return &Authenticator{apiTokens: apiTokens}
+}
+
+// IsEnabled returns false if the engine was started without API tokens, in which case every caller is let through
+func (authenticator *Authenticator) IsEnabled() bool {
+	return len(authenticator.apiTokens) > 0
+}
+
+// GetApiContainerApiTokens returns the API tokens the API containers of new enclaves accept: those of the engine, along
+// with the one the engine itself calls them with. It returns nil if authentication is disabled
+func (authenticator *Authenticator) GetApiContainerApiTokens() []api_container_args.ApiToken {
+	if !authenticator.IsEnabled() {
+		return nil
+	}
+	apiContainerApiTokens := []api_container_args.ApiToken{}
+	for _, apiToken := range authenticator.apiTokens {
+		apiContainerApiTokens = append(apiContainerApiTokens, api_container_args.NewApiToken(apiToken.Token, apiToken.User, api_container_args.ApiTokenRole(apiToken.Role)))
+	}
+	return append(
+		apiContainerApiTokens,
+		api_container_args.NewApiToken(authenticator.GetEngineApiContainerApiToken(), engineApiContainerUser, api_container_args.ApiTokenRole_Admin),
+	)
+}
+
+// GetEngineApiContainerApiToken returns the API token the engine calls the API containers with, or an empty string if
+// authentication is disabled. It's derived from the engine's API tokens rather than generated so that the API containers
+// started before the engine got restarted with the same tokens keep accepting it
+func (authenticator *Authenticator) GetEngineApiContainerApiToken() string {
+	if !authenticator.IsEnabled() {
+		return ""
+	}
+	tokens := []string{}
+	for _, apiToken := range authenticator.apiTokens {
+		tokens = append(tokens, apiToken.Token)
+	}
+	sort.Strings(tokens)
+
+	mac := hmac.New(sha256.New, []byte(engineApiContainerTokenDerivationKey))
+	for _, token := range tokens {
+		// The separator keeps different token lists from deriving the same token
+		mac.Write([]byte(token))
+		mac.Write([]byte{0})
+	}
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Authenticate returns the user whose token is in the Authorization header. It returns a nil principal and no error
+// if authentication is disabled
+func (authenticator *Authenticator) Authenticate(headers http.Header) (*Principal, error) {
+	if !authenticator.IsEnabled() {
+		return nil, nil
+	}
+
+	authorizationHeaderValue := headers.Get(AuthorizationHeaderKey)
+	if authorizationHeaderValue == "" {
+		return nil, stacktrace.NewError("The engine requires an API token but the request had no '%v' header", AuthorizationHeaderKey)
+	}
+	if !strings.HasPrefix(authorizationHeaderValue, bearerTokenPrefix) {
+		return nil, stacktrace.NewError("The '%v' header of the request doesn't hold a bearer token", AuthorizationHeaderKey)
+	}
+	token := strings.TrimPrefix(authorizationHeaderValue, bearerTokenPrefix)
+
+	// Every token is compared, so how long the check takes doesn't tell which tokens exist
+	var matchingApiToken *args.ApiToken
+	for idx := range authenticator.apiTokens {
+		apiToken := authenticator.apiTokens[idx]
+		if subtle.ConstantTimeCompare([]byte(apiToken.Token), []byte(token)) == 1 {
+			matchingApiToken = &apiToken
+		}
+	}
+	if matchingApiToken == nil {
+		return nil, stacktrace.NewError("The API token of the request isn't valid")
+	}
+	return NewPrincipal(matchingApiToken.User, matchingApiToken.Role), nil
+}
+
+// Authorize returns an error if the principal doesn't have the required role. A nil principal means authentication
+// is disabled, so it's allowed everything
+func Authorize(principal *Principal, requiredRole args.ApiTokenRole) error {
+	if principal == nil {
+		return nil
+	}
+	if !principal.GetRole().Includes(requiredRole) {
+		return stacktrace.NewError(
+			"User '%v' has role '%v' but this action requires role '%v'",
+			principal.GetUser(),
+			principal.GetRole(),
+			requiredRole,
+		)
+	}
+	return nil
+}
+
+// AuthorizeEnclaveOwnerAction returns an error if the principal may not act on an enclave with the given owner.
+// Admins may act on any enclave; anyone else only on the enclaves they created
+func AuthorizeEnclaveOwnerAction(principal *Principal, enclaveIdentifier string, enclaveOwner string) error {
+	if principal == nil || principal.GetRole().Includes(args.ApiTokenRole_Admin) {
+		return nil
+	}
+	if enclaveOwner != principal.GetUser() {
+		return stacktrace.NewError(
+			"User '%v' doesn't own enclave '%v'; only its owner or an '%v' can do this",
+			principal.GetUser(),
+			enclaveIdentifier,
+			args.ApiTokenRole_Admin,
+		)
+	}
+	return nil
+}
+
+// AuthorizeScheduleOwnerAction is AuthorizeEnclaveOwnerAction for the schedules of runs
+func AuthorizeScheduleOwnerAction(principal *Principal, scheduleUuid string, scheduleOwner string) error {
+	if principal == nil || principal.GetRole().Includes(args.ApiTokenRole_Admin) {
+		return nil
+	}
+	if scheduleOwner != principal.GetUser() {
+		return stacktrace.NewError(
+			"User '%v' didn't add schedule '%v'; only the user who added it or an '%v' can do this",
+			principal.GetUser(),
+			scheduleUuid,
+			args.ApiTokenRole_Admin,
+		)
+	}
+	return nil
+}
+
+func WithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalContextKey{}, principal)
+}
+
+// GetPrincipal returns the user the request in the context was authenticated as, or nil if authentication is disabled
+func GetPrincipal(ctx context.Context) *Principal {
+	principal, found := ctx.Value(principalContextKey{}).(*Principal)
+	if !found {
+		return nil
+	}
+	return principal
+}
+
+// GetUser returns the name of the user the request in the context was authenticated as, or an empty string if
+// authentication is disabled
+func GetUser(ctx context.Context) string {
+	principal := GetPrincipal(ctx)
+	if principal == nil {
+		return ""
+	}
+	return principal.GetUser()
+}
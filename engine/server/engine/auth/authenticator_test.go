@@ -0,0 +1,129 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	api_container_args "github.com/kurtosis-tech/kurtosis/core/launcher/args"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	viewerToken   = "viewer-token"
+	operatorToken = "operator-token"
+
+	viewerUser   = "alice"
+	operatorUser = "bob"
+	adminUser    = "carol"
+)
+
+var testApiTokens = []args.ApiToken{
+	*args.NewApiToken(viewerToken, viewerUser, args.ApiTokenRole_Viewer),
+	*args.NewApiToken(operatorToken, operatorUser, args.ApiTokenRole_Operator),
+}
+
+func TestAuthenticate_DisabledWithoutTokens(t *testing.T) {
+	authenticator := NewAuthenticator(nil)
+	require.False(t, authenticator.IsEnabled())
+
+	principal, err := authenticator.Authenticate(http.Header{})
+	require.NoError(t, err)
+	require.Nil(t, principal)
+}
+
+func TestAuthenticate_ValidToken(t *testing.T) {
+	authenticator := NewAuthenticator(testApiTokens)
+
+	principal, err := authenticator.Authenticate(newHeaders("Bearer " + operatorToken))
+	require.NoError(t, err)
+	require.Equal(t, operatorUser, principal.GetUser())
+	require.Equal(t, args.ApiTokenRole_Operator, principal.GetRole())
+}
+
+func TestAuthenticate_MissingOrInvalidToken(t *testing.T) {
+	authenticator := NewAuthenticator(testApiTokens)
+
+	_, err := authenticator.Authenticate(http.Header{})
+	require.Error(t, err)
+
+	_, err = authenticator.Authenticate(newHeaders(operatorToken))
+	require.Error(t, err)
+
+	_, err = authenticator.Authenticate(newHeaders("Bearer unknown-token"))
+	require.Error(t, err)
+}
+
+func TestAuthorize(t *testing.T) {
+	viewer := NewPrincipal(viewerUser, args.ApiTokenRole_Viewer)
+	operator := NewPrincipal(operatorUser, args.ApiTokenRole_Operator)
+	admin := NewPrincipal(adminUser, args.ApiTokenRole_Admin)
+
+	require.NoError(t, Authorize(nil, args.ApiTokenRole_Admin))
+	require.NoError(t, Authorize(viewer, args.ApiTokenRole_Viewer))
+	require.Error(t, Authorize(viewer, args.ApiTokenRole_Operator))
+	require.NoError(t, Authorize(operator, args.ApiTokenRole_Operator))
+	require.Error(t, Authorize(operator, args.ApiTokenRole_Admin))
+	require.NoError(t, Authorize(admin, args.ApiTokenRole_Operator))
+}
+
+func TestAuthorizeEnclaveOwnerAction(t *testing.T) {
+	operator := NewPrincipal(operatorUser, args.ApiTokenRole_Operator)
+	admin := NewPrincipal(adminUser, args.ApiTokenRole_Admin)
+
+	require.NoError(t, AuthorizeEnclaveOwnerAction(nil, "enclave", ""))
+	require.NoError(t, AuthorizeEnclaveOwnerAction(operator, "enclave", operatorUser))
+	require.Error(t, AuthorizeEnclaveOwnerAction(operator, "enclave", adminUser))
+	// Enclaves created before authentication was turned on have no owner, so only admins can touch them
+	require.Error(t, AuthorizeEnclaveOwnerAction(operator, "enclave", ""))
+	require.NoError(t, AuthorizeEnclaveOwnerAction(admin, "enclave", operatorUser))
+}
+
+func TestAuthorizeScheduleOwnerAction(t *testing.T) {
+	operator := NewPrincipal(operatorUser, args.ApiTokenRole_Operator)
+	admin := NewPrincipal(adminUser, args.ApiTokenRole_Admin)
+
+	require.NoError(t, AuthorizeScheduleOwnerAction(nil, "schedule", ""))
+	require.NoError(t, AuthorizeScheduleOwnerAction(operator, "schedule", operatorUser))
+	require.Error(t, AuthorizeScheduleOwnerAction(operator, "schedule", adminUser))
+	require.NoError(t, AuthorizeScheduleOwnerAction(admin, "schedule", operatorUser))
+}
+
+func TestGetApiContainerApiTokens(t *testing.T) {
+	disabledAuthenticator := NewAuthenticator(nil)
+	require.Nil(t, disabledAuthenticator.GetApiContainerApiTokens())
+	require.Equal(t, "", disabledAuthenticator.GetEngineApiContainerApiToken())
+
+	authenticator := NewAuthenticator(testApiTokens)
+	engineApiContainerApiToken := authenticator.GetEngineApiContainerApiToken()
+	require.NotEqual(t, viewerToken, engineApiContainerApiToken)
+	require.NotEqual(t, operatorToken, engineApiContainerApiToken)
+
+	// Only the hashes of the tokens are handed to the API containers
+	require.Equal(t, []api_container_args.ApiToken{
+		api_container_args.NewApiToken(viewerToken, viewerUser, api_container_args.ApiTokenRole_Viewer),
+		api_container_args.NewApiToken(operatorToken, operatorUser, api_container_args.ApiTokenRole_Operator),
+		api_container_args.NewApiToken(engineApiContainerApiToken, engineApiContainerUser, api_container_args.ApiTokenRole_Admin),
+	}, authenticator.GetApiContainerApiTokens())
+
+	// The same tokens, in any order, derive the same token so API containers keep accepting it across engine restarts
+	reorderedAuthenticator := NewAuthenticator([]args.ApiToken{testApiTokens[1], testApiTokens[0]})
+	require.Equal(t, engineApiContainerApiToken, reorderedAuthenticator.GetEngineApiContainerApiToken())
+	otherAuthenticator := NewAuthenticator(testApiTokens[:1])
+	require.NotEqual(t, engineApiContainerApiToken, otherAuthenticator.GetEngineApiContainerApiToken())
+}
+
+func TestPrincipalInContext(t *testing.T) {
+	require.Nil(t, GetPrincipal(context.Background()))
+	require.Equal(t, "", GetUser(context.Background()))
+
+	ctx := WithPrincipal(context.Background(), NewPrincipal(operatorUser, args.ApiTokenRole_Operator))
+	require.Equal(t, operatorUser, GetUser(ctx))
+}
+
+func newHeaders(authorizationHeaderValue string) http.Header {
+	headers := http.Header{}
+	headers.Set(AuthorizationHeaderKey, authorizationHeaderValue)
+	return headers
+}
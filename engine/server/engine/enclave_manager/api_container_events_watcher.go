@@ -0,0 +1,198 @@
+package enclave_manager
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/events"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/credentials"
+)
+
+const (
+	// How often the engine looks for running enclaves whose API container events aren't streamed yet, e.g. because
+	// the engine or the API container restarted. The API containers retain their recent events, so the ones published
+	// in the meantime are still received
+	apiContainerEventsWatcherInterval = 30 * time.Second
+
+	// A stream that ends is opened again right away, resuming after the last event received, until it fails this many
+	// times in a row
+	maxApiContainerEventsStreamAttempts = 5
+	// Doubled after every failed attempt
+	initialApiContainerEventsStreamRetryBackoff = 1 * time.Second
+)
+
+// Completeness enforced via unit test
+var engineEventTypesByApiContainerEventType = map[kurtosis_core_rpc_api_bindings.ApiContainerEventType]types.EngineEventType{
+	kurtosis_core_rpc_api_bindings.ApiContainerEventType_STARLARK_RUN_STARTED:   types.EngineEventType_STARLARK_RUN_STARTED,
+	kurtosis_core_rpc_api_bindings.ApiContainerEventType_STARLARK_RUN_FINISHED:  types.EngineEventType_STARLARK_RUN_FINISHED,
+	kurtosis_core_rpc_api_bindings.ApiContainerEventType_FILES_ARTIFACT_CREATED: types.EngineEventType_FILES_ARTIFACT_CREATED,
+	kurtosis_core_rpc_api_bindings.ApiContainerEventType_SERVICE_ADDED:          types.EngineEventType_SERVICE_ADDED,
+	kurtosis_core_rpc_api_bindings.ApiContainerEventType_SERVICE_STARTED:        types.EngineEventType_SERVICE_STARTED,
+	kurtosis_core_rpc_api_bindings.ApiContainerEventType_SERVICE_STOPPED:        types.EngineEventType_SERVICE_STOPPED,
+	kurtosis_core_rpc_api_bindings.ApiContainerEventType_SERVICE_CRASHED:        types.EngineEventType_SERVICE_CRASHED,
+}
+
+// apiContainerEventsWatcher streams the events of the API containers of the running enclaves, and publishes them on
+// the engine event bus alongside the enclave they happened in
+type apiContainerEventsWatcher struct {
+	mutex *sync.Mutex
+
+	apiContainerCredentials credentials.TransportCredentials
+	apiContainerApiToken    string
+
+	eventBus *events.EventBus
+
+	watchedEnclaveUuids map[string]bool
+
+	// The last event received from each enclave, so that watching it again resumes after it
+	lastReceivedEvents map[string]*apiContainerEventPosition
+}
+
+type apiContainerEventPosition struct {
+	streamId string
+
+	sequenceNumber uint64
+}
+
+func newApiContainerEventsWatcher(apiContainerCredentials credentials.TransportCredentials, apiContainerApiToken string, eventBus *events.EventBus) *apiContainerEventsWatcher {
+	return &apiContainerEventsWatcher{
+		mutex:                   &sync.Mutex{},
+		apiContainerCredentials: apiContainerCredentials,
+		apiContainerApiToken:    apiContainerApiToken,
+		eventBus:                eventBus,
+		watchedEnclaveUuids:     map[string]bool{},
+		lastReceivedEvents:      map[string]*apiContainerEventPosition{},
+	}
+}
+
+// watch starts streaming the events of the enclave, unless they're streamed already. The stream ends when the API
+// container goes away
+func (watcher *apiContainerEventsWatcher) watch(enclaveInfo *types.EnclaveInfo) {
+	watcher.mutex.Lock()
+	defer watcher.mutex.Unlock()
+
+	if enclaveInfo.ApiContainerStatus != types.ContainerStatus_RUNNING || enclaveInfo.ApiContainerInfo == nil {
+		return
+	}
+	if watcher.watchedEnclaveUuids[enclaveInfo.EnclaveUuid] {
+		return
+	}
+	watcher.watchedEnclaveUuids[enclaveInfo.EnclaveUuid] = true
+	go watcher.streamEvents(enclaveInfo)
+}
+
+// forget drops what the watcher knows about the enclave, once it's destroyed
+func (watcher *apiContainerEventsWatcher) forget(enclaveUuid string) {
+	watcher.mutex.Lock()
+	defer watcher.mutex.Unlock()
+	delete(watcher.lastReceivedEvents, enclaveUuid)
+}
+
+func (watcher *apiContainerEventsWatcher) streamEvents(enclaveInfo *types.EnclaveInfo) {
+	defer func() {
+		watcher.mutex.Lock()
+		defer watcher.mutex.Unlock()
+		delete(watcher.watchedEnclaveUuids, enclaveInfo.EnclaveUuid)
+	}()
+
+	backoff := initialApiContainerEventsStreamRetryBackoff
+	failedAttempts := 0
+	for {
+		hasReceivedEvents, err := watcher.streamEventsUntilError(enclaveInfo)
+		if hasReceivedEvents {
+			failedAttempts = 0
+			backoff = initialApiContainerEventsStreamRetryBackoff
+		}
+		failedAttempts++
+		if failedAttempts >= maxApiContainerEventsStreamAttempts {
+			logrus.Debugf("Stopped watching the events of the API container of enclave '%v' after %v failed attempts in a row; the last error was:\n%v", enclaveInfo.Name, failedAttempts, err)
+			return
+		}
+		logrus.Debugf("The event stream of the API container of enclave '%v' ended; watching it again in %v. Error was:\n%v", enclaveInfo.Name, backoff, err)
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+// streamEventsUntilError publishes the events of the API container, resuming after the last event received, until the
+// stream ends. Returns whether any event was received
+func (watcher *apiContainerEventsWatcher) streamEventsUntilError(enclaveInfo *types.EnclaveInfo) (bool, error) {
+	conn, err := dialApiContainer(watcher.apiContainerCredentials, watcher.apiContainerApiToken, enclaveInfo)
+	if err != nil {
+		return false, stacktrace.Propagate(err, "An error occurred connecting to the API container of enclave '%v' to watch its events", enclaveInfo.Name)
+	}
+	defer conn.Close()
+
+	watchEventsArgs := &kurtosis_core_rpc_api_bindings.WatchEventsArgs{
+		AfterStreamId:       nil,
+		AfterSequenceNumber: nil,
+	}
+	if lastReceivedEvent := watcher.getLastReceivedEvent(enclaveInfo.EnclaveUuid); lastReceivedEvent != nil {
+		watchEventsArgs.AfterStreamId = &lastReceivedEvent.streamId
+		watchEventsArgs.AfterSequenceNumber = &lastReceivedEvent.sequenceNumber
+	}
+	stream, err := kurtosis_core_rpc_api_bindings.NewApiContainerServiceClient(conn).WatchEvents(context.Background(), watchEventsArgs)
+	if err != nil {
+		return false, stacktrace.Propagate(err, "An error occurred watching the events of the API container of enclave '%v'", enclaveInfo.Name)
+	}
+	hasReceivedEvents := false
+	for {
+		apiContainerEvent, err := stream.Recv()
+		if err == io.EOF {
+			return hasReceivedEvents, stacktrace.NewError("The API container of enclave '%v' closed the event stream", enclaveInfo.Name)
+		}
+		if err != nil {
+			return hasReceivedEvents, stacktrace.Propagate(err, "An error occurred reading the event stream of the API container of enclave '%v'", enclaveInfo.Name)
+		}
+		hasReceivedEvents = true
+		watcher.recordReceivedEvent(enclaveInfo, apiContainerEvent)
+		eventType, found := engineEventTypesByApiContainerEventType[apiContainerEvent.GetType()]
+		if !found {
+			logrus.Warnf("Ignoring API container event of unknown type '%v' from enclave '%v'", apiContainerEvent.GetType(), enclaveInfo.Name)
+			continue
+		}
+		watcher.eventBus.Publish(&types.EngineEvent{
+			Type:              eventType,
+			Timestamp:         apiContainerEvent.GetTimestamp().AsTime(),
+			EnclaveUuid:       enclaveInfo.EnclaveUuid,
+			EnclaveName:       enclaveInfo.Name,
+			ServiceName:       apiContainerEvent.ServiceName,
+			ServiceUuid:       apiContainerEvent.ServiceUuid,
+			FilesArtifactName: apiContainerEvent.FilesArtifactName,
+			FilesArtifactUuid: apiContainerEvent.FilesArtifactUuid,
+			IsRunSuccessful:   apiContainerEvent.IsRunSuccessful,
+		})
+	}
+}
+
+func (watcher *apiContainerEventsWatcher) getLastReceivedEvent(enclaveUuid string) *apiContainerEventPosition {
+	watcher.mutex.Lock()
+	defer watcher.mutex.Unlock()
+	return watcher.lastReceivedEvents[enclaveUuid]
+}
+
+// recordReceivedEvent remembers the event as the last one received from the enclave, warning about the events the API
+// container didn't retain long enough for the engine to receive them
+func (watcher *apiContainerEventsWatcher) recordReceivedEvent(enclaveInfo *types.EnclaveInfo, apiContainerEvent *kurtosis_core_rpc_api_bindings.ApiContainerEvent) {
+	watcher.mutex.Lock()
+	defer watcher.mutex.Unlock()
+
+	expectedSequenceNumber := uint64(1)
+	lastReceivedEvent, found := watcher.lastReceivedEvents[enclaveInfo.EnclaveUuid]
+	if found && lastReceivedEvent.streamId == apiContainerEvent.GetStreamId() {
+		expectedSequenceNumber = lastReceivedEvent.sequenceNumber + 1
+	}
+	if apiContainerEvent.GetSequenceNumber() > expectedSequenceNumber {
+		logrus.Warnf("Missed %v events of enclave '%v' that its API container didn't retain", apiContainerEvent.GetSequenceNumber()-expectedSequenceNumber, enclaveInfo.Name)
+	}
+	watcher.lastReceivedEvents[enclaveInfo.EnclaveUuid] = &apiContainerEventPosition{
+		streamId:       apiContainerEvent.GetStreamId(),
+		sequenceNumber: apiContainerEvent.GetSequenceNumber(),
+	}
+}
@@ -0,0 +1,88 @@
+package enclave_manager
+
+import (
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/util/tls_credentials"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	api_container_args "github.com/kurtosis-tech/kurtosis/core/launcher/args"
+	"github.com/kurtosis-tech/stacktrace"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const (
+	apiContainerServerCertificateCommonNamePrefix = "kurtosis-api-container-"
+
+	// A new certificate is issued each time the API container is started, so this only has to outlive a long-running
+	// enclave
+	apiContainerServerCertificateValidity = 365 * 24 * time.Hour
+)
+
+// ApiContainerTlsConfig holds what the engine needs to start the API containers serving TLS and to call them. Each API
+// container gets a server certificate of its own, signed with the engine's CA, and never any of the engine's keys
+type ApiContainerTlsConfig struct {
+	certificateIssuer *tls_credentials.CertificateIssuer
+
+	// Certificate and key the engine presents to the API containers when they require client certificates
+	clientCertificate string
+	clientKey         string
+
+	requireClientCertificate bool
+}
+
+func NewApiContainerTlsConfig(
+	caCertificate string,
+	caKey string,
+	clientCertificate string,
+	clientKey string,
+	requireClientCertificate bool,
+) (*ApiContainerTlsConfig, error) {
+	certificateIssuer, err := tls_credentials.NewCertificateIssuer([]byte(caCertificate), []byte(caKey))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the CA the API containers' certificates get signed with")
+	}
+	return &ApiContainerTlsConfig{
+		certificateIssuer:        certificateIssuer,
+		clientCertificate:        clientCertificate,
+		clientKey:                clientKey,
+		requireClientCertificate: requireClientCertificate,
+	}, nil
+}
+
+// newApiContainerTlsConfig returns the TLS material handed to the API container of the enclave, with a newly issued
+// server certificate. A nil config means the API container serves plaintext
+func (tlsConfig *ApiContainerTlsConfig) newApiContainerTlsConfig(enclaveUuid enclave.EnclaveUUID) (*api_container_args.TlsConfig, error) {
+	if tlsConfig == nil {
+		return nil, nil
+	}
+	serverCertificate, serverKey, err := tlsConfig.certificateIssuer.IssueServerCertificate(
+		apiContainerServerCertificateCommonNamePrefix+string(enclaveUuid),
+		apiContainerServerCertificateValidity,
+	)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred issuing the server certificate of the API container of enclave '%v'", enclaveUuid)
+	}
+	return api_container_args.NewTlsConfig(
+		string(tlsConfig.certificateIssuer.GetCaCertificatePem()),
+		string(serverCertificate),
+		string(serverKey),
+		tlsConfig.requireClientCertificate,
+	), nil
+}
+
+// getApiContainerCredentials returns the credentials the engine calls the API containers with
+func getApiContainerCredentials(tlsConfig *ApiContainerTlsConfig) (credentials.TransportCredentials, error) {
+	if tlsConfig == nil {
+		return insecure.NewCredentials(), nil
+	}
+	apiContainerCredentials, err := tls_credentials.NewClientTransportCredentials(
+		tlsConfig.certificateIssuer.GetCaCertificatePem(),
+		[]byte(tlsConfig.clientCertificate),
+		[]byte(tlsConfig.clientKey),
+	)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the API container client TLS credentials")
+	}
+	return apiContainerCredentials, nil
+}
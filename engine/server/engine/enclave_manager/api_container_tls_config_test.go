@@ -0,0 +1,60 @@
+package enclave_manager
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"testing"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/util/tls_credentials"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	apiContainerTlsTestEnclaveUuid = "enclave-uuid"
+	apiContainerTlsTestCaValidity  = 24 * time.Hour
+)
+
+func TestNewApiContainerTlsConfig_PlaintextWithoutTls(t *testing.T) {
+	var tlsConfig *ApiContainerTlsConfig
+	apiContainerTlsConfig, err := tlsConfig.newApiContainerTlsConfig(apiContainerTlsTestEnclaveUuid)
+	require.NoError(t, err)
+	require.Nil(t, apiContainerTlsConfig)
+}
+
+func TestNewApiContainerTlsConfig_IssuesAServerCertificatePerApiContainer(t *testing.T) {
+	certificateIssuer, err := tls_credentials.NewSelfSignedCertificateIssuer("engine CA", apiContainerTlsTestCaValidity)
+	require.NoError(t, err)
+	caKey, err := certificateIssuer.GetCaKeyPem()
+	require.NoError(t, err)
+	engineClientCertificate, engineClientKey, err := certificateIssuer.IssueClientCertificate("engine", apiContainerTlsTestCaValidity)
+	require.NoError(t, err)
+	tlsConfig, err := NewApiContainerTlsConfig(string(certificateIssuer.GetCaCertificatePem()), string(caKey), string(engineClientCertificate), string(engineClientKey), true)
+	require.NoError(t, err)
+
+	apiContainerTlsConfig, err := tlsConfig.newApiContainerTlsConfig(apiContainerTlsTestEnclaveUuid)
+	require.NoError(t, err)
+	require.Equal(t, string(certificateIssuer.GetCaCertificatePem()), apiContainerTlsConfig.CaCertificate)
+	require.True(t, apiContainerTlsConfig.RequireClientCertificate)
+	// Neither the CA key nor the engine's client key get handed to the API container
+	require.NotContains(t, apiContainerTlsConfig.ServerKey, string(caKey))
+	require.NotEqual(t, string(engineClientKey), apiContainerTlsConfig.ServerKey)
+
+	serverKeyPair, err := tls.X509KeyPair([]byte(apiContainerTlsConfig.ServerCertificate), []byte(apiContainerTlsConfig.ServerKey))
+	require.NoError(t, err)
+	serverCertificate, err := x509.ParseCertificate(serverKeyPair.Certificate[0])
+	require.NoError(t, err)
+	require.Equal(t, apiContainerServerCertificateCommonNamePrefix+apiContainerTlsTestEnclaveUuid, serverCertificate.Subject.CommonName)
+	caPool, err := tls_credentials.NewCertPool(certificateIssuer.GetCaCertificatePem())
+	require.NoError(t, err)
+	_, err = serverCertificate.Verify(x509.VerifyOptions{
+		DNSName:   tls_credentials.ServerName,
+		Roots:     caPool,
+		KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	})
+	require.NoError(t, err)
+
+	otherApiContainerTlsConfig, err := tlsConfig.newApiContainerTlsConfig(apiContainerTlsTestEnclaveUuid)
+	require.NoError(t, err)
+	require.NotEqual(t, apiContainerTlsConfig.ServerKey, otherApiContainerTlsConfig.ServerKey)
+}
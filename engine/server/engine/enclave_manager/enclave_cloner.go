@@ -0,0 +1,163 @@
+package enclave_manager
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/util/api_token_credentials"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// cloneEnclaveContents copies the files artifacts and the persistent directories of the source enclave into the
+// destination enclave, and then replays the enclave plan of the source enclave in the destination one. Both enclaves
+// must have their API container running
+func cloneEnclaveContents(
+	ctx context.Context,
+	kurtosisBackend backend_interface.KurtosisBackend,
+	apiContainerCredentials credentials.TransportCredentials,
+	apiContainerApiToken string,
+	sourceEnclaveInfo *types.EnclaveInfo,
+	destinationEnclaveInfo *types.EnclaveInfo,
+) error {
+	sourceConn, err := dialApiContainer(apiContainerCredentials, apiContainerApiToken, sourceEnclaveInfo)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred connecting to the API container of enclave '%v'", sourceEnclaveInfo.Name)
+	}
+	defer sourceConn.Close()
+	sourceApiContainerClient := kurtosis_core_rpc_api_bindings.NewApiContainerServiceClient(sourceConn)
+
+	destinationConn, err := dialApiContainer(apiContainerCredentials, apiContainerApiToken, destinationEnclaveInfo)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred connecting to the API container of enclave '%v'", destinationEnclaveInfo.Name)
+	}
+	defer destinationConn.Close()
+	destinationApiContainerClient := kurtosis_core_rpc_api_bindings.NewApiContainerServiceClient(destinationConn)
+
+	// The secrets are sealed by the source enclave with a key only the destination enclave holds, so that they never
+	// transit in clear text through the engine
+	secretsRecipient, err := destinationApiContainerClient.GetSecretsRecipientPublicKey(ctx, &emptypb.Empty{})
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the key to seal the secrets for enclave '%v'", destinationEnclaveInfo.Name)
+	}
+
+	// The plan is exported first so that a source enclave whose plan can't be replayed fails the clone before anything
+	// gets copied
+	exportArgs := &kurtosis_core_rpc_api_bindings.ExportEnclavePlanArgs{
+		SecretsRecipientPublicKey: secretsRecipient.GetPublicKey(),
+	}
+	exportedEnclavePlan, err := sourceApiContainerClient.ExportEnclavePlan(ctx, exportArgs)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred exporting the enclave plan of enclave '%v'", sourceEnclaveInfo.Name)
+	}
+
+	replayedFilesArtifactNames := map[string]bool{}
+	for _, filesArtifactName := range exportedEnclavePlan.GetReplayedFilesArtifactNames() {
+		replayedFilesArtifactNames[filesArtifactName] = true
+	}
+	filesArtifacts, err := sourceApiContainerClient.ListFilesArtifactNamesAndUuids(ctx, &emptypb.Empty{})
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred listing the files artifacts of enclave '%v'", sourceEnclaveInfo.Name)
+	}
+	for _, filesArtifact := range filesArtifacts.GetFileNamesAndUuids() {
+		filesArtifactName := filesArtifact.GetFileName()
+		if replayedFilesArtifactNames[filesArtifactName] {
+			continue
+		}
+		if err := copyFilesArtifact(ctx, filesArtifactName, sourceApiContainerClient, destinationApiContainerClient); err != nil {
+			return stacktrace.Propagate(err, "An error occurred copying files artifact '%v' from enclave '%v' to enclave '%v'", filesArtifactName, sourceEnclaveInfo.Name, destinationEnclaveInfo.Name)
+		}
+	}
+
+	if err := kurtosisBackend.CopyEnclavePersistentDirectories(ctx, enclave.EnclaveUUID(sourceEnclaveInfo.EnclaveUuid), enclave.EnclaveUUID(destinationEnclaveInfo.EnclaveUuid)); err != nil {
+		return stacktrace.Propagate(err, "An error occurred copying the persistent directories of enclave '%v' to enclave '%v'", sourceEnclaveInfo.Name, destinationEnclaveInfo.Name)
+	}
+
+	logrus.Infof("Replaying the enclave plan of enclave '%v' in enclave '%v'...", sourceEnclaveInfo.Name, destinationEnclaveInfo.Name)
+	replayArgs := &kurtosis_core_rpc_api_bindings.ReplayEnclavePlanArgs{
+		SerializedExportedEnclavePlan: exportedEnclavePlan.GetSerializedExportedEnclavePlan(),
+		SealedSecrets:                 exportedEnclavePlan.GetSealedSecrets(),
+	}
+	stream, err := destinationApiContainerClient.ReplayEnclavePlan(ctx, replayArgs)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred starting the replay of the enclave plan of enclave '%v'", sourceEnclaveInfo.Name)
+	}
+	for {
+		responseLine, err := stream.Recv()
+		if err == io.EOF {
+			return stacktrace.NewError("The replay of the enclave plan of enclave '%v' ended without reporting whether it succeeded", sourceEnclaveInfo.Name)
+		}
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred reading the output of the replay of the enclave plan of enclave '%v'", sourceEnclaveInfo.Name)
+		}
+		if starlarkErr := responseLine.GetError(); starlarkErr != nil {
+			return stacktrace.NewError("The replay of the enclave plan of enclave '%v' failed:\n%v", sourceEnclaveInfo.Name, getStarlarkErrorMessage(starlarkErr))
+		}
+		if runFinishedEvent := responseLine.GetRunFinishedEvent(); runFinishedEvent != nil {
+			if !runFinishedEvent.GetIsRunSuccessful() {
+				return stacktrace.NewError("The replay of the enclave plan of enclave '%v' didn't succeed", sourceEnclaveInfo.Name)
+			}
+			logrus.Infof("Enclave plan of enclave '%v' replayed successfully in enclave '%v'", sourceEnclaveInfo.Name, destinationEnclaveInfo.Name)
+			return nil
+		}
+	}
+}
+
+// copyFilesArtifact streams a files artifact from one API container to another. The chunks are forwarded as they
+// are, as the download names them after the requested identifier, which here is the files artifact name
+func copyFilesArtifact(
+	ctx context.Context,
+	filesArtifactName string,
+	sourceApiContainerClient kurtosis_core_rpc_api_bindings.ApiContainerServiceClient,
+	destinationApiContainerClient kurtosis_core_rpc_api_bindings.ApiContainerServiceClient,
+) error {
+	downloadStream, err := sourceApiContainerClient.DownloadFilesArtifact(ctx, &kurtosis_core_rpc_api_bindings.DownloadFilesArtifactArgs{Identifier: filesArtifactName})
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred starting the download of files artifact '%v'", filesArtifactName)
+	}
+	uploadStream, err := destinationApiContainerClient.UploadFilesArtifact(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred starting the upload of files artifact '%v'", filesArtifactName)
+	}
+	for {
+		dataChunk, err := downloadStream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred downloading files artifact '%v'", filesArtifactName)
+		}
+		if err := uploadStream.Send(dataChunk); err != nil {
+			return stacktrace.Propagate(err, "An error occurred uploading files artifact '%v'", filesArtifactName)
+		}
+	}
+	if _, err := uploadStream.CloseAndRecv(); err != nil {
+		return stacktrace.Propagate(err, "An error occurred finishing the upload of files artifact '%v'", filesArtifactName)
+	}
+	return nil
+}
+
+// dialApiContainer connects to the API container of the enclave. If the API token isn't empty, every call carries it
+func dialApiContainer(apiContainerCredentials credentials.TransportCredentials, apiContainerApiToken string, enclaveInfo *types.EnclaveInfo) (*grpc.ClientConn, error) {
+	if enclaveInfo.ApiContainerInfo == nil {
+		return nil, stacktrace.NewError("Enclave '%v' has no API container information", enclaveInfo.Name)
+	}
+	apiContainerAddress := fmt.Sprintf("%v:%v", enclaveInfo.ApiContainerInfo.BridgeIpAddress, enclaveInfo.ApiContainerInfo.GrpcPortInsideEnclave)
+	dialOpts := []grpc.DialOption{grpc.WithTransportCredentials(apiContainerCredentials)}
+	if apiContainerApiToken != "" {
+		dialOpts = append(dialOpts, grpc.WithPerRPCCredentials(api_token_credentials.NewApiTokenCredentials(apiContainerApiToken)))
+	}
+	conn, err := grpc.NewClient(apiContainerAddress, dialOpts...)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating a connection to the API container at '%v'", apiContainerAddress)
+	}
+	return conn, nil
+}
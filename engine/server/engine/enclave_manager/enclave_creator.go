@@ -9,6 +9,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
 	"github.com/kurtosis-tech/kurtosis/core/launcher/api_container_launcher"
+	api_container_args "github.com/kurtosis-tech/kurtosis/core/launcher/args"
 	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
@@ -24,16 +25,28 @@ const (
 type EnclaveCreator struct {
 	kurtosisBackend                           backend_interface.KurtosisBackend
 	apiContainerKurtosisBackendConfigSupplier api_container_launcher.KurtosisBackendConfigSupplier
+	// If nil, the API containers serve plaintext
+	apiContainerTlsConfig *ApiContainerTlsConfig
+	// If empty, the API containers don't authenticate their callers
+	apiContainerApiTokens []api_container_args.ApiToken
+	// What the engine calls the API containers with, empty if they don't authenticate their callers
+	apiContainerApiToken string
 }
 
 func newEnclaveCreator(
 	kurtosisBackend backend_interface.KurtosisBackend,
 	apiContainerKurtosisBackendConfigSupplier api_container_launcher.KurtosisBackendConfigSupplier,
+	apiContainerTlsConfig *ApiContainerTlsConfig,
+	apiContainerApiTokens []api_container_args.ApiToken,
+	apiContainerApiToken string,
 ) *EnclaveCreator {
 
 	return &EnclaveCreator{
 		kurtosisBackend: kurtosisBackend,
 		apiContainerKurtosisBackendConfigSupplier: apiContainerKurtosisBackendConfigSupplier,
+		apiContainerTlsConfig:                     apiContainerTlsConfig,
+		apiContainerApiTokens:                     apiContainerApiTokens,
+		apiContainerApiToken:                      apiContainerApiToken,
 	}
 }
 
@@ -178,6 +191,9 @@ func (creator *EnclaveCreator) CreateEnclave(
 		ApiContainerHostMachineInfo: apiContainerHostMachineInfo,
 		CreationTime:                *creationTimestamp,
 		Mode:                        mode,
+		ExpirationTime:              nil,
+		IdleExpirationTime:          nil,
+		Owner:                       "",
 	}
 
 	// Everything started successfully, so the responsibility of deleting the enclave is now transferred to the caller
@@ -205,6 +221,10 @@ func (creator *EnclaveCreator) LaunchApiContainer(
 	resultApiContainer *api_container.APIContainer,
 	resultErr error,
 ) {
+	apiContainerTlsConfig, err := creator.apiContainerTlsConfig.newApiContainerTlsConfig(enclaveUuid)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the TLS config of the API container of enclave '%v'", enclaveUuid)
+	}
 	apiContainerLauncher := api_container_launcher.NewApiContainerLauncher(
 		creator.kurtosisBackend,
 	)
@@ -223,7 +243,10 @@ func (creator *EnclaveCreator) LaunchApiContainer(
 			isCI,
 			cloudUserID,
 			cloudInstanceID,
-			shouldStartInDebugMode)
+			shouldStartInDebugMode,
+			apiContainerTlsConfig,
+			creator.apiContainerApiTokens,
+		)
 		if err != nil {
 			return nil, stacktrace.Propagate(err, "Expected to be able to launch api container for enclave '%v' with custom version '%v', but an error occurred", enclaveUuid, apiContainerImageVersionTag)
 		}
@@ -243,6 +266,8 @@ func (creator *EnclaveCreator) LaunchApiContainer(
 		cloudUserID,
 		cloudInstanceID,
 		shouldStartInDebugMode,
+		apiContainerTlsConfig,
+		creator.apiContainerApiTokens,
 	)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Expected to be able to launch api container for enclave '%v' with the default version, but an error occurred", enclaveUuid)
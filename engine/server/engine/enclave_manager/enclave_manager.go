@@ -8,6 +8,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs"
 
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
@@ -20,11 +21,15 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
 	"github.com/kurtosis-tech/kurtosis/core/launcher/api_container_launcher"
+	api_container_args "github.com/kurtosis-tech/kurtosis/core/launcher/args"
 	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/events"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
 	"github.com/kurtosis-tech/kurtosis/name_generator"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 const (
@@ -37,6 +42,15 @@ const (
 	errorDelimiter = ", "
 
 	enclaveNameNotFound = "Name Not Found"
+
+	// How often the engine looks for enclaves that outlived their TTL or idle timeout
+	enclaveReaperInterval = 1 * time.Minute
+
+	// Activity is persisted at most this often per enclave, as enclaves are looked up on almost every client call; the
+	// idle timeouts are way coarser than this anyway
+	activityPersistenceInterval = 1 * time.Minute
+
+	getApiContainerLastActivityTimeTimeout = 10 * time.Second
 )
 
 // TODO Move this to the KurtosisBackend to calculate!!
@@ -80,6 +94,27 @@ type EnclaveManager struct {
 
 	logsCollectorFilters []logs_collector.Filter
 	logsCollectorParsers []logs_collector.Parser
+
+	// Last time each enclave was used, as seen by this engine. It's persisted along with the enclave every
+	// activityPersistenceInterval, so the persisted activity of an enclave can lag behind by that much
+	lastActivityTimes map[enclave.EnclaveUUID]time.Time
+	// Last activity time persisted for each enclave by this engine
+	persistedActivityTimes map[enclave.EnclaveUUID]time.Time
+	// An enclave without any activity recorded is considered to have been last used when it was created or when the
+	// engine started, whichever happened later
+	startTime time.Time
+
+	stopEnclaveReaperChan chan struct{}
+
+	// Used to reach the API containers of the enclaves when cloning them or running packages in them
+	apiContainerCredentials credentials.TransportCredentials
+	apiContainerApiToken    string
+
+	eventBus *events.EventBus
+
+	apiContainerEventsWatcher *apiContainerEventsWatcher
+
+	stopApiContainerEventsWatcherChan chan struct{}
 }
 
 func CreateEnclaveManager(
@@ -97,20 +132,30 @@ func CreateEnclaveManager(
 	cloudInstanceID metrics_client.CloudInstanceID,
 	logsCollectorFilters []logs_collector.Filter,
 	logsCollectorParsers []logs_collector.Parser,
+	apiContainerTlsConfig *ApiContainerTlsConfig,
+	enclaveTemplates []args.EnclaveTemplate,
+	eventBus *events.EventBus,
+	// The API tokens the API containers accept and the one the engine calls them with, empty if they don't authenticate
+	// their callers
+	apiContainerApiTokens []api_container_args.ApiToken,
+	apiContainerApiToken string,
 ) (*EnclaveManager, error) {
-	enclaveCreator := newEnclaveCreator(kurtosisBackend, apiContainerKurtosisBackendConfigSupplier)
+	enclaveCreator := newEnclaveCreator(kurtosisBackend, apiContainerKurtosisBackendConfigSupplier, apiContainerTlsConfig, apiContainerApiTokens, apiContainerApiToken)
+	apiContainerCredentials, err := getApiContainerCredentials(apiContainerTlsConfig)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the credentials to connect to the API containers")
+	}
 
-	var (
-		err         error
-		enclavePool *EnclavePool
-	)
+	var enclavePool *EnclavePool
 
 	// The enclave pool feature is only available for Kubernetes so far
 	if kurtosisBackendType == args.KurtosisBackendType_Kubernetes {
-		enclavePool, err = CreateEnclavePool(kurtosisBackend, enclaveCreator, poolSize, engineVersion, enclaveEnvVars, metricsUserID, didUserAcceptSendingMetrics, isCI, cloudUserID, cloudInstanceID, logsCollectorFilters, logsCollectorParsers)
+		enclavePool, err = CreateEnclavePool(kurtosisBackend, enclaveCreator, poolSize, enclaveTemplates, engineVersion, enclaveEnvVars, metricsUserID, didUserAcceptSendingMetrics, isCI, cloudUserID, cloudInstanceID, logsCollectorFilters, logsCollectorParsers)
 		if err != nil {
 			return nil, stacktrace.Propagate(err, "An error occurred creating enclave pool with pool-size '%v' and engine version '%v'", poolSize, engineVersion)
 		}
+	} else if len(enclaveTemplates) > 0 {
+		return nil, stacktrace.NewError("Enclave templates are only supported on Kubernetes, but the engine runs on backend '%v'", kurtosisBackendType.String())
 	}
 
 	enclaveManager := &EnclaveManager{
@@ -130,8 +175,20 @@ func CreateEnclaveManager(
 		cloudInstanceID:                           cloudInstanceID,
 		logsCollectorFilters:                      logsCollectorFilters,
 		logsCollectorParsers:                      logsCollectorParsers,
+		lastActivityTimes:                         map[enclave.EnclaveUUID]time.Time{},
+		persistedActivityTimes:                    map[enclave.EnclaveUUID]time.Time{},
+		startTime:                                 time.Now(),
+		stopEnclaveReaperChan:                     make(chan struct{}),
+		apiContainerCredentials:                   apiContainerCredentials,
+		apiContainerApiToken:                      apiContainerApiToken,
+		eventBus:                                  eventBus,
+		apiContainerEventsWatcher:                 newApiContainerEventsWatcher(apiContainerCredentials, apiContainerApiToken, eventBus),
+		stopApiContainerEventsWatcherChan:         make(chan struct{}),
 	}
 
+	go enclaveManager.runEnclaveReaper()
+	go enclaveManager.runApiContainerEventsWatcher()
+
 	return enclaveManager, nil
 }
 
@@ -148,7 +205,68 @@ func (manager *EnclaveManager) CreateEnclave(
 	enclaveName string,
 	isProduction bool,
 	shouldAPICRunInDebugMode bool,
+	// If nil, the enclave never expires
+	ttl *time.Duration,
+	// If nil, the enclave is never stopped for inactivity
+	idleTimeout *time.Duration,
+	// If nil, the services in the enclave can use as much as the host allows
+	resourceQuota *enclave.EnclaveResourceQuota,
+	// If blank, the enclave has no owner and only engine API admins can destroy it once authentication is turned on
+	owner string,
+	// If blank, the enclave starts empty; otherwise the package of this enclave template is running in it
+	templateName string,
 ) (*types.EnclaveInfo, error) {
+	enclaveInfo, isReady, err := manager.createEnclave(
+		setupCtx,
+		engineVersion,
+		apiContainerImageVersionTag,
+		apiContainerLogLevel,
+		enclaveName,
+		isProduction,
+		shouldAPICRunInDebugMode,
+		ttl,
+		idleTimeout,
+		resourceQuota,
+		owner,
+		templateName,
+	)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating enclave '%v'", enclaveName)
+	}
+	manager.apiContainerEventsWatcher.watch(enclaveInfo)
+	if isReady {
+		manager.publishEnclaveEvent(types.EngineEventType_ENCLAVE_CREATED, enclaveInfo.EnclaveUuid, enclaveInfo.Name)
+		return enclaveInfo, nil
+	}
+
+	// No idle enclave of the template was ready, so its package runs now. That can take minutes, which is why it
+	// happens without holding the mutex
+	if err := manager.enclavePool.RunTemplatePackage(setupCtx, templateName, enclaveInfo); err != nil {
+		if destroyErr := manager.DestroyEnclave(context.Background(), enclaveInfo.EnclaveUuid); destroyErr != nil {
+			logrus.Errorf("Running the package of template '%v' in enclave '%v' failed and destroying the enclave afterwards failed too, you'll need to destroy it manually. Error:\n%v", templateName, enclaveInfo.Name, destroyErr)
+		}
+		return nil, stacktrace.Propagate(err, "An error occurred running the package of template '%v' in enclave '%v'", templateName, enclaveInfo.Name)
+	}
+	manager.publishEnclaveEvent(types.EngineEventType_ENCLAVE_CREATED, enclaveInfo.EnclaveUuid, enclaveInfo.Name)
+	return enclaveInfo, nil
+}
+
+// createEnclave returns true alongside the enclave if it doesn't need the package of the template run in it, either
+// because no template was asked for or because the enclave comes from the pool with the package already run in it
+func (manager *EnclaveManager) createEnclave(
+	setupCtx context.Context,
+	engineVersion string,
+	apiContainerImageVersionTag string,
+	apiContainerLogLevel logrus.Level,
+	enclaveName string,
+	isProduction bool,
+	shouldAPICRunInDebugMode bool,
+	ttl *time.Duration,
+	idleTimeout *time.Duration,
+	resourceQuota *enclave.EnclaveResourceQuota,
+	owner string,
+	templateName string,
+) (*types.EnclaveInfo, bool, error) {
 	manager.mutex.Lock()
 	defer manager.mutex.Unlock()
 
@@ -157,9 +275,14 @@ func (manager *EnclaveManager) CreateEnclave(
 		err         error
 	)
 
+	isFromTemplate := templateName != ""
+	if isFromTemplate && manager.enclavePool == nil {
+		return nil, false, stacktrace.NewError("Enclave template '%v' was asked for, but the engine wasn't started with any enclave template", templateName)
+	}
+
 	allExistingAndHistoricalIdentifiers, err := manager.getExistingAndHistoricalEnclaveIdentifiersWithoutMutex()
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "An error occurred getting existing and historical enclave identifiers")
+		return nil, false, stacktrace.Propagate(err, "An error occurred getting existing and historical enclave identifiers")
 	}
 
 	allEnclaveNames := []string{}
@@ -173,11 +296,29 @@ func (manager *EnclaveManager) CreateEnclave(
 	}
 
 	if err := validateEnclaveName(enclaveName); err != nil {
-		return nil, stacktrace.Propagate(err, "An error occurred validating enclave name '%v'", enclaveName)
+		return nil, false, stacktrace.Propagate(err, "An error occurred validating enclave name '%v'", enclaveName)
 	}
 
-	// TODO(victor.colombo): Extend enclave pool to have warm production enclaves
-	if !isProduction && manager.enclavePool != nil {
+	isTemplatePackageRun := false
+	if isFromTemplate {
+		if isProduction {
+			return nil, false, stacktrace.NewError("Enclave templates only hand out test enclaves, but a production enclave was asked for from template '%v'", templateName)
+		}
+		enclaveInfo, err = manager.enclavePool.GetEnclaveFromTemplate(
+			setupCtx,
+			enclaveName,
+			templateName,
+			engineVersion,
+			apiContainerImageVersionTag,
+			apiContainerLogLevel,
+			shouldAPICRunInDebugMode,
+		)
+		if err != nil {
+			return nil, false, stacktrace.Propagate(err, "An error occurred getting an enclave from template '%v'", templateName)
+		}
+		isTemplatePackageRun = enclaveInfo != nil
+	} else if !isProduction && manager.enclavePool != nil {
+		// TODO(victor.colombo): Extend enclave pool to have warm production enclaves
 		enclaveInfo, err = manager.enclavePool.GetEnclave(
 			setupCtx,
 			enclaveName,
@@ -210,7 +351,7 @@ func (manager *EnclaveManager) CreateEnclave(
 			manager.logsCollectorParsers,
 		)
 		if err != nil {
-			return nil, stacktrace.Propagate(
+			return nil, false, stacktrace.Propagate(
 				err,
 				"An error occurred creating new enclave with name '%s' using api container image version '%s' and api container log level '%v'",
 				enclaveName,
@@ -220,6 +361,57 @@ func (manager *EnclaveManager) CreateEnclave(
 		}
 	}
 
+	enclaveUuid := enclave.EnclaveUUID(enclaveInfo.EnclaveUuid)
+	now := time.Now()
+	if ttl != nil || idleTimeout != nil {
+		var expirationTime *time.Time
+		if ttl != nil {
+			enclaveExpirationTime := now.Add(*ttl)
+			expirationTime = &enclaveExpirationTime
+		}
+		lifetime := enclave.NewEnclaveLifetime(expirationTime, idleTimeout)
+		if err := manager.kurtosisBackend.UpdateEnclaveLifetime(setupCtx, enclaveUuid, lifetime); err != nil {
+			// An enclave that was asked to expire shouldn't be left around forever
+			if destroyErr := manager.destroyEnclaveWithoutMutex(setupCtx, enclaveUuid); destroyErr != nil {
+				logrus.Errorf("Setting the lifetime of enclave '%v' failed and destroying it afterwards failed too, you'll need to destroy it manually. Error:\n%v", enclaveName, destroyErr)
+			}
+			return nil, false, stacktrace.Propagate(err, "An error occurred setting the lifetime of enclave '%v'", enclaveName)
+		}
+		enclaveInfo.ExpirationTime = expirationTime
+		if idleTimeout != nil {
+			idleExpirationTime := now.Add(*idleTimeout)
+			enclaveInfo.IdleExpirationTime = &idleExpirationTime
+		}
+	}
+	if resourceQuota != nil && !resourceQuota.IsEmpty() {
+		if err := manager.kurtosisBackend.UpdateEnclaveResourceQuota(setupCtx, enclaveUuid, resourceQuota); err != nil {
+			// Handing back an enclave without the caps that were asked for would let it starve the other enclaves
+			if destroyErr := manager.destroyEnclaveWithoutMutex(setupCtx, enclaveUuid); destroyErr != nil {
+				logrus.Errorf("Setting the resource quota of enclave '%v' failed and destroying it afterwards failed too, you'll need to destroy it manually. Error:\n%v", enclaveName, destroyErr)
+			}
+			return nil, false, stacktrace.Propagate(err, "An error occurred setting the resource quota of enclave '%v'", enclaveName)
+		}
+	}
+	if owner != "" {
+		if err := manager.kurtosisBackend.UpdateEnclaveOwner(setupCtx, enclaveUuid, owner); err != nil {
+			// An enclave without an owner could only be cleaned up by an admin
+			if destroyErr := manager.destroyEnclaveWithoutMutex(setupCtx, enclaveUuid); destroyErr != nil {
+				logrus.Errorf("Setting the owner of enclave '%v' failed and destroying it afterwards failed too, you'll need to destroy it manually. Error:\n%v", enclaveName, destroyErr)
+			}
+			return nil, false, stacktrace.Propagate(err, "An error occurred setting the owner of enclave '%v'", enclaveName)
+		}
+		enclaveInfo.Owner = owner
+	}
+	// Recorded so that the API container gets launched the same way when the enclave is started after being stopped
+	apiContainerSettings := enclave.NewEnclaveApiContainerSettings(apiContainerImageVersionTag, apiContainerLogLevel.String())
+	if err := manager.kurtosisBackend.UpdateEnclaveApiContainerSettings(setupCtx, enclaveUuid, apiContainerSettings); err != nil {
+		if destroyErr := manager.destroyEnclaveWithoutMutex(setupCtx, enclaveUuid); destroyErr != nil {
+			logrus.Errorf("Recording the API container settings of enclave '%v' failed and destroying it afterwards failed too, you'll need to destroy it manually. Error:\n%v", enclaveName, destroyErr)
+		}
+		return nil, false, stacktrace.Propagate(err, "An error occurred recording the API container settings of enclave '%v'", enclaveName)
+	}
+	manager.recordActivityWithoutMutex(setupCtx, enclaveUuid, now)
+
 	enclaveIdentifier := &types.EnclaveIdentifiers{
 		EnclaveUuid:   enclaveInfo.EnclaveUuid,
 		Name:          enclaveInfo.Name,
@@ -227,7 +419,7 @@ func (manager *EnclaveManager) CreateEnclave(
 	}
 	manager.allExistingAndHistoricalIdentifiers = append(manager.allExistingAndHistoricalIdentifiers, enclaveIdentifier)
 
-	return enclaveInfo, nil
+	return enclaveInfo, !isFromTemplate || isTemplatePackageRun, nil
 }
 
 // It's a liiiitle weird that we return an EnclaveInfo object (which is a Protobuf object), but as of 2021-10-21 this class
@@ -260,11 +452,26 @@ func (manager *EnclaveManager) GetEnclavesByUuid(
 	manager.mutex.Lock()
 	defer manager.mutex.Unlock()
 
+	// Clients look up the enclaves they're about to work with, so a lookup of specific enclaves counts as using them;
+	// listing all the enclaves doesn't
+	now := time.Now()
+	for _, enclaveUuid := range enclaveUuids {
+		manager.lastActivityTimes[enclaveUuid] = now
+	}
+
 	enclaveInfos, err := manager.getEnclavesByUuidWithoutMutex(ctx, enclaveUuids)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred getting the enclave '%v' without mutex", enclaveUuids)
 	}
 
+	for _, enclaveUuid := range enclaveUuids {
+		if _, found := enclaveInfos[enclaveUuid]; !found {
+			delete(manager.lastActivityTimes, enclaveUuid)
+			continue
+		}
+		manager.recordActivityWithoutMutex(ctx, enclaveUuid, now)
+	}
+
 	// Transform map[enclave.EnclaveUUID]*EnclaveInfo -> map[string]*EnclaveInfo
 	enclaveMapKeyedWithUuidStr := map[string]*types.EnclaveInfo{}
 	for enclaveUuid, enclaveInfo := range enclaveInfos {
@@ -287,6 +494,100 @@ func (manager *EnclaveManager) StopEnclave(ctx context.Context, enclaveIdentifie
 	return manager.stopEnclaveWithoutMutex(ctx, enclaveUuid)
 }
 
+// StartEnclave brings a stopped enclave back by recreating its logs collector and API container. The user services
+// aren't started here; the new API container starts them again once asked to resume them, as it's the one that knows
+// the order and ready conditions of the services
+func (manager *EnclaveManager) StartEnclave(ctx context.Context, enclaveIdentifier string) (*types.EnclaveInfo, error) {
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+
+	enclaveUuid, err := manager.getEnclaveUuidForIdentifierUnlocked(ctx, enclaveIdentifier)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while fetching enclave uuid for identifier '%v'", enclaveIdentifier)
+	}
+
+	enclaves, err := manager.kurtosisBackend.GetEnclaves(ctx, getEnclaveByEnclaveIdFilter(enclaveUuid))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting enclave '%v'", enclaveUuid)
+	}
+	enclaveObj, found := enclaves[enclaveUuid]
+	if !found {
+		return nil, stacktrace.NewError("Enclave '%v' wasn't found", enclaveIdentifier)
+	}
+
+	apiContainerStatus, _, _, err := getEnclaveApiContainerInformation(ctx, manager.kurtosisBackend, enclaveUuid)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the API container information of enclave '%v'", enclaveUuid)
+	}
+	if apiContainerStatus == types.ContainerStatus_RUNNING {
+		return nil, stacktrace.NewError("Enclave '%v' can't be started because it's already running", enclaveIdentifier)
+	}
+
+	// The stopped logs collector can't be started again in place, so it gets recreated so that the logs of the services
+	// started again are collected
+	if err := manager.kurtosisBackend.DestroyLogsCollectorForEnclave(ctx, enclaveUuid); err != nil {
+		logrus.Debugf("An error occurred destroying the logs collector of stopped enclave '%v'; trying to create a new one anyway:\n%v", enclaveUuid, err)
+	}
+	if _, err := manager.kurtosisBackend.CreateLogsCollectorForEnclave(ctx, enclaveUuid, defaultHttpLogsCollectorPortNum, defaultTcpLogsCollectorPortNum, manager.logsCollectorFilters, manager.logsCollectorParsers); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the logs collector of enclave '%v'", enclaveUuid)
+	}
+
+	if err := manager.destroyApiContainers(ctx, map[enclave.EnclaveUUID]bool{enclaveUuid: true}); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred destroying the stopped API container of enclave '%v'", enclaveUuid)
+	}
+
+	apiContainerVersionTag, apiContainerLogLevel, err := getApiContainerSettingsToStartWith(enclaveObj)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the settings to launch the API container of enclave '%v' with", enclaveUuid)
+	}
+	noDebugMode := false
+	if _, err := manager.enclaveCreator.LaunchApiContainer(
+		ctx,
+		apiContainerVersionTag,
+		apiContainerLogLevel,
+		enclaveUuid,
+		apiContainerListenGrpcPortNumInsideNetwork,
+		manager.enclaveEnvVars,
+		enclaveObj.IsProductionEnclave(),
+		manager.metricsUserID,
+		manager.didUserAcceptSendingMetrics,
+		manager.isCI,
+		manager.cloudUserID,
+		manager.cloudInstanceID,
+		noDebugMode,
+	); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred launching the API container of enclave '%v'", enclaveUuid)
+	}
+
+	manager.recordActivityWithoutMutex(ctx, enclaveUuid, time.Now())
+
+	enclaveInfos, err := manager.getEnclavesByUuidWithoutMutex(ctx, []enclave.EnclaveUUID{enclaveUuid})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the information of enclave '%v' after starting it", enclaveUuid)
+	}
+	enclaveInfo, found := enclaveInfos[enclaveUuid]
+	if !found {
+		return nil, stacktrace.NewError("Enclave '%v' wasn't found after starting it", enclaveUuid)
+	}
+	manager.apiContainerEventsWatcher.watch(enclaveInfo)
+	return enclaveInfo, nil
+}
+
+// Returns the version tag and log level the API container of the enclave was created with. Enclaves created before
+// these got recorded fall back to the defaults used when the engine restarts the API containers
+func getApiContainerSettingsToStartWith(enclaveObj *enclave.Enclave) (string, logrus.Level, error) {
+	apiContainerSettings := enclaveObj.GetApiContainerSettings()
+	if apiContainerSettings == nil {
+		useDefaultApiContainerVersionTag := ""
+		return useDefaultApiContainerVersionTag, logrus.DebugLevel, nil
+	}
+	logLevel, err := logrus.ParseLevel(apiContainerSettings.GetLogLevel())
+	if err != nil {
+		return "", 0, stacktrace.Propagate(err, "An error occurred parsing the recorded API container log level '%v'", apiContainerSettings.GetLogLevel())
+	}
+	return apiContainerSettings.GetVersionTag(), logLevel, nil
+}
+
 // DestroyEnclave
 // TODO remove these notes - this should be working on active enclaves as well
 // Destroys an enclave, deleting all objects associated with it in the container engine (containers, volumes, networks, etc.)
@@ -299,27 +600,179 @@ func (manager *EnclaveManager) DestroyEnclave(ctx context.Context, enclaveIdenti
 		return stacktrace.Propagate(err, "An error occurred while fetching enclave uuid for identifier '%v'", enclaveIdentifier)
 	}
 
-	enclaveDestroyFilter := &enclave.EnclaveFilters{
-		UUIDs: map[enclave.EnclaveUUID]bool{
-			enclaveUuid: true,
-		},
-		Statuses: nil,
+	return manager.destroyEnclaveWithoutMutex(ctx, enclaveUuid)
+}
+
+// ExtendEnclave pushes the expiration deadline of an enclave back by the given duration. It also counts as using the
+// enclave, so its idle timer starts over
+func (manager *EnclaveManager) ExtendEnclave(ctx context.Context, enclaveIdentifier string, duration time.Duration) (*types.EnclaveInfo, error) {
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+
+	if duration <= 0 {
+		return nil, stacktrace.NewError("The duration to extend enclave '%v' by must be positive but was '%v'", enclaveIdentifier, duration)
 	}
-	successfullyDestroyedEnclaves, erroredEnclaves, err := manager.kurtosisBackend.DestroyEnclaves(ctx, enclaveDestroyFilter)
+
+	enclaveUuid, err := manager.getEnclaveUuidForIdentifierUnlocked(ctx, enclaveIdentifier)
 	if err != nil {
-		return stacktrace.Propagate(err, "An error occurred destroying the enclave")
+		return nil, stacktrace.Propagate(err, "An error occurred while fetching enclave uuid for identifier '%v'", enclaveIdentifier)
 	}
-	if _, found := successfullyDestroyedEnclaves[enclaveUuid]; found {
-		if err = manager.logsDbClient.RemoveEnclaveLogs(string(enclaveUuid)); err != nil {
-			return stacktrace.Propagate(err, "An error occurred attempting to remove enclave '%v' logs after it was destroyed.", enclaveIdentifier)
+
+	enclaves, err := manager.kurtosisBackend.GetEnclaves(ctx, getEnclaveByEnclaveIdFilter(enclaveUuid))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting enclave '%v'", enclaveUuid)
+	}
+	enclaveObj, found := enclaves[enclaveUuid]
+	if !found {
+		return nil, stacktrace.NewError("Enclave '%v' wasn't found", enclaveIdentifier)
+	}
+	lifetime := enclaveObj.GetLifetime()
+	if lifetime == nil {
+		return nil, stacktrace.NewError("Enclave '%v' can't be extended because it was created without a TTL or an idle timeout", enclaveIdentifier)
+	}
+
+	if expirationTime := lifetime.GetExpirationTime(); expirationTime != nil {
+		newExpirationTime := expirationTime.Add(duration)
+		newLifetime := enclave.NewEnclaveLifetime(&newExpirationTime, lifetime.GetIdleTimeout())
+		if err := manager.kurtosisBackend.UpdateEnclaveLifetime(ctx, enclaveUuid, newLifetime); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred updating the expiration time of enclave '%v' to '%v'", enclaveIdentifier, newExpirationTime)
 		}
-		return nil
 	}
-	destructionErr, found := erroredEnclaves[enclaveUuid]
+	manager.recordActivityWithoutMutex(ctx, enclaveUuid, time.Now())
+
+	enclaveInfos, err := manager.getEnclavesByUuidWithoutMutex(ctx, []enclave.EnclaveUUID{enclaveUuid})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the information of enclave '%v' after extending it", enclaveUuid)
+	}
+	enclaveInfo, found := enclaveInfos[enclaveUuid]
 	if !found {
-		return stacktrace.NewError("The requested enclave UUD '%v' for identifier '%v' wasn't found in the successfully-destroyed enclaves map, nor in the errors map; this is a bug in Kurtosis!", enclaveUuid, enclaveIdentifier)
+		return nil, stacktrace.NewError("Enclave '%v' wasn't found after extending it", enclaveUuid)
 	}
-	return destructionErr
+	return enclaveInfo, nil
+}
+
+// CloneEnclave creates a new enclave holding the same files artifacts, persistent directories and services as the
+// source enclave. The services are re-created by replaying the enclave plan of the source enclave, so they get new
+// UUIDs and IP addresses but keep their names. The new enclave gets the same mode and resource quota as the source
+// one, but not its TTL or idle timeout
+func (manager *EnclaveManager) CloneEnclave(
+	ctx context.Context,
+	engineVersion string,
+	sourceEnclaveIdentifier string,
+	destinationEnclaveName string,
+	// If blank, the enclave has no owner and only engine API admins can destroy it once authentication is turned on
+	owner string,
+) (*types.EnclaveInfo, error) {
+	sourceEnclaveObj, sourceEnclaveInfo, err := manager.getRunningEnclave(ctx, sourceEnclaveIdentifier)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting source enclave '%v'", sourceEnclaveIdentifier)
+	}
+
+	// Same as when the engine restarts the API containers
+	useDefaultApiContainerVersionTag := ""
+	cloneAPIContainerDefaultLogLevel := logrus.DebugLevel
+	noDebugMode := false
+	noTtl := (*time.Duration)(nil)
+	noIdleTimeout := (*time.Duration)(nil)
+	noTemplate := ""
+	destinationEnclaveInfo, err := manager.CreateEnclave(
+		ctx,
+		engineVersion,
+		useDefaultApiContainerVersionTag,
+		cloneAPIContainerDefaultLogLevel,
+		destinationEnclaveName,
+		sourceEnclaveObj.IsProductionEnclave(),
+		noDebugMode,
+		noTtl,
+		noIdleTimeout,
+		sourceEnclaveObj.GetResourceQuota(),
+		owner,
+		noTemplate,
+	)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating enclave '%v' to clone enclave '%v' into", destinationEnclaveName, sourceEnclaveIdentifier)
+	}
+	shouldDestroyDestinationEnclave := true
+	defer func() {
+		if shouldDestroyDestinationEnclave {
+			// The context may be already canceled, but the half-cloned enclave still has to go
+			if err := manager.DestroyEnclave(context.Background(), destinationEnclaveInfo.EnclaveUuid); err != nil {
+				logrus.Errorf("Cloning enclave '%v' into enclave '%v' failed and destroying the latter afterwards failed too, you'll need to destroy it manually. Error:\n%v", sourceEnclaveInfo.Name, destinationEnclaveInfo.Name, err)
+			}
+		}
+	}()
+
+	if err := cloneEnclaveContents(ctx, manager.kurtosisBackend, manager.apiContainerCredentials, manager.apiContainerApiToken, sourceEnclaveInfo, destinationEnclaveInfo); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred cloning the contents of enclave '%v' into enclave '%v'", sourceEnclaveInfo.Name, destinationEnclaveInfo.Name)
+	}
+	shouldDestroyDestinationEnclave = false
+	return destinationEnclaveInfo, nil
+}
+
+// RunStarlarkPackage runs the package in the enclave, blocking until the run is over, and returns an error if the run
+// doesn't succeed. If the serialized params are blank, the package runs with its default params
+func (manager *EnclaveManager) RunStarlarkPackage(ctx context.Context, enclaveIdentifier string, packageId string, serializedParams string) error {
+	_, enclaveInfo, err := manager.getRunningEnclave(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting enclave '%v' to run package '%v' in it", enclaveIdentifier, packageId)
+	}
+	if err := runStarlarkPackage(ctx, manager.apiContainerCredentials, manager.apiContainerApiToken, enclaveInfo, packageId, serializedParams, manager.cloudInstanceID, manager.cloudUserID); err != nil {
+		return stacktrace.Propagate(err, "An error occurred running package '%v' in enclave '%v'", packageId, enclaveIdentifier)
+	}
+	return nil
+}
+
+// GetEnclaveOwner returns the engine API user who created the enclave, or an empty string if it has no owner
+func (manager *EnclaveManager) GetEnclaveOwner(ctx context.Context, enclaveIdentifier string) (string, error) {
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+
+	enclaveUuid, err := manager.getEnclaveUuidForIdentifierUnlocked(ctx, enclaveIdentifier)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred while fetching enclave uuid for identifier '%v'", enclaveIdentifier)
+	}
+
+	enclaves, err := manager.kurtosisBackend.GetEnclaves(ctx, getEnclaveByEnclaveIdFilter(enclaveUuid))
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting enclave '%v'", enclaveUuid)
+	}
+	enclaveObj, found := enclaves[enclaveUuid]
+	if !found {
+		return "", stacktrace.NewError("Enclave '%v' wasn't found", enclaveIdentifier)
+	}
+	return enclaveObj.GetOwner(), nil
+}
+
+// getRunningEnclave returns the enclave along with its information, failing if its API container isn't running
+func (manager *EnclaveManager) getRunningEnclave(ctx context.Context, enclaveIdentifier string) (*enclave.Enclave, *types.EnclaveInfo, error) {
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+
+	enclaveUuid, err := manager.getEnclaveUuidForIdentifierUnlocked(ctx, enclaveIdentifier)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred while fetching enclave uuid for identifier '%v'", enclaveIdentifier)
+	}
+
+	enclaves, err := manager.kurtosisBackend.GetEnclaves(ctx, getEnclaveByEnclaveIdFilter(enclaveUuid))
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting enclave '%v'", enclaveUuid)
+	}
+	enclaveObj, found := enclaves[enclaveUuid]
+	if !found {
+		return nil, nil, stacktrace.NewError("Enclave '%v' wasn't found", enclaveIdentifier)
+	}
+	enclaveInfos, err := manager.getEnclavesByUuidWithoutMutex(ctx, []enclave.EnclaveUUID{enclaveUuid})
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the information of enclave '%v'", enclaveUuid)
+	}
+	enclaveInfo, found := enclaveInfos[enclaveUuid]
+	if !found {
+		return nil, nil, stacktrace.NewError("Enclave '%v' wasn't found", enclaveIdentifier)
+	}
+	if enclaveInfo.ApiContainerStatus != types.ContainerStatus_RUNNING {
+		return nil, nil, stacktrace.NewError("Enclave '%v' isn't running; start it first", enclaveIdentifier)
+	}
+	return enclaveObj, enclaveInfo, nil
 }
 
 func (manager *EnclaveManager) Clean(ctx context.Context, shouldCleanAll bool) ([]*types.EnclaveNameAndUuid, error) {
@@ -371,6 +824,8 @@ func (manager *EnclaveManager) Clean(ctx context.Context, shouldCleanAll bool) (
 				nameAndUuid.Name = enclave.Name
 			}
 			resultEnclaveNameAndUuids = append(resultEnclaveNameAndUuids, nameAndUuid)
+			manager.apiContainerEventsWatcher.forget(nameAndUuid.Uuid)
+			manager.publishEnclaveEvent(types.EngineEventType_ENCLAVE_DESTROYED, nameAndUuid.Uuid, nameAndUuid.Name)
 			logrus.Infof("Enclave Uuid '%v'", successfullyRemovedEnclaveUuidStr)
 		}
 	}
@@ -480,6 +935,204 @@ func (manager *EnclaveManager) RestartAllEnclaveAPIContainers(ctx context.Contex
 	return nil
 }
 
+func (manager *EnclaveManager) runEnclaveReaper() {
+	ticker := time.NewTicker(enclaveReaperInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-manager.stopEnclaveReaperChan:
+			return
+		case <-ticker.C:
+			manager.reapEnclaves(context.Background())
+		}
+	}
+}
+
+func (manager *EnclaveManager) runApiContainerEventsWatcher() {
+	ticker := time.NewTicker(apiContainerEventsWatcherInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-manager.stopApiContainerEventsWatcherChan:
+			return
+		case <-ticker.C:
+			enclaves, err := manager.GetAllEnclaves(context.Background())
+			if err != nil {
+				logrus.Errorf("An error occurred getting the enclaves to watch the events of their API containers:\n%v", err)
+				continue
+			}
+			for _, enclaveInfo := range enclaves {
+				manager.apiContainerEventsWatcher.watch(enclaveInfo)
+			}
+		}
+	}
+}
+
+// Destroys the enclaves that outlived their TTL and stops the running enclaves that went unused for longer than their
+// idle timeout; stopped enclaves can be started again by the user. The mutex is only held while looking for expired
+// enclaves and then while reaping each of them, so the engine keeps serving other requests during the teardowns
+func (manager *EnclaveManager) reapEnclaves(ctx context.Context) {
+	expiredEnclaveUuids, err := manager.getExpiredEnclaveUuids(ctx)
+	if err != nil {
+		logrus.Errorf("An error occurred getting the enclaves to look for expired ones:\n%v", err)
+		return
+	}
+	for _, enclaveUuid := range expiredEnclaveUuids {
+		manager.reapEnclave(ctx, enclaveUuid)
+	}
+}
+
+// getExpiredEnclaveUuids returns the enclaves that expired or look idle at the moment; whether an idle enclave got used
+// through its API container is only checked when reaping it
+func (manager *EnclaveManager) getExpiredEnclaveUuids(ctx context.Context) ([]enclave.EnclaveUUID, error) {
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+
+	enclaves, err := manager.kurtosisBackend.GetEnclaves(ctx, getAllEnclavesFilter())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the enclaves")
+	}
+
+	now := time.Now()
+	expiredEnclaveUuids := []enclave.EnclaveUUID{}
+	for enclaveUuid, enclaveObj := range enclaves {
+		if isEnclaveExpired(enclaveObj, now) || manager.isIdle(enclaveObj, now) {
+			expiredEnclaveUuids = append(expiredEnclaveUuids, enclaveUuid)
+		}
+	}
+	return expiredEnclaveUuids, nil
+}
+
+// reapEnclave destroys or stops the enclave if it's still expired, as it may have been extended, used or torn down
+// since it was found expired
+func (manager *EnclaveManager) reapEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID) {
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+
+	enclaves, err := manager.kurtosisBackend.GetEnclaves(ctx, getEnclaveByEnclaveIdFilter(enclaveUuid))
+	if err != nil {
+		logrus.Errorf("An error occurred getting expired enclave '%v' to reap it:\n%v", enclaveUuid, err)
+		return
+	}
+	enclaveObj, found := enclaves[enclaveUuid]
+	if !found {
+		return
+	}
+
+	now := time.Now()
+	if isEnclaveExpired(enclaveObj, now) {
+		logrus.Infof("Enclave '%v' with UUID '%v' expired at '%v'; destroying it", enclaveObj.GetName(), enclaveUuid, enclaveObj.GetLifetime().GetExpirationTime().Format(time.RFC3339))
+		if err := manager.destroyEnclaveWithoutMutex(ctx, enclaveUuid); err != nil {
+			logrus.Errorf("An error occurred destroying expired enclave '%v':\n%v", enclaveUuid, err)
+		}
+		return
+	}
+
+	if !manager.isIdle(enclaveObj, now) {
+		return
+	}
+	// The enclave may have been used without going through the engine, e.g. by a client talking to its API
+	// container directly
+	if manager.wasUsedThroughApiContainerWithoutMutex(ctx, enclaveObj) {
+		return
+	}
+	logrus.Infof("Enclave '%v' with UUID '%v' went unused for longer than its idle timeout of '%v'; stopping it", enclaveObj.GetName(), enclaveUuid, *enclaveObj.GetLifetime().GetIdleTimeout())
+	if err := manager.stopEnclaveWithoutMutex(ctx, enclaveUuid); err != nil {
+		logrus.Errorf("An error occurred stopping idle enclave '%v':\n%v", enclaveUuid, err)
+	}
+}
+
+// Returns true if the enclave outlived its TTL
+func isEnclaveExpired(enclaveObj *enclave.Enclave, now time.Time) bool {
+	lifetime := enclaveObj.GetLifetime()
+	if lifetime == nil {
+		return false
+	}
+	expirationTime := lifetime.GetExpirationTime()
+	return expirationTime != nil && now.After(*expirationTime)
+}
+
+// Returns true if the enclave is running and went unused through the engine for longer than its idle timeout
+// this should be called from a thread safe context
+func (manager *EnclaveManager) isIdle(enclaveObj *enclave.Enclave, now time.Time) bool {
+	if enclaveObj.GetStatus() != enclave.EnclaveStatus_Running {
+		return false
+	}
+	idleExpirationTime := manager.getIdleExpirationTime(enclaveObj)
+	return idleExpirationTime != nil && now.After(*idleExpirationTime)
+}
+
+// Returns nil if the enclave has no idle timeout
+// this should be called from a thread safe context
+func (manager *EnclaveManager) getIdleExpirationTime(enclaveObj *enclave.Enclave) *time.Time {
+	lifetime := enclaveObj.GetLifetime()
+	if lifetime == nil || lifetime.GetIdleTimeout() == nil {
+		return nil
+	}
+
+	lastActivityTime, found := manager.lastActivityTimes[enclaveObj.GetUUID()]
+	if persistedLastActivityTime := enclaveObj.GetLastActivityTime(); persistedLastActivityTime != nil && (!found || persistedLastActivityTime.After(lastActivityTime)) {
+		lastActivityTime = *persistedLastActivityTime
+		found = true
+	}
+	if !found {
+		lastActivityTime = manager.startTime
+		if creationTime := enclaveObj.GetCreationTime(); creationTime != nil && creationTime.After(lastActivityTime) {
+			lastActivityTime = *creationTime
+		}
+	}
+	idleExpirationTime := lastActivityTime.Add(*lifetime.GetIdleTimeout())
+	return &idleExpirationTime
+}
+
+// recordActivityWithoutMutex records that the enclave was used at the given time, persisting it along with the enclave
+// unless it was persisted less than activityPersistenceInterval before
+// this should be called from a thread safe context
+func (manager *EnclaveManager) recordActivityWithoutMutex(ctx context.Context, enclaveUuid enclave.EnclaveUUID, activityTime time.Time) {
+	if lastActivityTime, found := manager.lastActivityTimes[enclaveUuid]; !found || activityTime.After(lastActivityTime) {
+		manager.lastActivityTimes[enclaveUuid] = activityTime
+	}
+	if persistedActivityTime, found := manager.persistedActivityTimes[enclaveUuid]; found && activityTime.Sub(persistedActivityTime) < activityPersistenceInterval {
+		return
+	}
+	// Failing to persist the activity only matters if the engine restarts before it's persisted again, so it doesn't
+	// fail what the enclave was used for
+	if err := manager.kurtosisBackend.UpdateEnclaveLastActivityTime(ctx, enclaveUuid, activityTime); err != nil {
+		logrus.Warnf("An error occurred persisting the last activity time of enclave '%v'; it will be retried the next time the enclave gets used:\n%v", enclaveUuid, err)
+		return
+	}
+	manager.persistedActivityTimes[enclaveUuid] = activityTime
+}
+
+// wasUsedThroughApiContainerWithoutMutex asks the API container of the enclave when it was last used, and records that
+// activity. Returns true if the enclave isn't idle after all
+// this should be called from a thread safe context
+func (manager *EnclaveManager) wasUsedThroughApiContainerWithoutMutex(ctx context.Context, enclaveObj *enclave.Enclave) bool {
+	enclaveUuid := enclaveObj.GetUUID()
+	enclaveInfos, err := manager.getEnclavesByUuidWithoutMutex(ctx, []enclave.EnclaveUUID{enclaveUuid})
+	if err != nil {
+		logrus.Warnf("An error occurred getting the information of enclave '%v' to ask its API container whether it's idle; considering it idle:\n%v", enclaveUuid, err)
+		return false
+	}
+	enclaveInfo, found := enclaveInfos[enclaveUuid]
+	if !found || enclaveInfo.ApiContainerStatus != types.ContainerStatus_RUNNING {
+		return false
+	}
+
+	apiContainerLastActivityTime, err := getApiContainerLastActivityTime(ctx, manager.apiContainerCredentials, manager.apiContainerApiToken, enclaveInfo)
+	if err != nil {
+		logrus.Warnf("An error occurred asking the API container of enclave '%v' whether it's idle; considering it idle:\n%v", enclaveUuid, err)
+		return false
+	}
+	if apiContainerLastActivityTime == nil {
+		return false
+	}
+	manager.recordActivityWithoutMutex(ctx, enclaveUuid, *apiContainerLastActivityTime)
+
+	idleExpirationTime := manager.getIdleExpirationTime(enclaveObj)
+	return idleExpirationTime != nil && !time.Now().After(*idleExpirationTime)
+}
+
 func (manager *EnclaveManager) destroyApiContainers(ctx context.Context, enclaveUuids map[enclave.EnclaveUUID]bool) error {
 
 	destroyAPIContainersFilters := &api_container.APIContainerFilters{
@@ -572,6 +1225,31 @@ func getEnclaveApiContainerInformation(
 	return resultApiContainerStatus, resultApiContainerInfo, resultApiContainerHostMachineInfo, nil
 }
 
+// Both DestroyEnclave and the enclave reaper need to destroy enclaves, and both hold the mutex when doing so
+func (manager *EnclaveManager) destroyEnclaveWithoutMutex(ctx context.Context, enclaveUuid enclave.EnclaveUUID) error {
+	// The name can't be looked up anymore once the enclave is gone
+	enclaveName := manager.getEnclaveNameWithoutMutex(enclaveUuid)
+	successfullyDestroyedEnclaves, erroredEnclaves, err := manager.kurtosisBackend.DestroyEnclaves(ctx, getEnclaveByEnclaveIdFilter(enclaveUuid))
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred destroying the enclave")
+	}
+	if _, found := successfullyDestroyedEnclaves[enclaveUuid]; found {
+		delete(manager.lastActivityTimes, enclaveUuid)
+		delete(manager.persistedActivityTimes, enclaveUuid)
+		manager.apiContainerEventsWatcher.forget(string(enclaveUuid))
+		manager.publishEnclaveEvent(types.EngineEventType_ENCLAVE_DESTROYED, string(enclaveUuid), enclaveName)
+		if err = manager.logsDbClient.RemoveEnclaveLogs(string(enclaveUuid)); err != nil {
+			return stacktrace.Propagate(err, "An error occurred attempting to remove enclave '%v' logs after it was destroyed.", enclaveUuid)
+		}
+		return nil
+	}
+	destructionErr, found := erroredEnclaves[enclaveUuid]
+	if !found {
+		return stacktrace.NewError("The requested enclave UUD '%v' wasn't found in the successfully-destroyed enclaves map, nor in the errors map; this is a bug in Kurtosis!", enclaveUuid)
+	}
+	return destructionErr
+}
+
 // Both StopEnclave and DestroyEnclave need to be able to stop enclaves, but both have a mutex guard. Because Go mutexes
 //
 //	aren't reentrant, DestroyEnclave can't just call StopEnclave so we use this helper function
@@ -600,9 +1278,39 @@ func (manager *EnclaveManager) stopEnclaveWithoutMutex(ctx context.Context, encl
 				"\n\n",
 			))
 	}
+	manager.publishEnclaveEvent(types.EngineEventType_ENCLAVE_STOPPED, string(enclaveId), manager.getEnclaveNameWithoutMutex(enclaveId))
 	return nil
 }
 
+// this should be called from a thread safe context
+func (manager *EnclaveManager) getEnclaveNameWithoutMutex(enclaveUuid enclave.EnclaveUUID) string {
+	enclaveIdentifiers, err := manager.getExistingAndHistoricalEnclaveIdentifiersWithoutMutex()
+	if err != nil {
+		logrus.Debugf("An error occurred getting the enclave identifiers to look up the name of enclave '%v':\n%v", enclaveUuid, err)
+		return enclaveNameNotFound
+	}
+	for _, enclaveIdentifier := range enclaveIdentifiers {
+		if enclaveIdentifier.EnclaveUuid == string(enclaveUuid) {
+			return enclaveIdentifier.Name
+		}
+	}
+	return enclaveNameNotFound
+}
+
+func (manager *EnclaveManager) publishEnclaveEvent(eventType types.EngineEventType, enclaveUuid string, enclaveName string) {
+	manager.eventBus.Publish(&types.EngineEvent{
+		Type:              eventType,
+		Timestamp:         time.Now(),
+		EnclaveUuid:       enclaveUuid,
+		EnclaveName:       enclaveName,
+		ServiceName:       nil,
+		ServiceUuid:       nil,
+		FilesArtifactName: nil,
+		FilesArtifactUuid: nil,
+		IsRunSuccessful:   nil,
+	})
+}
+
 func (manager *EnclaveManager) cleanEnclaves(
 	ctx context.Context,
 	enclaveUUIDs map[enclave.EnclaveUUID]bool,
@@ -662,6 +1370,7 @@ func (manager *EnclaveManager) getAllEnclavesWithoutMutex(
 		if err != nil {
 			return nil, stacktrace.Propagate(err, "An error occurred getting information about enclave '%v'", enclaveId)
 		}
+		enclaveInfo.IdleExpirationTime = manager.getIdleExpirationTime(enclaveObj)
 		result[enclaveId] = enclaveInfo
 	}
 	return result, nil
@@ -693,6 +1402,7 @@ func (manager *EnclaveManager) getEnclavesByUuidWithoutMutex(
 		if err != nil {
 			return nil, stacktrace.Propagate(err, "An error occurred getting information about enclave '%v'", enclaveUuids)
 		}
+		enclaveInfo.IdleExpirationTime = manager.getIdleExpirationTime(enclaveObj)
 		enclaveInfos[enclaveUuid] = enclaveInfo
 	}
 
@@ -700,8 +1410,12 @@ func (manager *EnclaveManager) getEnclavesByUuidWithoutMutex(
 }
 
 func (manager *EnclaveManager) Close() error {
-	if err := manager.enclavePool.Close(); err != nil {
-		return stacktrace.Propagate(err, "An error occurred closing the enclave pool")
+	close(manager.stopEnclaveReaperChan)
+	close(manager.stopApiContainerEventsWatcherChan)
+	if manager.enclavePool != nil {
+		if err := manager.enclavePool.Close(); err != nil {
+			return stacktrace.Propagate(err, "An error occurred closing the enclave pool")
+		}
 	}
 	logrus.Debugf("Enclave manager sucessfully closed")
 	return nil
@@ -767,6 +1481,11 @@ func getEnclaveInfoForEnclave(ctx context.Context, kurtosisBackend backend_inter
 		mode = types.EnclaveMode_PRODUCTION
 	}
 
+	var expirationTime *time.Time
+	if lifetime := enclave.GetLifetime(); lifetime != nil {
+		expirationTime = lifetime.GetExpirationTime()
+	}
+
 	return &types.EnclaveInfo{
 		EnclaveUuid:                 enclaveUuidStr,
 		ShortenedUuid:               uuid_generator.ShortenedUUIDString(enclaveUuidStr),
@@ -777,6 +1496,9 @@ func getEnclaveInfoForEnclave(ctx context.Context, kurtosisBackend backend_inter
 		ApiContainerHostMachineInfo: apiContainerHostMachineInfo,
 		CreationTime:                *creationTimestamp,
 		Mode:                        mode,
+		ExpirationTime:              expirationTime,
+		IdleExpirationTime:          nil,
+		Owner:                       enclave.GetOwner(),
 	}, nil
 }
 
@@ -862,3 +1584,24 @@ func getEnclaveCreationTimestamp(enclave *enclave.Enclave) (*time.Time, error) {
 
 	return enclaveCreationTime, nil
 }
+
+// Returns nil if the API container wasn't used since it started
+func getApiContainerLastActivityTime(ctx context.Context, apiContainerCredentials credentials.TransportCredentials, apiContainerApiToken string, enclaveInfo *types.EnclaveInfo) (*time.Time, error) {
+	conn, err := dialApiContainer(apiContainerCredentials, apiContainerApiToken, enclaveInfo)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred connecting to the API container of enclave '%v'", enclaveInfo.Name)
+	}
+	defer conn.Close()
+
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, getApiContainerLastActivityTimeTimeout)
+	defer cancel()
+	response, err := kurtosis_core_rpc_api_bindings.NewApiContainerServiceClient(conn).GetLastActivityTime(ctxWithTimeout, &emptypb.Empty{})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the last activity time of the API container of enclave '%v'", enclaveInfo.Name)
+	}
+	if response.LastActivityTime == nil {
+		return nil, nil
+	}
+	lastActivityTime := response.GetLastActivityTime().AsTime()
+	return &lastActivityTime, nil
+}
@@ -1,11 +1,17 @@
 package enclave_manager
 
 import (
+	"context"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager/types"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/require"
 )
 
@@ -29,3 +35,103 @@ func TestGetApiContainerStatusFromContainerStatusCompleteness(t *testing.T) {
 		require.NoError(t, err, "No ApiContainerStatus provided for container status '%v'", containerStatus.String())
 	}
 }
+
+func TestEngineEventTypesByApiContainerEventTypeCompleteness(t *testing.T) {
+	for apiContainerEventTypeValue, apiContainerEventTypeName := range kurtosis_core_rpc_api_bindings.ApiContainerEventType_name {
+		_, found := engineEventTypesByApiContainerEventType[kurtosis_core_rpc_api_bindings.ApiContainerEventType(apiContainerEventTypeValue)]
+		require.True(t, found, "No engine event type provided for API container event type '%v'", apiContainerEventTypeName)
+	}
+}
+
+func TestGetIdleExpirationTime(t *testing.T) {
+	engineStartTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	idleTimeout := 30 * time.Minute
+	manager := &EnclaveManager{
+		lastActivityTimes: map[enclave.EnclaveUUID]time.Time{},
+		startTime:         engineStartTime,
+	}
+
+	enclaveCreatedBeforeEngineStart := engineStartTime.Add(-time.Hour)
+	enclaveObj := enclave.NewEnclave("enclave-uuid", "enclave", enclave.EnclaveStatus_Running, &enclaveCreatedBeforeEngineStart, false, enclave.NewEnclaveLifetime(nil, &idleTimeout), nil, "", nil, nil)
+	require.Equal(t, engineStartTime.Add(idleTimeout), *manager.getIdleExpirationTime(enclaveObj))
+
+	enclaveCreatedAfterEngineStart := engineStartTime.Add(time.Hour)
+	enclaveObj = enclave.NewEnclave("enclave-uuid", "enclave", enclave.EnclaveStatus_Running, &enclaveCreatedAfterEngineStart, false, enclave.NewEnclaveLifetime(nil, &idleTimeout), nil, "", nil, nil)
+	require.Equal(t, enclaveCreatedAfterEngineStart.Add(idleTimeout), *manager.getIdleExpirationTime(enclaveObj))
+
+	lastActivityTime := engineStartTime.Add(2 * time.Hour)
+	manager.lastActivityTimes["enclave-uuid"] = lastActivityTime
+	require.Equal(t, lastActivityTime.Add(idleTimeout), *manager.getIdleExpirationTime(enclaveObj))
+
+	enclaveObj = enclave.NewEnclave("enclave-uuid", "enclave", enclave.EnclaveStatus_Running, &enclaveCreatedAfterEngineStart, false, nil, nil, "", nil, nil)
+	require.Nil(t, manager.getIdleExpirationTime(enclaveObj))
+
+	// Activity persisted by a previous engine counts as well, whichever is the latest
+	persistedLastActivityTime := lastActivityTime.Add(time.Hour)
+	enclaveObj = enclave.NewEnclave("enclave-uuid", "enclave", enclave.EnclaveStatus_Running, &enclaveCreatedBeforeEngineStart, false, enclave.NewEnclaveLifetime(nil, &idleTimeout), nil, "", nil, &persistedLastActivityTime)
+	require.Equal(t, persistedLastActivityTime.Add(idleTimeout), *manager.getIdleExpirationTime(enclaveObj))
+	delete(manager.lastActivityTimes, "enclave-uuid")
+	require.Equal(t, persistedLastActivityTime.Add(idleTimeout), *manager.getIdleExpirationTime(enclaveObj))
+}
+
+func TestRecordActivityWithoutMutex_PersistsAtMostEveryInterval(t *testing.T) {
+	kurtosisBackend := backend_interface.NewMockKurtosisBackend(t)
+	manager := &EnclaveManager{
+		kurtosisBackend:        kurtosisBackend,
+		lastActivityTimes:      map[enclave.EnclaveUUID]time.Time{},
+		persistedActivityTimes: map[enclave.EnclaveUUID]time.Time{},
+	}
+	ctx := context.Background()
+	firstActivityTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	kurtosisBackend.EXPECT().UpdateEnclaveLastActivityTime(ctx, enclave.EnclaveUUID("enclave-uuid"), firstActivityTime).Return(nil).Once()
+	manager.recordActivityWithoutMutex(ctx, "enclave-uuid", firstActivityTime)
+
+	soonAfterActivityTime := firstActivityTime.Add(activityPersistenceInterval / 2)
+	manager.recordActivityWithoutMutex(ctx, "enclave-uuid", soonAfterActivityTime)
+	require.Equal(t, soonAfterActivityTime, manager.lastActivityTimes["enclave-uuid"])
+
+	// Older activity reported late doesn't move the last activity back
+	manager.recordActivityWithoutMutex(ctx, "enclave-uuid", firstActivityTime)
+	require.Equal(t, soonAfterActivityTime, manager.lastActivityTimes["enclave-uuid"])
+
+	laterActivityTime := firstActivityTime.Add(activityPersistenceInterval)
+	kurtosisBackend.EXPECT().UpdateEnclaveLastActivityTime(ctx, enclave.EnclaveUUID("enclave-uuid"), laterActivityTime).Return(nil).Once()
+	manager.recordActivityWithoutMutex(ctx, "enclave-uuid", laterActivityTime)
+	require.Equal(t, laterActivityTime, manager.persistedActivityTimes["enclave-uuid"])
+}
+
+func TestReapEnclaves_SkipsEnclavesExtendedSinceFoundExpired(t *testing.T) {
+	kurtosisBackend := backend_interface.NewMockKurtosisBackend(t)
+	manager := &EnclaveManager{
+		mutex:                  &sync.Mutex{},
+		kurtosisBackend:        kurtosisBackend,
+		lastActivityTimes:      map[enclave.EnclaveUUID]time.Time{},
+		persistedActivityTimes: map[enclave.EnclaveUUID]time.Time{},
+		startTime:              time.Now(),
+	}
+	ctx := context.Background()
+	creationTime := time.Now().Add(-time.Hour)
+	expirationTime := time.Now().Add(-time.Minute)
+	expiredEnclave := enclave.NewEnclave("enclave-uuid", "enclave", enclave.EnclaveStatus_Running, &creationTime, false, enclave.NewEnclaveLifetime(&expirationTime, nil), nil, "", nil, nil)
+	extendedExpirationTime := time.Now().Add(time.Hour)
+	extendedEnclave := enclave.NewEnclave("enclave-uuid", "enclave", enclave.EnclaveStatus_Running, &creationTime, false, enclave.NewEnclaveLifetime(&extendedExpirationTime, nil), nil, "", nil, nil)
+
+	kurtosisBackend.EXPECT().GetEnclaves(ctx, getAllEnclavesFilter()).Return(map[enclave.EnclaveUUID]*enclave.Enclave{"enclave-uuid": expiredEnclave}, nil).Once()
+	// The enclave gets extended after it was found expired, so it's neither destroyed nor stopped
+	kurtosisBackend.EXPECT().GetEnclaves(ctx, getEnclaveByEnclaveIdFilter("enclave-uuid")).Return(map[enclave.EnclaveUUID]*enclave.Enclave{"enclave-uuid": extendedEnclave}, nil).Once()
+	manager.reapEnclaves(ctx)
+}
+
+func TestGetApiContainerSettingsToStartWith(t *testing.T) {
+	enclaveObj := enclave.NewEnclave("enclave-uuid", "enclave", enclave.EnclaveStatus_Stopped, nil, false, nil, nil, "", enclave.NewEnclaveApiContainerSettings("1.2.3", "info"), nil)
+	versionTag, logLevel, err := getApiContainerSettingsToStartWith(enclaveObj)
+	require.NoError(t, err)
+	require.Equal(t, "1.2.3", versionTag)
+	require.Equal(t, logrus.InfoLevel, logLevel)
+
+	enclaveObj = enclave.NewEnclave("enclave-uuid", "enclave", enclave.EnclaveStatus_Stopped, nil, false, nil, nil, "", nil, nil)
+	versionTag, logLevel, err = getApiContainerSettingsToStartWith(enclaveObj)
+	require.NoError(t, err)
+	require.Empty(t, versionTag)
+	require.Equal(t, logrus.DebugLevel, logLevel)
+}
@@ -2,6 +2,8 @@ package enclave_manager
 
 import (
 	"fmt"
+	"strings"
+
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
 	"github.com/kurtosis-tech/stacktrace"
 )
@@ -12,6 +14,9 @@ const (
 
 	// Signifies that this enclave will be created and will be available for further use
 	idleEnclaveNamePrefix = "idle-enclave-"
+
+	// Separates the fingerprint of a ready idle enclave from the rest of its name
+	idleEnclaveFingerprintSeparator = "-"
 )
 
 func GetRandomEnclaveNameWithRetries(generateNatureThemeName func() string, allCurrentEnclaveNames []string, retries uint16) string {
@@ -48,3 +53,29 @@ func GetRandomIdleEnclaveName() (string, error) {
 
 	return enclaveName, nil
 }
+
+// GetReadyIdleEnclaveName returns a name for an idle enclave that is ready to be handed out, which embeds the fingerprint
+// of what is in the enclave so that an engine started later can tell whether it can reuse it
+func GetReadyIdleEnclaveName(fingerprint string) (string, error) {
+	uuid, err := uuid_generator.GenerateUUIDString()
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred while creating UUID for idle enclave name")
+	}
+
+	enclaveName := idleEnclaveNamePrefix + fingerprint + idleEnclaveFingerprintSeparator + uuid
+
+	return enclaveName, nil
+}
+
+// getIdleEnclaveFingerprint returns the fingerprint embedded in the name of a ready idle enclave, and false if the
+// enclave isn't idle or isn't ready yet
+func getIdleEnclaveFingerprint(enclaveName string) (string, bool) {
+	if !strings.HasPrefix(enclaveName, idleEnclaveNamePrefix) {
+		return "", false
+	}
+	fingerprint, _, found := strings.Cut(strings.TrimPrefix(enclaveName, idleEnclaveNamePrefix), idleEnclaveFingerprintSeparator)
+	if !found || fingerprint == "" {
+		return "", false
+	}
+	return fingerprint, true
+}
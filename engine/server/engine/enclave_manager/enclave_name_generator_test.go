@@ -65,3 +65,23 @@ func TestGetRandomEnclaveIdWithRetriesUniqueNameNotFound(t *testing.T) {
 	require.NotEmpty(t, randomEnclaveId)
 	require.Equal(t, expectedUniqueNameWithRandomNum, randomEnclaveId)
 }
+
+func TestIdleEnclaveFingerprint(t *testing.T) {
+	fingerprint := "0a1b2c3d"
+	readyIdleEnclaveName, err := GetReadyIdleEnclaveName(fingerprint)
+	require.NoError(t, err)
+	require.NoError(t, validateEnclaveName(readyIdleEnclaveName))
+
+	actualFingerprint, isReady := getIdleEnclaveFingerprint(readyIdleEnclaveName)
+	require.True(t, isReady)
+	require.Equal(t, fingerprint, actualFingerprint)
+
+	// Idle enclaves still being prepared have no fingerprint yet
+	bootingIdleEnclaveName, err := GetRandomIdleEnclaveName()
+	require.NoError(t, err)
+	_, isReady = getIdleEnclaveFingerprint(bootingIdleEnclaveName)
+	require.False(t, isReady)
+
+	_, isReady = getIdleEnclaveFingerprint("my-enclave")
+	require.False(t, isReady)
+}
@@ -2,7 +2,10 @@ package enclave_manager
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -14,6 +17,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/credentials"
 )
 
 const (
@@ -25,13 +29,37 @@ const (
 	createTestEnclave = false
 
 	defaultApicDebugModeForEnclavesInThePool = false
+
+	// How many hex characters of the hash of what went into an idle enclave end up in its name
+	idleEnclaveFingerprintLength = 8
+
+	fingerprintFieldSeparator = byte(0)
+
+	// How long the pool waits before trying again to create an idle enclave that failed to be created
+	idleEnclaveCreationRetryDelay = 1 * time.Minute
 )
 
+// idleEnclaveQueue holds the idle enclaves of the pool that are interchangeable, either because they're empty or
+// because they had the package of the same template run in them
+type idleEnclaveQueue struct {
+	// nil for the queue of empty idle enclaves
+	template *args.EnclaveTemplate
+	// identifies what is in the idle enclaves of this queue, and is embedded in their names
+	fingerprint      string
+	size             uint8
+	idleEnclavesChan chan *types.EnclaveInfo
+	// This channel is used as a signal to tell to the sub-routine that one idle enclave
+	// has been allocated from the queue
+	// It has the capacity = size for not blocking the caller (for concurrent requests)
+	fillChan chan bool
+}
+
 type EnclavePool struct {
 	kurtosisBackend             backend_interface.KurtosisBackend
 	enclaveCreator              *EnclaveCreator
-	idleEnclavesChan            chan *types.EnclaveInfo
-	fillChan                    chan bool
+	emptyEnclavesQueue          *idleEnclaveQueue
+	templateQueues              map[string]*idleEnclaveQueue
+	apiContainerCredentials     credentials.TransportCredentials
 	engineVersion               string
 	cancelSubRoutineCtxFunc     context.CancelFunc
 	enclaveEnvVars              string
@@ -45,14 +73,17 @@ type EnclavePool struct {
 }
 
 // CreateEnclavePool will do the following:
-// 1- Will remove idle enclaves from previous engine runs even if the pool is not activated (this is for removing
+// 1- Will remove idle enclaves from previous engine runs if the pool is not activated (this is for removing
 // any resource leak after an engine restar without this feature enabled or after an engine crash)
-// 2- Wil create a new enclave pool object, if pool size > 1, return nil if pool size = 0, or return an error
-// 3- Will start a subroutine in charge of filling the pool
+// 2- Wil create a new enclave pool object, if pool size > 1 or there are enclave templates, return nil if pool size = 0
+// and there are no templates, or return an error
+// 3- Will start a subroutine in charge of filling the pool, which first reuses the idle enclaves from previous runs
+// that hold what this pool would create and destroys the other ones
 func CreateEnclavePool(
 	kurtosisBackend backend_interface.KurtosisBackend,
 	enclaveCreator *EnclaveCreator,
 	poolSize uint8,
+	enclaveTemplates []args.EnclaveTemplate,
 	engineVersion string,
 	enclaveEnvVars string,
 	metricsUserID string,
@@ -64,43 +95,45 @@ func CreateEnclavePool(
 	logsCollectorParsers []logs_collector.Parser,
 ) (*EnclavePool, error) {
 
-	//TODO the current implementation only removes the previous idle enclave, it's pending to implement the reusable feature
-	//TODO the reuse logic is not enable yet because we ned to store the APIC version on the APIContainer object in container-engine-lib
-	//TODO in order to using it for comparing it with the expected version
-
-	// Iterate on all the existing enclaves in order to find idle enclaves already created
-	// and reuse or destroy them if these were created from old Kurtosis version.
-	// It's executed as the first operation because the engine could be restarted or could crash
-	// letting some idle enclaves hanging out there, and we have to execute always even if the enclave
-	// pool won't be started on this run.
-	// We do our best effort to destroy idle enclaves from previous runs with a retry strategy
-	// but, we don't want to wait for it. If something fails, we suggest users to manually
-	// destroy the old idle enclaves showing them the UUIDs
+	// The engine could be restarted or could crash letting some idle enclaves hanging out there.
+	// Idle enclaves are matched with the pool by the fingerprint in their names, so only the enclaves
+	// created before now are looked at, the ones created by this pool are left alone
 	now := time.Now()
-	go destroyIdleEnclavesFromPreviousRuns(kurtosisBackend, now)
 
 	// validations
-	// poolSize = 0 means that the Enclave Pool won't be activated, it returns nil with no error
-	if poolSize == 0 {
-		logrus.Debugf("The enclave pool won't be activated due the pool size value is equal to zero")
+	// poolSize = 0 and no templates means that the Enclave Pool won't be activated, it returns nil with no error
+	if poolSize == 0 && len(enclaveTemplates) == 0 {
+		logrus.Debugf("The enclave pool won't be activated due the pool size value is equal to zero and there are no enclave templates")
+		// We do our best effort to destroy idle enclaves from previous runs with a retry strategy
+		// but, we don't want to wait for it. If something fails, we suggest users to manually
+		// destroy the old idle enclaves showing them the UUIDs
+		go destroyIdleEnclavesFromPreviousRuns(kurtosisBackend, now)
 		return nil, nil
 	}
 
-	// this channel is the repository of idle enclave UUIDs
-	idleEnclavesChan := make(chan *types.EnclaveInfo, poolSize)
+	apiContainerCredentials, err := getApiContainerCredentials(enclaveCreator.apiContainerTlsConfig)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the credentials to run the enclave template packages with")
+	}
 
-	// This channel is used as a signal to tell to the sub-routine that one idle enclave
-	// has been allocated from the pool
-	// It has the capacity = poolSize for not blocking the caller (for concurrent requests)
-	fillChan := make(chan bool, poolSize)
+	var emptyEnclavesQueue *idleEnclaveQueue
+	if poolSize > 0 {
+		emptyEnclavesQueue = newIdleEnclaveQueue(engineVersion, nil, poolSize)
+	}
+	templateQueues := map[string]*idleEnclaveQueue{}
+	for idx := range enclaveTemplates {
+		enclaveTemplate := enclaveTemplates[idx]
+		templateQueues[enclaveTemplate.Name] = newIdleEnclaveQueue(engineVersion, &enclaveTemplate, enclaveTemplate.PoolSize)
+	}
 
 	ctxWithCancel, cancelCtxFunc := context.WithCancel(context.Background())
 
 	enclavePool := &EnclavePool{
 		kurtosisBackend:             kurtosisBackend,
 		enclaveCreator:              enclaveCreator,
-		idleEnclavesChan:            idleEnclavesChan,
-		fillChan:                    fillChan,
+		emptyEnclavesQueue:          emptyEnclavesQueue,
+		templateQueues:              templateQueues,
+		apiContainerCredentials:     apiContainerCredentials,
 		engineVersion:               engineVersion,
 		cancelSubRoutineCtxFunc:     cancelCtxFunc,
 		enclaveEnvVars:              enclaveEnvVars,
@@ -113,14 +146,16 @@ func CreateEnclavePool(
 		logsCollectorParsers:        logsCollectorParsers,
 	}
 
-	go enclavePool.run(ctxWithCancel)
+	for _, queue := range enclavePool.getAllQueues() {
+		go enclavePool.run(ctxWithCancel, queue)
+	}
 
-	enclavePool.init(poolSize)
+	go enclavePool.init(ctxWithCancel, now)
 
 	return enclavePool, nil
 }
 
-// GetEnclave returns the first idle enclave from the pool, and the enclave is renamed with the
+// GetEnclave returns the first empty idle enclave from the pool, and the enclave is renamed with the
 // name set by the caller before returning it. It returns nil if there is no enclave on the pool
 // or if the requested enclave params are different from the enclave in the pool params
 func (pool *EnclavePool) GetEnclave(
@@ -139,6 +174,10 @@ func (pool *EnclavePool) GetEnclave(
 		apiContainerLogLevel,
 	)
 
+	if pool.emptyEnclavesQueue == nil {
+		return nil, nil
+	}
+
 	// TODO change the logLevel value ?? it's pending to check if it's possible
 	// The enclaves in the pool are already configured with defaults params and there is no way to update
 	// this config, so we have to check if the requested enclave params are equal to the enclaves stored
@@ -153,70 +192,68 @@ func (pool *EnclavePool) GetEnclave(
 		return nil, nil
 	}
 
-	// If there is no idle enclave in the pool returns nil
-	// for not to block the caller
-	if len(pool.idleEnclavesChan) == 0 {
-		return nil, nil
-	}
-	enclaveInfo, ok := <-pool.idleEnclavesChan
-	if !ok {
-		return nil, stacktrace.NewError("A new enclave can't be returned from the pool because the internal channel is closed, it shouldn't happen; this is a bug in Kurtosis")
-	}
-	// let the subroutine knows that one idle enclave has been taken from the pool,
-	// and it has to fill the pool again
-	pool.fillChan <- fill
-
-	enclaveUUID := enclave.EnclaveUUID(enclaveInfo.EnclaveUuid)
-	shouldDestroyEnclaveBecauseSomethingFails := true
-	defer func() {
-		if shouldDestroyEnclaveBecauseSomethingFails {
-			idleEnclavesToRemove := map[enclave.EnclaveUUID]bool{
-				enclaveUUID: true,
-			}
-			if err := destroyEnclavesByUUID(ctx, pool.kurtosisBackend, idleEnclavesToRemove); err != nil {
-				logrus.Errorf(
-					"Something fail while getting an enclave from the pool, we tried to destroy the "+
-						"enclave that was taken from it, for avoiding a resource leak, but this also fails, "+
-						"so you will have to manually destroy the enclave with UUUID '%v'. Error:\n%v", enclaveUUID, err)
-			}
-		}
-	}()
+	return pool.takeIdleEnclave(ctx, pool.emptyEnclavesQueue, newEnclaveName)
+}
 
-	// Check enclave is still running
-	if _, err := pool.getRunningEnclave(ctx, enclaveUUID); err != nil {
-		logrus.Debugf("The idle enclave with UUID '%v' is not longer running or have been destroyed", enclaveUUID)
-		return nil, stacktrace.Propagate(err, "An error occurred getting a running enclave with UUID '%v'", enclaveUUID)
+// GetEnclaveFromTemplate returns an idle enclave that already had the package of the template run in it, renamed
+// with the name set by the caller. It returns nil if the template has no idle enclave ready yet, in which case the
+// caller can create an enclave and run the template package in it with RunTemplatePackage
+func (pool *EnclavePool) GetEnclaveFromTemplate(
+	ctx context.Context,
+	newEnclaveName string,
+	templateName string,
+	engineVersion string,
+	apiContainerVersion string,
+	apiContainerLogLevel logrus.Level,
+	shouldAPICRunInDebugMode bool,
+) (*types.EnclaveInfo, error) {
+	queue, err := pool.getTemplateQueue(templateName)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the idle enclaves of template '%v'", templateName)
 	}
 
-	newCreationTime := time.Now()
-
-	if err := pool.kurtosisBackend.UpdateEnclave(ctx, enclaveUUID, newEnclaveName, &newCreationTime); err != nil {
-		return nil, stacktrace.Propagate(err, "An error occurred updating enclave with UUID '%v', trying to update name to '%s' and creation time to '%v'", enclaveUUID, newEnclaveName, newCreationTime)
+	// Unlike empty enclaves, an enclave from a template can't be swapped for a freshly created one with different
+	// params without losing what the template package did, so the caller is told instead
+	if !areRequestedEnclaveParamsEqualToEnclaveInThePoolParams(
+		engineVersion,
+		apiContainerVersion,
+		apiContainerLogLevel,
+		shouldAPICRunInDebugMode,
+	) {
+		return nil, stacktrace.NewError(
+			"Enclaves from templates run the API container version of the engine with log level '%v' and without debug mode, but API container version '%v', log level '%v' and debug mode '%v' were requested",
+			defaultApiContainerLogLevel,
+			apiContainerVersion,
+			apiContainerLogLevel,
+			shouldAPICRunInDebugMode,
+		)
 	}
 
-	// update the enclave info before returning it
-	// we assume that container status and apic status both are RUNNING because we check it above in getRunningEnclave
-	enclaveInfo.Name = newEnclaveName
-	enclaveInfo.CreationTime = newCreationTime
-
-	logrus.Debugf("Returning enclave Info '%+v' for requested enclave name '%s'", enclaveInfo, newEnclaveName)
+	return pool.takeIdleEnclave(ctx, queue, newEnclaveName)
+}
 
-	shouldDestroyEnclaveBecauseSomethingFails = false
-	return enclaveInfo, nil
+// RunTemplatePackage runs the package of the template in the enclave, blocking until the run is over
+func (pool *EnclavePool) RunTemplatePackage(ctx context.Context, templateName string, enclaveInfo *types.EnclaveInfo) error {
+	queue, err := pool.getTemplateQueue(templateName)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting template '%v'", templateName)
+	}
+	if err := pool.runTemplatePackage(ctx, queue.template, enclaveInfo); err != nil {
+		return stacktrace.Propagate(err, "An error occurred running the package of template '%v' in enclave '%v'", templateName, enclaveInfo.Name)
+	}
+	return nil
 }
 
-// Close stop the EnclavePool subroutine, in charge of filling the pool,
-// and removes all the idle enclaves already created
+// Close stop the EnclavePool subroutines, in charge of filling the pool, and removes the idle enclaves that
+// weren't ready yet. The ready ones are kept, so that the next engine run can hand them out right away
 func (pool *EnclavePool) Close() error {
 
-	defer close(pool.idleEnclavesChan)
-	defer close(pool.fillChan)
-
-	// will terminate running processes in the subroutine
+	// will terminate running processes in the subroutines; the queue channels are left open as the subroutines
+	// could still be sending to them while they stop
 	pool.cancelSubRoutineCtxFunc()
 
-	// destroy all the idle enclaves
-	if err := destroyIdleEnclaves(pool.kurtosisBackend); err != nil {
+	// destroy the idle enclaves that wouldn't be reused
+	if err := destroyNotReadyIdleEnclaves(pool.kurtosisBackend); err != nil {
 		return stacktrace.Propagate(err, "An error occurred destroying idle enclave")
 	}
 
@@ -228,22 +265,80 @@ func (pool *EnclavePool) Close() error {
 //	Private helper methods
 //
 // ====================================================================================================
-func (pool *EnclavePool) init(poolSize uint8) {
-	logrus.Debugf("Initializing enclave pool with size '%v'...", poolSize)
-	for i := uint8(0); i < poolSize; i++ {
-		pool.fillChan <- fill
+func newIdleEnclaveQueue(engineVersion string, enclaveTemplate *args.EnclaveTemplate, size uint8) *idleEnclaveQueue {
+	return &idleEnclaveQueue{
+		template:         enclaveTemplate,
+		fingerprint:      getIdleEnclaveQueueFingerprint(engineVersion, enclaveTemplate),
+		size:             size,
+		idleEnclavesChan: make(chan *types.EnclaveInfo, size),
+		fillChan:         make(chan bool, size),
+	}
+}
+
+// getIdleEnclaveQueueFingerprint returns a short hash of everything that went into the idle enclaves of a queue. An
+// idle enclave left by a previous engine run can only be reused if its fingerprint matches, so changing the engine
+// version, or the package or args of a template, replaces the idle enclaves
+func getIdleEnclaveQueueFingerprint(engineVersion string, enclaveTemplate *args.EnclaveTemplate) string {
+	hash := sha256.New()
+	hash.Write([]byte(engineVersion))
+	if enclaveTemplate != nil {
+		for _, value := range []string{enclaveTemplate.Name, enclaveTemplate.PackageId, enclaveTemplate.SerializedParams} {
+			hash.Write([]byte{fingerprintFieldSeparator})
+			hash.Write([]byte(value))
+		}
+	}
+	return hex.EncodeToString(hash.Sum(nil))[:idleEnclaveFingerprintLength]
+}
+
+func (pool *EnclavePool) getAllQueues() []*idleEnclaveQueue {
+	queues := []*idleEnclaveQueue{}
+	if pool.emptyEnclavesQueue != nil {
+		queues = append(queues, pool.emptyEnclavesQueue)
+	}
+	for _, queue := range pool.templateQueues {
+		queues = append(queues, queue)
+	}
+	return queues
+}
+
+func (pool *EnclavePool) getTemplateQueue(templateName string) (*idleEnclaveQueue, error) {
+	queue, found := pool.templateQueues[templateName]
+	if !found {
+		templateNames := []string{}
+		for name := range pool.templateQueues {
+			templateNames = append(templateNames, name)
+		}
+		sort.Strings(templateNames)
+		return nil, stacktrace.NewError("The engine has no enclave template named '%v'; its templates are: %v", templateName, strings.Join(templateNames, ", "))
+	}
+	return queue, nil
+}
+
+// init reuses the ready idle enclaves from previous runs, and asks the subroutines to create the idle enclaves
+// that are still missing
+func (pool *EnclavePool) init(ctx context.Context, beforeTime time.Time) {
+	numReusedEnclaves := pool.reuseOrDestroyIdleEnclavesFromPreviousRuns(ctx, beforeTime)
+	for _, queue := range pool.getAllQueues() {
+		logrus.Debugf("Initializing enclave pool queue with fingerprint '%v' and size '%v', reusing '%v' idle enclaves...", queue.fingerprint, queue.size, numReusedEnclaves[queue])
+		for i := numReusedEnclaves[queue]; i < queue.size; i++ {
+			select {
+			case <-ctx.Done():
+				return
+			case queue.fillChan <- fill:
+			}
+		}
 	}
 }
 
 // run is executed in a subroutine and wait for any of these two signals:
-// 1- for creating and add a new idle enclave in the pool
+// 1- for creating and add a new idle enclave in the queue
 // 2- for closing the subroutine
-func (pool *EnclavePool) run(ctx context.Context) {
+func (pool *EnclavePool) run(ctx context.Context, queue *idleEnclaveQueue) {
 	for {
 		// wait until receive the re-fill signal or the ctx has done signal
 		select {
-		case <-pool.fillChan:
-			if err := pool.createAndAddOneIdleEnclaveIfNeeded(ctx); err != nil {
+		case <-queue.fillChan:
+			if err := pool.createAndAddOneIdleEnclaveIfNeeded(ctx, queue); err != nil {
 				if err == context.Canceled {
 					logrus.Debug("The subroutine context has been canceled")
 				} else {
@@ -259,25 +354,36 @@ func (pool *EnclavePool) run(ctx context.Context) {
 	}
 }
 
-func (pool *EnclavePool) createAndAddOneIdleEnclaveIfNeeded(ctx context.Context) error {
+func (pool *EnclavePool) createAndAddOneIdleEnclaveIfNeeded(ctx context.Context, queue *idleEnclaveQueue) error {
 
-	newEnclaveInfo, err := pool.createNewIdleEnclave(ctx)
+	newEnclaveInfo, err := pool.createNewIdleEnclave(ctx, queue)
 	if err != nil {
 		if err == context.Canceled {
 			return nil
 		}
+		// Try again later instead of leaving the queue one enclave short for the rest of the engine run
+		go func() {
+			select {
+			case <-ctx.Done():
+			case <-time.After(idleEnclaveCreationRetryDelay):
+				queue.fillChan <- fill
+			}
+		}()
 		return stacktrace.Propagate(err, "An error occurred creating a new idle enclave.")
 	}
 
-	pool.idleEnclavesChan <- newEnclaveInfo
+	queue.idleEnclavesChan <- newEnclaveInfo
 	logrus.Debugf("Enclave with UUID '%s' was added intho the pool channel", newEnclaveInfo.EnclaveUuid)
 
 	return nil
 }
 
-func (pool *EnclavePool) createNewIdleEnclave(ctx context.Context) (*types.EnclaveInfo, error) {
+// createNewIdleEnclave creates an idle enclave for the queue, running the template package in it if there is one.
+// The enclave only gets the fingerprint of the queue in its name once it's ready, so an engine that crashes midway
+// leaves an enclave that the next engine run destroys instead of handing out
+func (pool *EnclavePool) createNewIdleEnclave(ctx context.Context, queue *idleEnclaveQueue) (*types.EnclaveInfo, error) {
 
-	enclaveName, err := GetRandomIdleEnclaveName()
+	readyEnclaveName, err := GetReadyIdleEnclaveName(queue.fingerprint)
 	if err != nil {
 		return nil, stacktrace.Propagate(
 			err,
@@ -285,6 +391,17 @@ func (pool *EnclavePool) createNewIdleEnclave(ctx context.Context) (*types.Encla
 		)
 	}
 
+	enclaveName := readyEnclaveName
+	if queue.template != nil {
+		enclaveName, err = GetRandomIdleEnclaveName()
+		if err != nil {
+			return nil, stacktrace.Propagate(
+				err,
+				"An error occurred generating a random name for a new idle enclave.",
+			)
+		}
+	}
+
 	apiContainerVersion := pool.engineVersion
 
 	newEnclaveInfo, err := pool.enclaveCreator.CreateEnclave(
@@ -314,10 +431,149 @@ func (pool *EnclavePool) createNewIdleEnclave(ctx context.Context) (*types.Encla
 		)
 	}
 
+	if queue.template != nil {
+		enclaveUUID := enclave.EnclaveUUID(newEnclaveInfo.EnclaveUuid)
+		shouldDestroyEnclave := true
+		defer func() {
+			if shouldDestroyEnclave {
+				// The context may be already canceled, but the enclave still has to go
+				if err := destroyEnclavesByUUID(context.Background(), pool.kurtosisBackend, map[enclave.EnclaveUUID]bool{enclaveUUID: true}); err != nil {
+					logrus.Errorf("Preparing idle enclave '%v' failed and destroying it afterwards failed too, you'll need to destroy it manually. Error:\n%v", enclaveUUID, err)
+				}
+			}
+		}()
+
+		if err := pool.runTemplatePackage(ctx, queue.template, newEnclaveInfo); err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			return nil, stacktrace.Propagate(err, "An error occurred running the package of template '%v' in idle enclave '%v'", queue.template.Name, enclaveName)
+		}
+		if err := pool.kurtosisBackend.UpdateEnclave(ctx, enclaveUUID, readyEnclaveName, nil); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred renaming idle enclave '%v' to '%v' once the package of template '%v' ran in it", enclaveName, readyEnclaveName, queue.template.Name)
+		}
+		newEnclaveInfo.Name = readyEnclaveName
+		shouldDestroyEnclave = false
+	}
+
 	logrus.Debugf("New idle enclave created '%+v'", newEnclaveInfo)
 	return newEnclaveInfo, nil
 }
 
+// runTemplatePackage runs the package of the template in the enclave through its API container, and returns an error
+// if the run doesn't succeed
+func (pool *EnclavePool) runTemplatePackage(ctx context.Context, enclaveTemplate *args.EnclaveTemplate, enclaveInfo *types.EnclaveInfo) error {
+	logrus.Infof("Running package '%v' of enclave template '%v' in enclave '%v'...", enclaveTemplate.PackageId, enclaveTemplate.Name, enclaveInfo.Name)
+	if err := runStarlarkPackage(ctx, pool.apiContainerCredentials, pool.enclaveCreator.apiContainerApiToken, enclaveInfo, enclaveTemplate.PackageId, enclaveTemplate.SerializedParams, pool.cloudInstanceID, pool.cloudUserID); err != nil {
+		return stacktrace.Propagate(err, "An error occurred running package '%v' of enclave template '%v'", enclaveTemplate.PackageId, enclaveTemplate.Name)
+	}
+	logrus.Infof("Package '%v' of enclave template '%v' ran successfully in enclave '%v'", enclaveTemplate.PackageId, enclaveTemplate.Name, enclaveInfo.Name)
+	return nil
+}
+
+// takeIdleEnclave takes the first idle enclave from the queue and renames it with the name set by the caller.
+// It returns nil if the queue is empty
+func (pool *EnclavePool) takeIdleEnclave(ctx context.Context, queue *idleEnclaveQueue, newEnclaveName string) (*types.EnclaveInfo, error) {
+	// If there is no idle enclave in the queue returns nil
+	// for not to block the caller
+	if len(queue.idleEnclavesChan) == 0 {
+		return nil, nil
+	}
+	enclaveInfo, ok := <-queue.idleEnclavesChan
+	if !ok {
+		return nil, stacktrace.NewError("A new enclave can't be returned from the pool because the internal channel is closed, it shouldn't happen; this is a bug in Kurtosis")
+	}
+	// let the subroutine knows that one idle enclave has been taken from the queue,
+	// and it has to fill the queue again
+	queue.fillChan <- fill
+
+	enclaveUUID := enclave.EnclaveUUID(enclaveInfo.EnclaveUuid)
+	shouldDestroyEnclaveBecauseSomethingFails := true
+	defer func() {
+		if shouldDestroyEnclaveBecauseSomethingFails {
+			idleEnclavesToRemove := map[enclave.EnclaveUUID]bool{
+				enclaveUUID: true,
+			}
+			if err := destroyEnclavesByUUID(ctx, pool.kurtosisBackend, idleEnclavesToRemove); err != nil {
+				logrus.Errorf(
+					"Something fail while getting an enclave from the pool, we tried to destroy the "+
+						"enclave that was taken from it, for avoiding a resource leak, but this also fails, "+
+						"so you will have to manually destroy the enclave with UUUID '%v'. Error:\n%v", enclaveUUID, err)
+			}
+		}
+	}()
+
+	// Check enclave is still running
+	if _, err := pool.getRunningEnclave(ctx, enclaveUUID); err != nil {
+		logrus.Debugf("The idle enclave with UUID '%v' is not longer running or have been destroyed", enclaveUUID)
+		return nil, stacktrace.Propagate(err, "An error occurred getting a running enclave with UUID '%v'", enclaveUUID)
+	}
+
+	newCreationTime := time.Now()
+
+	if err := pool.kurtosisBackend.UpdateEnclave(ctx, enclaveUUID, newEnclaveName, &newCreationTime); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred updating enclave with UUID '%v', trying to update name to '%s' and creation time to '%v'", enclaveUUID, newEnclaveName, newCreationTime)
+	}
+
+	// update the enclave info before returning it
+	// we assume that container status and apic status both are RUNNING because we check it above in getRunningEnclave
+	enclaveInfo.Name = newEnclaveName
+	enclaveInfo.CreationTime = newCreationTime
+
+	logrus.Debugf("Returning enclave Info '%+v' for requested enclave name '%s'", enclaveInfo, newEnclaveName)
+
+	shouldDestroyEnclaveBecauseSomethingFails = false
+	return enclaveInfo, nil
+}
+
+// reuseOrDestroyIdleEnclavesFromPreviousRuns puts the running idle enclaves created before the beforeTime whose
+// fingerprint matches a queue back in that queue, and destroys the other ones. It returns how many idle enclaves were
+// put in each queue
+func (pool *EnclavePool) reuseOrDestroyIdleEnclavesFromPreviousRuns(ctx context.Context, beforeTime time.Time) map[*idleEnclaveQueue]uint8 {
+	numReusedEnclaves := map[*idleEnclaveQueue]uint8{}
+
+	queuesByFingerprint := map[string]*idleEnclaveQueue{}
+	for _, queue := range pool.getAllQueues() {
+		queuesByFingerprint[queue.fingerprint] = queue
+	}
+
+	enclaves, err := getAllEnclavesWithRetries(ctx, pool.kurtosisBackend)
+	if err != nil {
+		logrus.Errorf("We tried to reuse or destroy the idle enclaves from previous runs but listing the enclaves failed; we suggest to manually remove the enclaves whose name starts with '%v'. Error was:\n%v", idleEnclaveNamePrefix, err)
+		return numReusedEnclaves
+	}
+
+	idleEnclavesToRemove := map[enclave.EnclaveUUID]bool{}
+	for enclaveUUID, enclaveObj := range enclaves {
+		if !isIdleEnclave(*enclaveObj) || !enclaveObj.GetCreationTime().Before(beforeTime) {
+			continue
+		}
+		fingerprint, isReady := getIdleEnclaveFingerprint(enclaveObj.GetName())
+		queue, found := queuesByFingerprint[fingerprint]
+		if !isReady || !found || numReusedEnclaves[queue] >= queue.size || enclaveObj.GetStatus() != enclave.EnclaveStatus_Running {
+			idleEnclavesToRemove[enclaveUUID] = true
+			continue
+		}
+		enclaveInfo, err := getEnclaveInfoForEnclave(ctx, pool.kurtosisBackend, enclaveObj)
+		if err != nil {
+			logrus.Warnf("Idle enclave '%v' from a previous run can't be reused because getting its information failed, it will be destroyed. Error was:\n%v", enclaveUUID, err)
+			idleEnclavesToRemove[enclaveUUID] = true
+			continue
+		}
+		queue.idleEnclavesChan <- enclaveInfo
+		numReusedEnclaves[queue]++
+		logrus.Debugf("Reusing idle enclave '%v' from a previous run", enclaveUUID)
+	}
+
+	if err := destroyEnclavesByUUID(ctx, pool.kurtosisBackend, idleEnclavesToRemove); err != nil {
+		logrus.Errorf("We tried to destroy idle enclaves from previous run but something failed; we suggest to manually remove these idle enclave with UUIDs '%+v'. Error was:\n %v", idleEnclavesToRemove, err)
+	} else if len(idleEnclavesToRemove) > 0 {
+		logrus.Debugf("Succesfully destroyed idle eclaves with UUIDS '%+v' from previous runs", idleEnclavesToRemove)
+	}
+
+	return numReusedEnclaves
+}
+
 func (pool *EnclavePool) getRunningEnclave(ctx context.Context, enclaveUUID enclave.EnclaveUUID) (*enclave.Enclave, error) {
 	filters := &enclave.EnclaveFilters{
 		UUIDs: map[enclave.EnclaveUUID]bool{
@@ -377,7 +633,8 @@ func areRequestedEnclaveParamsEqualToEnclaveInThePoolParams(
 	return false
 }
 
-func destroyIdleEnclaves(kurtosisBackend backend_interface.KurtosisBackend) error {
+// destroyNotReadyIdleEnclaves destroys the idle enclaves that are still being prepared, as no engine run would reuse them
+func destroyNotReadyIdleEnclaves(kurtosisBackend backend_interface.KurtosisBackend) error {
 	ctx := context.Background()
 
 	filters := &enclave.EnclaveFilters{
@@ -393,7 +650,7 @@ func destroyIdleEnclaves(kurtosisBackend backend_interface.KurtosisBackend) erro
 	idleEnclavesToRemove := map[enclave.EnclaveUUID]bool{}
 
 	for enclaveUUID, enclaveObj := range enclaves {
-		if isIdleEnclave(*enclaveObj) {
+		if _, isReady := getIdleEnclaveFingerprint(enclaveObj.GetName()); isIdleEnclave(*enclaveObj) && !isReady {
 			idleEnclavesToRemove[enclaveUUID] = true
 		}
 	}
@@ -407,6 +664,23 @@ func destroyIdleEnclaves(kurtosisBackend backend_interface.KurtosisBackend) erro
 
 const destroyEnclaveMaxRetries = 5
 
+// getAllEnclavesWithRetries lists the enclaves retrying a few times, as Kubernetes tends to time out when it has just started
+func getAllEnclavesWithRetries(ctx context.Context, kurtosisBackend backend_interface.KurtosisBackend) (map[enclave.EnclaveUUID]*enclave.Enclave, error) {
+	filters := &enclave.EnclaveFilters{
+		UUIDs:    nil,
+		Statuses: nil,
+	}
+	var err error
+	for numRetries := 0; numRetries < destroyEnclaveMaxRetries; numRetries++ {
+		var enclaves map[enclave.EnclaveUUID]*enclave.Enclave
+		enclaves, err = kurtosisBackend.GetEnclaves(ctx, filters)
+		if err == nil {
+			return enclaves, nil
+		}
+	}
+	return nil, stacktrace.Propagate(err, "An error occurred getting enclaves using filters '%+v', even after retrying %v times", filters, destroyEnclaveMaxRetries)
+}
+
 // destroyIdleEnclavesFromPreviousRuns destroy idle enclaves created before the beforeTime with a retry strategy
 // We have seen the "context deadline exceeded" from Kubernetes in the past, and this usually happens
 // because the Kubernetes has just started, and it is a bit slow to retrieve the information and throws that error
@@ -0,0 +1,28 @@
+package enclave_manager
+
+import (
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetIdleEnclaveQueueFingerprint(t *testing.T) {
+	engineVersion := "1.0.0"
+	devnetTemplate := args.NewEnclaveTemplate("devnet", "github.com/ethpandaops/ethereum-package", `{"participants": [{}]}`, 1)
+
+	emptyEnclavesFingerprint := getIdleEnclaveQueueFingerprint(engineVersion, nil)
+	devnetFingerprint := getIdleEnclaveQueueFingerprint(engineVersion, devnetTemplate)
+	require.Len(t, devnetFingerprint, idleEnclaveFingerprintLength)
+	require.Equal(t, devnetFingerprint, getIdleEnclaveQueueFingerprint(engineVersion, devnetTemplate))
+	require.NotEqual(t, emptyEnclavesFingerprint, devnetFingerprint)
+
+	// Idle enclaves from another engine version or with other template args can't be reused
+	require.NotEqual(t, devnetFingerprint, getIdleEnclaveQueueFingerprint("1.0.1", devnetTemplate))
+	otherArgsTemplate := args.NewEnclaveTemplate(devnetTemplate.Name, devnetTemplate.PackageId, `{"participants": [{}, {}]}`, 1)
+	require.NotEqual(t, devnetFingerprint, getIdleEnclaveQueueFingerprint(engineVersion, otherArgsTemplate))
+
+	// The pool size doesn't change what is in the idle enclaves
+	biggerPoolTemplate := args.NewEnclaveTemplate(devnetTemplate.Name, devnetTemplate.PackageId, devnetTemplate.SerializedParams, 5)
+	require.Equal(t, devnetFingerprint, getIdleEnclaveQueueFingerprint(engineVersion, biggerPoolTemplate))
+}
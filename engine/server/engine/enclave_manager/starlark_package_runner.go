@@ -0,0 +1,91 @@
+package enclave_manager
+
+import (
+	"context"
+	"io"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/starlark_run_config"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
+	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
+	"github.com/kurtosis-tech/stacktrace"
+	"google.golang.org/grpc/credentials"
+)
+
+// runStarlarkPackage runs the package in the enclave through its API container, blocking until the run is over, and
+// returns an error if the run doesn't succeed. If the serialized params are blank, the package runs with its defaults
+func runStarlarkPackage(
+	ctx context.Context,
+	apiContainerCredentials credentials.TransportCredentials,
+	apiContainerApiToken string,
+	enclaveInfo *types.EnclaveInfo,
+	packageId string,
+	serializedParams string,
+	cloudInstanceID metrics_client.CloudInstanceID,
+	cloudUserID metrics_client.CloudUserID,
+) error {
+	conn, err := dialApiContainer(apiContainerCredentials, apiContainerApiToken, enclaveInfo)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred connecting to the API container of enclave '%v' to run package '%v' in it", enclaveInfo.Name, packageId)
+	}
+	defer conn.Close()
+	apiContainerClient := kurtosis_core_rpc_api_bindings.NewApiContainerServiceClient(conn)
+
+	runConfig := starlark_run_config.NewRunStarlarkConfig()
+	if serializedParams == "" {
+		serializedParams = runConfig.SerializedParams
+	}
+	runPackageArgs := binding_constructors.NewRunStarlarkRemotePackageArgs(
+		packageId,
+		runConfig.RelativePathToMainFile,
+		runConfig.MainFunctionName,
+		serializedParams,
+		runConfig.DryRun,
+		runConfig.Parallelism,
+		runConfig.ExperimentalFeatureFlags,
+		string(cloudInstanceID),
+		string(cloudUserID),
+		runConfig.ImageDownload,
+		runConfig.NonBlockingMode,
+		runConfig.Parallel,
+		runConfig.ResourceCheck,
+		runConfig.GitHubAuthToken,
+	)
+
+	stream, err := apiContainerClient.RunStarlarkPackage(ctx, runPackageArgs)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred starting the run of package '%v' in enclave '%v'", packageId, enclaveInfo.Name)
+	}
+	for {
+		responseLine, err := stream.Recv()
+		if err == io.EOF {
+			return stacktrace.NewError("The run of package '%v' in enclave '%v' ended without reporting whether it succeeded", packageId, enclaveInfo.Name)
+		}
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred reading the output of the run of package '%v' in enclave '%v'", packageId, enclaveInfo.Name)
+		}
+		if starlarkErr := responseLine.GetError(); starlarkErr != nil {
+			return stacktrace.NewError("The run of package '%v' in enclave '%v' failed:\n%v", packageId, enclaveInfo.Name, getStarlarkErrorMessage(starlarkErr))
+		}
+		if runFinishedEvent := responseLine.GetRunFinishedEvent(); runFinishedEvent != nil {
+			if !runFinishedEvent.GetIsRunSuccessful() {
+				return stacktrace.NewError("The run of package '%v' in enclave '%v' didn't succeed", packageId, enclaveInfo.Name)
+			}
+			return nil
+		}
+	}
+}
+
+func getStarlarkErrorMessage(starlarkErr *kurtosis_core_rpc_api_bindings.StarlarkError) string {
+	switch {
+	case starlarkErr.GetInterpretationError() != nil:
+		return starlarkErr.GetInterpretationError().GetErrorMessage()
+	case starlarkErr.GetValidationError() != nil:
+		return starlarkErr.GetValidationError().GetErrorMessage()
+	case starlarkErr.GetExecutionError() != nil:
+		return starlarkErr.GetExecutionError().GetErrorMessage()
+	default:
+		return starlarkErr.String()
+	}
+}
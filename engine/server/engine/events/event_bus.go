@@ -0,0 +1,78 @@
+package events
+
+import (
+	"sync"
+
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// A subscriber that has this many events waiting to be read misses the events published in the meantime, so that
+	// a slow watcher never holds up the enclave operations. Webhooks queue their events on their own, so they only
+	// miss events when their queue is full
+	subscriberBufferSize = 256
+)
+
+// EventBus fans out the events of the engine and its enclaves to the event streams and webhooks
+type EventBus struct {
+	mutex *sync.Mutex
+
+	subscribers map[uint64]*subscriber
+
+	nextSubscriberId uint64
+}
+
+type subscriber struct {
+	events chan *types.EngineEvent
+
+	droppedEvents uint64
+}
+
+func NewEventBus() *EventBus {
+	return &EventBus{
+		mutex:            &sync.Mutex{},
+		subscribers:      map[uint64]*subscriber{},
+		nextSubscriberId: 0,
+	}
+}
+
+func (bus *EventBus) Publish(event *types.EngineEvent) {
+	bus.mutex.Lock()
+	defer bus.mutex.Unlock()
+
+	for subscriberId, subscriberObj := range bus.subscribers {
+		select {
+		case subscriberObj.events <- event:
+		default:
+			subscriberObj.droppedEvents++
+			logrus.Warnf("Subscriber '%v' of the engine events isn't keeping up; dropped event '%v' of enclave '%v' (%v events dropped for it so far)", subscriberId, event.Type, event.EnclaveUuid, subscriberObj.droppedEvents)
+		}
+	}
+}
+
+// Subscribe returns a channel receiving every event published from now on, and a function closing the channel that
+// must be called once the events aren't needed anymore
+func (bus *EventBus) Subscribe() (<-chan *types.EngineEvent, func()) {
+	bus.mutex.Lock()
+	defer bus.mutex.Unlock()
+
+	subscriberId := bus.nextSubscriberId
+	bus.nextSubscriberId++
+	subscriberChan := make(chan *types.EngineEvent, subscriberBufferSize)
+	bus.subscribers[subscriberId] = &subscriber{
+		events:        subscriberChan,
+		droppedEvents: 0,
+	}
+
+	unsubscribeOnce := &sync.Once{}
+	unsubscribeFunc := func() {
+		unsubscribeOnce.Do(func() {
+			bus.mutex.Lock()
+			defer bus.mutex.Unlock()
+			delete(bus.subscribers, subscriberId)
+			close(subscriberChan)
+		})
+	}
+	return subscriberChan, unsubscribeFunc
+}
@@ -0,0 +1,49 @@
+package events
+
+import (
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+var allEventTypes = map[types.EngineEventType]bool{
+	types.EngineEventType_ENCLAVE_CREATED:        true,
+	types.EngineEventType_ENCLAVE_STOPPED:        true,
+	types.EngineEventType_ENCLAVE_DESTROYED:      true,
+	types.EngineEventType_SERVICE_ADDED:          true,
+	types.EngineEventType_SERVICE_STARTED:        true,
+	types.EngineEventType_SERVICE_STOPPED:        true,
+	types.EngineEventType_SERVICE_CRASHED:        true,
+	types.EngineEventType_STARLARK_RUN_STARTED:   true,
+	types.EngineEventType_STARLARK_RUN_FINISHED:  true,
+	types.EngineEventType_FILES_ARTIFACT_CREATED: true,
+}
+
+// EventFilter selects the events a watcher or a webhook gets
+type EventFilter struct {
+	// If empty, events of every enclave pass
+	enclaveUuid string
+
+	// If empty, events of every type pass
+	eventTypes map[types.EngineEventType]bool
+}
+
+func NewEventFilter(enclaveUuid string, eventTypes []types.EngineEventType) (*EventFilter, error) {
+	eventTypesSet := map[types.EngineEventType]bool{}
+	for _, eventType := range eventTypes {
+		if !allEventTypes[eventType] {
+			return nil, stacktrace.NewError("Unrecognized event type '%v'", eventType)
+		}
+		eventTypesSet[eventType] = true
+	}
+	return &EventFilter{
+		enclaveUuid: enclaveUuid,
+		eventTypes:  eventTypesSet,
+	}, nil
+}
+
+func (filter *EventFilter) IsPassing(event *types.EngineEvent) bool {
+	if filter.enclaveUuid != "" && event.EnclaveUuid != filter.enclaveUuid {
+		return false
+	}
+	return len(filter.eventTypes) == 0 || filter.eventTypes[event.Type]
+}
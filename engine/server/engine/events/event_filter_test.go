@@ -0,0 +1,23 @@
+package events
+
+import (
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventFilter(t *testing.T) {
+	allEventsFilter, err := NewEventFilter("", nil)
+	require.NoError(t, err)
+	require.True(t, allEventsFilter.IsPassing(newEnclaveEventForTest(types.EngineEventType_SERVICE_CRASHED)))
+
+	filter, err := NewEventFilter(testEnclaveUuid, []types.EngineEventType{types.EngineEventType_SERVICE_CRASHED})
+	require.NoError(t, err)
+	require.True(t, filter.IsPassing(newEnclaveEventForTest(types.EngineEventType_SERVICE_CRASHED)))
+	require.False(t, filter.IsPassing(newEnclaveEventForTest(types.EngineEventType_SERVICE_ADDED)))
+
+	otherEnclaveEvent := newEnclaveEventForTest(types.EngineEventType_SERVICE_CRASHED)
+	otherEnclaveEvent.EnclaveUuid = "fedcba9876543210fedcba9876543210"
+	require.False(t, filter.IsPassing(otherEnclaveEvent))
+}
@@ -0,0 +1,202 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/mapping/to_http"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	SignatureHeader      = "X-Kurtosis-Signature"
+	signatureValuePrefix = "sha256="
+
+	EventTypeHeader = "X-Kurtosis-Event"
+
+	contentTypeHeader = "Content-Type"
+	jsonContentType   = "application/json"
+
+	webhookRequestTimeout = 10 * time.Second
+
+	maxWebhookDeliveryAttempts = 5
+	// Doubled after every failed attempt
+	initialWebhookRetryBackoff = 1 * time.Second
+
+	// Events wait here while the previous ones are being delivered, so that a slow or unreachable webhook doesn't fall
+	// behind the bus and miss events. Events published while the queue is full are dropped
+	maxQueuedWebhookEvents = 4096
+)
+
+// WebhookSender POSTs the events of the engine to a webhook as JSON, retrying with exponential backoff when the
+// endpoint can't be reached or answers with a server error
+type WebhookSender struct {
+	webhookUrl string
+
+	secret string
+
+	filter *EventFilter
+
+	httpClient *http.Client
+
+	initialRetryBackoff time.Duration
+
+	queueSize int
+
+	// Events that couldn't be queued or delivered
+	droppedEvents *atomic.Uint64
+}
+
+func NewWebhookSender(webhook args.Webhook) (*WebhookSender, error) {
+	eventTypes := []types.EngineEventType{}
+	for _, eventTypeStr := range webhook.EventTypes {
+		eventTypes = append(eventTypes, types.EngineEventType(eventTypeStr))
+	}
+	allEnclaves := ""
+	filter, err := NewEventFilter(allEnclaves, eventTypes)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the event filter of webhook '%v'", webhook.Url)
+	}
+	return &WebhookSender{
+		webhookUrl:          webhook.Url,
+		secret:              webhook.Secret,
+		filter:              filter,
+		httpClient:          &http.Client{Timeout: webhookRequestTimeout},
+		initialRetryBackoff: initialWebhookRetryBackoff,
+		queueSize:           maxQueuedWebhookEvents,
+		droppedEvents:       &atomic.Uint64{},
+	}, nil
+}
+
+// Run sends the events published on the bus until the context is cancelled, one at a time and in the order they were
+// published. Events wait in a queue of their own while the webhook is slow or unreachable; the ones that couldn't be
+// queued or delivered after all the attempts are dropped
+func (sender *WebhookSender) Run(ctx context.Context, eventBus *EventBus) {
+	events, unsubscribe := eventBus.Subscribe()
+	defer unsubscribe()
+
+	queuedEvents := make(chan *types.EngineEvent, sender.queueSize)
+	deliveryDone := make(chan struct{})
+	go func() {
+		defer close(deliveryDone)
+		sender.deliver(ctx, queuedEvents)
+	}()
+	defer func() {
+		close(queuedEvents)
+		<-deliveryDone
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, isChanOpen := <-events:
+			if !isChanOpen {
+				return
+			}
+			if !sender.filter.IsPassing(event) {
+				continue
+			}
+			select {
+			case queuedEvents <- event:
+			default:
+				sender.drop(event, stacktrace.NewError("The %v events queued for webhook '%v' are still waiting to be delivered", sender.queueSize, sender.webhookUrl))
+			}
+		}
+	}
+}
+
+// GetDroppedEventsCount returns the number of events that couldn't be queued or delivered since the sender started
+func (sender *WebhookSender) GetDroppedEventsCount() uint64 {
+	return sender.droppedEvents.Load()
+}
+
+func (sender *WebhookSender) deliver(ctx context.Context, queuedEvents <-chan *types.EngineEvent) {
+	for event := range queuedEvents {
+		if ctx.Err() != nil {
+			return
+		}
+		if err := sender.send(ctx, event); err != nil {
+			sender.drop(event, err)
+		}
+	}
+}
+
+func (sender *WebhookSender) drop(event *types.EngineEvent, err error) {
+	droppedEventsCount := sender.droppedEvents.Add(1)
+	logrus.Errorf("Event '%v' of enclave '%v' couldn't be delivered to webhook '%v'; it's dropped (%v events dropped for this webhook so far). Error was:\n%v", event.Type, event.EnclaveUuid, sender.webhookUrl, droppedEventsCount, err)
+}
+
+func (sender *WebhookSender) send(ctx context.Context, event *types.EngineEvent) error {
+	body, err := json.Marshal(to_http.ToHttpEngineEvent(event))
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing event '%v'", event.Type)
+	}
+
+	backoff := sender.initialRetryBackoff
+	var lastErr error
+	for attempt := 1; attempt <= maxWebhookDeliveryAttempts; attempt++ {
+		isRetryable, err := sender.post(ctx, event.Type, body)
+		if err == nil {
+			return nil
+		}
+		if !isRetryable {
+			return stacktrace.Propagate(err, "Webhook '%v' rejected the event", sender.webhookUrl)
+		}
+		lastErr = err
+		logrus.Debugf("Attempt %v of %v to deliver event '%v' to webhook '%v' failed; retrying in %v. Error was:\n%v", attempt, maxWebhookDeliveryAttempts, event.Type, sender.webhookUrl, backoff, err)
+		if attempt == maxWebhookDeliveryAttempts {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return stacktrace.Propagate(ctx.Err(), "Stopped delivering the event to webhook '%v' before it succeeded", sender.webhookUrl)
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+	return stacktrace.Propagate(lastErr, "Delivering the event to webhook '%v' failed after %v attempts", sender.webhookUrl, maxWebhookDeliveryAttempts)
+}
+
+// post returns whether a failed delivery is worth retrying
+func (sender *WebhookSender) post(ctx context.Context, eventType types.EngineEventType, body []byte) (bool, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, sender.webhookUrl, bytes.NewReader(body))
+	if err != nil {
+		return false, stacktrace.Propagate(err, "An error occurred creating the request to webhook '%v'", sender.webhookUrl)
+	}
+	request.Header.Set(contentTypeHeader, jsonContentType)
+	request.Header.Set(EventTypeHeader, string(eventType))
+	if sender.secret != "" {
+		request.Header.Set(SignatureHeader, signatureValuePrefix+Sign(sender.secret, body))
+	}
+
+	response, err := sender.httpClient.Do(request)
+	if err != nil {
+		return true, stacktrace.Propagate(err, "An error occurred sending the request to webhook '%v'", sender.webhookUrl)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode >= http.StatusOK && response.StatusCode < http.StatusMultipleChoices {
+		return false, nil
+	}
+	isRetryable := response.StatusCode >= http.StatusInternalServerError || response.StatusCode == http.StatusTooManyRequests
+	return isRetryable, stacktrace.NewError("Webhook '%v' answered with status '%v'", sender.webhookUrl, response.Status)
+}
+
+// Sign returns the hex-encoded HMAC-SHA256 of the body keyed with the secret, which webhook receivers compute on their
+// side to check the request comes from the engine
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,192 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	api_type "github.com/kurtosis-tech/kurtosis/api/golang/http_rest/api_types"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testSecret      = "s3cr3t"
+	testEnclaveUuid = "0123456789abcdef0123456789abcdef"
+	testEnclaveName = "test-enclave"
+)
+
+func TestSendSignsTheEvent(t *testing.T) {
+	var receivedBody []byte
+	var receivedSignature string
+	var receivedEventType string
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		receivedBody, _ = io.ReadAll(request.Body)
+		receivedSignature = request.Header.Get(SignatureHeader)
+		receivedEventType = request.Header.Get(EventTypeHeader)
+		writer.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	sender, err := NewWebhookSender(*args.NewWebhook(server.URL, testSecret, nil))
+	require.NoError(t, err)
+	require.NoError(t, sender.send(context.Background(), newEnclaveEventForTest(types.EngineEventType_ENCLAVE_CREATED)))
+
+	require.Equal(t, signatureValuePrefix+Sign(testSecret, receivedBody), receivedSignature)
+	require.Equal(t, string(types.EngineEventType_ENCLAVE_CREATED), receivedEventType)
+	var receivedEvent api_type.EngineEvent
+	require.NoError(t, json.Unmarshal(receivedBody, &receivedEvent))
+	require.Equal(t, api_type.ENCLAVECREATED, receivedEvent.Type)
+	require.Equal(t, testEnclaveName, receivedEvent.EnclaveName)
+}
+
+func TestSendRetriesServerErrors(t *testing.T) {
+	attempts := &atomic.Int32{}
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if attempts.Add(1) < 3 {
+			writer.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		writer.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	sender := newWebhookSenderForTest(t, server.URL)
+	require.NoError(t, sender.send(context.Background(), newEnclaveEventForTest(types.EngineEventType_ENCLAVE_DESTROYED)))
+	require.Equal(t, int32(3), attempts.Load())
+}
+
+func TestSendDoesNotRetryClientErrors(t *testing.T) {
+	attempts := &atomic.Int32{}
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		attempts.Add(1)
+		writer.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	sender := newWebhookSenderForTest(t, server.URL)
+	require.Error(t, sender.send(context.Background(), newEnclaveEventForTest(types.EngineEventType_ENCLAVE_DESTROYED)))
+	require.Equal(t, int32(1), attempts.Load())
+}
+
+func TestSendGivesUpAfterMaxAttempts(t *testing.T) {
+	attempts := &atomic.Int32{}
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		attempts.Add(1)
+		writer.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	sender := newWebhookSenderForTest(t, server.URL)
+	require.Error(t, sender.send(context.Background(), newEnclaveEventForTest(types.EngineEventType_ENCLAVE_STOPPED)))
+	require.Equal(t, int32(maxWebhookDeliveryAttempts), attempts.Load())
+}
+
+func TestRunQueuesEventsWhileTheWebhookIsSlow(t *testing.T) {
+	unblockWebhook := make(chan struct{})
+	releaseWebhook := sync.OnceFunc(func() { close(unblockWebhook) })
+	deliveredEvents := &atomic.Int32{}
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		<-unblockWebhook
+		deliveredEvents.Add(1)
+		writer.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	defer releaseWebhook()
+
+	sender := newWebhookSenderForTest(t, server.URL)
+	eventBus := runWebhookSenderForTest(t, sender)
+
+	// more events than the bus buffers for a subscriber, each taken off the bus while the webhook is still blocked
+	numberOfEvents := subscriberBufferSize * 2
+	for i := 0; i < numberOfEvents; i++ {
+		eventBus.Publish(newEnclaveEventForTest(types.EngineEventType_SERVICE_ADDED))
+		require.Eventually(t, func() bool { return getNumberOfEventsWaitingOnBus(eventBus) == 0 }, time.Second, time.Millisecond)
+	}
+	releaseWebhook()
+
+	require.Eventually(t, func() bool { return deliveredEvents.Load() == int32(numberOfEvents) }, 5*time.Second, time.Millisecond)
+	require.Zero(t, sender.GetDroppedEventsCount())
+}
+
+func TestRunCountsTheEventsDroppedWhenTheQueueIsFull(t *testing.T) {
+	unblockWebhook := make(chan struct{})
+	releaseWebhook := sync.OnceFunc(func() { close(unblockWebhook) })
+	receivedRequests := make(chan struct{}, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		receivedRequests <- struct{}{}
+		<-unblockWebhook
+		writer.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	defer releaseWebhook()
+
+	sender := newWebhookSenderForTest(t, server.URL)
+	sender.queueSize = 2
+	eventBus := runWebhookSenderForTest(t, sender)
+
+	eventBus.Publish(newEnclaveEventForTest(types.EngineEventType_SERVICE_ADDED))
+	<-receivedRequests
+	// two events get queued behind the one being delivered, and the rest are dropped
+	for i := 0; i < 4; i++ {
+		eventBus.Publish(newEnclaveEventForTest(types.EngineEventType_SERVICE_ADDED))
+	}
+	require.Eventually(t, func() bool { return sender.GetDroppedEventsCount() == 2 }, 5*time.Second, time.Millisecond)
+}
+
+func TestNewWebhookSenderRejectsUnknownEventTypes(t *testing.T) {
+	_, err := NewWebhookSender(*args.NewWebhook("https://hooks.example.com", "", []string{"ENCLAVE_EXPLODED"}))
+	require.Error(t, err)
+}
+
+func getNumberOfEventsWaitingOnBus(eventBus *EventBus) int {
+	eventBus.mutex.Lock()
+	defer eventBus.mutex.Unlock()
+	numberOfEvents := 0
+	for _, subscriberObj := range eventBus.subscribers {
+		numberOfEvents += len(subscriberObj.events)
+	}
+	return numberOfEvents
+}
+
+// runWebhookSenderForTest returns the bus the sender is running on, once the sender has subscribed to it
+func runWebhookSenderForTest(t *testing.T, sender *WebhookSender) *EventBus {
+	eventBus := NewEventBus()
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go sender.Run(ctx, eventBus)
+	require.Eventually(t, func() bool {
+		eventBus.mutex.Lock()
+		defer eventBus.mutex.Unlock()
+		return len(eventBus.subscribers) > 0
+	}, time.Second, time.Millisecond)
+	return eventBus
+}
+
+func newWebhookSenderForTest(t *testing.T, webhookUrl string) *WebhookSender {
+	sender, err := NewWebhookSender(*args.NewWebhook(webhookUrl, "", nil))
+	require.NoError(t, err)
+	sender.initialRetryBackoff = time.Millisecond
+	return sender
+}
+
+func newEnclaveEventForTest(eventType types.EngineEventType) *types.EngineEvent {
+	return &types.EngineEvent{
+		Type:              eventType,
+		Timestamp:         time.Now(),
+		EnclaveUuid:       testEnclaveUuid,
+		EnclaveName:       testEnclaveName,
+		ServiceName:       nil,
+		ServiceUuid:       nil,
+		FilesArtifactName: nil,
+		FilesArtifactUuid: nil,
+		IsRunSuccessful:   nil,
+	}
+}
@@ -7,6 +7,7 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"io/fs"
 	"math"
@@ -20,11 +21,13 @@ import (
 	"strings"
 	"time"
 
+	"connectrpc.com/connect"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings/kurtosis_engine_rpc_api_bindingsconnect"
 	enclaveApi "github.com/kurtosis-tech/kurtosis/api/golang/http_rest/server/core_rest_api"
 	engineApi "github.com/kurtosis-tech/kurtosis/api/golang/http_rest/server/engine_rest_api"
 	loggingApi "github.com/kurtosis-tech/kurtosis/api/golang/http_rest/server/websocket_api"
+	"github.com/kurtosis-tech/kurtosis/api/golang/util/tls_credentials"
 	connect_server "github.com/kurtosis-tech/kurtosis/connect-server"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/backend_creator"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/consts"
@@ -33,10 +36,13 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/configs"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/engine"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/engine_db"
 	"github.com/kurtosis-tech/kurtosis/core/launcher/api_container_launcher"
+	api_container_args "github.com/kurtosis-tech/kurtosis/core/launcher/args"
 	em_api "github.com/kurtosis-tech/kurtosis/enclave-manager/server"
 	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
 	"github.com/kurtosis-tech/kurtosis/engine/launcher/args/kurtosis_backend_config"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/auth"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/client_implementations/persistent_volume"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/client_implementations/persistent_volume/file_layout"
@@ -45,6 +51,8 @@ import (
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/client_implementations/persistent_volume/stream_logs_strategy"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/client_implementations/persistent_volume/volume_filesystem"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/enclave_manager"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/events"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/scheduler"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/server"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/streaming"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/utils"
@@ -56,6 +64,8 @@ import (
 	echomiddleware "github.com/labstack/echo/v4/middleware"
 	"github.com/rs/cors"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 const (
@@ -95,7 +105,7 @@ const (
 
 var (
 	defaultCORSOrigins []string = []string{"*"}
-	defaultCORSHeaders []string = []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept}
+	defaultCORSHeaders []string = []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, echo.HeaderAuthorization}
 )
 
 // Nil indicates that the KurtosisBackend should not operate in API container mode, which is appropriate here
@@ -183,6 +193,22 @@ func runMain() error {
 	logsDatabaseClient := getLogsDatabaseClient(kurtosisBackend, logRetentionPeriodDuration)
 	logsDatabaseClient.StartLogFileManagement(ctx)
 
+	eventBus := events.NewEventBus()
+	for _, webhook := range serverArgs.Webhooks {
+		webhookSender, err := events.NewWebhookSender(webhook)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred creating the sender of webhook '%v'", webhook.Url)
+		}
+		go webhookSender.Run(ctx, eventBus)
+	}
+
+	authenticator := auth.NewAuthenticator(serverArgs.ApiTokens)
+	if authenticator.IsEnabled() {
+		logrus.Infof("Engine API authentication is enabled with %v API token(s)", len(serverArgs.ApiTokens))
+	} else {
+		logrus.Info("Engine API authentication is disabled because no API tokens were configured")
+	}
+
 	enclaveManager, err := getEnclaveManager(
 		kurtosisBackend,
 		serverArgs.KurtosisBackendType,
@@ -198,11 +224,36 @@ func runMain() error {
 		serverArgs.KurtosisLocalBackendConfig,
 		serverArgs.LogsCollectorFilters,
 		serverArgs.LogsCollectorParsers,
+		serverArgs.Tls,
+		serverArgs.EnclaveTemplates,
+		eventBus,
+		authenticator.GetApiContainerApiTokens(),
+		authenticator.GetEngineApiContainerApiToken(),
 	)
 	if err != nil {
 		return stacktrace.Propagate(err, "Failed to create an enclave manager for backend type '%v' and config '%+v'", serverArgs.KurtosisBackendType, backendConfig)
 	}
 
+	// The engine data lives in a volume that outlives the engine: a Docker volume, or a retained persistent volume on
+	// Kubernetes
+	engineDb, err := engine_db.GetOrCreateEngineDatabase(consts.EngineDataStorageDirPath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred opening the engine database in '%v'", consts.EngineDataStorageDirPath)
+	}
+	defer func() {
+		if err := engineDb.Close(); err != nil {
+			logrus.Warnf("We tried to close the engine database, but doing so threw an error:\n%v", err)
+		}
+	}()
+	scheduleRepository, err := scheduler.GetOrCreateNewScheduleRepository(engineDb)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the schedule repository")
+	}
+	engineScheduler, err := scheduler.CreateScheduler(scheduleRepository, enclaveManager, serverArgs.ImageVersionTag)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the scheduler of the runs")
+	}
+
 	go func() {
 		envJsFilePath := filepath.Join(pathToStaticFolder, envJsFilename)
 		envJsFilePathPerm := envJsFilePerm
@@ -248,9 +299,21 @@ func runMain() error {
 		}
 	}()
 
+	// The enclave manager API calls the engine and the API containers, so it needs the engine's client TLS material
+	var enclaveManagerApiClientTlsConfig *tls.Config
+	if serverArgs.Tls != nil {
+		enclaveManagerApiClientTlsConfig, err = tls_credentials.NewClientTlsConfig(
+			[]byte(serverArgs.Tls.CaCertificate),
+			[]byte(serverArgs.Tls.ClientCertificate),
+			[]byte(serverArgs.Tls.ClientKey),
+		)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred creating the client TLS config of the enclave manager API")
+		}
+	}
 	go func() {
 		enforceAuth := serverArgs.OnBastionHost
-		err = em_api.RunEnclaveManagerApiServer(enforceAuth)
+		err = em_api.RunEnclaveManagerApiServerWithTls(enforceAuth, enclaveManagerApiClientTlsConfig)
 		if err != nil {
 			logrus.Fatal("an error occurred while processing the auth settings, exiting!", err)
 			fmt.Fprintln(logrus.StandardLogger().Out, err)
@@ -296,6 +359,8 @@ func runMain() error {
 			enclaveManager,
 			logsDatabaseClient,
 			metricsClient,
+			authenticator,
+			eventBus,
 		)
 		if err != nil {
 			logrus.Fatal("The REST API server is down, exiting!", err)
@@ -310,8 +375,13 @@ func runMain() error {
 		serverArgs.MetricsUserID,
 		serverArgs.DidUserAcceptSendingMetrics,
 		logsDatabaseClient,
-		metricsClient)
-	apiPath, handler := kurtosis_engine_rpc_api_bindingsconnect.NewEngineServiceHandler(engineConnectServer)
+		metricsClient,
+		eventBus,
+		engineScheduler)
+	apiPath, handler := kurtosis_engine_rpc_api_bindingsconnect.NewEngineServiceHandler(
+		engineConnectServer,
+		connect.WithInterceptors(server.NewEngineAuthInterceptor(authenticator)),
+	)
 	defer func() {
 		if err := engineConnectServer.Close(); err != nil {
 			logrus.Errorf("We tried to close the engine connect server service but something fails. Err:\n%v", err)
@@ -319,6 +389,19 @@ func runMain() error {
 	}()
 
 	engineHttpServer := connect_server.NewConnectServer(serverArgs.GrpcListenPortNum, grpcServerStopGracePeriod, handler, apiPath)
+	if serverArgs.Tls != nil {
+		tlsConfig, err := tls_credentials.NewServerTlsConfig(
+			[]byte(serverArgs.Tls.CaCertificate),
+			[]byte(serverArgs.Tls.ServerCertificate),
+			[]byte(serverArgs.Tls.ServerKey),
+			serverArgs.Tls.RequireClientCertificate,
+		)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred creating the engine server TLS config")
+		}
+		logrus.Infof("The engine server will serve TLS (client certificates required: %v)", serverArgs.Tls.RequireClientCertificate)
+		engineHttpServer = connect_server.NewConnectServerWithTls(serverArgs.GrpcListenPortNum, grpcServerStopGracePeriod, handler, apiPath, tlsConfig)
+	}
 	if err := engineHttpServer.RunServerUntilInterruptedWithCors(cors.AllowAll()); err != nil {
 		return stacktrace.Propagate(err, "An error occurred running the server.")
 	}
@@ -341,6 +424,11 @@ func getEnclaveManager(
 	kurtosisLocalBackendConfig interface{},
 	logsCollectorFilters []logs_collector.Filter,
 	logsCollectorParsers []logs_collector.Parser,
+	tlsConfig *args.TlsConfig,
+	enclaveTemplates []args.EnclaveTemplate,
+	eventBus *events.EventBus,
+	apiContainerApiTokens []api_container_args.ApiToken,
+	apiContainerApiToken string,
 ) (*enclave_manager.EnclaveManager, error) {
 	var apiContainerKurtosisBackendConfigSupplier api_container_launcher.KurtosisBackendConfigSupplier
 	switch kurtosisBackendType {
@@ -358,6 +446,23 @@ func getEnclaveManager(
 		return nil, stacktrace.NewError("Backend type '%v' was not recognized by engine server.", kurtosisBackendType.String())
 	}
 
+	// Each API container serves a certificate of its own signed with the engine's CA, so clients trusting the engine
+	// trust them too, while the engine's keys stay in the engine
+	var apiContainerTlsConfig *enclave_manager.ApiContainerTlsConfig
+	if tlsConfig != nil {
+		var err error
+		apiContainerTlsConfig, err = enclave_manager.NewApiContainerTlsConfig(
+			tlsConfig.CaCertificate,
+			tlsConfig.CaKey,
+			tlsConfig.ClientCertificate,
+			tlsConfig.ClientKey,
+			tlsConfig.RequireClientCertificate,
+		)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating the TLS config of the API containers")
+		}
+	}
+
 	enclaveManager, err := enclave_manager.CreateEnclaveManager(
 		kurtosisBackend,
 		kurtosisBackendType,
@@ -373,6 +478,11 @@ func getEnclaveManager(
 		cloudInstanceId,
 		logsCollectorFilters,
 		logsCollectorParsers,
+		apiContainerTlsConfig,
+		enclaveTemplates,
+		eventBus,
+		apiContainerApiTokens,
+		apiContainerApiToken,
 	)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating enclave manager for backend type '%+v' using pool-size '%v' and engine version '%v'", kurtosisBackendType, poolSize, engineVersion)
@@ -381,6 +491,21 @@ func getEnclaveManager(
 	return enclaveManager, nil
 }
 
+func getApiContainerClientCredentials(tlsConfig *args.TlsConfig) (credentials.TransportCredentials, error) {
+	if tlsConfig == nil {
+		return insecure.NewCredentials(), nil
+	}
+	apiContainerCredentials, err := tls_credentials.NewClientTransportCredentials(
+		[]byte(tlsConfig.CaCertificate),
+		[]byte(tlsConfig.ClientCertificate),
+		[]byte(tlsConfig.ClientKey),
+	)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the API container client TLS credentials")
+	}
+	return apiContainerCredentials, nil
+}
+
 func getKurtosisBackend(ctx context.Context, kurtosisBackendType args.KurtosisBackendType, backendConfig interface{}, remoteBackendConfigMaybe *configs.KurtosisRemoteBackendConfig) (backend_interface.KurtosisBackend, error) {
 	var kurtosisBackend backend_interface.KurtosisBackend
 	var err error
@@ -455,6 +580,8 @@ func restApiServer(
 	enclave_manager *enclave_manager.EnclaveManager,
 	logsDatabaseClient centralized_logs.LogsDatabaseClient,
 	metricsClient metrics_client.MetricsClient,
+	authenticator *auth.Authenticator,
+	eventBus *events.EventBus,
 ) error {
 
 	asyncStarlarkLogs := streaming.NewStreamerPool[*kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine](streamerPoolSize, streamerExpirationTime)
@@ -489,6 +616,8 @@ func restApiServer(
 		AllowHeaders: defaultCORSHeaders,
 	}))
 
+	echoApiRouter.Use(server.NewRestApiAuthMiddleware(authenticator, enclave_manager))
+
 	// ============================== Engine Management API ======================================
 	engineRuntime := server.EngineRuntime{
 		ImageVersionTag: serverArgs.ImageVersionTag,
@@ -513,11 +642,16 @@ func restApiServer(
 		MetricsClient:               metricsClient,
 		AsyncStarlarkLogs:           asyncStarlarkLogs,
 		CorsConfig:                  *corsConfig,
+		EventBus:                    eventBus,
 	}
 	loggingApi.RegisterHandlers(echoApiRouter, webSocketRuntime)
 
 	// ============================== Engine Management API ======================================
-	enclaveRuntime, err := server.NewEnclaveRuntime(ctx, *enclave_manager, asyncStarlarkLogs, false)
+	apiContainerCredentials, err := getApiContainerClientCredentials(serverArgs.Tls)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the credentials to call the API containers with")
+	}
+	enclaveRuntime, err := server.NewEnclaveRuntime(ctx, *enclave_manager, asyncStarlarkLogs, false, apiContainerCredentials, authenticator.GetEngineApiContainerApiToken())
 	if err != nil {
 		newErr := stacktrace.Propagate(err, "Failed to initialize %T", enclaveRuntime)
 		return newErr
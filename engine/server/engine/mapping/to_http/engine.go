@@ -207,3 +207,45 @@ func ToHttpRestartPolicy(policy rpc_api.RestartPolicy) api_type.RestartPolicy {
 		panic(fmt.Sprintf("Missing conversion of Restart Policy Enum value: %s", policy))
 	}
 }
+
+func ToHttpEngineEventType(eventType types.EngineEventType) api_type.EngineEventType {
+	switch eventType {
+	case types.EngineEventType_ENCLAVE_CREATED:
+		return api_type.ENCLAVECREATED
+	case types.EngineEventType_ENCLAVE_STOPPED:
+		return api_type.ENCLAVESTOPPED
+	case types.EngineEventType_ENCLAVE_DESTROYED:
+		return api_type.ENCLAVEDESTROYED
+	case types.EngineEventType_SERVICE_ADDED:
+		return api_type.SERVICEADDED
+	case types.EngineEventType_SERVICE_STARTED:
+		return api_type.SERVICESTARTED
+	case types.EngineEventType_SERVICE_STOPPED:
+		return api_type.SERVICESTOPPED
+	case types.EngineEventType_SERVICE_CRASHED:
+		return api_type.SERVICECRASHED
+	case types.EngineEventType_STARLARK_RUN_STARTED:
+		return api_type.STARLARKRUNSTARTED
+	case types.EngineEventType_STARLARK_RUN_FINISHED:
+		return api_type.STARLARKRUNFINISHED
+	case types.EngineEventType_FILES_ARTIFACT_CREATED:
+		return api_type.FILESARTIFACTCREATED
+	default:
+		warnUnmatchedValue(eventType)
+		panic(fmt.Sprintf("Missing conversion of Engine Event Type value: %s", eventType))
+	}
+}
+
+func ToHttpEngineEvent(event *types.EngineEvent) api_type.EngineEvent {
+	return api_type.EngineEvent{
+		Type:              ToHttpEngineEventType(event.Type),
+		Timestamp:         event.Timestamp,
+		EnclaveUuid:       event.EnclaveUuid,
+		EnclaveName:       event.EnclaveName,
+		ServiceName:       event.ServiceName,
+		ServiceUuid:       event.ServiceUuid,
+		FilesArtifactName: event.FilesArtifactName,
+		FilesArtifactUuid: event.FilesArtifactUuid,
+		IsRunSuccessful:   event.IsRunSuccessful,
+	}
+}
@@ -0,0 +1,38 @@
+package scheduler
+
+import (
+	"time"
+
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/robfig/cron/v3"
+)
+
+// CronExpression is a parsed standard five-field cron expression: minute, hour, day of month, month and day of week.
+// The parsing and matching are done by robfig/cron, which is also what the Kubernetes CronJobs use, so the expressions
+// behave the same as there, '@daily' style shortcuts included
+type CronExpression struct {
+	schedule *cron.SpecSchedule
+}
+
+func ParseCronExpression(expression string) (*CronExpression, error) {
+	schedule, err := cron.ParseStandard(expression)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing cron expression '%v'", expression)
+	}
+	// '@every' intervals would be counted from whenever the engine checked the schedule last, so they aren't supported
+	specSchedule, ok := schedule.(*cron.SpecSchedule)
+	if !ok {
+		return nil, stacktrace.NewError("Cron expression '%v' isn't supported; only the five fields and the '@daily' style shortcuts are", expression)
+	}
+	return &CronExpression{schedule: specSchedule}, nil
+}
+
+// GetNextRunTime returns the first minute strictly after the given time that matches the expression, in the location
+// of the given time. It returns false if no minute matches in the next few years
+func (expression *CronExpression) GetNextRunTime(after time.Time) (time.Time, bool) {
+	nextRunTime := expression.schedule.Next(after)
+	if nextRunTime.IsZero() {
+		return time.Time{}, false
+	}
+	return nextRunTime, true
+}
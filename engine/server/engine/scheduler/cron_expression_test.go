@@ -0,0 +1,92 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// A Wednesday
+var referenceTimeForTest = time.Date(2026, time.October, 14, 10, 30, 45, 0, time.UTC)
+
+func TestGetNextRunTime_EveryMinute(t *testing.T) {
+	requireNextRunTime(t, "* * * * *", time.Date(2026, time.October, 14, 10, 31, 0, 0, time.UTC))
+}
+
+func TestGetNextRunTime_Nightly(t *testing.T) {
+	requireNextRunTime(t, "0 2 * * *", time.Date(2026, time.October, 15, 2, 0, 0, 0, time.UTC))
+}
+
+func TestGetNextRunTime_Steps(t *testing.T) {
+	requireNextRunTime(t, "*/15 * * * *", time.Date(2026, time.October, 14, 10, 45, 0, 0, time.UTC))
+	requireNextRunTime(t, "5/20 * * * *", time.Date(2026, time.October, 14, 10, 45, 0, 0, time.UTC))
+	requireNextRunTime(t, "0 0-12/6 * * *", time.Date(2026, time.October, 14, 12, 0, 0, 0, time.UTC))
+}
+
+func TestGetNextRunTime_ListsAndRanges(t *testing.T) {
+	requireNextRunTime(t, "10,20 9-10 * * *", time.Date(2026, time.October, 15, 9, 10, 0, 0, time.UTC))
+}
+
+func TestGetNextRunTime_DayOfWeek(t *testing.T) {
+	requireNextRunTime(t, "0 3 * * 0", time.Date(2026, time.October, 18, 3, 0, 0, 0, time.UTC))
+	requireNextRunTime(t, "0 3 * * SUN", time.Date(2026, time.October, 18, 3, 0, 0, 0, time.UTC))
+	requireNextRunTime(t, "0 3 * * 1-5", time.Date(2026, time.October, 15, 3, 0, 0, 0, time.UTC))
+}
+
+func TestGetNextRunTime_EitherDayFieldMatchesWhenBothAreRestricted(t *testing.T) {
+	// The 20th is a Tuesday, but Friday the 16th comes first
+	requireNextRunTime(t, "0 0 20 * 5", time.Date(2026, time.October, 16, 0, 0, 0, 0, time.UTC))
+}
+
+func TestGetNextRunTime_SkipsMonthsWithoutTheDay(t *testing.T) {
+	requireNextRunTime(t, "0 0 31 * *", time.Date(2026, time.October, 31, 0, 0, 0, 0, time.UTC))
+	requireNextRunTime(t, "0 0 29 2 *", time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC))
+}
+
+func TestGetNextRunTime_Shortcuts(t *testing.T) {
+	requireNextRunTime(t, "@hourly", time.Date(2026, time.October, 14, 11, 0, 0, 0, time.UTC))
+	requireNextRunTime(t, "@daily", time.Date(2026, time.October, 15, 0, 0, 0, 0, time.UTC))
+	requireNextRunTime(t, "@weekly", time.Date(2026, time.October, 18, 0, 0, 0, 0, time.UTC))
+	requireNextRunTime(t, "@monthly", time.Date(2026, time.November, 1, 0, 0, 0, 0, time.UTC))
+	requireNextRunTime(t, "@yearly", time.Date(2027, time.January, 1, 0, 0, 0, 0, time.UTC))
+}
+
+func TestGetNextRunTime_NeverMatching(t *testing.T) {
+	cronExpression, err := ParseCronExpression("0 0 31 2 *")
+	require.NoError(t, err)
+
+	_, found := cronExpression.GetNextRunTime(referenceTimeForTest)
+	require.False(t, found)
+}
+
+func TestParseCronExpression_Invalid(t *testing.T) {
+	invalidExpressions := []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 7",
+		"*/0 * * * *",
+		"10-5 * * * *",
+		"a * * * *",
+		"@reboot",
+		"@every 1h",
+	}
+	for _, invalidExpression := range invalidExpressions {
+		_, err := ParseCronExpression(invalidExpression)
+		require.Error(t, err, "Expected cron expression '%v' to be invalid", invalidExpression)
+	}
+}
+
+func requireNextRunTime(t *testing.T, expression string, expectedNextRunTime time.Time) {
+	cronExpression, err := ParseCronExpression(expression)
+	require.NoError(t, err)
+
+	nextRunTime, found := cronExpression.GetNextRunTime(referenceTimeForTest)
+	require.True(t, found)
+	require.Equal(t, expectedNextRunTime, nextRunTime, "Unexpected next run time for cron expression '%v'", expression)
+}
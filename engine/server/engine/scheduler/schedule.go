@@ -0,0 +1,65 @@
+package scheduler
+
+import (
+	"time"
+)
+
+type EnclaveMode string
+
+const (
+	// Every run creates a new enclave
+	EnclaveMode_Fresh EnclaveMode = "fresh"
+	// Every run happens in the same enclave, which is created by the first run if it doesn't exist
+	EnclaveMode_Reuse EnclaveMode = "reuse"
+)
+
+type ScheduledRunStatus string
+
+const (
+	ScheduledRunStatus_Running   ScheduledRunStatus = "running"
+	ScheduledRunStatus_Succeeded ScheduledRunStatus = "succeeded"
+	ScheduledRunStatus_Failed    ScheduledRunStatus = "failed"
+)
+
+// Schedule is a package that the engine runs every time its cron expression matches
+type Schedule struct {
+	Uuid string `json:"uuid"`
+
+	CronExpression string `json:"cronExpression"`
+
+	PackageId string `json:"packageId"`
+
+	// If blank, the package runs with its default params
+	SerializedParams string `json:"serializedParams"`
+
+	EnclaveMode EnclaveMode `json:"enclaveMode"`
+
+	// The enclave the runs happen in when reusing an enclave, or the prefix of the names of the new enclaves otherwise
+	EnclaveName string `json:"enclaveName"`
+
+	// Only for fresh enclaves, which are destroyed once the run is over, whatever its outcome
+	ShouldDestroyEnclave bool `json:"shouldDestroyEnclave"`
+
+	// The engine API user who added the schedule; the enclaves of the runs belong to this user
+	Owner string `json:"owner"`
+
+	CreationTime time.Time `json:"creationTime"`
+
+	// The latest runs of the schedule, oldest first
+	RecentRuns []*ScheduledRun `json:"recentRuns"`
+}
+
+// ScheduledRun is the outcome of one run of a schedule
+type ScheduledRun struct {
+	StartTime time.Time `json:"startTime"`
+
+	// Nil while the run is in progress
+	EndTime *time.Time `json:"endTime"`
+
+	EnclaveName string `json:"enclaveName"`
+
+	Status ScheduledRunStatus `json:"status"`
+
+	// Why the run failed; blank if it didn't
+	ErrorMessage string `json:"errorMessage"`
+}
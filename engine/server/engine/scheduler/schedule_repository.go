@@ -0,0 +1,130 @@
+package scheduler
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/engine_db"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	// the schedules are keyed by schedule UUID
+	scheduleBucketName = []byte("schedule-repository")
+)
+
+// ScheduleRepository persists the schedules and the outcome of their latest runs, so that they survive engine restarts
+type ScheduleRepository struct {
+	engineDb *engine_db.EngineDB
+}
+
+func GetOrCreateNewScheduleRepository(engineDb *engine_db.EngineDB) (*ScheduleRepository, error) {
+	if err := engineDb.Update(func(tx *bolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists(scheduleBucketName)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred while creating the schedule database bucket")
+		}
+		logrus.Debugf("Schedule bucket: '%+v'", bucket)
+
+		return nil
+	}); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while building the schedule repository")
+	}
+
+	scheduleRepository := &ScheduleRepository{
+		engineDb: engineDb,
+	}
+
+	return scheduleRepository, nil
+}
+
+// Get returns the schedule with the given UUID, or nil if there's none
+func (repository *ScheduleRepository) Get(scheduleUuid string) (*Schedule, error) {
+	var (
+		scheduleObj *Schedule
+		err         error
+	)
+
+	if err = repository.engineDb.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(scheduleBucketName)
+
+		scheduleBytes := bucket.Get([]byte(scheduleUuid))
+		if scheduleBytes == nil {
+			return nil
+		}
+
+		scheduleObj = &Schedule{}
+		if err = json.Unmarshal(scheduleBytes, scheduleObj); err != nil {
+			return stacktrace.Propagate(err, "An error occurred unmarshalling schedule '%v'", scheduleUuid)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while getting schedule '%v' from the repository", scheduleUuid)
+	}
+	return scheduleObj, nil
+}
+
+// GetAll returns all the schedules, sorted by creation time
+func (repository *ScheduleRepository) GetAll() ([]*Schedule, error) {
+	schedules := []*Schedule{}
+
+	if err := repository.engineDb.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(scheduleBucketName)
+
+		return bucket.ForEach(func(scheduleUuid, scheduleBytes []byte) error {
+			scheduleObj := &Schedule{}
+			if err := json.Unmarshal(scheduleBytes, scheduleObj); err != nil {
+				return stacktrace.Propagate(err, "An error occurred unmarshalling schedule '%v'", string(scheduleUuid))
+			}
+			schedules = append(schedules, scheduleObj)
+			return nil
+		})
+	}); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while getting the schedules from the repository")
+	}
+
+	sort.SliceStable(schedules, func(i, j int) bool {
+		return schedules[i].CreationTime.Before(schedules[j].CreationTime)
+	})
+	return schedules, nil
+}
+
+// Save creates the schedule, or replaces it if it already exists
+func (repository *ScheduleRepository) Save(schedule *Schedule) error {
+	if err := repository.engineDb.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(scheduleBucketName)
+
+		jsonBytes, err := json.Marshal(schedule)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred marshalling schedule '%v'", schedule.Uuid)
+		}
+
+		if err := bucket.Put([]byte(schedule.Uuid), jsonBytes); err != nil {
+			return stacktrace.Propagate(err, "An error occurred while saving schedule '%v' into the engine db bucket", schedule.Uuid)
+		}
+
+		return nil
+	}); err != nil {
+		return stacktrace.Propagate(err, "An error occurred while saving schedule '%v' into the schedule repository", schedule.Uuid)
+	}
+	return nil
+}
+
+// Delete removes the schedule; it's a no-op if the schedule doesn't exist
+func (repository *ScheduleRepository) Delete(scheduleUuid string) error {
+	if err := repository.engineDb.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(scheduleBucketName)
+
+		if err := bucket.Delete([]byte(scheduleUuid)); err != nil {
+			return stacktrace.Propagate(err, "An error occurred while deleting schedule '%v' from the engine db bucket", scheduleUuid)
+		}
+
+		return nil
+	}); err != nil {
+		return stacktrace.Propagate(err, "An error occurred while deleting schedule '%v' from the schedule repository", scheduleUuid)
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package scheduler
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/engine_db"
+	"github.com/stretchr/testify/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	firstScheduleUuidForTest  = "4f7a3f4c3e5b4d6f8a9b0c1d2e3f4a5b"
+	secondScheduleUuidForTest = "0a1b2c3d4e5f40718293a4b5c6d7e8f9"
+)
+
+func TestSaveAndGetSchedule_Success(t *testing.T) {
+	repository := getScheduleRepositoryForTest(t)
+
+	schedule := newScheduleForTest(firstScheduleUuidForTest, time.Unix(1700000000, 0).UTC())
+	require.NoError(t, repository.Save(schedule))
+
+	endTime := time.Unix(1700000300, 0).UTC()
+	schedule.RecentRuns = append(schedule.RecentRuns, &ScheduledRun{
+		StartTime:    time.Unix(1700000100, 0).UTC(),
+		EndTime:      &endTime,
+		EnclaveName:  "nightly-20231114-2215",
+		Status:       ScheduledRunStatus_Failed,
+		ErrorMessage: "Evaluation error: undefined: plan.add_servic",
+	})
+	require.NoError(t, repository.Save(schedule))
+
+	scheduleFromRepository, err := repository.Get(firstScheduleUuidForTest)
+	require.NoError(t, err)
+	require.Equal(t, schedule, scheduleFromRepository)
+}
+
+func TestGetNilSchedule_Success(t *testing.T) {
+	repository := getScheduleRepositoryForTest(t)
+
+	scheduleFromRepository, err := repository.Get(firstScheduleUuidForTest)
+	require.NoError(t, err)
+	require.Nil(t, scheduleFromRepository)
+}
+
+func TestGetAllSchedules_SortedByCreationTime(t *testing.T) {
+	repository := getScheduleRepositoryForTest(t)
+
+	// the schedule created last has the smallest UUID, so that the order doesn't come from the keys
+	laterSchedule := newScheduleForTest(secondScheduleUuidForTest, time.Unix(1700000100, 0).UTC())
+	earlierSchedule := newScheduleForTest(firstScheduleUuidForTest, time.Unix(1700000000, 0).UTC())
+	require.NoError(t, repository.Save(laterSchedule))
+	require.NoError(t, repository.Save(earlierSchedule))
+
+	schedules, err := repository.GetAll()
+	require.NoError(t, err)
+	require.Equal(t, []*Schedule{earlierSchedule, laterSchedule}, schedules)
+}
+
+func TestDeleteSchedule_Success(t *testing.T) {
+	repository := getScheduleRepositoryForTest(t)
+
+	require.NoError(t, repository.Save(newScheduleForTest(firstScheduleUuidForTest, time.Unix(1700000000, 0).UTC())))
+	require.NoError(t, repository.Delete(firstScheduleUuidForTest))
+	// deleting a schedule that doesn't exist is a no-op
+	require.NoError(t, repository.Delete(secondScheduleUuidForTest))
+
+	schedules, err := repository.GetAll()
+	require.NoError(t, err)
+	require.Empty(t, schedules)
+}
+
+func newScheduleForTest(scheduleUuid string, creationTime time.Time) *Schedule {
+	return &Schedule{
+		Uuid:                 scheduleUuid,
+		CronExpression:       "0 2 * * *",
+		PackageId:            "github.com/kurtosis-tech/ethereum-package",
+		SerializedParams:     "{\"participants\": [{\"el_type\": \"geth\"}]}",
+		EnclaveMode:          EnclaveMode_Fresh,
+		EnclaveName:          "nightly",
+		ShouldDestroyEnclave: true,
+		Owner:                "ci",
+		CreationTime:         creationTime,
+		RecentRuns:           []*ScheduledRun{},
+	}
+}
+
+func getScheduleRepositoryForTest(t *testing.T) *ScheduleRepository {
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer func() {
+		err = os.Remove(file.Name())
+		require.NoError(t, err)
+	}()
+
+	require.NoError(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.NoError(t, err)
+	engineDb := &engine_db.EngineDB{
+		DB: db,
+	}
+	repository, err := GetOrCreateNewScheduleRepository(engineDb)
+	require.NoError(t, err)
+
+	return repository
+}
@@ -0,0 +1,439 @@
+package scheduler
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	enclave_consts "github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/enclave_manager"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// How often the scheduler looks for schedules whose next run is due, so runs start at most this late
+	scheduleCheckInterval = 15 * time.Second
+
+	// Only the outcome of the latest runs of each schedule is kept
+	maxRecentRunsPerSchedule = 10
+
+	defaultFreshEnclaveNamePrefix = "scheduled"
+	freshEnclaveNameTimeFormat    = "20060102-1504"
+	freshEnclaveNameSeparator     = "-"
+	// The names of the fresh enclaves are the prefix followed by the time the run started at, and can't be longer
+	// than 60 characters
+	maxFreshEnclaveNamePrefixLength = 60 - len(freshEnclaveNameSeparator) - len(freshEnclaveNameTimeFormat)
+
+	// This is the same default value used in the `kurtosis enclave add` CLI command
+	scheduledRunApiContainerLogLevel = logrus.DebugLevel
+
+	interruptedRunErrorMessage = "The engine stopped before the run was over"
+)
+
+// Scheduler runs the packages of the schedules every time their cron expression matches. Cron expressions are
+// evaluated in UTC, and the runs that were due while the engine was down are skipped rather than caught up on
+type Scheduler struct {
+	// Serializes the updates to the schedules, which happen both from the API and from the runs
+	mutex *sync.Mutex
+
+	repository *ScheduleRepository
+
+	enclaveManager *enclave_manager.EnclaveManager
+
+	engineVersion string
+
+	// A schedule that is due while its previous run is still in progress skips that run
+	runningScheduleUuids map[string]bool
+
+	runsCtx context.Context
+
+	cancelRunsCtxFunc context.CancelFunc
+
+	stopChan chan struct{}
+}
+
+// CreateScheduler marks the runs that the previous engine left unfinished as failed, and starts the routine that
+// runs the schedules
+func CreateScheduler(repository *ScheduleRepository, enclaveManager *enclave_manager.EnclaveManager, engineVersion string) (*Scheduler, error) {
+	runsCtx, cancelRunsCtxFunc := context.WithCancel(context.Background())
+	scheduler := &Scheduler{
+		mutex:                &sync.Mutex{},
+		repository:           repository,
+		enclaveManager:       enclaveManager,
+		engineVersion:        engineVersion,
+		runningScheduleUuids: map[string]bool{},
+		runsCtx:              runsCtx,
+		cancelRunsCtxFunc:    cancelRunsCtxFunc,
+		stopChan:             make(chan struct{}),
+	}
+
+	if err := scheduler.failInterruptedRuns(); err != nil {
+		cancelRunsCtxFunc()
+		return nil, stacktrace.Propagate(err, "An error occurred marking the runs interrupted by the previous engine as failed")
+	}
+
+	go scheduler.run()
+
+	return scheduler, nil
+}
+
+func (scheduler *Scheduler) AddSchedule(
+	cronExpression string,
+	packageId string,
+	// If blank, the package runs with its default params
+	serializedParams string,
+	enclaveMode EnclaveMode,
+	// The prefix of the names of the new enclaves, which defaults to one unique to the schedule, or the name of the
+	// enclave to reuse, which is required
+	enclaveName string,
+	shouldDestroyEnclave bool,
+	owner string,
+) (*Schedule, error) {
+	if _, err := ParseCronExpression(cronExpression); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing the cron expression of the schedule")
+	}
+	if strings.TrimSpace(packageId) == "" {
+		return nil, stacktrace.NewError("The schedule needs a package to run")
+	}
+	scheduleUuid, err := uuid_generator.GenerateUUIDString()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred generating the UUID of the schedule")
+	}
+	switch enclaveMode {
+	case EnclaveMode_Fresh:
+		if enclaveName == "" {
+			enclaveName = defaultFreshEnclaveNamePrefix + freshEnclaveNameSeparator + uuid_generator.ShortenedUUIDString(scheduleUuid)
+		}
+		if len(enclaveName) > maxFreshEnclaveNamePrefixLength {
+			return nil, stacktrace.NewError(
+				"The prefix of the names of the new enclaves '%v' can't be longer than %v characters, as the time the run started at gets appended to it",
+				enclaveName,
+				maxFreshEnclaveNamePrefixLength,
+			)
+		}
+	case EnclaveMode_Reuse:
+		if enclaveName == "" {
+			return nil, stacktrace.NewError("The name of the enclave to reuse is required when the schedule reuses an enclave")
+		}
+		if shouldDestroyEnclave {
+			return nil, stacktrace.NewError("The enclave of a schedule can only be destroyed after each run when every run gets a fresh enclave")
+		}
+	default:
+		return nil, stacktrace.NewError("Unrecognized enclave mode '%v'; it should be '%v' or '%v'", enclaveMode, EnclaveMode_Fresh, EnclaveMode_Reuse)
+	}
+	isValidEnclaveName, err := regexp.MatchString(enclave_consts.AllowedEnclaveNameCharsRegexStr, enclaveName)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred validating enclave name '%v'", enclaveName)
+	}
+	if !isValidEnclaveName {
+		return nil, stacktrace.NewError("Enclave name '%v' doesn't match allowed enclave name regex '%v'", enclaveName, enclave_consts.AllowedEnclaveNameCharsRegexStr)
+	}
+
+	schedule := &Schedule{
+		Uuid:                 scheduleUuid,
+		CronExpression:       cronExpression,
+		PackageId:            packageId,
+		SerializedParams:     serializedParams,
+		EnclaveMode:          enclaveMode,
+		EnclaveName:          enclaveName,
+		ShouldDestroyEnclave: shouldDestroyEnclave,
+		Owner:                owner,
+		CreationTime:         time.Now().UTC(),
+		RecentRuns:           []*ScheduledRun{},
+	}
+
+	scheduler.mutex.Lock()
+	defer scheduler.mutex.Unlock()
+	if err := scheduler.repository.Save(schedule); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred saving schedule '%v'", scheduleUuid)
+	}
+	logrus.Infof("Added schedule '%v' running package '%v' at '%v'", scheduleUuid, packageId, cronExpression)
+	return schedule, nil
+}
+
+// GetSchedules returns all the schedules, oldest first
+func (scheduler *Scheduler) GetSchedules() ([]*Schedule, error) {
+	schedules, err := scheduler.repository.GetAll()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the schedules")
+	}
+	return schedules, nil
+}
+
+// GetSchedule returns the schedule matching the full or shortened UUID
+func (scheduler *Scheduler) GetSchedule(scheduleIdentifier string) (*Schedule, error) {
+	schedules, err := scheduler.repository.GetAll()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the schedules")
+	}
+	var matchingSchedule *Schedule
+	for _, schedule := range schedules {
+		if scheduleIdentifier != schedule.Uuid && scheduleIdentifier != uuid_generator.ShortenedUUIDString(schedule.Uuid) {
+			continue
+		}
+		if matchingSchedule != nil {
+			return nil, stacktrace.NewError("Found more than one schedule matching '%v'; use the full UUID of the schedule", scheduleIdentifier)
+		}
+		matchingSchedule = schedule
+	}
+	if matchingSchedule == nil {
+		return nil, stacktrace.NewError("No schedule matching '%v' was found", scheduleIdentifier)
+	}
+	return matchingSchedule, nil
+}
+
+// RemoveSchedule stops the schedule from running again; a run that is in progress is left to finish
+func (scheduler *Scheduler) RemoveSchedule(scheduleUuid string) error {
+	scheduler.mutex.Lock()
+	defer scheduler.mutex.Unlock()
+	if err := scheduler.repository.Delete(scheduleUuid); err != nil {
+		return stacktrace.Propagate(err, "An error occurred removing schedule '%v'", scheduleUuid)
+	}
+	logrus.Infof("Removed schedule '%v'", scheduleUuid)
+	return nil
+}
+
+// Close stops the scheduler and cancels the runs in progress
+func (scheduler *Scheduler) Close() {
+	close(scheduler.stopChan)
+	scheduler.cancelRunsCtxFunc()
+}
+
+// GetNextRunTime returns when the schedule runs next after the given time, or nil if its cron expression never matches
+func GetNextRunTime(schedule *Schedule, after time.Time) *time.Time {
+	cronExpression, err := ParseCronExpression(schedule.CronExpression)
+	if err != nil {
+		logrus.Errorf("The cron expression '%v' of schedule '%v' is invalid:\n%v", schedule.CronExpression, schedule.Uuid, err)
+		return nil
+	}
+	if schedule.CreationTime.After(after) {
+		after = schedule.CreationTime
+	}
+	nextRunTime, found := cronExpression.GetNextRunTime(after.UTC())
+	if !found {
+		return nil
+	}
+	return &nextRunTime
+}
+
+func (scheduler *Scheduler) run() {
+	ticker := time.NewTicker(scheduleCheckInterval)
+	defer ticker.Stop()
+	lastCheckTime := time.Now().UTC()
+	for {
+		select {
+		case <-scheduler.stopChan:
+			return
+		case <-ticker.C:
+			now := time.Now().UTC()
+			scheduler.startDueRuns(lastCheckTime, now)
+			lastCheckTime = now
+		}
+	}
+}
+
+// startDueRuns starts the run of every schedule that was due between the two times
+func (scheduler *Scheduler) startDueRuns(lastCheckTime time.Time, now time.Time) {
+	schedules, err := scheduler.repository.GetAll()
+	if err != nil {
+		logrus.Errorf("An error occurred getting the schedules to run:\n%v", err)
+		return
+	}
+	for _, schedule := range schedules {
+		nextRunTime := GetNextRunTime(schedule, lastCheckTime)
+		if nextRunTime == nil || nextRunTime.After(now) {
+			continue
+		}
+
+		scheduler.mutex.Lock()
+		isRunning := scheduler.runningScheduleUuids[schedule.Uuid]
+		if !isRunning {
+			scheduler.runningScheduleUuids[schedule.Uuid] = true
+		}
+		scheduler.mutex.Unlock()
+		if isRunning {
+			logrus.Warnf("Skipping the run of schedule '%v' due at '%v' as its previous run is still in progress", schedule.Uuid, nextRunTime)
+			continue
+		}
+		go scheduler.runSchedule(schedule)
+	}
+}
+
+func (scheduler *Scheduler) runSchedule(schedule *Schedule) {
+	defer func() {
+		scheduler.mutex.Lock()
+		delete(scheduler.runningScheduleUuids, schedule.Uuid)
+		scheduler.mutex.Unlock()
+	}()
+
+	startTime := time.Now().UTC()
+	enclaveName := schedule.EnclaveName
+	if schedule.EnclaveMode == EnclaveMode_Fresh {
+		enclaveName = schedule.EnclaveName + freshEnclaveNameSeparator + startTime.Format(freshEnclaveNameTimeFormat)
+	}
+	scheduledRun := &ScheduledRun{
+		StartTime:    startTime,
+		EndTime:      nil,
+		EnclaveName:  enclaveName,
+		Status:       ScheduledRunStatus_Running,
+		ErrorMessage: "",
+	}
+	if err := scheduler.saveScheduledRun(schedule.Uuid, scheduledRun); err != nil {
+		logrus.Errorf("An error occurred saving the start of the run of schedule '%v':\n%v", schedule.Uuid, err)
+	}
+
+	logrus.Infof("Running package '%v' of schedule '%v' in enclave '%v'...", schedule.PackageId, schedule.Uuid, enclaveName)
+	runErr := scheduler.runPackage(schedule, enclaveName)
+	endTime := time.Now().UTC()
+	scheduledRun.EndTime = &endTime
+	if runErr != nil {
+		logrus.Errorf("The run of schedule '%v' in enclave '%v' failed:\n%v", schedule.Uuid, enclaveName, runErr)
+		scheduledRun.Status = ScheduledRunStatus_Failed
+		scheduledRun.ErrorMessage = runErr.Error()
+	} else {
+		logrus.Infof("Package '%v' of schedule '%v' ran successfully in enclave '%v'", schedule.PackageId, schedule.Uuid, enclaveName)
+		scheduledRun.Status = ScheduledRunStatus_Succeeded
+	}
+	if err := scheduler.saveScheduledRun(schedule.Uuid, scheduledRun); err != nil {
+		logrus.Errorf("An error occurred saving the outcome of the run of schedule '%v':\n%v", schedule.Uuid, err)
+	}
+}
+
+// runPackage gets the enclave the run happens in ready, runs the package in it and destroys it afterwards if the
+// schedule asks for it
+func (scheduler *Scheduler) runPackage(schedule *Schedule, enclaveName string) error {
+	ctx := scheduler.runsCtx
+
+	enclaveUuid := ""
+	if schedule.EnclaveMode == EnclaveMode_Reuse {
+		existingEnclaveUuid, err := scheduler.getEnclaveUuidByName(ctx, enclaveName)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred looking for enclave '%v'", enclaveName)
+		}
+		enclaveUuid = existingEnclaveUuid
+	}
+
+	if enclaveUuid == "" {
+		isProduction := false
+		noDebugMode := false
+		useDefaultApiContainerVersionTag := ""
+		noTtl := (*time.Duration)(nil)
+		noIdleTimeout := (*time.Duration)(nil)
+		noResourceQuota := (*enclave.EnclaveResourceQuota)(nil)
+		noTemplate := ""
+		enclaveInfo, err := scheduler.enclaveManager.CreateEnclave(
+			ctx,
+			scheduler.engineVersion,
+			useDefaultApiContainerVersionTag,
+			scheduledRunApiContainerLogLevel,
+			enclaveName,
+			isProduction,
+			noDebugMode,
+			noTtl,
+			noIdleTimeout,
+			noResourceQuota,
+			schedule.Owner,
+			noTemplate,
+		)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred creating enclave '%v'", enclaveName)
+		}
+		enclaveUuid = enclaveInfo.EnclaveUuid
+	}
+
+	if schedule.ShouldDestroyEnclave {
+		defer func() {
+			// The context may be canceled if the engine is stopping, but the enclave still has to go
+			if err := scheduler.enclaveManager.DestroyEnclave(context.Background(), enclaveUuid); err != nil {
+				logrus.Errorf("An error occurred destroying enclave '%v' after the run of schedule '%v', you'll need to destroy it manually:\n%v", enclaveName, schedule.Uuid, err)
+			}
+		}()
+	}
+
+	if err := scheduler.enclaveManager.RunStarlarkPackage(ctx, enclaveUuid, schedule.PackageId, schedule.SerializedParams); err != nil {
+		return stacktrace.Propagate(err, "An error occurred running package '%v' in enclave '%v'", schedule.PackageId, enclaveName)
+	}
+	return nil
+}
+
+// getEnclaveUuidByName returns an empty string if there's no enclave with that name
+func (scheduler *Scheduler) getEnclaveUuidByName(ctx context.Context, enclaveName string) (string, error) {
+	enclaves, err := scheduler.enclaveManager.GetAllEnclaves(ctx)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the enclaves")
+	}
+	for enclaveUuid, enclaveInfo := range enclaves {
+		if enclaveInfo.Name == enclaveName {
+			return enclaveUuid, nil
+		}
+	}
+	return "", nil
+}
+
+// saveScheduledRun adds the run to the recent runs of the schedule, or replaces it if it's already there. The
+// schedule may have been removed meanwhile, in which case there's nothing to save
+func (scheduler *Scheduler) saveScheduledRun(scheduleUuid string, scheduledRun *ScheduledRun) error {
+	scheduler.mutex.Lock()
+	defer scheduler.mutex.Unlock()
+
+	schedule, err := scheduler.repository.Get(scheduleUuid)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting schedule '%v'", scheduleUuid)
+	}
+	if schedule == nil {
+		return nil
+	}
+
+	isRunAlreadySaved := false
+	for runIdx, recentRun := range schedule.RecentRuns {
+		if recentRun.StartTime.Equal(scheduledRun.StartTime) {
+			schedule.RecentRuns[runIdx] = scheduledRun
+			isRunAlreadySaved = true
+		}
+	}
+	if !isRunAlreadySaved {
+		schedule.RecentRuns = append(schedule.RecentRuns, scheduledRun)
+	}
+	if len(schedule.RecentRuns) > maxRecentRunsPerSchedule {
+		schedule.RecentRuns = schedule.RecentRuns[len(schedule.RecentRuns)-maxRecentRunsPerSchedule:]
+	}
+
+	if err := scheduler.repository.Save(schedule); err != nil {
+		return stacktrace.Propagate(err, "An error occurred saving schedule '%v'", scheduleUuid)
+	}
+	return nil
+}
+
+func (scheduler *Scheduler) failInterruptedRuns() error {
+	scheduler.mutex.Lock()
+	defer scheduler.mutex.Unlock()
+
+	schedules, err := scheduler.repository.GetAll()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the schedules")
+	}
+	for _, schedule := range schedules {
+		hasInterruptedRuns := false
+		for _, recentRun := range schedule.RecentRuns {
+			if recentRun.Status != ScheduledRunStatus_Running {
+				continue
+			}
+			recentRun.Status = ScheduledRunStatus_Failed
+			recentRun.ErrorMessage = interruptedRunErrorMessage
+			hasInterruptedRuns = true
+		}
+		if !hasInterruptedRuns {
+			continue
+		}
+		if err := scheduler.repository.Save(schedule); err != nil {
+			return stacktrace.Propagate(err, "An error occurred saving schedule '%v'", schedule.Uuid)
+		}
+		logrus.Warnf("Marked the runs of schedule '%v' that were in progress when the engine stopped as failed", schedule.Uuid)
+	}
+	return nil
+}
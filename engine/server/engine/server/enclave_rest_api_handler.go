@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"sync"
 
+	"github.com/kurtosis-tech/kurtosis/api/golang/util/api_token_credentials"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/enclave_manager"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/mapping/to_grpc"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/mapping/to_http"
@@ -18,7 +19,7 @@ import (
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	rpc_api "github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
@@ -37,9 +38,14 @@ type enclaveRuntime struct {
 	ctx                      context.Context
 	lock                     sync.Mutex
 	asyncStarlarkLogs        streaming.StreamerPool[*rpc_api.StarlarkRunResponseLine]
+	// Insecure credentials unless the API containers serve TLS
+	apiContainerCredentials credentials.TransportCredentials
+	// The engine's own API token for the API containers, empty if they don't authenticate their callers. The callers of
+	// the REST API are authorized by the engine before their calls get here
+	apiContainerApiToken string
 }
 
-func NewEnclaveRuntime(ctx context.Context, manager enclave_manager.EnclaveManager, asyncStarlarkLogs streaming.StreamerPool[*rpc_api.StarlarkRunResponseLine], connectOnHostMachine bool) (*enclaveRuntime, error) {
+func NewEnclaveRuntime(ctx context.Context, manager enclave_manager.EnclaveManager, asyncStarlarkLogs streaming.StreamerPool[*rpc_api.StarlarkRunResponseLine], connectOnHostMachine bool, apiContainerCredentials credentials.TransportCredentials, apiContainerApiToken string) (*enclaveRuntime, error) {
 
 	runtime := enclaveRuntime{
 		enclaveManager:           manager,
@@ -48,6 +54,8 @@ func NewEnclaveRuntime(ctx context.Context, manager enclave_manager.EnclaveManag
 		ctx:                      ctx,
 		asyncStarlarkLogs:        asyncStarlarkLogs,
 		lock:                     sync.Mutex{},
+		apiContainerCredentials:  apiContainerCredentials,
+		apiContainerApiToken:     apiContainerApiToken,
 	}
 
 	err := runtime.refreshEnclaveConnections()
@@ -722,7 +730,7 @@ func (manager *enclaveRuntime) PostEnclavesEnclaveIdentifierStarlarkScripts(ctx
 
 // GetGrpcClientConn returns a client conn dialed in to the local port
 // It is the caller's responsibility to call resultClientConn.close()
-func getGrpcClientConn(enclaveInfo types.EnclaveInfo, connectOnHostMachine bool) (resultClientConn *grpc.ClientConn, resultErr error) {
+func getGrpcClientConn(enclaveInfo types.EnclaveInfo, connectOnHostMachine bool, apiContainerCredentials credentials.TransportCredentials, apiContainerApiToken string) (resultClientConn *grpc.ClientConn, resultErr error) {
 	enclaveAPIContainerInfo := enclaveInfo.ApiContainerInfo
 	if enclaveAPIContainerInfo == nil {
 		logrus.Infof("No API container info is available for enclave %s", enclaveInfo.EnclaveUuid)
@@ -742,7 +750,11 @@ func getGrpcClientConn(enclaveInfo types.EnclaveInfo, connectOnHostMachine bool)
 	}
 
 	grpcServerAddress := fmt.Sprintf("%v:%v", apiContainerIP, apiContainerGrpcPort)
-	grpcConnection, err := grpc.NewClient(grpcServerAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	dialOpts := []grpc.DialOption{grpc.WithTransportCredentials(apiContainerCredentials)}
+	if apiContainerApiToken != "" {
+		dialOpts = append(dialOpts, grpc.WithPerRPCCredentials(api_token_credentials.NewApiTokenCredentials(apiContainerApiToken)))
+	}
+	grpcConnection, err := grpc.NewClient(grpcServerAddress, dialOpts...)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Expected to be able to create a GRPC client connection on address '%v', but a non-nil error was returned", grpcServerAddress)
 	}
@@ -804,7 +816,7 @@ func (runtime *enclaveRuntime) refreshEnclaveConnections() error {
 	for uuid, info := range enclaves {
 		_, found := runtime.remoteApiContainerClient[uuid]
 		if !found && info != nil {
-			conn, err := getGrpcClientConn(*info, runtime.connectOnHostMachine)
+			conn, err := getGrpcClientConn(*info, runtime.connectOnHostMachine, runtime.apiContainerCredentials, runtime.apiContainerApiToken)
 			if err != nil {
 				return stacktrace.Propagate(err, "Failed to establish gRPC connection with enclave manager service on enclave %s", uuid)
 			}
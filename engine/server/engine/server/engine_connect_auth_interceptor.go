@@ -0,0 +1,84 @@
+package server
+
+import (
+	"context"
+	"net/http"
+
+	"connectrpc.com/connect"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings/kurtosis_engine_rpc_api_bindingsconnect"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/auth"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// Procedures that aren't in here require the admin role, so a new RPC is locked down until it's given a role
+var requiredRolesByEngineProcedure = map[string]args.ApiTokenRole{
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceGetEngineInfoProcedure:                              args.ApiTokenRole_Viewer,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceGetEnclavesProcedure:                                args.ApiTokenRole_Viewer,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceGetEnclavesByUuidsProcedure:                         args.ApiTokenRole_Viewer,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceGetExistingAndHistoricalEnclaveIdentifiersProcedure: args.ApiTokenRole_Viewer,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceGetServiceLogsProcedure:                             args.ApiTokenRole_Viewer,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceWatchEventsProcedure:                                args.ApiTokenRole_Viewer,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceCreateEnclaveProcedure:                              args.ApiTokenRole_Operator,
+	// Stopping, starting, extending, cloning and destroying are further restricted to the enclave's owner by the handlers
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceStopEnclaveProcedure:    args.ApiTokenRole_Operator,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceStartEnclaveProcedure:   args.ApiTokenRole_Operator,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceExtendEnclaveProcedure:  args.ApiTokenRole_Operator,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceCloneEnclaveProcedure:   args.ApiTokenRole_Operator,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceDestroyEnclaveProcedure: args.ApiTokenRole_Operator,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceCleanProcedure:          args.ApiTokenRole_Admin,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceGetSchedulesProcedure:   args.ApiTokenRole_Viewer,
+	// The runs of a schedule act on behalf of the user who added it; removing is further restricted to that user
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceAddScheduleProcedure:    args.ApiTokenRole_Operator,
+	kurtosis_engine_rpc_api_bindingsconnect.EngineServiceRemoveScheduleProcedure: args.ApiTokenRole_Operator,
+}
+
+// engineAuthInterceptor authenticates every call to the engine's gRPC/Connect API and checks the caller's role
+type engineAuthInterceptor struct {
+	authenticator *auth.Authenticator
+}
+
+func NewEngineAuthInterceptor(authenticator *auth.Authenticator) connect.Interceptor {
+	return &engineAuthInterceptor{authenticator: authenticator}
+}
+
+func (interceptor *engineAuthInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, request connect.AnyRequest) (connect.AnyResponse, error) {
+		authenticatedCtx, err := interceptor.authenticate(ctx, request.Spec().Procedure, request.Header())
+		if err != nil {
+			return nil, err
+		}
+		return next(authenticatedCtx, request)
+	}
+}
+
+// The engine server never acts as a client, so there's nothing to do here
+func (interceptor *engineAuthInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (interceptor *engineAuthInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		authenticatedCtx, err := interceptor.authenticate(ctx, conn.Spec().Procedure, conn.RequestHeader())
+		if err != nil {
+			return err
+		}
+		return next(authenticatedCtx, conn)
+	}
+}
+
+func (interceptor *engineAuthInterceptor) authenticate(ctx context.Context, procedure string, headers http.Header) (context.Context, error) {
+	principal, err := interceptor.authenticator.Authenticate(headers)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+
+	requiredRole, found := requiredRolesByEngineProcedure[procedure]
+	if !found {
+		requiredRole = args.ApiTokenRole_Admin
+	}
+	if err := auth.Authorize(principal, requiredRole); err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, stacktrace.Propagate(err, "Calling '%v' isn't allowed", procedure))
+	}
+	return auth.WithPrincipal(ctx, principal), nil
+}
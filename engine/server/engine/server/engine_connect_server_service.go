@@ -7,11 +7,16 @@ import (
 
 	"connectrpc.com/connect"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/compute_resources"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	user_service "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/auth"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/logline"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/enclave_manager"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/events"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/scheduler"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/utils"
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
@@ -41,6 +46,10 @@ type EngineConnectServerService struct {
 	logsDatabaseClient centralized_logs.LogsDatabaseClient
 
 	metricsClient metrics_client.MetricsClient
+
+	eventBus *events.EventBus
+
+	scheduler *scheduler.Scheduler
 }
 
 func NewEngineConnectServerService(
@@ -50,6 +59,8 @@ func NewEngineConnectServerService(
 	didUserAcceptSendingMetrics bool,
 	logsDatabaseClient centralized_logs.LogsDatabaseClient,
 	metricsClient metrics_client.MetricsClient,
+	eventBus *events.EventBus,
+	scheduler *scheduler.Scheduler,
 ) *EngineConnectServerService {
 	service := &EngineConnectServerService{
 		imageVersionTag:             imageVersionTag,
@@ -58,6 +69,8 @@ func NewEngineConnectServerService(
 		didUserAcceptSendingMetrics: didUserAcceptSendingMetrics,
 		logsDatabaseClient:          logsDatabaseClient,
 		metricsClient:               metricsClient,
+		eventBus:                    eventBus,
+		scheduler:                   scheduler,
 	}
 	return service
 }
@@ -108,6 +121,13 @@ func toGrpcTimestamp(timestamp time.Time) *timestamppb.Timestamp {
 	return timestamppb.New(timestamp)
 }
 
+func toGrpcOptionalTimestamp(timestamp *time.Time) *timestamppb.Timestamp {
+	if timestamp == nil {
+		return nil
+	}
+	return timestamppb.New(*timestamp)
+}
+
 func toGrpcEnclaveMode(mode types.EnclaveMode) kurtosis_engine_rpc_api_bindings.EnclaveMode {
 	switch mode {
 	case types.EnclaveMode_PRODUCTION:
@@ -132,6 +152,9 @@ func toGrpcEnclaveInfo(info types.EnclaveInfo) kurtosis_engine_rpc_api_bindings.
 		ApiContainerHostMachineInfo: apiHostMachine,
 		CreationTime:                toGrpcTimestamp(info.CreationTime),
 		Mode:                        toGrpcEnclaveMode(info.Mode),
+		ExpirationTime:              toGrpcOptionalTimestamp(info.ExpirationTime),
+		IdleExpirationTime:          toGrpcOptionalTimestamp(info.IdleExpirationTime),
+		Owner:                       info.Owner,
 	}
 }
 
@@ -150,6 +173,136 @@ func toGrpcEnclaveNameAndUuid(identifier types.EnclaveNameAndUuid) kurtosis_engi
 	}
 }
 
+func toGrpcEngineEventType(eventType types.EngineEventType) kurtosis_engine_rpc_api_bindings.EngineEventType {
+	switch eventType {
+	case types.EngineEventType_ENCLAVE_CREATED:
+		return kurtosis_engine_rpc_api_bindings.EngineEventType_EngineEventType_ENCLAVE_CREATED
+	case types.EngineEventType_ENCLAVE_STOPPED:
+		return kurtosis_engine_rpc_api_bindings.EngineEventType_EngineEventType_ENCLAVE_STOPPED
+	case types.EngineEventType_ENCLAVE_DESTROYED:
+		return kurtosis_engine_rpc_api_bindings.EngineEventType_EngineEventType_ENCLAVE_DESTROYED
+	case types.EngineEventType_SERVICE_ADDED:
+		return kurtosis_engine_rpc_api_bindings.EngineEventType_EngineEventType_SERVICE_ADDED
+	case types.EngineEventType_SERVICE_STARTED:
+		return kurtosis_engine_rpc_api_bindings.EngineEventType_EngineEventType_SERVICE_STARTED
+	case types.EngineEventType_SERVICE_STOPPED:
+		return kurtosis_engine_rpc_api_bindings.EngineEventType_EngineEventType_SERVICE_STOPPED
+	case types.EngineEventType_SERVICE_CRASHED:
+		return kurtosis_engine_rpc_api_bindings.EngineEventType_EngineEventType_SERVICE_CRASHED
+	case types.EngineEventType_STARLARK_RUN_STARTED:
+		return kurtosis_engine_rpc_api_bindings.EngineEventType_EngineEventType_STARLARK_RUN_STARTED
+	case types.EngineEventType_STARLARK_RUN_FINISHED:
+		return kurtosis_engine_rpc_api_bindings.EngineEventType_EngineEventType_STARLARK_RUN_FINISHED
+	case types.EngineEventType_FILES_ARTIFACT_CREATED:
+		return kurtosis_engine_rpc_api_bindings.EngineEventType_EngineEventType_FILES_ARTIFACT_CREATED
+	default:
+		panic(fmt.Sprintf("Undefined mapping of value: %s", eventType))
+	}
+}
+
+func fromGrpcEngineEventType(eventType kurtosis_engine_rpc_api_bindings.EngineEventType) (types.EngineEventType, error) {
+	switch eventType {
+	case kurtosis_engine_rpc_api_bindings.EngineEventType_EngineEventType_ENCLAVE_CREATED:
+		return types.EngineEventType_ENCLAVE_CREATED, nil
+	case kurtosis_engine_rpc_api_bindings.EngineEventType_EngineEventType_ENCLAVE_STOPPED:
+		return types.EngineEventType_ENCLAVE_STOPPED, nil
+	case kurtosis_engine_rpc_api_bindings.EngineEventType_EngineEventType_ENCLAVE_DESTROYED:
+		return types.EngineEventType_ENCLAVE_DESTROYED, nil
+	case kurtosis_engine_rpc_api_bindings.EngineEventType_EngineEventType_SERVICE_ADDED:
+		return types.EngineEventType_SERVICE_ADDED, nil
+	case kurtosis_engine_rpc_api_bindings.EngineEventType_EngineEventType_SERVICE_STARTED:
+		return types.EngineEventType_SERVICE_STARTED, nil
+	case kurtosis_engine_rpc_api_bindings.EngineEventType_EngineEventType_SERVICE_STOPPED:
+		return types.EngineEventType_SERVICE_STOPPED, nil
+	case kurtosis_engine_rpc_api_bindings.EngineEventType_EngineEventType_SERVICE_CRASHED:
+		return types.EngineEventType_SERVICE_CRASHED, nil
+	case kurtosis_engine_rpc_api_bindings.EngineEventType_EngineEventType_STARLARK_RUN_STARTED:
+		return types.EngineEventType_STARLARK_RUN_STARTED, nil
+	case kurtosis_engine_rpc_api_bindings.EngineEventType_EngineEventType_STARLARK_RUN_FINISHED:
+		return types.EngineEventType_STARLARK_RUN_FINISHED, nil
+	case kurtosis_engine_rpc_api_bindings.EngineEventType_EngineEventType_FILES_ARTIFACT_CREATED:
+		return types.EngineEventType_FILES_ARTIFACT_CREATED, nil
+	default:
+		return "", stacktrace.NewError("Unrecognized engine event type '%v'", eventType)
+	}
+}
+
+func toGrpcEngineEvent(event *types.EngineEvent) *kurtosis_engine_rpc_api_bindings.EngineEvent {
+	return &kurtosis_engine_rpc_api_bindings.EngineEvent{
+		Type:              toGrpcEngineEventType(event.Type),
+		Timestamp:         toGrpcTimestamp(event.Timestamp),
+		EnclaveUuid:       event.EnclaveUuid,
+		EnclaveName:       event.EnclaveName,
+		ServiceName:       event.ServiceName,
+		ServiceUuid:       event.ServiceUuid,
+		FilesArtifactName: event.FilesArtifactName,
+		FilesArtifactUuid: event.FilesArtifactUuid,
+		IsRunSuccessful:   event.IsRunSuccessful,
+	}
+}
+
+func toGrpcScheduleEnclaveMode(enclaveMode scheduler.EnclaveMode) kurtosis_engine_rpc_api_bindings.ScheduleEnclaveMode {
+	switch enclaveMode {
+	case scheduler.EnclaveMode_Fresh:
+		return kurtosis_engine_rpc_api_bindings.ScheduleEnclaveMode_ScheduleEnclaveMode_FRESH
+	case scheduler.EnclaveMode_Reuse:
+		return kurtosis_engine_rpc_api_bindings.ScheduleEnclaveMode_ScheduleEnclaveMode_REUSE
+	default:
+		panic(fmt.Sprintf("Undefined mapping of value: %s", enclaveMode))
+	}
+}
+
+func fromGrpcScheduleEnclaveMode(enclaveMode kurtosis_engine_rpc_api_bindings.ScheduleEnclaveMode) (scheduler.EnclaveMode, error) {
+	switch enclaveMode {
+	case kurtosis_engine_rpc_api_bindings.ScheduleEnclaveMode_ScheduleEnclaveMode_FRESH:
+		return scheduler.EnclaveMode_Fresh, nil
+	case kurtosis_engine_rpc_api_bindings.ScheduleEnclaveMode_ScheduleEnclaveMode_REUSE:
+		return scheduler.EnclaveMode_Reuse, nil
+	default:
+		return "", stacktrace.NewError("Unrecognized schedule enclave mode '%v'", enclaveMode)
+	}
+}
+
+func toGrpcScheduledRunStatus(status scheduler.ScheduledRunStatus) kurtosis_engine_rpc_api_bindings.ScheduledRunStatus {
+	switch status {
+	case scheduler.ScheduledRunStatus_Running:
+		return kurtosis_engine_rpc_api_bindings.ScheduledRunStatus_ScheduledRunStatus_RUNNING
+	case scheduler.ScheduledRunStatus_Succeeded:
+		return kurtosis_engine_rpc_api_bindings.ScheduledRunStatus_ScheduledRunStatus_SUCCEEDED
+	case scheduler.ScheduledRunStatus_Failed:
+		return kurtosis_engine_rpc_api_bindings.ScheduledRunStatus_ScheduledRunStatus_FAILED
+	default:
+		panic(fmt.Sprintf("Undefined mapping of value: %s", status))
+	}
+}
+
+func toGrpcSchedule(schedule *scheduler.Schedule, now time.Time) *kurtosis_engine_rpc_api_bindings.Schedule {
+	grpcRecentRuns := []*kurtosis_engine_rpc_api_bindings.ScheduledRun{}
+	for _, recentRun := range schedule.RecentRuns {
+		grpcRecentRuns = append(grpcRecentRuns, &kurtosis_engine_rpc_api_bindings.ScheduledRun{
+			StartTime:    toGrpcTimestamp(recentRun.StartTime),
+			EndTime:      toGrpcOptionalTimestamp(recentRun.EndTime),
+			EnclaveName:  recentRun.EnclaveName,
+			Status:       toGrpcScheduledRunStatus(recentRun.Status),
+			ErrorMessage: recentRun.ErrorMessage,
+		})
+	}
+	return &kurtosis_engine_rpc_api_bindings.Schedule{
+		ScheduleUuid:         schedule.Uuid,
+		ShortenedUuid:        uuid_generator.ShortenedUUIDString(schedule.Uuid),
+		CronExpression:       schedule.CronExpression,
+		PackageId:            schedule.PackageId,
+		SerializedParams:     schedule.SerializedParams,
+		EnclaveMode:          toGrpcScheduleEnclaveMode(schedule.EnclaveMode),
+		EnclaveName:          schedule.EnclaveName,
+		ShouldDestroyEnclave: schedule.ShouldDestroyEnclave,
+		Owner:                schedule.Owner,
+		CreationTime:         toGrpcTimestamp(schedule.CreationTime),
+		NextRunTime:          toGrpcOptionalTimestamp(scheduler.GetNextRunTime(schedule, now)),
+		RecentRuns:           grpcRecentRuns,
+	}
+}
+
 func (service *EngineConnectServerService) GetEngineInfo(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEngineInfoResponse], error) {
 	result := &kurtosis_engine_rpc_api_bindings.GetEngineInfoResponse{
 		EngineVersion: service.imageVersionTag,
@@ -172,6 +325,32 @@ func (service *EngineConnectServerService) CreateEnclave(ctx context.Context, co
 
 	isProduction := args.GetMode() == kurtosis_engine_rpc_api_bindings.EnclaveMode_PRODUCTION
 
+	var ttl *time.Duration
+	if args.Ttl != nil {
+		ttlDuration := args.GetTtl().AsDuration()
+		if ttlDuration <= 0 {
+			return nil, stacktrace.NewError("The enclave TTL must be positive but was '%v'", ttlDuration)
+		}
+		ttl = &ttlDuration
+	}
+	var idleTimeout *time.Duration
+	if args.IdleTimeout != nil {
+		idleTimeoutDuration := args.GetIdleTimeout().AsDuration()
+		if idleTimeoutDuration <= 0 {
+			return nil, stacktrace.NewError("The enclave idle timeout must be positive but was '%v'", idleTimeoutDuration)
+		}
+		idleTimeout = &idleTimeoutDuration
+	}
+	var resourceQuota *enclave.EnclaveResourceQuota
+	if args.ResourceQuota != nil {
+		resourceQuota = enclave.NewEnclaveResourceQuota(
+			compute_resources.CpuMilliCores(args.GetResourceQuota().GetCpuMillicores()),
+			compute_resources.MemoryInMegaBytes(args.GetResourceQuota().GetMemoryMegabytes()),
+			args.GetResourceQuota().GetMaxServices(),
+			args.GetResourceQuota().GetDiskMegabytes(),
+		)
+	}
+
 	enclaveInfo, err := service.enclaveManager.CreateEnclave(
 		ctx,
 		service.imageVersionTag,
@@ -180,6 +359,11 @@ func (service *EngineConnectServerService) CreateEnclave(ctx context.Context, co
 		args.GetEnclaveName(),
 		isProduction,
 		args.GetShouldApicRunInDebugMode(),
+		ttl,
+		idleTimeout,
+		resourceQuota,
+		auth.GetUser(ctx),
+		args.GetTemplateName(),
 	)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating new enclave with name '%v'", args.GetEnclaveName())
@@ -258,6 +442,10 @@ func (service *EngineConnectServerService) StopEnclave(ctx context.Context, conn
 		logrus.Warnf("An error occurred while logging the stop enclave event for enclave '%v'", enclaveIdentifier)
 	}
 
+	if err := service.authorizeEnclaveOwnerAction(ctx, enclaveIdentifier); err != nil {
+		return nil, err
+	}
+
 	if err := service.enclaveManager.StopEnclave(ctx, enclaveIdentifier); err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred stopping enclave '%v'", enclaveIdentifier)
 	}
@@ -265,6 +453,67 @@ func (service *EngineConnectServerService) StopEnclave(ctx context.Context, conn
 	return connect.NewResponse(&emptypb.Empty{}), nil
 }
 
+func (service *EngineConnectServerService) StartEnclave(ctx context.Context, connectArgs *connect.Request[kurtosis_engine_rpc_api_bindings.StartEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.StartEnclaveResponse], error) {
+	args := connectArgs.Msg
+	enclaveIdentifier := args.EnclaveIdentifier
+
+	if err := service.authorizeEnclaveOwnerAction(ctx, enclaveIdentifier); err != nil {
+		return nil, err
+	}
+
+	enclaveInfo, err := service.enclaveManager.StartEnclave(ctx, enclaveIdentifier)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred starting enclave '%v'", enclaveIdentifier)
+	}
+
+	grpcEnclaveInfo := toGrpcEnclaveInfo(*enclaveInfo)
+	response := &kurtosis_engine_rpc_api_bindings.StartEnclaveResponse{
+		EnclaveInfo: &grpcEnclaveInfo,
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (service *EngineConnectServerService) ExtendEnclave(ctx context.Context, connectArgs *connect.Request[kurtosis_engine_rpc_api_bindings.ExtendEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.ExtendEnclaveResponse], error) {
+	args := connectArgs.Msg
+	enclaveIdentifier := args.EnclaveIdentifier
+
+	if err := service.authorizeEnclaveOwnerAction(ctx, enclaveIdentifier); err != nil {
+		return nil, err
+	}
+
+	enclaveInfo, err := service.enclaveManager.ExtendEnclave(ctx, enclaveIdentifier, args.GetDuration().AsDuration())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred extending enclave '%v'", enclaveIdentifier)
+	}
+
+	grpcEnclaveInfo := toGrpcEnclaveInfo(*enclaveInfo)
+	response := &kurtosis_engine_rpc_api_bindings.ExtendEnclaveResponse{
+		EnclaveInfo: &grpcEnclaveInfo,
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (service *EngineConnectServerService) CloneEnclave(ctx context.Context, connectArgs *connect.Request[kurtosis_engine_rpc_api_bindings.CloneEnclaveArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.CloneEnclaveResponse], error) {
+	args := connectArgs.Msg
+	sourceEnclaveIdentifier := args.GetSourceEnclaveIdentifier()
+
+	// The clone holds a copy of all the data of the source enclave
+	if err := service.authorizeEnclaveOwnerAction(ctx, sourceEnclaveIdentifier); err != nil {
+		return nil, err
+	}
+
+	enclaveInfo, err := service.enclaveManager.CloneEnclave(ctx, service.imageVersionTag, sourceEnclaveIdentifier, args.GetDestinationEnclaveName(), auth.GetUser(ctx))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred cloning enclave '%v' into enclave '%v'", sourceEnclaveIdentifier, args.GetDestinationEnclaveName())
+	}
+
+	grpcEnclaveInfo := toGrpcEnclaveInfo(*enclaveInfo)
+	response := &kurtosis_engine_rpc_api_bindings.CloneEnclaveResponse{
+		EnclaveInfo: &grpcEnclaveInfo,
+	}
+	return connect.NewResponse(response), nil
+}
+
 func (service *EngineConnectServerService) DestroyEnclave(ctx context.Context, connectArgs *connect.Request[kurtosis_engine_rpc_api_bindings.DestroyEnclaveArgs]) (*connect.Response[emptypb.Empty], error) {
 	args := connectArgs.Msg
 	enclaveIdentifier := args.EnclaveIdentifier
@@ -273,6 +522,10 @@ func (service *EngineConnectServerService) DestroyEnclave(ctx context.Context, c
 		logrus.Warnf("An error occurred while logging the destroy enclave event for enclave '%v'", enclaveIdentifier)
 	}
 
+	if err := service.authorizeEnclaveOwnerAction(ctx, enclaveIdentifier); err != nil {
+		return nil, err
+	}
+
 	if err := service.enclaveManager.DestroyEnclave(ctx, enclaveIdentifier); err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred destroying enclave with identifier '%v':", args.EnclaveIdentifier)
 	}
@@ -400,7 +653,121 @@ func (service *EngineConnectServerService) GetServiceLogs(ctx context.Context, c
 	}
 }
 
+func (service *EngineConnectServerService) WatchEvents(ctx context.Context, connectArgs *connect.Request[kurtosis_engine_rpc_api_bindings.WatchEventsArgs], stream *connect.ServerStream[kurtosis_engine_rpc_api_bindings.EngineEvent]) error {
+	args := connectArgs.Msg
+	enclaveUuid := ""
+	if args.EnclaveIdentifier != nil {
+		foundEnclaveUuid, err := service.enclaveManager.GetEnclaveUuidForEnclaveIdentifier(ctx, args.GetEnclaveIdentifier())
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the UUID of enclave '%v'", args.GetEnclaveIdentifier())
+		}
+		enclaveUuid = string(foundEnclaveUuid)
+	}
+	eventTypes := []types.EngineEventType{}
+	for _, grpcEventType := range args.GetEventTypes() {
+		eventType, err := fromGrpcEngineEventType(grpcEventType)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred reading the event types to watch")
+		}
+		eventTypes = append(eventTypes, eventType)
+	}
+	filter, err := events.NewEventFilter(enclaveUuid, eventTypes)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the filter of the events to watch")
+	}
+
+	engineEvents, unsubscribe := service.eventBus.Subscribe()
+	defer unsubscribe()
+	for {
+		select {
+		case <-ctx.Done():
+			logrus.Debug("The engine events stream has done")
+			return nil
+		case event, isChanOpen := <-engineEvents:
+			if !isChanOpen {
+				return nil
+			}
+			if !filter.IsPassing(event) {
+				continue
+			}
+			if err := stream.Send(toGrpcEngineEvent(event)); err != nil {
+				return stacktrace.Propagate(err, "An error occurred sending event '%v' of enclave '%v'", event.Type, event.EnclaveUuid)
+			}
+		}
+	}
+}
+
+func (service *EngineConnectServerService) AddSchedule(ctx context.Context, connectArgs *connect.Request[kurtosis_engine_rpc_api_bindings.AddScheduleArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.AddScheduleResponse], error) {
+	args := connectArgs.Msg
+	enclaveMode, err := fromGrpcScheduleEnclaveMode(args.GetEnclaveMode())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the enclave mode of the schedule")
+	}
+
+	// The runs would act on an existing enclave, so it has to be one the user may act on
+	if enclaveMode == scheduler.EnclaveMode_Reuse {
+		if _, err := service.enclaveManager.GetEnclaveUuidForEnclaveIdentifier(ctx, args.GetEnclaveName()); err == nil {
+			if err := service.authorizeEnclaveOwnerAction(ctx, args.GetEnclaveName()); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	schedule, err := service.scheduler.AddSchedule(
+		args.GetCronExpression(),
+		args.GetPackageId(),
+		args.GetSerializedParams(),
+		enclaveMode,
+		args.GetEnclaveName(),
+		args.GetShouldDestroyEnclave(),
+		auth.GetUser(ctx),
+	)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred adding a schedule for package '%v'", args.GetPackageId())
+	}
+
+	response := &kurtosis_engine_rpc_api_bindings.AddScheduleResponse{
+		Schedule: toGrpcSchedule(schedule, time.Now()),
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (service *EngineConnectServerService) GetSchedules(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetSchedulesResponse], error) {
+	schedules, err := service.scheduler.GetSchedules()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the schedules")
+	}
+
+	now := time.Now()
+	grpcSchedules := []*kurtosis_engine_rpc_api_bindings.Schedule{}
+	for _, schedule := range schedules {
+		grpcSchedules = append(grpcSchedules, toGrpcSchedule(schedule, now))
+	}
+	response := &kurtosis_engine_rpc_api_bindings.GetSchedulesResponse{
+		Schedules: grpcSchedules,
+	}
+	return connect.NewResponse(response), nil
+}
+
+func (service *EngineConnectServerService) RemoveSchedule(ctx context.Context, connectArgs *connect.Request[kurtosis_engine_rpc_api_bindings.RemoveScheduleArgs]) (*connect.Response[emptypb.Empty], error) {
+	scheduleIdentifier := connectArgs.Msg.GetScheduleIdentifier()
+	schedule, err := service.scheduler.GetSchedule(scheduleIdentifier)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting schedule '%v'", scheduleIdentifier)
+	}
+
+	if err := auth.AuthorizeScheduleOwnerAction(auth.GetPrincipal(ctx), schedule.Uuid, schedule.Owner); err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	if err := service.scheduler.RemoveSchedule(schedule.Uuid); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred removing schedule '%v'", scheduleIdentifier)
+	}
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
 func (service *EngineConnectServerService) Close() error {
+	service.scheduler.Close()
 	if err := service.enclaveManager.Close(); err != nil {
 		return stacktrace.Propagate(err, "An error occurred closing the enclave manager")
 	}
@@ -508,6 +875,22 @@ func getNotFoundServiceUuidsAndEmptyServiceLogsMap(
 	return notFoundServiceUuids
 }
 
+// authorizeEnclaveOwnerAction returns an error if the user the request was authenticated as may not act on the enclave
+func (service *EngineConnectServerService) authorizeEnclaveOwnerAction(ctx context.Context, enclaveIdentifier string) error {
+	principal := auth.GetPrincipal(ctx)
+	if principal == nil {
+		return nil
+	}
+	enclaveOwner, err := service.enclaveManager.GetEnclaveOwner(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the owner of enclave '%v'", enclaveIdentifier)
+	}
+	if err := auth.AuthorizeEnclaveOwnerAction(principal, enclaveIdentifier, enclaveOwner); err != nil {
+		return connect.NewError(connect.CodePermissionDenied, err)
+	}
+	return nil
+}
+
 func newConjunctiveLogLineFiltersFromGRPCLogLineFilters(
 	grpcLogLineFilters []*kurtosis_engine_rpc_api_bindings.LogLineFilter,
 ) (logline.ConjunctiveLogLineFilters, error) {
@@ -4,7 +4,10 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/auth"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs"
 
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/enclave_manager"
@@ -98,6 +101,12 @@ func (engine EngineRuntime) PostEnclaves(ctx context.Context, request api.PostEn
 
 	isProduction := enclaveMode == api_type.PRODUCTION
 
+	// The REST API doesn't expose enclave lifetimes, resource quotas or templates yet, so enclaves created through it
+	// never expire, aren't capped and start empty
+	var noEnclaveTtl, noEnclaveIdleTimeout *time.Duration
+	var noEnclaveResourceQuota *enclave.EnclaveResourceQuota
+	noEnclaveTemplate := ""
+
 	enclaveInfo, err := engine.EnclaveManager.CreateEnclave(
 		ctx,
 		engine.ImageVersionTag,
@@ -106,6 +115,11 @@ func (engine EngineRuntime) PostEnclaves(ctx context.Context, request api.PostEn
 		enclaveName,
 		isProduction,
 		bool(shouldApicRunInDebugMode),
+		noEnclaveTtl,
+		noEnclaveIdleTimeout,
+		noEnclaveResourceQuota,
+		auth.GetUser(ctx),
+		noEnclaveTemplate,
 	)
 	if err != nil {
 		response := internalErrorResponseInfof(err, "An error occurred creating new enclave with name '%v'", request.Body.EnclaveName)
@@ -0,0 +1,90 @@
+package server
+
+import (
+	"net/http"
+	"strings"
+
+	api_type "github.com/kurtosis-tech/kurtosis/api/golang/http_rest/api_types"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/auth"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/enclave_manager"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	enclaveIdentifierPathParam = "enclave_identifier"
+
+	enclavesRoutePathSuffix      = "/enclaves"
+	enclaveRoutePathSuffix       = "/enclaves/:" + enclaveIdentifierPathParam
+	enclaveStatusRoutePathSuffix = enclaveRoutePathSuffix + "/status"
+)
+
+// NewRestApiAuthMiddleware authenticates every call to the engine's REST API and checks the caller's role, applying
+// the same rules as the Connect API: reads need a viewer, writes an operator and cleaning the engine an admin
+func NewRestApiAuthMiddleware(authenticator *auth.Authenticator, enclaveManager *enclave_manager.EnclaveManager) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			request := ctx.Request()
+			// CORS preflight requests never carry credentials
+			if request.Method == http.MethodOptions {
+				return next(ctx)
+			}
+
+			principal, err := authenticator.Authenticate(request.Header)
+			if err != nil {
+				writeAuthErrorResponseInfo(ctx, http.StatusUnauthorized, err)
+				return nil
+			}
+
+			routePath := ctx.Path()
+			if err := auth.Authorize(principal, getRequiredRoleForRestRoute(request.Method, routePath)); err != nil {
+				writeAuthErrorResponseInfo(ctx, http.StatusForbidden, err)
+				return nil
+			}
+
+			// Admins may act on any enclave, so the owner isn't looked up for them
+			if isEnclaveOwnerRestRoute(request.Method, routePath) && principal != nil && !principal.GetRole().Includes(args.ApiTokenRole_Admin) {
+				enclaveIdentifier := ctx.Param(enclaveIdentifierPathParam)
+				enclaveOwner, err := enclaveManager.GetEnclaveOwner(request.Context(), enclaveIdentifier)
+				if err != nil {
+					// The request is refused if the owner can't be checked, whatever the reason
+					writeAuthErrorResponseInfo(ctx, http.StatusForbidden, stacktrace.Propagate(err, "An error occurred getting the owner of enclave '%v' to authorize the request", enclaveIdentifier))
+					return nil
+				}
+				if err := auth.AuthorizeEnclaveOwnerAction(principal, enclaveIdentifier, enclaveOwner); err != nil {
+					writeAuthErrorResponseInfo(ctx, http.StatusForbidden, err)
+					return nil
+				}
+			}
+
+			ctx.SetRequest(request.WithContext(auth.WithPrincipal(request.Context(), principal)))
+			return next(ctx)
+		}
+	}
+}
+
+func getRequiredRoleForRestRoute(method string, routePath string) args.ApiTokenRole {
+	switch {
+	case method == http.MethodGet:
+		return args.ApiTokenRole_Viewer
+	case method == http.MethodDelete && strings.HasSuffix(routePath, enclavesRoutePathSuffix):
+		return args.ApiTokenRole_Admin
+	default:
+		return args.ApiTokenRole_Operator
+	}
+}
+
+// Stopping, starting and destroying an enclave are restricted to the enclave's owner
+func isEnclaveOwnerRestRoute(method string, routePath string) bool {
+	return (method == http.MethodDelete && strings.HasSuffix(routePath, enclaveRoutePathSuffix)) ||
+		(method == http.MethodPost && strings.HasSuffix(routePath, enclaveStatusRoutePathSuffix))
+}
+
+func writeAuthErrorResponseInfo(ctx echo.Context, statusCode int, err error) {
+	writeResponseInfo(ctx, api_type.ResponseInfo{
+		Code:    uint32(statusCode),
+		Type:    api_type.ERROR,
+		Message: err.Error(),
+	})
+}
@@ -8,8 +8,10 @@ import (
 	user_service "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/enclave_manager"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/events"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/mapping/to_http"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/streaming"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/types"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/utils"
 	"github.com/kurtosis-tech/kurtosis/metrics-library/golang/lib/metrics_client"
 	"github.com/kurtosis-tech/stacktrace"
@@ -43,6 +45,9 @@ type WebSocketRuntime struct {
 
 	// Allow CORS origins for websocket
 	CorsConfig cors.Cors
+
+	// Events of the engine and its enclaves, streamed to the event watchers
+	EventBus *events.EventBus
 }
 
 func (engine WebSocketRuntime) GetEnclavesEnclaveIdentifierLogs(ctx echo.Context, enclaveIdentifier api_type.EnclaveIdentifier, params api_type.GetEnclavesEnclaveIdentifierLogsParams) error {
@@ -137,6 +142,50 @@ func (engine WebSocketRuntime) GetStarlarkExecutionsStarlarkExecutionUuidLogs(ct
 	return nil
 }
 
+// (GET /events)
+func (engine WebSocketRuntime) GetEvents(ctx echo.Context, params api_type.GetEventsParams) error {
+	enclaveUuid := ""
+	if params.EnclaveIdentifier != nil {
+		foundEnclaveUuid, err := engine.EnclaveManager.GetEnclaveUuidForEnclaveIdentifier(ctx.Request().Context(), *params.EnclaveIdentifier)
+		if err != nil {
+			logrus.WithError(err).WithFields(logrus.Fields{
+				"enclave_identifier": *params.EnclaveIdentifier,
+			}).Warn("Failed to find the enclave to watch the events of")
+			writeResponseInfo(ctx, api_type.ResponseInfo{
+				Code:    http.StatusNotFound,
+				Message: fmt.Sprintf("Enclave '%s' not found", *params.EnclaveIdentifier),
+				Type:    api_type.INFO,
+			})
+			return nil
+		}
+		enclaveUuid = string(foundEnclaveUuid)
+	}
+	eventTypes := utils.MapList(utils.DerefWith(params.EventTypes, []api_type.EngineEventType{}), func(eventType api_type.EngineEventType) types.EngineEventType {
+		return types.EngineEventType(eventType)
+	})
+	filter, err := events.NewEventFilter(enclaveUuid, eventTypes)
+	if err != nil {
+		writeResponseInfo(ctx, api_type.ResponseInfo{
+			Code:    http.StatusBadRequest,
+			Message: fmt.Sprintf("Invalid event types: %v", err.Error()),
+			Type:    api_type.ERROR,
+		})
+		return nil
+	}
+
+	engineEvents, unsubscribe := engine.EventBus.Subscribe()
+	defer unsubscribe()
+
+	if ctx.IsWebSocket() {
+		logrus.Infof("Starting events stream using Websocket")
+		streamEventsWithWebsocket(ctx, engine.CorsConfig, engineEvents, unsubscribe, filter)
+	} else {
+		logrus.Infof("Starting events stream using plain HTTP")
+		streamEventsWithHTTP(ctx, engineEvents, filter)
+	}
+	return nil
+}
+
 // =============================================================================================================================================
 // ============================================== Helper Functions =============================================================================
 // =============================================================================================================================================
@@ -300,3 +349,55 @@ func streamServiceLogsWithHTTP(ctx echo.Context, streamer streaming.ServiceLogSt
 		}
 	}
 }
+
+func streamEventsWithWebsocket(ctx echo.Context, cors cors.Cors, engineEvents <-chan *types.EngineEvent, unsubscribe func(), filter *events.EventFilter) {
+	wsPump, err := streaming.NewWebsocketPump[api_type.EngineEvent](ctx, cors)
+	if err != nil {
+		logrus.WithError(err).Error("Failed to start websocket connection")
+		writeResponseInfo(ctx, api_type.ResponseInfo{
+			Type:    api_type.ERROR,
+			Message: "Failed to start websocket connection",
+			Code:    http.StatusInternalServerError,
+		})
+		return
+	}
+	defer wsPump.Close()
+	// Closes the events channel once the client goes away
+	wsPump.OnClose(unsubscribe)
+
+	for event := range engineEvents {
+		if !filter.IsPassing(event) {
+			continue
+		}
+		response := to_http.ToHttpEngineEvent(event)
+		if err := wsPump.PumpMessage(&response); err != nil {
+			logrus.WithError(err).Warn("Failed to stream all events")
+			return
+		}
+	}
+}
+
+func streamEventsWithHTTP(ctx echo.Context, engineEvents <-chan *types.EngineEvent, filter *events.EventFilter) {
+	ctx.Response().Header().Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	enc := json.NewEncoder(ctx.Response())
+	ctx.Response().WriteHeader(http.StatusOK)
+	ctx.Response().Flush()
+	for {
+		select {
+		case <-ctx.Request().Context().Done():
+			return
+		case event, isChanOpen := <-engineEvents:
+			if !isChanOpen {
+				return
+			}
+			if !filter.IsPassing(event) {
+				continue
+			}
+			if err := enc.Encode(to_http.ToHttpEngineEvent(event)); err != nil {
+				logrus.WithError(err).Warn("Failed to stream all events")
+				return
+			}
+			ctx.Response().Flush()
+		}
+	}
+}
@@ -30,6 +30,22 @@ const (
 	EnclaveMode_TEST       EnclaveMode = "TEST"
 )
 
+// Defines values for EngineEventType.
+type EngineEventType string
+
+const (
+	EngineEventType_ENCLAVE_CREATED        EngineEventType = "ENCLAVE_CREATED"
+	EngineEventType_ENCLAVE_STOPPED        EngineEventType = "ENCLAVE_STOPPED"
+	EngineEventType_ENCLAVE_DESTROYED      EngineEventType = "ENCLAVE_DESTROYED"
+	EngineEventType_SERVICE_ADDED          EngineEventType = "SERVICE_ADDED"
+	EngineEventType_SERVICE_STARTED        EngineEventType = "SERVICE_STARTED"
+	EngineEventType_SERVICE_STOPPED        EngineEventType = "SERVICE_STOPPED"
+	EngineEventType_SERVICE_CRASHED        EngineEventType = "SERVICE_CRASHED"
+	EngineEventType_STARLARK_RUN_STARTED   EngineEventType = "STARLARK_RUN_STARTED"
+	EngineEventType_STARLARK_RUN_FINISHED  EngineEventType = "STARLARK_RUN_FINISHED"
+	EngineEventType_FILES_ARTIFACT_CREATED EngineEventType = "FILES_ARTIFACT_CREATED"
+)
+
 // CreateEnclaveArgs defines model for CreateEnclaveArgs.
 type CreateEnclaveArgs struct {
 	ApiContainerLogLevel   string
@@ -70,6 +86,9 @@ type EnclaveInfo struct {
 	Mode                        EnclaveMode
 	Name                        string
 	ShortenedUuid               string
+	ExpirationTime              *Timestamp
+	IdleExpirationTime          *Timestamp
+	Owner                       string
 }
 
 // EnclaveNameAndUuid defines model for EnclaveNameAndUuid.
@@ -82,6 +101,19 @@ type GetEnclavesResponse struct {
 	EnclaveInfo *map[string]EnclaveInfo
 }
 
+// EngineEvent defines model for EngineEvent. Only the fields relevant to the event type are set
+type EngineEvent struct {
+	Type              EngineEventType
+	Timestamp         Timestamp
+	EnclaveUuid       string
+	EnclaveName       string
+	ServiceName       *string
+	ServiceUuid       *string
+	FilesArtifactName *string
+	FilesArtifactUuid *string
+	IsRunSuccessful   *bool
+}
+
 // GetEngineInfoResponse defines model for GetEngineInfoResponse.
 type EngineVersion struct {
 	EngineVersion string
@@ -0,0 +1,115 @@
+package path_compression
+
+import (
+	"archive/tar"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"io/fs"
+	"os"
+
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/mholt/archives"
+)
+
+const (
+	// BlobSha256PaxRecordKey is set on an empty archive entry standing for a regular file whose content was left out
+	// of the archive because the receiver already stores it. The value is the hex encoded SHA-256 of the content
+	BlobSha256PaxRecordKey = "KURTOSIS.blob.sha256"
+)
+
+// ComputeFileContentSha256s returns the hex encoded SHA-256 of the content of every regular file that compressing
+// pathToHash would put in the archive
+func ComputeFileContentSha256s(pathToHash string) ([]string, error) {
+	files, err := getFilesToArchive(context.Background(), pathToHash)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred listing the files to hash in '%s'", pathToHash)
+	}
+	var fileContentSha256s []string
+	for _, file := range files {
+		if !file.Mode().IsRegular() {
+			continue
+		}
+		fileContentSha256, err := computeFileContentSha256(file)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred hashing the content of '%s'", file.NameInArchive)
+		}
+		fileContentSha256s = append(fileContentSha256s, fileContentSha256)
+	}
+	return fileContentSha256s, nil
+}
+
+// CompressPathWithBlobReferences is similar to CompressPath, except that every regular file whose SHA-256 is in
+// blobHashesToReference is archived as an empty entry carrying BlobSha256PaxRecordKey instead of its content.
+// Only a receiver that already stores those contents can restore the archive.
+// The returned MD5 is the one of the full content, as if nothing had been left out.
+func CompressPathWithBlobReferences(pathToCompress string, enforceMaxFileSizeLimit bool, blobHashesToReference map[string]bool) (io.ReadCloser, uint64, []byte, error) {
+	compressedFilePath, compressedFileSize, compressedFileContentMd5, err := compressPathToFile(pathToCompress, enforceMaxFileSizeLimit, blobHashesToReference)
+	if err != nil {
+		return nil, 0, nil, stacktrace.Propagate(err,
+			"An error occurred creating the archive from the files at '%s'", pathToCompress)
+	}
+	compressedFile, err := os.OpenFile(compressedFilePath, os.O_RDONLY, ownerAllPermissions)
+	if err != nil {
+		return nil, 0, nil, stacktrace.Propagate(err,
+			"Failed to open the archive file at '%s' during files upload for '%s'.", compressedFilePath, pathToCompress)
+	}
+	return compressedFile, compressedFileSize, compressedFileContentMd5, nil
+}
+
+func replaceFilesWithBlobReferences(files []archives.FileInfo, blobHashesToReference map[string]bool) ([]archives.FileInfo, error) {
+	result := make([]archives.FileInfo, 0, len(files))
+	for _, file := range files {
+		if !file.Mode().IsRegular() {
+			result = append(result, file)
+			continue
+		}
+		fileContentSha256, err := computeFileContentSha256(file)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred hashing the content of '%s'", file.NameInArchive)
+		}
+		if !blobHashesToReference[fileContentSha256] {
+			result = append(result, file)
+			continue
+		}
+		file.FileInfo = blobReferenceFileInfo{
+			FileInfo: file.FileInfo,
+			header: &tar.Header{ //nolint:exhaustruct
+				PAXRecords: map[string]string{
+					BlobSha256PaxRecordKey: fileContentSha256,
+				},
+			},
+		}
+		result = append(result, file)
+	}
+	return result, nil
+}
+
+func computeFileContentSha256(file archives.FileInfo) (string, error) {
+	reader, err := file.Open()
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred opening '%s'", file.NameInArchive)
+	}
+	defer reader.Close()
+	hasher := sha256.New()
+	if _, err = io.Copy(hasher, reader); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred reading '%s'", file.NameInArchive)
+	}
+	return hex.EncodeToString(hasher.Sum(nil)), nil
+}
+
+// blobReferenceFileInfo makes tar.FileInfoHeader produce an empty entry carrying the PAX records of header, and
+// as the content is copied up to the size of the entry, nothing gets read from the file
+type blobReferenceFileInfo struct {
+	fs.FileInfo
+	header *tar.Header
+}
+
+func (info blobReferenceFileInfo) Size() int64 {
+	return 0
+}
+
+func (info blobReferenceFileInfo) Sys() any {
+	return info.header
+}
@@ -0,0 +1,63 @@
+package path_compression
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompressPathWithBlobReferences(t *testing.T) {
+	dirPath, err := os.MkdirTemp("", "test-dir-*")
+	require.NoError(t, err)
+	defer os.RemoveAll(dirPath)
+
+	storedContent := "already on the server"
+	newContent := "not on the server yet"
+	require.NoError(t, os.WriteFile(path.Join(dirPath, "stored.txt"), []byte(storedContent), defaultPerm))
+	require.NoError(t, os.WriteFile(path.Join(dirPath, "new.txt"), []byte(newContent), defaultPerm))
+
+	fileContentSha256s, err := ComputeFileContentSha256s(dirPath)
+	require.NoError(t, err)
+	storedContentSha256 := sha256.Sum256([]byte(storedContent))
+	newContentSha256 := sha256.Sum256([]byte(newContent))
+	require.ElementsMatch(t, []string{hex.EncodeToString(storedContentSha256[:]), hex.EncodeToString(newContentSha256[:])}, fileContentSha256s)
+
+	_, _, fullContentMd5, err := CompressPath(dirPath, false)
+	require.NoError(t, err)
+
+	archive, _, contentMd5, err := CompressPathWithBlobReferences(dirPath, false, map[string]bool{
+		hex.EncodeToString(storedContentSha256[:]): true,
+	})
+	require.NoError(t, err)
+	defer archive.Close()
+	require.Equal(t, fullContentMd5, contentMd5)
+
+	gzipReader, err := gzip.NewReader(archive)
+	require.NoError(t, err)
+	tarReader := tar.NewReader(gzipReader)
+	contentByName := map[string]string{}
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		content, err := io.ReadAll(tarReader)
+		require.NoError(t, err)
+		contentByName[header.Name] = string(content)
+		if header.Name == "stored.txt" {
+			require.Equal(t, int64(0), header.Size)
+			require.Equal(t, hex.EncodeToString(storedContentSha256[:]), header.PAXRecords[BlobSha256PaxRecordKey])
+		} else {
+			require.NotContains(t, header.PAXRecords, BlobSha256PaxRecordKey)
+		}
+	}
+	require.Equal(t, map[string]string{"stored.txt": "", "new.txt": newContent}, contentByName)
+}
@@ -0,0 +1,65 @@
+package path_compression
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/mholt/archives"
+)
+
+// ListFilesInPath returns the paths, relative to rootPath and sorted, of the regular files found recursively in the
+// directory at rootPath, leaving out the ones its .kurtosisignore excludes. It's what a consumer keeping the copy of a
+// directory in sync should watch.
+func ListFilesInPath(rootPath string) ([]string, error) {
+	rules := loadIgnoreRules(rootPath)
+	pathsInRoot, err := listFilesInPathDeterministic(rootPath, true, rules)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred listing the files in '%s'", rootPath)
+	}
+	relativeFilepaths := []string{}
+	for _, pathInRoot := range pathsInRoot {
+		fileInfo, err := os.Stat(pathInRoot)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred inspecting '%s'", pathInRoot)
+		}
+		if fileInfo.IsDir() {
+			continue
+		}
+		relativeFilepath, err := filepath.Rel(rootPath, pathInRoot)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the path of '%s' relative to '%s'", pathInRoot, rootPath)
+		}
+		relativeFilepaths = append(relativeFilepaths, relativeFilepath)
+	}
+	return relativeFilepaths, nil
+}
+
+// ArchiveFilesToTar writes to output an uncompressed TAR of the given files, identified by their path relative to
+// rootPath. The files keep their relative path inside the archive, so extracting it in a directory mirrors rootPath.
+func ArchiveFilesToTar(ctx context.Context, rootPath string, relativeFilepaths []string, output io.Writer) error {
+	filenameMappings := map[string]string{}
+	for _, relativeFilepath := range relativeFilepaths {
+		filenameMappings[filepath.Join(rootPath, relativeFilepath)] = filepath.ToSlash(relativeFilepath)
+	}
+	files, err := archives.FilesFromDisk(ctx, nil, filenameMappings)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred listing the files to archive from '%s'", rootPath)
+	}
+	format := archives.Tar{
+		Format:          0,
+		FormatGNU:       false,
+		NumericUIDGID:   false,
+		ContinueOnError: false,
+		Uid:             0,
+		Gid:             0,
+		Uname:           "",
+		Gname:           "",
+	}
+	if err = format.Archive(ctx, output, files); err != nil {
+		return stacktrace.Propagate(err, "An error occurred archiving %d files from '%s'", len(relativeFilepaths), rootPath)
+	}
+	return nil
+}
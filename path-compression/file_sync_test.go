@@ -0,0 +1,58 @@
+package path_compression
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestListFilesInPath_SkipsDirectoriesAndIgnoredFiles(t *testing.T) {
+	// test-dir/
+	// |-- .kurtosisignore  (contains "*.tmp")
+	// |-- file_1.txt
+	// |-- temp.tmp
+	// |-- src/
+	// |   |-- main.go
+	dirPath, err := os.MkdirTemp("", "test-list-files-*")
+	require.NoError(t, err)
+	defer os.RemoveAll(dirPath)
+
+	require.NoError(t, os.WriteFile(path.Join(dirPath, ".kurtosisignore"), []byte("*.tmp\n"), defaultPerm))
+	require.NoError(t, os.WriteFile(path.Join(dirPath, "file_1.txt"), []byte("1"), defaultPerm))
+	require.NoError(t, os.WriteFile(path.Join(dirPath, "temp.tmp"), []byte("tmp"), defaultPerm))
+	require.NoError(t, os.Mkdir(path.Join(dirPath, "src"), defaultPerm))
+	require.NoError(t, os.WriteFile(path.Join(dirPath, "src", "main.go"), []byte("package main"), defaultPerm))
+
+	relativeFilepaths, err := ListFilesInPath(dirPath)
+	require.NoError(t, err)
+	require.Equal(t, []string{".kurtosisignore", "file_1.txt", "src/main.go"}, relativeFilepaths)
+}
+
+func TestArchiveFilesToTar_OnlyArchivesGivenFiles(t *testing.T) {
+	dirPath, err := os.MkdirTemp("", "test-archive-files-*")
+	require.NoError(t, err)
+	defer os.RemoveAll(dirPath)
+
+	require.NoError(t, os.WriteFile(path.Join(dirPath, "file_1.txt"), []byte("1"), defaultPerm))
+	require.NoError(t, os.Mkdir(path.Join(dirPath, "src"), defaultPerm))
+	require.NoError(t, os.WriteFile(path.Join(dirPath, "src", "main.go"), []byte("package main"), defaultPerm))
+
+	output := &bytes.Buffer{}
+	require.NoError(t, ArchiveFilesToTar(context.Background(), dirPath, []string{"src/main.go"}, output))
+
+	tarReader := tar.NewReader(output)
+	header, err := tarReader.Next()
+	require.NoError(t, err)
+	require.Equal(t, "src/main.go", header.Name)
+	content, err := io.ReadAll(tarReader)
+	require.NoError(t, err)
+	require.Equal(t, "package main", string(content))
+	_, err = tarReader.Next()
+	require.Equal(t, io.EOF, err)
+}
@@ -66,6 +66,11 @@ func CompressPath(pathToCompress string, enforceMaxFileSizeLimit bool) (io.ReadC
 // to the TGZ archive created, alongside the size (in bytes) of the archive and the md5 of its content
 // Note: the MD5 is NOT the MD% of the archive file itself. See inline comments below for more info on this MD5 hash
 func CompressPathToFile(pathToCompress string, enforceMaxFileSizeLimit bool) (string, uint64, []byte, error) {
+	noBlobHashesToReference := map[string]bool{}
+	return compressPathToFile(pathToCompress, enforceMaxFileSizeLimit, noBlobHashesToReference)
+}
+
+func compressPathToFile(pathToCompress string, enforceMaxFileSizeLimit bool, blobHashesToReference map[string]bool) (string, uint64, []byte, error) {
 	// First we compute the hash of the content about to be compressed
 	compressedFileContentMd5, err := ComputeContentHash(pathToCompress)
 	if err != nil {
@@ -73,10 +78,15 @@ func CompressPathToFile(pathToCompress string, enforceMaxFileSizeLimit bool) (st
 	}
 
 	// Then we compress the content into an archive
-	rules := loadIgnoreRules(pathToCompress)
-	filepathsToUpload, err := listFilesInPathDeterministic(pathToCompress, false, rules)
+	ctx := context.Background()
+	files, err := getFilesToArchive(ctx, pathToCompress)
 	if err != nil {
-		return "", 0, nil, stacktrace.Propagate(err, "There was an error in getting a list of files in the directory '%s' provided", pathToCompress)
+		return "", 0, nil, stacktrace.Propagate(err, "An error occurred when creating files list for archive from '%s'.", pathToCompress)
+	}
+	if len(blobHashesToReference) > 0 {
+		if files, err = replaceFilesWithBlobReferences(files, blobHashesToReference); err != nil {
+			return "", 0, nil, stacktrace.Propagate(err, "An error occurred replacing the files from '%s' already stored by the receiver with references to their content.", pathToCompress)
+		}
 	}
 
 	tempDir, err := os.MkdirTemp(defaultTmpDir, tempCompressionDirPattern)
@@ -93,16 +103,6 @@ func CompressPathToFile(pathToCompress string, enforceMaxFileSizeLimit bool) (st
 	}
 	defer outFile.Close()
 
-	ctx := context.Background()
-
-	files, err := archives.FilesFromDisk(
-		ctx,
-		nil, // use default settings
-		mapFilePathOnDiskToRelativePathInArchive(pathToCompress, filepathsToUpload))
-	if err != nil {
-		return "", 0, nil, stacktrace.Propagate(err, "An error occurred when creating files list for archive from '%s'.", pathToCompress)
-	}
-
 	format := archives.CompressedArchive{
 		Compression: archives.Gz{
 			CompressionLevel:   0,
@@ -150,6 +150,23 @@ func CompressPathToFile(pathToCompress string, enforceMaxFileSizeLimit bool) (st
 	return compressedFilePath, compressedFileSize, compressedFileContentMd5, nil
 }
 
+// getFilesToArchive returns the files that compressing pathToCompress puts in the archive
+func getFilesToArchive(ctx context.Context, pathToCompress string) ([]archives.FileInfo, error) {
+	rules := loadIgnoreRules(pathToCompress)
+	filepathsToUpload, err := listFilesInPathDeterministic(pathToCompress, false, rules)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "There was an error in getting a list of files in the directory '%s' provided", pathToCompress)
+	}
+	files, err := archives.FilesFromDisk(
+		ctx,
+		nil, // use default settings
+		mapFilePathOnDiskToRelativePathInArchive(pathToCompress, filepathsToUpload))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred listing the files to archive from '%s'", pathToCompress)
+	}
+	return files, nil
+}
+
 // listFilesInPathDeterministic returns the list of file paths in the path passed as an argument.
 // If the path points to a file, it only returns the given file path.
 // If recursiveMode is true, it recursively iterates over the directory inside the given path.